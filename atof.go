@@ -3,6 +3,7 @@
 package float16
 
 import (
+	"math/bits"
 	"strconv"
 )
 
@@ -34,17 +35,20 @@ func commonPrefixLenIgnoreCase(s, prefix string) int {
 	return n
 }
 
-func special(s string) (f Float16, n int, ok bool) {
+// special parses the infinity or NaN in s,
+// and returns its bit representation in the format described by flt.
+func special(s string, flt *floatInfo) (b uint64, n int, ok bool) {
 	if len(s) == 0 {
 		return 0, 0, false
 	}
 
-	sign := 1
+	inf := uint64(1<<flt.expbits-1) << flt.mantbits
+	sign := uint64(0)
 	nsign := 0
 	switch s[0] {
 	case '+', '-':
 		if s[0] == '-' {
-			sign = -1
+			sign = 1 << (flt.expbits + flt.mantbits)
 		}
 		nsign = 1
 		s = s[1:]
@@ -57,12 +61,12 @@ func special(s string) (f Float16, n int, ok bool) {
 			n = 3
 		}
 		if n == 3 || n == 8 {
-			return Inf(sign), nsign + n, true
+			return sign | inf, nsign + n, true
 		}
 	case 'n', 'N':
 		n := commonPrefixLenIgnoreCase(s, "nan")
 		if n == 3 {
-			return NaN(), n, true
+			return inf | 1<<(flt.mantbits-1), n, true
 		}
 	}
 	return 0, 0, false
@@ -88,6 +92,7 @@ func (b *decimal) set(s string) (ok bool) {
 	// digits
 	sawdot := false
 	sawdigits := false
+	nd := 0 // the number of digits, including the ones beyond the capacity of b.d
 	for ; i < len(s); i++ {
 		switch {
 		case s[i] == '_':
@@ -98,15 +103,16 @@ func (b *decimal) set(s string) (ok bool) {
 				return
 			}
 			sawdot = true
-			b.dp = b.nd
+			b.dp = nd
 			continue
 
 		case '0' <= s[i] && s[i] <= '9':
 			sawdigits = true
-			if s[i] == '0' && b.nd == 0 { // ignore leading zeros
+			if s[i] == '0' && nd == 0 { // ignore leading zeros
 				b.dp--
 				continue
 			}
+			nd++
 			if b.nd < len(b.d) {
 				b.d[b.nd] = s[i]
 				b.nd++
@@ -121,7 +127,7 @@ func (b *decimal) set(s string) (ok bool) {
 		return
 	}
 	if !sawdot {
-		b.dp = b.nd
+		b.dp = nd
 	}
 
 	// optional exponent moves decimal point.
@@ -168,30 +174,32 @@ func (b *decimal) set(s string) (ok bool) {
 // decimal power of ten to binary power of two.
 var powtab = []int{1, 3, 6, 9, 13, 16, 19, 23, 26}
 
-func (d *decimal) floatBits() (b uint16, overflow bool) {
-	var exp int
-	var mant uint64
+func (d *decimal) floatBits(flt *floatInfo, c *Context) (b uint64) {
+	sign := uint64(0)
+	if d.neg {
+		sign = 1 << (flt.expbits + flt.mantbits)
+	}
+	mantbits := int(flt.mantbits)
 
 	// Zero is always special.
 	if d.nd == 0 {
-		mant = 0
-		exp = -bias16
-		goto out
+		return sign
 	}
 
 	// Obvious overflow/underflow.
-	if d.dp > 5 {
-		goto overflow
+	// d is in [10^(dp-1), 10^dp), and 2^(3dp) <= 10^dp.
+	maxexp := 1<<flt.expbits - 2 + flt.bias // the exponent of the largest finite number
+	minexp := 1 + flt.bias                  // the exponent of the smallest normal number
+	if 3*(d.dp-1) > maxexp+1 {
+		return c.overflowBits(flt, d.neg)
 	}
-	if d.dp < -8 {
-		// zero
-		mant = 0
-		exp = -bias16
-		goto out
+	if 3*d.dp <= minexp-mantbits-1 {
+		// less than half of the smallest subnormal number
+		return c.roundBits(flt, d.neg, 0, 0, true)
 	}
 
 	// Scale by powers of two until in range [0.5, 1.0)
-	exp = 0
+	exp := 0
 	for d.dp > 0 {
 		var n int
 		if d.dp >= len(powtab) {
@@ -213,53 +221,24 @@ func (d *decimal) floatBits() (b uint16, overflow bool) {
 		exp -= n
 	}
 
-	// Our rage is [0.5,1) but floating point range is [1,2).
-	exp--
-
-	// Minimum exponent is -bias16.
-	// If the exponent is smaller, denormalize.
-	if exp <= -bias16 {
-		n := -bias16 - exp + 1
-		d.Shift(-n)
-		exp += n
-	}
-	if exp+bias16 >= mask16 {
-		goto overflow
-	}
-
-	// Extract 1+shift16 bits
-	d.Shift(1 + shift16)
-	mant = d.RoundedInteger()
-
-	// Rounding might have added a bit; shift down.
-	if mant == 2<<shift16 {
-		mant >>= 1
-		exp++
-		if exp+bias16 >= mask16 {
-			goto overflow
-		}
-	}
+	// Extract 1+mantbits bits, the rounding bit and the guard bit.
+	// The remaining digits are folded into sticky.
+	d.Shift(mantbits + 3)
+	mant, sticky := d.integer()
+	return c.roundBits(flt, d.neg, exp-(mantbits+3), mant, sticky)
+}
 
-	// Denormalized?
-	if mant&(1<<shift16) == 0 {
-		exp = -bias16
+// integer returns the integer part of d, truncated,
+// and reports whether any non-zero digits have been discarded.
+func (d *decimal) integer() (n uint64, sticky bool) {
+	var i int
+	for i = 0; i < d.dp && i < d.nd; i++ {
+		n = n*10 + uint64(d.d[i]-'0')
 	}
-	goto out
-
-overflow:
-	// ±Inf
-	mant = 0
-	exp = mask16 - bias16
-	overflow = true
-
-out:
-	// Assemble bits.
-	bits := mant & fracMask16
-	bits |= (uint64(exp+bias16) & mask16) << shift16
-	if d.neg {
-		bits |= signMask16
+	for ; i < d.dp; i++ {
+		n *= 10
 	}
-	return uint16(bits), overflow
+	return n, d.trunc || d.dp < d.nd
 }
 
 // readFloat reads a decimal or hexadecimal mantissa and exponent from a float
@@ -404,105 +383,186 @@ loop:
 	return
 }
 
-// atofHex converts the hex floating-point string s
-// The string s has already been parsed into a mantissa, exponent, and sign (neg==true for negative).
-// If trunc is true, trailing non-zero bits have been omitted from the mantissa.
-//
-// based on https://github.com/golang/go/blob/8c92897e15d15fbc664cd5a05132ce800cf4017f/src/strconv/atof.go#L494-L562
-func atofHex(s string, mantissa uint64, exp int, neg, trunc bool) (Float16, error) {
-	maxExp := mask16 - bias16 - 1
-	minExp := -bias16 + 1
-	exp += int(shift16) // mantissa now implicitly divided by 2^shift16.
-
-	// Shift mantissa and exponent to bring representation into float range.
-	// Eventually we want a mantissa with a leading 1-bit followed by mantbits other bits.
-	// For rounding, we need two more, where the bottom bit represents
-	// whether that bit or any later bit was non-zero.
-	// (If the mantissa has already lost non-zero bits, trunc is true,
-	// and we OR in a 1 below after shifting left appropriately.)
-	for mantissa != 0 && mantissa>>(shift16+2) == 0 {
-		mantissa <<= 1
-		exp--
-	}
-	if trunc {
-		mantissa |= 1
-	}
-	for mantissa>>(1+shift16+2) != 0 {
-		mantissa = mantissa>>1 | mantissa&1
-		exp++
-	}
-
-	// If exponent is too negative,
-	// denormalize in hopes of making it representable.
-	// (The -2 is for the rounding bits.)
-	for mantissa > 1 && exp < minExp-2 {
-		mantissa = mantissa>>1 | mantissa&1
-		exp++
-	}
-
-	// Round using two bottom bits.
-	round := mantissa & 3
-	mantissa >>= 2
-	round |= mantissa & 1 // round to even (round up if mantissa is odd)
-	exp += 2
-	if round == 3 {
-		mantissa++
-		if mantissa == 1<<(1+shift16) {
-			mantissa >>= 1
-			exp++
+// pow10tab and pow5tab are the powers of 10 and 5 that fit in uint64.
+var (
+	pow10tab [20]uint64
+	pow5tab  [28]uint64
+)
+
+func init() {
+	pow10tab[0], pow5tab[0] = 1, 1
+	for i := 1; i < len(pow10tab); i++ {
+		pow10tab[i] = pow10tab[i-1] * 10
+	}
+	for i := 1; i < len(pow5tab); i++ {
+		pow5tab[i] = pow5tab[i-1] * 5
+	}
+}
+
+// decimalToBinary converts mantissa × 10^exp to mant × 2^bexp exactly in 128-bit arithmetic.
+// sticky reports whether non-zero bits below mant have been discarded.
+// mant has at most 62 bits, so that one more bit can be appended.
+// It reports false if exp is out of the range it can handle.
+func decimalToBinary(mantissa uint64, exp int) (mant uint64, bexp int, sticky bool, ok bool) {
+	var hi, lo uint64
+	switch {
+	case 0 <= exp && exp < len(pow10tab):
+		// the product of two uint64 fits in 128 bits.
+		hi, lo = bits.Mul64(mantissa, pow10tab[exp])
+		bexp = 0
+	case -len(pow5tab) < exp && exp < 0:
+		// mantissa × 10^exp = (mantissa × 2^(64+s) / 5^-exp) × 2^(exp-64-s),
+		// where s is chosen so that the quotient has at least 64 bits.
+		// the quotient is computed by the long division.
+		s := bits.LeadingZeros64(mantissa)
+		m := mantissa << s
+		d := pow5tab[-exp]
+		var rem uint64
+		hi, rem = m/d, m%d
+		lo, rem = bits.Div64(rem, 0, d)
+		bexp = exp - 64 - s
+		sticky = rem != 0
+	default:
+		return 0, 0, false, false
+	}
+
+	// normalize the 128-bit integer to 62 bits.
+	n := 128 - bits.LeadingZeros64(hi)
+	if hi == 0 {
+		n = 64 - bits.LeadingZeros64(lo)
+	}
+	if n > 62 {
+		shift := uint(n - 62)
+		if shift < 64 {
+			sticky = sticky || lo&(1<<shift-1) != 0
+			lo = lo>>shift | hi<<(64-shift)
+		} else {
+			sticky = sticky || lo != 0 || hi&(1<<(shift-64)-1) != 0
+			lo = hi >> (shift - 64)
 		}
+		bexp += int(shift)
 	}
+	return lo, bexp, sticky, true
+}
 
-	if mantissa>>shift16 == 0 { // subnormal or zero
-		exp = -bias16
+// atofDec converts mantissa × 10^exp to the format described by flt, rounding in the direction of c.Mode.
+// If trunc is true, trailing non-zero digits have been omitted from the mantissa.
+// It reports false if the result can't be determined from mantissa and exp;
+// then the caller has to fall back to the multiprecision decimal.
+func atofDec(flt *floatInfo, mantissa uint64, exp int, neg, trunc bool, c *Context) (b uint64, ok bool) {
+	mant, bexp, sticky, ok := decimalToBinary(mantissa, exp)
+	if !ok {
+		return 0, false
+	}
+	if !trunc {
+		// the conversion is exact.
+		return c.roundBits(flt, neg, bexp, mant, sticky), true
 	}
 
-	var err error
-	if exp > maxExp {
-		mantissa = 0
-		exp = mask16 - bias16
-		err = &strconv.NumError{Func: "float16.Parse", Num: s, Err: strconv.ErrRange}
+	// the exact value is strictly between mantissa × 10^exp and (mantissa+1) × 10^exp.
+	// if the both ends round to the same result with the same flags, so does the exact value,
+	// because rounding is monotonic.
+	upper, ubexp, usticky, _ := decimalToBinary(mantissa+1, exp)
+	if !usticky {
+		// just below the upper end.
+		upper, ubexp = upper<<1-1, ubexp-1
+	}
+	lc := Context{Mode: c.Mode, Tininess: c.Tininess}
+	uc := Context{Mode: c.Mode, Tininess: c.Tininess}
+	b = lc.roundBits(flt, neg, bexp, mant, true)
+	if b != uc.roundBits(flt, neg, ubexp, upper, true) || lc.Flags != uc.Flags {
+		return 0, false
 	}
 
-	bits := mantissa & fracMask16
-	bits |= (uint64(exp+bias16) & mask16) << shift16
-	if neg {
-		bits |= signMask16
+	// however, the exact value may be a representable value between the ends,
+	// which makes the result exact. rounding toward zero detects it.
+	var z Context
+	z.Mode = ToZero
+	if z.roundBits(flt, neg, bexp, mant, true) != z.roundBits(flt, neg, ubexp, upper, true) {
+		return 0, false
 	}
-	return Float16(bits), err
+	c.Flags |= lc.Flags
+	return b, true
 }
 
-func atof16(s string) (f Float16, n int, err error) {
-	if val, n, ok := special(s); ok {
+// atof converts s to the bit representation in the format described by flt.
+// fn is the name of the function reported in errors.
+//
+// The result is correctly rounded for any number of digits.
+// The common cases, that have at most 19 significant digits and a small exponent,
+// are converted exactly in 128-bit arithmetic.
+// The others are converted by the multiprecision decimal,
+// whose precision is far beyond what is needed to round them correctly.
+func atof(s string, flt *floatInfo, fn string, c *Context) (b uint64, n int, err error) {
+	if val, n, ok := special(s, flt); ok {
 		return val, n, nil
 	}
 
 	mantissa, exp, neg, trunc, hex, n, ok := readFloat(s)
 	if !ok {
-		return 0, n, &strconv.NumError{Func: "float16.Parse", Num: s, Err: strconv.ErrSyntax}
+		return 0, n, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
 	}
 
 	if hex {
-		f, err = atofHex(s, mantissa, exp, neg, trunc)
-		return f, n, err
+		// the mantissa is already binary.
+		b = c.roundBits(flt, neg, exp, mantissa, trunc)
+	} else if b, ok = atofDec(flt, mantissa, exp, neg, trunc, c); !ok {
+		var d decimal
+		if !d.set(s[:n]) {
+			return 0, n, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+		}
+		b = d.floatBits(flt, c)
 	}
 
-	var d decimal
-	if !d.set(s[:n]) {
-		return 0, n, &strconv.NumError{Func: "float16.Parse", Num: s, Err: strconv.ErrSyntax}
-	}
-	b, ovf := d.floatBits()
-	f = Float16(b)
-	if ovf {
-		err = &strconv.NumError{Func: "float16.Parse", Num: s, Err: strconv.ErrRange}
+	// the value is out of range if it overflows to an infinity,
+	// or if it is non-zero but underflows to zero.
+	mag := b &^ (1 << (flt.expbits + flt.mantbits))
+	if mag == uint64(1<<flt.expbits-1)<<flt.mantbits || mag == 0 && mantissa != 0 {
+		err = &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrRange}
 	}
-	return f, n, err
+	return b, n, err
 }
 
+// Parse converts the string s to a Float16, rounding to nearest even.
+// It accepts decimal and hexadecimal floating-point numbers, infinities and NaNs
+// in the same syntax as [strconv.ParseFloat].
+// The result is correctly rounded, however many digits s has.
+//
+// If s is syntactically well-formed but is out of the range of Float16,
+// Parse returns ±Inf for an overflow or ±0 for a non-zero value that underflows to zero,
+// and an error whose Err is [strconv.ErrRange].
 func Parse(s string) (Float16, error) {
-	f, n, err := atof16(s)
+	var c Context
+	return parse(s, &c)
+}
+
+// ParsePrefix converts the longest prefix of s that is a floating-point number to a Float16,
+// and returns the number of bytes consumed.
+// It accepts the same syntax as [Parse], and is useful to parse a number in a larger text.
+// If s doesn't start with a number, ParsePrefix returns n = 0 and an error whose Err is [strconv.ErrSyntax].
+// If the number is out of the range of Float16,
+// ParsePrefix returns ±Inf or ±0 as [Parse] does, the length of the number,
+// and an error whose Err is [strconv.ErrRange].
+func ParsePrefix(s string) (x Float16, n int, err error) {
+	var c Context
+	b, n, err := atof(s, &float16info, "float16.ParsePrefix", &c)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return 0, 0, err
+	}
+	return Float16(b), n, err
+}
+
+func parse(s string, c *Context) (Float16, error) {
+	b, err := parseBits(s, &float16info, "float16.Parse", c)
+	return Float16(b), err
+}
+
+// parseBits converts the whole string s to the bit representation
+// in the format described by flt.
+func parseBits(s string, flt *floatInfo, fn string, c *Context) (uint64, error) {
+	b, n, err := atof(s, flt, fn, c)
 	if n != len(s) && (err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax) {
-		return 0, &strconv.NumError{Func: "float16.Parse", Num: s, Err: strconv.ErrSyntax}
+		return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
 	}
-	return f, err
+	return b, err
 }
@@ -3,7 +3,10 @@ package float16
 import (
 	"cmp"
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -137,6 +140,150 @@ func TestParse_overflow(t *testing.T) {
 	}
 }
 
+func TestParse_underflow(t *testing.T) {
+	tests := []struct {
+		s string
+		x Float16
+	}{
+		{"1e-8", 0x0000},
+		{"-1e-8", 0x8000},
+		{"2.98023223876953125e-8", 0x0000}, // halfway between 0 and the smallest subnormal; rounds to even
+		{"0x1p-25", 0x0000},
+		{"-0x1p-30", 0x8000},
+		{"1e-1000000", 0x0000},
+		{"0." + strings.Repeat("0", 1000) + "1", 0x0000},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.s)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Err != strconv.ErrRange {
+			t.Errorf("%q: expected strconv.ErrRange, got %v", tt.s, err)
+		}
+		if got != tt.x {
+			t.Errorf("%q: expected %04x, got %04x", tt.s, tt.x, got)
+		}
+	}
+
+	// zeros and the values rounded to the smallest subnormal number are not errors.
+	for _, s := range []string{"0", "-0e-100", "0x0p-100", "2.98023223876953126e-8", "0x1.000001p-25"} {
+		if _, err := Parse(s); err != nil {
+			t.Errorf("%q: expected no error, got %v", s, err)
+		}
+	}
+}
+
+// TestParse_BigRat checks that Parse rounds correctly in all rounding modes,
+// comparing the results with big.Rat.
+// The inputs are Float16 values, the midpoints between adjacent ones, the values just around them,
+// and random digit strings, some of which are longer than the buffer of the decimal.
+func TestParse_BigRat(t *testing.T) {
+	var o bigOracle
+	r := newXorshift64()
+	n := 100000
+	if testing.Short() {
+		n = 10000
+	}
+
+	var x, y, eps big.Rat
+	var buf []byte
+	for i := 0; i < n; i++ {
+		// the number of digits after the decimal point.
+		digits := 40
+		switch r.Uint64() % 4 {
+		case 0:
+			digits = 60
+		case 1:
+			digits = 900
+		}
+
+		switch r.Uint64() % 3 {
+		case 0, 1:
+			// a random value a, the midpoint of a and the next value, and the values next to them.
+			a := Float16(r.Uint64() % uint64(uvmax))
+			x.SetFloat64(a.Float64())
+			if r.Uint64()%2 == 0 {
+				y.SetFloat64((a + 1).Float64())
+				x.Add(&x, &y)
+				x.Quo(&x, big.NewRat(2, 1))
+			}
+			eps.SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil))
+			switch r.Uint64() % 3 {
+			case 1:
+				x.Add(&x, &eps)
+			case 2:
+				x.Sub(&x, &eps)
+			}
+		case 2:
+			// random digits.
+			m := new(big.Int).SetUint64(r.Uint64())
+			m.Mul(m, new(big.Int).SetUint64(r.Uint64()))
+			e := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(r.Uint64()%40)), nil)
+			if r.Uint64()%2 == 0 {
+				x.SetFrac(m, e)
+			} else {
+				x.SetFrac(m.Mul(m, big.NewInt(1<<16)), e)
+			}
+		}
+		if x.Sign() == 0 {
+			continue
+		}
+		if r.Uint64()%2 == 0 {
+			x.Neg(&x)
+		}
+		// x may be negative before the flip, e.g. 0 - eps.
+		neg := x.Sign() < 0
+
+		// format x exactly, in the decimal or exponential form.
+		buf = buf[:0]
+		if r.Uint64()%2 == 0 {
+			buf = append(buf, x.FloatString(digits)...)
+		} else {
+			y.SetFrac(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil), big.NewInt(1))
+			y.Mul(&y, &x)
+			buf = append(buf, y.Num().String()...)
+			buf = append(buf, 'e', '-')
+			buf = strconv.AppendInt(buf, int64(digits), 10)
+		}
+		s := string(buf)
+
+		for _, mode := range roundingModes {
+			c := Context{Mode: mode}
+			got, err := c.Parse(s)
+			want := o.round(&x, neg, mode)
+			if got != want {
+				t.Errorf("%s: %q: expected %04x, got %04x", mode, s, want, got)
+				continue
+			}
+
+			wantRange := want&^signMask16 == 0 || want&^signMask16 == uvinf
+			if numErr, ok := err.(*strconv.NumError); wantRange && (!ok || numErr.Err != strconv.ErrRange) || !wantRange && err != nil {
+				t.Errorf("%s: %q: unexpected error %v", mode, s, err)
+			}
+			if want.IsInf(0) {
+				continue
+			}
+			y.SetFloat64(want.Float64())
+			if exact := y.Cmp(&x) == 0; exact != (c.Flags&Inexact == 0) {
+				t.Errorf("%s: %q: unexpected flags %v", mode, s, c.Flags)
+			}
+		}
+	}
+}
+
+// TestParse_Allocs checks that Parse doesn't allocate, except for errors.
+func TestParse_Allocs(t *testing.T) {
+	for _, s := range []string{"1024", "0.1", "1.00048828125000000000000000000000000001", "0x1.92p+01", "0." + strings.Repeat("3", 1000)} {
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := Parse(s); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("%q: expected no allocations, got %v", s, allocs)
+		}
+	}
+}
+
 func TestParse_SyntaxError(t *testing.T) {
 	tests := []string{
 		"",
@@ -175,6 +322,14 @@ func TestParse_SyntaxError(t *testing.T) {
 	}
 }
 
+// FuzzParse checks that Parse never panics, that it round-trips through String,
+// and that it agrees with strconv.ParseFloat followed by FromFloat64.
+//
+// The latter rounds twice, first to float64 and then to Float16.
+// The midpoints between adjacent Float16 values are float64 values,
+// so the first rounding never crosses them, but it may round onto one of them.
+// Then FromFloat64 breaks the tie to even, while Parse rounds the exact value,
+// and the results may differ by one ulp. This is the only case they disagree.
 func FuzzParse(f *testing.F) {
 	f.Add("0")
 	f.Add("-0")
@@ -187,11 +342,20 @@ func FuzzParse(f *testing.F) {
 
 	f.Fuzz(func(t *testing.T, s string) {
 		x0, err := Parse(s)
-		if err != nil {
+		f64, err64 := strconv.ParseFloat(s, 64)
+		if isSyntaxError(err) != isSyntaxError(err64) {
+			t.Fatalf("%q: unexpected error %v, strconv.ParseFloat returns %v", s, err, err64)
+		}
+		if isSyntaxError(err) {
 			return
 		}
-		s1 := x0.String()
 
+		want := FromFloat64(f64)
+		if x0 != want && !(x0.IsNaN() && want.IsNaN()) && !isDoubleRounding(f64, x0, want) {
+			t.Errorf("%q: expected %04x, got %04x", s, want, x0)
+		}
+
+		s1 := x0.String()
 		x1, err := Parse(s1)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
@@ -204,3 +368,126 @@ func FuzzParse(f *testing.F) {
 		}
 	})
 }
+
+func isSyntaxError(err error) bool {
+	numErr, ok := err.(*strconv.NumError)
+	return ok && numErr.Err == strconv.ErrSyntax
+}
+
+// isDoubleRounding reports whether f is the midpoint between the adjacent Float16 values x and y.
+func isDoubleRounding(f float64, x, y Float16) bool {
+	if x.IsNaN() || y.IsNaN() || x&signMask16 != y&signMask16 {
+		return false
+	}
+	if x > y {
+		x, y = y, x
+	}
+	if y-x != 1 {
+		return false
+	}
+
+	// the infinity is rounded from the values beyond 65520, the midpoint between 65504 and 65536.
+	fx, fy := x.Float64(), y.Float64()
+	if math.IsInf(fy, 0) {
+		fy = math.Copysign(65536, fy)
+	}
+	return f == (fx+fy)/2
+}
+
+func TestParsePrefix(t *testing.T) {
+	tests := []struct {
+		s   string
+		x   Float16
+		n   int
+		err error
+	}{
+		{"1.5", FromFloat64(1.5), 3, nil},
+		{"1.5, 2", FromFloat64(1.5), 3, nil},
+		{"-0x1p-2]", FromFloat64(-0.25), 7, nil},
+		{"1e5x", uvinf, 3, strconv.ErrRange},
+		{"infinity!", uvinf, 8, nil},
+		{"infin", uvinf, 3, nil},
+		{"1_000 ", FromFloat64(1000), 5, nil},
+		{"1e+", 0, 0, strconv.ErrSyntax},
+		{"x1", 0, 0, strconv.ErrSyntax},
+		{"", 0, 0, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		x, n, err := ParsePrefix(tt.s)
+		if x != tt.x || n != tt.n {
+			t.Errorf("%q: expected %04x, %d, got %04x, %d", tt.s, tt.x, tt.n, x, n)
+		}
+		if tt.err == nil {
+			if err != nil {
+				t.Errorf("%q: expected no error, got %v", tt.s, err)
+			}
+			continue
+		}
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Err != tt.err || numErr.Func != "float16.ParsePrefix" {
+			t.Errorf("%q: unexpected error %v", tt.s, err)
+		}
+	}
+
+	nan, n, err := ParsePrefix("NaN)")
+	if !nan.IsNaN() || n != 3 || err != nil {
+		t.Errorf("NaN): unexpected result %v, %d, %v", nan, n, err)
+	}
+}
+
+func TestParsePrefix_Parse(t *testing.T) {
+	// ParsePrefix consumes the whole string if Parse accepts it.
+	for i := 0; i < 0x10000; i++ {
+		x := FromBits(uint16(i))
+		for _, fmt := range []byte{'e', 'f', 'g', 'x'} {
+			s := x.Text(fmt, -1)
+			want, err := Parse(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, n, err := ParsePrefix(s + "+")
+			if err != nil || n != len(s) || (got != want && !want.IsNaN()) {
+				t.Errorf("%q: expected %04x, %d, got %04x, %d, %v", s, want, len(s), got, n, err)
+			}
+		}
+	}
+}
+
+var benchmarkParseInputs = []struct {
+	name string
+	s    string
+}{
+	{"Int", "1024"},
+	{"Decimal", "3.140625"},
+	{"Shortest", "0.1"},
+	{"Exp", "6.1035e-05"},
+	{"Subnormal", "5.96e-08"},
+	{"Hex", "0x1.92p+01"},
+	{"Long", "1.00048828125000000000000000000000000001"},
+	{"Halfway", "1.00048828125"},
+	{"Overflow", "1e10"},
+	{"Underflow", "1e-10"},
+	{"Digits1000", "0." + strings.Repeat("3", 1000)},
+}
+
+func BenchmarkParse(b *testing.B) {
+	for _, bb := range benchmarkParseInputs {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Parse(bb.s)
+			}
+		})
+	}
+}
+
+func BenchmarkParse_Strconv(b *testing.B) {
+	for _, bb := range benchmarkParseInputs {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				f, _ := strconv.ParseFloat(bb.s, 64)
+				FromFloat64(f)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package float16
+
+import "math"
+
+const (
+	uvnanBF16    = 0x7fc0     // "not-a-number"
+	uvinfBF16    = 0x7f80     // infinity
+	uvneginfBF16 = 0xff80     // negative infinity
+	maskBF16     = 0xff       // mask for exponent
+	shiftBF16    = 16 - 8 - 1 // shift for exponent
+	biasBF16     = 127        // bias for exponent
+	signMaskBF16 = 1 << 15    // mask for sign bit
+	fracMaskBF16 = 1<<shiftBF16 - 1
+)
+
+var bfloat16info = floatInfo{shiftBF16, 8, -biasBF16}
+
+// BFloat16 represents a 16-bit brain floating point number.
+// It has the same exponent range as float32, and 8 bits of precision.
+// The arithmetic of BFloat16 rounds to nearest even.
+type BFloat16 uint16
+
+// BFloat16Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func BFloat16Inf(sign int) BFloat16 {
+	if sign >= 0 {
+		return BFloat16(uvinfBF16)
+	}
+	return BFloat16(uvneginfBF16)
+}
+
+// IsInf reports whether f is an infinity, according to sign.
+// If sign > 0, IsInf reports whether f is positive infinity.
+// If sign < 0, IsInf reports whether f is negative infinity.
+// If sign == 0, IsInf reports whether f is either infinity.
+func (f BFloat16) IsInf(sign int) bool {
+	return sign >= 0 && f == uvinfBF16 || sign <= 0 && f == uvneginfBF16
+}
+
+// BFloat16NaN returns a “not-a-number” value.
+func BFloat16NaN() BFloat16 {
+	return BFloat16(uvnanBF16)
+}
+
+// IsNaN reports whether f is a “not-a-number” value.
+func (f BFloat16) IsNaN() bool {
+	return f&(maskBF16<<shiftBF16) == (maskBF16<<shiftBF16) && f&fracMaskBF16 != 0
+}
+
+// BFloat16FromBits returns the floating point number corresponding
+// the binary representation b.
+func BFloat16FromBits(b uint16) BFloat16 {
+	return BFloat16(b)
+}
+
+// Bits returns the binary representation of f.
+func (f BFloat16) Bits() uint16 {
+	return uint16(f)
+}
+
+// BFloat16FromFloat32 returns the BFloat16 nearest to f, rounding ties to even.
+// NaNs keep their sign and the upper bits of their payload, and become quiet.
+func BFloat16FromFloat32(f float32) BFloat16 {
+	b := math.Float32bits(f)
+	if b&^signMask32 > mask32<<shift32 {
+		// NaN
+		return BFloat16(b>>16 | 1<<(shiftBF16-1))
+	}
+
+	// round to nearest even
+	const halfMinusULP = 1<<15 - 1
+	b += halfMinusULP + (b >> 16 & 1)
+	return BFloat16(b >> 16)
+}
+
+// BFloat16FromFloat64 returns the BFloat16 nearest to f, rounding ties to even.
+// NaNs keep their sign and the upper bits of their payload, and become quiet.
+func BFloat16FromFloat64(f float64) BFloat16 {
+	if math.IsNaN(f) {
+		b := math.Float64bits(f)
+		sign := uint16(b >> (64 - 16) & signMaskBF16)
+		return BFloat16(sign | uvnanBF16 | uint16(b>>(shift64-shiftBF16)&fracMaskBF16))
+	}
+
+	// Converting to float32 and then to BFloat16 may round twice.
+	// Rounding to odd in the first step makes the final result correct,
+	// because float32 has more than two extra bits of precision.
+	f32 := float32(f)
+	if float64(f32) != f {
+		b := math.Float32bits(f32)
+		if math.Abs(float64(f32)) > math.Abs(f) {
+			// f32 has been rounded away from zero; truncate it.
+			b--
+		}
+		b |= 1
+		f32 = math.Float32frombits(b)
+	}
+	return BFloat16FromFloat32(f32)
+}
+
+// Float32 returns the float32 representation of f.
+// The conversion is exact.
+func (f BFloat16) Float32() float32 {
+	return math.Float32frombits(uint32(f) << 16)
+}
+
+// Float64 returns the float64 representation of f.
+// The conversion is exact.
+func (f BFloat16) Float64() float64 {
+	return float64(f.Float32())
+}
+
+// Add returns the sum of a and b.
+func (a BFloat16) Add(b BFloat16) BFloat16 {
+	// float64 has enough precision to avoid double rounding.
+	return BFloat16FromFloat64(a.Float64() + b.Float64())
+}
+
+// Sub returns the difference of a and b.
+func (a BFloat16) Sub(b BFloat16) BFloat16 {
+	return a.Add(b ^ signMaskBF16)
+}
+
+// Mul returns the product of a and b.
+func (a BFloat16) Mul(b BFloat16) BFloat16 {
+	// the product of two BFloat16 values is exact in float64.
+	return BFloat16FromFloat64(a.Float64() * b.Float64())
+}
+
+// Quo returns the quotient of a and b.
+func (a BFloat16) Quo(b BFloat16) BFloat16 {
+	// float64 has enough precision to avoid double rounding.
+	return BFloat16FromFloat64(a.Float64() / b.Float64())
+}
+
+// Sqrt returns the square root of x.
+//
+// Special cases are:
+//
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+func (x BFloat16) Sqrt() BFloat16 {
+	// float64 has enough precision to avoid double rounding.
+	return BFloat16FromFloat64(math.Sqrt(x.Float64()))
+}
+
+// BFloat16FMA returns x * y + z, computed with only one rounding.
+// (That is, BFloat16FMA returns the fused multiply-add of x, y, and z.)
+func BFloat16FMA(x, y, z BFloat16) BFloat16 {
+	// the product is exact in float64.
+	p := x.Float64() * y.Float64()
+	q := z.Float64()
+	s := p + q
+	if math.IsInf(s, 0) || math.IsNaN(s) {
+		return BFloat16FromFloat64(s)
+	}
+
+	// err is the rounding error of s, that is, p + q = s + err exactly.
+	t := s - p
+	err := (p - (s - t)) + (q - t)
+	if err != 0 {
+		// round s to odd, so that the second rounding is correct.
+		b := math.Float64bits(s)
+		if (err < 0) != (s < 0) {
+			// s has been rounded away from zero; truncate it.
+			b--
+		}
+		b |= 1
+		s = math.Float64frombits(b)
+	}
+	return BFloat16FromFloat64(s)
+}
+
+// Compare compares x and y and returns:
+//
+//	-1 if x <  y
+//	 0 if x == y (incl. -0 == 0, -Inf == -Inf, and +Inf == +Inf)
+//	+1 if x >  y
+//
+// a NaN is considered less than any non-NaN, and two NaNs are equal.
+func (a BFloat16) Compare(b BFloat16) int {
+	aNaN := a.IsNaN()
+	bNaN := b.IsNaN()
+	if aNaN && bNaN {
+		return 0
+	}
+	if aNaN {
+		return -1
+	}
+	if bNaN {
+		return 1
+	}
+
+	ia := a.comparable()
+	ib := b.comparable()
+	if ia < ib {
+		return -1
+	}
+	if ia > ib {
+		return 1
+	}
+	return 0
+}
+
+// comparable converts a to a comparable form.
+func (a BFloat16) comparable() int16 {
+	i := int16(a) ^ ((int16(a) >> 15) & 0x7fff)
+	return i + int16(a>>15) // normalize -0 to 0
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+func (a BFloat16) Eq(b BFloat16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+
+	// a == b if a and b have the same bit pattern,
+	// or if they are both ±0.
+	return a == b || (a|b)&^signMaskBF16 == 0
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+func (a BFloat16) Ne(b BFloat16) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a BFloat16) Lt(b BFloat16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+
+	return a.comparable() < b.comparable()
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a BFloat16) Gt(b BFloat16) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a BFloat16) Le(b BFloat16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+
+	return a.comparable() <= b.comparable()
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a BFloat16) Ge(b BFloat16) bool {
+	return b.Le(a)
+}
+
+// ParseBFloat16 converts the string s to a BFloat16, rounding to nearest even.
+// It accepts the same syntax as [Parse], and reports overflows and underflows in the same way.
+func ParseBFloat16(s string) (BFloat16, error) {
+	var c Context
+	b, err := parseBits(s, &bfloat16info, "float16.ParseBFloat16", &c)
+	return BFloat16(b), err
+}
+
+func (x BFloat16) String() string {
+	return x.Text('g', -1)
+}
+
+// Text converts x to a string, in the same way as [strconv.FormatFloat].
+func (x BFloat16) Text(fmt byte, prec int) string {
+	return string(x.Append(make([]byte, 0, 8), fmt, prec))
+}
+
+// Append appends the string form of x, as generated by x.Text, to buf
+// and returns the extended buffer.
+func (x BFloat16) Append(buf []byte, fmt byte, prec int) []byte {
+	return genericFtoa(buf, uint64(x), fmt, prec, &bfloat16info)
+}
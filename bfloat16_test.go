@@ -0,0 +1,382 @@
+package float16
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBFloat16_IsInf(t *testing.T) {
+	tests := []struct {
+		f    BFloat16
+		sign int
+		inf  bool
+	}{
+		{BFloat16Inf(1), 1, true},
+		{BFloat16Inf(-1), 1, false},
+		{BFloat16Inf(1), -1, false},
+		{BFloat16Inf(-1), -1, true},
+		{BFloat16Inf(1), 0, true},
+		{BFloat16Inf(-1), 0, true},
+		{BFloat16NaN(), 0, false},
+	}
+	for _, tt := range tests {
+		if tt.f.IsInf(tt.sign) != tt.inf {
+			t.Errorf("%x: expected %v", tt.f, tt.sign)
+		}
+	}
+	if !BFloat16NaN().IsNaN() {
+		t.Errorf("expected NaN")
+	}
+}
+
+func TestBFloat16FromFloat32(t *testing.T) {
+	tests := []struct {
+		f float32
+		r BFloat16
+	}{
+		{0, 0x0000},
+		{0x1p-133, 0x0001},    // smallest positive subnormal number
+		{0x1.fcp-127, 0x007f}, // largest positive subnormal number
+		{0x1p-126, 0x0080},    // smallest positive normal number
+		{0x1.56p-02, 0x3eab},  // nearest value to 1/3
+		{0x1p+00, 0x3f80},     // one
+		{0x1.02p+00, 0x3f81},  // smallest number larger than one
+		{0x1.fep+127, 0x7f7f}, // largest normal number
+		{float32(negZero), 0x8000},
+		{-2, 0xc000},
+
+		// rounds to nearest even
+		{0x1.01p+00, 0x3f80},
+		{math.Nextafter32(0x1.01p+00, 2), 0x3f81},
+		{0x1.03p+00, 0x3f82},
+		{0x1.ffp+127, 0x7f80},
+		{math.Nextafter32(0x1.ffp+127, 0), 0x7f7f},
+
+		// infinities
+		{float32(math.Inf(1)), 0x7f80},
+		{float32(math.Inf(-1)), 0xff80},
+
+		// NaN
+		{float32(math.NaN()), 0x7fc0},
+		{math.Float32frombits(0x7f800001), 0x7fc0}, // signaling NaN
+		{math.Float32frombits(0xff810000), 0xffc1},
+	}
+	for _, tt := range tests {
+		got := BFloat16FromFloat32(tt.f)
+		if got != tt.r {
+			t.Errorf("%x: expected %04x, got %04x", tt.f, tt.r, got)
+		}
+	}
+}
+
+func TestBFloat16FromFloat32_TestFloat(t *testing.T) {
+	testFloat(t, "f32_to_bf16", func(x []uint64) uint64 {
+		return uint64(BFloat16FromFloat32(math.Float32frombits(uint32(x[0]))))
+	})
+}
+
+func TestBFloat16FromFloat32_All(t *testing.T) {
+	for bits := 0; bits < 1<<16; bits++ {
+		f := BFloat16FromBits(uint16(bits))
+		if f.IsNaN() {
+			continue
+		}
+		if got := BFloat16FromFloat32(f.Float32()); got != f {
+			t.Errorf("%x: expected %x, got %x", bits, f, got)
+		}
+		if got := BFloat16FromFloat64(f.Float64()); got != f {
+			t.Errorf("%x: expected %x, got %x", bits, f, got)
+		}
+	}
+}
+
+func TestBFloat16FromFloat64_TestFloat(t *testing.T) {
+	testFloat(t, "f64_to_bf16", func(x []uint64) uint64 {
+		return uint64(BFloat16FromFloat64(math.Float64frombits(x[0])))
+	})
+}
+
+func TestBFloat16FromFloat64_NaN(t *testing.T) {
+	tests := []struct {
+		f64  uint64
+		bf16 BFloat16
+	}{
+		{0x7ff8_0000_0000_0000, 0x7fc0},
+		{0xfff8_0000_0000_0000, 0xffc0},
+		{0x7ff0_0000_0000_0001, 0x7fc0}, // signaling NaN, whose payload is lost
+		{0x7ff4_0000_0000_0000, 0x7fe0}, // signaling NaN
+		{0xfffc_1000_0000_0000, 0xffe0},
+		{0x7ff8_2000_0000_0000, 0x7fc1},
+		{0xffff_ffff_ffff_ffff, 0xffff},
+	}
+	for _, tt := range tests {
+		got := BFloat16FromFloat64(math.Float64frombits(tt.f64))
+		if got != tt.bf16 {
+			t.Errorf("%016x: expected %04x, got %04x", tt.f64, tt.bf16.Bits(), got.Bits())
+		}
+	}
+
+	// the same as converting through float32, which keeps the payload in the same way.
+	for _, b := range []uint32{0x7fc00000, 0xffc00001, 0x7f810000, 0xffa5a5a5, 0x7fffffff} {
+		f := math.Float32frombits(b)
+		if got, want := BFloat16FromFloat64(float64(f)), BFloat16FromFloat32(f); got != want {
+			t.Errorf("%08x: expected %04x, got %04x", b, want.Bits(), got.Bits())
+		}
+	}
+}
+
+func TestBFloat16_Add_TestFloat(t *testing.T) {
+	testFloat(t, "bf16_add", func(x []uint64) uint64 {
+		return uint64(BFloat16(x[0]).Add(BFloat16(x[1])))
+	})
+}
+
+func TestBFloat16_Sub_TestFloat(t *testing.T) {
+	testFloat(t, "bf16_sub", func(x []uint64) uint64 {
+		return uint64(BFloat16(x[0]).Sub(BFloat16(x[1])))
+	})
+}
+
+func TestBFloat16_Mul_TestFloat(t *testing.T) {
+	testFloat(t, "bf16_mul", func(x []uint64) uint64 {
+		return uint64(BFloat16(x[0]).Mul(BFloat16(x[1])))
+	})
+}
+
+func TestBFloat16_Quo_TestFloat(t *testing.T) {
+	testFloat(t, "bf16_div", func(x []uint64) uint64 {
+		return uint64(BFloat16(x[0]).Quo(BFloat16(x[1])))
+	})
+}
+
+func TestBFloat16_Sqrt_TestFloat(t *testing.T) {
+	testFloat(t, "bf16_sqrt", func(x []uint64) uint64 {
+		return uint64(BFloat16(x[0]).Sqrt())
+	})
+}
+
+func TestBFloat16FMA_TestFloat(t *testing.T) {
+	testFloat(t, "bf16_mulAdd", func(x []uint64) uint64 {
+		return uint64(BFloat16FMA(BFloat16(x[0]), BFloat16(x[1]), BFloat16(x[2])))
+	})
+}
+
+func TestBFloat16_Compare(t *testing.T) {
+	r := newXorshift32()
+	for i := 0; i < 1<<16; i++ {
+		u := r.Uint32()
+		a, b := BFloat16(u), BFloat16(u>>16)
+		if i < len(specials16) {
+			a, b = specials16[i/4], specials16[i%4]
+		}
+		fa, fb := a.Float32(), b.Float32()
+
+		if got, want := a.Eq(b), fa == fb; got != want {
+			t.Errorf("%04x == %04x: expected %t, got %t", a, b, want, got)
+		}
+		if got, want := a.Ne(b), fa != fb; got != want {
+			t.Errorf("%04x != %04x: expected %t, got %t", a, b, want, got)
+		}
+		if got, want := a.Lt(b), fa < fb; got != want {
+			t.Errorf("%04x < %04x: expected %t, got %t", a, b, want, got)
+		}
+		if got, want := a.Le(b), fa <= fb; got != want {
+			t.Errorf("%04x <= %04x: expected %t, got %t", a, b, want, got)
+		}
+		if got, want := a.Gt(b), fa > fb; got != want {
+			t.Errorf("%04x > %04x: expected %t, got %t", a, b, want, got)
+		}
+		if got, want := a.Ge(b), fa >= fb; got != want {
+			t.Errorf("%04x >= %04x: expected %t, got %t", a, b, want, got)
+		}
+
+		want := 0
+		switch {
+		case a.IsNaN() && b.IsNaN():
+		case a.IsNaN() || fa < fb:
+			want = -1
+		case b.IsNaN() || fa > fb:
+			want = 1
+		}
+		if got := a.Compare(b); got != want {
+			t.Errorf("compare(%04x, %04x): expected %d, got %d", a, b, want, got)
+		}
+	}
+}
+
+// specials16 is a set of interesting BFloat16 values for comparisons.
+var specials16 = []BFloat16{0x0000, 0x8000, 0x7fc0, 0xff80}
+
+func TestParseBFloat16(t *testing.T) {
+	tests := []struct {
+		s    string
+		want BFloat16
+	}{
+		{"0", 0x0000},
+		{"-0", 0x8000},
+		{"1", 0x3f80},
+		{"+1", 0x3f80},
+		{"-2", 0xc000},
+		{"0.333333", 0x3eab},
+		{"1.00390625", 0x3f80}, // halfway between 1 and 1.0078125; rounds to even
+		{"1.00390626", 0x3f81},
+		{"3.3895313892515355e38", 0x7f7f}, // largest finite number
+		{"9.183549615799121e-41", 0x0001}, // smallest subnormal number
+		{"4.6e-41", 0x0001},
+		{"0x1p-133", 0x0001},
+		{"0x1.fep127", 0x7f7f},
+		{"1_000", 0x447a},
+		{"inf", 0x7f80},
+		{"-Infinity", 0xff80},
+		{"nan", 0x7fc0},
+	}
+	for _, tt := range tests {
+		got, err := ParseBFloat16(tt.s)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.s, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %04x, got %04x", tt.s, tt.want, got)
+		}
+	}
+}
+
+func TestParseBFloat16_Error(t *testing.T) {
+	tests := []struct {
+		s    string
+		want BFloat16
+		err  error
+	}{
+		{"1e39", 0x7f80, strconv.ErrRange},
+		{"-3.4e38", 0xff80, strconv.ErrRange},
+		{"0x1p128", 0x7f80, strconv.ErrRange},
+		{"4.5e-41", 0x0000, strconv.ErrRange},
+		{"-0x1p-135", 0x8000, strconv.ErrRange},
+		{"", 0, strconv.ErrSyntax},
+		{"1x", 0, strconv.ErrSyntax},
+		{"0x1", 0, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		got, err := ParseBFloat16(tt.s)
+		if got != tt.want {
+			t.Errorf("%q: expected %04x, got %04x", tt.s, tt.want, got)
+		}
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) || numErr.Func != "float16.ParseBFloat16" || numErr.Err != tt.err {
+			t.Errorf("%q: unexpected error: %v", tt.s, err)
+		}
+	}
+}
+
+func TestBFloat16_Text(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		fmt  byte
+		prec int
+		want string
+	}{
+		{0x0000, 'g', -1, "0"},
+		{0x8000, 'g', -1, "-0"},
+		{0x3f80, 'g', -1, "1"},
+		{0x3eab, 'g', -1, "0.334"},
+		{0x7f7f, 'g', -1, "3.39e+38"},
+		{0x0001, 'g', -1, "1e-40"},
+		{0x447a, 'g', -1, "1000"},
+		{0x7f80, 'g', -1, "+Inf"},
+		{0xff80, 'g', -1, "-Inf"},
+		{0x7fc0, 'g', -1, "NaN"},
+		{0x3eab, 'e', -1, "3.34e-01"},
+		{0x3eab, 'e', 5, "3.33984e-01"},
+		{0x3eab, 'f', -1, "0.334"},
+		{0x3eab, 'f', 8, "0.33398438"},
+		{0x3eab, 'b', -1, "171p-9"},
+		{0x0000, 'b', -1, "0p-133"},
+		{0x3eab, 'x', -1, "0x1.56p-02"},
+		{0x3eab, 'X', 1, "0X1.5P-02"},
+		{0x0001, 'x', -1, "0x1p-133"},
+		{0x3f80, 'z', -1, "%z"},
+	}
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.want {
+			t.Errorf("%04x.Text(%q, %d): expected %q, got %q", tt.x, tt.fmt, tt.prec, tt.want, got)
+		}
+	}
+}
+
+func TestBFloat16_Text_All(t *testing.T) {
+	step := 1
+	if testing.Short() {
+		step = 97
+	}
+	for bits := 0; bits < 1<<16; bits += step {
+		x := BFloat16(bits)
+		if x.IsNaN() {
+			continue
+		}
+		f := x.Float64()
+
+		// the shortest representations must round trip.
+		for _, fmt := range []byte{'e', 'f', 'g', 'x'} {
+			s := x.Text(fmt, -1)
+			got, err := ParseBFloat16(s)
+			if err != nil || got != x {
+				t.Errorf("%04x: %q round trips to %04x, %v", bits, s, got, err)
+			}
+		}
+
+		// and they must be the shortest.
+		if x&^signMaskBF16 != 0 && !x.IsInf(0) {
+			s := x.Text('e', -1)
+			mant := s[:strings.IndexByte(s, 'e')]
+			digits := len(strings.NewReplacer("-", "", ".", "").Replace(mant))
+			for prec := 0; prec < digits-1; prec++ {
+				y, _ := ParseBFloat16(strconv.FormatFloat(f, 'e', prec, 64))
+				if y == x {
+					t.Errorf("%04x: %q is not the shortest", bits, s)
+					break
+				}
+			}
+		}
+
+		// the conversions with precision are exact as well as float64.
+		for _, fmt := range []byte{'e', 'f', 'g'} {
+			for _, prec := range []int{0, 1, 3, 10} {
+				got := x.Text(fmt, prec)
+				want := strconv.FormatFloat(f, fmt, prec, 64)
+				if got != want {
+					t.Errorf("%04x.Text(%q, %d): expected %q, got %q", bits, fmt, prec, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestBFloat16_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      BFloat16
+		want   string
+	}{
+		{"%v", 0x3f80, "1"},
+		{"%v", 0xbeab, "-0.334"},
+		{"%+v", 0x3eab, "0.334"}, // as float32, %+v has no effect on numbers
+		{"%+g", 0x3eab, "+0.334"},
+		{"%.3f", 0x3eab, "0.334"},
+		{"%8.2e", 0x3eab, "3.34e-01"},
+		{"%-8g|", 0x4120, "10      |"},
+		{"%x", 0x3f00, "0x1p-01"},
+		{"%v", 0x7fc0, "NaN"},
+	}
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("%q: expected %q, got %q", tt.format, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package float16
+
+import (
+	"math/big"
+)
+
+// maxBigExp is the limit of the exponents handled by the conversions from math/big.
+// The values whose magnitude is out of [2^-maxBigExp, 2^maxBigExp] overflow or underflow
+// in any rounding mode, so the exponents are clamped to the range
+// to avoid overflowing the exponents of round.
+const maxBigExp = 128
+
+// FromBigFloat returns the Float16 nearest to x, rounding ties to even,
+// and the accuracy of the result.
+// If x is too small to be represented by a Float16, the result is (±0, Below) or (±0, Above), depending on the sign of x.
+// If x is too large to be represented by a Float16, the result is (±Inf, Above) or (±Inf, Below), depending on the sign of x.
+func FromBigFloat(x *big.Float) (Float16, big.Accuracy) {
+	var c Context
+	f := c.FromBigFloat(x)
+	return f, accuracy(f, x)
+}
+
+// FromBigRat returns the Float16 nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func FromBigRat(x *big.Rat) (Float16, bool) {
+	var c Context
+	f := c.FromBigRat(x)
+	return f, c.Flags&Inexact == 0
+}
+
+// FromBigInt returns the Float16 nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func FromBigInt(x *big.Int) (Float16, big.Accuracy) {
+	var c Context
+	f := c.FromBigInt(x)
+	return f, accuracy(f, new(big.Float).SetInt(x))
+}
+
+// accuracy returns the accuracy of f as an approximation of x.
+func accuracy(f Float16, x *big.Float) big.Accuracy {
+	switch f.BigFloat().Cmp(x) {
+	case -1:
+		return big.Below
+	case +1:
+		return big.Above
+	}
+	return big.Exact
+}
+
+// FromBigFloat returns the Float16 nearest to x in the direction of c.Mode.
+func (c *Context) FromBigFloat(x *big.Float) Float16 {
+	var sign uint16
+	if x.Signbit() {
+		sign = signMask16
+	}
+	if x.IsInf() {
+		return Float16(sign | uvinf)
+	}
+	if x.Sign() == 0 {
+		return Float16(sign)
+	}
+
+	// |x| = 0.mant × 2^exp, 0.5 <= 0.mant < 1
+	mant := new(big.Float)
+	exp := x.MantExp(mant)
+	mant.Abs(mant)
+	exp = max(min(exp, maxBigExp), -maxBigExp)
+
+	// take the leading 64 bits of the mantissa.
+	m, acc := mant.SetMantExp(mant, 64).Uint64()
+	return c.round(sign, exp-64, m, acc != big.Exact)
+}
+
+// FromBigRat returns the Float16 nearest to x in the direction of c.Mode.
+func (c *Context) FromBigRat(x *big.Rat) Float16 {
+	var sign uint16
+	if x.Sign() < 0 {
+		sign = signMask16
+	}
+	if x.Sign() == 0 {
+		return 0
+	}
+
+	a := new(big.Int).Abs(x.Num())
+	b := x.Denom()
+
+	// q = floor(a × 2^s / b), 2^62 <= q < 2^64
+	d := a.BitLen() - b.BitLen()
+	if d > maxBigExp {
+		return c.overflow(sign)
+	}
+	if d < -maxBigExp {
+		// x is non-zero, but much less than the smallest subnormal.
+		return c.round(sign, -2*maxBigExp, 1, false)
+	}
+	s := 63 - d
+	if s >= 0 {
+		a.Lsh(a, uint(s))
+	} else {
+		b = new(big.Int).Lsh(b, uint(-s))
+	}
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	return c.round(sign, -s, q.Uint64(), r.Sign() != 0)
+}
+
+// FromBigInt returns the Float16 nearest to x in the direction of c.Mode.
+func (c *Context) FromBigInt(x *big.Int) Float16 {
+	var sign uint16
+	if x.Sign() < 0 {
+		sign = signMask16
+	}
+
+	a := new(big.Int).Abs(x)
+	n := a.BitLen() - 64
+	if n <= 0 {
+		return c.round(sign, 0, a.Uint64(), false)
+	}
+	if n > maxBigExp {
+		return c.overflow(sign)
+	}
+
+	// take the leading 64 bits.
+	sticky := a.TrailingZeroBits() < uint(n)
+	return c.round(sign, n, a.Rsh(a, uint(n)).Uint64(), sticky)
+}
+
+// BigFloat returns the value of x as a *big.Float with the precision of 53 bits,
+// the same as [big.NewFloat]. The conversion is exact.
+// If x is NaN, BigFloat panics with an [big.ErrNaN].
+func (x Float16) BigFloat() *big.Float {
+	return big.NewFloat(x.Float64())
+}
+
+// BigRat returns the value of x as a *big.Rat. The conversion is exact.
+// If x is not finite, the result is nil.
+func (x Float16) BigRat() *big.Rat {
+	if x.IsNaN() || x.IsInf(0) {
+		return nil
+	}
+	return new(big.Rat).SetFloat64(x.Float64())
+}
+
+// BigInt returns the result of truncating x towards zero as a *big.Int,
+// and the accuracy of the result, in the same manner as [big.Float.Int].
+// If x is an infinity, the result is nil and the accuracy is Below for +Inf, and Above for -Inf.
+// If x is NaN, BigInt panics with an [big.ErrNaN].
+func (x Float16) BigInt() (*big.Int, big.Accuracy) {
+	return x.BigFloat().Int(nil)
+}
@@ -0,0 +1,231 @@
+package float16
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestBigFloat_RoundTrip(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		if x.IsNaN() {
+			continue
+		}
+		got, acc := FromBigFloat(x.BigFloat())
+		if got != x || acc != big.Exact {
+			t.Errorf("%04x: expected %04x (Exact), got %04x (%v)", i, i, got, acc)
+		}
+	}
+}
+
+func TestBigRat_RoundTrip(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		r := x.BigRat()
+		if x.IsNaN() || x.IsInf(0) {
+			if r != nil {
+				t.Errorf("%04x: expected nil, got %v", i, r)
+			}
+			continue
+		}
+		got, exact := FromBigRat(r)
+		if x == signMask16 {
+			// big.Rat has no negative zero.
+			x = 0
+		}
+		if got != x || !exact {
+			t.Errorf("%04x: expected %04x (exact), got %04x (%t)", i, x, got, exact)
+		}
+	}
+}
+
+func TestBigInt_RoundTrip(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		if x.IsNaN() {
+			continue
+		}
+		n, acc := x.BigInt()
+		if x.IsInf(0) {
+			want := big.Below
+			if x.Signbit() {
+				want = big.Above
+			}
+			if n != nil || acc != want {
+				t.Errorf("%04x: expected nil (%v), got %v (%v)", i, want, n, acc)
+			}
+			continue
+		}
+
+		want := math.Trunc(x.Float64())
+		if f, _ := n.Float64(); f != want {
+			t.Errorf("%04x: expected %v, got %v", i, want, n)
+		}
+		if (acc == big.Exact) != (want == x.Float64()) {
+			t.Errorf("%04x: unexpected accuracy %v", i, acc)
+		}
+		if acc != big.Exact {
+			continue
+		}
+		got, acc := FromBigInt(n)
+		if x == signMask16 {
+			// big.Int has no negative zero.
+			x = 0
+		}
+		if got != x || acc != big.Exact {
+			t.Errorf("%04x: expected %04x (Exact), got %04x (%v)", i, x, got, acc)
+		}
+	}
+}
+
+func TestBigFloat_NaN(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.As(err, new(big.ErrNaN)) {
+			t.Errorf("expected big.ErrNaN, got %v", err)
+		}
+	}()
+	NaN().BigFloat()
+}
+
+// randBigFloat returns a random big.Float with a random precision
+// whose exponent is often out of the range of Float16.
+func randBigFloat(r *rand.Rand) *big.Float {
+	prec := uint(r.Intn(100) + 1)
+	mant := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), prec))
+	x := new(big.Float).SetPrec(prec).SetInt(mant)
+	var exp int
+	switch r.Intn(4) {
+	case 0:
+		exp = r.Intn(1<<20) - 1<<19
+	case 1:
+		exp = r.Intn(16) - 8
+	default:
+		exp = r.Intn(100) - 60
+	}
+	exp -= int(prec)
+	x.SetMantExp(x, exp)
+	if r.Intn(2) == 0 {
+		x.Neg(x)
+	}
+	return x
+}
+
+func TestContext_FromBigFloat(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < contextSamples(); i++ {
+		x := randBigFloat(r)
+		for _, mode := range roundingModes {
+			c := Context{Mode: mode}
+			got := c.FromBigFloat(x)
+			want := roundBig(x, mode)
+			if got != want {
+				t.Errorf("%v, %v: expected %04x, got %04x", mode, x, want, got)
+			}
+			if (c.Flags&Inexact != 0) != (got.BigFloat().Cmp(x) != 0) {
+				t.Errorf("%v, %v: unexpected flags %v", mode, x, c.Flags)
+			}
+		}
+	}
+
+	tests := []struct {
+		x    *big.Float
+		want Float16
+		acc  big.Accuracy
+	}{
+		{new(big.Float).SetInf(false), uvinf, big.Exact},
+		{new(big.Float).SetInf(true), uvneginf, big.Exact},
+		{new(big.Float).Neg(new(big.Float)), signMask16, big.Exact},
+		{new(big.Float).SetMantExp(big.NewFloat(1), math.MaxInt32-1), uvinf, big.Above},
+		{new(big.Float).SetMantExp(big.NewFloat(-1), math.MinInt32), signMask16, big.Above},
+		{big.NewFloat(65519.99), uvmax, big.Below},
+		{big.NewFloat(65520), uvinf, big.Above},
+		{big.NewFloat(0x1p-25), 0, big.Below},
+		{big.NewFloat(0x1.0001p-25), 1, big.Above},
+	}
+	for _, tt := range tests {
+		got, acc := FromBigFloat(tt.x)
+		if got != tt.want || acc != tt.acc {
+			t.Errorf("%v: expected %04x (%v), got %04x (%v)", tt.x, tt.want, tt.acc, got, acc)
+		}
+	}
+}
+
+func TestContext_FromBigRat(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < contextSamples(); i++ {
+		a := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(100)+1)))
+		b := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(100)+1)))
+		b.Add(b, big.NewInt(1))
+		if r.Intn(2) == 0 {
+			a.Neg(a)
+		}
+		x := new(big.Rat).SetFrac(a, b)
+
+		// 2000 bits are enough to round correctly, because the distance between x and
+		// any halfway point is much larger than 2^-2000 × |x|.
+		f := new(big.Float).SetPrec(2000).SetRat(x)
+		for _, mode := range roundingModes {
+			c := Context{Mode: mode}
+			got := c.FromBigRat(x)
+			if want := roundBig(f, mode); got != want {
+				t.Errorf("%v, %v: expected %04x, got %04x", mode, x, want, got)
+			}
+			if got.IsInf(0) {
+				continue
+			}
+			if exact := x.Cmp(got.BigRat()) == 0; (c.Flags&Inexact == 0) != exact {
+				t.Errorf("%v, %v: unexpected flags %v", mode, x, c.Flags)
+			}
+		}
+	}
+
+	tests := []struct {
+		x     *big.Rat
+		want  Float16
+		exact bool
+	}{
+		{big.NewRat(0, 1), 0, true},
+		{big.NewRat(1, 3), 0x3555, false},
+		{big.NewRat(-65504, 1), 0xfbff, true},
+		{big.NewRat(65520, 1), uvinf, false},
+		{new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 1000)), 0, false},
+		{new(big.Rat).SetFrac(new(big.Int).Lsh(big.NewInt(-1), 1000), big.NewInt(3)), uvneginf, false},
+	}
+	for _, tt := range tests {
+		got, exact := FromBigRat(tt.x)
+		if got != tt.want || exact != tt.exact {
+			t.Errorf("%v: expected %04x (%t), got %04x (%t)", tt.x, tt.want, tt.exact, got, exact)
+		}
+	}
+}
+
+func TestContext_FromBigInt(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < contextSamples(); i++ {
+		x := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(80))))
+		if r.Intn(2) == 0 {
+			x.Neg(x)
+		}
+		f := new(big.Float).SetInt(x)
+		for _, mode := range roundingModes {
+			c := Context{Mode: mode}
+			got := c.FromBigInt(x)
+			if want := roundBig(f, mode); got != want {
+				t.Errorf("%v, %v: expected %04x, got %04x", mode, x, want, got)
+			}
+		}
+	}
+
+	huge := new(big.Int).Lsh(big.NewInt(1), 10000)
+	if got, acc := FromBigInt(huge); got != uvinf || acc != big.Above {
+		t.Errorf("2^10000: expected %04x (Above), got %04x (%v)", uvinf, got, acc)
+	}
+	huge.Neg(huge)
+	if got, acc := FromBigInt(huge); got != uvneginf || acc != big.Below {
+		t.Errorf("-2^10000: expected %04x (Below), got %04x (%v)", uvneginf, got, acc)
+	}
+}
@@ -0,0 +1,283 @@
+package float16
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// This file implements the elementary functions with math/big,
+// as the references of the tests of the elementary functions.
+// They are far more precise than Float16, and their results don't depend on the math package.
+
+const bigPrec = 320
+
+var (
+	bigLn2  = bigMul(big.NewFloat(2), bigAtanhSeries(bigQuo(big.NewFloat(1), big.NewFloat(3))))
+	bigLn10 = bigLog(big.NewFloat(10))
+	bigPi   = bigComputePi()
+)
+
+func newBigFloat() *big.Float {
+	return new(big.Float).SetPrec(bigPrec)
+}
+
+func bigAdd(x, y *big.Float) *big.Float { return newBigFloat().Add(x, y) }
+func bigSub(x, y *big.Float) *big.Float { return newBigFloat().Sub(x, y) }
+func bigMul(x, y *big.Float) *big.Float { return newBigFloat().Mul(x, y) }
+func bigQuo(x, y *big.Float) *big.Float { return newBigFloat().Quo(x, y) }
+func bigSqrt(x *big.Float) *big.Float   { return newBigFloat().Sqrt(x) }
+
+func bigInt(i int64) *big.Float {
+	return newBigFloat().SetInt64(i)
+}
+
+// bigSeries returns the sum of the series term(n) for n = 0, 1, 2, ... until the terms become negligible.
+func bigSeries(term func(n int64) *big.Float) *big.Float {
+	sum := newBigFloat()
+	for n := int64(0); ; n++ {
+		t := term(n)
+		if t.Sign() == 0 || sum.Sign() != 0 && t.MantExp(nil) < sum.MantExp(nil)-bigPrec-8 {
+			return sum
+		}
+		sum.Add(sum, t)
+	}
+}
+
+// bigAtanhSeries returns atanh(t) for small |t|.
+func bigAtanhSeries(t *big.Float) *big.Float {
+	t2 := bigMul(t, t)
+	pow := newBigFloat().Set(t)
+	return bigSeries(func(n int64) *big.Float {
+		term := bigQuo(pow, bigInt(2*n+1))
+		pow.Mul(pow, t2)
+		return term
+	})
+}
+
+// bigAtanSeries returns atan(t) for small |t|.
+func bigAtanSeries(t *big.Float) *big.Float {
+	t2 := bigMul(t, t)
+	pow := newBigFloat().Set(t)
+	return bigSeries(func(n int64) *big.Float {
+		term := bigQuo(pow, bigInt(2*n+1))
+		if n%2 == 1 {
+			term.Neg(term)
+		}
+		pow.Mul(pow, t2)
+		return term
+	})
+}
+
+func bigComputePi() *big.Float {
+	// Machin's formula: π = 16 atan(1/5) - 4 atan(1/239)
+	a := bigAtanSeries(bigQuo(bigInt(1), bigInt(5)))
+	b := bigAtanSeries(bigQuo(bigInt(1), bigInt(239)))
+	return bigSub(bigMul(bigInt(16), a), bigMul(bigInt(4), b))
+}
+
+// bigExp returns e**x.
+// It returns a huge or tiny value instead of overflowing or underflowing.
+func bigExp(x *big.Float) *big.Float {
+	switch {
+	case x.Cmp(big.NewFloat(64)) > 0:
+		return newBigFloat().SetMantExp(bigInt(1), 1000)
+	case x.Cmp(big.NewFloat(-64)) < 0:
+		return newBigFloat().SetMantExp(bigInt(1), -1000)
+	}
+
+	// e**x = (e**(x/2**16))**(2**16)
+	const k = 16
+	r := newBigFloat().SetMantExp(x, -k)
+	term := bigInt(1)
+	sum := bigSeries(func(n int64) *big.Float {
+		if n > 0 {
+			term.Mul(term, r)
+			term.Quo(term, bigInt(n))
+		}
+		return newBigFloat().Set(term)
+	})
+	for i := 0; i < k; i++ {
+		sum.Mul(sum, sum)
+	}
+	return sum
+}
+
+// bigLog returns the natural logarithm of x > 0.
+func bigLog(x *big.Float) *big.Float {
+	// x = m × 2**e, 1 <= m < 2
+	m := newBigFloat()
+	e := x.MantExp(m)
+	m.SetMantExp(m, 1)
+	e--
+
+	// log(m) = 2 atanh((m - 1) / (m + 1))
+	t := bigQuo(bigSub(m, bigInt(1)), bigAdd(m, bigInt(1)))
+	l := bigMul(bigInt(2), bigAtanhSeries(t))
+	return bigAdd(l, bigMul(bigInt(int64(e)), bigLn2))
+}
+
+// bigSinCos returns sin(x) and cos(x).
+func bigSinCos(x *big.Float) (sin, cos *big.Float) {
+	// x = k × π/2 + r, |r| <= π/4
+	halfPi := newBigFloat().SetMantExp(bigPi, -1)
+	kf := bigQuo(x, halfPi)
+	kf.Add(kf, big.NewFloat(0.5))
+	ki, _ := kf.Int(nil)
+	if kf.Sign() < 0 && !kf.IsInt() {
+		ki.Sub(ki, big.NewInt(1)) // floor
+	}
+	k := newBigFloat().SetInt(ki)
+	r := bigSub(x, bigMul(k, halfPi))
+
+	r2 := bigMul(r, r)
+	term := newBigFloat().Set(r)
+	s := bigSeries(func(n int64) *big.Float {
+		ret := newBigFloat().Set(term)
+		term.Mul(term, r2)
+		term.Quo(term, bigInt(-(2*n+2)*(2*n+3)))
+		return ret
+	})
+	term = bigInt(1)
+	c := bigSeries(func(n int64) *big.Float {
+		ret := newBigFloat().Set(term)
+		term.Mul(term, r2)
+		term.Quo(term, bigInt(-(2*n+1)*(2*n+2)))
+		return ret
+	})
+
+	switch new(big.Int).And(ki, big.NewInt(3)).Int64() {
+	case 0:
+		return s, c
+	case 1:
+		return c, s.Neg(s)
+	case 2:
+		return s.Neg(s), c.Neg(c)
+	default:
+		return c.Neg(c), s
+	}
+}
+
+// bigAtan returns atan(x).
+func bigAtan(x *big.Float) *big.Float {
+	if x.IsInf() {
+		r := newBigFloat().SetMantExp(bigPi, -1)
+		if x.Signbit() {
+			r.Neg(r)
+		}
+		return r
+	}
+	ax := newBigFloat().Abs(x)
+	if ax.Cmp(bigInt(1)) > 0 {
+		// atan(x) = ±π/2 - atan(1/x)
+		r := bigSub(newBigFloat().SetMantExp(bigPi, -1), bigAtan(bigQuo(bigInt(1), ax)))
+		if x.Signbit() {
+			r.Neg(r)
+		}
+		return r
+	}
+
+	// atan(x) = 2 atan(x / (1 + sqrt(1 + x**2)))
+	const k = 3
+	t := newBigFloat().Set(x)
+	for i := 0; i < k; i++ {
+		t = bigQuo(t, bigAdd(bigInt(1), bigSqrt(bigAdd(bigInt(1), bigMul(t, t)))))
+	}
+	r := bigAtanSeries(t)
+	return r.SetMantExp(r, k)
+}
+
+// bigCbrt returns the cube root of x.
+func bigCbrt(x *big.Float) *big.Float {
+	if x.Sign() == 0 {
+		return newBigFloat()
+	}
+	f, _ := x.Float64()
+	y := newBigFloat().SetFloat64(math.Cbrt(f))
+
+	// Newton's method: y = y - (y**3 - x) / (3 y**2)
+	for i := 0; i < 8; i++ {
+		y2 := bigMul(y, y)
+		d := bigQuo(bigSub(bigMul(y2, y), x), bigMul(bigInt(3), y2))
+		y.Sub(y, d)
+	}
+	return y
+}
+
+// testExhaustive checks that f returns the correctly rounded results of ref
+// for all finite non-zero Float16 values.
+// ref returns nil if the result is NaN.
+// In the short mode, it checks only a part of values.
+func testExhaustive(t *testing.T, f func(x Float16) Float16, ref func(x *big.Float) *big.Float) {
+	t.Helper()
+	step := 1
+	if testing.Short() {
+		step = 61
+	}
+	for i := 1; i < 0x10000; i += step {
+		x := Float16(i)
+		if x.IsNaN() || x.IsInf(0) || x&^signMask16 == 0 {
+			continue
+		}
+		got := f(x)
+		r := ref(x.big(ToNearestEven))
+		if r == nil {
+			if !got.IsNaN() {
+				t.Errorf("%v (%04x): expected NaN, got %v (%04x)", x, uint16(x), got, uint16(got))
+			}
+			continue
+		}
+		if want := roundBig(r, ToNearestEven); got != want {
+			t.Errorf("%v (%04x): expected %v (%04x), got %v (%04x)", x, uint16(x), want, uint16(want), got, uint16(got))
+		}
+	}
+}
+
+type specialCase struct {
+	x, want float64
+}
+
+// testSpecialCases checks that f returns the results in the tests.
+func testSpecialCases(t *testing.T, f func(x Float16) Float16, tests []specialCase) {
+	t.Helper()
+	for _, tt := range tests {
+		got := f(FromFloat64(tt.x))
+		want := FromFloat64(tt.want)
+		if got != want && !(got.IsNaN() && want.IsNaN()) {
+			t.Errorf("%v: expected %v (%04x), got %v (%04x)", tt.x, want, uint16(want), got, uint16(got))
+		}
+	}
+}
+
+func TestBigMath(t *testing.T) {
+	// check the references by the math package.
+	type bigCase struct {
+		name string
+		got  *big.Float
+		want float64
+	}
+	tests := []bigCase{
+		{"ln2", bigLn2, math.Ln2},
+		{"ln10", bigLn10, math.Ln10},
+		{"pi", bigPi, math.Pi},
+		{"exp(1)", bigExp(bigInt(1)), math.E},
+		{"exp(-10)", bigExp(bigInt(-10)), math.Exp(-10)},
+		{"log(3)", bigLog(bigInt(3)), math.Log(3)},
+		{"atan(3)", bigAtan(bigInt(3)), math.Atan(3)},
+		{"atan(-0.5)", bigAtan(big.NewFloat(-0.5)), math.Atan(-0.5)},
+		{"cbrt(10)", bigCbrt(bigInt(10)), math.Cbrt(10)},
+	}
+	for i := int64(-20); i <= 20; i++ {
+		sin, cos := bigSinCos(bigInt(i))
+		tests = append(tests,
+			bigCase{"sin", sin, math.Sin(float64(i))},
+			bigCase{"cos", cos, math.Cos(float64(i))},
+		)
+	}
+	for _, tt := range tests {
+		got, _ := tt.got.Float64()
+		if math.Abs(got-tt.want) > 1e-15*math.Max(1, math.Abs(tt.want)) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package float16
+
+import "math"
+
+// The bulk conversions use the floating-point hardware to handle subnormal numbers
+// instead of normalizing them bit by bit.
+// See "half to float" and "float to half" by Fabian Giesen:
+// https://gist.github.com/rygorous/2156668
+//
+// They give bit-identical results to the scalar conversions.
+
+// Float32sToFloat16s converts the elements of src to Float16, rounding to nearest even,
+// and stores them into dst.
+// It converts min(len(dst), len(src)) elements, and returns the number of them.
+// The results are the same as [FromFloat32].
+func Float32sToFloat16s(dst []Float16, src []float32) int {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	for i, f := range src {
+		dst[i] = fromFloat32Fast(f)
+	}
+	return n
+}
+
+// Float16sToFloat32s converts the elements of src to float32, and stores them into dst.
+// It converts min(len(dst), len(src)) elements, and returns the number of them.
+// The results are the same as [Float16.Float32].
+func Float16sToFloat32s(dst []float32, src []Float16) int {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	for i, x := range src {
+		dst[i] = math.Float32frombits(float32bitsFast(x))
+	}
+	return n
+}
+
+// Float64sToFloat16s converts the elements of src to Float16, rounding to nearest even,
+// and stores them into dst.
+// It converts min(len(dst), len(src)) elements, and returns the number of them.
+// The results are the same as [FromFloat64].
+func Float64sToFloat16s(dst []Float16, src []float64) int {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	for i, f := range src {
+		dst[i] = fromFloat64Fast(f)
+	}
+	return n
+}
+
+// Float16sToFloat64s converts the elements of src to float64, and stores them into dst.
+// It converts min(len(dst), len(src)) elements, and returns the number of them.
+// The results are the same as [Float16.Float64].
+func Float16sToFloat64s(dst []float64, src []Float16) int {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	for i, x := range src {
+		dst[i] = float64Fast(x)
+	}
+	return n
+}
+
+func fromFloat32Fast(f float32) Float16 {
+	const (
+		inf32 = mask32 << shift32
+		max16 = (bias32 + bias16 + 1) << shift32 // 2^16, every value above it overflows
+		min16 = (bias32 - bias16 + 1) << shift32 // the smallest normal number of Float16
+
+		// 0.5, its ulp is the smallest subnormal number of Float16.
+		denormMagic = (bias32 - bias16 + shift32 - shift16 + 1) << shift32
+	)
+
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & signMask16
+	b &^= signMask32
+
+	switch {
+	case b >= max16:
+		if b > inf32 {
+			// NaN
+			return Float16(sign | uvnan | uint16(b>>(shift32-shift16)&fracMask16))
+		}
+		return Float16(sign | uvinf)
+	case b < min16:
+		// subnormal number or zero; the addition rounds to nearest even.
+		g := math.Float32frombits(b) + math.Float32frombits(denormMagic)
+		return Float16(sign | uint16(math.Float32bits(g)-denormMagic))
+	}
+
+	// normal number; round to nearest even.
+	odd := (b >> (shift32 - shift16)) & 1
+	b -= (bias32 - bias16) << shift32
+	b += 1<<(shift32-shift16-1) - 1 + odd
+	return Float16(sign | uint16(b>>(shift32-shift16)))
+}
+
+func float32bitsFast(x Float16) uint32 {
+	const (
+		// 2^-14, the smallest normal number of Float16.
+		magic = (bias32 - bias16 + 1) << shift32
+	)
+
+	sign := uint32(x&signMask16) << 16
+	b := uint32(x&^signMask16) << (shift32 - shift16)
+	exp := b & (mask16 << shift32)
+	b += (bias32 - bias16) << shift32 // adjust the exponent
+	switch exp {
+	case mask16 << shift32:
+		// infinity or NaN
+		b += (bias32 - bias16) << shift32
+		if b&fracMask32 != 0 {
+			// NaN is made quiet in the same way as Float16.Float32
+			b |= quietBit16 << (shift32 - shift16)
+		}
+	case 0:
+		// subnormal number or zero; renormalize by the subtraction.
+		b += 1 << shift32
+		b = math.Float32bits(math.Float32frombits(b) - math.Float32frombits(magic))
+	}
+	return sign | b
+}
+
+func fromFloat64Fast(f float64) Float16 {
+	const (
+		inf64 = mask64 << shift64
+		max16 = (bias64 + bias16 + 1) << shift64 // 2^16, every value above it overflows
+		min16 = (bias64 - bias16 + 1) << shift64 // the smallest normal number of Float16
+
+		// 2^28, its ulp is the smallest subnormal number of Float16.
+		denormMagic = (bias64 - bias16 + shift64 - shift16 + 1) << shift64
+	)
+
+	b := math.Float64bits(f)
+	sign := uint16(b>>48) & signMask16
+	b &^= signMask64
+
+	switch {
+	case b >= max16:
+		if b > inf64 {
+			// NaN
+			return Float16(sign | uvnan | uint16(b>>(shift64-shift16)&fracMask16))
+		}
+		return Float16(sign | uvinf)
+	case b < min16:
+		// subnormal number or zero; the addition rounds to nearest even.
+		g := math.Float64frombits(b) + math.Float64frombits(denormMagic)
+		return Float16(sign | uint16(math.Float64bits(g)-denormMagic))
+	}
+
+	// normal number; round to nearest even.
+	odd := (b >> (shift64 - shift16)) & 1
+	b -= (bias64 - bias16) << shift64
+	b += 1<<(shift64-shift16-1) - 1 + odd
+	return Float16(sign | uint16(b>>(shift64-shift16)))
+}
+
+func float64Fast(x Float16) float64 {
+	const (
+		// 2^-14, the smallest normal number of Float16.
+		magic = (bias64 - bias16 + 1) << shift64
+	)
+
+	sign := uint64(x&signMask16) << 48
+	b := uint64(x&^signMask16) << (shift64 - shift16)
+	exp := b & (mask16 << shift64)
+	b += (bias64 - bias16) << shift64 // adjust the exponent
+	switch exp {
+	case mask16 << shift64:
+		// infinity or NaN
+		b += (mask64 - mask16 - (bias64 - bias16)) << shift64
+		if b&fracMask64 != 0 {
+			// NaN is made quiet in the same way as Float16.Float64
+			b |= quietBit16 << (shift64 - shift16)
+		}
+	case 0:
+		// subnormal number or zero; renormalize by the subtraction.
+		b += 1 << shift64
+		b = math.Float64bits(math.Float64frombits(b) - math.Float64frombits(magic))
+	}
+	return math.Float64frombits(sign | b)
+}
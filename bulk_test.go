@@ -0,0 +1,237 @@
+package float16
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestFloat32sToFloat16s(t *testing.T) {
+	// check all float32 values in the non-short mode.
+	step := uint64(1)
+	if testing.Short() {
+		step = 9973
+	}
+	src := make([]float32, 0, 1024)
+	dst := make([]Float16, 1024)
+	check := func() {
+		n := Float32sToFloat16s(dst, src)
+		if n != len(src) {
+			t.Fatalf("expected %d, got %d", len(src), n)
+		}
+		for i, f := range src {
+			if want := FromFloat32(f); dst[i] != want {
+				t.Errorf("%08x: expected %04x, got %04x", math.Float32bits(f), want, dst[i])
+			}
+		}
+		src = src[:0]
+	}
+	for b := uint64(0); b < 1<<32; b += step {
+		src = append(src, math.Float32frombits(uint32(b)))
+		if len(src) == cap(src) {
+			check()
+		}
+	}
+	// the boundaries of the ranges.
+	for _, b := range []uint32{0x387fffff, 0x38800000, 0x477fefff, 0x477ff000, 0x477fffff, 0x47800000, 0x7f800000, 0x7f800001, 0xffffffff} {
+		src = append(src, math.Float32frombits(b), math.Float32frombits(b|signMask32))
+	}
+	check()
+}
+
+func TestFloat64sToFloat16s(t *testing.T) {
+	r := newXorshift64()
+	src := make([]float64, 1<<16)
+	for i := range src {
+		switch i % 4 {
+		case 0:
+			src[i] = r.Float64()
+		case 1:
+			// the values near the representable ones, including the halfway points.
+			f := Float16(i >> 2).Float64()
+			src[i] = math.Float64frombits(math.Float64bits(f) + uint64(r.Uint64()%5) - 2)
+		case 2:
+			// the halfway points.
+			a, b := Float16(i>>2).Float64(), Float16(i>>2+1).Float64()
+			src[i] = a + (b-a)/2
+		default:
+			src[i] = float64(math.Float32frombits(uint32(r.Uint64())))
+		}
+	}
+	src = append(src, math.NaN(), math.Copysign(math.NaN(), -1), math.Inf(1), math.Inf(-1), 65519.99, 65520, math.MaxFloat64, math.SmallestNonzeroFloat64, 0x1p-25, 0x1.0000000000001p-25)
+
+	dst := make([]Float16, len(src))
+	if n := Float64sToFloat16s(dst, src); n != len(src) {
+		t.Fatalf("expected %d, got %d", len(src), n)
+	}
+	for i, f := range src {
+		if want := FromFloat64(f); dst[i] != want && !(f != f && dst[i].IsNaN() && want.IsNaN()) {
+			t.Errorf("%016x: expected %04x, got %04x", math.Float64bits(f), want, dst[i])
+		}
+	}
+}
+
+func TestFloat16sToFloats(t *testing.T) {
+	src := make([]Float16, 0x10000)
+	for i := range src {
+		src[i] = Float16(i)
+	}
+
+	dst32 := make([]float32, len(src))
+	if n := Float16sToFloat32s(dst32, src); n != len(src) {
+		t.Fatalf("expected %d, got %d", len(src), n)
+	}
+	for i, x := range src {
+		if want := x.Float32(); math.Float32bits(dst32[i]) != math.Float32bits(want) {
+			t.Errorf("%04x: expected %08x, got %08x", i, math.Float32bits(want), math.Float32bits(dst32[i]))
+		}
+	}
+
+	dst64 := make([]float64, len(src))
+	if n := Float16sToFloat64s(dst64, src); n != len(src) {
+		t.Fatalf("expected %d, got %d", len(src), n)
+	}
+	for i, x := range src {
+		if want := x.Float64(); math.Float64bits(dst64[i]) != math.Float64bits(want) {
+			t.Errorf("%04x: expected %016x, got %016x", i, math.Float64bits(want), math.Float64bits(dst64[i]))
+		}
+	}
+}
+
+func TestBulk_Length(t *testing.T) {
+	f16 := make([]Float16, 3)
+	f32 := make([]float32, 5)
+	f64 := make([]float64, 2)
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{"Float32sToFloat16s", Float32sToFloat16s(f16, f32), 3},
+		{"Float16sToFloat32s", Float16sToFloat32s(f32, f16), 3},
+		{"Float64sToFloat16s", Float64sToFloat16s(f16, f64), 2},
+		{"Float16sToFloat64s", Float16sToFloat64s(f64, f16), 2},
+		{"empty", Float32sToFloat16s(nil, f32), 0},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
+const benchLen = 4096
+
+func benchFloat32s() []float32 {
+	r := newXorshift32()
+	s := make([]float32, benchLen)
+	for i := range s {
+		s[i] = r.Float32()
+	}
+	return s
+}
+
+func benchFloat16s() []Float16 {
+	r := newXorshift32()
+	s := make([]Float16, benchLen)
+	for i := range s {
+		s[i], _ = r.Float16Pair()
+	}
+	return s
+}
+
+func BenchmarkFloat32sToFloat16s(b *testing.B) {
+	src := benchFloat32s()
+	dst := make([]Float16, len(src))
+	b.SetBytes(int64(len(src) * 4))
+	for i := 0; i < b.N; i++ {
+		Float32sToFloat16s(dst, src)
+	}
+	runtime.KeepAlive(dst)
+}
+
+func BenchmarkFloat32sToFloat16s_Scalar(b *testing.B) {
+	src := benchFloat32s()
+	dst := make([]Float16, len(src))
+	b.SetBytes(int64(len(src) * 4))
+	for i := 0; i < b.N; i++ {
+		for j, f := range src {
+			dst[j] = FromFloat32(f)
+		}
+	}
+	runtime.KeepAlive(dst)
+}
+
+func BenchmarkFloat16sToFloat32s(b *testing.B) {
+	src := benchFloat16s()
+	dst := make([]float32, len(src))
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		Float16sToFloat32s(dst, src)
+	}
+	runtime.KeepAlive(dst)
+}
+
+func BenchmarkFloat16sToFloat32s_Scalar(b *testing.B) {
+	src := benchFloat16s()
+	dst := make([]float32, len(src))
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		for j, x := range src {
+			dst[j] = x.Float32()
+		}
+	}
+	runtime.KeepAlive(dst)
+}
+
+func BenchmarkFloat64sToFloat16s(b *testing.B) {
+	r := newXorshift64()
+	src := make([]float64, benchLen)
+	for i := range src {
+		src[i] = r.Float64()
+	}
+	dst := make([]Float16, len(src))
+	b.SetBytes(int64(len(src) * 8))
+	for i := 0; i < b.N; i++ {
+		Float64sToFloat16s(dst, src)
+	}
+	runtime.KeepAlive(dst)
+}
+
+func BenchmarkFloat64sToFloat16s_Scalar(b *testing.B) {
+	r := newXorshift64()
+	src := make([]float64, benchLen)
+	for i := range src {
+		src[i] = r.Float64()
+	}
+	dst := make([]Float16, len(src))
+	b.SetBytes(int64(len(src) * 8))
+	for i := 0; i < b.N; i++ {
+		for j, f := range src {
+			dst[j] = FromFloat64(f)
+		}
+	}
+	runtime.KeepAlive(dst)
+}
+
+func BenchmarkFloat16sToFloat64s(b *testing.B) {
+	src := benchFloat16s()
+	dst := make([]float64, len(src))
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		Float16sToFloat64s(dst, src)
+	}
+	runtime.KeepAlive(dst)
+}
+
+func BenchmarkFloat16sToFloat64s_Scalar(b *testing.B) {
+	src := benchFloat16s()
+	dst := make([]float64, len(src))
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		for j, x := range src {
+			dst[j] = x.Float64()
+		}
+	}
+	runtime.KeepAlive(dst)
+}
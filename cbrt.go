@@ -0,0 +1,14 @@
+package float16
+
+import "math"
+
+// Cbrt returns the cube root of x.
+//
+// Special cases are:
+//
+//	Cbrt(±0) = ±0
+//	Cbrt(±Inf) = ±Inf
+//	Cbrt(NaN) = NaN
+func (x Float16) Cbrt() Float16 {
+	return elementary(math.Cbrt, x)
+}
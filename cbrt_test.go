@@ -0,0 +1,20 @@
+package float16
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCbrt(t *testing.T) {
+	testSpecialCases(t, Float16.Cbrt, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), math.Inf(-1)},
+		{math.NaN(), math.NaN()},
+		{8, 2},
+		{-27, -3},
+		{0x1p-24, 0x1p-8},
+	})
+	testExhaustive(t, Float16.Cbrt, bigCbrt)
+}
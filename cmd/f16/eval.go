@@ -0,0 +1,263 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shogo82148/float16"
+)
+
+// functions are the functions available in expressions.
+var functions = map[string]struct {
+	args int
+	fn   func(x []float16.Float16) float16.Float16
+}{
+	"abs":      {1, func(x []float16.Float16) float16.Float16 { return x[0].Abs() }},
+	"sqrt":     {1, func(x []float16.Float16) float16.Float16 { return x[0].Sqrt() }},
+	"cbrt":     {1, func(x []float16.Float16) float16.Float16 { return x[0].Cbrt() }},
+	"exp":      {1, func(x []float16.Float16) float16.Float16 { return x[0].Exp() }},
+	"exp2":     {1, func(x []float16.Float16) float16.Float16 { return x[0].Exp2() }},
+	"log":      {1, func(x []float16.Float16) float16.Float16 { return x[0].Log() }},
+	"log2":     {1, func(x []float16.Float16) float16.Float16 { return x[0].Log2() }},
+	"log10":    {1, func(x []float16.Float16) float16.Float16 { return x[0].Log10() }},
+	"sin":      {1, func(x []float16.Float16) float16.Float16 { return x[0].Sin() }},
+	"cos":      {1, func(x []float16.Float16) float16.Float16 { return x[0].Cos() }},
+	"tan":      {1, func(x []float16.Float16) float16.Float16 { return x[0].Tan() }},
+	"floor":    {1, func(x []float16.Float16) float16.Float16 { return x[0].Floor() }},
+	"ceil":     {1, func(x []float16.Float16) float16.Float16 { return x[0].Ceil() }},
+	"trunc":    {1, func(x []float16.Float16) float16.Float16 { return x[0].Trunc() }},
+	"round":    {1, func(x []float16.Float16) float16.Float16 { return x[0].Round() }},
+	"nextup":   {1, func(x []float16.Float16) float16.Float16 { return x[0].Nextafter(float16.Inf(1)) }},
+	"nextdown": {1, func(x []float16.Float16) float16.Float16 { return x[0].Nextafter(float16.Inf(-1)) }},
+	"pow":      {2, func(x []float16.Float16) float16.Float16 { return x[0].Pow(x[1]) }},
+	"min":      {2, func(x []float16.Float16) float16.Float16 { return x[0].Minimum(x[1]) }},
+	"max":      {2, func(x []float16.Float16) float16.Float16 { return x[0].Maximum(x[1]) }},
+	"fma":      {3, func(x []float16.Float16) float16.Float16 { return float16.FMA(x[0], x[1], x[2]) }},
+}
+
+// eval evaluates the expression s in Float16 arithmetic.
+// Every operation rounds its result to Float16, to nearest even.
+//
+// The operands are the numbers in the syntax of [float16.Parse],
+// raw bits such as 0x3c00 and 0b0_01111_0000000000, and the functions listed in functions.
+// The operators are +, -, *, / and parentheses, with the usual precedence.
+//
+// If a number in s overflows or underflows, eval returns the result
+// and an error that wraps [strconv.ErrRange].
+func eval(s string) (float16.Float16, error) {
+	p := &parser{s: s}
+	x := p.expr()
+	p.skipSpace()
+	if p.err == nil && p.pos < len(p.s) {
+		p.fail("unexpected %q", p.s[p.pos:])
+	}
+	if p.err != nil {
+		return 0, p.err
+	}
+	return x, p.rangeErr
+}
+
+// parser is a recursive descent parser of expressions.
+type parser struct {
+	s   string
+	pos int
+
+	// err is the first syntax error.
+	err error
+
+	// rangeErr is the first number out of the range of Float16.
+	rangeErr error
+}
+
+func (p *parser) fail(format string, args ...any) {
+	if p.err == nil {
+		p.err = fmt.Errorf("%s: column %d: %s", p.s, p.pos+1, fmt.Sprintf(format, args...))
+	}
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// peek returns the next non-space byte, or 0 at the end of the input.
+func (p *parser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.s) || p.err != nil {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+// expr = term { ("+" | "-") term }
+func (p *parser) expr() float16.Float16 {
+	x := p.term()
+	for {
+		switch p.peek() {
+		case '+':
+			p.pos++
+			x = x.Add(p.term())
+		case '-':
+			p.pos++
+			x = x.Sub(p.term())
+		default:
+			return x
+		}
+	}
+}
+
+// term = unary { ("*" | "/") unary }
+func (p *parser) term() float16.Float16 {
+	x := p.unary()
+	for {
+		switch p.peek() {
+		case '*':
+			p.pos++
+			x = x.Mul(p.unary())
+		case '/':
+			p.pos++
+			x = x.Quo(p.unary())
+		default:
+			return x
+		}
+	}
+}
+
+// unary = ("+" | "-") unary | primary
+func (p *parser) unary() float16.Float16 {
+	switch p.peek() {
+	case '+':
+		p.pos++
+		return p.unary()
+	case '-':
+		p.pos++
+		// negation flips the sign bit, even of NaNs.
+		return p.unary() ^ 0x8000
+	}
+	return p.primary()
+}
+
+// primary = number | bits | "(" expr ")" | function "(" expr { "," expr } ")"
+func (p *parser) primary() float16.Float16 {
+	switch c := p.peek(); {
+	case c == 0:
+		p.fail("unexpected end of expression")
+		return 0
+	case c == '(':
+		p.pos++
+		x := p.expr()
+		p.expect(')')
+		return x
+	case isLetter(c):
+		if x, ok := p.call(); ok {
+			return x
+		}
+	}
+	if x, ok := p.bits(); ok {
+		return x
+	}
+	return p.number()
+}
+
+func (p *parser) expect(c byte) {
+	if p.peek() != c {
+		p.fail("expected %q", c)
+		return
+	}
+	p.pos++
+}
+
+// call parses a function call.
+// It reports false if the identifier at the position is not a function,
+// which may be a number such as "inf" and "NaN".
+func (p *parser) call() (float16.Float16, bool) {
+	start := p.pos
+	end := start
+	for end < len(p.s) && (isLetter(p.s[end]) || isDigit(p.s[end])) {
+		end++
+	}
+	name := strings.ToLower(p.s[start:end])
+	f, ok := functions[name]
+	if !ok {
+		return 0, false
+	}
+	p.pos = end
+	p.expect('(')
+	args := make([]float16.Float16, 0, f.args)
+	for i := 0; i < f.args; i++ {
+		if i > 0 {
+			p.expect(',')
+		}
+		args = append(args, p.expr())
+	}
+	p.expect(')')
+	if p.err != nil {
+		return 0, true
+	}
+	return f.fn(args), true
+}
+
+// bits parses the raw bits of a Float16 in hexadecimal, e.g. 0x3c00, or in binary, e.g. 0b0_01111_0000000000.
+// The hexadecimal numbers with a point or an exponent, e.g. 0x1p-2, are floating-point numbers, not bits.
+func (p *parser) bits() (float16.Float16, bool) {
+	s := p.s[p.pos:]
+	if len(s) < 2 || s[0] != '0' {
+		return 0, false
+	}
+	base := 0
+	switch s[1] {
+	case 'x', 'X':
+		base = 16
+	case 'b', 'B':
+		base = 2
+	default:
+		return 0, false
+	}
+
+	end := 2
+	for end < len(s) && (isDigit(s[end]) || isLetter(s[end]) || s[end] == '_') {
+		end++
+	}
+	if base == 16 && (end < len(s) && s[end] == '.' || strings.ContainsAny(s[:end], "pP")) {
+		return 0, false
+	}
+
+	// ParseUint accepts underscores only with the base prefix.
+	v, err := strconv.ParseUint(s[:end], 0, 16)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			p.fail("%s is out of the range of 16 bits", s[:end])
+		} else {
+			p.fail("invalid bits %s", s[:end])
+		}
+		return 0, true
+	}
+	p.pos += end
+	return float16.FromBits(uint16(v)), true
+}
+
+func (p *parser) number() float16.Float16 {
+	x, n, err := float16.ParsePrefix(p.s[p.pos:])
+	if err != nil {
+		if n == 0 {
+			p.fail("invalid number %q", p.s[p.pos:])
+			return 0
+		}
+		if p.rangeErr == nil {
+			p.rangeErr = fmt.Errorf("%s: %w", p.s[p.pos:p.pos+n], strconv.ErrRange)
+		}
+	}
+	p.pos += n
+	return x
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
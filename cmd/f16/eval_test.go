@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/shogo82148/float16"
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		s    string
+		want float16.Float16
+	}{
+		{"1", 0x3c00},
+		{"-1.5", 0xbe00},
+		{"0x1p-2", 0x3400},
+		{"0x3c00", 0x3c00},
+		{"0X3C00", 0x3c00},
+		{"-0x3c00", 0xbc00},
+		{"0b0_01111_0000000000", 0x3c00},
+		{"inf", 0x7c00},
+		{"-Inf", 0xfc00},
+		{"0x7e01", 0x7e01}, // NaN payloads are kept
+		{"1 + 2 * 3", 0x4700},
+		{"(1 + 2) * 3", 0x4880},
+		{"1 - 2 - 3", 0xc400},
+		{"8 / 2 / 2", 0x4000},
+		{"2 * -3", 0xc600},
+		{"--1", 0x3c00},
+		{"1/3", 0x3555},
+		{"1 + 0x1p-11", 0x3c00}, // rounds to even
+		{"1 + 0x1p-11 + 0x1p-11", 0x3c00},
+		{"1 + (0x1p-11 + 0x1p-11)", 0x3c01},
+		{"sqrt(2)", 0x3da8},
+		{"SQRT(4)", 0x4000},
+		{"nextup(0)", 0x0001},
+		{"nextdown(1)", 0x3bff},
+		{"pow(2, 10)", 0x6400},
+		{"max(1, nan)", 0x7e00},
+		{"fma(0x3c01, 0x3c01, -0x3c02)", 0x0010}, // 0x1p-20, which is lost by rounding the product
+		{"1e4 * 10", 0x7c00},                     // overflows in the arithmetic, which is not an error
+	}
+	for _, tt := range tests {
+		got, err := eval(tt.s)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.s, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %04x, got %04x", tt.s, tt.want, got)
+		}
+	}
+}
+
+func TestEval_Range(t *testing.T) {
+	tests := []struct {
+		s    string
+		want float16.Float16
+	}{
+		{"1e10", 0x7c00},
+		{"-1e-10", 0x8000},
+		{"1 + 1e-10", 0x3c00},
+	}
+	for _, tt := range tests {
+		got, err := eval(tt.s)
+		if !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("%q: expected strconv.ErrRange, got %v", tt.s, err)
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %04x, got %04x", tt.s, tt.want, got)
+		}
+	}
+}
+
+func TestEval_Error(t *testing.T) {
+	tests := []string{
+		"",
+		"1 +",
+		"(1",
+		"1)",
+		"1 2",
+		"foo",
+		"sqrt 2",
+		"sqrt(1, 2)",
+		"pow(2)",
+		"0x10000",
+		"0b2",
+		"1e",
+	}
+	for _, s := range tests {
+		if x, err := eval(s); err == nil || errors.Is(err, strconv.ErrRange) {
+			t.Errorf("%q: expected syntax error, got %04x, %v", s, x, err)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Command f16 inspects and converts half-precision floating-point numbers.
+//
+// Usage:
+//
+//	f16 [-v view] [expression ...]
+//
+// f16 evaluates each expression in Float16 arithmetic, and prints the result in various views:
+// the decimal, the hexadecimal floating-point number, the raw bits, the fields in binary,
+// the float32 and bfloat16 values, the adjacent values and the ulp.
+// If no expressions are given, f16 reads them from the standard input, one per line.
+// Blank lines and the lines starting with # are ignored.
+//
+// The operands of the expressions are:
+//
+//   - numbers in the syntax of [strconv.ParseFloat], e.g. 1.5, 6.1e-5, 0x1.8p+0, Inf and NaN.
+//   - raw bits in hexadecimal, e.g. 0x3c00, or in binary, e.g. 0b0_01111_0000000000.
+//     The hexadecimal numbers without a point nor an exponent are bits.
+//   - function calls: abs, sqrt, cbrt, exp, exp2, log, log2, log10, sin, cos, tan,
+//     floor, ceil, trunc, round, nextup, nextdown, pow(x, y), min(x, y), max(x, y) and fma(x, y, z).
+//
+// They are combined with +, -, *, / and parentheses.
+// Every operation rounds its result to Float16, to nearest even.
+//
+// The -v flag selects a single view, which prints one line per expression.
+// It is useful to convert a list of values, e.g.:
+//
+//	$ printf '0x3c00\n0x3555\n0x7bff\n' | f16 -v dec
+//	1
+//	0.3333
+//	65504
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command, and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("f16", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	name := flags.String("v", "all", "the `view` to print")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: f16 [-v view] [expression ...]")
+		flags.PrintDefaults()
+		fmt.Fprintln(stderr, "views:")
+		fmt.Fprintf(stderr, "  %-7s %s\n", "all", "all of the following views (default)")
+		for _, v := range views {
+			fmt.Fprintf(stderr, "  %-7s %s\n", v.name, v.usage)
+		}
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	var selected []view
+	if *name == "all" {
+		selected = views
+	} else if v, ok := lookupView(*name); ok {
+		selected = []view{v}
+	} else {
+		fmt.Fprintf(stderr, "f16: unknown view %q\n", *name)
+		flags.Usage()
+		return 2
+	}
+
+	w := bufio.NewWriter(stdout)
+	defer w.Flush()
+	p := &printer{w: w, stderr: stderr, views: selected}
+
+	if flags.NArg() > 0 {
+		for _, s := range flags.Args() {
+			p.print(s)
+		}
+		return p.status
+	}
+
+	s := bufio.NewScanner(stdin)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		p.print(line)
+	}
+	if err := s.Err(); err != nil {
+		w.Flush()
+		fmt.Fprintf(stderr, "f16: %v\n", err)
+		return 1
+	}
+	return p.status
+}
+
+// printer evaluates the expressions and prints the results.
+type printer struct {
+	w      *bufio.Writer
+	stderr io.Writer
+	views  []view
+	buf    []byte
+	count  int
+
+	// status is the exit status, which is 1 if any of the expressions is invalid.
+	status int
+}
+
+func (p *printer) print(s string) {
+	x, err := eval(s)
+	if err != nil {
+		// the messages go to the standard error, after the results printed so far.
+		p.w.Flush()
+		fmt.Fprintf(p.stderr, "f16: %v\n", err)
+		if !errors.Is(err, strconv.ErrRange) {
+			p.status = 1
+			return
+		}
+	}
+
+	buf := p.buf[:0]
+	if len(p.views) == 1 {
+		buf = p.views[0].fn(buf, x)
+		buf = append(buf, '\n')
+	} else {
+		if p.count > 0 {
+			buf = append(buf, '\n')
+		}
+		buf = append(buf, s...)
+		buf = append(buf, '\n')
+		for _, v := range p.views {
+			buf = append(buf, "  "...)
+			buf = append(buf, v.name...)
+			buf = append(buf, "        "[len(v.name):]...)
+			buf = v.fn(buf, x)
+			buf = append(buf, '\n')
+		}
+	}
+	p.w.Write(buf)
+	p.buf = buf
+	p.count++
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"1"}, nil, &stdout, &stderr)
+	if status != 0 {
+		t.Fatalf("unexpected status %d: %s", status, stderr.String())
+	}
+	want := `1
+  dec     1
+  exact   1
+  hex     0x1p+00
+  bits    0x3c00
+  fields  0 01111 0000000000 (+, normal, 1.0000000000 × 2^0)
+  f32     1 (0x3f800000)
+  bf16    1 (0x3f80)
+  next    down 0.9995 (0x3bff), up 1.001 (0x3c01)
+  ulp     0.000977 (0x1p-10)
+`
+	if got := stdout.String(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestRun_Stdin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader("0x3c00\n\n# comment\n  0x3555  \n1e10\nfoo\n1/3\n")
+	status := run([]string{"-v", "dec"}, stdin, &stdout, &stderr)
+	if status != 1 {
+		t.Errorf("expected status 1, got %d", status)
+	}
+	if got, want := stdout.String(), "1\n0.3333\n+Inf\n0.3333\n"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got := stderr.String(); !strings.Contains(got, "1e10: value out of range") || !strings.Contains(got, "foo") {
+		t.Errorf("unexpected error output %q", got)
+	}
+}
+
+func TestRun_UnknownView(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-v", "foo", "1"}, nil, &stdout, &stderr); status != 2 {
+		t.Errorf("expected status 2, got %d", status)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/shogo82148/float16"
+)
+
+// view is a representation of a Float16.
+type view struct {
+	name  string
+	usage string
+	fn    func(buf []byte, x float16.Float16) []byte
+}
+
+// views are the available views, in the order that -v all prints them.
+var views = []view{
+	{"dec", "the shortest decimal that parses back to the value", appendDec},
+	{"exact", "the exact decimal value", appendExact},
+	{"hex", "the hexadecimal floating-point number", appendHex},
+	{"bits", "the raw bits", appendBits},
+	{"fields", "the sign, exponent and fraction fields in binary", appendFields},
+	{"f32", "the float32 value and its bits", appendFloat32},
+	{"bf16", "the bfloat16 value rounded to nearest even, and its bits", appendBFloat16},
+	{"next", "the adjacent values toward -Inf and +Inf", appendNext},
+	{"ulp", "the unit in the last place", appendUlp},
+}
+
+func lookupView(name string) (view, bool) {
+	for _, v := range views {
+		if v.name == name {
+			return v, true
+		}
+	}
+	return view{}, false
+}
+
+func appendDec(buf []byte, x float16.Float16) []byte {
+	return x.Append(buf, 'g', -1)
+}
+
+func appendExact(buf []byte, x float16.Float16) []byte {
+	// 32 digits are enough for the exact value of any Float16,
+	// and 'g' omits the trailing zeros.
+	return x.Append(buf, 'g', 32)
+}
+
+func appendHex(buf []byte, x float16.Float16) []byte {
+	return x.Append(buf, 'x', -1)
+}
+
+func appendBits(buf []byte, x float16.Float16) []byte {
+	return appendHexBits(buf, uint64(x.Bits()), 4)
+}
+
+// appendFields appends the fields of x in binary, and what they mean.
+func appendFields(buf []byte, x float16.Float16) []byte {
+	b := x.Bits()
+	sign := b >> 15
+	exp := int(b>>10) & 0x1f
+	frac := b & 0x3ff
+
+	buf = append(buf, byte('0'+sign), ' ')
+	buf = appendBinary(buf, uint64(exp), 5)
+	buf = append(buf, ' ')
+	buf = appendBinary(buf, uint64(frac), 10)
+
+	buf = append(buf, " ("...)
+	if sign != 0 {
+		buf = append(buf, '-')
+	} else {
+		buf = append(buf, '+')
+	}
+	switch {
+	case exp == 0x1f && frac == 0:
+		buf = append(buf, ", infinity"...)
+	case exp == 0x1f:
+		if x.IsSignalingNaN() {
+			buf = append(buf, ", signaling NaN, payload "...)
+		} else {
+			buf = append(buf, ", quiet NaN, payload "...)
+		}
+		buf = appendHexBits(buf, uint64(x.Payload()), 3)
+	case exp == 0 && frac == 0:
+		buf = append(buf, ", zero"...)
+	case exp == 0:
+		buf = append(buf, ", subnormal, 0."...)
+		buf = appendBinary(buf, uint64(frac), 10)
+		buf = append(buf, " × 2^-14"...)
+	default:
+		buf = append(buf, ", normal, 1."...)
+		buf = appendBinary(buf, uint64(frac), 10)
+		buf = append(buf, " × 2^"...)
+		buf = strconv.AppendInt(buf, int64(exp-15), 10)
+	}
+	return append(buf, ')')
+}
+
+func appendFloat32(buf []byte, x float16.Float16) []byte {
+	f := x.Float32()
+	buf = strconv.AppendFloat(buf, float64(f), 'g', -1, 32)
+	buf = append(buf, " ("...)
+	buf = appendHexBits(buf, uint64(math.Float32bits(f)), 8)
+	return append(buf, ')')
+}
+
+func appendBFloat16(buf []byte, x float16.Float16) []byte {
+	// the conversion to float32 is exact, so the only rounding is the one to bfloat16.
+	f := x.Float32()
+	b := float16.BFloat16FromFloat32(f)
+	buf = b.Append(buf, 'g', -1)
+	buf = append(buf, " ("...)
+	buf = appendHexBits(buf, uint64(b.Bits()), 4)
+	buf = append(buf, ')')
+	if !x.IsNaN() && b.Float32() != f {
+		buf = append(buf, ", inexact"...)
+	}
+	return buf
+}
+
+func appendNext(buf []byte, x float16.Float16) []byte {
+	down := x.Nextafter(float16.Inf(-1))
+	up := x.Nextafter(float16.Inf(1))
+	buf = append(buf, "down "...)
+	buf = appendDecBits(buf, down)
+	buf = append(buf, ", up "...)
+	return appendDecBits(buf, up)
+}
+
+// appendUlp appends the distance between |x| and the next larger magnitude.
+// The ulp of the largest finite number is the distance to the one below it, as if the exponent range were unbounded.
+func appendUlp(buf []byte, x float16.Float16) []byte {
+	a := x.Abs()
+	var ulp float16.Float16
+	switch {
+	case x.IsNaN() || x.IsInf(0):
+		ulp = a
+	case a.Nextafter(float16.Inf(1)).IsInf(0):
+		// the difference of adjacent values is a power of two, so the subtraction is exact.
+		ulp = a.Sub(a.Nextafter(0))
+	default:
+		ulp = a.Nextafter(float16.Inf(1)).Sub(a)
+	}
+	buf = ulp.Append(buf, 'g', -1)
+	buf = append(buf, " ("...)
+	buf = ulp.Append(buf, 'x', -1)
+	return append(buf, ')')
+}
+
+// appendDecBits appends x in decimal, and its bits.
+func appendDecBits(buf []byte, x float16.Float16) []byte {
+	buf = x.Append(buf, 'g', -1)
+	buf = append(buf, " ("...)
+	buf = appendHexBits(buf, uint64(x.Bits()), 4)
+	return append(buf, ')')
+}
+
+func appendHexBits(buf []byte, v uint64, digits int) []byte {
+	const hex = "0123456789abcdef"
+	buf = append(buf, "0x"...)
+	for i := digits - 1; i >= 0; i-- {
+		buf = append(buf, hex[v>>(4*i)&0xf])
+	}
+	return buf
+}
+
+func appendBinary(buf []byte, v uint64, digits int) []byte {
+	for i := digits - 1; i >= 0; i-- {
+		buf = append(buf, byte('0'+v>>i&1))
+	}
+	return buf
+}
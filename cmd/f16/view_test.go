@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/shogo82148/float16"
+)
+
+func TestViews(t *testing.T) {
+	tests := []struct {
+		view string
+		x    float16.Float16
+		want string
+	}{
+		{"dec", 0x3555, "0.3333"},
+		{"exact", 0x3555, "0.333251953125"},
+		{"exact", 0x0001, "5.9604644775390625e-08"},
+		{"hex", 0x3555, "0x1.554p-02"},
+		{"bits", 0x0001, "0x0001"},
+		{"fields", 0x3c00, "0 01111 0000000000 (+, normal, 1.0000000000 × 2^0)"},
+		{"fields", 0x8001, "1 00000 0000000001 (-, subnormal, 0.0000000001 × 2^-14)"},
+		{"fields", 0x8000, "1 00000 0000000000 (-, zero)"},
+		{"fields", 0xfc00, "1 11111 0000000000 (-, infinity)"},
+		{"fields", 0x7e05, "0 11111 1000000101 (+, quiet NaN, payload 0x005)"},
+		{"fields", 0x7c05, "0 11111 0000000101 (+, signaling NaN, payload 0x005)"},
+		{"f32", 0x3555, "0.33325195 (0x3eaaa000)"},
+		{"bf16", 0x3c00, "1 (0x3f80)"},
+		{"bf16", 0x3555, "0.334 (0x3eab), inexact"},
+		{"next", 0x3c00, "down 0.9995 (0x3bff), up 1.001 (0x3c01)"},
+		{"next", 0x8000, "down -6e-08 (0x8001), up 6e-08 (0x0001)"},
+		{"ulp", 0x3c00, "0.000977 (0x1p-10)"},
+		{"ulp", 0xbc00, "0.000977 (0x1p-10)"},
+		{"ulp", 0x0000, "6e-08 (0x1p-24)"},
+		{"ulp", 0x7bff, "32 (0x1p+05)"},
+		{"ulp", 0x7c00, "+Inf (+Inf)"},
+	}
+	for _, tt := range tests {
+		v, ok := lookupView(tt.view)
+		if !ok {
+			t.Fatalf("%s: view not found", tt.view)
+		}
+		if got := string(v.fn(nil, tt.x)); got != tt.want {
+			t.Errorf("%s(%04x): expected %q, got %q", tt.view, uint16(tt.x), tt.want, got)
+		}
+	}
+}
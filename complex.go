@@ -0,0 +1,324 @@
+package float16
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Complex32 represents a complex number whose real and imaginary parts are Float16.
+type Complex32 struct {
+	re, im Float16
+}
+
+// Complex returns the complex number re + im i.
+func Complex(re, im Float16) Complex32 {
+	return Complex32{re, im}
+}
+
+// FromComplex64 returns the Complex32 nearest to c,
+// rounding the real and imaginary parts to nearest even.
+func FromComplex64(c complex64) Complex32 {
+	return Complex32{FromFloat32(real(c)), FromFloat32(imag(c))}
+}
+
+// FromComplex128 returns the Complex32 nearest to c,
+// rounding the real and imaginary parts to nearest even.
+func FromComplex128(c complex128) Complex32 {
+	return Complex32{FromFloat64(real(c)), FromFloat64(imag(c))}
+}
+
+// Complex64 returns the complex64 representation of c.
+func (c Complex32) Complex64() complex64 {
+	return complex(c.re.Float32(), c.im.Float32())
+}
+
+// Complex128 returns the complex128 representation of c.
+func (c Complex32) Complex128() complex128 {
+	return complex(c.re.Float64(), c.im.Float64())
+}
+
+// Real returns the real part of c.
+func (c Complex32) Real() Float16 {
+	return c.re
+}
+
+// Imag returns the imaginary part of c.
+func (c Complex32) Imag() Float16 {
+	return c.im
+}
+
+// IsNaN reports whether either c.Real() or c.Imag() is NaN
+// and neither is an infinity, in the same way as [math/cmplx.IsNaN].
+func (c Complex32) IsNaN() bool {
+	if c.IsInf() {
+		return false
+	}
+	return c.re.IsNaN() || c.im.IsNaN()
+}
+
+// IsInf reports whether either c.Real() or c.Imag() is an infinity,
+// in the same way as [math/cmplx.IsInf].
+func (c Complex32) IsInf() bool {
+	return c.re.IsInf(0) || c.im.IsInf(0)
+}
+
+// Add returns the sum of a and b.
+func (a Complex32) Add(b Complex32) Complex32 {
+	return Complex32{a.re.Add(b.re), a.im.Add(b.im)}
+}
+
+// Sub returns the difference of a and b.
+func (a Complex32) Sub(b Complex32) Complex32 {
+	return Complex32{a.re.Sub(b.re), a.im.Sub(b.im)}
+}
+
+// Mul returns the product of a and b.
+// The real and imaginary parts are correctly rounded.
+// Infinities and NaNs are handled as the Annex G of the C99 standard specifies,
+// so a product of an infinity and a non-zero number is an infinity
+// even if the naive formula gives NaN.
+func (a Complex32) Mul(b Complex32) Complex32 {
+	x, y := a.re.Float64(), a.im.Float64()
+	u, v := b.re.Float64(), b.im.Float64()
+
+	// the products are exact in float64,
+	// so the sums are rounded only once, to odd, and then rounded to Float16.
+	re := addOdd(x*u, -(y * v))
+	im := addOdd(x*v, y*u)
+
+	if math.IsNaN(re) && math.IsNaN(im) {
+		// recover infinities that the naive formula computes as NaN.
+		recalc := false
+		if math.IsInf(x, 0) || math.IsInf(y, 0) {
+			// a is infinite.
+			x, y = boxInf(x), boxInf(y)
+			u, v = nanToZero(u), nanToZero(v)
+			recalc = true
+		}
+		if math.IsInf(u, 0) || math.IsInf(v, 0) {
+			// b is infinite.
+			u, v = boxInf(u), boxInf(v)
+			x, y = nanToZero(x), nanToZero(y)
+			recalc = true
+		}
+		if recalc {
+			re = math.Inf(1) * (x*u - y*v)
+			im = math.Inf(1) * (x*v + y*u)
+		}
+	}
+	return Complex32{FromFloat64(re), FromFloat64(im)}
+}
+
+// addOdd returns p + q, rounded to odd.
+// Rounding to odd first and then to nearest even gives the correctly rounded result,
+// if the intermediate format has at least two more bits than the final format.
+func addOdd(p, q float64) float64 {
+	s := p + q
+	if math.IsInf(s, 0) || math.IsNaN(s) {
+		return s
+	}
+
+	// the error of the addition, by the TwoSum algorithm.
+	bp := s - q
+	bq := s - bp
+	e := (p - bp) + (q - bq)
+	if e == 0 {
+		return s
+	}
+
+	b := math.Float64bits(s)
+	if b&1 == 0 {
+		if (e > 0) == (s > 0) {
+			b++
+		} else {
+			b--
+		}
+	}
+	return math.Float64frombits(b)
+}
+
+// boxInf converts infinities to ±1, and finite numbers or NaNs to ±0.
+func boxInf(x float64) float64 {
+	if math.IsInf(x, 0) {
+		return math.Copysign(1, x)
+	}
+	return math.Copysign(0, x)
+}
+
+// nanToZero converts NaNs to ±0.
+func nanToZero(x float64) float64 {
+	if math.IsNaN(x) {
+		return math.Copysign(0, x)
+	}
+	return x
+}
+
+// Quo returns the quotient of a and b.
+// The calculation is done in float64, and the real and imaginary parts are rounded to Float16 at the end,
+// so they may differ from the correctly rounded ones only if they are very close to a halfway point.
+// Infinities and NaNs are handled as the Annex G of the C99 standard specifies.
+func (a Complex32) Quo(b Complex32) Complex32 {
+	x, y := a.re.Float64(), a.im.Float64()
+	u, v := b.re.Float64(), b.im.Float64()
+
+	// the squares are exact, and they don't overflow.
+	den := u*u + v*v
+	re := (x*u + y*v) / den
+	im := (y*u - x*v) / den
+
+	if math.IsNaN(re) && math.IsNaN(im) {
+		// recover infinities and zeros that the naive formula computes as NaN.
+		switch {
+		case den == 0 && (!math.IsNaN(x) || !math.IsNaN(y)):
+			re = math.Copysign(math.Inf(1), u) * x
+			im = math.Copysign(math.Inf(1), u) * y
+		case (math.IsInf(x, 0) || math.IsInf(y, 0)) && isFinite64(u) && isFinite64(v):
+			x, y = boxInf(x), boxInf(y)
+			re = math.Inf(1) * (x*u + y*v)
+			im = math.Inf(1) * (y*u - x*v)
+		case (math.IsInf(u, 0) || math.IsInf(v, 0)) && isFinite64(x) && isFinite64(y):
+			u, v = boxInf(u), boxInf(v)
+			re = 0 * (x*u + y*v)
+			im = 0 * (y*u - x*v)
+		}
+	}
+	return Complex32{FromFloat64(re), FromFloat64(im)}
+}
+
+func isFinite64(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
+
+// Abs returns the absolute value (also called the modulus) of c.
+func (c Complex32) Abs() Float16 {
+	return c.re.Hypot(c.im)
+}
+
+// Conj returns the complex conjugate of c.
+func (c Complex32) Conj() Complex32 {
+	return Complex32{c.re, c.im ^ signMask16}
+}
+
+// Phase returns the phase (also called the argument) of c.
+// The returned value is in the range [-Pi, Pi].
+func (c Complex32) Phase() Float16 {
+	return c.im.Atan2(c.re)
+}
+
+// String returns the string representation of c in the form "(re+imi)",
+// the same as [strconv.FormatComplex] with 'g' format and the smallest precision.
+func (c Complex32) String() string {
+	return c.Text('g', -1)
+}
+
+// Text returns the string representation of c in the form "(re+imi)",
+// according to the format fmt and precision prec in the same way as [strconv.FormatComplex].
+func (c Complex32) Text(fmt byte, prec int) string {
+	return string(c.Append(make([]byte, 0, 24), fmt, prec))
+}
+
+// Append appends the string form of c, as generated by c.Text, to buf and returns the extended buffer.
+func (c Complex32) Append(buf []byte, fmt byte, prec int) []byte {
+	buf = append(buf, '(')
+	buf = c.re.Append(buf, fmt, prec)
+
+	// the imaginary part always has a sign.
+	var im [32]byte
+	b := c.im.Append(im[:0], fmt, prec)
+	if b[0] != '+' && b[0] != '-' {
+		buf = append(buf, '+')
+	}
+	buf = append(buf, b...)
+	return append(buf, 'i', ')')
+}
+
+var _ fmt.Formatter = Complex32{}
+
+// Format implements [fmt.Formatter].
+// It formats c in the same way as the fmt package formats complex64.
+func (c Complex32) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v', 'b', 'g', 'G', 'x', 'X', 'f', 'F', 'e', 'E':
+	default:
+		fmt.Fprintf(s, "%%!%c(float16.Complex32=%s)", verb, c.String())
+		return
+	}
+
+	// the real part is formatted as a Float16,
+	// and the imaginary part always has a sign.
+	plus := s.Flag('+') && verb != 'v'
+	sharp := s.Flag('#') && verb != 'v'
+	s.Write([]byte{'('})
+	c.re.formatNumber().format(s, verb, plus, sharp)
+	c.im.formatNumber().format(s, verb, true, sharp)
+	s.Write([]byte{'i', ')'})
+}
+
+// ParseComplex32 converts the string s to a Complex32.
+// It accepts the same syntax as [strconv.ParseComplex], such as "(1+2i)", "1+2i", "2i" and "NaN",
+// and the real and imaginary parts are parsed in the same way as [Parse].
+// If s is syntactically well-formed but either part is out of the range of Float16,
+// ParseComplex32 returns the result with the part overflowed to ±Inf or underflowed to ±0,
+// and an error whose Err is [strconv.ErrRange].
+func ParseComplex32(s string) (Complex32, error) {
+	const fn = "float16.ParseComplex32"
+	orig := s
+
+	// remove parentheses, if any.
+	if len(s) >= 2 && s[0] == '(' && s[len(s)-1] == ')' {
+		s = s[1 : len(s)-1]
+	}
+
+	var pending error // pending range error, or nil
+	var c Context
+
+	// read the real part, which may be the imaginary part if it is followed by 'i'.
+	re, n, err := atof(s, &float16info, fn, &c)
+	if err != nil {
+		if err.(*strconv.NumError).Err != strconv.ErrRange {
+			return Complex32{}, &strconv.NumError{Func: fn, Num: orig, Err: strconv.ErrSyntax}
+		}
+		pending = &strconv.NumError{Func: fn, Num: orig, Err: strconv.ErrRange}
+	}
+	s = s[n:]
+
+	// if we have nothing left, we're done.
+	if len(s) == 0 {
+		return Complex32{Float16(re), 0}, pending
+	}
+
+	// otherwise, look at the next character.
+	switch s[0] {
+	case '+':
+		// consume the '+' to avoid an error if we have "+NaNi",
+		// but do this only if we don't have a "++" (don't hide that error).
+		if len(s) > 1 && s[1] != '+' {
+			s = s[1:]
+		}
+	case '-':
+		// ok
+	case 'i':
+		// if 'i' is the last character, we only have an imaginary part.
+		if len(s) == 1 {
+			return Complex32{0, Float16(re)}, pending
+		}
+		fallthrough
+	default:
+		return Complex32{}, &strconv.NumError{Func: fn, Num: orig, Err: strconv.ErrSyntax}
+	}
+
+	// read the imaginary part.
+	im, n, err := atof(s, &float16info, fn, &c)
+	if err != nil {
+		if err.(*strconv.NumError).Err != strconv.ErrRange {
+			return Complex32{}, &strconv.NumError{Func: fn, Num: orig, Err: strconv.ErrSyntax}
+		}
+		pending = &strconv.NumError{Func: fn, Num: orig, Err: strconv.ErrRange}
+	}
+	s = s[n:]
+	if s != "i" {
+		return Complex32{}, &strconv.NumError{Func: fn, Num: orig, Err: strconv.ErrSyntax}
+	}
+	return Complex32{Float16(re), Float16(im)}, pending
+}
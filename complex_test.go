@@ -0,0 +1,339 @@
+package float16
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"strconv"
+	"testing"
+)
+
+func TestFromComplex128(t *testing.T) {
+	c := FromComplex128(complex(1.5, -0.1))
+	if c.Real() != FromFloat64(1.5) || c.Imag() != FromFloat64(-0.1) {
+		t.Errorf("unexpected result: %v", c)
+	}
+	if got := c.Complex128(); got != complex(1.5, FromFloat64(-0.1).Float64()) {
+		t.Errorf("unexpected result: %v", got)
+	}
+	if got := FromComplex64(c.Complex64()); got != c {
+		t.Errorf("expected %v, got %v", c, got)
+	}
+}
+
+func TestComplex32_IsNaN(t *testing.T) {
+	tests := []struct {
+		c   Complex32
+		nan bool
+		inf bool
+	}{
+		{Complex(0, 0), false, false},
+		{Complex(NaN(), 0), true, false},
+		{Complex(0, NaN()), true, false},
+		{Complex(Inf(1), NaN()), false, true},
+		{Complex(NaN(), Inf(-1)), false, true},
+	}
+	for _, tt := range tests {
+		if got := tt.c.IsNaN(); got != tt.nan {
+			t.Errorf("%v: IsNaN: expected %t, got %t", tt.c, tt.nan, got)
+		}
+		if got := tt.c.IsInf(); got != tt.inf {
+			t.Errorf("%v: IsInf: expected %t, got %t", tt.c, tt.inf, got)
+		}
+	}
+}
+
+// mulBig returns a*b + c*d correctly rounded to Float16.
+func mulBig(a, b, c, d Float16) Float16 {
+	x := new(big.Float).SetPrec(100).Mul(a.BigFloat(), b.BigFloat())
+	y := new(big.Float).SetPrec(100).Mul(c.BigFloat(), d.BigFloat())
+	x.Add(x, y)
+	return roundBig(x, ToNearestEven)
+}
+
+func TestComplex32_Mul(t *testing.T) {
+	r := newXorshift32()
+	for i := 0; i < 1<<18; i++ {
+		a, b := r.Float16Pair()
+		c, d := r.Float16Pair()
+		if a.IsNaN() || b.IsNaN() || c.IsNaN() || d.IsNaN() ||
+			a.IsInf(0) || b.IsInf(0) || c.IsInf(0) || d.IsInf(0) {
+			continue
+		}
+		got := Complex(a, b).Mul(Complex(c, d))
+		wantRe := mulBig(a, c, b^signMask16, d)
+		wantIm := mulBig(a, d, b, c)
+		if (got.re != wantRe && !(isZero(got.re) && isZero(wantRe))) ||
+			(got.im != wantIm && !(isZero(got.im) && isZero(wantIm))) {
+			t.Errorf("(%v)*(%v): expected (%v, %v), got %v", Complex(a, b), Complex(c, d), wantRe, wantIm, got)
+		}
+	}
+}
+
+func TestComplex32_Quo(t *testing.T) {
+	r := newXorshift32()
+	for i := 0; i < 1<<18; i++ {
+		a, b := r.Float16Pair()
+		c, d := r.Float16Pair()
+		x, y := Complex(a, b), Complex(c, d)
+		got := x.Quo(y)
+		want := FromComplex128(x.Complex128() / y.Complex128())
+		if !closeComplex(got, want) {
+			t.Errorf("(%v)/(%v): expected %v, got %v", x, y, want, got)
+		}
+	}
+}
+
+// closeComplex reports whether the parts of a and b are within 1 ulp.
+func closeComplex(a, b Complex32) bool {
+	return closeFloat16(a.re, b.re) && closeFloat16(a.im, b.im)
+}
+
+func closeFloat16(a, b Float16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return a.IsNaN() && b.IsNaN()
+	}
+	if a == b || (isZero(a) && isZero(b)) {
+		return true
+	}
+	if a.Signbit() != b.Signbit() {
+		return false
+	}
+	d := int(a&^signMask16) - int(b&^signMask16)
+	return d == 1 || d == -1
+}
+
+func TestComplex32_AnnexG(t *testing.T) {
+	inf := Inf(1)
+	nan := NaN()
+	one := FromFloat64(1)
+	tests := []struct {
+		name string
+		got  Complex32
+		inf  bool
+		zero bool
+	}{
+		{"inf*1", Complex(inf, nan).Mul(Complex(one, 0)), true, false},
+		{"(inf+inf i)*(1+1i)", Complex(inf, inf).Mul(Complex(one, one)), true, false},
+		{"(1+nan i)*inf", Complex(one, nan).Mul(Complex(inf, nan)), true, false},
+		{"1/0", Complex(one, 0).Quo(Complex(0, 0)), true, false},
+		{"inf/1", Complex(inf, nan).Quo(Complex(one, one)), true, false},
+		{"1/inf", Complex(one, one).Quo(Complex(inf, nan)), false, true},
+	}
+	for _, tt := range tests {
+		if tt.inf && !tt.got.IsInf() {
+			t.Errorf("%s: expected infinity, got %v", tt.name, tt.got)
+		}
+		if tt.zero && !(isZero(tt.got.re) && isZero(tt.got.im)) {
+			t.Errorf("%s: expected zero, got %v", tt.name, tt.got)
+		}
+	}
+
+	values := []Float16{0, signMask16, one, one ^ signMask16, inf, Inf(-1), nan, uvmax}
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range values {
+				for _, d := range values {
+					x, y := Complex(a, b), Complex(c, d)
+
+					// the division of complex128 follows the Annex G in the same way.
+					got := x.Quo(y)
+					want := FromComplex128(x.Complex128() / y.Complex128())
+					if got.IsInf() != want.IsInf() || got.IsNaN() != want.IsNaN() {
+						t.Errorf("(%v)/(%v): expected %v, got %v", x, y, want, got)
+					}
+
+					// but the multiplication doesn't.
+					// an infinity times a non-zero number is an infinity.
+					got = x.Mul(y)
+					nonzero := func(c Complex32) bool {
+						return !c.IsNaN() && !(isZero(c.re) && isZero(c.im))
+					}
+					if (x.IsInf() && nonzero(y)) || (y.IsInf() && nonzero(x)) {
+						if !got.IsInf() {
+							t.Errorf("(%v)*(%v): expected infinity, got %v", x, y, got)
+						}
+					} else if !x.IsInf() && !y.IsInf() && (x.IsNaN() || y.IsNaN()) {
+						if !got.IsNaN() {
+							t.Errorf("(%v)*(%v): expected NaN, got %v", x, y, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestComplex32_Abs(t *testing.T) {
+	c := Complex(FromFloat64(3), FromFloat64(-4))
+	if got := c.Abs(); got != FromFloat64(5) {
+		t.Errorf("expected 5, got %v", got)
+	}
+	if got := c.Conj(); got != Complex(FromFloat64(3), FromFloat64(4)) {
+		t.Errorf("unexpected conjugate: %v", got)
+	}
+	if got, want := c.Phase(), FromFloat64(cmplx.Phase(complex(3, -4))); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := Complex(Inf(-1), NaN()).Abs(); !got.IsInf(1) {
+		t.Errorf("expected +Inf, got %v", got)
+	}
+}
+
+func TestComplex32_String(t *testing.T) {
+	tests := []struct {
+		c    Complex32
+		want string
+	}{
+		{Complex(0, 0), "(0+0i)"},
+		{Complex(FromFloat64(1.5), FromFloat64(-2)), "(1.5-2i)"},
+		{Complex(signMask16, signMask16), "(-0-0i)"},
+		{Complex(FromFloat64(0.1), Inf(1)), "(0.1+Infi)"},
+		{Complex(Inf(-1), Inf(-1)), "(-Inf-Infi)"},
+		{Complex(NaN(), NaN()), "(NaN+NaNi)"},
+	}
+	for _, tt := range tests {
+		if got := tt.c.String(); got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestComplex32_Text(t *testing.T) {
+	r := newXorshift32()
+	for i := 0; i < 1<<12; i++ {
+		a, b := r.Float16Pair()
+		c := Complex(a, b)
+		for _, f := range []byte{'e', 'f', 'g', 'x'} {
+			for _, prec := range []int{-1, 0, 3} {
+				got := c.Text(f, prec)
+				want := "(" + a.Text(f, prec)
+				if s := b.Text(f, prec); s[0] != '+' && s[0] != '-' {
+					want += "+"
+				}
+				want += b.Text(f, prec) + "i)"
+				if got != want {
+					t.Errorf("%c, %d: expected %s, got %s", f, prec, want, got)
+				}
+			}
+		}
+
+		// the same syntax as strconv.FormatComplex.
+		if got, want := c.Text('g', 5), strconv.FormatComplex(c.Complex128(), 'g', 5, 64); got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	}
+}
+
+func TestComplex32_Format(t *testing.T) {
+	c := Complex(FromFloat64(1.5), FromFloat64(-0.25))
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "(1.5-0.25i)"},
+		{"%.2f", "(1.50-0.25i)"},
+		{"%.3e", "(1.500e+00-2.500e-01i)"},
+		{"%d", "%!d(float16.Complex32=(1.5-0.25i))"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, c); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.format, tt.want, got)
+		}
+	}
+	for _, format := range []string{"%v", "%+v", "%8.2f", "%-8.2f", "%+.1e", "%#g", "%08.3F", "% x"} {
+		got := fmt.Sprintf(format, c)
+		want := fmt.Sprintf(format, c.Complex64())
+		if got != want {
+			t.Errorf("%s: expected %s, got %s", format, want, got)
+		}
+	}
+	if got, want := fmt.Sprint(Complex(1, 0x3c00)), "(6e-08+1i)"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestParseComplex32(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Complex32
+	}{
+		{"0", Complex(0, 0)},
+		{"(1+2i)", Complex(FromFloat64(1), FromFloat64(2))},
+		{"1-2i", Complex(FromFloat64(1), FromFloat64(-2))},
+		{"2i", Complex(0, FromFloat64(2))},
+		{"-0.5i", Complex(0, FromFloat64(-0.5))},
+		{"+Inf-Infi", Complex(Inf(1), Inf(-1))},
+		{"(0x1p-2+0x1p3i)", Complex(FromFloat64(0.25), FromFloat64(8))},
+		{"1_000+1e3i", Complex(FromFloat64(1000), FromFloat64(1000))},
+	}
+	for _, tt := range tests {
+		got, err := ParseComplex32(tt.in)
+		if err != nil {
+			t.Errorf("%s: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.in, tt.want, got)
+		}
+	}
+
+	nan, err := ParseComplex32("NaN+NaNi")
+	if err != nil || !nan.re.IsNaN() || !nan.im.IsNaN() {
+		t.Errorf("NaN+NaNi: unexpected result %v, %v", nan, err)
+	}
+
+	for _, in := range []string{"", "()", "i", "1+", "1++2i", "1+2", "1+2j", "(1+2i", "1i+2"} {
+		_, err := ParseComplex32(in)
+		if err == nil {
+			t.Errorf("%q: expected error, got nil", in)
+			continue
+		}
+		if e, ok := err.(*strconv.NumError); !ok || e.Err != strconv.ErrSyntax || e.Num != in {
+			t.Errorf("%q: unexpected error %v", in, err)
+		}
+		if _, err := strconv.ParseComplex(in, 128); err == nil {
+			t.Errorf("%q: strconv.ParseComplex accepts it", in)
+		}
+	}
+
+	got, err := ParseComplex32("1+1e10i")
+	if e, ok := err.(*strconv.NumError); !ok || e.Err != strconv.ErrRange {
+		t.Errorf("unexpected error %v", err)
+	}
+	if got != Complex(FromFloat64(1), Inf(1)) {
+		t.Errorf("unexpected result %v", got)
+	}
+}
+
+func TestParseComplex32_RoundTrip(t *testing.T) {
+	r := newXorshift32()
+	for i := 0; i < 1<<16; i++ {
+		a, b := r.Float16Pair()
+		c := Complex(a, b)
+		s := c.String()
+		got, err := ParseComplex32(s)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+			continue
+		}
+		if !(got == c || (c.re.IsNaN() && got.re.IsNaN()) || (c.im.IsNaN() && got.im.IsNaN())) {
+			t.Errorf("%s: expected %v, got %v", s, c, got)
+		}
+
+		// strconv.ParseComplex can parse it too.
+		z, err := strconv.ParseComplex(s, 128)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+			continue
+		}
+		if w := FromComplex128(z); !(w == c || cmplx.IsNaN(z) || math.IsNaN(real(z)) || math.IsNaN(imag(z))) {
+			t.Errorf("%s: strconv.ParseComplex returns %v", s, z)
+		}
+	}
+}
+
+func isZero(x Float16) bool {
+	return x&^signMask16 == 0
+}
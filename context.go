@@ -0,0 +1,382 @@
+package float16
+
+import "math"
+
+// A Context carries the rounding mode used by arithmetic, conversion and parsing,
+// and accumulates the exception flags raised by them.
+// The zero value rounds to nearest even,
+// which gives the same results as the methods of Float16.
+type Context struct {
+	// Mode is the rounding mode.
+	Mode RoundingMode
+
+	// Tininess determines when underflow is detected.
+	Tininess Tininess
+
+	// Flags is the set of exception flags raised so far.
+	// The flags are sticky; they are never cleared by the operations.
+	Flags Flags
+}
+
+// signal raises Invalid if f is a signaling NaN.
+func (c *Context) signal(f Float16) {
+	if f.IsSignalingNaN() {
+		c.Flags |= Invalid
+	}
+}
+
+// invalid raises Invalid and returns NaN.
+func (c *Context) invalid() Float16 {
+	c.Flags |= Invalid
+	return uvnan
+}
+
+// FromFloat32 returns the Float16 nearest to f in the direction of c.Mode.
+func (c *Context) FromFloat32(f float32) Float16 {
+	b := math.Float32bits(f)
+	sign := uint16((b & signMask32) >> (32 - 16))
+	exp := int((b >> shift32) & mask32)
+	frac := uint64(b & fracMask32)
+
+	if exp == mask32 {
+		// infinity or NaN
+		if frac != 0 && frac&(1<<(shift32-1)) == 0 {
+			// signaling NaN
+			c.Flags |= Invalid
+		}
+		return FromFloat32(f)
+	}
+	if exp == 0 {
+		// subnormal number
+		exp = 1
+	} else {
+		// normal number
+		frac |= 1 << shift32
+	}
+	return c.round(sign, exp-bias32-shift32, frac, false)
+}
+
+// FromFloat64 returns the Float16 nearest to f in the direction of c.Mode.
+func (c *Context) FromFloat64(f float64) Float16 {
+	b := math.Float64bits(f)
+	sign := uint16((b & signMask64) >> (64 - 16))
+	exp := int((b >> shift64) & mask64)
+	frac := b & fracMask64
+
+	if exp == mask64 {
+		// infinity or NaN
+		if frac != 0 && frac&(1<<(shift64-1)) == 0 {
+			// signaling NaN
+			c.Flags |= Invalid
+		}
+		return FromFloat64(f)
+	}
+	if exp == 0 {
+		// subnormal number
+		exp = 1
+	} else {
+		// normal number
+		frac |= 1 << shift64
+	}
+	return c.round(sign, exp-bias64-shift64, frac, false)
+}
+
+// Parse converts the string s to a Float16, rounding in the direction of c.Mode.
+// See [Parse] for the accepted syntax.
+// Like [Parse], it reports an error whose Err is [strconv.ErrRange]
+// if the rounded result is ±Inf or a non-zero value rounds to ±0.
+// A result rounded to the largest finite number, e.g. "1e10" with ToZero, is not an error.
+func (c *Context) Parse(s string) (Float16, error) {
+	return parse(s, c)
+}
+
+// Add returns the sum of a and b, rounded in the direction of c.Mode.
+func (c *Context) Add(a, b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		// anything + NaN = NaN
+		// NaN + anything = NaN
+		c.signal(a)
+		c.signal(b)
+		return propagateNaN(a, b)
+	}
+	if a.IsInf(0) {
+		if b == a^signMask16 {
+			// ±inf + ∓inf = NaN
+			return c.invalid()
+		}
+		return a // ±inf + anything = ±inf
+	}
+	if b.IsInf(0) {
+		return b // anything + ±inf = ±inf
+	}
+
+	fix := a.fix24() + b.fix24()
+	if fix == 0 {
+		if a&b&signMask16 != 0 {
+			// -0 + -0 = -0
+			return signMask16
+		}
+		if (a|b)&signMask16 == 0 {
+			// +0 + +0 = +0
+			return 0
+		}
+		// x + (-x) = +0, or -0 when rounding toward negative infinity.
+		return c.Mode.zero()
+	}
+
+	var sign uint16
+	if fix < 0 {
+		sign = signMask16
+		fix = -fix
+	}
+	return c.round(sign, -(bias16 + shift16 - 1), uint64(fix), false)
+}
+
+// Sub returns the difference of a and b, rounded in the direction of c.Mode.
+func (c *Context) Sub(a, b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		// keep the sign of NaN
+		c.signal(a)
+		c.signal(b)
+		return propagateNaN(a, b)
+	}
+	return c.Add(a, b^signMask16)
+}
+
+// Mul returns the product of a and b, rounded in the direction of c.Mode.
+func (c *Context) Mul(a, b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		// anything * NaN = NaN
+		// NaN * anything = NaN
+		c.signal(a)
+		c.signal(b)
+		return propagateNaN(a, b)
+	}
+
+	sign := (a ^ b) & signMask16
+	if a.IsInf(0) || b.IsInf(0) {
+		if a&^signMask16 == 0 || b&^signMask16 == 0 {
+			// ±inf * ±0 = NaN
+			return c.invalid()
+		}
+		return sign | uvinf
+	}
+	if a&^signMask16 == 0 || b&^signMask16 == 0 {
+		return sign
+	}
+
+	_, expA, fracA := a.split()
+	_, expB, fracB := b.split()
+	exp := int(expA+expB) - 2*shift16
+	return c.round(uint16(sign), exp, uint64(fracA)*uint64(fracB), false)
+}
+
+// Quo returns the quotient of a and b, rounded in the direction of c.Mode.
+func (c *Context) Quo(a, b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		// anything / NaN = NaN
+		// NaN / anything = NaN
+		c.signal(a)
+		c.signal(b)
+		return propagateNaN(a, b)
+	}
+
+	sign := (a ^ b) & signMask16
+	if b&^signMask16 == 0 {
+		// division by zero
+		if a&^signMask16 == 0 {
+			// ±0 / ±0 = NaN
+			return c.invalid()
+		}
+		if !a.IsInf(0) {
+			c.Flags |= DivByZero
+		}
+		return sign | uvinf
+	}
+	if a.IsInf(0) {
+		if b.IsInf(0) {
+			// ±inf / ±inf = NaN
+			return c.invalid()
+		}
+		return sign | uvinf
+	}
+	if b.IsInf(0) || a&^signMask16 == 0 {
+		return sign
+	}
+
+	// 40 extra bits are enough for the rounding bit and the guard bit.
+	_, expA, fracA := a.split()
+	_, expB, fracB := b.split()
+	n := uint64(fracA) << 40
+	q, r := n/uint64(fracB), n%uint64(fracB)
+	return c.round(uint16(sign), int(expA-expB)-40, q, r != 0)
+}
+
+// Sqrt returns the square root of x, rounded in the direction of c.Mode.
+//
+// Special cases are the same as [Float16.Sqrt].
+func (c *Context) Sqrt(x Float16) Float16 {
+	// special cases
+	switch {
+	case x.IsNaN():
+		c.signal(x)
+		return x.quiet()
+	case x&^signMask16 == 0 || x.IsInf(1):
+		return x
+	case x&signMask16 != 0:
+		return c.invalid()
+	}
+
+	_, exp, frac := x.split()
+	e := int(exp) - shift16
+	m := uint64(frac)
+	if e%2 != 0 {
+		// make the exponent even
+		m <<= 1
+		e--
+	}
+	m <<= 40
+	e -= 40
+	s := sqrt64(m)
+	return c.round(0, e/2, s, s*s != m)
+}
+
+// sqrt64 returns the integer square root of x.
+// x must be less than 2^53.
+func sqrt64(x uint64) uint64 {
+	s := uint64(math.Sqrt(float64(x)))
+	for s*s > x {
+		s--
+	}
+	for (s+1)*(s+1) <= x {
+		s++
+	}
+	return s
+}
+
+// FMA returns x * y + z, computed with only one rounding in the direction of c.Mode.
+func (c *Context) FMA(x, y, z Float16) Float16 {
+	if x.IsNaN() || y.IsNaN() {
+		c.signal(x)
+		c.signal(y)
+		c.signal(z)
+		return propagateNaN(x, y)
+	}
+
+	sign := uint16((x ^ y) & signMask16)
+	zeroXY := x&^signMask16 == 0 || y&^signMask16 == 0
+	if x.IsInf(0) || y.IsInf(0) {
+		if zeroXY {
+			// ±inf * ±0 = NaN
+			c.signal(z)
+			return c.invalid()
+		}
+		if z.IsNaN() {
+			c.signal(z)
+			return z.quiet()
+		}
+		if z.IsInf(0) && uint16(z&signMask16) != sign {
+			// ±inf - ±inf = NaN
+			return c.invalid()
+		}
+		return Float16(sign) | uvinf
+	}
+	if z.IsNaN() {
+		c.signal(z)
+		return z.quiet()
+	}
+	if z.IsInf(0) {
+		return z
+	}
+	if zeroXY {
+		if z&^signMask16 != 0 || uint16(z&signMask16) == sign {
+			return z
+		}
+		return c.Mode.zero()
+	}
+
+	_, expX, fracX := x.split()
+	_, expY, fracY := y.split()
+	m1 := uint64(fracX) * uint64(fracY)
+	e1 := int(expX+expY) - 2*shift16
+	if z&^signMask16 == 0 {
+		return c.round(sign, e1, m1, false)
+	}
+
+	_, expZ, fracZ := z.split()
+	m2 := uint64(fracZ)
+	e2 := int(expZ) - shift16
+	s1, s2 := sign, uint16(z&signMask16)
+
+	// align the operands; m1 × 2^e1 has the larger exponent.
+	if e1 < e2 {
+		m1, m2 = m2, m1
+		e1, e2 = e2, e1
+		s1, s2 = s2, s1
+	}
+	if e1-e2 > 40 {
+		// m2 is too small to affect anything but the sticky bit.
+		m2 = 1
+		e2 = e1 - 40
+	}
+	m1 <<= e1 - e2
+
+	var m uint64
+	var s uint16
+	switch {
+	case s1 == s2:
+		m, s = m1+m2, s1
+	case m1 >= m2:
+		m, s = m1-m2, s1
+	default:
+		m, s = m2-m1, s2
+	}
+	if m == 0 {
+		return c.Mode.zero()
+	}
+	return c.round(s, e2, m, false)
+}
+
+// Eq returns a == b.
+// It raises Invalid if a or b is a signaling NaN.
+func (c *Context) Eq(a, b Float16) bool {
+	c.signal(a)
+	c.signal(b)
+	return a.Eq(b)
+}
+
+// Ne returns a != b.
+// It raises Invalid if a or b is a signaling NaN.
+func (c *Context) Ne(a, b Float16) bool {
+	return !c.Eq(a, b)
+}
+
+// Lt returns a < b.
+// It raises Invalid if a or b is NaN.
+func (c *Context) Lt(a, b Float16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		c.Flags |= Invalid
+	}
+	return a.Lt(b)
+}
+
+// Le returns a <= b.
+// It raises Invalid if a or b is NaN.
+func (c *Context) Le(a, b Float16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		c.Flags |= Invalid
+	}
+	return a.Le(b)
+}
+
+// Gt returns a > b.
+// It raises Invalid if a or b is NaN.
+func (c *Context) Gt(a, b Float16) bool {
+	return c.Lt(b, a)
+}
+
+// Ge returns a >= b.
+// It raises Invalid if a or b is NaN.
+func (c *Context) Ge(a, b Float16) bool {
+	return c.Le(b, a)
+}
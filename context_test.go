@@ -0,0 +1,509 @@
+package float16
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+var roundingModes = []RoundingMode{
+	ToNearestEven,
+	ToNearestAway,
+	ToZero,
+	ToNegativeInf,
+	ToPositiveInf,
+}
+
+func (mode RoundingMode) big() big.RoundingMode {
+	switch mode {
+	case ToNearestEven:
+		return big.ToNearestEven
+	case ToNearestAway:
+		return big.ToNearestAway
+	case ToZero:
+		return big.ToZero
+	case ToNegativeInf:
+		return big.ToNegativeInf
+	case ToPositiveInf:
+		return big.ToPositiveInf
+	}
+	panic("unknown rounding mode")
+}
+
+// newBig returns a new big.Float that is large enough to hold
+// intermediate results of Float16 arithmetic exactly.
+func newBig(mode RoundingMode) *big.Float {
+	return new(big.Float).SetPrec(256).SetMode(mode.big())
+}
+
+// roundBig rounds x to Float16 in the direction of mode.
+// It is a straightforward implementation of rounding for testing.
+func roundBig(x *big.Float, mode RoundingMode) Float16 {
+	var sign Float16
+	if x.Signbit() {
+		sign = signMask16
+	}
+	if x.IsInf() {
+		return sign | uvinf
+	}
+	if x.Sign() == 0 {
+		return sign
+	}
+
+	abs := new(big.Float).Abs(x)
+	exp := abs.MantExp(nil) // abs = mant × 2^exp, 0.5 <= mant < 1
+	lsb := exp - 11
+	if lsb < -24 {
+		lsb = -24
+	}
+	scaled := new(big.Float).SetMantExp(abs, -lsb)
+	i, _ := scaled.Int(nil)
+	frac := new(big.Float).Sub(scaled, new(big.Float).SetInt(i))
+	cmpHalf := frac.Cmp(big.NewFloat(0.5))
+
+	var up bool
+	switch mode {
+	case ToNearestEven:
+		up = cmpHalf > 0 || cmpHalf == 0 && i.Bit(0) != 0
+	case ToNearestAway:
+		up = cmpHalf >= 0
+	case ToNegativeInf:
+		up = frac.Sign() != 0 && sign != 0
+	case ToPositiveInf:
+		up = frac.Sign() != 0 && sign == 0
+	}
+	if up {
+		i.Add(i, big.NewInt(1))
+	}
+
+	f, _ := new(big.Float).SetMantExp(new(big.Float).SetInt(i), lsb).Float64()
+	if f > 65504 {
+		if mode == ToZero || mode == ToNegativeInf && sign == 0 || mode == ToPositiveInf && sign != 0 {
+			return sign | uvmax
+		}
+		return sign | uvinf
+	}
+	return sign | FromFloat64(f)
+}
+
+func (f Float16) big(mode RoundingMode) *big.Float {
+	return newBig(mode).SetFloat64(f.Float64())
+}
+
+// sqrtBig returns the square root of x.
+// big.Float.Sqrt takes the rounding mode from x and may be off by an ulp
+// even if the square root is exact, so the calculation is done in ToNearestEven and
+// the result is rounded to 64 bits, which is much larger than
+// the precision of Float16 and much smaller than the precision of the calculation.
+func sqrtBig(x *big.Float) *big.Float {
+	x = newBig(ToNearestEven).Set(x)
+	return newBig(ToNearestEven).Sqrt(x).SetPrec(64)
+}
+
+func isFinite(f Float16) bool {
+	return !f.IsNaN() && !f.IsInf(0)
+}
+
+func contextSamples() int {
+	if testing.Short() {
+		return 1 << 12
+	}
+	return 1 << 18
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		want string
+	}{
+		{ToNearestEven, "ToNearestEven"},
+		{ToNearestAway, "ToNearestAway"},
+		{ToZero, "ToZero"},
+		{ToNegativeInf, "ToNegativeInf"},
+		{ToPositiveInf, "ToPositiveInf"},
+		{RoundingMode(42), "RoundingMode(42)"},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.String(); got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestContext_FromFloat64(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		f    float64
+		want Float16
+	}{
+		{ToNearestEven, 0x1.002p+00, 0x3c00},
+		{ToNearestAway, 0x1.002p+00, 0x3c01},
+		{ToZero, 0x1.002p+00, 0x3c00},
+		{ToNegativeInf, 0x1.002p+00, 0x3c00},
+		{ToPositiveInf, 0x1.002p+00, 0x3c01},
+
+		{ToNearestEven, -0x1.002p+00, 0xbc00},
+		{ToNearestAway, -0x1.002p+00, 0xbc01},
+		{ToZero, -0x1.002p+00, 0xbc00},
+		{ToNegativeInf, -0x1.002p+00, 0xbc01},
+		{ToPositiveInf, -0x1.002p+00, 0xbc00},
+
+		// overflow
+		{ToNearestEven, 0x1p+16, 0x7c00},
+		{ToNearestAway, 0x1p+16, 0x7c00},
+		{ToZero, 0x1p+16, 0x7bff},
+		{ToNegativeInf, 0x1p+16, 0x7bff},
+		{ToPositiveInf, 0x1p+16, 0x7c00},
+		{ToNegativeInf, -0x1p+16, 0xfc00},
+		{ToPositiveInf, -0x1p+16, 0xfbff},
+
+		// underflow
+		{ToNearestEven, 0x1p-100, 0x0000},
+		{ToZero, 0x1p-100, 0x0000},
+		{ToPositiveInf, 0x1p-100, 0x0001},
+		{ToNegativeInf, -0x1p-100, 0x8001},
+		{ToNearestAway, 0x1p-25, 0x0001},
+
+		// infinities and zeros are exact
+		{ToZero, math.Inf(1), 0x7c00},
+		{ToZero, math.Inf(-1), 0xfc00},
+		{ToNegativeInf, 0, 0x0000},
+		{ToPositiveInf, negZero, 0x8000},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		if got := c.FromFloat64(tt.f); got != tt.want {
+			t.Errorf("%v: %x: expected %04x, got %04x", tt.mode, tt.f, tt.want, got)
+		}
+	}
+
+	x := newXorshift64()
+	for _, mode := range roundingModes {
+		c := &Context{Mode: mode}
+		for i := 0; i < contextSamples(); i++ {
+			// limit the exponent to around the range of Float16.
+			b := x.Uint64()
+			exp := (b>>shift64)%64 + bias64 - 40
+			f := math.Float64frombits(b&(signMask64|fracMask64) | exp<<shift64)
+
+			got := c.FromFloat64(f)
+			want := roundBig(big.NewFloat(f), mode)
+			if got != want {
+				t.Errorf("%v: %x: expected %04x, got %04x", mode, f, want, got)
+			}
+		}
+	}
+}
+
+func TestContext_FromFloat32(t *testing.T) {
+	x := newXorshift32()
+	for _, mode := range roundingModes {
+		c := &Context{Mode: mode}
+		for i := 0; i < contextSamples(); i++ {
+			f := x.Float32()
+			if math.IsNaN(float64(f)) {
+				continue
+			}
+
+			got := c.FromFloat32(f)
+			want := roundBig(big.NewFloat(float64(f)), mode)
+			if got != want {
+				t.Errorf("%v: %x: expected %04x, got %04x", mode, f, want, got)
+			}
+		}
+	}
+}
+
+func TestContext_ToNearestEven(t *testing.T) {
+	var c Context
+
+	for bits := int64(0); bits < 1<<32; bits += 0x10001 {
+		f := math.Float32frombits(uint32(bits))
+		if got, want := c.FromFloat32(f), FromFloat32(f); got != want {
+			t.Errorf("%x: expected %04x, got %04x", f, want, got)
+		}
+	}
+
+	canonical := func(f Float16) uint16 {
+		if f.IsNaN() {
+			return uvnan
+		}
+		return f.Bits()
+	}
+	checkEqual(t, func(a, b uint16) uint16 {
+		return canonical(c.Add(Float16(a), Float16(b)))
+	}, func(a, b uint16) uint16 {
+		return canonical(Float16(a).Add(Float16(b)))
+	}, "+")
+	checkEqual(t, func(a, b uint16) uint16 {
+		return canonical(c.Mul(Float16(a), Float16(b)))
+	}, func(a, b uint16) uint16 {
+		return canonical(Float16(a).Mul(Float16(b)))
+	}, "*")
+	checkEqual(t, func(a, b uint16) uint16 {
+		return canonical(c.Quo(Float16(a), Float16(b)))
+	}, func(a, b uint16) uint16 {
+		return canonical(Float16(a).Quo(Float16(b)))
+	}, "/")
+
+	for bits := 0; bits < 1<<16; bits++ {
+		f := Float16(bits)
+		if got, want := canonical(c.Sqrt(f)), canonical(f.Sqrt()); got != want {
+			t.Errorf("sqrt(%04x): expected %04x, got %04x", bits, want, got)
+		}
+	}
+}
+
+func TestContext_Arithmetic(t *testing.T) {
+	x := newXorshift32()
+	for _, mode := range roundingModes {
+		c := &Context{Mode: mode}
+		for i := 0; i < contextSamples(); i++ {
+			a, b := x.Float16Pair()
+			if !isFinite(a) || !isFinite(b) {
+				continue
+			}
+
+			got := c.Add(a, b)
+			want := roundBig(newBig(mode).Add(a.big(mode), b.big(mode)), mode)
+			if got != want {
+				t.Errorf("%v: %04x + %04x: expected %04x, got %04x", mode, a, b, want, got)
+			}
+
+			got = c.Sub(a, b)
+			want = roundBig(newBig(mode).Sub(a.big(mode), b.big(mode)), mode)
+			if got != want {
+				t.Errorf("%v: %04x - %04x: expected %04x, got %04x", mode, a, b, want, got)
+			}
+
+			got = c.Mul(a, b)
+			want = roundBig(newBig(mode).Mul(a.big(mode), b.big(mode)), mode)
+			if got != want {
+				t.Errorf("%v: %04x * %04x: expected %04x, got %04x", mode, a, b, want, got)
+			}
+
+			if b&^signMask16 != 0 {
+				got = c.Quo(a, b)
+				want = roundBig(newBig(mode).Quo(a.big(mode), b.big(mode)), mode)
+				if got != want {
+					t.Errorf("%v: %04x / %04x: expected %04x, got %04x", mode, a, b, want, got)
+				}
+			}
+
+			z, _ := x.Float16Pair()
+			if isFinite(z) {
+				got = c.FMA(a, b, z)
+				want = roundBig(newBig(mode).Add(newBig(mode).Mul(a.big(mode), b.big(mode)), z.big(mode)), mode)
+				if got != want {
+					t.Errorf("%v: %04x * %04x + %04x: expected %04x, got %04x", mode, a, b, z, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestContext_Sqrt(t *testing.T) {
+	for _, mode := range roundingModes {
+		c := &Context{Mode: mode}
+		for bits := 0x0001; bits < uvinf; bits++ {
+			f := Float16(bits)
+			got := c.Sqrt(f)
+			want := roundBig(sqrtBig(f.big(mode)), mode)
+			if got != want {
+				t.Errorf("%v: sqrt(%04x): expected %04x, got %04x", mode, f, want, got)
+			}
+		}
+	}
+}
+
+func TestContext_Specials(t *testing.T) {
+	inf := Float16(uvinf)
+	ninf := Float16(uvneginf)
+	nan := Float16(uvnan)
+	one := Float16(uvone)
+	zero := Float16(0)
+	nzero := Float16(signMask16)
+
+	tests := []struct {
+		name string
+		f    func(c *Context) Float16
+		want Float16
+		rtn  Float16 // result with ToNegativeInf
+	}{
+		{"1 - 1", func(c *Context) Float16 { return c.Sub(one, one) }, zero, nzero},
+		{"-0 + -0", func(c *Context) Float16 { return c.Add(nzero, nzero) }, nzero, nzero},
+		{"+0 + +0", func(c *Context) Float16 { return c.Add(zero, zero) }, zero, zero},
+		{"+0 + -0", func(c *Context) Float16 { return c.Add(zero, nzero) }, zero, nzero},
+		{"inf + 1", func(c *Context) Float16 { return c.Add(inf, one) }, inf, inf},
+		{"inf - inf", func(c *Context) Float16 { return c.Sub(inf, inf) }, nan, nan},
+		{"inf * 0", func(c *Context) Float16 { return c.Mul(inf, zero) }, nan, nan},
+		{"-inf * 1", func(c *Context) Float16 { return c.Mul(ninf, one) }, ninf, ninf},
+		{"1 / 0", func(c *Context) Float16 { return c.Quo(one, zero) }, inf, inf},
+		{"1 / -0", func(c *Context) Float16 { return c.Quo(one, nzero) }, ninf, ninf},
+		{"0 / 0", func(c *Context) Float16 { return c.Quo(zero, zero) }, nan, nan},
+		{"inf / inf", func(c *Context) Float16 { return c.Quo(inf, inf) }, nan, nan},
+		{"1 / inf", func(c *Context) Float16 { return c.Quo(one, inf) }, zero, zero},
+		{"sqrt(-1)", func(c *Context) Float16 { return c.Sqrt(one | signMask16) }, nan, nan},
+		{"sqrt(-0)", func(c *Context) Float16 { return c.Sqrt(nzero) }, nzero, nzero},
+		{"sqrt(inf)", func(c *Context) Float16 { return c.Sqrt(inf) }, inf, inf},
+		{"fma(inf, 0, 1)", func(c *Context) Float16 { return c.FMA(inf, zero, one) }, nan, nan},
+		{"fma(inf, 1, -inf)", func(c *Context) Float16 { return c.FMA(inf, one, ninf) }, nan, nan},
+		{"fma(1, 1, -1)", func(c *Context) Float16 { return c.FMA(one, one, one|signMask16) }, zero, nzero},
+		{"fma(0, 1, -0)", func(c *Context) Float16 { return c.FMA(zero, one, nzero) }, zero, nzero},
+		{"fma(-0, 1, -0)", func(c *Context) Float16 { return c.FMA(nzero, one, nzero) }, nzero, nzero},
+		{"fma(0, 1, 1)", func(c *Context) Float16 { return c.FMA(zero, one, one) }, one, one},
+	}
+	for _, tt := range tests {
+		for _, mode := range roundingModes {
+			c := &Context{Mode: mode}
+			want := tt.want
+			if mode == ToNegativeInf {
+				want = tt.rtn
+			}
+			got := tt.f(c)
+			if got.IsNaN() && want.IsNaN() {
+				continue
+			}
+			if got != want {
+				t.Errorf("%v: %s: expected %04x, got %04x", mode, tt.name, want, got)
+			}
+		}
+	}
+}
+
+func TestContext_Parse(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		s    string
+		want Float16
+		err  error
+	}{
+		{ToNearestEven, "1.00048828125", 0x3c00, nil},
+		{ToNearestAway, "1.00048828125", 0x3c01, nil},
+		{ToZero, "1.0009765624", 0x3c00, nil},
+		{ToPositiveInf, "1.0000000001", 0x3c01, nil},
+		{ToNegativeInf, "-1.0000000001", 0xbc01, nil},
+		{ToPositiveInf, "1e-30", 0x0001, nil},
+		{ToZero, "1e-30", 0x0000, strconv.ErrRange},
+		{ToZero, "1e10", 0x7bff, nil},
+		{ToNearestEven, "1e10", 0x7c00, strconv.ErrRange},
+		{ToNegativeInf, "-1e10", 0xfc00, strconv.ErrRange},
+		{ToPositiveInf, "-1e10", 0xfbff, nil},
+		{ToZero, "0x1.ffffp+15", 0x7bff, nil},
+		{ToPositiveInf, "0x1.ffc1p+15", 0x7c00, strconv.ErrRange},
+		{ToPositiveInf, "0x1p-100", 0x0001, nil},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got, err := c.Parse(tt.s)
+		if got != tt.want {
+			t.Errorf("%v: %q: expected %04x, got %04x", tt.mode, tt.s, tt.want, got)
+		}
+		if tt.err == nil && err != nil {
+			t.Errorf("%v: %q: unexpected error: %v", tt.mode, tt.s, err)
+		}
+		if tt.err != nil && (err == nil || err.(*strconv.NumError).Err != tt.err) {
+			t.Errorf("%v: %q: expected error %v, got %v", tt.mode, tt.s, tt.err, err)
+		}
+	}
+
+	x := newXorshift64()
+	for _, mode := range roundingModes {
+		c := &Context{Mode: mode}
+		for i := 0; i < contextSamples(); i++ {
+			b := x.Uint64()
+			exp := (b>>shift64)%64 + bias64 - 40
+			f := math.Float64frombits(b&(signMask64|fracMask64) | exp<<shift64)
+			prec := int(b>>shift64>>6) % 20
+
+			for _, s := range []string{
+				strconv.FormatFloat(f, 'e', prec, 64),
+				strconv.FormatFloat(f, 'x', prec, 64),
+			} {
+				want, _, err := big.ParseFloat(s, 0, 256, mode.big())
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, _ := c.Parse(s)
+				if got != roundBig(want, mode) {
+					t.Errorf("%v: %q: expected %04x, got %04x", mode, s, roundBig(want, mode), got)
+				}
+			}
+		}
+	}
+}
+
+func TestContext_Flags(t *testing.T) {
+	one := Float16(uvone)
+	snan := Float16(0x7c01)
+
+	tests := []struct {
+		name     string
+		tininess Tininess
+		f        func(c *Context) Float16
+		want     Flags
+	}{
+		{"1 + 1", AfterRounding, func(c *Context) Float16 { return c.Add(one, one) }, 0},
+		{"1 / 3", AfterRounding, func(c *Context) Float16 { return c.Quo(one, 0x4200) }, Inexact},
+		{"1 / 0", AfterRounding, func(c *Context) Float16 { return c.Quo(one, 0) }, DivByZero},
+		{"inf / 0", AfterRounding, func(c *Context) Float16 { return c.Quo(uvinf, 0) }, 0},
+		{"0 / 0", AfterRounding, func(c *Context) Float16 { return c.Quo(0, 0) }, Invalid},
+		{"sqrt(-1)", AfterRounding, func(c *Context) Float16 { return c.Sqrt(one | signMask16) }, Invalid},
+		{"sNaN + 1", AfterRounding, func(c *Context) Float16 { return c.Add(snan, one) }, Invalid},
+		{"qNaN + 1", AfterRounding, func(c *Context) Float16 { return c.Add(uvnan, one) }, 0},
+		{"max + max", AfterRounding, func(c *Context) Float16 { return c.Add(uvmax, uvmax) }, Overflow | Inexact},
+		{"fma(inf, 0, qNaN)", AfterRounding, func(c *Context) Float16 { return c.FMA(uvinf, 0, uvnan) }, Invalid},
+		{"fma(1, 1, sNaN)", AfterRounding, func(c *Context) Float16 { return c.FMA(one, one, snan) }, Invalid},
+
+		// the smallest subnormal number is exact; no underflow.
+		{"0x1p-14 * 0x1p-10", AfterRounding, func(c *Context) Float16 { return c.Mul(0x0400, 0x1400) }, 0},
+		{"0x1p-24 / 2", AfterRounding, func(c *Context) Float16 { return c.Quo(0x0001, 0x4000) }, Inexact | Underflow},
+
+		// 0x1.004p-01 * 0x1.ff8p-14 = 0x1.ffffep-15 rounds to 0x1p-14.
+		// It is tiny before rounding, but not tiny after rounding.
+		{"0x1.004p-01 * 0x1.ff8p-14", AfterRounding, func(c *Context) Float16 { return c.Mul(0x3801, 0x07fe) }, Inexact},
+		{"0x1.004p-01 * 0x1.ff8p-14", BeforeRounding, func(c *Context) Float16 { return c.Mul(0x3801, 0x07fe) }, Inexact | Underflow},
+	}
+	for _, tt := range tests {
+		c := &Context{Tininess: tt.tininess}
+		tt.f(c)
+		if c.Flags != tt.want {
+			t.Errorf("%s (%v): expected %v, got %v", tt.name, tt.tininess, tt.want, c.Flags)
+		}
+	}
+
+	// flags are sticky
+	var c Context
+	c.Quo(one, 0)
+	c.Quo(one, 0x4200)
+	if c.Flags != DivByZero|Inexact {
+		t.Errorf("expected %v, got %v", DivByZero|Inexact, c.Flags)
+	}
+
+	// parsing raises flags, too.
+	c = Context{}
+	c.Parse("0.1")
+	c.Parse("1e10")
+	if c.Flags != Inexact|Overflow {
+		t.Errorf("expected %v, got %v", Inexact|Overflow, c.Flags)
+	}
+}
+
+func TestFlags_String(t *testing.T) {
+	tests := []struct {
+		flags Flags
+		want  string
+	}{
+		{0, "0"},
+		{Inexact, "Inexact"},
+		{Inexact | Underflow, "Inexact|Underflow"},
+		{Overflow | DivByZero | Invalid, "Overflow|DivByZero|Invalid"},
+		{Invalid | 0x80, "Invalid|0x80"},
+	}
+	for _, tt := range tests {
+		if got := tt.flags.String(); got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
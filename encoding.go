@@ -0,0 +1,170 @@
+package float16
+
+import (
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+var (
+	_ encoding.TextMarshaler     = Float16(0)
+	_ encoding.TextUnmarshaler   = (*Float16)(nil)
+	_ json.Marshaler             = Float16(0)
+	_ json.Unmarshaler           = (*Float16)(nil)
+	_ encoding.BinaryMarshaler   = Float16(0)
+	_ encoding.BinaryUnmarshaler = (*Float16)(nil)
+	_ gob.GobEncoder             = Float16(0)
+	_ gob.GobDecoder             = (*Float16)(nil)
+
+	_ json.Marshaler   = NonFiniteString(0)
+	_ json.Unmarshaler = (*NonFiniteString)(nil)
+	_ json.Marshaler   = NonFiniteNull(0)
+	_ json.Unmarshaler = (*NonFiniteNull)(nil)
+)
+
+// MarshalText implements [encoding.TextMarshaler].
+// It formats x in the same way as [Float16.String].
+func (x Float16) MarshalText() ([]byte, error) {
+	return x.Append(make([]byte, 0, 8), 'g', -1), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+// It accepts the same syntax as [Parse].
+func (x *Float16) UnmarshalText(text []byte) error {
+	f, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*x = f
+	return nil
+}
+
+// MarshalJSON implements [json.Marshaler].
+// It encodes x as the shortest decimal number that rounds to x.
+// JSON has no representation of NaN and infinities,
+// so MarshalJSON fails for them, as encoding/json does for float32 and float64.
+// Use [NonFiniteString] or [NonFiniteNull] to encode them.
+func (x Float16) MarshalJSON() ([]byte, error) {
+	if x.IsNaN() || x.IsInf(0) {
+		return nil, &json.UnsupportedValueError{
+			Value: reflect.ValueOf(x),
+			Str:   x.String(),
+		}
+	}
+	return x.MarshalText()
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// It accepts a JSON number, or a JSON string in the syntax of [Parse],
+// such as "NaN", "+Inf" and "-Inf".
+// As with the other types, null is a no-op.
+func (x *Float16) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		var err error
+		s, err = strconv.Unquote(s)
+		if err != nil {
+			return errors.New("float16: invalid JSON string " + string(data))
+		}
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// NonFiniteString is a Float16 that encodes NaN and infinities in JSON
+// as the strings "NaN", "+Inf" and "-Inf".
+// It is useful for the fields of structs, e.g.:
+//
+//	type Config struct {
+//		Scale float16.NonFiniteString `json:"scale"`
+//	}
+//
+// Convert it to Float16 for the arithmetic.
+type NonFiniteString Float16
+
+// String returns the same string as [Float16.String].
+func (x NonFiniteString) String() string {
+	return Float16(x).String()
+}
+
+// MarshalJSON implements [json.Marshaler].
+// It encodes the finite numbers in the same way as [Float16.MarshalJSON].
+func (x NonFiniteString) MarshalJSON() ([]byte, error) {
+	f := Float16(x)
+	if f.IsNaN() || f.IsInf(0) {
+		buf := append(make([]byte, 0, 8), '"')
+		buf = f.Append(buf, 'g', -1)
+		return append(buf, '"'), nil
+	}
+	return f.MarshalText()
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// It accepts the same input as [Float16.UnmarshalJSON].
+func (x *NonFiniteString) UnmarshalJSON(data []byte) error {
+	return (*Float16)(x).UnmarshalJSON(data)
+}
+
+// NonFiniteNull is a Float16 that encodes NaN and infinities in JSON as null.
+// As with the other types, decoding null is a no-op,
+// so they don't round-trip.
+type NonFiniteNull Float16
+
+// String returns the same string as [Float16.String].
+func (x NonFiniteNull) String() string {
+	return Float16(x).String()
+}
+
+// MarshalJSON implements [json.Marshaler].
+// It encodes the finite numbers in the same way as [Float16.MarshalJSON].
+func (x NonFiniteNull) MarshalJSON() ([]byte, error) {
+	f := Float16(x)
+	if f.IsNaN() || f.IsInf(0) {
+		return []byte("null"), nil
+	}
+	return f.MarshalText()
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// It accepts the same input as [Float16.UnmarshalJSON].
+func (x *NonFiniteNull) UnmarshalJSON(data []byte) error {
+	return (*Float16)(x).UnmarshalJSON(data)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// x is encoded as its IEEE 754 binary representation in big-endian byte order.
+func (x Float16) MarshalBinary() ([]byte, error) {
+	return x.AppendBinary(make([]byte, 0, 2))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+// It appends the same encoding as [Float16.MarshalBinary] to b.
+func (x Float16) AppendBinary(b []byte) ([]byte, error) {
+	return AppendBigEndian(b, x), nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// data must be the 2 bytes encoded by [Float16.MarshalBinary].
+func (x *Float16) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return errors.New("float16: invalid length of binary data " + strconv.Itoa(len(data)))
+	}
+	*x = BigEndian(data)
+	return nil
+}
+
+// GobEncode implements [gob.GobEncoder].
+// It returns the same encoding as [Float16.MarshalBinary].
+func (x Float16) GobEncode() ([]byte, error) {
+	return x.MarshalBinary()
+}
+
+// GobDecode implements [gob.GobDecoder].
+func (x *Float16) GobDecode(data []byte) error {
+	return x.UnmarshalBinary(data)
+}
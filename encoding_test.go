@@ -0,0 +1,274 @@
+package float16
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMarshalText_RoundTrip(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		text, err := x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var y Float16
+		if err := y.UnmarshalText(text); err != nil {
+			t.Errorf("%04x: %v", i, err)
+			continue
+		}
+		if y != x && !(x.IsNaN() && y.IsNaN()) {
+			t.Errorf("%04x: %s is decoded as %04x", i, text, y)
+		}
+	}
+}
+
+func TestUnmarshalText_Invalid(t *testing.T) {
+	x := FromFloat64(1.5)
+	if err := x.UnmarshalText([]byte("foo")); err == nil {
+		t.Error("expected error, got nil")
+	}
+	if x != FromFloat64(1.5) {
+		t.Errorf("the value is modified: %v", x)
+	}
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		if x.IsNaN() || x.IsInf(0) {
+			continue
+		}
+		data, err := json.Marshal(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !json.Valid(data) {
+			t.Errorf("%04x: invalid JSON %s", i, data)
+		}
+		var y Float16
+		if err := json.Unmarshal(data, &y); err != nil {
+			t.Errorf("%04x: %v", i, err)
+			continue
+		}
+		if y != x {
+			t.Errorf("%04x: %s is decoded as %04x", i, data, y)
+		}
+
+		// other decoders that know only float64 also get x.
+		var f float64
+		if err := json.Unmarshal(data, &f); err != nil {
+			t.Errorf("%04x: %v", i, err)
+		}
+		if FromFloat64(f) != x {
+			t.Errorf("%04x: %s is decoded as %v", i, data, f)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	type S struct {
+		A Float16            `json:"a"`
+		B []Float16          `json:"b"`
+		M map[Float16]string `json:"m"`
+	}
+	s := S{
+		A: FromFloat64(0.1),
+		B: []Float16{FromFloat64(1), FromFloat64(-2.5), FromFloat64(0x1p-24), FromFloat64(65504)},
+		M: map[Float16]string{FromFloat64(1.5): "x"},
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"a":0.1,"b":[1,-2.5,6e-08,65504],"m":{"1.5":"x"}}`
+	if string(data) != want {
+		t.Errorf("expected %s, got %s", want, data)
+	}
+
+	var got S
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.A != s.A || len(got.B) != len(s.B) || got.M[FromFloat64(1.5)] != "x" {
+		t.Errorf("expected %v, got %v", s, got)
+	}
+}
+
+func TestMarshalJSON_NonFinite(t *testing.T) {
+	values := []Float16{NaN(), Inf(1), Inf(-1)}
+	for _, x := range values {
+		_, err := json.Marshal(x)
+		var e *json.UnsupportedValueError
+		if !errors.As(err, &e) {
+			t.Errorf("%v: expected UnsupportedValueError, got %v", x, err)
+		}
+	}
+
+	tests := []struct {
+		x    Float16
+		str  string
+		null string
+	}{
+		{NaN(), `"NaN"`, `null`},
+		{Inf(1), `"+Inf"`, `null`},
+		{Inf(-1), `"-Inf"`, `null`},
+		{FromFloat64(0.1), `0.1`, `0.1`},
+		{signMask16, `-0`, `-0`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(NonFiniteString(tt.x))
+		if err != nil {
+			t.Errorf("NonFiniteString(%v): %v", tt.x, err)
+		} else if string(data) != tt.str {
+			t.Errorf("NonFiniteString(%v): expected %s, got %s", tt.x, tt.str, data)
+		}
+
+		data, err = json.Marshal(NonFiniteNull(tt.x))
+		if err != nil {
+			t.Errorf("NonFiniteNull(%v): %v", tt.x, err)
+		} else if string(data) != tt.null {
+			t.Errorf("NonFiniteNull(%v): expected %s, got %s", tt.x, tt.null, data)
+		}
+	}
+
+	// the policy is per value, so the types can be mixed.
+	s := struct {
+		A Float16         `json:"a"`
+		B NonFiniteString `json:"b"`
+		C NonFiniteNull   `json:"c"`
+	}{FromFloat64(1.5), NonFiniteString(Inf(1)), NonFiniteNull(NaN())}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"a":1.5,"b":"+Inf","c":null}`; string(data) != want {
+		t.Errorf("expected %s, got %s", want, data)
+	}
+}
+
+func TestUnmarshalJSON_NonFinite(t *testing.T) {
+	var s struct {
+		B NonFiniteString `json:"b"`
+		C NonFiniteNull   `json:"c"`
+	}
+	s.C = NonFiniteNull(uvone)
+	if err := json.Unmarshal([]byte(`{"b":"-Inf","c":null}`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if Float16(s.B) != uvneginf {
+		t.Errorf("expected -Inf, got %v", s.B)
+	}
+	if Float16(s.C) != uvone {
+		t.Errorf("null: the value is modified: %v", s.C)
+	}
+	if got := s.B.String(); got != "-Inf" {
+		t.Errorf("expected -Inf, got %s", got)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Float16
+	}{
+		{`1`, uvone},
+		{`-0`, signMask16},
+		{`1e-3`, FromFloat64(1e-3)},
+		{`"1.5"`, FromFloat64(1.5)},
+		{`"NaN"`, uvnan},
+		{`"+Inf"`, uvinf},
+		{`"-Inf"`, uvneginf},
+		{`"inf"`, uvinf},
+	}
+	for _, tt := range tests {
+		var got Float16
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("%s: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %04x, got %04x", tt.in, tt.want, got)
+		}
+	}
+
+	// null is a no-op.
+	x := FromFloat64(1.5)
+	if err := json.Unmarshal([]byte(`null`), &x); err != nil {
+		t.Fatal(err)
+	}
+	if x != FromFloat64(1.5) {
+		t.Errorf("null: the value is modified: %v", x)
+	}
+
+	for _, in := range []string{`"foo"`, `true`, `"1.5`, `{}`, `1e10`} {
+		var x Float16
+		if err := x.UnmarshalJSON([]byte(in)); err == nil {
+			t.Errorf("%s: expected error, got nil", in)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		data, err := x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []byte{byte(i >> 8), byte(i)}; !bytes.Equal(data, want) {
+			t.Errorf("%04x: expected %x, got %x", i, want, data)
+		}
+		var y Float16
+		if err := y.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if y != x {
+			t.Errorf("%04x: %x is decoded as %04x", i, data, y)
+		}
+	}
+
+	for _, data := range [][]byte{nil, {0x3c}, {0x3c, 0x00, 0x00}} {
+		var x Float16
+		if err := x.UnmarshalBinary(data); err == nil {
+			t.Errorf("%x: expected error, got nil", data)
+		}
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	buf := []byte("prefix")
+	buf, err := Float16(0x3c01).AppendBinary(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "prefix\x3c\x01"; string(buf) != want {
+		t.Errorf("expected %q, got %q", want, buf)
+	}
+}
+
+func TestGob(t *testing.T) {
+	type S struct {
+		A Float16
+		B []Float16
+	}
+	want := S{
+		A: FromFloat64(0.1),
+		B: []Float16{NaN(), Inf(-1), signMask16, 1},
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+	var got S
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.A != want.A || !slices.Equal(got.B, want.B) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
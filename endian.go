@@ -0,0 +1,90 @@
+package float16
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// PutLittleEndian stores x into b[0:2] in little-endian byte order.
+// It panics if len(b) < 2.
+func PutLittleEndian(b []byte, x Float16) {
+	binary.LittleEndian.PutUint16(b, uint16(x))
+}
+
+// LittleEndian returns the Float16 stored in b[0:2] in little-endian byte order.
+// It panics if len(b) < 2.
+func LittleEndian(b []byte) Float16 {
+	return Float16(binary.LittleEndian.Uint16(b))
+}
+
+// AppendLittleEndian appends x to b in little-endian byte order, and returns the extended buffer.
+func AppendLittleEndian(b []byte, x Float16) []byte {
+	return binary.LittleEndian.AppendUint16(b, uint16(x))
+}
+
+// PutBigEndian stores x into b[0:2] in big-endian byte order.
+// It panics if len(b) < 2.
+func PutBigEndian(b []byte, x Float16) {
+	binary.BigEndian.PutUint16(b, uint16(x))
+}
+
+// BigEndian returns the Float16 stored in b[0:2] in big-endian byte order.
+// It panics if len(b) < 2.
+func BigEndian(b []byte) Float16 {
+	return Float16(binary.BigEndian.Uint16(b))
+}
+
+// AppendBigEndian appends x to b in big-endian byte order, and returns the extended buffer.
+func AppendBigEndian(b []byte, x Float16) []byte {
+	return binary.BigEndian.AppendUint16(b, uint16(x))
+}
+
+// EncodeLittleEndian appends the elements of s to b in little-endian byte order,
+// and returns the extended buffer.
+// It grows b at most once.
+func EncodeLittleEndian(b []byte, s []Float16) []byte {
+	b = slices.Grow(b, 2*len(s))
+	for _, x := range s {
+		b = binary.LittleEndian.AppendUint16(b, uint16(x))
+	}
+	return b
+}
+
+// DecodeLittleEndian appends the values stored in b in little-endian byte order to s,
+// and returns the extended slice.
+// It grows s at most once, and panics if len(b) is odd.
+func DecodeLittleEndian(s []Float16, b []byte) []Float16 {
+	if len(b)%2 != 0 {
+		panic("float16: odd length of bytes")
+	}
+	s = slices.Grow(s, len(b)/2)
+	for i := 0; i < len(b); i += 2 {
+		s = append(s, Float16(binary.LittleEndian.Uint16(b[i:])))
+	}
+	return s
+}
+
+// EncodeBigEndian appends the elements of s to b in big-endian byte order,
+// and returns the extended buffer.
+// It grows b at most once.
+func EncodeBigEndian(b []byte, s []Float16) []byte {
+	b = slices.Grow(b, 2*len(s))
+	for _, x := range s {
+		b = binary.BigEndian.AppendUint16(b, uint16(x))
+	}
+	return b
+}
+
+// DecodeBigEndian appends the values stored in b in big-endian byte order to s,
+// and returns the extended slice.
+// It grows s at most once, and panics if len(b) is odd.
+func DecodeBigEndian(s []Float16, b []byte) []Float16 {
+	if len(b)%2 != 0 {
+		panic("float16: odd length of bytes")
+	}
+	s = slices.Grow(s, len(b)/2)
+	for i := 0; i < len(b); i += 2 {
+		s = append(s, Float16(binary.BigEndian.Uint16(b[i:])))
+	}
+	return s
+}
@@ -0,0 +1,81 @@
+package float16
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestEndian(t *testing.T) {
+	x := Float16(0x3c01)
+
+	b := make([]byte, 2)
+	PutLittleEndian(b, x)
+	if want := []byte{0x01, 0x3c}; !bytes.Equal(b, want) {
+		t.Errorf("PutLittleEndian: expected %x, got %x", want, b)
+	}
+	if got := LittleEndian(b); got != x {
+		t.Errorf("LittleEndian: expected %04x, got %04x", x, got)
+	}
+	if got := AppendLittleEndian([]byte{0xff}, x); !bytes.Equal(got, []byte{0xff, 0x01, 0x3c}) {
+		t.Errorf("AppendLittleEndian: unexpected %x", got)
+	}
+
+	PutBigEndian(b, x)
+	if want := []byte{0x3c, 0x01}; !bytes.Equal(b, want) {
+		t.Errorf("PutBigEndian: expected %x, got %x", want, b)
+	}
+	if got := BigEndian(b); got != x {
+		t.Errorf("BigEndian: expected %04x, got %04x", x, got)
+	}
+	if got := AppendBigEndian([]byte{0xff}, x); !bytes.Equal(got, []byte{0xff, 0x3c, 0x01}) {
+		t.Errorf("AppendBigEndian: unexpected %x", got)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	s := []Float16{0x3c00, 0x0001, 0xfc00, 0x7e00}
+	tests := []struct {
+		name   string
+		encode func(b []byte, s []Float16) []byte
+		decode func(s []Float16, b []byte) []Float16
+		want   []byte
+	}{
+		{"LittleEndian", EncodeLittleEndian, DecodeLittleEndian, []byte{0xaa, 0x00, 0x3c, 0x01, 0x00, 0x00, 0xfc, 0x00, 0x7e}},
+		{"BigEndian", EncodeBigEndian, DecodeBigEndian, []byte{0xaa, 0x3c, 0x00, 0x00, 0x01, 0xfc, 0x00, 0x7e, 0x00}},
+	}
+	for _, tt := range tests {
+		b := tt.encode([]byte{0xaa}, s)
+		if !bytes.Equal(b, tt.want) {
+			t.Errorf("Encode%s: expected %x, got %x", tt.name, tt.want, b)
+		}
+		got := tt.decode([]Float16{1}, b[1:])
+		if !slices.Equal(got, append([]Float16{1}, s...)) {
+			t.Errorf("Decode%s: expected %v, got %v", tt.name, s, got)
+		}
+	}
+}
+
+func TestEncodeDecode_Allocs(t *testing.T) {
+	s := make([]Float16, 1024)
+	b := make([]byte, 0, 2*len(s))
+	d := make([]Float16, 0, len(s))
+	allocs := testing.AllocsPerRun(10, func() {
+		b = EncodeLittleEndian(b[:0], s)
+		d = DecodeLittleEndian(d[:0], b)
+		b = EncodeBigEndian(b[:0], s)
+		d = DecodeBigEndian(d[:0], b)
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocations, got %v", allocs)
+	}
+}
+
+func TestDecode_OddLength(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	DecodeLittleEndian(nil, []byte{0x00, 0x3c, 0x00})
+}
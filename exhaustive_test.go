@@ -0,0 +1,399 @@
+package float16
+
+import (
+	"flag"
+	"math"
+	"math/big"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// The exhaustive tests check all the 2^32 pairs of the operands of the binary operations
+// against the results computed by math/big in every rounding mode.
+// They take a long time, so they run only with the -long flag:
+//
+//	$ go test -run Exhaustive -long -timeout 0
+//
+// Otherwise they check one random pair per the first operand.
+var long = flag.Bool("long", false, "check all pairs of the operands in the exhaustive tests")
+
+// bigOracle computes the results of the operations exactly with math/big,
+// and rounds them to Float16.
+// It doesn't depend on the arithmetic of the float16 package.
+// It isn't safe for concurrent use, so each goroutine has its own.
+type bigOracle struct {
+	x, y, z big.Float
+	p, q    big.Rat
+}
+
+const (
+	oracleAdd = iota
+	oracleSub
+	oracleMul
+	oracleQuo
+)
+
+var oracleOps = [...]struct {
+	name string
+	sym  string
+	f    func(c *Context, a, b Float16) Float16
+	g    func(a, b Float16) Float16 // the operation of math.go, which rounds to nearest even
+	f64  func(a, b float64) float64
+}{
+	oracleAdd: {"Add", "+", (*Context).Add, Float16.Add, func(a, b float64) float64 { return a + b }},
+	oracleSub: {"Sub", "-", (*Context).Sub, Float16.Sub, func(a, b float64) float64 { return a - b }},
+	oracleMul: {"Mul", "*", (*Context).Mul, Float16.Mul, func(a, b float64) float64 { return a * b }},
+	oracleQuo: {"Quo", "/", (*Context).Quo, Float16.Quo, func(a, b float64) float64 { return a / b }},
+}
+
+// compute returns a op b rounded in the direction of mode.
+func (o *bigOracle) compute(op int, a, b Float16, mode RoundingMode) Float16 {
+	fa, fb := a.Float64(), b.Float64()
+	if math.IsNaN(fa) || math.IsNaN(fb) || math.IsInf(fa, 0) || math.IsInf(fb, 0) || op == oracleQuo && fb == 0 {
+		// the results of the special cases are exact, so float64 gives the same result.
+		return FromFloat64(oracleOps[op].f64(fa, fb))
+	}
+
+	// the sums and the products are exact in 64 bits.
+	o.z.SetPrec(64).SetMode(mode.big())
+	o.x.SetFloat64(fa)
+	o.y.SetFloat64(fb)
+	switch op {
+	case oracleAdd:
+		o.z.Add(&o.x, &o.y)
+	case oracleSub:
+		o.z.Sub(&o.x, &o.y)
+	case oracleMul:
+		o.z.Mul(&o.x, &o.y)
+	case oracleQuo:
+		// the quotient isn't exact in any precision, so it is computed in rational numbers.
+		o.x.Rat(&o.p)
+		o.y.Rat(&o.q)
+		o.p.Quo(&o.p, &o.q)
+		neg := math.Signbit(fa) != math.Signbit(fb)
+		if o.p.Sign() == 0 {
+			return o.zero(neg)
+		}
+		return o.round(&o.p, neg, mode)
+	}
+	if o.z.Sign() == 0 {
+		if op == oracleMul {
+			return o.zero(o.z.Signbit())
+		}
+
+		// the exact sum of the operands of opposite signs is +0, or -0 when rounding toward negative infinity.
+		// math/big doesn't follow the rule if both operands are zero.
+		sb := math.Signbit(fb) != (op == oracleSub)
+		if fa == 0 && fb == 0 && math.Signbit(fa) == sb {
+			return o.zero(sb)
+		}
+		return o.zero(mode == ToNegativeInf)
+	}
+	o.z.Rat(&o.p)
+	return o.round(&o.p, o.z.Signbit(), mode)
+}
+
+// fma returns x * y + z rounded in the direction of mode.
+func (o *bigOracle) fma(x, y, z Float16, mode RoundingMode) Float16 {
+	fx, fy, fz := x.Float64(), y.Float64(), z.Float64()
+	if x.IsNaN() || y.IsNaN() || z.IsNaN() || x.IsInf(0) || y.IsInf(0) || z.IsInf(0) {
+		return FromFloat64(math.FMA(fx, fy, fz))
+	}
+
+	// the product is exact in 64 bits, and the sum is exact in 128 bits.
+	o.x.SetPrec(64).SetFloat64(fx)
+	o.y.SetPrec(64).SetFloat64(fy)
+	o.x.Mul(&o.x, &o.y)
+	o.y.SetFloat64(fz)
+	o.z.SetPrec(128).SetMode(mode.big()).Add(&o.x, &o.y)
+	if o.z.Sign() == 0 {
+		// the same rule as the sum; see compute.
+		if o.x.Sign() == 0 && fz == 0 && o.x.Signbit() == math.Signbit(fz) {
+			return o.zero(math.Signbit(fz))
+		}
+		return o.zero(mode == ToNegativeInf)
+	}
+	o.z.Rat(&o.p)
+	return o.round(&o.p, o.z.Signbit(), mode)
+}
+
+func (o *bigOracle) zero(neg bool) Float16 {
+	if neg {
+		return signMask16
+	}
+	return 0
+}
+
+// round rounds the non-zero rational number r to Float16 in the direction of mode.
+func (o *bigOracle) round(r *big.Rat, neg bool, mode RoundingMode) Float16 {
+	// find the exponent; r = mant × 2^exp, 0.5 <= |mant| < 1.
+	// rounding toward zero doesn't carry into the exponent.
+	o.z.SetPrec(64).SetMode(big.ToZero).SetRat(r)
+	exp := o.z.MantExp(nil)
+
+	// the precision is 11 bits for normal numbers, and less for subnormal numbers.
+	// the least significant bit is 2^-24.
+	prec := min(exp+24, 11)
+	if prec <= 0 {
+		// |r| < 2^-24, the result is zero or the smallest subnormal number.
+		cmpHalf := o.q.Abs(r).Cmp(big.NewRat(1, 1<<25))
+		var up bool
+		switch mode {
+		case ToNearestEven:
+			// the halfway point rounds to zero, which is even.
+			up = cmpHalf > 0
+		case ToNearestAway:
+			up = cmpHalf >= 0
+		case ToNegativeInf:
+			up = neg
+		case ToPositiveInf:
+			up = !neg
+		}
+		if up {
+			return o.zero(neg) | 1
+		}
+		return o.zero(neg)
+	}
+
+	o.z.SetPrec(uint(prec)).SetMode(mode.big()).SetRat(r)
+	f, _ := o.z.Float64() // exact
+	if math.Abs(f) > 65504 {
+		if mode == ToZero || mode == ToNegativeInf && !neg || mode == ToPositiveInf && neg {
+			return o.zero(neg) | uvmax
+		}
+		return o.zero(neg) | uvinf
+	}
+	return FromFloat64(f)
+}
+
+// compare compares a and b in the same way as Float16.Compare.
+func (o *bigOracle) compare(a, b Float16) int {
+	switch {
+	case a.IsNaN() && b.IsNaN():
+		return 0
+	case a.IsNaN():
+		return -1
+	case b.IsNaN():
+		return 1
+	}
+	o.x.SetFloat64(a.Float64())
+	o.y.SetFloat64(b.Float64())
+	return o.x.Cmp(&o.y)
+}
+
+// exhaustive calls f for the pairs of the operands in parallel,
+// each goroutine with its own oracle.
+// f returns false to stop the test.
+func exhaustive(t *testing.T, f func(o *bigOracle, a, b Float16) bool) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping the exhaustive test in short mode")
+	}
+
+	var stop atomic.Bool
+	var wg sync.WaitGroup
+	ch := make(chan int)
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var o bigOracle
+			for a := range ch {
+				if *long {
+					for b := 0; b < 0x10000 && !stop.Load(); b++ {
+						if !f(&o, Float16(a), Float16(b)) {
+							stop.Store(true)
+						}
+					}
+					continue
+				}
+
+				x := xorshift32(a + 1)
+				if !f(&o, Float16(a), Float16(x.Uint32())) {
+					stop.Store(true)
+				}
+			}
+		}()
+	}
+	for a := 0; a < 0x10000 && !stop.Load(); a++ {
+		ch <- a
+	}
+	close(ch)
+	wg.Wait()
+}
+
+// errorCounter stops the exhaustive test after too many errors.
+type errorCounter struct {
+	t *testing.T
+	n atomic.Int32
+}
+
+func (c *errorCounter) Errorf(format string, args ...any) bool {
+	c.t.Errorf(format, args...)
+	return c.n.Add(1) < 20
+}
+
+func TestExhaustive_Arithmetic(t *testing.T) {
+	for op := range oracleOps {
+		op := op
+		t.Run(oracleOps[op].name, func(t *testing.T) {
+			t.Parallel()
+			errs := &errorCounter{t: t}
+			exhaustive(t, func(o *bigOracle, a, b Float16) bool {
+				for _, mode := range roundingModes {
+					want := o.compute(op, a, b, mode)
+					c := Context{Mode: mode}
+					got := oracleOps[op].f(&c, a, b)
+					if got != want && !(got.IsNaN() && want.IsNaN()) {
+						return errs.Errorf("%v: %04x %s %04x: expected %04x, got %04x", mode, a, oracleOps[op].sym, b, want, got)
+					}
+					if mode != ToNearestEven {
+						continue
+					}
+					got = oracleOps[op].g(a, b)
+					if got != want && !(got.IsNaN() && want.IsNaN()) {
+						return errs.Errorf("%04x %s %04x: expected %04x, got %04x", a, oracleOps[op].sym, b, want, got)
+					}
+				}
+				return true
+			})
+		})
+	}
+}
+
+func TestExhaustive_Compare(t *testing.T) {
+	errs := &errorCounter{t: t}
+	exhaustive(t, func(o *bigOracle, a, b Float16) bool {
+		want := o.compare(a, b)
+		if got := a.Compare(b); got != want {
+			return errs.Errorf("Compare(%04x, %04x): expected %d, got %d", a, b, want, got)
+		}
+
+		ordered := !a.IsNaN() && !b.IsNaN()
+		var c Context
+		if got := a.Eq(b); got != (ordered && want == 0) || c.Eq(a, b) != got {
+			return errs.Errorf("%04x == %04x: unexpected %t", a, b, got)
+		}
+		if got := a.Lt(b); got != (ordered && want < 0) || c.Lt(a, b) != got {
+			return errs.Errorf("%04x < %04x: unexpected %t", a, b, got)
+		}
+		if got := a.Le(b); got != (ordered && want <= 0) || c.Le(a, b) != got {
+			return errs.Errorf("%04x <= %04x: unexpected %t", a, b, got)
+		}
+		return true
+	})
+}
+
+func TestBigOracle(t *testing.T) {
+	// the oracle agrees with roundBig, which is a straightforward but slow implementation of rounding.
+	var o bigOracle
+	x := newXorshift32()
+	for i := 0; i < 10000; i++ {
+		a, b := x.Float16Pair()
+		if a.IsNaN() || a.IsInf(0) || b.IsNaN() || b.IsInf(0) {
+			continue
+		}
+		for _, mode := range roundingModes {
+			for op, f := range []func(z, x, y *big.Float) *big.Float{
+				oracleAdd: (*big.Float).Add,
+				oracleSub: (*big.Float).Sub,
+				oracleMul: (*big.Float).Mul,
+			} {
+				exact := f(newBig(mode), a.big(mode), b.big(mode))
+				want := roundBig(exact, mode)
+				if got := o.compute(op, a, b, mode); got != want {
+					t.Errorf("%v: %04x %s %04x: expected %04x, got %04x", mode, a, oracleOps[op].sym, b, want, got)
+				}
+			}
+		}
+	}
+
+	// halfway cases of the subnormal numbers.
+	tiny := Float16(1)
+	half := FromFloat64(0.5)
+	for _, mode := range roundingModes {
+		want := roundBig(new(big.Float).SetMantExp(big.NewFloat(1), -25), mode)
+		if got := o.compute(oracleMul, tiny, half, mode); got != want {
+			t.Errorf("%v: 2^-24 * 0.5: expected %04x, got %04x", mode, want, got)
+		}
+	}
+
+	// the signs of the zeros.
+	one := FromFloat64(1)
+	zeros := []struct {
+		op   int
+		a, b Float16
+		want Float16 // the result unless rounding toward negative infinity
+		down Float16 // the result when rounding toward negative infinity
+	}{
+		{oracleAdd, 0, 0, 0, 0},
+		{oracleAdd, signMask16, signMask16, signMask16, signMask16},
+		{oracleAdd, signMask16, 0, 0, signMask16},
+		{oracleAdd, one, one | signMask16, 0, signMask16},
+		{oracleSub, 0, 0, 0, signMask16},
+		{oracleSub, signMask16, 0, signMask16, signMask16},
+		{oracleSub, 0, signMask16, 0, 0},
+		{oracleSub, one, one, 0, signMask16},
+	}
+	for _, tt := range zeros {
+		for _, mode := range roundingModes {
+			want := tt.want
+			if mode == ToNegativeInf {
+				want = tt.down
+			}
+			if got := o.compute(tt.op, tt.a, tt.b, mode); got != want {
+				t.Errorf("%v: %04x %s %04x: expected %04x, got %04x", mode, tt.a, oracleOps[tt.op].sym, tt.b, want, got)
+			}
+		}
+	}
+}
+
+// FuzzArithmetic checks the arithmetic operations against the oracle in every rounding mode.
+func FuzzArithmetic(f *testing.F) {
+	f.Add(uint16(0x3c00), uint16(0x3c00), uint16(0xbc00))
+	f.Add(uint16(0x8000), uint16(0x0000), uint16(0x0000))
+	f.Add(uint16(0x0001), uint16(0x3800), uint16(0x8000))
+	f.Add(uint16(0x7bff), uint16(0x3c01), uint16(0xfbff))
+	f.Add(uint16(0x3c01), uint16(0x3bff), uint16(0x1400))
+	f.Add(uint16(0x7c01), uint16(0xfe00), uint16(0x3c00))
+
+	f.Fuzz(func(t *testing.T, a, b, c uint16) {
+		var o bigOracle
+		x, y, z := FromBits(a), FromBits(b), FromBits(c)
+		for _, mode := range roundingModes {
+			for op := range oracleOps {
+				want := o.compute(op, x, y, mode)
+				ctx := Context{Mode: mode}
+				got := oracleOps[op].f(&ctx, x, y)
+				if got != want && !(got.IsNaN() && want.IsNaN()) {
+					t.Errorf("%v: %04x %s %04x: expected %04x, got %04x", mode, a, oracleOps[op].sym, b, want, got)
+				}
+				if mode == ToNearestEven {
+					got = oracleOps[op].g(x, y)
+					if got != want && !(got.IsNaN() && want.IsNaN()) {
+						t.Errorf("%04x %s %04x: expected %04x, got %04x", a, oracleOps[op].sym, b, want, got)
+					}
+				}
+			}
+
+			want := o.fma(x, y, z, mode)
+			ctx := Context{Mode: mode}
+			got := ctx.FMA(x, y, z)
+			if got != want && !(got.IsNaN() && want.IsNaN()) {
+				t.Errorf("%v: FMA(%04x, %04x, %04x): expected %04x, got %04x", mode, a, b, c, want, got)
+			}
+			if mode == ToNearestEven {
+				got = FMA(x, y, z)
+				if got != want && !(got.IsNaN() && want.IsNaN()) {
+					t.Errorf("FMA(%04x, %04x, %04x): expected %04x, got %04x", a, b, c, want, got)
+				}
+			}
+		}
+
+		if got, want := x.Compare(y), o.compare(x, y); got != want {
+			t.Errorf("Compare(%04x, %04x): expected %d, got %d", a, b, want, got)
+		}
+	})
+}
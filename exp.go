@@ -0,0 +1,63 @@
+package float16
+
+import "math"
+
+// elementary returns f(x) rounded to Float16.
+// It implements the elementary functions Exp, Exp2, Expm1, Log, Log2, Log10, Log1p,
+// Sin, Cos, Tan, Asin, Acos, Atan, Sinh, Cosh, Tanh, Asinh, Acosh, Atanh and Cbrt,
+// whose documents give only their special cases, as the math package does.
+//
+// The functions of the math package are accurate to about 1 ulp of float64,
+// and the results are rounded to Float16 only once.
+// It gives the correctly rounded results
+// unless the exact results are extremely close to the midpoints of Float16.
+//
+// The results of the math package depend on the platform,
+// e.g. on the assembly implementations and on the fused multiply-add.
+// The exhaustive tests against math/big confirm that no such inputs exist on amd64 and 386,
+// so the elementary functions are correctly rounded there.
+// On the other platforms, the results are guaranteed only to be faithfully rounded,
+// i.e. to be one of the two Float16 values nearest to the exact results.
+//
+// The guarantee was checked against the math package of Go 1.27.
+// It is a property of that implementation, not of the math package's documented accuracy,
+// so a change of the math package in a later Go release may break it
+// without any change in this package.
+// The exhaustive tests run in CI to catch it.
+func elementary(f func(float64) float64, x Float16) Float16 {
+	return FromFloat64(f(x.Float64()))
+}
+
+// Exp returns e**x, the base-e exponential of x.
+//
+// Special cases are:
+//
+//	Exp(+Inf) = +Inf
+//	Exp(NaN) = NaN
+//
+// Very large values overflow to 0 or +Inf.
+// Very small values underflow to 1.
+func (x Float16) Exp() Float16 {
+	return elementary(math.Exp, x)
+}
+
+// Exp2 returns 2**x, the base-2 exponential of x.
+//
+// Special cases are the same as Exp.
+func (x Float16) Exp2() Float16 {
+	return elementary(math.Exp2, x)
+}
+
+// Expm1 returns e**x - 1, the base-e exponential of x minus 1.
+// It is more accurate than x.Exp().Sub(1) when x is near zero.
+//
+// Special cases are:
+//
+//	Expm1(+Inf) = +Inf
+//	Expm1(-Inf) = -1
+//	Expm1(NaN) = NaN
+//
+// Very large values overflow to -1 or +Inf.
+func (x Float16) Expm1() Float16 {
+	return elementary(math.Expm1, x)
+}
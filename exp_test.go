@@ -0,0 +1,51 @@
+package float16
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestExp(t *testing.T) {
+	testSpecialCases(t, Float16.Exp, []specialCase{
+		{0, 1},
+		{negZero, 1},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), 0},
+		{math.NaN(), math.NaN()},
+		{12, math.Inf(1)},
+		{-18, 0},
+		{0x1p-24, 1},
+	})
+	testExhaustive(t, Float16.Exp, bigExp)
+}
+
+func TestExp2(t *testing.T) {
+	testSpecialCases(t, Float16.Exp2, []specialCase{
+		{0, 1},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), 0},
+		{math.NaN(), math.NaN()},
+		{10, 1024},
+		{-24, 0x1p-24},
+		{16, math.Inf(1)},
+	})
+	testExhaustive(t, Float16.Exp2, func(x *big.Float) *big.Float {
+		return bigExp(bigMul(x, bigLn2))
+	})
+}
+
+func TestExpm1(t *testing.T) {
+	testSpecialCases(t, Float16.Expm1, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), -1},
+		{math.NaN(), math.NaN()},
+		{0x1p-24, 0x1p-24},
+		{-20, -1},
+	})
+	testExhaustive(t, Float16.Expm1, func(x *big.Float) *big.Float {
+		return bigSub(bigExp(x), bigInt(1))
+	})
+}
@@ -1,2458 +0,0 @@
-// Code generated by scripts/f16_sqrt.pl; DO NOT EDIT.
-
-package float16
-
-var f16Sqrt = []struct {
-	x uint16
-	y uint16
-}{
-
-	{0xE803, 0x7E00},
-	{0x0000, 0x0000},
-	{0xBD07, 0x7E00},
-	{0x0001, 0x0C00},
-	{0xBBFF, 0x7E00},
-	{0x0002, 0x0DA8},
-	{0xC006, 0x7E00},
-	{0x0004, 0x1000},
-	{0xAC0F, 0x7E00},
-	{0x0008, 0x11A8},
-	{0x485E, 0x41E9},
-	{0x0010, 0x1400},
-	{0xB002, 0x7E00},
-	{0x0020, 0x15A8},
-	{0xB3DD, 0x7E00},
-	{0x0040, 0x1800},
-	{0x424C, 0x3F19},
-	{0x0080, 0x19A8},
-	{0x9DEF, 0x7E00},
-	{0x0100, 0x1C00},
-	{0xAB6F, 0x7E00},
-	{0x0200, 0x1DA8},
-	{0xBA00, 0x7E00},
-	{0x0300, 0x1EEE},
-	{0xD800, 0x7E00},
-	{0x0380, 0x1F7C},
-	{0x3F7F, 0x3D7A},
-	{0x03C0, 0x1FBF},
-	{0xCD07, 0x7E00},
-	{0x03E0, 0x1FE0},
-	{0x52FF, 0x477B},
-	{0x03F0, 0x1FF0},
-	{0x3A03, 0x3AEF},
-	{0x03F8, 0x1FF8},
-	{0x0539, 0x2092},
-	{0x03FC, 0x1FFC},
-	{0x47EF, 0x41A2},
-	{0x03FE, 0x1FFE},
-	{0xEBBC, 0x7E00},
-	{0x03FF, 0x1FFF},
-	{0xC9C5, 0x7E00},
-	{0x03FD, 0x1FFD},
-	{0xA8FE, 0x7E00},
-	{0x03FB, 0x1FFB},
-	{0x93EF, 0x7E00},
-	{0x03F7, 0x1FF7},
-	{0x50C0, 0x462A},
-	{0x03EF, 0x1FEF},
-	{0x3F98, 0x3D83},
-	{0x03DF, 0x1FDF},
-	{0xF54E, 0x7E00},
-	{0x03BF, 0x1FBE},
-	{0xB47B, 0x7E00},
-	{0x037F, 0x1F7B},
-	{0x469D, 0x4125},
-	{0x02FF, 0x1EEC},
-	{0x12BE, 0x2758},
-	{0x01FF, 0x1DA7},
-	{0xE88C, 0x7E00},
-	{0x00FF, 0x1BFC},
-	{0x1F00, 0x2D4B},
-	{0x007F, 0x19A2},
-	{0x9001, 0x7E00},
-	{0x003F, 0x17F0},
-	{0x49F9, 0x42EA},
-	{0x001F, 0x1591},
-	{0x4807, 0x41AD},
-	{0x000F, 0x13BF},
-	{0xEBFF, 0x7E00},
-	{0x0007, 0x114B},
-	{0x3E34, 0x3CFB},
-	{0x0003, 0x0EEE},
-	{0xFA0F, 0x7E00},
-	{0x0400, 0x2000},
-	{0x451E, 0x4086},
-	{0x0401, 0x2000},
-	{0x321E, 0x36FF},
-	{0x0402, 0x2001},
-	{0x7FEB, 0x7FEB},
-	{0x0404, 0x2002},
-	{0xCFBF, 0x7E00},
-	{0x0408, 0x2004},
-	{0x3E00, 0x3CE6},
-	{0x0410, 0x2008},
-	{0x2C23, 0x3411},
-	{0x0420, 0x2010},
-	{0x00FD, 0x1BF4},
-	{0x0440, 0x2020},
-	{0xC1F7, 0x7E00},
-	{0x0480, 0x203E},
-	{0x3FFF, 0x3DA8},
-	{0x0500, 0x2079},
-	{0x1E1F, 0x2CF3},
-	{0x0600, 0x20E6},
-	{0x37FF, 0x39A8},
-	{0x0700, 0x214B},
-	{0xCDFC, 0x7E00},
-	{0x0780, 0x217A},
-	{0x48B9, 0x4226},
-	{0x07C0, 0x2191},
-	{0x8B9F, 0x7E00},
-	{0x07E0, 0x219D},
-	{0x0E47, 0x2503},
-	{0x07F0, 0x21A2},
-	{0xCDFC, 0x7E00},
-	{0x07F8, 0x21A5},
-	{0x002E, 0x16C8},
-	{0x07FC, 0x21A7},
-	{0xC63F, 0x7E00},
-	{0x07FE, 0x21A7},
-	{0x3800, 0x39A8},
-	{0x07FF, 0x21A8},
-	{0x3C03, 0x3C01},
-	{0x07FD, 0x21A7},
-	{0x8829, 0x7E00},
-	{0x07FB, 0x21A6},
-	{0xAFF8, 0x7E00},
-	{0x07F7, 0x21A5},
-	{0xF51F, 0x7E00},
-	{0x07EF, 0x21A2},
-	{0x4FA0, 0x4586},
-	{0x07DF, 0x219C},
-	{0xA087, 0x7E00},
-	{0x07BF, 0x2191},
-	{0x0BBD, 0x23DE},
-	{0x077F, 0x217A},
-	{0xFF7F, 0xFF7F},
-	{0x06FF, 0x214A},
-	{0xCB7B, 0x7E00},
-	{0x05FF, 0x20E6},
-	{0x500B, 0x45B0},
-	{0x04FF, 0x2078},
-	{0x3886, 0x3A04},
-	{0x047F, 0x203E},
-	{0xC3FA, 0x7E00},
-	{0x043F, 0x201F},
-	{0x8427, 0x7E00},
-	{0x041F, 0x200F},
-	{0xC902, 0x7E00},
-	{0x040F, 0x2007},
-	{0x0BEF, 0x23F7},
-	{0x0407, 0x2003},
-	{0xC41D, 0x7E00},
-	{0x0403, 0x2001},
-	{0x0D00, 0x2479},
-	{0x0800, 0x21A8},
-	{0xA7FF, 0x7E00},
-	{0x0801, 0x21A9},
-	{0x3A7E, 0x3B35},
-	{0x0802, 0x21AA},
-	{0x6908, 0x5258},
-	{0x0804, 0x21AB},
-	{0xB884, 0x7E00},
-	{0x0808, 0x21AE},
-	{0xA886, 0x7E00},
-	{0x0810, 0x21B3},
-	{0xD80F, 0x7E00},
-	{0x0820, 0x21BF},
-	{0xAC1F, 0x7E00},
-	{0x0840, 0x21D5},
-	{0x697E, 0x52A1},
-	{0x0880, 0x2200},
-	{0x497E, 0x42A1},
-	{0x0900, 0x2253},
-	{0x79FB, 0x5AEB},
-	{0x0A00, 0x22EE},
-	{0x4604, 0x40E8},
-	{0x0B00, 0x237C},
-	{0xBBE0, 0x7E00},
-	{0x0B80, 0x23BF},
-	{0x53E0, 0x47F0},
-	{0x0BC0, 0x23E0},
-	{0xC027, 0x7E00},
-	{0x0BE0, 0x23F0},
-	{0x8BBE, 0x7E00},
-	{0x0BF0, 0x23F8},
-	{0x97AE, 0x7E00},
-	{0x0BF8, 0x23FC},
-	{0x02EF, 0x1EDA},
-	{0x0BFC, 0x23FE},
-	{0xF016, 0x7E00},
-	{0x0BFE, 0x23FF},
-	{0xD011, 0x7E00},
-	{0x0BFF, 0x23FF},
-	{0x88C0, 0x7E00},
-	{0x0BFD, 0x23FE},
-	{0xE8BE, 0x7E00},
-	{0x0BFB, 0x23FD},
-	{0x6BF4, 0x53FA},
-	{0x0BF7, 0x23FB},
-	{0xC7F4, 0x7E00},
-	{0x0BEF, 0x23F7},
-	{0x30FC, 0x3651},
-	{0x0BDF, 0x23EF},
-	{0x4C05, 0x4402},
-	{0x0BBF, 0x23DF},
-	{0x3798, 0x3983},
-	{0x0B7F, 0x23BE},
-	{0x83B6, 0x7E00},
-	{0x0AFF, 0x237B},
-	{0xBE01, 0x7E00},
-	{0x09FF, 0x22ED},
-	{0x9000, 0x7E00},
-	{0x08FF, 0x2252},
-	{0x3C78, 0x3C3A},
-	{0x087F, 0x21FF},
-	{0xC850, 0x7E00},
-	{0x083F, 0x21D4},
-	{0x9220, 0x7E00},
-	{0x081F, 0x21BE},
-	{0xAC01, 0x7E00},
-	{0x080F, 0x21B3},
-	{0x420E, 0x3EF6},
-	{0x0807, 0x21AD},
-	{0xDFFF, 0x7E00},
-	{0x0803, 0x21AA},
-	{0xC7AD, 0x7E00},
-	{0x1000, 0x25A8},
-	{0xD026, 0x7E00},
-	{0x1001, 0x25A9},
-	{0xAC13, 0x7E00},
-	{0x1002, 0x25AA},
-	{0x2BF8, 0x33FC},
-	{0x1004, 0x25AB},
-	{0xA37B, 0x7E00},
-	{0x1008, 0x25AE},
-	{0xA3FE, 0x7E00},
-	{0x1010, 0x25B3},
-	{0x35D0, 0x38D2},
-	{0x1020, 0x25BF},
-	{0x1841, 0x29D5},
-	{0x1040, 0x25D5},
-	{0xAFCF, 0x7E00},
-	{0x1080, 0x2600},
-	{0xAF1F, 0x7E00},
-	{0x1100, 0x2653},
-	{0x81F7, 0x7E00},
-	{0x1200, 0x26EE},
-	{0x5907, 0x4A58},
-	{0x1300, 0x277C},
-	{0xBCFB, 0x7E00},
-	{0x1380, 0x27BF},
-	{0x67DB, 0x519B},
-	{0x13C0, 0x27E0},
-	{0x0780, 0x217A},
-	{0x13E0, 0x27F0},
-	{0x7DDF, 0x7FDF},
-	{0x13F0, 0x27F8},
-	{0xBBF8, 0x7E00},
-	{0x13F8, 0x27FC},
-	{0x5FBD, 0x4D90},
-	{0x13FC, 0x27FE},
-	{0x53F8, 0x47FC},
-	{0x13FE, 0x27FF},
-	{0xB380, 0x7E00},
-	{0x13FF, 0x27FF},
-	{0xC66C, 0x7E00},
-	{0x13FD, 0x27FE},
-	{0x4C20, 0x4410},
-	{0x13FB, 0x27FD},
-	{0xE823, 0x7E00},
-	{0x13F7, 0x27FB},
-	{0x3CCB, 0x3C61},
-	{0x13EF, 0x27F7},
-	{0x7C56, 0x7E56},
-	{0x13DF, 0x27EF},
-	{0x3EE0, 0x3D3E},
-	{0x13BF, 0x27DF},
-	{0x71EF, 0x56E4},
-	{0x137F, 0x27BE},
-	{0xB89F, 0x7E00},
-	{0x12FF, 0x277B},
-	{0xA3DB, 0x7E00},
-	{0x11FF, 0x26ED},
-	{0xC9A0, 0x7E00},
-	{0x10FF, 0x2652},
-	{0x35A8, 0x38C2},
-	{0x107F, 0x25FF},
-	{0x420F, 0x3EF6},
-	{0x103F, 0x25D4},
-	{0xEBDE, 0x7E00},
-	{0x101F, 0x25BE},
-	{0xD01E, 0x7E00},
-	{0x100F, 0x25B3},
-	{0x4451, 0x4028},
-	{0x1007, 0x25AD},
-	{0xC9FF, 0x7E00},
-	{0x1003, 0x25AA},
-	{0x802E, 0x7E00},
-	{0x2C00, 0x3400},
-	{0x9B07, 0x7E00},
-	{0x2C01, 0x3400},
-	{0xC3FF, 0x7E00},
-	{0x2C02, 0x3401},
-	{0x4043, 0x3DD7},
-	{0x2C04, 0x3402},
-	{0xE48E, 0x7E00},
-	{0x2C08, 0x3404},
-	{0x8C1F, 0x7E00},
-	{0x2C10, 0x3408},
-	{0xCC1E, 0x7E00},
-	{0x2C20, 0x3410},
-	{0x5A7E, 0x4B35},
-	{0x2C40, 0x3420},
-	{0x3841, 0x39D5},
-	{0x2C80, 0x343E},
-	{0x191F, 0x2A67},
-	{0x2D00, 0x3479},
-	{0x4AFE, 0x437B},
-	{0x2E00, 0x34E6},
-	{0xF7F2, 0x7E00},
-	{0x2F00, 0x354B},
-	{0xCDF8, 0x7E00},
-	{0x2F80, 0x357A},
-	{0x43CF, 0x3FE7},
-	{0x2FC0, 0x3591},
-	{0xCB8D, 0x7E00},
-	{0x2FE0, 0x359D},
-	{0xB98C, 0x7E00},
-	{0x2FF0, 0x35A2},
-	{0x8FF1, 0x7E00},
-	{0x2FF8, 0x35A5},
-	{0xCB9E, 0x7E00},
-	{0x2FFC, 0x35A7},
-	{0x3A07, 0x3AF2},
-	{0x2FFE, 0x35A7},
-	{0x3C5E, 0x3C2E},
-	{0x2FFF, 0x35A8},
-	{0xB9FF, 0x7E00},
-	{0x2FFD, 0x35A7},
-	{0xBF48, 0x7E00},
-	{0x2FFB, 0x35A6},
-	{0xEBFE, 0x7E00},
-	{0x2FF7, 0x35A5},
-	{0xB851, 0x7E00},
-	{0x2FEF, 0x35A2},
-	{0xF65C, 0x7E00},
-	{0x2FDF, 0x359C},
-	{0x3005, 0x35AC},
-	{0x2FBF, 0x3591},
-	{0x83C2, 0x7E00},
-	{0x2F7F, 0x357A},
-	{0x49A6, 0x42B9},
-	{0x2EFF, 0x354A},
-	{0xBFD4, 0x7E00},
-	{0x2DFF, 0x34E6},
-	{0x116A, 0x2695},
-	{0x2CFF, 0x3478},
-	{0x8877, 0x7E00},
-	{0x2C7F, 0x343E},
-	{0x07DF, 0x219C},
-	{0x2C3F, 0x341F},
-	{0x105F, 0x25EA},
-	{0x2C1F, 0x340F},
-	{0xAC48, 0x7E00},
-	{0x2C0F, 0x3407},
-	{0x2FF5, 0x35A4},
-	{0x2C07, 0x3403},
-	{0x3CF6, 0x3C74},
-	{0x2C03, 0x3401},
-	{0xCC0E, 0x7E00},
-	{0x3000, 0x35A8},
-	{0x36F7, 0x3947},
-	{0x3001, 0x35A9},
-	{0xC740, 0x7E00},
-	{0x3002, 0x35AA},
-	{0xC804, 0x7E00},
-	{0x3004, 0x35AB},
-	{0xBBFF, 0x7E00},
-	{0x3008, 0x35AE},
-	{0xCDC0, 0x7E00},
-	{0x3010, 0x35B3},
-	{0xBBBF, 0x7E00},
-	{0x3020, 0x35BF},
-	{0x1BFF, 0x2BFF},
-	{0x3040, 0x35D5},
-	{0x002E, 0x16C8},
-	{0x3080, 0x3600},
-	{0xABD7, 0x7E00},
-	{0x3100, 0x3653},
-	{0xCBBF, 0x7E00},
-	{0x3200, 0x36EE},
-	{0xFBBC, 0x7E00},
-	{0x3300, 0x377C},
-	{0x375F, 0x396E},
-	{0x3380, 0x37BF},
-	{0xF00A, 0x7E00},
-	{0x33C0, 0x37E0},
-	{0xEE07, 0x7E00},
-	{0x33E0, 0x37F0},
-	{0x9040, 0x7E00},
-	{0x33F0, 0x37F8},
-	{0x33DB, 0x37ED},
-	{0x33F8, 0x37FC},
-	{0xB555, 0x7E00},
-	{0x33FC, 0x37FE},
-	{0x2BC2, 0x33E1},
-	{0x33FE, 0x37FF},
-	{0x32DB, 0x3768},
-	{0x33FF, 0x37FF},
-	{0xAD40, 0x7E00},
-	{0x33FD, 0x37FE},
-	{0xCFE0, 0x7E00},
-	{0x33FB, 0x37FD},
-	{0x47FF, 0x41A8},
-	{0x33F7, 0x37FB},
-	{0xF47E, 0x7E00},
-	{0x33EF, 0x37F7},
-	{0x3B07, 0x3B7F},
-	{0x33DF, 0x37EF},
-	{0x7F80, 0x7F80},
-	{0x33BF, 0x37DF},
-	{0xB013, 0x7E00},
-	{0x337F, 0x37BE},
-	{0xC68C, 0x7E00},
-	{0x32FF, 0x377B},
-	{0x467E, 0x4119},
-	{0x31FF, 0x36ED},
-	{0x5000, 0x45A8},
-	{0x30FF, 0x3652},
-	{0xF6C5, 0x7E00},
-	{0x307F, 0x35FF},
-	{0xB3EE, 0x7E00},
-	{0x303F, 0x35D4},
-	{0x3281, 0x3737},
-	{0x301F, 0x35BE},
-	{0x0BFB, 0x23FD},
-	{0x300F, 0x35B3},
-	{0x27FF, 0x31A8},
-	{0x3007, 0x35AD},
-	{0x47BA, 0x418F},
-	{0x3003, 0x35AA},
-	{0x442E, 0x4017},
-	{0x3400, 0x3800},
-	{0xCA40, 0x7E00},
-	{0x3401, 0x3800},
-	{0xF75E, 0x7E00},
-	{0x3402, 0x3801},
-	{0x81EF, 0x7E00},
-	{0x3404, 0x3802},
-	{0x406A, 0x3DF1},
-	{0x3408, 0x3804},
-	{0x93EE, 0x7E00},
-	{0x3410, 0x3808},
-	{0x7432, 0x5819},
-	{0x3420, 0x3810},
-	{0xCC38, 0x7E00},
-	{0x3440, 0x3820},
-	{0x0B7D, 0x23BD},
-	{0x3480, 0x383E},
-	{0x2437, 0x301B},
-	{0x3500, 0x3879},
-	{0x26FA, 0x3148},
-	{0x3600, 0x38E6},
-	{0x4BC6, 0x43E3},
-	{0x3700, 0x394B},
-	{0x4C16, 0x440B},
-	{0x3780, 0x397A},
-	{0x2940, 0x327B},
-	{0x37C0, 0x3991},
-	{0x4BC2, 0x43E1},
-	{0x37E0, 0x399D},
-	{0x077F, 0x217A},
-	{0x37F0, 0x39A2},
-	{0x7607, 0x58E9},
-	{0x37F8, 0x39A5},
-	{0x3FC7, 0x3D94},
-	{0x37FC, 0x39A7},
-	{0xB1FE, 0x7E00},
-	{0x37FE, 0x39A7},
-	{0x47AF, 0x418B},
-	{0x37FF, 0x39A8},
-	{0x383B, 0x39D1},
-	{0x37FD, 0x39A7},
-	{0x4C00, 0x4400},
-	{0x37FB, 0x39A6},
-	{0x40F0, 0x3E49},
-	{0x37F7, 0x39A5},
-	{0x40F0, 0x3E49},
-	{0x37EF, 0x39A2},
-	{0xC812, 0x7E00},
-	{0x37DF, 0x399C},
-	{0xCDFF, 0x7E00},
-	{0x37BF, 0x3991},
-	{0xF7E0, 0x7E00},
-	{0x377F, 0x397A},
-	{0x097E, 0x22A1},
-	{0x36FF, 0x394A},
-	{0x032C, 0x1F20},
-	{0x35FF, 0x38E6},
-	{0xCFFE, 0x7E00},
-	{0x34FF, 0x3878},
-	{0xBCFF, 0x7E00},
-	{0x347F, 0x383E},
-	{0xCC17, 0x7E00},
-	{0x343F, 0x381F},
-	{0xC46F, 0x7E00},
-	{0x341F, 0x380F},
-	{0xCF7C, 0x7E00},
-	{0x340F, 0x3807},
-	{0x3ECD, 0x3D37},
-	{0x3407, 0x3803},
-	{0xB4DE, 0x7E00},
-	{0x3403, 0x3801},
-	{0xFF7E, 0xFF7E},
-	{0x3800, 0x39A8},
-	{0x3042, 0x35D6},
-	{0x3801, 0x39A9},
-	{0xDCBF, 0x7E00},
-	{0x3802, 0x39AA},
-	{0xD2D5, 0x7E00},
-	{0x3804, 0x39AB},
-	{0xDC01, 0x7E00},
-	{0x3808, 0x39AE},
-	{0xC2FB, 0x7E00},
-	{0x3810, 0x39B3},
-	{0x49DA, 0x42D8},
-	{0x3820, 0x39BF},
-	{0x6AEB, 0x5370},
-	{0x3840, 0x39D5},
-	{0xBA07, 0x7E00},
-	{0x3880, 0x3A00},
-	{0x6BF2, 0x53F9},
-	{0x3900, 0x3A53},
-	{0xB3FF, 0x7E00},
-	{0x3A00, 0x3AEE},
-	{0x2D80, 0x34B1},
-	{0x3B00, 0x3B7C},
-	{0xCDEF, 0x7E00},
-	{0x3B80, 0x3BBF},
-	{0x06DE, 0x213E},
-	{0x3BC0, 0x3BE0},
-	{0x4CFC, 0x4477},
-	{0x3BE0, 0x3BF0},
-	{0x42FE, 0x3F7B},
-	{0x3BF0, 0x3BF8},
-	{0x101B, 0x25BB},
-	{0x3BF8, 0x3BFC},
-	{0x4FC0, 0x4591},
-	{0x3BFC, 0x3BFE},
-	{0xB3D6, 0x7E00},
-	{0x3BFE, 0x3BFF},
-	{0x443A, 0x401D},
-	{0x3BFF, 0x3BFF},
-	{0xCCFE, 0x7E00},
-	{0x3BFD, 0x3BFE},
-	{0xFBAC, 0x7E00},
-	{0x3BFB, 0x3BFD},
-	{0x8707, 0x7E00},
-	{0x3BF7, 0x3BFB},
-	{0xA71F, 0x7E00},
-	{0x3BEF, 0x3BF7},
-	{0xC88E, 0x7E00},
-	{0x3BDF, 0x3BEF},
-	{0x7B7C, 0x5BBD},
-	{0x3BBF, 0x3BDF},
-	{0x7420, 0x5810},
-	{0x3B7F, 0x3BBE},
-	{0x6AFB, 0x5379},
-	{0x3AFF, 0x3B7B},
-	{0x5F7F, 0x4D7A},
-	{0x39FF, 0x3AED},
-	{0xB0E6, 0x7E00},
-	{0x38FF, 0x3A52},
-	{0x3FFF, 0x3DA8},
-	{0x387F, 0x39FF},
-	{0x68FA, 0x524F},
-	{0x383F, 0x39D4},
-	{0x7800, 0x59A8},
-	{0x381F, 0x39BE},
-	{0x5F7B, 0x4D78},
-	{0x380F, 0x39B3},
-	{0x485E, 0x41E9},
-	{0x3807, 0x39AD},
-	{0x4403, 0x4001},
-	{0x3803, 0x39AA},
-	{0xFF23, 0xFF23},
-	{0x3C00, 0x3C00},
-	{0x420F, 0x3EF6},
-	{0x3C01, 0x3C00},
-	{0x0B3E, 0x239D},
-	{0x3C02, 0x3C01},
-	{0x5EC3, 0x4D33},
-	{0x3C04, 0x3C02},
-	{0x18F6, 0x2A4D},
-	{0x3C08, 0x3C04},
-	{0xA120, 0x7E00},
-	{0x3C10, 0x3C08},
-	{0xB877, 0x7E00},
-	{0x3C20, 0x3C10},
-	{0xC1E0, 0x7E00},
-	{0x3C40, 0x3C20},
-	{0xDBF7, 0x7E00},
-	{0x3C80, 0x3C3E},
-	{0x5D19, 0x4C84},
-	{0x3D00, 0x3C79},
-	{0x03FB, 0x1FFB},
-	{0x3E00, 0x3CE6},
-	{0x401B, 0x3DBB},
-	{0x3F00, 0x3D4B},
-	{0x911F, 0x7E00},
-	{0x3F80, 0x3D7A},
-	{0xC1DE, 0x7E00},
-	{0x3FC0, 0x3D91},
-	{0xDAEC, 0x7E00},
-	{0x3FE0, 0x3D9D},
-	{0x33BF, 0x37DF},
-	{0x3FF0, 0x3DA2},
-	{0x7FED, 0x7FED},
-	{0x3FF8, 0x3DA5},
-	{0xE7FF, 0x7E00},
-	{0x3FFC, 0x3DA7},
-	{0x6BD6, 0x53EB},
-	{0x3FFE, 0x3DA7},
-	{0x3FC7, 0x3D94},
-	{0x3FFF, 0x3DA8},
-	{0x7970, 0x5A98},
-	{0x3FFD, 0x3DA7},
-	{0x3F3F, 0x3D62},
-	{0x3FFB, 0x3DA6},
-	{0x8AFA, 0x7E00},
-	{0x3FF7, 0x3DA5},
-	{0x447A, 0x403B},
-	{0x3FEF, 0x3DA2},
-	{0xB2BE, 0x7E00},
-	{0x3FDF, 0x3D9C},
-	{0x5083, 0x4602},
-	{0x3FBF, 0x3D91},
-	{0x440E, 0x4007},
-	{0x3F7F, 0x3D7A},
-	{0xB7E7, 0x7E00},
-	{0x3EFF, 0x3D4A},
-	{0xD36D, 0x7E00},
-	{0x3DFF, 0x3CE6},
-	{0x5003, 0x45AA},
-	{0x3CFF, 0x3C78},
-	{0x60C0, 0x4E2A},
-	{0x3C7F, 0x3C3E},
-	{0xFC80, 0xFE80},
-	{0x3C3F, 0x3C1F},
-	{0xCF34, 0x7E00},
-	{0x3C1F, 0x3C0F},
-	{0xBFF9, 0x7E00},
-	{0x3C0F, 0x3C07},
-	{0xC07E, 0x7E00},
-	{0x3C07, 0x3C03},
-	{0x8BF0, 0x7E00},
-	{0x3C03, 0x3C01},
-	{0xB374, 0x7E00},
-	{0x4000, 0x3DA8},
-	{0xBD9B, 0x7E00},
-	{0x4001, 0x3DA9},
-	{0x32DF, 0x376A},
-	{0x4002, 0x3DAA},
-	{0xBC12, 0x7E00},
-	{0x4004, 0x3DAB},
-	{0x77FF, 0x59A8},
-	{0x4008, 0x3DAE},
-	{0x842F, 0x7E00},
-	{0x4010, 0x3DB3},
-	{0xB304, 0x7E00},
-	{0x4020, 0x3DBF},
-	{0xC3F3, 0x7E00},
-	{0x4040, 0x3DD5},
-	{0x37FC, 0x39A7},
-	{0x4080, 0x3E00},
-	{0xA00E, 0x7E00},
-	{0x4100, 0x3E53},
-	{0xB7EB, 0x7E00},
-	{0x4200, 0x3EEE},
-	{0x03FB, 0x1FFB},
-	{0x4300, 0x3F7C},
-	{0x900E, 0x7E00},
-	{0x4380, 0x3FBF},
-	{0x5805, 0x49AC},
-	{0x43C0, 0x3FE0},
-	{0x3018, 0x35B9},
-	{0x43E0, 0x3FF0},
-	{0x3840, 0x39D5},
-	{0x43F0, 0x3FF8},
-	{0x4DFE, 0x44E5},
-	{0x43F8, 0x3FFC},
-	{0xCC17, 0x7E00},
-	{0x43FC, 0x3FFE},
-	{0x63D0, 0x4FE8},
-	{0x43FE, 0x3FFF},
-	{0x5B23, 0x4B8E},
-	{0x43FF, 0x3FFF},
-	{0x830E, 0x7E00},
-	{0x43FD, 0x3FFE},
-	{0x9E41, 0x7E00},
-	{0x43FB, 0x3FFD},
-	{0xFF0D, 0xFF0D},
-	{0x43F7, 0x3FFB},
-	{0xB3FE, 0x7E00},
-	{0x43EF, 0x3FF7},
-	{0x257E, 0x30B0},
-	{0x43DF, 0x3FEF},
-	{0x0830, 0x21CA},
-	{0x43BF, 0x3FDF},
-	{0x8A0A, 0x7E00},
-	{0x437F, 0x3FBE},
-	{0x7881, 0x5A01},
-	{0x42FF, 0x3F7B},
-	{0x2D20, 0x3487},
-	{0x41FF, 0x3EED},
-	{0xD801, 0x7E00},
-	{0x40FF, 0x3E52},
-	{0x3ABB, 0x3B56},
-	{0x407F, 0x3DFF},
-	{0xB440, 0x7E00},
-	{0x403F, 0x3DD4},
-	{0x27BF, 0x3191},
-	{0x401F, 0x3DBE},
-	{0xDFFF, 0x7E00},
-	{0x400F, 0x3DB3},
-	{0xCC80, 0x7E00},
-	{0x4007, 0x3DAD},
-	{0xCF5F, 0x7E00},
-	{0x4003, 0x3DAA},
-	{0x443C, 0x401E},
-	{0x4400, 0x4000},
-	{0x93F9, 0x7E00},
-	{0x4401, 0x4000},
-	{0xDFFD, 0x7E00},
-	{0x4402, 0x4001},
-	{0xD41D, 0x7E00},
-	{0x4404, 0x4002},
-	{0xBC6E, 0x7E00},
-	{0x4408, 0x4004},
-	{0xAC04, 0x7E00},
-	{0x4410, 0x4008},
-	{0xC61A, 0x7E00},
-	{0x4420, 0x4010},
-	{0xCF00, 0x7E00},
-	{0x4440, 0x4020},
-	{0x67DE, 0x519C},
-	{0x4480, 0x403E},
-	{0x783E, 0x59D3},
-	{0x4500, 0x4079},
-	{0xF4FD, 0x7E00},
-	{0x4600, 0x40E6},
-	{0x480C, 0x41B1},
-	{0x4700, 0x414B},
-	{0xDC3F, 0x7E00},
-	{0x4780, 0x417A},
-	{0x39FE, 0x3AEC},
-	{0x47C0, 0x4191},
-	{0x4BEE, 0x43F7},
-	{0x47E0, 0x419D},
-	{0x4878, 0x41FB},
-	{0x47F0, 0x41A2},
-	{0xBEFF, 0x7E00},
-	{0x47F8, 0x41A5},
-	{0xC4F8, 0x7E00},
-	{0x47FC, 0x41A7},
-	{0x88F9, 0x7E00},
-	{0x47FE, 0x41A7},
-	{0x3CBF, 0x3C5B},
-	{0x47FF, 0x41A8},
-	{0xC3FA, 0x7E00},
-	{0x47FD, 0x41A7},
-	{0x3817, 0x39B8},
-	{0x47FB, 0x41A6},
-	{0x93DD, 0x7E00},
-	{0x47F7, 0x41A5},
-	{0x3FC2, 0x3D92},
-	{0x47EF, 0x41A2},
-	{0x2FD7, 0x359A},
-	{0x47DF, 0x419C},
-	{0x4A18, 0x42FB},
-	{0x47BF, 0x4191},
-	{0x4510, 0x4080},
-	{0x477F, 0x417A},
-	{0x27E1, 0x319D},
-	{0x46FF, 0x414A},
-	{0xC570, 0x7E00},
-	{0x45FF, 0x40E6},
-	{0xDBBE, 0x7E00},
-	{0x44FF, 0x4078},
-	{0xEFC8, 0x7E00},
-	{0x447F, 0x403E},
-	{0x3568, 0x38A6},
-	{0x443F, 0x401F},
-	{0x4BEF, 0x43F7},
-	{0x441F, 0x400F},
-	{0xDC00, 0x7E00},
-	{0x440F, 0x4007},
-	{0xCBF6, 0x7E00},
-	{0x4407, 0x4003},
-	{0xBB39, 0x7E00},
-	{0x4403, 0x4001},
-	{0x2900, 0x3253},
-	{0x4800, 0x41A8},
-	{0x28C3, 0x322C},
-	{0x4801, 0x41A9},
-	{0x6BF2, 0x53F9},
-	{0x4802, 0x41AA},
-	{0xC7DC, 0x7E00},
-	{0x4804, 0x41AB},
-	{0x4321, 0x3F8D},
-	{0x4808, 0x41AE},
-	{0xE9EB, 0x7E00},
-	{0x4810, 0x41B3},
-	{0xC64E, 0x7E00},
-	{0x4820, 0x41BF},
-	{0xFF01, 0xFF01},
-	{0x4840, 0x41D5},
-	{0xCB01, 0x7E00},
-	{0x4880, 0x4200},
-	{0xD631, 0x7E00},
-	{0x4900, 0x4253},
-	{0x4C06, 0x4403},
-	{0x4A00, 0x42EE},
-	{0xAFEE, 0x7E00},
-	{0x4B00, 0x437C},
-	{0x4A02, 0x42EF},
-	{0x4B80, 0x43BF},
-	{0x8030, 0x7E00},
-	{0x4BC0, 0x43E0},
-	{0xFFFB, 0xFFFB},
-	{0x4BE0, 0x43F0},
-	{0xDA4B, 0x7E00},
-	{0x4BF0, 0x43F8},
-	{0x33C7, 0x37E3},
-	{0x4BF8, 0x43FC},
-	{0x8DFC, 0x7E00},
-	{0x4BFC, 0x43FE},
-	{0x5FF5, 0x4DA4},
-	{0x4BFE, 0x43FF},
-	{0x3D02, 0x3C7A},
-	{0x4BFF, 0x43FF},
-	{0x46DF, 0x413E},
-	{0x4BFD, 0x43FE},
-	{0xCBE1, 0x7E00},
-	{0x4BFB, 0x43FD},
-	{0x494D, 0x4283},
-	{0x4BF7, 0x43FB},
-	{0x8BCA, 0x7E00},
-	{0x4BEF, 0x43F7},
-	{0x7D7F, 0x7F7F},
-	{0x4BDF, 0x43EF},
-	{0xF865, 0x7E00},
-	{0x4BBF, 0x43DF},
-	{0xF00C, 0x7E00},
-	{0x4B7F, 0x43BE},
-	{0x3FE1, 0x3D9D},
-	{0x4AFF, 0x437B},
-	{0xAC1E, 0x7E00},
-	{0x49FF, 0x42ED},
-	{0xC3D8, 0x7E00},
-	{0x48FF, 0x4252},
-	{0x07FF, 0x21A8},
-	{0x487F, 0x41FF},
-	{0xA81F, 0x7E00},
-	{0x483F, 0x41D4},
-	{0x7DFD, 0x7FFD},
-	{0x481F, 0x41BE},
-	{0xB3EF, 0x7E00},
-	{0x480F, 0x41B3},
-	{0x4103, 0x3E55},
-	{0x4807, 0x41AD},
-	{0xCDDF, 0x7E00},
-	{0x4803, 0x41AA},
-	{0xFBFE, 0x7E00},
-	{0x4C00, 0x4400},
-	{0x3FFF, 0x3DA8},
-	{0x4C01, 0x4400},
-	{0x3C4F, 0x3C27},
-	{0x4C02, 0x4401},
-	{0xBB40, 0x7E00},
-	{0x4C04, 0x4402},
-	{0x7FF3, 0x7FF3},
-	{0x4C08, 0x4404},
-	{0x45EF, 0x40DF},
-	{0x4C10, 0x4408},
-	{0x93F8, 0x7E00},
-	{0x4C20, 0x4410},
-	{0x9B7E, 0x7E00},
-	{0x4C40, 0x4420},
-	{0x9802, 0x7E00},
-	{0x4C80, 0x443E},
-	{0x7883, 0x5A02},
-	{0x4D00, 0x4479},
-	{0xCF83, 0x7E00},
-	{0x4E00, 0x44E6},
-	{0x3FF3, 0x3DA4},
-	{0x4F00, 0x454B},
-	{0x0BF0, 0x23F8},
-	{0x4F80, 0x457A},
-	{0x2FF0, 0x35A2},
-	{0x4FC0, 0x4591},
-	{0x6B0E, 0x5383},
-	{0x4FE0, 0x459D},
-	{0x340F, 0x3807},
-	{0x4FF0, 0x45A2},
-	{0x441D, 0x400E},
-	{0x4FF8, 0x45A5},
-	{0x4F40, 0x4563},
-	{0x4FFC, 0x45A7},
-	{0xC818, 0x7E00},
-	{0x4FFE, 0x45A7},
-	{0x1836, 0x29CE},
-	{0x4FFF, 0x45A8},
-	{0xDBDB, 0x7E00},
-	{0x4FFD, 0x45A7},
-	{0x3C00, 0x3C00},
-	{0x4FFB, 0x45A6},
-	{0xEAFE, 0x7E00},
-	{0x4FF7, 0x45A5},
-	{0x75C3, 0x58CD},
-	{0x4FEF, 0x45A2},
-	{0x3078, 0x35FB},
-	{0x4FDF, 0x459C},
-	{0xAE0F, 0x7E00},
-	{0x4FBF, 0x4591},
-	{0x2C37, 0x341B},
-	{0x4F7F, 0x457A},
-	{0x2CAC, 0x3453},
-	{0x4EFF, 0x454A},
-	{0x38F7, 0x3A4D},
-	{0x4DFF, 0x44E6},
-	{0xB3EC, 0x7E00},
-	{0x4CFF, 0x4478},
-	{0x0EFF, 0x254A},
-	{0x4C7F, 0x443E},
-	{0x07E1, 0x219D},
-	{0x4C3F, 0x441F},
-	{0x7C06, 0x7E06},
-	{0x4C1F, 0x440F},
-	{0x345B, 0x382D},
-	{0x4C0F, 0x4407},
-	{0x4C5F, 0x442E},
-	{0x4C07, 0x4403},
-	{0x7C3A, 0x7E3A},
-	{0x4C03, 0x4401},
-	{0xF7FF, 0x7E00},
-	{0x6800, 0x51A8},
-	{0xF85F, 0x7E00},
-	{0x6801, 0x51A9},
-	{0x92D2, 0x7E00},
-	{0x6802, 0x51AA},
-	{0xF53F, 0x7E00},
-	{0x6804, 0x51AB},
-	{0xC3F7, 0x7E00},
-	{0x6808, 0x51AE},
-	{0xB4F7, 0x7E00},
-	{0x6810, 0x51B3},
-	{0x3383, 0x37C1},
-	{0x6820, 0x51BF},
-	{0xEC00, 0x7E00},
-	{0x6840, 0x51D5},
-	{0xCFF7, 0x7E00},
-	{0x6880, 0x5200},
-	{0xD582, 0x7E00},
-	{0x6900, 0x5253},
-	{0x3BEB, 0x3BF5},
-	{0x6A00, 0x52EE},
-	{0x6BED, 0x53F6},
-	{0x6B00, 0x537C},
-	{0x3040, 0x35D5},
-	{0x6B80, 0x53BF},
-	{0xADFF, 0x7E00},
-	{0x6BC0, 0x53E0},
-	{0xCAF7, 0x7E00},
-	{0x6BE0, 0x53F0},
-	{0xBE7E, 0x7E00},
-	{0x6BF0, 0x53F8},
-	{0xA803, 0x7E00},
-	{0x6BF8, 0x53FC},
-	{0xB380, 0x7E00},
-	{0x6BFC, 0x53FE},
-	{0xB488, 0x7E00},
-	{0x6BFE, 0x53FF},
-	{0x813A, 0x7E00},
-	{0x6BFF, 0x53FF},
-	{0xB701, 0x7E00},
-	{0x6BFD, 0x53FE},
-	{0x481B, 0x41BB},
-	{0x6BFB, 0x53FD},
-	{0x2B87, 0x33C3},
-	{0x6BF7, 0x53FB},
-	{0xBFFC, 0x7E00},
-	{0x6BEF, 0x53F7},
-	{0x2C37, 0x341B},
-	{0x6BDF, 0x53EF},
-	{0x744E, 0x5826},
-	{0x6BBF, 0x53DF},
-	{0x8010, 0x7E00},
-	{0x6B7F, 0x53BE},
-	{0x47DC, 0x419B},
-	{0x6AFF, 0x537B},
-	{0x4C11, 0x4408},
-	{0x69FF, 0x52ED},
-	{0xEB04, 0x7E00},
-	{0x68FF, 0x5252},
-	{0x883F, 0x7E00},
-	{0x687F, 0x51FF},
-	{0x03F6, 0x1FF6},
-	{0x683F, 0x51D4},
-	{0x442F, 0x4017},
-	{0x681F, 0x51BE},
-	{0xEFBE, 0x7E00},
-	{0x680F, 0x51B3},
-	{0x4BFF, 0x43FF},
-	{0x6807, 0x51AD},
-	{0xCA37, 0x7E00},
-	{0x6803, 0x51AA},
-	{0x09E0, 0x22DB},
-	{0x7400, 0x5800},
-	{0x3002, 0x35AA},
-	{0x7401, 0x5800},
-	{0x136F, 0x27B6},
-	{0x7402, 0x5801},
-	{0xE985, 0x7E00},
-	{0x7404, 0x5802},
-	{0x1207, 0x26F2},
-	{0x7408, 0x5804},
-	{0xB376, 0x7E00},
-	{0x7410, 0x5808},
-	{0x100C, 0x25B1},
-	{0x7420, 0x5810},
-	{0x0A80, 0x2336},
-	{0x7440, 0x5820},
-	{0xBB56, 0x7E00},
-	{0x7480, 0x583E},
-	{0x3FF2, 0x3DA3},
-	{0x7500, 0x5879},
-	{0x87E7, 0x7E00},
-	{0x7600, 0x58E6},
-	{0x241A, 0x300D},
-	{0x7700, 0x594B},
-	{0x7F00, 0x7F00},
-	{0x7780, 0x597A},
-	{0xC20F, 0x7E00},
-	{0x77C0, 0x5991},
-	{0x8FC4, 0x7E00},
-	{0x77E0, 0x599D},
-	{0xA7FF, 0x7E00},
-	{0x77F0, 0x59A2},
-	{0xD3D9, 0x7E00},
-	{0x77F8, 0x59A5},
-	{0x7BEF, 0x5BF7},
-	{0x77FC, 0x59A7},
-	{0x8603, 0x7E00},
-	{0x77FE, 0x59A7},
-	{0x249F, 0x304D},
-	{0x77FF, 0x59A8},
-	{0x61B7, 0x4EC3},
-	{0x77FD, 0x59A7},
-	{0x2162, 0x2E90},
-	{0x77FB, 0x59A6},
-	{0x802F, 0x7E00},
-	{0x77F7, 0x59A5},
-	{0x8303, 0x7E00},
-	{0x77EF, 0x59A2},
-	{0x2BF0, 0x33F8},
-	{0x77DF, 0x599C},
-	{0x87C8, 0x7E00},
-	{0x77BF, 0x5991},
-	{0xCF69, 0x7E00},
-	{0x777F, 0x597A},
-	{0xBBEB, 0x7E00},
-	{0x76FF, 0x594A},
-	{0x7FFF, 0x7FFF},
-	{0x75FF, 0x58E6},
-	{0xA438, 0x7E00},
-	{0x74FF, 0x5878},
-	{0x4421, 0x4010},
-	{0x747F, 0x583E},
-	{0xD3F2, 0x7E00},
-	{0x743F, 0x581F},
-	{0x345B, 0x382D},
-	{0x741F, 0x580F},
-	{0x383F, 0x39D4},
-	{0x740F, 0x5807},
-	{0xAF81, 0x7E00},
-	{0x7407, 0x5803},
-	{0x90FC, 0x7E00},
-	{0x7403, 0x5801},
-	{0x08FD, 0x2251},
-	{0x7800, 0x59A8},
-	{0x5BFF, 0x4BFF},
-	{0x7801, 0x59A9},
-	{0xCC81, 0x7E00},
-	{0x7802, 0x59AA},
-	{0x1280, 0x2736},
-	{0x7804, 0x59AB},
-	{0xBC07, 0x7E00},
-	{0x7808, 0x59AE},
-	{0x187F, 0x29FF},
-	{0x7810, 0x59B3},
-	{0x35FF, 0x38E6},
-	{0x7820, 0x59BF},
-	{0xF509, 0x7E00},
-	{0x7840, 0x59D5},
-	{0x1000, 0x25A8},
-	{0x7880, 0x5A00},
-	{0x5EFD, 0x4D49},
-	{0x7900, 0x5A53},
-	{0x6BF2, 0x53F9},
-	{0x7A00, 0x5AEE},
-	{0x2D87, 0x34B4},
-	{0x7B00, 0x5B7C},
-	{0xCFDE, 0x7E00},
-	{0x7B80, 0x5BBF},
-	{0xFB77, 0x7E00},
-	{0x7BC0, 0x5BE0},
-	{0x8820, 0x7E00},
-	{0x7BE0, 0x5BF0},
-	{0x11DE, 0x26DA},
-	{0x7BF0, 0x5BF8},
-	{0xF47F, 0x7E00},
-	{0x7BF8, 0x5BFC},
-	{0xDAB2, 0x7E00},
-	{0x7BFC, 0x5BFE},
-	{0x7802, 0x59AA},
-	{0x7BFE, 0x5BFF},
-	{0xA03C, 0x7E00},
-	{0x7BFF, 0x5BFF},
-	{0xBBFD, 0x7E00},
-	{0x7BFD, 0x5BFE},
-	{0x30E2, 0x3640},
-	{0x7BFB, 0x5BFD},
-	{0x305F, 0x35EA},
-	{0x7BF7, 0x5BFB},
-	{0xBD80, 0x7E00},
-	{0x7BEF, 0x5BF7},
-	{0x0040, 0x1800},
-	{0x7BDF, 0x5BEF},
-	{0x84C0, 0x7E00},
-	{0x7BBF, 0x5BDF},
-	{0x1490, 0x2846},
-	{0x7B7F, 0x5BBE},
-	{0xC81D, 0x7E00},
-	{0x7AFF, 0x5B7B},
-	{0xAF7A, 0x7E00},
-	{0x79FF, 0x5AED},
-	{0x3618, 0x38F0},
-	{0x78FF, 0x5A52},
-	{0x194F, 0x2A84},
-	{0x787F, 0x59FF},
-	{0xE83E, 0x7E00},
-	{0x783F, 0x59D4},
-	{0x4FF8, 0x45A5},
-	{0x781F, 0x59BE},
-	{0x2A07, 0x32F2},
-	{0x780F, 0x59B3},
-	{0xB740, 0x7E00},
-	{0x7807, 0x59AD},
-	{0x00FB, 0x1BEC},
-	{0x7803, 0x59AA},
-	{0x3701, 0x394B},
-	{0x7C00, 0x7C00},
-	{0x3445, 0x3822},
-	{0x7C01, 0x7E01},
-	{0xB808, 0x7E00},
-	{0x7C02, 0x7E02},
-	{0xB784, 0x7E00},
-	{0x7C04, 0x7E04},
-	{0x3600, 0x38E6},
-	{0x7C08, 0x7E08},
-	{0xB87F, 0x7E00},
-	{0x7C10, 0x7E10},
-	{0x3BF1, 0x3BF8},
-	{0x7C20, 0x7E20},
-	{0x83A8, 0x7E00},
-	{0x7C40, 0x7E40},
-	{0x3A10, 0x3AF7},
-	{0x7C80, 0x7E80},
-	{0x3FAF, 0x3D8B},
-	{0x7D00, 0x7F00},
-	{0xB052, 0x7E00},
-	{0x7E00, 0x7E00},
-	{0x4130, 0x3E71},
-	{0x7F00, 0x7F00},
-	{0x3C3A, 0x3C1D},
-	{0x7F80, 0x7F80},
-	{0x03FF, 0x1FFF},
-	{0x7FC0, 0x7FC0},
-	{0xAFFF, 0x7E00},
-	{0x7FE0, 0x7FE0},
-	{0x33D0, 0x37E8},
-	{0x7FF0, 0x7FF0},
-	{0xE74D, 0x7E00},
-	{0x7FF8, 0x7FF8},
-	{0x37FA, 0x39A6},
-	{0x7FFC, 0x7FFC},
-	{0xBBEF, 0x7E00},
-	{0x7FFE, 0x7FFE},
-	{0xB97F, 0x7E00},
-	{0x7FFF, 0x7FFF},
-	{0xFCBE, 0xFEBE},
-	{0x7FFD, 0x7FFD},
-	{0x302E, 0x35C8},
-	{0x7FFB, 0x7FFB},
-	{0xD7BE, 0x7E00},
-	{0x7FF7, 0x7FF7},
-	{0xB47E, 0x7E00},
-	{0x7FEF, 0x7FEF},
-	{0xC8E0, 0x7E00},
-	{0x7FDF, 0x7FDF},
-	{0xA481, 0x7E00},
-	{0x7FBF, 0x7FBF},
-	{0x4787, 0x417D},
-	{0x7F7F, 0x7F7F},
-	{0xCFEE, 0x7E00},
-	{0x7EFF, 0x7EFF},
-	{0x43F1, 0x3FF8},
-	{0x7DFF, 0x7FFF},
-	{0x37FE, 0x39A7},
-	{0x7CFF, 0x7EFF},
-	{0x68FE, 0x5252},
-	{0x7C7F, 0x7E7F},
-	{0x901F, 0x7E00},
-	{0x7C3F, 0x7E3F},
-	{0x420C, 0x3EF5},
-	{0x7C1F, 0x7E1F},
-	{0x741C, 0x580E},
-	{0x7C0F, 0x7E0F},
-	{0xDBD3, 0x7E00},
-	{0x7C07, 0x7E07},
-	{0x0B7F, 0x23BE},
-	{0x7C03, 0x7E03},
-	{0x2F7E, 0x3579},
-	{0x8000, 0x8000},
-	{0x440D, 0x4006},
-	{0x8001, 0x7E00},
-	{0x784D, 0x59DE},
-	{0x8002, 0x7E00},
-	{0x6B51, 0x53A7},
-	{0x8004, 0x7E00},
-	{0x2CFF, 0x3478},
-	{0x8008, 0x7E00},
-	{0x47EF, 0x41A2},
-	{0x8010, 0x7E00},
-	{0x3BCE, 0x3BE7},
-	{0x8020, 0x7E00},
-	{0x8865, 0x7E00},
-	{0x8040, 0x7E00},
-	{0xE82B, 0x7E00},
-	{0x8080, 0x7E00},
-	{0x93FF, 0x7E00},
-	{0x8100, 0x7E00},
-	{0x1842, 0x29D6},
-	{0x8200, 0x7E00},
-	{0x379F, 0x3985},
-	{0x8300, 0x7E00},
-	{0xC250, 0x7E00},
-	{0x8380, 0x7E00},
-	{0x3807, 0x39AD},
-	{0x83C0, 0x7E00},
-	{0x3102, 0x3654},
-	{0x83E0, 0x7E00},
-	{0x0327, 0x1F1A},
-	{0x83F0, 0x7E00},
-	{0x83BF, 0x7E00},
-	{0x83F8, 0x7E00},
-	{0x9F6F, 0x7E00},
-	{0x83FC, 0x7E00},
-	{0x782F, 0x59C9},
-	{0x83FE, 0x7E00},
-	{0x120D, 0x26F5},
-	{0x83FF, 0x7E00},
-	{0xB343, 0x7E00},
-	{0x83FD, 0x7E00},
-	{0x36FF, 0x394A},
-	{0x83FB, 0x7E00},
-	{0x4233, 0x3F0B},
-	{0x83F7, 0x7E00},
-	{0xFAFC, 0x7E00},
-	{0x83EF, 0x7E00},
-	{0x3C26, 0x3C13},
-	{0x83DF, 0x7E00},
-	{0x70FD, 0x5651},
-	{0x83BF, 0x7E00},
-	{0xF8B2, 0x7E00},
-	{0x837F, 0x7E00},
-	{0xCAFC, 0x7E00},
-	{0x82FF, 0x7E00},
-	{0xC8C2, 0x7E00},
-	{0x81FF, 0x7E00},
-	{0xC437, 0x7E00},
-	{0x80FF, 0x7E00},
-	{0x8817, 0x7E00},
-	{0x807F, 0x7E00},
-	{0x2FFF, 0x35A8},
-	{0x803F, 0x7E00},
-	{0xAF2A, 0x7E00},
-	{0x801F, 0x7E00},
-	{0xBC38, 0x7E00},
-	{0x800F, 0x7E00},
-	{0xF62B, 0x7E00},
-	{0x8007, 0x7E00},
-	{0x2F9E, 0x3585},
-	{0x8003, 0x7E00},
-	{0xFB5B, 0x7E00},
-	{0x8400, 0x7E00},
-	{0x13F6, 0x27FB},
-	{0x8401, 0x7E00},
-	{0x2381, 0x2FBF},
-	{0x8402, 0x7E00},
-	{0x27D7, 0x319A},
-	{0x8404, 0x7E00},
-	{0xC475, 0x7E00},
-	{0x8408, 0x7E00},
-	{0xC4B4, 0x7E00},
-	{0x8410, 0x7E00},
-	{0x942E, 0x7E00},
-	{0x8420, 0x7E00},
-	{0x3C03, 0x3C01},
-	{0x8440, 0x7E00},
-	{0x23FE, 0x2FFF},
-	{0x8480, 0x7E00},
-	{0x4B5F, 0x43AE},
-	{0x8500, 0x7E00},
-	{0x41FD, 0x3EEC},
-	{0x8600, 0x7E00},
-	{0xF8FD, 0x7E00},
-	{0x8700, 0x7E00},
-	{0x38C0, 0x3A2A},
-	{0x8780, 0x7E00},
-	{0xE81F, 0x7E00},
-	{0x87C0, 0x7E00},
-	{0x917E, 0x7E00},
-	{0x87E0, 0x7E00},
-	{0x4C02, 0x4401},
-	{0x87F0, 0x7E00},
-	{0x4A01, 0x42EE},
-	{0x87F8, 0x7E00},
-	{0x6105, 0x4E56},
-	{0x87FC, 0x7E00},
-	{0x880F, 0x7E00},
-	{0x87FE, 0x7E00},
-	{0x92DC, 0x7E00},
-	{0x87FF, 0x7E00},
-	{0x4BE3, 0x43F1},
-	{0x87FD, 0x7E00},
-	{0x6902, 0x5254},
-	{0x87FB, 0x7E00},
-	{0xC005, 0x7E00},
-	{0x87F7, 0x7E00},
-	{0xBBD8, 0x7E00},
-	{0x87EF, 0x7E00},
-	{0x4607, 0x40E9},
-	{0x87DF, 0x7E00},
-	{0xABC1, 0x7E00},
-	{0x87BF, 0x7E00},
-	{0xEA2C, 0x7E00},
-	{0x877F, 0x7E00},
-	{0xE1FA, 0x7E00},
-	{0x86FF, 0x7E00},
-	{0xC87B, 0x7E00},
-	{0x85FF, 0x7E00},
-	{0x43F1, 0x3FF8},
-	{0x84FF, 0x7E00},
-	{0x87F2, 0x7E00},
-	{0x847F, 0x7E00},
-	{0x403B, 0x3DD1},
-	{0x843F, 0x7E00},
-	{0x87A8, 0x7E00},
-	{0x841F, 0x7E00},
-	{0xC884, 0x7E00},
-	{0x840F, 0x7E00},
-	{0xC7F9, 0x7E00},
-	{0x8407, 0x7E00},
-	{0xBB25, 0x7E00},
-	{0x8403, 0x7E00},
-	{0xFFFB, 0xFFFB},
-	{0x8800, 0x7E00},
-	{0x03E7, 0x1FE7},
-	{0x8801, 0x7E00},
-	{0xCD9E, 0x7E00},
-	{0x8802, 0x7E00},
-	{0xCBF7, 0x7E00},
-	{0x8804, 0x7E00},
-	{0x30F6, 0x364D},
-	{0x8808, 0x7E00},
-	{0x4FCE, 0x4596},
-	{0x8810, 0x7E00},
-	{0x4FBE, 0x4591},
-	{0x8820, 0x7E00},
-	{0x4BEC, 0x43F6},
-	{0x8840, 0x7E00},
-	{0xBE05, 0x7E00},
-	{0x8880, 0x7E00},
-	{0x9204, 0x7E00},
-	{0x8900, 0x7E00},
-	{0x4FFE, 0x45A7},
-	{0x8A00, 0x7E00},
-	{0x4128, 0x3E6C},
-	{0x8B00, 0x7E00},
-	{0x2D61, 0x34A3},
-	{0x8B80, 0x7E00},
-	{0xBADF, 0x7E00},
-	{0x8BC0, 0x7E00},
-	{0x4C42, 0x4420},
-	{0x8BE0, 0x7E00},
-	{0xCF7E, 0x7E00},
-	{0x8BF0, 0x7E00},
-	{0x4CFF, 0x4478},
-	{0x8BF8, 0x7E00},
-	{0x1320, 0x278D},
-	{0x8BFC, 0x7E00},
-	{0x5080, 0x4600},
-	{0x8BFE, 0x7E00},
-	{0x3F8F, 0x3D80},
-	{0x8BFF, 0x7E00},
-	{0x4FFA, 0x45A6},
-	{0x8BFD, 0x7E00},
-	{0x37FE, 0x39A7},
-	{0x8BFB, 0x7E00},
-	{0x81B6, 0x7E00},
-	{0x8BF7, 0x7E00},
-	{0x901F, 0x7E00},
-	{0x8BEF, 0x7E00},
-	{0x77DE, 0x599C},
-	{0x8BDF, 0x7E00},
-	{0xB651, 0x7E00},
-	{0x8BBF, 0x7E00},
-	{0xBFEA, 0x7E00},
-	{0x8B7F, 0x7E00},
-	{0x4D00, 0x4479},
-	{0x8AFF, 0x7E00},
-	{0x037C, 0x1F77},
-	{0x89FF, 0x7E00},
-	{0x0559, 0x20A0},
-	{0x88FF, 0x7E00},
-	{0xB40B, 0x7E00},
-	{0x887F, 0x7E00},
-	{0xBC08, 0x7E00},
-	{0x883F, 0x7E00},
-	{0xE095, 0x7E00},
-	{0x881F, 0x7E00},
-	{0x27AE, 0x318B},
-	{0x880F, 0x7E00},
-	{0xBFE6, 0x7E00},
-	{0x8807, 0x7E00},
-	{0xB081, 0x7E00},
-	{0x8803, 0x7E00},
-	{0xB4CE, 0x7E00},
-	{0x9000, 0x7E00},
-	{0xF0EF, 0x7E00},
-	{0x9001, 0x7E00},
-	{0xC7CF, 0x7E00},
-	{0x9002, 0x7E00},
-	{0x4857, 0x41E4},
-	{0x9004, 0x7E00},
-	{0xFBFE, 0x7E00},
-	{0x9008, 0x7E00},
-	{0x75EE, 0x58DF},
-	{0x9010, 0x7E00},
-	{0xDC0A, 0x7E00},
-	{0x9020, 0x7E00},
-	{0xC86F, 0x7E00},
-	{0x9040, 0x7E00},
-	{0x0430, 0x2018},
-	{0x9080, 0x7E00},
-	{0xE41B, 0x7E00},
-	{0x9100, 0x7E00},
-	{0x51C4, 0x46CB},
-	{0x9200, 0x7E00},
-	{0xC80E, 0x7E00},
-	{0x9300, 0x7E00},
-	{0xB8EE, 0x7E00},
-	{0x9380, 0x7E00},
-	{0x2CFE, 0x3478},
-	{0x93C0, 0x7E00},
-	{0x388F, 0x3A0A},
-	{0x93E0, 0x7E00},
-	{0x74DF, 0x586A},
-	{0x93F0, 0x7E00},
-	{0x4800, 0x41A8},
-	{0x93F8, 0x7E00},
-	{0x0077, 0x1974},
-	{0x93FC, 0x7E00},
-	{0xB004, 0x7E00},
-	{0x93FE, 0x7E00},
-	{0x8BDF, 0x7E00},
-	{0x93FF, 0x7E00},
-	{0x3BFF, 0x3BFF},
-	{0x93FD, 0x7E00},
-	{0x5011, 0x45B4},
-	{0x93FB, 0x7E00},
-	{0x95FF, 0x7E00},
-	{0x93F7, 0x7E00},
-	{0x773E, 0x5962},
-	{0x93EF, 0x7E00},
-	{0x2B14, 0x3386},
-	{0x93DF, 0x7E00},
-	{0xFBD9, 0x7E00},
-	{0x93BF, 0x7E00},
-	{0x7BEF, 0x5BF7},
-	{0x937F, 0x7E00},
-	{0xC080, 0x7E00},
-	{0x92FF, 0x7E00},
-	{0xB7C7, 0x7E00},
-	{0x91FF, 0x7E00},
-	{0x8FCE, 0x7E00},
-	{0x90FF, 0x7E00},
-	{0x43EE, 0x3FF7},
-	{0x907F, 0x7E00},
-	{0xE804, 0x7E00},
-	{0x903F, 0x7E00},
-	{0x84BE, 0x7E00},
-	{0x901F, 0x7E00},
-	{0xB7AE, 0x7E00},
-	{0x900F, 0x7E00},
-	{0x7934, 0x5A74},
-	{0x9007, 0x7E00},
-	{0x106E, 0x25F4},
-	{0x9003, 0x7E00},
-	{0xB3FA, 0x7E00},
-	{0xAC00, 0x7E00},
-	{0x6823, 0x51C1},
-	{0xAC01, 0x7E00},
-	{0xB000, 0x7E00},
-	{0xAC02, 0x7E00},
-	{0x8007, 0x7E00},
-	{0xAC04, 0x7E00},
-	{0xEF02, 0x7E00},
-	{0xAC08, 0x7E00},
-	{0xB003, 0x7E00},
-	{0xAC10, 0x7E00},
-	{0x0740, 0x2163},
-	{0xAC20, 0x7E00},
-	{0xBB33, 0x7E00},
-	{0xAC40, 0x7E00},
-	{0x0B7F, 0x23BE},
-	{0xAC80, 0x7E00},
-	{0x4404, 0x4002},
-	{0xAD00, 0x7E00},
-	{0x9C82, 0x7E00},
-	{0xAE00, 0x7E00},
-	{0x4042, 0x3DD6},
-	{0xAF00, 0x7E00},
-	{0xE9DE, 0x7E00},
-	{0xAF80, 0x7E00},
-	{0xFD1F, 0xFF1F},
-	{0xAFC0, 0x7E00},
-	{0x37FE, 0x39A7},
-	{0xAFE0, 0x7E00},
-	{0xA71F, 0x7E00},
-	{0xAFF0, 0x7E00},
-	{0x8BFF, 0x7E00},
-	{0xAFF8, 0x7E00},
-	{0x8814, 0x7E00},
-	{0xAFFC, 0x7E00},
-	{0x6BE6, 0x53F3},
-	{0xAFFE, 0x7E00},
-	{0xB047, 0x7E00},
-	{0xAFFF, 0x7E00},
-	{0xCC83, 0x7E00},
-	{0xAFFD, 0x7E00},
-	{0x4850, 0x41E0},
-	{0xAFFB, 0x7E00},
-	{0x43CF, 0x3FE7},
-	{0xAFF7, 0x7E00},
-	{0xDCBF, 0x7E00},
-	{0xAFEF, 0x7E00},
-	{0xCAF0, 0x7E00},
-	{0xAFDF, 0x7E00},
-	{0x8BDB, 0x7E00},
-	{0xAFBF, 0x7E00},
-	{0xA4FC, 0x7E00},
-	{0xAF7F, 0x7E00},
-	{0x6002, 0x4DAA},
-	{0xAEFF, 0x7E00},
-	{0x082F, 0x21C9},
-	{0xADFF, 0x7E00},
-	{0xA406, 0x7E00},
-	{0xACFF, 0x7E00},
-	{0xCF46, 0x7E00},
-	{0xAC7F, 0x7E00},
-	{0xB8F8, 0x7E00},
-	{0xAC3F, 0x7E00},
-	{0x6BC2, 0x53E1},
-	{0xAC1F, 0x7E00},
-	{0xD87C, 0x7E00},
-	{0xAC0F, 0x7E00},
-	{0xBBFF, 0x7E00},
-	{0xAC07, 0x7E00},
-	{0xFFFC, 0xFFFC},
-	{0xAC03, 0x7E00},
-	{0xFD24, 0xFF24},
-	{0xB000, 0x7E00},
-	{0xB883, 0x7E00},
-	{0xB001, 0x7E00},
-	{0x00BF, 0x1AE9},
-	{0xB002, 0x7E00},
-	{0x4327, 0x3F90},
-	{0xB004, 0x7E00},
-	{0xAABE, 0x7E00},
-	{0xB008, 0x7E00},
-	{0xF5FF, 0x7E00},
-	{0xB010, 0x7E00},
-	{0x388E, 0x3A09},
-	{0xB020, 0x7E00},
-	{0x3C23, 0x3C11},
-	{0xB040, 0x7E00},
-	{0x881C, 0x7E00},
-	{0xB080, 0x7E00},
-	{0x640F, 0x5007},
-	{0xB100, 0x7E00},
-	{0x03E7, 0x1FE7},
-	{0xB200, 0x7E00},
-	{0x4BC2, 0x43E1},
-	{0xB300, 0x7E00},
-	{0xB202, 0x7E00},
-	{0xB380, 0x7E00},
-	{0xC07E, 0x7E00},
-	{0xB3C0, 0x7E00},
-	{0xB476, 0x7E00},
-	{0xB3E0, 0x7E00},
-	{0xBA0F, 0x7E00},
-	{0xB3F0, 0x7E00},
-	{0xA2EB, 0x7E00},
-	{0xB3F8, 0x7E00},
-	{0x2C10, 0x3408},
-	{0xB3FC, 0x7E00},
-	{0xBFE6, 0x7E00},
-	{0xB3FE, 0x7E00},
-	{0x8BEF, 0x7E00},
-	{0xB3FF, 0x7E00},
-	{0x3F7E, 0x3D79},
-	{0xB3FD, 0x7E00},
-	{0x5306, 0x477F},
-	{0xB3FB, 0x7E00},
-	{0xBF02, 0x7E00},
-	{0xB3F7, 0x7E00},
-	{0x3C00, 0x3C00},
-	{0xB3EF, 0x7E00},
-	{0x4005, 0x3DAC},
-	{0xB3DF, 0x7E00},
-	{0xB3F1, 0x7E00},
-	{0xB3BF, 0x7E00},
-	{0xF7FE, 0x7E00},
-	{0xB37F, 0x7E00},
-	{0xC020, 0x7E00},
-	{0xB2FF, 0x7E00},
-	{0xB052, 0x7E00},
-	{0xB1FF, 0x7E00},
-	{0x4BFF, 0x43FF},
-	{0xB0FF, 0x7E00},
-	{0xFEDF, 0xFEDF},
-	{0xB07F, 0x7E00},
-	{0xBB19, 0x7E00},
-	{0xB03F, 0x7E00},
-	{0x3FF6, 0x3DA5},
-	{0xB01F, 0x7E00},
-	{0xB33D, 0x7E00},
-	{0xB00F, 0x7E00},
-	{0xFC00, 0x7E00},
-	{0xB007, 0x7E00},
-	{0xCC0E, 0x7E00},
-	{0xB003, 0x7E00},
-	{0xCA68, 0x7E00},
-	{0xB400, 0x7E00},
-	{0x3DFE, 0x3CE5},
-	{0xB401, 0x7E00},
-	{0x385C, 0x39E8},
-	{0xB402, 0x7E00},
-	{0x77C3, 0x5992},
-	{0xB404, 0x7E00},
-	{0xC6F7, 0x7E00},
-	{0xB408, 0x7E00},
-	{0xBBFE, 0x7E00},
-	{0xB410, 0x7E00},
-	{0xC4BE, 0x7E00},
-	{0xB420, 0x7E00},
-	{0xC64E, 0x7E00},
-	{0xB440, 0x7E00},
-	{0x39BF, 0x3AC8},
-	{0xB480, 0x7E00},
-	{0x3BCF, 0x3BE7},
-	{0xB500, 0x7E00},
-	{0x33FF, 0x37FF},
-	{0xB600, 0x7E00},
-	{0x24FF, 0x3078},
-	{0xB700, 0x7E00},
-	{0xB85F, 0x7E00},
-	{0xB780, 0x7E00},
-	{0x1199, 0x26B1},
-	{0xB7C0, 0x7E00},
-	{0x907F, 0x7E00},
-	{0xB7E0, 0x7E00},
-	{0x1307, 0x277F},
-	{0xB7F0, 0x7E00},
-	{0x936E, 0x7E00},
-	{0xB7F8, 0x7E00},
-	{0xF88F, 0x7E00},
-	{0xB7FC, 0x7E00},
-	{0x1830, 0x29CA},
-	{0xB7FE, 0x7E00},
-	{0x74E5, 0x586D},
-	{0xB7FF, 0x7E00},
-	{0x3DF7, 0x3CE2},
-	{0xB7FD, 0x7E00},
-	{0x43DB, 0x3FED},
-	{0xB7FB, 0x7E00},
-	{0xD943, 0x7E00},
-	{0xB7F7, 0x7E00},
-	{0xC11E, 0x7E00},
-	{0xB7EF, 0x7E00},
-	{0xC48F, 0x7E00},
-	{0xB7DF, 0x7E00},
-	{0xF3F1, 0x7E00},
-	{0xB7BF, 0x7E00},
-	{0xBBFD, 0x7E00},
-	{0xB77F, 0x7E00},
-	{0x39CB, 0x3ACF},
-	{0xB6FF, 0x7E00},
-	{0x789F, 0x5A15},
-	{0xB5FF, 0x7E00},
-	{0xBF1F, 0x7E00},
-	{0xB4FF, 0x7E00},
-	{0x5F02, 0x4D4B},
-	{0xB47F, 0x7E00},
-	{0x1F42, 0x2D63},
-	{0xB43F, 0x7E00},
-	{0xC3BB, 0x7E00},
-	{0xB41F, 0x7E00},
-	{0xCC1B, 0x7E00},
-	{0xB40F, 0x7E00},
-	{0xBD83, 0x7E00},
-	{0xB407, 0x7E00},
-	{0x43BF, 0x3FDF},
-	{0xB403, 0x7E00},
-	{0xC801, 0x7E00},
-	{0xB800, 0x7E00},
-	{0x52EF, 0x4773},
-	{0xB801, 0x7E00},
-	{0x0C17, 0x240B},
-	{0xB802, 0x7E00},
-	{0xE42F, 0x7E00},
-	{0xB804, 0x7E00},
-	{0xBFFF, 0x7E00},
-	{0xB808, 0x7E00},
-	{0x3FFB, 0x3DA6},
-	{0xB810, 0x7E00},
-	{0x03E3, 0x1FE3},
-	{0xB820, 0x7E00},
-	{0x7FF2, 0x7FF2},
-	{0xB840, 0x7E00},
-	{0x7E42, 0x7E42},
-	{0xB880, 0x7E00},
-	{0x06FD, 0x2149},
-	{0xB900, 0x7E00},
-	{0xB7E7, 0x7E00},
-	{0xBA00, 0x7E00},
-	{0x4808, 0x41AE},
-	{0xBB00, 0x7E00},
-	{0xBC0C, 0x7E00},
-	{0xBB80, 0x7E00},
-	{0x4E3F, 0x4500},
-	{0xBBC0, 0x7E00},
-	{0x22FF, 0x2F7B},
-	{0xBBE0, 0x7E00},
-	{0xAFF9, 0x7E00},
-	{0xBBF0, 0x7E00},
-	{0x2229, 0x2F05},
-	{0xBBF8, 0x7E00},
-	{0x3299, 0x3744},
-	{0xBBFC, 0x7E00},
-	{0xFFE0, 0xFFE0},
-	{0xBBFE, 0x7E00},
-	{0xBFFE, 0x7E00},
-	{0xBBFF, 0x7E00},
-	{0xD846, 0x7E00},
-	{0xBBFD, 0x7E00},
-	{0x3A38, 0x3B0E},
-	{0xBBFB, 0x7E00},
-	{0x0804, 0x21AB},
-	{0xBBF7, 0x7E00},
-	{0x39BE, 0x3AC7},
-	{0xBBEF, 0x7E00},
-	{0xFC04, 0xFE04},
-	{0xBBDF, 0x7E00},
-	{0x649F, 0x504D},
-	{0xBBBF, 0x7E00},
-	{0x77FF, 0x59A8},
-	{0xBB7F, 0x7E00},
-	{0x661E, 0x50F2},
-	{0xBAFF, 0x7E00},
-	{0xA682, 0x7E00},
-	{0xB9FF, 0x7E00},
-	{0xCDEE, 0x7E00},
-	{0xB8FF, 0x7E00},
-	{0xC7F3, 0x7E00},
-	{0xB87F, 0x7E00},
-	{0x3082, 0x3601},
-	{0xB83F, 0x7E00},
-	{0x475C, 0x416D},
-	{0xB81F, 0x7E00},
-	{0xB371, 0x7E00},
-	{0xB80F, 0x7E00},
-	{0x06C3, 0x2133},
-	{0xB807, 0x7E00},
-	{0xED12, 0x7E00},
-	{0xB803, 0x7E00},
-	{0x8228, 0x7E00},
-	{0xBC00, 0x7E00},
-	{0xF426, 0x7E00},
-	{0xBC01, 0x7E00},
-	{0x37F8, 0x39A5},
-	{0xBC02, 0x7E00},
-	{0xC7FF, 0x7E00},
-	{0xBC04, 0x7E00},
-	{0x7FF7, 0x7FF7},
-	{0xBC08, 0x7E00},
-	{0xB801, 0x7E00},
-	{0xBC10, 0x7E00},
-	{0x3710, 0x3951},
-	{0xBC20, 0x7E00},
-	{0x8803, 0x7E00},
-	{0xBC40, 0x7E00},
-	{0x106E, 0x25F4},
-	{0xBC80, 0x7E00},
-	{0x4AFE, 0x437B},
-	{0xBD00, 0x7E00},
-	{0x3508, 0x387C},
-	{0xBE00, 0x7E00},
-	{0xAEF7, 0x7E00},
-	{0xBF00, 0x7E00},
-	{0x6788, 0x517D},
-	{0xBF80, 0x7E00},
-	{0x56F7, 0x4947},
-	{0xBFC0, 0x7E00},
-	{0xE7FD, 0x7E00},
-	{0xBFE0, 0x7E00},
-	{0x2DF0, 0x34E0},
-	{0xBFF0, 0x7E00},
-	{0xF42F, 0x7E00},
-	{0xBFF8, 0x7E00},
-	{0x461F, 0x40F3},
-	{0xBFFC, 0x7E00},
-	{0xE902, 0x7E00},
-	{0xBFFE, 0x7E00},
-	{0x4BAB, 0x43D5},
-	{0xBFFF, 0x7E00},
-	{0x7F46, 0x7F46},
-	{0xBFFD, 0x7E00},
-	{0x6A3F, 0x5312},
-	{0xBFFB, 0x7E00},
-	{0xC8C0, 0x7E00},
-	{0xBFF7, 0x7E00},
-	{0x3AFF, 0x3B7B},
-	{0xBFEF, 0x7E00},
-	{0x69CD, 0x52D0},
-	{0xBFDF, 0x7E00},
-	{0x7E12, 0x7E12},
-	{0xBFBF, 0x7E00},
-	{0xDC20, 0x7E00},
-	{0xBF7F, 0x7E00},
-	{0xC7D0, 0x7E00},
-	{0xBEFF, 0x7E00},
-	{0x490F, 0x425D},
-	{0xBDFF, 0x7E00},
-	{0x3A52, 0x3B1C},
-	{0xBCFF, 0x7E00},
-	{0xEBDB, 0x7E00},
-	{0xBC7F, 0x7E00},
-	{0x3823, 0x39C1},
-	{0xBC3F, 0x7E00},
-	{0x93BE, 0x7E00},
-	{0xBC1F, 0x7E00},
-	{0x5EF7, 0x4D47},
-	{0xBC0F, 0x7E00},
-	{0x4702, 0x414B},
-	{0xBC07, 0x7E00},
-	{0x9030, 0x7E00},
-	{0xBC03, 0x7E00},
-	{0xA5FF, 0x7E00},
-	{0xC000, 0x7E00},
-	{0x2FDC, 0x359B},
-	{0xC001, 0x7E00},
-	{0x78FF, 0x5A52},
-	{0xC002, 0x7E00},
-	{0xEBDC, 0x7E00},
-	{0xC004, 0x7E00},
-	{0x93FE, 0x7E00},
-	{0xC008, 0x7E00},
-	{0x6B00, 0x537C},
-	{0xC010, 0x7E00},
-	{0x80FB, 0x7E00},
-	{0xC020, 0x7E00},
-	{0x3924, 0x3A6A},
-	{0xC040, 0x7E00},
-	{0x3788, 0x397D},
-	{0xC080, 0x7E00},
-	{0x2814, 0x31B6},
-	{0xC100, 0x7E00},
-	{0x11DC, 0x26D9},
-	{0xC200, 0x7E00},
-	{0x4420, 0x4010},
-	{0xC300, 0x7E00},
-	{0xE7F0, 0x7E00},
-	{0xC380, 0x7E00},
-	{0xF801, 0x7E00},
-	{0xC3C0, 0x7E00},
-	{0x7D00, 0x7F00},
-	{0xC3E0, 0x7E00},
-	{0x4D4C, 0x449A},
-	{0xC3F0, 0x7E00},
-	{0x4381, 0x3FBF},
-	{0xC3F8, 0x7E00},
-	{0x7CBE, 0x7EBE},
-	{0xC3FC, 0x7E00},
-	{0x4FEF, 0x45A2},
-	{0xC3FE, 0x7E00},
-	{0x3AFC, 0x3B7A},
-	{0xC3FF, 0x7E00},
-	{0x6EFC, 0x5549},
-	{0xC3FD, 0x7E00},
-	{0xA04D, 0x7E00},
-	{0xC3FB, 0x7E00},
-	{0x4002, 0x3DAA},
-	{0xC3F7, 0x7E00},
-	{0xC01B, 0x7E00},
-	{0xC3EF, 0x7E00},
-	{0x3B86, 0x3BC2},
-	{0xC3DF, 0x7E00},
-	{0x47F1, 0x41A3},
-	{0xC3BF, 0x7E00},
-	{0x643E, 0x501F},
-	{0xC37F, 0x7E00},
-	{0xFF4D, 0xFF4D},
-	{0xC2FF, 0x7E00},
-	{0x9BC0, 0x7E00},
-	{0xC1FF, 0x7E00},
-	{0x77FF, 0x59A8},
-	{0xC0FF, 0x7E00},
-	{0x3016, 0x35B8},
-	{0xC07F, 0x7E00},
-	{0xC508, 0x7E00},
-	{0xC03F, 0x7E00},
-	{0x3788, 0x397D},
-	{0xC01F, 0x7E00},
-	{0x37BD, 0x3990},
-	{0xC00F, 0x7E00},
-	{0xF97C, 0x7E00},
-	{0xC007, 0x7E00},
-	{0xB377, 0x7E00},
-	{0xC003, 0x7E00},
-	{0xFF7F, 0xFF7F},
-	{0xC400, 0x7E00},
-	{0x3340, 0x379E},
-	{0xC401, 0x7E00},
-	{0x3E7F, 0x3D19},
-	{0xC402, 0x7E00},
-	{0xD500, 0x7E00},
-	{0xC404, 0x7E00},
-	{0x2AEB, 0x3370},
-	{0xC408, 0x7E00},
-	{0xCFC1, 0x7E00},
-	{0xC410, 0x7E00},
-	{0x080B, 0x21B0},
-	{0xC420, 0x7E00},
-	{0x18F7, 0x2A4D},
-	{0xC440, 0x7E00},
-	{0x967F, 0x7E00},
-	{0xC480, 0x7E00},
-	{0x07DE, 0x219C},
-	{0xC500, 0x7E00},
-	{0x3FDF, 0x3D9C},
-	{0xC600, 0x7E00},
-	{0x9E77, 0x7E00},
-	{0xC700, 0x7E00},
-	{0x91E0, 0x7E00},
-	{0xC780, 0x7E00},
-	{0xCFE0, 0x7E00},
-	{0xC7C0, 0x7E00},
-	{0xF402, 0x7E00},
-	{0xC7E0, 0x7E00},
-	{0xBFE7, 0x7E00},
-	{0xC7F0, 0x7E00},
-	{0xB430, 0x7E00},
-	{0xC7F8, 0x7E00},
-	{0x10FE, 0x2652},
-	{0xC7FC, 0x7E00},
-	{0xCDC9, 0x7E00},
-	{0xC7FE, 0x7E00},
-	{0x4040, 0x3DD5},
-	{0xC7FF, 0x7E00},
-	{0x6904, 0x5256},
-	{0xC7FD, 0x7E00},
-	{0x7A04, 0x5AF0},
-	{0xC7FB, 0x7E00},
-	{0xBFEE, 0x7E00},
-	{0xC7F7, 0x7E00},
-	{0x07BA, 0x218F},
-	{0xC7EF, 0x7E00},
-	{0x801E, 0x7E00},
-	{0xC7DF, 0x7E00},
-	{0x35FE, 0x38E5},
-	{0xC7BF, 0x7E00},
-	{0x745F, 0x582E},
-	{0xC77F, 0x7E00},
-	{0x5D74, 0x4CAC},
-	{0xC6FF, 0x7E00},
-	{0x4847, 0x41DA},
-	{0xC5FF, 0x7E00},
-	{0x39DF, 0x3ADA},
-	{0xC4FF, 0x7E00},
-	{0x37D7, 0x399A},
-	{0xC47F, 0x7E00},
-	{0xC440, 0x7E00},
-	{0xC43F, 0x7E00},
-	{0xCFFA, 0x7E00},
-	{0xC41F, 0x7E00},
-	{0xE026, 0x7E00},
-	{0xC40F, 0x7E00},
-	{0x3806, 0x39AC},
-	{0xC407, 0x7E00},
-	{0x3C21, 0x3C10},
-	{0xC403, 0x7E00},
-	{0x13F8, 0x27FC},
-	{0xC800, 0x7E00},
-	{0x442E, 0x4017},
-	{0xC801, 0x7E00},
-	{0xCFF3, 0x7E00},
-	{0xC802, 0x7E00},
-	{0x2B90, 0x33C7},
-	{0xC804, 0x7E00},
-	{0x8CC0, 0x7E00},
-	{0xC808, 0x7E00},
-	{0xBBF3, 0x7E00},
-	{0xC810, 0x7E00},
-	{0x8949, 0x7E00},
-	{0xC820, 0x7E00},
-	{0xB083, 0x7E00},
-	{0xC840, 0x7E00},
-	{0xB7FF, 0x7E00},
-	{0xC880, 0x7E00},
-	{0x6765, 0x5170},
-	{0xC900, 0x7E00},
-	{0x443F, 0x401F},
-	{0xCA00, 0x7E00},
-	{0xFDAB, 0xFFAB},
-	{0xCB00, 0x7E00},
-	{0x03FF, 0x1FFF},
-	{0xCB80, 0x7E00},
-	{0x4B0C, 0x4382},
-	{0xCBC0, 0x7E00},
-	{0x7FF2, 0x7FF2},
-	{0xCBE0, 0x7E00},
-	{0xAFE8, 0x7E00},
-	{0xCBF0, 0x7E00},
-	{0x82F4, 0x7E00},
-	{0xCBF8, 0x7E00},
-	{0xC7E1, 0x7E00},
-	{0xCBFC, 0x7E00},
-	{0x2CFB, 0x3477},
-	{0xCBFE, 0x7E00},
-	{0x2C7A, 0x343B},
-	{0xCBFF, 0x7E00},
-	{0xFC47, 0xFE47},
-	{0xCBFD, 0x7E00},
-	{0x546E, 0x4836},
-	{0xCBFB, 0x7E00},
-	{0xCAFD, 0x7E00},
-	{0xCBF7, 0x7E00},
-	{0x2000, 0x2DA8},
-	{0xCBEF, 0x7E00},
-	{0x3DA8, 0x3CC2},
-	{0xCBDF, 0x7E00},
-	{0x6B83, 0x53C1},
-	{0xCBBF, 0x7E00},
-	{0x05C6, 0x20CE},
-	{0xCB7F, 0x7E00},
-	{0x4AB0, 0x4350},
-	{0xCAFF, 0x7E00},
-	{0xF93F, 0x7E00},
-	{0xC9FF, 0x7E00},
-	{0xB487, 0x7E00},
-	{0xC8FF, 0x7E00},
-	{0xFBA8, 0x7E00},
-	{0xC87F, 0x7E00},
-	{0x3FEA, 0x3DA0},
-	{0xC83F, 0x7E00},
-	{0x34DB, 0x3868},
-	{0xC81F, 0x7E00},
-	{0xD47F, 0x7E00},
-	{0xC80F, 0x7E00},
-	{0x2C87, 0x3441},
-	{0xC807, 0x7E00},
-	{0x126D, 0x272B},
-	{0xC803, 0x7E00},
-	{0xC7FC, 0x7E00},
-	{0xCC00, 0x7E00},
-	{0x346F, 0x3836},
-	{0xCC01, 0x7E00},
-	{0x78F6, 0x5A4D},
-	{0xCC02, 0x7E00},
-	{0xD4C0, 0x7E00},
-	{0xCC04, 0x7E00},
-	{0x4268, 0x3F29},
-	{0xCC08, 0x7E00},
-	{0x3D0F, 0x3C80},
-	{0xCC10, 0x7E00},
-	{0x9AFC, 0x7E00},
-	{0xCC20, 0x7E00},
-	{0xC822, 0x7E00},
-	{0xCC40, 0x7E00},
-	{0x0C23, 0x2411},
-	{0xCC80, 0x7E00},
-	{0xD807, 0x7E00},
-	{0xCD00, 0x7E00},
-	{0x2DFE, 0x34E5},
-	{0xCE00, 0x7E00},
-	{0x903A, 0x7E00},
-	{0xCF00, 0x7E00},
-	{0x13CA, 0x27E5},
-	{0xCF80, 0x7E00},
-	{0xFFFC, 0xFFFC},
-	{0xCFC0, 0x7E00},
-	{0x0806, 0x21AC},
-	{0xCFE0, 0x7E00},
-	{0xF7D7, 0x7E00},
-	{0xCFF0, 0x7E00},
-	{0x441D, 0x400E},
-	{0xCFF8, 0x7E00},
-	{0x435F, 0x3FAE},
-	{0xCFFC, 0x7E00},
-	{0x3BFB, 0x3BFD},
-	{0xCFFE, 0x7E00},
-	{0x3BF3, 0x3BF9},
-	{0xCFFF, 0x7E00},
-	{0x0780, 0x217A},
-	{0xCFFD, 0x7E00},
-	{0x91F3, 0x7E00},
-	{0xCFFB, 0x7E00},
-	{0xFC0A, 0xFE0A},
-	{0xCFF7, 0x7E00},
-	{0x2C3C, 0x341E},
-	{0xCFEF, 0x7E00},
-	{0x3DFC, 0x3CE5},
-	{0xCFDF, 0x7E00},
-	{0x3400, 0x3800},
-	{0xCFBF, 0x7E00},
-	{0x4EEF, 0x4544},
-	{0xCF7F, 0x7E00},
-	{0xE81D, 0x7E00},
-	{0xCEFF, 0x7E00},
-	{0x583C, 0x49D2},
-	{0xCDFF, 0x7E00},
-	{0xF529, 0x7E00},
-	{0xCCFF, 0x7E00},
-	{0x9880, 0x7E00},
-	{0xCC7F, 0x7E00},
-	{0x443B, 0x401D},
-	{0xCC3F, 0x7E00},
-	{0xCC12, 0x7E00},
-	{0xCC1F, 0x7E00},
-	{0x820F, 0x7E00},
-	{0xCC0F, 0x7E00},
-	{0x10C0, 0x262A},
-	{0xCC07, 0x7E00},
-	{0x7C1E, 0x7E1E},
-	{0xCC03, 0x7E00},
-	{0xADFF, 0x7E00},
-	{0xE800, 0x7E00},
-	{0x5C37, 0x4C1B},
-	{0xE801, 0x7E00},
-	{0x4BF0, 0x43F8},
-	{0xE802, 0x7E00},
-	{0xCD07, 0x7E00},
-	{0xE804, 0x7E00},
-	{0x3FC9, 0x3D95},
-	{0xE808, 0x7E00},
-	{0xFF7C, 0xFF7C},
-	{0xE810, 0x7E00},
-	{0xCF5E, 0x7E00},
-	{0xE820, 0x7E00},
-	{0x473F, 0x4162},
-	{0xE840, 0x7E00},
-	{0xBFFF, 0x7E00},
-	{0xE880, 0x7E00},
-	{0xF741, 0x7E00},
-	{0xE900, 0x7E00},
-	{0xB484, 0x7E00},
-	{0xEA00, 0x7E00},
-	{0x4D50, 0x449C},
-	{0xEB00, 0x7E00},
-	{0xE843, 0x7E00},
-	{0xEB80, 0x7E00},
-	{0x47F3, 0x41A4},
-	{0xEBC0, 0x7E00},
-	{0xCC00, 0x7E00},
-	{0xEBE0, 0x7E00},
-	{0x7C1A, 0x7E1A},
-	{0xEBF0, 0x7E00},
-	{0xBD8D, 0x7E00},
-	{0xEBF8, 0x7E00},
-	{0xCC7E, 0x7E00},
-	{0xEBFC, 0x7E00},
-	{0xC7C2, 0x7E00},
-	{0xEBFE, 0x7E00},
-	{0x45FC, 0x40E5},
-	{0xEBFF, 0x7E00},
-	{0xAD9B, 0x7E00},
-	{0xEBFD, 0x7E00},
-	{0xB006, 0x7E00},
-	{0xEBFB, 0x7E00},
-	{0x1080, 0x2600},
-	{0xEBF7, 0x7E00},
-	{0xF457, 0x7E00},
-	{0xEBEF, 0x7E00},
-	{0x51C7, 0x46CC},
-	{0xEBDF, 0x7E00},
-	{0x3BC2, 0x3BE1},
-	{0xEBBF, 0x7E00},
-	{0x67FE, 0x51A7},
-	{0xEB7F, 0x7E00},
-	{0xBFF4, 0x7E00},
-	{0xEAFF, 0x7E00},
-	{0x42E8, 0x3F6F},
-	{0xE9FF, 0x7E00},
-	{0xB0BF, 0x7E00},
-	{0xE8FF, 0x7E00},
-	{0x4438, 0x401C},
-	{0xE87F, 0x7E00},
-	{0x01FB, 0x1DA1},
-	{0xE83F, 0x7E00},
-	{0x43F7, 0x3FFB},
-	{0xE81F, 0x7E00},
-	{0xAD02, 0x7E00},
-	{0xE80F, 0x7E00},
-	{0x780B, 0x59B0},
-	{0xE807, 0x7E00},
-	{0x1C02, 0x2C01},
-	{0xE803, 0x7E00},
-	{0xC0FF, 0x7E00},
-	{0xF400, 0x7E00},
-	{0x39A8, 0x3ABA},
-	{0xF401, 0x7E00},
-	{0x3333, 0x3797},
-	{0xF402, 0x7E00},
-	{0xB7D8, 0x7E00},
-	{0xF404, 0x7E00},
-	{0xC82F, 0x7E00},
-	{0xF408, 0x7E00},
-	{0x2D08, 0x347C},
-	{0xF410, 0x7E00},
-	{0x111E, 0x2666},
-	{0xF420, 0x7E00},
-	{0x27BF, 0x3191},
-	{0xF440, 0x7E00},
-	{0x41FA, 0x3EEA},
-	{0xF480, 0x7E00},
-	{0x803F, 0x7E00},
-	{0xF500, 0x7E00},
-	{0x33C7, 0x37E3},
-	{0xF600, 0x7E00},
-	{0xAF7E, 0x7E00},
-	{0xF700, 0x7E00},
-	{0xFF86, 0xFF86},
-	{0xF780, 0x7E00},
-	{0x903F, 0x7E00},
-	{0xF7C0, 0x7E00},
-	{0x8669, 0x7E00},
-	{0xF7E0, 0x7E00},
-	{0x8404, 0x7E00},
-	{0xF7F0, 0x7E00},
-	{0xAC1F, 0x7E00},
-	{0xF7F8, 0x7E00},
-	{0x847F, 0x7E00},
-	{0xF7FC, 0x7E00},
-	{0xF6BC, 0x7E00},
-	{0xF7FE, 0x7E00},
-	{0xC3D8, 0x7E00},
-	{0xF7FF, 0x7E00},
-	{0x0081, 0x19AE},
-	{0xF7FD, 0x7E00},
-	{0x4BF3, 0x43F9},
-	{0xF7FB, 0x7E00},
-	{0x0012, 0x143E},
-	{0xF7F7, 0x7E00},
-	{0x3F16, 0x3D53},
-	{0xF7EF, 0x7E00},
-	{0x4490, 0x4046},
-	{0xF7DF, 0x7E00},
-	{0x477C, 0x4179},
-	{0xF7BF, 0x7E00},
-	{0xBFFE, 0x7E00},
-	{0xF77F, 0x7E00},
-	{0xF40A, 0x7E00},
-	{0xF6FF, 0x7E00},
-	{0x7BFF, 0x5BFF},
-	{0xF5FF, 0x7E00},
-	{0xC9F0, 0x7E00},
-	{0xF4FF, 0x7E00},
-	{0x3567, 0x38A6},
-	{0xF47F, 0x7E00},
-	{0x3771, 0x3975},
-	{0xF43F, 0x7E00},
-	{0x6EF7, 0x5547},
-	{0xF41F, 0x7E00},
-	{0xB37A, 0x7E00},
-	{0xF40F, 0x7E00},
-	{0x9F1F, 0x7E00},
-	{0xF407, 0x7E00},
-	{0x74F6, 0x5874},
-	{0xF403, 0x7E00},
-	{0xB769, 0x7E00},
-	{0xF800, 0x7E00},
-	{0xB00F, 0x7E00},
-	{0xF801, 0x7E00},
-	{0xFFDC, 0xFFDC},
-	{0xF802, 0x7E00},
-	{0xB4BE, 0x7E00},
-	{0xF804, 0x7E00},
-	{0x6BFB, 0x53FD},
-	{0xF808, 0x7E00},
-	{0xB307, 0x7E00},
-	{0xF810, 0x7E00},
-	{0xBBD0, 0x7E00},
-	{0xF820, 0x7E00},
-	{0x3CFF, 0x3C78},
-	{0xF840, 0x7E00},
-	{0xCEE5, 0x7E00},
-	{0xF880, 0x7E00},
-	{0xBBCF, 0x7E00},
-	{0xF900, 0x7E00},
-	{0xCBE2, 0x7E00},
-	{0xFA00, 0x7E00},
-	{0xAE39, 0x7E00},
-	{0xFB00, 0x7E00},
-	{0xF4DF, 0x7E00},
-	{0xFB80, 0x7E00},
-	{0x7FD7, 0x7FD7},
-	{0xFBC0, 0x7E00},
-	{0x22BF, 0x2F59},
-	{0xFBE0, 0x7E00},
-	{0xB500, 0x7E00},
-	{0xFBF0, 0x7E00},
-	{0x04BF, 0x205B},
-	{0xFBF8, 0x7E00},
-	{0xB400, 0x7E00},
-	{0xFBFC, 0x7E00},
-	{0xB3D8, 0x7E00},
-	{0xFBFE, 0x7E00},
-	{0x3F7C, 0x3D79},
-	{0xFBFF, 0x7E00},
-	{0xBAFA, 0x7E00},
-	{0xFBFD, 0x7E00},
-	{0x8A40, 0x7E00},
-	{0xFBFB, 0x7E00},
-	{0x090E, 0x225C},
-	{0xFBF7, 0x7E00},
-	{0xBF56, 0x7E00},
-	{0xFBEF, 0x7E00},
-	{0x06FE, 0x214A},
-	{0xFBDF, 0x7E00},
-	{0x7FDF, 0x7FDF},
-	{0xFBBF, 0x7E00},
-	{0xE80D, 0x7E00},
-	{0xFB7F, 0x7E00},
-	{0xC7B6, 0x7E00},
-	{0xFAFF, 0x7E00},
-	{0xF906, 0x7E00},
-	{0xF9FF, 0x7E00},
-	{0x3BFB, 0x3BFD},
-	{0xF8FF, 0x7E00},
-	{0xBF82, 0x7E00},
-	{0xF87F, 0x7E00},
-	{0x49FB, 0x42EB},
-	{0xF83F, 0x7E00},
-	{0xC007, 0x7E00},
-	{0xF81F, 0x7E00},
-	{0xABE1, 0x7E00},
-	{0xF80F, 0x7E00},
-	{0x4C87, 0x4441},
-	{0xF807, 0x7E00},
-	{0x0375, 0x1F70},
-	{0xF803, 0x7E00},
-	{0xC970, 0x7E00},
-	{0xFC00, 0x7E00},
-	{0xB41C, 0x7E00},
-	{0xFC01, 0xFE01},
-	{0x47F3, 0x41A4},
-	{0xFC02, 0xFE02},
-	{0x3FEB, 0x3DA1},
-	{0xFC04, 0xFE04},
-	{0xFD1D, 0xFF1D},
-	{0xFC08, 0xFE08},
-	{0x68AC, 0x521D},
-	{0xFC10, 0xFE10},
-	{0x1272, 0x272E},
-	{0xFC20, 0xFE20},
-	{0x0BF8, 0x23FC},
-	{0xFC40, 0xFE40},
-	{0xB4E0, 0x7E00},
-	{0xFC80, 0xFE80},
-	{0xB429, 0x7E00},
-	{0xFD00, 0xFF00},
-	{0xBC04, 0x7E00},
-	{0xFE00, 0xFE00},
-	{0x5840, 0x49D5},
-	{0xFF00, 0xFF00},
-	{0xCFF9, 0x7E00},
-	{0xFF80, 0xFF80},
-	{0xE00F, 0x7E00},
-	{0xFFC0, 0xFFC0},
-	{0xB6EE, 0x7E00},
-	{0xFFE0, 0xFFE0},
-	{0x15FC, 0x28E5},
-	{0xFFF0, 0xFFF0},
-	{0x643C, 0x501E},
-	{0xFFF8, 0xFFF8},
-	{0xC2FE, 0x7E00},
-	{0xFFFC, 0xFFFC},
-	{0x3BF1, 0x3BF8},
-	{0xFFFE, 0xFFFE},
-	{0xFFE8, 0xFFE8},
-	{0xFFFF, 0xFFFF},
-	{0x4FA0, 0x4586},
-	{0xFFFD, 0xFFFD},
-	{0x5483, 0x4840},
-	{0xFFFB, 0xFFFB},
-	{0x6C87, 0x5441},
-	{0xFFF7, 0xFFF7},
-	{0x497E, 0x42A1},
-	{0xFFEF, 0xFFEF},
-	{0x3BBF, 0x3BDF},
-	{0xFFDF, 0xFFDF},
-	{0x4F13, 0x4552},
-	{0xFFBF, 0xFFBF},
-	{0xF81D, 0x7E00},
-	{0xFF7F, 0xFF7F},
-	{0xF43D, 0x7E00},
-	{0xFEFF, 0xFEFF},
-	{0x3A1F, 0x3AFF},
-	{0xFDFF, 0xFFFF},
-	{0xB7FE, 0x7E00},
-	{0xFCFF, 0xFEFF},
-	{0x6CFA, 0x5476},
-	{0xFC7F, 0xFE7F},
-	{0x3E08, 0x3CE9},
-	{0xFC3F, 0xFE3F},
-	{0x7AF8, 0x5B77},
-	{0xFC1F, 0xFE1F},
-	{0x362E, 0x38F9},
-	{0xFC0F, 0xFE0F},
-	{0xE8FD, 0x7E00},
-	{0xFC07, 0xFE07},
-	{0x56FF, 0x494A},
-	{0xFC03, 0xFE03},
-}
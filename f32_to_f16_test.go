@@ -1,8810 +0,0 @@
-// Code generated by scripts/f32_to_f16.pl; DO NOT EDIT.
-
-package float16
-
-var f32ToF16 = []struct {
-	f32 uint32
-	f16 uint16
-}{
-
-	{0xDE7F8006, 0xFC00},
-	{0x00000000, 0x0000},
-	{0xBC27FFFF, 0xA140},
-	{0x00000001, 0x0000},
-	{0x54FE0080, 0x7C00},
-	{0x00000002, 0x0000},
-	{0x3E81FBFF, 0x3410},
-	{0x00000004, 0x0000},
-	{0x3EFFFC00, 0x3800},
-	{0x00000008, 0x0000},
-	{0xE6000004, 0xFC00},
-	{0x00000010, 0x0000},
-	{0x4D7FFFF7, 0x7C00},
-	{0x00000020, 0x0000},
-	{0x5F81EFFF, 0x7C00},
-	{0x00000040, 0x0000},
-	{0x015B51BA, 0x0000},
-	{0x00000080, 0x0000},
-	{0x8083FF80, 0x8000},
-	{0x00000100, 0x0000},
-	{0x9E0FFFFB, 0x8000},
-	{0x00000200, 0x0000},
-	{0xBE60001E, 0xB300},
-	{0x00000400, 0x0000},
-	{0x7F6ECA00, 0x7C00},
-	{0x00000800, 0x0000},
-	{0x4688003F, 0x7440},
-	{0x00001000, 0x0000},
-	{0xBFFFFE00, 0xC000},
-	{0x00002000, 0x0000},
-	{0xC07FFF83, 0xC400},
-	{0x00004000, 0x0000},
-	{0x8A800102, 0x8000},
-	{0x00008000, 0x0000},
-	{0xDBDFF000, 0xFC00},
-	{0x00010000, 0x0000},
-	{0xB381F800, 0x8001},
-	{0x00020000, 0x0000},
-	{0x3B8D2B8F, 0x1C69},
-	{0x00040000, 0x0000},
-	{0xCF3D39C8, 0xFC00},
-	{0x00080000, 0x0000},
-	{0xDC000480, 0xFC00},
-	{0x00100000, 0x0000},
-	{0x80001FDF, 0x8000},
-	{0x00200000, 0x0000},
-	{0xCF4DC544, 0xFC00},
-	{0x00400000, 0x0000},
-	{0xBF9FEFFF, 0xBCFF},
-	{0x00600000, 0x0000},
-	{0xCFFF9FFE, 0xFC00},
-	{0x00700000, 0x0000},
-	{0x92BDFFFF, 0x8000},
-	{0x00780000, 0x0000},
-	{0x50FA0000, 0x7C00},
-	{0x007C0000, 0x0000},
-	{0xBE7B2F98, 0xB3D9},
-	{0x007E0000, 0x0000},
-	{0xDE7E894E, 0xFC00},
-	{0x007F0000, 0x0000},
-	{0xB4FF7BFF, 0x8008},
-	{0x007F8000, 0x0000},
-	{0x409DE3FD, 0x44EF},
-	{0x007FC000, 0x0000},
-	{0xC00F12BE, 0xC079},
-	{0x007FE000, 0x0000},
-	{0xBE0C5DC2, 0xB063},
-	{0x007FF000, 0x0000},
-	{0x2B2826E4, 0x0000},
-	{0x007FF800, 0x0000},
-	{0x3CFFFF88, 0x2800},
-	{0x007FFC00, 0x0000},
-	{0xC1FC6F19, 0xCFE3},
-	{0x007FFE00, 0x0000},
-	{0x411FFFFF, 0x4900},
-	{0x007FFF00, 0x0000},
-	{0xBE01FFFB, 0xB010},
-	{0x007FFF80, 0x0000},
-	{0x33817FFF, 0x0001},
-	{0x007FFFC0, 0x0000},
-	{0x5BFBFFFE, 0x7C00},
-	{0x007FFFE0, 0x0000},
-	{0x6D1E9CF1, 0x7C00},
-	{0x007FFFF0, 0x0000},
-	{0xDF880002, 0xFC00},
-	{0x007FFFF8, 0x0000},
-	{0x3171FFFE, 0x0000},
-	{0x007FFFFC, 0x0000},
-	{0x4F7FDFFB, 0x7C00},
-	{0x007FFFFE, 0x0000},
-	{0x268004FE, 0x0000},
-	{0x007FFFFF, 0x0000},
-	{0x40FFFCFE, 0x4800},
-	{0x007FFFFD, 0x0000},
-	{0xBF2000FF, 0xB900},
-	{0x007FFFFB, 0x0000},
-	{0x40FFE000, 0x47FF},
-	{0x007FFFF7, 0x0000},
-	{0x33F0003F, 0x0002},
-	{0x007FFFEF, 0x0000},
-	{0x0003FFF0, 0x0000},
-	{0x007FFFDF, 0x0000},
-	{0x80FFF006, 0x8000},
-	{0x007FFFBF, 0x0000},
-	{0xBDFFFF3F, 0xB000},
-	{0x007FFF7F, 0x0000},
-	{0xC38203FF, 0xDC10},
-	{0x007FFEFF, 0x0000},
-	{0x1C1001FF, 0x0000},
-	{0x007FFDFF, 0x0000},
-	{0xDF6B24CF, 0xFC00},
-	{0x007FFBFF, 0x0000},
-	{0xCF1DDD8B, 0xFC00},
-	{0x007FF7FF, 0x0000},
-	{0x4E07FC00, 0x7C00},
-	{0x007FEFFF, 0x0000},
-	{0x48B960D5, 0x7C00},
-	{0x007FDFFF, 0x0000},
-	{0xC4FF003F, 0xE7F8},
-	{0x007FBFFF, 0x0000},
-	{0x33FFBBFF, 0x0002},
-	{0x007F7FFF, 0x0000},
-	{0x5F400000, 0x7C00},
-	{0x007EFFFF, 0x0000},
-	{0xC400403F, 0xE002},
-	{0x007DFFFF, 0x0000},
-	{0xCF811FFF, 0xFC00},
-	{0x007BFFFF, 0x0000},
-	{0x000080FF, 0x0000},
-	{0x0077FFFF, 0x0000},
-	{0x7FA07FFF, 0x7F03},
-	{0x006FFFFF, 0x0000},
-	{0xC07FFFFE, 0xC400},
-	{0x005FFFFF, 0x0000},
-	{0x33FE1FFF, 0x0002},
-	{0x003FFFFF, 0x0000},
-	{0xC171FFFE, 0xCB90},
-	{0x001FFFFF, 0x0000},
-	{0xDFF70000, 0xFC00},
-	{0x000FFFFF, 0x0000},
-	{0xC10000BE, 0xC800},
-	{0x0007FFFF, 0x0000},
-	{0xDEFF01FE, 0xFC00},
-	{0x0003FFFF, 0x0000},
-	{0xE63550A8, 0xFC00},
-	{0x0001FFFF, 0x0000},
-	{0xB97F7FFF, 0x8BFC},
-	{0x0000FFFF, 0x0000},
-	{0x5FDFFFFC, 0x7C00},
-	{0x00007FFF, 0x0000},
-	{0x5004007F, 0x7C00},
-	{0x00003FFF, 0x0000},
-	{0x412886C8, 0x4944},
-	{0x00001FFF, 0x0000},
-	{0x384594A1, 0x0316},
-	{0x00000FFF, 0x0000},
-	{0x857000FF, 0x8000},
-	{0x000007FF, 0x0000},
-	{0xB3820007, 0x8001},
-	{0x000003FF, 0x0000},
-	{0xD080000E, 0xFC00},
-	{0x000001FF, 0x0000},
-	{0x3F7FFFF8, 0x3C00},
-	{0x000000FF, 0x0000},
-	{0xCEFFFFF7, 0xFC00},
-	{0x0000007F, 0x0000},
-	{0x81D00000, 0x8000},
-	{0x0000003F, 0x0000},
-	{0xC302007F, 0xD810},
-	{0x0000001F, 0x0000},
-	{0xCDAEAE26, 0xFC00},
-	{0x0000000F, 0x0000},
-	{0xDF7F001E, 0xFC00},
-	{0x00000007, 0x0000},
-	{0x40F80100, 0x47C0},
-	{0x00000003, 0x0000},
-	{0x4053FE63, 0x42A0},
-	{0x00800000, 0x0000},
-	{0xBE000240, 0xB000},
-	{0x00800001, 0x0000},
-	{0xFF1EFFFE, 0xFC00},
-	{0x00800002, 0x0000},
-	{0x43FE1000, 0x5FF0},
-	{0x00800004, 0x0000},
-	{0x3E8001F7, 0x3400},
-	{0x00800008, 0x0000},
-	{0x4192D1A2, 0x4C97},
-	{0x00800010, 0x0000},
-	{0xC620007F, 0xF100},
-	{0x00800020, 0x0000},
-	{0xB3F03FFF, 0x8002},
-	{0x00800040, 0x0000},
-	{0xDB7FF9FF, 0xFC00},
-	{0x00800080, 0x0000},
-	{0xC000001C, 0xC000},
-	{0x00800100, 0x0000},
-	{0xBE60066D, 0xB300},
-	{0x00800200, 0x0000},
-	{0x357F9FFF, 0x0010},
-	{0x00800400, 0x0000},
-	{0xC270007F, 0xD380},
-	{0x00800800, 0x0000},
-	{0x5EFFFF9F, 0x7C00},
-	{0x00801000, 0x0000},
-	{0xBF809FFF, 0xBC05},
-	{0x00802000, 0x0000},
-	{0x7F0001BE, 0x7C00},
-	{0x00804000, 0x0000},
-	{0x7F200FFF, 0x7C00},
-	{0x00808000, 0x0000},
-	{0x3DBFFFFA, 0x2E00},
-	{0x00810000, 0x0000},
-	{0x3E32D1D8, 0x3197},
-	{0x00820000, 0x0000},
-	{0xBF800810, 0xBC00},
-	{0x00840000, 0x0000},
-	{0xA0FE0000, 0x8000},
-	{0x00880000, 0x0000},
-	{0xDE1F7FFE, 0xFC00},
-	{0x00900000, 0x0000},
-	{0xC17FF007, 0xCC00},
-	{0x00A00000, 0x0000},
-	{0xD4FFEBFF, 0xFC00},
-	{0x00C00000, 0x0000},
-	{0x3383F800, 0x0001},
-	{0x00E00000, 0x0000},
-	{0xC03FDFFF, 0xC1FF},
-	{0x00F00000, 0x0000},
-	{0xC06F2337, 0xC379},
-	{0x00F80000, 0x0000},
-	{0x3E17FFFF, 0x30C0},
-	{0x00FC0000, 0x0000},
-	{0x447FFFC2, 0x6400},
-	{0x00FE0000, 0x0000},
-	{0x5100043F, 0x7C00},
-	{0x00FF0000, 0x0000},
-	{0x4F8006FE, 0x7C00},
-	{0x00FF8000, 0x0000},
-	{0xBC7FFFFF, 0xA400},
-	{0x00FFC000, 0x0000},
-	{0xCB8023FF, 0xFC00},
-	{0x00FFE000, 0x0000},
-	{0x500FE000, 0x7C00},
-	{0x00FFF000, 0x0000},
-	{0x51600006, 0x7C00},
-	{0x00FFF800, 0x0000},
-	{0xCF8000BE, 0xFC00},
-	{0x00FFFC00, 0x0000},
-	{0x43DFF7FE, 0x5F00},
-	{0x00FFFE00, 0x0000},
-	{0xDE7FFFFE, 0xFC00},
-	{0x00FFFF00, 0x0000},
-	{0xFE803FFF, 0xFC00},
-	{0x00FFFF80, 0x0000},
-	{0x812DDFF5, 0x8000},
-	{0x00FFFFC0, 0x0000},
-	{0x5EA6019A, 0x7C00},
-	{0x00FFFFE0, 0x0000},
-	{0xC0C00C0D, 0xC600},
-	{0x00FFFFF0, 0x0000},
-	{0x2880005F, 0x0000},
-	{0x00FFFFF8, 0x0000},
-	{0x3CFFF6FF, 0x2800},
-	{0x00FFFFFC, 0x0000},
-	{0x408407FE, 0x4420},
-	{0x00FFFFFE, 0x0000},
-	{0xC187FFF7, 0xCC40},
-	{0x00FFFFFF, 0x0000},
-	{0xDE19D05E, 0xFC00},
-	{0x00FFFFFD, 0x0000},
-	{0x1B601FE9, 0x0000},
-	{0x00FFFFFB, 0x0000},
-	{0xC37F00FF, 0xDBF8},
-	{0x00FFFFF7, 0x0000},
-	{0xC18FFFF7, 0xCC80},
-	{0x00FFFFEF, 0x0000},
-	{0xBA1AB145, 0x90D6},
-	{0x00FFFFDF, 0x0000},
-	{0x7E87C000, 0x7C00},
-	{0x00FFFFBF, 0x0000},
-	{0x80FFE0FF, 0x8000},
-	{0x00FFFF7F, 0x0000},
-	{0xDEFFF7DF, 0xFC00},
-	{0x00FFFEFF, 0x0000},
-	{0xBD8FFF80, 0xAC80},
-	{0x00FFFDFF, 0x0000},
-	{0x3F7FF001, 0x3C00},
-	{0x00FFFBFF, 0x0000},
-	{0x00FF40C5, 0x0000},
-	{0x00FFF7FF, 0x0000},
-	{0x40807FEF, 0x4404},
-	{0x00FFEFFF, 0x0000},
-	{0x0003FFFD, 0x0000},
-	{0x00FFDFFF, 0x0000},
-	{0x3A7FF7FE, 0x1400},
-	{0x00FFBFFF, 0x0000},
-	{0x3DAC8475, 0x2D64},
-	{0x00FF7FFF, 0x0000},
-	{0xCE7AD7C3, 0xFC00},
-	{0x00FEFFFF, 0x0000},
-	{0x005FC000, 0x0000},
-	{0x00FDFFFF, 0x0000},
-	{0xFFFFF9FE, 0xFFFF},
-	{0x00FBFFFF, 0x0000},
-	{0xE9FFFFFE, 0xFC00},
-	{0x00F7FFFF, 0x0000},
-	{0xFFB40EFF, 0xFFA0},
-	{0x00EFFFFF, 0x0000},
-	{0xBC2000FF, 0xA100},
-	{0x00DFFFFF, 0x0000},
-	{0xA0000000, 0x8000},
-	{0x00BFFFFF, 0x0000},
-	{0x73FFAFFF, 0x7C00},
-	{0x009FFFFF, 0x0000},
-	{0x7F9FC651, 0x7EFE},
-	{0x008FFFFF, 0x0000},
-	{0xBE7DFFFC, 0xB3F0},
-	{0x0087FFFF, 0x0000},
-	{0xBF38C9A0, 0xB9C6},
-	{0x0083FFFF, 0x0000},
-	{0x3EA87A26, 0x3544},
-	{0x0081FFFF, 0x0000},
-	{0xBCFF6FFE, 0xA7FB},
-	{0x0080FFFF, 0x0000},
-	{0x8035D1F1, 0x8000},
-	{0x00807FFF, 0x0000},
-	{0x3E80043E, 0x3400},
-	{0x00803FFF, 0x0000},
-	{0x440001EF, 0x6000},
-	{0x00801FFF, 0x0000},
-	{0xDE83BFFF, 0xFC00},
-	{0x00800FFF, 0x0000},
-	{0xC0F0001F, 0xC780},
-	{0x008007FF, 0x0000},
-	{0x4D002007, 0x7C00},
-	{0x008003FF, 0x0000},
-	{0xDF7FFC1E, 0xFC00},
-	{0x008001FF, 0x0000},
-	{0x3DFFF7FB, 0x3000},
-	{0x008000FF, 0x0000},
-	{0xC180003C, 0xCC00},
-	{0x0080007F, 0x0000},
-	{0x417E03FF, 0x4BF0},
-	{0x0080003F, 0x0000},
-	{0x33804080, 0x0001},
-	{0x0080001F, 0x0000},
-	{0x44837FFF, 0x641C},
-	{0x0080000F, 0x0000},
-	{0xCF868977, 0xFC00},
-	{0x00800007, 0x0000},
-	{0xC1FDFFF6, 0xCFF0},
-	{0x00800003, 0x0000},
-	{0x58FEEFFE, 0x7C00},
-	{0x01000000, 0x0000},
-	{0x3F009FFE, 0x3805},
-	{0x01000001, 0x0000},
-	{0x3E000800, 0x3000},
-	{0x01000002, 0x0000},
-	{0x4AFE79BD, 0x7C00},
-	{0x01000004, 0x0000},
-	{0xBE00021E, 0xB000},
-	{0x01000008, 0x0000},
-	{0xC2FF9FFF, 0xD7FD},
-	{0x01000010, 0x0000},
-	{0x40B80343, 0x45C0},
-	{0x01000020, 0x0000},
-	{0xDED7FFFF, 0xFC00},
-	{0x01000040, 0x0000},
-	{0xCB8D374A, 0xFC00},
-	{0x01000080, 0x0000},
-	{0xC080047F, 0xC400},
-	{0x01000100, 0x0000},
-	{0xBC800003, 0xA400},
-	{0x01000200, 0x0000},
-	{0x3EFEFFBF, 0x37F8},
-	{0x01000400, 0x0000},
-	{0xBE203FFF, 0xB102},
-	{0x01000800, 0x0000},
-	{0x3FFF7FF6, 0x3FFC},
-	{0x01001000, 0x0000},
-	{0xB88FFF80, 0x8480},
-	{0x01002000, 0x0000},
-	{0xBF48B580, 0xBA46},
-	{0x01004000, 0x0000},
-	{0x40E9DDC3, 0x474F},
-	{0x01008000, 0x0000},
-	{0xB2FDFFBE, 0x8000},
-	{0x01010000, 0x0000},
-	{0x3FFC00FE, 0x3FE0},
-	{0x01020000, 0x0000},
-	{0xF7FFFBEE, 0xFC00},
-	{0x01040000, 0x0000},
-	{0x0783F7FF, 0x0000},
-	{0x01080000, 0x0000},
-	{0x4E000046, 0x7C00},
-	{0x01100000, 0x0000},
-	{0xBE00009F, 0xB000},
-	{0x01200000, 0x0000},
-	{0xB180017F, 0x8000},
-	{0x01400000, 0x0000},
-	{0x7E811FFF, 0x7C00},
-	{0x01600000, 0x0000},
-	{0x008007BF, 0x0000},
-	{0x01700000, 0x0000},
-	{0x3380400F, 0x0001},
-	{0x01780000, 0x0000},
-	{0xBA00207F, 0x9001},
-	{0x017C0000, 0x0000},
-	{0xF400001E, 0xFC00},
-	{0x017E0000, 0x0000},
-	{0xC8225B76, 0xFC00},
-	{0x017F0000, 0x0000},
-	{0xBDEFFFFC, 0xAF80},
-	{0x017F8000, 0x0000},
-	{0x377FDDFF, 0x0100},
-	{0x017FC000, 0x0000},
-	{0xC1083FFE, 0xC842},
-	{0x017FE000, 0x0000},
-	{0xB7013FFF, 0x8081},
-	{0x017FF000, 0x0000},
-	{0x0088000E, 0x0000},
-	{0x017FF800, 0x0000},
-	{0xB9010002, 0x8808},
-	{0x017FFC00, 0x0000},
-	{0x4E7FFA00, 0x7C00},
-	{0x017FFE00, 0x0000},
-	{0x48BF3515, 0x7C00},
-	{0x017FFF00, 0x0000},
-	{0xC02B6288, 0xC15B},
-	{0x017FFF80, 0x0000},
-	{0x3E040800, 0x3020},
-	{0x017FFFC0, 0x0000},
-	{0x4D040001, 0x7C00},
-	{0x017FFFE0, 0x0000},
-	{0xCF002002, 0xFC00},
-	{0x017FFFF0, 0x0000},
-	{0x353FFFFF, 0x000C},
-	{0x017FFFF8, 0x0000},
-	{0x7F806FFF, 0x7E03},
-	{0x017FFFFC, 0x0000},
-	{0xB3803F7F, 0x8001},
-	{0x017FFFFE, 0x0000},
-	{0xBF7FFFAF, 0xBC00},
-	{0x017FFFFF, 0x0000},
-	{0x3EFF03FF, 0x37F8},
-	{0x017FFFFD, 0x0000},
-	{0xC6A0003F, 0xF500},
-	{0x017FFFFB, 0x0000},
-	{0x3EDFFFF7, 0x3700},
-	{0x017FFFF7, 0x0000},
-	{0xEF7FF0FF, 0xFC00},
-	{0x017FFFEF, 0x0000},
-	{0x3EBDFFFF, 0x35F0},
-	{0x017FFFDF, 0x0000},
-	{0x4F5FFFF7, 0x7C00},
-	{0x017FFFBF, 0x0000},
-	{0xDF9FFFF8, 0xFC00},
-	{0x017FFF7F, 0x0000},
-	{0x2A017FFE, 0x0000},
-	{0x017FFEFF, 0x0000},
-	{0xC13647B3, 0xC9B2},
-	{0x017FFDFF, 0x0000},
-	{0x32FFFFF6, 0x0000},
-	{0x017FFBFF, 0x0000},
-	{0xDF83FFFF, 0xFC00},
-	{0x017FF7FF, 0x0000},
-	{0x39FFB837, 0x0FFE},
-	{0x017FEFFF, 0x0000},
-	{0xBDFFBFFB, 0xAFFE},
-	{0x017FDFFF, 0x0000},
-	{0xDEE754F8, 0xFC00},
-	{0x017FBFFF, 0x0000},
-	{0xC2F4AAA6, 0xD7A5},
-	{0x017F7FFF, 0x0000},
-	{0x3484000E, 0x0004},
-	{0x017EFFFF, 0x0000},
-	{0x3A7FFEFF, 0x1400},
-	{0x017DFFFF, 0x0000},
-	{0x7C700000, 0x7C00},
-	{0x017BFFFF, 0x0000},
-	{0xBF002020, 0xB801},
-	{0x0177FFFF, 0x0000},
-	{0xBFFC07FE, 0xBFE0},
-	{0x016FFFFF, 0x0000},
-	{0xCF34BE62, 0xFC00},
-	{0x015FFFFF, 0x0000},
-	{0x4F002001, 0x7C00},
-	{0x013FFFFF, 0x0000},
-	{0x00803FDF, 0x0000},
-	{0x011FFFFF, 0x0000},
-	{0x404001FF, 0x4200},
-	{0x010FFFFF, 0x0000},
-	{0xC500023E, 0xE800},
-	{0x0107FFFF, 0x0000},
-	{0x80E36FD5, 0x8000},
-	{0x0103FFFF, 0x0000},
-	{0x4E67FFFF, 0x7C00},
-	{0x0101FFFF, 0x0000},
-	{0x0100001E, 0x0000},
-	{0x0100FFFF, 0x0000},
-	{0x4100001B, 0x4800},
-	{0x01007FFF, 0x0000},
-	{0xC07FFFD7, 0xC400},
-	{0x01003FFF, 0x0000},
-	{0x40FDFFFA, 0x47F0},
-	{0x01001FFF, 0x0000},
-	{0xB3882000, 0x8001},
-	{0x01000FFF, 0x0000},
-	{0xBEFFFEF6, 0xB800},
-	{0x010007FF, 0x0000},
-	{0xFFFFF7FD, 0xFFFF},
-	{0x010003FF, 0x0000},
-	{0x807FFF7F, 0x8000},
-	{0x010001FF, 0x0000},
-	{0x5F7FFF7D, 0x7C00},
-	{0x010000FF, 0x0000},
-	{0x74327D5B, 0x7C00},
-	{0x0100007F, 0x0000},
-	{0x46700007, 0x7380},
-	{0x0100003F, 0x0000},
-	{0xBFE03FFF, 0xBF02},
-	{0x0100001F, 0x0000},
-	{0x4F800005, 0x7C00},
-	{0x0100000F, 0x0000},
-	{0xFF0CA2C8, 0xFC00},
-	{0x01000007, 0x0000},
-	{0x26FFFDFB, 0x0000},
-	{0x01000003, 0x0000},
-	{0x4B1FFFBE, 0x7C00},
-	{0x33800000, 0x0001},
-	{0xCF0FFFFF, 0xFC00},
-	{0x33800001, 0x0001},
-	{0xBFFFC000, 0xBFFE},
-	{0x33800002, 0x0001},
-	{0x4F00077F, 0x7C00},
-	{0x33800004, 0x0001},
-	{0x417FC004, 0x4BFE},
-	{0x33800008, 0x0001},
-	{0x3C7E007F, 0x23F0},
-	{0x33800010, 0x0001},
-	{0xFF2001FE, 0xFC00},
-	{0x33800020, 0x0001},
-	{0x3BED98E2, 0x1F6D},
-	{0x33800040, 0x0001},
-	{0x44010000, 0x6008},
-	{0x33800080, 0x0001},
-	{0x5E7FDFBF, 0x7C00},
-	{0x33800100, 0x0001},
-	{0xE700400E, 0xFC00},
-	{0x33800200, 0x0001},
-	{0x3E7E001E, 0x33F0},
-	{0x33800400, 0x0001},
-	{0xD9701FFF, 0xFC00},
-	{0x33800800, 0x0001},
-	{0x3FB3ED7C, 0x3D9F},
-	{0x33801000, 0x0001},
-	{0x4E26E426, 0x7C00},
-	{0x33802000, 0x0001},
-	{0x4201C000, 0x500E},
-	{0x33804000, 0x0001},
-	{0xB3E003FE, 0x8002},
-	{0x33808000, 0x0001},
-	{0xCE3F22CD, 0xFC00},
-	{0x33810000, 0x0001},
-	{0xB8937E1B, 0x849C},
-	{0x33820000, 0x0001},
-	{0xA97F01FE, 0x8000},
-	{0x33840000, 0x0001},
-	{0xC520003F, 0xE900},
-	{0x33880000, 0x0001},
-	{0xBDFEFEFE, 0xAFF8},
-	{0x33900000, 0x0001},
-	{0xDF000FDE, 0xFC00},
-	{0x33A00000, 0x0001},
-	{0x3FDFFBFF, 0x3F00},
-	{0x33C00000, 0x0002},
-	{0x3F808FFF, 0x3C04},
-	{0x33E00000, 0x0002},
-	{0xBFFFFFF6, 0xC000},
-	{0x33F00000, 0x0002},
-	{0xCF011FFF, 0xFC00},
-	{0x33F80000, 0x0002},
-	{0x7F5FAD9C, 0x7C00},
-	{0x33FC0000, 0x0002},
-	{0xDEFFFF00, 0xFC00},
-	{0x33FE0000, 0x0002},
-	{0x4B94DEAB, 0x7C00},
-	{0x33FF0000, 0x0002},
-	{0x7E38177C, 0x7C00},
-	{0x33FF8000, 0x0002},
-	{0xC0F7FF80, 0xC7C0},
-	{0x33FFC000, 0x0002},
-	{0xC37FC03E, 0xDBFE},
-	{0x33FFE000, 0x0002},
-	{0x43800000, 0x5C00},
-	{0x33FFF000, 0x0002},
-	{0xB80FFFBF, 0x8240},
-	{0x33FFF800, 0x0002},
-	{0x417C0200, 0x4BE0},
-	{0x33FFFC00, 0x0002},
-	{0x809FFFF6, 0x8000},
-	{0x33FFFE00, 0x0002},
-	{0xCFFFEEFF, 0xFC00},
-	{0x33FFFF00, 0x0002},
-	{0xB3803FFF, 0x8001},
-	{0x33FFFF80, 0x0002},
-	{0x5F11FFFF, 0x7C00},
-	{0x33FFFFC0, 0x0002},
-	{0x5E010080, 0x7C00},
-	{0x33FFFFE0, 0x0002},
-	{0xC1DE4EAD, 0xCEF2},
-	{0x33FFFFF0, 0x0002},
-	{0xFEDCDA07, 0xFC00},
-	{0x33FFFFF8, 0x0002},
-	{0xCC9DA9AF, 0xFC00},
-	{0x33FFFFFC, 0x0002},
-	{0xBE820000, 0xB410},
-	{0x33FFFFFE, 0x0002},
-	{0xCE01F7FF, 0xFC00},
-	{0x33FFFFFF, 0x0002},
-	{0xB4FFC0FE, 0x8008},
-	{0x33FFFFFD, 0x0002},
-	{0x3E9000FF, 0x3480},
-	{0x33FFFFFB, 0x0002},
-	{0x467FFFBA, 0x7400},
-	{0x33FFFFF7, 0x0002},
-	{0x3B801FFE, 0x1C01},
-	{0x33FFFFEF, 0x0002},
-	{0xB3FF5629, 0x8002},
-	{0x33FFFFDF, 0x0002},
-	{0xBDFFFC7E, 0xB000},
-	{0x33FFFFBF, 0x0002},
-	{0xBF08000F, 0xB840},
-	{0x33FFFF7F, 0x0002},
-	{0xC0804007, 0xC402},
-	{0x33FFFEFF, 0x0002},
-	{0x3D2001FE, 0x2900},
-	{0x33FFFDFF, 0x0002},
-	{0x41800021, 0x4C00},
-	{0x33FFFBFF, 0x0002},
-	{0x3EFFFFFF, 0x3800},
-	{0x33FFF7FF, 0x0002},
-	{0xCFBEFFFE, 0xFC00},
-	{0x33FFEFFF, 0x0002},
-	{0x4EFFFFFE, 0x7C00},
-	{0x33FFDFFF, 0x0002},
-	{0xDF7F1000, 0xFC00},
-	{0x33FFBFFF, 0x0002},
-	{0x000D366B, 0x0000},
-	{0x33FF7FFF, 0x0002},
-	{0x4C7DFFF7, 0x7C00},
-	{0x33FEFFFF, 0x0002},
-	{0x8057B61A, 0x8000},
-	{0x33FDFFFF, 0x0002},
-	{0x807FFE7F, 0x8000},
-	{0x33FBFFFF, 0x0002},
-	{0xCE8081FE, 0xFC00},
-	{0x33F7FFFF, 0x0002},
-	{0xC0A1E2CA, 0xC50F},
-	{0x33EFFFFF, 0x0002},
-	{0x4BF755B1, 0x7C00},
-	{0x33DFFFFF, 0x0002},
-	{0xCFFFC00F, 0xFC00},
-	{0x33BFFFFF, 0x0001},
-	{0x3FFBFFF7, 0x3FE0},
-	{0x339FFFFF, 0x0001},
-	{0x412D6130, 0x496B},
-	{0x338FFFFF, 0x0001},
-	{0x407FF002, 0x4400},
-	{0x3387FFFF, 0x0001},
-	{0xCA7FF3FF, 0xFC00},
-	{0x3383FFFF, 0x0001},
-	{0xC1801007, 0xCC01},
-	{0x3381FFFF, 0x0001},
-	{0x80FFFFC6, 0x8000},
-	{0x3380FFFF, 0x0001},
-	{0x5EC3B5FC, 0x7C00},
-	{0x33807FFF, 0x0001},
-	{0x227FE003, 0x0000},
-	{0x33803FFF, 0x0001},
-	{0xCEA41829, 0xFC00},
-	{0x33801FFF, 0x0001},
-	{0x4841FFB9, 0x7C00},
-	{0x33800FFF, 0x0001},
-	{0xC1564AFA, 0xCAB2},
-	{0x338007FF, 0x0001},
-	{0x7EFFBFFF, 0x7C00},
-	{0x338003FF, 0x0001},
-	{0x390EE029, 0x0877},
-	{0x338001FF, 0x0001},
-	{0xCE7FFEE0, 0xFC00},
-	{0x338000FF, 0x0001},
-	{0x420007FC, 0x5000},
-	{0x3380007F, 0x0001},
-	{0x5F73FFFF, 0x7C00},
-	{0x3380003F, 0x0001},
-	{0x80FFF7F6, 0x8000},
-	{0x3380001F, 0x0001},
-	{0xDAECA4B4, 0xFC00},
-	{0x3380000F, 0x0001},
-	{0x3E7E0003, 0x33F0},
-	{0x33800007, 0x0001},
-	{0x7FC0F25E, 0x7E07},
-	{0x33800003, 0x0001},
-	{0xBEEFDFFF, 0xB77F},
-	{0x3D800000, 0x2C00},
-	{0xA6B91E6B, 0x8000},
-	{0x3D800001, 0x2C00},
-	{0x4A59B9D6, 0x7C00},
-	{0x3D800002, 0x2C00},
-	{0xDE20000E, 0xFC00},
-	{0x3D800004, 0x2C00},
-	{0x4BBDE170, 0x7C00},
-	{0x3D800008, 0x2C00},
-	{0x3F800406, 0x3C00},
-	{0x3D800010, 0x2C00},
-	{0x717FFBF7, 0x7C00},
-	{0x3D800020, 0x2C00},
-	{0x41007FFE, 0x4804},
-	{0x3D800040, 0x2C00},
-	{0xBD7FFFBD, 0xAC00},
-	{0x3D800080, 0x2C00},
-	{0xCBFFFD7F, 0xFC00},
-	{0x3D800100, 0x2C00},
-	{0xDF03FFE0, 0xFC00},
-	{0x3D800200, 0x2C00},
-	{0xC381001F, 0xDC08},
-	{0x3D800400, 0x2C00},
-	{0x3D000100, 0x2800},
-	{0x3D800800, 0x2C00},
-	{0xB47FEFFD, 0x8004},
-	{0x3D801000, 0x2C00},
-	{0xD36D0D9A, 0xFC00},
-	{0x3D802000, 0x2C01},
-	{0xCEFFFC20, 0xFC00},
-	{0x3D804000, 0x2C02},
-	{0x7F81FFF0, 0x7E0F},
-	{0x3D808000, 0x2C04},
-	{0xBEFF0003, 0xB7F8},
-	{0x3D810000, 0x2C08},
-	{0xCE0B3454, 0xFC00},
-	{0x3D820000, 0x2C10},
-	{0x3DFFE004, 0x2FFF},
-	{0x3D840000, 0x2C20},
-	{0x3FEFFEFF, 0x3F80},
-	{0x3D880000, 0x2C40},
-	{0x41A0FFFF, 0x4D08},
-	{0x3D900000, 0x2C80},
-	{0xCE801001, 0xFC00},
-	{0x3DA00000, 0x2D00},
-	{0x44E374A7, 0x671C},
-	{0x3DC00000, 0x2E00},
-	{0xBE1B81DC, 0xB0DC},
-	{0x3DE00000, 0x2F00},
-	{0x4A206784, 0x7C00},
-	{0x3DF00000, 0x2F80},
-	{0xFB9EFFFF, 0xFC00},
-	{0x3DF80000, 0x2FC0},
-	{0x5EF1ABAA, 0x7C00},
-	{0x3DFC0000, 0x2FE0},
-	{0xC1080000, 0xC840},
-	{0x3DFE0000, 0x2FF0},
-	{0xBF800FFE, 0xBC00},
-	{0x3DFF0000, 0x2FF8},
-	{0x6DAE27C2, 0x7C00},
-	{0x3DFF8000, 0x2FFC},
-	{0xC77FF000, 0xFC00},
-	{0x3DFFC000, 0x2FFE},
-	{0x3EEFFEFE, 0x3780},
-	{0x3DFFE000, 0x2FFF},
-	{0x598200FF, 0x7C00},
-	{0x3DFFF000, 0x3000},
-	{0x3F7FFFF3, 0x3C00},
-	{0x3DFFF800, 0x3000},
-	{0x4F6FFFDF, 0x7C00},
-	{0x3DFFFC00, 0x3000},
-	{0x3F1BFFFF, 0x38E0},
-	{0x3DFFFE00, 0x3000},
-	{0xDE4B58A8, 0xFC00},
-	{0x3DFFFF00, 0x3000},
-	{0x410041FF, 0x4802},
-	{0x3DFFFF80, 0x3000},
-	{0x0000403F, 0x0000},
-	{0x3DFFFFC0, 0x3000},
-	{0x5EAA4808, 0x7C00},
-	{0x3DFFFFE0, 0x3000},
-	{0xC1C07FFE, 0xCE04},
-	{0x3DFFFFF0, 0x3000},
-	{0x3D899D9C, 0x2C4D},
-	{0x3DFFFFF8, 0x3000},
-	{0x7FFBFFF7, 0x7FDF},
-	{0x3DFFFFFC, 0x3000},
-	{0x3F030000, 0x3818},
-	{0x3DFFFFFE, 0x3000},
-	{0xB3ED4716, 0x8002},
-	{0x3DFFFFFF, 0x3000},
-	{0xB3FFC020, 0x8002},
-	{0x3DFFFFFD, 0x3000},
-	{0x419000FE, 0x4C80},
-	{0x3DFFFFFB, 0x3000},
-	{0x5F000081, 0x7C00},
-	{0x3DFFFFF7, 0x3000},
-	{0xC08403FF, 0xC420},
-	{0x3DFFFFEF, 0x3000},
-	{0x7BFFE007, 0x7C00},
-	{0x3DFFFFDF, 0x3000},
-	{0xDFFFE000, 0xFC00},
-	{0x3DFFFFBF, 0x3000},
-	{0x5E8AF9A2, 0x7C00},
-	{0x3DFFFF7F, 0x3000},
-	{0xBF000810, 0xB800},
-	{0x3DFFFEFF, 0x3000},
-	{0x3DA71BEE, 0x2D39},
-	{0x3DFFFDFF, 0x3000},
-	{0x4F004007, 0x7C00},
-	{0x3DFFFBFF, 0x3000},
-	{0x3C800003, 0x2400},
-	{0x3DFFF7FF, 0x3000},
-	{0x01FDACA9, 0x0000},
-	{0x3DFFEFFF, 0x2FFF},
-	{0xCF852226, 0xFC00},
-	{0x3DFFDFFF, 0x2FFF},
-	{0xCEB0DF9A, 0xFC00},
-	{0x3DFFBFFF, 0x2FFE},
-	{0x27810020, 0x0000},
-	{0x3DFF7FFF, 0x2FFC},
-	{0xB2FFFFFF, 0x8000},
-	{0x3DFEFFFF, 0x2FF8},
-	{0xB87F07FF, 0x83FC},
-	{0x3DFDFFFF, 0x2FF0},
-	{0x33FFF880, 0x0002},
-	{0x3DFBFFFF, 0x2FE0},
-	{0x387FFFE7, 0x0400},
-	{0x3DF7FFFF, 0x2FC0},
-	{0xC6FFFFFF, 0xF800},
-	{0x3DEFFFFF, 0x2F80},
-	{0x4E7FFE0F, 0x7C00},
-	{0x3DDFFFFF, 0x2F00},
-	{0xC7FFFF87, 0xFC00},
-	{0x3DBFFFFF, 0x2E00},
-	{0xD5FFFE3F, 0xFC00},
-	{0x3D9FFFFF, 0x2D00},
-	{0xDF700002, 0xFC00},
-	{0x3D8FFFFF, 0x2C80},
-	{0xCE03FFFD, 0xFC00},
-	{0x3D87FFFF, 0x2C40},
-	{0xFF285607, 0xFC00},
-	{0x3D83FFFF, 0x2C20},
-	{0xCF7FFFFF, 0xFC00},
-	{0x3D81FFFF, 0x2C10},
-	{0xC1A01000, 0xCD00},
-	{0x3D80FFFF, 0x2C08},
-	{0xCF920000, 0xFC00},
-	{0x3D807FFF, 0x2C04},
-	{0x3B0007FC, 0x1800},
-	{0x3D803FFF, 0x2C02},
-	{0x3C80E6EA, 0x2407},
-	{0x3D801FFF, 0x2C01},
-	{0xBF7BFEFF, 0xBBE0},
-	{0x3D800FFF, 0x2C00},
-	{0xBD5A6B5F, 0xAAD3},
-	{0x3D8007FF, 0x2C00},
-	{0xFEFFFE00, 0xFC00},
-	{0x3D8003FF, 0x2C00},
-	{0xBA008002, 0x9004},
-	{0x3D8001FF, 0x2C00},
-	{0x4400FFFF, 0x6008},
-	{0x3D8000FF, 0x2C00},
-	{0xC200007E, 0xD000},
-	{0x3D80007F, 0x2C00},
-	{0x444C663B, 0x6263},
-	{0x3D80003F, 0x2C00},
-	{0xC4008080, 0xE004},
-	{0x3D80001F, 0x2C00},
-	{0xBEF0001F, 0xB780},
-	{0x3D80000F, 0x2C00},
-	{0x80FFDBFF, 0x8000},
-	{0x3D800007, 0x2C00},
-	{0xC4A49BB4, 0xE525},
-	{0x3D800003, 0x2C00},
-	{0x5E800A00, 0x7C00},
-	{0x3E000000, 0x3000},
-	{0xDE80FFDF, 0xFC00},
-	{0x3E000001, 0x3000},
-	{0x400FFFDE, 0x4080},
-	{0x3E000002, 0x3000},
-	{0x41FFF700, 0x5000},
-	{0x3E000004, 0x3000},
-	{0xC2810000, 0xD408},
-	{0x3E000008, 0x3000},
-	{0xBE801FFE, 0xB401},
-	{0x3E000010, 0x3000},
-	{0x80FFFFD0, 0x8000},
-	{0x3E000020, 0x3000},
-	{0xDFC3C224, 0xFC00},
-	{0x3E000040, 0x3000},
-	{0x2CFEFFFF, 0x0000},
-	{0x3E000080, 0x3000},
-	{0x4A18AABF, 0x7C00},
-	{0x3E000100, 0x3000},
-	{0xC0080200, 0xC040},
-	{0x3E000200, 0x3000},
-	{0xDF80200E, 0xFC00},
-	{0x3E000400, 0x3000},
-	{0x27929C62, 0x0000},
-	{0x3E000800, 0x3000},
-	{0x3E07FBFE, 0x3040},
-	{0x3E001000, 0x3000},
-	{0x4E8000FE, 0x7C00},
-	{0x3E002000, 0x3001},
-	{0xCFFBFF7E, 0xFC00},
-	{0x3E004000, 0x3002},
-	{0xEF780080, 0xFC00},
-	{0x3E008000, 0x3004},
-	{0xC02568CE, 0xC12B},
-	{0x3E010000, 0x3008},
-	{0x410103FF, 0x4808},
-	{0x3E020000, 0x3010},
-	{0x4E9FFFFC, 0x7C00},
-	{0x3E040000, 0x3020},
-	{0x5E1FFFDE, 0x7C00},
-	{0x3E080000, 0x3040},
-	{0xCFFFFFDA, 0xFC00},
-	{0x3E100000, 0x3080},
-	{0x4E46B2A8, 0x7C00},
-	{0x3E200000, 0x3100},
-	{0x284777AD, 0x0000},
-	{0x3E400000, 0x3200},
-	{0x3E28C3E0, 0x3146},
-	{0x3E600000, 0x3300},
-	{0x4BFF8008, 0x7C00},
-	{0x3E700000, 0x3380},
-	{0x817FFFDF, 0x8000},
-	{0x3E780000, 0x33C0},
-	{0x40217B7B, 0x410C},
-	{0x3E7C0000, 0x33E0},
-	{0x3DFFFFC3, 0x3000},
-	{0x3E7E0000, 0x33F0},
-	{0x007FFB0B, 0x0000},
-	{0x3E7F0000, 0x33F8},
-	{0xAFDFF800, 0x8000},
-	{0x3E7F8000, 0x33FC},
-	{0x41BFFFFD, 0x4E00},
-	{0x3E7FC000, 0x33FE},
-	{0x5F8007BF, 0x7C00},
-	{0x3E7FE000, 0x33FF},
-	{0x4F037FFF, 0x7C00},
-	{0x3E7FF000, 0x3400},
-	{0xC2800FFE, 0xD400},
-	{0x3E7FF800, 0x3400},
-	{0x1BBFFFFD, 0x0000},
-	{0x3E7FFC00, 0x3400},
-	{0xCED680A1, 0xFC00},
-	{0x3E7FFE00, 0x3400},
-	{0x7F7FFEBF, 0x7C00},
-	{0x3E7FFF00, 0x3400},
-	{0x7F8000DF, 0x7E00},
-	{0x3E7FFF80, 0x3400},
-	{0x8D7FF81E, 0x8000},
-	{0x3E7FFFC0, 0x3400},
-	{0x5F63FFFF, 0x7C00},
-	{0x3E7FFFE0, 0x3400},
-	{0xFF7FBC00, 0xFC00},
-	{0x3E7FFFF0, 0x3400},
-	{0x7EFFF700, 0x7C00},
-	{0x3E7FFFF8, 0x3400},
-	{0xDF00F7FE, 0xFC00},
-	{0x3E7FFFFC, 0x3400},
-	{0x0981FFF8, 0x0000},
-	{0x3E7FFFFE, 0x3400},
-	{0x6D000FFE, 0x7C00},
-	{0x3E7FFFFF, 0x3400},
-	{0x7F808007, 0x7E04},
-	{0x3E7FFFFD, 0x3400},
-	{0x494DBB4F, 0x7C00},
-	{0x3E7FFFFB, 0x3400},
-	{0x4EA97FC9, 0x7C00},
-	{0x3E7FFFF7, 0x3400},
-	{0x40EFFEFE, 0x4780},
-	{0x3E7FFFEF, 0x3400},
-	{0x80008000, 0x8000},
-	{0x3E7FFFDF, 0x3400},
-	{0xCEFFFE00, 0xFC00},
-	{0x3E7FFFBF, 0x3400},
-	{0x3F002002, 0x3801},
-	{0x3E7FFF7F, 0x3400},
-	{0x4E7FFFF6, 0x7C00},
-	{0x3E7FFEFF, 0x3400},
-	{0x3EFC007E, 0x37E0},
-	{0x3E7FFDFF, 0x3400},
-	{0x80FDF000, 0x8000},
-	{0x3E7FFBFF, 0x3400},
-	{0x0080013F, 0x0000},
-	{0x3E7FF7FF, 0x3400},
-	{0xCE7FFFCF, 0xFC00},
-	{0x3E7FEFFF, 0x33FF},
-	{0xBE622041, 0xB311},
-	{0x3E7FDFFF, 0x33FF},
-	{0xB38007FF, 0x8001},
-	{0x3E7FBFFF, 0x33FE},
-	{0xDE8043FF, 0xFC00},
-	{0x3E7F7FFF, 0x33FC},
-	{0x40FFC01F, 0x47FE},
-	{0x3E7EFFFF, 0x33F8},
-	{0xCE07FFF7, 0xFC00},
-	{0x3E7DFFFF, 0x33F0},
-	{0xC0F7FDFF, 0xC7C0},
-	{0x3E7BFFFF, 0x33E0},
-	{0xB3FF0000, 0x8002},
-	{0x3E77FFFF, 0x33C0},
-	{0x42401FFF, 0x5201},
-	{0x3E6FFFFF, 0x3380},
-	{0xB86C0000, 0x83B0},
-	{0x3E5FFFFF, 0x3300},
-	{0x4F7FF7FE, 0x7C00},
-	{0x3E3FFFFF, 0x3200},
-	{0x448007FA, 0x6400},
-	{0x3E1FFFFF, 0x3100},
-	{0xB87FF000, 0x8400},
-	{0x3E0FFFFF, 0x3080},
-	{0x985FFF00, 0x8000},
-	{0x3E07FFFF, 0x3040},
-	{0x98FFFE1F, 0x8000},
-	{0x3E03FFFF, 0x3020},
-	{0x187BC67B, 0x0000},
-	{0x3E01FFFF, 0x3010},
-	{0x3380041E, 0x0001},
-	{0x3E00FFFF, 0x3008},
-	{0xC263FFFF, 0xD320},
-	{0x3E007FFF, 0x3004},
-	{0x3F100FFE, 0x3880},
-	{0x3E003FFF, 0x3002},
-	{0xBA001FFA, 0x9001},
-	{0x3E001FFF, 0x3001},
-	{0xFE802003, 0xFC00},
-	{0x3E000FFF, 0x3000},
-	{0x2D007FFF, 0x0000},
-	{0x3E0007FF, 0x3000},
-	{0xA3DBFFFF, 0x8000},
-	{0x3E0003FF, 0x3000},
-	{0xC281FDFF, 0xD410},
-	{0x3E0001FF, 0x3000},
-	{0xAC800380, 0x8000},
-	{0x3E0000FF, 0x3000},
-	{0x4080107F, 0x4401},
-	{0x3E00007F, 0x3000},
-	{0x80F01FFF, 0x8000},
-	{0x3E00003F, 0x3000},
-	{0xA2802FFE, 0x8000},
-	{0x3E00001F, 0x3000},
-	{0xF8B9D111, 0xFC00},
-	{0x3E00000F, 0x3000},
-	{0x18369D58, 0x0000},
-	{0x3E000007, 0x3000},
-	{0xCEFFFBEF, 0xFC00},
-	{0x3E000003, 0x3000},
-	{0xDF80F800, 0xFC00},
-	{0x3E800000, 0x3400},
-	{0xC1A0003F, 0xCD00},
-	{0x3E800001, 0x3400},
-	{0xFF80BFFE, 0xFE05},
-	{0x3E800002, 0x3400},
-	{0xC3FB0000, 0xDFD8},
-	{0x3E800004, 0x3400},
-	{0x3DFF0000, 0x2FF8},
-	{0x3E800008, 0x3400},
-	{0xCE80FFF7, 0xFC00},
-	{0x3E800010, 0x3400},
-	{0x40C0FFFE, 0x4608},
-	{0x3E800020, 0x3400},
-	{0x2E003FFF, 0x0000},
-	{0x3E800040, 0x3400},
-	{0x2CAC04B9, 0x0000},
-	{0x3E800080, 0x3400},
-	{0x39FF9FFF, 0x0FFD},
-	{0x3E800100, 0x3400},
-	{0xB30001EF, 0x8001},
-	{0x3E800200, 0x3400},
-	{0xC1400020, 0xCA00},
-	{0x3E800400, 0x3400},
-	{0x41004002, 0x4802},
-	{0x3E800800, 0x3400},
-	{0x4E000803, 0x7C00},
-	{0x3E801000, 0x3400},
-	{0xC0FFF100, 0xC800},
-	{0x3E802000, 0x3401},
-	{0x3E8001FF, 0x3400},
-	{0x3E804000, 0x3402},
-	{0x41F03FFF, 0x4F82},
-	{0x3E808000, 0x3404},
-	{0x4F7BFFFB, 0x7C00},
-	{0x3E810000, 0x3408},
-	{0xBB0007FE, 0x9800},
-	{0x3E820000, 0x3410},
-	{0xBE01003F, 0xB008},
-	{0x3E840000, 0x3420},
-	{0xF9007FF7, 0xFC00},
-	{0x3E880000, 0x3440},
-	{0xFECDF28C, 0xFC00},
-	{0x3E900000, 0x3480},
-	{0x417FFFFF, 0x4C00},
-	{0x3EA00000, 0x3500},
-	{0xD000FF80, 0xFC00},
-	{0x3EC00000, 0x3600},
-	{0x4F1BBA77, 0x7C00},
-	{0x3EE00000, 0x3700},
-	{0xCBE00400, 0xFC00},
-	{0x3EF00000, 0x3780},
-	{0xBEFC01FF, 0xB7E0},
-	{0x3EF80000, 0x37C0},
-	{0x0A7FF807, 0x0000},
-	{0x3EFC0000, 0x37E0},
-	{0xCE003FF7, 0xFC00},
-	{0x3EFE0000, 0x37F0},
-	{0x46FFF7FE, 0x7800},
-	{0x3EFF0000, 0x37F8},
-	{0x4FFFFBFA, 0x7C00},
-	{0x3EFF8000, 0x37FC},
-	{0x3C975ED7, 0x24BB},
-	{0x3EFFC000, 0x37FE},
-	{0x4FE37F40, 0x7C00},
-	{0x3EFFE000, 0x37FF},
-	{0x5F83DFFF, 0x7C00},
-	{0x3EFFF000, 0x3800},
-	{0xB3F78EE5, 0x8002},
-	{0x3EFFF800, 0x3800},
-	{0xBF50709C, 0xBA84},
-	{0x3EFFFC00, 0x3800},
-	{0x5F064D0F, 0x7C00},
-	{0x3EFFFE00, 0x3800},
-	{0xB080803F, 0x8000},
-	{0x3EFFFF00, 0x3800},
-	{0x3F808000, 0x3C04},
-	{0x3EFFFF80, 0x3800},
-	{0x4FFF1FFF, 0x7C00},
-	{0x3EFFFFC0, 0x3800},
-	{0xBE3FFDFE, 0xB200},
-	{0x3EFFFFE0, 0x3800},
-	{0x41BFFC00, 0x4E00},
-	{0x3EFFFFF0, 0x3800},
-	{0xFEAB87ED, 0xFC00},
-	{0x3EFFFFF8, 0x3800},
-	{0x3EFFFFA0, 0x3800},
-	{0x3EFFFFFC, 0x3800},
-	{0x3D803FFE, 0x2C02},
-	{0x3EFFFFFE, 0x3800},
-	{0x4E4E6534, 0x7C00},
-	{0x3EFFFFFF, 0x3800},
-	{0xCF80041F, 0xFC00},
-	{0x3EFFFFFD, 0x3800},
-	{0x4080FFFF, 0x4408},
-	{0x3EFFFFFB, 0x3800},
-	{0x320401FF, 0x0000},
-	{0x3EFFFFF7, 0x3800},
-	{0xCEA00020, 0xFC00},
-	{0x3EFFFFEF, 0x3800},
-	{0x5E810007, 0x7C00},
-	{0x3EFFFFDF, 0x3800},
-	{0x398FFE00, 0x0C80},
-	{0x3EFFFFBF, 0x3800},
-	{0xC1800BFF, 0xCC00},
-	{0x3EFFFF7F, 0x3800},
-	{0x4B9FFF00, 0x7C00},
-	{0x3EFFFEFF, 0x3800},
-	{0x7EC000FF, 0x7C00},
-	{0x3EFFFDFF, 0x3800},
-	{0xC17C0800, 0xCBE0},
-	{0x3EFFFBFF, 0x3800},
-	{0xCF060000, 0xFC00},
-	{0x3EFFF7FF, 0x3800},
-	{0x3102000F, 0x0000},
-	{0x3EFFEFFF, 0x37FF},
-	{0x496FFFFD, 0x7C00},
-	{0x3EFFDFFF, 0x37FF},
-	{0xDEE58595, 0xFC00},
-	{0x3EFFBFFF, 0x37FE},
-	{0x0B883FFF, 0x0000},
-	{0x3EFF7FFF, 0x37FC},
-	{0xB37640BC, 0x8001},
-	{0x3EFEFFFF, 0x37F8},
-	{0xCC001040, 0xFC00},
-	{0x3EFDFFFF, 0x37F0},
-	{0xCE100000, 0xFC00},
-	{0x3EFBFFFF, 0x37E0},
-	{0x7FD6A921, 0x7EB5},
-	{0x3EF7FFFF, 0x37C0},
-	{0x407F7E00, 0x43FC},
-	{0x3EEFFFFF, 0x3780},
-	{0x3F01001E, 0x3808},
-	{0x3EDFFFFF, 0x3700},
-	{0x246FFFF7, 0x0000},
-	{0x3EBFFFFF, 0x3600},
-	{0xFFFFF003, 0xFFFF},
-	{0x3E9FFFFF, 0x3500},
-	{0x010203FE, 0x0000},
-	{0x3E8FFFFF, 0x3480},
-	{0xC110FFFF, 0xC888},
-	{0x3E87FFFF, 0x3440},
-	{0xDFC003FF, 0xFC00},
-	{0x3E83FFFF, 0x3420},
-	{0xC0FC0040, 0xC7E0},
-	{0x3E81FFFF, 0x3410},
-	{0xA4800018, 0x8000},
-	{0x3E80FFFF, 0x3408},
-	{0xD3D91ADF, 0xFC00},
-	{0x3E807FFF, 0x3404},
-	{0xA9C1FFFE, 0x8000},
-	{0x3E803FFF, 0x3402},
-	{0x5E17FFFF, 0x7C00},
-	{0x3E801FFF, 0x3401},
-	{0x40007FFD, 0x4004},
-	{0x3E800FFF, 0x3400},
-	{0xBF60FB26, 0xBB08},
-	{0x3E8007FF, 0x3400},
-	{0xDF00C000, 0xFC00},
-	{0x3E8003FF, 0x3400},
-	{0x7E8017FF, 0x7C00},
-	{0x3E8001FF, 0x3400},
-	{0xDE5FFFFE, 0xFC00},
-	{0x3E8000FF, 0x3400},
-	{0x337FFDC0, 0x0001},
-	{0x3E80007F, 0x3400},
-	{0xCF7FFFFD, 0xFC00},
-	{0x3E80003F, 0x3400},
-	{0x5E780080, 0x7C00},
-	{0x3E80001F, 0x3400},
-	{0xBFF3A069, 0xBF9D},
-	{0x3E80000F, 0x3400},
-	{0xC4D93EBA, 0xE6CA},
-	{0x3E800007, 0x3400},
-	{0xCEFF7FFC, 0xFC00},
-	{0x3E800003, 0x3400},
-	{0x7E0003FB, 0x7C00},
-	{0x3F000000, 0x3800},
-	{0xA438E575, 0x8000},
-	{0x3F000001, 0x3800},
-	{0x82780001, 0x8000},
-	{0x3F000002, 0x3800},
-	{0xC07F8010, 0xC3FC},
-	{0x3F000004, 0x3800},
-	{0x345B89C0, 0x0003},
-	{0x3F000008, 0x3800},
-	{0xBFF2EFCB, 0xBF97},
-	{0x3F000010, 0x3800},
-	{0xC003FBFF, 0xC020},
-	{0x3F000020, 0x3800},
-	{0xBE700002, 0xB380},
-	{0x3F000040, 0x3800},
-	{0x4FFE0000, 0x7C00},
-	{0x3F000080, 0x3800},
-	{0x80FFDEFF, 0x8000},
-	{0x3F000100, 0x3800},
-	{0x5A00FBFF, 0x7C00},
-	{0x3F000200, 0x3800},
-	{0x40808006, 0x4404},
-	{0x3F000400, 0x3800},
-	{0xBBA08000, 0x9D04},
-	{0x3F000800, 0x3800},
-	{0x3F10922B, 0x3885},
-	{0x3F001000, 0x3800},
-	{0x805FEFFF, 0x8000},
-	{0x3F002000, 0x3801},
-	{0xC3801010, 0xDC01},
-	{0x3F004000, 0x3802},
-	{0xC13274E6, 0xC994},
-	{0x3F008000, 0x3804},
-	{0x80ABA498, 0x8000},
-	{0x3F010000, 0x3808},
-	{0xFEFF0001, 0xFC00},
-	{0x3F020000, 0x3810},
-	{0x0A80203F, 0x0000},
-	{0x3F040000, 0x3820},
-	{0x4000FFFD, 0x4008},
-	{0x3F080000, 0x3840},
-	{0xC0800BFE, 0xC400},
-	{0x3F100000, 0x3880},
-	{0x5E77FE00, 0x7C00},
-	{0x3F200000, 0x3900},
-	{0x7F07EFFE, 0x7C00},
-	{0x3F400000, 0x3A00},
-	{0xDDFDFF7E, 0xFC00},
-	{0x3F600000, 0x3B00},
-	{0xACCF5DC0, 0x8000},
-	{0x3F700000, 0x3B80},
-	{0x397FFF8F, 0x0C00},
-	{0x3F780000, 0x3BC0},
-	{0x3F7E0800, 0x3BF0},
-	{0x3F7C0000, 0x3BE0},
-	{0x40FE1FFF, 0x47F1},
-	{0x3F7E0000, 0x3BF0},
-	{0x40FF8008, 0x47FC},
-	{0x3F7F0000, 0x3BF8},
-	{0xBD8011FF, 0xAC01},
-	{0x3F7F8000, 0x3BFC},
-	{0x4F9DCABA, 0x7C00},
-	{0x3F7FC000, 0x3BFE},
-	{0xC1A695C5, 0xCD35},
-	{0x3F7FE000, 0x3BFF},
-	{0xCEFFFFF1, 0xFC00},
-	{0x3F7FF000, 0x3C00},
-	{0x30E2ABB8, 0x0000},
-	{0x3F7FF800, 0x3C00},
-	{0x4100FEFF, 0x4808},
-	{0x3F7FFC00, 0x3C00},
-	{0x01077E28, 0x0000},
-	{0x3F7FFE00, 0x3C00},
-	{0x42805000, 0x5402},
-	{0x3F7FFF00, 0x3C00},
-	{0x44FFA722, 0x67FD},
-	{0x3F7FFF80, 0x3C00},
-	{0xB3F7FDFF, 0x8002},
-	{0x3F7FFFC0, 0x3C00},
-	{0x5FFFFEFB, 0x7C00},
-	{0x3F7FFFE0, 0x3C00},
-	{0x3E98F7E2, 0x34C8},
-	{0x3F7FFFF0, 0x3C00},
-	{0x3E800FC0, 0x3400},
-	{0x3F7FFFF8, 0x3C00},
-	{0xBF0000FE, 0xB800},
-	{0x3F7FFFFC, 0x3C00},
-	{0xB9E07FFF, 0x8F04},
-	{0x3F7FFFFE, 0x3C00},
-	{0x4F5FFDFE, 0x7C00},
-	{0x3F7FFFFF, 0x3C00},
-	{0xBF0F0000, 0xB878},
-	{0x3F7FFFFD, 0x3C00},
-	{0xCFC6E111, 0xFC00},
-	{0x3F7FFFFB, 0x3C00},
-	{0xB6202000, 0x8028},
-	{0x3F7FFFF7, 0x3C00},
-	{0x00FFDBFF, 0x0000},
-	{0x3F7FFFEF, 0x3C00},
-	{0xCF001002, 0xFC00},
-	{0x3F7FFFDF, 0x3C00},
-	{0xC57FF7F8, 0xEC00},
-	{0x3F7FFFBF, 0x3C00},
-	{0x3F800110, 0x3C00},
-	{0x3F7FFF7F, 0x3C00},
-	{0x0100001D, 0x0000},
-	{0x3F7FFEFF, 0x3C00},
-	{0x40780001, 0x43C0},
-	{0x3F7FFDFF, 0x3C00},
-	{0x40820000, 0x4410},
-	{0x3F7FFBFF, 0x3C00},
-	{0xBB83FEFF, 0x9C20},
-	{0x3F7FF7FF, 0x3C00},
-	{0x3E000003, 0x3000},
-	{0x3F7FEFFF, 0x3BFF},
-	{0x4FA88A1E, 0x7C00},
-	{0x3F7FDFFF, 0x3BFF},
-	{0xEA77FFFB, 0xFC00},
-	{0x3F7FBFFF, 0x3BFE},
-	{0x7ED20F1C, 0x7C00},
-	{0x3F7F7FFF, 0x3BFC},
-	{0x41810080, 0x4C08},
-	{0x3F7EFFFF, 0x3BF8},
-	{0x417FFE00, 0x4C00},
-	{0x3F7DFFFF, 0x3BF0},
-	{0xCF000801, 0xFC00},
-	{0x3F7BFFFF, 0x3BE0},
-	{0xDF000003, 0xFC00},
-	{0x3F77FFFF, 0x3BC0},
-	{0xDD06EE57, 0xFC00},
-	{0x3F6FFFFF, 0x3B80},
-	{0x4E1537D9, 0x7C00},
-	{0x3F5FFFFF, 0x3B00},
-	{0x3F7FFFA0, 0x3C00},
-	{0x3F3FFFFF, 0x3A00},
-	{0xBE828000, 0xB414},
-	{0x3F1FFFFF, 0x3900},
-	{0x5F801F7F, 0x7C00},
-	{0x3F0FFFFF, 0x3880},
-	{0xBEFFFBDE, 0xB800},
-	{0x3F07FFFF, 0x3840},
-	{0x4F001FF6, 0x7C00},
-	{0x3F03FFFF, 0x3820},
-	{0x40880008, 0x4440},
-	{0x3F01FFFF, 0x3810},
-	{0x44780215, 0x63C0},
-	{0x3F00FFFF, 0x3808},
-	{0xB5800012, 0x8010},
-	{0x3F007FFF, 0x3804},
-	{0x3F77FFBF, 0x3BC0},
-	{0x3F003FFF, 0x3802},
-	{0xCB800F7F, 0xFC00},
-	{0x3F001FFF, 0x3801},
-	{0xCF1F7FFF, 0xFC00},
-	{0x3F000FFF, 0x3800},
-	{0x47810000, 0x7C00},
-	{0x3F0007FF, 0x3800},
-	{0xA501FFFF, 0x8000},
-	{0x3F0003FF, 0x3800},
-	{0xCF8525F5, 0xFC00},
-	{0x3F0001FF, 0x3800},
-	{0x407FF6FF, 0x4400},
-	{0x3F0000FF, 0x3800},
-	{0xC07F5FFF, 0xC3FB},
-	{0x3F00007F, 0x3800},
-	{0x40010007, 0x4008},
-	{0x3F00003F, 0x3800},
-	{0x187C0004, 0x0000},
-	{0x3F00001F, 0x3800},
-	{0x4608007E, 0x7040},
-	{0x3F00000F, 0x3800},
-	{0x469ACF7F, 0x74D6},
-	{0x3F000007, 0x3800},
-	{0x4E0200FF, 0x7C00},
-	{0x3F000003, 0x3800},
-	{0x338F0000, 0x0001},
-	{0x3F800000, 0x3C00},
-	{0x3F3FFFFE, 0x3A00},
-	{0x3F800001, 0x3C00},
-	{0x014F33E5, 0x0000},
-	{0x3F800002, 0x3C00},
-	{0x003FFE00, 0x0000},
-	{0x3F800004, 0x3C00},
-	{0x814B9E88, 0x8000},
-	{0x3F800008, 0x3C00},
-	{0x867FBFFF, 0x8000},
-	{0x3F800010, 0x3C00},
-	{0x5EFD0000, 0x7C00},
-	{0x3F800020, 0x3C00},
-	{0x5F329A13, 0x7C00},
-	{0x3F800040, 0x3C00},
-	{0x8102EBD2, 0x8000},
-	{0x3F800080, 0x3C00},
-	{0xCFDDFFFF, 0xFC00},
-	{0x3F800100, 0x3C00},
-	{0xC4FF1FFE, 0xE7F9},
-	{0x3F800200, 0x3C00},
-	{0xC07FFFF4, 0xC400},
-	{0x3F800400, 0x3C00},
-	{0x5EE5D78D, 0x7C00},
-	{0x3F800800, 0x3C00},
-	{0x411000FF, 0x4880},
-	{0x3F801000, 0x3C00},
-	{0x80004000, 0x8000},
-	{0x3F802000, 0x3C01},
-	{0x3FC41C0B, 0x3E21},
-	{0x3F804000, 0x3C02},
-	{0xC0801800, 0xC401},
-	{0x3F808000, 0x3C04},
-	{0x80FDFFE0, 0x8000},
-	{0x3F810000, 0x3C08},
-	{0x3A04007E, 0x1020},
-	{0x3F820000, 0x3C10},
-	{0x1787DFFE, 0x0000},
-	{0x3F840000, 0x3C20},
-	{0xC17327D2, 0xCB99},
-	{0x3F880000, 0x3C40},
-	{0x548005FF, 0x7C00},
-	{0x3F900000, 0x3C80},
-	{0xCF5FFFFD, 0xFC00},
-	{0x3FA00000, 0x3D00},
-	{0x4E800046, 0x7C00},
-	{0x3FC00000, 0x3E00},
-	{0x3E00403E, 0x3002},
-	{0x3FE00000, 0x3F00},
-	{0x0100007F, 0x0000},
-	{0x3FF00000, 0x3F80},
-	{0xB300EFFF, 0x8001},
-	{0x3FF80000, 0x3FC0},
-	{0x4363FFFF, 0x5B20},
-	{0x3FFC0000, 0x3FE0},
-	{0xFF80021E, 0xFE00},
-	{0x3FFE0000, 0x3FF0},
-	{0x33820006, 0x0001},
-	{0x3FFF0000, 0x3FF8},
-	{0x4177FBFE, 0x4BC0},
-	{0x3FFF8000, 0x3FFC},
-	{0xC0EFFA82, 0xC780},
-	{0x3FFFC000, 0x3FFE},
-	{0xC4FFF0FF, 0xE800},
-	{0x3FFFE000, 0x3FFF},
-	{0x42FFFE00, 0x5800},
-	{0x3FFFF000, 0x4000},
-	{0xBF32E272, 0xB997},
-	{0x3FFFF800, 0x4000},
-	{0xFEFFFFFE, 0xFC00},
-	{0x3FFFFC00, 0x4000},
-	{0xDF0003E0, 0xFC00},
-	{0x3FFFFE00, 0x4000},
-	{0xCB9FFDFE, 0xFC00},
-	{0x3FFFFF00, 0x4000},
-	{0x418A4DB6, 0x4C52},
-	{0x3FFFFF80, 0x4000},
-	{0x3EFF0080, 0x37F8},
-	{0x3FFFFFC0, 0x4000},
-	{0x5FF8003F, 0x7C00},
-	{0x3FFFFFE0, 0x4000},
-	{0x4D2FBD80, 0x7C00},
-	{0x3FFFFFF0, 0x4000},
-	{0x3F7DDFFF, 0x3BEF},
-	{0x3FFFFFF8, 0x4000},
-	{0x4080011F, 0x4400},
-	{0x3FFFFFFC, 0x4000},
-	{0x80F800FF, 0x8000},
-	{0x3FFFFFFE, 0x4000},
-	{0x580D5757, 0x7C00},
-	{0x3FFFFFFF, 0x4000},
-	{0x23800017, 0x0000},
-	{0x3FFFFFFD, 0x4000},
-	{0x3F644746, 0x3B22},
-	{0x3FFFFFFB, 0x4000},
-	{0x4E000006, 0x7C00},
-	{0x3FFFFFF7, 0x4000},
-	{0x3DD1A891, 0x2E8D},
-	{0x3FFFFFEF, 0x4000},
-	{0x3BF7EE6E, 0x1FBF},
-	{0x3FFFFFDF, 0x4000},
-	{0xDF000408, 0xFC00},
-	{0x3FFFFFBF, 0x4000},
-	{0xA080005E, 0x8000},
-	{0x3FFFFF7F, 0x4000},
-	{0x3F8FF7FF, 0x3C80},
-	{0x3FFFFEFF, 0x4000},
-	{0x2103FFFB, 0x0000},
-	{0x3FFFFDFF, 0x4000},
-	{0x48DFFFEF, 0x7C00},
-	{0x3FFFFBFF, 0x4000},
-	{0xDE83FFE0, 0xFC00},
-	{0x3FFFF7FF, 0x4000},
-	{0x40F7FFFD, 0x47C0},
-	{0x3FFFEFFF, 0x3FFF},
-	{0x5E7FE01E, 0x7C00},
-	{0x3FFFDFFF, 0x3FFF},
-	{0x4E120000, 0x7C00},
-	{0x3FFFBFFF, 0x3FFE},
-	{0xBDE2A1D9, 0xAF15},
-	{0x3FFF7FFF, 0x3FFC},
-	{0x3EFFFFC7, 0x3800},
-	{0x3FFEFFFF, 0x3FF8},
-	{0xB3EB147A, 0x8002},
-	{0x3FFDFFFF, 0x3FF0},
-	{0xBA8007FB, 0x9400},
-	{0x3FFBFFFF, 0x3FE0},
-	{0x417FF007, 0x4C00},
-	{0x3FF7FFFF, 0x3FC0},
-	{0x6105FADD, 0x7C00},
-	{0x3FEFFFFF, 0x3F80},
-	{0x2D000016, 0x0000},
-	{0x3FDFFFFF, 0x3F00},
-	{0xDFD0D02F, 0xFC00},
-	{0x3FBFFFFF, 0x3E00},
-	{0x4C07FEFE, 0x7C00},
-	{0x3F9FFFFF, 0x3D00},
-	{0xBD85C3C0, 0xAC2E},
-	{0x3F8FFFFF, 0x3C80},
-	{0xDF07FFF6, 0xFC00},
-	{0x3F87FFFF, 0x3C40},
-	{0x4F7FFBBF, 0x7C00},
-	{0x3F83FFFF, 0x3C20},
-	{0x4660000F, 0x7300},
-	{0x3F81FFFF, 0x3C10},
-	{0xBFE00000, 0xBF00},
-	{0x3F80FFFF, 0x3C08},
-	{0xE2FFF7FB, 0xFC00},
-	{0x3F807FFF, 0x3C04},
-	{0x3DFDFC00, 0x2FF0},
-	{0x3F803FFF, 0x3C02},
-	{0xBFFF03FE, 0xBFF8},
-	{0x3F801FFF, 0x3C01},
-	{0x40FF8004, 0x47FC},
-	{0x3F800FFF, 0x3C00},
-	{0x5E7F8008, 0x7C00},
-	{0x3F8007FF, 0x3C00},
-	{0x437BF7FF, 0x5BE0},
-	{0x3F8003FF, 0x3C00},
-	{0xFF49EFA8, 0xFC00},
-	{0x3F8001FF, 0x3C00},
-	{0xC087EFFE, 0xC43F},
-	{0x3F8000FF, 0x3C00},
-	{0x47A6D1AD, 0x7C00},
-	{0x3F80007F, 0x3C00},
-	{0xDF4B255E, 0xFC00},
-	{0x3F80003F, 0x3C00},
-	{0x3E87EFFF, 0x343F},
-	{0x3F80001F, 0x3C00},
-	{0x007F87FF, 0x0000},
-	{0x3F80000F, 0x3C00},
-	{0xB7659D9E, 0x80E6},
-	{0x3F800007, 0x3C00},
-	{0xC8FFEF80, 0xFC00},
-	{0x3F800003, 0x3C00},
-	{0x3E76B932, 0x33B6},
-	{0x40000000, 0x4000},
-	{0x3EFDBFFF, 0x37EE},
-	{0x40000001, 0x4000},
-	{0x49000700, 0x7C00},
-	{0x40000002, 0x4000},
-	{0x3EFFFFBB, 0x3800},
-	{0x40000004, 0x4000},
-	{0xB581FFDF, 0x8010},
-	{0x40000008, 0x4000},
-	{0xDE800047, 0xFC00},
-	{0x40000010, 0x4000},
-	{0xCE2001FE, 0xFC00},
-	{0x40000020, 0x4000},
-	{0xDFD483F4, 0xFC00},
-	{0x40000040, 0x4000},
-	{0x41080080, 0x4840},
-	{0x40000080, 0x4000},
-	{0x7F80000B, 0x7E00},
-	{0x40000100, 0x4000},
-	{0xDEC69B6E, 0xFC00},
-	{0x40000200, 0x4000},
-	{0x637F1FFF, 0x7C00},
-	{0x40000400, 0x4000},
-	{0x400047FF, 0x4002},
-	{0x40000800, 0x4000},
-	{0xCC7FFF0F, 0xFC00},
-	{0x40001000, 0x4000},
-	{0xBE887FFE, 0xB444},
-	{0x40002000, 0x4001},
-	{0xFF77FFF7, 0xFC00},
-	{0x40004000, 0x4002},
-	{0x45400020, 0x6A00},
-	{0x40008000, 0x4004},
-	{0x01003FF0, 0x0000},
-	{0x40010000, 0x4008},
-	{0xBC00037F, 0xA000},
-	{0x40020000, 0x4010},
-	{0x41840006, 0x4C20},
-	{0x40040000, 0x4020},
-	{0x7E800FF7, 0x7C00},
-	{0x40080000, 0x4040},
-	{0xCB800FFA, 0xFC00},
-	{0x40100000, 0x4080},
-	{0xBFFFF7F0, 0xC000},
-	{0x40200000, 0x4100},
-	{0x3E79FFFF, 0x33D0},
-	{0x40400000, 0x4200},
-	{0x4E7EFF00, 0x7C00},
-	{0x40600000, 0x4300},
-	{0xB65120B1, 0x8034},
-	{0x40700000, 0x4380},
-	{0xBE0021FE, 0xB001},
-	{0x40780000, 0x43C0},
-	{0x4370FFFF, 0x5B88},
-	{0x407C0000, 0x43E0},
-	{0xDEEFF800, 0xFC00},
-	{0x407E0000, 0x43F0},
-	{0x00D92689, 0x0000},
-	{0x407F0000, 0x43F8},
-	{0xFF81007E, 0xFE08},
-	{0x407F8000, 0x43FC},
-	{0x33E40E0F, 0x0002},
-	{0x407FC000, 0x43FE},
-	{0x3DE095DD, 0x2F05},
-	{0x407FE000, 0x43FF},
-	{0xBD000083, 0xA800},
-	{0x407FF000, 0x4400},
-	{0x407F7FDF, 0x43FC},
-	{0x407FF800, 0x4400},
-	{0xAB63B15B, 0x8000},
-	{0x407FFC00, 0x4400},
-	{0xBC80800E, 0xA404},
-	{0x407FFE00, 0x4400},
-	{0x7F803FFA, 0x7E01},
-	{0x407FFF00, 0x4400},
-	{0xC0FE007F, 0xC7F0},
-	{0x407FFF80, 0x4400},
-	{0x70FFFEFE, 0x7C00},
-	{0x407FFFC0, 0x4400},
-	{0xC03FFFFB, 0xC200},
-	{0x407FFFE0, 0x4400},
-	{0xCBA0007F, 0xFC00},
-	{0x407FFFF0, 0x4400},
-	{0x5FEF7FFE, 0x7C00},
-	{0x407FFFF8, 0x4400},
-	{0x467F3FFE, 0x73FA},
-	{0x407FFFFC, 0x4400},
-	{0x3F7FFFF4, 0x3C00},
-	{0x407FFFFE, 0x4400},
-	{0xDFA7FFFE, 0xFC00},
-	{0x407FFFFF, 0x4400},
-	{0xC8FF8002, 0xFC00},
-	{0x407FFFFD, 0x4400},
-	{0xD0600FFF, 0xFC00},
-	{0x407FFFFB, 0x4400},
-	{0x3A801020, 0x1401},
-	{0x407FFFF7, 0x4400},
-	{0xFF83FFEF, 0xFE1F},
-	{0x407FFFEF, 0x4400},
-	{0x3EF7FFFC, 0x37C0},
-	{0x407FFFDF, 0x4400},
-	{0x7FFFDFFC, 0x7FFE},
-	{0x407FFFBF, 0x4400},
-	{0x3D81FE00, 0x2C10},
-	{0x407FFF7F, 0x4400},
-	{0xCB80A000, 0xFC00},
-	{0x407FFEFF, 0x4400},
-	{0x25A06376, 0x0000},
-	{0x407FFDFF, 0x4400},
-	{0x40040000, 0x4020},
-	{0x407FFBFF, 0x4400},
-	{0x7F7F0000, 0x7C00},
-	{0x407FF7FF, 0x4400},
-	{0xBE5A2340, 0xB2D1},
-	{0x407FEFFF, 0x43FF},
-	{0xCF83FC0E, 0xFC00},
-	{0x407FDFFF, 0x43FF},
-	{0x5E80003F, 0x7C00},
-	{0x407FBFFF, 0x43FE},
-	{0x403849E7, 0x41C2},
-	{0x407F7FFF, 0x43FC},
-	{0x4E469496, 0x7C00},
-	{0x407EFFFF, 0x43F8},
-	{0x41FE0003, 0x4FF0},
-	{0x407DFFFF, 0x43F0},
-	{0x075F2395, 0x0000},
-	{0x407BFFFF, 0x43E0},
-	{0x40656957, 0x432B},
-	{0x4077FFFF, 0x43C0},
-	{0x40BB8377, 0x45DC},
-	{0x406FFFFF, 0x4380},
-	{0x4F7FC01F, 0x7C00},
-	{0x405FFFFF, 0x4300},
-	{0x31008001, 0x0000},
-	{0x403FFFFF, 0x4200},
-	{0xB60BFFFF, 0x8023},
-	{0x401FFFFF, 0x4100},
-	{0x8F7FFFF2, 0x8000},
-	{0x400FFFFF, 0x4080},
-	{0x43FFFFFC, 0x6000},
-	{0x4007FFFF, 0x4040},
-	{0xBE000018, 0xB000},
-	{0x4003FFFF, 0x4020},
-	{0xCEFEFEFF, 0xFC00},
-	{0x4001FFFF, 0x4010},
-	{0x5CC19E5F, 0x7C00},
-	{0x4000FFFF, 0x4008},
-	{0xCAFF8FFF, 0xFC00},
-	{0x40007FFF, 0x4004},
-	{0x0F80201E, 0x0000},
-	{0x40003FFF, 0x4002},
-	{0x6E8000DE, 0x7C00},
-	{0x40001FFF, 0x4001},
-	{0x316136EE, 0x0000},
-	{0x40000FFF, 0x4000},
-	{0xBA800780, 0x9400},
-	{0x400007FF, 0x4000},
-	{0xB20043FF, 0x8000},
-	{0x400003FF, 0x4000},
-	{0xBE000080, 0xB000},
-	{0x400001FF, 0x4000},
-	{0xEC4007FF, 0xFC00},
-	{0x400000FF, 0x4000},
-	{0xAC71E1F0, 0x8000},
-	{0x4000007F, 0x4000},
-	{0x5106F4B8, 0x7C00},
-	{0x4000003F, 0x4000},
-	{0xBCFFDFFF, 0xA7FF},
-	{0x4000001F, 0x4000},
-	{0x40B3E481, 0x459F},
-	{0x4000000F, 0x4000},
-	{0x017BFFBE, 0x0000},
-	{0x40000007, 0x4000},
-	{0xDEFC0200, 0xFC00},
-	{0x40000003, 0x4000},
-	{0x5F9F52A4, 0x7C00},
-	{0x40800000, 0x4400},
-	{0x81000807, 0x8000},
-	{0x40800001, 0x4400},
-	{0x01010002, 0x0000},
-	{0x40800002, 0x4400},
-	{0x3883FFFE, 0x0420},
-	{0x40800004, 0x4400},
-	{0xC17390E3, 0xCB9D},
-	{0x40800008, 0x4400},
-	{0xC14007FF, 0xCA00},
-	{0x40800010, 0x4400},
-	{0xC3B9195C, 0xDDC9},
-	{0x40800020, 0x4400},
-	{0xC100403F, 0xC802},
-	{0x40800040, 0x4400},
-	{0x5EFE3FFE, 0x7C00},
-	{0x40800080, 0x4400},
-	{0xC09FFFFF, 0xC500},
-	{0x40800100, 0x4400},
-	{0x3E77FFF7, 0x33C0},
-	{0x40800200, 0x4400},
-	{0x7F000FFE, 0x7C00},
-	{0x40800400, 0x4400},
-	{0xFF80FBFF, 0xFE07},
-	{0x40800800, 0x4400},
-	{0xC48368B9, 0xE41B},
-	{0x40801000, 0x4400},
-	{0x1F8803FF, 0x0000},
-	{0x40802000, 0x4401},
-	{0x3FF7FFFF, 0x3FC0},
-	{0x40804000, 0x4402},
-	{0xC1010040, 0xC808},
-	{0x40808000, 0x4404},
-	{0x4067F970, 0x4340},
-	{0x40810000, 0x4408},
-	{0xE51001FE, 0xFC00},
-	{0x40820000, 0x4410},
-	{0xA238C64D, 0x8000},
-	{0x40840000, 0x4420},
-	{0x27FFFA00, 0x0000},
-	{0x40880000, 0x4440},
-	{0x7F8007FB, 0x7E00},
-	{0x40900000, 0x4480},
-	{0x5E08000F, 0x7C00},
-	{0x40A00000, 0x4500},
-	{0xBC001020, 0xA001},
-	{0x40C00000, 0x4600},
-	{0x80FFAFFF, 0x8000},
-	{0x40E00000, 0x4700},
-	{0xBC01E000, 0xA00F},
-	{0x40F00000, 0x4780},
-	{0xC18CD9FB, 0xCC67},
-	{0x40F80000, 0x47C0},
-	{0xB2E003FE, 0x8000},
-	{0x40FC0000, 0x47E0},
-	{0xC1FFDFFF, 0xCFFF},
-	{0x40FE0000, 0x47F0},
-	{0xFF800030, 0xFE00},
-	{0x40FF0000, 0x47F8},
-	{0xC16AAA39, 0xCB55},
-	{0x40FF8000, 0x47FC},
-	{0xE2BFFEFF, 0xFC00},
-	{0x40FFC000, 0x47FE},
-	{0x4E21243E, 0x7C00},
-	{0x40FFE000, 0x47FF},
-	{0xB97F803F, 0x8BFC},
-	{0x40FFF000, 0x4800},
-	{0xDE000080, 0xFC00},
-	{0x40FFF800, 0x4800},
-	{0x7971FFFF, 0x7C00},
-	{0x40FFFC00, 0x4800},
-	{0x04A84CFF, 0x0000},
-	{0x40FFFE00, 0x4800},
-	{0x407BDFFE, 0x43DF},
-	{0x40FFFF00, 0x4800},
-	{0x7BCFFFFF, 0x7C00},
-	{0x40FFFF80, 0x4800},
-	{0x3F880FFF, 0x3C40},
-	{0x40FFFFC0, 0x4800},
-	{0x5E800C00, 0x7C00},
-	{0x40FFFFE0, 0x4800},
-	{0x67E00000, 0x7C00},
-	{0x40FFFFF0, 0x4800},
-	{0x000040FF, 0x0000},
-	{0x40FFFFF8, 0x4800},
-	{0xBE300000, 0xB180},
-	{0x40FFFFFC, 0x4800},
-	{0x54F6FFFE, 0x7C00},
-	{0x40FFFFFE, 0x4800},
-	{0x40FFFE08, 0x4800},
-	{0x40FFFFFF, 0x4800},
-	{0xFFE9DE76, 0xFF4E},
-	{0x40FFFFFD, 0x4800},
-	{0x7F7F7FFD, 0x7C00},
-	{0x40FFFFFB, 0x4800},
-	{0x7FFFF1FF, 0x7FFF},
-	{0x40FFFFF7, 0x4800},
-	{0xA2EBC810, 0x8000},
-	{0x40FFFFEF, 0x4800},
-	{0x1E80401F, 0x0000},
-	{0x40FFFFDF, 0x4800},
-	{0x7EAA4D5E, 0x7C00},
-	{0x40FFFFBF, 0x4800},
-	{0x007FFF00, 0x0000},
-	{0x40FFFF7F, 0x4800},
-	{0x49328D86, 0x7C00},
-	{0x40FFFEFF, 0x4800},
-	{0x5F003BFF, 0x7C00},
-	{0x40FFFDFF, 0x4800},
-	{0xFF80008F, 0xFE00},
-	{0x40FFFBFF, 0x4800},
-	{0x0A7FFBFE, 0x0000},
-	{0x40FFF7FF, 0x4800},
-	{0x5E801003, 0x7C00},
-	{0x40FFEFFF, 0x47FF},
-	{0x0C5A5A90, 0x0000},
-	{0x40FFDFFF, 0x47FF},
-	{0x497FF808, 0x7C00},
-	{0x40FFBFFF, 0x47FE},
-	{0xC3800600, 0xDC00},
-	{0x40FF7FFF, 0x47FC},
-	{0x7ED23A2E, 0x7C00},
-	{0x40FEFFFF, 0x47F8},
-	{0x40FF0004, 0x47F8},
-	{0x40FDFFFF, 0x47F0},
-	{0x817FFFB7, 0x8000},
-	{0x40FBFFFF, 0x47E0},
-	{0x5FFFF3FE, 0x7C00},
-	{0x40F7FFFF, 0x47C0},
-	{0x388761A3, 0x043B},
-	{0x40EFFFFF, 0x4780},
-	{0xBCE08000, 0xA704},
-	{0x40DFFFFF, 0x4700},
-	{0x417EDFFF, 0x4BF7},
-	{0x40BFFFFF, 0x4600},
-	{0x3E7FEF7E, 0x33FF},
-	{0x409FFFFF, 0x4500},
-	{0xC001003F, 0xC008},
-	{0x408FFFFF, 0x4480},
-	{0x5E07BFFE, 0x7C00},
-	{0x4087FFFF, 0x4440},
-	{0x805C4B3A, 0x8000},
-	{0x4083FFFF, 0x4420},
-	{0x3EEDADBE, 0x376D},
-	{0x4081FFFF, 0x4410},
-	{0x3DFFFFF5, 0x3000},
-	{0x4080FFFF, 0x4408},
-	{0x3BFFFC06, 0x2000},
-	{0x40807FFF, 0x4404},
-	{0xFE80023F, 0xFC00},
-	{0x40803FFF, 0x4402},
-	{0x540FFF00, 0x7C00},
-	{0x40801FFF, 0x4401},
-	{0xFFFFFFCE, 0xFFFF},
-	{0x40800FFF, 0x4400},
-	{0x80FFBFEF, 0x8000},
-	{0x408007FF, 0x4400},
-	{0xC07FC1FF, 0xC3FE},
-	{0x408003FF, 0x4400},
-	{0xC1954245, 0xCCAA},
-	{0x408001FF, 0x4400},
-	{0x81107FFF, 0x8000},
-	{0x408000FF, 0x4400},
-	{0x40802080, 0x4401},
-	{0x4080007F, 0x4400},
-	{0x3DB9991A, 0x2DCD},
-	{0x4080003F, 0x4400},
-	{0xBE7FFFF8, 0xB400},
-	{0x4080001F, 0x4400},
-	{0x470203FF, 0x7810},
-	{0x4080000F, 0x4400},
-	{0x5F7FFFFE, 0x7C00},
-	{0x40800007, 0x4400},
-	{0xC0809896, 0xC405},
-	{0x40800003, 0x4400},
-	{0xC080FFBF, 0xC408},
-	{0x41000000, 0x4800},
-	{0xDF801010, 0xFC00},
-	{0x41000001, 0x4800},
-	{0x16FF07FF, 0x0000},
-	{0x41000002, 0x4800},
-	{0xD308001F, 0xFC00},
-	{0x41000004, 0x4800},
-	{0xCF8003FE, 0xFC00},
-	{0x41000008, 0x4800},
-	{0xBD7EF000, 0xABF8},
-	{0x41000010, 0x4800},
-	{0x4F07FFFA, 0x7C00},
-	{0x41000020, 0x4800},
-	{0xC1DFFBFE, 0xCF00},
-	{0x41000040, 0x4800},
-	{0xDF02F146, 0xFC00},
-	{0x41000080, 0x4800},
-	{0x3ECA95CC, 0x3655},
-	{0x41000100, 0x4800},
-	{0xC2C9BCF1, 0xD64E},
-	{0x41000200, 0x4800},
-	{0x7F800807, 0x7E00},
-	{0x41000400, 0x4800},
-	{0xC17FFFDE, 0xCC00},
-	{0x41000800, 0x4800},
-	{0x4EF07FFF, 0x7C00},
-	{0x41001000, 0x4800},
-	{0x807003FE, 0x8000},
-	{0x41002000, 0x4801},
-	{0x410000FD, 0x4800},
-	{0x41004000, 0x4802},
-	{0xCB87FDFE, 0xFC00},
-	{0x41008000, 0x4804},
-	{0xC0D8251F, 0xC6C1},
-	{0x41010000, 0x4808},
-	{0xDF7FFFB7, 0xFC00},
-	{0x41020000, 0x4810},
-	{0xC8CCE0A1, 0xFC00},
-	{0x41040000, 0x4820},
-	{0x40F7FFEE, 0x47C0},
-	{0x41080000, 0x4840},
-	{0x83040001, 0x8000},
-	{0x41100000, 0x4880},
-	{0x41BA87D2, 0x4DD4},
-	{0x41200000, 0x4900},
-	{0xC0020040, 0xC010},
-	{0x41400000, 0x4A00},
-	{0x5FC12D5D, 0x7C00},
-	{0x41600000, 0x4B00},
-	{0x0D0003FE, 0x0000},
-	{0x41700000, 0x4B80},
-	{0xDE000087, 0xFC00},
-	{0x41780000, 0x4BC0},
-	{0x3E77FFDE, 0x33C0},
-	{0x417C0000, 0x4BE0},
-	{0x77004003, 0x7C00},
-	{0x417E0000, 0x4BF0},
-	{0x000FAC24, 0x0000},
-	{0x417F0000, 0x4BF8},
-	{0xCFFFF00F, 0xFC00},
-	{0x417F8000, 0x4BFC},
-	{0x5EA19106, 0x7C00},
-	{0x417FC000, 0x4BFE},
-	{0xD8F45C5C, 0xFC00},
-	{0x417FE000, 0x4BFF},
-	{0x4F1FE000, 0x7C00},
-	{0x417FF000, 0x4C00},
-	{0x00326710, 0x0000},
-	{0x417FF800, 0x4C00},
-	{0x6B180000, 0x7C00},
-	{0x417FFC00, 0x4C00},
-	{0xCFFFEFFD, 0xFC00},
-	{0x417FFE00, 0x4C00},
-	{0xCDFB9A57, 0xFC00},
-	{0x417FFF00, 0x4C00},
-	{0x5E00003B, 0x7C00},
-	{0x417FFF80, 0x4C00},
-	{0x3D0BFFFF, 0x2860},
-	{0x417FFFC0, 0x4C00},
-	{0x80811FFF, 0x8000},
-	{0x417FFFE0, 0x4C00},
-	{0xBFF8FFFF, 0xBFC8},
-	{0x417FFFF0, 0x4C00},
-	{0x70FFFFBB, 0x7C00},
-	{0x417FFFF8, 0x4C00},
-	{0xBEA007FF, 0xB500},
-	{0x417FFFFC, 0x4C00},
-	{0xB3A00002, 0x8001},
-	{0x417FFFFE, 0x4C00},
-	{0xFA00023E, 0xFC00},
-	{0x417FFFFF, 0x4C00},
-	{0x01310909, 0x0000},
-	{0x417FFFFD, 0x4C00},
-	{0x407FEFBE, 0x43FF},
-	{0x417FFFFB, 0x4C00},
-	{0xC0FFFFFD, 0xC800},
-	{0x417FFFF7, 0x4C00},
-	{0xB17FC07F, 0x8000},
-	{0x417FFFEF, 0x4C00},
-	{0x3C7EFF7F, 0x23F8},
-	{0x417FFFDF, 0x4C00},
-	{0xDEA7F28A, 0xFC00},
-	{0x417FFFBF, 0x4C00},
-	{0xBC0003BF, 0xA000},
-	{0x417FFF7F, 0x4C00},
-	{0xDF80EFFE, 0xFC00},
-	{0x417FFEFF, 0x4C00},
-	{0x5FEFFFFD, 0x7C00},
-	{0x417FFDFF, 0x4C00},
-	{0xBDFFEFFA, 0xAFFF},
-	{0x417FFBFF, 0x4C00},
-	{0xBF7FFFEF, 0xBC00},
-	{0x417FF7FF, 0x4C00},
-	{0xCA15E7E4, 0xFC00},
-	{0x417FEFFF, 0x4BFF},
-	{0xB1CB2357, 0x8000},
-	{0x417FDFFF, 0x4BFF},
-	{0x3CEFFFEE, 0x2780},
-	{0x417FBFFF, 0x4BFE},
-	{0x3DFC0003, 0x2FE0},
-	{0x417F7FFF, 0x4BFC},
-	{0x3F12EB9E, 0x3897},
-	{0x417EFFFF, 0x4BF8},
-	{0xDA7E001E, 0xFC00},
-	{0x417DFFFF, 0x4BF0},
-	{0xCF77F7FE, 0xFC00},
-	{0x417BFFFF, 0x4BE0},
-	{0x3E803FFF, 0x3402},
-	{0x4177FFFF, 0x4BC0},
-	{0xCE0023FE, 0xFC00},
-	{0x416FFFFF, 0x4B80},
-	{0x33950D7F, 0x0001},
-	{0x415FFFFF, 0x4B00},
-	{0xCE7FDF80, 0xFC00},
-	{0x413FFFFF, 0x4A00},
-	{0x7F80001F, 0x7E00},
-	{0x411FFFFF, 0x4900},
-	{0x42800407, 0x5400},
-	{0x410FFFFF, 0x4880},
-	{0xD9FFFFC0, 0xFC00},
-	{0x4107FFFF, 0x4840},
-	{0x44106E8D, 0x6083},
-	{0x4103FFFF, 0x4820},
-	{0xB27FEBFE, 0x8000},
-	{0x4101FFFF, 0x4810},
-	{0x0820EBD0, 0x0000},
-	{0x4100FFFF, 0x4808},
-	{0x3BD00000, 0x1E80},
-	{0x41007FFF, 0x4804},
-	{0xC45277F0, 0xE294},
-	{0x41003FFF, 0x4802},
-	{0xC17FFFF9, 0xCC00},
-	{0x41001FFF, 0x4801},
-	{0xF6000090, 0xFC00},
-	{0x41000FFF, 0x4800},
-	{0xAE1AF5EB, 0x8000},
-	{0x410007FF, 0x4800},
-	{0xDE86B0D6, 0xFC00},
-	{0x410003FF, 0x4800},
-	{0xD778000E, 0xFC00},
-	{0x410001FF, 0x4800},
-	{0xE8820010, 0xFC00},
-	{0x410000FF, 0x4800},
-	{0x4BAB9340, 0x7C00},
-	{0x4100007F, 0x4800},
-	{0x3AEB34F5, 0x175A},
-	{0x4100003F, 0x4800},
-	{0xFEFE0002, 0xFC00},
-	{0x4100001F, 0x4800},
-	{0xBF73B520, 0xBB9E},
-	{0x4100000F, 0x4800},
-	{0xB9803F7F, 0x8C02},
-	{0x41000007, 0x4800},
-	{0xB3B7A9CD, 0x8001},
-	{0x41000003, 0x4800},
-	{0x7E125C5A, 0x7C00},
-	{0x41800000, 0x4C00},
-	{0x1D7FFFDE, 0x0000},
-	{0x41800001, 0x4C00},
-	{0xDB700040, 0xFC00},
-	{0x41800002, 0x4C00},
-	{0x527FF7F7, 0x7C00},
-	{0x41800004, 0x4C00},
-	{0x3B880FFF, 0x1C40},
-	{0x41800008, 0x4C00},
-	{0xC0001FDF, 0xC001},
-	{0x41800010, 0x4C00},
-	{0x55FFFFFD, 0x7C00},
-	{0x41800020, 0x4C00},
-	{0x4F7FFBBE, 0x7C00},
-	{0x41800040, 0x4C00},
-	{0x8004902E, 0x8000},
-	{0x41800080, 0x4C00},
-	{0xC052D11E, 0xC297},
-	{0x41800100, 0x4C00},
-	{0xBD47FFFE, 0xAA40},
-	{0x41800200, 0x4C00},
-	{0x89129AE1, 0x8000},
-	{0x41800400, 0x4C00},
-	{0x4FBC274E, 0x7C00},
-	{0x41800800, 0x4C00},
-	{0xBE7DFC00, 0xB3F0},
-	{0x41801000, 0x4C00},
-	{0x5F7F7F7E, 0x7C00},
-	{0x41802000, 0x4C01},
-	{0x6B0006BC, 0x7C00},
-	{0x41804000, 0x4C02},
-	{0x00A2BE57, 0x0000},
-	{0x41808000, 0x4C04},
-	{0x2F495924, 0x0000},
-	{0x41810000, 0x4C08},
-	{0x3E17884C, 0x30BC},
-	{0x41820000, 0x4C10},
-	{0xC005FFFF, 0xC030},
-	{0x41840000, 0x4C20},
-	{0xCF8D6B0E, 0xFC00},
-	{0x41880000, 0x4C40},
-	{0x7F900007, 0x7E80},
-	{0x41900000, 0x4C80},
-	{0xD417E4C1, 0xFC00},
-	{0x41A00000, 0x4D00},
-	{0x4370FFFE, 0x5B88},
-	{0x41C00000, 0x4E00},
-	{0xB807FFFC, 0x8220},
-	{0x41E00000, 0x4F00},
-	{0x7C880003, 0x7C00},
-	{0x41F00000, 0x4F80},
-	{0x6A7FEF80, 0x7C00},
-	{0x41F80000, 0x4FC0},
-	{0xDEE80AEA, 0xFC00},
-	{0x41FC0000, 0x4FE0},
-	{0xC6FFBFFF, 0xF7FE},
-	{0x41FE0000, 0x4FF0},
-	{0x803F4F62, 0x8000},
-	{0x41FF0000, 0x4FF8},
-	{0xC1603FFF, 0xCB02},
-	{0x41FF8000, 0x4FFC},
-	{0xBC3FFDFF, 0xA200},
-	{0x41FFC000, 0x4FFE},
-	{0xBE7F7F7F, 0xB3FC},
-	{0x41FFE000, 0x4FFF},
-	{0xE3FFFFDF, 0xFC00},
-	{0x41FFF000, 0x5000},
-	{0x5F800801, 0x7C00},
-	{0x41FFF800, 0x5000},
-	{0x437FFFBB, 0x5C00},
-	{0x41FFFC00, 0x5000},
-	{0x7F200008, 0x7C00},
-	{0x41FFFE00, 0x5000},
-	{0x3F400004, 0x3A00},
-	{0x41FFFF00, 0x5000},
-	{0x4E2E9AED, 0x7C00},
-	{0x41FFFF80, 0x5000},
-	{0x417139E8, 0x4B8A},
-	{0x41FFFFC0, 0x5000},
-	{0x4F7001FF, 0x7C00},
-	{0x41FFFFE0, 0x5000},
-	{0x0001FBFF, 0x0000},
-	{0x41FFFFF0, 0x5000},
-	{0xDE001FFF, 0xFC00},
-	{0x41FFFFF8, 0x5000},
-	{0x7E800600, 0x7C00},
-	{0x41FFFFFC, 0x5000},
-	{0xBD7E03FF, 0xABF0},
-	{0x41FFFFFE, 0x5000},
-	{0x5E3399E1, 0x7C00},
-	{0x41FFFFFF, 0x5000},
-	{0xBFFBFFDF, 0xBFE0},
-	{0x41FFFFFD, 0x5000},
-	{0x3E4025B2, 0x3201},
-	{0x41FFFFFB, 0x5000},
-	{0x7F5FFBFF, 0x7C00},
-	{0x41FFFFF7, 0x5000},
-	{0xD6280000, 0xFC00},
-	{0x41FFFFEF, 0x5000},
-	{0xC1052AEB, 0xC829},
-	{0x41FFFFDF, 0x5000},
-	{0xC07C0002, 0xC3E0},
-	{0x41FFFFBF, 0x5000},
-	{0x015FF7FF, 0x0000},
-	{0x41FFFF7F, 0x5000},
-	{0x19000202, 0x0000},
-	{0x41FFFEFF, 0x5000},
-	{0x30859429, 0x0000},
-	{0x41FFFDFF, 0x5000},
-	{0xD320000F, 0xFC00},
-	{0x41FFFBFF, 0x5000},
-	{0x047EFE00, 0x0000},
-	{0x41FFF7FF, 0x5000},
-	{0x3E01FFBF, 0x3010},
-	{0x41FFEFFF, 0x4FFF},
-	{0x4E000003, 0x7C00},
-	{0x41FFDFFF, 0x4FFF},
-	{0x3AFEFFE0, 0x17F8},
-	{0x41FFBFFF, 0x4FFE},
-	{0x407FE3FE, 0x43FF},
-	{0x41FF7FFF, 0x4FFC},
-	{0x3D7E0000, 0x2BF0},
-	{0x41FEFFFF, 0x4FF8},
-	{0xDEFFFFDD, 0xFC00},
-	{0x41FDFFFF, 0x4FF0},
-	{0x5FE1FFFF, 0x7C00},
-	{0x41FBFFFF, 0x4FE0},
-	{0xC10EEB71, 0xC877},
-	{0x41F7FFFF, 0x4FC0},
-	{0xBF69DDC9, 0xBB4F},
-	{0x41EFFFFF, 0x4F80},
-	{0xCE040800, 0xFC00},
-	{0x41DFFFFF, 0x4F00},
-	{0xCE801001, 0xFC00},
-	{0x41BFFFFF, 0x4E00},
-	{0x4B800000, 0x7C00},
-	{0x419FFFFF, 0x4D00},
-	{0x4E900040, 0x7C00},
-	{0x418FFFFF, 0x4C80},
-	{0x807F7F00, 0x8000},
-	{0x4187FFFF, 0x4C40},
-	{0x0080FFDE, 0x0000},
-	{0x4183FFFF, 0x4C20},
-	{0x26805000, 0x0000},
-	{0x4181FFFF, 0x4C10},
-	{0x5E79FFFF, 0x7C00},
-	{0x4180FFFF, 0x4C08},
-	{0x3EFFBC00, 0x37FE},
-	{0x41807FFF, 0x4C04},
-	{0xC1A04000, 0xCD02},
-	{0x41803FFF, 0x4C02},
-	{0xC07FFD7E, 0xC400},
-	{0x41801FFF, 0x4C01},
-	{0x388FDFFE, 0x047F},
-	{0x41800FFF, 0x4C00},
-	{0x3A021FFF, 0x1011},
-	{0x418007FF, 0x4C00},
-	{0xBF82007E, 0xBC10},
-	{0x418003FF, 0x4C00},
-	{0xC5004000, 0xE802},
-	{0x418001FF, 0x4C00},
-	{0x40FFF040, 0x4800},
-	{0x418000FF, 0x4C00},
-	{0xDF630FCF, 0xFC00},
-	{0x4180007F, 0x4C00},
-	{0xBF485A49, 0xBA43},
-	{0x4180003F, 0x4C00},
-	{0xA192F420, 0x8000},
-	{0x4180001F, 0x4C00},
-	{0x00AFFFFE, 0x0000},
-	{0x4180000F, 0x4C00},
-	{0x4280017E, 0x5400},
-	{0x41800007, 0x4C00},
-	{0x477BFFFC, 0x7BE0},
-	{0x41800003, 0x4C00},
-	{0x41FFFFBE, 0x5000},
-	{0x4B800000, 0x7C00},
-	{0x2960FFFF, 0x0000},
-	{0x4B800001, 0x7C00},
-	{0xC107FFF6, 0xC840},
-	{0x4B800002, 0x7C00},
-	{0xC71FFDFF, 0xF900},
-	{0x4B800004, 0x7C00},
-	{0x4080401F, 0x4402},
-	{0x4B800008, 0x7C00},
-	{0x01003FFC, 0x0000},
-	{0x4B800010, 0x7C00},
-	{0x9E7000FF, 0x8000},
-	{0x4B800020, 0x7C00},
-	{0x3A2A831B, 0x1154},
-	{0x4B800040, 0x7C00},
-	{0xBFAB3201, 0xBD5A},
-	{0x4B800080, 0x7C00},
-	{0x3ED00000, 0x3680},
-	{0x4B800100, 0x7C00},
-	{0x2208007E, 0x0000},
-	{0x4B800200, 0x7C00},
-	{0x5FFE00FF, 0x7C00},
-	{0x4B800400, 0x7C00},
-	{0x0122F4CC, 0x0000},
-	{0x4B800800, 0x7C00},
-	{0xC1FF0002, 0xCFF8},
-	{0x4B801000, 0x7C00},
-	{0x3DFFE006, 0x2FFF},
-	{0x4B802000, 0x7C00},
-	{0xFF0003FF, 0xFC00},
-	{0x4B804000, 0x7C00},
-	{0x5EFFFFD8, 0x7C00},
-	{0x4B808000, 0x7C00},
-	{0xBE400002, 0xB200},
-	{0x4B810000, 0x7C00},
-	{0xBEFFBFFD, 0xB7FE},
-	{0x4B820000, 0x7C00},
-	{0x5E1FF7FF, 0x7C00},
-	{0x4B840000, 0x7C00},
-	{0x3CC0000F, 0x2600},
-	{0x4B880000, 0x7C00},
-	{0x7EE7932D, 0x7C00},
-	{0x4B900000, 0x7C00},
-	{0x3DA83835, 0x2D42},
-	{0x4BA00000, 0x7C00},
-	{0xB3800403, 0x8001},
-	{0x4BC00000, 0x7C00},
-	{0xC16FFEFF, 0xCB80},
-	{0x4BE00000, 0x7C00},
-	{0xC37E5668, 0xDBF3},
-	{0x4BF00000, 0x7C00},
-	{0x42FFFF7F, 0x5800},
-	{0x4BF80000, 0x7C00},
-	{0xC0F8001E, 0xC7C0},
-	{0x4BFC0000, 0x7C00},
-	{0xC1003FFF, 0xC802},
-	{0x4BFE0000, 0x7C00},
-	{0x5FFFFFFF, 0x7C00},
-	{0x4BFF0000, 0x7C00},
-	{0xBBFFFFBB, 0xA000},
-	{0x4BFF8000, 0x7C00},
-	{0xCF8020FF, 0xFC00},
-	{0x4BFFC000, 0x7C00},
-	{0x3D800030, 0x2C00},
-	{0x4BFFE000, 0x7C00},
-	{0x811FFDFE, 0x8000},
-	{0x4BFFF000, 0x7C00},
-	{0x5E8007EF, 0x7C00},
-	{0x4BFFF800, 0x7C00},
-	{0x3E807FFD, 0x3404},
-	{0x4BFFFC00, 0x7C00},
-	{0x4EFFCFFE, 0x7C00},
-	{0x4BFFFE00, 0x7C00},
-	{0x7EFFFFFD, 0x7C00},
-	{0x4BFFFF00, 0x7C00},
-	{0x3E7FFF6F, 0x3400},
-	{0x4BFFFF80, 0x7C00},
-	{0xDF7F8020, 0xFC00},
-	{0x4BFFFFC0, 0x7C00},
-	{0xC184000F, 0xCC20},
-	{0x4BFFFFE0, 0x7C00},
-	{0x04FFBDFE, 0x0000},
-	{0x4BFFFFF0, 0x7C00},
-	{0xCF7FF00F, 0xFC00},
-	{0x4BFFFFF8, 0x7C00},
-	{0xC1800030, 0xCC00},
-	{0x4BFFFFFC, 0x7C00},
-	{0xC0820006, 0xC410},
-	{0x4BFFFFFE, 0x7C00},
-	{0xBA808FFF, 0x9404},
-	{0x4BFFFFFF, 0x7C00},
-	{0x3F04001F, 0x3820},
-	{0x4BFFFFFD, 0x7C00},
-	{0xFF7FFE7F, 0xFC00},
-	{0x4BFFFFFB, 0x7C00},
-	{0xCF7FFBEF, 0xFC00},
-	{0x4BFFFFF7, 0x7C00},
-	{0x4E7F001F, 0x7C00},
-	{0x4BFFFFEF, 0x7C00},
-	{0x4087C6B1, 0x443E},
-	{0x4BFFFFDF, 0x7C00},
-	{0xD2FFFF08, 0xFC00},
-	{0x4BFFFFBF, 0x7C00},
-	{0xBDF70ADF, 0xAFB8},
-	{0x4BFFFF7F, 0x7C00},
-	{0x33B229FD, 0x0001},
-	{0x4BFFFEFF, 0x7C00},
-	{0x3EEDEFDA, 0x376F},
-	{0x4BFFFDFF, 0x7C00},
-	{0xC77FFF5F, 0xFC00},
-	{0x4BFFFBFF, 0x7C00},
-	{0x9054AE6A, 0x8000},
-	{0x4BFFF7FF, 0x7C00},
-	{0xCBFFEFF8, 0xFC00},
-	{0x4BFFEFFF, 0x7C00},
-	{0x04600001, 0x0000},
-	{0x4BFFDFFF, 0x7C00},
-	{0xCBEDF381, 0xFC00},
-	{0x4BFFBFFF, 0x7C00},
-	{0xDA080080, 0xFC00},
-	{0x4BFF7FFF, 0x7C00},
-	{0xFAFFFFEB, 0xFC00},
-	{0x4BFEFFFF, 0x7C00},
-	{0x3FACDCC3, 0x3D67},
-	{0x4BFDFFFF, 0x7C00},
-	{0xFD780004, 0xFC00},
-	{0x4BFBFFFF, 0x7C00},
-	{0xBE700080, 0xB380},
-	{0x4BF7FFFF, 0x7C00},
-	{0xCA000FEF, 0xFC00},
-	{0x4BEFFFFF, 0x7C00},
-	{0xCFEFFFFF, 0xFC00},
-	{0x4BDFFFFF, 0x7C00},
-	{0xA3F61D67, 0x8000},
-	{0x4BBFFFFF, 0x7C00},
-	{0x4E069BAF, 0x7C00},
-	{0x4B9FFFFF, 0x7C00},
-	{0xAA7DF7FF, 0x8000},
-	{0x4B8FFFFF, 0x7C00},
-	{0xCC126946, 0xFC00},
-	{0x4B87FFFF, 0x7C00},
-	{0xF3000840, 0xFC00},
-	{0x4B83FFFF, 0x7C00},
-	{0x3F7FDF80, 0x3BFF},
-	{0x4B81FFFF, 0x7C00},
-	{0xBF001FF8, 0xB801},
-	{0x4B80FFFF, 0x7C00},
-	{0x2E87FFC0, 0x0000},
-	{0x4B807FFF, 0x7C00},
-	{0xCF601FFF, 0xFC00},
-	{0x4B803FFF, 0x7C00},
-	{0x5E878000, 0x7C00},
-	{0x4B801FFF, 0x7C00},
-	{0x3E3FFFFE, 0x3200},
-	{0x4B800FFF, 0x7C00},
-	{0xC4807EFF, 0xE404},
-	{0x4B8007FF, 0x7C00},
-	{0xBF873144, 0xBC3A},
-	{0x4B8003FF, 0x7C00},
-	{0xCD7FFF6E, 0xFC00},
-	{0x4B8001FF, 0x7C00},
-	{0x40F7FEFF, 0x47C0},
-	{0x4B8000FF, 0x7C00},
-	{0x807FE7FF, 0x8000},
-	{0x4B80007F, 0x7C00},
-	{0xC077FFFF, 0xC3C0},
-	{0x4B80003F, 0x7C00},
-	{0x2180FFFD, 0x0000},
-	{0x4B80001F, 0x7C00},
-	{0xAEFFFDEF, 0x8000},
-	{0x4B80000F, 0x7C00},
-	{0xFF06FFFE, 0xFC00},
-	{0x4B800007, 0x7C00},
-	{0x3F6677C9, 0x3B34},
-	{0x4B800003, 0x7C00},
-	{0x5FFFE007, 0x7C00},
-	{0x4E000000, 0x7C00},
-	{0xD240BC55, 0xFC00},
-	{0x4E000001, 0x7C00},
-	{0x4007FFF6, 0x4040},
-	{0x4E000002, 0x7C00},
-	{0xCD7FBEFE, 0xFC00},
-	{0x4E000004, 0x7C00},
-	{0xC683FFDE, 0xF420},
-	{0x4E000008, 0x7C00},
-	{0x440FFC00, 0x6080},
-	{0x4E000010, 0x7C00},
-	{0x5F9B3E3F, 0x7C00},
-	{0x4E000020, 0x7C00},
-	{0xBD8FFFBF, 0xAC80},
-	{0x4E000040, 0x7C00},
-	{0xDF00002F, 0xFC00},
-	{0x4E000080, 0x7C00},
-	{0x41810000, 0x4C08},
-	{0x4E000100, 0x7C00},
-	{0x4381FFE0, 0x5C10},
-	{0x4E000200, 0x7C00},
-	{0xC01D0ABA, 0xC0E8},
-	{0x4E000400, 0x7C00},
-	{0x417FFF40, 0x4C00},
-	{0x4E000800, 0x7C00},
-	{0x807FF07F, 0x8000},
-	{0x4E001000, 0x7C00},
-	{0x40FFFFCF, 0x4800},
-	{0x4E002000, 0x7C00},
-	{0xCE003FF7, 0xFC00},
-	{0x4E004000, 0x7C00},
-	{0x3C7FEC00, 0x23FF},
-	{0x4E008000, 0x7C00},
-	{0xF901BDD3, 0xFC00},
-	{0x4E010000, 0x7C00},
-	{0x3B91DE25, 0x1C8F},
-	{0x4E020000, 0x7C00},
-	{0x5D863EAF, 0x7C00},
-	{0x4E040000, 0x7C00},
-	{0xBE07BFFF, 0xB03E},
-	{0x4E080000, 0x7C00},
-	{0x3D7FFFFF, 0x2C00},
-	{0x4E100000, 0x7C00},
-	{0xCE00203F, 0xFC00},
-	{0x4E200000, 0x7C00},
-	{0x811000FF, 0x8000},
-	{0x4E400000, 0x7C00},
-	{0x3F7FF81F, 0x3C00},
-	{0x4E600000, 0x7C00},
-	{0x3E33275B, 0x3199},
-	{0x4E700000, 0x7C00},
-	{0xC094A0D9, 0xC4A5},
-	{0x4E780000, 0x7C00},
-	{0xBC3FFEFE, 0xA200},
-	{0x4E7C0000, 0x7C00},
-	{0xBEED12AE, 0xB769},
-	{0x4E7E0000, 0x7C00},
-	{0x4000407F, 0x4002},
-	{0x4E7F0000, 0x7C00},
-	{0x3DF8007F, 0x2FC0},
-	{0x4E7F8000, 0x7C00},
-	{0x3A27BF42, 0x113E},
-	{0x4E7FC000, 0x7C00},
-	{0x3FA27056, 0x3D14},
-	{0x4E7FE000, 0x7C00},
-	{0x4F801FC0, 0x7C00},
-	{0x4E7FF000, 0x7C00},
-	{0x3080005F, 0x0000},
-	{0x4E7FF800, 0x7C00},
-	{0x5F801EFE, 0x7C00},
-	{0x4E7FFC00, 0x7C00},
-	{0x869FFEFE, 0x8000},
-	{0x4E7FFE00, 0x7C00},
-	{0xBF7FFFFF, 0xBC00},
-	{0x4E7FFF00, 0x7C00},
-	{0x417FFE7F, 0x4C00},
-	{0x4E7FFF80, 0x7C00},
-	{0x5E10001E, 0x7C00},
-	{0x4E7FFFC0, 0x7C00},
-	{0x5E5419A1, 0x7C00},
-	{0x4E7FFFE0, 0x7C00},
-	{0x4AFE1FFF, 0x7C00},
-	{0x4E7FFFF0, 0x7C00},
-	{0xBEBCC56B, 0xB5E6},
-	{0x4E7FFFF8, 0x7C00},
-	{0xDF7FF03F, 0xFC00},
-	{0x4E7FFFFC, 0x7C00},
-	{0xDE7838A2, 0xFC00},
-	{0x4E7FFFFE, 0x7C00},
-	{0x00008002, 0x0000},
-	{0x4E7FFFFF, 0x7C00},
-	{0x4980081F, 0x7C00},
-	{0x4E7FFFFD, 0x7C00},
-	{0xC2000104, 0xD000},
-	{0x4E7FFFFB, 0x7C00},
-	{0x3E7FE3FF, 0x33FF},
-	{0x4E7FFFF7, 0x7C00},
-	{0xA32215A4, 0x8000},
-	{0x4E7FFFEF, 0x7C00},
-	{0x2C7FFEEE, 0x0000},
-	{0x4E7FFFDF, 0x7C00},
-	{0xC2100FFE, 0xD080},
-	{0x4E7FFFBF, 0x7C00},
-	{0xF797FFFE, 0xFC00},
-	{0x4E7FFF7F, 0x7C00},
-	{0xCF7F8007, 0xFC00},
-	{0x4E7FFEFF, 0x7C00},
-	{0x3D8003DE, 0x2C00},
-	{0x4E7FFDFF, 0x7C00},
-	{0xCF803FFF, 0xFC00},
-	{0x4E7FFBFF, 0x7C00},
-	{0x40A03567, 0x4502},
-	{0x4E7FF7FF, 0x7C00},
-	{0x37719619, 0x00F2},
-	{0x4E7FEFFF, 0x7C00},
-	{0xBF00FBFF, 0xB808},
-	{0x4E7FDFFF, 0x7C00},
-	{0xDFFFFEF7, 0xFC00},
-	{0x4E7FBFFF, 0x7C00},
-	{0x9F1F9FF9, 0x8000},
-	{0x4E7F7FFF, 0x7C00},
-	{0xBDDBAC5D, 0xAEDD},
-	{0x4E7EFFFF, 0x7C00},
-	{0x3527B0D0, 0x000A},
-	{0x4E7DFFFF, 0x7C00},
-	{0xBE800400, 0xB400},
-	{0x4E7BFFFF, 0x7C00},
-	{0xC05FFFEF, 0xC300},
-	{0x4E77FFFF, 0x7C00},
-	{0x7FFFFFD7, 0x7FFF},
-	{0x4E6FFFFF, 0x7C00},
-	{0x58FC0007, 0x7C00},
-	{0x4E5FFFFF, 0x7C00},
-	{0x3E80FFFD, 0x3408},
-	{0x4E3FFFFF, 0x7C00},
-	{0x00B86235, 0x0000},
-	{0x4E1FFFFF, 0x7C00},
-	{0x7F7FE00E, 0x7C00},
-	{0x4E0FFFFF, 0x7C00},
-	{0x017FBFFA, 0x0000},
-	{0x4E07FFFF, 0x7C00},
-	{0xC0100002, 0xC080},
-	{0x4E03FFFF, 0x7C00},
-	{0x7FFF6FFF, 0x7FFB},
-	{0x4E01FFFF, 0x7C00},
-	{0xCE2E39A9, 0xFC00},
-	{0x4E00FFFF, 0x7C00},
-	{0x359FDFFF, 0x0014},
-	{0x4E007FFF, 0x7C00},
-	{0x41004003, 0x4802},
-	{0x4E003FFF, 0x7C00},
-	{0x41BFFFBF, 0x4E00},
-	{0x4E001FFF, 0x7C00},
-	{0xB6800021, 0x8040},
-	{0x4E000FFF, 0x7C00},
-	{0xBF800006, 0xBC00},
-	{0x4E0007FF, 0x7C00},
-	{0x409B0798, 0x44D8},
-	{0x4E0003FF, 0x7C00},
-	{0x495B3942, 0x7C00},
-	{0x4E0001FF, 0x7C00},
-	{0x3F80040E, 0x3C00},
-	{0x4E0000FF, 0x7C00},
-	{0x49000202, 0x7C00},
-	{0x4E00007F, 0x7C00},
-	{0x5FFFFBBF, 0x7C00},
-	{0x4E00003F, 0x7C00},
-	{0x4F011FFF, 0x7C00},
-	{0x4E00001F, 0x7C00},
-	{0xB10FFFBF, 0x8000},
-	{0x4E00000F, 0x7C00},
-	{0x5F872D4B, 0x7C00},
-	{0x4E000007, 0x7C00},
-	{0xC17FF00F, 0xCC00},
-	{0x4E000003, 0x7C00},
-	{0x4B897AE4, 0x7C00},
-	{0x4E800000, 0x7C00},
-	{0x3E00FFDF, 0x3008},
-	{0x4E800001, 0x7C00},
-	{0xC366B0E0, 0xDB36},
-	{0x4E800002, 0x7C00},
-	{0x4E004004, 0x7C00},
-	{0x4E800004, 0x7C00},
-	{0xCB8FFEFF, 0xFC00},
-	{0x4E800008, 0x7C00},
-	{0x33C001FF, 0x0002},
-	{0x4E800010, 0x7C00},
-	{0xC2F257AF, 0xD793},
-	{0x4E800020, 0x7C00},
-	{0x80001100, 0x8000},
-	{0x4E800040, 0x7C00},
-	{0x3B7E000F, 0x1BF0},
-	{0x4E800080, 0x7C00},
-	{0xA23FFFC0, 0x8000},
-	{0x4E800100, 0x7C00},
-	{0xC10FFFFF, 0xC880},
-	{0x4E800200, 0x7C00},
-	{0x4FD77FD9, 0x7C00},
-	{0x4E800400, 0x7C00},
-	{0x00FFEF80, 0x0000},
-	{0x4E800800, 0x7C00},
-	{0xCFF80006, 0xFC00},
-	{0x4E801000, 0x7C00},
-	{0x4FFFE1FF, 0x7C00},
-	{0x4E802000, 0x7C00},
-	{0x5F820007, 0x7C00},
-	{0x4E804000, 0x7C00},
-	{0x4E800FFB, 0x7C00},
-	{0x4E808000, 0x7C00},
-	{0x1DF80007, 0x0000},
-	{0x4E810000, 0x7C00},
-	{0x33F219F7, 0x0002},
-	{0x4E820000, 0x7C00},
-	{0x4F801BFF, 0x7C00},
-	{0x4E840000, 0x7C00},
-	{0x7F010000, 0x7C00},
-	{0x4E880000, 0x7C00},
-	{0xB429D525, 0x8003},
-	{0x4E900000, 0x7C00},
-	{0x427FFF02, 0x5400},
-	{0x4EA00000, 0x7C00},
-	{0xB3802000, 0x8001},
-	{0x4EC00000, 0x7C00},
-	{0xDF0040FE, 0xFC00},
-	{0x4EE00000, 0x7C00},
-	{0x267F0001, 0x0000},
-	{0x4EF00000, 0x7C00},
-	{0xBCA1F6A0, 0xA510},
-	{0x4EF80000, 0x7C00},
-	{0x7F7F8000, 0x7C00},
-	{0x4EFC0000, 0x7C00},
-	{0x6E801FFB, 0x7C00},
-	{0x4EFE0000, 0x7C00},
-	{0xF58FFC00, 0xFC00},
-	{0x4EFF0000, 0x7C00},
-	{0x3E000FEF, 0x3000},
-	{0x4EFF8000, 0x7C00},
-	{0xC09FFE00, 0xC500},
-	{0x4EFFC000, 0x7C00},
-	{0xBE7F7FFF, 0xB3FC},
-	{0x4EFFE000, 0x7C00},
-	{0x7F6007FE, 0x7C00},
-	{0x4EFFF000, 0x7C00},
-	{0x4F82A2BE, 0x7C00},
-	{0x4EFFF800, 0x7C00},
-	{0xBF75FFFF, 0xBBB0},
-	{0x4EFFFC00, 0x7C00},
-	{0xB5887FFF, 0x8011},
-	{0x4EFFFE00, 0x7C00},
-	{0x6D107FFF, 0x7C00},
-	{0x4EFFFF00, 0x7C00},
-	{0x417FEF7E, 0x4BFF},
-	{0x4EFFFF80, 0x7C00},
-	{0x3B003EFF, 0x1802},
-	{0x4EFFFFC0, 0x7C00},
-	{0xC0B27313, 0xC594},
-	{0x4EFFFFE0, 0x7C00},
-	{0xBF77FDFF, 0xBBC0},
-	{0x4EFFFFF0, 0x7C00},
-	{0xBE80041E, 0xB400},
-	{0x4EFFFFF8, 0x7C00},
-	{0xBE9D2E1F, 0xB4E9},
-	{0x4EFFFFFC, 0x7C00},
-	{0x41FBFFFC, 0x4FE0},
-	{0x4EFFFFFE, 0x7C00},
-	{0xBC77FDFF, 0xA3C0},
-	{0x4EFFFFFF, 0x7C00},
-	{0x3EFFDF7F, 0x37FF},
-	{0x4EFFFFFD, 0x7C00},
-	{0xCF6F8000, 0xFC00},
-	{0x4EFFFFFB, 0x7C00},
-	{0x3F7FB000, 0x3BFE},
-	{0x4EFFFFF7, 0x7C00},
-	{0x3D400000, 0x2A00},
-	{0x4EFFFFEF, 0x7C00},
-	{0xFFFFBEFF, 0xFFFD},
-	{0x4EFFFFDF, 0x7C00},
-	{0x3F0FE000, 0x387F},
-	{0x4EFFFFBF, 0x7C00},
-	{0x3DF7FFFE, 0x2FC0},
-	{0x4EFFFF7F, 0x7C00},
-	{0x4E807FFE, 0x7C00},
-	{0x4EFFFEFF, 0x7C00},
-	{0xBF0BFBEA, 0xB860},
-	{0x4EFFFDFF, 0x7C00},
-	{0xCF000001, 0xFC00},
-	{0x4EFFFBFF, 0x7C00},
-	{0x41177ADF, 0x48BC},
-	{0x4EFFF7FF, 0x7C00},
-	{0x3E3E3575, 0x31F2},
-	{0x4EFFEFFF, 0x7C00},
-	{0xBDDC20ED, 0xAEE1},
-	{0x4EFFDFFF, 0x7C00},
-	{0xBF7FF807, 0xBC00},
-	{0x4EFFBFFF, 0x7C00},
-	{0x007FF000, 0x0000},
-	{0x4EFF7FFF, 0x7C00},
-	{0x5E50D796, 0x7C00},
-	{0x4EFEFFFF, 0x7C00},
-	{0x7FD05F1F, 0x7E82},
-	{0x4EFDFFFF, 0x7C00},
-	{0x966FFDFE, 0x8000},
-	{0x4EFBFFFF, 0x7C00},
-	{0xCF6FFFFE, 0xFC00},
-	{0x4EF7FFFF, 0x7C00},
-	{0x4FFFFBBE, 0x7C00},
-	{0x4EEFFFFF, 0x7C00},
-	{0x3887FDFF, 0x0440},
-	{0x4EDFFFFF, 0x7C00},
-	{0xC0013FFF, 0xC00A},
-	{0x4EBFFFFF, 0x7C00},
-	{0x5EFFEFFF, 0x7C00},
-	{0x4E9FFFFF, 0x7C00},
-	{0x5F7D0000, 0x7C00},
-	{0x4E8FFFFF, 0x7C00},
-	{0xFEFFBFFB, 0xFC00},
-	{0x4E87FFFF, 0x7C00},
-	{0x147FC7FF, 0x0000},
-	{0x4E83FFFF, 0x7C00},
-	{0x8F7FFFDE, 0x8000},
-	{0x4E81FFFF, 0x7C00},
-	{0x0080F7FE, 0x0000},
-	{0x4E80FFFF, 0x7C00},
-	{0x4003FFFC, 0x4020},
-	{0x4E807FFF, 0x7C00},
-	{0x5481003F, 0x7C00},
-	{0x4E803FFF, 0x7C00},
-	{0xFFE5E1DE, 0xFF2F},
-	{0x4E801FFF, 0x7C00},
-	{0x3CFBFFFC, 0x27E0},
-	{0x4E800FFF, 0x7C00},
-	{0x3A75FFFF, 0x13B0},
-	{0x4E8007FF, 0x7C00},
-	{0x418021FF, 0x4C01},
-	{0x4E8003FF, 0x7C00},
-	{0x41FFFDFD, 0x5000},
-	{0x4E8001FF, 0x7C00},
-	{0xDCB842EE, 0xFC00},
-	{0x4E8000FF, 0x7C00},
-	{0xCEFFEFFF, 0xFC00},
-	{0x4E80007F, 0x7C00},
-	{0xDE80000A, 0xFC00},
-	{0x4E80003F, 0x7C00},
-	{0xCF00801E, 0xFC00},
-	{0x4E80001F, 0x7C00},
-	{0x39DFEFFE, 0x0EFF},
-	{0x4E80000F, 0x7C00},
-	{0x3CB376EE, 0x259C},
-	{0x4E800007, 0x7C00},
-	{0x2D8000A0, 0x0000},
-	{0x4E800003, 0x7C00},
-	{0x327F0003, 0x0000},
-	{0x4F000000, 0x7C00},
-	{0xBD6641D2, 0xAB32},
-	{0x4F000001, 0x7C00},
-	{0x7F6BCA85, 0x7C00},
-	{0x4F000002, 0x7C00},
-	{0x3DFDACBF, 0x2FED},
-	{0x4F000004, 0x7C00},
-	{0xC3800083, 0xDC00},
-	{0x4F000008, 0x7C00},
-	{0xBF000011, 0xB800},
-	{0x4F000010, 0x7C00},
-	{0xCBBFFBFE, 0xFC00},
-	{0x4F000020, 0x7C00},
-	{0xF13FEFFF, 0xFC00},
-	{0x4F000040, 0x7C00},
-	{0x998FFDFE, 0x8000},
-	{0x4F000080, 0x7C00},
-	{0x5FEF4D58, 0x7C00},
-	{0x4F000100, 0x7C00},
-	{0x40BF6F93, 0x45FB},
-	{0x4F000200, 0x7C00},
-	{0xB6E5B902, 0x8073},
-	{0x4F000400, 0x7C00},
-	{0x4103FFDF, 0x4820},
-	{0x4F000800, 0x7C00},
-	{0xCB01D554, 0xFC00},
-	{0x4F001000, 0x7C00},
-	{0xDF7F8000, 0xFC00},
-	{0x4F002000, 0x7C00},
-	{0x927FD208, 0x8000},
-	{0x4F004000, 0x7C00},
-	{0x4F00007F, 0x7C00},
-	{0x4F008000, 0x7C00},
-	{0xDE02007E, 0xFC00},
-	{0x4F010000, 0x7C00},
-	{0x402C48A0, 0x4162},
-	{0x4F020000, 0x7C00},
-	{0xC17F3FFF, 0xCBFA},
-	{0x4F040000, 0x7C00},
-	{0xE5C0E5FF, 0xFC00},
-	{0x4F080000, 0x7C00},
-	{0xDF8001FB, 0xFC00},
-	{0x4F100000, 0x7C00},
-	{0x80922E43, 0x8000},
-	{0x4F200000, 0x7C00},
-	{0xC004FA70, 0xC028},
-	{0x4F400000, 0x7C00},
-	{0x40FFE1FE, 0x47FF},
-	{0x4F600000, 0x7C00},
-	{0x3D8001BF, 0x2C00},
-	{0x4F700000, 0x7C00},
-	{0x007EFF00, 0x0000},
-	{0x4F780000, 0x7C00},
-	{0x807FFEFE, 0x8000},
-	{0x4F7C0000, 0x7C00},
-	{0xC67FFE00, 0xF400},
-	{0x4F7E0000, 0x7C00},
-	{0x7F000A00, 0x7C00},
-	{0x4F7F0000, 0x7C00},
-	{0xCF9FFBFF, 0xFC00},
-	{0x4F7F8000, 0x7C00},
-	{0xC47DFFFC, 0xE3F0},
-	{0x4F7FC000, 0x7C00},
-	{0x4E03BFFF, 0x7C00},
-	{0x4F7FE000, 0x7C00},
-	{0xC033847F, 0xC19C},
-	{0x4F7FF000, 0x7C00},
-	{0x5D694BBB, 0x7C00},
-	{0x4F7FF800, 0x7C00},
-	{0x408FDFFF, 0x447F},
-	{0x4F7FFC00, 0x7C00},
-	{0x4E3F86BB, 0x7C00},
-	{0x4F7FFE00, 0x7C00},
-	{0xDE7E0FFE, 0xFC00},
-	{0x4F7FFF00, 0x7C00},
-	{0x3E7E0000, 0x33F0},
-	{0x4F7FFF80, 0x7C00},
-	{0xBD7FFC03, 0xAC00},
-	{0x4F7FFFC0, 0x7C00},
-	{0x419B03BC, 0x4CD8},
-	{0x4F7FFFE0, 0x7C00},
-	{0x7EBFF000, 0x7C00},
-	{0x4F7FFFF0, 0x7C00},
-	{0x42697F18, 0x534C},
-	{0x4F7FFFF8, 0x7C00},
-	{0x33FFE0FF, 0x0002},
-	{0x4F7FFFFC, 0x7C00},
-	{0xCEF96B93, 0xFC00},
-	{0x4F7FFFFE, 0x7C00},
-	{0x33BFFE00, 0x0001},
-	{0x4F7FFFFF, 0x7C00},
-	{0xC1401FFF, 0xCA01},
-	{0x4F7FFFFD, 0x7C00},
-	{0xDB901FFF, 0xFC00},
-	{0x4F7FFFFB, 0x7C00},
-	{0x4083FFBF, 0x4420},
-	{0x4F7FFFF7, 0x7C00},
-	{0x3E7C1FFF, 0x33E1},
-	{0x4F7FFFEF, 0x7C00},
-	{0xBE880002, 0xB440},
-	{0x4F7FFFDF, 0x7C00},
-	{0xC0DFFFDF, 0xC700},
-	{0x4F7FFFBF, 0x7C00},
-	{0xE27FFFF9, 0xFC00},
-	{0x4F7FFF7F, 0x7C00},
-	{0xDE5FFFFC, 0xFC00},
-	{0x4F7FFEFF, 0x7C00},
-	{0xBC1A3E11, 0xA0D2},
-	{0x4F7FFDFF, 0x7C00},
-	{0xC47FF3FE, 0xE400},
-	{0x4F7FFBFF, 0x7C00},
-	{0xD97C3FFF, 0xFC00},
-	{0x4F7FF7FF, 0x7C00},
-	{0xBE000030, 0xB000},
-	{0x4F7FEFFF, 0x7C00},
-	{0xBFFEFEFF, 0xBFF8},
-	{0x4F7FDFFF, 0x7C00},
-	{0xCEFF7FE0, 0xFC00},
-	{0x4F7FBFFF, 0x7C00},
-	{0x807FFFFF, 0x8000},
-	{0x4F7F7FFF, 0x7C00},
-	{0x4FF7FFDF, 0x7C00},
-	{0x4F7EFFFF, 0x7C00},
-	{0xC0F0FFFF, 0xC788},
-	{0x4F7DFFFF, 0x7C00},
-	{0x4E400007, 0x7C00},
-	{0x4F7BFFFF, 0x7C00},
-	{0xC191C158, 0xCC8E},
-	{0x4F77FFFF, 0x7C00},
-	{0xC79B77D8, 0xFC00},
-	{0x4F6FFFFF, 0x7C00},
-	{0xBF08007E, 0xB840},
-	{0x4F5FFFFF, 0x7C00},
-	{0xFE8006FF, 0xFC00},
-	{0x4F3FFFFF, 0x7C00},
-	{0xC1DFE000, 0xCEFF},
-	{0x4F1FFFFF, 0x7C00},
-	{0xE37FFFFF, 0xFC00},
-	{0x4F0FFFFF, 0x7C00},
-	{0xC20005FF, 0xD000},
-	{0x4F07FFFF, 0x7C00},
-	{0xE8FCFFFF, 0xFC00},
-	{0x4F03FFFF, 0x7C00},
-	{0xBD7A0000, 0xABD0},
-	{0x4F01FFFF, 0x7C00},
-	{0x51FFEFE0, 0x7C00},
-	{0x4F00FFFF, 0x7C00},
-	{0xCF200000, 0xFC00},
-	{0x4F007FFF, 0x7C00},
-	{0xBDF7FDFE, 0xAFC0},
-	{0x4F003FFF, 0x7C00},
-	{0xC55FDFFE, 0xEAFF},
-	{0x4F001FFF, 0x7C00},
-	{0x3F9FFFFD, 0x3D00},
-	{0x4F000FFF, 0x7C00},
-	{0xC103FFEF, 0xC820},
-	{0x4F0007FF, 0x7C00},
-	{0x4EFFFFFF, 0x7C00},
-	{0x4F0003FF, 0x7C00},
-	{0x43FFFDFB, 0x6000},
-	{0x4F0001FF, 0x7C00},
-	{0xFF57A466, 0xFC00},
-	{0x4F0000FF, 0x7C00},
-	{0x5E383403, 0x7C00},
-	{0x4F00007F, 0x7C00},
-	{0xC17FFC7F, 0xCC00},
-	{0x4F00003F, 0x7C00},
-	{0x43800006, 0x5C00},
-	{0x4F00001F, 0x7C00},
-	{0x3962A53D, 0x0B15},
-	{0x4F00000F, 0x7C00},
-	{0xBE9C997B, 0xB4E5},
-	{0x4F000007, 0x7C00},
-	{0x4EFA8232, 0x7C00},
-	{0x4F000003, 0x7C00},
-	{0x40DB751A, 0x46DC},
-	{0x4F800000, 0x7C00},
-	{0x4E6FFEFF, 0x7C00},
-	{0x4F800001, 0x7C00},
-	{0xB902001F, 0x8810},
-	{0x4F800002, 0x7C00},
-	{0x45F7FFEF, 0x6FC0},
-	{0x4F800004, 0x7C00},
-	{0x4AFBFFEF, 0x7C00},
-	{0x4F800008, 0x7C00},
-	{0x89783FFE, 0x8000},
-	{0x4F800010, 0x7C00},
-	{0xBE0EFFFF, 0xB078},
-	{0x4F800020, 0x7C00},
-	{0xBF023FFE, 0xB812},
-	{0x4F800040, 0x7C00},
-	{0xC4807FFF, 0xE404},
-	{0x4F800080, 0x7C00},
-	{0xF88083FE, 0xFC00},
-	{0x4F800100, 0x7C00},
-	{0x7F800102, 0x7E00},
-	{0x4F800200, 0x7C00},
-	{0x440479B5, 0x6024},
-	{0x4F800400, 0x7C00},
-	{0x43FFFBFE, 0x6000},
-	{0x4F800800, 0x7C00},
-	{0xCEE0003E, 0xFC00},
-	{0x4F801000, 0x7C00},
-	{0x5F8F4B56, 0x7C00},
-	{0x4F802000, 0x7C00},
-	{0x410FBFFE, 0x487E},
-	{0x4F804000, 0x7C00},
-	{0xBF807000, 0xBC04},
-	{0x4F808000, 0x7C00},
-	{0x5EF03FFF, 0x7C00},
-	{0x4F810000, 0x7C00},
-	{0xB3808FFE, 0x8001},
-	{0x4F820000, 0x7C00},
-	{0x97FFFCFF, 0x8000},
-	{0x4F840000, 0x7C00},
-	{0x7F20EF34, 0x7C00},
-	{0x4F880000, 0x7C00},
-	{0x4109BD05, 0x484E},
-	{0x4F900000, 0x7C00},
-	{0x008FFFBF, 0x0000},
-	{0x4FA00000, 0x7C00},
-	{0xDE6EFFFF, 0xFC00},
-	{0x4FC00000, 0x7C00},
-	{0x5F80D491, 0x7C00},
-	{0x4FE00000, 0x7C00},
-	{0x0117529F, 0x0000},
-	{0x4FF00000, 0x7C00},
-	{0x4600801F, 0x7004},
-	{0x4FF80000, 0x7C00},
-	{0xD2FFF840, 0xFC00},
-	{0x4FFC0000, 0x7C00},
-	{0x0F74797A, 0x0000},
-	{0x4FFE0000, 0x7C00},
-	{0x7EF3240F, 0x7C00},
-	{0x4FFF0000, 0x7C00},
-	{0x41FAC436, 0x4FD6},
-	{0x4FFF8000, 0x7C00},
-	{0x4BC64E42, 0x7C00},
-	{0x4FFFC000, 0x7C00},
-	{0x5180041E, 0x7C00},
-	{0x4FFFE000, 0x7C00},
-	{0x41601C19, 0x4B01},
-	{0x4FFFF000, 0x7C00},
-	{0xDE7FFFFE, 0xFC00},
-	{0x4FFFF800, 0x7C00},
-	{0x4181007F, 0x4C08},
-	{0x4FFFFC00, 0x7C00},
-	{0xCA800201, 0xFC00},
-	{0x4FFFFE00, 0x7C00},
-	{0x3F400FFF, 0x3A00},
-	{0x4FFFFF00, 0x7C00},
-	{0xBD100080, 0xA880},
-	{0x4FFFFF80, 0x7C00},
-	{0xB399B67C, 0x8001},
-	{0x4FFFFFC0, 0x7C00},
-	{0x3CFFFFF3, 0x2800},
-	{0x4FFFFFE0, 0x7C00},
-	{0x3E7241F6, 0x3392},
-	{0x4FFFFFF0, 0x7C00},
-	{0x3D98689D, 0x2CC3},
-	{0x4FFFFFF8, 0x7C00},
-	{0xC0CDB76D, 0xC66E},
-	{0x4FFFFFFC, 0x7C00},
-	{0x807FE200, 0x8000},
-	{0x4FFFFFFE, 0x7C00},
-	{0xCFFFF07E, 0xFC00},
-	{0x4FFFFFFF, 0x7C00},
-	{0x5EFFF6FE, 0x7C00},
-	{0x4FFFFFFD, 0x7C00},
-	{0x40A000FE, 0x4500},
-	{0x4FFFFFFB, 0x7C00},
-	{0x800201FE, 0x8000},
-	{0x4FFFFFF7, 0x7C00},
-	{0x46FFFFBD, 0x7800},
-	{0x4FFFFFEF, 0x7C00},
-	{0x05BFFF7F, 0x0000},
-	{0x4FFFFFDF, 0x7C00},
-	{0xDE70001F, 0xFC00},
-	{0x4FFFFFBF, 0x7C00},
-	{0x387F589A, 0x03FD},
-	{0x4FFFFF7F, 0x7C00},
-	{0x827E0001, 0x8000},
-	{0x4FFFFEFF, 0x7C00},
-	{0x8177F7FF, 0x8000},
-	{0x4FFFFDFF, 0x7C00},
-	{0x0101F800, 0x0000},
-	{0x4FFFFBFF, 0x7C00},
-	{0xFF7FFF03, 0xFC00},
-	{0x4FFFF7FF, 0x7C00},
-	{0x4EC02000, 0x7C00},
-	{0x4FFFEFFF, 0x7C00},
-	{0xC18000EE, 0xCC00},
-	{0x4FFFDFFF, 0x7C00},
-	{0x3FCB44E0, 0x3E5A},
-	{0x4FFFBFFF, 0x7C00},
-	{0x8089FBE4, 0x8000},
-	{0x4FFF7FFF, 0x7C00},
-	{0x0C968A60, 0x0000},
-	{0x4FFEFFFF, 0x7C00},
-	{0x2081FEFE, 0x0000},
-	{0x4FFDFFFF, 0x7C00},
-	{0xD47FFFDF, 0xFC00},
-	{0x4FFBFFFF, 0x7C00},
-	{0xB1BFDFFF, 0x8000},
-	{0x4FF7FFFF, 0x7C00},
-	{0x811FFFE0, 0x8000},
-	{0x4FEFFFFF, 0x7C00},
-	{0x5E7EFEFE, 0x7C00},
-	{0x4FDFFFFF, 0x7C00},
-	{0xBEFE0400, 0xB7F0},
-	{0x4FBFFFFF, 0x7C00},
-	{0xFD80001F, 0xFC00},
-	{0x4F9FFFFF, 0x7C00},
-	{0x3910000F, 0x0880},
-	{0x4F8FFFFF, 0x7C00},
-	{0xBF7FFFF4, 0xBC00},
-	{0x4F87FFFF, 0x7C00},
-	{0x5F01FFF8, 0x7C00},
-	{0x4F83FFFF, 0x7C00},
-	{0xDEFDEFFE, 0xFC00},
-	{0x4F81FFFF, 0x7C00},
-	{0x7E9CC036, 0x7C00},
-	{0x4F80FFFF, 0x7C00},
-	{0x40542447, 0x42A1},
-	{0x4F807FFF, 0x7C00},
-	{0xCC001FF8, 0xFC00},
-	{0x4F803FFF, 0x7C00},
-	{0xCB84C1EA, 0xFC00},
-	{0x4F801FFF, 0x7C00},
-	{0xB6BF53A0, 0x8060},
-	{0x4F800FFF, 0x7C00},
-	{0xA96103DF, 0x8000},
-	{0x4F8007FF, 0x7C00},
-	{0xAC279353, 0x8000},
-	{0x4F8003FF, 0x7C00},
-	{0x40FC3FFE, 0x47E2},
-	{0x4F8001FF, 0x7C00},
-	{0x40003FFE, 0x4002},
-	{0x4F8000FF, 0x7C00},
-	{0x46B0FC6E, 0x7588},
-	{0x4F80007F, 0x7C00},
-	{0x3C00000F, 0x2000},
-	{0x4F80003F, 0x7C00},
-	{0xC2820003, 0xD410},
-	{0x4F80001F, 0x7C00},
-	{0xBCFFF8FF, 0xA800},
-	{0x4F80000F, 0x7C00},
-	{0xC1FFE000, 0xCFFF},
-	{0x4F800007, 0x7C00},
-	{0xB301000F, 0x8001},
-	{0x4F800003, 0x7C00},
-	{0xBF000017, 0xB800},
-	{0x5E000000, 0x7C00},
-	{0x8001000F, 0x8000},
-	{0x5E000001, 0x7C00},
-	{0xBFDFFFFE, 0xBF00},
-	{0x5E000002, 0x7C00},
-	{0xBFFF87FE, 0xBFFC},
-	{0x5E000004, 0x7C00},
-	{0x489FF7FE, 0x7C00},
-	{0x5E000008, 0x7C00},
-	{0x5E7FB7FF, 0x7C00},
-	{0x5E000010, 0x7C00},
-	{0x01153A18, 0x0000},
-	{0x5E000020, 0x7C00},
-	{0xBFC07FFE, 0xBE04},
-	{0x5E000040, 0x7C00},
-	{0x80078000, 0x8000},
-	{0x5E000080, 0x7C00},
-	{0x52FFF810, 0x7C00},
-	{0x5E000100, 0x7C00},
-	{0xAD800104, 0x8000},
-	{0x5E000200, 0x7C00},
-	{0x40925471, 0x4493},
-	{0x5E000400, 0x7C00},
-	{0x7F77FFC0, 0x7C00},
-	{0x5E000800, 0x7C00},
-	{0x41C3C128, 0x4E1E},
-	{0x5E001000, 0x7C00},
-	{0x41907FFE, 0x4C84},
-	{0x5E002000, 0x7C00},
-	{0xC0FFBBFF, 0xC7FE},
-	{0x5E004000, 0x7C00},
-	{0x806000FF, 0x8000},
-	{0x5E008000, 0x7C00},
-	{0x462F7D1A, 0x717C},
-	{0x5E010000, 0x7C00},
-	{0x3E7FC3FE, 0x33FE},
-	{0x5E020000, 0x7C00},
-	{0xBFDFCA11, 0xBEFE},
-	{0x5E040000, 0x7C00},
-	{0xBF7BFF7F, 0xBBE0},
-	{0x5E080000, 0x7C00},
-	{0x3EC00008, 0x3600},
-	{0x5E100000, 0x7C00},
-	{0x3D1FFFFE, 0x2900},
-	{0x5E200000, 0x7C00},
-	{0x5E10FFFE, 0x7C00},
-	{0x5E400000, 0x7C00},
-	{0xD61FFFFD, 0xFC00},
-	{0x5E600000, 0x7C00},
-	{0x3DA891AF, 0x2D45},
-	{0x5E700000, 0x7C00},
-	{0xD1807FFF, 0xFC00},
-	{0x5E780000, 0x7C00},
-	{0xFF01FFFD, 0xFC00},
-	{0x5E7C0000, 0x7C00},
-	{0xBD8021FF, 0xAC01},
-	{0x5E7E0000, 0x7C00},
-	{0xBFFE0007, 0xBFF0},
-	{0x5E7F0000, 0x7C00},
-	{0x7FF00400, 0x7F80},
-	{0x5E7F8000, 0x7C00},
-	{0x3483F000, 0x0004},
-	{0x5E7FC000, 0x7C00},
-	{0xFEFF7FFE, 0xFC00},
-	{0x5E7FE000, 0x7C00},
-	{0x408BFFFF, 0x4460},
-	{0x5E7FF000, 0x7C00},
-	{0xCE0107FF, 0xFC00},
-	{0x5E7FF800, 0x7C00},
-	{0xBE60003F, 0xB300},
-	{0x5E7FFC00, 0x7C00},
-	{0x407F8000, 0x43FC},
-	{0x5E7FFE00, 0x7C00},
-	{0xCBFFFC1F, 0xFC00},
-	{0x5E7FFF00, 0x7C00},
-	{0xC07DFF7F, 0xC3F0},
-	{0x5E7FFF80, 0x7C00},
-	{0xBF7FFBFF, 0xBC00},
-	{0x5E7FFFC0, 0x7C00},
-	{0x017FE002, 0x0000},
-	{0x5E7FFFE0, 0x7C00},
-	{0xB3800000, 0x8001},
-	{0x5E7FFFF0, 0x7C00},
-	{0x817F7FFE, 0x8000},
-	{0x5E7FFFF8, 0x7C00},
-	{0xABF07FFE, 0x8000},
-	{0x5E7FFFFC, 0x7C00},
-	{0x4D00080F, 0x7C00},
-	{0x5E7FFFFE, 0x7C00},
-	{0xC0804002, 0xC402},
-	{0x5E7FFFFF, 0x7C00},
-	{0xBEAE9853, 0xB575},
-	{0x5E7FFFFD, 0x7C00},
-	{0x3E7FF003, 0x3400},
-	{0x5E7FFFFB, 0x7C00},
-	{0x338C7FF1, 0x0001},
-	{0x5E7FFFF7, 0x7C00},
-	{0xC10DA73A, 0xC86D},
-	{0x5E7FFFEF, 0x7C00},
-	{0xFE80B2CA, 0xFC00},
-	{0x5E7FFFDF, 0x7C00},
-	{0xB5000200, 0x8008},
-	{0x5E7FFFBF, 0x7C00},
-	{0xBD0FF7FF, 0xA880},
-	{0x5E7FFF7F, 0x7C00},
-	{0xCF81FBFF, 0xFC00},
-	{0x5E7FFEFF, 0x7C00},
-	{0xBF9CA291, 0xBCE5},
-	{0x5E7FFDFF, 0x7C00},
-	{0xCE8F7FFF, 0xFC00},
-	{0x5E7FFBFF, 0x7C00},
-	{0x7F7BEFFF, 0x7C00},
-	{0x5E7FF7FF, 0x7C00},
-	{0x41604000, 0x4B02},
-	{0x5E7FEFFF, 0x7C00},
-	{0xBE6AA5D2, 0xB355},
-	{0x5E7FDFFF, 0x7C00},
-	{0xFF00FFF6, 0xFC00},
-	{0x5E7FBFFF, 0x7C00},
-	{0xBEC03FFF, 0xB602},
-	{0x5E7F7FFF, 0x7C00},
-	{0x41F803FF, 0x4FC0},
-	{0x5E7EFFFF, 0x7C00},
-	{0xC284007E, 0xD420},
-	{0x5E7DFFFF, 0x7C00},
-	{0x807BE09D, 0x8000},
-	{0x5E7BFFFF, 0x7C00},
-	{0x0100807E, 0x0000},
-	{0x5E77FFFF, 0x7C00},
-	{0x5E90FFFF, 0x7C00},
-	{0x5E6FFFFF, 0x7C00},
-	{0xFF9FFF7E, 0xFEFF},
-	{0x5E5FFFFF, 0x7C00},
-	{0xC4F7C000, 0xE7BE},
-	{0x5E3FFFFF, 0x7C00},
-	{0x5400FFEF, 0x7C00},
-	{0x5E1FFFFF, 0x7C00},
-	{0x77001FFF, 0x7C00},
-	{0x5E0FFFFF, 0x7C00},
-	{0x5EF00100, 0x7C00},
-	{0x5E07FFFF, 0x7C00},
-	{0x2CFFE800, 0x0000},
-	{0x5E03FFFF, 0x7C00},
-	{0xDF7EFE00, 0xFC00},
-	{0x5E01FFFF, 0x7C00},
-	{0xC12003FE, 0xC900},
-	{0x5E00FFFF, 0x7C00},
-	{0xFC000A00, 0xFC00},
-	{0x5E007FFF, 0x7C00},
-	{0x3FAE9885, 0x3D75},
-	{0x5E003FFF, 0x7C00},
-	{0x3E7F7EFE, 0x33FC},
-	{0x5E001FFF, 0x7C00},
-	{0x4FF0007F, 0x7C00},
-	{0x5E000FFF, 0x7C00},
-	{0xCB7FFBF0, 0xFC00},
-	{0x5E0007FF, 0x7C00},
-	{0xF3FE000F, 0xFC00},
-	{0x5E0003FF, 0x7C00},
-	{0xD2787FFE, 0xFC00},
-	{0x5E0001FF, 0x7C00},
-	{0x56FFFFF8, 0x7C00},
-	{0x5E0000FF, 0x7C00},
-	{0x00550EA9, 0x0000},
-	{0x5E00007F, 0x7C00},
-	{0xBEFDFEFF, 0xB7F0},
-	{0x5E00003F, 0x7C00},
-	{0x80807FF7, 0x8000},
-	{0x5E00001F, 0x7C00},
-	{0x3ED5A2A9, 0x36AD},
-	{0x5E00000F, 0x7C00},
-	{0x387FC7FE, 0x03FF},
-	{0x5E000007, 0x7C00},
-	{0x33803FFB, 0x0001},
-	{0x5E000003, 0x7C00},
-	{0xC08C0663, 0xC460},
-	{0x5E800000, 0x7C00},
-	{0xBFACCFC3, 0xBD66},
-	{0x5E800001, 0x7C00},
-	{0xDEFF803E, 0xFC00},
-	{0x5E800002, 0x7C00},
-	{0xC0FFF020, 0xC800},
-	{0x5E800004, 0x7C00},
-	{0xFF180000, 0xFC00},
-	{0x5E800008, 0x7C00},
-	{0x41F007FE, 0x4F80},
-	{0x5E800010, 0x7C00},
-	{0xC07FEFFB, 0xC3FF},
-	{0x5E800020, 0x7C00},
-	{0x41936B06, 0x4C9B},
-	{0x5E800040, 0x7C00},
-	{0x277FFD7F, 0x0000},
-	{0x5E800080, 0x7C00},
-	{0x4E87FFFC, 0x7C00},
-	{0x5E800100, 0x7C00},
-	{0xC6198018, 0xF0CC},
-	{0x5E800200, 0x7C00},
-	{0x008008FF, 0x0000},
-	{0x5E800400, 0x7C00},
-	{0x3F32B371, 0x3996},
-	{0x5E800800, 0x7C00},
-	{0xC7820FFE, 0xFC00},
-	{0x5E801000, 0x7C00},
-	{0x7E81CADF, 0x7C00},
-	{0x5E802000, 0x7C00},
-	{0x41BC81CE, 0x4DE4},
-	{0x5E804000, 0x7C00},
-	{0x7EFFF0FF, 0x7C00},
-	{0x5E808000, 0x7C00},
-	{0x600077FF, 0x7C00},
-	{0x5E810000, 0x7C00},
-	{0xB3FFFF7D, 0x8002},
-	{0x5E820000, 0x7C00},
-	{0xC087FFFF, 0xC440},
-	{0x5E840000, 0x7C00},
-	{0x46008FFF, 0x7004},
-	{0x5E880000, 0x7C00},
-	{0xFB0007F7, 0xFC00},
-	{0x5E900000, 0x7C00},
-	{0xC0707FFF, 0xC384},
-	{0x5EA00000, 0x7C00},
-	{0x39E8C985, 0x0F46},
-	{0x5EC00000, 0x7C00},
-	{0x7F095B3A, 0x7C00},
-	{0x5EE00000, 0x7C00},
-	{0xBF0077FF, 0xB804},
-	{0x5EF00000, 0x7C00},
-	{0x490003DF, 0x7C00},
-	{0x5EF80000, 0x7C00},
-	{0xBF03FFF7, 0xB820},
-	{0x5EFC0000, 0x7C00},
-	{0x3F7EFFF6, 0x3BF8},
-	{0x5EFE0000, 0x7C00},
-	{0x5F176712, 0x7C00},
-	{0x5EFF0000, 0x7C00},
-	{0x4F25441D, 0x7C00},
-	{0x5EFF8000, 0x7C00},
-	{0xBE7FC007, 0xB3FE},
-	{0x5EFFC000, 0x7C00},
-	{0xCE07FBFF, 0xFC00},
-	{0x5EFFE000, 0x7C00},
-	{0xDFFFC010, 0xFC00},
-	{0x5EFFF000, 0x7C00},
-	{0x7EFFFF77, 0x7C00},
-	{0x5EFFF800, 0x7C00},
-	{0xBB600020, 0x9B00},
-	{0x5EFFFC00, 0x7C00},
-	{0x3E7C03FF, 0x33E0},
-	{0x5EFFFE00, 0x7C00},
-	{0x3E414B6C, 0x320A},
-	{0x5EFFFF00, 0x7C00},
-	{0xC7820000, 0xFC00},
-	{0x5EFFFF80, 0x7C00},
-	{0xC1E01000, 0xCF00},
-	{0x5EFFFFC0, 0x7C00},
-	{0x3D9A1A78, 0x2CD1},
-	{0x5EFFFFE0, 0x7C00},
-	{0xC0807BFF, 0xC404},
-	{0x5EFFFFF0, 0x7C00},
-	{0xDFFFFBDF, 0xFC00},
-	{0x5EFFFFF8, 0x7C00},
-	{0xCF020001, 0xFC00},
-	{0x5EFFFFFC, 0x7C00},
-	{0xFF957D8A, 0xFEAB},
-	{0x5EFFFFFE, 0x7C00},
-	{0x3EC5F3F8, 0x3630},
-	{0x5EFFFFFF, 0x7C00},
-	{0xFF7EFDFF, 0xFC00},
-	{0x5EFFFFFD, 0x7C00},
-	{0xECFE2000, 0xFC00},
-	{0x5EFFFFFB, 0x7C00},
-	{0x41EA7514, 0x4F54},
-	{0x5EFFFFF7, 0x7C00},
-	{0x3E0003BF, 0x3000},
-	{0x5EFFFFEF, 0x7C00},
-	{0xCB8ED5EB, 0xFC00},
-	{0x5EFFFFDF, 0x7C00},
-	{0xC3804003, 0xDC02},
-	{0x5EFFFFBF, 0x7C00},
-	{0xDEFFFBFC, 0xFC00},
-	{0x5EFFFF7F, 0x7C00},
-	{0xB2000000, 0x8000},
-	{0x5EFFFEFF, 0x7C00},
-	{0x3EFFBBFF, 0x37FE},
-	{0x5EFFFDFF, 0x7C00},
-	{0xC1FF803E, 0xCFFC},
-	{0x5EFFFBFF, 0x7C00},
-	{0x7FFFC00F, 0x7FFE},
-	{0x5EFFF7FF, 0x7C00},
-	{0xD17FFDFD, 0xFC00},
-	{0x5EFFEFFF, 0x7C00},
-	{0x408C58E5, 0x4463},
-	{0x5EFFDFFF, 0x7C00},
-	{0x32037FFE, 0x0000},
-	{0x5EFFBFFF, 0x7C00},
-	{0x8007ECBC, 0x8000},
-	{0x5EFF7FFF, 0x7C00},
-	{0xBF80207F, 0xBC01},
-	{0x5EFEFFFF, 0x7C00},
-	{0x0160F48B, 0x0000},
-	{0x5EFDFFFF, 0x7C00},
-	{0x9BD1E72E, 0x8000},
-	{0x5EFBFFFF, 0x7C00},
-	{0xD72844E7, 0xFC00},
-	{0x5EF7FFFF, 0x7C00},
-	{0x4180FFFE, 0x4C08},
-	{0x5EEFFFFF, 0x7C00},
-	{0x5EFFEFFA, 0x7C00},
-	{0x5EDFFFFF, 0x7C00},
-	{0x35FE07FF, 0x0020},
-	{0x5EBFFFFF, 0x7C00},
-	{0x40FF5FFE, 0x47FB},
-	{0x5E9FFFFF, 0x7C00},
-	{0x5F76C854, 0x7C00},
-	{0x5E8FFFFF, 0x7C00},
-	{0xB47F3FFE, 0x8004},
-	{0x5E87FFFF, 0x7C00},
-	{0xCA4000FF, 0xFC00},
-	{0x5E83FFFF, 0x7C00},
-	{0x3EFBB9C9, 0x37DE},
-	{0x5E81FFFF, 0x7C00},
-	{0x6E80107E, 0x7C00},
-	{0x5E80FFFF, 0x7C00},
-	{0xC1400007, 0xCA00},
-	{0x5E807FFF, 0x7C00},
-	{0x3F000FFF, 0x3800},
-	{0x5E803FFF, 0x7C00},
-	{0xC173FFFF, 0xCBA0},
-	{0x5E801FFF, 0x7C00},
-	{0x407FFDFF, 0x4400},
-	{0x5E800FFF, 0x7C00},
-	{0x1D80021E, 0x0000},
-	{0x5E8007FF, 0x7C00},
-	{0xB3BDFFFF, 0x8001},
-	{0x5E8003FF, 0x7C00},
-	{0xDF2804FA, 0xFC00},
-	{0x5E8001FF, 0x7C00},
-	{0x0103FFBF, 0x0000},
-	{0x5E8000FF, 0x7C00},
-	{0x40C6FE67, 0x4638},
-	{0x5E80007F, 0x7C00},
-	{0x3E808FFF, 0x3404},
-	{0x5E80003F, 0x7C00},
-	{0x3FFEEFFF, 0x3FF7},
-	{0x5E80001F, 0x7C00},
-	{0x00FFF807, 0x0000},
-	{0x5E80000F, 0x7C00},
-	{0xBE800014, 0xB400},
-	{0x5E800007, 0x7C00},
-	{0xC0FFEFFC, 0xC7FF},
-	{0x5E800003, 0x7C00},
-	{0x3D97FFFE, 0x2CC0},
-	{0x5F000000, 0x7C00},
-	{0xDF60000E, 0xFC00},
-	{0x5F000001, 0x7C00},
-	{0xC1FFFC00, 0xD000},
-	{0x5F000002, 0x7C00},
-	{0xC15A2CB8, 0xCAD1},
-	{0x5F000004, 0x7C00},
-	{0xDF810001, 0xFC00},
-	{0x5F000008, 0x7C00},
-	{0x40807E00, 0x4404},
-	{0x5F000010, 0x7C00},
-	{0x417FFFF4, 0x4C00},
-	{0x5F000020, 0x7C00},
-	{0x3E7C000E, 0x33E0},
-	{0x5F000040, 0x7C00},
-	{0x40701FFF, 0x4381},
-	{0x5F000080, 0x7C00},
-	{0xDE8FF7FF, 0xFC00},
-	{0x5F000100, 0x7C00},
-	{0x3E7FFF7C, 0x3400},
-	{0x5F000200, 0x7C00},
-	{0xC0FFF03F, 0xC800},
-	{0x5F000400, 0x7C00},
-	{0xC18F796B, 0xCC7C},
-	{0x5F000800, 0x7C00},
-	{0xBEF7FF7F, 0xB7C0},
-	{0x5F001000, 0x7C00},
-	{0x43BC4D06, 0x5DE2},
-	{0x5F002000, 0x7C00},
-	{0x01820040, 0x0000},
-	{0x5F004000, 0x7C00},
-	{0xBE88003E, 0xB440},
-	{0x5F008000, 0x7C00},
-	{0x41F0D8D1, 0x4F87},
-	{0x5F010000, 0x7C00},
-	{0x42E001FF, 0x5700},
-	{0x5F020000, 0x7C00},
-	{0x3DD49ACA, 0x2EA5},
-	{0x5F040000, 0x7C00},
-	{0xBE70000F, 0xB380},
-	{0x5F080000, 0x7C00},
-	{0x99FBFFF8, 0x8000},
-	{0x5F100000, 0x7C00},
-	{0xE09FFFFD, 0xFC00},
-	{0x5F200000, 0x7C00},
-	{0xCE80FFFE, 0xFC00},
-	{0x5F400000, 0x7C00},
-	{0xC07FC1FF, 0xC3FE},
-	{0x5F600000, 0x7C00},
-	{0xB97FFFD7, 0x8C00},
-	{0x5F700000, 0x7C00},
-	{0xBE80003B, 0xB400},
-	{0x5F780000, 0x7C00},
-	{0x8C8000FC, 0x8000},
-	{0x5F7C0000, 0x7C00},
-	{0x26458E84, 0x0000},
-	{0x5F7E0000, 0x7C00},
-	{0xCF0800FF, 0xFC00},
-	{0x5F7F0000, 0x7C00},
-	{0xBF7FFCFF, 0xBC00},
-	{0x5F7F8000, 0x7C00},
-	{0xC0E2A438, 0xC715},
-	{0x5F7FC000, 0x7C00},
-	{0x23000820, 0x0000},
-	{0x5F7FE000, 0x7C00},
-	{0x3DF1D841, 0x2F8F},
-	{0x5F7FF000, 0x7C00},
-	{0xBE7EFFF0, 0xB3F8},
-	{0x5F7FF800, 0x7C00},
-	{0x9104001F, 0x8000},
-	{0x5F7FFC00, 0x7C00},
-	{0x537FFFE7, 0x7C00},
-	{0x5F7FFE00, 0x7C00},
-	{0xE66FBB2D, 0xFC00},
-	{0x5F7FFF00, 0x7C00},
-	{0x5577F7FF, 0x7C00},
-	{0x5F7FFF80, 0x7C00},
-	{0xC377FFFF, 0xDBC0},
-	{0x5F7FFFC0, 0x7C00},
-	{0x33801000, 0x0001},
-	{0x5F7FFFE0, 0x7C00},
-	{0xB2386680, 0x8000},
-	{0x5F7FFFF0, 0x7C00},
-	{0xCE77FFEF, 0xFC00},
-	{0x5F7FFFF8, 0x7C00},
-	{0x344812FD, 0x0003},
-	{0x5F7FFFFC, 0x7C00},
-	{0x409F187A, 0x44F9},
-	{0x5F7FFFFE, 0x7C00},
-	{0x3D078000, 0x283C},
-	{0x5F7FFFFF, 0x7C00},
-	{0x3F7800FF, 0x3BC0},
-	{0x5F7FFFFD, 0x7C00},
-	{0xBF007FEE, 0xB804},
-	{0x5F7FFFFB, 0x7C00},
-	{0xC0000077, 0xC000},
-	{0x5F7FFFF7, 0x7C00},
-	{0xCEFF3FFE, 0xFC00},
-	{0x5F7FFFEF, 0x7C00},
-	{0xFFC44AE7, 0xFE22},
-	{0x5F7FFFDF, 0x7C00},
-	{0x5E200002, 0x7C00},
-	{0x5F7FFFBF, 0x7C00},
-	{0xC090295F, 0xC481},
-	{0x5F7FFF7F, 0x7C00},
-	{0xDF00003F, 0xFC00},
-	{0x5F7FFEFF, 0x7C00},
-	{0x5E701000, 0x7C00},
-	{0x5F7FFDFF, 0x7C00},
-	{0xCFFFFFDE, 0xFC00},
-	{0x5F7FFBFF, 0x7C00},
-	{0x47802000, 0x7C00},
-	{0x5F7FF7FF, 0x7C00},
-	{0xDF2C1DAF, 0xFC00},
-	{0x5F7FEFFF, 0x7C00},
-	{0xC480200E, 0xE401},
-	{0x5F7FDFFF, 0x7C00},
-	{0xDE00003F, 0xFC00},
-	{0x5F7FBFFF, 0x7C00},
-	{0x4180043E, 0x4C00},
-	{0x5F7F7FFF, 0x7C00},
-	{0xC1588B41, 0xCAC4},
-	{0x5F7EFFFF, 0x7C00},
-	{0x5F03FFFB, 0x7C00},
-	{0x5F7DFFFF, 0x7C00},
-	{0x418000FC, 0x4C00},
-	{0x5F7BFFFF, 0x7C00},
-	{0xC1B815E1, 0xCDC1},
-	{0x5F77FFFF, 0x7C00},
-	{0xB901FDFF, 0x8810},
-	{0x5F6FFFFF, 0x7C00},
-	{0xBFFFE7FF, 0xBFFF},
-	{0x5F5FFFFF, 0x7C00},
-	{0xCF0081FE, 0xFC00},
-	{0x5F3FFFFF, 0x7C00},
-	{0xDF04FFFF, 0xFC00},
-	{0x5F1FFFFF, 0x7C00},
-	{0x5A80010E, 0x7C00},
-	{0x5F0FFFFF, 0x7C00},
-	{0xB61F98D6, 0x8028},
-	{0x5F07FFFF, 0x7C00},
-	{0x4F000107, 0x7C00},
-	{0x5F03FFFF, 0x7C00},
-	{0x45D9AA9B, 0x6ECD},
-	{0x5F01FFFF, 0x7C00},
-	{0x40C01FFF, 0x4601},
-	{0x5F00FFFF, 0x7C00},
-	{0x4700800F, 0x7804},
-	{0x5F007FFF, 0x7C00},
-	{0xCEE001FF, 0xFC00},
-	{0x5F003FFF, 0x7C00},
-	{0x3E001F7E, 0x3001},
-	{0x5F001FFF, 0x7C00},
-	{0xFF000100, 0xFC00},
-	{0x5F000FFF, 0x7C00},
-	{0xDF01FFFE, 0xFC00},
-	{0x5F0007FF, 0x7C00},
-	{0xBFFFF01F, 0xC000},
-	{0x5F0003FF, 0x7C00},
-	{0x4DC31868, 0x7C00},
-	{0x5F0001FF, 0x7C00},
-	{0x487FDF00, 0x7C00},
-	{0x5F0000FF, 0x7C00},
-	{0x813FFE00, 0x8000},
-	{0x5F00007F, 0x7C00},
-	{0x3E7F7FFF, 0x33FC},
-	{0x5F00003F, 0x7C00},
-	{0xCB804800, 0xFC00},
-	{0x5F00001F, 0x7C00},
-	{0x00BBFA18, 0x0000},
-	{0x5F00000F, 0x7C00},
-	{0x0280004E, 0x0000},
-	{0x5F000007, 0x7C00},
-	{0xC001FFF8, 0xC010},
-	{0x5F000003, 0x7C00},
-	{0x33878000, 0x0001},
-	{0x5F800000, 0x7C00},
-	{0x5CD92B10, 0x7C00},
-	{0x5F800001, 0x7C00},
-	{0xC1800041, 0xCC00},
-	{0x5F800002, 0x7C00},
-	{0xB4FFFC80, 0x8008},
-	{0x5F800004, 0x7C00},
-	{0x3E700080, 0x3380},
-	{0x5F800008, 0x7C00},
-	{0x5F23FFFF, 0x7C00},
-	{0x5F800010, 0x7C00},
-	{0xBAFFBFEF, 0x97FE},
-	{0x5F800020, 0x7C00},
-	{0x41FFFFC3, 0x5000},
-	{0x5F800040, 0x7C00},
-	{0x15991B00, 0x0000},
-	{0x5F800080, 0x7C00},
-	{0xC06537D3, 0xC32A},
-	{0x5F800100, 0x7C00},
-	{0x810002FF, 0x8000},
-	{0x5F800200, 0x7C00},
-	{0x00780020, 0x0000},
-	{0x5F800400, 0x7C00},
-	{0x80780003, 0x8000},
-	{0x5F800800, 0x7C00},
-	{0xC56BE9A9, 0xEB5F},
-	{0x5F801000, 0x7C00},
-	{0x2F7220D6, 0x0000},
-	{0x5F802000, 0x7C00},
-	{0xC17DFF00, 0xCBF0},
-	{0x5F804000, 0x7C00},
-	{0x3EDBE896, 0x36DF},
-	{0x5F808000, 0x7C00},
-	{0xABFDFFF0, 0x8000},
-	{0x5F810000, 0x7C00},
-	{0xBF8645BC, 0xBC32},
-	{0x5F820000, 0x7C00},
-	{0x7FE00040, 0x7F00},
-	{0x5F840000, 0x7C00},
-	{0x017BFFF8, 0x0000},
-	{0x5F880000, 0x7C00},
-	{0xCFFB7FFF, 0xFC00},
-	{0x5F900000, 0x7C00},
-	{0xDF820002, 0xFC00},
-	{0x5FA00000, 0x7C00},
-	{0x4E810000, 0x7C00},
-	{0x5FC00000, 0x7C00},
-	{0xBE807FFE, 0xB404},
-	{0x5FE00000, 0x7C00},
-	{0x3F3B42FF, 0x39DA},
-	{0x5FF00000, 0x7C00},
-	{0xB3803F7E, 0x8001},
-	{0x5FF80000, 0x7C00},
-	{0x4F00101E, 0x7C00},
-	{0x5FFC0000, 0x7C00},
-	{0x96F00003, 0x8000},
-	{0x5FFE0000, 0x7C00},
-	{0x4BFC00FE, 0x7C00},
-	{0x5FFF0000, 0x7C00},
-	{0x4DEFFDFE, 0x7C00},
-	{0x5FFF8000, 0x7C00},
-	{0x5E7FFE1F, 0x7C00},
-	{0x5FFFC000, 0x7C00},
-	{0xC1FFF600, 0xD000},
-	{0x5FFFE000, 0x7C00},
-	{0x4F800F80, 0x7C00},
-	{0x5FFFF000, 0x7C00},
-	{0xBA7BFF7F, 0x93E0},
-	{0x5FFFF800, 0x7C00},
-	{0xBF777FFF, 0xBBBC},
-	{0x5FFFFC00, 0x7C00},
-	{0x437FFFBD, 0x5C00},
-	{0x5FFFFE00, 0x7C00},
-	{0x21FFDFFB, 0x0000},
-	{0x5FFFFF00, 0x7C00},
-	{0xC39E671C, 0xDCF3},
-	{0x5FFFFF80, 0x7C00},
-	{0x467FF01F, 0x7400},
-	{0x5FFFFFC0, 0x7C00},
-	{0xDFFFFFF9, 0xFC00},
-	{0x5FFFFFE0, 0x7C00},
-	{0xC19E1FE2, 0xCCF1},
-	{0x5FFFFFF0, 0x7C00},
-	{0x08FFDF7F, 0x0000},
-	{0x5FFFFFF8, 0x7C00},
-	{0xBE8003FD, 0xB400},
-	{0x5FFFFFFC, 0x7C00},
-	{0xB3FFFEFF, 0x8002},
-	{0x5FFFFFFE, 0x7C00},
-	{0xFEFDFE63, 0xFC00},
-	{0x5FFFFFFF, 0x7C00},
-	{0x3DFFEBFF, 0x2FFF},
-	{0x5FFFFFFD, 0x7C00},
-	{0xC78000E0, 0xFC00},
-	{0x5FFFFFFB, 0x7C00},
-	{0x5F7FFF1F, 0x7C00},
-	{0x5FFFFFF7, 0x7C00},
-	{0x30FFAFFF, 0x0000},
-	{0x5FFFFFEF, 0x7C00},
-	{0xBF000201, 0xB800},
-	{0x5FFFFFDF, 0x7C00},
-	{0xC183F7FF, 0xCC20},
-	{0x5FFFFFBF, 0x7C00},
-	{0xC0FFFFFF, 0xC800},
-	{0x5FFFFF7F, 0x7C00},
-	{0xBDA00FFF, 0xAD00},
-	{0x5FFFFEFF, 0x7C00},
-	{0x3E7F807E, 0x33FC},
-	{0x5FFFFDFF, 0x7C00},
-	{0x3E0000FC, 0x3000},
-	{0x5FFFFBFF, 0x7C00},
-	{0x5F789621, 0x7C00},
-	{0x5FFFF7FF, 0x7C00},
-	{0x3F768E5E, 0x3BB4},
-	{0x5FFFEFFF, 0x7C00},
-	{0x5F771A96, 0x7C00},
-	{0x5FFFDFFF, 0x7C00},
-	{0x45ABEC62, 0x6D5F},
-	{0x5FFFBFFF, 0x7C00},
-	{0x573658AE, 0x7C00},
-	{0x5FFF7FFF, 0x7C00},
-	{0xF6FE001F, 0xFC00},
-	{0x5FFEFFFF, 0x7C00},
-	{0x44B194EA, 0x658D},
-	{0x5FFDFFFF, 0x7C00},
-	{0xB1AB603D, 0x8000},
-	{0x5FFBFFFF, 0x7C00},
-	{0xC1FF7FFF, 0xCFFC},
-	{0x5FF7FFFF, 0x7C00},
-	{0xCF800200, 0xFC00},
-	{0x5FEFFFFF, 0x7C00},
-	{0xB68000FF, 0x8040},
-	{0x5FDFFFFF, 0x7C00},
-	{0x4F87FEFF, 0x7C00},
-	{0x5FBFFFFF, 0x7C00},
-	{0xA49AD0A3, 0x8000},
-	{0x5F9FFFFF, 0x7C00},
-	{0x80DFF800, 0x8000},
-	{0x5F8FFFFF, 0x7C00},
-	{0xB9000420, 0x8800},
-	{0x5F87FFFF, 0x7C00},
-	{0xC10403FF, 0xC820},
-	{0x5F83FFFF, 0x7C00},
-	{0xABD08FD0, 0x8000},
-	{0x5F81FFFF, 0x7C00},
-	{0x327FBEFE, 0x0000},
-	{0x5F80FFFF, 0x7C00},
-	{0xC0B4DAB4, 0xC5A7},
-	{0x5F807FFF, 0x7C00},
-	{0xBDA2491D, 0xAD12},
-	{0x5F803FFF, 0x7C00},
-	{0x451E0791, 0x68F0},
-	{0x5F801FFF, 0x7C00},
-	{0xDF200FFF, 0xFC00},
-	{0x5F800FFF, 0x7C00},
-	{0xC0A0CEE8, 0xC506},
-	{0x5F8007FF, 0x7C00},
-	{0xFF07DFFE, 0xFC00},
-	{0x5F8003FF, 0x7C00},
-	{0xB849BA47, 0x8327},
-	{0x5F8001FF, 0x7C00},
-	{0x54FFFBDF, 0x7C00},
-	{0x5F8000FF, 0x7C00},
-	{0xC0FFF803, 0xC800},
-	{0x5F80007F, 0x7C00},
-	{0x5F20000E, 0x7C00},
-	{0x5F80003F, 0x7C00},
-	{0xCEFFFFF5, 0xFC00},
-	{0x5F80001F, 0x7C00},
-	{0x72FFBFDF, 0x7C00},
-	{0x5F80000F, 0x7C00},
-	{0xDC77FEFF, 0xFC00},
-	{0x5F800007, 0x7C00},
-	{0xCEE01FFF, 0xFC00},
-	{0x5F800003, 0x7C00},
-	{0xFF800004, 0xFE00},
-	{0x7E800000, 0x7C00},
-	{0xCB9ED826, 0xFC00},
-	{0x7E800001, 0x7C00},
-	{0xBF95EA42, 0xBCAF},
-	{0x7E800002, 0x7C00},
-	{0xA6107FFE, 0x8000},
-	{0x7E800004, 0x7C00},
-	{0xFF1FFFFE, 0xFC00},
-	{0x7E800008, 0x7C00},
-	{0x40800010, 0x4400},
-	{0x7E800010, 0x7C00},
-	{0xE0F00007, 0xFC00},
-	{0x7E800020, 0x7C00},
-	{0xCF7FFF03, 0xFC00},
-	{0x7E800040, 0x7C00},
-	{0x007FFC06, 0x0000},
-	{0x7E800080, 0x7C00},
-	{0x507FFF77, 0x7C00},
-	{0x7E800100, 0x7C00},
-	{0x3E81FF80, 0x3410},
-	{0x7E800200, 0x7C00},
-	{0xBF003FDE, 0xB802},
-	{0x7E800400, 0x7C00},
-	{0x40FE00FE, 0x47F0},
-	{0x7E800800, 0x7C00},
-	{0xBE0077FE, 0xB004},
-	{0x7E801000, 0x7C00},
-	{0x47DD5E27, 0x7C00},
-	{0x7E802000, 0x7C00},
-	{0x7EC025CF, 0x7C00},
-	{0x7E804000, 0x7C00},
-	{0x36C61BEC, 0x0063},
-	{0x7E808000, 0x7C00},
-	{0xA7B669B8, 0x8000},
-	{0x7E810000, 0x7C00},
-	{0x460FE000, 0x707F},
-	{0x7E820000, 0x7C00},
-	{0x5E003FDF, 0x7C00},
-	{0x7E840000, 0x7C00},
-	{0xC2FFFFFE, 0xD800},
-	{0x7E880000, 0x7C00},
-	{0x3DFFFC07, 0x3000},
-	{0x7E900000, 0x7C00},
-	{0xCBBFFFF7, 0xFC00},
-	{0x7EA00000, 0x7C00},
-	{0xC0700400, 0xC380},
-	{0x7EC00000, 0x7C00},
-	{0x807FE1FF, 0x8000},
-	{0x7EE00000, 0x7C00},
-	{0x807E0004, 0x8000},
-	{0x7EF00000, 0x7C00},
-	{0x8F800180, 0x8000},
-	{0x7EF80000, 0x7C00},
-	{0xC1004006, 0xC802},
-	{0x7EFC0000, 0x7C00},
-	{0xBE00087F, 0xB000},
-	{0x7EFE0000, 0x7C00},
-	{0x45FFEEFF, 0x6FFF},
-	{0x7EFF0000, 0x7C00},
-	{0x017FFFFF, 0x0000},
-	{0x7EFF8000, 0x7C00},
-	{0x7F178522, 0x7C00},
-	{0x7EFFC000, 0x7C00},
-	{0xFEFFFCFE, 0xFC00},
-	{0x7EFFE000, 0x7C00},
-	{0xCB80002E, 0xFC00},
-	{0x7EFFF000, 0x7C00},
-	{0xA5FFC01E, 0x8000},
-	{0x7EFFF800, 0x7C00},
-	{0x3F7EEFFE, 0x3BF7},
-	{0x7EFFFC00, 0x7C00},
-	{0xFF803F00, 0xFE01},
-	{0x7EFFFE00, 0x7C00},
-	{0xDF6967E9, 0xFC00},
-	{0x7EFFFF00, 0x7C00},
-	{0x5EABC98A, 0x7C00},
-	{0x7EFFFF80, 0x7C00},
-	{0x80009FFF, 0x8000},
-	{0x7EFFFFC0, 0x7C00},
-	{0xBE601FFF, 0xB301},
-	{0x7EFFFFE0, 0x7C00},
-	{0x3FBAE7BA, 0x3DD7},
-	{0x7EFFFFF0, 0x7C00},
-	{0x8007DFFF, 0x8000},
-	{0x7EFFFFF8, 0x7C00},
-	{0x72040010, 0x7C00},
-	{0x7EFFFFFC, 0x7C00},
-	{0x7FA3491A, 0x7F1A},
-	{0x7EFFFFFE, 0x7C00},
-	{0x7F0007BF, 0x7C00},
-	{0x7EFFFFFF, 0x7C00},
-	{0x33F80FFE, 0x0002},
-	{0x7EFFFFFD, 0x7C00},
-	{0xBE0E0000, 0xB070},
-	{0x7EFFFFFB, 0x7C00},
-	{0x41A44009, 0x4D22},
-	{0x7EFFFFF7, 0x7C00},
-	{0xBBFFDFFD, 0x9FFF},
-	{0x7EFFFFEF, 0x7C00},
-	{0xC0003FBF, 0xC002},
-	{0x7EFFFFDF, 0x7C00},
-	{0x4FB5C853, 0x7C00},
-	{0x7EFFFFBF, 0x7C00},
-	{0x4D7FDEFF, 0x7C00},
-	{0x7EFFFF7F, 0x7C00},
-	{0xEB2F34E6, 0xFC00},
-	{0x7EFFFEFF, 0x7C00},
-	{0xC1AD5EE8, 0xCD6B},
-	{0x7EFFFDFF, 0x7C00},
-	{0x5FFF5FFF, 0x7C00},
-	{0x7EFFFBFF, 0x7C00},
-	{0x5E63D2B1, 0x7C00},
-	{0x7EFFF7FF, 0x7C00},
-	{0x00880008, 0x0000},
-	{0x7EFFEFFF, 0x7C00},
-	{0x64FFFF01, 0x7C00},
-	{0x7EFFDFFF, 0x7C00},
-	{0x3C80087F, 0x2400},
-	{0x7EFFBFFF, 0x7C00},
-	{0xBEF87FFF, 0xB7C4},
-	{0x7EFF7FFF, 0x7C00},
-	{0x0100000F, 0x0000},
-	{0x7EFEFFFF, 0x7C00},
-	{0x5900083F, 0x7C00},
-	{0x7EFDFFFF, 0x7C00},
-	{0xDF1E08E2, 0xFC00},
-	{0x7EFBFFFF, 0x7C00},
-	{0x8CE99F68, 0x8000},
-	{0x7EF7FFFF, 0x7C00},
-	{0x32800041, 0x0000},
-	{0x7EEFFFFF, 0x7C00},
-	{0x407EFFDE, 0x43F8},
-	{0x7EDFFFFF, 0x7C00},
-	{0x3F170436, 0x38B8},
-	{0x7EBFFFFF, 0x7C00},
-	{0x43800022, 0x5C00},
-	{0x7E9FFFFF, 0x7C00},
-	{0x267F8000, 0x0000},
-	{0x7E8FFFFF, 0x7C00},
-	{0x33F3FFFF, 0x0002},
-	{0x7E87FFFF, 0x7C00},
-	{0x3E106271, 0x3083},
-	{0x7E83FFFF, 0x7C00},
-	{0xAE8017FF, 0x8000},
-	{0x7E81FFFF, 0x7C00},
-	{0xAD1003FF, 0x8000},
-	{0x7E80FFFF, 0x7C00},
-	{0xC07FFFD0, 0xC400},
-	{0x7E807FFF, 0x7C00},
-	{0x4119754D, 0x48CC},
-	{0x7E803FFF, 0x7C00},
-	{0x4EF00003, 0x7C00},
-	{0x7E801FFF, 0x7C00},
-	{0x3FBAB123, 0x3DD6},
-	{0x7E800FFF, 0x7C00},
-	{0x3F80FFBF, 0x3C08},
-	{0x7E8007FF, 0x7C00},
-	{0xC17C0001, 0xCBE0},
-	{0x7E8003FF, 0x7C00},
-	{0x4E23C8C3, 0x7C00},
-	{0x7E8001FF, 0x7C00},
-	{0xBE8FFDFE, 0xB480},
-	{0x7E8000FF, 0x7C00},
-	{0x3F376109, 0x39BB},
-	{0x7E80007F, 0x7C00},
-	{0x4187FFDF, 0x4C40},
-	{0x7E80003F, 0x7C00},
-	{0xBF7FF87F, 0xBC00},
-	{0x7E80001F, 0x7C00},
-	{0x3E6B53F7, 0x335B},
-	{0x7E80000F, 0x7C00},
-	{0xDE80001F, 0xFC00},
-	{0x7E800007, 0x7C00},
-	{0xBE7FF040, 0xB400},
-	{0x7E800003, 0x7C00},
-	{0x317FFFFF, 0x0000},
-	{0x7F000000, 0x7C00},
-	{0xC0FF8002, 0xC7FC},
-	{0x7F000001, 0x7C00},
-	{0xC3080020, 0xD840},
-	{0x7F000002, 0x7C00},
-	{0x3F7FDFEF, 0x3BFF},
-	{0x7F000004, 0x7C00},
-	{0x4000007B, 0x4000},
-	{0x7F000008, 0x7C00},
-	{0xBE81FFFA, 0xB410},
-	{0x7F000010, 0x7C00},
-	{0xB96FBFFF, 0x8B7E},
-	{0x7F000020, 0x7C00},
-	{0xDC512E79, 0xFC00},
-	{0x7F000040, 0x7C00},
-	{0x3E5FFFFA, 0x3300},
-	{0x7F000080, 0x7C00},
-	{0x5FFC001F, 0x7C00},
-	{0x7F000100, 0x7C00},
-	{0x807FF5FE, 0x8000},
-	{0x7F000200, 0x7C00},
-	{0xFF0010FF, 0xFC00},
-	{0x7F000400, 0x7C00},
-	{0xC181FFFF, 0xCC10},
-	{0x7F000800, 0x7C00},
-	{0xFF1FF7FE, 0xFC00},
-	{0x7F001000, 0x7C00},
-	{0xB8E00006, 0x8700},
-	{0x7F002000, 0x7C00},
-	{0x655FFFF8, 0x7C00},
-	{0x7F004000, 0x7C00},
-	{0xBE11AF57, 0xB08D},
-	{0x7F008000, 0x7C00},
-	{0x3F780400, 0x3BC0},
-	{0x7F010000, 0x7C00},
-	{0xFF248EBA, 0xFC00},
-	{0x7F020000, 0x7C00},
-	{0x3E77EFFF, 0x33BF},
-	{0x7F040000, 0x7C00},
-	{0x3DFFFBFC, 0x3000},
-	{0x7F080000, 0x7C00},
-	{0xC5533BEA, 0xEA9A},
-	{0x7F100000, 0x7C00},
-	{0x3C9CA5E6, 0x24E5},
-	{0x7F200000, 0x7C00},
-	{0xBC94F05D, 0xA4A8},
-	{0x7F400000, 0x7C00},
-	{0xB89844E0, 0x84C2},
-	{0x7F600000, 0x7C00},
-	{0x34F7FFE0, 0x0008},
-	{0x7F700000, 0x7C00},
-	{0x010000FF, 0x0000},
-	{0x7F780000, 0x7C00},
-	{0x13FFAFFF, 0x0000},
-	{0x7F7C0000, 0x7C00},
-	{0xCBC89496, 0xFC00},
-	{0x7F7E0000, 0x7C00},
-	{0xBEF7FFC0, 0xB7C0},
-	{0x7F7F0000, 0x7C00},
-	{0x107DF7FE, 0x0000},
-	{0x7F7F8000, 0x7C00},
-	{0xC101FFEF, 0xC810},
-	{0x7F7FC000, 0x7C00},
-	{0xBB107FFF, 0x9884},
-	{0x7F7FE000, 0x7C00},
-	{0xDE000002, 0xFC00},
-	{0x7F7FF000, 0x7C00},
-	{0xCA001FFF, 0xFC00},
-	{0x7F7FF800, 0x7C00},
-	{0xFF6F7FFE, 0xFC00},
-	{0x7F7FFC00, 0x7C00},
-	{0x5E080000, 0x7C00},
-	{0x7F7FFE00, 0x7C00},
-	{0xC08FFF00, 0xC480},
-	{0x7F7FFF00, 0x7C00},
-	{0xBFFFFC07, 0xC000},
-	{0x7F7FFF80, 0x7C00},
-	{0xBE00007C, 0xB000},
-	{0x7F7FFFC0, 0x7C00},
-	{0x4C80107E, 0x7C00},
-	{0x7F7FFFE0, 0x7C00},
-	{0xBF0FFEFE, 0xB880},
-	{0x7F7FFFF0, 0x7C00},
-	{0xC07F4000, 0xC3FA},
-	{0x7F7FFFF8, 0x7C00},
-	{0xFEABC4CC, 0xFC00},
-	{0x7F7FFFFC, 0x7C00},
-	{0x4F8001FF, 0x7C00},
-	{0x7F7FFFFE, 0x7C00},
-	{0x7E803FFE, 0x7C00},
-	{0x7F7FFFFF, 0x7C00},
-	{0xCC7FFFFF, 0xFC00},
-	{0x7F7FFFFD, 0x7C00},
-	{0xC1F800FF, 0xCFC0},
-	{0x7F7FFFFB, 0x7C00},
-	{0xCBFFE000, 0xFC00},
-	{0x7F7FFFF7, 0x7C00},
-	{0xBE8E336A, 0xB472},
-	{0x7F7FFFEF, 0x7C00},
-	{0xE8003E00, 0xFC00},
-	{0x7F7FFFDF, 0x7C00},
-	{0x3FEA1BE4, 0x3F51},
-	{0x7F7FFFBF, 0x7C00},
-	{0x5F800000, 0x7C00},
-	{0x7F7FFF7F, 0x7C00},
-	{0x418201FE, 0x4C10},
-	{0x7F7FFEFF, 0x7C00},
-	{0xBF802800, 0xBC01},
-	{0x7F7FFDFF, 0x7C00},
-	{0x5F7FB7FF, 0x7C00},
-	{0x7F7FFBFF, 0x7C00},
-	{0xA77F8040, 0x8000},
-	{0x7F7FF7FF, 0x7C00},
-	{0x2CF60000, 0x0000},
-	{0x7F7FEFFF, 0x7C00},
-	{0xC1F7FFFD, 0xCFC0},
-	{0x7F7FDFFF, 0x7C00},
-	{0x03882742, 0x0000},
-	{0x7F7FBFFF, 0x7C00},
-	{0xC1EBE151, 0xCF5F},
-	{0x7F7F7FFF, 0x7C00},
-	{0xBE7FEFDF, 0xB3FF},
-	{0x7F7EFFFF, 0x7C00},
-	{0x107CFFFE, 0x0000},
-	{0x7F7DFFFF, 0x7C00},
-	{0xCF2E97A4, 0xFC00},
-	{0x7F7BFFFF, 0x7C00},
-	{0xC2804400, 0xD402},
-	{0x7F77FFFF, 0x7C00},
-	{0xC7810007, 0xFC00},
-	{0x7F6FFFFF, 0x7C00},
-	{0x3CFFFFFB, 0x2800},
-	{0x7F5FFFFF, 0x7C00},
-	{0x4EFF4918, 0x7C00},
-	{0x7F3FFFFF, 0x7C00},
-	{0x0801FFFF, 0x0000},
-	{0x7F1FFFFF, 0x7C00},
-	{0xCFFDDFFE, 0xFC00},
-	{0x7F0FFFFF, 0x7C00},
-	{0x3D010002, 0x2808},
-	{0x7F07FFFF, 0x7C00},
-	{0xC25FFDFE, 0xD300},
-	{0x7F03FFFF, 0x7C00},
-	{0xC07FD7FF, 0xC3FF},
-	{0x7F01FFFF, 0x7C00},
-	{0x33FFFFF2, 0x0002},
-	{0x7F00FFFF, 0x7C00},
-	{0x3E2695BB, 0x3135},
-	{0x7F007FFF, 0x7C00},
-	{0xBD7FFFFF, 0xAC00},
-	{0x7F003FFF, 0x7C00},
-	{0xDEF7FFFD, 0xFC00},
-	{0x7F001FFF, 0x7C00},
-	{0xFFB9F329, 0xFFCF},
-	{0x7F000FFF, 0x7C00},
-	{0xBB041FFE, 0x9821},
-	{0x7F0007FF, 0x7C00},
-	{0x4077CB4A, 0x43BE},
-	{0x7F0003FF, 0x7C00},
-	{0x4F840008, 0x7C00},
-	{0x7F0001FF, 0x7C00},
-	{0x80FBFFFE, 0x8000},
-	{0x7F0000FF, 0x7C00},
-	{0x3FC1E894, 0x3E0F},
-	{0x7F00007F, 0x7C00},
-	{0xB7EB96D1, 0x81D7},
-	{0x7F00003F, 0x7C00},
-	{0x5DFF61D4, 0x7C00},
-	{0x7F00001F, 0x7C00},
-	{0xBC77FFFF, 0xA3C0},
-	{0x7F00000F, 0x7C00},
-	{0x4EAFA180, 0x7C00},
-	{0x7F000007, 0x7C00},
-	{0xFB6B3B8F, 0xFC00},
-	{0x7F000003, 0x7C00},
-	{0xBD83FFEF, 0xAC20},
-	{0x7F800000, 0x7C00},
-	{0x2A500000, 0x0000},
-	{0x7F800001, 0x7E00},
-	{0x437FFFFA, 0x5C00},
-	{0x7F800002, 0x7E00},
-	{0xCFFFFDE0, 0xFC00},
-	{0x7F800004, 0x7E00},
-	{0xC097DF69, 0xC4BF},
-	{0x7F800008, 0x7E00},
-	{0x80B0FE5D, 0x8000},
-	{0x7F800010, 0x7E00},
-	{0x3E7FFFF7, 0x3400},
-	{0x7F800020, 0x7E00},
-	{0xC7C3A5F7, 0xFC00},
-	{0x7F800040, 0x7E00},
-	{0xBF81F800, 0xBC10},
-	{0x7F800080, 0x7E00},
-	{0xCB7FC01F, 0xFC00},
-	{0x7F800100, 0x7E00},
-	{0x400407FF, 0x4020},
-	{0x7F800200, 0x7E00},
-	{0x4861C4C0, 0x7C00},
-	{0x7F800400, 0x7E00},
-	{0x40000202, 0x4000},
-	{0x7F800800, 0x7E00},
-	{0x81DD2F9F, 0x8000},
-	{0x7F801000, 0x7E00},
-	{0x400DE162, 0x406F},
-	{0x7F802000, 0x7E01},
-	{0xFFFEFFFE, 0xFFF7},
-	{0x7F804000, 0x7E02},
-	{0x5EB0543E, 0x7C00},
-	{0x7F808000, 0x7E04},
-	{0xAE9FFFF7, 0x8000},
-	{0x7F810000, 0x7E08},
-	{0x466617E1, 0x7331},
-	{0x7F820000, 0x7E10},
-	{0x2C837FFE, 0x0000},
-	{0x7F840000, 0x7E20},
-	{0x7FF8000F, 0x7FC0},
-	{0x7F880000, 0x7E40},
-	{0x4E607FFF, 0x7C00},
-	{0x7F900000, 0x7E80},
-	{0xCC29E4A1, 0xFC00},
-	{0x7FA00000, 0x7F00},
-	{0x9FFE0004, 0x8000},
-	{0x7FC00000, 0x7E00},
-	{0x93078C93, 0x8000},
-	{0x7FE00000, 0x7F00},
-	{0x3E163BF9, 0x30B2},
-	{0x7FF00000, 0x7F80},
-	{0x5E0023FF, 0x7C00},
-	{0x7FF80000, 0x7FC0},
-	{0x3E77BFFE, 0x33BE},
-	{0x7FFC0000, 0x7FE0},
-	{0xBF85FFFF, 0xBC30},
-	{0x7FFE0000, 0x7FF0},
-	{0x4F000FFF, 0x7C00},
-	{0x7FFF0000, 0x7FF8},
-	{0xC00002FE, 0xC000},
-	{0x7FFF8000, 0x7FFC},
-	{0xFE9F7FFF, 0xFC00},
-	{0x7FFFC000, 0x7FFE},
-	{0xC1FF0007, 0xCFF8},
-	{0x7FFFE000, 0x7FFF},
-	{0x3DB41C25, 0x2DA1},
-	{0x7FFFF000, 0x7FFF},
-	{0x4F6FFFFF, 0x7C00},
-	{0x7FFFF800, 0x7FFF},
-	{0xC07F7FC0, 0xC3FC},
-	{0x7FFFFC00, 0x7FFF},
-	{0x417FBE00, 0x4BFE},
-	{0x7FFFFE00, 0x7FFF},
-	{0x4BFF800F, 0x7C00},
-	{0x7FFFFF00, 0x7FFF},
-	{0x314E1C73, 0x0000},
-	{0x7FFFFF80, 0x7FFF},
-	{0x087DEFFE, 0x0000},
-	{0x7FFFFFC0, 0x7FFF},
-	{0xAA7FFFDF, 0x8000},
-	{0x7FFFFFE0, 0x7FFF},
-	{0xFECB2DCC, 0xFC00},
-	{0x7FFFFFF0, 0x7FFF},
-	{0x44001FFE, 0x6001},
-	{0x7FFFFFF8, 0x7FFF},
-	{0xDE00FFC0, 0xFC00},
-	{0x7FFFFFFC, 0x7FFF},
-	{0x51F6FFFE, 0x7C00},
-	{0x7FFFFFFE, 0x7FFF},
-	{0xD97FF80F, 0xFC00},
-	{0x7FFFFFFF, 0x7FFF},
-	{0xBF080000, 0xB840},
-	{0x7FFFFFFD, 0x7FFF},
-	{0xFE801F7E, 0xFC00},
-	{0x7FFFFFFB, 0x7FFF},
-	{0x2206FFFF, 0x0000},
-	{0x7FFFFFF7, 0x7FFF},
-	{0x41807FF8, 0x4C04},
-	{0x7FFFFFEF, 0x7FFF},
-	{0xDF000027, 0xFC00},
-	{0x7FFFFFDF, 0x7FFF},
-	{0x4000005E, 0x4000},
-	{0x7FFFFFBF, 0x7FFF},
-	{0x7E99488A, 0x7C00},
-	{0x7FFFFF7F, 0x7FFF},
-	{0xDF80000F, 0xFC00},
-	{0x7FFFFEFF, 0x7FFF},
-	{0x01080008, 0x0000},
-	{0x7FFFFDFF, 0x7FFF},
-	{0x40003EFF, 0x4002},
-	{0x7FFFFBFF, 0x7FFF},
-	{0xBE81FFFE, 0xB410},
-	{0x7FFFF7FF, 0x7FFF},
-	{0x827F8000, 0x8000},
-	{0x7FFFEFFF, 0x7FFF},
-	{0x21FF6FFE, 0x0000},
-	{0x7FFFDFFF, 0x7FFE},
-	{0x4000087E, 0x4000},
-	{0x7FFFBFFF, 0x7FFD},
-	{0x48FFFF00, 0x7C00},
-	{0x7FFF7FFF, 0x7FFB},
-	{0xDE7FFE0E, 0xFC00},
-	{0x7FFEFFFF, 0x7FF7},
-	{0xC4FDFFFC, 0xE7F0},
-	{0x7FFDFFFF, 0x7FEF},
-	{0x7E85FFFF, 0x7C00},
-	{0x7FFBFFFF, 0x7FDF},
-	{0xCA890000, 0xFC00},
-	{0x7FF7FFFF, 0x7FBF},
-	{0x3E6FFBA4, 0x3380},
-	{0x7FEFFFFF, 0x7F7F},
-	{0x46800017, 0x7400},
-	{0x7FDFFFFF, 0x7EFF},
-	{0xDF663904, 0xFC00},
-	{0x7FBFFFFF, 0x7FFF},
-	{0xBD800027, 0xAC00},
-	{0x7F9FFFFF, 0x7EFF},
-	{0x40008003, 0x4004},
-	{0x7F8FFFFF, 0x7E7F},
-	{0x5FE63A32, 0x7C00},
-	{0x7F87FFFF, 0x7E3F},
-	{0x3681BFFF, 0x0041},
-	{0x7F83FFFF, 0x7E1F},
-	{0x5E800046, 0x7C00},
-	{0x7F81FFFF, 0x7E0F},
-	{0xC14FEC7C, 0xCA7F},
-	{0x7F80FFFF, 0x7E07},
-	{0x00800009, 0x0000},
-	{0x7F807FFF, 0x7E03},
-	{0x4F9820AB, 0x7C00},
-	{0x7F803FFF, 0x7E01},
-	{0xC404BB3A, 0xE026},
-	{0x7F801FFF, 0x7E00},
-	{0x037DBFFF, 0x0000},
-	{0x7F800FFF, 0x7E00},
-	{0x43001100, 0x5801},
-	{0x7F8007FF, 0x7E00},
-	{0xC00DFFFF, 0xC070},
-	{0x7F8003FF, 0x7E00},
-	{0x390FFFFF, 0x0880},
-	{0x7F8001FF, 0x7E00},
-	{0xC1EFFBFE, 0xCF80},
-	{0x7F8000FF, 0x7E00},
-	{0xBE88000F, 0xB440},
-	{0x7F80007F, 0x7E00},
-	{0x4F7FC01F, 0x7C00},
-	{0x7F80003F, 0x7E00},
-	{0x3F0027FF, 0x3801},
-	{0x7F80001F, 0x7E00},
-	{0xC17FBFFD, 0xCBFE},
-	{0x7F80000F, 0x7E00},
-	{0x807EE924, 0x8000},
-	{0x7F800007, 0x7E00},
-	{0x5E80101F, 0x7C00},
-	{0x7F800003, 0x7E00},
-	{0xC1800806, 0xCC00},
-	{0x80000000, 0x8000},
-	{0x3F1D995D, 0x38ED},
-	{0x80000001, 0x8000},
-	{0x50E6BDF4, 0x7C00},
-	{0x80000002, 0x8000},
-	{0xB4FFDFBF, 0x8008},
-	{0x80000004, 0x8000},
-	{0x4781FFF7, 0x7C00},
-	{0x80000008, 0x8000},
-	{0x4101FFBF, 0x4810},
-	{0x80000010, 0x8000},
-	{0xDCFE3FFF, 0xFC00},
-	{0x80000020, 0x8000},
-	{0xBD800100, 0xAC00},
-	{0x80000040, 0x8000},
-	{0x41238D93, 0x491C},
-	{0x80000080, 0x8000},
-	{0xCF7FFC1F, 0xFC00},
-	{0x80000100, 0x8000},
-	{0x381FEFFE, 0x0280},
-	{0x80000200, 0x8000},
-	{0x3F800401, 0x3C00},
-	{0x80000400, 0x8000},
-	{0xE378FFFE, 0xFC00},
-	{0x80000800, 0x8000},
-	{0x4BE51328, 0x7C00},
-	{0x80001000, 0x8000},
-	{0x24E1094A, 0x0000},
-	{0x80002000, 0x8000},
-	{0xC0FFF81F, 0xC800},
-	{0x80004000, 0x8000},
-	{0xFFFF0FFF, 0xFFF8},
-	{0x80008000, 0x8000},
-	{0xBA48D15D, 0x9247},
-	{0x80010000, 0x8000},
-	{0xCEEFFFFC, 0xFC00},
-	{0x80020000, 0x8000},
-	{0xAAFEFEFF, 0x8000},
-	{0x80040000, 0x8000},
-	{0x4FFF0010, 0x7C00},
-	{0x80080000, 0x8000},
-	{0xBF1DFFFE, 0xB8F0},
-	{0x80100000, 0x8000},
-	{0x726FFFEF, 0x7C00},
-	{0x80200000, 0x8000},
-	{0xDEFEFFBF, 0xFC00},
-	{0x80400000, 0x8000},
-	{0x061A75C7, 0x0000},
-	{0x80600000, 0x8000},
-	{0x128100FE, 0x0000},
-	{0x80700000, 0x8000},
-	{0xB3EFFFF6, 0x8002},
-	{0x80780000, 0x8000},
-	{0x4F624753, 0x7C00},
-	{0x807C0000, 0x8000},
-	{0xA0FBFEFF, 0x8000},
-	{0x807E0000, 0x8000},
-	{0xC3189C82, 0xD8C5},
-	{0x807F0000, 0x8000},
-	{0xD8B434C0, 0xFC00},
-	{0x807F8000, 0x8000},
-	{0xBD7FFFFF, 0xAC00},
-	{0x807FC000, 0x8000},
-	{0xC1AF9B91, 0xCD7D},
-	{0x807FE000, 0x8000},
-	{0x8781DFFF, 0x8000},
-	{0x807FF000, 0x8000},
-	{0x5F01003E, 0x7C00},
-	{0x807FF800, 0x8000},
-	{0x4F17C007, 0x7C00},
-	{0x807FFC00, 0x8000},
-	{0x3CFFEBFE, 0x27FF},
-	{0x807FFE00, 0x8000},
-	{0x809174FA, 0x8000},
-	{0x807FFF00, 0x8000},
-	{0xFF9FFFF7, 0xFEFF},
-	{0x807FFF80, 0x8000},
-	{0xBF7FFF80, 0xBC00},
-	{0x807FFFC0, 0x8000},
-	{0x40BEF078, 0x45F8},
-	{0x807FFFE0, 0x8000},
-	{0xC184FFFE, 0xCC28},
-	{0x807FFFF0, 0x8000},
-	{0x3FFFFF3E, 0x4000},
-	{0x807FFFF8, 0x8000},
-	{0x41F00001, 0x4F80},
-	{0x807FFFFC, 0x8000},
-	{0x60DFFFFD, 0x7C00},
-	{0x807FFFFE, 0x8000},
-	{0x207FBFBF, 0x0000},
-	{0x807FFFFF, 0x8000},
-	{0xAC809FFF, 0x8000},
-	{0x807FFFFD, 0x8000},
-	{0x41DFFF7F, 0x4F00},
-	{0x807FFFFB, 0x8000},
-	{0xB3801FFF, 0x8001},
-	{0x807FFFF7, 0x8000},
-	{0x8121AFFC, 0x8000},
-	{0x807FFFEF, 0x8000},
-	{0xC080203F, 0xC401},
-	{0x807FFFDF, 0x8000},
-	{0xC1C00800, 0xCE00},
-	{0x807FFFBF, 0x8000},
-	{0x3FD69846, 0x3EB5},
-	{0x807FFF7F, 0x8000},
-	{0x7F7FFC1E, 0x7C00},
-	{0x807FFEFF, 0x8000},
-	{0x00FF87FF, 0x0000},
-	{0x807FFDFF, 0x8000},
-	{0xFEDFFFF7, 0xFC00},
-	{0x807FFBFF, 0x8000},
-	{0xB057FA90, 0x8000},
-	{0x807FF7FF, 0x8000},
-	{0x2C7FF7F0, 0x0000},
-	{0x807FEFFF, 0x8000},
-	{0xCE7FFFE0, 0xFC00},
-	{0x807FDFFF, 0x8000},
-	{0x407FE7FF, 0x43FF},
-	{0x807FBFFF, 0x8000},
-	{0x4C19378E, 0x7C00},
-	{0x807F7FFF, 0x8000},
-	{0xCEF40000, 0xFC00},
-	{0x807EFFFF, 0x8000},
-	{0x5080021E, 0x7C00},
-	{0x807DFFFF, 0x8000},
-	{0x41800010, 0x4C00},
-	{0x807BFFFF, 0x8000},
-	{0xA17FFE02, 0x8000},
-	{0x8077FFFF, 0x8000},
-	{0xFF005FFF, 0xFC00},
-	{0x806FFFFF, 0x8000},
-	{0x42E0003E, 0x5700},
-	{0x805FFFFF, 0x8000},
-	{0x3DCB0A83, 0x2E58},
-	{0x803FFFFF, 0x8000},
-	{0xDE9F7FFF, 0xFC00},
-	{0x801FFFFF, 0x8000},
-	{0xAB003F80, 0x8000},
-	{0x800FFFFF, 0x8000},
-	{0xFF7FFE0F, 0xFC00},
-	{0x8007FFFF, 0x8000},
-	{0x499FFBFF, 0x7C00},
-	{0x8003FFFF, 0x8000},
-	{0xBFC9A685, 0xBE4D},
-	{0x8001FFFF, 0x8000},
-	{0x0047A65E, 0x0000},
-	{0x8000FFFF, 0x8000},
-	{0xFF000001, 0xFC00},
-	{0x80007FFF, 0x8000},
-	{0x5F7FFFFA, 0x7C00},
-	{0x80003FFF, 0x8000},
-	{0xF07FFDEF, 0xFC00},
-	{0x80001FFF, 0x8000},
-	{0x5E0021FF, 0x7C00},
-	{0x80000FFF, 0x8000},
-	{0x509FFFEF, 0x7C00},
-	{0x800007FF, 0x8000},
-	{0x3C0003EF, 0x2000},
-	{0x800003FF, 0x8000},
-	{0x4100FFF7, 0x4808},
-	{0x800001FF, 0x8000},
-	{0xBF20000F, 0xB900},
-	{0x800000FF, 0x8000},
-	{0x3B8003FC, 0x1C00},
-	{0x8000007F, 0x8000},
-	{0x19C47432, 0x0000},
-	{0x8000003F, 0x8000},
-	{0x400023FE, 0x4001},
-	{0x8000001F, 0x8000},
-	{0xBE255FC4, 0xB12B},
-	{0x8000000F, 0x8000},
-	{0xC0F0003E, 0xC780},
-	{0x80000007, 0x8000},
-	{0x4EA22C22, 0x7C00},
-	{0x80000003, 0x8000},
-	{0x3EDFFFC0, 0x3700},
-	{0x80800000, 0x8000},
-	{0xCC600002, 0xFC00},
-	{0x80800001, 0x8000},
-	{0xBED18A43, 0xB68C},
-	{0x80800002, 0x8000},
-	{0xC0E1B618, 0xC70E},
-	{0x80800004, 0x8000},
-	{0xCBFFF01F, 0xFC00},
-	{0x80800008, 0x8000},
-	{0xC00001FE, 0xC000},
-	{0x80800010, 0x8000},
-	{0x41FFE01F, 0x4FFF},
-	{0x80800020, 0x8000},
-	{0xBFFBBFFF, 0xBFDE},
-	{0x80800040, 0x8000},
-	{0xBE000402, 0xB000},
-	{0x80800080, 0x8000},
-	{0xCE43FFFF, 0xFC00},
-	{0x80800100, 0x8000},
-	{0xCB90003F, 0xFC00},
-	{0x80800200, 0x8000},
-	{0xBE236F54, 0xB11B},
-	{0x80800400, 0x8000},
-	{0x9A7FFC07, 0x8000},
-	{0x80800800, 0x8000},
-	{0xC1000017, 0xC800},
-	{0x80801000, 0x8000},
-	{0xB200FFFE, 0x8000},
-	{0x80802000, 0x8000},
-	{0xC2800880, 0xD400},
-	{0x80804000, 0x8000},
-	{0x4EB74642, 0x7C00},
-	{0x80808000, 0x8000},
-	{0x3D80FFFE, 0x2C08},
-	{0x80810000, 0x8000},
-	{0x4B78FFFF, 0x7C00},
-	{0x80820000, 0x8000},
-	{0x2D0FFF00, 0x0000},
-	{0x80840000, 0x8000},
-	{0xBD810E56, 0xAC08},
-	{0x80880000, 0x8000},
-	{0xBE700008, 0xB380},
-	{0x80900000, 0x8000},
-	{0xC2A9A563, 0xD54D},
-	{0x80A00000, 0x8000},
-	{0x4F724A2B, 0x7C00},
-	{0x80C00000, 0x8000},
-	{0x5F7EFFDE, 0x7C00},
-	{0x80E00000, 0x8000},
-	{0x3E881FFF, 0x3441},
-	{0x80F00000, 0x8000},
-	{0xDF8200FF, 0xFC00},
-	{0x80F80000, 0x8000},
-	{0xFEFFFFF8, 0xFC00},
-	{0x80FC0000, 0x8000},
-	{0x3E7FF3FF, 0x3400},
-	{0x80FE0000, 0x8000},
-	{0xC1100000, 0xC880},
-	{0x80FF0000, 0x8000},
-	{0x3F5FFFFE, 0x3B00},
-	{0x80FF8000, 0x8000},
-	{0x417FE200, 0x4BFF},
-	{0x80FFC000, 0x8000},
-	{0xB38080FF, 0x8001},
-	{0x80FFE000, 0x8000},
-	{0x96000046, 0x8000},
-	{0x80FFF000, 0x8000},
-	{0xC67FE03F, 0xF3FF},
-	{0x80FFF800, 0x8000},
-	{0xDEFFFFFF, 0xFC00},
-	{0x80FFFC00, 0x8000},
-	{0xC121FFFF, 0xC910},
-	{0x80FFFE00, 0x8000},
-	{0x0000BFFF, 0x0000},
-	{0x80FFFF00, 0x8000},
-	{0x4B840000, 0x7C00},
-	{0x80FFFF80, 0x8000},
-	{0xAC7E0FFF, 0x8000},
-	{0x80FFFFC0, 0x8000},
-	{0xDE000016, 0xFC00},
-	{0x80FFFFE0, 0x8000},
-	{0xD932F500, 0xFC00},
-	{0x80FFFFF0, 0x8000},
-	{0x40800004, 0x4400},
-	{0x80FFFFF8, 0x8000},
-	{0x64001002, 0x7C00},
-	{0x80FFFFFC, 0x8000},
-	{0x807F81FE, 0x8000},
-	{0x80FFFFFE, 0x8000},
-	{0xC1D3B083, 0xCE9E},
-	{0x80FFFFFF, 0x8000},
-	{0xC3FFDE00, 0xDFFF},
-	{0x80FFFFFD, 0x8000},
-	{0xAAFFDEFF, 0x8000},
-	{0x80FFFFFB, 0x8000},
-	{0xC2FFBFFF, 0xD7FE},
-	{0x80FFFFF7, 0x8000},
-	{0xBE4A61AF, 0xB253},
-	{0x80FFFFEF, 0x8000},
-	{0x3EA3FFFE, 0x3520},
-	{0x80FFFFDF, 0x8000},
-	{0x7EFFFFFF, 0x7C00},
-	{0x80FFFFBF, 0x8000},
-	{0x3FBFFFBF, 0x3E00},
-	{0x80FFFF7F, 0x8000},
-	{0xBE2423B5, 0xB121},
-	{0x80FFFEFF, 0x8000},
-	{0x5180007F, 0x7C00},
-	{0x80FFFDFF, 0x8000},
-	{0xC3FFF77F, 0xE000},
-	{0x80FFFBFF, 0x8000},
-	{0x3EF3B1CB, 0x379E},
-	{0x80FFF7FF, 0x8000},
-	{0x3EFF9FFE, 0x37FD},
-	{0x80FFEFFF, 0x8000},
-	{0x42975562, 0x54BB},
-	{0x80FFDFFF, 0x8000},
-	{0xBF0784E9, 0xB83C},
-	{0x80FFBFFF, 0x8000},
-	{0xC0FFFDFD, 0xC800},
-	{0x80FF7FFF, 0x8000},
-	{0x4121FFFF, 0x4910},
-	{0x80FEFFFF, 0x8000},
-	{0x00FC0007, 0x0000},
-	{0x80FDFFFF, 0x8000},
-	{0xB07FF802, 0x8000},
-	{0x80FBFFFF, 0x8000},
-	{0x000100FE, 0x0000},
-	{0x80F7FFFF, 0x8000},
-	{0x4F007F7E, 0x7C00},
-	{0x80EFFFFF, 0x8000},
-	{0xE191BF44, 0xFC00},
-	{0x80DFFFFF, 0x8000},
-	{0xDE0008FF, 0xFC00},
-	{0x80BFFFFF, 0x8000},
-	{0x59E8203A, 0x7C00},
-	{0x809FFFFF, 0x8000},
-	{0xCF7FFFFF, 0xFC00},
-	{0x808FFFFF, 0x8000},
-	{0xFF2C97AF, 0xFC00},
-	{0x8087FFFF, 0x8000},
-	{0xDE80010F, 0xFC00},
-	{0x8083FFFF, 0x8000},
-	{0xC6FFFE00, 0xF800},
-	{0x8081FFFF, 0x8000},
-	{0xBEFC00FF, 0xB7E0},
-	{0x8080FFFF, 0x8000},
-	{0xBEFFE020, 0xB7FF},
-	{0x80807FFF, 0x8000},
-	{0x7F800000, 0x7C00},
-	{0x80803FFF, 0x8000},
-	{0xC000003F, 0xC000},
-	{0x80801FFF, 0x8000},
-	{0x43FEFF97, 0x5FF8},
-	{0x80800FFF, 0x8000},
-	{0xCFFFFBE0, 0xFC00},
-	{0x808007FF, 0x8000},
-	{0x81048000, 0x8000},
-	{0x808003FF, 0x8000},
-	{0x3F7FEF80, 0x3BFF},
-	{0x808001FF, 0x8000},
-	{0xBB809FFE, 0x9C05},
-	{0x808000FF, 0x8000},
-	{0x516B51E6, 0x7C00},
-	{0x8080007F, 0x8000},
-	{0x4F7FB7FF, 0x7C00},
-	{0x8080003F, 0x8000},
-	{0x41800403, 0x4C00},
-	{0x8080001F, 0x8000},
-	{0x4F080100, 0x7C00},
-	{0x8080000F, 0x8000},
-	{0xBEFFFFF0, 0xB800},
-	{0x80800007, 0x8000},
-	{0x4280087F, 0x5400},
-	{0x80800003, 0x8000},
-	{0xDE80013E, 0xFC00},
-	{0x81000000, 0x8000},
-	{0x4768A300, 0x7B45},
-	{0x81000001, 0x8000},
-	{0x4E603FFE, 0x7C00},
-	{0x81000002, 0x8000},
-	{0x4B38A87C, 0x7C00},
-	{0x81000004, 0x8000},
-	{0xC5787FFF, 0xEBC4},
-	{0x81000008, 0x8000},
-	{0x010007F7, 0x0000},
-	{0x81000010, 0x8000},
-	{0xFF80021F, 0xFE00},
-	{0x81000020, 0x8000},
-	{0x7E80000A, 0x7C00},
-	{0x81000040, 0x8000},
-	{0xC07FBBFE, 0xC3FE},
-	{0x81000080, 0x8000},
-	{0x42FEFFFF, 0x57F8},
-	{0x81000100, 0x8000},
-	{0x2210007F, 0x0000},
-	{0x81000200, 0x8000},
-	{0x428007F8, 0x5400},
-	{0x81000400, 0x8000},
-	{0x813BDEF9, 0x8000},
-	{0x81000800, 0x8000},
-	{0xCC903FFE, 0xFC00},
-	{0x81001000, 0x8000},
-	{0x42425AFD, 0x5213},
-	{0x81002000, 0x8000},
-	{0xF2B7FFFE, 0xFC00},
-	{0x81004000, 0x8000},
-	{0x5F780004, 0x7C00},
-	{0x81008000, 0x8000},
-	{0x3DF807FF, 0x2FC0},
-	{0x81010000, 0x8000},
-	{0x007BFF7E, 0x0000},
-	{0x81020000, 0x8000},
-	{0x407BFE00, 0x43E0},
-	{0x81040000, 0x8000},
-	{0xBFCFFFFF, 0xBE80},
-	{0x81080000, 0x8000},
-	{0x947FFFF7, 0x8000},
-	{0x81100000, 0x8000},
-	{0x1CFE7FFF, 0x0000},
-	{0x81200000, 0x8000},
-	{0xDE843FFF, 0xFC00},
-	{0x81400000, 0x8000},
-	{0xCEFFFF5F, 0xFC00},
-	{0x81600000, 0x8000},
-	{0xC0D86B42, 0xC6C3},
-	{0x81700000, 0x8000},
-	{0x017E0040, 0x0000},
-	{0x81780000, 0x8000},
-	{0xDFFFF7DE, 0xFC00},
-	{0x817C0000, 0x8000},
-	{0xCE7FFE00, 0xFC00},
-	{0x817E0000, 0x8000},
-	{0x48E9DF97, 0x7C00},
-	{0x817F0000, 0x8000},
-	{0x4FCB454C, 0x7C00},
-	{0x817F8000, 0x8000},
-	{0x4E8003FD, 0x7C00},
-	{0x817FC000, 0x8000},
-	{0x12FFFF1F, 0x0000},
-	{0x817FE000, 0x8000},
-	{0x96808800, 0x8000},
-	{0x817FF000, 0x8000},
-	{0xBD8101FF, 0xAC08},
-	{0x817FF800, 0x8000},
-	{0xDF7C00FE, 0xFC00},
-	{0x817FFC00, 0x8000},
-	{0xC0CBF008, 0xC660},
-	{0x817FFE00, 0x8000},
-	{0x887EFBFF, 0x8000},
-	{0x817FFF00, 0x8000},
-	{0xB000021F, 0x8000},
-	{0x817FFF80, 0x8000},
-	{0xBF89FFFF, 0xBC50},
-	{0x817FFFC0, 0x8000},
-	{0xC00FFF7E, 0xC080},
-	{0x817FFFE0, 0x8000},
-	{0x3AF8003F, 0x17C0},
-	{0x817FFFF0, 0x8000},
-	{0x413F712E, 0x49FC},
-	{0x817FFFF8, 0x8000},
-	{0x7FD989AE, 0x7ECC},
-	{0x817FFFFC, 0x8000},
-	{0xA0FEFFF7, 0x8000},
-	{0x817FFFFE, 0x8000},
-	{0xB8DF21E4, 0x86F9},
-	{0x817FFFFF, 0x8000},
-	{0x49FF7FFE, 0x7C00},
-	{0x817FFFFD, 0x8000},
-	{0x5F7FFF7F, 0x7C00},
-	{0x817FFFFB, 0x8000},
-	{0x3F8FFFDF, 0x3C80},
-	{0x817FFFF7, 0x8000},
-	{0x4E81FFE0, 0x7C00},
-	{0x817FFFEF, 0x8000},
-	{0xBE801007, 0xB401},
-	{0x817FFFDF, 0x8000},
-	{0x4BBBFFFF, 0x7C00},
-	{0x817FFFBF, 0x8000},
-	{0xBE7F3FFF, 0xB3FA},
-	{0x817FFF7F, 0x8000},
-	{0x40EE0000, 0x4770},
-	{0x817FFEFF, 0x8000},
-	{0x5FFDF7FF, 0x7C00},
-	{0x817FFDFF, 0x8000},
-	{0x3F0007FD, 0x3800},
-	{0x817FFBFF, 0x8000},
-	{0x40253574, 0x412A},
-	{0x817FF7FF, 0x8000},
-	{0xF880007F, 0xFC00},
-	{0x817FEFFF, 0x8000},
-	{0xC6FFFFBD, 0xF800},
-	{0x817FDFFF, 0x8000},
-	{0x49FFFFEA, 0x7C00},
-	{0x817FBFFF, 0x8000},
-	{0x40801FFE, 0x4401},
-	{0x817F7FFF, 0x8000},
-	{0x80FFFF7F, 0x8000},
-	{0x817EFFFF, 0x8000},
-	{0x5E7FE01E, 0x7C00},
-	{0x817DFFFF, 0x8000},
-	{0x37EC0000, 0x01D8},
-	{0x817BFFFF, 0x8000},
-	{0x337FEFFF, 0x0001},
-	{0x8177FFFF, 0x8000},
-	{0x007BFFFD, 0x0000},
-	{0x816FFFFF, 0x8000},
-	{0x26BFFFDF, 0x0000},
-	{0x815FFFFF, 0x8000},
-	{0xBE000004, 0xB000},
-	{0x813FFFFF, 0x8000},
-	{0x3D71025F, 0x2B88},
-	{0x811FFFFF, 0x8000},
-	{0xC17FF1FF, 0xCC00},
-	{0x810FFFFF, 0x8000},
-	{0x7FC95005, 0x7E4A},
-	{0x8107FFFF, 0x8000},
-	{0xFF87FFFE, 0xFE3F},
-	{0x8103FFFF, 0x8000},
-	{0x3E7EDFFE, 0x33F7},
-	{0x8101FFFF, 0x8000},
-	{0x32007FFA, 0x0000},
-	{0x8100FFFF, 0x8000},
-	{0xBF00005E, 0xB800},
-	{0x81007FFF, 0x8000},
-	{0xBF77FFFE, 0xBBC0},
-	{0x81003FFF, 0x8000},
-	{0xC4802FFF, 0xE401},
-	{0x81001FFF, 0x8000},
-	{0xBBFC7FFE, 0x9FE4},
-	{0x81000FFF, 0x8000},
-	{0x5F00C377, 0x7C00},
-	{0x810007FF, 0x8000},
-	{0xC47E001E, 0xE3F0},
-	{0x810003FF, 0x8000},
-	{0x3F000408, 0x3800},
-	{0x810001FF, 0x8000},
-	{0xBE037FFE, 0xB01C},
-	{0x810000FF, 0x8000},
-	{0xCF000043, 0xFC00},
-	{0x8100007F, 0x8000},
-	{0xB2FF1FD5, 0x8000},
-	{0x8100003F, 0x8000},
-	{0xFEC525D3, 0xFC00},
-	{0x8100001F, 0x8000},
-	{0x4C81FF7E, 0x7C00},
-	{0x8100000F, 0x8000},
-	{0xC0FFBFEF, 0xC7FE},
-	{0x81000007, 0x8000},
-	{0xFFFBFFFF, 0xFFDF},
-	{0x81000003, 0x8000},
-	{0xBE6FFFBE, 0xB380},
-	{0xB3800000, 0x8001},
-	{0x4BFF01FE, 0x7C00},
-	{0xB3800001, 0x8001},
-	{0x08690BD0, 0x0000},
-	{0xB3800002, 0x8001},
-	{0x5F00005E, 0x7C00},
-	{0xB3800004, 0x8001},
-	{0x397FEFF6, 0x0BFF},
-	{0xB3800008, 0x8001},
-	{0xC181FFFB, 0xCC10},
-	{0xB3800010, 0x8001},
-	{0x3FFDFFF7, 0x3FF0},
-	{0xB3800020, 0x8001},
-	{0x7E900000, 0x7C00},
-	{0xB3800040, 0x8001},
-	{0xD0020004, 0xFC00},
-	{0xB3800080, 0x8001},
-	{0x7EFFFD00, 0x7C00},
-	{0xB3800100, 0x8001},
-	{0xCF7FF07F, 0xFC00},
-	{0xB3800200, 0x8001},
-	{0xC079CA75, 0xC3CE},
-	{0xB3800400, 0x8001},
-	{0x5F80017F, 0x7C00},
-	{0xB3800800, 0x8001},
-	{0x5E79FFFE, 0x7C00},
-	{0xB3801000, 0x8001},
-	{0xC27FFFEF, 0xD400},
-	{0xB3802000, 0x8001},
-	{0x9E7BFF00, 0x8000},
-	{0xB3804000, 0x8001},
-	{0xCF823FFE, 0xFC00},
-	{0xB3808000, 0x8001},
-	{0xC187FFBF, 0xCC40},
-	{0xB3810000, 0x8001},
-	{0x39A15BEC, 0x0D0B},
-	{0xB3820000, 0x8001},
-	{0x40309BDB, 0x4185},
-	{0xB3840000, 0x8001},
-	{0x3FAFFFFE, 0x3D80},
-	{0xB3880000, 0x8001},
-	{0xD288FFFE, 0xFC00},
-	{0xB3900000, 0x8001},
-	{0xFA10FFFE, 0xFC00},
-	{0xB3A00000, 0x8001},
-	{0xACFFC00F, 0x8000},
-	{0xB3C00000, 0x8002},
-	{0xF86FFFF7, 0xFC00},
-	{0xB3E00000, 0x8002},
-	{0x4E800047, 0x7C00},
-	{0xB3F00000, 0x8002},
-	{0x007E0002, 0x0000},
-	{0xB3F80000, 0x8002},
-	{0x821800A2, 0x8000},
-	{0xB3FC0000, 0x8002},
-	{0xC780000B, 0xFC00},
-	{0xB3FE0000, 0x8002},
-	{0xA5F15493, 0x8000},
-	{0xB3FF0000, 0x8002},
-	{0xCE1FFFF7, 0xFC00},
-	{0xB3FF8000, 0x8002},
-	{0x8000003E, 0x8000},
-	{0xB3FFC000, 0x8002},
-	{0x3EEF8000, 0x377C},
-	{0xB3FFE000, 0x8002},
-	{0xBF400200, 0xBA00},
-	{0xB3FFF000, 0x8002},
-	{0xD8FCE48F, 0xFC00},
-	{0xB3FFF800, 0x8002},
-	{0x7D4FFFFF, 0x7C00},
-	{0xB3FFFC00, 0x8002},
-	{0x81064978, 0x8000},
-	{0xB3FFFE00, 0x8002},
-	{0x847EFFFE, 0x8000},
-	{0xB3FFFF00, 0x8002},
-	{0x40000808, 0x4000},
-	{0xB3FFFF80, 0x8002},
-	{0x40F7FF00, 0x47C0},
-	{0xB3FFFFC0, 0x8002},
-	{0xFFA00010, 0xFF00},
-	{0xB3FFFFE0, 0x8002},
-	{0x4EFFFFDD, 0x7C00},
-	{0xB3FFFFF0, 0x8002},
-	{0xC87FFEEF, 0xFC00},
-	{0xB3FFFFF8, 0x8002},
-	{0xA2DE3C49, 0x8000},
-	{0xB3FFFFFC, 0x8002},
-	{0xC3FFEF7F, 0xDFFF},
-	{0xB3FFFFFE, 0x8002},
-	{0x3FFFF802, 0x4000},
-	{0xB3FFFFFF, 0x8002},
-	{0x35FEFFC0, 0x0020},
-	{0xB3FFFFFD, 0x8002},
-	{0x448003FD, 0x6400},
-	{0xB3FFFFFB, 0x8002},
-	{0xE07DFFFD, 0xFC00},
-	{0xB3FFFFF7, 0x8002},
-	{0x7FC5A57E, 0x7E2D},
-	{0xB3FFFFEF, 0x8002},
-	{0xBDE435AF, 0xAF22},
-	{0xB3FFFFDF, 0x8002},
-	{0xCEA0FFFF, 0xFC00},
-	{0xB3FFFFBF, 0x8002},
-	{0x40BDFFFE, 0x45F0},
-	{0xB3FFFF7F, 0x8002},
-	{0xBF07FDFE, 0xB840},
-	{0xB3FFFEFF, 0x8002},
-	{0x3F67FFFF, 0x3B40},
-	{0xB3FFFDFF, 0x8002},
-	{0x3F78000E, 0x3BC0},
-	{0xB3FFFBFF, 0x8002},
-	{0x148201FF, 0x0000},
-	{0xB3FFF7FF, 0x8002},
-	{0xC9800006, 0xFC00},
-	{0xB3FFEFFF, 0x8002},
-	{0x017C0006, 0x0000},
-	{0xB3FFDFFF, 0x8002},
-	{0xB3FFD7FF, 0x8002},
-	{0xB3FFBFFF, 0x8002},
-	{0xBFFFDEFF, 0xBFFF},
-	{0xB3FF7FFF, 0x8002},
-	{0xBE7FFFF8, 0xB400},
-	{0xB3FEFFFF, 0x8002},
-	{0x4E847FFF, 0x7C00},
-	{0xB3FDFFFF, 0x8002},
-	{0x3E7FE000, 0x33FF},
-	{0xB3FBFFFF, 0x8002},
-	{0xBE9FBFFE, 0xB4FE},
-	{0xB3F7FFFF, 0x8002},
-	{0x5770000F, 0x7C00},
-	{0xB3EFFFFF, 0x8002},
-	{0xAB7FC7FF, 0x8000},
-	{0xB3DFFFFF, 0x8002},
-	{0xBF71CC49, 0xBB8E},
-	{0xB3BFFFFF, 0x8001},
-	{0x3C00FF7F, 0x2008},
-	{0xB39FFFFF, 0x8001},
-	{0xBE77BFFF, 0xB3BE},
-	{0xB38FFFFF, 0x8001},
-	{0xC2F7FF80, 0xD7C0},
-	{0xB387FFFF, 0x8001},
-	{0x6E7003FF, 0x7C00},
-	{0xB383FFFF, 0x8001},
-	{0xCEC3FFFF, 0xFC00},
-	{0xB381FFFF, 0x8001},
-	{0x4700FE00, 0x7808},
-	{0xB380FFFF, 0x8001},
-	{0xD3FFFFFF, 0xFC00},
-	{0xB3807FFF, 0x8001},
-	{0x7F8001FF, 0x7E00},
-	{0xB3803FFF, 0x8001},
-	{0xBFDE149C, 0xBEF1},
-	{0xB3801FFF, 0x8001},
-	{0xFF78001F, 0xFC00},
-	{0xB3800FFF, 0x8001},
-	{0x5E7FFEFA, 0x7C00},
-	{0xB38007FF, 0x8001},
-	{0x4A2D03A7, 0x7C00},
-	{0xB38003FF, 0x8001},
-	{0x3D161989, 0x28B1},
-	{0xB38001FF, 0x8001},
-	{0x4C7FFC1F, 0x7C00},
-	{0xB38000FF, 0x8001},
-	{0x416DC847, 0x4B6E},
-	{0xB380007F, 0x8001},
-	{0xBF00FFFF, 0xB808},
-	{0xB380003F, 0x8001},
-	{0x3E800DFF, 0x3400},
-	{0xB380001F, 0x8001},
-	{0xBB900001, 0x9C80},
-	{0xB380000F, 0x8001},
-	{0x7F7DBFFF, 0x7C00},
-	{0xB3800007, 0x8001},
-	{0x2600017F, 0x0000},
-	{0xB3800003, 0x8001},
-	{0xC17FBFFE, 0xCBFE},
-	{0xBD800000, 0xAC00},
-	{0xCE7FF81E, 0xFC00},
-	{0xBD800001, 0xAC00},
-	{0xC1DAF3AA, 0xCED8},
-	{0xBD800002, 0xAC00},
-	{0x481FEFFF, 0x7C00},
-	{0xBD800004, 0xAC00},
-	{0x0170A1C4, 0x0000},
-	{0xBD800008, 0xAC00},
-	{0x3E8200FF, 0x3410},
-	{0xBD800010, 0xAC00},
-	{0x417EFFC0, 0x4BF8},
-	{0xBD800020, 0xAC00},
-	{0xCF0ACDD4, 0xFC00},
-	{0xBD800040, 0xAC00},
-	{0x3F802001, 0x3C01},
-	{0xBD800080, 0xAC00},
-	{0xBE202000, 0xB101},
-	{0xBD800100, 0xAC00},
-	{0xC00080FF, 0xC004},
-	{0xBD800200, 0xAC00},
-	{0x50000006, 0x7C00},
-	{0xBD800400, 0xAC00},
-	{0xBE820000, 0xB410},
-	{0xBD800800, 0xAC00},
-	{0xDE803DFF, 0xFC00},
-	{0xBD801000, 0xAC00},
-	{0x40EFC000, 0x477E},
-	{0xBD802000, 0xAC01},
-	{0xDE7807FF, 0xFC00},
-	{0xBD804000, 0xAC02},
-	{0x7E80FFDE, 0x7C00},
-	{0xBD808000, 0xAC04},
-	{0xBDF60000, 0xAFB0},
-	{0xBD810000, 0xAC08},
-	{0xBE87BFFF, 0xB43E},
-	{0xBD820000, 0xAC10},
-	{0x403E83E7, 0x41F4},
-	{0xBD840000, 0xAC20},
-	{0x922CD11A, 0x8000},
-	{0xBD880000, 0xAC40},
-	{0xDF0000F7, 0xFC00},
-	{0xBD900000, 0xAC80},
-	{0x41F08315, 0x4F84},
-	{0xBDA00000, 0xAD00},
-	{0xDF108000, 0xFC00},
-	{0xBDC00000, 0xAE00},
-	{0xDE8001FD, 0xFC00},
-	{0xBDE00000, 0xAF00},
-	{0x413FD5D4, 0x49FF},
-	{0xBDF00000, 0xAF80},
-	{0xD1003DFF, 0xFC00},
-	{0xBDF80000, 0xAFC0},
-	{0x4BDDA348, 0x7C00},
-	{0xBDFC0000, 0xAFE0},
-	{0x3F7FF87F, 0x3C00},
-	{0xBDFE0000, 0xAFF0},
-	{0xB200007C, 0x8000},
-	{0xBDFF0000, 0xAFF8},
-	{0x2D7FA000, 0x0000},
-	{0xBDFF8000, 0xAFFC},
-	{0x3F0027FE, 0x3801},
-	{0xBDFFC000, 0xAFFE},
-	{0x3E000004, 0x3000},
-	{0xBDFFE000, 0xAFFF},
-	{0xCBFFF040, 0xFC00},
-	{0xBDFFF000, 0xB000},
-	{0xEA0F56B9, 0xFC00},
-	{0xBDFFF800, 0xB000},
-	{0xFEFCA0BC, 0xFC00},
-	{0xBDFFFC00, 0xB000},
-	{0x3FD7FFFE, 0x3EC0},
-	{0xBDFFFE00, 0xB000},
-	{0xA9D38EA0, 0x8000},
-	{0xBDFFFF00, 0xB000},
-	{0x438007F6, 0x5C00},
-	{0xBDFFFF80, 0xB000},
-	{0xC4FFFFBE, 0xE800},
-	{0xBDFFFFC0, 0xB000},
-	{0xDF00040E, 0xFC00},
-	{0xBDFFFFE0, 0xB000},
-	{0xBAFFF9FF, 0x9800},
-	{0xBDFFFFF0, 0xB000},
-	{0x3EFDF7FF, 0x37F0},
-	{0xBDFFFFF8, 0xB000},
-	{0x807FEFFC, 0x8000},
-	{0xBDFFFFFC, 0xB000},
-	{0xBC8CDF84, 0xA467},
-	{0xBDFFFFFE, 0xB000},
-	{0x007C001E, 0x0000},
-	{0xBDFFFFFF, 0xB000},
-	{0xC1FEFDFF, 0xCFF8},
-	{0xBDFFFFFD, 0xB000},
-	{0x51003C00, 0x7C00},
-	{0xBDFFFFFB, 0xB000},
-	{0x3E800020, 0x3400},
-	{0xBDFFFFF7, 0xB000},
-	{0x3F200FFE, 0x3900},
-	{0xBDFFFFEF, 0xB000},
-	{0xFF9FF000, 0xFEFF},
-	{0xBDFFFFDF, 0xB000},
-	{0x3E8017FE, 0x3401},
-	{0xBDFFFFBF, 0xB000},
-	{0x807BFDFF, 0x8000},
-	{0xBDFFFF7F, 0xB000},
-	{0xCC8200FF, 0xFC00},
-	{0xBDFFFEFF, 0xB000},
-	{0xBFB107A4, 0xBD88},
-	{0xBDFFFDFF, 0xB000},
-	{0x4B800030, 0x7C00},
-	{0xBDFFFBFF, 0xB000},
-	{0x257F7FFD, 0x0000},
-	{0xBDFFF7FF, 0xB000},
-	{0x4FE01FFF, 0x7C00},
-	{0xBDFFEFFF, 0xAFFF},
-	{0xDC801000, 0xFC00},
-	{0xBDFFDFFF, 0xAFFF},
-	{0x3C6DBD10, 0x236E},
-	{0xBDFFBFFF, 0xAFFE},
-	{0x3FE671CB, 0x3F34},
-	{0xBDFF7FFF, 0xAFFC},
-	{0xC0B80000, 0xC5C0},
-	{0xBDFEFFFF, 0xAFF8},
-	{0xCFB73E4C, 0xFC00},
-	{0xBDFDFFFF, 0xAFF0},
-	{0x430007BE, 0x5800},
-	{0xBDFBFFFF, 0xAFE0},
-	{0xDFA0000E, 0xFC00},
-	{0xBDF7FFFF, 0xAFC0},
-	{0x383DFFFE, 0x02F8},
-	{0xBDEFFFFF, 0xAF80},
-	{0x4F70007F, 0x7C00},
-	{0xBDDFFFFF, 0xAF00},
-	{0xBFABDF45, 0xBD5F},
-	{0xBDBFFFFF, 0xAE00},
-	{0x3E80A000, 0x3405},
-	{0xBD9FFFFF, 0xAD00},
-	{0x00001003, 0x0000},
-	{0xBD8FFFFF, 0xAC80},
-	{0x3DCFAFCA, 0x2E7D},
-	{0xBD87FFFF, 0xAC40},
-	{0x447FFF87, 0x6400},
-	{0xBD83FFFF, 0xAC20},
-	{0x43A6F42E, 0x5D38},
-	{0xBD81FFFF, 0xAC10},
-	{0x407C9261, 0x43E5},
-	{0xBD80FFFF, 0xAC08},
-	{0xC37FF07E, 0xDC00},
-	{0xBD807FFF, 0xAC04},
-	{0x40FC3167, 0x47E2},
-	{0xBD803FFF, 0xAC02},
-	{0xC27FFF5E, 0xD400},
-	{0xBD801FFF, 0xAC01},
-	{0x3E8407FF, 0x3420},
-	{0xBD800FFF, 0xAC00},
-	{0x3D7FF01F, 0x2C00},
-	{0xBD8007FF, 0xAC00},
-	{0x41FE1FFF, 0x4FF1},
-	{0xBD8003FF, 0xAC00},
-	{0xBFF9FFFF, 0xBFD0},
-	{0xBD8001FF, 0xAC00},
-	{0x4E65D23D, 0x7C00},
-	{0xBD8000FF, 0xAC00},
-	{0xBFBFF31F, 0xBE00},
-	{0xBD80007F, 0xAC00},
-	{0xC383BFFF, 0xDC1E},
-	{0xBD80003F, 0xAC00},
-	{0xAF0FFFFD, 0x8000},
-	{0xBD80001F, 0xAC00},
-	{0x7FE00003, 0x7F00},
-	{0xBD80000F, 0xAC00},
-	{0x7F80F7FF, 0x7E07},
-	{0xBD800007, 0xAC00},
-	{0x4032C52D, 0x4196},
-	{0xBD800003, 0xAC00},
-	{0xBE000010, 0xB000},
-	{0xBE000000, 0xB000},
-	{0xE1200004, 0xFC00},
-	{0xBE000001, 0xB000},
-	{0xDC7FFFC2, 0xFC00},
-	{0xBE000002, 0xB000},
-	{0xDF00F000, 0xFC00},
-	{0xBE000004, 0xB000},
-	{0x4B8DFFFF, 0x7C00},
-	{0xBE000008, 0xB000},
-	{0xCD0001FA, 0xFC00},
-	{0xBE000010, 0xB000},
-	{0x4175FFFE, 0x4BB0},
-	{0xBE000020, 0xB000},
-	{0xCBFF8080, 0xFC00},
-	{0xBE000040, 0xB000},
-	{0xB39DFFFE, 0x8001},
-	{0xBE000080, 0xB000},
-	{0x3A00027F, 0x1000},
-	{0xBE000100, 0xB000},
-	{0x017F03FF, 0x0000},
-	{0xBE000200, 0xB000},
-	{0x4001FBFF, 0x4010},
-	{0xBE000400, 0xB000},
-	{0xC0BFFE00, 0xC600},
-	{0xBE000800, 0xB000},
-	{0xCF007FFF, 0xFC00},
-	{0xBE001000, 0xB000},
-	{0x3F1F8521, 0x38FC},
-	{0xBE002000, 0xB001},
-	{0x4E003FF6, 0x7C00},
-	{0xBE004000, 0xB002},
-	{0x5E0807FE, 0x7C00},
-	{0xBE008000, 0xB004},
-	{0x010087FF, 0x0000},
-	{0xBE010000, 0xB008},
-	{0xB380000B, 0x8001},
-	{0xBE020000, 0xB010},
-	{0x5E80DFFF, 0x7C00},
-	{0xBE040000, 0xB020},
-	{0x3D7FFFF6, 0x2C00},
-	{0xBE080000, 0xB040},
-	{0x7F0FFFFD, 0x7C00},
-	{0xBE100000, 0xB080},
-	{0x7E80201F, 0x7C00},
-	{0xBE200000, 0xB100},
-	{0x41880003, 0x4C40},
-	{0xBE400000, 0xB200},
-	{0x31F800FF, 0x0000},
-	{0xBE600000, 0xB300},
-	{0xBF400003, 0xBA00},
-	{0xBE700000, 0xB380},
-	{0x42C8B6D8, 0x5646},
-	{0xBE780000, 0xB3C0},
-	{0xDEB49BB4, 0xFC00},
-	{0xBE7C0000, 0xB3E0},
-	{0x4F7FFFF1, 0x7C00},
-	{0xBE7E0000, 0xB3F0},
-	{0xBFF7FFFF, 0xBFC0},
-	{0xBE7F0000, 0xB3F8},
-	{0xDCF807FF, 0xFC00},
-	{0xBE7F8000, 0xB3FC},
-	{0xD280FFFB, 0xFC00},
-	{0xBE7FC000, 0xB3FE},
-	{0x3F5DFFFE, 0x3AF0},
-	{0xBE7FE000, 0xB3FF},
-	{0xBC84003F, 0xA420},
-	{0xBE7FF000, 0xB400},
-	{0xC3FF7FFB, 0xDFFC},
-	{0xBE7FF800, 0xB400},
-	{0xDE5B4D34, 0xFC00},
-	{0xBE7FFC00, 0xB400},
-	{0xC17D7FFF, 0xCBEC},
-	{0xBE7FFE00, 0xB400},
-	{0xC280007F, 0xD400},
-	{0xBE7FFF00, 0xB400},
-	{0xBCFED9EF, 0xA7F7},
-	{0xBE7FFF80, 0xB400},
-	{0x42FFFFC3, 0x5800},
-	{0xBE7FFFC0, 0xB400},
-	{0xC000009F, 0xC000},
-	{0xBE7FFFE0, 0xB400},
-	{0x5E80FFDF, 0x7C00},
-	{0xBE7FFFF0, 0xB400},
-	{0x5E800208, 0x7C00},
-	{0xBE7FFFF8, 0xB400},
-	{0x5E35FE20, 0x7C00},
-	{0xBE7FFFFC, 0xB400},
-	{0xC1F00FFE, 0xCF80},
-	{0xBE7FFFFE, 0xB400},
-	{0x3F7C039F, 0x3BE0},
-	{0xBE7FFFFF, 0xB400},
-	{0xDFFFFFFE, 0xFC00},
-	{0xBE7FFFFD, 0xB400},
-	{0x4BBB003F, 0x7C00},
-	{0xBE7FFFFB, 0xB400},
-	{0xA9FC0002, 0x8000},
-	{0xBE7FFFF7, 0xB400},
-	{0xC43DCB6B, 0xE1EE},
-	{0xBE7FFFEF, 0xB400},
-	{0x36FF0003, 0x0080},
-	{0xBE7FFFDF, 0xB400},
-	{0xC3000FE0, 0xD800},
-	{0xBE7FFFBF, 0xB400},
-	{0xCFFFEFFC, 0xFC00},
-	{0xBE7FFF7F, 0xB400},
-	{0xBEFAFFFF, 0xB7D8},
-	{0xBE7FFEFF, 0xB400},
-	{0x3F82001E, 0x3C10},
-	{0xBE7FFDFF, 0xB400},
-	{0x8101EFFF, 0x8000},
-	{0xBE7FFBFF, 0xB400},
-	{0xC18007EE, 0xCC00},
-	{0xBE7FF7FF, 0xB400},
-	{0xC1AA68DC, 0xCD53},
-	{0xBE7FEFFF, 0xB3FF},
-	{0xBB7FFC03, 0x9C00},
-	{0xBE7FDFFF, 0xB3FF},
-	{0x418FAE3C, 0x4C7D},
-	{0xBE7FBFFF, 0xB3FE},
-	{0xDFFFEFFF, 0xFC00},
-	{0xBE7F7FFF, 0xB3FC},
-	{0xCBA49F33, 0xFC00},
-	{0xBE7EFFFF, 0xB3F8},
-	{0x5E7FFF07, 0x7C00},
-	{0xBE7DFFFF, 0xB3F0},
-	{0x46DFF6E0, 0x7700},
-	{0xBE7BFFFF, 0xB3E0},
-	{0x7F3FFFFE, 0x7C00},
-	{0xBE77FFFF, 0xB3C0},
-	{0xC0807FFC, 0xC404},
-	{0xBE6FFFFF, 0xB380},
-	{0xCEF87A4A, 0xFC00},
-	{0xBE5FFFFF, 0xB300},
-	{0x1F801800, 0x0000},
-	{0xBE3FFFFF, 0xB200},
-	{0xD49007FF, 0xFC00},
-	{0xBE1FFFFF, 0xB100},
-	{0x3EFF7EFE, 0x37FC},
-	{0xBE0FFFFF, 0xB080},
-	{0xB78001EF, 0x8100},
-	{0xBE07FFFF, 0xB040},
-	{0xC4F90DD0, 0xE7C8},
-	{0xBE03FFFF, 0xB020},
-	{0xDEA3197C, 0xFC00},
-	{0xBE01FFFF, 0xB010},
-	{0x00808200, 0x0000},
-	{0xBE00FFFF, 0xB008},
-	{0xEE855594, 0xFC00},
-	{0xBE007FFF, 0xB004},
-	{0xCEFFFEF6, 0xFC00},
-	{0xBE003FFF, 0xB002},
-	{0xC01D143B, 0xC0E9},
-	{0xBE001FFF, 0xB001},
-	{0x46FF8008, 0x77FC},
-	{0xBE000FFF, 0xB000},
-	{0xFFF80001, 0xFFC0},
-	{0xBE0007FF, 0xB000},
-	{0xC583D842, 0xEC1F},
-	{0xBE0003FF, 0xB000},
-	{0xCC7D2684, 0xFC00},
-	{0xBE0001FF, 0xB000},
-	{0x3DE7FFFF, 0x2F40},
-	{0xBE0000FF, 0xB000},
-	{0xC1A89EFB, 0xCD45},
-	{0xBE00007F, 0xB000},
-	{0xBF810040, 0xBC08},
-	{0xBE00003F, 0xB000},
-	{0xC17FFBFF, 0xCC00},
-	{0xBE00001F, 0xB000},
-	{0x3EFFE008, 0x37FF},
-	{0xBE00000F, 0xB000},
-	{0xB3BFF800, 0x8001},
-	{0xBE000007, 0xB000},
-	{0x3DA2AAD1, 0x2D15},
-	{0xBE000003, 0xB000},
-	{0x5E81FBFE, 0x7C00},
-	{0xBE800000, 0xB400},
-	{0xCF7FFCFF, 0xFC00},
-	{0xBE800001, 0xB400},
-	{0x4EFFFDF6, 0x7C00},
-	{0xBE800002, 0xB400},
-	{0xFEFEFFFC, 0xFC00},
-	{0xBE800004, 0xB400},
-	{0x3F89FFFF, 0x3C50},
-	{0xBE800008, 0xB400},
-	{0x3EFDFFBF, 0x37F0},
-	{0xBE800010, 0xB400},
-	{0x53F80003, 0x7C00},
-	{0xBE800020, 0xB400},
-	{0xDE8FFEFF, 0xFC00},
-	{0xBE800040, 0xB400},
-	{0x4BFC003F, 0x7C00},
-	{0xBE800080, 0xB400},
-	{0x808007C0, 0x8000},
-	{0xBE800100, 0xB400},
-	{0x008023FF, 0x0000},
-	{0xBE800200, 0xB400},
-	{0xCFFF7F7E, 0xFC00},
-	{0xBE800400, 0xB400},
-	{0x2E9B9884, 0x0000},
-	{0xBE800800, 0xB400},
-	{0xFF7EF7FF, 0xFC00},
-	{0xBE801000, 0xB400},
-	{0xB7802FFE, 0x8100},
-	{0xBE802000, 0xB401},
-	{0xB3800084, 0x8001},
-	{0xBE804000, 0xB402},
-	{0x01000000, 0x0000},
-	{0xBE808000, 0xB404},
-	{0xB9BDE649, 0x8DEF},
-	{0xBE810000, 0xB408},
-	{0xCFAC3350, 0xFC00},
-	{0xBE820000, 0xB410},
-	{0xBDFBFFFB, 0xAFE0},
-	{0xBE840000, 0xB420},
-	{0xCE810000, 0xFC00},
-	{0xBE880000, 0xB440},
-	{0xADFE0020, 0x8000},
-	{0xBE900000, 0xB480},
-	{0x007FF0FE, 0x0000},
-	{0xBEA00000, 0xB500},
-	{0x3E040001, 0x3020},
-	{0xBEC00000, 0xB600},
-	{0x3C800404, 0x2400},
-	{0xBEE00000, 0xB700},
-	{0x4060001E, 0x4300},
-	{0xBEF00000, 0xB780},
-	{0xCFFF0000, 0xFC00},
-	{0xBEF80000, 0xB7C0},
-	{0x401FFFFF, 0x4100},
-	{0xBEFC0000, 0xB7E0},
-	{0x4100FFFE, 0x4808},
-	{0xBEFE0000, 0xB7F0},
-	{0xBD800036, 0xAC00},
-	{0xBEFF0000, 0xB7F8},
-	{0xBE02007F, 0xB010},
-	{0xBEFF8000, 0xB7FC},
-	{0xBC003BFE, 0xA002},
-	{0xBEFFC000, 0xB7FE},
-	{0xC17FFC00, 0xCC00},
-	{0xBEFFE000, 0xB7FF},
-	{0xC27FF000, 0xD400},
-	{0xBEFFF000, 0xB800},
-	{0xBFC46ED3, 0xBE23},
-	{0xBEFFF800, 0xB800},
-	{0x4AE0001E, 0x7C00},
-	{0xBEFFFC00, 0xB800},
-	{0xDABAB241, 0xFC00},
-	{0xBEFFFE00, 0xB800},
-	{0x9D002007, 0x8000},
-	{0xBEFFFF00, 0xB800},
-	{0xBD80003E, 0xAC00},
-	{0xBEFFFF80, 0xB800},
-	{0x4E5FFF7E, 0x7C00},
-	{0xBEFFFFC0, 0xB800},
-	{0xDF7FF880, 0xFC00},
-	{0xBEFFFFE0, 0xB800},
-	{0xCE6011B5, 0xFC00},
-	{0xBEFFFFF0, 0xB800},
-	{0xC0FFFCFF, 0xC800},
-	{0xBEFFFFF8, 0xB800},
-	{0x7FB10104, 0x7F88},
-	{0xBEFFFFFC, 0xB800},
-	{0x40840003, 0x4420},
-	{0xBEFFFFFE, 0xB800},
-	{0x5781003E, 0x7C00},
-	{0xBEFFFFFF, 0xB800},
-	{0x7F0007FF, 0x7C00},
-	{0xBEFFFFFD, 0xB800},
-	{0x807FFF5E, 0x8000},
-	{0xBEFFFFFB, 0xB800},
-	{0x6AA1FFFF, 0x7C00},
-	{0xBEFFFFF7, 0xB800},
-	{0x2F081000, 0x0000},
-	{0xBEFFFFEF, 0xB800},
-	{0x20800024, 0x0000},
-	{0xBEFFFFDF, 0xB800},
-	{0xC4080400, 0xE040},
-	{0xBEFFFFBF, 0xB800},
-	{0xBC679CF4, 0xA33D},
-	{0xBEFFFF7F, 0xB800},
-	{0xBF87C6B9, 0xBC3E},
-	{0xBEFFFEFF, 0xB800},
-	{0x4EC1FF26, 0x7C00},
-	{0xBEFFFDFF, 0xB800},
-	{0x3EA07FFF, 0x3504},
-	{0xBEFFFBFF, 0xB800},
-	{0x4EFC00FE, 0x7C00},
-	{0xBEFFF7FF, 0xB800},
-	{0xBEF86C80, 0xB7C3},
-	{0xBEFFEFFF, 0xB7FF},
-	{0xBE7BE000, 0xB3DF},
-	{0xBEFFDFFF, 0xB7FF},
-	{0x5E10007F, 0x7C00},
-	{0xBEFFBFFF, 0xB7FE},
-	{0x33F60000, 0x0002},
-	{0xBEFF7FFF, 0xB7FC},
-	{0xBF801FFF, 0xBC01},
-	{0xBEFEFFFF, 0xB7F8},
-	{0xC182001F, 0xCC10},
-	{0xBEFDFFFF, 0xB7F0},
-	{0x017210EC, 0x0000},
-	{0xBEFBFFFF, 0xB7E0},
-	{0xC10000FB, 0xC800},
-	{0xBEF7FFFF, 0xB7C0},
-	{0x7E84003F, 0x7C00},
-	{0xBEEFFFFF, 0xB780},
-	{0x7E80FF80, 0x7C00},
-	{0xBEDFFFFF, 0xB700},
-	{0x80B2F5F4, 0x8000},
-	{0xBEBFFFFF, 0xB600},
-	{0x003A241A, 0x0000},
-	{0xBE9FFFFF, 0xB500},
-	{0xC4FFC01F, 0xE7FE},
-	{0xBE8FFFFF, 0xB480},
-	{0xCFCBDEB5, 0xFC00},
-	{0xBE87FFFF, 0xB440},
-	{0xC1BFDFFF, 0xCDFF},
-	{0xBE83FFFF, 0xB420},
-	{0x3FE02000, 0x3F01},
-	{0xBE81FFFF, 0xB410},
-	{0xDFC00020, 0xFC00},
-	{0xBE80FFFF, 0xB408},
-	{0xBE0F44F1, 0xB07A},
-	{0xBE807FFF, 0xB404},
-	{0xBF047FFF, 0xB824},
-	{0xBE803FFF, 0xB402},
-	{0x7EF80000, 0x7C00},
-	{0xBE801FFF, 0xB401},
-	{0xDEEFFDFF, 0xFC00},
-	{0xBE800FFF, 0xB400},
-	{0xBE77FFFD, 0xB3C0},
-	{0xBE8007FF, 0xB400},
-	{0xBD7D0000, 0xABE8},
-	{0xBE8003FF, 0xB400},
-	{0x418081FF, 0x4C04},
-	{0xBE8001FF, 0xB400},
-	{0x3E700003, 0x3380},
-	{0xBE8000FF, 0xB400},
-	{0x41900000, 0x4C80},
-	{0xBE80007F, 0xB400},
-	{0xADE1D1C7, 0x8000},
-	{0xBE80003F, 0xB400},
-	{0x41FFCFFF, 0x4FFE},
-	{0xBE80001F, 0xB400},
-	{0x3FEB0F74, 0x3F58},
-	{0xBE80000F, 0xB400},
-	{0x5FFFC001, 0x7C00},
-	{0xBE800007, 0xB400},
-	{0x40BB03B8, 0x45D8},
-	{0xBE800003, 0xB400},
-	{0x3F7EFFDE, 0x3BF8},
-	{0xBF000000, 0xB800},
-	{0x27900008, 0x0000},
-	{0xBF000001, 0xB800},
-	{0xBB84007F, 0x9C20},
-	{0xBF000002, 0xB800},
-	{0xBF000030, 0xB800},
-	{0xBF000004, 0xB800},
-	{0x5F807000, 0x7C00},
-	{0xBF000008, 0xB800},
-	{0xA505FFFE, 0x8000},
-	{0xBF000010, 0xB800},
-	{0x7FFFFF5F, 0x7FFF},
-	{0xBF000020, 0xB800},
-	{0xC09A3156, 0xC4D2},
-	{0xBF000040, 0xB800},
-	{0x4E7A0000, 0x7C00},
-	{0xBF000080, 0xB800},
-	{0xBE8D7AD2, 0xB46C},
-	{0xBF000100, 0xB800},
-	{0x3948DBB9, 0x0A47},
-	{0xBF000200, 0xB800},
-	{0x71BFFFFA, 0x7C00},
-	{0xBF000400, 0xB800},
-	{0x4186E006, 0x4C37},
-	{0xBF000800, 0xB800},
-	{0x41AF567A, 0x4D7B},
-	{0xBF001000, 0xB800},
-	{0xC16775F9, 0xCB3C},
-	{0xBF002000, 0xB801},
-	{0xCB8FFFFC, 0xFC00},
-	{0xBF004000, 0xB802},
-	{0x3D000011, 0x2800},
-	{0xBF008000, 0xB804},
-	{0x45D9DFAB, 0x6ECF},
-	{0xBF010000, 0xB808},
-	{0x010828FD, 0x0000},
-	{0xBF020000, 0xB810},
-	{0x3978FFFE, 0x0BC8},
-	{0xBF040000, 0xB820},
-	{0x7C81FFDF, 0x7C00},
-	{0xBF080000, 0xB840},
-	{0xCFFBFF80, 0xFC00},
-	{0xBF100000, 0xB880},
-	{0x3E7BFFFF, 0x33E0},
-	{0xBF200000, 0xB900},
-	{0xC1FF800E, 0xCFFC},
-	{0xBF400000, 0xBA00},
-	{0x40132E93, 0x4099},
-	{0xBF600000, 0xBB00},
-	{0xC4540A51, 0xE2A0},
-	{0xBF700000, 0xBB80},
-	{0x80000005, 0x8000},
-	{0xBF780000, 0xBBC0},
-	{0xC000006F, 0xC000},
-	{0xBF7C0000, 0xBBE0},
-	{0xDE9FFFFE, 0xFC00},
-	{0xBF7E0000, 0xBBF0},
-	{0x0015E9E7, 0x0000},
-	{0xBF7F0000, 0xBBF8},
-	{0x7EFBFFE0, 0x7C00},
-	{0xBF7F8000, 0xBBFC},
-	{0xFF7D402D, 0xFC00},
-	{0xBF7FC000, 0xBBFE},
-	{0xFF400080, 0xFC00},
-	{0xBF7FE000, 0xBBFF},
-	{0x4E7FFFF1, 0x7C00},
-	{0xBF7FF000, 0xBC00},
-	{0x4E7FFF88, 0x7C00},
-	{0xBF7FF800, 0xBC00},
-	{0xBD880000, 0xAC40},
-	{0xBF7FFC00, 0xBC00},
-	{0x4080FDFE, 0x4408},
-	{0xBF7FFE00, 0xBC00},
-	{0xC1FAFE41, 0xCFD8},
-	{0xBF7FFF00, 0xBC00},
-	{0xCE007FDF, 0xFC00},
-	{0xBF7FFF80, 0xBC00},
-	{0x36FFBFF0, 0x0080},
-	{0xBF7FFFC0, 0xBC00},
-	{0x417FFFFF, 0x4C00},
-	{0xBF7FFFE0, 0xBC00},
-	{0x33F40000, 0x0002},
-	{0xBF7FFFF0, 0xBC00},
-	{0xC140F26D, 0xCA08},
-	{0xBF7FFFF8, 0xBC00},
-	{0x3280004F, 0x0000},
-	{0xBF7FFFFC, 0xBC00},
-	{0x5F7FEFFF, 0x7C00},
-	{0xBF7FFFFE, 0xBC00},
-	{0x40800440, 0x4400},
-	{0xBF7FFFFF, 0xBC00},
-	{0xC0E99C75, 0xC74D},
-	{0xBF7FFFFD, 0xBC00},
-	{0x7F799299, 0x7C00},
-	{0xBF7FFFFB, 0xBC00},
-	{0x37FFFF7C, 0x0200},
-	{0xBF7FFFF7, 0xBC00},
-	{0x414EE189, 0x4A77},
-	{0xBF7FFFEF, 0xBC00},
-	{0x41A19802, 0x4D0D},
-	{0xBF7FFFDF, 0xBC00},
-	{0xD5C02E0A, 0xFC00},
-	{0xBF7FFFBF, 0xBC00},
-	{0x4181FFFC, 0x4C10},
-	{0xBF7FFF7F, 0xBC00},
-	{0xCF002000, 0xFC00},
-	{0xBF7FFEFF, 0xBC00},
-	{0x437FFDFC, 0x5C00},
-	{0xBF7FFDFF, 0xBC00},
-	{0x41827FFF, 0x4C14},
-	{0xBF7FFBFF, 0xBC00},
-	{0x007F87FF, 0x0000},
-	{0xBF7FF7FF, 0xBC00},
-	{0x4E8001FC, 0x7C00},
-	{0xBF7FEFFF, 0xBBFF},
-	{0x4BFFFFFF, 0x7C00},
-	{0xBF7FDFFF, 0xBBFF},
-	{0x00003DFF, 0x0000},
-	{0xBF7FBFFF, 0xBBFE},
-	{0xC103FFFF, 0xC820},
-	{0xBF7F7FFF, 0xBBFC},
-	{0xFFFFFF1F, 0xFFFF},
-	{0xBF7EFFFF, 0xBBF8},
-	{0x3CFC7FFF, 0x27E4},
-	{0xBF7DFFFF, 0xBBF0},
-	{0xBF659DA7, 0xBB2D},
-	{0xBF7BFFFF, 0xBBE0},
-	{0xC00003EF, 0xC000},
-	{0xBF77FFFF, 0xBBC0},
-	{0x3B8001BF, 0x1C00},
-	{0xBF6FFFFF, 0xBB80},
-	{0x76FFFFCF, 0x7C00},
-	{0xBF5FFFFF, 0xBB00},
-	{0xCB03BA2E, 0xFC00},
-	{0xBF3FFFFF, 0xBA00},
-	{0xFC9DFFFF, 0xFC00},
-	{0xBF1FFFFF, 0xB900},
-	{0xC100009E, 0xC800},
-	{0xBF0FFFFF, 0xB880},
-	{0xBAFEFFE0, 0x97F8},
-	{0xBF07FFFF, 0xB840},
-	{0xBB820FFF, 0x9C10},
-	{0xBF03FFFF, 0xB820},
-	{0xDFF01FFF, 0xFC00},
-	{0xBF01FFFF, 0xB810},
-	{0x4F0009FF, 0x7C00},
-	{0xBF00FFFF, 0xB808},
-	{0x3FFF8003, 0x3FFC},
-	{0xBF007FFF, 0xB804},
-	{0x5F83BFFF, 0x7C00},
-	{0xBF003FFF, 0xB802},
-	{0x3301FFFF, 0x0001},
-	{0xBF001FFF, 0xB801},
-	{0xDF8003E0, 0xFC00},
-	{0xBF000FFF, 0xB800},
-	{0x41EEFFFF, 0x4F78},
-	{0xBF0007FF, 0xB800},
-	{0x7E26794C, 0x7C00},
-	{0xBF0003FF, 0xB800},
-	{0x4F801080, 0x7C00},
-	{0xBF0001FF, 0xB800},
-	{0x3FFFFDFE, 0x4000},
-	{0xBF0000FF, 0xB800},
-	{0xBCEFFFFD, 0xA780},
-	{0xBF00007F, 0xB800},
-	{0x3E7FFFFF, 0x3400},
-	{0xBF00003F, 0xB800},
-	{0x4007F800, 0x4040},
-	{0xBF00001F, 0xB800},
-	{0x447A0000, 0x63D0},
-	{0xBF00000F, 0xB800},
-	{0xDEFC0004, 0xFC00},
-	{0xBF000007, 0xB800},
-	{0x7242E7F2, 0x7C00},
-	{0xBF000003, 0xB800},
-	{0x526FF000, 0x7C00},
-	{0xBF800000, 0xBC00},
-	{0xC17FC07F, 0xCBFE},
-	{0xBF800001, 0xBC00},
-	{0x5F6ECAA6, 0x7C00},
-	{0xBF800002, 0xBC00},
-	{0x7F7FF03F, 0x7C00},
-	{0xBF800004, 0xBC00},
-	{0xBFFFFFFF, 0xC000},
-	{0xBF800008, 0xBC00},
-	{0xBE6FF800, 0xB380},
-	{0xBF800010, 0xBC00},
-	{0xC189E6B9, 0xCC4F},
-	{0xBF800020, 0xBC00},
-	{0xC16001FF, 0xCB00},
-	{0xBF800040, 0xBC00},
-	{0x7FE9889B, 0x7F4C},
-	{0xBF800080, 0xBC00},
-	{0xBF200040, 0xB900},
-	{0xBF800100, 0xBC00},
-	{0x4A800180, 0x7C00},
-	{0xBF800200, 0xBC00},
-	{0xC1800102, 0xCC00},
-	{0xBF800400, 0xBC00},
-	{0x3D700200, 0x2B80},
-	{0xBF800800, 0xBC00},
-	{0xBF1FFFEF, 0xB900},
-	{0xBF801000, 0xBC00},
-	{0xA15DFFFE, 0x8000},
-	{0xBF802000, 0xBC01},
-	{0x3F7FF400, 0x3C00},
-	{0xBF804000, 0xBC02},
-	{0x816D84EE, 0x8000},
-	{0xBF808000, 0xBC04},
-	{0x30DB2F48, 0x0000},
-	{0xBF810000, 0xBC08},
-	{0x380001FD, 0x0200},
-	{0xBF820000, 0xBC10},
-	{0x408003E0, 0x4400},
-	{0xBF840000, 0xBC20},
-	{0x80FFF5FF, 0x8000},
-	{0xBF880000, 0xBC40},
-	{0xBEFFFFD7, 0xB800},
-	{0xBF900000, 0xBC80},
-	{0x55A4B1D6, 0x7C00},
-	{0xBFA00000, 0xBD00},
-	{0xDE0CBD92, 0xFC00},
-	{0xBFC00000, 0xBE00},
-	{0x34680000, 0x0004},
-	{0xBFE00000, 0xBF00},
-	{0xC3FE0000, 0xDFF0},
-	{0xBFF00000, 0xBF80},
-	{0xBFBF5A66, 0xBDFB},
-	{0xBFF80000, 0xBFC0},
-	{0xFEFFFF06, 0xFC00},
-	{0xBFFC0000, 0xBFE0},
-	{0x7E880200, 0x7C00},
-	{0xBFFE0000, 0xBFF0},
-	{0x7A800FF7, 0x7C00},
-	{0xBFFF0000, 0xBFF8},
-	{0x317BC000, 0x0000},
-	{0xBFFF8000, 0xBFFC},
-	{0x5EDAA465, 0x7C00},
-	{0xBFFFC000, 0xBFFE},
-	{0x3F7FFBFE, 0x3C00},
-	{0xBFFFE000, 0xBFFF},
-	{0xCE7C1CAF, 0xFC00},
-	{0xBFFFF000, 0xC000},
-	{0x80203FFF, 0x8000},
-	{0xBFFFF800, 0xC000},
-	{0x3F801FFE, 0x3C01},
-	{0xBFFFFC00, 0xC000},
-	{0x808E18CF, 0x8000},
-	{0xBFFFFE00, 0xC000},
-	{0x4E87FBFF, 0x7C00},
-	{0xBFFFFF00, 0xC000},
-	{0xD57FFFBF, 0xFC00},
-	{0xBFFFFF80, 0xC000},
-	{0xF280FFFB, 0xFC00},
-	{0xBFFFFFC0, 0xC000},
-	{0xC78A0000, 0xFC00},
-	{0xBFFFFFE0, 0xC000},
-	{0x4F2474AA, 0x7C00},
-	{0xBFFFFFF0, 0xC000},
-	{0xC0881FFF, 0xC441},
-	{0xBFFFFFF8, 0xC000},
-	{0xCE8005FE, 0xFC00},
-	{0xBFFFFFFC, 0xC000},
-	{0x41000003, 0x4800},
-	{0xBFFFFFFE, 0xC000},
-	{0xFFA88A5F, 0xFF44},
-	{0xBFFFFFFF, 0xC000},
-	{0x5F7FC010, 0x7C00},
-	{0xBFFFFFFD, 0xC000},
-	{0x8381FFDF, 0x8000},
-	{0xBFFFFFFB, 0xC000},
-	{0xDF7FFF7F, 0xFC00},
-	{0xBFFFFFF7, 0xC000},
-	{0xC208007E, 0xD040},
-	{0xBFFFFFEF, 0xC000},
-	{0xBF880003, 0xBC40},
-	{0xBFFFFFDF, 0xC000},
-	{0x4080C000, 0x4406},
-	{0xBFFFFFBF, 0xC000},
-	{0x9B0DCCF4, 0x8000},
-	{0xBFFFFF7F, 0xC000},
-	{0x7F08D730, 0x7C00},
-	{0xBFFFFEFF, 0xC000},
-	{0x7F7C00FF, 0x7C00},
-	{0xBFFFFDFF, 0xC000},
-	{0x3AFF0000, 0x17F8},
-	{0xBFFFFBFF, 0xC000},
-	{0xC0FFBFFE, 0xC7FE},
-	{0xBFFFF7FF, 0xC000},
-	{0xCF03FF7F, 0xFC00},
-	{0xBFFFEFFF, 0xBFFF},
-	{0x766E66B7, 0x7C00},
-	{0xBFFFDFFF, 0xBFFF},
-	{0xB38001C0, 0x8001},
-	{0xBFFFBFFF, 0xBFFE},
-	{0x4FFBF7FE, 0x7C00},
-	{0xBFFF7FFF, 0xBFFC},
-	{0xC2CCC2A0, 0xD666},
-	{0xBFFEFFFF, 0xBFF8},
-	{0xC77940A8, 0xFBCA},
-	{0xBFFDFFFF, 0xBFF0},
-	{0xBA6FFE00, 0x9380},
-	{0xBFFBFFFF, 0xBFE0},
-	{0xCE9FFFF7, 0xFC00},
-	{0xBFF7FFFF, 0xBFC0},
-	{0xC2429A2A, 0xD215},
-	{0xBFEFFFFF, 0xBF80},
-	{0xBE01000F, 0xB008},
-	{0xBFDFFFFF, 0xBF00},
-	{0xCE800084, 0xFC00},
-	{0xBFBFFFFF, 0xBE00},
-	{0x80FFF7BF, 0x8000},
-	{0xBF9FFFFF, 0xBD00},
-	{0x417FFFC7, 0x4C00},
-	{0xBF8FFFFF, 0xBC80},
-	{0x4E7FFFFC, 0x7C00},
-	{0xBF87FFFF, 0xBC40},
-	{0xC1FFFC3F, 0xD000},
-	{0xBF83FFFF, 0xBC20},
-	{0x3E003FFE, 0x3002},
-	{0xBF81FFFF, 0xBC10},
-	{0x3F84001F, 0x3C20},
-	{0xBF80FFFF, 0xBC08},
-	{0xC1EFFEFF, 0xCF80},
-	{0xBF807FFF, 0xBC04},
-	{0x3F497051, 0x3A4C},
-	{0xBF803FFF, 0xBC02},
-	{0x007FCFFE, 0x0000},
-	{0xBF801FFF, 0xBC01},
-	{0x4001FFFF, 0x4010},
-	{0xBF800FFF, 0xBC00},
-	{0xDEF07FFF, 0xFC00},
-	{0xBF8007FF, 0xBC00},
-	{0x147F0000, 0x0000},
-	{0xBF8003FF, 0xBC00},
-	{0xA610000F, 0x8000},
-	{0xBF8001FF, 0xBC00},
-	{0x4BE38928, 0x7C00},
-	{0xBF8000FF, 0xBC00},
-	{0xE080003A, 0xFC00},
-	{0xBF80007F, 0xBC00},
-	{0x7F8001FE, 0x7E00},
-	{0xBF80003F, 0xBC00},
-	{0x00694DA2, 0x0000},
-	{0xBF80001F, 0xBC00},
-	{0x4E423607, 0x7C00},
-	{0xBF80000F, 0xBC00},
-	{0x327FFE7F, 0x0000},
-	{0xBF800007, 0xBC00},
-	{0x3F3F8000, 0x39FC},
-	{0xBF800003, 0xBC00},
-	{0xCE3FFFF7, 0xFC00},
-	{0xC0000000, 0xC000},
-	{0xB17FFEF0, 0x8000},
-	{0xC0000001, 0xC000},
-	{0xBFFCDA5F, 0xBFE7},
-	{0xC0000002, 0xC000},
-	{0x3380FFFF, 0x0001},
-	{0xC0000004, 0xC000},
-	{0xBB5FFFFD, 0x9B00},
-	{0xC0000008, 0xC000},
-	{0x33FFF0FF, 0x0002},
-	{0xC0000010, 0xC000},
-	{0x4EFE8C80, 0x7C00},
-	{0xC0000020, 0xC000},
-	{0x89808006, 0x8000},
-	{0xC0000040, 0xC000},
-	{0xFF02003F, 0xFC00},
-	{0xC0000080, 0xC000},
-	{0x8DE00800, 0x8000},
-	{0xC0000100, 0xC000},
-	{0x00411D5E, 0x0000},
-	{0xC0000200, 0xC000},
-	{0x416FDFFF, 0x4B7F},
-	{0xC0000400, 0xC000},
-	{0xC2100008, 0xD080},
-	{0xC0000800, 0xC000},
-	{0x4F800401, 0x7C00},
-	{0xC0001000, 0xC000},
-	{0xBDF32CB5, 0xAF99},
-	{0xC0002000, 0xC001},
-	{0x3589FFFE, 0x0011},
-	{0xC0004000, 0xC002},
-	{0x3FF83FFF, 0x3FC2},
-	{0xC0008000, 0xC004},
-	{0x40FFF801, 0x4800},
-	{0xC0010000, 0xC008},
-	{0xBFFC7A9D, 0xBFE4},
-	{0xC0020000, 0xC010},
-	{0xCFDEEAED, 0xFC00},
-	{0xC0040000, 0xC020},
-	{0x3C851FC0, 0x2429},
-	{0xC0080000, 0xC040},
-	{0x41800202, 0x4C00},
-	{0xC0100000, 0xC080},
-	{0xFEA0003F, 0xFC00},
-	{0xC0200000, 0xC100},
-	{0x3EFC07FF, 0x37E0},
-	{0xC0400000, 0xC200},
-	{0x40C1FFFF, 0x4610},
-	{0xC0600000, 0xC300},
-	{0x40FFF9FF, 0x4800},
-	{0xC0700000, 0xC380},
-	{0xB17F8008, 0x8000},
-	{0xC0780000, 0xC3C0},
-	{0xC1007EFF, 0xC804},
-	{0xC07C0000, 0xC3E0},
-	{0x4007163E, 0x4039},
-	{0xC07E0000, 0xC3F0},
-	{0x3D01FC00, 0x2810},
-	{0xC07F0000, 0xC3F8},
-	{0x43959FB4, 0x5CAD},
-	{0xC07F8000, 0xC3FC},
-	{0x3DFFEBFF, 0x2FFF},
-	{0xC07FC000, 0xC3FE},
-	{0x3A2007FE, 0x1100},
-	{0xC07FE000, 0xC3FF},
-	{0xCE5BC713, 0xFC00},
-	{0xC07FF000, 0xC400},
-	{0x589FFFF7, 0x7C00},
-	{0xC07FF800, 0xC400},
-	{0xDFA72A35, 0xFC00},
-	{0xC07FFC00, 0xC400},
-	{0x7E8040FF, 0x7C00},
-	{0xC07FFE00, 0xC400},
-	{0x41F2E888, 0x4F97},
-	{0xC07FFF00, 0xC400},
-	{0xC0DFFFFF, 0xC700},
-	{0xC07FFF80, 0xC400},
-	{0xCE0002FE, 0xFC00},
-	{0xC07FFFC0, 0xC400},
-	{0x0000F000, 0x0000},
-	{0xC07FFFE0, 0xC400},
-	{0xCF800081, 0xFC00},
-	{0xC07FFFF0, 0xC400},
-	{0xB390A9C7, 0x8001},
-	{0xC07FFFF8, 0xC400},
-	{0xA6000018, 0x8000},
-	{0xC07FFFFC, 0xC400},
-	{0xBFF7FEFF, 0xBFC0},
-	{0xC07FFFFE, 0xC400},
-	{0xD5B1E26B, 0xFC00},
-	{0xC07FFFFF, 0xC400},
-	{0x0041EFDA, 0x0000},
-	{0xC07FFFFD, 0xC400},
-	{0xB9887FFF, 0x8C44},
-	{0xC07FFFFB, 0xC400},
-	{0x467FFEDE, 0x7400},
-	{0xC07FFFF7, 0xC400},
-	{0xB3C1821E, 0x8002},
-	{0xC07FFFEF, 0xC400},
-	{0xC3760000, 0xDBB0},
-	{0xC07FFFDF, 0xC400},
-	{0x4B8083FF, 0x7C00},
-	{0xC07FFFBF, 0xC400},
-	{0xC3806FFE, 0xDC03},
-	{0xC07FFF7F, 0xC400},
-	{0x3A882E60, 0x1441},
-	{0xC07FFEFF, 0xC400},
-	{0xC6000FFC, 0xF000},
-	{0xC07FFDFF, 0xC400},
-	{0x3F800403, 0x3C00},
-	{0xC07FFBFF, 0xC400},
-	{0xD97E001F, 0xFC00},
-	{0xC07FF7FF, 0xC400},
-	{0x5F81FEFF, 0x7C00},
-	{0xC07FEFFF, 0xC3FF},
-	{0xC0400001, 0xC200},
-	{0xC07FDFFF, 0xC3FF},
-	{0x41010FFF, 0x4808},
-	{0xC07FBFFF, 0xC3FE},
-	{0xCEFFFDBE, 0xFC00},
-	{0xC07F7FFF, 0xC3FC},
-	{0x3F31ED2B, 0x398F},
-	{0xC07EFFFF, 0xC3F8},
-	{0x367FFFDF, 0x0040},
-	{0xC07DFFFF, 0xC3F0},
-	{0xD9DFFDFE, 0xFC00},
-	{0xC07BFFFF, 0xC3E0},
-	{0xC3080006, 0xD840},
-	{0xC077FFFF, 0xC3C0},
-	{0x31F007FF, 0x0000},
-	{0xC06FFFFF, 0xC380},
-	{0xBDF10000, 0xAF88},
-	{0xC05FFFFF, 0xC300},
-	{0xCB82FFFF, 0xFC00},
-	{0xC03FFFFF, 0xC200},
-	{0xC17BFFFB, 0xCBE0},
-	{0xC01FFFFF, 0xC100},
-	{0xFF6FFEFE, 0xFC00},
-	{0xC00FFFFF, 0xC080},
-	{0xC27F9FFE, 0xD3FD},
-	{0xC007FFFF, 0xC040},
-	{0x3CF8003E, 0x27C0},
-	{0xC003FFFF, 0xC020},
-	{0xCEC00010, 0xFC00},
-	{0xC001FFFF, 0xC010},
-	{0xBE7FBFFA, 0xB3FE},
-	{0xC000FFFF, 0xC008},
-	{0xDF56845B, 0xFC00},
-	{0xC0007FFF, 0xC004},
-	{0x3EFCF179, 0x37E8},
-	{0xC0003FFF, 0xC002},
-	{0x00923F45, 0x0000},
-	{0xC0001FFF, 0xC001},
-	{0x4F03FFFF, 0x7C00},
-	{0xC0000FFF, 0xC000},
-	{0xCF6656C5, 0xFC00},
-	{0xC00007FF, 0xC000},
-	{0xCE82003E, 0xFC00},
-	{0xC00003FF, 0xC000},
-	{0x33FFFE02, 0x0002},
-	{0xC00001FF, 0xC000},
-	{0xBDFFEFFD, 0xAFFF},
-	{0xC00000FF, 0xC000},
-	{0xC8FFDFFF, 0xFC00},
-	{0xC000007F, 0xC000},
-	{0xCF880000, 0xFC00},
-	{0xC000003F, 0xC000},
-	{0xC0F00010, 0xC780},
-	{0xC000001F, 0xC000},
-	{0x427BDFFF, 0x53DF},
-	{0xC000000F, 0xC000},
-	{0xE2FFFF00, 0xFC00},
-	{0xC0000007, 0xC000},
-	{0xCE8001F0, 0xFC00},
-	{0xC0000003, 0xC000},
-	{0x3D800300, 0x2C00},
-	{0xC0800000, 0xC400},
-	{0x3E8ACBB3, 0x3456},
-	{0xC0800001, 0xC400},
-	{0xB18107FF, 0x8000},
-	{0xC0800002, 0xC400},
-	{0xDF80FF80, 0xFC00},
-	{0xC0800004, 0xC400},
-	{0x89801F7F, 0x8000},
-	{0xC0800008, 0xC400},
-	{0x4E84BC52, 0x7C00},
-	{0xC0800010, 0xC400},
-	{0x6EFBF7FE, 0x7C00},
-	{0xC0800020, 0xC400},
-	{0xC17C0003, 0xCBE0},
-	{0xC0800040, 0xC400},
-	{0x33FFFC7F, 0x0002},
-	{0xC0800080, 0xC400},
-	{0x4FFFBFFE, 0x7C00},
-	{0xC0800100, 0xC400},
-	{0xCC81FFF6, 0xFC00},
-	{0xC0800200, 0xC400},
-	{0xC24A5FEA, 0xD253},
-	{0xC0800400, 0xC400},
-	{0x47DF27E5, 0x7C00},
-	{0xC0800800, 0xC400},
-	{0xB00000DF, 0x8000},
-	{0xC0801000, 0xC400},
-	{0x01200040, 0x0000},
-	{0xC0802000, 0xC401},
-	{0x40020000, 0x4010},
-	{0xC0804000, 0xC402},
-	{0xBF003DFF, 0xB802},
-	{0xC0808000, 0xC404},
-	{0x80880004, 0x8000},
-	{0xC0810000, 0xC408},
-	{0xBEFFFDEF, 0xB800},
-	{0xC0820000, 0xC410},
-	{0xBD938DFE, 0xAC9C},
-	{0xC0840000, 0xC420},
-	{0x813FFFDF, 0x8000},
-	{0xC0880000, 0xC440},
-	{0xCBE00000, 0xFC00},
-	{0xC0900000, 0xC480},
-	{0x80800FEF, 0x8000},
-	{0xC0A00000, 0xC500},
-	{0x3D7FDFEF, 0x2BFF},
-	{0xC0C00000, 0xC600},
-	{0x3D77F7FF, 0x2BC0},
-	{0xC0E00000, 0xC700},
-	{0x83CF65FC, 0x8000},
-	{0xC0F00000, 0xC780},
-	{0x817FDEFF, 0x8000},
-	{0xC0F80000, 0xC7C0},
-	{0x80600003, 0x8000},
-	{0xC0FC0000, 0xC7E0},
-	{0xC6D8FAEA, 0xF6C8},
-	{0xC0FE0000, 0xC7F0},
-	{0xBA81FFDF, 0x9410},
-	{0xC0FF0000, 0xC7F8},
-	{0xCE2790EB, 0xFC00},
-	{0xC0FF8000, 0xC7FC},
-	{0xA4800400, 0x8000},
-	{0xC0FFC000, 0xC7FE},
-	{0x4FA9B245, 0x7C00},
-	{0xC0FFE000, 0xC7FF},
-	{0x0800000B, 0x0000},
-	{0xC0FFF000, 0xC800},
-	{0xC684000F, 0xF420},
-	{0xC0FFF800, 0xC800},
-	{0x44360BA2, 0x61B0},
-	{0xC0FFFC00, 0xC800},
-	{0x6E81F7FF, 0x7C00},
-	{0xC0FFFE00, 0xC800},
-	{0x21200000, 0x0000},
-	{0xC0FFFF00, 0xC800},
-	{0x43100000, 0x5880},
-	{0xC0FFFF80, 0xC800},
-	{0xC1677FC8, 0xCB3C},
-	{0xC0FFFFC0, 0xC800},
-	{0x3F10000E, 0x3880},
-	{0xC0FFFFE0, 0xC800},
-	{0xDFFFF87F, 0xFC00},
-	{0xC0FFFFF0, 0xC800},
-	{0x5F0FFFFB, 0x7C00},
-	{0xC0FFFFF8, 0xC800},
-	{0x4B571A12, 0x7C00},
-	{0xC0FFFFFC, 0xC800},
-	{0x3F000086, 0x3800},
-	{0xC0FFFFFE, 0xC800},
-	{0xB98005FE, 0x8C00},
-	{0xC0FFFFFF, 0xC800},
-	{0xBAA0FFFF, 0x9508},
-	{0xC0FFFFFD, 0xC800},
-	{0x407DFBFF, 0x43F0},
-	{0xC0FFFFFB, 0xC800},
-	{0x3FFC0001, 0x3FE0},
-	{0xC0FFFFF7, 0xC800},
-	{0x3E7FFC1F, 0x3400},
-	{0xC0FFFFEF, 0xC800},
-	{0x5EFFFDFB, 0x7C00},
-	{0xC0FFFFDF, 0xC800},
-	{0x3F700040, 0x3B80},
-	{0xC0FFFFBF, 0xC800},
-	{0x5EA00020, 0x7C00},
-	{0xC0FFFF7F, 0xC800},
-	{0x92C8FD50, 0x8000},
-	{0xC0FFFEFF, 0xC800},
-	{0x3F802002, 0x3C01},
-	{0xC0FFFDFF, 0xC800},
-	{0x5E8FFEFF, 0x7C00},
-	{0xC0FFFBFF, 0xC800},
-	{0xCB80020F, 0xFC00},
-	{0xC0FFF7FF, 0xC800},
-	{0xFEFDFFFE, 0xFC00},
-	{0xC0FFEFFF, 0xC7FF},
-	{0xDE600010, 0xFC00},
-	{0xC0FFDFFF, 0xC7FF},
-	{0xCE1FFEFF, 0xFC00},
-	{0xC0FFBFFF, 0xC7FE},
-	{0xFEFF8008, 0xFC00},
-	{0xC0FF7FFF, 0xC7FC},
-	{0x40FFFBF7, 0x4800},
-	{0xC0FEFFFF, 0xC7F8},
-	{0x41000401, 0x4800},
-	{0xC0FDFFFF, 0xC7F0},
-	{0xD967D8D8, 0xFC00},
-	{0xC0FBFFFF, 0xC7E0},
-	{0xBF8080FF, 0xBC04},
-	{0xC0F7FFFF, 0xC7C0},
-	{0xDEF7FFEF, 0xFC00},
-	{0xC0EFFFFF, 0xC780},
-	{0x4BA00010, 0x7C00},
-	{0xC0DFFFFF, 0xC700},
-	{0x4BAD34D6, 0x7C00},
-	{0xC0BFFFFF, 0xC600},
-	{0x40DFFBFE, 0x4700},
-	{0xC09FFFFF, 0xC500},
-	{0xC0ADB9F2, 0xC56E},
-	{0xC08FFFFF, 0xC480},
-	{0x41840080, 0x4C20},
-	{0xC087FFFF, 0xC440},
-	{0xB380020F, 0x8001},
-	{0xC083FFFF, 0xC420},
-	{0x070C9C43, 0x0000},
-	{0xC081FFFF, 0xC410},
-	{0x40989128, 0x44C5},
-	{0xC080FFFF, 0xC408},
-	{0xC1FFFFDF, 0xD000},
-	{0xC0807FFF, 0xC404},
-	{0x198000C0, 0x0000},
-	{0xC0803FFF, 0xC402},
-	{0x3F63C844, 0x3B1E},
-	{0xC0801FFF, 0xC401},
-	{0x3E8FFFFF, 0x3480},
-	{0xC0800FFF, 0xC400},
-	{0x71FC0200, 0x7C00},
-	{0xC08007FF, 0xC400},
-	{0x96F5088D, 0x8000},
-	{0xC08003FF, 0xC400},
-	{0x7F7FF3FE, 0x7C00},
-	{0xC08001FF, 0xC400},
-	{0xC7802FFF, 0xFC00},
-	{0xC08000FF, 0xC400},
-	{0x42FE1FFE, 0x57F1},
-	{0xC080007F, 0xC400},
-	{0x3E9007FE, 0x3480},
-	{0xC080003F, 0xC400},
-	{0x3E00003B, 0x3000},
-	{0xC080001F, 0xC400},
-	{0x7EF80002, 0x7C00},
-	{0xC080000F, 0xC400},
-	{0x3E7F77FF, 0x33FC},
-	{0xC0800007, 0xC400},
-	{0xC07F03FE, 0xC3F8},
-	{0xC0800003, 0xC400},
-	{0xBF0000EF, 0xB800},
-	{0xC1000000, 0xC800},
-	{0x4B07FF80, 0x7C00},
-	{0xC1000001, 0xC800},
-	{0xC6800002, 0xF400},
-	{0xC1000002, 0xC800},
-	{0xC089A0A4, 0xC44D},
-	{0xC1000004, 0xC800},
-	{0x5A90001E, 0x7C00},
-	{0xC1000008, 0xC800},
-	{0xBE6FFFFF, 0xB380},
-	{0xC1000010, 0xC800},
-	{0xBF884000, 0xBC42},
-	{0xC1000020, 0xC800},
-	{0xBF800FF8, 0xBC00},
-	{0xC1000040, 0xC800},
-	{0x4587FEFF, 0x6C40},
-	{0xC1000080, 0xC800},
-	{0x3F7FFFFA, 0x3C00},
-	{0xC1000100, 0xC800},
-	{0x7E80040E, 0x7C00},
-	{0xC1000200, 0xC800},
-	{0x4BBFFFEF, 0x7C00},
-	{0xC1000400, 0xC800},
-	{0xBEFFFBBF, 0xB800},
-	{0xC1000800, 0xC800},
-	{0x3D80EFFE, 0x2C07},
-	{0xC1001000, 0xC800},
-	{0x4B800044, 0x7C00},
-	{0xC1002000, 0xC801},
-	{0x3DA4690D, 0x2D23},
-	{0xC1004000, 0xC802},
-	{0xBEFFC07E, 0xB7FE},
-	{0xC1008000, 0xC804},
-	{0xBF03FFFB, 0xB820},
-	{0xC1010000, 0xC808},
-	{0x47000037, 0x7800},
-	{0xC1020000, 0xC810},
-	{0x407FFFFE, 0x4400},
-	{0xC1040000, 0xC820},
-	{0x49800FF7, 0x7C00},
-	{0xC1080000, 0xC840},
-	{0x4FFFFEFF, 0x7C00},
-	{0xC1100000, 0xC880},
-	{0xC382E08F, 0xDC17},
-	{0xC1200000, 0xC900},
-	{0x4000DFFE, 0x4007},
-	{0xC1400000, 0xCA00},
-	{0x005EDAEB, 0x0000},
-	{0xC1600000, 0xCB00},
-	{0xBF1A33A0, 0xB8D2},
-	{0xC1700000, 0xCB80},
-	{0xFF71E710, 0xFC00},
-	{0xC1780000, 0xCBC0},
-	{0xCBA40546, 0xFC00},
-	{0xC17C0000, 0xCBE0},
-	{0xAC200004, 0x8000},
-	{0xC17E0000, 0xCBF0},
-	{0x3C86F5C9, 0x2438},
-	{0xC17F0000, 0xCBF8},
-	{0xC0C0003F, 0xC600},
-	{0xC17F8000, 0xCBFC},
-	{0xC6FFC200, 0xF7FE},
-	{0xC17FC000, 0xCBFE},
-	{0xC17FF820, 0xCC00},
-	{0xC17FE000, 0xCBFF},
-	{0xDFF00400, 0xFC00},
-	{0xC17FF000, 0xCC00},
-	{0x80800006, 0x8000},
-	{0xC17FF800, 0xCC00},
-	{0xB966F533, 0x8B38},
-	{0xC17FFC00, 0xCC00},
-	{0xC7700FFF, 0xFB80},
-	{0xC17FFE00, 0xCC00},
-	{0xCA01000E, 0xFC00},
-	{0xC17FFF00, 0xCC00},
-	{0xFF0001FB, 0xFC00},
-	{0xC17FFF80, 0xCC00},
-	{0x4E1003FF, 0x7C00},
-	{0xC17FFFC0, 0xCC00},
-	{0x40B757A0, 0x45BB},
-	{0xC17FFFE0, 0xCC00},
-	{0xCFEFEFFF, 0xFC00},
-	{0xC17FFFF0, 0xCC00},
-	{0x6DFEFFF7, 0x7C00},
-	{0xC17FFFF8, 0xCC00},
-	{0x5F88003E, 0x7C00},
-	{0xC17FFFFC, 0xCC00},
-	{0xA1719D4C, 0x8000},
-	{0xC17FFFFE, 0xCC00},
-	{0xBF80002F, 0xBC00},
-	{0xC17FFFFF, 0xCC00},
-	{0x3C093B07, 0x204A},
-	{0xC17FFFFD, 0xCC00},
-	{0x407CFFFE, 0x43E8},
-	{0xC17FFFFB, 0xCC00},
-	{0x49FFFFD8, 0x7C00},
-	{0xC17FFFF7, 0xCC00},
-	{0x68FFBFFC, 0x7C00},
-	{0xC17FFFEF, 0xCC00},
-	{0xBEA308F0, 0xB518},
-	{0xC17FFFDF, 0xCC00},
-	{0xCF000F7F, 0xFC00},
-	{0xC17FFFBF, 0xCC00},
-	{0x41FDFFFF, 0x4FF0},
-	{0xC17FFF7F, 0xCC00},
-	{0xCBFE0000, 0xFC00},
-	{0xC17FFEFF, 0xCC00},
-	{0x477FFFF2, 0x7C00},
-	{0xC17FFDFF, 0xCC00},
-	{0xB803FDFF, 0x8210},
-	{0xC17FFBFF, 0xCC00},
-	{0x37801EFE, 0x0100},
-	{0xC17FF7FF, 0xCC00},
-	{0x00D8D2E8, 0x0000},
-	{0xC17FEFFF, 0xCBFF},
-	{0x41FFDEFF, 0x4FFF},
-	{0xC17FDFFF, 0xCBFF},
-	{0xBF1BA552, 0xB8DD},
-	{0xC17FBFFF, 0xCBFE},
-	{0xCBB31E6D, 0xFC00},
-	{0xC17F7FFF, 0xCBFC},
-	{0x80B80000, 0x8000},
-	{0xC17EFFFF, 0xCBF8},
-	{0x3C4E9BEC, 0x2275},
-	{0xC17DFFFF, 0xCBF0},
-	{0xBC268F86, 0xA134},
-	{0xC17BFFFF, 0xCBE0},
-	{0xD47F87FE, 0xFC00},
-	{0xC177FFFF, 0xCBC0},
-	{0xB3A15327, 0x8001},
-	{0xC16FFFFF, 0xCB80},
-	{0xCFFDFFF6, 0xFC00},
-	{0xC15FFFFF, 0xCB00},
-	{0x2E7FFF02, 0x0000},
-	{0xC13FFFFF, 0xCA00},
-	{0x3CEBFFFF, 0x2760},
-	{0xC11FFFFF, 0xC900},
-	{0xDF000808, 0xFC00},
-	{0xC10FFFFF, 0xC880},
-	{0x676B5874, 0x7C00},
-	{0xC107FFFF, 0xC840},
-	{0x2AC3A5ED, 0x0000},
-	{0xC103FFFF, 0xC820},
-	{0xC284000F, 0xD420},
-	{0xC101FFFF, 0xC810},
-	{0x39FF0000, 0x0FF8},
-	{0xC100FFFF, 0xC808},
-	{0x42B51BBF, 0x55A9},
-	{0xC1007FFF, 0xC804},
-	{0xC206E0E2, 0xD037},
-	{0xC1003FFF, 0xC802},
-	{0xA187DFFF, 0x8000},
-	{0xC1001FFF, 0xC801},
-	{0xDF079FDA, 0xFC00},
-	{0xC1000FFF, 0xC800},
-	{0x3F7C03FF, 0x3BE0},
-	{0xC10007FF, 0xC800},
-	{0xC2BE659C, 0xD5F3},
-	{0xC10003FF, 0xC800},
-	{0xC09FFE00, 0xC500},
-	{0xC10001FF, 0xC800},
-	{0xFF7003FF, 0xFC00},
-	{0xC10000FF, 0xC800},
-	{0x40840200, 0x4420},
-	{0xC100007F, 0xC800},
-	{0xBD0161F4, 0xA80B},
-	{0xC100003F, 0xC800},
-	{0x41FFFDF6, 0x5000},
-	{0xC100001F, 0xC800},
-	{0xBEFFFF77, 0xB800},
-	{0xC100000F, 0xC800},
-	{0x4D818000, 0x7C00},
-	{0xC1000007, 0xC800},
-	{0x8900FC00, 0x8000},
-	{0xC1000003, 0xC800},
-	{0xD7802000, 0xFC00},
-	{0xC1800000, 0xCC00},
-	{0x33FEFFF7, 0x0002},
-	{0xC1800001, 0xCC00},
-	{0x3E0B67C9, 0x305B},
-	{0xC1800002, 0xCC00},
-	{0x3E800401, 0x3400},
-	{0xC1800004, 0xCC00},
-	{0x80FBFFBF, 0x8000},
-	{0xC1800008, 0xCC00},
-	{0xC0DFFFFE, 0xC700},
-	{0xC1800010, 0xCC00},
-	{0x44E013AB, 0x6701},
-	{0xC1800020, 0xCC00},
-	{0xE181007F, 0xFC00},
-	{0xC1800040, 0xCC00},
-	{0x3EFFFC04, 0x3800},
-	{0xC1800080, 0xCC00},
-	{0x407F80FF, 0x43FC},
-	{0xC1800100, 0xCC00},
-	{0x4B801F7E, 0x7C00},
-	{0xC1800200, 0xCC00},
-	{0x403FFFFC, 0x4200},
-	{0xC1800400, 0xCC00},
-	{0x006FFEFF, 0x0000},
-	{0xC1800800, 0xCC00},
-	{0x8003DFFF, 0x8000},
-	{0xC1801000, 0xCC00},
-	{0xFF7FF803, 0xFC00},
-	{0xC1802000, 0xCC01},
-	{0x33BFFDFF, 0x0001},
-	{0xC1804000, 0xCC02},
-	{0x5C7FF3FF, 0x7C00},
-	{0xC1808000, 0xCC04},
-	{0xEF001FEF, 0xFC00},
-	{0xC1810000, 0xCC08},
-	{0x9AFFFFF6, 0x8000},
-	{0xC1820000, 0xCC10},
-	{0xC303DFFF, 0xD81F},
-	{0xC1840000, 0xCC20},
-	{0xDEDEC106, 0xFC00},
-	{0xC1880000, 0xCC40},
-	{0xBDFFF7C0, 0xB000},
-	{0xC1900000, 0xCC80},
-	{0xCF83FFFE, 0xFC00},
-	{0xC1A00000, 0xCD00},
-	{0x3F1FFFFD, 0x3900},
-	{0xC1C00000, 0xCE00},
-	{0xBF0003BE, 0xB800},
-	{0xC1E00000, 0xCF00},
-	{0x3E0400FF, 0x3020},
-	{0xC1F00000, 0xCF80},
-	{0xC07FFF03, 0xC400},
-	{0xC1F80000, 0xCFC0},
-	{0xBE0011FE, 0xB001},
-	{0xC1FC0000, 0xCFE0},
-	{0xBFFE0003, 0xBFF0},
-	{0xC1FE0000, 0xCFF0},
-	{0xDF010001, 0xFC00},
-	{0xC1FF0000, 0xCFF8},
-	{0x3AFC003E, 0x17E0},
-	{0xC1FF8000, 0xCFFC},
-	{0x35F80200, 0x001F},
-	{0xC1FFC000, 0xCFFE},
-	{0xBE5F8000, 0xB2FC},
-	{0xC1FFE000, 0xCFFF},
-	{0xCF7FFC02, 0xFC00},
-	{0xC1FFF000, 0xD000},
-	{0xBD9709B7, 0xACB8},
-	{0xC1FFF800, 0xD000},
-	{0x38000700, 0x0200},
-	{0xC1FFFC00, 0xD000},
-	{0x7D02C5D3, 0x7C00},
-	{0xC1FFFE00, 0xD000},
-	{0x5F000BFF, 0x7C00},
-	{0xC1FFFF00, 0xD000},
-	{0xC1800020, 0xCC00},
-	{0xC1FFFF80, 0xD000},
-	{0x8AFFFFFF, 0x8000},
-	{0xC1FFFFC0, 0xD000},
-	{0x3D800003, 0x2C00},
-	{0xC1FFFFE0, 0xD000},
-	{0xC1087703, 0xC844},
-	{0xC1FFFFF0, 0xD000},
-	{0x41800200, 0x4C00},
-	{0xC1FFFFF8, 0xD000},
-	{0xAE00200F, 0x8000},
-	{0xC1FFFFFC, 0xD000},
-	{0xBFFFFBBF, 0xC000},
-	{0xC1FFFFFE, 0xD000},
-	{0x4E9A1FAC, 0x7C00},
-	{0xC1FFFFFF, 0xD000},
-	{0x40601000, 0x4300},
-	{0xC1FFFFFD, 0xD000},
-	{0x3F0FA88A, 0x387D},
-	{0xC1FFFFFB, 0xD000},
-	{0x3F81EFFF, 0x3C0F},
-	{0xC1FFFFF7, 0xD000},
-	{0x4F00023E, 0x7C00},
-	{0xC1FFFFEF, 0xD000},
-	{0x47703FFE, 0x7B82},
-	{0xC1FFFFDF, 0xD000},
-	{0xCE8003BE, 0xFC00},
-	{0xC1FFFFBF, 0xD000},
-	{0x80780020, 0x8000},
-	{0xC1FFFF7F, 0xD000},
-	{0xD17FDFEF, 0xFC00},
-	{0xC1FFFEFF, 0xD000},
-	{0x5EFEFFFC, 0x7C00},
-	{0xC1FFFDFF, 0xD000},
-	{0x33DBFFFF, 0x0002},
-	{0xC1FFFBFF, 0xD000},
-	{0xBE1E4319, 0xB0F2},
-	{0xC1FFF7FF, 0xD000},
-	{0xCF5FF7FF, 0xFC00},
-	{0xC1FFEFFF, 0xCFFF},
-	{0xB07FFF80, 0x8000},
-	{0xC1FFDFFF, 0xCFFF},
-	{0x420023FF, 0x5001},
-	{0xC1FFBFFF, 0xCFFE},
-	{0x5E7FFFFF, 0x7C00},
-	{0xC1FF7FFF, 0xCFFC},
-	{0xBEBC0000, 0xB5E0},
-	{0xC1FEFFFF, 0xCFF8},
-	{0x7E800780, 0x7C00},
-	{0xC1FDFFFF, 0xCFF0},
-	{0xB80000DF, 0x8200},
-	{0xC1FBFFFF, 0xCFE0},
-	{0x3B7FBFFF, 0x1BFE},
-	{0xC1F7FFFF, 0xCFC0},
-	{0xA0FFE03F, 0x8000},
-	{0xC1EFFFFF, 0xCF80},
-	{0x7FFFF801, 0x7FFF},
-	{0xC1DFFFFF, 0xCF00},
-	{0x41007EFF, 0x4804},
-	{0xC1BFFFFF, 0xCE00},
-	{0xCA80008F, 0xFC00},
-	{0xC19FFFFF, 0xCD00},
-	{0x7FFFBDFF, 0x7FFD},
-	{0xC18FFFFF, 0xCC80},
-	{0xC060001E, 0xC300},
-	{0xC187FFFF, 0xCC40},
-	{0xA88007FF, 0x8000},
-	{0xC183FFFF, 0xCC20},
-	{0x42E7FFFE, 0x5740},
-	{0xC181FFFF, 0xCC10},
-	{0xC37FFFFF, 0xDC00},
-	{0xC180FFFF, 0xCC08},
-	{0xCFA00080, 0xFC00},
-	{0xC1807FFF, 0xCC04},
-	{0x39200800, 0x0900},
-	{0xC1803FFF, 0xCC02},
-	{0x1A7EFFF7, 0x0000},
-	{0xC1801FFF, 0xCC01},
-	{0xBF80000D, 0xBC00},
-	{0xC1800FFF, 0xCC00},
-	{0xC3FFFFDA, 0xE000},
-	{0xC18007FF, 0xCC00},
-	{0x3A00010E, 0x1000},
-	{0xC18003FF, 0xCC00},
-	{0x4F19CC41, 0x7C00},
-	{0xC18001FF, 0xCC00},
-	{0x3D200006, 0x2900},
-	{0xC18000FF, 0xCC00},
-	{0x002FC1A6, 0x0000},
-	{0xC180007F, 0xCC00},
-	{0xC600003B, 0xF000},
-	{0xC180003F, 0xCC00},
-	{0x321FFFEF, 0x0000},
-	{0xC180001F, 0xCC00},
-	{0x488000A0, 0x7C00},
-	{0xC180000F, 0xCC00},
-	{0xBE881FFF, 0xB441},
-	{0xC1800007, 0xCC00},
-	{0x310FFFF7, 0x0000},
-	{0xC1800003, 0xCC00},
-	{0x7F6D94D8, 0x7C00},
-	{0xCB800000, 0xFC00},
-	{0x80A37BC5, 0x8000},
-	{0xCB800001, 0xFC00},
-	{0xBE33BD74, 0xB19E},
-	{0xCB800002, 0xFC00},
-	{0x5E0087FF, 0x7C00},
-	{0xCB800004, 0xFC00},
-	{0xDF800077, 0xFC00},
-	{0xCB800008, 0xFC00},
-	{0xBFEB69A5, 0xBF5B},
-	{0xCB800010, 0xFC00},
-	{0x33807DFF, 0x0001},
-	{0xCB800020, 0xFC00},
-	{0xC006B9AB, 0xC036},
-	{0xCB800040, 0xFC00},
-	{0x4E8027FF, 0x7C00},
-	{0xCB800080, 0xFC00},
-	{0x6901007F, 0x7C00},
-	{0xCB800100, 0xFC00},
-	{0x7F0001F8, 0x7C00},
-	{0xCB800200, 0xFC00},
-	{0x95DFFFFF, 0x8000},
-	{0xCB800400, 0xFC00},
-	{0x3C6FE000, 0x237F},
-	{0xCB800800, 0xFC00},
-	{0xC17F7FF8, 0xCBFC},
-	{0xCB801000, 0xFC00},
-	{0x4F801800, 0x7C00},
-	{0xCB802000, 0xFC00},
-	{0x007FF810, 0x0000},
-	{0xCB804000, 0xFC00},
-	{0xBEFFFDFE, 0xB800},
-	{0xCB808000, 0xFC00},
-	{0xB3DFFFFD, 0x8002},
-	{0xCB810000, 0xFC00},
-	{0x4E1001FF, 0x7C00},
-	{0xCB820000, 0xFC00},
-	{0xC1270C26, 0xC938},
-	{0xCB840000, 0xFC00},
-	{0x5917FFFE, 0x7C00},
-	{0xCB880000, 0xFC00},
-	{0x41800600, 0x4C00},
-	{0xCB900000, 0xFC00},
-	{0xC0FFC00F, 0xC7FE},
-	{0xCBA00000, 0xFC00},
-	{0xC1E001FF, 0xCF00},
-	{0xCBC00000, 0xFC00},
-	{0xCFFFFF7D, 0xFC00},
-	{0xCBE00000, 0xFC00},
-	{0x5FFA6E34, 0x7C00},
-	{0xCBF00000, 0xFC00},
-	{0xDF6FFFDF, 0xFC00},
-	{0xCBF80000, 0xFC00},
-	{0xBEFFEFFE, 0xB7FF},
-	{0xCBFC0000, 0xFC00},
-	{0xB380010F, 0x8001},
-	{0xCBFE0000, 0xFC00},
-	{0xB1DFFFF0, 0x8000},
-	{0xCBFF0000, 0xFC00},
-	{0xC2FFFFFF, 0xD800},
-	{0xCBFF8000, 0xFC00},
-	{0xDF3A2B41, 0xFC00},
-	{0xCBFFC000, 0xFC00},
-	{0x4F401FFF, 0x7C00},
-	{0xCBFFE000, 0xFC00},
-	{0x2559F743, 0x0000},
-	{0xCBFFF000, 0xFC00},
-	{0xBFFFF800, 0xC000},
-	{0xCBFFF800, 0xFC00},
-	{0xD3DF9B6D, 0xFC00},
-	{0xCBFFFC00, 0xFC00},
-	{0x4B8FF7FE, 0x7C00},
-	{0xCBFFFE00, 0xFC00},
-	{0xCE7FFFE0, 0xFC00},
-	{0xCBFFFF00, 0xFC00},
-	{0xCBFFE000, 0xFC00},
-	{0xCBFFFF80, 0xFC00},
-	{0x55B72B97, 0x7C00},
-	{0xCBFFFFC0, 0xFC00},
-	{0x4E110000, 0x7C00},
-	{0xCBFFFFE0, 0xFC00},
-	{0xCE90001E, 0xFC00},
-	{0xCBFFFFF0, 0xFC00},
-	{0x2588FDE3, 0x0000},
-	{0xCBFFFFF8, 0xFC00},
-	{0xCF0EF6B3, 0xFC00},
-	{0xCBFFFFFC, 0xFC00},
-	{0xDEFFFF10, 0xFC00},
-	{0xCBFFFFFE, 0xFC00},
-	{0x4159D61D, 0x4ACF},
-	{0xCBFFFFFF, 0xFC00},
-	{0x07FFF5FF, 0x0000},
-	{0xCBFFFFFD, 0xFC00},
-	{0x3F7FBF7E, 0x3BFE},
-	{0xCBFFFFFB, 0xFC00},
-	{0xC0FBE000, 0xC7DF},
-	{0xCBFFFFF7, 0xFC00},
-	{0xCF7E003E, 0xFC00},
-	{0xCBFFFFEF, 0xFC00},
-	{0xB38007FF, 0x8001},
-	{0xCBFFFFDF, 0xFC00},
-	{0xB98FFFDE, 0x8C80},
-	{0xCBFFFFBF, 0xFC00},
-	{0xBCDFFFC0, 0xA700},
-	{0xCBFFFF7F, 0xFC00},
-	{0x5DC14DCF, 0x7C00},
-	{0xCBFFFEFF, 0xFC00},
-	{0xDE700FFE, 0xFC00},
-	{0xCBFFFDFF, 0xFC00},
-	{0x7EF00000, 0x7C00},
-	{0xCBFFFBFF, 0xFC00},
-	{0x52800840, 0x7C00},
-	{0xCBFFF7FF, 0xFC00},
-	{0x3E7FE002, 0x33FF},
-	{0xCBFFEFFF, 0xFC00},
-	{0x5665E57E, 0x7C00},
-	{0xCBFFDFFF, 0xFC00},
-	{0x5683FF00, 0x7C00},
-	{0xCBFFBFFF, 0xFC00},
-	{0xFF780001, 0xFC00},
-	{0xCBFF7FFF, 0xFC00},
-	{0xB74681E6, 0x80C7},
-	{0xCBFEFFFF, 0xFC00},
-	{0x3DA00003, 0x2D00},
-	{0xCBFDFFFF, 0xFC00},
-	{0xCF000FFE, 0xFC00},
-	{0xCBFBFFFF, 0xFC00},
-	{0xBF7FFF3E, 0xBC00},
-	{0xCBF7FFFF, 0xFC00},
-	{0x45FFFDBF, 0x7000},
-	{0xCBEFFFFF, 0xFC00},
-	{0x5E323F72, 0x7C00},
-	{0xCBDFFFFF, 0xFC00},
-	{0xBCFFFFFA, 0xA800},
-	{0xCBBFFFFF, 0xFC00},
-	{0xB27FDFFA, 0x8000},
-	{0xCB9FFFFF, 0xFC00},
-	{0xB5DF8000, 0x801C},
-	{0xCB8FFFFF, 0xFC00},
-	{0x017FFDFE, 0x0000},
-	{0xCB87FFFF, 0xFC00},
-	{0x4E7FF81F, 0x7C00},
-	{0xCB83FFFF, 0xFC00},
-	{0x5F003FFE, 0x7C00},
-	{0xCB81FFFF, 0xFC00},
-	{0x520403FF, 0x7C00},
-	{0xCB80FFFF, 0xFC00},
-	{0x8077FFEF, 0x8000},
-	{0xCB807FFF, 0xFC00},
-	{0x4EFFFAFF, 0x7C00},
-	{0xCB803FFF, 0xFC00},
-	{0xBEFEFF7E, 0xB7F8},
-	{0xCB801FFF, 0xFC00},
-	{0xB957C418, 0x8ABE},
-	{0xCB800FFF, 0xFC00},
-	{0xBF000000, 0xB800},
-	{0xCB8007FF, 0xFC00},
-	{0xB05EFFFF, 0x8000},
-	{0xCB8003FF, 0xFC00},
-	{0xCBDFFFF7, 0xFC00},
-	{0xCB8001FF, 0xFC00},
-	{0x4E02515B, 0x7C00},
-	{0xCB8000FF, 0xFC00},
-	{0xFF000004, 0xFC00},
-	{0xCB80007F, 0xFC00},
-	{0xFF4EA65E, 0xFC00},
-	{0xCB80003F, 0xFC00},
-	{0x4B5A15F4, 0x7C00},
-	{0xCB80001F, 0xFC00},
-	{0xC1DFBFFE, 0xCEFE},
-	{0xCB80000F, 0xFC00},
-	{0x4F80100F, 0x7C00},
-	{0xCB800007, 0xFC00},
-	{0x3D9DFFFF, 0x2CF0},
-	{0xCB800003, 0xFC00},
-	{0x00201181, 0x0000},
-	{0xCE000000, 0xFC00},
-	{0xD0FF7FEF, 0xFC00},
-	{0xCE000001, 0xFC00},
-	{0x4B7FEBFE, 0x7C00},
-	{0xCE000002, 0xFC00},
-	{0x3CF80001, 0x27C0},
-	{0xCE000004, 0xFC00},
-	{0x3DF7FEFE, 0x2FC0},
-	{0xCE000008, 0xFC00},
-	{0xDEFFE1FF, 0xFC00},
-	{0xCE000010, 0xFC00},
-	{0x930800FF, 0x8000},
-	{0xCE000020, 0xFC00},
-	{0x3FFFFCFF, 0x4000},
-	{0xCE000040, 0xFC00},
-	{0x4E878000, 0x7C00},
-	{0xCE000080, 0xFC00},
-	{0xE100800F, 0xFC00},
-	{0xCE000100, 0xFC00},
-	{0xBFFBFFFA, 0xBFE0},
-	{0xCE000200, 0xFC00},
-	{0x339C358B, 0x0001},
-	{0xCE000400, 0xFC00},
-	{0x33FFFFFE, 0x0002},
-	{0xCE000800, 0xFC00},
-	{0x88111E4B, 0x8000},
-	{0xCE001000, 0xFC00},
-	{0xFEB1AFFE, 0xFC00},
-	{0xCE002000, 0xFC00},
-	{0x4758320C, 0x7AC2},
-	{0xCE004000, 0xFC00},
-	{0xCA800000, 0xFC00},
-	{0xCE008000, 0xFC00},
-	{0x407FFFC4, 0x4400},
-	{0xCE010000, 0xFC00},
-	{0xB8B5268A, 0x85A9},
-	{0xCE020000, 0xFC00},
-	{0x4DF8000F, 0x7C00},
-	{0xCE040000, 0xFC00},
-	{0x7F5A2933, 0x7C00},
-	{0xCE080000, 0xFC00},
-	{0xC481FFFD, 0xE410},
-	{0xCE100000, 0xFC00},
-	{0x80F7FFEF, 0x8000},
-	{0xCE200000, 0xFC00},
-	{0x42804010, 0x5402},
-	{0xCE400000, 0xFC00},
-	{0x40FEFFFD, 0x47F8},
-	{0xCE600000, 0xFC00},
-	{0xFF020001, 0xFC00},
-	{0xCE700000, 0xFC00},
-	{0x29604000, 0x0000},
-	{0xCE780000, 0xFC00},
-	{0xFF7FFB7F, 0xFC00},
-	{0xCE7C0000, 0xFC00},
-	{0xDEAF23AC, 0xFC00},
-	{0xCE7E0000, 0xFC00},
-	{0xC17C000F, 0xCBE0},
-	{0xCE7F0000, 0xFC00},
-	{0xCFFE7FFE, 0xFC00},
-	{0xCE7F8000, 0xFC00},
-	{0xB3E00010, 0x8002},
-	{0xCE7FC000, 0xFC00},
-	{0x407FFF82, 0x4400},
-	{0xCE7FE000, 0xFC00},
-	{0x4F7807FE, 0x7C00},
-	{0xCE7FF000, 0xFC00},
-	{0xC1E07FFF, 0xCF04},
-	{0xCE7FF800, 0xFC00},
-	{0xC2808002, 0xD404},
-	{0xCE7FFC00, 0xFC00},
-	{0x400FFFFC, 0x4080},
-	{0xCE7FFE00, 0xFC00},
-	{0xC800087F, 0xFC00},
-	{0xCE7FFF00, 0xFC00},
-	{0x4FEFFFFE, 0x7C00},
-	{0xCE7FFF80, 0xFC00},
-	{0xBC7FFD7F, 0xA400},
-	{0xCE7FFFC0, 0xFC00},
-	{0xCEFBEFFF, 0xFC00},
-	{0xCE7FFFE0, 0xFC00},
-	{0x4000000B, 0x4000},
-	{0xCE7FFFF0, 0xFC00},
-	{0xBE802007, 0xB401},
-	{0xCE7FFFF8, 0xFC00},
-	{0xC0EFFBFF, 0xC780},
-	{0xCE7FFFFC, 0xFC00},
-	{0x3D7C0FFE, 0x2BE0},
-	{0xCE7FFFFE, 0xFC00},
-	{0xFFFEFFF7, 0xFFF7},
-	{0xCE7FFFFF, 0xFC00},
-	{0x80D08213, 0x8000},
-	{0xCE7FFFFD, 0xFC00},
-	{0xC77FDFFE, 0xFBFF},
-	{0xCE7FFFFB, 0xFC00},
-	{0x4EFF1FFF, 0x7C00},
-	{0xCE7FFFF7, 0xFC00},
-	{0x3E7FFFFB, 0x3400},
-	{0xCE7FFFEF, 0xFC00},
-	{0x5823757A, 0x7C00},
-	{0xCE7FFFDF, 0xFC00},
-	{0x5F800210, 0x7C00},
-	{0xCE7FFFBF, 0xFC00},
-	{0x397FDEFE, 0x0BFF},
-	{0xCE7FFF7F, 0xFC00},
-	{0xB9002020, 0x8801},
-	{0xCE7FFEFF, 0xFC00},
-	{0xC9B7FFFE, 0xFC00},
-	{0xCE7FFDFF, 0xFC00},
-	{0xBC81FFF0, 0xA410},
-	{0xCE7FFBFF, 0xFC00},
-	{0x3E83FBFE, 0x3420},
-	{0xCE7FF7FF, 0xFC00},
-	{0x3C8C0000, 0x2460},
-	{0xCE7FEFFF, 0xFC00},
-	{0xB87DFFFE, 0x83F8},
-	{0xCE7FDFFF, 0xFC00},
-	{0x33807407, 0x0001},
-	{0xCE7FBFFF, 0xFC00},
-	{0x4496E39E, 0x64B7},
-	{0xCE7F7FFF, 0xFC00},
-	{0x3B7FFFE7, 0x1C00},
-	{0xCE7EFFFF, 0xFC00},
-	{0xDF0003F7, 0xFC00},
-	{0xCE7DFFFF, 0xFC00},
-	{0xC061FE9A, 0xC310},
-	{0xCE7BFFFF, 0xFC00},
-	{0x80A00400, 0x8000},
-	{0xCE77FFFF, 0xFC00},
-	{0x33800001, 0x0001},
-	{0xCE6FFFFF, 0xFC00},
-	{0x3387FE00, 0x0001},
-	{0xCE5FFFFF, 0xFC00},
-	{0x4FFFF01E, 0x7C00},
-	{0xCE3FFFFF, 0xFC00},
-	{0xC07FFFDB, 0xC400},
-	{0xCE1FFFFF, 0xFC00},
-	{0xBEFF007F, 0xB7F8},
-	{0xCE0FFFFF, 0xFC00},
-	{0xDE7FF1FF, 0xFC00},
-	{0xCE07FFFF, 0xFC00},
-	{0xFEA04327, 0xFC00},
-	{0xCE03FFFF, 0xFC00},
-	{0x5F00107E, 0x7C00},
-	{0xCE01FFFF, 0xFC00},
-	{0xBEFFFEDE, 0xB800},
-	{0xCE00FFFF, 0xFC00},
-	{0xCE900FFF, 0xFC00},
-	{0xCE007FFF, 0xFC00},
-	{0x38FFFFFF, 0x0800},
-	{0xCE003FFF, 0xFC00},
-	{0xC0FFFFC0, 0xC800},
-	{0xCE001FFF, 0xFC00},
-	{0x7FED6A09, 0x7F6B},
-	{0xCE000FFF, 0xFC00},
-	{0x3A80801E, 0x1404},
-	{0xCE0007FF, 0xFC00},
-	{0x00000403, 0x0000},
-	{0xCE0003FF, 0xFC00},
-	{0x4F800402, 0x7C00},
-	{0xCE0001FF, 0xFC00},
-	{0x4E00FEFE, 0x7C00},
-	{0xCE0000FF, 0xFC00},
-	{0xCE7F0800, 0xFC00},
-	{0xCE00007F, 0xFC00},
-	{0xBFFC07FF, 0xBFE0},
-	{0xCE00003F, 0xFC00},
-	{0xBF7FFFFF, 0xBC00},
-	{0xCE00001F, 0xFC00},
-	{0xDE81007F, 0xFC00},
-	{0xCE00000F, 0xFC00},
-	{0x3F7D90F4, 0x3BED},
-	{0xCE000007, 0xFC00},
-	{0xC77A2931, 0xFBD1},
-	{0xCE000003, 0xFC00},
-	{0x807E1FFE, 0x8000},
-	{0xCE800000, 0xFC00},
-	{0xC0BAC233, 0xC5D6},
-	{0xCE800001, 0xFC00},
-	{0x337FE3FE, 0x0001},
-	{0xCE800002, 0xFC00},
-	{0xC1780003, 0xCBC0},
-	{0xCE800004, 0xFC00},
-	{0x806003FE, 0x8000},
-	{0xCE800008, 0xFC00},
-	{0xDF7EFFFF, 0xFC00},
-	{0xCE800010, 0xFC00},
-	{0x3E6BB32C, 0x335E},
-	{0xCE800020, 0xFC00},
-	{0x7FEFFFFC, 0x7F7F},
-	{0xCE800040, 0xFC00},
-	{0x83F9F55C, 0x8000},
-	{0xCE800080, 0xFC00},
-	{0x4F7FFFFF, 0x7C00},
-	{0xCE800100, 0xFC00},
-	{0xD941FFFE, 0xFC00},
-	{0xCE800200, 0xFC00},
-	{0x442A5AC5, 0x6153},
-	{0xCE800400, 0xFC00},
-	{0x40FFFF1E, 0x4800},
-	{0xCE800800, 0xFC00},
-	{0x8131D205, 0x8000},
-	{0xCE801000, 0xFC00},
-	{0xCF42393A, 0xFC00},
-	{0xCE802000, 0xFC00},
-	{0xCC4F67AD, 0xFC00},
-	{0xCE804000, 0xFC00},
-	{0xA6C0001E, 0x8000},
-	{0xCE808000, 0xFC00},
-	{0x010400FE, 0x0000},
-	{0xCE810000, 0xFC00},
-	{0xC07FFF08, 0xC400},
-	{0xCE820000, 0xFC00},
-	{0xCB83F7FF, 0xFC00},
-	{0xCE840000, 0xFC00},
-	{0xBD800000, 0xAC00},
-	{0xCE880000, 0xFC00},
-	{0xBC780FFE, 0xA3C0},
-	{0xCE900000, 0xFC00},
-	{0xCEFFFD80, 0xFC00},
-	{0xCEA00000, 0xFC00},
-	{0x41001007, 0x4801},
-	{0xCEC00000, 0xFC00},
-	{0xCBFF0080, 0xFC00},
-	{0xCEE00000, 0xFC00},
-	{0xBF6E0AD9, 0xBB70},
-	{0xCEF00000, 0xFC00},
-	{0xDF7F7FFC, 0xFC00},
-	{0xCEF80000, 0xFC00},
-	{0xBEFFFFFF, 0xB800},
-	{0xCEFC0000, 0xFC00},
-	{0x4E7BFFFB, 0x7C00},
-	{0xCEFE0000, 0xFC00},
-	{0x80FFEFF8, 0x8000},
-	{0xCEFF0000, 0xFC00},
-	{0xE7FF8002, 0xFC00},
-	{0xCEFF8000, 0xFC00},
-	{0xBE7FFFFF, 0xB400},
-	{0xCEFFC000, 0xFC00},
-	{0x0002FFFF, 0x0000},
-	{0xCEFFE000, 0xFC00},
-	{0x41B6C115, 0x4DB6},
-	{0xCEFFF000, 0xFC00},
-	{0xDF700FFF, 0xFC00},
-	{0xCEFFF800, 0xFC00},
-	{0x808F80C2, 0x8000},
-	{0xCEFFFC00, 0xFC00},
-	{0x3F00043F, 0x3800},
-	{0xCEFFFE00, 0xFC00},
-	{0xDEFBB8C4, 0xFC00},
-	{0xCEFFFF00, 0xFC00},
-	{0xC0FFF81F, 0xC800},
-	{0xCEFFFF80, 0xFC00},
-	{0x030047FF, 0x0000},
-	{0xCEFFFFC0, 0xFC00},
-	{0xBF80FF80, 0xBC08},
-	{0xCEFFFFE0, 0xFC00},
-	{0x7BDFEFFF, 0x7C00},
-	{0xCEFFFFF0, 0xFC00},
-	{0x01040008, 0x0000},
-	{0xCEFFFFF8, 0xFC00},
-	{0x3F66361F, 0x3B32},
-	{0xCEFFFFFC, 0xFC00},
-	{0x230002FF, 0x0000},
-	{0xCEFFFFFE, 0xFC00},
-	{0x38780FFF, 0x03E0},
-	{0xCEFFFFFF, 0xFC00},
-	{0x27068C98, 0x0000},
-	{0xCEFFFFFD, 0xFC00},
-	{0x43E01FFF, 0x5F01},
-	{0xCEFFFFFB, 0xFC00},
-	{0x3F3FC000, 0x39FE},
-	{0xCEFFFFF7, 0xFC00},
-	{0x41BF66A5, 0x4DFB},
-	{0xCEFFFFEF, 0xFC00},
-	{0xED7FFF80, 0xFC00},
-	{0xCEFFFFDF, 0xFC00},
-	{0xCBFF00FE, 0xFC00},
-	{0xCEFFFFBF, 0xFC00},
-	{0xDF7FDFFA, 0xFC00},
-	{0xCEFFFF7F, 0xFC00},
-	{0x3E511BDD, 0x3289},
-	{0xCEFFFEFF, 0xFC00},
-	{0x4B400001, 0x7C00},
-	{0xCEFFFDFF, 0xFC00},
-	{0xEF5FFF80, 0xFC00},
-	{0xCEFFFBFF, 0xFC00},
-	{0xA1EA686A, 0x8000},
-	{0xCEFFF7FF, 0xFC00},
-	{0x53FFC008, 0x7C00},
-	{0xCEFFEFFF, 0xFC00},
-	{0xFF80003C, 0xFE00},
-	{0xCEFFDFFF, 0xFC00},
-	{0x400FFFFD, 0x4080},
-	{0xCEFFBFFF, 0xFC00},
-	{0x447BFFFE, 0x63E0},
-	{0xCEFF7FFF, 0xFC00},
-	{0x7B807FEE, 0x7C00},
-	{0xCEFEFFFF, 0xFC00},
-	{0x1BFFFFF6, 0x0000},
-	{0xCEFDFFFF, 0xFC00},
-	{0x3CFF7C00, 0x27FC},
-	{0xCEFBFFFF, 0xFC00},
-	{0xB3F80000, 0x8002},
-	{0xCEF7FFFF, 0xFC00},
-	{0x40F8003F, 0x47C0},
-	{0xCEEFFFFF, 0xFC00},
-	{0x002FFA49, 0x0000},
-	{0xCEDFFFFF, 0xFC00},
-	{0xC7F9BE05, 0xFC00},
-	{0xCEBFFFFF, 0xFC00},
-	{0x40FFFE0F, 0x4800},
-	{0xCE9FFFFF, 0xFC00},
-	{0xB9FFFFFF, 0x9000},
-	{0xCE8FFFFF, 0xFC00},
-	{0x80A7DE18, 0x8000},
-	{0xCE87FFFF, 0xFC00},
-	{0x5E800E00, 0x7C00},
-	{0xCE83FFFF, 0xFC00},
-	{0xCEFFF81F, 0xFC00},
-	{0xCE81FFFF, 0xFC00},
-	{0x1E000010, 0x0000},
-	{0xCE80FFFF, 0xFC00},
-	{0xC1AE7124, 0xCD74},
-	{0xCE807FFF, 0xFC00},
-	{0xBC7FFF70, 0xA400},
-	{0xCE803FFF, 0xFC00},
-	{0x01003FF7, 0x0000},
-	{0xCE801FFF, 0xFC00},
-	{0x7F68F649, 0x7C00},
-	{0xCE800FFF, 0xFC00},
-	{0xFEBFF000, 0xFC00},
-	{0xCE8007FF, 0xFC00},
-	{0xBDE1EF48, 0xAF0F},
-	{0xCE8003FF, 0xFC00},
-	{0x43690F1A, 0x5B48},
-	{0xCE8001FF, 0xFC00},
-	{0xDF053EF8, 0xFC00},
-	{0xCE8000FF, 0xFC00},
-	{0x884470BA, 0x8000},
-	{0xCE80007F, 0xFC00},
-	{0x808103FE, 0x8000},
-	{0xCE80003F, 0xFC00},
-	{0x3C80040E, 0x2400},
-	{0xCE80001F, 0xFC00},
-	{0x3E65B3DE, 0x332E},
-	{0xCE80000F, 0xFC00},
-	{0xCE7FBFF0, 0xFC00},
-	{0xCE800007, 0xFC00},
-	{0xB8803000, 0x8402},
-	{0xCE800003, 0xFC00},
-	{0x3E800036, 0x3400},
-	{0xCF000000, 0xFC00},
-	{0x5FA00006, 0x7C00},
-	{0xCF000001, 0xFC00},
-	{0x47FFBFF6, 0x7C00},
-	{0xCF000002, 0xFC00},
-	{0x8081FF7E, 0x8000},
-	{0xCF000004, 0xFC00},
-	{0x4FFDFFFA, 0x7C00},
-	{0xCF000008, 0xFC00},
-	{0x41EFFF7F, 0x4F80},
-	{0xCF000010, 0xFC00},
-	{0x5480003E, 0x7C00},
-	{0xCF000020, 0xFC00},
-	{0xC07F826D, 0xC3FC},
-	{0xCF000040, 0xFC00},
-	{0xBFBDFFFE, 0xBDF0},
-	{0xCF000080, 0xFC00},
-	{0xBEE001FF, 0xB700},
-	{0xCF000100, 0xFC00},
-	{0x33FFBEFE, 0x0002},
-	{0xCF000200, 0xFC00},
-	{0xCF7F8000, 0xFC00},
-	{0xCF000400, 0xFC00},
-	{0x3F803FF7, 0x3C02},
-	{0xCF000800, 0xFC00},
-	{0xBF001F80, 0xB801},
-	{0xCF001000, 0xFC00},
-	{0x80FFFFBE, 0x8000},
-	{0xCF002000, 0xFC00},
-	{0x4E78007F, 0x7C00},
-	{0xCF004000, 0xFC00},
-	{0x801A49A4, 0x8000},
-	{0xCF008000, 0xFC00},
-	{0x3F00FFFB, 0x3808},
-	{0xCF010000, 0xFC00},
-	{0x017B8000, 0x0000},
-	{0xCF020000, 0xFC00},
-	{0xBF7FE3FF, 0xBBFF},
-	{0xCF040000, 0xFC00},
-	{0xB38007FB, 0x8001},
-	{0xCF080000, 0xFC00},
-	{0xFEFE0008, 0xFC00},
-	{0xCF100000, 0xFC00},
-	{0x4200004F, 0x5000},
-	{0xCF200000, 0xFC00},
-	{0xBD80010F, 0xAC00},
-	{0xCF400000, 0xFC00},
-	{0x04400004, 0x0000},
-	{0xCF600000, 0xFC00},
-	{0x38A160CC, 0x050B},
-	{0xCF700000, 0xFC00},
-	{0x44000FFF, 0x6000},
-	{0xCF780000, 0xFC00},
-	{0x5F51E83A, 0x7C00},
-	{0xCF7C0000, 0xFC00},
-	{0x3B841FFE, 0x1C21},
-	{0xCF7E0000, 0xFC00},
-	{0xBEB9976D, 0xB5CD},
-	{0xCF7F0000, 0xFC00},
-	{0xCEFF07FF, 0xFC00},
-	{0xCF7F8000, 0xFC00},
-	{0xF7FE001F, 0xFC00},
-	{0xCF7FC000, 0xFC00},
-	{0x3DFFFD7F, 0x3000},
-	{0xCF7FE000, 0xFC00},
-	{0xCE13AA34, 0xFC00},
-	{0xCF7FF000, 0xFC00},
-	{0xB7FFE7FF, 0x8200},
-	{0xCF7FF800, 0xFC00},
-	{0xBF4C4713, 0xBA62},
-	{0xCF7FFC00, 0xFC00},
-	{0xB3EFFFEF, 0x8002},
-	{0xCF7FFE00, 0xFC00},
-	{0x57800120, 0x7C00},
-	{0xCF7FFF00, 0xFC00},
-	{0x43780FFF, 0x5BC0},
-	{0xCF7FFF80, 0xFC00},
-	{0x7E811FFF, 0x7C00},
-	{0xCF7FFFC0, 0xFC00},
-	{0x80F29F2E, 0x8000},
-	{0xCF7FFFE0, 0xFC00},
-	{0xC17FFFDF, 0xCC00},
-	{0xCF7FFFF0, 0xFC00},
-	{0x3FFFFC00, 0x4000},
-	{0xCF7FFFF8, 0xFC00},
-	{0xCF7FF020, 0xFC00},
-	{0xCF7FFFFC, 0xFC00},
-	{0x0201FDFF, 0x0000},
-	{0xCF7FFFFE, 0xFC00},
-	{0xF79D7DC0, 0xFC00},
-	{0xCF7FFFFF, 0xFC00},
-	{0xB58027FF, 0x8010},
-	{0xCF7FFFFD, 0xFC00},
-	{0x3E800000, 0x3400},
-	{0xCF7FFFFB, 0xFC00},
-	{0x437F7F80, 0x5BFC},
-	{0xCF7FFFF7, 0xFC00},
-	{0xFE85D77A, 0xFC00},
-	{0xCF7FFFEF, 0xFC00},
-	{0xCE3921A5, 0xFC00},
-	{0xCF7FFFDF, 0xFC00},
-	{0xAC0083FF, 0x8000},
-	{0xCF7FFFBF, 0xFC00},
-	{0x016DFFFF, 0x0000},
-	{0xCF7FFF7F, 0xFC00},
-	{0xBF87FFFF, 0xBC40},
-	{0xCF7FFEFF, 0xFC00},
-	{0x017FFEFD, 0x0000},
-	{0xCF7FFDFF, 0xFC00},
-	{0xC47C0020, 0xE3E0},
-	{0xCF7FFBFF, 0xFC00},
-	{0x3D040007, 0x2820},
-	{0xCF7FF7FF, 0xFC00},
-	{0x3A8FFFEE, 0x1480},
-	{0xCF7FEFFF, 0xFC00},
-	{0xCFA064D9, 0xFC00},
-	{0xCF7FDFFF, 0xFC00},
-	{0xBEEFC000, 0xB77E},
-	{0xCF7FBFFF, 0xFC00},
-	{0xCF1007FE, 0xFC00},
-	{0xCF7F7FFF, 0xFC00},
-	{0x3CFDEFFF, 0x27EF},
-	{0xCF7EFFFF, 0xFC00},
-	{0x00FFFFD0, 0x0000},
-	{0xCF7DFFFF, 0xFC00},
-	{0xC1FFFC1F, 0xD000},
-	{0xCF7BFFFF, 0xFC00},
-	{0xDC066B25, 0xFC00},
-	{0xCF77FFFF, 0xFC00},
-	{0xDD81FF7F, 0xFC00},
-	{0xCF6FFFFF, 0xFC00},
-	{0xBE800200, 0xB400},
-	{0xCF5FFFFF, 0xFC00},
-	{0xB007E000, 0x8000},
-	{0xCF3FFFFF, 0xFC00},
-	{0xC00003F8, 0xC000},
-	{0xCF1FFFFF, 0xFC00},
-	{0x40A0003E, 0x4500},
-	{0xCF0FFFFF, 0xFC00},
-	{0x492DBC6B, 0x7C00},
-	{0xCF07FFFF, 0xFC00},
-	{0x3ECFCA1B, 0x367E},
-	{0xCF03FFFF, 0xFC00},
-	{0x4F7BFFDF, 0x7C00},
-	{0xCF01FFFF, 0xFC00},
-	{0xBD8047FE, 0xAC02},
-	{0xCF00FFFF, 0xFC00},
-	{0xBFFC1FFE, 0xBFE1},
-	{0xCF007FFF, 0xFC00},
-	{0x7FFFDFBF, 0x7FFE},
-	{0xCF003FFF, 0xFC00},
-	{0x810000BF, 0x8000},
-	{0xCF001FFF, 0xFC00},
-	{0x3E7A0000, 0x33D0},
-	{0xCF000FFF, 0xFC00},
-	{0xCE94B798, 0xFC00},
-	{0xCF0007FF, 0xFC00},
-	{0xCCE9324D, 0xFC00},
-	{0xCF0003FF, 0xFC00},
-	{0x49007FEF, 0x7C00},
-	{0xCF0001FF, 0xFC00},
-	{0xBAFDFFDF, 0x97F0},
-	{0xCF0000FF, 0xFC00},
-	{0x3A7FC0FF, 0x13FE},
-	{0xCF00007F, 0xFC00},
-	{0xC4880FFE, 0xE440},
-	{0xCF00003F, 0xFC00},
-	{0x4F800207, 0x7C00},
-	{0xCF00001F, 0xFC00},
-	{0xC373B315, 0xDB9E},
-	{0xCF00000F, 0xFC00},
-	{0x4BEDB8DF, 0x7C00},
-	{0xCF000007, 0xFC00},
-	{0x4071FFFF, 0x4390},
-	{0xCF000003, 0xFC00},
-	{0x20FFEFFF, 0x0000},
-	{0xCF800000, 0xFC00},
-	{0xC1FE003E, 0xCFF0},
-	{0xCF800001, 0xFC00},
-	{0xE9C6C5D1, 0xFC00},
-	{0xCF800002, 0xFC00},
-	{0x81007EFE, 0x8000},
-	{0xCF800004, 0xFC00},
-	{0xBF7FFFFF, 0xBC00},
-	{0xCF800008, 0xFC00},
-	{0x42D6ADFB, 0x56B5},
-	{0xCF800010, 0xFC00},
-	{0x3C120000, 0x2090},
-	{0xCF800020, 0xFC00},
-	{0x0A00020F, 0x0000},
-	{0xCF800040, 0xFC00},
-	{0xC134A724, 0xC9A5},
-	{0xCF800080, 0xFC00},
-	{0xFF1D7BC3, 0xFC00},
-	{0xCF800100, 0xFC00},
-	{0xCE790000, 0xFC00},
-	{0xCF800200, 0xFC00},
-	{0xBB800044, 0x9C00},
-	{0xCF800400, 0xFC00},
-	{0xFF79FE7D, 0xFC00},
-	{0xCF800800, 0xFC00},
-	{0xB2FF7FBF, 0x8000},
-	{0xCF801000, 0xFC00},
-	{0xB384CB4D, 0x8001},
-	{0xCF802000, 0xFC00},
-	{0x3E80002F, 0x3400},
-	{0xCF804000, 0xFC00},
-	{0x0080083F, 0x0000},
-	{0xCF808000, 0xFC00},
-	{0x37402000, 0x00C0},
-	{0xCF810000, 0xFC00},
-	{0x41AF459E, 0x4D7A},
-	{0xCF820000, 0xFC00},
-	{0x3DFFFE04, 0x3000},
-	{0xCF840000, 0xFC00},
-	{0x40000000, 0x4000},
-	{0xCF880000, 0xFC00},
-	{0x5E7FFFF6, 0x7C00},
-	{0xCF900000, 0xFC00},
-	{0xC000002F, 0xC000},
-	{0xCFA00000, 0xFC00},
-	{0xFD6A5EED, 0xFC00},
-	{0xCFC00000, 0xFC00},
-	{0xC0814000, 0xC40A},
-	{0xCFE00000, 0xFC00},
-	{0x6EFFEFFB, 0x7C00},
-	{0xCFF00000, 0xFC00},
-	{0xFF00BFFF, 0xFC00},
-	{0xCFF80000, 0xFC00},
-	{0xE07FFDF7, 0xFC00},
-	{0xCFFC0000, 0xFC00},
-	{0xBBC78961, 0x9E3C},
-	{0xCFFE0000, 0xFC00},
-	{0x3F9003FF, 0x3C80},
-	{0xCFFF0000, 0xFC00},
-	{0xFE81DFFE, 0xFC00},
-	{0xCFFF8000, 0xFC00},
-	{0x22603FFE, 0x0000},
-	{0xCFFFC000, 0xFC00},
-	{0xFF5FFF7E, 0xFC00},
-	{0xCFFFE000, 0xFC00},
-	{0x3C40B3DC, 0x2206},
-	{0xCFFFF000, 0xFC00},
-	{0xC0FBFFF7, 0xC7E0},
-	{0xCFFFF800, 0xFC00},
-	{0x5F4C69E9, 0x7C00},
-	{0xCFFFFC00, 0xFC00},
-	{0xBF81FF80, 0xBC10},
-	{0xCFFFFE00, 0xFC00},
-	{0x2821AA64, 0x0000},
-	{0xCFFFFF00, 0xFC00},
-	{0x80E07FFE, 0x8000},
-	{0xCFFFFF80, 0xFC00},
-	{0xA3FE003F, 0x8000},
-	{0xCFFFFFC0, 0xFC00},
-	{0x01007FFF, 0x0000},
-	{0xCFFFFFE0, 0xFC00},
-	{0x3FF0D61F, 0x3F87},
-	{0xCFFFFFF0, 0xFC00},
-	{0xCA6FFFF7, 0xFC00},
-	{0xCFFFFFF8, 0xFC00},
-	{0x3FFE00FF, 0x3FF0},
-	{0xCFFFFFFC, 0xFC00},
-	{0xDF044BB9, 0xFC00},
-	{0xCFFFFFFE, 0xFC00},
-	{0xBE8B7943, 0xB45C},
-	{0xCFFFFFFF, 0xFC00},
-	{0xFF040100, 0xFC00},
-	{0xCFFFFFFD, 0xFC00},
-	{0x4EFA29D9, 0x7C00},
-	{0xCFFFFFFB, 0xFC00},
-	{0x80003FF8, 0x8000},
-	{0xCFFFFFF7, 0xFC00},
-	{0xBE8103FF, 0xB408},
-	{0xCFFFFFEF, 0xFC00},
-	{0xBDFE4000, 0xAFF2},
-	{0xCFFFFFDF, 0xFC00},
-	{0xB77E7FFF, 0x80FE},
-	{0xCFFFFFBF, 0xFC00},
-	{0x7EF7FBFF, 0x7C00},
-	{0xCFFFFF7F, 0xFC00},
-	{0xCF80103E, 0xFC00},
-	{0xCFFFFEFF, 0xFC00},
-	{0x4FFFFE02, 0x7C00},
-	{0xCFFFFDFF, 0xFC00},
-	{0x3FBFBFFF, 0x3DFE},
-	{0xCFFFFBFF, 0xFC00},
-	{0xDBFFFEFB, 0xFC00},
-	{0xCFFFF7FF, 0xFC00},
-	{0x7F60000E, 0x7C00},
-	{0xCFFFEFFF, 0xFC00},
-	{0x3FFFFA00, 0x4000},
-	{0xCFFFDFFF, 0xFC00},
-	{0x4A00010F, 0x7C00},
-	{0xCFFFBFFF, 0xFC00},
-	{0xCBA638B5, 0xFC00},
-	{0xCFFF7FFF, 0xFC00},
-	{0xBF2BD71B, 0xB95F},
-	{0xCFFEFFFF, 0xFC00},
-	{0x6DEFFFF0, 0x7C00},
-	{0xCFFDFFFF, 0xFC00},
-	{0x3F06A844, 0x3835},
-	{0xCFFBFFFF, 0xFC00},
-	{0x4B8081FF, 0x7C00},
-	{0xCFF7FFFF, 0xFC00},
-	{0x9AFFFFC1, 0x8000},
-	{0xCFEFFFFF, 0xFC00},
-	{0xA2B595E4, 0x8000},
-	{0xCFDFFFFF, 0xFC00},
-	{0x3FD6DF77, 0x3EB7},
-	{0xCFBFFFFF, 0xFC00},
-	{0xBCFFF7DE, 0xA800},
-	{0xCF9FFFFF, 0xFC00},
-	{0xDE8BBAFD, 0xFC00},
-	{0xCF8FFFFF, 0xFC00},
-	{0xC7BB89D8, 0xFC00},
-	{0xCF87FFFF, 0xFC00},
-	{0xBD7EFFC0, 0xABF8},
-	{0xCF83FFFF, 0xFC00},
-	{0x43004007, 0x5802},
-	{0xCF81FFFF, 0xFC00},
-	{0xCF8007FF, 0xFC00},
-	{0xCF80FFFF, 0xFC00},
-	{0x7F801BFE, 0x7E00},
-	{0xCF807FFF, 0xFC00},
-	{0x7FE00000, 0x7F00},
-	{0xCF803FFF, 0xFC00},
-	{0xBF017FFF, 0xB80C},
-	{0xCF801FFF, 0xFC00},
-	{0xB8FDFFEE, 0x87F0},
-	{0xCF800FFF, 0xFC00},
-	{0x5E7F8000, 0x7C00},
-	{0xCF8007FF, 0xFC00},
-	{0x5E8FFFFF, 0x7C00},
-	{0xCF8003FF, 0xFC00},
-	{0xA7882968, 0x8000},
-	{0xCF8001FF, 0xFC00},
-	{0x3DFFFFFF, 0x3000},
-	{0xCF8000FF, 0xFC00},
-	{0x3C600001, 0x2300},
-	{0xCF80007F, 0xFC00},
-	{0xFF7DEFFF, 0xFC00},
-	{0xCF80003F, 0xFC00},
-	{0x3D8D3B7C, 0x2C6A},
-	{0xCF80001F, 0xFC00},
-	{0xCEBC9371, 0xFC00},
-	{0xCF80000F, 0xFC00},
-	{0x8AFDFF7F, 0x8000},
-	{0xCF800007, 0xFC00},
-	{0x80291B5D, 0x8000},
-	{0xCF800003, 0xFC00},
-	{0x4080407E, 0x4402},
-	{0xDE000000, 0xFC00},
-	{0xAD1FFFFD, 0x8000},
-	{0xDE000001, 0xFC00},
-	{0x4BFFE020, 0x7C00},
-	{0xDE000002, 0xFC00},
-	{0xBC000100, 0xA000},
-	{0xDE000004, 0xFC00},
-	{0x407F3FFF, 0x43FA},
-	{0xDE000008, 0xFC00},
-	{0x9D80017E, 0x8000},
-	{0xDE000010, 0xFC00},
-	{0xC37FFFE1, 0xDC00},
-	{0xDE000020, 0xFC00},
-	{0xF5A83C50, 0xFC00},
-	{0xDE000040, 0xFC00},
-	{0xC13ACF8E, 0xC9D6},
-	{0xDE000080, 0xFC00},
-	{0x105E914C, 0x0000},
-	{0xDE000100, 0xFC00},
-	{0xAE2CC898, 0x8000},
-	{0xDE000200, 0xFC00},
-	{0xBDFFFFF9, 0xB000},
-	{0xDE000400, 0xFC00},
-	{0xBFFFE000, 0xBFFF},
-	{0xDE000800, 0xFC00},
-	{0x49957D32, 0x7C00},
-	{0xDE001000, 0xFC00},
-	{0xB27FDFFE, 0x8000},
-	{0xDE002000, 0xFC00},
-	{0x5320007F, 0x7C00},
-	{0xDE004000, 0xFC00},
-	{0xC1000F7F, 0xC800},
-	{0xDE008000, 0xFC00},
-	{0xC17FF87E, 0xCC00},
-	{0xDE010000, 0xFC00},
-	{0xC0801007, 0xC401},
-	{0xDE020000, 0xFC00},
-	{0x41C007FF, 0x4E00},
-	{0xDE040000, 0xFC00},
-	{0x8079FFFE, 0x8000},
-	{0xDE080000, 0xFC00},
-	{0xC080200F, 0xC401},
-	{0xDE100000, 0xFC00},
-	{0x43DE0000, 0x5EF0},
-	{0xDE200000, 0xFC00},
-	{0x16FFBF7F, 0x0000},
-	{0xDE400000, 0xFC00},
-	{0xDF7FFFFF, 0xFC00},
-	{0xDE600000, 0xFC00},
-	{0xC19FBFFF, 0xCCFE},
-	{0xDE700000, 0xFC00},
-	{0x4C7FFFBD, 0x7C00},
-	{0xDE780000, 0xFC00},
-	{0x01003EFF, 0x0000},
-	{0xDE7C0000, 0xFC00},
-	{0xBF7FE0FF, 0xBBFF},
-	{0xDE7E0000, 0xFC00},
-	{0x38CCAC21, 0x0665},
-	{0xDE7F0000, 0xFC00},
-	{0x4F8003EF, 0x7C00},
-	{0xDE7F8000, 0xFC00},
-	{0xD587FFFD, 0xFC00},
-	{0xDE7FC000, 0xFC00},
-	{0xC203FFDF, 0xD020},
-	{0xDE7FE000, 0xFC00},
-	{0x4F700FFE, 0x7C00},
-	{0xDE7FF000, 0xFC00},
-	{0x7FC01FFF, 0x7E00},
-	{0xDE7FF800, 0xFC00},
-	{0xA58FF800, 0x8000},
-	{0xDE7FFC00, 0xFC00},
-	{0xDE001FFF, 0xFC00},
-	{0xDE7FFE00, 0xFC00},
-	{0xBFFFFF02, 0xC000},
-	{0xDE7FFF00, 0xFC00},
-	{0xBE9EFFFE, 0xB4F8},
-	{0xDE7FFF80, 0xFC00},
-	{0x37CAC659, 0x0196},
-	{0xDE7FFFC0, 0xFC00},
-	{0xDE8A5384, 0xFC00},
-	{0xDE7FFFE0, 0xFC00},
-	{0xCB80008F, 0xFC00},
-	{0xDE7FFFF0, 0xFC00},
-	{0x78800800, 0x7C00},
-	{0xDE7FFFF8, 0xFC00},
-	{0x4F7BFFFD, 0x7C00},
-	{0xDE7FFFFC, 0xFC00},
-	{0xC196F946, 0xCCB8},
-	{0xDE7FFFFE, 0xFC00},
-	{0xC4800040, 0xE400},
-	{0xDE7FFFFF, 0xFC00},
-	{0x5E7003FE, 0x7C00},
-	{0xDE7FFFFD, 0xFC00},
-	{0xC48007FB, 0xE400},
-	{0xDE7FFFFB, 0xFC00},
-	{0x41FEFF7E, 0x4FF8},
-	{0xDE7FFFF7, 0xFC00},
-	{0x40466379, 0x4233},
-	{0xDE7FFFEF, 0xFC00},
-	{0xC2FFFFC0, 0xD800},
-	{0xDE7FFFDF, 0xFC00},
-	{0x3E7FFDFF, 0x3400},
-	{0xDE7FFFBF, 0xFC00},
-	{0xC0FE07FE, 0xC7F0},
-	{0xDE7FFF7F, 0xFC00},
-	{0x4A0009FF, 0x7C00},
-	{0xDE7FFEFF, 0xFC00},
-	{0x41C0001F, 0x4E00},
-	{0xDE7FFDFF, 0xFC00},
-	{0xB3000102, 0x8001},
-	{0xDE7FFBFF, 0xFC00},
-	{0x417FFDFF, 0x4C00},
-	{0xDE7FF7FF, 0xFC00},
-	{0xB1F00040, 0x8000},
-	{0xDE7FEFFF, 0xFC00},
-	{0x5F01FFFF, 0x7C00},
-	{0xDE7FDFFF, 0xFC00},
-	{0x41DF5CD5, 0x4EFB},
-	{0xDE7FBFFF, 0xFC00},
-	{0x4783FBFF, 0x7C00},
-	{0xDE7F7FFF, 0xFC00},
-	{0xDF0401FE, 0xFC00},
-	{0xDE7EFFFF, 0xFC00},
-	{0x406FFFDF, 0x4380},
-	{0xDE7DFFFF, 0xFC00},
-	{0x33916F54, 0x0001},
-	{0xDE7BFFFF, 0xFC00},
-	{0x3FEE954D, 0x3F75},
-	{0xDE77FFFF, 0xFC00},
-	{0x0000803E, 0x0000},
-	{0xDE6FFFFF, 0xFC00},
-	{0x405FFFDE, 0x4300},
-	{0xDE5FFFFF, 0xFC00},
-	{0xDD084000, 0xFC00},
-	{0xDE3FFFFF, 0xFC00},
-	{0xAFFFE3FE, 0x8000},
-	{0xDE1FFFFF, 0xFC00},
-	{0xBA0203FF, 0x9010},
-	{0xDE0FFFFF, 0xFC00},
-	{0x7F3FFFFB, 0x7C00},
-	{0xDE07FFFF, 0xFC00},
-	{0xBF801FF6, 0xBC01},
-	{0xDE03FFFF, 0xFC00},
-	{0xBF7DF7FF, 0xBBF0},
-	{0xDE01FFFF, 0xFC00},
-	{0x3E201FFF, 0x3101},
-	{0xDE00FFFF, 0xFC00},
-	{0x407F1FFF, 0x43F9},
-	{0xDE007FFF, 0xFC00},
-	{0x4B9E910D, 0x7C00},
-	{0xDE003FFF, 0xFC00},
-	{0x5E000082, 0x7C00},
-	{0xDE001FFF, 0xFC00},
-	{0xBF400FFE, 0xBA00},
-	{0xDE000FFF, 0xFC00},
-	{0xBDE319C6, 0xAF19},
-	{0xDE0007FF, 0xFC00},
-	{0x4002000F, 0x4010},
-	{0xDE0003FF, 0xFC00},
-	{0xC0BFFFFB, 0xC600},
-	{0xDE0001FF, 0xFC00},
-	{0x4280081F, 0x5400},
-	{0xDE0000FF, 0xFC00},
-	{0xC007DFFF, 0xC03F},
-	{0xDE00007F, 0xFC00},
-	{0xCB87FFFF, 0xFC00},
-	{0xDE00003F, 0xFC00},
-	{0x3EA2B37B, 0x3516},
-	{0xDE00001F, 0xFC00},
-	{0x5FADB48D, 0x7C00},
-	{0xDE00000F, 0xFC00},
-	{0x3F5F179E, 0x3AF9},
-	{0xDE000007, 0xFC00},
-	{0x4217B9D1, 0x50BE},
-	{0xDE000003, 0xFC00},
-	{0x24010001, 0x0000},
-	{0xDE800000, 0xFC00},
-	{0x3F823FFE, 0x3C12},
-	{0xDE800001, 0xFC00},
-	{0x3C50F1BC, 0x2288},
-	{0xDE800002, 0xFC00},
-	{0x41800600, 0x4C00},
-	{0xDE800004, 0xFC00},
-	{0x44F5B6AB, 0x67AE},
-	{0xDE800008, 0xFC00},
-	{0x37F807FF, 0x01F0},
-	{0xDE800010, 0xFC00},
-	{0xBD007FFB, 0xA804},
-	{0xDE800020, 0xFC00},
-	{0xBF87FFE0, 0xBC40},
-	{0xDE800040, 0xFC00},
-	{0x7F000FF8, 0x7C00},
-	{0xDE800080, 0xFC00},
-	{0xCEFFDFDE, 0xFC00},
-	{0xDE800100, 0xFC00},
-	{0x3401FFFD, 0x0002},
-	{0xDE800200, 0xFC00},
-	{0x017FBFEF, 0x0000},
-	{0xDE800400, 0xFC00},
-	{0x3DF7BFFF, 0x2FBE},
-	{0xDE800800, 0xFC00},
-	{0xBFBFFFFF, 0xBE00},
-	{0xDE801000, 0xFC00},
-	{0x05800000, 0x0000},
-	{0xDE802000, 0xFC00},
-	{0x40812000, 0x4409},
-	{0xDE804000, 0xFC00},
-	{0x437FDFC0, 0x5BFF},
-	{0xDE808000, 0xFC00},
-	{0xFEF1314E, 0xFC00},
-	{0xDE810000, 0xFC00},
-	{0xBE7F7FFD, 0xB3FC},
-	{0xDE820000, 0xFC00},
-	{0xB9FFC000, 0x8FFE},
-	{0xDE840000, 0xFC00},
-	{0x5E5EFFFF, 0x7C00},
-	{0xDE880000, 0xFC00},
-	{0x88000009, 0x8000},
-	{0xDE900000, 0xFC00},
-	{0xC0A7FFFF, 0xC540},
-	{0xDEA00000, 0xFC00},
-	{0xBE07FFEE, 0xB040},
-	{0xDEC00000, 0xFC00},
-	{0x4100081F, 0x4800},
-	{0xDEE00000, 0xFC00},
-	{0x8080FFFE, 0x8000},
-	{0xDEF00000, 0xFC00},
-	{0xCBBB416A, 0xFC00},
-	{0xDEF80000, 0xFC00},
-	{0x4E8080FE, 0x7C00},
-	{0xDEFC0000, 0xFC00},
-	{0x5EFFFFFF, 0x7C00},
-	{0xDEFE0000, 0xFC00},
-	{0xB47FFF7B, 0x8004},
-	{0xDEFF0000, 0xFC00},
-	{0xBE04001F, 0xB020},
-	{0xDEFF8000, 0xFC00},
-	{0x02FFFFCE, 0x0000},
-	{0xDEFFC000, 0xFC00},
-	{0xFE80011E, 0xFC00},
-	{0xDEFFE000, 0xFC00},
-	{0xD3DFFFEF, 0xFC00},
-	{0xDEFFF000, 0xFC00},
-	{0xCEFE7FFE, 0xFC00},
-	{0xDEFFF800, 0xFC00},
-	{0xFF933EFA, 0xFE99},
-	{0xDEFFFC00, 0xFC00},
-	{0xCF75A699, 0xFC00},
-	{0xDEFFFE00, 0xFC00},
-	{0x8C331855, 0x8000},
-	{0xDEFFFF00, 0xFC00},
-	{0xBDF81000, 0xAFC0},
-	{0xDEFFFF80, 0xFC00},
-	{0x2A07E000, 0x0000},
-	{0xDEFFFFC0, 0xFC00},
-	{0xCE07FFF7, 0xFC00},
-	{0xDEFFFFE0, 0xFC00},
-	{0x4E48CCBE, 0x7C00},
-	{0xDEFFFFF0, 0xFC00},
-	{0xBFDF0707, 0xBEF8},
-	{0xDEFFFFF8, 0xFC00},
-	{0xB07FFF03, 0x8000},
-	{0xDEFFFFFC, 0xFC00},
-	{0x4132990E, 0x4995},
-	{0xDEFFFFFE, 0xFC00},
-	{0x3EE1396D, 0x370A},
-	{0xDEFFFFFF, 0xFC00},
-	{0x3DB0E420, 0x2D87},
-	{0xDEFFFFFD, 0xFC00},
-	{0x3E001004, 0x3001},
-	{0xDEFFFFFB, 0xFC00},
-	{0xFFFFFFE7, 0xFFFF},
-	{0xDEFFFFF7, 0xFC00},
-	{0xFFFC0008, 0xFFE0},
-	{0xDEFFFFEF, 0xFC00},
-	{0x02FFF880, 0x0000},
-	{0xDEFFFFDF, 0xFC00},
-	{0x4B804000, 0x7C00},
-	{0xDEFFFFBF, 0xFC00},
-	{0x807FFC3F, 0x8000},
-	{0xDEFFFF7F, 0xFC00},
-	{0x4F824000, 0x7C00},
-	{0xDEFFFEFF, 0xFC00},
-	{0xFF801001, 0xFE00},
-	{0xDEFFFDFF, 0xFC00},
-	{0xC0880FFF, 0xC440},
-	{0xDEFFFBFF, 0xFC00},
-	{0xFFFF001F, 0xFFF8},
-	{0xDEFFF7FF, 0xFC00},
-	{0x967D0E28, 0x8000},
-	{0xDEFFEFFF, 0xFC00},
-	{0x7F900020, 0x7E80},
-	{0xDEFFDFFF, 0xFC00},
-	{0xDEFFC002, 0xFC00},
-	{0xDEFFBFFF, 0xFC00},
-	{0x3E1007FF, 0x3080},
-	{0xDEFF7FFF, 0xFC00},
-	{0xA4007FFE, 0x8000},
-	{0xDEFEFFFF, 0xFC00},
-	{0x4FFFFE0E, 0x7C00},
-	{0xDEFDFFFF, 0xFC00},
-	{0xC5803E00, 0xEC02},
-	{0xDEFBFFFF, 0xFC00},
-	{0xFFC06AAF, 0xFE03},
-	{0xDEF7FFFF, 0xFC00},
-	{0xCBBFCEF8, 0xFC00},
-	{0xDEEFFFFF, 0xFC00},
-	{0xC17F007F, 0xCBF8},
-	{0xDEDFFFFF, 0xFC00},
-	{0x41B850E3, 0x4DC3},
-	{0xDEBFFFFF, 0xFC00},
-	{0xC4FE01FE, 0xE7F0},
-	{0xDE9FFFFF, 0xFC00},
-	{0x0C10D073, 0x0000},
-	{0xDE8FFFFF, 0xFC00},
-	{0xA0809000, 0x8000},
-	{0xDE87FFFF, 0xFC00},
-	{0xBD980000, 0xACC0},
-	{0xDE83FFFF, 0xFC00},
-	{0x3EF80000, 0x37C0},
-	{0xDE81FFFF, 0xFC00},
-	{0x4BFFFFE0, 0x7C00},
-	{0xDE80FFFF, 0xFC00},
-	{0x3855D25D, 0x0357},
-	{0xDE807FFF, 0xFC00},
-	{0xCE7BFDFE, 0xFC00},
-	{0xDE803FFF, 0xFC00},
-	{0xDF007FDF, 0xFC00},
-	{0xDE801FFF, 0xFC00},
-	{0xBE7FFFEA, 0xB400},
-	{0xDE800FFF, 0xFC00},
-	{0xC1A26515, 0xCD13},
-	{0xDE8007FF, 0xFC00},
-	{0x017FBFFB, 0x0000},
-	{0xDE8003FF, 0xFC00},
-	{0x7FEFBFFF, 0x7F7D},
-	{0xDE8001FF, 0xFC00},
-	{0xBF40000E, 0xBA00},
-	{0xDE8000FF, 0xFC00},
-	{0xBAC4F154, 0x9628},
-	{0xDE80007F, 0xFC00},
-	{0x0C7FF7EF, 0x0000},
-	{0xDE80003F, 0xFC00},
-	{0xBF7426CB, 0xBBA1},
-	{0xDE80001F, 0xFC00},
-	{0x1B00800E, 0x0000},
-	{0xDE80000F, 0xFC00},
-	{0xCF4D219D, 0xFC00},
-	{0xDE800007, 0xFC00},
-	{0x807FBC00, 0x8000},
-	{0xDE800003, 0xFC00},
-	{0x3D200001, 0x2900},
-	{0xDF000000, 0xFC00},
-	{0xBA7FFE00, 0x9400},
-	{0xDF000001, 0xFC00},
-	{0x3F808006, 0x3C04},
-	{0xDF000002, 0xFC00},
-	{0xBEA71679, 0xB539},
-	{0xDF000004, 0xFC00},
-	{0x237DBFFF, 0x0000},
-	{0xDF000008, 0xFC00},
-	{0xBFFEFFFB, 0xBFF8},
-	{0xDF000010, 0xFC00},
-	{0xB39FFFF7, 0x8001},
-	{0xDF000020, 0xFC00},
-	{0xC57BFFFE, 0xEBE0},
-	{0xDF000040, 0xFC00},
-	{0x40D96E91, 0x46CB},
-	{0xDF000080, 0xFC00},
-	{0x7FE27F8A, 0x7F13},
-	{0xDF000100, 0xFC00},
-	{0xBFA00200, 0xBD00},
-	{0xDF000200, 0xFC00},
-	{0xDE9FFDFF, 0xFC00},
-	{0xDF000400, 0xFC00},
-	{0x1E0BFFFF, 0x0000},
-	{0xDF000800, 0xFC00},
-	{0xC1E25353, 0xCF13},
-	{0xDF001000, 0xFC00},
-	{0xB3800011, 0x8001},
-	{0xDF002000, 0xFC00},
-	{0x4C000020, 0x7C00},
-	{0xDF004000, 0xFC00},
-	{0xCD77FFFF, 0xFC00},
-	{0xDF008000, 0xFC00},
-	{0x46400000, 0x7200},
-	{0xDF010000, 0xFC00},
-	{0xCF0FFFBE, 0xFC00},
-	{0xDF020000, 0xFC00},
-	{0xBEB67C09, 0xB5B4},
-	{0xDF040000, 0xFC00},
-	{0x3F04001F, 0x3820},
-	{0xDF080000, 0xFC00},
-	{0xBE001004, 0xB001},
-	{0xDF100000, 0xFC00},
-	{0x3DF00400, 0x2F80},
-	{0xDF200000, 0xFC00},
-	{0x301A2EDE, 0x0000},
-	{0xDF400000, 0xFC00},
-	{0xBEE7B164, 0xB73E},
-	{0xDF600000, 0xFC00},
-	{0x3E76C0B0, 0x33B6},
-	{0xDF700000, 0xFC00},
-	{0x3FBA7F99, 0x3DD4},
-	{0xDF780000, 0xFC00},
-	{0x3FEBFFFE, 0x3F60},
-	{0xDF7C0000, 0xFC00},
-	{0x0E1A3D95, 0x0000},
-	{0xDF7E0000, 0xFC00},
-	{0xBF80013F, 0xBC00},
-	{0xDF7F0000, 0xFC00},
-	{0x5E80000E, 0x7C00},
-	{0xDF7F8000, 0xFC00},
-	{0x4E01FFEF, 0x7C00},
-	{0xDF7FC000, 0xFC00},
-	{0xC27FFFDF, 0xD400},
-	{0xDF7FE000, 0xFC00},
-	{0xFE83FFEF, 0xFC00},
-	{0xDF7FF000, 0xFC00},
-	{0x8176E0F3, 0x8000},
-	{0xDF7FF800, 0xFC00},
-	{0xC2006000, 0xD003},
-	{0xDF7FFC00, 0xFC00},
-	{0x000BFFFE, 0x0000},
-	{0xDF7FFE00, 0xFC00},
-	{0x32F7FFFE, 0x0000},
-	{0xDF7FFF00, 0xFC00},
-	{0x4080C000, 0x4406},
-	{0xDF7FFF80, 0xFC00},
-	{0x7FDA7F72, 0x7ED3},
-	{0xDF7FFFC0, 0xFC00},
-	{0xBFFDEFFF, 0xBFEF},
-	{0xDF7FFFE0, 0xFC00},
-	{0x5FBFFFFF, 0x7C00},
-	{0xDF7FFFF0, 0xFC00},
-	{0x00E00006, 0x0000},
-	{0xDF7FFFF8, 0xFC00},
-	{0xCF803FFC, 0xFC00},
-	{0xDF7FFFFC, 0xFC00},
-	{0xB38FFF7F, 0x8001},
-	{0xDF7FFFFE, 0xFC00},
-	{0x7A62C6A1, 0x7C00},
-	{0xDF7FFFFF, 0xFC00},
-	{0x3DFFFF00, 0x3000},
-	{0xDF7FFFFD, 0xFC00},
-	{0x6D7FFFE1, 0x7C00},
-	{0xDF7FFFFB, 0xFC00},
-	{0xCEA7FFFE, 0xFC00},
-	{0xDF7FFFF7, 0xFC00},
-	{0x315A7B0C, 0x0000},
-	{0xDF7FFFEF, 0xFC00},
-	{0x00DEAA9E, 0x0000},
-	{0xDF7FFFDF, 0xFC00},
-	{0x2A60003F, 0x0000},
-	{0xDF7FFFBF, 0xFC00},
-	{0x5A1DCA2B, 0x7C00},
-	{0xDF7FFF7F, 0xFC00},
-	{0x3DD2F1F7, 0x2E98},
-	{0xDF7FFEFF, 0xFC00},
-	{0x40FF7F7F, 0x47FC},
-	{0xDF7FFDFF, 0xFC00},
-	{0x4F7FFFB7, 0x7C00},
-	{0xDF7FFBFF, 0xFC00},
-	{0x3B8FF000, 0x1C80},
-	{0xDF7FF7FF, 0xFC00},
-	{0x4E7FFBEE, 0x7C00},
-	{0xDF7FEFFF, 0xFC00},
-	{0xCE800200, 0xFC00},
-	{0xDF7FDFFF, 0xFC00},
-	{0xCFE007FE, 0xFC00},
-	{0xDF7FBFFF, 0xFC00},
-	{0xB33A7643, 0x8001},
-	{0xDF7F7FFF, 0xFC00},
-	{0xBE5B2320, 0xB2D9},
-	{0xDF7EFFFF, 0xFC00},
-	{0x41A5FDF5, 0x4D30},
-	{0xDF7DFFFF, 0xFC00},
-	{0x81601FFF, 0x8000},
-	{0xDF7BFFFF, 0xFC00},
-	{0xFFEFFFFA, 0xFF7F},
-	{0xDF77FFFF, 0xFC00},
-	{0x2193FE79, 0x0000},
-	{0xDF6FFFFF, 0xFC00},
-	{0x5EFFFFF4, 0x7C00},
-	{0xDF5FFFFF, 0xFC00},
-	{0x5B0101FF, 0x7C00},
-	{0xDF3FFFFF, 0xFC00},
-	{0x3D801FEF, 0x2C01},
-	{0xDF1FFFFF, 0xFC00},
-	{0xBF6ADCCB, 0xBB57},
-	{0xDF0FFFFF, 0xFC00},
-	{0x4E000820, 0x7C00},
-	{0xDF07FFFF, 0xFC00},
-	{0x401FDFFF, 0x40FF},
-	{0xDF03FFFF, 0xFC00},
-	{0x33BFBFFE, 0x0001},
-	{0xDF01FFFF, 0xFC00},
-	{0xC1400004, 0xCA00},
-	{0xDF00FFFF, 0xFC00},
-	{0xBCE0007F, 0xA700},
-	{0xDF007FFF, 0xFC00},
-	{0x4E803FFD, 0x7C00},
-	{0xDF003FFF, 0xFC00},
-	{0xCE87FFF0, 0xFC00},
-	{0xDF001FFF, 0xFC00},
-	{0x3E83FFFF, 0x3420},
-	{0xDF000FFF, 0xFC00},
-	{0xBB7D7FFF, 0x9BEC},
-	{0xDF0007FF, 0xFC00},
-	{0x408007BF, 0x4400},
-	{0xDF0003FF, 0xFC00},
-	{0x4A92ABF7, 0x7C00},
-	{0xDF0001FF, 0xFC00},
-	{0x4E9FFFEE, 0x7C00},
-	{0xDF0000FF, 0xFC00},
-	{0x27700100, 0x0000},
-	{0xDF00007F, 0xFC00},
-	{0x407F03FE, 0x43F8},
-	{0xDF00003F, 0xFC00},
-	{0x4E83FFEF, 0x7C00},
-	{0xDF00001F, 0xFC00},
-	{0x017E128B, 0x0000},
-	{0xDF00000F, 0xFC00},
-	{0xFF07DFFE, 0xFC00},
-	{0xDF000007, 0xFC00},
-	{0x81007FFD, 0x8000},
-	{0xDF000003, 0xFC00},
-	{0xDEFFFFE6, 0xFC00},
-	{0xDF800000, 0xFC00},
-	{0x3EFFCFFF, 0x37FE},
-	{0xDF800001, 0xFC00},
-	{0xB3BFFFBE, 0x8001},
-	{0xDF800002, 0xFC00},
-	{0x7FDF7FE6, 0x7EFB},
-	{0xDF800004, 0xFC00},
-	{0x81423509, 0x8000},
-	{0xDF800008, 0xFC00},
-	{0xBFB52F5C, 0xBDA9},
-	{0xDF800010, 0xFC00},
-	{0xC080207E, 0xC401},
-	{0xDF800020, 0xFC00},
-	{0xBE7FEEFE, 0xB3FF},
-	{0xDF800040, 0xFC00},
-	{0x397FFFF1, 0x0C00},
-	{0xDF800080, 0xFC00},
-	{0xBA7FFFF8, 0x9400},
-	{0xDF800100, 0xFC00},
-	{0xEE38B205, 0xFC00},
-	{0xDF800200, 0xFC00},
-	{0x3D81FFFD, 0x2C10},
-	{0xDF800400, 0xFC00},
-	{0xB6801FFB, 0x8040},
-	{0xDF800800, 0xFC00},
-	{0xC10C08E1, 0xC860},
-	{0xDF801000, 0xFC00},
-	{0xBFFFEFFF, 0xBFFF},
-	{0xDF802000, 0xFC00},
-	{0xC98401FF, 0xFC00},
-	{0xDF804000, 0xFC00},
-	{0xC3C00002, 0xDE00},
-	{0xDF808000, 0xFC00},
-	{0x83901000, 0x8000},
-	{0xDF810000, 0xFC00},
-	{0x3E780FFF, 0x33C0},
-	{0xDF820000, 0xFC00},
-	{0x5E80403F, 0x7C00},
-	{0xDF840000, 0xFC00},
-	{0x598BD653, 0x7C00},
-	{0xDF880000, 0xFC00},
-	{0x7FEFFFFB, 0x7F7F},
-	{0xDF900000, 0xFC00},
-	{0xC180003B, 0xCC00},
-	{0xDFA00000, 0xFC00},
-	{0x017E3FFF, 0x0000},
-	{0xDFC00000, 0xFC00},
-	{0x33FE0040, 0x0002},
-	{0xDFE00000, 0xFC00},
-	{0xBE59D25F, 0xB2CF},
-	{0xDFF00000, 0xFC00},
-	{0x4100103E, 0x4801},
-	{0xDFF80000, 0xFC00},
-	{0xBDF72950, 0xAFB9},
-	{0xDFFC0000, 0xFC00},
-	{0xC06F7FFF, 0xC37C},
-	{0xDFFE0000, 0xFC00},
-	{0x804CE591, 0x8000},
-	{0xDFFF0000, 0xFC00},
-	{0x3EFEF800, 0x37F8},
-	{0xDFFF8000, 0xFC00},
-	{0x5E7C86E2, 0x7C00},
-	{0xDFFFC000, 0xFC00},
-	{0x632235B3, 0x7C00},
-	{0xDFFFE000, 0xFC00},
-	{0x3DBFFFDE, 0x2E00},
-	{0xDFFFF000, 0xFC00},
-	{0x41FFFDBF, 0x5000},
-	{0xDFFFF800, 0xFC00},
-	{0xB8000014, 0x8200},
-	{0xDFFFFC00, 0xFC00},
-	{0xFE8BFFFF, 0xFC00},
-	{0xDFFFFE00, 0xFC00},
-	{0x47FFDFDF, 0x7C00},
-	{0xDFFFFF00, 0xFC00},
-	{0x473FEFFE, 0x79FF},
-	{0xDFFFFF80, 0xFC00},
-	{0xDE00BFFE, 0xFC00},
-	{0xDFFFFFC0, 0xFC00},
-	{0x0107FC00, 0x0000},
-	{0xDFFFFFE0, 0xFC00},
-	{0xBE001080, 0xB001},
-	{0xDFFFFFF0, 0xFC00},
-	{0xBF9039FB, 0xBC82},
-	{0xDFFFFFF8, 0xFC00},
-	{0xFF780FFE, 0xFC00},
-	{0xDFFFFFFC, 0xFC00},
-	{0x3F8C5E6B, 0x3C63},
-	{0xDFFFFFFE, 0xFC00},
-	{0x4C7FDFE0, 0x7C00},
-	{0xDFFFFFFF, 0xFC00},
-	{0x8BFFF7F7, 0x8000},
-	{0xDFFFFFFD, 0xFC00},
-	{0xC020001E, 0xC100},
-	{0xDFFFFFFB, 0xFC00},
-	{0xB07FEFFE, 0x8000},
-	{0xDFFFFFF7, 0xFC00},
-	{0x3D008FFE, 0x2804},
-	{0xDFFFFFEF, 0xFC00},
-	{0xDB71FFFF, 0xFC00},
-	{0xDFFFFFDF, 0xFC00},
-	{0x42BA7401, 0x55D4},
-	{0xDFFFFFBF, 0xFC00},
-	{0xCBF30E5F, 0xFC00},
-	{0xDFFFFF7F, 0xFC00},
-	{0x24FC0003, 0x0000},
-	{0xDFFFFEFF, 0xFC00},
-	{0x31FC001F, 0x0000},
-	{0xDFFFFDFF, 0xFC00},
-	{0xC390A2F5, 0xDC85},
-	{0xDFFFFBFF, 0xFC00},
-	{0xC2FB1536, 0xD7D9},
-	{0xDFFFF7FF, 0xFC00},
-	{0xFEFFFFC3, 0xFC00},
-	{0xDFFFEFFF, 0xFC00},
-	{0x4D8A7983, 0x7C00},
-	{0xDFFFDFFF, 0xFC00},
-	{0x4E3FC000, 0x7C00},
-	{0xDFFFBFFF, 0xFC00},
-	{0x40724323, 0x4392},
-	{0xDFFF7FFF, 0xFC00},
-	{0x3F9997CF, 0x3CCD},
-	{0xDFFEFFFF, 0xFC00},
-	{0xBDF7FBFE, 0xAFC0},
-	{0xDFFDFFFF, 0xFC00},
-	{0xC1621EB3, 0xCB11},
-	{0xDFFBFFFF, 0xFC00},
-	{0xBE00103F, 0xB001},
-	{0xDFF7FFFF, 0xFC00},
-	{0xB380FDFF, 0x8001},
-	{0xDFEFFFFF, 0xFC00},
-	{0x41FFFFDC, 0x5000},
-	{0xDFDFFFFF, 0xFC00},
-	{0x3E6FFFBE, 0x3380},
-	{0xDFBFFFFF, 0xFC00},
-	{0x4F76FFFF, 0x7C00},
-	{0xDF9FFFFF, 0xFC00},
-	{0xCE9C0000, 0xFC00},
-	{0xDF8FFFFF, 0xFC00},
-	{0x437FFF10, 0x5C00},
-	{0xDF87FFFF, 0xFC00},
-	{0xC2572D35, 0xD2B9},
-	{0xDF83FFFF, 0xFC00},
-	{0xC0FFFFFB, 0xC800},
-	{0xDF81FFFF, 0xFC00},
-	{0x80A00003, 0x8000},
-	{0xDF80FFFF, 0xFC00},
-	{0x407FFF5F, 0x4400},
-	{0xDF807FFF, 0xFC00},
-	{0x4E0BFFFF, 0x7C00},
-	{0xDF803FFF, 0xFC00},
-	{0x3E7E00FF, 0x33F0},
-	{0xDF801FFF, 0xFC00},
-	{0x4E00023F, 0x7C00},
-	{0xDF800FFF, 0xFC00},
-	{0x40FFEF7F, 0x47FF},
-	{0xDF8007FF, 0xFC00},
-	{0xBF009000, 0xB804},
-	{0xDF8003FF, 0xFC00},
-	{0x4D7FDFDF, 0x7C00},
-	{0xDF8001FF, 0xFC00},
-	{0xC5FDFDFF, 0xEFF0},
-	{0xDF8000FF, 0xFC00},
-	{0x3C080002, 0x2040},
-	{0xDF80007F, 0xFC00},
-	{0x418400FF, 0x4C20},
-	{0xDF80003F, 0xFC00},
-	{0x5FFFBFBF, 0x7C00},
-	{0xDF80001F, 0xFC00},
-	{0xCE6FFFEF, 0xFC00},
-	{0xDF80000F, 0xFC00},
-	{0x4077A6D4, 0x43BD},
-	{0xDF800007, 0xFC00},
-	{0xBF802FFF, 0xBC01},
-	{0xDF800003, 0xFC00},
-	{0x43FBFFFF, 0x5FE0},
-	{0xFE800000, 0xFC00},
-	{0xC100004F, 0xC800},
-	{0xFE800001, 0xFC00},
-	{0x80FFE00F, 0x8000},
-	{0xFE800002, 0xFC00},
-	{0xFCFFFFFE, 0xFC00},
-	{0xFE800004, 0xFC00},
-	{0xC119A3E0, 0xC8CD},
-	{0xFE800008, 0xFC00},
-	{0xBE800048, 0xB400},
-	{0xFE800010, 0xFC00},
-	{0xDE708000, 0xFC00},
-	{0xFE800020, 0xFC00},
-	{0xD27EFFF0, 0xFC00},
-	{0xFE800040, 0xFC00},
-	{0xDEFE0000, 0xFC00},
-	{0xFE800080, 0xFC00},
-	{0xC1FFFFF0, 0xD000},
-	{0xFE800100, 0xFC00},
-	{0xC193C09F, 0xCC9E},
-	{0xFE800200, 0xFC00},
-	{0xB61B32F9, 0x8027},
-	{0xFE800400, 0xFC00},
-	{0xC08000F8, 0xC400},
-	{0xFE800800, 0xFC00},
-	{0xDE011FFF, 0xFC00},
-	{0xFE801000, 0xFC00},
-	{0x5EFFFFF3, 0x7C00},
-	{0xFE802000, 0xFC00},
-	{0xC3800F7F, 0xDC00},
-	{0xFE804000, 0xFC00},
-	{0x4E8FFF00, 0x7C00},
-	{0xFE808000, 0xFC00},
-	{0x422007FF, 0x5100},
-	{0xFE810000, 0xFC00},
-	{0x497F801F, 0x7C00},
-	{0xFE820000, 0xFC00},
-	{0xCC00041F, 0xFC00},
-	{0xFE840000, 0xFC00},
-	{0x3D7DEFFE, 0x2BEF},
-	{0xFE880000, 0xFC00},
-	{0x406FFFFB, 0x4380},
-	{0xFE900000, 0xFC00},
-	{0xCF484AC2, 0xFC00},
-	{0xFEA00000, 0xFC00},
-	{0x470023FE, 0x7801},
-	{0xFEC00000, 0xFC00},
-	{0x4B820008, 0x7C00},
-	{0xFEE00000, 0xFC00},
-	{0xCF8003FF, 0xFC00},
-	{0xFEF00000, 0xFC00},
-	{0x3EFFFFFA, 0x3800},
-	{0xFEF80000, 0xFC00},
-	{0x3600087F, 0x0020},
-	{0xFEFC0000, 0xFC00},
-	{0xBBFFF87E, 0xA000},
-	{0xFEFE0000, 0xFC00},
-	{0xFE80203E, 0xFC00},
-	{0xFEFF0000, 0xFC00},
-	{0x5A195C85, 0x7C00},
-	{0xFEFF8000, 0xFC00},
-	{0x0083FBFE, 0x0000},
-	{0xFEFFC000, 0xFC00},
-	{0xA80107FE, 0x8000},
-	{0xFEFFE000, 0xFC00},
-	{0x41820000, 0x4C10},
-	{0xFEFFF000, 0xFC00},
-	{0xC1FFFFF4, 0xD000},
-	{0xFEFFF800, 0xFC00},
-	{0xC280007F, 0xD400},
-	{0xFEFFFC00, 0xFC00},
-	{0x4BFFFC1E, 0x7C00},
-	{0xFEFFFE00, 0xFC00},
-	{0xA0FFE000, 0x8000},
-	{0xFEFFFF00, 0xFC00},
-	{0xDBEAC55C, 0xFC00},
-	{0xFEFFFF80, 0xFC00},
-	{0xC1FFFF83, 0xD000},
-	{0xFEFFFFC0, 0xFC00},
-	{0xC2B1EBA5, 0xD58F},
-	{0xFEFFFFE0, 0xFC00},
-	{0xC67FDFEF, 0xF3FF},
-	{0xFEFFFFF0, 0xFC00},
-	{0xBABFE110, 0x95FF},
-	{0xFEFFFFF8, 0xFC00},
-	{0x597EDFFF, 0x7C00},
-	{0xFEFFFFFC, 0xFC00},
-	{0xC108003F, 0xC840},
-	{0xFEFFFFFE, 0xFC00},
-	{0xD4BBFFFF, 0xFC00},
-	{0xFEFFFFFF, 0xFC00},
-	{0xAFC64A8E, 0x8000},
-	{0xFEFFFFFD, 0xFC00},
-	{0x3EFF7FFF, 0x37FC},
-	{0xFEFFFFFB, 0xFC00},
-	{0xABFBFFFF, 0x8000},
-	{0xFEFFFFF7, 0xFC00},
-	{0xCF0CDBC9, 0xFC00},
-	{0xFEFFFFEF, 0xFC00},
-	{0xCE7EFFF7, 0xFC00},
-	{0xFEFFFFDF, 0xFC00},
-	{0x5F000003, 0x7C00},
-	{0xFEFFFFBF, 0xFC00},
-	{0xBE600040, 0xB300},
-	{0xFEFFFF7F, 0xFC00},
-	{0x817FF7FB, 0x8000},
-	{0xFEFFFEFF, 0xFC00},
-	{0xD7FFFFFE, 0xFC00},
-	{0xFEFFFDFF, 0xFC00},
-	{0x4F80003D, 0x7C00},
-	{0xFEFFFBFF, 0xFC00},
-	{0xB3C0001F, 0x8002},
-	{0xFEFFF7FF, 0xFC00},
-	{0x3F53A578, 0x3A9D},
-	{0xFEFFEFFF, 0xFC00},
-	{0xCF08D937, 0xFC00},
-	{0xFEFFDFFF, 0xFC00},
-	{0x4BFC03FE, 0x7C00},
-	{0xFEFFBFFF, 0xFC00},
-	{0x3EFFEFFF, 0x37FF},
-	{0xFEFF7FFF, 0xFC00},
-	{0x6D00401E, 0x7C00},
-	{0xFEFEFFFF, 0xFC00},
-	{0x5F820003, 0x7C00},
-	{0xFEFDFFFF, 0xFC00},
-	{0x0100DFFF, 0x0000},
-	{0xFEFBFFFF, 0xFC00},
-	{0xBE002001, 0xB001},
-	{0xFEF7FFFF, 0xFC00},
-	{0xD67E4000, 0xFC00},
-	{0xFEEFFFFF, 0xFC00},
-	{0x3DE63331, 0x2F32},
-	{0xFEDFFFFF, 0xFC00},
-	{0x5E9F7013, 0x7C00},
-	{0xFEBFFFFF, 0xFC00},
-	{0xDF382679, 0xFC00},
-	{0xFE9FFFFF, 0xFC00},
-	{0x00AA7063, 0x0000},
-	{0xFE8FFFFF, 0xFC00},
-	{0x307FF802, 0x0000},
-	{0xFE87FFFF, 0xFC00},
-	{0x2CA00001, 0x0000},
-	{0xFE83FFFF, 0xFC00},
-	{0xB45FFFF7, 0x8003},
-	{0xFE81FFFF, 0xFC00},
-	{0xC0B598E6, 0xC5AD},
-	{0xFE80FFFF, 0xFC00},
-	{0xDEC72A62, 0xFC00},
-	{0xFE807FFF, 0xFC00},
-	{0x42FF3FFF, 0x57FA},
-	{0xFE803FFF, 0xFC00},
-	{0xB27FFFFE, 0x8000},
-	{0xFE801FFF, 0xFC00},
-	{0x817FC003, 0x8000},
-	{0xFE800FFF, 0xFC00},
-	{0x42FC1FFF, 0x57E1},
-	{0xFE8007FF, 0xFC00},
-	{0x40FFFF03, 0x4800},
-	{0xFE8003FF, 0xFC00},
-	{0xC003F7FF, 0xC020},
-	{0xFE8001FF, 0xFC00},
-	{0xC1EFFFFA, 0xCF80},
-	{0xFE8000FF, 0xFC00},
-	{0x39009000, 0x0804},
-	{0xFE80007F, 0xFC00},
-	{0x3F05FFFE, 0x3830},
-	{0xFE80003F, 0xFC00},
-	{0x3E7FFBFE, 0x3400},
-	{0xFE80001F, 0xFC00},
-	{0xC2FFFDFE, 0xD800},
-	{0xFE80000F, 0xFC00},
-	{0x4D4FFFFE, 0x7C00},
-	{0xFE800007, 0xFC00},
-	{0x4B807FF8, 0x7C00},
-	{0xFE800003, 0xFC00},
-	{0xBED4B25E, 0xB6A6},
-	{0xFF000000, 0xFC00},
-	{0x3FBD42FF, 0x3DEA},
-	{0xFF000001, 0xFC00},
-	{0x80124E62, 0x8000},
-	{0xFF000002, 0xFC00},
-	{0xA7810002, 0x8000},
-	{0xFF000004, 0xFC00},
-	{0x51C001FE, 0x7C00},
-	{0xFF000008, 0xFC00},
-	{0x3F7E1FFE, 0x3BF1},
-	{0xFF000010, 0xFC00},
-	{0xCEDF7FFF, 0xFC00},
-	{0xFF000020, 0xFC00},
-	{0x417FF83F, 0x4C00},
-	{0xFF000040, 0xFC00},
-	{0x4EB5AEAA, 0x7C00},
-	{0xFF000080, 0xFC00},
-	{0x7F7FBFEE, 0x7C00},
-	{0xFF000100, 0xFC00},
-	{0xFFFBFDFF, 0xFFDF},
-	{0xFF000200, 0xFC00},
-	{0x628021FF, 0x7C00},
-	{0xFF000400, 0xFC00},
-	{0xDF30B755, 0xFC00},
-	{0xFF000800, 0xFC00},
-	{0x4B802000, 0x7C00},
-	{0xFF001000, 0xFC00},
-	{0x5EFF7FFE, 0x7C00},
-	{0xFF002000, 0xFC00},
-	{0x637FFFF4, 0x7C00},
-	{0xFF004000, 0xFC00},
-	{0x3F7FF200, 0x3C00},
-	{0xFF008000, 0xFC00},
-	{0x40C003FE, 0x4600},
-	{0xFF010000, 0xFC00},
-	{0xC18043FF, 0xCC02},
-	{0xFF020000, 0xFC00},
-	{0xCFFDFBFF, 0xFC00},
-	{0xFF040000, 0xFC00},
-	{0x427F8003, 0x53FC},
-	{0xFF080000, 0xFC00},
-	{0x41F6F1BF, 0x4FB8},
-	{0xFF100000, 0xFC00},
-	{0xD9801FBF, 0xFC00},
-	{0xFF200000, 0xFC00},
-	{0xBD9A9DC9, 0xACD5},
-	{0xFF400000, 0xFC00},
-	{0x40FFBFBF, 0x47FE},
-	{0xFF600000, 0xFC00},
-	{0x5F904000, 0x7C00},
-	{0xFF700000, 0xFC00},
-	{0x44FC0FFF, 0x67E0},
-	{0xFF780000, 0xFC00},
-	{0xCEFEFFEE, 0xFC00},
-	{0xFF7C0000, 0xFC00},
-	{0xBEDFFFC0, 0xB700},
-	{0xFF7E0000, 0xFC00},
-	{0x7EFFDFF7, 0x7C00},
-	{0xFF7F0000, 0xFC00},
-	{0xB3DFEFFE, 0x8002},
-	{0xFF7F8000, 0xFC00},
-	{0xCFA01FFF, 0xFC00},
-	{0xFF7FC000, 0xFC00},
-	{0x7F81FFDE, 0x7E0F},
-	{0xFF7FE000, 0xFC00},
-	{0xAEC5F06A, 0x8000},
-	{0xFF7FF000, 0xFC00},
-	{0x9A000400, 0x8000},
-	{0xFF7FF800, 0xFC00},
-	{0xCF7FC100, 0xFC00},
-	{0xFF7FFC00, 0xFC00},
-	{0x38E7FFFF, 0x0740},
-	{0xFF7FFE00, 0xFC00},
-	{0x3F0001F8, 0x3800},
-	{0xFF7FFF00, 0xFC00},
-	{0x80DBD3DF, 0x8000},
-	{0xFF7FFF80, 0xFC00},
-	{0xBD8000FE, 0xAC00},
-	{0xFF7FFFC0, 0xFC00},
-	{0x3F58D301, 0x3AC7},
-	{0xFF7FFFE0, 0xFC00},
-	{0xBE000600, 0xB000},
-	{0xFF7FFFF0, 0xFC00},
-	{0xBDFFFFF7, 0xB000},
-	{0xFF7FFFF8, 0xFC00},
-	{0xC100027E, 0xC800},
-	{0xFF7FFFFC, 0xFC00},
-	{0x5E7F7F7E, 0x7C00},
-	{0xFF7FFFFE, 0xFC00},
-	{0x8087BFFE, 0x8000},
-	{0xFF7FFFFF, 0xFC00},
-	{0xBFF001FF, 0xBF80},
-	{0xFF7FFFFD, 0xFC00},
-	{0xC1FFFEEF, 0xD000},
-	{0xFF7FFFFB, 0xFC00},
-	{0xBE03FE00, 0xB020},
-	{0xFF7FFFF7, 0xFC00},
-	{0xFA7EFFBE, 0xFC00},
-	{0xFF7FFFEF, 0xFC00},
-	{0x47FBFFF8, 0x7C00},
-	{0xFF7FFFDF, 0xFC00},
-	{0x3E77FEFE, 0x33C0},
-	{0xFF7FFFBF, 0xFC00},
-	{0x4E000206, 0x7C00},
-	{0xFF7FFF7F, 0xFC00},
-	{0x40400FFE, 0x4200},
-	{0xFF7FFEFF, 0xFC00},
-	{0xC000077F, 0xC000},
-	{0xFF7FFDFF, 0xFC00},
-	{0x3F5D267D, 0x3AE9},
-	{0xFF7FFBFF, 0xFC00},
-	{0xBF00BFFF, 0xB806},
-	{0xFF7FF7FF, 0xFC00},
-	{0x142FFFFF, 0x0000},
-	{0xFF7FEFFF, 0xFC00},
-	{0xCEF00200, 0xFC00},
-	{0xFF7FDFFF, 0xFC00},
-	{0x6C804FFF, 0x7C00},
-	{0xFF7FBFFF, 0xFC00},
-	{0x337FFE02, 0x0001},
-	{0xFF7F7FFF, 0xFC00},
-	{0xC387E000, 0xDC3F},
-	{0xFF7EFFFF, 0xFC00},
-	{0xBE887FFF, 0xB444},
-	{0xFF7DFFFF, 0xFC00},
-	{0x4BFC4000, 0x7C00},
-	{0xFF7BFFFF, 0xFC00},
-	{0x1AFFFE7E, 0x0000},
-	{0xFF77FFFF, 0xFC00},
-	{0xCEFBEFFF, 0xFC00},
-	{0xFF6FFFFF, 0xFC00},
-	{0xDE000802, 0xFC00},
-	{0xFF5FFFFF, 0xFC00},
-	{0x354138FC, 0x000C},
-	{0xFF3FFFFF, 0xFC00},
-	{0xC0010006, 0xC008},
-	{0xFF1FFFFF, 0xFC00},
-	{0x7FBFEFFF, 0x7FFF},
-	{0xFF0FFFFF, 0xFC00},
-	{0x4B8003EF, 0x7C00},
-	{0xFF07FFFF, 0xFC00},
-	{0xBEA46F11, 0xB523},
-	{0xFF03FFFF, 0xFC00},
-	{0xDEFFF7FE, 0xFC00},
-	{0xFF01FFFF, 0xFC00},
-	{0xBF8103FE, 0xBC08},
-	{0xFF00FFFF, 0xFC00},
-	{0xB802C77D, 0x820B},
-	{0xFF007FFF, 0xFC00},
-	{0x2BE0001F, 0x0000},
-	{0xFF003FFF, 0xFC00},
-	{0x013DFD6E, 0x0000},
-	{0xFF001FFF, 0xFC00},
-	{0x1DFFFEEF, 0x0000},
-	{0xFF000FFF, 0xFC00},
-	{0x41FFFC03, 0x5000},
-	{0xFF0007FF, 0xFC00},
-	{0x3E7FFFFF, 0x3400},
-	{0xFF0003FF, 0xFC00},
-	{0x4F9FEFFF, 0x7C00},
-	{0xFF0001FF, 0xFC00},
-	{0x407FFFEF, 0x4400},
-	{0xFF0000FF, 0xFC00},
-	{0xDEFFFF01, 0xFC00},
-	{0xFF00007F, 0xFC00},
-	{0xC23FFFF8, 0xD200},
-	{0xFF00003F, 0xFC00},
-	{0x431A9177, 0x58D5},
-	{0xFF00001F, 0xFC00},
-	{0x40BFBFFF, 0x45FE},
-	{0xFF00000F, 0xFC00},
-	{0xC1FBFF00, 0xCFE0},
-	{0xFF000007, 0xFC00},
-	{0x7E801002, 0x7C00},
-	{0xFF000003, 0xFC00},
-	{0xBDFEFFFF, 0xAFF8},
-	{0xFF800000, 0xFC00},
-	{0xC1000402, 0xC800},
-	{0xFF800001, 0xFE00},
-	{0x807FFFFF, 0x8000},
-	{0xFF800002, 0xFE00},
-	{0x2600001C, 0x0000},
-	{0xFF800004, 0xFE00},
-	{0x7F600100, 0x7C00},
-	{0xFF800008, 0xFE00},
-	{0x40FFC7FF, 0x47FE},
-	{0xFF800010, 0xFE00},
-	{0xBE7FBFFE, 0xB3FE},
-	{0xFF800020, 0xFE00},
-	{0x4501BFFF, 0x680E},
-	{0xFF800040, 0xFE00},
-	{0xB92DE80A, 0x896F},
-	{0xFF800080, 0xFE00},
-	{0xBF002020, 0xB801},
-	{0xFF800100, 0xFE00},
-	{0x3F7FFF8F, 0x3C00},
-	{0xFF800200, 0xFE00},
-	{0xDF52F735, 0xFC00},
-	{0xFF800400, 0xFE00},
-	{0x5FCEC634, 0x7C00},
-	{0xFF800800, 0xFE00},
-	{0x47FFEFFE, 0x7C00},
-	{0xFF801000, 0xFE00},
-	{0x4F01FFFD, 0x7C00},
-	{0xFF802000, 0xFE01},
-	{0x47FFFFDE, 0x7C00},
-	{0xFF804000, 0xFE02},
-	{0x6181FFFD, 0x7C00},
-	{0xFF808000, 0xFE04},
-	{0x5E203FFF, 0x7C00},
-	{0xFF810000, 0xFE08},
-	{0x3FA000FF, 0x3D00},
-	{0xFF820000, 0xFE10},
-	{0x429AFB4D, 0x54D8},
-	{0xFF840000, 0xFE20},
-	{0x49820040, 0x7C00},
-	{0xFF880000, 0xFE40},
-	{0xC1800087, 0xCC00},
-	{0xFF900000, 0xFE80},
-	{0xB70FFFFE, 0x8090},
-	{0xFFA00000, 0xFF00},
-	{0xFF8768AA, 0xFE3B},
-	{0xFFC00000, 0xFE00},
-	{0x4000FFFA, 0x4008},
-	{0xFFE00000, 0xFF00},
-	{0x7EFFFF6F, 0x7C00},
-	{0xFFF00000, 0xFF80},
-	{0x3FFFFFBB, 0x4000},
-	{0xFFF80000, 0xFFC0},
-	{0xBF60007F, 0xBB00},
-	{0xFFFC0000, 0xFFE0},
-	{0xC0F1FFFE, 0xC790},
-	{0xFFFE0000, 0xFFF0},
-	{0xE5FFF7FF, 0xFC00},
-	{0xFFFF0000, 0xFFF8},
-	{0xDFF20000, 0xFC00},
-	{0xFFFF8000, 0xFFFC},
-	{0x92FBFFF6, 0x8000},
-	{0xFFFFC000, 0xFFFE},
-	{0x600001EF, 0x7C00},
-	{0xFFFFE000, 0xFFFF},
-	{0x4F8001FB, 0x7C00},
-	{0xFFFFF000, 0xFFFF},
-	{0xF3800202, 0xFC00},
-	{0xFFFFF800, 0xFFFF},
-	{0xCFFFF3FE, 0xFC00},
-	{0xFFFFFC00, 0xFFFF},
-	{0xBDC149FA, 0xAE0A},
-	{0xFFFFFE00, 0xFFFF},
-	{0xC9EBFFFF, 0xFC00},
-	{0xFFFFFF00, 0xFFFF},
-	{0x33F7FF7F, 0x0002},
-	{0xFFFFFF80, 0xFFFF},
-	{0x7F0007EE, 0x7C00},
-	{0xFFFFFFC0, 0xFFFF},
-	{0x4075FFFE, 0x43B0},
-	{0xFFFFFFE0, 0xFFFF},
-	{0x5F8000A0, 0x7C00},
-	{0xFFFFFFF0, 0xFFFF},
-	{0x4BF5FFFF, 0x7C00},
-	{0xFFFFFFF8, 0xFFFF},
-	{0x4077FBFF, 0x43C0},
-	{0xFFFFFFFC, 0xFFFF},
-	{0x117FFFFE, 0x0000},
-	{0xFFFFFFFE, 0xFFFF},
-	{0xFEFFBFFD, 0xFC00},
-	{0xFFFFFFFF, 0xFFFF},
-	{0xDF807FF7, 0xFC00},
-	{0xFFFFFFFD, 0xFFFF},
-	{0x7F800003, 0x7E00},
-	{0xFFFFFFFB, 0xFFFF},
-	{0xBE00007C, 0xB000},
-	{0xFFFFFFF7, 0xFFFF},
-	{0xCEFFFF7F, 0xFC00},
-	{0xFFFFFFEF, 0xFFFF},
-	{0x33C20000, 0x0002},
-	{0xFFFFFFDF, 0xFFFF},
-	{0xBE801FEE, 0xB401},
-	{0xFFFFFFBF, 0xFFFF},
-	{0x42BE54B2, 0x55F3},
-	{0xFFFFFF7F, 0xFFFF},
-	{0xBDFC7FF7, 0xAFE4},
-	{0xFFFFFEFF, 0xFFFF},
-	{0x3FFFF200, 0x4000},
-	{0xFFFFFDFF, 0xFFFF},
-	{0x3D803BFF, 0x2C02},
-	{0xFFFFFBFF, 0xFFFF},
-	{0xC0800C00, 0xC400},
-	{0xFFFFF7FF, 0xFFFF},
-	{0x338011FF, 0x0001},
-	{0xFFFFEFFF, 0xFFFF},
-	{0x877F7DFF, 0x8000},
-	{0xFFFFDFFF, 0xFFFE},
-	{0x266C2136, 0x0000},
-	{0xFFFFBFFF, 0xFFFD},
-	{0x3D0FFFBF, 0x2880},
-	{0xFFFF7FFF, 0xFFFB},
-	{0x7FF04000, 0x7F82},
-	{0xFFFEFFFF, 0xFFF7},
-	{0x3B6AE28D, 0x1B57},
-	{0xFFFDFFFF, 0xFFEF},
-	{0xCBFFF7F7, 0xFC00},
-	{0xFFFBFFFF, 0xFFDF},
-	{0xDE7FFDEF, 0xFC00},
-	{0xFFF7FFFF, 0xFFBF},
-	{0xBF0003FF, 0xB800},
-	{0xFFEFFFFF, 0xFF7F},
-	{0xC1800001, 0xCC00},
-	{0xFFDFFFFF, 0xFEFF},
-	{0x3F804008, 0x3C02},
-	{0xFFBFFFFF, 0xFFFF},
-	{0x007FFFFE, 0x0000},
-	{0xFF9FFFFF, 0xFEFF},
-	{0x7F87FF7F, 0x7E3F},
-	{0xFF8FFFFF, 0xFE7F},
-	{0xDE7FFDFD, 0xFC00},
-	{0xFF87FFFF, 0xFE3F},
-	{0xDF804FFF, 0xFC00},
-	{0xFF83FFFF, 0xFE1F},
-	{0x37F00200, 0x01E0},
-	{0xFF81FFFF, 0xFE0F},
-	{0x50BFDFFF, 0x7C00},
-	{0xFF80FFFF, 0xFE07},
-	{0x4F667624, 0x7C00},
-	{0xFF807FFF, 0xFE03},
-	{0x7F7FFF07, 0x7C00},
-	{0xFF803FFF, 0xFE01},
-	{0x3E7FDF7F, 0x33FF},
-	{0xFF801FFF, 0xFE00},
-	{0xAD07FFBE, 0x8000},
-	{0xFF800FFF, 0xFE00},
-	{0x5487BFFF, 0x7C00},
-	{0xFF8007FF, 0xFE00},
-	{0xBE9001FE, 0xB480},
-	{0xFF8003FF, 0xFE00},
-	{0x40821000, 0x4410},
-	{0xFF8001FF, 0xFE00},
-	{0xCE2AD7EC, 0xFC00},
-	{0xFF8000FF, 0xFE00},
-	{0x5E200007, 0x7C00},
-	{0xFF80007F, 0xFE00},
-	{0x5CFFFB00, 0x7C00},
-	{0xFF80003F, 0xFE00},
-	{0x43FF000F, 0x5FF8},
-	{0xFF80001F, 0xFE00},
-	{0x39000027, 0x0800},
-	{0xFF80000F, 0xFE00},
-	{0x3DD6A592, 0x2EB5},
-	{0xFF800007, 0xFE00},
-	{0x4FFBFFF8, 0x7C00},
-	{0xFF800003, 0xFE00},
-}
@@ -1,26122 +0,0 @@
-// Code generated by scripts/f64_to_f16.pl; DO NOT EDIT.
-
-package float16
-
-var f64ToF16 = []struct {
-	f64 uint64
-	f16 uint16
-}{
-
-	{0xC8004001FFFFFFFE, 0xFC00},
-	{0x0000000000000000, 0x0000},
-	{0xC1C0008000007FFF, 0xFC00},
-	{0x0000000000000001, 0x0000},
-	{0xC7E13995E2B162B0, 0xFC00},
-	{0x0000000000000002, 0x0000},
-	{0x40DFFFFFBFFDFFFF, 0x7800},
-	{0x0000000000000004, 0x0000},
-	{0x3FCFFFF800000002, 0x3400},
-	{0x0000000000000008, 0x0000},
-	{0x3FAFFFFFFFFFFDDF, 0x2C00},
-	{0x0000000000000010, 0x0000},
-	{0x401FFFF000000004, 0x4800},
-	{0x0000000000000020, 0x0000},
-	{0xB7FFFFFFFFBFF000, 0x8000},
-	{0x0000000000000040, 0x0000},
-	{0x4800001FFFFFFFEF, 0x7C00},
-	{0x0000000000000080, 0x0000},
-	{0xB81000087FFFFFFF, 0x8000},
-	{0x0000000000000100, 0x0000},
-	{0x5F20000000000FDE, 0x7C00},
-	{0x0000000000000200, 0x0000},
-	{0x4020000FDFFFFFFE, 0x4800},
-	{0x0000000000000400, 0x0000},
-	{0xC02ECA00AD8F3EDA, 0xCBB3},
-	{0x0000000000000800, 0x0000},
-	{0xADC00000000040FE, 0x8000},
-	{0x0000000000001000, 0x0000},
-	{0x3ECFFFFFFFFFFFFB, 0x0040},
-	{0x0000000000002000, 0x0000},
-	{0xC01FFFF800000FFE, 0xC800},
-	{0x0000000000004000, 0x0000},
-	{0x41D0201FFFFFFFFF, 0x7C00},
-	{0x0000000000008000, 0x0000},
-	{0x8011000000008000, 0x8000},
-	{0x0000000000010000, 0x0000},
-	{0x3F40003FF7FFFFFF, 0x1000},
-	{0x0000000000020000, 0x0000},
-	{0x434BDEA404BD39C8, 0x7C00},
-	{0x0000000000040000, 0x0000},
-	{0xBC60000000203FFF, 0x8000},
-	{0x0000000000080000, 0x0000},
-	{0xC80BFFFFFFFFFFFE, 0xFC00},
-	{0x0000000000100000, 0x0000},
-	{0xBFB0047FFFFFFFFE, 0xAC01},
-	{0x0000000000200000, 0x0000},
-	{0xB81FFFF8000000FF, 0x8000},
-	{0x0000000000400000, 0x0000},
-	{0x801FFFFDFFFFBFFE, 0x8000},
-	{0x0000000000800000, 0x0000},
-	{0xC803F7FFFFFFFFFF, 0xFC00},
-	{0x0000000001000000, 0x0000},
-	{0x4800000FFF800000, 0x7C00},
-	{0x0000000002000000, 0x0000},
-	{0x35103FFFFFEFFFFF, 0x0000},
-	{0x0000000004000000, 0x0000},
-	{0x7FD000020FFFFFFE, 0x7C00},
-	{0x0000000008000000, 0x0000},
-	{0x401FFFFFDFFFFFDE, 0x4800},
-	{0x0000000010000000, 0x0000},
-	{0xB7EFFFFFFFFE0003, 0x8000},
-	{0x0000000020000000, 0x0000},
-	{0x4A9FFFF01FFFFFFE, 0x7C00},
-	{0x0000000040000000, 0x0000},
-	{0xBEEB7B988C5DC207, 0x80DC},
-	{0x0000000080000000, 0x0000},
-	{0x4826E41D9144C1E2, 0x7C00},
-	{0x0000000100000000, 0x0000},
-	{0xD540000000FFFEFF, 0xFC00},
-	{0x0000000200000000, 0x0000},
-	{0x43DFFFFE00000000, 0x7C00},
-	{0x0000000400000000, 0x0000},
-	{0xFFDFFBFFFFFEFFFF, 0xFC00},
-	{0x0000000800000000, 0x0000},
-	{0x381FF000FFFFFFFF, 0x0000},
-	{0x0000001000000000, 0x0000},
-	{0x4BEFFF7FFBFFFFFE, 0x7C00},
-	{0x0000002000000000, 0x0000},
-	{0x4D1E9CF1D1F6EFBA, 0x7C00},
-	{0x0000004000000000, 0x0000},
-	{0xC07FFFBFFFFFFFF0, 0xE000},
-	{0x0000008000000000, 0x0000},
-	{0xC3EFFF4000000000, 0xFC00},
-	{0x0000010000000000, 0x0000},
-	{0x3EC043FFFFFFFFFE, 0x0021},
-	{0x0000020000000000, 0x0000},
-	{0x41FFFFFFFFFFFFD6, 0x7C00},
-	{0x0000040000000000, 0x0000},
-	{0x1280020100000000, 0x0000},
-	{0x0000080000000000, 0x0000},
-	{0xC7EFFFFFFFC01000, 0xFC00},
-	{0x0000100000000000, 0x0000},
-	{0x3CA0001FEFFFFFFE, 0x0000},
-	{0x0000200000000000, 0x0000},
-	{0x5320FFFFFFFFFFFB, 0x7C00},
-	{0x0000400000000000, 0x0000},
-	{0x80064C675EE7AC9F, 0x8000},
-	{0x0000800000000000, 0x0000},
-	{0x3FE81C15C02BA183, 0x3A07},
-	{0x0001000000000000, 0x0000},
-	{0x3FA000000FFFFFFF, 0x2800},
-	{0x0002000000000000, 0x0000},
-	{0x404FE08000000000, 0x53F8},
-	{0x0004000000000000, 0x0000},
-	{0x6C38B960D54C6794, 0x7C00},
-	{0x0008000000000000, 0x0000},
-	{0xB73FFFFF00002000, 0x8000},
-	{0x000C000000000000, 0x0000},
-	{0xC7FFFFFFFF7FFDFF, 0xFC00},
-	{0x000E000000000000, 0x0000},
-	{0x001FDFFEFFFFFFFE, 0x0000},
-	{0x000F000000000000, 0x0000},
-	{0xB7F0008000001FFE, 0x8000},
-	{0x000F800000000000, 0x0000},
-	{0xC0D0000400000002, 0xF400},
-	{0x000FC00000000000, 0x0000},
-	{0x3FC004000FFFFFFE, 0x3001},
-	{0x000FE00000000000, 0x0000},
-	{0xBFDFFFFFFF00FFFF, 0xB800},
-	{0x000FF00000000000, 0x0000},
-	{0x403000FDFFFFFFFF, 0x4C00},
-	{0x000FF80000000000, 0x0000},
-	{0xBFEFFFFFFFFFE010, 0xBC00},
-	{0x000FFC0000000000, 0x0000},
-	{0xBFFFFFFFE00FFFFE, 0xC000},
-	{0x000FFE0000000000, 0x0000},
-	{0xBFBFF000001FFFFF, 0xAFFC},
-	{0x000FFF0000000000, 0x0000},
-	{0xB360000010007FFF, 0x8000},
-	{0x000FFF8000000000, 0x0000},
-	{0x37F550A89E85E12D, 0x0000},
-	{0x000FFFC000000000, 0x0000},
-	{0xBF0FFFFFFFFF0007, 0x8400},
-	{0x000FFFE000000000, 0x0000},
-	{0xB454302A8F1097BB, 0x8000},
-	{0x000FFFF000000000, 0x0000},
-	{0xA6CFFFFF7FFFFFFD, 0x8000},
-	{0x000FFFF800000000, 0x0000},
-	{0xC0207FFFBFFFFFFF, 0xC820},
-	{0x000FFFFC00000000, 0x0000},
-	{0x3FC0D7720891A525, 0x3036},
-	{0x000FFFFE00000000, 0x0000},
-	{0x380FFFBFFFFFC000, 0x0000},
-	{0x000FFFFF00000000, 0x0000},
-	{0xC6500800007FFFFF, 0xFC00},
-	{0x000FFFFF80000000, 0x0000},
-	{0xBFC60E81A8DB2E2A, 0xB184},
-	{0x000FFFFFC0000000, 0x0000},
-	{0xBFE0020000001FFE, 0xB801},
-	{0x000FFFFFE0000000, 0x0000},
-	{0xC76100007FFFFFFE, 0xFC00},
-	{0x000FFFFFF0000000, 0x0000},
-	{0x58B000001FEFFFFE, 0x7C00},
-	{0x000FFFFFF8000000, 0x0000},
-	{0x3F3400000003FFFF, 0x0D00},
-	{0x000FFFFFFC000000, 0x0000},
-	{0x41E0000000FFFFF0, 0x7C00},
-	{0x000FFFFFFE000000, 0x0000},
-	{0x3400001000001FFE, 0x0000},
-	{0x000FFFFFFF000000, 0x0000},
-	{0xBF50000002FFFFFF, 0x9400},
-	{0x000FFFFFFF800000, 0x0000},
-	{0x4800000001FFFC00, 0x7C00},
-	{0x000FFFFFFFC00000, 0x0000},
-	{0xC71EFFFFFFFFFF7F, 0xFC00},
-	{0x000FFFFFFFE00000, 0x0000},
-	{0x401FFFFFFFFFFFFE, 0x4800},
-	{0x000FFFFFFFF00000, 0x0000},
-	{0xBF7008000FFFFFFF, 0x9C02},
-	{0x000FFFFFFFF80000, 0x0000},
-	{0x3F3EFBFFFFFFFFFF, 0x0FBF},
-	{0x000FFFFFFFFC0000, 0x0000},
-	{0x711001000000000E, 0x7C00},
-	{0x000FFFFFFFFE0000, 0x0000},
-	{0x2D5FFFFEDFFFFFFF, 0x0000},
-	{0x000FFFFFFFFF0000, 0x0000},
-	{0x37EFFBFFFFFFFFBF, 0x0000},
-	{0x000FFFFFFFFF8000, 0x0000},
-	{0x3FDCD6F898E43FC2, 0x3736},
-	{0x000FFFFFFFFFC000, 0x0000},
-	{0xB880000078000000, 0x8000},
-	{0x000FFFFFFFFFE000, 0x0000},
-	{0xC060000002000002, 0xD800},
-	{0x000FFFFFFFFFF000, 0x0000},
-	{0x40195F66A1015EDD, 0x4658},
-	{0x000FFFFFFFFFF800, 0x0000},
-	{0x43C739F35E87C788, 0x7C00},
-	{0x000FFFFFFFFFFC00, 0x0000},
-	{0xC1DFFFFFC00003FF, 0xFC00},
-	{0x000FFFFFFFFFFE00, 0x0000},
-	{0xBCAE000000001FFF, 0x8000},
-	{0x000FFFFFFFFFFF00, 0x0000},
-	{0x42AFFFFE00000002, 0x7C00},
-	{0x000FFFFFFFFFFF80, 0x0000},
-	{0xC1DFFFFFFFFF01FE, 0xFC00},
-	{0x000FFFFFFFFFFFC0, 0x0000},
-	{0x47E4A9F0B6D96E43, 0x7C00},
-	{0x000FFFFFFFFFFFE0, 0x0000},
-	{0x4390001C00000000, 0x7C00},
-	{0x000FFFFFFFFFFFF0, 0x0000},
-	{0xC0001FFFFFFFBFFF, 0xC008},
-	{0x000FFFFFFFFFFFF8, 0x0000},
-	{0xB7F33798162C8125, 0x8000},
-	{0x000FFFFFFFFFFFFC, 0x0000},
-	{0xC1D0AF66062A5153, 0xFC00},
-	{0x000FFFFFFFFFFFFE, 0x0000},
-	{0x3CA080000007FFFE, 0x0000},
-	{0x000FFFFFFFFFFFFF, 0x0000},
-	{0x400000087FFFFFFF, 0x4000},
-	{0x000FFFFFFFFFFFFD, 0x0000},
-	{0x800FFFFFFC0001FF, 0x8000},
-	{0x000FFFFFFFFFFFFB, 0x0000},
-	{0x401411501A2A4056, 0x4504},
-	{0x000FFFFFFFFFFFF7, 0x0000},
-	{0x42AC84A2A8ECA5DE, 0x7C00},
-	{0x000FFFFFFFFFFFEF, 0x0000},
-	{0x41BFFFFFE000007E, 0x7C00},
-	{0x000FFFFFFFFFFFDF, 0x0000},
-	{0xC010000020007FFF, 0xC400},
-	{0x000FFFFFFFFFFFBF, 0x0000},
-	{0xBFC8BD98C11D6B6E, 0xB22F},
-	{0x000FFFFFFFFFFF7F, 0x0000},
-	{0xB826019A57EBF4C1, 0x8000},
-	{0x000FFFFFFFFFFEFF, 0x0000},
-	{0x0DDFFFFFFFFFFE80, 0x0000},
-	{0x000FFFFFFFFFFDFF, 0x0000},
-	{0xBFB554555C81B36A, 0xAD55},
-	{0x000FFFFFFFFFFBFF, 0x0000},
-	{0xBFDFFF8000001FFE, 0xB800},
-	{0x000FFFFFFFFFF7FF, 0x0000},
-	{0xBFC003FFFFF80000, 0xB001},
-	{0x000FFFFFFFFFEFFF, 0x0000},
-	{0x99DFFEFFFFFFFDFF, 0x8000},
-	{0x000FFFFFFFFFDFFF, 0x0000},
-	{0x400FE905B8D757F0, 0x43FA},
-	{0x000FFFFFFFFFBFFF, 0x0000},
-	{0x7FD0FFFFFFFFF7FF, 0x7C00},
-	{0x000FFFFFFFFF7FFF, 0x0000},
-	{0xBFD07FFFFFFFFEFE, 0xB420},
-	{0x000FFFFFFFFEFFFF, 0x0000},
-	{0x400FFFFC0FFFFFFF, 0x4400},
-	{0x000FFFFFFFFDFFFF, 0x0000},
-	{0xC00FFF0000000000, 0xC400},
-	{0x000FFFFFFFFBFFFF, 0x0000},
-	{0xC34000000001BFFF, 0xFC00},
-	{0x000FFFFFFFF7FFFF, 0x0000},
-	{0xC1DFFFF800000FFF, 0xFC00},
-	{0x000FFFFFFFEFFFFF, 0x0000},
-	{0x4266AD3B5772046B, 0x7C00},
-	{0x000FFFFFFFDFFFFF, 0x0000},
-	{0xCF400000000000DF, 0xFC00},
-	{0x000FFFFFFFBFFFFF, 0x0000},
-	{0x41F00000FFFFFFF0, 0x7C00},
-	{0x000FFFFFFF7FFFFF, 0x0000},
-	{0x00000000021FFFFE, 0x0000},
-	{0x000FFFFFFEFFFFFF, 0x0000},
-	{0x41FFFFFFDFFFFFFE, 0x7C00},
-	{0x000FFFFFFDFFFFFF, 0x0000},
-	{0x3DBFF80000020000, 0x0000},
-	{0x000FFFFFFBFFFFFF, 0x0000},
-	{0x40020000000003FE, 0x4080},
-	{0x000FFFFFF7FFFFFF, 0x0000},
-	{0x0010FF0000000000, 0x0000},
-	{0x000FFFFFEFFFFFFF, 0x0000},
-	{0xC3C0000000003F7E, 0xFC00},
-	{0x000FFFFFDFFFFFFF, 0x0000},
-	{0xC9EFDFFFFFFBFFFE, 0xFC00},
-	{0x000FFFFFBFFFFFFF, 0x0000},
-	{0x3CACB3D0702CCBA3, 0x0000},
-	{0x000FFFFF7FFFFFFF, 0x0000},
-	{0xB85000020003FFFF, 0x8000},
-	{0x000FFFFEFFFFFFFF, 0x0000},
-	{0x43C003FFFFFFEFFF, 0x7C00},
-	{0x000FFFFDFFFFFFFF, 0x0000},
-	{0xC3FFFEFFFFEFFFFF, 0xFC00},
-	{0x000FFFFBFFFFFFFF, 0x0000},
-	{0xC03040001FFFFFFE, 0xCC10},
-	{0x000FFFF7FFFFFFFF, 0x0000},
-	{0xBD7F80000000001F, 0x8000},
-	{0x000FFFEFFFFFFFFF, 0x0000},
-	{0xBFEFFF0000003FFE, 0xBC00},
-	{0x000FFFDFFFFFFFFF, 0x0000},
-	{0x48002319C9A87A26, 0x7C00},
-	{0x000FFFBFFFFFFFFF, 0x0000},
-	{0xC07FFFFFEFFFFFF7, 0xE000},
-	{0x000FFF7FFFFFFFFF, 0x0000},
-	{0xC1FFFFFFE0800000, 0xFC00},
-	{0x000FFEFFFFFFFFFF, 0x0000},
-	{0xC01F245114629AB0, 0xC7C9},
-	{0x000FFDFFFFFFFFFF, 0x0000},
-	{0xA5900020000007FF, 0x8000},
-	{0x000FFBFFFFFFFFFF, 0x0000},
-	{0xC3CFFFFFFEFFFBFF, 0xFC00},
-	{0x000FF7FFFFFFFFFF, 0x0000},
-	{0x9930000000FFFF7E, 0x8000},
-	{0x000FEFFFFFFFFFFF, 0x0000},
-	{0x3FB0000003FFFDFF, 0x2C00},
-	{0x000FDFFFFFFFFFFF, 0x0000},
-	{0xBFCFFFFFFFFE0800, 0xB400},
-	{0x000FBFFFFFFFFFFF, 0x0000},
-	{0x3D177DC80D1F11E4, 0x0000},
-	{0x000F7FFFFFFFFFFF, 0x0000},
-	{0xCF3FFF8003FFFFFF, 0xFC00},
-	{0x000EFFFFFFFFFFFF, 0x0000},
-	{0x3FE977BCB0BDE661, 0x3A5E},
-	{0x000DFFFFFFFFFFFF, 0x0000},
-	{0x58FFFFFFFFEFEFFE, 0x7C00},
-	{0x000BFFFFFFFFFFFF, 0x0000},
-	{0x402BBFFFFFFFFFFE, 0x4AF0},
-	{0x0007FFFFFFFFFFFF, 0x0000},
-	{0x6700000041000000, 0x7C00},
-	{0x0003FFFFFFFFFFFF, 0x0000},
-	{0x3EFE79BD7C8EE65D, 0x01E8},
-	{0x0001FFFFFFFFFFFF, 0x0000},
-	{0xC7F0004000000000, 0xFC00},
-	{0x0000FFFFFFFFFFFF, 0x0000},
-	{0x4388000007FFFFFF, 0x7C00},
-	{0x00007FFFFFFFFFFF, 0x0000},
-	{0x489EFFBFFFFFFFFF, 0x7C00},
-	{0x00003FFFFFFFFFFF, 0x0000},
-	{0x3FEFFFC0000FFFFE, 0x3C00},
-	{0x00001FFFFFFFFFFF, 0x0000},
-	{0xC02FFE0000000080, 0xCC00},
-	{0x00000FFFFFFFFFFF, 0x0000},
-	{0xC3CFDFFFFFFFFFFF, 0xFC00},
-	{0x000007FFFFFFFFFF, 0x0000},
-	{0xC80FFFFE1FFFFFFF, 0xFC00},
-	{0x000003FFFFFFFFFF, 0x0000},
-	{0x403FFFFFFFFBFFBF, 0x5000},
-	{0x000001FFFFFFFFFF, 0x0000},
-	{0xBFA001FFFC000000, 0xA800},
-	{0x000000FFFFFFFFFF, 0x0000},
-	{0xBF48B580EFC22EE9, 0x922D},
-	{0x0000007FFFFFFFFF, 0x0000},
-	{0xC0AFFFFFFFBFFFFE, 0xEC00},
-	{0x0000003FFFFFFFFF, 0x0000},
-	{0xBFD007FFFF7FFFFE, 0xB402},
-	{0x0000001FFFFFFFFF, 0x0000},
-	{0xD79FFFFFFFFFFEEF, 0xFC00},
-	{0x0000000FFFFFFFFF, 0x0000},
-	{0x078FFF77FFFFFFFF, 0x0000},
-	{0x00000007FFFFFFFF, 0x0000},
-	{0x42100081FFFFFFFE, 0x7C00},
-	{0x00000003FFFFFFFF, 0x0000},
-	{0xBF0FFFFFFFFFBFF6, 0x8400},
-	{0x00000001FFFFFFFF, 0x0000},
-	{0xB8101FFFFFFF7FFF, 0x8000},
-	{0x00000000FFFFFFFF, 0x0000},
-	{0x41C0020000000010, 0x7C00},
-	{0x000000007FFFFFFF, 0x0000},
-	{0x3E3A0FF9DFE1ABCE, 0x0000},
-	{0x000000003FFFFFFF, 0x0000},
-	{0xBFD001000FFFFFFF, 0xB400},
-	{0x000000001FFFFFFF, 0x0000},
-	{0xF45FFFFFFFFF9000, 0xFC00},
-	{0x000000000FFFFFFF, 0x0000},
-	{0xC0225B765876F45D, 0xC897},
-	{0x0000000007FFFFFF, 0x0000},
-	{0x43DF000001000000, 0x7C00},
-	{0x0000000003FFFFFF, 0x0000},
-	{0xC5BFFFDFFFFFFFBF, 0xFC00},
-	{0x0000000001FFFFFF, 0x0000},
-	{0xC3D020000000003F, 0xFC00},
-	{0x0000000000FFFFFF, 0x0000},
-	{0x403805ABD5CC8DD4, 0x4E01},
-	{0x00000000007FFFFF, 0x0000},
-	{0xBFD0000040000006, 0xB400},
-	{0x00000000003FFFFF, 0x0000},
-	{0x4804EF5E28BF3515, 0x7C00},
-	{0x00000000001FFFFF, 0x0000},
-	{0xC1C4D7344DB42619, 0xFC00},
-	{0x00000000000FFFFF, 0x0000},
-	{0x4D5FFF000000000F, 0x7C00},
-	{0x000000000007FFFF, 0x0000},
-	{0xFFEFFFFFFFFBFC00, 0xFC00},
-	{0x000000000003FFFF, 0x0000},
-	{0x43C0001FFFFFFF7F, 0x7C00},
-	{0x000000000001FFFF, 0x0000},
-	{0xC00FFFFFFFFFEFFF, 0xC400},
-	{0x000000000000FFFF, 0x0000},
-	{0xC083EC6753401ACB, 0xE0FB},
-	{0x0000000000007FFF, 0x0000},
-	{0xBD6FFFFBFDFFFFFE, 0x8000},
-	{0x0000000000003FFF, 0x0000},
-	{0x5580000FFFFFFFFC, 0x7C00},
-	{0x0000000000001FFF, 0x0000},
-	{0x42D5134CA61A922E, 0x7C00},
-	{0x0000000000000FFF, 0x0000},
-	{0x40400001FFFFFEFF, 0x5000},
-	{0x00000000000007FF, 0x0000},
-	{0xC03007EFFFFFFFFF, 0xCC02},
-	{0x00000000000003FF, 0x0000},
-	{0x415FFFFFE0000000, 0x7C00},
-	{0x00000000000001FF, 0x0000},
-	{0x380FF81FFFFFFFFE, 0x0000},
-	{0x00000000000000FF, 0x0000},
-	{0xBBBFFFFFFFFFF001, 0x8000},
-	{0x000000000000007F, 0x0000},
-	{0xBFF0FABB24901FE6, 0xBC3F},
-	{0x000000000000003F, 0x0000},
-	{0xC03F0000001FFFFF, 0xCFC0},
-	{0x000000000000001F, 0x0000},
-	{0xC7EFFFFFFFFCFFFF, 0xFC00},
-	{0x000000000000000F, 0x0000},
-	{0x3814F8EF7DD2F4AA, 0x0000},
-	{0x0000000000000007, 0x0000},
-	{0x400FFF8000FFFFFE, 0x4400},
-	{0x0000000000000003, 0x0000},
-	{0xFFE9126C35227C11, 0xFC00},
-	{0x0010000000000000, 0x0000},
-	{0x43F0000010FFFFFE, 0x7C00},
-	{0x0010000000000001, 0x0000},
-	{0x40100000000807FF, 0x4400},
-	{0x0010000000000002, 0x0000},
-	{0xBF50005FFFFFFFFE, 0x9400},
-	{0x0010000000000004, 0x0000},
-	{0x404FFFFFFFFFFEEE, 0x5400},
-	{0x0010000000000008, 0x0000},
-	{0xC01FFFFFFFC00040, 0xC800},
-	{0x0010000000000010, 0x0000},
-	{0x3FE0100000000002, 0x3804},
-	{0x0010000000000020, 0x0000},
-	{0xC530107FFFFFFFFE, 0xFC00},
-	{0x0010000000000040, 0x0000},
-	{0xC1F36FD5E2F43832, 0xFC00},
-	{0x0010000000000080, 0x0000},
-	{0xB7F000000000020F, 0x8000},
-	{0x0010000000000100, 0x0000},
-	{0x381FFFE800000000, 0x0000},
-	{0x0010000000000200, 0x0000},
-	{0x4280E8286555D129, 0x7C00},
-	{0x0010000000000400, 0x0000},
-	{0xBE700000100001FF, 0x8001},
-	{0x0010000000000800, 0x0000},
-	{0x487FFFFFFFFFFF6F, 0x7C00},
-	{0x0010000000001000, 0x0000},
-	{0x8020007FFFFFF800, 0x8000},
-	{0x0010000000002000, 0x0000},
-	{0x3E6A6A524CB75CB7, 0x0001},
-	{0x0010000000004000, 0x0000},
-	{0xC7E1FFFFFFBFFFFF, 0xFC00},
-	{0x0010000000008000, 0x0000},
-	{0x4022C708D6B4F3ED, 0x48B2},
-	{0x0010000000010000, 0x0000},
-	{0xBFBFFFFE00000010, 0xB000},
-	{0x0010000000020000, 0x0000},
-	{0xBD1E0CA2C8E65406, 0x8000},
-	{0x0010000000040000, 0x0000},
-	{0xC3C1FFFFFFFEFFFF, 0xFC00},
-	{0x0010000000080000, 0x0000},
-	{0x0967880AA63389BA, 0x0000},
-	{0x0010000000100000, 0x0000},
-	{0x3F2940F6E0691904, 0x0A50},
-	{0x0010000000200000, 0x0000},
-	{0x37EC3F078E782C40, 0x0000},
-	{0x0010000000400000, 0x0000},
-	{0x38CFFFFE00000FFE, 0x0000},
-	{0x0010000000800000, 0x0000},
-	{0x41CDFFFFFFFFFFFB, 0x7C00},
-	{0x0010000001000000, 0x0000},
-	{0x802FEFFFFEFFFFFF, 0x8000},
-	{0x0010000002000000, 0x0000},
-	{0x41FFFFFFE000007F, 0x7C00},
-	{0x0010000004000000, 0x0000},
-	{0xC1EEDFFFFFFFFFFF, 0xFC00},
-	{0x0010000008000000, 0x0000},
-	{0xC2AA95D63C3A7CB9, 0xFC00},
-	{0x0010000010000000, 0x0000},
-	{0x802D983393DAF920, 0x8000},
-	{0x0010000020000000, 0x0000},
-	{0xBD7FEFFFFFF00000, 0x8000},
-	{0x0010000040000000, 0x0000},
-	{0x3EE000000001FDFF, 0x0080},
-	{0x0010000080000000, 0x0000},
-	{0x41DFFDFFFFFBFFFF, 0x7C00},
-	{0x0010000100000000, 0x0000},
-	{0x7FFC0001FFFFFFFF, 0x7F00},
-	{0x0010000200000000, 0x0000},
-	{0xFFFC000004000000, 0xFF00},
-	{0x0010000400000000, 0x0000},
-	{0x3800200007FFFFFE, 0x0000},
-	{0x0010000800000000, 0x0000},
-	{0xC7FD00A72C3276F8, 0xFC00},
-	{0x0010001000000000, 0x0000},
-	{0xFFD00000007FFFFF, 0xFC00},
-	{0x0010002000000000, 0x0000},
-	{0xC1D001000000FFFF, 0xFC00},
-	{0x0010004000000000, 0x0000},
-	{0x46BC5BC11B94DA87, 0x7C00},
-	{0x0010008000000000, 0x0000},
-	{0xC000004000000FFE, 0xC000},
-	{0x0010010000000000, 0x0000},
-	{0xC01FAD9C52B36B03, 0xC7EB},
-	{0x0010020000000000, 0x0000},
-	{0x47F4DEAB873B7E38, 0x7C00},
-	{0x0010040000000000, 0x0000},
-	{0xBF8F34D34DBD7493, 0xA3CD},
-	{0x0010080000000000, 0x0000},
-	{0xC0C0000001FEFFFF, 0xF000},
-	{0x0010100000000000, 0x0000},
-	{0xBFE000000000403F, 0xB800},
-	{0x0010200000000000, 0x0000},
-	{0xBFC000000000003E, 0xB000},
-	{0x0010400000000000, 0x0000},
-	{0x43401007FFFFFFFF, 0x7C00},
-	{0x0010800000000000, 0x0000},
-	{0x306FFFFBFFFFFF7F, 0x0000},
-	{0x0011000000000000, 0x0000},
-	{0xC3F7FFFFE0000000, 0xFC00},
-	{0x0012000000000000, 0x0000},
-	{0x7FFFDFF7FFFFFFFE, 0x7FF7},
-	{0x0014000000000000, 0x0000},
-	{0xBFCFFC0003FFFFFE, 0xB3FF},
-	{0x0018000000000000, 0x0000},
-	{0xC3EFFFFF80008000, 0xFC00},
-	{0x001C000000000000, 0x0000},
-	{0x41C7245E4EADD3BC, 0x7C00},
-	{0x001E000000000000, 0x0000},
-	{0xC01FFFFFFFFFFF3F, 0xC800},
-	{0x001F000000000000, 0x0000},
-	{0xC80FBFFFFEFFFFFF, 0xFC00},
-	{0x001F800000000000, 0x0000},
-	{0x44EFFFFFFFF7FBFE, 0x7C00},
-	{0x001FC00000000000, 0x0000},
-	{0xBF9003FFFFFEFFFE, 0xA401},
-	{0x001FE00000000000, 0x0000},
-	{0x3FFFFFF80001FFFF, 0x4000},
-	{0x001FF00000000000, 0x0000},
-	{0x467000001FFFFEFF, 0x7C00},
-	{0x001FF80000000000, 0x0000},
-	{0x5A5BDC5B98637129, 0x7C00},
-	{0x001FFC0000000000, 0x0000},
-	{0x3DF874A46EFCCCF5, 0x0000},
-	{0x001FFE0000000000, 0x0000},
-	{0x380FFFBFFDFFFFFE, 0x0000},
-	{0x001FFF0000000000, 0x0000},
-	{0x1584652CA0898DDD, 0x0000},
-	{0x001FFF8000000000, 0x0000},
-	{0xA891196E1609C9AA, 0x8000},
-	{0x001FFFC000000000, 0x0000},
-	{0x3FDFFDFFFFFFFFF7, 0x37FF},
-	{0x001FFFE000000000, 0x0000},
-	{0xBEBFF00002000000, 0x8020},
-	{0x001FFFF000000000, 0x0000},
-	{0x000D366BAD562C1E, 0x0000},
-	{0x001FFFF800000000, 0x0000},
-	{0x406BFFFFFFFF0000, 0x5B00},
-	{0x001FFFFC00000000, 0x0000},
-	{0xB0E0000000001FFD, 0x8000},
-	{0x001FFFFE00000000, 0x0000},
-	{0xB810C0B294A1377C, 0x8000},
-	{0x001FFFFF00000000, 0x0000},
-	{0x47FFFFFFFFFAFFFF, 0x7C00},
-	{0x001FFFFF80000000, 0x0000},
-	{0x7FF20007FFFFFFFF, 0x7E80},
-	{0x001FFFFFC0000000, 0x0000},
-	{0xBFDBC82E78AD6130, 0xB6F2},
-	{0x001FFFFFE0000000, 0x0000},
-	{0x3FCFFFF000000008, 0x3400},
-	{0x001FFFFFF0000000, 0x0000},
-	{0xAA200000003FF7FF, 0x8000},
-	{0x001FFFFFF8000000, 0x0000},
-	{0x37F0000000001FF6, 0x0000},
-	{0x001FFFFFFC000000, 0x0000},
-	{0xC1E7FFFFFFFEFFFF, 0xFC00},
-	{0x001FFFFFFE000000, 0x0000},
-	{0x3B20000001FFEFFF, 0x0000},
-	{0x001FFFFFFF000000, 0x0000},
-	{0x3BA0100800000000, 0x0000},
-	{0x001FFFFFFF800000, 0x0000},
-	{0x41FFFFFFFFFDFFBF, 0x7C00},
-	{0x001FFFFFFFC00000, 0x0000},
-	{0xC1CFFBFFFFFDFFFF, 0xFC00},
-	{0x001FFFFFFFE00000, 0x0000},
-	{0xC00FFFFFFFFF0000, 0xC400},
-	{0x001FFFFFFFF00000, 0x0000},
-	{0x3D0EE029009D504A, 0x0000},
-	{0x001FFFFFFFF80000, 0x0000},
-	{0x3EF0000007FEFFFF, 0x0100},
-	{0x001FFFFFFFFC0000, 0x0000},
-	{0x43EFFFFFFFC0001F, 0x7C00},
-	{0x001FFFFFFFFE0000, 0x0000},
-	{0x41EFFFFFF7FFFFF6, 0x7C00},
-	{0x001FFFFFFFFF0000, 0x0000},
-	{0xC3E001FFF7FFFFFF, 0xFC00},
-	{0x001FFFFFFFFF8000, 0x0000},
-	{0x3FEF80003FFFFFFF, 0x3BE0},
-	{0x001FFFFFFFFFC000, 0x0000},
-	{0xC1C0F25E497C32E7, 0xFC00},
-	{0x001FFFFFFFFFE000, 0x0000},
-	{0xC7E0087FFFFFFFFF, 0xFC00},
-	{0x001FFFFFFFFFF000, 0x0000},
-	{0x402FFFFFFFFFFFCF, 0x4C00},
-	{0x001FFFFFFFFFF800, 0x0000},
-	{0xBFF00000800FFFFF, 0xBC00},
-	{0x001FFFFFFFFFFC00, 0x0000},
-	{0xC04BFC0000000000, 0xD2FF},
-	{0x001FFFFFFFFFFE00, 0x0000},
-	{0xC3E0000400000FFF, 0xFC00},
-	{0x001FFFFFFFFFFF00, 0x0000},
-	{0x3FFFFFFFFDFFFBFF, 0x4000},
-	{0x001FFFFFFFFFFF80, 0x0000},
-	{0xC26F5DC367749379, 0xFC00},
-	{0x001FFFFFFFFFFFC0, 0x0000},
-	{0xC110000003FF7FFF, 0xFC00},
-	{0x001FFFFFFFFFFFE0, 0x0000},
-	{0xC1EDF7FFFFFFFFFE, 0xFC00},
-	{0x001FFFFFFFFFFFF0, 0x0000},
-	{0xB8B0003FFF800000, 0x8000},
-	{0x001FFFFFFFFFFFF8, 0x0000},
-	{0xC0DFF80000001FFE, 0xF7FE},
-	{0x001FFFFFFFFFFFFC, 0x0000},
-	{0x402FFFFFE0080000, 0x4C00},
-	{0x001FFFFFFFFFFFFE, 0x0000},
-	{0xBE6FFFDFFFFFF800, 0x8001},
-	{0x001FFFFFFFFFFFFF, 0x0000},
-	{0xB36D0D9A2C51AE88, 0x8000},
-	{0x001FFFFFFFFFFFFD, 0x0000},
-	{0x441FFFFFFFEFFF80, 0x7C00},
-	{0x001FFFFFFFFFFFFB, 0x0000},
-	{0xB08FFFFFFFF0003F, 0x8000},
-	{0x001FFFFFFFFFFFF7, 0x0000},
-	{0xC7E4549EAD718FD6, 0xFC00},
-	{0x001FFFFFFFFFFFEF, 0x0000},
-	{0xC4FC00000000000F, 0xFC00},
-	{0x001FFFFFFFFFFFDF, 0x0000},
-	{0x43F0000000007FEE, 0x7C00},
-	{0x001FFFFFFFFFFFBF, 0x0000},
-	{0xA93020000000000F, 0x8000},
-	{0x001FFFFFFFFFFF7F, 0x0000},
-	{0xC0203FFFFFFFFFDE, 0xC810},
-	{0x001FFFFFFFFFFEFF, 0x0000},
-	{0xC7E3798D9B81DC17, 0xFC00},
-	{0x001FFFFFFFFFFDFF, 0x0000},
-	{0x306FFF7FDFFFFFFE, 0x0000},
-	{0x001FFFFFFFFFFBFF, 0x0000},
-	{0x7FDFFFFFFC0003FF, 0x7C00},
-	{0x001FFFFFFFFFF7FF, 0x0000},
-	{0x0000007FC0000000, 0x0000},
-	{0x001FFFFFFFFFEFFF, 0x0000},
-	{0xC8E7590F2F6DAE27, 0xFC00},
-	{0x001FFFFFFFFFDFFF, 0x0000},
-	{0x8010003FFFFBFFFF, 0x8000},
-	{0x001FFFFFFFFFBFFF, 0x0000},
-	{0x400FFFC000000800, 0x4400},
-	{0x001FFFFFFFFF7FFF, 0x0000},
-	{0xB96FFFFFF7FFF7FF, 0x8000},
-	{0x001FFFFFFFFEFFFF, 0x0000},
-	{0xBF2003FFFFFBFFFF, 0x8801},
-	{0x001FFFFFFFFDFFFF, 0x0000},
-	{0xC3DFFFFFFF000002, 0xFC00},
-	{0x001FFFFFFFFBFFFF, 0x0000},
-	{0xBF500103FFFFFFFF, 0x9400},
-	{0x001FFFFFFFF7FFFF, 0x0000},
-	{0x47F00001FFFFFFFE, 0x7C00},
-	{0x001FFFFFFFEFFFFF, 0x0000},
-	{0x40A0000001080000, 0x6800},
-	{0x001FFFFFFFDFFFFF, 0x0000},
-	{0x000020001FFFFFFF, 0x0000},
-	{0x001FFFFFFFBFFFFF, 0x0000},
-	{0x001000803FFFFFFF, 0x0000},
-	{0x001FFFFFFF7FFFFF, 0x0000},
-	{0xBCAA480876A5DDC2, 0x8000},
-	{0x001FFFFFFEFFFFFF, 0x0000},
-	{0xC0200000000100FF, 0xC800},
-	{0x001FFFFFFDFFFFFF, 0x0000},
-	{0x000FFFFFFFFFFF40, 0x0000},
-	{0x001FFFFFFBFFFFFF, 0x0000},
-	{0xDF300003FFFFF000, 0xFC00},
-	{0x001FFFFFF7FFFFFF, 0x0000},
-	{0xA37FFFFFDFFFFFBE, 0x8000},
-	{0x001FFFFFEFFFFFFF, 0x0000},
-	{0x9E419387BF0D3E9C, 0x8000},
-	{0x001FFFFFDFFFFFFF, 0x0000},
-	{0xC080040007FFFFFE, 0xE001},
-	{0x001FFFFFBFFFFFFF, 0x0000},
-	{0xBF000200007FFFFE, 0x8200},
-	{0x001FFFFF7FFFFFFF, 0x0000},
-	{0x3FE0000001EFFFFF, 0x3800},
-	{0x001FFFFEFFFFFFFF, 0x0000},
-	{0xC0F000080000007F, 0xFC00},
-	{0x001FFFFDFFFFFFFF, 0x0000},
-	{0x7FF0000010000003, 0x7E00},
-	{0x001FFFFBFFFFFFFF, 0x0000},
-	{0xBCA0000000200200, 0x8000},
-	{0x001FFFF7FFFFFFFF, 0x0000},
-	{0x7FF7FFFFFFFBFFFE, 0x7FFF},
-	{0x001FFFEFFFFFFFFF, 0x0000},
-	{0x3FF0004000008000, 0x3C00},
-	{0x001FFFDFFFFFFFFF, 0x0000},
-	{0x8006DCC5B2094C53, 0x8000},
-	{0x001FFFBFFFFFFFFF, 0x0000},
-	{0xBFC000003FFFFEFF, 0xB000},
-	{0x001FFF7FFFFFFFFF, 0x0000},
-	{0xFDACA9D3ABCC8522, 0xFC00},
-	{0x001FFEFFFFFFFFFF, 0x0000},
-	{0xCF9DFFFFFF7FFFFF, 0xFC00},
-	{0x001FFDFFFFFFFFFF, 0x0000},
-	{0xBB23A56BE2BADF77, 0x8000},
-	{0x001FFBFFFFFFFFFF, 0x0000},
-	{0x9EA1FFFFFFFFFFEF, 0x8000},
-	{0x001FF7FFFFFFFFFF, 0x0000},
-	{0x390FFFFC00010000, 0x0000},
-	{0x001FEFFFFFFFFFFF, 0x0000},
-	{0x4030000003FFFFBF, 0x4C00},
-	{0x001FDFFFFFFFFFFF, 0x0000},
-	{0xB80254AC39DC3A48, 0x8000},
-	{0x001FBFFFFFFFFFFF, 0x0000},
-	{0xB8100002FFFFFFFE, 0x8000},
-	{0x001F7FFFFFFFFFFF, 0x0000},
-	{0xB7F00080000001FE, 0x8000},
-	{0x001EFFFFFFFFFFFF, 0x0000},
-	{0xB7F0403FFFFFFFFF, 0x8000},
-	{0x001DFFFFFFFFFFFF, 0x0000},
-	{0xBFC0002000003FFE, 0xB000},
-	{0x001BFFFFFFFFFFFF, 0x0000},
-	{0x9AFFFF7FFFFFFFFE, 0x8000},
-	{0x0017FFFFFFFFFFFF, 0x0000},
-	{0x40061AC47BDF98D0, 0x4187},
-	{0x0013FFFFFFFFFFFF, 0x0000},
-	{0x41DE2364B25D9D5F, 0x7C00},
-	{0x0011FFFFFFFFFFFF, 0x0000},
-	{0x7FEFFFFFF9FFFFFE, 0x7C00},
-	{0x0010FFFFFFFFFFFF, 0x0000},
-	{0xFFFFFFFFEFFEFFFF, 0xFFFF},
-	{0x00107FFFFFFFFFFF, 0x0000},
-	{0x3F50000000107FFF, 0x1400},
-	{0x00103FFFFFFFFFFF, 0x0000},
-	{0xC00FE0007FFFFFFE, 0xC3F8},
-	{0x00101FFFFFFFFFFF, 0x0000},
-	{0x3FCFEDFFFFFFFFFF, 0x33FB},
-	{0x00100FFFFFFFFFFF, 0x0000},
-	{0x3FE007FFFEFFFFFF, 0x3802},
-	{0x001007FFFFFFFFFF, 0x0000},
-	{0x40663B35909C4021, 0x598F},
-	{0x001003FFFFFFFFFF, 0x0000},
-	{0xC1DE00000000007E, 0xFC00},
-	{0x001001FFFFFFFFFF, 0x0000},
-	{0xB86FFFFFFFFFFF76, 0x8000},
-	{0x001000FFFFFFFFFF, 0x0000},
-	{0x800394A49BB4A84E, 0x8000},
-	{0x0010007FFFFFFFFF, 0x0000},
-	{0xF9B00003FFFFFFF8, 0xFC00},
-	{0x0010003FFFFFFFFF, 0x0000},
-	{0x41DFFFEFFFFFFFFB, 0x7C00},
-	{0x0010001FFFFFFFFF, 0x0000},
-	{0xC1FFFFFFF0000003, 0xFC00},
-	{0x0010000FFFFFFFFF, 0x0000},
-	{0x802CAEFAD5253AD5, 0x8000},
-	{0x00100007FFFFFFFF, 0x0000},
-	{0x3FDA68F6DA50533B, 0x369A},
-	{0x00100003FFFFFFFF, 0x0000},
-	{0x4032249C0D2CE25B, 0x4C89},
-	{0x00100001FFFFFFFF, 0x0000},
-	{0xC1F000000077FFFF, 0xFC00},
-	{0x00100000FFFFFFFF, 0x0000},
-	{0x41F00000008FFFFF, 0x7C00},
-	{0x001000007FFFFFFF, 0x0000},
-	{0x41E0014000000000, 0x7C00},
-	{0x001000003FFFFFFF, 0x0000},
-	{0xC1C0000000400200, 0xFC00},
-	{0x001000001FFFFFFF, 0x0000},
-	{0xC3DFFFFFFF7FFDFE, 0xFC00},
-	{0x001000000FFFFFFF, 0x0000},
-	{0xBDAB697E6C0BEF09, 0x8000},
-	{0x0010000007FFFFFF, 0x0000},
-	{0xBBEFFFFC007FFFFF, 0x8000},
-	{0x0010000003FFFFFF, 0x0000},
-	{0xC0B3FFFF80000000, 0xED00},
-	{0x0010000001FFFFFF, 0x0000},
-	{0x3FEC57FD2D153C5E, 0x3B16},
-	{0x0010000000FFFFFF, 0x0000},
-	{0xC1D0001DFFFFFFFE, 0xFC00},
-	{0x00100000007FFFFF, 0x0000},
-	{0xC13800000000007F, 0xFC00},
-	{0x00100000003FFFFF, 0x0000},
-	{0x41F0007F00000000, 0x7C00},
-	{0x00100000001FFFFF, 0x0000},
-	{0xD73028C3E0F937CD, 0xFC00},
-	{0x00100000000FFFFF, 0x0000},
-	{0x7FE0000000FFFEFF, 0x7C00},
-	{0x001000000007FFFF, 0x0000},
-	{0xC1C0000FFFFFFFEF, 0xFC00},
-	{0x001000000003FFFF, 0x0000},
-	{0x403B8DEB8F75B64E, 0x4EE3},
-	{0x001000000001FFFF, 0x0000},
-	{0xCF01157FFB0B2C05, 0xFC00},
-	{0x001000000000FFFF, 0x0000},
-	{0x403631993287B358, 0x4D8C},
-	{0x0010000000007FFF, 0x0000},
-	{0xBFD1FFFFFEFFFFFF, 0xB480},
-	{0x0010000000003FFF, 0x0000},
-	{0x413FFB7FFFFFFFFF, 0x7C00},
-	{0x0010000000001FFF, 0x0000},
-	{0xBF9FFFFE00200000, 0xA800},
-	{0x0010000000000FFF, 0x0000},
-	{0xBFFFDED680A1D1E8, 0xBFF8},
-	{0x00100000000007FF, 0x0000},
-	{0x3E6FFFFFFFFFFF78, 0x0001},
-	{0x00100000000003FF, 0x0000},
-	{0x3FC0000000027FFF, 0x3000},
-	{0x00100000000001FF, 0x0000},
-	{0x3FA0001FFF800000, 0x2800},
-	{0x00100000000000FF, 0x0000},
-	{0x463FFFFFDFFFF000, 0x7C00},
-	{0x001000000000007F, 0x0000},
-	{0xC030100100000000, 0xCC04},
-	{0x001000000000003F, 0x0000},
-	{0x41D00007FFF80000, 0x7C00},
-	{0x001000000000001F, 0x0000},
-	{0xC3CFE00000001FFF, 0xFC00},
-	{0x001000000000000F, 0x0000},
-	{0xC0BFDFFFFFFFFFDF, 0xEFF8},
-	{0x0010000000000007, 0x0000},
-	{0xC3FFFFFFFBFBFFFF, 0xFC00},
-	{0x0010000000000003, 0x0000},
-	{0xBCAA3DDA297FC9AA, 0x8000},
-	{0x0020000000000000, 0x0000},
-	{0xC341E98358651E1F, 0xFC00},
-	{0x0020000000000001, 0x0000},
-	{0xB7E000000087FFFF, 0x8000},
-	{0x0020000000000002, 0x0000},
-	{0x5F1FF00000000010, 0x7C00},
-	{0x0020000000000004, 0x0000},
-	{0xBFDFDFFFFFFFFF7F, 0xB7F8},
-	{0x0020000000000008, 0x0000},
-	{0x406FBFF000000000, 0x5BF0},
-	{0x0020000000000010, 0x0000},
-	{0x0020040000003FFF, 0x0000},
-	{0x0020000000000020, 0x0000},
-	{0xBE200003FEFFFFFF, 0x8000},
-	{0x0020000000000040, 0x0000},
-	{0x3E41487119B55B61, 0x0000},
-	{0x0020000000000080, 0x0000},
-	{0x3FEFFFFFFFFE7FFF, 0x3C00},
-	{0x0020000000000100, 0x0000},
-	{0x381000000201FFFE, 0x0000},
-	{0x0020000000000200, 0x0000},
-	{0xBF7ABE4ECD61B829, 0x9EB0},
-	{0x0020000000000400, 0x0000},
-	{0xB7FFFFFFFFFFFDFF, 0x8000},
-	{0x0020000000000800, 0x0000},
-	{0x41CCFF834A2087B2, 0x7C00},
-	{0x0020000000001000, 0x0000},
-	{0x48BEFFFFFFFE0000, 0x7C00},
-	{0x0020000000002000, 0x0000},
-	{0x43F0D545582E2344, 0x7C00},
-	{0x0020000000004000, 0x0000},
-	{0xC0A00100001FFFFF, 0xE800},
-	{0x0020000000008000, 0x0000},
-	{0x00024EF51E983D4A, 0x0000},
-	{0x0020000000010000, 0x0000},
-	{0xB51E007FFFFFFFFE, 0x8000},
-	{0x0020000000020000, 0x0000},
-	{0x3CAFF80000800000, 0x0000},
-	{0x0020000000040000, 0x0000},
-	{0xFFDCA99FF6BC9677, 0xFC00},
-	{0x0020000000080000, 0x0000},
-	{0xC1DFFFEFF7FFFFFF, 0xFC00},
-	{0x0020000000100000, 0x0000},
-	{0xBFDA0998CFF7F1B2, 0xB682},
-	{0x0020000000200000, 0x0000},
-	{0xBFC7DE5D4D05D894, 0xB1F8},
-	{0x0020000000400000, 0x0000},
-	{0x000FBE0000000000, 0x0000},
-	{0x0020000000800000, 0x0000},
-	{0x47FCB7E6C1CD6C24, 0x7C00},
-	{0x0020000001000000, 0x0000},
-	{0xC4BFFFFFF8400000, 0xFC00},
-	{0x0020000002000000, 0x0000},
-	{0x43400000043FFFFF, 0x7C00},
-	{0x0020000004000000, 0x0000},
-	{0x3E9C000000000000, 0x0007},
-	{0x0020000008000000, 0x0000},
-	{0xC3F5F8B9D111C7F9, 0xFC00},
-	{0x0020000010000000, 0x0000},
-	{0x3FFD58BAFAA14763, 0x3F56},
-	{0x0020000020000000, 0x0000},
-	{0x41CEFFFBFFFFFFFF, 0x7C00},
-	{0x0020000040000000, 0x0000},
-	{0x3CAFFFFBFFFDFFFE, 0x0000},
-	{0x0020000080000000, 0x0000},
-	{0xC0B0000003FFF7FE, 0xEC00},
-	{0x0020000100000000, 0x0000},
-	{0x7FDE0000000003FF, 0x7C00},
-	{0x0020000200000000, 0x0000},
-	{0x3FCFC00400000000, 0x33F0},
-	{0x0020000400000000, 0x0000},
-	{0xBFDFF0001FFFFFFE, 0xB7FC},
-	{0x0020000800000000, 0x0000},
-	{0x41FFF7FEFFFFFFFE, 0x7C00},
-	{0x0020001000000000, 0x0000},
-	{0x2E6FFFFFFFFFE3FF, 0x0000},
-	{0x0020002000000000, 0x0000},
-	{0x4000000200000007, 0x4000},
-	{0x0020004000000000, 0x0000},
-	{0xC3E00000000020FF, 0xFC00},
-	{0x0020008000000000, 0x0000},
-	{0x3DFD6DA33FC052A1, 0x0000},
-	{0x0020010000000000, 0x0000},
-	{0xBFE0200000001000, 0xB808},
-	{0x0020020000000000, 0x0000},
-	{0x3F10000040000010, 0x0400},
-	{0x0020040000000000, 0x0000},
-	{0x7FD200000000003F, 0x7C00},
-	{0x0020080000000000, 0x0000},
-	{0x801FFFFFE0020000, 0x8000},
-	{0x0020100000000000, 0x0000},
-	{0x400B28331E82FAE2, 0x42CA},
-	{0x0020200000000000, 0x0000},
-	{0xFFFFFFF7FFFEFFFF, 0xFFFF},
-	{0x0020400000000000, 0x0000},
-	{0x00119ABCC89C5493, 0x0000},
-	{0x0020800000000000, 0x0000},
-	{0xF92520608BBDF8CD, 0xFC00},
-	{0x0021000000000000, 0x0000},
-	{0xBFB01FFFFEFFFFFF, 0xAC08},
-	{0x0022000000000000, 0x0000},
-	{0x5E5DF059E122B94D, 0x7C00},
-	{0x0024000000000000, 0x0000},
-	{0x800BBA7741F9ED9A, 0x8000},
-	{0x0028000000000000, 0x0000},
-	{0xB68EF6D536EFA11E, 0x8000},
-	{0x002C000000000000, 0x0000},
-	{0x74A03FF7FFFFFFFF, 0x7C00},
-	{0x002E000000000000, 0x0000},
-	{0x403FFFFFF7FFFDFF, 0x5000},
-	{0x002F000000000000, 0x0000},
-	{0x8020000001FFC000, 0x8000},
-	{0x002F800000000000, 0x0000},
-	{0x420FFFFFF7FFFFFB, 0x7C00},
-	{0x002FC00000000000, 0x0000},
-	{0xC3B7482FE37F4040, 0xFC00},
-	{0x002FE00000000000, 0x0000},
-	{0xBFD0001FFFFFBFFF, 0xB400},
-	{0x002FF00000000000, 0x0000},
-	{0xC3F78EE5A72BBF50, 0xFC00},
-	{0x002FF80000000000, 0x0000},
-	{0xFFDFFFFFFFC00003, 0xFC00},
-	{0x002FFC0000000000, 0x0000},
-	{0x4700000003FFFF7E, 0x7C00},
-	{0x002FFE0000000000, 0x0000},
-	{0x4DCFFFFFFFC001FE, 0x7C00},
-	{0x002FFF0000000000, 0x0000},
-	{0x32FBFFFDFFFFFFFF, 0x0000},
-	{0x002FFF8000000000, 0x0000},
-	{0xFFEFFFFF7FFFFFBE, 0xFC00},
-	{0x002FFFC000000000, 0x0000},
-	{0x43E01FFE00000000, 0x7C00},
-	{0x002FFFE000000000, 0x0000},
-	{0x3FC7ED65CD5C984E, 0x31FB},
-	{0x002FFFF000000000, 0x0000},
-	{0xC340000000011FFF, 0xFC00},
-	{0x002FFFF800000000, 0x0000},
-	{0x402FE00003FFFFFF, 0x4BF8},
-	{0x002FFFFC00000000, 0x0000},
-	{0xBCA7FFFFFFFFFFFC, 0x8000},
-	{0x002FFFFE00000000, 0x0000},
-	{0x3FE0000000000011, 0x3800},
-	{0x002FFFFF00000000, 0x0000},
-	{0xB13A46293206148E, 0x8000},
-	{0x002FFFFF80000000, 0x0000},
-	{0x43CFFFFFFE0007FF, 0x7C00},
-	{0x002FFFFFC0000000, 0x0000},
-	{0x4580400000000000, 0x7C00},
-	{0x002FFFFFE0000000, 0x0000},
-	{0x4210007FEFFFFFFE, 0x7C00},
-	{0x002FFFFFF0000000, 0x0000},
-	{0xEE67FFFFE0000000, 0xFC00},
-	{0x002FFFFFF8000000, 0x0000},
-	{0x43C00FFFFFFFEFFF, 0x7C00},
-	{0x002FFFFFFC000000, 0x0000},
-	{0x80171909A729397C, 0x8000},
-	{0x002FFFFFFE000000, 0x0000},
-	{0x3D4FFE00000003FF, 0x0000},
-	{0x002FFFFFFF000000, 0x0000},
-	{0x4903FFFFFFFFF800, 0x7C00},
-	{0x002FFFFFFF800000, 0x0000},
-	{0xFFD5951E110B8BBF, 0xFC00},
-	{0x002FFFFFFFC00000, 0x0000},
-	{0x3FCFFFFFFF7F7FFF, 0x3400},
-	{0x002FFFFFFFE00000, 0x0000},
-	{0x3CA0000000042000, 0x0000},
-	{0x002FFFFFFFF00000, 0x0000},
-	{0x37F23FFFFFFFFFFF, 0x0000},
-	{0x002FFFFFFFF80000, 0x0000},
-	{0x3FD0000FFFFDFFFF, 0x3400},
-	{0x002FFFFFFFFC0000, 0x0000},
-	{0xC02FFFFFBFFDFFFF, 0xCC00},
-	{0x002FFFFFFFFE0000, 0x0000},
-	{0x419000100000007F, 0x7C00},
-	{0x002FFFFFFFFF0000, 0x0000},
-	{0xD65FFFFFF800007E, 0xFC00},
-	{0x002FFFFFFFFF8000, 0x0000},
-	{0xC520000000200001, 0xFC00},
-	{0x002FFFFFFFFFC000, 0x0000},
-	{0xC1DFFBFFFFFFBFFF, 0xFC00},
-	{0x002FFFFFFFFFE000, 0x0000},
-	{0x3FEDCA0E36861DA4, 0x3B73},
-	{0x002FFFFFFFFFF000, 0x0000},
-	{0x381000000037FFFF, 0x0000},
-	{0x002FFFFFFFFFF800, 0x0000},
-	{0xBBD91ADF3DF83AA9, 0x8000},
-	{0x002FFFFFFFFFFC00, 0x0000},
-	{0xB80001DFFFFFFFFF, 0x8000},
-	{0x002FFFFFFFFFFE00, 0x0000},
-	{0x26500001000007FF, 0x0000},
-	{0x002FFFFFFFFFFF00, 0x0000},
-	{0x3CAB2661B7EFF8F1, 0x0000},
-	{0x002FFFFFFFFFFF80, 0x0000},
-	{0x425BFFFBFFFFFFFF, 0x7C00},
-	{0x002FFFFFFFFFFFC0, 0x0000},
-	{0xC001FFF7FFFFFFFF, 0xC080},
-	{0x002FFFFFFFFFFFE0, 0x0000},
-	{0x00180AA505CFE7D2, 0x0000},
-	{0x002FFFFFFFFFFFF0, 0x0000},
-	{0x3F9034B7C58DF3A0, 0x240D},
-	{0x002FFFFFFFFFFFF8, 0x0000},
-	{0xC1FFFE000007FFFF, 0xFC00},
-	{0x002FFFFFFFFFFFFC, 0x0000},
-	{0x7FE0007FFFFDFFFF, 0x7C00},
-	{0x002FFFFFFFFFFFFE, 0x0000},
-	{0xFFF0000003FFFF00, 0xFE00},
-	{0x002FFFFFFFFFFFFF, 0x0000},
-	{0xEF2F81FFFFFFFFFF, 0xFC00},
-	{0x002FFFFFFFFFFFFD, 0x0000},
-	{0x3F0FFFFFF80000FF, 0x0400},
-	{0x002FFFFFFFFFFFFB, 0x0000},
-	{0x407FF7FBFFFFFFFF, 0x5FFE},
-	{0x002FFFFFFFFFFFF7, 0x0000},
-	{0x1F4FFFFFFFF7F000, 0x0000},
-	{0x002FFFFFFFFFFFEF, 0x0000},
-	{0x4020000000040002, 0x4800},
-	{0x002FFFFFFFFFFFDF, 0x0000},
-	{0xBFDFFF7EFFFFFFFF, 0xB800},
-	{0x002FFFFFFFFFFFBF, 0x0000},
-	{0x343FFFFBFF800000, 0x0000},
-	{0x002FFFFFFFFFFF7F, 0x0000},
-	{0x396FFFFFEFFFDFFF, 0x0000},
-	{0x002FFFFFFFFFFEFF, 0x0000},
-	{0x423FF0000000007E, 0x7C00},
-	{0x002FFFFFFFFFFDFF, 0x0000},
-	{0xC5C67EBEB0ADE5F8, 0xFC00},
-	{0x002FFFFFFFFFFBFF, 0x0000},
-	{0x9AA00001000001FF, 0x8000},
-	{0x002FFFFFFFFFF7FF, 0x0000},
-	{0x4090040200000000, 0x6401},
-	{0x002FFFFFFFFFEFFF, 0x0000},
-	{0xBEEFFFDFBFFFFFFF, 0x8100},
-	{0x002FFFFFFFFFDFFF, 0x0000},
-	{0xC030000002000400, 0xCC00},
-	{0x002FFFFFFFFFBFFF, 0x0000},
-	{0x44E6CBFB6B662BA4, 0x7C00},
-	{0x002FFFFFFFFF7FFF, 0x0000},
-	{0xC3E0000000020003, 0xFC00},
-	{0x002FFFFFFFFEFFFF, 0x0000},
-	{0xC5090AAB0E9816D8, 0xFC00},
-	{0x002FFFFFFFFDFFFF, 0x0000},
-	{0x435800000003FFFE, 0x7C00},
-	{0x002FFFFFFFFBFFFF, 0x0000},
-	{0xC3D84934B95CFFEB, 0xFC00},
-	{0x002FFFFFFFF7FFFF, 0x0000},
-	{0xBF1FFFFEFFFFFFFA, 0x8800},
-	{0x002FFFFFFFEFFFFF, 0x0000},
-	{0xC0FFFFFFFFFFF400, 0xFC00},
-	{0x002FFFFFFFDFFFFF, 0x0000},
-	{0x80000000001FFFFA, 0x8000},
-	{0x002FFFFFFFBFFFFF, 0x0000},
-	{0xCA30000001001000, 0xFC00},
-	{0x002FFFFFFF7FFFFF, 0x0000},
-	{0xC3EFFFFFFFC00FFE, 0xFC00},
-	{0x002FFFFFFEFFFFFF, 0x0000},
-	{0xC00000000800007F, 0xC000},
-	{0x002FFFFFFDFFFFFF, 0x0000},
-	{0x7FE0000000000050, 0x7C00},
-	{0x002FFFFFFBFFFFFF, 0x0000},
-	{0xA0F003F7FFFFFFFF, 0x8000},
-	{0x002FFFFFF7FFFFFF, 0x0000},
-	{0xBFEFC07FFFFFFFFE, 0xBBF0},
-	{0x002FFFFFEFFFFFFF, 0x0000},
-	{0xB85FFFFFFEFFFFFD, 0x8000},
-	{0x002FFFFFDFFFFFFF, 0x0000},
-	{0x3FE00000000000FF, 0x3800},
-	{0x002FFFFFBFFFFFFF, 0x0000},
-	{0xBFF0004400000000, 0xBC00},
-	{0x002FFFFF7FFFFFFF, 0x0000},
-	{0x000FFFFFFF80003F, 0x0000},
-	{0x002FFFFEFFFFFFFF, 0x0000},
-	{0xB7FFE001FFFFFFFF, 0x8000},
-	{0x002FFFFDFFFFFFFF, 0x0000},
-	{0xBE100000003FFFDF, 0x8000},
-	{0x002FFFFBFFFFFFFF, 0x0000},
-	{0xD2EFFFFFFFFFFC0E, 0xFC00},
-	{0x002FFFF7FFFFFFFF, 0x0000},
-	{0xB7FFFDFFFFFDFFFF, 0x8000},
-	{0x002FFFEFFFFFFFFF, 0x0000},
-	{0xBFE81BCE18F7E202, 0xBA07},
-	{0x002FFFDFFFFFFFFF, 0x0000},
-	{0xBCA0000001FBFFFF, 0x8000},
-	{0x002FFFBFFFFFFFFF, 0x0000},
-	{0x43EFC00000000001, 0x7C00},
-	{0x002FFF7FFFFFFFFF, 0x0000},
-	{0xC3CFFFFFE000001E, 0xFC00},
-	{0x002FFEFFFFFFFFFF, 0x0000},
-	{0x7FD401FFFFFFFFFF, 0x7C00},
-	{0x002FFDFFFFFFFFFF, 0x0000},
-	{0xC3F000000001007F, 0xFC00},
-	{0x002FFBFFFFFFFFFF, 0x0000},
-	{0xC000100008000000, 0xC004},
-	{0x002FF7FFFFFFFFFF, 0x0000},
-	{0x009FFFFEFFFFBFFF, 0x0000},
-	{0x002FEFFFFFFFFFFF, 0x0000},
-	{0x4000080000000008, 0x4002},
-	{0x002FDFFFFFFFFFFF, 0x0000},
-	{0x40144575B15BDA0B, 0x4511},
-	{0x002FBFFFFFFFFFFF, 0x0000},
-	{0x00125C0D3226E803, 0x0000},
-	{0x002F7FFFFFFFFFFF, 0x0000},
-	{0xB00000000001FF7E, 0x8000},
-	{0x002EFFFFFFFFFFFF, 0x0000},
-	{0xC346048A6649C9CF, 0xFC00},
-	{0x002DFFFFFFFFFFFF, 0x0000},
-	{0xC02FFFFC00000004, 0xCC00},
-	{0x002BFFFFFFFFFFFF, 0x0000},
-	{0x3F9429EA2235E05E, 0x250A},
-	{0x0027FFFFFFFFFFFF, 0x0000},
-	{0xC0020F1C41301023, 0xC084},
-	{0x0023FFFFFFFFFFFF, 0x0000},
-	{0x40FFFFFFC0000001, 0x7C00},
-	{0x0021FFFFFFFFFFFF, 0x0000},
-	{0x00210000007FFFFE, 0x0000},
-	{0x0020FFFFFFFFFFFF, 0x0000},
-	{0xB80FFFFFFFFF0020, 0x8000},
-	{0x00207FFFFFFFFFFF, 0x0000},
-	{0x480537D9D91EE74D, 0x7C00},
-	{0x00203FFFFFFFFFFF, 0x0000},
-	{0x402FFFFFFBFC0000, 0x4C00},
-	{0x00201FFFFFFFFFFF, 0x0000},
-	{0xC0101FFFFFFFFFFB, 0xC408},
-	{0x00200FFFFFFFFFFF, 0x0000},
-	{0xC3DFFFBFFFFF8000, 0xFC00},
-	{0x002007FFFFFFFFFF, 0x0000},
-	{0xC03BFFFFFFFFFFF6, 0xCF00},
-	{0x002003FFFFFFFFFF, 0x0000},
-	{0x289FFFFF7FDFFFFE, 0x0000},
-	{0x002001FFFFFFFFFF, 0x0000},
-	{0x00100000000FFC00, 0x0000},
-	{0x002000FFFFFFFFFF, 0x0000},
-	{0xC51FFFFFFDFFFFFE, 0xFC00},
-	{0x0020007FFFFFFFFF, 0x0000},
-	{0xC017FFFFFBFFFFFF, 0xC600},
-	{0x0020003FFFFFFFFF, 0x0000},
-	{0xC80007FFFFFFBFFF, 0xFC00},
-	{0x0020001FFFFFFFFF, 0x0000},
-	{0x43DFE01000000000, 0x7C00},
-	{0x0020000FFFFFFFFF, 0x0000},
-	{0xC16FF003FFFFFFFF, 0xFC00},
-	{0x00200007FFFFFFFF, 0x0000},
-	{0x0025C22444DD6A11, 0x0000},
-	{0x00200003FFFFFFFF, 0x0000},
-	{0xC7EFFC000001FFFF, 0xFC00},
-	{0x00200001FFFFFFFF, 0x0000},
-	{0x41EFF000000000FF, 0x7C00},
-	{0x00200000FFFFFFFF, 0x0000},
-	{0x485000000000201F, 0x7C00},
-	{0x002000007FFFFFFF, 0x0000},
-	{0x3E16A58C3F933E9A, 0x0000},
-	{0x002000003FFFFFFF, 0x0000},
-	{0xBF38A324AA0C2B3A, 0x8E29},
-	{0x002000001FFFFFFF, 0x0000},
-	{0x401FFFFEFFFFDFFF, 0x4800},
-	{0x002000000FFFFFFF, 0x0000},
-	{0x380F33E5C8032E48, 0x0000},
-	{0x0020000007FFFFFF, 0x0000},
-	{0xC3DB9E88BCF02A86, 0xFC00},
-	{0x0020000003FFFFFF, 0x0000},
-	{0x7FEFFFFFFC001FFF, 0x7C00},
-	{0x0020000001FFFFFF, 0x0000},
-	{0x47F158B29A13EB6C, 0x7C00},
-	{0x0020000000FFFFFF, 0x0000},
-	{0x0010008FFFFFFFFF, 0x0000},
-	{0x00200000007FFFFF, 0x0000},
-	{0x480DFFFFBFFFFFFE, 0x7C00},
-	{0x00200000003FFFFF, 0x0000},
-	{0x402FFFFDFFFFFFBF, 0x4C00},
-	{0x00200000001FFFFF, 0x0000},
-	{0x404FFFBFFFFFFFFB, 0x5400},
-	{0x00200000000FFFFF, 0x0000},
-	{0x43C00BFFFFFFFFFF, 0x7C00},
-	{0x002000000007FFFF, 0x0000},
-	{0xC1E0000018000000, 0xFC00},
-	{0x002000000003FFFF, 0x0000},
-	{0x40341C0B5A8D181A, 0x4D07},
-	{0x002000000001FFFF, 0x0000},
-	{0xC34F800000003FFF, 0xFC00},
-	{0x002000000000FFFF, 0x0000},
-	{0xBFB0000000080000, 0xAC00},
-	{0x0020000000007FFF, 0x0000},
-	{0x381000FFFFFFFFFE, 0x0000},
-	{0x0020000000003FFF, 0x0000},
-	{0x17FFFFDFFFFFDFFE, 0x0000},
-	{0x0020000000001FFF, 0x0000},
-	{0x0007D2464D34CE14, 0x0000},
-	{0x0020000000000FFF, 0x0000},
-	{0xB7D00001FFFFF800, 0x8000},
-	{0x00200000000007FF, 0x0000},
-	{0x97F0007FFDFFFFFE, 0x8000},
-	{0x00200000000003FF, 0x0000},
-	{0x3CADB3599343CCBB, 0x0000},
-	{0x00200000000001FF, 0x0000},
-	{0x85EACFB327C2C4F4, 0x8000},
-	{0x00200000000000FF, 0x0000},
-	{0xC3EFFFF0000001FF, 0xFC00},
-	{0x002000000000007F, 0x0000},
-	{0x4003E00000000000, 0x40F8},
-	{0x002000000000003F, 0x0000},
-	{0xC3DFFF7FFFEFFFFF, 0xFC00},
-	{0x002000000000001F, 0x0000},
-	{0xC3D29488ACD5DCDD, 0xFC00},
-	{0x002000000000000F, 0x0000},
-	{0xC3F4B276BC4A4BC3, 0xFC00},
-	{0x0020000000000007, 0x0000},
-	{0xC03000000007F7FE, 0xCC00},
-	{0x0020000000000003, 0x0000},
-	{0x3FF0000003FFFFFE, 0x3C00},
-	{0x37E0000000000000, 0x0000},
-	{0x3FEDFFFFFFFFFFDF, 0x3B80},
-	{0x37E0000000000001, 0x0000},
-	{0xC3CFFE003FFFFFFF, 0xFC00},
-	{0x37E0000000000002, 0x0000},
-	{0xB8100000007F7FFF, 0x8000},
-	{0x37E0000000000004, 0x0000},
-	{0x3FBFEFFFF7FFFFFF, 0x2FFC},
-	{0x37E0000000000008, 0x0000},
-	{0xBF2A4D2FBD80C21F, 0x8A93},
-	{0x37E0000000000010, 0x0000},
-	{0x800003FFFFBFFFFF, 0x8000},
-	{0x37E0000000000020, 0x0000},
-	{0x3FC0000FF7FFFFFE, 0x3000},
-	{0x37E0000000000040, 0x0000},
-	{0xC34FFFFFFE03FFFF, 0xFC00},
-	{0x37E0000000000080, 0x0000},
-	{0x3C0D5757FC1F23F1, 0x0000},
-	{0x37E0000000000100, 0x0000},
-	{0xC1FFFFFEFFFFFFF8, 0xFC00},
-	{0x37E0000000000200, 0x0000},
-	{0xB7B000000000BFFE, 0x8000},
-	{0x37E0000000000400, 0x0000},
-	{0x3FBFFC1FFFFFFFFE, 0x2FFF},
-	{0x37E0000000000800, 0x0000},
-	{0x40F7EE6EA0B41609, 0x7C00},
-	{0x37E0000000001000, 0x0000},
-	{0xBCF0002000000FFE, 0x8000},
-	{0x37E0000000002000, 0x0000},
-	{0xBFAFFFEBFFFFFFFF, 0xAC00},
-	{0x37E0000000004000, 0x0000},
-	{0x312FFF800003FFFE, 0x0000},
-	{0x37E0000000008000, 0x0000},
-	{0xBFEFFFFFFFFFF807, 0xBC00},
-	{0x37E0000000010000, 0x0000},
-	{0xC1C0000000000000, 0xFC00},
-	{0x37E0000000020000, 0x0000},
-	{0x41EFFBFFFFBFFFFF, 0x7C00},
-	{0x37E0000000040000, 0x0000},
-	{0x4340F034FAEC4975, 0x7C00},
-	{0x37E0000000080000, 0x0000},
-	{0x3A2F001000000000, 0x0000},
-	{0x37E0000000100000, 0x0000},
-	{0xFFDFFFFFFE800000, 0xFC00},
-	{0x37E0000000200000, 0x0000},
-	{0xBFCFFFFFFFFFFBEE, 0xB400},
-	{0x37E0000000400000, 0x0000},
-	{0x3F57FFEFFFFFFFFE, 0x1600},
-	{0x37E0000000800000, 0x0000},
-	{0x3D2FFFFFFFFFF07F, 0x0000},
-	{0x37E0000001000000, 0x0000},
-	{0x4105FADDD64F4D14, 0x7C00},
-	{0x37E0000002000000, 0x0000},
-	{0xBFCC50D02F0DE261, 0xB314},
-	{0x37E0000004000000, 0x0000},
-	{0x3BFFFFDFFFFEFFFE, 0x0000},
-	{0x37E0000008000000, 0x0000},
-	{0xC3C00000010000FF, 0xFC00},
-	{0x37E0000010000000, 0x0000},
-	{0xBFEFBF8000000000, 0xBBF0},
-	{0x37E0000020000000, 0x0000},
-	{0x40074621AA300634, 0x41D2},
-	{0x37E0000040000000, 0x0000},
-	{0xCA2CC52AE294D656, 0xFC00},
-	{0x37E0000080000000, 0x0000},
-	{0xBFFF8007FFFFFFFF, 0xBFE0},
-	{0x37E0000100000000, 0x0000},
-	{0xBC9F7FFFFFFFFFFE, 0x8000},
-	{0x37E0000200000000, 0x0000},
-	{0xBF60000000008040, 0x9800},
-	{0x37E0000400000000, 0x0000},
-	{0x41BFFC0000003FFF, 0x7C00},
-	{0x37E0000800000000, 0x0000},
-	{0x414FFFF7FEFFFFFF, 0x7C00},
-	{0x37E0001000000000, 0x0000},
-	{0xB7F841F38DE1BF8D, 0x8000},
-	{0x37E0002000000000, 0x0000},
-	{0xC3F0000100004000, 0xFC00},
-	{0x37E0004000000000, 0x0000},
-	{0xBFF9B766CB255E31, 0xBE6E},
-	{0x37E0008000000000, 0x0000},
-	{0xE28F7FE000000000, 0xFC00},
-	{0x37E0010000000000, 0x0000},
-	{0x7FE0003FFFFFBFFF, 0x7C00},
-	{0x37E0020000000000, 0x0000},
-	{0xBF9E0420C8CD434C, 0xA781},
-	{0x37E0040000000000, 0x0000},
-	{0x3FD6B9325E630E8A, 0x35AE},
-	{0x37E0080000000000, 0x0000},
-	{0x403EFFFFC0000000, 0x4FC0},
-	{0x37E0100000000000, 0x0000},
-	{0x4ABFFFFFFFBFFE00, 0x7C00},
-	{0x37E0200000000000, 0x0000},
-	{0xC1E540D5BD90D150, 0xFC00},
-	{0x37E0400000000000, 0x0000},
-	{0x3CAC7FFFFFFFFFFE, 0x0000},
-	{0x37E0800000000000, 0x0000},
-	{0x43FFFFE0000FFFFE, 0x7C00},
-	{0x37E1000000000000, 0x0000},
-	{0xC060001003FFFFFF, 0xD800},
-	{0x37E2000000000000, 0x0000},
-	{0x41FC000000007FFF, 0x7C00},
-	{0x37E4000000000000, 0x0000},
-	{0xBFE0000407FFFFFE, 0xB800},
-	{0x37E8000000000000, 0x0000},
-	{0xC36007FFFFFFFFF0, 0xFC00},
-	{0x37EC000000000000, 0x0000},
-	{0x40300000083FFFFF, 0x4C00},
-	{0x37EE000000000000, 0x0000},
-	{0x40210000000000FE, 0x4840},
-	{0x37EF000000000000, 0x0000},
-	{0xBE40FFFFFFBFFFFF, 0x8000},
-	{0x37EF800000000000, 0x0000},
-	{0xC00FFFFFFEFFFFFE, 0xC400},
-	{0x37EFC00000000000, 0x0000},
-	{0x412FFFFFF8000800, 0x7C00},
-	{0x37EFE00000000000, 0x0000},
-	{0x39EFFFFFFFE00400, 0x0000},
-	{0x37EFF00000000000, 0x0000},
-	{0x1EA00023FFFFFFFF, 0x0000},
-	{0x37EFF80000000000, 0x0000},
-	{0x3FE4000000020000, 0x3900},
-	{0x37EFFC0000000000, 0x0000},
-	{0xBFF0000003FFFFC0, 0xBC00},
-	{0x37EFFE0000000000, 0x0000},
-	{0xC02FFFFEFFEFFFFF, 0xCC00},
-	{0x37EFFF0000000000, 0x0000},
-	{0xBDB63A517E39A83B, 0x8000},
-	{0x37EFFF8000000000, 0x0000},
-	{0xB8000020007FFFFF, 0x8000},
-	{0x37EFFFC000000000, 0x0000},
-	{0x403000000FFFFFF7, 0x4C00},
-	{0x37EFFFE000000000, 0x0000},
-	{0xBFDE00001FFFFFFE, 0xB780},
-	{0x37EFFFF000000000, 0x0000},
-	{0xC013FFFFFFFFFBFF, 0xC500},
-	{0x37EFFFF800000000, 0x0000},
-	{0x41CFFFFFF8000001, 0x7C00},
-	{0x37EFFFFC00000000, 0x0000},
-	{0x802B04E159268971, 0x8000},
-	{0x37EFFFFE00000000, 0x0000},
-	{0xBFEFFFF00000001E, 0xBC00},
-	{0x37EFFFFF00000000, 0x0000},
-	{0x4000000040004000, 0x4000},
-	{0x37EFFFFF80000000, 0x0000},
-	{0x3FF03FFFFFFFFFFE, 0x3C10},
-	{0x37EFFFFFC0000000, 0x0000},
-	{0x3EA0000001FFF7FF, 0x0008},
-	{0x37EFFFFFE0000000, 0x0000},
-	{0x34CFFFEFFFFFFBFF, 0x0000},
-	{0x37EFFFFFF0000000, 0x0000},
-	{0xC00DC4E2AAB29421, 0xC371},
-	{0x37EFFFFFF8000000, 0x0000},
-	{0xC02E8EDD38F2A574, 0xCBA4},
-	{0x37EFFFFFFC000000, 0x0000},
-	{0xC0A26DBDC2EE3486, 0xE89B},
-	{0x37EFFFFFFE000000, 0x0000},
-	{0xC068A42D485779B2, 0xDA29},
-	{0x37EFFFFFFF000000, 0x0000},
-	{0x4000004020000000, 0x4000},
-	{0x37EFFFFFFF800000, 0x0000},
-	{0x3FAFFFFFFFFFEDFF, 0x2C00},
-	{0x37EFFFFFFFC00000, 0x0000},
-	{0xBCF000000007FFFE, 0x8000},
-	{0x37EFFFFFFFE00000, 0x0000},
-	{0x002FFFFFFFFC001E, 0x0000},
-	{0x37EFFFFFFFF00000, 0x0000},
-	{0x3BF006D030C7C6F9, 0x0000},
-	{0x37EFFFFFFFF80000, 0x0000},
-	{0x43F0000004000800, 0x7C00},
-	{0x37EFFFFFFFFC0000, 0x0000},
-	{0xBFC0002003FFFFFF, 0xB000},
-	{0x37EFFFFFFFFE0000, 0x0000},
-	{0x3FEFFFFFFFFFE0FE, 0x3C00},
-	{0x37EFFFFFFFFF0000, 0x0000},
-	{0xF21000000800001E, 0xFC00},
-	{0x37EFFFFFFFFF8000, 0x0000},
-	{0x3A90000108000000, 0x0000},
-	{0x37EFFFFFFFFFC000, 0x0000},
-	{0x35A06376DEA35C69, 0x0000},
-	{0x37EFFFFFFFFFE000, 0x0000},
-	{0xBFBB00E7C79C5A23, 0xAEC0},
-	{0x37EFFFFFFFFFF000, 0x0000},
-	{0xC0000004FFFFFFFE, 0xC000},
-	{0x37EFFFFFFFFFF800, 0x0000},
-	{0x3CAEB24FA43B2533, 0x0000},
-	{0x37EFFFFFFFFFFC00, 0x0000},
-	{0xB7FFBFFFEFFFFFFF, 0x8000},
-	{0x37EFFFFFFFFFFE00, 0x0000},
-	{0xC003C00000000000, 0xC0F0},
-	{0x37EFFFFFFFFFFF00, 0x0000},
-	{0x075F2395FFDA3A65, 0x0000},
-	{0x37EFFFFFFFFFFF80, 0x0000},
-	{0xBFE0040004000000, 0xB801},
-	{0x37EFFFFFFFFFFFC0, 0x0000},
-	{0xB37FFF9FFFFFFFFF, 0x8000},
-	{0x37EFFFFFFFFFFFE0, 0x0000},
-	{0x3FB00000FFFFFFFB, 0x2C00},
-	{0x37EFFFFFFFFFFFF0, 0x0000},
-	{0x0010010800000000, 0x0000},
-	{0x37EFFFFFFFFFFFF8, 0x0000},
-	{0x408FFF00003FFFFF, 0x6400},
-	{0x37EFFFFFFFFFFFFC, 0x0000},
-	{0xC02D3C43E9F5F4C3, 0xCB4F},
-	{0x37EFFFFFFFFFFFFE, 0x0000},
-	{0xBFFFFFFFFFFC07FE, 0xC000},
-	{0x37EFFFFFFFFFFFFF, 0x0000},
-	{0x37F1CC4B7B7F385C, 0x0000},
-	{0x37EFFFFFFFFFFFFD, 0x0000},
-	{0xC02FFFFFFFFFFFEC, 0xCC00},
-	{0x37EFFFFFFFFFFFFB, 0x0000},
-	{0x7FE8000000000010, 0x7C00},
-	{0x37EFFFFFFFFFFFF7, 0x0000},
-	{0xC058991C106EA6D1, 0xD626},
-	{0x37EFFFFFFFFFFFEF, 0x0000},
-	{0xC1D753516136EEC4, 0xFC00},
-	{0x37EFFFFFFFFFFFDF, 0x0000},
-	{0x4C9FFFF7C0000000, 0x7C00},
-	{0x37EFFFFFFFFFFFBF, 0x0000},
-	{0x402C7FFFFFFFFFFF, 0x4B20},
-	{0x37EFFFFFFFFFFF7F, 0x0000},
-	{0x802FFFFFF8040000, 0x8000},
-	{0x37EFFFFFFFFFFEFF, 0x0000},
-	{0xCC42000000000FFE, 0xFC00},
-	{0x37EFFFFFFFFFFDFF, 0x0000},
-	{0xAC7FFFFFF8004000, 0x8000},
-	{0x37EFFFFFFFFFFBFF, 0x0000},
-	{0x37E01000001FFFFF, 0x0000},
-	{0x37EFFFFFFFFFF7FF, 0x0000},
-	{0x77D002000003FFFF, 0x7C00},
-	{0x37EFFFFFFFFFEFFF, 0x0000},
-	{0x41FFD9E566D0B3EB, 0x7C00},
-	{0x37EFFFFFFFFFDFFF, 0x0000},
-	{0xABE00007FFF80000, 0x8000},
-	{0x37EFFFFFFFFFBFFF, 0x0000},
-	{0xC08A6E089DF108B5, 0xE29C},
-	{0x37EFFFFFFFFF7FFF, 0x0000},
-	{0x002FFFF000100000, 0x0000},
-	{0x37EFFFFFFFFEFFFF, 0x0000},
-	{0xFFFFEFBFFFFFFFFF, 0xFFFB},
-	{0x37EFFFFFFFFDFFFF, 0x0000},
-	{0xC80FFBFF7FFFFFFE, 0xFC00},
-	{0x37EFFFFFFFFBFFFF, 0x0000},
-	{0xC7F0000003FFFBFE, 0xFC00},
-	{0x37EFFFFFFFF7FFFF, 0x0000},
-	{0x3FFFFFFFDFFFF7FF, 0x4000},
-	{0x37EFFFFFFFEFFFFF, 0x0000},
-	{0x3FE71F19E64FBC97, 0x39C8},
-	{0x37EFFFFFFFDFFFFF, 0x0000},
-	{0xFFEC000000003FFF, 0xFC00},
-	{0x37EFFFFFFFBFFFFF, 0x0000},
-	{0xB81461D834D25F59, 0x8000},
-	{0x37EFFFFFFF7FFFFF, 0x0000},
-	{0xBFF793AF76F48368, 0xBDE5},
-	{0x37EFFFFFFEFFFFFF, 0x0000},
-	{0x43C0000040200000, 0x7C00},
-	{0x37EFFFFFFDFFFFFF, 0x0000},
-	{0x3F58000001FFFFFF, 0x1600},
-	{0x37EFFFFFFBFFFFFF, 0x0000},
-	{0x3F80000200000003, 0x2000},
-	{0x37EFFFFFF7FFFFFF, 0x0000},
-	{0xBF702E3CE51A8AA1, 0x9C0C},
-	{0x37EFFFFFEFFFFFFF, 0x0000},
-	{0xBE38C64DC63B27D8, 0x8000},
-	{0x37EFFFFFDFFFFFFF, 0x0000},
-	{0x0061FFFFFFFEFFFF, 0x0000},
-	{0x37EFFFFFBFFFFFFF, 0x0000},
-	{0xB80FFFC0000001FF, 0x8000},
-	{0x37EFFFFF7FFFFFFF, 0x0000},
-	{0xDC400000040000FF, 0xFC00},
-	{0x37EFFFFEFFFFFFFF, 0x0000},
-	{0x38100000000001EF, 0x0000},
-	{0x37EFFFFDFFFFFFFF, 0x0000},
-	{0x404FFFF7FFFFFFBF, 0x5400},
-	{0x37EFFFFBFFFFFFFF, 0x0000},
-	{0x41DF00000007FFFE, 0x7C00},
-	{0x37EFFFF7FFFFFFFF, 0x0000},
-	{0xC070000000001FEF, 0xDC00},
-	{0x37EFFFEFFFFFFFFF, 0x0000},
-	{0x7FFBFFFFFFFDFFFE, 0x7EFF},
-	{0x37EFFFDFFFFFFFFF, 0x0000},
-	{0x42B02000000000FE, 0x7C00},
-	{0x37EFFFBFFFFFFFFF, 0x0000},
-	{0x480FFFFFFFFC1000, 0x7C00},
-	{0x37EFFF7FFFFFFFFF, 0x0000},
-	{0xB80FEFFFF0000000, 0x8000},
-	{0x37EFFEFFFFFFFFFF, 0x0000},
-	{0x5EDBFFFFFEFFFFFF, 0x7C00},
-	{0x37EFFDFFFFFFFFFF, 0x0000},
-	{0xBFF10FDAAC02B9A5, 0xBC44},
-	{0x37EFFBFFFFFFFFFF, 0x0000},
-	{0x7920000FEFFFFFFF, 0x7C00},
-	{0x37EFF7FFFFFFFFFF, 0x0000},
-	{0x24A84CFF6EA16420, 0x0000},
-	{0x37EFEFFFFFFFFFFF, 0x0000},
-	{0x40E000000FEFFFFF, 0x7800},
-	{0x37EFDFFFFFFFFFFF, 0x0000},
-	{0x43421B8C094FE746, 0x7C00},
-	{0x37EFBFFFFFFFFFFF, 0x0000},
-	{0x3FCFFFFFDFFFFFFE, 0x3400},
-	{0x37EF7FFFFFFFFFFF, 0x0000},
-	{0xC005B49713EDD7C0, 0xC16D},
-	{0x37EEFFFFFFFFFFFF, 0x0000},
-	{0x43FE200000000000, 0x7C00},
-	{0x37EDFFFFFFFFFFFF, 0x0000},
-	{0xBFDFFFFFFBEFFFFE, 0xB800},
-	{0x37EBFFFFFFFFFFFF, 0x0000},
-	{0x434FFFFF80000100, 0x7C00},
-	{0x37E7FFFFFFFFFFFF, 0x0000},
-	{0xC1F6925DEABC78ED, 0xFC00},
-	{0x37E3FFFFFFFFFFFF, 0x0000},
-	{0x43E0000000001F7F, 0x7C00},
-	{0x37E1FFFFFFFFFFFF, 0x0000},
-	{0x404FFFFFF800001E, 0x5400},
-	{0x37E0FFFFFFFFFFFF, 0x0000},
-	{0x3FD0000020000002, 0x3400},
-	{0x37E07FFFFFFFFFFF, 0x0000},
-	{0xBEDCCC97B35B5FAA, 0x8073},
-	{0x37E03FFFFFFFFFFF, 0x0000},
-	{0xBD0FFFFFF4000000, 0x8000},
-	{0x37E01FFFFFFFFFFF, 0x0000},
-	{0x29328D8600531F6F, 0x0000},
-	{0x37E00FFFFFFFFFFF, 0x0000},
-	{0x380000800001FFFF, 0x0000},
-	{0x37E007FFFFFFFFFF, 0x0000},
-	{0x43D03FFFFFFFFBFE, 0x7C00},
-	{0x37E003FFFFFFFFFF, 0x0000},
-	{0xB81800000000001F, 0x8000},
-	{0x37E001FFFFFFFFFF, 0x0000},
-	{0x0C5A5A905C09497F, 0x0000},
-	{0x37E000FFFFFFFFFF, 0x0000},
-	{0x356FF00000000FFE, 0x0000},
-	{0x37E0007FFFFFFFFF, 0x0000},
-	{0xFBDFFFFFFFFFC000, 0xFC00},
-	{0x37E0003FFFFFFFFF, 0x0000},
-	{0x7FD0200010000000, 0x7C00},
-	{0x37E0001FFFFFFFFF, 0x0000},
-	{0xC1EFE000003FFFFE, 0xFC00},
-	{0x37E0000FFFFFFFFF, 0x0000},
-	{0xC0FF6DC3614F1955, 0xFC00},
-	{0x37E00007FFFFFFFF, 0x0000},
-	{0xC3E00000003FFEFE, 0xFC00},
-	{0x37E00003FFFFFFFF, 0x0000},
-	{0xC36FFFFFFFFFFFFF, 0xFC00},
-	{0x37E00001FFFFFFFF, 0x0000},
-	{0xC051000080000000, 0xD440},
-	{0x37E00000FFFFFFFF, 0x0000},
-	{0x383FFFFFBFFFBFFF, 0x0000},
-	{0x37E000007FFFFFFF, 0x0000},
-	{0xBF6FFFF00000001E, 0x9C00},
-	{0x37E000003FFFFFFF, 0x0000},
-	{0x4A4FFFFFFFFF0006, 0x7C00},
-	{0x37E000001FFFFFFF, 0x0000},
-	{0x3E5FDFFFF0000000, 0x0000},
-	{0x37E000000FFFFFFF, 0x0000},
-	{0xEDADBEB66D2DD258, 0xFC00},
-	{0x37E0000007FFFFFF, 0x0000},
-	{0xC03FFFFFFF7FFFF7, 0xD000},
-	{0x37E0000003FFFFFF, 0x0000},
-	{0xC3DE0FA165F64E34, 0xFC00},
-	{0x37E0000001FFFFFF, 0x0000},
-	{0xB800040007FFFFFF, 0x8000},
-	{0x37E0000000FFFFFF, 0x0000},
-	{0xC1E00003FFFFFBFE, 0xFC00},
-	{0x37E00000007FFFFF, 0x0000},
-	{0xC1CFFDFFFFFFFFFD, 0xFC00},
-	{0x37E00000003FFFFF, 0x0000},
-	{0x3FDF9EC9954245A0, 0x37E8},
-	{0x37E00000001FFFFF, 0x0000},
-	{0xB69FFFE0FFFFFFFF, 0x8000},
-	{0x37E00000000FFFFF, 0x0000},
-	{0x3FBFFFFFFFFFFFFF, 0x3000},
-	{0x37E000000007FFFF, 0x0000},
-	{0x38191A507BE1B3B6, 0x0000},
-	{0x37E000000003FFFF, 0x0000},
-	{0x0010000000000FC0, 0x0000},
-	{0x37E000000001FFFF, 0x0000},
-	{0x3FCD9E249150A26A, 0x3368},
-	{0x37E000000000FFFF, 0x0000},
-	{0xC0169DD086912366, 0xC5A7},
-	{0x37E0000000007FFF, 0x0000},
-	{0x380FFFC003FFFFFF, 0x0000},
-	{0x37E0000000003FFF, 0x0000},
-	{0x3FEFE00003FFFFFF, 0x3BF8},
-	{0x37E0000000001FFF, 0x0000},
-	{0xD320008FFFFFFFFF, 0xFC00},
-	{0x37E0000000000FFF, 0x0000},
-	{0xC000000008FFFFFE, 0xC000},
-	{0x37E00000000007FF, 0x0000},
-	{0x41D1573BEFCAD5BE, 0x7C00},
-	{0x37E00000000003FF, 0x0000},
-	{0x801FFFBFFFFFFEFF, 0x8000},
-	{0x37E00000000001FF, 0x0000},
-	{0xC1AFFFFFBFFFFF7E, 0xFC00},
-	{0x37E00000000000FF, 0x0000},
-	{0x001607F9D53CCA95, 0x0000},
-	{0x37E000000000007F, 0x0000},
-	{0xC00000000001FFEF, 0xC000},
-	{0x37E000000000003F, 0x0000},
-	{0x54DFFFFFFF03FFFF, 0x7C00},
-	{0x37E000000000001F, 0x0000},
-	{0xBFBFFE0080000000, 0xB000},
-	{0x37E000000000000F, 0x0000},
-	{0x3FC03FFFFFFFFFFD, 0x3010},
-	{0x37E0000000000007, 0x0000},
-	{0xBFA000000001FC00, 0xA800},
-	{0x37E0000000000003, 0x0000},
-	{0xC3CFFFEFFFFFFFFF, 0xFC00},
-	{0x37F0000000000000, 0x0000},
-	{0xBC55E0B267A744E8, 0x8000},
-	{0x37F0000000000001, 0x0000},
-	{0xC04FFFFFFBFFFFEF, 0xD400},
-	{0x37F0000000000002, 0x0000},
-	{0x8370002003FFFFFF, 0x8000},
-	{0x37F0000000000004, 0x0000},
-	{0xC1ED69BA87D2E4CC, 0xFC00},
-	{0x37F0000000000008, 0x0000},
-	{0xBFC0001000004000, 0xB000},
-	{0x37F0000000000010, 0x0000},
-	{0xBFE12D5DA43F0D3A, 0xB84B},
-	{0x37F0000000000020, 0x0000},
-	{0xC0004000007FFFFF, 0xC010},
-	{0x37F0000000000040, 0x0000},
-	{0xE5AFFFFFFE0FFFFF, 0xFC00},
-	{0x37F0000000000080, 0x0000},
-	{0xC1DFDFFFFDFFFFFF, 0xFC00},
-	{0x37F0000000000100, 0x0000},
-	{0x47FBB0AFE301610F, 0x7C00},
-	{0x37F0000000000200, 0x0000},
-	{0x5EFFFC0000000200, 0x7C00},
-	{0x37F0000000000400, 0x0000},
-	{0x80122191062F52F8, 0x8000},
-	{0x37F0000000000800, 0x0000},
-	{0x40AFFFA000000000, 0x6C00},
-	{0x37F0000000001000, 0x0000},
-	{0x11B0000000007FFF, 0x0000},
-	{0x37F0000000002000, 0x0000},
-	{0x4B70030000000000, 0x7C00},
-	{0x37F0000000004000, 0x0000},
-	{0xC0DFFFDFFFFFF800, 0xF800},
-	{0x37F0000000008000, 0x0000},
-	{0xBDFB9A57B04A57A7, 0x8000},
-	{0x37F0000000010000, 0x0000},
-	{0x222FFF00001FFFFE, 0x0000},
-	{0x37F0000000020000, 0x0000},
-	{0x40901FFF7FFFFFFE, 0x6408},
-	{0x37F0000000040000, 0x0000},
-	{0xBFD00000000005FE, 0xB400},
-	{0x37F0000000080000, 0x0000},
-	{0xC0ACFAB48919D970, 0xEB3F},
-	{0x37F0000000100000, 0x0000},
-	{0xFFFF800010000000, 0xFFE0},
-	{0x37F0000000200000, 0x0000},
-	{0x37F000080FFFFFFE, 0x0000},
-	{0x37F0000000400000, 0x0000},
-	{0x37FFFFFFFDFFE000, 0x0000},
-	{0x37F0000000800000, 0x0000},
-	{0xC3F0000000000005, 0xFC00},
-	{0x37F0000001000000, 0x0000},
-	{0x4070000000FDFFFF, 0x5C00},
-	{0x37F0000002000000, 0x0000},
-	{0x380E66A9187A683A, 0x0000},
-	{0x37F0000004000000, 0x0000},
-	{0xFFF82D6716E2B8C8, 0xFE0B},
-	{0x37F0000008000000, 0x0000},
-	{0xC3F7BC59C6826176, 0xFC00},
-	{0x37F0000010000000, 0x0000},
-	{0x3FB6D4E97EB652B9, 0x2DB5},
-	{0x37F0000020000000, 0x0000},
-	{0xC3F00003FFFFFFFF, 0xFC00},
-	{0x37F0000040000000, 0x0000},
-	{0xB1C00003FFFF0000, 0x8000},
-	{0x37F0000080000000, 0x0000},
-	{0x44AFFFFFEFFFFFDF, 0x7C00},
-	{0x37F0000100000000, 0x0000},
-	{0x3FC000000080001F, 0x3000},
-	{0x37F0000200000000, 0x0000},
-	{0xFFFEED12EB9EED45, 0xFFBB},
-	{0x37F0000400000000, 0x0000},
-	{0x41F0000FFFDFFFFE, 0x7C00},
-	{0x37F0000800000000, 0x0000},
-	{0xC3E0100003FFFFFF, 0xFC00},
-	{0x37F0001000000000, 0x0000},
-	{0x974EFFFFFFFFFFFE, 0x8000},
-	{0x37F0002000000000, 0x0000},
-	{0xBFEFFFFFFFFF2000, 0xBC00},
-	{0x37F0004000000000, 0x0000},
-	{0x7F307FFFF7FFFFFF, 0x7C00},
-	{0x37F0008000000000, 0x0000},
-	{0x8000000001FFFFF0, 0x8000},
-	{0x37F0010000000000, 0x0000},
-	{0xBCAFFFFE01FFFFFF, 0x8000},
-	{0x37F0020000000000, 0x0000},
-	{0xACB01FFFFF000000, 0x8000},
-	{0x37F0040000000000, 0x0000},
-	{0x000B0C106E8D4468, 0x0000},
-	{0x37F0080000000000, 0x0000},
-	{0xC7EFFFFFFFFFEEFE, 0xFC00},
-	{0x37F0100000000000, 0x0000},
-	{0x3820EBD094575BC4, 0x0000},
-	{0x37F0200000000000, 0x0000},
-	{0xCF2FFFFDFFFFFFFE, 0xFC00},
-	{0x37F0400000000000, 0x0000},
-	{0x43F02000000001FF, 0x7C00},
-	{0x37F0800000000000, 0x0000},
-	{0xBFFEBF02E622F655, 0xBFB0},
-	{0x37F1000000000000, 0x0000},
-	{0x3FB8AE1AF5EBEBB1, 0x2E2C},
-	{0x37F2000000000000, 0x0000},
-	{0x43400203FFFFFFFF, 0x7C00},
-	{0x37F4000000000000, 0x0000},
-	{0x381FF800000003FF, 0x0000},
-	{0x37F8000000000000, 0x0000},
-	{0x41D00020003FFFFF, 0x7C00},
-	{0x37FC000000000000, 0x0000},
-	{0x80061AEB34F5E2BE, 0x8000},
-	{0x37FE000000000000, 0x0000},
-	{0x4082ED73B5201D81, 0x60BB},
-	{0x37FF000000000000, 0x0000},
-	{0x4023FFFFFFFFFFFB, 0x4900},
-	{0x37FF800000000000, 0x0000},
-	{0x47FD6FEF197E125C, 0x7C00},
-	{0x37FFC00000000000, 0x0000},
-	{0x3FEFE00010000000, 0x3BF8},
-	{0x37FFE00000000000, 0x0000},
-	{0xBE5C000000100000, 0x8000},
-	{0x37FFF00000000000, 0x0000},
-	{0x43DF331CE2523278, 0x7C00},
-	{0x37FFF80000000000, 0x0000},
-	{0xBF6003BFFFFFFFFF, 0x9801},
-	{0x37FFFC0000000000, 0x0000},
-	{0x0000CFE899E54A35, 0x0000},
-	{0x37FFFE0000000000, 0x0000},
-	{0x5D8FFFF00000000F, 0x7C00},
-	{0x37FFFF0000000000, 0x0000},
-	{0xC3DFFFFFFFFFFFFD, 0xFC00},
-	{0x37FFFF8000000000, 0x0000},
-	{0x902FFFF8000003FF, 0x8000},
-	{0x37FFFFC000000000, 0x0000},
-	{0xBFCFFC0020000000, 0xB3FF},
-	{0x37FFFFE000000000, 0x0000},
-	{0xBE800FFFFFFFFEFF, 0x8002},
-	{0x37FFFFF000000000, 0x0000},
-	{0x3FC7EFFFFFFFFFFF, 0x31FC},
-	{0x37FFFFF800000000, 0x0000},
-	{0x3C2FFFFFFE000200, 0x0000},
-	{0x37FFFFFC00000000, 0x0000},
-	{0x480FFFFE0000000F, 0x7C00},
-	{0x37FFFFFE00000000, 0x0000},
-	{0xBFBFFFFFFFFBFFFA, 0xB000},
-	{0x37FFFFFF00000000, 0x0000},
-	{0x4B0006BCC3F30582, 0x7C00},
-	{0x37FFFFFF80000000, 0x0000},
-	{0xBFE0001000003FFF, 0xB800},
-	{0x37FFFFFFC0000000, 0x0000},
-	{0x4124EFEFC3501788, 0x7C00},
-	{0x37FFFFFFE0000000, 0x0000},
-	{0xBFFFFF8FFFFFFFFF, 0xC000},
-	{0x37FFFFFFF0000000, 0x0000},
-	{0x3FEB0EA9DC62FFAD, 0x3AC4},
-	{0x37FFFFFFF8000000, 0x0000},
-	{0x4340000000600000, 0x7C00},
-	{0x37FFFFFFFC000000, 0x0000},
-	{0xE4C00000003FFDFF, 0xFC00},
-	{0x37FFFFFFFE000000, 0x0000},
-	{0xC083069BA0005CDE, 0xE0C2},
-	{0x37FFFFFFFF000000, 0x0000},
-	{0x403000803FFFFFFF, 0x4C00},
-	{0x37FFFFFFFF800000, 0x0000},
-	{0x4A5FFFFFF8FFFFFF, 0x7C00},
-	{0x37FFFFFFFFC00000, 0x0000},
-	{0x41C0000007FFFFF6, 0x7C00},
-	{0x37FFFFFFFFE00000, 0x0000},
-	{0x380FFFFFFFFFFFFE, 0x0000},
-	{0x37FFFFFFFFF00000, 0x0000},
-	{0x3DF3646E3F4F623A, 0x0000},
-	{0x37FFFFFFFFF80000, 0x0000},
-	{0x4022007FFFFFFFFE, 0x4880},
-	{0x37FFFFFFFFFC0000, 0x0000},
-	{0x4DF03FFE00000000, 0x7C00},
-	{0x37FFFFFFFFFE0000, 0x0000},
-	{0xBC4D78B625E38F4E, 0x8000},
-	{0x37FFFFFFFFFF0000, 0x0000},
-	{0xC1F0000000FE0000, 0xFC00},
-	{0x37FFFFFFFFFF8000, 0x0000},
-	{0x401FFFEFFFFFFFEE, 0x4800},
-	{0x37FFFFFFFFFFC000, 0x0000},
-	{0x434FFFFFFFFFF900, 0x7C00},
-	{0x37FFFFFFFFFFE000, 0x0000},
-	{0x44AFFFFFFFEFFFFE, 0x7C00},
-	{0x37FFFFFFFFFFF000, 0x0000},
-	{0xDF4D2E2E9AED67D0, 0xFC00},
-	{0x37FFFFFFFFFFF800, 0x0000},
-	{0x40E88A43C114BB82, 0x7A23},
-	{0x37FFFFFFFFFFFC00, 0x0000},
-	{0x0010000FFFFFFF7F, 0x0000},
-	{0x37FFFFFFFFFFFE00, 0x0000},
-	{0x4003FFFDFFFFFFFE, 0x4100},
-	{0x37FFFFFFFFFFFF00, 0x0000},
-	{0x3FFFFFFFBFFFFFFE, 0x4000},
-	{0x37FFFFFFFFFFFF80, 0x0000},
-	{0xC01CC016B34A5B33, 0xC730},
-	{0x37FFFFFFFFFFFFC0, 0x0000},
-	{0x47FFFFFFFFFFEFFC, 0x7C00},
-	{0x37FFFFFFFFFFFFE0, 0x0000},
-	{0x4020000007DFFFFF, 0x4800},
-	{0x37FFFFFFFFFFFFF0, 0x0000},
-	{0x3EF0400000000004, 0x0104},
-	{0x37FFFFFFFFFFFFF8, 0x0000},
-	{0x43CFFFFFE03FFFFF, 0x7C00},
-	{0x37FFFFFFFFFFFFFC, 0x0000},
-	{0x0020020000000007, 0x0000},
-	{0x37FFFFFFFFFFFFFE, 0x0000},
-	{0x34100A8544953719, 0x0000},
-	{0x37FFFFFFFFFFFFFF, 0x0000},
-	{0xC7EFFF7FBFFFFFFF, 0xFC00},
-	{0x37FFFFFFFFFFFFFD, 0x0000},
-	{0xB4500003FFFFFFEE, 0x8000},
-	{0x37FFFFFFFFFFFFFB, 0x0000},
-	{0x0B0BF00000000000, 0x0000},
-	{0x37FFFFFFFFFFFFF7, 0x0000},
-	{0xBCAFFFFFFFFFFFBE, 0x8000},
-	{0x37FFFFFFFFFFFFEF, 0x0000},
-	{0xBCAF7DFFFFFFFFFF, 0x8000},
-	{0x37FFFFFFFFFFFFDF, 0x0000},
-	{0x9E770AB550B03B29, 0x8000},
-	{0x37FFFFFFFFFFFFBF, 0x0000},
-	{0xBF8FF80000000000, 0xA3FE},
-	{0x37FFFFFFFFFFFF7F, 0x0000},
-	{0xFFE248B1C0C9EC65, 0xFC00},
-	{0x37FFFFFFFFFFFEFF, 0x0000},
-	{0xBFF35FE83091CFA9, 0xBCD8},
-	{0x37FFFFFFFFFFFDFF, 0x0000},
-	{0xEB7FFFFFFFFFFF3E, 0xFC00},
-	{0x37FFFFFFFFFFFBFF, 0x0000},
-	{0xC1F000000000010F, 0xFC00},
-	{0x37FFFFFFFFFFF7FF, 0x0000},
-	{0x41E00800007FFFFF, 0x7C00},
-	{0x37FFFFFFFFFFEFFF, 0x0000},
-	{0x0015365904F93D2A, 0x0000},
-	{0x37FFFFFFFFFFDFFF, 0x0000},
-	{0x986FFBFFE0000000, 0x8000},
-	{0x37FFFFFFFFFFBFFF, 0x0000},
-	{0x37EFF7FFFFFFFFF7, 0x0000},
-	{0x37FFFFFFFFFF7FFF, 0x0000},
-	{0x42B0000022000000, 0x7C00},
-	{0x37FFFFFFFFFEFFFF, 0x0000},
-	{0xB7FFC000003FFFFF, 0x8000},
-	{0x37FFFFFFFFFDFFFF, 0x0000},
-	{0xC0EFFF0001000000, 0xFC00},
-	{0x37FFFFFFFFFBFFFF, 0x0000},
-	{0x4491F3FC2CBEED1E, 0x7C00},
-	{0x37FFFFFFFFF7FFFF, 0x0000},
-	{0xC3CFFFFFFFFFFFED, 0xFC00},
-	{0x37FFFFFFFFEFFFFF, 0x0000},
-	{0xC7EFF007FFFFFFFF, 0xFC00},
-	{0x37FFFFFFFFDFFFFF, 0x0000},
-	{0xC03FFC0007FFFFFF, 0xCFFF},
-	{0x37FFFFFFFFBFFFFF, 0x0000},
-	{0xBFDFE1FFFFFFFFFE, 0xB7F8},
-	{0x37FFFFFFFF7FFFFF, 0x0000},
-	{0x3010000000002200, 0x0000},
-	{0x37FFFFFFFEFFFFFF, 0x0000},
-	{0x3808E30FCF8B83D6, 0x0000},
-	{0x37FFFFFFFDFFFFFF, 0x0000},
-	{0xC00FFFFEBFFFFFFF, 0xC400},
-	{0x37FFFFFFFBFFFFFF, 0x0000},
-	{0x4030000000800020, 0x4C00},
-	{0x37FFFFFFF7FFFFFF, 0x0000},
-	{0x3CA8A4A3DE613711, 0x0000},
-	{0x37FFFFFFEFFFFFFF, 0x0000},
-	{0x3FC000000001EFFE, 0x3000},
-	{0x37FFFFFFDFFFFFFF, 0x0000},
-	{0x42E0010000100000, 0x7C00},
-	{0x37FFFFFFBFFFFFFF, 0x0000},
-	{0xCF90000000103FFF, 0xFC00},
-	{0x37FFFFFF7FFFFFFF, 0x0000},
-	{0xBCC0000007FFFF7F, 0x8000},
-	{0x37FFFFFEFFFFFFFF, 0x0000},
-	{0xC31FFFFBBFFFFFFF, 0xFC00},
-	{0x37FFFFFDFFFFFFFF, 0x0000},
-	{0xB990000004000FFF, 0x8000},
-	{0x37FFFFFBFFFFFFFF, 0x0000},
-	{0x37FEFFFFFFFFFBFF, 0x0000},
-	{0x37FFFFF7FFFFFFFF, 0x0000},
-	{0x9E4203FFFFFFFFFF, 0x8000},
-	{0x37FFFFEFFFFFFFFF, 0x0000},
-	{0x402A831B0B8759AB, 0x4AA1},
-	{0x37FFFFDFFFFFFFFF, 0x0000},
-	{0x0000000003FFFFE0, 0x0000},
-	{0x37FFFFBFFFFFFFFF, 0x0000},
-	{0x43DC49425EDCA48D, 0x7C00},
-	{0x37FFFF7FFFFFFFFF, 0x0000},
-	{0xBFDF83FFFFFFFFFF, 0xB7E1},
-	{0x37FFFEFFFFFFFFFF, 0x0000},
-	{0x3802F4CC7095F5C6, 0x0000},
-	{0x37FFFDFFFFFFFFFF, 0x0000},
-	{0x40100000FFFFBFFE, 0x4400},
-	{0x37FFFBFFFFFFFFFF, 0x0000},
-	{0xB7E0FFFFFF7FFFFF, 0x8000},
-	{0x37FFF7FFFFFFFFFF, 0x0000},
-	{0xBE5FFFFFFFF9FFFE, 0x8000},
-	{0x37FFEFFFFFFFFFFF, 0x0000},
-	{0x7D7FFFFFFFFF7FFF, 0x7C00},
-	{0x37FFDFFFFFFFFFFF, 0x0000},
-	{0x3DFAF0712CE4BA50, 0x0000},
-	{0x37FFBFFFFFFFFFFF, 0x0000},
-	{0x435FF01FFFFFFFFF, 0x7C00},
-	{0x37FF7FFFFFFFFFFF, 0x0000},
-	{0x3C3F801FFFFFFFFF, 0x0000},
-	{0x37FEFFFFFFFFFFFF, 0x0000},
-	{0x403040000001FFFE, 0x4C10},
-	{0x37FDFFFFFFFFFFFF, 0x0000},
-	{0xC01020001FFFFFFF, 0xC408},
-	{0x37FBFFFFFFFFFFFF, 0x0000},
-	{0x41EF0000007FFFFE, 0x7C00},
-	{0x37F7FFFFFFFFFFFF, 0x0000},
-	{0x3CCEFFFFFFF7FFFE, 0x0000},
-	{0x37F3FFFFFFFFFFFF, 0x0000},
-	{0x6B8FE0001FFFFFFE, 0x7C00},
-	{0x37F1FFFFFFFFFFFF, 0x0000},
-	{0x3819FFFFFFFFFFFF, 0x0000},
-	{0x37F0FFFFFFFFFFFF, 0x0000},
-	{0xC01FFFFFFFFBFF00, 0xC800},
-	{0x37F07FFFFFFFFFFF, 0x0000},
-	{0x3EDB945FB6F24E20, 0x006E},
-	{0x37F03FFFFFFFFFFF, 0x0000},
-	{0x3FCBFFFFFFFBFFFE, 0x3300},
-	{0x37F01FFFFFFFFFFF, 0x0000},
-	{0x3800000008000FFF, 0x0000},
-	{0x37F00FFFFFFFFFFF, 0x0000},
-	{0xBFE0000017FFFFFF, 0xB800},
-	{0x37F007FFFFFFFFFF, 0x0000},
-	{0xBFCFE00004000000, 0xB3F8},
-	{0x37F003FFFFFFFFFF, 0x0000},
-	{0xC01FFC000000000F, 0xC7FF},
-	{0x37F001FFFFFFFFFF, 0x0000},
-	{0xC03B58E6E5D959F5, 0xCED6},
-	{0x37F000FFFFFFFFFF, 0x0000},
-	{0x401000008000007F, 0x4400},
-	{0x37F0007FFFFFFFFF, 0x0000},
-	{0x3A68DCB70C3CE2EE, 0x0000},
-	{0x37F0003FFFFFFFFF, 0x0000},
-	{0xBFE01FFFBFFFFFFF, 0xB808},
-	{0x37F0001FFFFFFFFF, 0x0000},
-	{0x400FFFFFFFBFFFBF, 0x4400},
-	{0x37F0000FFFFFFFFF, 0x0000},
-	{0xC3F0000002000400, 0xFC00},
-	{0x37F00007FFFFFFFF, 0x0000},
-	{0x0D4FFC0000000002, 0x0000},
-	{0x37F00003FFFFFFFF, 0x0000},
-	{0xBFFFC00001FFFFFF, 0xBFF0},
-	{0x37F00001FFFFFFFF, 0x0000},
-	{0x40100000010FFFFE, 0x4400},
-	{0x37F00000FFFFFFFF, 0x0000},
-	{0xE0C0000000007FDF, 0xFC00},
-	{0x37F000007FFFFFFF, 0x0000},
-	{0xC3DFFFFFFFE3FFFF, 0xFC00},
-	{0x37F000003FFFFFFF, 0x0000},
-	{0x7FD000001FEFFFFE, 0x7C00},
-	{0x37F000001FFFFFFF, 0x0000},
-	{0x3810000040000006, 0x0000},
-	{0x37F000000FFFFFFF, 0x0000},
-	{0xB7E0000000000081, 0x8000},
-	{0x37F0000007FFFFFF, 0x0000},
-	{0x3FCCF70ADFAF6243, 0x333E},
-	{0x37F0000003FFFFFF, 0x0000},
-	{0x41F0000000700000, 0x7C00},
-	{0x37F0000001FFFFFF, 0x0000},
-	{0x41AFDFFFFFFFFFFB, 0x7C00},
-	{0x37F0000000FFFFFF, 0x0000},
-	{0x402FFDFFFFFFFFFF, 0x4BFF},
-	{0x37F00000007FFFFF, 0x0000},
-	{0x409FFFEFFFFC0000, 0x6800},
-	{0x37F00000003FFFFF, 0x0000},
-	{0x2460800000000002, 0x0000},
-	{0x37F00000001FFFFF, 0x0000},
-	{0xBFB3818611DA0ABB, 0xACE0},
-	{0x37F00000000FFFFF, 0x0000},
-	{0x41FFF80000000020, 0x7C00},
-	{0x37F000000007FFFF, 0x0000},
-	{0xBFCFFFFBFFFFFF00, 0xB400},
-	{0x37F000000003FFFF, 0x0000},
-	{0xDD6C000000000040, 0xFC00},
-	{0x37F000000001FFFF, 0x0000},
-	{0xBB11FFFFFFFFFE00, 0x8000},
-	{0x37F000000000FFFF, 0x0000},
-	{0x3FE19A19DDA9BE33, 0x3867},
-	{0x37F0000000007FFF, 0x0000},
-	{0x43DFFFF8000003FF, 0x7C00},
-	{0x37F0000000003FFF, 0x0000},
-	{0x671000FFFE000000, 0x7C00},
-	{0x37F0000000001FFF, 0x0000},
-	{0xBFE10007FFFFFFFF, 0xB840},
-	{0x37F0000000000FFF, 0x0000},
-	{0xFFEFFFFBFFFFFF00, 0xFC00},
-	{0x37F00000000007FF, 0x0000},
-	{0x6946FD16F370180F, 0x7C00},
-	{0x37F00000000003FF, 0x0000},
-	{0x401FFFBFF8000000, 0x4800},
-	{0x37F00000000001FF, 0x0000},
-	{0x401FFFDFFFFBFFFF, 0x4800},
-	{0x37F00000000000FF, 0x0000},
-	{0xBCA00100000007FF, 0x8000},
-	{0x37F000000000007F, 0x0000},
-	{0x4430000007FFF000, 0x7C00},
-	{0x37F000000000003F, 0x0000},
-	{0x3FEFF8000003FFFF, 0x3BFE},
-	{0x37F000000000001F, 0x0000},
-	{0x718FFFFDFFFFDFFE, 0x7C00},
-	{0x37F000000000000F, 0x0000},
-	{0x3FA000400000000E, 0x2800},
-	{0x37F0000000000007, 0x0000},
-	{0x43EFFFF000000000, 0x7C00},
-	{0x37F0000000000003, 0x0000},
-	{0x43FFFFFFFFFFFFED, 0x7C00},
-	{0x3800000000000000, 0x0000},
-	{0x41F7FFFFFFFFFFEF, 0x7C00},
-	{0x3800000000000001, 0x0000},
-	{0xC1E100001FFFFFFE, 0xFC00},
-	{0x3800000000000002, 0x0000},
-	{0x8003FFEFFFFFFFFF, 0x8000},
-	{0x3800000000000004, 0x0000},
-	{0x3FDFFDBFFFFFFFFF, 0x37FF},
-	{0x3800000000000008, 0x0000},
-	{0x2E5FFF800001FFFE, 0x0000},
-	{0x3800000000000010, 0x0000},
-	{0xC3CFFFFFFF801FFF, 0xFC00},
-	{0x3800000000000020, 0x0000},
-	{0x402677C940DBE1CA, 0x499E},
-	{0x3800000000000040, 0x0000},
-	{0x74203F8000000000, 0x7C00},
-	{0x3800000000000080, 0x0000},
-	{0xBE20000001FFFFBF, 0x8000},
-	{0x3800000000000100, 0x0000},
-	{0xBFE1000001FFFFFF, 0xB840},
-	{0x3800000000000200, 0x0000},
-	{0x40600000007FFFF7, 0x5800},
-	{0x3800000000000400, 0x0000},
-	{0xBFE00007FFC00000, 0xB800},
-	{0x3800000000000800, 0x0000},
-	{0x434FFFEE00000000, 0x7C00},
-	{0x3800000000001000, 0x0000},
-	{0xC13E3FB0754F8AB8, 0xFC00},
-	{0x3800000000002000, 0x0000},
-	{0xC03FF01000000000, 0xCFFC},
-	{0x3800000000004000, 0x0000},
-	{0xC32FFFFFFFC0003F, 0xFC00},
-	{0x3800000000008000, 0x0000},
-	{0x40100000000801FF, 0x4400},
-	{0x3800000000010000, 0x0000},
-	{0x47FFFFFFFA000000, 0x7C00},
-	{0x3800000000020000, 0x0000},
-	{0xC1E000EFFFFFFFFF, 0xFC00},
-	{0x3800000000040000, 0x0000},
-	{0xBF60FFFFFFDFFFFF, 0x9840},
-	{0x3800000000080000, 0x0000},
-	{0xC030000840000000, 0xCC00},
-	{0x3800000000100000, 0x0000},
-	{0x3B4000002007FFFE, 0x0000},
-	{0x3800000000200000, 0x0000},
-	{0x4021BDD347977391, 0x486F},
-	{0x3800000000400000, 0x0000},
-	{0x374FFFFF7FBFFFFE, 0x0000},
-	{0x3800000000800000, 0x0000},
-	{0x748FFFFFFFFFC07F, 0x7C00},
-	{0x3800000001000000, 0x0000},
-	{0x7FFFFFE00000FFFF, 0x7FFF},
-	{0x3800000002000000, 0x0000},
-	{0x3C707FFFDFFFFFFF, 0x0000},
-	{0x3800000004000000, 0x0000},
-	{0xFA9FFFFFFFFFFFBC, 0xFC00},
-	{0x3800000008000000, 0x0000},
-	{0x4018D316C333275B, 0x4635},
-	{0x3800000010000000, 0x0000},
-	{0xC01956A98019D0C9, 0xC656},
-	{0x3800000020000000, 0x0000},
-	{0xC1EAA8D2ED12AEF2, 0xFC00},
-	{0x3800000040000000, 0x0000},
-	{0x96C0000000018000, 0x8000},
-	{0x3800000080000000, 0x0000},
-	{0xC7FFFFFF00000001, 0xFC00},
-	{0x3800000100000000, 0x0000},
-	{0xC79A27A27056EA46, 0xFC00},
-	{0x3800000200000000, 0x0000},
-	{0xA57FFFFFFFFFFF7C, 0x8000},
-	{0x3800000400000000, 0x0000},
-	{0xFFDFFFFFFFFEFFF7, 0xFC00},
-	{0x3800000800000000, 0x0000},
-	{0x3FE286B0B4B4CC89, 0x38A2},
-	{0x3800001000000000, 0x0000},
-	{0xC3400000000201FF, 0xFC00},
-	{0x3800002000000000, 0x0000},
-	{0x48000001F7FFFFFF, 0x7C00},
-	{0x3800004000000000, 0x0000},
-	{0xC04FFFFFFFFFBFFB, 0xD400},
-	{0x3800008000000000, 0x0000},
-	{0xBFCE5419A14E014A, 0xB395},
-	{0x3800010000000000, 0x0000},
-	{0xEB7C7B7F72BCC56B, 0xFC00},
-	{0x3800020000000000, 0x0000},
-	{0x801000803FFFFFFE, 0x8000},
-	{0x3800040000000000, 0x0000},
-	{0xB2A0000000000012, 0x8000},
-	{0x3800080000000000, 0x0000},
-	{0xC3400000003FBFFF, 0xFC00},
-	{0x3800100000000000, 0x0000},
-	{0x3FC8000000000001, 0x3200},
-	{0x3800200000000000, 0x0000},
-	{0x3FCFFF800000003F, 0x3400},
-	{0x3800400000000000, 0x0000},
-	{0x467EFFFF00000000, 0x7C00},
-	{0x3800800000000000, 0x0000},
-	{0x41C414450C53F36A, 0x7C00},
-	{0x3801000000000000, 0x0000},
-	{0xBF9000000007DFFF, 0xA400},
-	{0x3802000000000000, 0x0000},
-	{0x3CA79ABE8D8A60A4, 0x0000},
-	{0x3804000000000000, 0x0000},
-	{0x3F607FFFFFFFDFFF, 0x1820},
-	{0x3808000000000000, 0x0000},
-	{0x7FF0040000004000, 0x7E01},
-	{0x380C000000000000, 0x0000},
-	{0x47EEFFFFFFFFFFFF, 0x7C00},
-	{0x380E000000000000, 0x0000},
-	{0x3996196C99B7416E, 0x0000},
-	{0x380F000000000000, 0x0000},
-	{0xC0000000001FFFF6, 0xC000},
-	{0x380F800000000000, 0x0000},
-	{0xBFD0000000030000, 0xB400},
-	{0x380FC00000000000, 0x0000},
-	{0x9FF92374F65BAC5D, 0x8000},
-	{0x380FE00000000000, 0x0000},
-	{0x3F27B0D086C3C4E2, 0x09EC},
-	{0x380FF00000000000, 0x0000},
-	{0x528A8453F06365F4, 0x7C00},
-	{0x380FF80000000000, 0x0000},
-	{0xC24878D7D67E0934, 0xFC00},
-	{0x380FFC0000000000, 0x0000},
-	{0x800FEF7FFFFFFFFE, 0x8000},
-	{0x380FFE0000000000, 0x0000},
-	{0x37E00000003FFFFE, 0x0000},
-	{0x380FFF0000000000, 0x0000},
-	{0x47E8000000100000, 0x7C00},
-	{0x380FFF8000000000, 0x0000},
-	{0xAAF875010BD1EF76, 0x8000},
-	{0x380FFFC000000000, 0x0000},
-	{0xC1A000000007FFDF, 0xFC00},
-	{0x380FFFE000000000, 0x0000},
-	{0x7FEFFFFFFFFFF3FE, 0x7C00},
-	{0x380FFFF000000000, 0x0000},
-	{0x3FF9A97C6125AA88, 0x3E6A},
-	{0x380FFFF800000000, 0x0000},
-	{0xC00FF0007FFFFFFF, 0xC3FC},
-	{0x380FFFFC00000000, 0x0000},
-	{0x51603FFFFFFFBFFF, 0x7C00},
-	{0x380FFFFE00000000, 0x0000},
-	{0x002FFFEFFFFFDFFE, 0x0000},
-	{0x380FFFFF00000000, 0x0000},
-	{0x04BFD95E9B079897, 0x0000},
-	{0x380FFFFF80000000, 0x0000},
-	{0x413FFFE080000000, 0x7C00},
-	{0x380FFFFFC0000000, 0x0000},
-	{0x3D70200000007FFE, 0x0000},
-	{0x380FFFFFE0000000, 0x0000},
-	{0x3EEFFFFFFEFFFF7F, 0x0100},
-	{0x380FFFFFF0000000, 0x0000},
-	{0x800E0003FFFFFFFF, 0x8000},
-	{0x380FFFFFF8000000, 0x0000},
-	{0xB10003FFFFFFFFFF, 0x8000},
-	{0x380FFFFFFC000000, 0x0000},
-	{0xBFE72D4BD093AAE6, 0xB9CB},
-	{0x380FFFFFFE000000, 0x0000},
-	{0x47F97AE4B81550B9, 0x7C00},
-	{0x380FFFFFFF000000, 0x0000},
-	{0xC3D0000040007FFE, 0xFC00},
-	{0x380FFFFFFF800000, 0x0000},
-	{0xC33000000010001F, 0xFC00},
-	{0x380FFFFFFFC00000, 0x0000},
-	{0xA6500003DFFFFFFF, 0x8000},
-	{0x380FFFFFFFE00000, 0x0000},
-	{0xC1D4000000000200, 0xFC00},
-	{0x380FFFFFFFF00000, 0x0000},
-	{0x43D08FFFFFFFFFFE, 0x7C00},
-	{0x380FFFFFFFF80000, 0x0000},
-	{0xBFE002003FFFFFFF, 0xB801},
-	{0x380FFFFFFFFC0000, 0x0000},
-	{0xC11FFFF800000003, 0xFC00},
-	{0x380FFFFFFFFE0000, 0x0000},
-	{0xBA30080000000007, 0x8000},
-	{0x380FFFFFFFFF0000, 0x0000},
-	{0xBCAEC9FB228ADBB3, 0x8000},
-	{0x380FFFFFFFFF8000, 0x0000},
-	{0xC7EFFFFFFEFFFEFF, 0xFC00},
-	{0x380FFFFFFFFFC000, 0x0000},
-	{0xC3EFFFE000000010, 0xFC00},
-	{0x380FFFFFFFFFE000, 0x0000},
-	{0x38A980AD0046B9A1, 0x0000},
-	{0x380FFFFFFFFFF000, 0x0000},
-	{0xB95FFE000000007F, 0x8000},
-	{0x380FFFFFFFFFF800, 0x0000},
-	{0x7FF3FFFFFFFEFFFF, 0x7EFF},
-	{0x380FFFFFFFFFFC00, 0x0000},
-	{0x1D8800000000001F, 0x0000},
-	{0x380FFFFFFFFFFE00, 0x0000},
-	{0xA270000001000080, 0x8000},
-	{0x380FFFFFFFFFFF00, 0x0000},
-	{0x3FF00007FFFFFF00, 0x3C00},
-	{0x380FFFFFFFFFFF80, 0x0000},
-	{0xBFE429D525BCB80E, 0xB90A},
-	{0x380FFFFFFFFFFFC0, 0x0000},
-	{0x002FFFFFDFFBFFFF, 0x0000},
-	{0x380FFFFFFFFFFFE0, 0x0000},
-	{0x000D1D22B5B4A296, 0x0000},
-	{0x380FFFFFFFFFFFF0, 0x0000},
-	{0x3E4FE00000000004, 0x0000},
-	{0x380FFFFFFFFFFFF8, 0x0000},
-	{0x5F3EFCC6CAE33FB6, 0x7C00},
-	{0x380FFFFFFFFFFFFC, 0x0000},
-	{0xC3D85FB51C1527F5, 0xFC00},
-	{0x380FFFFFFFFFFFFE, 0x0000},
-	{0x260F80000000001F, 0x0000},
-	{0x380FFFFFFFFFFFFF, 0x0000},
-	{0x4030001000001FFF, 0x4C00},
-	{0x380FFFFFFFFFFFFD, 0x0000},
-	{0xB8202000001FFFFF, 0x8000},
-	{0x380FFFFFFFFFFFFB, 0x0000},
-	{0x37FFAFFFFFFFFFFF, 0x0000},
-	{0x380FFFFFFFFFFFF7, 0x0000},
-	{0xC02000000400000E, 0xC800},
-	{0x380FFFFFFFFFFFEF, 0x0000},
-	{0xC06FFFFFFEFEFFFE, 0xDC00},
-	{0x380FFFFFFFFFFFDF, 0x0000},
-	{0x7FD7FFFFFFBFFFFF, 0x7C00},
-	{0x380FFFFFFFFFFFBF, 0x0000},
-	{0xC09FFFE07FFFFFFF, 0xE800},
-	{0x380FFFFFFFFFFF7F, 0x0000},
-	{0x6D1FFFF800000002, 0x7C00},
-	{0x380FFFFFFFFFFEFF, 0x0000},
-	{0xC0003FFFFFFFFFFB, 0xC010},
-	{0x380FFFFFFFFFFDFF, 0x0000},
-	{0xC3FFFFDFFFDFFFFF, 0xFC00},
-	{0x380FFFFFFFFFFBFF, 0x0000},
-	{0x43DE6AB38CB33273, 0x7C00},
-	{0x380FFFFFFFFFF7FF, 0x0000},
-	{0xB7F27E9EA94B7D13, 0x8000},
-	{0x380FFFFFFFFFEFFF, 0x0000},
-	{0x3F0FFFFFFEFFFFEF, 0x0400},
-	{0x380FFFFFFFFFDFFF, 0x0000},
-	{0xBFDBFFFFFFFFFFBF, 0xB700},
-	{0x380FFFFFFFFFBFFF, 0x0000},
-	{0x3FFFFFFFFBFFDFFF, 0x4000},
-	{0x380FFFFFFFFF7FFF, 0x0000},
-	{0x43F0F7FFFFFFFFFF, 0x7C00},
-	{0x380FFFFFFFFEFFFF, 0x0000},
-	{0x3FCFFE8000000000, 0x3400},
-	{0x380FFFFFFFFDFFFF, 0x0000},
-	{0x43CC79662E0178C5, 0x7C00},
-	{0x380FFFFFFFFBFFFF, 0x0000},
-	{0x3FC7FFFFFFFC0000, 0x3200},
-	{0x380FFFFFFFF7FFFF, 0x0000},
-	{0x434FFFFFFFFFFFFE, 0x7C00},
-	{0x380FFFFFFFEFFFFF, 0x0000},
-	{0xC00FF7FFFFFFFFC0, 0xC3FE},
-	{0x380FFFFFFFDFFFFF, 0x0000},
-	{0x403FFFFFE7FFFFFF, 0x5000},
-	{0x380FFFFFFFBFFFFF, 0x0000},
-	{0xC060003FFFF7FFFE, 0xD800},
-	{0x380FFFFFFF7FFFFF, 0x0000},
-	{0xC37000400000007F, 0xFC00},
-	{0x380FFFFFFEFFFFFF, 0x0000},
-	{0xC08F7F4E3E3575CF, 0xE3E0},
-	{0x380FFFFFFDFFFFFF, 0x0000},
-	{0x4036848707AD45C0, 0x4DA1},
-	{0x380FFFFFFBFFFFFF, 0x0000},
-	{0x000FFFE000000001, 0x0000},
-	{0x380FFFFFF7FFFFFF, 0x0000},
-	{0xB800D796D3C86284, 0x8000},
-	{0x380FFFFFEFFFFFFF, 0x0000},
-	{0xC020080040000000, 0xC802},
-	{0x380FFFFFDFFFFFFF, 0x0000},
-	{0x40326D838AA4858A, 0x4C9B},
-	{0x380FFFFFBFFFFFFF, 0x0000},
-	{0xB7E0000001FFFF7E, 0x8000},
-	{0x380FFFFF7FFFFFFF, 0x0000},
-	{0x386FDFFFFFFFFFBE, 0x0000},
-	{0x380FFFFEFFFFFFFF, 0x0000},
-	{0xB49FFFFDFFFFFFBE, 0x8000},
-	{0x380FFFFDFFFFFFFF, 0x0000},
-	{0x3FC30140E551D2BF, 0x30C0},
-	{0x380FFFFBFFFFFFFF, 0x0000},
-	{0x480D5E3F1E0F1414, 0x7C00},
-	{0x380FFFF7FFFFFFFF, 0x0000},
-	{0xB5307EFFFFFFFFFF, 0x8000},
-	{0x380FFFEFFFFFFFFF, 0x0000},
-	{0xC1E2000000000010, 0xFC00},
-	{0x380FFFDFFFFFFFFF, 0x0000},
-	{0xC3EFF0FFFFFFFFFE, 0xFC00},
-	{0x380FFFBFFFFFFFFF, 0x0000},
-	{0xFFF000000000F800, 0xFE00},
-	{0x380FFF7FFFFFFFFF, 0x0000},
-	{0x3CEEE423A773C707, 0x0000},
-	{0x380FFEFFFFFFFFFF, 0x0000},
-	{0x403EFFFFFFFE0000, 0x4FC0},
-	{0x380FFDFFFFFFFFFF, 0x0000},
-	{0x3FBFBFFFFDFFFFFF, 0x2FF0},
-	{0x380FFBFFFFFFFFFF, 0x0000},
-	{0xE410200FFFFFFFFF, 0xFC00},
-	{0x380FF7FFFFFFFFFF, 0x0000},
-	{0x00100000800003FE, 0x0000},
-	{0x380FEFFFFFFFFFFF, 0x0000},
-	{0xC3C0000000401FFF, 0xFC00},
-	{0x380FDFFFFFFFFFFF, 0x0000},
-	{0xC630080001FFFFFF, 0xFC00},
-	{0x380FBFFFFFFFFFFF, 0x0000},
-	{0xC7E8DE920409740A, 0xFC00},
-	{0x380F7FFFFFFFFFFF, 0x0000},
-	{0xA8948E8841EB8472, 0x8000},
-	{0x380EFFFFFFFFFFFF, 0x0000},
-	{0x3EB376EEC5474DD6, 0x0013},
-	{0x380DFFFFFFFFFFFF, 0x0000},
-	{0x381FFFDFFFFFFFF0, 0x0000},
-	{0x380BFFFFFFFFFFFF, 0x0000},
-	{0xB7701FFFFFFFFFFE, 0x8000},
-	{0x3807FFFFFFFFFFFF, 0x0000},
-	{0xC1EFFFC00003FFFF, 0xFC00},
-	{0x3803FFFFFFFFFFFF, 0x0000},
-	{0x3FCA8543126EFDAC, 0x32A1},
-	{0x3801FFFFFFFFFFFF, 0x0000},
-	{0x4CD2ED2E0AD9CB03, 0x7C00},
-	{0x3800FFFFFFFFFFFF, 0x0000},
-	{0xC3D0000000000000, 0xFC00},
-	{0x38007FFFFFFFFFFF, 0x0000},
-	{0x40303FFFFFFFF7FF, 0x4C10},
-	{0x38003FFFFFFFFFFF, 0x0000},
-	{0x99BFFFEFFFFFFFDE, 0x8000},
-	{0x38001FFFFFFFFFFF, 0x0000},
-	{0xBFDF4D587328523F, 0xB7D3},
-	{0x38000FFFFFFFFFFF, 0x0000},
-	{0x402286E5B902902C, 0x48A2},
-	{0x380007FFFFFFFFFF, 0x0000},
-	{0x4164000000000000, 0x7C00},
-	{0x380003FFFFFFFFFF, 0x0000},
-	{0xBCF00000003BFFFE, 0x8000},
-	{0x380001FFFFFFFFFF, 0x0000},
-	{0xC3DFF800000003FF, 0xFC00},
-	{0x380000FFFFFFFFFF, 0x0000},
-	{0x69DB425B1081AEA5, 0x7C00},
-	{0x3800007FFFFFFFFF, 0x0000},
-	{0x434007FF80000000, 0x7C00},
-	{0x3800003FFFFFFFFF, 0x0000},
-	{0xFFDFFFFF20000000, 0xFC00},
-	{0x3800001FFFFFFFFF, 0x0000},
-	{0x3F680C122E438B89, 0x1A03},
-	{0x3800000FFFFFFFFF, 0x0000},
-	{0xFA70C22AA075CEA7, 0xFC00},
-	{0x38000007FFFFFFFF, 0x0000},
-	{0x3FD0000001FFFFFD, 0x3400},
-	{0x38000003FFFFFFFF, 0x0000},
-	{0x001FFFFFE00000FF, 0x0000},
-	{0x38000001FFFFFFFF, 0x0000},
-	{0xC1DB4BED19C62BE1, 0xFC00},
-	{0x38000000FFFFFFFF, 0x0000},
-	{0x000FFFFFFFFFFF07, 0x0000},
-	{0x380000007FFFFFFF, 0x0000},
-	{0x3FD0000800007FFF, 0x3400},
-	{0x380000003FFFFFFF, 0x0000},
-	{0xBF9FF800000001FF, 0xA7FE},
-	{0x380000001FFFFFFF, 0x0000},
-	{0xBFEFEF0000000000, 0xBBFC},
-	{0x380000000FFFFFFF, 0x0000},
-	{0xC020020007FFFFFE, 0xC801},
-	{0x3800000007FFFFFF, 0x0000},
-	{0xFFFC7D694BBB782A, 0xFF1F},
-	{0x3800000003FFFFFF, 0x0000},
-	{0x3B3FFEBFFFFFFFFF, 0x0000},
-	{0x3800000001FFFFFF, 0x0000},
-	{0xBF5000000000001F, 0x9400},
-	{0x3800000000FFFFFF, 0x0000},
-	{0x001FFFBFFFDFFFFE, 0x0000},
-	{0x38000000007FFFFF, 0x0000},
-	{0x403FFFFFFFFFFFBF, 0x5000},
-	{0x38000000003FFFFF, 0x0000},
-	{0x43EB03BCE85AC443, 0x7C00},
-	{0x38000000001FFFFF, 0x0000},
-	{0xBFE6697F18A2EA0E, 0xB99A},
-	{0x38000000000FFFFF, 0x0000},
-	{0x800158F96B9351E1, 0x8000},
-	{0x380000000007FFFF, 0x0000},
-	{0x38103FFF80000000, 0x0000},
-	{0x380000000003FFFF, 0x0000},
-	{0x41DFFFFF7FFF7FFE, 0x7C00},
-	{0x380000000001FFFF, 0x0000},
-	{0x434BFFFFFFFFBFFE, 0x7C00},
-	{0x380000000000FFFF, 0x0000},
-	{0xB44FFF800001FFFE, 0x8000},
-	{0x3800000000007FFF, 0x0000},
-	{0x406000000EFFFFFF, 0x5800},
-	{0x3800000000003FFF, 0x0000},
-	{0xFFE0008000000008, 0xFC00},
-	{0x3800000000001FFF, 0x0000},
-	{0xBFB001FFFFFFC000, 0xAC00},
-	{0x3800000000000FFF, 0x0000},
-	{0xBCC8AC84F15DFFB6, 0x8000},
-	{0x38000000000007FF, 0x0000},
-	{0x400FFE0000010000, 0x4400},
-	{0x38000000000003FF, 0x0000},
-	{0xBF407FFFFFFFEFFE, 0x9020},
-	{0x38000000000001FF, 0x0000},
-	{0xD95000001F7FFFFF, 0xFC00},
-	{0x38000000000000FF, 0x0000},
-	{0xC800000000000C00, 0xFC00},
-	{0x380000000000007F, 0x0000},
-	{0xC07FFFFFEFEFFFFF, 0xE000},
-	{0x380000000000003F, 0x0000},
-	{0xBFBFF80200000000, 0xAFFE},
-	{0x380000000000001F, 0x0000},
-	{0x3F9FFFFBFFC00000, 0x2800},
-	{0x380000000000000F, 0x0000},
-	{0x404AFAD582B62281, 0x52BF},
-	{0x3800000000000007, 0x0000},
-	{0x37FFFFFF0003FFFF, 0x0000},
-	{0x3800000000000003, 0x0000},
-	{0xC158777BB79B77D8, 0xFC00},
-	{0x3810000000000000, 0x0000},
-	{0x3FF0001FFFFFFFEF, 0x3C00},
-	{0x3810000000000001, 0x0000},
-	{0x43E40CE339660E9E, 0x7C00},
-	{0x3810000000000002, 0x0000},
-	{0xC1D1FFFF7FFFFFFF, 0xFC00},
-	{0x3810000000000004, 0x0000},
-	{0xC8AF7FFFFFF7FFFF, 0xFC00},
-	{0x3810000000000008, 0x0000},
-	{0x403007FFFFFFE000, 0x4C02},
-	{0x3810000000000010, 0x0000},
-	{0xBFB000EFFFFFFFFF, 0xAC00},
-	{0x3810000000000020, 0x0000},
-	{0x43D07FFFFFFEFFFF, 0x7C00},
-	{0x3810000000000040, 0x0000},
-	{0xBF8C07C55A84BCD6, 0xA302},
-	{0x3810000000000080, 0x0000},
-	{0xA85FFE000007FFFE, 0x8000},
-	{0x3810000000000100, 0x0000},
-	{0x403E1971D5034395, 0x4F86},
-	{0x3810000000000200, 0x0000},
-	{0xBFCFFFFFFFFF4000, 0xB400},
-	{0x3810000000000400, 0x0000},
-	{0xC14B800000000000, 0xFC00},
-	{0x3810000000000800, 0x0000},
-	{0x72EFFE0000007FFF, 0x7C00},
-	{0x3810000000001000, 0x0000},
-	{0xC30FFFFFFFFFBEFF, 0xFC00},
-	{0x3810000000002000, 0x0000},
-	{0x3EA03BFFFFFFFFFF, 0x0008},
-	{0x3810000000004000, 0x0000},
-	{0xFFD3F80000000000, 0xFC00},
-	{0x3810000000008000, 0x0000},
-	{0xFFD1FFFFF7FFFFFF, 0xFC00},
-	{0x3810000000010000, 0x0000},
-	{0x405B751AB2396B32, 0x56DD},
-	{0x3810000000020000, 0x0000},
-	{0xBD10001FFFFFFFFF, 0x8000},
-	{0x3810000000040000, 0x0000},
-	{0x3F8C00000007FFFF, 0x2300},
-	{0x3810000000080000, 0x0000},
-	{0xC1C9E5064AA9E762, 0xFC00},
-	{0x3810000000100000, 0x0000},
-	{0xB92F0000003FFFFE, 0x8000},
-	{0x3810000000200000, 0x0000},
-	{0x80007FFFFFFFFFFB, 0x8000},
-	{0x3810000000400000, 0x0000},
-	{0xBF2FFFBFE0000000, 0x8C00},
-	{0x3810000000800000, 0x0000},
-	{0x3FDFFFFF8001FFFE, 0x3800},
-	{0x3810000001000000, 0x0000},
-	{0xC3BF9FFFFFFFFFFF, 0xFC00},
-	{0x3810000002000000, 0x0000},
-	{0xC038F2C412E3F305, 0xCE3D},
-	{0x3810000004000000, 0x0000},
-	{0x440479B5466A53FD, 0x7C00},
-	{0x3810000008000000, 0x0000},
-	{0xC3D8A1F9A6F78433, 0xFC00},
-	{0x3810000010000000, 0x0000},
-	{0xC00FFFFFFFFE0002, 0xC400},
-	{0x3810000020000000, 0x0000},
-	{0xBFF7DADB854220D8, 0xBDF7},
-	{0x3810000040000000, 0x0000},
-	{0xC78BFFFFFFFFF7FE, 0xFC00},
-	{0x3810000080000000, 0x0000},
-	{0xBFFEFFFFFFFFFEFE, 0xBFC0},
-	{0x3810000100000000, 0x0000},
-	{0x3FC000000003FE00, 0x3000},
-	{0x3810000200000000, 0x0000},
-	{0x4037FFFFFFFEFFFF, 0x4E00},
-	{0x3810000400000000, 0x0000},
-	{0x09B0000000000018, 0x0000},
-	{0x3810000800000000, 0x0000},
-	{0xC1E003FFFFFFF7FF, 0xFC00},
-	{0x3810001000000000, 0x0000},
-	{0xB170000001FEFFFF, 0x8000},
-	{0x3810002000000000, 0x0000},
-	{0xC1C000000000005F, 0xFC00},
-	{0x3810004000000000, 0x0000},
-	{0xC366EB895E04C2D1, 0xFC00},
-	{0x3810008000000000, 0x0000},
-	{0x3FCFFFF800004000, 0x3400},
-	{0x3810010000000000, 0x0000},
-	{0x3F74797A1F1A7EF3, 0x1D1E},
-	{0x3810020000000000, 0x0000},
-	{0x6B301000000001FF, 0x7C00},
-	{0x3810040000000000, 0x0000},
-	{0xBB4FFFDFFE000000, 0x8000},
-	{0x3810080000000000, 0x0000},
-	{0x3D80400FFFFFFFFE, 0x0000},
-	{0x3810100000000000, 0x0000},
-	{0x40201C1928ADAB62, 0x4807},
-	{0x3810200000000000, 0x0000},
-	{0x43E00203FFFFFFFF, 0x7C00},
-	{0x3810400000000000, 0x0000},
-	{0xC0B020000000000F, 0xEC08},
-	{0x3810800000000000, 0x0000},
-	{0x4AC43FFFFFFFFFFF, 0x7C00},
-	{0x3811000000000000, 0x0000},
-	{0x3FA000000000EFFF, 0x2800},
-	{0x3812000000000000, 0x0000},
-	{0x3FF00000005FFFFE, 0x3C00},
-	{0x3814000000000000, 0x0000},
-	{0xAED0000000000000, 0x8000},
-	{0x3818000000000000, 0x0000},
-	{0x5241F6CBE6119E18, 0x7C00},
-	{0x381C000000000000, 0x0000},
-	{0xC34FFF7BFFFFFFFE, 0xFC00},
-	{0x381E000000000000, 0x0000},
-	{0x3F6FFF8000100000, 0x1C00},
-	{0x381F000000000000, 0x0000},
-	{0xC3F000FFFBFFFFFF, 0xFC00},
-	{0x381F800000000000, 0x0000},
-	{0xC3EFFFFFB7FFFFFE, 0xFC00},
-	{0x381FC00000000000, 0x0000},
-	{0x37F01FFFFFFFFFFB, 0x0000},
-	{0x381FE00000000000, 0x0000},
-	{0xC3CFFFFFDFFDFFFF, 0xFC00},
-	{0x381FF00000000000, 0x0000},
-	{0xC1E00401FFFFFFFF, 0xFC00},
-	{0x381FF80000000000, 0x0000},
-	{0x3FE000007FFFFFDF, 0x3800},
-	{0x381FFC0000000000, 0x0000},
-	{0x3CAFFFFFFFFFFFF4, 0x0000},
-	{0x381FFE0000000000, 0x0000},
-	{0xBEE50F8271FAEF39, 0x80A8},
-	{0x381FFF0000000000, 0x0000},
-	{0x001FFFFBFFFFFEFF, 0x0000},
-	{0x381FFF8000000000, 0x0000},
-	{0x0000001000000100, 0x0000},
-	{0x381FFFC000000000, 0x0000},
-	{0xC00000000000000F, 0xC000},
-	{0x381FFFE000000000, 0x0000},
-	{0x7FF0400008000000, 0x7E10},
-	{0x381FFFF000000000, 0x0000},
-	{0xC04007FFFFFFFFDF, 0xD002},
-	{0x381FFFF800000000, 0x0000},
-	{0xC044E0636BB089FB, 0xD138},
-	{0x381FFFFC00000000, 0x0000},
-	{0x4A0F7FF7FFFFFFFE, 0x7C00},
-	{0x381FFFFE00000000, 0x0000},
-	{0xBFCFFF8000000003, 0xB400},
-	{0x381FFFFF00000000, 0x0000},
-	{0x3FF0000000000000, 0x3C00},
-	{0x381FFFFF80000000, 0x0000},
-	{0xC0801FFFFFFFBFFF, 0xE008},
-	{0x381FFFFFC0000000, 0x0000},
-	{0xC3D007FFFF800000, 0xFC00},
-	{0x381FFFFFE0000000, 0x0000},
-	{0xB800000007FFFFEE, 0x8000},
-	{0x381FFFFFF0000000, 0x0000},
-	{0xC0E000020000FFFE, 0xF800},
-	{0x381FFFFFF8000000, 0x0000},
-	{0x3FE8010000000000, 0x3A00},
-	{0x381FFFFFFC000000, 0x0000},
-	{0xC29FFFF0FFFFFFFF, 0xFC00},
-	{0x381FFFFFFE000000, 0x0000},
-	{0x4345DB1EFF61C15C, 0x7C00},
-	{0x381FFFFFFF000000, 0x0000},
-	{0xBFB00007FFF80000, 0xAC00},
-	{0x381FFFFFFF800000, 0x0000},
-	{0xC3FFFFFFFFDFFFEE, 0xFC00},
-	{0x381FFFFFFFC00000, 0x0000},
-	{0x401E000000001FFF, 0x4780},
-	{0x381FFFFFFFE00000, 0x0000},
-	{0x3C2447E665BC5A54, 0x0000},
-	{0x381FFFFFFFF00000, 0x0000},
-	{0xC19FFFFFE0000FFE, 0xFC00},
-	{0x381FFFFFFFF80000, 0x0000},
-	{0xC03FFFFFFFF03FFF, 0xD000},
-	{0x381FFFFFFFFC0000, 0x0000},
-	{0xFFFFFFFFC000000F, 0xFFFF},
-	{0x381FFFFFFFFE0000, 0x0000},
-	{0x0010003FFFFF8000, 0x0000},
-	{0x381FFFFFFFFF0000, 0x0000},
-	{0xB35FFFFF800000FE, 0x8000},
-	{0x381FFFFFFFFF8000, 0x0000},
-	{0xFFFF81FFFFFFFFFF, 0xFFE0},
-	{0x381FFFFFFFFFC000, 0x0000},
-	{0xBF3FDDFFFFFFFFFF, 0x8FF7},
-	{0x381FFFFFFFFFE000, 0x0000},
-	{0xC3D000000000FFF7, 0xFC00},
-	{0x381FFFFFFFFFF000, 0x0000},
-	{0xC340001FFFEFFFFF, 0xFC00},
-	{0x381FFFFFFFFFF800, 0x0000},
-	{0xC00FFC000000001F, 0xC3FF},
-	{0x381FFFFFFFFFFC00, 0x0000},
-	{0xC04000000001FDFF, 0xD000},
-	{0x381FFFFFFFFFFE00, 0x0000},
-	{0x7FF0000027FFFFFF, 0x7E00},
-	{0x381FFFFFFFFFFF00, 0x0000},
-	{0xBF5FF000000001FF, 0x97FC},
-	{0x381FFFFFFFFFFF80, 0x0000},
-	{0x3F800001000007FE, 0x2000},
-	{0x381FFFFFFFFFFFC0, 0x0000},
-	{0xCBEFFFFFFFFFFFFE, 0xFC00},
-	{0x381FFFFFFFFFFFE0, 0x0000},
-	{0xC07FFFFF7FFBFFFE, 0xE000},
-	{0x381FFFFFFFFFFFF0, 0x0000},
-	{0x414FFFFDFFFFFDFE, 0x7C00},
-	{0x381FFFFFFFFFFFF8, 0x0000},
-	{0x37FFFEFFE0000000, 0x0000},
-	{0x381FFFFFFFFFFFFC, 0x0000},
-	{0xC3EFF00000000400, 0xFC00},
-	{0x381FFFFFFFFFFFFE, 0x0000},
-	{0x3A1000FFDFFFFFFF, 0x0000},
-	{0x381FFFFFFFFFFFFF, 0x0000},
-	{0xC01FFBFFFFFFFFFF, 0xC7FF},
-	{0x381FFFFFFFFFFFFD, 0x0000},
-	{0xFFE1FFFFFFFFE000, 0xFC00},
-	{0x381FFFFFFFFFFFFB, 0x0000},
-	{0x3805F74D8DD70612, 0x0000},
-	{0x381FFFFFFFFFFFF7, 0x0000},
-	{0xC25471485E4E3403, 0xFC00},
-	{0x381FFFFFFFFFFFEF, 0x0000},
-	{0x41F73DC2D9948B11, 0x7C00},
-	{0x381FFFFFFFFFFFDF, 0x0000},
-	{0x3F88D265A2311F81, 0x2235},
-	{0x381FFFFFFFFFFFBF, 0x0000},
-	{0x5D100000000007F8, 0x7C00},
-	{0x381FFFFFFFFFFF7F, 0x0000},
-	{0x9EC3FFFFFFFF7FFF, 0x8000},
-	{0x381FFFFFFFFFFEFF, 0x0000},
-	{0xBE11C2814CB1B57E, 0x8000},
-	{0x381FFFFFFFFFFDFF, 0x0000},
-	{0x4000400000000100, 0x4010},
-	{0x381FFFFFFFFFFBFF, 0x0000},
-	{0x4FCFFFFBFFFFDFFF, 0x7C00},
-	{0x381FFFFFFFFFF7FF, 0x0000},
-	{0xB80FF7F7FFFFFFFE, 0x8000},
-	{0x381FFFFFFFFFEFFF, 0x0000},
-	{0xC60007FFFFFFF800, 0xFC00},
-	{0x381FFFFFFFFFDFFF, 0x0000},
-	{0x3FB891AFA410D1A2, 0x2E24},
-	{0x381FFFFFFFFFBFFF, 0x0000},
-	{0x41CFFFF80000000E, 0x7C00},
-	{0x381FFFFFFFFF7FFF, 0x0000},
-	{0xC010001000001FFF, 0xC400},
-	{0x381FFFFFFFFEFFFF, 0x0000},
-	{0xA0DFF0000000007F, 0x8000},
-	{0x381FFFFFFFFDFFFF, 0x0000},
-	{0x43C2000000200000, 0x7C00},
-	{0x381FFFFFFFFBFFFF, 0x0000},
-	{0x3EB0003000000000, 0x0010},
-	{0x381FFFFFFFF7FFFF, 0x0000},
-	{0x7FEFFFFFFFFFA000, 0x7C00},
-	{0x381FFFFFFFEFFFFF, 0x0000},
-	{0x43489DC823D49CA6, 0x7C00},
-	{0x381FFFFFFFDFFFFF, 0x0000},
-	{0x40B5BCB8A6E125D4, 0x6D6F},
-	{0x381FFFFFFFBFFFFF, 0x0000},
-	{0x4890001DFFFFFFFF, 0x7C00},
-	{0x381FFFFFFF7FFFFF, 0x0000},
-	{0x3E6FFFFBFBFFFFFE, 0x0001},
-	{0x381FFFFFFEFFFFFF, 0x0000},
-	{0xC00FFFFF00000FFF, 0xC400},
-	{0x381FFFFFFDFFFFFF, 0x0000},
-	{0x37F000007FFFDFFE, 0x0000},
-	{0x381FFFFFFBFFFFFF, 0x0000},
-	{0xC3EE0000001FFFFF, 0xFC00},
-	{0x381FFFFFF7FFFFFF, 0x0000},
-	{0xB1FFFFFFFFFF7F7E, 0x8000},
-	{0x381FFFFFEFFFFFFF, 0x0000},
-	{0xC01FC003FFFFFFFE, 0xC7F0},
-	{0x381FFFFFDFFFFFFF, 0x0000},
-	{0x37ED40E00B9C05DE, 0x0000},
-	{0x381FFFFFBFFFFFFF, 0x0000},
-	{0xBFCFFFFFC0200000, 0xB400},
-	{0x381FFFFF7FFFFFFF, 0x0000},
-	{0x3B0F240C7FF16392, 0x0000},
-	{0x381FFFFEFFFFFFFF, 0x0000},
-	{0x7FD004000000FFFF, 0x7C00},
-	{0x381FFFFDFFFFFFFF, 0x0000},
-	{0x37EFBFFFFFFF7FFF, 0x0000},
-	{0x381FFFFBFFFFFFFF, 0x0000},
-	{0xB4481D7EED6ABF44, 0x8000},
-	{0x381FFFF7FFFFFFFF, 0x0000},
-	{0x4020000000BFFFFE, 0x4800},
-	{0x381FFFEFFFFFFFFF, 0x0000},
-	{0xC18FFFFFF8000000, 0xFC00},
-	{0x381FFFDFFFFFFFFF, 0x0000},
-	{0x3FBFFFFFFFFFFFFF, 0x3000},
-	{0x381FFFBFFFFFFFFF, 0x0000},
-	{0xC02FFFFFFF000006, 0xCC00},
-	{0x381FFF7FFFFFFFFF, 0x0000},
-	{0x43CE31AB7966EAA5, 0x7C00},
-	{0x381FFEFFFFFFFFFF, 0x0000},
-	{0x380000000000077F, 0x0000},
-	{0x381FFDFFFFFFFFFF, 0x0000},
-	{0xFFFF9FFFFFFFFFFF, 0xFFE7},
-	{0x381FFBFFFFFFFFFF, 0x0000},
-	{0x43E08000003FFFFE, 0x7C00},
-	{0x381FF7FFFFFFFFFF, 0x0000},
-	{0x41FFDF7FFFFFFFFE, 0x7C00},
-	{0x381FEFFFFFFFFFFF, 0x0000},
-	{0x4060FFFFFFEFFFFE, 0x5840},
-	{0x381FDFFFFFFFFFFF, 0x0000},
-	{0x380FC1FFFFFFFFFE, 0x0000},
-	{0x381FBFFFFFFFFFFF, 0x0000},
-	{0xB81FF1FFFFFFFFFF, 0x8000},
-	{0x381F7FFFFFFFFFFF, 0x0000},
-	{0xC1F20000000000FE, 0xFC00},
-	{0x381EFFFFFFFFFFFF, 0x0000},
-	{0x45FFFFFFFFF88000, 0x7C00},
-	{0x381DFFFFFFFFFFFF, 0x0000},
-	{0xBEE529773F41FE96, 0x80A9},
-	{0x381BFFFFFFFFFFFF, 0x0000},
-	{0xBCA000000023FFFF, 0x8000},
-	{0x3817FFFFFFFFFFFF, 0x0000},
-	{0xBFBB40F01862B73A, 0xAED0},
-	{0x3813FFFFFFFFFFFF, 0x0000},
-	{0x3CEBD68F63F59E88, 0x0000},
-	{0x3811FFFFFFFFFFFF, 0x0000},
-	{0xCC70000001100000, 0xFC00},
-	{0x3810FFFFFFFFFFFF, 0x0000},
-	{0xAE9885C914DC786A, 0x8000},
-	{0x38107FFFFFFFFFFF, 0x0000},
-	{0xB7EFFFFFF0007FFF, 0x8000},
-	{0x38103FFFFFFFFFFF, 0x0000},
-	{0xC15FFFFE03FFFFFF, 0xFC00},
-	{0x38101FFFFFFFFFFF, 0x0000},
-	{0x411FF3D0AA387C27, 0x7C00},
-	{0x38100FFFFFFFFFFF, 0x0000},
-	{0xC02FC00000003FFE, 0xCBF0},
-	{0x381007FFFFFFFFFF, 0x0000},
-	{0xC3C07FFFFFFE0000, 0xFC00},
-	{0x381003FFFFFFFFFF, 0x0000},
-	{0xBD5FFFFFFFFFFFF3, 0x8000},
-	{0x381001FFFFFFFFFF, 0x0000},
-	{0x42AFFFFFFFBFFFFA, 0x7C00},
-	{0x381000FFFFFFFFFF, 0x0000},
-	{0x480895BDCDC755FB, 0x7C00},
-	{0x3810007FFFFFFFFF, 0x0000},
-	{0xC03FFFFFFFFBFFFD, 0xD000},
-	{0x3810003FFFFFFFFF, 0x0000},
-	{0x40EF800000001FFF, 0x7BE0},
-	{0x3810001FFFFFFFFF, 0x0000},
-	{0xBFEFFEEFFFFFFFFF, 0xBC00},
-	{0x3810000FFFFFFFFF, 0x0000},
-	{0x3FE31B87CA2CCFC3, 0x38C7},
-	{0x38100007FFFFFFFF, 0x0000},
-	{0xC8007FDFFFFFFFFF, 0xFC00},
-	{0x38100003FFFFFFFF, 0x0000},
-	{0x45600400000003FF, 0x7C00},
-	{0x38100001FFFFFFFF, 0x0000},
-	{0x41F0000001003FFF, 0x7C00},
-	{0x38100000FFFFFFFF, 0x0000},
-	{0xBFEFFFEFFFFFFEFF, 0xBC00},
-	{0x381000007FFFFFFF, 0x0000},
-	{0x400FFFFFFFFFFFFF, 0x4400},
-	{0x381000003FFFFFFF, 0x0000},
-	{0xC34DF74B471FE469, 0xFC00},
-	{0x381000001FFFFFFF, 0x0000},
-	{0x43EFFBFFFFFFF800, 0x7C00},
-	{0x381000000FFFFFFF, 0x0000},
-	{0x4EDFFFFFFEFFFFFF, 0x7C00},
-	{0x3810000007FFFFFF, 0x0000},
-	{0x37E200000003FFFF, 0x0000},
-	{0x3810000003FFFFFF, 0x0000},
-	{0x4012B3712E9BEFB9, 0x44AD},
-	{0x3810000001FFFFFF, 0x0000},
-	{0xC1C01FFFFFFFDFFF, 0xFC00},
-	{0x3810000000FFFFFF, 0x0000},
-	{0x43E000000001FFDF, 0x7C00},
-	{0x38100000007FFFFF, 0x0000},
-	{0x3FF003FFFFFFBFFF, 0x3C01},
-	{0x38100000003FFFFF, 0x0000},
-	{0x4035E5B844C8C695, 0x4D79},
-	{0x38100000001FFFFF, 0x0000},
-	{0xC3FFFFFFF7FFFC00, 0xFC00},
-	{0x38100000000FFFFF, 0x0000},
-	{0xC02000800007FFFF, 0xC800},
-	{0x381000000007FFFF, 0x0000},
-	{0x381E7F1BE7957C14, 0x0000},
-	{0x381000000003FFFF, 0x0000},
-	{0x37F076729079F3DD, 0x0000},
-	{0x381000000001FFFF, 0x0000},
-	{0xB780FFFFFFFFFFBF, 0x8000},
-	{0x381000000000FFFF, 0x0000},
-	{0xFFD0001FFFFFFEFF, 0xFC00},
-	{0x3810000000007FFF, 0x0000},
-	{0xC00FDFFE00000000, 0xC3F8},
-	{0x3810000000003FFF, 0x0000},
-	{0x94CFFFF7FFFFDFFF, 0x8000},
-	{0x3810000000001FFF, 0x0000},
-	{0x3FF08000000FFFFF, 0x3C20},
-	{0x3810000000000FFF, 0x0000},
-	{0x41CFFFFFFBFBFFFF, 0x7C00},
-	{0x38100000000007FF, 0x0000},
-	{0x401FFFFFFFF007FE, 0x4800},
-	{0x38100000000003FF, 0x0000},
-	{0xBFBEFFFFFFFFFFDF, 0xAFC0},
-	{0x38100000000001FF, 0x0000},
-	{0x83F440B225441D6E, 0x8000},
-	{0x38100000000000FF, 0x0000},
-	{0xC016C0F39F6E287A, 0xC5B0},
-	{0x381000000000007F, 0x0000},
-	{0x7FF0F1584C615651, 0x7E3C},
-	{0x381000000000003F, 0x0000},
-	{0xC1C60CDED44C23B0, 0xFC00},
-	{0x381000000000001F, 0x0000},
-	{0xC70F3E414B6C247E, 0xFC00},
-	{0x381000000000000F, 0x0000},
-	{0x24D9E396311B0312, 0x0000},
-	{0x3810000000000007, 0x0000},
-	{0x40383C8588B7FFE4, 0x4E0F},
-	{0x3810000000000003, 0x0000},
-	{0x401FFFFFFF8FFFFF, 0x4800},
-	{0x3CA0000000000000, 0x0000},
-	{0x2373C5A3157D8AB3, 0x0000},
-	{0x3CA0000000000001, 0x0000},
-	{0x37F0001BFFFFFFFF, 0x0000},
-	{0x3CA0000000000002, 0x0000},
-	{0xB7F0000003F7FFFF, 0x8000},
-	{0x3CA0000000000004, 0x0000},
-	{0xF7300000003FE000, 0xFC00},
-	{0x3CA0000000000008, 0x0000},
-	{0xC7E0000000080080, 0xFC00},
-	{0x3CA0000000000010, 0x0000},
-	{0xBF13FFBFFFFFFFFE, 0x8500},
-	{0x3CA0000000000020, 0x0000},
-	{0x7ABFFFFFFFFFF77F, 0x7C00},
-	{0x3CA0000000000040, 0x0000},
-	{0xC04FFFFFFFE00400, 0xD400},
-	{0x3CA0000000000080, 0x0000},
-	{0x400FFFFEFFFEFFFF, 0x4400},
-	{0x3CA0000000000100, 0x0000},
-	{0xC800003FBFFFFFFF, 0xFC00},
-	{0x3CA0000000000200, 0x0000},
-	{0x7FF00007FFFC0000, 0x7E00},
-	{0x3CA0000000000400, 0x0000},
-	{0xC1CFFFFFC0000FFF, 0xFC00},
-	{0x3CA0000000000800, 0x0000},
-	{0x3EBFFFDFFFFF7FFF, 0x0020},
-	{0x3CA0000000001000, 0x0000},
-	{0x3F4FFFFFFFFC003F, 0x1400},
-	{0x3CA0000000002000, 0x0000},
-	{0x42CFFFFF0001FFFE, 0x7C00},
-	{0x3CA0000000004000, 0x0000},
-	{0x3FBFF000000000FF, 0x2FFC},
-	{0x3CA0000000008000, 0x0000},
-	{0xBF839BD1E72E0708, 0xA0E7},
-	{0x3CA0000000010000, 0x0000},
-	{0x24E74DC633909594, 0x0000},
-	{0x3CA0000000020000, 0x0000},
-	{0xBCAFFFFFFFFFF5FF, 0x8000},
-	{0x3CA0000000040000, 0x0000},
-	{0x35F00000005FFFFF, 0x0000},
-	{0x3CA0000000080000, 0x0000},
-	{0x40AFFFFFFFFDBFFE, 0x6C00},
-	{0x3CA0000000100000, 0x0000},
-	{0xBFB6C8542E3CB42B, 0xADB2},
-	{0x3CA0000000200000, 0x0000},
-	{0xC7FFFFFFFFFFFEFE, 0xFC00},
-	{0x3CA0000000400000, 0x0000},
-	{0x400FFFFF0001FFFF, 0x4400},
-	{0x3CA0000000800000, 0x0000},
-	{0x400BB9C9F62A6EBB, 0x42EE},
-	{0x3CA0000001000000, 0x0000},
-	{0x3FDFFFFF000000FF, 0x3800},
-	{0x3CA0000002000000, 0x0000},
-	{0x402BFFEFFFFFFFFF, 0x4B00},
-	{0x3CA0000004000000, 0x0000},
-	{0xBFFDFFFFFBFFFFFF, 0xBF80},
-	{0x3CA0000008000000, 0x0000},
-	{0x3FFFFFFFFFFFFFE0, 0x4000},
-	{0x3CA0000010000000, 0x0000},
-	{0x4DB0100FFFFFFFFE, 0x7C00},
-	{0x3CA0000020000000, 0x0000},
-	{0xC3F0000000203FFE, 0xFC00},
-	{0x3CA0000040000000, 0x0000},
-	{0x3BB0007FFFFFFF80, 0x0000},
-	{0x3CA0000080000000, 0x0000},
-	{0x406000001DFFFFFF, 0x5800},
-	{0x3CA0000100000000, 0x0000},
-	{0xBFAFFFFDFFFFFFFF, 0xAC00},
-	{0x3CA0000200000000, 0x0000},
-	{0x3FFFC00001FFFFFF, 0x3FF0},
-	{0x3CA0000400000000, 0x0000},
-	{0xC77FF7FFFFFFFFFF, 0xFC00},
-	{0x3CA0000800000000, 0x0000},
-	{0x71DF7FFFFFFF7FFE, 0x7C00},
-	{0x3CA0001000000000, 0x0000},
-	{0x37EDD7CED15D7389, 0x0000},
-	{0x3CA0002000000000, 0x0000},
-	{0xC0000004003FFFFE, 0xC000},
-	{0x3CA0004000000000, 0x0000},
-	{0x406FFFFC00000007, 0x5C00},
-	{0x3CA0008000000000, 0x0000},
-	{0x401A2CB8D0BA93B9, 0x468B},
-	{0x3CA0010000000000, 0x0000},
-	{0xBF400000C0000000, 0x9000},
-	{0x3CA0020000000000, 0x0000},
-	{0x43CFFFFFFFF00020, 0x7C00},
-	{0x3CA0040000000000, 0x0000},
-	{0x3FE00003FEFFFFFE, 0x3800},
-	{0x3CA0080000000000, 0x0000},
-	{0x431BFFFFEFFFFFFE, 0x7C00},
-	{0x3CA0100000000000, 0x0000},
-	{0x802115C1D8564CDA, 0x8000},
-	{0x3CA0200000000000, 0x0000},
-	{0xF67FEFFEFFFFFFFF, 0xFC00},
-	{0x3CA0400000000000, 0x0000},
-	{0x4095ACE2E86935BF, 0x656B},
-	{0x3CA0800000000000, 0x0000},
-	{0x41BFFFFFFC000020, 0x7C00},
-	{0x3CA1000000000000, 0x0000},
-	{0x31A0001000004000, 0x0000},
-	{0x3CA2000000000000, 0x0000},
-	{0xFFF000401FFFFFFE, 0xFE00},
-	{0x3CA4000000000000, 0x0000},
-	{0x43E0001FFFFFDFFE, 0x7C00},
-	{0x3CA8000000000000, 0x0000},
-	{0xCE80000000080003, 0xFC00},
-	{0x3CAC000000000000, 0x0000},
-	{0xBFD8000000000006, 0xB600},
-	{0x3CAE000000000000, 0x0000},
-	{0xC8020007FFFFFFFF, 0xFC00},
-	{0x3CAF000000000000, 0x0000},
-	{0xC9BE000001FFFFFF, 0xFC00},
-	{0x3CAF800000000000, 0x0000},
-	{0x4DE0000003FF7FFF, 0x7C00},
-	{0x3CAFC00000000000, 0x0000},
-	{0xFFDFFDFFFDFFFFFE, 0xFC00},
-	{0x3CAFE00000000000, 0x0000},
-	{0x7FF00FFFFFFBFFFF, 0x7E03},
-	{0x3CAFF00000000000, 0x0000},
-	{0x43FFFFFFFFFBFFFC, 0x7C00},
-	{0x3CAFF80000000000, 0x0000},
-	{0xC2FFFFE00000FFFF, 0xFC00},
-	{0x3CAFFC0000000000, 0x0000},
-	{0x3FB75A46458E84E0, 0x2DD7},
-	{0x3CAFFE0000000000, 0x0000},
-	{0xC06E13E981136EBB, 0xDB85},
-	{0x3CAFFF0000000000, 0x0000},
-	{0x3AA438BE58432319, 0x0000},
-	{0x3CAFFF8000000000, 0x0000},
-	{0x30FFFFBFFFFFFBFF, 0x0000},
-	{0x3CAFFFC000000000, 0x0000},
-	{0xFFDFDFFFFFF00000, 0xFC00},
-	{0x3CAFFFE000000000, 0x0000},
-	{0x913FFF00000007FF, 0x8000},
-	{0x3CAFFFF000000000, 0x0000},
-	{0x532FFFFFFFF7FFF0, 0x7C00},
-	{0x3CAFFFF800000000, 0x0000},
-	{0xB66FBB2D7EEFF328, 0x8000},
-	{0x3CAFFFFC00000000, 0x0000},
-	{0x3E8FC003FFFFFFFE, 0x0004},
-	{0x3CAFFFFE00000000, 0x0000},
-	{0xBB28A90FFB1DFDC9, 0x8000},
-	{0x3CAFFFFF00000000, 0x0000},
-	{0x402F7FFFFFFF8000, 0x4BE0},
-	{0x3CAFFFFF80000000, 0x0000},
-	{0xC01000000001FFEF, 0xC400},
-	{0x3CAFFFFFC0000000, 0x0000},
-	{0x3C4008000001FFFF, 0x0000},
-	{0x3CAFFFFFE0000000, 0x0000},
-	{0x4030000000004010, 0x4C00},
-	{0x3CAFFFFFF0000000, 0x0000},
-	{0xB3BFFD0000000000, 0x8000},
-	{0x3CAFFFFFF8000000, 0x0000},
-	{0x40A8000000000007, 0x6A00},
-	{0x3CAFFFFFFC000000, 0x0000},
-	{0xC1CFE1FFFFFFFFFE, 0xFC00},
-	{0x3CAFFFFFFE000000, 0x0000},
-	{0x3F916010A17ACE96, 0x2458},
-	{0x3CAFFFFFFF000000, 0x0000},
-	{0x7FFAE7C94BF189D1, 0x7EB9},
-	{0x3CAFFFFFFF800000, 0x0000},
-	{0xC010295F9417DF67, 0xC40A},
-	{0x3CAFFFFFFFC00000, 0x0000},
-	{0x3F14000000000000, 0x0500},
-	{0x3CAFFFFFFFE00000, 0x0000},
-	{0xC1DFFFFF7FFFEFFF, 0xFC00},
-	{0x3CAFFFFFFFF00000, 0x0000},
-	{0xF41FFF7FFFFFFFF0, 0xFC00},
-	{0x3CAFFFFFFFF80000, 0x0000},
-	{0x3DA0FFFFF7FFFFFE, 0x0000},
-	{0x3CAFFFFFFFFC0000, 0x0000},
-	{0xBFC0000200007FFF, 0xB000},
-	{0x3CAFFFFFFFFE0000, 0x0000},
-	{0xC7C753BB9FCFA7F7, 0xFC00},
-	{0x3CAFFFFFFFFF0000, 0x0000},
-	{0xBF4FFFFFBFE00000, 0x9400},
-	{0x3CAFFFFFFFFF8000, 0x0000},
-	{0x255421E85DEBC1A2, 0x0000},
-	{0x3CAFFFFFFFFFC000, 0x0000},
-	{0xE1668FF14A9148AC, 0xFC00},
-	{0x3CAFFFFFFFFFE000, 0x0000},
-	{0x3F19A794C0A0554C, 0x066A},
-	{0x3CAFFFFFFFFFF000, 0x0000},
-	{0x228F7D4E3AB3AAA2, 0x0000},
-	{0x3CAFFFFFFFFFF800, 0x0000},
-	{0xC01F98D660C940F9, 0xC7E6},
-	{0x3CAFFFFFFFFFFC00, 0x0000},
-	{0x37F0080000007FFE, 0x0000},
-	{0x3CAFFFFFFFFFFE00, 0x0000},
-	{0x4059AA9B8CCD3AD7, 0x566B},
-	{0x3CAFFFFFFFFFFF00, 0x0000},
-	{0x46EFFF003FFFFFFF, 0x7C00},
-	{0x3CAFFFFFFFFFFF80, 0x0000},
-	{0x4030000800003FFE, 0x4C00},
-	{0x3CAFFFFFFFFFFFC0, 0x0000},
-	{0x43EFFFFF80080000, 0x7C00},
-	{0x3CAFFFFFFFFFFFE0, 0x0000},
-	{0xC1FE000000001FFE, 0xFC00},
-	{0x3CAFFFFFFFFFFFF0, 0x0000},
-	{0x1108B58E7C6887B6, 0x0000},
-	{0x3CAFFFFFFFFFFFF8, 0x0000},
-	{0xFFFFFC00000003FF, 0xFFFF},
-	{0x3CAFFFFFFFFFFFFC, 0x0000},
-	{0xC1FFFFFFFDFFEFFF, 0xFC00},
-	{0x3CAFFFFFFFFFFFFE, 0x0000},
-	{0xBFBFFF7FE0000000, 0xB000},
-	{0x3CAFFFFFFFFFFFFF, 0x0000},
-	{0xC3C0400003FFFFFF, 0xFC00},
-	{0x3CAFFFFFFFFFFFFD, 0x0000},
-	{0x7FF07FFFFF800000, 0x7E1F},
-	{0x3CAFFFFFFFFFFFFB, 0x0000},
-	{0x3DF8000000FFFFFF, 0x0000},
-	{0x3CAFFFFFFFFFFFF7, 0x0000},
-	{0x402400000000000F, 0x4900},
-	{0x3CAFFFFFFFFFFFEF, 0x0000},
-	{0x7FD8862302B8B1BE, 0x7C00},
-	{0x3CAFFFFFFFFFFFDF, 0x0000},
-	{0x3FD5E8DC0C283CC7, 0x357A},
-	{0x3CAFFFFFFFFFFFBF, 0x0000},
-	{0xBD2FE00000007FFF, 0x8000},
-	{0x3CAFFFFFFFFFFF7F, 0x0000},
-	{0x3CA1000000000001, 0x0000},
-	{0x3CAFFFFFFFFFFEFF, 0x0000},
-	{0xB7EFFE0000010000, 0x8000},
-	{0x3CAFFFFFFFFFFDFF, 0x0000},
-	{0xC3DFFFFFFF00FFFF, 0xFC00},
-	{0x3CAFFFFFFFFFFBFF, 0x0000},
-	{0x001689B676EADB62, 0x0000},
-	{0x3CAFFFFFFFFFF7FF, 0x0000},
-	{0x3FAFC71315991B00, 0x2BF2},
-	{0x3CAFFFFFFFFFEFFF, 0x0000},
-	{0x41D37A6F4BC79ED0, 0x7C00},
-	{0x3CAFFFFFFFFFDFFF, 0x0000},
-	{0x000000000000103F, 0x0000},
-	{0x3CAFFFFFFFFFBFFF, 0x0000},
-	{0x3FA0FFFFFFFFFFFF, 0x2840},
-	{0x3CAFFFFFFFFF7FFF, 0x0000},
-	{0xBFA0400000001000, 0xA810},
-	{0x3CAFFFFFFFFEFFFF, 0x0000},
-	{0x403FFDFFDFFFFFFF, 0x4FFF},
-	{0x3CAFFFFFFFFDFFFF, 0x0000},
-	{0x480000000103FFFF, 0x7C00},
-	{0x3CAFFFFFFFFBFFFF, 0x0000},
-	{0xC00F81FFFFFFFFFF, 0xC3E0},
-	{0x3CAFFFFFFFF7FFFF, 0x0000},
-	{0x480FF48AB78645BC, 0x7C00},
-	{0x3CAFFFFFFFEFFFFF, 0x0000},
-	{0xC030000000401FFF, 0xCC00},
-	{0x3CAFFFFFFFDFFFFF, 0x0000},
-	{0x801FFFFFFFFC0080, 0x8000},
-	{0x3CAFFFFFFFBFFFFF, 0x0000},
-	{0xC07D3C7E01B805B6, 0xDF4F},
-	{0x3CAFFFFFFF7FFFFF, 0x0000},
-	{0xC3DFF80000000002, 0xFC00},
-	{0x3CAFFFFFFEFFFFFF, 0x0000},
-	{0xFFF00000FFFF8000, 0xFE00},
-	{0x3CAFFFFFFDFFFFFF, 0x0000},
-	{0x402B42FF69706997, 0x4AD1},
-	{0x3CAFFFFFFBFFFFFF, 0x0000},
-	{0x8004000FFFFFFFFE, 0x8000},
-	{0x3CAFFFFFF7FFFFFF, 0x0000},
-	{0x96E400007FFFFFFF, 0x8000},
-	{0x3CAFFFFFEFFFFFFF, 0x0000},
-	{0x47F003FFFEFFFFFE, 0x7C00},
-	{0x3CAFFFFFDFFFFFFF, 0x0000},
-	{0x408FFFFFF80003FE, 0x6400},
-	{0x3CAFFFFFBFFFFFFF, 0x0000},
-	{0x3F400000000007DF, 0x1000},
-	{0x3CAFFFFF7FFFFFFF, 0x0000},
-	{0xC00FFFFF80000FFF, 0xC400},
-	{0x3CAFFFFEFFFFFFFF, 0x0000},
-	{0xB7E0000000000000, 0x8000},
-	{0x3CAFFFFDFFFFFFFF, 0x0000},
-	{0xBFCDFFFFFFFFFFFD, 0xB380},
-	{0x3CAFFFFBFFFFFFFF, 0x0000},
-	{0x557000FFFFFBFFFE, 0x7C00},
-	{0x3CAFFFF7FFFFFFFF, 0x0000},
-	{0x381F2E4141865774, 0x0000},
-	{0x3CAFFFEFFFFFFFFF, 0x0000},
-	{0xC39E671CBD973E5F, 0xFC00},
-	{0x3CAFFFDFFFFFFFFF, 0x0000},
-	{0xE1B67280BAE40354, 0xFC00},
-	{0x3CAFFFBFFFFFFFFF, 0x0000},
-	{0x40241A08B7754CC5, 0x4907},
-	{0x3CAFFF7FFFFFFFFF, 0x0000},
-	{0xC0100000001FFC00, 0xC400},
-	{0x3CAFFEFFFFFFFFFF, 0x0000},
-	{0xC3F007FFBFFFFFFF, 0xFC00},
-	{0x3CAFFDFFFFFFFFFF, 0x0000},
-	{0xFFEE63AEA619C96E, 0xFC00},
-	{0x3CAFFBFFFFFFFFFF, 0x0000},
-	{0xC01FFEFFF7FFFFFE, 0xC800},
-	{0x3CAFF7FFFFFFFFFF, 0x0000},
-	{0x3FCFFFDFFF800000, 0x3400},
-	{0x3CAFEFFFFFFFFFFF, 0x0000},
-	{0xBDC00007FFFFFFFF, 0x8000},
-	{0x3CAFDFFFFFFFFFFF, 0x0000},
-	{0xC3FFFE0000010000, 0xFC00},
-	{0x3CAFBFFFFFFFFFFF, 0x0000},
-	{0x00100000001FFFFF, 0x0000},
-	{0x3CAF7FFFFFFFFFFF, 0x0000},
-	{0xC6AFFFC3FFFFFFFE, 0xFC00},
-	{0x3CAEFFFFFFFFFFFF, 0x0000},
-	{0xBFCFFFFFFFFFE006, 0xB400},
-	{0x3CADFFFFFFFFFFFF, 0x0000},
-	{0x4000000FFFFFFFFF, 0x4000},
-	{0x3CABFFFFFFFFFFFF, 0x0000},
-	{0xC8043A3E6DDD5DA3, 0xFC00},
-	{0x3CA7FFFFFFFFFFFF, 0x0000},
-	{0xBFB896212FD46576, 0xAE26},
-	{0x3CA3FFFFFFFFFFFF, 0x0000},
-	{0x3FF0000000E00000, 0x3C00},
-	{0x3CA1FFFFFFFFFFFF, 0x0000},
-	{0x96AF9D6DABEC621F, 0x8000},
-	{0x3CA0FFFFFFFFFFFF, 0x0000},
-	{0x3F5001007FFFFFFE, 0x1400},
-	{0x3CA07FFFFFFFFFFF, 0x0000},
-	{0x7C1FFFFF87FFFFFF, 0x7C00},
-	{0x3CA03FFFFFFFFFFF, 0x0000},
-	{0x3CAFFFFFFB7FFFFE, 0x0000},
-	{0x3CA01FFFFFFFFFFF, 0x0000},
-	{0xC035D19BBBE95B3C, 0xCD74},
-	{0x3CA00FFFFFFFFFFF, 0x0000},
-	{0x00100000000FFFFD, 0x0000},
-	{0x3CA007FFFFFFFFFF, 0x0000},
-	{0xBF800400000003FE, 0xA001},
-	{0x3CA003FFFFFFFFFF, 0x0000},
-	{0x802FFFDFDFFFFFFF, 0x8000},
-	{0x3CA001FFFFFFFFFF, 0x0000},
-	{0xBC9AD0A3C2F3E269, 0x8000},
-	{0x3CA000FFFFFFFFFF, 0x0000},
-	{0x80207FFFFFFE0000, 0x8000},
-	{0x3CA0007FFFFFFFFF, 0x0000},
-	{0xBFB60DFED5D36516, 0xAD83},
-	{0x3CA0003FFFFFFFFF, 0x0000},
-	{0xBBD08FD0D47D423E, 0x8000},
-	{0x3CA0001FFFFFFFFF, 0x0000},
-	{0xC7FFF934DAB44B77, 0xFC00},
-	{0x3CA0000FFFFFFFFF, 0x0000},
-	{0x45300FBFFFFFFFFF, 0x7C00},
-	{0x3CA00007FFFFFFFF, 0x0000},
-	{0x9110080001FFFFFF, 0x8000},
-	{0x3CA00003FFFFFFFF, 0x0000},
-	{0xFFF020000000003E, 0xFE08},
-	{0x3CA00001FFFFFFFF, 0x0000},
-	{0xC08000003FFFDFFF, 0xE000},
-	{0x3CA00000FFFFFFFF, 0x0000},
-	{0xB7FFFFFF0007FFFF, 0x8000},
-	{0x3CA000007FFFFFFF, 0x0000},
-	{0x54800000037FFFFF, 0x7C00},
-	{0x3CA000003FFFFFFF, 0x0000},
-	{0xC3DFFFFFFFFFEFDF, 0xFC00},
-	{0x3CA000001FFFFFFF, 0x0000},
-	{0x37EFFFFFFF7FFDFF, 0x0000},
-	{0x3CA000000FFFFFFF, 0x0000},
-	{0xC03003FFFFFFF7FF, 0xCC01},
-	{0x3CA0000007FFFFFF, 0x0000},
-	{0xBFD0000001FFF7FF, 0xB400},
-	{0x3CA0000003FFFFFF, 0x0000},
-	{0xBFBE3DDC35804AB9, 0xAF8F},
-	{0x3CA0000001FFFFFF, 0x0000},
-	{0x43E08000000001FF, 0x7C00},
-	{0x3CA0000000FFFFFF, 0x0000},
-	{0xBCAD9399AE1ED826, 0x8000},
-	{0x3CA00000007FFFFF, 0x0000},
-	{0x801CA6428B941AC0, 0x8000},
-	{0x3CA00000003FFFFF, 0x0000},
-	{0x47F00FFFF7FFFFFF, 0x7C00},
-	{0x3CA00000001FFFFF, 0x0000},
-	{0x3523E0AC33ADC8A4, 0x0000},
-	{0x3CA00000000FFFFF, 0x0000},
-	{0x3E02ACC16FAC1B50, 0x0000},
-	{0x3CA000000007FFFF, 0x0000},
-	{0xBFDEE7C31491254C, 0xB7BA},
-	{0x3CA000000003FFFF, 0x0000},
-	{0xC1DF8000000FFFFE, 0xFC00},
-	{0x3CA000000001FFFF, 0x0000},
-	{0xBFA0000000DFFFFF, 0xA800},
-	{0x3CA000000000FFFF, 0x0000},
-	{0xC3EFDFFFFEFFFFFE, 0xFC00},
-	{0x3CA0000000007FFF, 0x0000},
-	{0x3FE0003FFFFFFE00, 0x3800},
-	{0x3CA0000000003FFF, 0x0000},
-	{0xC00025CFBF2D36C6, 0xC009},
-	{0x3CA0000000001FFF, 0x0000},
-	{0x3FE7B669B8F4855E, 0x39EE},
-	{0x3CA0000000000FFF, 0x0000},
-	{0x434FFF8000003FFF, 0x7C00},
-	{0x3CA00000000007FF, 0x0000},
-	{0xB7F000001FFFFFF8, 0x8000},
-	{0x3CA00000000003FF, 0x0000},
-	{0xBAB0001FFFFFFFF7, 0x8000},
-	{0x3CA00000000001FF, 0x0000},
-	{0x3FCFFFFFFFFFFFFF, 0x3400},
-	{0x3CA00000000000FF, 0x0000},
-	{0xC0303FFFFFFFFFFE, 0xCC10},
-	{0x3CA000000000007F, 0x0000},
-	{0xBFD2A864A0D1415A, 0xB4AA},
-	{0x3CA000000000003F, 0x0000},
-	{0x36EFFF3FFFFFFFFF, 0x0000},
-	{0x3CA000000000001F, 0x0000},
-	{0x3FD29096AD997910, 0x34A4},
-	{0x3CA000000000000F, 0x0000},
-	{0xC1DBFFFFFFFFFFFD, 0xFC00},
-	{0x3CA0000000000007, 0x0000},
-	{0xC3FFFFFFF1FFFFFF, 0xFC00},
-	{0x3CA0000000000003, 0x0000},
-	{0x37FFFF8001FFFFFE, 0x0000},
-	{0x3FB0000000000000, 0x2C00},
-	{0x47FD3497852222BC, 0x7C00},
-	{0x3FB0000000000001, 0x2C00},
-	{0xC3CBB1D9A9AACD3A, 0xFC00},
-	{0x3FB0000000000002, 0x2C00},
-	{0xC03FFF0000200000, 0xD000},
-	{0x3FB0000000000004, 0x2C00},
-	{0x3F3FFFFFE000007F, 0x1000},
-	{0x3FB0000000000008, 0x2C00},
-	{0x40200000003FEFFF, 0x4800},
-	{0x3FB0000000000010, 0x2C00},
-	{0x41E98BFD4EFA2BC9, 0x7C00},
-	{0x3FB0000000000020, 0x2C00},
-	{0x8000000007FFFFFC, 0x8000},
-	{0x3FB0000000000040, 0x2C00},
-	{0xC130003FFFFFFFFA, 0xFC00},
-	{0x3FB0000000000080, 0x2C00},
-	{0xC1D0007FFFFFBFFF, 0xFC00},
-	{0x3FB0000000000100, 0x2C00},
-	{0x7220004000000200, 0x7C00},
-	{0x3FB0000000000200, 0x2C00},
-	{0xC1C3491ADA5F4E9D, 0xFC00},
-	{0x3FB0000000000400, 0x2C00},
-	{0x40F3FFFFFBFFFFFE, 0x7C00},
-	{0x3FB0000000000800, 0x2C00},
-	{0x4021000400000000, 0x4840},
-	{0x3FB0000000001000, 0x2C00},
-	{0x41DFFF8000000100, 0x7C00},
-	{0x3FB0000000002000, 0x2C00},
-	{0xC18FFF800000007F, 0xFC00},
-	{0x3FB0000000004000, 0x2C00},
-	{0xBF10000003FFFFFD, 0x8400},
-	{0x3FB0000000008000, 0x2C00},
-	{0xBFC0000FFFF00000, 0xB000},
-	{0x3FB0000000010000, 0x2C00},
-	{0x404A7577F817EB2F, 0x529D},
-	{0x3FB0000000020000, 0x2C00},
-	{0xC7500000FFFFFDFF, 0xFC00},
-	{0x3FB0000000040000, 0x2C00},
-	{0xFFEFFFFFFCFFFFFF, 0xFC00},
-	{0x3FB0000000080000, 0x2C00},
-	{0x800FFFFFFFFE07FE, 0x8000},
-	{0x3FB0000000100000, 0x2C00},
-	{0xB803D2B1D80529F7, 0x8000},
-	{0x3FB0000000200000, 0x2C00},
-	{0x4020000007FFFFF8, 0x4800},
-	{0x3FB0000000400000, 0x2C00},
-	{0x3E1FFFDFFFF7FFFF, 0x0000},
-	{0x3FB0000000800000, 0x2C00},
-	{0x3FFFFFFFFFC00FFE, 0x4000},
-	{0x3FB0000001000000, 0x2C00},
-	{0xA071DB08630C7E36, 0x8000},
-	{0x3FB0000002000000, 0x2C00},
-	{0xC1D2000000001FFF, 0xFC00},
-	{0x3FB0000004000000, 0x2C00},
-	{0xC31E08E2B7238CE9, 0xFC00},
-	{0x3FB0000008000000, 0x2C00},
-	{0xBE0532CD020F4DF6, 0x8000},
-	{0x3FB0000010000000, 0x2C00},
-	{0x4064D27AC7D96917, 0x5935},
-	{0x3FB0000020000000, 0x2C00},
-	{0xBDAF0000000007FF, 0x8000},
-	{0x3FB0000040000000, 0x2C00},
-	{0xAE4FFC0000000020, 0x8000},
-	{0x3FB0000080000000, 0x2C00},
-	{0xCACF0E368127D138, 0xFC00},
-	{0x3FB0000100000000, 0x2C00},
-	{0xC1CFFFFFFFFFFBFB, 0xFC00},
-	{0x3FB0000200000000, 0x2C00},
-	{0x400FFFFF7FFC0000, 0x4400},
-	{0x3FB0000400000000, 0x2C00},
-	{0x3E9FFFFE0000FFFF, 0x0008},
-	{0x3FB0000800000000, 0x2C00},
-	{0xBFBFFFFDFFFFFFFE, 0xB000},
-	{0x3FB0001000000000, 0x2C00},
-	{0x3FFFFFFFFBFFBFFF, 0x4000},
-	{0x3FB0002000000000, 0x2C00},
-	{0x7FEFFFFFF80003FE, 0x7C00},
-	{0x3FB0004000000000, 0x2C00},
-	{0x37EFFFFFFFFFFEFB, 0x0000},
-	{0x3FB0008000000000, 0x2C00},
-	{0x41C00003FFFDFFFF, 0x7C00},
-	{0x3FB0010000000000, 0x2C00},
-	{0xBCA00800FFFFFFFF, 0x8000},
-	{0x3FB0020000000000, 0x2C00},
-	{0x430F800000000400, 0x7C00},
-	{0x3FB0040000000000, 0x2C01},
-	{0xA8C3E6CEF49B6C8B, 0x8000},
-	{0x3FB0080000000000, 0x2C02},
-	{0x402761096EFFAC45, 0x49D8},
-	{0x3FB0100000000000, 0x2C04},
-	{0xBFBFFF3FFFFFFFFF, 0xB000},
-	{0x3FB0200000000000, 0x2C08},
-	{0xC3E0116FD4206B53, 0xFC00},
-	{0x3FB0400000000000, 0x2C10},
-	{0x41D0FC1C42CA0E84, 0x7C00},
-	{0x3FB0800000000000, 0x2C20},
-	{0xC03FFBFFFFFFFBFF, 0xCFFF},
-	{0x3FB1000000000000, 0x2C40},
-	{0x41FFFF803FFFFFFE, 0x7C00},
-	{0x3FB2000000000000, 0x2C80},
-	{0xC3E0010000001000, 0xFC00},
-	{0x3FB4000000000000, 0x2D00},
-	{0x401FFF7FFFFFFFF0, 0x4800},
-	{0x3FB8000000000000, 0x2E00},
-	{0x05D001FFFFFEFFFF, 0x0000},
-	{0x3FBC000000000000, 0x2F00},
-	{0xFFFFFBFFFFFFFDFF, 0xFFFE},
-	{0x3FBE000000000000, 0x2F80},
-	{0xD94FFFFFF8000002, 0xFC00},
-	{0x3FBF000000000000, 0x2FC0},
-	{0xC4512E79EDDD5019, 0xFC00},
-	{0x3FBF800000000000, 0x2FE0},
-	{0xBDCFFFFFFF7EFFFF, 0x8000},
-	{0x3FBFC00000000000, 0x2FF0},
-	{0x3FFFFFDFFFFFFFBF, 0x4000},
-	{0x3FBFE00000000000, 0x2FF8},
-	{0x3FC8000003FFFFFF, 0x3200},
-	{0x3FBFF00000000000, 0x2FFC},
-	{0x28C0000000003BFE, 0x0000},
-	{0x3FBFF80000000000, 0x2FFE},
-	{0x4027FFFFFFFFFFFC, 0x4A00},
-	{0x3FBFFC0000000000, 0x2FFF},
-	{0xBFDFAD9A11AF5732, 0xB7EB},
-	{0x3FBFFE0000000000, 0x3000},
-	{0x355BC186248EBA0E, 0x0000},
-	{0x3FBFFF0000000000, 0x3000},
-	{0x413573C9125647D7, 0x7C00},
-	{0x3FBFFF8000000000, 0x3000},
-	{0xBF5FF00003FFFFFF, 0x97FC},
-	{0x3FBFFFC000000000, 0x3000},
-	{0xC01FFFBEFFFFFFFE, 0xC800},
-	{0x3FBFFFE000000000, 0x3000},
-	{0x802FFFFFFFFFFBFB, 0x8000},
-	{0x3FBFFFF000000000, 0x3000},
-	{0x41100000000803FF, 0x7C00},
-	{0x3FBFFFF800000000, 0x3000},
-	{0xCCEFDFFFFFFFDFFF, 0xFC00},
-	{0x3FBFFFFC00000000, 0x3000},
-	{0x3FC00000007F7FFE, 0x3000},
-	{0x3FBFFFFE00000000, 0x3000},
-	{0xBFCA489496A87FBA, 0xB292},
-	{0x3FBFFFFF00000000, 0x3000},
-	{0x453FFFC000008000, 0x7C00},
-	{0x3FBFFFFF80000000, 0x3000},
-	{0xFFEFFFDFFFFFFBFF, 0xFC00},
-	{0x3FBFFFFFC0000000, 0x3000},
-	{0xC023668A20CAF0AF, 0xC8DA},
-	{0x3FBFFFFFE0000000, 0x3000},
-	{0xBFFFFFFFFFFF801F, 0xC000},
-	{0x3FBFFFFFF0000000, 0x3000},
-	{0x480000000003DFFE, 0x7C00},
-	{0x3FBFFFFFF8000000, 0x3000},
-	{0xA0800000001BFFFE, 0x8000},
-	{0x3FBFFFFFFC000000, 0x3000},
-	{0xB808000000FFFFFF, 0x8000},
-	{0x3FBFFFFFFE000000, 0x3000},
-	{0xC1CB4ADA86AC4709, 0xFC00},
-	{0x3FBFFFFFFF000000, 0x3000},
-	{0xC01000FFFFFBFFFE, 0xC400},
-	{0x3FBFFFFFFF800000, 0x3000},
-	{0xBFDFFFFFFBFFFFF6, 0xB800},
-	{0x3FBFFFFFFFC00000, 0x3000},
-	{0x400FF00040000000, 0x43FC},
-	{0x3FBFFFFFFFE00000, 0x3000},
-	{0x3FB4CC81E74748E4, 0x2D33},
-	{0x3FBFFFFFFFF00000, 0x3000},
-	{0x405B970E4FAC1833, 0x56E6},
-	{0x3FBFFFFFFFF80000, 0x3000},
-	{0xC01DD7CA34A3345C, 0xC776},
-	{0x3FBFFFFFFFFC0000, 0x3000},
-	{0x00137EBD8E336AF5, 0x0000},
-	{0x3FBFFFFFFFFE0000, 0x3000},
-	{0xBFB00000401FFFFF, 0xAC00},
-	{0x3FBFFFFFFFFF0000, 0x3000},
-	{0xB7F00000003FFFEE, 0x8000},
-	{0x3FBFFFFFFFFF8000, 0x3000},
-	{0x400FFBFFFFFEFFFF, 0x43FF},
-	{0x3FBFFFFFFFFFC000, 0x3000},
-	{0x323FFE0000000002, 0x0000},
-	{0x3FBFFFFFFFFFE000, 0x3000},
-	{0x3FF000002FFFFFFF, 0x3C00},
-	{0x3FBFFFFFFFFFF000, 0x3000},
-	{0x7FFF706549C7750F, 0x7FDC},
-	{0x3FBFFFFFFFFFF800, 0x3000},
-	{0x3C2FC007FFFFFFFF, 0x0000},
-	{0x3FBFFFFFFFFFFC00, 0x3000},
-	{0xC1B7FFFFFFFFFC00, 0xFC00},
-	{0x3FBFFFFFFFFFFE00, 0x3000},
-	{0x038827424B95D26B, 0x0000},
-	{0x3FBFFFFFFFFFFF00, 0x3000},
-	{0xBFB0003FFFFF7FFF, 0xAC00},
-	{0x3FBFFFFFFFFFFF80, 0x3000},
-	{0x3C10000000008080, 0x0000},
-	{0x3FBFFFFFFFFFFFC0, 0x3000},
-	{0x40C001FFFFFBFFFF, 0x7000},
-	{0x3FBFFFFFFFFFFFE0, 0x3000},
-	{0xC01FFFFF800FFFFE, 0xC800},
-	{0x3FBFFFFFFFFFFFF0, 0x3000},
-	{0xB61000FFFF7FFFFE, 0x8000},
-	{0x3FBFFFFFFFFFFFF8, 0x3000},
-	{0xBFC84C7A0CFF54AF, 0xB213},
-	{0x3FBFFFFFFFFFFFFC, 0x3000},
-	{0x7FFF49185D0B087F, 0x7FD2},
-	{0x3FBFFFFFFFFFFFFE, 0x3000},
-	{0xA8AFFFFFFFDFBFFE, 0x8000},
-	{0x3FBFFFFFFFFFFFFF, 0x3000},
-	{0x3D0FF0007FFFFFFE, 0x0000},
-	{0x3FBFFFFFFFFFFFFD, 0x3000},
-	{0x8460000000077FFE, 0x8000},
-	{0x3FBFFFFFFFFFFFFB, 0x3000},
-	{0xC074200DC0615E0B, 0xDD08},
-	{0x3FBFFFFFFFFFFFF7, 0x3000},
-	{0x369000001003FFFF, 0x0000},
-	{0x3FBFFFFFFFFFFFEF, 0x3000},
-	{0xBBF00007FFDFFFFF, 0x8000},
-	{0x3FBFFFFFFFFFFFDF, 0x3000},
-	{0xBFFFFFFFFC7FFFFE, 0xC000},
-	{0x3FBFFFFFFFFFFFBF, 0x3000},
-	{0x401329763DBB0099, 0x44CA},
-	{0x3FBFFFFFFFFFFF7F, 0x3000},
-	{0x77CB4A894527D109, 0x7C00},
-	{0x3FBFFFFFFFFFFEFF, 0x3000},
-	{0xC34FFF7FFBFFFFFE, 0xFC00},
-	{0x3FBFFFFFFFFFFDFF, 0x3000},
-	{0xA1E894DF4FD7EB96, 0x8000},
-	{0x3FBFFFFFFFFFFBFF, 0x3000},
-	{0x37EFFFFFF7BFFFFF, 0x0000},
-	{0x3FBFFFFFFFFFF7FF, 0x3000},
-	{0xBEB4BFD0428C3383, 0x8015},
-	{0x3FBFFFFFFFFFEFFF, 0x3000},
-	{0xC180EFE307FB6B3B, 0xFC00},
-	{0x3FBFFFFFFFFFDFFF, 0x3000},
-	{0xA399A72662DC4B0A, 0x8000},
-	{0x3FBFFFFFFFFFBFFF, 0x3000},
-	{0x43F0FFFFFE000000, 0x7C00},
-	{0x3FBFFFFFFFFF7FFF, 0x3000},
-	{0x5CCFFFFFFFFDC000, 0x7C00},
-	{0x3FBFFFFFFFFEFFFF, 0x3000},
-	{0xBFBFFFFFC003FFFF, 0xB000},
-	{0x3FBFFFFFFFFDFFFF, 0x3000},
-	{0xC020400000000002, 0xC810},
-	{0x3FBFFFFFFFFBFFFF, 0x3000},
-	{0xC010000000401FFF, 0xC400},
-	{0x3FBFFFFFFFF7FFFF, 0x3000},
-	{0xC3900000400003FE, 0xFC00},
-	{0x3FBFFFFFFFEFFFFF, 0x3000},
-	{0x3D000003EFFFFFFF, 0x0000},
-	{0x3FBFFFFFFFDFFFFF, 0x3000},
-	{0xC7F0000010001000, 0xFC00},
-	{0x3FBFFFFFFFBFFFFF, 0x3000},
-	{0x7FF8E88EE70C4861, 0x7E3A},
-	{0x3FBFFFFFFF7FFFFF, 0x3000},
-	{0x311FFFFFFFFFFF10, 0x0000},
-	{0x3FBFFFFFFEFFFFFF, 0x3000},
-	{0xBFA03FFFFEFFFFFE, 0xA810},
-	{0x3FBFFFFFFDFFFFFF, 0x3000},
-	{0x3FC0000000000FE0, 0x3000},
-	{0x3FBFFFFFFBFFFFFF, 0x3000},
-	{0xCFBFFFFFFFFFF003, 0xFC00},
-	{0x3FBFFFFFF7FFFFFF, 0x3000},
-	{0xBCA07FFFFFF80000, 0x8000},
-	{0x3FBFFFFFEFFFFFFF, 0x3000},
-	{0xC07FFFFBFFFDFFFF, 0xE000},
-	{0x3FBFFFFFDFFFFFFF, 0x3000},
-	{0x3F600000003FFFFE, 0x1800},
-	{0x3FBFFFFFBFFFFFFF, 0x3000},
-	{0x3FDFFFFFF7FFF800, 0x3800},
-	{0x3FBFFFFF7FFFFFFF, 0x3000},
-	{0xC03000007FFDFFFF, 0xCC00},
-	{0x3FBFFFFEFFFFFFFF, 0x3000},
-	{0x47E000040000007F, 0x7C00},
-	{0x3FBFFFFDFFFFFFFF, 0x3000},
-	{0x4091FFFFFFFFFFDE, 0x6480},
-	{0x3FBFFFFBFFFFFFFF, 0x3000},
-	{0xC1D0400000001FFF, 0xFC00},
-	{0x3FBFFFF7FFFFFFFF, 0x3000},
-	{0x37E72D93078C93A7, 0x0000},
-	{0x3FBFFFEFFFFFFFFF, 0x3000},
-	{0xB81FF00000200000, 0x8000},
-	{0x3FBFFFDFFFFFFFFF, 0x3000},
-	{0xC8003FFFBFFFFFFF, 0xFC00},
-	{0x3FBFFFBFFFFFFFFF, 0x3000},
-	{0xF3100000003F7FFE, 0xFC00},
-	{0x3FBFFF7FFFFFFFFF, 0x3000},
-	{0x41DFFFF00FFFFFFF, 0x7C00},
-	{0x3FBFFEFFFFFFFFFF, 0x3000},
-	{0xC3E00FFFFFFFEFFE, 0xFC00},
-	{0x3FBFFDFFFFFFFFFF, 0x2FFF},
-	{0xBFBFFC000000007F, 0xAFFF},
-	{0x3FBFFBFFFFFFFFFF, 0x2FFF},
-	{0x001FBFFFFFFFFFFF, 0x0000},
-	{0x3FBFF7FFFFFFFFFF, 0x2FFE},
-	{0x49B0001008000000, 0x7C00},
-	{0x3FBFEFFFFFFFFFFF, 0x2FFC},
-	{0x80107FEFFFFFFFFF, 0x8000},
-	{0x3FBFDFFFFFFFFFFF, 0x2FF8},
-	{0x3C400000040007FF, 0x0000},
-	{0x3FBFBFFFFFFFFFFF, 0x2FF0},
-	{0x460EFFFFFFFDFFFF, 0x7C00},
-	{0x3FBF7FFFFFFFFFFF, 0x2FE0},
-	{0x9F00FFFFFE000000, 0x8000},
-	{0x3FBEFFFFFFFFFFFF, 0x2FC0},
-	{0xBF0FFFFFFFF8007F, 0x8400},
-	{0x3FBDFFFFFFFFFFFF, 0x2F80},
-	{0xBF50000000020007, 0x9400},
-	{0x3FBBFFFFFFFFFFFF, 0x2F00},
-	{0x73BD9ACB2DCC3487, 0x7C00},
-	{0x3FB7FFFFFFFFFFFF, 0x2E00},
-	{0x49E0200007FFFFFF, 0x7C00},
-	{0x3FB3FFFFFFFFFFFF, 0x2D00},
-	{0x2E960451A7807A15, 0x0000},
-	{0x3FB1FFFFFFFFFFFF, 0x2C80},
-	{0x7FEFFFFFFFFFFFFF, 0x7C00},
-	{0x3FB0FFFFFFFFFFFF, 0x2C40},
-	{0x0018000000003FFE, 0x0000},
-	{0x3FB07FFFFFFFFFFF, 0x2C20},
-	{0xC1FFDFFF7FFFFFFF, 0xFC00},
-	{0x3FB03FFFFFFFFFFF, 0x2C10},
-	{0xB80FEFBFFFFFFFFF, 0x8000},
-	{0x3FB01FFFFFFFFFFF, 0x2C08},
-	{0x43E00000FFF80000, 0x7C00},
-	{0x3FB00FFFFFFFFFFF, 0x2C04},
-	{0x37F000000002007E, 0x0000},
-	{0x3FB007FFFFFFFFFF, 0x2C02},
-	{0xB56FFFFFFFFFB000, 0x8000},
-	{0x3FB003FFFFFFFFFF, 0x2C01},
-	{0xB7FAB80AE45A6009, 0x8000},
-	{0x3FB001FFFFFFFFFF, 0x2C00},
-	{0x41F0001020000000, 0x7C00},
-	{0x3FB000FFFFFFFFFF, 0x2C00},
-	{0xB80FFFFFFF7FBFFF, 0x8000},
-	{0x3FB0007FFFFFFFFF, 0x2C00},
-	{0x41DFFFFFFFFFFFFF, 0x7C00},
-	{0x3FB0003FFFFFFFFF, 0x2C00},
-	{0x7FEFFFFFFFFC0003, 0x7C00},
-	{0x3FB0001FFFFFFFFF, 0x2C00},
-	{0x41BFFFFFFFFBF7FE, 0x7C00},
-	{0x3FB0000FFFFFFFFF, 0x2C00},
-	{0x41E101FFFFFFFFFE, 0x7C00},
-	{0x3FB00007FFFFFFFF, 0x2C00},
-	{0x3C8FFFFF80040000, 0x0000},
-	{0x3FB00003FFFFFFFF, 0x2C00},
-	{0xC3C000041FFFFFFE, 0xFC00},
-	{0x3FB00001FFFFFFFF, 0x2C00},
-	{0xC01FBFFFFFFE0000, 0xC7F0},
-	{0x3FB00000FFFFFFFF, 0x2C00},
-	{0xC000007FFFDFFFFF, 0xC000},
-	{0x3FB000007FFFFFFF, 0x2C00},
-	{0xBFF0010400000000, 0xBC00},
-	{0x3FB000003FFFFFFF, 0x2C00},
-	{0x3FDFFBA4E67A56C1, 0x37FF},
-	{0x3FB000001FFFFFFF, 0x2C00},
-	{0x37F00000007FEFFE, 0x0000},
-	{0x3FB000000FFFFFFF, 0x2C00},
-	{0x34D17609A87ED34A, 0x0000},
-	{0x3FB0000007FFFFFF, 0x2C00},
-	{0x37EFFFFFFFF7FFEF, 0x0000},
-	{0x3FB0000003FFFFFF, 0x2C00},
-	{0xC1E1E00000000000, 0xFC00},
-	{0x3FB0000001FFFFFF, 0x2C00},
-	{0x3FD0000FFFFF7FFF, 0x3400},
-	{0x3FB0000000FFFFFF, 0x2C00},
-	{0xBCA00081FFFFFFFE, 0x8000},
-	{0x3FB00000007FFFFF, 0x2C00},
-	{0xC1CC7C990405C4AD, 0xFC00},
-	{0x3FB00000003FFFFF, 0x2C00},
-	{0xB7E820AB8788AC04, 0x8000},
-	{0x3FB00000001FFFFF, 0x2C00},
-	{0x7FD60354387616BB, 0x7C00},
-	{0x3FB00000000FFFFF, 0x2C00},
-	{0x4B13D88B7CEE2A4D, 0x7C00},
-	{0x3FB000000007FFFF, 0x2C00},
-	{0x352FBFFFEFFFFFFF, 0x0000},
-	{0x3FB000000003FFFF, 0x2C00},
-	{0x404FFFFFFE0000FE, 0x5400},
-	{0x3FB000000001FFFF, 0x2C00},
-	{0x3800FFE000000000, 0x0000},
-	{0x3FB000000000FFFF, 0x2C00},
-	{0xE81E000000FFFFFF, 0xFC00},
-	{0x3FB0000000007FFF, 0x2C00},
-	{0xBBC0000000003FFF, 0x8000},
-	{0x3FB0000000003FFF, 0x2C00},
-	{0x417EE9241ACE4EFA, 0x7C00},
-	{0x3FB0000000001FFF, 0x2C00},
-	{0x381AE586E0EF9DE6, 0x0000},
-	{0x3FB0000000000FFF, 0x2C00},
-	{0x4D9FFFFFFFFEFBFF, 0x7C00},
-	{0x3FB00000000007FF, 0x2C00},
-	{0xC00080000000FFFF, 0xC020},
-	{0x3FB00000000003FF, 0x2C00},
-	{0xBFBFFFBFFFFFFFFE, 0xB000},
-	{0x3FB00000000001FF, 0x2C00},
-	{0x40B00007FFFFFFC0, 0x6C00},
-	{0x3FB00000000000FF, 0x2C00},
-	{0x43CFFFFFFF00000F, 0x7C00},
-	{0x3FB000000000007F, 0x2C00},
-	{0xBCAFFFFFFFFFF8FF, 0x8000},
-	{0x3FB000000000003F, 0x2C00},
-	{0x400FFFFFEFFFDFFF, 0x4400},
-	{0x3FB000000000001F, 0x2C00},
-	{0xCF4FFFC3FFFFFFFF, 0xFC00},
-	{0x3FB000000000000F, 0x2C00},
-	{0xC3DC5E8040228972, 0xFC00},
-	{0x3FB0000000000007, 0x2C00},
-	{0x4030000000002007, 0x4C00},
-	{0x3FB0000000000003, 0x2C00},
-	{0xC346CB8092FB3489, 0xFC00},
-	{0x3FC0000000000000, 0x3000},
-	{0x286FFFF7FFFFFC00, 0x0000},
-	{0x3FC0000000000001, 0x3000},
-	{0x37FFFFFFDFFC0000, 0x0000},
-	{0x3FC0000000000002, 0x3000},
-	{0xC3E0000000001080, 0xFC00},
-	{0x3FC0000000000004, 0x3000},
-	{0x7A5791A248D15DB0, 0x7C00},
-	{0x3FC0000000000008, 0x3000},
-	{0xBFE1FFFFF0000000, 0xB880},
-	{0x3FC0000000000010, 0x3000},
-	{0xB80FFFFEFFEFFFFF, 0x8000},
-	{0x3FC0000000000020, 0x3000},
-	{0x7FD0C2887C4EF015, 0x7C00},
-	{0x3FC0000000000040, 0x3000},
-	{0xC7EFFFFFF7DFFFFF, 0xFC00},
-	{0x3FC0000000000080, 0x3000},
-	{0xC1EFF00000100000, 0xFC00},
-	{0x3FC0000000000100, 0x3000},
-	{0x75C020007FFFFFFF, 0x7C00},
-	{0x3FC0000000000200, 0x3000},
-	{0xC3FFFFFFEFFFFF7F, 0xFC00},
-	{0x3FC0000000000400, 0x3000},
-	{0x800247534454C0DB, 0x8000},
-	{0x3FC0000000000800, 0x3000},
-	{0xB80FFEFFFEFFFFFE, 0x8000},
-	{0x3FC0000000001000, 0x3000},
-	{0x9C8FFFFE01FFFFFF, 0x8000},
-	{0x3FC0000000002000, 0x3000},
-	{0x40207FFFFFFFC000, 0x4820},
-	{0x3FC0000000004000, 0x3000},
-	{0xB80F00000007FFFE, 0x8000},
-	{0x3FC0000000008000, 0x3000},
-	{0xBFFC0C879C904121, 0xBF03},
-	{0x3FC0000000010000, 0x3000},
-	{0x801F000000001FFE, 0x8000},
-	{0x3FC0000000020000, 0x3000},
-	{0xB0CAFF736E1B69D7, 0x8000},
-	{0x3FC0000000040000, 0x3000},
-	{0xC7E00000002000FE, 0xFC00},
-	{0x3FC0000000080000, 0x3000},
-	{0x3EC000000FEFFFFE, 0x0020},
-	{0x3FC0000000100000, 0x3000},
-	{0x6940020008000000, 0x7C00},
-	{0x3FC0000000200000, 0x3000},
-	{0x3F9FF77FFFFFFFFE, 0x27FE},
-	{0x3FC0000000400000, 0x3000},
-	{0x403FFFFFFFFFFFF9, 0x5000},
-	{0x3FC0000000800000, 0x3000},
-	{0xF5D4000000000002, 0xFC00},
-	{0x3FC0000001000000, 0x3000},
-	{0x60FFFFFFE000003E, 0x7C00},
-	{0x3FC0000002000000, 0x3000},
-	{0xB7F0040000000000, 0x8000},
-	{0x3FC0000004000000, 0x3000},
-	{0x3FC000020000003F, 0x3000},
-	{0x3FC0000008000000, 0x3000},
-	{0x401000000000000E, 0x4400},
-	{0x3FC0000010000000, 0x3000},
-	{0x43EFFFFFFFDFFFFD, 0x7C00},
-	{0x3FC0000020000000, 0x3000},
-	{0x400000000003FF7E, 0x4000},
-	{0x3FC0000040000000, 0x3000},
-	{0x403001003FFFFFFF, 0x4C00},
-	{0x3FC0000080000000, 0x3000},
-	{0x99F5BFADF72F191F, 0x8000},
-	{0x3FC0000100000000, 0x3000},
-	{0x43EDFFFDFFFFFFFF, 0x7C00},
-	{0x3FC0000200000000, 0x3000},
-	{0xC010000FFFFFFEFE, 0xC400},
-	{0x3FC0000400000000, 0x3000},
-	{0x37E0FBFFFFFFFFFF, 0x0000},
-	{0x3FC0000800000000, 0x3000},
-	{0x43EFFFFFFFFFFF3F, 0x7C00},
-	{0x3FC0001000000000, 0x3000},
-	{0xBFEFFDFFFF7FFFFE, 0xBBFF},
-	{0x3FC0002000000000, 0x3000},
-	{0x4E407FFFFFEFFFFF, 0x7C00},
-	{0x3FC0004000000000, 0x3000},
-	{0xC1D6447287344C19, 0xFC00},
-	{0x3FC0008000000000, 0x3000},
-	{0x4023BFFBC135365E, 0x48F0},
-	{0x3FC0010000000000, 0x3000},
-	{0xBFBDA9A0283202AB, 0xAF6A},
-	{0x3FC0020000000000, 0x3000},
-	{0xB110000040FFFFFE, 0x8000},
-	{0x3FC0040000000000, 0x3001},
-	{0xC3C0000000002FFE, 0xFC00},
-	{0x3FC0080000000000, 0x3002},
-	{0xC7E000000001FDFF, 0xFC00},
-	{0x3FC0100000000000, 0x3004},
-	{0xBFC000400000007E, 0xB000},
-	{0x3FC0200000000000, 0x3008},
-	{0xC10A8301FDB2D62C, 0xFC00},
-	{0x3FC0400000000000, 0x3010},
-	{0x7FEFFFEFF7FFFFFF, 0x7C00},
-	{0x3FC0800000000000, 0x3020},
-	{0xBA400800000003FE, 0x8000},
-	{0x3FC1000000000000, 0x3040},
-	{0xC26E6E79FE2DB76F, 0xFC00},
-	{0x3FC2000000000000, 0x3080},
-	{0xC0268593FE0254C7, 0xC9A1},
-	{0x3FC4000000000000, 0x3100},
-	{0xBCCFFFFFFFFFE008, 0x8000},
-	{0x3FC8000000000000, 0x3200},
-	{0xBFCFFFFFFFFFDF00, 0xB400},
-	{0x3FCC000000000000, 0x3300},
-	{0xF05FFFFF807FFFFF, 0xFC00},
-	{0x3FCE000000000000, 0x3380},
-	{0x3CAFFFFFFFFFFE40, 0x0000},
-	{0x3FCF000000000000, 0x33C0},
-	{0x3FC0000000000000, 0x3000},
-	{0x3FCF800000000000, 0x33E0},
-	{0x43CE303C0416D063, 0x7C00},
-	{0x3FCFC00000000000, 0x33F0},
-	{0x68D00003FFFFFFC0, 0x7C00},
-	{0x3FCFE00000000000, 0x33F8},
-	{0x43CFFFFFFF7FFC00, 0x7C00},
-	{0x3FCFF00000000000, 0x33FC},
-	{0x3FE64F1219C47432, 0x3994},
-	{0x3FCFF80000000000, 0x33FE},
-	{0x41DC7FFFFFFFFFFE, 0x7C00},
-	{0x3FCFFC0000000000, 0x33FF},
-	{0x3FC4C2DD8EB6EFA7, 0x3131},
-	{0x3FCFFE0000000000, 0x3400},
-	{0xBFC7222C22591A30, 0xB1C9},
-	{0x3FCFFF0000000000, 0x3400},
-	{0xBF7000005FFFFFFE, 0x9C00},
-	{0x3FCFFF8000000000, 0x3400},
-	{0xFFE18A4387E9D5BA, 0xFC00},
-	{0x3FCFFFC000000000, 0x3400},
-	{0x3FF7F7FFFFFFFFFE, 0x3DFE},
-	{0x3FCFFFE000000000, 0x3400},
-	{0x801FFFFFC000001E, 0x8000},
-	{0x3FCFFFF000000000, 0x3400},
-	{0x1553FFFFFFFFDFFF, 0x0000},
-	{0x3FCFFFF800000000, 0x3400},
-	{0xC04FFEFFFF7FFFFF, 0xD400},
-	{0x3FCFFFFC00000000, 0x3400},
-	{0xC800000000200010, 0xFC00},
-	{0x3FCFFFFE00000000, 0x3400},
-	{0x43D7FF7FFFFFFFFE, 0x7C00},
-	{0x3FCFFFFF00000000, 0x3400},
-	{0xA37873236F54CE26, 0x8000},
-	{0x3FCFFFFF80000000, 0x3400},
-	{0x37EFFFFE0003FFFF, 0x0000},
-	{0x3FCFFFFFC0000000, 0x3400},
-	{0x90600000010007FF, 0x8000},
-	{0x3FCFFFFFE0000000, 0x3400},
-	{0x3CAFFDFFFFF7FFFE, 0x0000},
-	{0x3FCFFFFFF0000000, 0x3400},
-	{0xBF9000000001007F, 0xA400},
-	{0x3FCFFFFFF8000000, 0x3400},
-	{0x7FE74642AA1122F0, 0x7C00},
-	{0x3FCFFFFFFC000000, 0x3400},
-	{0x41DFEFFFFFFFBFFF, 0x7C00},
-	{0x3FCFFFFFFE000000, 0x3400},
-	{0x3FA0100000000004, 0x2804},
-	{0x3FCFFFFFFF000000, 0x3400},
-	{0x43AFFFFFFFFFBFEF, 0x7C00},
-	{0x3FCFFFFFFF800000, 0x3400},
-	{0x3CAFFFFFFFBDFFFF, 0x0000},
-	{0x3FCFFFFFFFC00000, 0x3400},
-	{0x40140000000003FF, 0x4500},
-	{0x3FCFFFFFFFE00000, 0x3400},
-	{0xBCA00800000007FF, 0x8000},
-	{0x3FCFFFFFFFF00000, 0x3400},
-	{0xC1CFFFC000040000, 0xFC00},
-	{0x3FCFFFFFFFF80000, 0x3400},
-	{0x431FFFFFFFAFFFFF, 0x7C00},
-	{0x3FCFFFFFFFFC0000, 0x3400},
-	{0xC1D00000007F7FFE, 0xFC00},
-	{0x3FCFFFFFFFFE0000, 0x3400},
-	{0x4010000000107FFF, 0x4400},
-	{0x3FCFFFFFFFFF0000, 0x3400},
-	{0x41DF5C5E72146E45, 0x7C00},
-	{0x3FCFFFFFFFFF8000, 0x3400},
-	{0x43F01000007FFFFF, 0x7C00},
-	{0x3FCFFFFFFFFFC000, 0x3400},
-	{0x43E2B985D4CB5765, 0x7C00},
-	{0x3FCFFFFFFFFFE000, 0x3400},
-	{0xC27FC0000003FFFF, 0xFC00},
-	{0x3FCFFFFFFFFFF000, 0x3400},
-	{0x96200040FFFFFFFE, 0x8000},
-	{0x3FCFFFFFFFFFF800, 0x3400},
-	{0xC66FFFFFFFFFFFFF, 0xFC00},
-	{0x3FCFFFFFFFFFFC00, 0x3400},
-	{0x7FFFFC0FFFFFFFFF, 0x7FFF},
-	{0x3FCFFFFFFFFFFE00, 0x3400},
-	{0xBFF0000200000002, 0xBC00},
-	{0x3FCFFFFFFFFFFF00, 0x3400},
-	{0x3F60002000000000, 0x1800},
-	{0x3FCFFFFFFFFFFF80, 0x3400},
-	{0xBC53BFFFFFFFFFFF, 0x8000},
-	{0x3FCFFFFFFFFFFFC0, 0x3400},
-	{0xBFF2000000000000, 0xBC80},
-	{0x3FCFFFFFFFFFFFE0, 0x3400},
-	{0xB2F00000FFFFF7FF, 0x8000},
-	{0x3FCFFFFFFFFFFFF0, 0x3400},
-	{0x4450000002000008, 0x7C00},
-	{0x3FCFFFFFFFFFFFF8, 0x3400},
-	{0xC1D01FFFFFFDFFFE, 0xFC00},
-	{0x3FCFFFFFFFFFFFFC, 0x3400},
-	{0xBFC3EEFB87BBC1D3, 0xB0FC},
-	{0x3FCFFFFFFFFFFFFE, 0x3400},
-	{0xB7FFFFBFFF7FFFFF, 0x8000},
-	{0x3FCFFFFFFFFFFFFF, 0x3400},
-	{0xBFBAC04134BDFEEA, 0xAEB0},
-	{0x3FCFFFFFFFFFFFFD, 0x3400},
-	{0x43D00000000BFFFE, 0x7C00},
-	{0x3FCFFFFFFFFFFFFB, 0x3400},
-	{0xC03000803FFFFFFF, 0xCC00},
-	{0x3FCFFFFFFFFFFFF7, 0x3400},
-	{0xB7FFFFFFEFFFE000, 0x8000},
-	{0x3FCFFFFFFFFFFFEF, 0x3400},
-	{0xBFE10000003FFFFF, 0xB840},
-	{0x3FCFFFFFFFFFFFDF, 0x3400},
-	{0xC04B4E8F92E62423, 0xD2D4},
-	{0x3FCFFFFFFFFFFFBF, 0x3400},
-	{0x0050004000001FFF, 0x0000},
-	{0x3FCFFFFFFFFFFF7F, 0x3400},
-	{0x3F10000010000007, 0x0400},
-	{0x3FCFFFFFFFFFFEFF, 0x3400},
-	{0x47F4FF7577CF883A, 0x7C00},
-	{0x3FCFFFFFFFFFFDFF, 0x3400},
-	{0x3E6FD1850784E921, 0x0001},
-	{0x3FCFFFFFFFFFFBFF, 0x3400},
-	{0x3E492D2CF291B006, 0x0000},
-	{0x3FCFFFFFFFFFF7FF, 0x3400},
-	{0xFFF000000000807F, 0xFE00},
-	{0x3FCFFFFFFFFFEFFF, 0x3400},
-	{0xBF5FFFFC0003FFFF, 0x9800},
-	{0x3FCFFFFFFFFFDFFF, 0x3400},
-	{0xA390000000001FFD, 0x8000},
-	{0x3FCFFFFFFFFFBFFF, 0x3400},
-	{0xB19469DF28E191BF, 0x8000},
-	{0x3FCFFFFFFFFF7FFF, 0x3400},
-	{0xE1900000000007FF, 0xFC00},
-	{0x3FCFFFFFFFFEFFFF, 0x3400},
-	{0x403FDFFFFFFFFBFF, 0x4FF8},
-	{0x3FCFFFFFFFFDFFFF, 0x3400},
-	{0xC593C2AE2C97AFF9, 0xFC00},
-	{0x3FCFFFFFFFFBFFFF, 0x3400},
-	{0x3800000000007EFE, 0x0000},
-	{0x3FCFFFFFFFF7FFFF, 0x3400},
-	{0x381000200000007E, 0x0000},
-	{0x3FCFFFFFFFEFFFFF, 0x3400},
-	{0x47F004000007FFFF, 0x7C00},
-	{0x3FCFFFFFFFDFFFFF, 0x3400},
-	{0x381FBF8000000000, 0x0000},
-	{0x3FCFFFFFFFBFFFFF, 0x3400},
-	{0x3FBD5DDA9E6D00A7, 0x2F57},
-	{0x3FCFFFFFFF7FFFFF, 0x3400},
-	{0xC2FF97AAAB514694, 0xFC00},
-	{0x3FCFFFFFFEFFFFFF, 0x3400},
-	{0x81500000807FFFFF, 0x8000},
-	{0x3FCFFFFFFDFFFFFF, 0x3400},
-	{0xCEC85925B4424C16, 0xFC00},
-	{0x3FCFFFFFFBFFFFFF, 0x3400},
-	{0x47EFFFFFFFBFFFEF, 0x7C00},
-	{0x3FCFFFFFF7FFFFFF, 0x3400},
-	{0x41CFFFBFFFFEFFFF, 0x7C00},
-	{0x3FCFFFFFEFFFFFFF, 0x3400},
-	{0xBF00400000000006, 0x8208},
-	{0x3FCFFFFFDFFFFFFF, 0x3400},
-	{0x3FC0010000200000, 0x3000},
-	{0x3FCFFFFFBFFFFFFF, 0x3400},
-	{0xC0107FFFFFFFFFFF, 0xC420},
-	{0x3FCFFFFF7FFFFFFF, 0x3400},
-	{0x47F9BCA570B1C7A2, 0x7C00},
-	{0x3FCFFFFEFFFFFFFF, 0x3400},
-	{0xC03F68A300213996, 0xCFDA},
-	{0x3FCFFFFDFFFFFFFF, 0x3400},
-	{0x4030001FFFDFFFFE, 0x4C00},
-	{0x3FCFFFFBFFFFFFFF, 0x3400},
-	{0xBD3BDFFFFFFFFFFF, 0x8000},
-	{0x3FCFFFF7FFFFFFFF, 0x3400},
-	{0x37F0FFFFFFF7FFFF, 0x0000},
-	{0x3FCFFFEFFFFFFFFF, 0x3400},
-	{0x3FDFFFFFFFF7DFFF, 0x3800},
-	{0x3FCFFFDFFFFFFFFF, 0x3400},
-	{0xBFEFFFFFFFFEFEFE, 0xBC00},
-	{0x3FCFFFBFFFFFFFFF, 0x3400},
-	{0xC3D080007FFFFFFE, 0xFC00},
-	{0x3FCFFF7FFFFFFFFF, 0x3400},
-	{0x4A9FFFFFE0000002, 0x7C00},
-	{0x3FCFFEFFFFFFFFFF, 0x3400},
-	{0x2E5000000001FFDF, 0x0000},
-	{0x3FCFFDFFFFFFFFFF, 0x33FF},
-	{0x572FEFFFFBFFFFFF, 0x7C00},
-	{0x3FCFFBFFFFFFFFFF, 0x33FF},
-	{0x402F7FF7FFFFFFFE, 0x4BE0},
-	{0x3FCFF7FFFFFFFFFF, 0x33FE},
-	{0x225AFDD53FF2E4B0, 0x0000},
-	{0x3FCFEFFFFFFFFFFF, 0x33FC},
-	{0xC3FFFFFFFFC00020, 0xFC00},
-	{0x3FCFDFFFFFFFFFFF, 0x33F8},
-	{0xBF310000000FFFFE, 0x8C40},
-	{0x3FCFBFFFFFFFFFFF, 0x33F0},
-	{0xC1FFFFFFFF800FFE, 0xFC00},
-	{0x3FCF7FFFFFFFFFFF, 0x33E0},
-	{0xC01DFFFFC0000000, 0xC780},
-	{0x3FCEFFFFFFFFFFFF, 0x33C0},
-	{0x43DFFFF800000002, 0x7C00},
-	{0x3FCDFFFFFFFFFFFF, 0x3380},
-	{0xC460000000000060, 0xFC00},
-	{0x3FCBFFFFFFFFFFFF, 0x3300},
-	{0x1C9FF7FFFFEFFFFF, 0x0000},
-	{0x3FC7FFFFFFFFFFFF, 0x3200},
-	{0x41EF80000000FFFE, 0x7C00},
-	{0x3FC3FFFFFFFFFFFF, 0x3100},
-	{0xBFB0003FFFFFFFFA, 0xAC00},
-	{0x3FC1FFFFFFFFFFFF, 0x3080},
-	{0x406209EC0F3868F3, 0x5882},
-	{0x3FC0FFFFFFFFFFFF, 0x3040},
-	{0xC3E000000007FFF7, 0xFC00},
-	{0x3FC07FFFFFFFFFFF, 0x3020},
-	{0x00103FFFFFFFFFBF, 0x0000},
-	{0x3FC03FFFFFFFFFFF, 0x3010},
-	{0xB9DF9703D8CC46E2, 0x8000},
-	{0x3FC01FFFFFFFFFFF, 0x3008},
-	{0x80293F589E0E3B12, 0x8000},
-	{0x3FC00FFFFFFFFFFF, 0x3004},
-	{0xC0AB7FFFFFFFFFFF, 0xEAE0},
-	{0x3FC007FFFFFFFFFF, 0x3002},
-	{0xCBC00000007FFFFB, 0xFC00},
-	{0x3FC003FFFFFFFFFF, 0x3001},
-	{0x93300000017FFFFE, 0x8000},
-	{0x3FC001FFFFFFFFFF, 0x3000},
-	{0xC064CBF0088E2588, 0xD933},
-	{0x3FC000FFFFFFFFFF, 0x3000},
-	{0x3F6FFFFF7FFE0000, 0x1C00},
-	{0x3FC0007FFFFFFFFF, 0x3000},
-	{0x3FD00004FFFFFFFE, 0x3400},
-	{0x3FC0003FFFFFFFFF, 0x3000},
-	{0x41F9698BEE6B2A96, 0x7C00},
-	{0x3FC0001FFFFFFFFF, 0x3000},
-	{0x3CA00FFFFFFFFFEF, 0x0000},
-	{0x3FC0000FFFFFFFFF, 0x3000},
-	{0xC3E020003FFFFFFF, 0xFC00},
-	{0x3FC00007FFFFFFFF, 0x3000},
-	{0xC08000008000001F, 0xE000},
-	{0x3FC00003FFFFFFFF, 0x3000},
-	{0xC01D7BF954079CB0, 0xC75F},
-	{0x3FC00001FFFFFFFF, 0x3000},
-	{0xC4D4E67D19D0F977, 0xFC00},
-	{0x3FC00000FFFFFFFF, 0x3000},
-	{0x51DDF7F1C6116BF8, 0x7C00},
-	{0x3FC000007FFFFFFF, 0x3000},
-	{0x4037CC64A13D2551, 0x4DF3},
-	{0x3FC000003FFFFFFF, 0x3000},
-	{0xB2ADFFF7FFFFFFFF, 0x8000},
-	{0x3FC000001FFFFFFF, 0x3000},
-	{0x3FEFFDFFFFF7FFFF, 0x3BFF},
-	{0x3FC000000FFFFFFF, 0x3000},
-	{0x3F21C00000000000, 0x0870},
-	{0x3FC0000007FFFFFF, 0x3000},
-	{0xBFEFFFFFFFC00FFE, 0xBC00},
-	{0x3FC0000003FFFFFF, 0x3000},
-	{0x3EDFC04000000000, 0x007F},
-	{0x3FC0000001FFFFFF, 0x3000},
-	{0xBFF0005000000000, 0xBC00},
-	{0x3FC0000000FFFFFF, 0x3000},
-	{0xC02003FFFBFFFFFF, 0xC801},
-	{0x3FC00000007FFFFF, 0x3000},
-	{0xA6D000000FFFBFFF, 0x8000},
-	{0x3FC00000003FFFFF, 0x3000},
-	{0x4009F162C2EE5E19, 0x427C},
-	{0x3FC00000001FFFFF, 0x3000},
-	{0xBFF2000010000000, 0xBC80},
-	{0x3FC00000000FFFFF, 0x3000},
-	{0xC34A6801494875A9, 0xFC00},
-	{0x3FC000000007FFFF, 0x3000},
-	{0x40A1000000000000, 0x6840},
-	{0x3FC000000003FFFF, 0x3000},
-	{0x411FFFFFFFFFE1FE, 0x7C00},
-	{0x3FC000000001FFFF, 0x3000},
-	{0x700000000007FBFF, 0x7C00},
-	{0x3FC000000000FFFF, 0x3000},
-	{0x47F07FFFFF7FFFFF, 0x7C00},
-	{0x3FC0000000007FFF, 0x3000},
-	{0xC20FFFFFFFFE001F, 0xFC00},
-	{0x3FC0000000003FFF, 0x3000},
-	{0xC1E000003FFFBFFF, 0xFC00},
-	{0x3FC0000000001FFF, 0x3000},
-	{0x001FFFFFFFFFFE7F, 0x0000},
-	{0x3FC0000000000FFF, 0x3000},
-	{0xB8100000000FEFFF, 0x8000},
-	{0x3FC00000000007FF, 0x3000},
-	{0xC1C9500539C48E8E, 0xFC00},
-	{0x3FC00000000003FF, 0x3000},
-	{0x437FFFFFFFFFDFFD, 0x7C00},
-	{0x3FC00000000001FF, 0x3000},
-	{0x3FCFC00000000003, 0x33F0},
-	{0x3FC00000000000FF, 0x3000},
-	{0xC0556A64A5F5C885, 0xD55B},
-	{0x3FC000000000007F, 0x3000},
-	{0xFFFFFFFFFFFFFFFE, 0xFFFF},
-	{0x3FC000000000003F, 0x3000},
-	{0xC040000002FFFFFF, 0xD000},
-	{0x3FC000000000001F, 0x3000},
-	{0xC1E3BAE17F943350, 0xFC00},
-	{0x3FC000000000000F, 0x3000},
-	{0xBFF00000EFFFFFFF, 0xBC00},
-	{0x3FC0000000000007, 0x3000},
-	{0xC2F000100000FFFE, 0xFC00},
-	{0x3FC0000000000003, 0x3000},
-	{0x460000000037FFFF, 0x7C00},
-	{0x3FD0000000000000, 0x3400},
-	{0xC0A6AF0ED771C2FF, 0xE9AC},
-	{0x3FD0000000000001, 0x3400},
-	{0xC461C525D345643C, 0xFC00},
-	{0x3FD0000000000002, 0x3400},
-	{0xBFFFFF7FFFFFFFDF, 0xC000},
-	{0x3FD0000000000004, 0x3400},
-	{0x7FFDC7375A217966, 0x7F71},
-	{0x3FD0000000000008, 0x3400},
-	{0x47E0000000EFFFFE, 0x7C00},
-	{0x3FD0000000000010, 0x3400},
-	{0x40000000000003FB, 0x4000},
-	{0x3FD0000000000020, 0x3400},
-	{0xC340000FFFDFFFFF, 0xFC00},
-	{0x3FD0000000000040, 0x3400},
-	{0x403FFEFFFFFFFFC0, 0x5000},
-	{0x3FD0000000000080, 0x3400},
-	{0x802000007FFFFDFF, 0x8000},
-	{0x3FD0000000000100, 0x3400},
-	{0x347000000007DFFF, 0x0000},
-	{0x3FD0000000000200, 0x3400},
-	{0x001000001FFFFC00, 0x0000},
-	{0x3FD0000000000400, 0x3400},
-	{0x41F0000800000040, 0x7C00},
-	{0x3FD0000000000800, 0x3400},
-	{0xC00FFFFE00020000, 0xC400},
-	{0x3FD0000000001000, 0x3400},
-	{0x801002000003FFFF, 0x8000},
-	{0x3FD0000000002000, 0x3400},
-	{0x3CAA75D057DCFCD2, 0x0000},
-	{0x3FD0000000004000, 0x3400},
-	{0x401FFDFFFDFFFFFF, 0x47FF},
-	{0x3FD0000000008000, 0x3400},
-	{0x434FFFFFFFFFFFF1, 0x7C00},
-	{0x3FD0000000010000, 0x3400},
-	{0xCE0EFFFFF0000000, 0xFC00},
-	{0x3FD0000000020000, 0x3400},
-	{0x480EFFFFFDFFFFFF, 0x7C00},
-	{0x3FD0000000040000, 0x3400},
-	{0x402FFFFFFFFFF004, 0x4C00},
-	{0x3FD0000000080000, 0x3400},
-	{0xBFE03FFFFF7FFFFF, 0xB810},
-	{0x3FD0000000100000, 0x3400},
-	{0xC01FF80000000007, 0xC7FE},
-	{0x3FD0000000200000, 0x3400},
-	{0x3F9BFA04BF8B9254, 0x26FF},
-	{0x3FD0000000400000, 0x3400},
-	{0xCC8C770B5D2EF876, 0xFC00},
-	{0x3FD0000000800000, 0x3400},
-	{0x406FF800FFFFFFFF, 0x5BFE},
-	{0x3FD0000001000000, 0x3400},
-	{0x404FFFFFFFFFFFFE, 0x5400},
-	{0x3FD0000002000000, 0x3400},
-	{0x821800A2F4919FE6, 0x8000},
-	{0x3FD0000004000000, 0x3400},
-	{0x37F0004000000001, 0x0000},
-	{0x3FD0000008000000, 0x3400},
-	{0xBF9080001FFFFFFE, 0xA420},
-	{0x3FD0000010000000, 0x3400},
-	{0x3FE000FFFFFFFFFD, 0x3800},
-	{0x3FD0000020000000, 0x3400},
-	{0x4001FC0000000000, 0x407F},
-	{0x3FD0000040000000, 0x3400},
-	{0x3FE000000010003F, 0x3800},
-	{0x3FD0000080000000, 0x3400},
-	{0x3FA00000003FFFFF, 0x2800},
-	{0x3FD0000100000000, 0x3400},
-	{0x43EFFFFFFFFFFEEF, 0x7C00},
-	{0x3FD0000200000000, 0x3400},
-	{0x37E8041A84463B59, 0x0000},
-	{0x3FD0000400000000, 0x3400},
-	{0x41D0000001000100, 0x7C00},
-	{0x3FD0000800000000, 0x3400},
-	{0x434C00000FFFFFFF, 0x7C00},
-	{0x3FD0001000000000, 0x3400},
-	{0x800B2CB5FF671EA0, 0x8000},
-	{0x3FD0002000000000, 0x3400},
-	{0xBFB00000FFFFFBFF, 0xAC00},
-	{0x3FD0004000000000, 0x3400},
-	{0x7FDFFFFFFFDFFFF7, 0x7C00},
-	{0x3FD0008000000000, 0x3400},
-	{0xC2DE3C49AE93DB8F, 0xFC00},
-	{0x3FD0010000000000, 0x3400},
-	{0x801FFFE01FFFFFFE, 0x8000},
-	{0x3FD0020000000000, 0x3400},
-	{0x80264D55CBF54EE2, 0x8000},
-	{0x3FD0040000000000, 0x3401},
-	{0xC3D4949E589527E0, 0xFC00},
-	{0x3FD0080000000000, 0x3402},
-	{0xBFFFFFEFFFBFFFFF, 0xC000},
-	{0x3FD0100000000000, 0x3404},
-	{0xBEC5A57E5F82C5E4, 0x802B},
-	{0x3FD0200000000000, 0x3408},
-	{0xC0E017FFFFFFFFFF, 0xF806},
-	{0x3FD0400000000000, 0x3410},
-	{0xB7EFFFFF800003FE, 0x8000},
-	{0x3FD0800000000000, 0x3420},
-	{0x3FB1F31AE46C8C62, 0x2C7D},
-	{0x3FD1000000000000, 0x3440},
-	{0xB19E1C3F62AAF580, 0x8000},
-	{0x3FD2000000000000, 0x3480},
-	{0x14E0100007FFFFFE, 0x0000},
-	{0x3FD4000000000000, 0x3500},
-	{0xBFC0000000000028, 0xB000},
-	{0x3FD8000000000000, 0x3600},
-	{0x38000001FEFFFFFE, 0x0000},
-	{0x3FDC000000000000, 0x3700},
-	{0xC3FFFFF7FFFFBFFF, 0xFC00},
-	{0x3FDE000000000000, 0x3780},
-	{0xC34FFFC001000000, 0xFC00},
-	{0x3FDF000000000000, 0x37C0},
-	{0x000F7FFFFFFC0000, 0x0000},
-	{0x3FDF800000000000, 0x37E0},
-	{0x3FE0000010080000, 0x3800},
-	{0x3FDFC00000000000, 0x37F0},
-	{0x7FF8C290D08976E5, 0x7E30},
-	{0x3FDFE00000000000, 0x37F8},
-	{0x403000087FFFFFFE, 0x4C00},
-	{0x3FDFF00000000000, 0x37FC},
-	{0x7FD00000007EFFFF, 0x7C00},
-	{0x3FDFF80000000000, 0x37FE},
-	{0xC3E9CCB448512269, 0xFC00},
-	{0x3FDFFC0000000000, 0x37FF},
-	{0xFFD00000003EFFFE, 0xFC00},
-	{0x3FDFFE0000000000, 0x3800},
-	{0xC1BBFFFFFC000000, 0xFC00},
-	{0x3FDFFF0000000000, 0x3800},
-	{0x4E2FFFFFF0000002, 0x7C00},
-	{0x3FDFFF8000000000, 0x3800},
-	{0xC0B0003EFFFFFFFF, 0xEC00},
-	{0x3FDFFFC000000000, 0x3800},
-	{0x40000003C0000000, 0x4000},
-	{0x3FDFFFE000000000, 0x3800},
-	{0xBBBFFFFE0000007F, 0x8000},
-	{0x3FDFFFF000000000, 0x3800},
-	{0xC148AF28BFDE149C, 0xFC00},
-	{0x3FDFFFF800000000, 0x3800},
-	{0x47EFFFFF70000000, 0x7C00},
-	{0x3FDFFFFC00000000, 0x3800},
-	{0xC1456A2D03A79F80, 0xFC00},
-	{0x3FDFFFFE00000000, 0x3800},
-	{0x198FBFFFFFFDFFFE, 0x0000},
-	{0x3FDFFFFF00000000, 0x3800},
-	{0x6FC0000000040FFF, 0x7C00},
-	{0x3FDFFFFF80000000, 0x3800},
-	{0x43CFFFFFFFFFFFDE, 0x7C00},
-	{0x3FDFFFFFC0000000, 0x3800},
-	{0xC0589520A8199AB4, 0xD625},
-	{0x3FDFFFFFE0000000, 0x3800},
-	{0xC02FFFEFFEFFFFFE, 0xCC00},
-	{0x3FDFFFFFF0000000, 0x3800},
-	{0x2A585ED7DA3EF77D, 0x0000},
-	{0x3FDFFFFFF8000000, 0x3800},
-	{0x3E2004000000000E, 0x0000},
-	{0x3FDFFFFFFC000000, 0x3800},
-	{0xC9FFEFFFFFFEFFFE, 0xFC00},
-	{0x3FDFFFFFFE000000, 0x3800},
-	{0xC00EA971C3950ADA, 0xC3AA},
-	{0x3FDFFFFFFF000000, 0x3800},
-	{0xC009F5E2C5722D7B, 0xC27D},
-	{0x3FDFFFFFFF800000, 0x3800},
-	{0xC3E01000000001FE, 0xFC00},
-	{0x3FDFFFFFFFC00000, 0x3800},
-	{0x4000000800003FFF, 0x4000},
-	{0x3FDFFFFFFFE00000, 0x3800},
-	{0x402B4E73A63A8ACD, 0x4AD4},
-	{0x3FDFFFFFFFF00000, 0x3800},
-	{0xD50E00000000000F, 0xFC00},
-	{0x3FDFFFFFFFF80000, 0x3800},
-	{0xC800080008000000, 0xFC00},
-	{0x3FDFFFFFFFFC0000, 0x3800},
-	{0xCA9000000002FFFF, 0xFC00},
-	{0x3FDFFFFFFFFE0000, 0x3800},
-	{0x3EAFFFFFFFFFE200, 0x0010},
-	{0x3FDFFFFFFFFF0000, 0x3800},
-	{0xB22FFFFFFF800003, 0x8000},
-	{0x3FDFFFFFFFFF8000, 0x3800},
-	{0xB7F4020000000000, 0x8000},
-	{0x3FDFFFFFFFFFC000, 0x3800},
-	{0xCC8FFFFFFFFFDFFC, 0xFC00},
-	{0x3FDFFFFFFFFFE000, 0x3800},
-	{0xB7F834FDD9A48E8D, 0x8000},
-	{0x3FDFFFFFFFFFF000, 0x3800},
-	{0xB76C703E83E71F1A, 0x8000},
-	{0x3FDFFFFFFFFFF800, 0x3800},
-	{0x43C0000002000100, 0x7C00},
-	{0x3FDFFFFFFFFFFC00, 0x3800},
-	{0xC0A0000040400000, 0xE800},
-	{0x3FDFFFFFFFFFFE00, 0x3800},
-	{0x3FD00400007FFFFF, 0x3401},
-	{0x3FDFFFFFFFFFFF00, 0x3800},
-	{0x3FF010000FFFFFFE, 0x3C04},
-	{0x3FDFFFFFFFFFFF80, 0x3800},
-	{0xBFF007FFFFF00000, 0xBC02},
-	{0x3FDFFFFFFFFFFFC0, 0x3800},
-	{0x7FF0000FFFE00000, 0x7E00},
-	{0x3FDFFFFFFFFFFFE0, 0x3800},
-	{0x7FFF7FFFBFFFFFFF, 0x7FDF},
-	{0x3FDFFFFFFFFFFFF0, 0x3800},
-	{0x47FDA34836871710, 0x7C00},
-	{0x3FDFFFFFFFFFFFF8, 0x3800},
-	{0x44E0000008000007, 0x7C00},
-	{0x3FDFFFFFFFFFFFFC, 0x3800},
-	{0x415FFFFE00001000, 0x7C00},
-	{0x3FDFFFFFFFFFFFFE, 0x3800},
-	{0x800FFE00003FFFFF, 0x8000},
-	{0x3FDFFFFFFFFFFFFF, 0x3800},
-	{0x47F8D814E0BC0404, 0x7C00},
-	{0x3FDFFFFFFFFFFFFD, 0x3800},
-	{0x3CAFFFE0001FFFFF, 0x0000},
-	{0x3FDFFFFFFFFFFFFB, 0x3800},
-	{0xCA0F56B953BF53FC, 0xFC00},
-	{0x3FDFFFFFFFFFFFF7, 0x3800},
-	{0x3FD00000000007BE, 0x3400},
-	{0x3FDFFFFFFFFFFFEF, 0x3800},
-	{0x3F8FFF8FFFFFFFFE, 0x2400},
-	{0x3FDFFFFFFFFFFFDF, 0x3800},
-	{0xC001FFFFFFFFFFBF, 0xC080},
-	{0x3FDFFFFFFFFFFFBF, 0x3800},
-	{0xC07FFFFFFFFFDFFE, 0xE000},
-	{0x3FDFFFFFFFFFFF7F, 0x3800},
-	{0x3FDFF6FFFFFFFFFE, 0x37FE},
-	{0x3FDFFFFFFFFFFEFF, 0x3800},
-	{0xC3DFFFFFC0000000, 0xFC00},
-	{0x3FDFFFFFFFFFFDFF, 0x3800},
-	{0xFFE9EB64EE72DC5C, 0xFC00},
-	{0x3FDFFFFFFFFFFBFF, 0x3800},
-	{0xC0046A02A87E7097, 0xC11B},
-	{0x3FDFFFFFFFFFF7FF, 0x3800},
-	{0xB61FFFFFFFBFFF7F, 0x8000},
-	{0x3FDFFFFFFFFFEFFF, 0x3800},
-	{0xBF100000009FFFFF, 0x8400},
-	{0x3FDFFFFFFFFFDFFF, 0x3800},
-	{0xFA1800000003FFFE, 0xFC00},
-	{0x3FDFFFFFFFFFBFFF, 0x3800},
-	{0x41CFFFF00000003F, 0x7C00},
-	{0x3FDFFFFFFFFF7FFF, 0x3800},
-	{0x4A7C0000007FFFFE, 0x7C00},
-	{0x3FDFFFFFFFFEFFFF, 0x3800},
-	{0xC1EFFFFF7F7FFFFF, 0xFC00},
-	{0x3FDFFFFFFFFDFFFF, 0x3800},
-	{0xC4C00407FFFFFFFF, 0xFC00},
-	{0x3FDFFFFFFFFBFFFF, 0x3800},
-	{0x4807A468D3370C0A, 0x7C00},
-	{0x3FDFFFFFFFF7FFFF, 0x3800},
-	{0x255FFBFFFFFFF800, 0x0000},
-	{0x3FDFFFFFFFEFFFFF, 0x3800},
-	{0xB7E00000083FFFFF, 0x8000},
-	{0x3FDFFFFFFFDFFFFF, 0x3800},
-	{0xC07FFFE004000000, 0xE000},
-	{0x3FDFFFFFFFBFFFFF, 0x3800},
-	{0x000000FFFFFFFFFC, 0x0000},
-	{0x3FDFFFFFFF7FFFFF, 0x3800},
-	{0x1FFFFFFFFFFFFFFF, 0x0000},
-	{0x3FDFFFFFFEFFFFFF, 0x3800},
-	{0xC08200000FFFFFFE, 0xE080},
-	{0x3FDFFFFFFDFFFFFF, 0x3800},
-	{0x43D00000005FFFFE, 0x7C00},
-	{0x3FDFFFFFFBFFFFFF, 0x3800},
-	{0xCBAFFFFF803FFFFF, 0xFC00},
-	{0x3FDFFFFFF7FFFFFF, 0x3800},
-	{0x3E3FFFF000000040, 0x0000},
-	{0x3FDFFFFFEFFFFFFF, 0x3800},
-	{0xC1E0FFFFFFEFFFFF, 0xFC00},
-	{0x3FDFFFFFDFFFFFFF, 0x3800},
-	{0xC02B88CC49185486, 0xCAE2},
-	{0x3FDFFFFFBFFFFFFF, 0x3800},
-	{0xFFEFFFF0000FFFFF, 0xFC00},
-	{0x3FDFFFFF7FFFFFFF, 0x3800},
-	{0x80200000010FFFFF, 0x8000},
-	{0x3FDFFFFEFFFFFFFF, 0x3800},
-	{0x4010000003FFFFFF, 0x4400},
-	{0x3FDFFFFDFFFFFFFF, 0x3800},
-	{0x3FBFAFCA04FE7A34, 0x2FEC},
-	{0x3FDFFFFBFFFFFFFF, 0x3800},
-	{0xB81CACC7BE37A6F4, 0x8000},
-	{0x3FDFFFF7FFFFFFFF, 0x3800},
-	{0x3E7FF7FFFFFFFFBF, 0x0002},
-	{0x3FDFFFEFFFFFFFFF, 0x3800},
-	{0xDAFFFFFFFFFFF07E, 0xFC00},
-	{0x3FDFFFDFFFFFFFFF, 0x3800},
-	{0x2470FFFFFFFFFFFF, 0x0000},
-	{0x3FDFFFBFFFFFFFFF, 0x3800},
-	{0x3D6FC00000800000, 0x0000},
-	{0x3FDFFF7FFFFFFFFF, 0x3800},
-	{0x3FFFFF00001FFFFE, 0x4000},
-	{0x3FDFFEFFFFFFFFFF, 0x3800},
-	{0x3FAFFFFFFFFFFFFF, 0x2C00},
-	{0x3FDFFDFFFFFFFFFF, 0x37FF},
-	{0xA77000010FFFFFFF, 0x8000},
-	{0x3FDFFBFFFFFFFFFF, 0x37FF},
-	{0xFFE2363B3B9765D2, 0xFC00},
-	{0x3FDFF7FFFFFFFFFF, 0x37FE},
-	{0xC0A0000040003FFF, 0xE800},
-	{0x3FDFEFFFFFFFFFFF, 0x37FC},
-	{0xC010003FFFFF7FFF, 0xC400},
-	{0x3FDFDFFFFFFFFFFF, 0x37F8},
-	{0xAF200200003FFFFF, 0x8000},
-	{0x3FDFBFFFFFFFFFFF, 0x37F0},
-	{0x62A0FFFFFFFFFFC0, 0x7C00},
-	{0x3FDF7FFFFFFFFFFF, 0x37E0},
-	{0x41C0002000000400, 0x7C00},
-	{0x3FDEFFFFFFFFFFFF, 0x37C0},
-	{0x43FD444FDE78CFD6, 0x7C00},
-	{0x3FDDFFFFFFFFFFFF, 0x3780},
-	{0x0001001FFFFFFFFF, 0x0000},
-	{0x3FDBFFFFFFFFFFFF, 0x3700},
-	{0x7FE000000080003F, 0x7C00},
-	{0x3FD7FFFFFFFFFFFF, 0x3600},
-	{0x3AD000000000000C, 0x0000},
-	{0x3FD3FFFFFFFFFFFF, 0x3500},
-	{0xBFAFFFDF80000000, 0xAC00},
-	{0x3FD1FFFFFFFFFFFF, 0x3480},
-	{0xBA8FFFFFFFBFFF7F, 0x8000},
-	{0x3FD0FFFFFFFFFFFF, 0x3440},
-	{0xC2BFFBFFFFFFFF80, 0xFC00},
-	{0x3FD07FFFFFFFFFFF, 0x3420},
-	{0xC02E000000007FFF, 0xCB80},
-	{0x3FD03FFFFFFFFFFF, 0x3410},
-	{0x3FCDFFFFFFBFFFFF, 0x3380},
-	{0x3FD01FFFFFFFFFFF, 0x3408},
-	{0xC1EFFFFFFFFFFFFF, 0xFC00},
-	{0x3FD00FFFFFFFFFFF, 0x3404},
-	{0xA4210BFBC83B9FE8, 0x8000},
-	{0x3FD007FFFFFFFFFF, 0x3402},
-	{0xC124CA8E492E58DC, 0xFC00},
-	{0x3FD003FFFFFFFFFF, 0x3401},
-	{0x7FE32FDA3D1F8521, 0x7C00},
-	{0x3FD001FFFFFFFFFF, 0x3400},
-	{0x7FD00000001FFFBF, 0x7C00},
-	{0x3FD000FFFFFFFFFF, 0x3400},
-	{0xC59C3A09CF189470, 0xFC00},
-	{0x3FD0007FFFFFFFFF, 0x3400},
-	{0x380FFBFFFFFFFEFF, 0x0000},
-	{0x3FD0003FFFFFFFFF, 0x3400},
-	{0xBA0000000000FFFA, 0x8000},
-	{0x3FD0001FFFFFFFFF, 0x3400},
-	{0xBFDFFFFFFFFF7800, 0xB800},
-	{0x3FD0000FFFFFFFFF, 0x3400},
-	{0xBFFB30F15AA91D98, 0xBECC},
-	{0x3FD00007FFFFFFFF, 0x3400},
-	{0x41F57E618234A33A, 0x7C00},
-	{0x3FD00003FFFFFFFF, 0x3400},
-	{0x43D00200003FFFFF, 0x7C00},
-	{0x3FD00001FFFFFFFF, 0x3400},
-	{0xC0BBFFFFFFFFFFFF, 0xEF00},
-	{0x3FD00000FFFFFFFF, 0x3400},
-	{0xBFFFFF8000000008, 0xC000},
-	{0x3FD000007FFFFFFF, 0x3400},
-	{0x41DBF882293C46FC, 0x7C00},
-	{0x3FD000003FFFFFFF, 0x3400},
-	{0x3FD0FFFFFFFFFF00, 0x3440},
-	{0x3FD000001FFFFFFF, 0x3400},
-	{0xBFB57D4539BCD50F, 0xAD5F},
-	{0x3FD000000FFFFFFF, 0x3400},
-	{0xC6BFF7FFFFFFFE00, 0xFC00},
-	{0x3FD0000007FFFFFF, 0x3400},
-	{0xC004FE9A89123878, 0xC140},
-	{0x3FD0000003FFFFFF, 0x3400},
-	{0xA6F000008000001E, 0x8000},
-	{0x3FD0000001FFFFFF, 0x3400},
-	{0xC6A0001FFDFFFFFF, 0xFC00},
-	{0x3FD0000000FFFFFF, 0x3400},
-	{0xBCA000009FFFFFFE, 0x8000},
-	{0x3FD00000007FFFFF, 0x3400},
-	{0x381FFFFFF5FFFFFF, 0x0000},
-	{0x3FD00000003FFFFF, 0x3400},
-	{0xBFBFFFFFFE000080, 0xB000},
-	{0x3FD00000001FFFFF, 0x3400},
-	{0xC7B000007FDFFFFF, 0xFC00},
-	{0x3FD00000000FFFFF, 0x3400},
-	{0x4020010000000003, 0x4800},
-	{0x3FD000000007FFFF, 0x3400},
-	{0xD9CAA16C6B37653B, 0xFC00},
-	{0x3FD000000003FFFF, 0x3400},
-	{0x7FF0000000FFBFFF, 0x7E00},
-	{0x3FD000000001FFFF, 0x3400},
-	{0x002EFFFFDFFFFFFF, 0x0000},
-	{0x3FD000000000FFFF, 0x3400},
-	{0xBB6FFFFFFFFF81FF, 0x8000},
-	{0x3FD0000000007FFF, 0x3400},
-	{0xCEAFFFFFFFFFFFEF, 0xFC00},
-	{0x3FD0000000003FFF, 0x3400},
-	{0xC1FBB85141F6A8DF, 0xFC00},
-	{0x3FD0000000001FFF, 0x3400},
-	{0xBADE7FF47A3735CF, 0x8000},
-	{0x3FD0000000000FFF, 0x3400},
-	{0xC3C00007FFFFEFFF, 0xFC00},
-	{0x3FD00000000007FF, 0x3400},
-	{0xC0210000007FFFFE, 0xC840},
-	{0x3FD00000000003FF, 0x3400},
-	{0xC1CFFFFF0003FFFF, 0xFC00},
-	{0x3FD00000000001FF, 0x3400},
-	{0xBEEFAFFFFFFFFFFE, 0x80FD},
-	{0x3FD00000000000FF, 0x3400},
-	{0xC7AFC15B8FAE3CE0, 0xFC00},
-	{0x3FD000000000007F, 0x3400},
-	{0x386B9B92A49F3372, 0x0000},
-	{0x3FD000000000003F, 0x3400},
-	{0xC3400000000000C0, 0xFC00},
-	{0x3FD000000000001F, 0x3400},
-	{0x7FF0000000000000, 0x7C00},
-	{0x3FD000000000000F, 0x3400},
-	{0x4030000003FF0000, 0x4C00},
-	{0x3FD0000000000007, 0x3400},
-	{0xFFDC241F881A188C, 0xFC00},
-	{0x3FD0000000000003, 0x3400},
-	{0x3FF1000002000000, 0x3C40},
-	{0x3FE0000000000000, 0x3800},
-	{0xC800000000FFFFEF, 0xFC00},
-	{0x3FE0000000000001, 0x3800},
-	{0x63970DC4F90DD0CB, 0x7C00},
-	{0x3FE0000000000002, 0x3800},
-	{0xC3C0000401000000, 0xFC00},
-	{0x3FE0000000000004, 0x3800},
-	{0x42C0000100080000, 0x7C00},
-	{0x3FE0000000000008, 0x3800},
-	{0xBE85559410A2BBD5, 0x8003},
-	{0x3FE0000000000010, 0x3800},
-	{0xC34000100FFFFFFF, 0xFC00},
-	{0x3FE0000000000020, 0x3800},
-	{0x4C6FFE00001FFFFF, 0x7C00},
-	{0x3FE0000000000040, 0x3800},
-	{0x8ACC000000000002, 0x8000},
-	{0x3FE0000000000080, 0x3800},
-	{0xB583D842BFF272BC, 0x8000},
-	{0x3FE0000000000100, 0x3800},
-	{0xA401000000040000, 0x8000},
-	{0x3FE0000000000200, 0x3800},
-	{0xBFE07FBFFFFFFFFF, 0xB820},
-	{0x3FE0000000000400, 0x3800},
-	{0x2E3FF0000000001E, 0x0000},
-	{0x3FE0000000000800, 0x3800},
-	{0x3F100000000004FF, 0x0400},
-	{0x3FE0000000001000, 0x3800},
-	{0xC3E01FFC00000000, 0xFC00},
-	{0x3FE0000000002000, 0x3800},
-	{0xB2AAD1814F916E10, 0x8000},
-	{0x3FE0000000004000, 0x3800},
-	{0xC23FFFFFFFFFFF3F, 0xFC00},
-	{0x3FE0000000008000, 0x3800},
-	{0xFFD000000000FBFF, 0xFC00},
-	{0x3FE0000000010000, 0x3800},
-	{0xB81FFFE7FFFFFFFF, 0x8000},
-	{0x3FE0000000020000, 0x3800},
-	{0x400F7FFFFFFFFFFF, 0x43E0},
-	{0x3FE0000000040000, 0x3800},
-	{0x53B000009FFFFFFE, 0x7C00},
-	{0x3FE0000000080000, 0x3800},
-	{0x47E12B6BC83637D1, 0x7C00},
-	{0x3FE0000000100000, 0x3800},
-	{0x6840000001000040, 0x7C00},
-	{0x3FE0000000200000, 0x3800},
-	{0xCAEDA86978673D2E, 0xFC00},
-	{0x3FE0000000400000, 0x3800},
-	{0x802E63E7C2EB999B, 0x8000},
-	{0x3FE0000000800000, 0x3800},
-	{0xC3E0000000008020, 0xFC00},
-	{0x3FE0000001000000, 0x3800},
-	{0x37F0000008007FFF, 0x0000},
-	{0x3FE0000002000000, 0x3800},
-	{0xC3DFFFFC0000FFFE, 0xFC00},
-	{0x3FE0000004000000, 0x3800},
-	{0x43A00001FFFFFFFC, 0x7C00},
-	{0x3FE0000008000000, 0x3800},
-	{0xBFB8745F37B2A201, 0xAE1D},
-	{0x3FE0000010000000, 0x3800},
-	{0xC01DD1E338BFF7D4, 0xC774},
-	{0x3FE0000020000000, 0x3800},
-	{0x73AE0000000FFFFF, 0x7C00},
-	{0x3FE0000040000000, 0x3800},
-	{0x0024B768E016B407, 0x0000},
-	{0x3FE0000080000000, 0x3800},
-	{0x4780801FFFFFFFFF, 0x7C00},
-	{0x3FE0000100000000, 0x3800},
-	{0x7A982687DD3019D2, 0x7C00},
-	{0x3FE0000200000000, 0x3800},
-	{0xBEECE190D5D461A6, 0x80E7},
-	{0x3FE0000400000000, 0x3800},
-	{0xFFD00207FFFFFFFF, 0xFC00},
-	{0x3FE0000800000000, 0x3800},
-	{0xC66000000001F7FF, 0xFC00},
-	{0x3FE0001000000000, 0x3800},
-	{0x3EDFFFF80001FFFF, 0x0080},
-	{0x3FE0002000000000, 0x3800},
-	{0x40FFFF7FFFFFF000, 0x7C00},
-	{0x3FE0004000000000, 0x3800},
-	{0xBF6000000C000000, 0x9800},
-	{0x3FE0008000000000, 0x3800},
-	{0x37EFFFFFFFF7FFFF, 0x0000},
-	{0x3FE0010000000000, 0x3800},
-	{0x800000000001000F, 0x8000},
-	{0x3FE0020000000000, 0x3800},
-	{0xC6BC00000000007F, 0xFC00},
-	{0x3FE0040000000000, 0x3801},
-	{0xC000003FFFFFEFFF, 0xC000},
-	{0x3FE0080000000000, 0x3802},
-	{0x7FDFFFFFDFFFFFFA, 0x7C00},
-	{0x3FE0100000000000, 0x3804},
-	{0x8020000000010003, 0x8000},
-	{0x3FE0200000000000, 0x3808},
-	{0x3011B5C67690B846, 0x0000},
-	{0x3FE0400000000000, 0x3810},
-	{0xC1C10104F6DD0AD6, 0xFC00},
-	{0x3FE0800000000000, 0x3820},
-	{0x37EFFF7FFFBFFFFF, 0x0000},
-	{0x3FE1000000000000, 0x3840},
-	{0xC01EB6711AB96768, 0xC7AE},
-	{0x3FE2000000000000, 0x3880},
-	{0x1A600FFBFFFFFFFF, 0x0000},
-	{0x3FE4000000000000, 0x3900},
-	{0x38103FFFFFFFFFF7, 0x0000},
-	{0x3FE8000000000000, 0x3A00},
-	{0x41FA40CD0C060487, 0x7C00},
-	{0x3FEC000000000000, 0x3B00},
-	{0x16C000800003FFFF, 0x0000},
-	{0x3FEE000000000000, 0x3B80},
-	{0xC0279CF4D3853007, 0xC9E7},
-	{0x3FEF000000000000, 0x3BC0},
-	{0x41400020000007FF, 0x7C00},
-	{0x3FEF800000000000, 0x3BE0},
-	{0x4031FFFBFFFFFFFF, 0x4C80},
-	{0x3FEFC00000000000, 0x3BF0},
-	{0x40EFFFFFFFBDFFFE, 0x7C00},
-	{0x3FEFE00000000000, 0x3BF8},
-	{0xC0680007FFFFFFFE, 0xDA00},
-	{0x3FEFF00000000000, 0x3BFC},
-	{0xC8CFFF8002000000, 0xFC00},
-	{0x3FEFF80000000000, 0x3BFE},
-	{0xBCAE01FFFFFFFFFF, 0x8000},
-	{0x3FEFFC0000000000, 0x3BFF},
-	{0x49EFFFFF3FFFFFFF, 0x7C00},
-	{0x3FEFFE0000000000, 0x3C00},
-	{0xBFCFFFFFFFF00400, 0xB400},
-	{0x3FEFFF0000000000, 0x3C00},
-	{0x3FE001001FFFFFFE, 0x3800},
-	{0x3FEFFF8000000000, 0x3C00},
-	{0xBFE003BFFFFFFFFF, 0xB801},
-	{0x3FEFFFC000000000, 0x3C00},
-	{0x3CAFFFBFFFFFF000, 0x0000},
-	{0x3FEFFFE000000000, 0x3C00},
-	{0x41CD836A29B2F5F4, 0x7C00},
-	{0x3FEFFFF000000000, 0x3C00},
-	{0x001A241AF46AD4D3, 0x0000},
-	{0x3FEFFFF800000000, 0x3C00},
-	{0xB888CBDEB544A1E5, 0x8000},
-	{0x3FEFFFFC00000000, 0x3C00},
-	{0x3FE023BFF231DC1D, 0x3809},
-	{0x3FEFFFFE00000000, 0x3C00},
-	{0x43D0000000001004, 0x7C00},
-	{0x3FEFFFFF00000000, 0x3C00},
-	{0x47EFFEF7FFFFFFFF, 0x7C00},
-	{0x3FEFFFFF80000000, 0x3C00},
-	{0x7FFFDFFFFF7FFFFE, 0x7FF7},
-	{0x3FEFFFFFC0000000, 0x3C00},
-	{0x20582833E9B0B4DF, 0x0000},
-	{0x3FEFFFFFE0000000, 0x3C00},
-	{0x3D9FFFFFFFEF7FFF, 0x0000},
-	{0x3FEFFFFFF0000000, 0x3C00},
-	{0x41CF000000003FFF, 0x7C00},
-	{0x3FEFFFFFF8000000, 0x3C00},
-	{0x43F0CEE0F8A046FD, 0x7C00},
-	{0x3FEFFFFFFC000000, 0x3C00},
-	{0x26820609302BD300, 0x0000},
-	{0x3FEFFFFFFE000000, 0x3C00},
-	{0xC5E1D1C782D03272, 0xFC00},
-	{0x3FEFFFFFFF000000, 0x3C00},
-	{0xC1E00400001FFFFF, 0xFC00},
-	{0x3FEFFFFFFF800000, 0x3C00},
-	{0x3CAA54D33E037328, 0x0000},
-	{0x3FEFFFFFFFC00000, 0x3C00},
-	{0x7FD8E01D7A64455A, 0x7C00},
-	{0x3FEFFFFFFFE00000, 0x3C00},
-	{0x29FD28BBA9B426A4, 0x0000},
-	{0x3FEFFFFFFFF00000, 0x3C00},
-	{0x3800010000000800, 0x0000},
-	{0x3FEFFFFFFFF80000, 0x3C00},
-	{0x39BD06A5708E9091, 0x0000},
-	{0x3FEFFFFFFFFC0000, 0x3C00},
-	{0xBE67FFFFFF7FFFFF, 0x8001},
-	{0x3FEFFFFFFFFE0000, 0x3C00},
-	{0xBFEFEFFFFFFFFBFF, 0xBBFC},
-	{0x3FEFFFFFFFFF0000, 0x3C00},
-	{0x470B7C110D7AD25F, 0x7C00},
-	{0x3FEFFFFFFFFF8000, 0x3C00},
-	{0xC03FFFFF800FFFFF, 0xD000},
-	{0x3FEFFFFFFFFFC000, 0x3C00},
-	{0x5190000007FFFDFF, 0x7C00},
-	{0x3FEFFFFFFFFFE000, 0x3C00},
-	{0xBF8386E006A333DD, 0xA0E2},
-	{0x3FEFFFFFFFFFF000, 0x3C00},
-	{0x3EB7FBCDB36775F9, 0x0018},
-	{0x3FEFFFFFFFFFF800, 0x3C00},
-	{0xC0101000FFFFFFFF, 0xC404},
-	{0x3FEFFFFFFFFFFC00, 0x3C00},
-	{0x002FFF07FFFFFFFF, 0x0000},
-	{0x3FEFFFFFFFFFFE00, 0x3C00},
-	{0xB9D00403FFFFFFFF, 0x8000},
-	{0x3FEFFFFFFFFFFF00, 0x3C00},
-	{0xD680008000000800, 0xFC00},
-	{0x3FEFFFFFFFFFFF80, 0x3C00},
-	{0x480FFBFFFFFFFFFF, 0x7C00},
-	{0x3FEFFFFFFFFFFFC0, 0x3C00},
-	{0x3FBFFFFFFFE007FE, 0x3000},
-	{0x3FEFFFFFFFFFFFE0, 0x3C00},
-	{0xBFB000200000001E, 0xAC00},
-	{0x3FEFFFFFFFFFFFF0, 0x3C00},
-	{0xB81EFFFFF8000000, 0x8000},
-	{0x3FEFFFFFFFFFFFF8, 0x3C00},
-	{0x3FAFC000000001FE, 0x2BF0},
-	{0x3FEFFFFFFFFFFFFC, 0x3C00},
-	{0xC000000800000007, 0xC000},
-	{0x3FEFFFFFFFFFFFFE, 0x3C00},
-	{0x43D79D9C540A51B9, 0x7C00},
-	{0x3FEFFFFFFFFFFFFF, 0x3C00},
-	{0xAF3000FBFFFFFFFE, 0x8000},
-	{0x3FEFFFFFFFFFFFFD, 0x3C00},
-	{0x00100100000FFFFF, 0x0000},
-	{0x3FEFFFFFFFFFFFFB, 0x3C00},
-	{0xC00FFFFFFFC00007, 0xC400},
-	{0x3FEFFFFFFFFFFFF7, 0x3C00},
-	{0xFF700F8000000000, 0xFC00},
-	{0x3FEFFFFFFFFFFFEF, 0x3C00},
-	{0x43D000800000FFFF, 0x7C00},
-	{0x3FEFFFFFFFFFFFDF, 0x3C00},
-	{0x416FFFFFFFFFFFF0, 0x7C00},
-	{0x3FEFFFFFFFFFFFBF, 0x3C00},
-	{0x480FFFFFFC000080, 0x7C00},
-	{0x3FEFFFFFFFFFFF7F, 0x3C00},
-	{0xBFF0008000000000, 0xBC00},
-	{0x3FEFFFFFFFFFFEFF, 0x3C00},
-	{0x41F00000000001BE, 0x7C00},
-	{0x3FEFFFFFFFFFFDFF, 0x3C00},
-	{0x3FDFFFBFFFF7FFFF, 0x3800},
-	{0x3FEFFFFFFFFFFBFF, 0x3C00},
-	{0xBFBFDFFFFFFEFFFE, 0xAFF8},
-	{0x3FEFFFFFFFFFF7FF, 0x3C00},
-	{0x42500000007FFFF0, 0x7C00},
-	{0x3FEFFFFFFFFFEFFF, 0x3C00},
-	{0xC340000000001F80, 0xFC00},
-	{0x3FEFFFFFFFFFDFFF, 0x3C00},
-	{0x41ECAB40F26D54F0, 0x7C00},
-	{0x3FEFFFFFFFFFBFFF, 0x3C00},
-	{0xCDF0000400003FFF, 0xFC00},
-	{0x3FEFFFFFFFFF7FFF, 0x3C00},
-	{0xBFBFFFFFFFFFFE0E, 0xB000},
-	{0x3FEFFFFFFFFEFFFF, 0x3C00},
-	{0xC1F0000000402000, 0xFC00},
-	{0x3FEFFFFFFFFDFFFF, 0x3C00},
-	{0x3FF3E95FCB799299, 0x3CFA},
-	{0x3FEFFFFFFFFBFFFF, 0x3C00},
-	{0x3FE000000001FFFF, 0x3800},
-	{0x3FEFFFFFFFF7FFFF, 0x3C00},
-	{0xC03FFFFBFDFFFFFF, 0xD000},
-	{0x3FEFFFFFFFEFFFFF, 0x3C00},
-	{0xC0980237F21CD5C0, 0xE601},
-	{0x3FEFFFFFFFDFFFFF, 0x3C00},
-	{0x7A3FFBFFFFFFFBFF, 0x7C00},
-	{0x3FEFFFFFFFBFFFFF, 0x3C00},
-	{0x402FFF7FFF7FFFFF, 0x4C00},
-	{0x3FEFFFFFFF7FFFFF, 0x3C00},
-	{0x41E748403021DC90, 0x7C00},
-	{0x3FEFFFFFFEFFFFFF, 0x3C00},
-	{0x0012000000000002, 0x0000},
-	{0x3FEFFFFFFDFFFFFF, 0x3C00},
-	{0xBAD6DFCE3F56EA15, 0x8000},
-	{0x3FEFFFFFFBFFFFFF, 0x3C00},
-	{0x47E0040000003FFE, 0x7C00},
-	{0x3FEFFFFFF7FFFFFF, 0x3C00},
-	{0xB7FFFFFF80000000, 0x8000},
-	{0x3FEFFFFFEFFFFFFF, 0x3C00},
-	{0xB88EDD013AC66AF6, 0x8000},
-	{0x3FEFFFFFDFFFFFFF, 0x3C00},
-	{0x381FFFFFFFFFDFFB, 0x0000},
-	{0x3FEFFFFFBFFFFFFF, 0x3C00},
-	{0xBFDEFFFEFFFFFFFF, 0xB7C0},
-	{0x3FEFFFFF7FFFFFFF, 0x3C00},
-	{0x401DA786CDC640B8, 0x476A},
-	{0x3FEFFFFEFFFFFFFF, 0x3C00},
-	{0x3FE643A514EC67E4, 0x3991},
-	{0x3FEFFFFDFFFFFFFF, 0x3C00},
-	{0xBF0B675761FB03BA, 0x836D},
-	{0x3FEFFFFBFFFFFFFF, 0x3C00},
-	{0xDC8FBFFBFFFFFFFF, 0xFC00},
-	{0x3FEFFFF7FFFFFFFF, 0x3C00},
-	{0xB0A0010001FFFFFE, 0x8000},
-	{0x3FEFFFEFFFFFFFFF, 0x3C00},
-	{0x7FDC7AEBCD1AE490, 0x7C00},
-	{0x3FEFFFDFFFFFFFFF, 0x3C00},
-	{0xC790000007FFFFFF, 0xFC00},
-	{0x3FEFFFBFFFFFFFFF, 0x3C00},
-	{0x800100000007FFFF, 0x8000},
-	{0x3FEFFF7FFFFFFFFF, 0x3C00},
-	{0xBF000000FFFBFFFF, 0x8200},
-	{0x3FEFFEFFFFFFFFFF, 0x3C00},
-	{0xBFDFFCFFFFFFFFFF, 0xB7FF},
-	{0x3FEFFDFFFFFFFFFF, 0x3BFF},
-	{0x3B4FF803FFFFFFFF, 0x0000},
-	{0x3FEFFBFFFFFFFFFF, 0x3BFF},
-	{0x3FE000007F800000, 0x3800},
-	{0x3FEFF7FFFFFFFFFF, 0x3BFE},
-	{0x4AD0000000000000, 0x7C00},
-	{0x3FEFEFFFFFFFFFFF, 0x3BFC},
-	{0x594FFFF7FFFDFFFF, 0x7C00},
-	{0x3FEFDFFFFFFFFFFF, 0x3BF8},
-	{0x3FB57D8B6FA1D5A3, 0x2D5F},
-	{0x3FEFBFFFFFFFFFFF, 0x3BF0},
-	{0xBFF0000000201FFF, 0xBC00},
-	{0x3FEF7FFFFFFFFFFF, 0x3BE0},
-	{0xBEF725E8ED28BC45, 0x8172},
-	{0x3FEEFFFFFFFFFFFF, 0x3BC0},
-	{0x3E3EC00000000000, 0x0000},
-	{0x3FEDFFFFFFFFFFFF, 0x3B80},
-	{0x47F000000000403F, 0x7C00},
-	{0x3FEBFFFFFFFFFFFF, 0x3B00},
-	{0x7242E7F25D385273, 0x7C00},
-	{0x3FE7FFFFFFFFFFFF, 0x3A00},
-	{0x43FFFFFFF007FFFF, 0x7C00},
-	{0x3FE3FFFFFFFFFFFF, 0x3900},
-	{0xB53BFFFFFFE00000, 0x8000},
-	{0x3FE1FFFFFFFFFFFF, 0x3880},
-	{0x3FB0013FFFFFFFFE, 0x2C00},
-	{0x3FE0FFFFFFFFFFFF, 0x3840},
-	{0x43FFFFBFFFEFFFFF, 0x7C00},
-	{0x3FE07FFFFFFFFFFF, 0x3820},
-	{0x33900000400000FF, 0x0000},
-	{0x3FE03FFFFFFFFFFF, 0x3810},
-	{0xBFF00FFFEFFFFFFF, 0xBC04},
-	{0x3FE01FFFFFFFFFFF, 0x3808},
-	{0xC1F3FFFDFFFFFFFE, 0xFC00},
-	{0x3FE00FFFFFFFFFFF, 0x3804},
-	{0x43CFFFFFFFC03FFF, 0x7C00},
-	{0x3FE007FFFFFFFFFF, 0x3802},
-	{0xC310000000020000, 0xFC00},
-	{0x3FE003FFFFFFFFFF, 0x3801},
-	{0x41E0000000087FFF, 0x7C00},
-	{0x3FE001FFFFFFFFFF, 0x3800},
-	{0x43F00FFFFDFFFFFF, 0x7C00},
-	{0x3FE000FFFFFFFFFF, 0x3800},
-	{0x40101FFFFFFFFFFF, 0x4408},
-	{0x3FE0007FFFFFFFFF, 0x3800},
-	{0x404E937711474453, 0x53A5},
-	{0x3FE0003FFFFFFFFF, 0x3800},
-	{0x3D8FFF7FFF800000, 0x0000},
-	{0x3FE0001FFFFFFFFF, 0x3800},
-	{0x43DC70481EC85CD7, 0x7C00},
-	{0x3FE0000FFFFFFFFF, 0x3800},
-	{0x3FDFFFFF7FFFFDFF, 0x3800},
-	{0x3FE00007FFFFFFFF, 0x3800},
-	{0x43DFFFFFBFFFFFFB, 0x7C00},
-	{0x3FE00003FFFFFFFF, 0x3800},
-	{0x3F7D61500F4E35A4, 0x1F58},
-	{0x3FE00001FFFFFFFF, 0x3800},
-	{0xC1C400007FFFFFFF, 0xFC00},
-	{0x3FE00000FFFFFFFF, 0x3800},
-	{0x400000000007F000, 0x4000},
-	{0x3FE000007FFFFFFF, 0x3800},
-	{0x43E00100007FFFFF, 0x7C00},
-	{0x3FE000003FFFFFFF, 0x3800},
-	{0x480FCBBDBF5A6662, 0x7C00},
-	{0x3FE000001FFFFFFF, 0x3800},
-	{0xBE59F4DE5829149D, 0x8000},
-	{0x3FE000000FFFFFFF, 0x3800},
-	{0xC010000000FFFF7F, 0xC400},
-	{0x3FE0000007FFFFFF, 0x3800},
-	{0x4736B83F334FF85A, 0x7C00},
-	{0x3FE0000003FFFFFF, 0x3800},
-	{0x40EFE000003FFFFF, 0x7BF8},
-	{0x3FE0000001FFFFFF, 0x3800},
-	{0x4020800007FFFFFF, 0x4820},
-	{0x3FE0000000FFFFFF, 0x3800},
-	{0x40003DB11F89DDF0, 0x400F},
-	{0x3FE00000007FFFFF, 0x3800},
-	{0xBC581D736A278E18, 0x8000},
-	{0x3FE00000003FFFFF, 0x3800},
-	{0x3FF8DA286F96F801, 0x3E37},
-	{0x3FE00000001FFFFF, 0x3800},
-	{0x3550000000001FDF, 0x0000},
-	{0x3FE00000000FFFFF, 0x3800},
-	{0x41C0000007FFFDFF, 0x7C00},
-	{0x3FE000000007FFFF, 0x3800},
-	{0xC030010000FFFFFF, 0xCC00},
-	{0x3FE000000003FFFF, 0x3800},
-	{0x3F900007FFFFDFFF, 0x2400},
-	{0x3FE000000001FFFF, 0x3800},
-	{0x4011FFBFFFFFFFFE, 0x4480},
-	{0x3FE000000000FFFF, 0x3800},
-	{0xC3CC556AFD56DAAC, 0xFC00},
-	{0x3FE0000000007FFF, 0x3800},
-	{0xC0220BC23EA42583, 0xC883},
-	{0x3FE0000000003FFF, 0x3800},
-	{0x41D00000001FFFFB, 0x7C00},
-	{0x3FE0000000001FFF, 0x3800},
-	{0xCF0FFFFFF7FFE000, 0xFC00},
-	{0x3FE0000000000FFF, 0x3800},
-	{0x402FFFC01FFFFFFE, 0x4C00},
-	{0x3FE00000000007FF, 0x3800},
-	{0x40628CCED652A5E4, 0x58A3},
-	{0x3FE00000000003FF, 0x3800},
-	{0x7FF0000000000000, 0x7C00},
-	{0x3FE00000000001FF, 0x3800},
-	{0x3814BB5E1E08D730, 0x0000},
-	{0x3FE00000000000FF, 0x3800},
-	{0xC01E03FFFFFFFFFF, 0xC781},
-	{0x3FE000000000007F, 0x3800},
-	{0x3AFFF00000000000, 0x0000},
-	{0x3FE000000000003F, 0x3800},
-	{0xC7FFFFFFFFFFF7FF, 0xFC00},
-	{0x3FE000000000001F, 0x3800},
-	{0xB81000FFFFFFFFFF, 0x8000},
-	{0x3FE000000000000F, 0x3800},
-	{0x40B000000FFFEFFE, 0x6C00},
-	{0x3FE0000000000007, 0x3800},
-	{0x3FD247E97E7097B0, 0x3492},
-	{0x3FE0000000000003, 0x3800},
-	{0x4DFFFF7FFFFBFFFF, 0x7C00},
-	{0x3FF0000000000000, 0x3C00},
-	{0xB817FFFFDFFFFFFF, 0x8000},
-	{0x3FF0000000000001, 0x3C00},
-	{0x43F9A2E28955C7E2, 0x7C00},
-	{0x3FF0000000000002, 0x3C00},
-	{0xC2200000001000FF, 0xFC00},
-	{0x3FF0000000000004, 0x3C00},
-	{0x3FFFF000000001FF, 0x3FFC},
-	{0x3FF0000000000008, 0x3C00},
-	{0x3FB000000000003D, 0x2C00},
-	{0x3FF0000000000010, 0x3C00},
-	{0xBCA1FFFFFFFFFDFE, 0x8000},
-	{0x3FF0000000000020, 0x3C00},
-	{0x41AFFFDFFF000000, 0x7C00},
-	{0x3FF0000000000040, 0x3C00},
-	{0x37EC8AC9AE0F6F65, 0x0000},
-	{0x3FF0000000000080, 0x3C00},
-	{0xC02E582E8917C4E4, 0xCB96},
-	{0x3FF0000000000100, 0x3C00},
-	{0x3817824B079E1749, 0x0000},
-	{0x3FF0000000000200, 0x3C00},
-	{0xBFBF800000000000, 0xAFE0},
-	{0x3FF0000000000400, 0x3C00},
-	{0xC3F0003FFFFFDFFF, 0xFC00},
-	{0x3FF0000000000800, 0x3C00},
-	{0xB8E0000004FFFFFE, 0x8000},
-	{0x3FF0000000001000, 0x3C00},
-	{0xB43FFFFFFFFFFFFF, 0x8000},
-	{0x3FF0000000002000, 0x3C00},
-	{0x4800A631DE2AAA4B, 0x7C00},
-	{0x3FF0000000004000, 0x3C00},
-	{0x406100000000001F, 0x5840},
-	{0x3FF0000000008000, 0x3C00},
-	{0xC3000000000FFFE0, 0xFC00},
-	{0x3FF0000000010000, 0x3C00},
-	{0xBF0FFFFFFC1FFFFF, 0x8400},
-	{0x3FF0000000020000, 0x3C00},
-	{0x400FE000000007FF, 0x43F8},
-	{0x3FF0000000040000, 0x3C00},
-	{0x0C40400000020000, 0x0000},
-	{0x3FF0000000080000, 0x3C00},
-	{0x40103C0000000000, 0x440F},
-	{0x3FF0000000100000, 0x3C00},
-	{0xBFD000FFFFEFFFFF, 0xB400},
-	{0x3FF0000000200000, 0x3C00},
-	{0x403FFFFFEFF00000, 0x5000},
-	{0x3FF0000000400000, 0x3C00},
-	{0xFFEA5FFAF30C7B24, 0xFC00},
-	{0x3FF0000000800000, 0x3C00},
-	{0xC03FFFFFBFFF7FFF, 0xD000},
-	{0x3FF0000001000000, 0x3C00},
-	{0x381000000003EFFF, 0x0000},
-	{0x3FF0000002000000, 0x3C00},
-	{0x7FEE8C805CE03C89, 0x7C00},
-	{0x3FF0000004000000, 0x3C00},
-	{0xC009C30F911C078D, 0xC271},
-	{0x3FF0000008000000, 0x3C00},
-	{0x43E3024E411D5EC1, 0x7C00},
-	{0x3FF0000010000000, 0x3C00},
-	{0x3FCEA1F6392B0941, 0x33A8},
-	{0x3FF0000020000000, 0x3C00},
-	{0x802D16E2E6035F5D, 0x8000},
-	{0x3FF0000040000000, 0x3C00},
-	{0xD59C000000000010, 0xFC00},
-	{0x3FF0000080000000, 0x3C00},
-	{0xBF95AE4388E8DE34, 0xA56C},
-	{0x3FF0000100000000, 0x3C00},
-	{0xA844D602FB87F67C, 0x8000},
-	{0x3FF0000200000000, 0x3C00},
-	{0xC1CE001FFFFFFFFE, 0xFC00},
-	{0x3FF0000400000000, 0x3C00},
-	{0xC012000000001FFE, 0xC480},
-	{0x3FF0000800000000, 0x3C00},
-	{0x40101000001FFFFE, 0x4404},
-	{0x3FF0001000000000, 0x3C00},
-	{0xFEE10F897DDA14C7, 0xFC00},
-	{0x3FF0002000000000, 0x3C00},
-	{0x40D66930ECDF87F4, 0x759A},
-	{0x3FF0004000000000, 0x3C00},
-	{0x41D003F7FFFFFFFF, 0x7C00},
-	{0x3FF0008000000000, 0x3C00},
-	{0xC3D01FFFFFF80000, 0xFC00},
-	{0x3FF0010000000000, 0x3C00},
-	{0x800FFC000FFFFFFF, 0x8000},
-	{0x3FF0020000000000, 0x3C00},
-	{0x52F5F8916AE8B820, 0x7C00},
-	{0x3FF0040000000000, 0x3C01},
-	{0x40163E4D6C6D4424, 0x4590},
-	{0x3FF0080000000000, 0x3C02},
-	{0xC73F959FB4A812D3, 0xFC00},
-	{0x3FF0100000000000, 0x3C04},
-	{0xC3D46A7C89E39C13, 0xFC00},
-	{0x3FF0200000000000, 0x3C08},
-	{0xC3100007FFF80000, 0xFC00},
-	{0x3FF0400000000000, 0x3C10},
-	{0xC03007FFFFFFFFF0, 0xCC02},
-	{0x3FF0800000000000, 0x3C20},
-	{0x7FE0024000000000, 0x7C00},
-	{0x3FF1000000000000, 0x3C40},
-	{0x42E007FFFFFFFFEE, 0x7C00},
-	{0x3FF2000000000000, 0x3C80},
-	{0xB7FFFFFDFFFFFBFE, 0x8000},
-	{0x3FF4000000000000, 0x3D00},
-	{0x3F889F9F6BF90042, 0x2228},
-	{0x3FF8000000000000, 0x3E00},
-	{0x002001EFFFFFFFFE, 0x0000},
-	{0x3FFC000000000000, 0x3F00},
-	{0x4010A9C7D659C679, 0x442A},
-	{0x3FFE000000000000, 0x3F80},
-	{0x37FFFFFFBBFFFFFE, 0x0000},
-	{0x3FFF000000000000, 0x3FC0},
-	{0x43D000000FFFFFEE, 0x7C00},
-	{0x3FFF800000000000, 0x3FE0},
-	{0x0011EFDAC605B9FC, 0x0000},
-	{0x3FFFC00000000000, 0x3FF0},
-	{0x41E0001C00000000, 0x7C00},
-	{0x3FFFE00000000000, 0x3FF8},
-	{0xC3496593F472DC41, 0xFC00},
-	{0x3FFFF00000000000, 0x3FFC},
-	{0x4020000000400000, 0x4800},
-	{0x3FFFF80000000000, 0x3FFE},
-	{0x4025D5797BCC9E21, 0x4975},
-	{0x3FFFFC0000000000, 0x3FFF},
-	{0xB3CFBFFFFFFFF7FE, 0x8000},
-	{0x3FFFFE0000000000, 0x4000},
-	{0x3A882E60A464B628, 0x0000},
-	{0x3FFFFF0000000000, 0x4000},
-	{0xC013659F16BBCBBF, 0xC4D9},
-	{0x3FFFFF8000000000, 0x4000},
-	{0x0BD941E8A8387C46, 0x0000},
-	{0x3FFFFFC000000000, 0x4000},
-	{0x4B828B86B105B02C, 0x7C00},
-	{0x3FFFFFE000000000, 0x4000},
-	{0x41C020000007FFFE, 0x7C00},
-	{0x3FFFFFF000000000, 0x4000},
-	{0x4100FF0000000000, 0x7C00},
-	{0x3FFFFFF800000000, 0x4000},
-	{0x2460002200000000, 0x0000},
-	{0x3FFFFFFC00000000, 0x4000},
-	{0xC00FFFFBFFFFF000, 0xC400},
-	{0x3FFFFFFE00000000, 0x4000},
-	{0xC3CFFFFFE0000FFF, 0xFC00},
-	{0x3FFFFFFF00000000, 0x4000},
-	{0xC1AFFFFFFFFFE008, 0xFC00},
-	{0x3FFFFFFF80000000, 0x4000},
-	{0x43F1FFFFFFFFDFFF, 0x7C00},
-	{0x3FFFFFFFC0000000, 0x4000},
-	{0xC3F2255893D25FD0, 0xFC00},
-	{0x3FFFFFFFE0000000, 0x4000},
-	{0xC08FFF7FFFFFFFEE, 0xE400},
-	{0x3FFFFFFFF0000000, 0x4000},
-	{0x99BFFFFEFFFDFFFE, 0x8000},
-	{0x3FFFFFFFF8000000, 0x4000},
-	{0xC02043FFFFFFFFFF, 0xC811},
-	{0x3FFFFFFFFC000000, 0x4000},
-	{0xC80FFFFFFFFF7EFF, 0xFC00},
-	{0x3FFFFFFFFE000000, 0x4000},
-	{0x47F45BD319897CF1, 0x7C00},
-	{0x3FFFFFFFFF000000, 0x4000},
-	{0x380FFFF000000040, 0x0000},
-	{0x3FFFFFFFFF800000, 0x4000},
-	{0x7FFFFF7C00000000, 0x7FFF},
-	{0x3FFFFFFFFFC00000, 0x4000},
-	{0x47FFFFDFFFEFFFFF, 0x7C00},
-	{0x3FFFFFFFFFE00000, 0x4000},
-	{0xC140043FFFFFFFFE, 0xFC00},
-	{0x3FFFFFFFFFF00000, 0x4000},
-	{0xBFCD49C1C60494AB, 0xB352},
-	{0x3FFFFFFFFFF80000, 0x4000},
-	{0x801FFFFFFFFFFAFF, 0x8000},
-	{0x3FFFFFFFFFFC0000, 0x4000},
-	{0xC3FFFFE0000007FF, 0xFC00},
-	{0x3FFFFFFFFFFE0000, 0x4000},
-	{0x00120000000007FF, 0x0000},
-	{0x3FFFFFFFFFFF0000, 0x4000},
-	{0x43C21A7537EE26E2, 0x7C00},
-	{0x3FFFFFFFFFFF8000, 0x4000},
-	{0xB7F1EEA1D9FF1552, 0x8000},
-	{0x3FFFFFFFFFFFC000, 0x4000},
-	{0x3FC0000000120000, 0x3000},
-	{0x3FFFFFFFFFFFE000, 0x4000},
-	{0x3FFACBB33E53D1D0, 0x3EB3},
-	{0x3FFFFFFFFFFFF000, 0x4000},
-	{0x403FFFFFF807FFFF, 0x5000},
-	{0x3FFFFFFFFFFFF800, 0x4000},
-	{0x41C43289FF4C998B, 0x7C00},
-	{0x3FFFFFFFFFFFFC00, 0x4000},
-	{0x3FB0000000600000, 0x2C00},
-	{0x3FFFFFFFFFFFFE00, 0x4000},
-	{0xC071FFFFFFDFFFFE, 0xDC80},
-	{0x3FFFFFFFFFFFFF00, 0x4000},
-	{0xC3DF00003FFFFFFF, 0xFC00},
-	{0x3FFFFFFFFFFFFF80, 0x4000},
-	{0x3CAFFFFE000000FF, 0x0000},
-	{0x3FFFFFFFFFFFFFC0, 0x4000},
-	{0xBFDEFFFEFFFFFFFF, 0xB7C0},
-	{0x3FFFFFFFFFFFFFE0, 0x4000},
-	{0x3FEFFFFFFFC1FFFE, 0x3C00},
-	{0x3FFFFFFFFFFFFFF0, 0x4000},
-	{0xC03EFBFFFFFFFFFF, 0xCFBF},
-	{0x3FFFFFFFFFFFFFF8, 0x4000},
-	{0xB80FFFFFF7FFFEFF, 0x8000},
-	{0x3FFFFFFFFFFFFFFC, 0x4000},
-	{0xC5B517B06BDFE612, 0xFC00},
-	{0x3FFFFFFFFFFFFFFE, 0x4000},
-	{0x3F0B2D0FFEC4661B, 0x0366},
-	{0x3FFFFFFFFFFFFFFF, 0x4000},
-	{0xC3D40000007FFFFE, 0xFC00},
-	{0x3FFFFFFFFFFFFFFD, 0x4000},
-	{0xB7F69701ED692906, 0x8000},
-	{0x3FFFFFFFFFFFFFFB, 0x4000},
-	{0xBECFFFFFFFEFFFBF, 0x8040},
-	{0x3FFFFFFFFFFFFFF7, 0x4000},
-	{0xC7E38DFED8D1D26E, 0xFC00},
-	{0x3FFFFFFFFFFFFFEF, 0x4000},
-	{0x3EFBFFFFFFFFFFFF, 0x01C0},
-	{0x3FFFFFFFFFFFFFDF, 0x4000},
-	{0xBA60000003FFFFF0, 0x8000},
-	{0x3FFFFFFFFFFFFFBF, 0x4000},
-	{0x437FFFDFFFDFFFFF, 0x7C00},
-	{0x3FFFFFFFFFFFFF7F, 0x4000},
-	{0x50300000007FFBFE, 0x7C00},
-	{0x3FFFFFFFFFFFFEFF, 0x4000},
-	{0xB3CF65FC906E4AF0, 0x8000},
-	{0x3FFFFFFFFFFFFDFF, 0x4000},
-	{0xC0AF800000000000, 0xEBE0},
-	{0x3FFFFFFFFFFFFBFF, 0x4000},
-	{0xBFBF8AA790EB055A, 0xAFE3},
-	{0x3FFFFFFFFFFFF7FF, 0x4000},
-	{0xBFFFFFFFFFFFFFF4, 0xC000},
-	{0x3FFFFFFFFFFFEFFF, 0x4000},
-	{0x41B7FFFC00000000, 0x7C00},
-	{0x3FFFFFFFFFFFDFFF, 0x4000},
-	{0x2C57AB4483BEE60B, 0x0000},
-	{0x3FFFFFFFFFFFBFFF, 0x4000},
-	{0xBFDC360BA2BCEAF4, 0xB70E},
-	{0x3FFFFFFFFFFF7FFF, 0x4000},
-	{0xC04490D516213B2E, 0xD124},
-	{0x3FFFFFFFFFFEFFFF, 0x4000},
-	{0xB814000400000000, 0x8000},
-	{0x3FFFFFFFFFFDFFFF, 0x4000},
-	{0x41EFFFFFFEFFFFFE, 0x7C00},
-	{0x3FFFFFFFFFFBFFFF, 0x4000},
-	{0x401FFFFBFFFFFFFE, 0x4800},
-	{0x3FFFFFFFFFF7FFFF, 0x4000},
-	{0x802FFFFFFFE20000, 0x8000},
-	{0x3FFFFFFFFFEFFFFF, 0x4000},
-	{0x434FFFFFFFFFFEEF, 0x7C00},
-	{0x3FFFFFFFFFDFFFFF, 0x4000},
-	{0xBFE25EDB5D6FA5AD, 0xB898},
-	{0x3FFFFFFFFFBFFFFF, 0x4000},
-	{0xC9C08000007FFFFE, 0xFC00},
-	{0x3FFFFFFFFF7FFFFF, 0x4000},
-	{0x41CFFFFC001FFFFF, 0x7C00},
-	{0x3FFFFFFFFEFFFFFF, 0x4000},
-	{0x3EA00000FEFFFFFF, 0x0008},
-	{0x3FFFFFFFFDFFFFFF, 0x4000},
-	{0x0CA000007FFFFFE0, 0x0000},
-	{0x3FFFFFFFFBFFFFFF, 0x4000},
-	{0xBE4FFFFFFFFEFFBF, 0x8000},
-	{0x3FFFFFFFF7FFFFFF, 0x4000},
-	{0x433FFFFFFF002000, 0x7C00},
-	{0x3FFFFFFFEFFFFFFF, 0x4000},
-	{0xF7F007FFFF000000, 0xFC00},
-	{0x3FFFFFFFDFFFFFFF, 0x4000},
-	{0xB2C8FD503EC1058D, 0x8000},
-	{0x3FFFFFFFBFFFFFFF, 0x4000},
-	{0xBA4FFFF7EFFFFFFF, 0x8000},
-	{0x3FFFFFFF7FFFFFFF, 0x4000},
-	{0x380FFFFFFE007FFE, 0x0000},
-	{0x3FFFFFFEFFFFFFFF, 0x4000},
-	{0x4039ACBC30F90A41, 0x4E6B},
-	{0x3FFFFFFDFFFFFFFF, 0x4000},
-	{0x3F0FF80000003FFF, 0x03FF},
-	{0x3FFFFFFBFFFFFFFF, 0x4000},
-	{0x37F86F5514806916, 0x0000},
-	{0x3FFFFFF7FFFFFFFF, 0x4000},
-	{0xC0000000000FFFFE, 0xC000},
-	{0x3FFFFFEFFFFFFFFF, 0x4000},
-	{0xC1C400007FFFFFFF, 0xFC00},
-	{0x3FFFFFDFFFFFFFFF, 0x4000},
-	{0xC1F4638837CEE12D, 0xFC00},
-	{0x3FFFFFBFFFFFFFFF, 0x4000},
-	{0x47ED34D66EA934F0, 0x7C00},
-	{0x3FFFFF7FFFFFFFFF, 0x4000},
-	{0xC00E082DB9F295B5, 0xC382},
-	{0x3FFFFEFFFFFFFFFF, 0x4000},
-	{0x2700000001FFFDFE, 0x0000},
-	{0x3FFFFDFFFFFFFFFF, 0x3FFF},
-	{0x380F000000003FFF, 0x0000},
-	{0x3FFFFBFFFFFFFFFF, 0x3FFF},
-	{0x9C43C7C446989128, 0x8000},
-	{0x3FFFF7FFFFFFFFFF, 0x3FFE},
-	{0x000FBFFFFFFFFFFC, 0x0000},
-	{0x3FFFEFFFFFFFFFFF, 0x3FFC},
-	{0x3FCEFFFFFDFFFFFF, 0x33C0},
-	{0x3FFFDFFFFFFFFFFF, 0x3FF8},
-	{0xBE44D8F31BCF832C, 0x8000},
-	{0x3FFFBFFFFFFFFFFF, 0x3FF0},
-	{0x718E000000080000, 0x7C00},
-	{0x3FFF7FFFFFFFFFFF, 0x3FE0},
-	{0xC6F5088DCA5D739E, 0xFC00},
-	{0x3FFEFFFFFFFFFFFF, 0x3FC0},
-	{0xC7FC000001FFFFFF, 0xFC00},
-	{0x3FFDFFFFFFFFFFFF, 0x3F80},
-	{0xC0CBFFFFFFDFFFFE, 0xF300},
-	{0x3FFBFFFFFFFFFFFF, 0x3F00},
-	{0xBD9FFFF800FFFFFE, 0x8000},
-	{0x3FF7FFFFFFFFFFFF, 0x3E00},
-	{0x31B0007FFFFEFFFF, 0x0000},
-	{0x3FF3FFFFFFFFFFFF, 0x3D00},
-	{0xC00C000000000008, 0xC300},
-	{0x3FF1FFFFFFFFFFFF, 0x3C80},
-	{0x3FDFF7FFFFFFFBFF, 0x37FE},
-	{0x3FF0FFFFFFFFFFFF, 0x3C40},
-	{0x3FF04000000003FE, 0x3C10},
-	{0x3FF07FFFFFFFFFFF, 0x3C20},
-	{0xC16007FFFFEFFFFF, 0xFC00},
-	{0x3FF03FFFFFFFFFFF, 0x3C10},
-	{0x410000FFF8000000, 0x7C00},
-	{0x3FF01FFFFFFFFFFF, 0x3C08},
-	{0xBE90000000000008, 0x8004},
-	{0x3FF00FFFFFFFFFFF, 0x3C04},
-	{0xC009A0A405F4005A, 0xC268},
-	{0x3FF007FFFFFFFFFF, 0x3C02},
-	{0xBFE000000000FFF7, 0xB800},
-	{0x3FF003FFFFFFFFFF, 0x3C01},
-	{0x0005291F76BB93CF, 0x0000},
-	{0x3FF001FFFFFFFFFF, 0x3C00},
-	{0xB580000000000FEF, 0x8000},
-	{0x3FF000FFFFFFFFFF, 0x3C00},
-	{0xB800000010000008, 0x8000},
-	{0x3FF0007FFFFFFFFF, 0x3C00},
-	{0xBFB07FFFFFF7FFFE, 0xAC20},
-	{0x3FF0003FFFFFFFFF, 0x3C00},
-	{0x419FFFFFFFC0007E, 0x7C00},
-	{0x3FF0001FFFFFFFFF, 0x3C00},
-	{0x40E000000000F7FF, 0x7800},
-	{0x3FF0000FFFFFFFFF, 0x3C00},
-	{0x480F07A310842469, 0x7C00},
-	{0x3FF00007FFFFFFFF, 0x3C00},
-	{0x7CE0000000077FFE, 0x7C00},
-	{0x3FF00003FFFFFFFF, 0x3C00},
-	{0xC3EFFEFFFFFFEFFE, 0xFC00},
-	{0x3FF00001FFFFFFFF, 0x3C00},
-	{0xBFAF450F612AF126, 0xABD1},
-	{0x3FF00000FFFFFFFF, 0x3C00},
-	{0xC01FFFFFFFFBFBFE, 0xC800},
-	{0x3FF000007FFFFFFF, 0x3C00},
-	{0xC03C000003FFFFFF, 0xCF00},
-	{0x3FF000003FFFFFFF, 0x3C00},
-	{0x3EAFFFFFF800FFFE, 0x0010},
-	{0x3FF000001FFFFFFF, 0x3C00},
-	{0x43C0001FFFFFFFE0, 0x7C00},
-	{0x3FF000000FFFFFFF, 0x3C00},
-	{0x3FCFEEFFFFFFFFFF, 0x33FC},
-	{0x3FF0000007FFFFFF, 0x3C00},
-	{0x33AFFFF0000003FF, 0x0000},
-	{0x3FF0000003FFFFFF, 0x3C00},
-	{0xC3E07B998A240546, 0xFC00},
-	{0x3FF0000001FFFFFF, 0x3C00},
-	{0xAC50100000000040, 0x8000},
-	{0x3FF0000000FFFFFF, 0x3C00},
-	{0x86F5C96ED3BEBE86, 0x8000},
-	{0x3FF00000007FFFFF, 0x3C00},
-	{0xBECF143ED192440C, 0x803E},
-	{0x3FF00000003FFFFF, 0x3C00},
-	{0x3FE3FFFFFFFFFFE0, 0x3900},
-	{0x3FF00000001FFFFF, 0x3C00},
-	{0xC03A9966F533AC4C, 0xCEA6},
-	{0x3FF00000000FFFFF, 0x3C00},
-	{0xC13265EC75DA0A92, 0xFC00},
-	{0x3FF000000007FFFF, 0x3C00},
-	{0xC0C00000000807FF, 0xF000},
-	{0x3FF000000003FFFF, 0x3C00},
-	{0xBE3083FFFFFFFFFF, 0x8000},
-	{0x3FF000000001FFFF, 0x3C00},
-	{0x41F757A098F9D5A8, 0x7C00},
-	{0x3FF000000000FFFF, 0x3C00},
-	{0x8010000007FFFFBF, 0x8000},
-	{0x3FF0000000007FFF, 0x3C00},
-	{0xBFD0000001003FFE, 0xB400},
-	{0x3FF0000000003FFF, 0x3C00},
-	{0x4340007FFFFBFFFF, 0x7C00},
-	{0x3FF0000000001FFF, 0x3C00},
-	{0x41A0FFFFFFFFFBFE, 0x7C00},
-	{0x3FF0000000000FFF, 0x3C00},
-	{0xCF900207FFFFFFFE, 0xFC00},
-	{0x3FF00000000007FF, 0x3C00},
-	{0x393000000100003F, 0x0000},
-	{0x3FF00000000003FF, 0x3C00},
-	{0x7F7FFFFFFFBFFFFE, 0x7C00},
-	{0x3FF00000000001FF, 0x3C00},
-	{0xBFDFFFFFFFBF7FFF, 0xB800},
-	{0x3FF00000000000FF, 0x3C00},
-	{0xC1FFFFE000007FFE, 0xFC00},
-	{0x3FF000000000007F, 0x3C00},
-	{0xC07FFFFFFFFFFFFF, 0xE000},
-	{0x3FF000000000003F, 0x3C00},
-	{0xC1F0000000001FFF, 0xFC00},
-	{0x3FF000000000001F, 0x3C00},
-	{0xB818FFFFFFFFFFFF, 0x8000},
-	{0x3FF000000000000F, 0x3C00},
-	{0xFFDC7FFFFFFFFFFF, 0xFC00},
-	{0x3FF0000000000007, 0x3C00},
-	{0xFFEFFFFBFFFFFFFF, 0xFC00},
-	{0x3FF0000000000003, 0x3C00},
-	{0xDB52D5D9F504B443, 0xFC00},
-	{0x4000000000000000, 0x4000},
-	{0xC00000000000001E, 0xC000},
-	{0x4000000000000001, 0x4000},
-	{0xAE7327CB986BE3CC, 0x8000},
-	{0x4000000000000002, 0x4000},
-	{0xC8D40000001FFFFF, 0xFC00},
-	{0x4000000000000004, 0x4000},
-	{0x8009A11BA55213E4, 0x8000},
-	{0x4000000000000008, 0x4000},
-	{0x43EE6DD8692FC2C0, 0x7C00},
-	{0x4000000000000010, 0x4000},
-	{0x4F9FFFFFFE080000, 0x7C00},
-	{0x4000000000000020, 0x4000},
-	{0x41E64655B46D9C79, 0x7C00},
-	{0x4000000000000040, 0x4000},
-	{0xC3F15327A2D1A8CA, 0xFC00},
-	{0x4000000000000080, 0x4000},
-	{0xFFE0CEED192E28AF, 0xFC00},
-	{0x4000000000000100, 0x4000},
-	{0xC34FFFFE000007FF, 0xFC00},
-	{0x4000000000000200, 0x4000},
-	{0x43F0000006FFFFFE, 0x7C00},
-	{0x4000000000000400, 0x4000},
-	{0x3FF0200000000100, 0x3C08},
-	{0x4000000000000800, 0x4000},
-	{0x3E74D7484AC3A5ED, 0x0001},
-	{0x4000000000001000, 0x4000},
-	{0xBFEFFFFFBFFFFF80, 0xBC00},
-	{0x4000000000002000, 0x4000},
-	{0x32FFF00001FFFFFF, 0x0000},
-	{0x4000000000004000, 0x4000},
-	{0xBF000000004007FF, 0x8200},
-	{0x4000000000008000, 0x4000},
-	{0x35A00080000003FF, 0x0000},
-	{0x4000000000010000, 0x4000},
-	{0xC01FFFFFFE7FFFFF, 0xC800},
-	{0x4000000000020000, 0x4000},
-	{0x3F7FFF44E2BE659C, 0x2000},
-	{0x4000000000040000, 0x4000},
-	{0xB7F0080000001FFF, 0x8000},
-	{0x4000000000080000, 0x4000},
-	{0x4340020000080000, 0x7C00},
-	{0x4000000000100000, 0x4000},
-	{0xBBE90161F4AE0941, 0x8000},
-	{0x4000000000200000, 0x4000},
-	{0xC03001FFFFFFFFBE, 0xCC00},
-	{0x4000000000400000, 0x4000},
-	{0xBFDFFFFFFFFFFFFD, 0xB800},
-	{0x4000000000800000, 0x4000},
-	{0xB12FE000FFFFFFFF, 0x8000},
-	{0x4000000001000000, 0x4000},
-	{0x41E0000300000000, 0x7C00},
-	{0x4000000002000000, 0x4000},
-	{0xB7EFFF8040000000, 0x8000},
-	{0x4000000004000000, 0x4000},
-	{0x3CAFDFFFFFFFFFC0, 0x0000},
-	{0x4000000008000000, 0x4000},
-	{0x3FDB67C970F8F118, 0x36DA},
-	{0x4000000010000000, 0x4000},
-	{0x3FEFFFFFFFFFFFFC, 0x3C00},
-	{0x4000000020000000, 0x4000},
-	{0xBA8E8283AF9C7CE0, 0x8000},
-	{0x4000000040000000, 0x4000},
-	{0xBFD1D822A56118E9, 0xB476},
-	{0x4000000080000000, 0x4000},
-	{0x4025EC7913C93469, 0x497B},
-	{0x4000000100000000, 0x4000},
-	{0xC800201000000000, 0xFC00},
-	{0x4000000200000000, 0x4000},
-	{0x40300000000007EE, 0x4C00},
-	{0x4000000400000000, 0x4000},
-	{0xC1DFFEBFFFFFFFFF, 0xFC00},
-	{0x4000000800000000, 0x4000},
-	{0xC3E000000000801F, 0xFC00},
-	{0x4000001000000000, 0x4000},
-	{0x3CA01FFFFFFFFFBF, 0x0000},
-	{0x4000002000000000, 0x4000},
-	{0x40000000001FEFFF, 0x4000},
-	{0x4000004000000000, 0x4000},
-	{0xEF4000000007FFFE, 0xFC00},
-	{0x4000008000000000, 0x4000},
-	{0xBFCFFFFE80000000, 0xB400},
-	{0x4000010000000000, 0x4000},
-	{0xC03007FFFFFFE000, 0xCC02},
-	{0x4000020000000000, 0x4000},
-	{0xC1FFFF3FFFFFFFFF, 0xFC00},
-	{0x4000040000000000, 0x4001},
-	{0x41F1066567CDAE4E, 0x7C00},
-	{0x4000080000000000, 0x4002},
-	{0xA85E002000000000, 0x8000},
-	{0x4000100000000000, 0x4004},
-	{0x000FFFFFFFFFF820, 0x0000},
-	{0x4000200000000000, 0x4008},
-	{0xC020400000007FFE, 0xC810},
-	{0x4000400000000000, 0x4010},
-	{0x408FFFFFFFE1FFFF, 0x6400},
-	{0x4000800000000000, 0x4020},
-	{0x4000000000000037, 0x4000},
-	{0x4001000000000000, 0x4040},
-	{0xFFD41FFFFFFFFFFE, 0xFC00},
-	{0x4002000000000000, 0x4080},
-	{0x480000003FFDFFFE, 0x7C00},
-	{0x4004000000000000, 0x4100},
-	{0x23CD87429BD6BFA6, 0x0000},
-	{0x4008000000000000, 0x4200},
-	{0xBF7825A9D7143439, 0x9E09},
-	{0x400C000000000000, 0x4300},
-	{0xC80FFC000000000F, 0xFC00},
-	{0x400E000000000000, 0x4380},
-	{0xFFD001FEFFFFFFFF, 0xFC00},
-	{0x400F000000000000, 0x43C0},
-	{0x577CCD9709B7C682, 0x7C00},
-	{0x400F800000000000, 0x43E0},
-	{0x480000000100003F, 0x7C00},
-	{0x400FC00000000000, 0x43F0},
-	{0x47357D02C5D32051, 0x7C00},
-	{0x400FE00000000000, 0x43F8},
-	{0xB7E087CD0A0B96F1, 0x8000},
-	{0x400FF00000000000, 0x43FC},
-	{0xC01FDFFFFFFFF7FF, 0xC7F8},
-	{0x400FF80000000000, 0x43FE},
-	{0x5B07039036887703, 0x7C00},
-	{0x400FFC0000000000, 0x43FF},
-	{0x43F0000007FFFFFF, 0x7C00},
-	{0x400FFE0000000000, 0x4400},
-	{0x3E58CE2EAABD1C91, 0x0000},
-	{0x400FFF0000000000, 0x4400},
-	{0x3FCE933F9B9A1FAC, 0x33A5},
-	{0x400FFF8000000000, 0x4400},
-	{0x41D100000000FFFF, 0x7C00},
-	{0x400FFFC000000000, 0x4400},
-	{0xDF80000000003FFF, 0xFC00},
-	{0x400FFFE000000000, 0x4400},
-	{0x7FF00000002FFFFF, 0x7E00},
-	{0x400FFFF000000000, 0x4400},
-	{0x41DFFF7FFFFFF7FF, 0x7C00},
-	{0x400FFFF800000000, 0x4400},
-	{0x402FFFFFC00007FF, 0x4C00},
-	{0x400FFFFC00000000, 0x4400},
-	{0xC1E03FFFFFFFFFFD, 0xFC00},
-	{0x400FFFFE00000000, 0x4400},
-	{0x47E559F166537449, 0x7C00},
-	{0x400FFFFF00000000, 0x4400},
-	{0x3E40000000000BFF, 0x0000},
-	{0x400FFFFF80000000, 0x4400},
-	{0xC3707FEFFFFFFFFF, 0xFC00},
-	{0x400FFFFFC0000000, 0x4400},
-	{0x43E0000000000DFF, 0x7C00},
-	{0x400FFFFFE0000000, 0x4400},
-	{0x00200000000100FF, 0x0000},
-	{0x400FFFFFF0000000, 0x4400},
-	{0xC3CFFFFFFF820000, 0xFC00},
-	{0x400FFFFFF8000000, 0x4400},
-	{0xBFDFFFFFEFBFFFFF, 0xB800},
-	{0x400FFFFFFC000000, 0x4400},
-	{0x7E2EFFC000000000, 0x7C00},
-	{0x400FFFFFFE000000, 0x4400},
-	{0x3FFFFFFFF8100000, 0x4000},
-	{0x400FFFFFFF000000, 0x4400},
-	{0xBC0007FFFFBFFFFF, 0x8000},
-	{0x400FFFFFFF800000, 0x4400},
-	{0x3FFFFFFFFFFF0000, 0x4000},
-	{0x400FFFFFFFC00000, 0x4400},
-	{0xB8F00000000023FF, 0x8000},
-	{0x400FFFFFFFE00000, 0x4400},
-	{0x3170000000200008, 0x0000},
-	{0x400FFFFFFFF00000, 0x4400},
-	{0x43E000007EFFFFFF, 0x7C00},
-	{0x400FFFFFFFF80000, 0x4400},
-	{0xC3E010000007FFFE, 0xFC00},
-	{0x400FFFFFFFFC0000, 0x4400},
-	{0x186FFEFFFFFFFFBF, 0x0000},
-	{0x400FFFFFFFFE0000, 0x4400},
-	{0x407FFFFFCFFFFFFE, 0x6000},
-	{0x400FFFFFFFFF0000, 0x4400},
-	{0x4010009FFFFFFFFE, 0x4400},
-	{0x400FFFFFFFFF8000, 0x4400},
-	{0x3FF0000000FFDFFF, 0x3C00},
-	{0x400FFFFFFFFFC000, 0x4400},
-	{0xC4CFFFFFEDFFFFFE, 0xFC00},
-	{0x400FFFFFFFFFE000, 0x4400},
-	{0xC1EFFFFFFFFFE001, 0xFC00},
-	{0x400FFFFFFFFFF000, 0x4400},
-	{0x3FB007FFFFE00000, 0x2C02},
-	{0x400FFFFFFFFFF800, 0x4400},
-	{0xC00000000100FFFF, 0xC000},
-	{0x400FFFFFFFFFFC00, 0x4400},
-	{0x3E1FFFFFFFFFFF78, 0x0000},
-	{0x400FFFFFFFFFFE00, 0x4400},
-	{0xC01B6EAF8FA164DD, 0xC6DC},
-	{0x400FFFFFFFFFFF00, 0x4400},
-	{0x40200407FFFFFFFE, 0x4801},
-	{0x400FFFFFFFFFFF80, 0x4400},
-	{0x8009CC4124925501, 0x8000},
-	{0x400FFFFFFFFFFFC0, 0x4400},
-	{0x0000400000003FFF, 0x0000},
-	{0x400FFFFFFFFFFFE0, 0x4400},
-	{0xC01FFFFEFFF7FFFF, 0xC800},
-	{0x400FFFFFFFFFFFF0, 0x4400},
-	{0x426FFFDFFF000000, 0x7C00},
-	{0x400FFFFFFFFFFFF8, 0x4400},
-	{0xC1DA4828DEF6FB0C, 0xFC00},
-	{0x400FFFFFFFFFFFFC, 0x4400},
-	{0x7FF00000080001FF, 0x7E00},
-	{0x400FFFFFFFFFFFFE, 0x4400},
-	{0x514FFFEFFFF7FFFF, 0x7C00},
-	{0x400FFFFFFFFFFFFF, 0x4400},
-	{0xC01D94D83B696C23, 0xC765},
-	{0x400FFFFFFFFFFFFD, 0x4400},
-	{0x400AA333BD74B949, 0x42A9},
-	{0x400FFFFFFFFFFFFB, 0x4400},
-	{0x403100000003FFFE, 0x4C40},
-	{0x400FFFFFFFFFFFF7, 0x4400},
-	{0x3FBBF685216B69A5, 0x2EFE},
-	{0x400FFFFFFFFFFFEF, 0x4400},
-	{0x381FBFFF7FFFFFFF, 0x0000},
-	{0x400FFFFFFFFFFFDF, 0x4400},
-	{0x3FF9AB953F4EBDEE, 0x3E6B},
-	{0x400FFFFFFFFFFFBF, 0x4400},
-	{0x3FEFFFFFFBFFFFFB, 0x3C00},
-	{0x400FFFFFFFFFFF7F, 0x4400},
-	{0x3FFFFFFFFFFFFC1F, 0x4000},
-	{0x400FFFFFFFFFFEFF, 0x4400},
-	{0xC13EBFFFFFFFFFFE, 0xFC00},
-	{0x400FFFFFFFFFFDFF, 0x4400},
-	{0xC06FF80800000000, 0xDBFE},
-	{0x400FFFFFFFFFFBFF, 0x4400},
-	{0x800AF4EC903C54D1, 0x8000},
-	{0x400FFFFFFFFFF7FF, 0x4400},
-	{0xB61FFFFFFFFF8004, 0x8000},
-	{0x400FFFFFFFFFEFFF, 0x4400},
-	{0xF07FFFFFFFFFDFBF, 0xFC00},
-	{0x400FFFFFFFFFDFFF, 0x4400},
-	{0xC3FFFFFFFFFFFC7F, 0xFC00},
-	{0x400FFFFFFFFFBFFF, 0x4400},
-	{0xC2CF800000100000, 0xFC00},
-	{0x400FFFFFFFFF7FFF, 0x4400},
-	{0x3FC2A70C26AE0C59, 0x30AA},
-	{0x400FFFFFFFFEFFFF, 0x4400},
-	{0xC001FFFF80000000, 0xC080},
-	{0x400FFFFFFFFDFFFF, 0x4400},
-	{0x19FFF9DB8CD33EAA, 0x0000},
-	{0x400FFFFFFFFBFFFF, 0x4400},
-	{0xC1C09FFFFFFFFFFF, 0xFC00},
-	{0x400FFFFFFFF7FFFF, 0x4400},
-	{0xB81FFFEFFFFFF7FF, 0x8000},
-	{0x400FFFFFFFEFFFFF, 0x4400},
-	{0xBFD000FFFFFFFF7F, 0xB400},
-	{0x400FFFFFFFDFFFFF, 0x4400},
-	{0xC3B3FFFFFFFFFFFC, 0xFC00},
-	{0x400FFFFFFFBFFFFF, 0x4400},
-	{0xFFEFFFDFFFFFC000, 0xFC00},
-	{0x400FFFFFFF7FFFFF, 0x4400},
-	{0xC3E0000000000BFF, 0xFC00},
-	{0x400FFFFFFEFFFFFF, 0x4400},
-	{0x48000000101FFFFE, 0x7C00},
-	{0x400FFFFFFDFFFFFF, 0x4400},
-	{0xBFC000001FFFFDFF, 0xB000},
-	{0x400FFFFFFBFFFFFF, 0x4400},
-	{0xB7E03FFFFFFFFEFF, 0x8000},
-	{0x400FFFFFF7FFFFFF, 0x4400},
-	{0x7FD003C000000000, 0x7C00},
-	{0x400FFFFFEFFFFFFF, 0x4400},
-	{0x800E1FFFFFFFFFFF, 0x8000},
-	{0x400FFFFFDFFFFFFF, 0x4400},
-	{0x3559F743EAE98644, 0x0000},
-	{0x400FFFFFBFFFFFFF, 0x4400},
-	{0xBFD4000003FFFFFE, 0xB500},
-	{0x400FFFFF7FFFFFFF, 0x4400},
-	{0xBA707FFBFFFFFFFE, 0x8000},
-	{0x400FFFFEFFFFFFFF, 0x4400},
-	{0xC9EE00000007FFFE, 0xFC00},
-	{0x400FFFFDFFFFFFFF, 0x4400},
-	{0x801EFFFFFFF00000, 0x8000},
-	{0x400FFFFBFFFFFFFF, 0x4400},
-	{0xB75F840000000000, 0x8000},
-	{0x400FFFF7FFFFFFFF, 0x4400},
-	{0xC3E08AD7E4E0A97F, 0xFC00},
-	{0x400FFFEFFFFFFFFF, 0x4400},
-	{0x802003FDFFFFFFFE, 0x8000},
-	{0x400FFFDFFFFFFFFF, 0x4400},
-	{0xBFF6B359B14B0B23, 0xBDAD},
-	{0x400FFFBFFFFFFFFF, 0x4400},
-	{0x43D0000010000020, 0x7C00},
-	{0x400FFF7FFFFFFFFF, 0x4400},
-	{0x3CA000000000023F, 0x0000},
-	{0x400FFEFFFFFFFFFF, 0x4400},
-	{0x3FB0000000000FBF, 0x2C00},
-	{0x400FFDFFFFFFFFFF, 0x43FF},
-	{0xC7F0000000000FDF, 0xFC00},
-	{0x400FFBFFFFFFFFFF, 0x43FF},
-	{0x800F000100000000, 0x8000},
-	{0x400FF7FFFFFFFFFF, 0x43FE},
-	{0xC0A007FFFFFFF7FE, 0xE802},
-	{0x400FEFFFFFFFFFFF, 0x43FC},
-	{0x0EFA7C89A3C08A64, 0x0000},
-	{0x400FDFFFFFFFFFFF, 0x43F8},
-	{0xC040FFFFFE000000, 0xD040},
-	{0x400FBFFFFFFFFFFF, 0x43F0},
-	{0x41C14DCFA0AD9A83, 0x7C00},
-	{0x400F7FFFFFFFFFFF, 0x43E0},
-	{0xBFFFFFFFF8000003, 0xC000},
-	{0x400EFFFFFFFFFFFF, 0x43C0},
-	{0x42D0000001004000, 0x7C00},
-	{0x400DFFFFFFFFFFFF, 0x4380},
-	{0x3FD000007FFFBFFE, 0x3400},
-	{0x400BFFFFFFFFFFFF, 0x4300},
-	{0x4265E57E3E447686, 0x7C00},
-	{0x4007FFFFFFFFFFFF, 0x4200},
-	{0x41E000000000040F, 0x7C00},
-	{0x4003FFFFFFFFFFFF, 0x4100},
-	{0x37EFFFFE003FFFFF, 0x0000},
-	{0x4001FFFFFFFFFFFF, 0x4080},
-	{0xC1C0000000047FFF, 0xFC00},
-	{0x4000FFFFFFFFFFFF, 0x4040},
-	{0xCFB0FFFFFFFFFFE0, 0xFC00},
-	{0x40007FFFFFFFFFFF, 0x4020},
-	{0xC009FFFFFFFFFFFF, 0xC280},
-	{0x40003FFFFFFFFFFF, 0x4010},
-	{0xC08FFC000001FFFF, 0xE3FF},
-	{0x40001FFFFFFFFFFF, 0x4008},
-	{0x3B42000001000000, 0x0000},
-	{0x40000FFFFFFFFFFF, 0x4004},
-	{0xC0A5F088EFEB5856, 0xE97C},
-	{0x400007FFFFFFFFFF, 0x4002},
-	{0xB20FFFFFFFFFDEFF, 0x8000},
-	{0x400003FFFFFFFFFF, 0x4001},
-	{0xB5B000000800003E, 0x8000},
-	{0x400001FFFFFFFFFF, 0x4000},
-	{0x7FE00000000000FD, 0x7C00},
-	{0x400000FFFFFFFFFF, 0x4000},
-	{0xBFFA71F0C60DB851, 0xBE9C},
-	{0x4000007FFFFFFFFF, 0x4000},
-	{0xC00EFFFFFFFFFDFF, 0xC3C0},
-	{0x4000003FFFFFFFFF, 0x4000},
-	{0x3DB0801FFFFFFFFF, 0x0000},
-	{0x4000001FFFFFFFFF, 0x4000},
-	{0xA01000000FEFFFFF, 0x8000},
-	{0x4000000FFFFFFFFF, 0x4000},
-	{0x7FEFFFFE00FFFFFF, 0x7C00},
-	{0x40000007FFFFFFFF, 0x4000},
-	{0xC1F03FFFFFF7FFFF, 0xFC00},
-	{0x40000003FFFFFFFF, 0x4000},
-	{0xBFE00000008001FF, 0xB800},
-	{0x40000001FFFFFFFF, 0x4000},
-	{0x3FABE00000000000, 0x2AF8},
-	{0x40000000FFFFFFFF, 0x4000},
-	{0x403BBC30AAC89A61, 0x4EEF},
-	{0x400000007FFFFFFF, 0x4000},
-	{0x7FDFFFFFFFC00002, 0x7C00},
-	{0x400000003FFFFFFF, 0x4000},
-	{0x001BC1FBCEA65E57, 0x0000},
-	{0x400000001FFFFFFF, 0x4000},
-	{0xB8000000000FE000, 0x8000},
-	{0x400000000FFFFFFF, 0x4000},
-	{0xBEE000000800FFFE, 0x8080},
-	{0x4000000007FFFFFF, 0x4000},
-	{0xBFE3FFF7FFFFFFFF, 0xB900},
-	{0x4000000003FFFFFF, 0x4000},
-	{0x3FD000000007FFBF, 0x3400},
-	{0x4000000001FFFFFF, 0x4000},
-	{0x3F40000080000004, 0x1000},
-	{0x4000000000FFFFFF, 0x4000},
-	{0x402FFFFFFFF8001F, 0x4C00},
-	{0x40000000007FFFFF, 0x4000},
-	{0xBFC0000070000000, 0xB000},
-	{0x40000000003FFFFF, 0x4000},
-	{0xC3D01FFFFFFFF7FF, 0xFC00},
-	{0x40000000001FFFFF, 0x4000},
-	{0xBFD003FFFDFFFFFE, 0xB401},
-	{0x40000000000FFFFF, 0x4000},
-	{0x81D0000007FFFFF6, 0x8000},
-	{0x400000000007FFFF, 0x4000},
-	{0xC7E00002000FFFFF, 0xFC00},
-	{0x400000000003FFFF, 0x4000},
-	{0xC1D22949206E1226, 0xFC00},
-	{0x400000000001FFFF, 0x4000},
-	{0xC1EFFDFBFFFFFFFF, 0xFC00},
-	{0x400000000000FFFF, 0x4000},
-	{0x7FE0020000000003, 0x7C00},
-	{0x4000000000007FFF, 0x4000},
-	{0x37EFC000000003FF, 0x0000},
-	{0x4000000000003FFF, 0x4000},
-	{0xC02FFFFFFF000FFF, 0xCC00},
-	{0x4000000000001FFF, 0x4000},
-	{0x358B9A0DC7A5D7B6, 0x0000},
-	{0x4000000000000FFF, 0x4000},
-	{0x411FFFFE0000FFFF, 0x7C00},
-	{0x40000000000007FF, 0x4000},
-	{0xBBB000004FFFFFFE, 0x8000},
-	{0x40000000000003FF, 0x4000},
-	{0x4111FFFFFFFF8000, 0x7C00},
-	{0x40000000000001FF, 0x4000},
-	{0x49EFF7FFEFFFFFFF, 0x7C00},
-	{0x40000000000000FF, 0x4000},
-	{0x41EFFFFFFF000040, 0x7C00},
-	{0x400000000000007F, 0x4000},
-	{0xBCB5268AE55A2DDD, 0x8000},
-	{0x400000000000003F, 0x4000},
-	{0x380003FFFFFFFEFF, 0x0000},
-	{0x400000000000001F, 0x4000},
-	{0x538001FFFFF7FFFF, 0x7C00},
-	{0x400000000000000F, 0x4000},
-	{0xBFC0000FFFC00000, 0xB000},
-	{0x4000000000000007, 0x4000},
-	{0x47E00FFFFFFFFFE0, 0x7C00},
-	{0x4000000000000003, 0x4000},
-	{0x801000000000040F, 0x8000},
-	{0x4010000000000000, 0x4400},
-	{0xE59E18C15CF93BEA, 0xFC00},
-	{0x4010000000000001, 0x4400},
-	{0x41D0000000000000, 0x7C00},
-	{0x4010000000000002, 0x4400},
-	{0x3B1000000000023E, 0x0000},
-	{0x4010000000000004, 0x4400},
-	{0x4340000000000FFA, 0x7C00},
-	{0x4010000000000008, 0x4400},
-	{0x3FA0000200000FFE, 0x2800},
-	{0x4010000000000010, 0x4400},
-	{0x47F0001BFFFFFFFE, 0x7C00},
-	{0x4010000000000020, 0x4400},
-	{0xFFDFFFFFFFFFF0FF, 0xFC00},
-	{0x4010000000000040, 0x4400},
-	{0x3801004000000000, 0x0000},
-	{0x4010000000000080, 0x4400},
-	{0xC03FFFFFFEF80000, 0xD000},
-	{0x4010000000000100, 0x4400},
-	{0x40500100FFFFFFFF, 0x5400},
-	{0x4010000000000200, 0x4400},
-	{0x9C90000100000010, 0x8000},
-	{0x4010000000000400, 0x4400},
-	{0x3F5FFFEFFFFFFFBE, 0x1800},
-	{0x4010000000000800, 0x4400},
-	{0xC1C1000000007FFF, 0xFC00},
-	{0x4010000000001000, 0x4400},
-	{0xB7E1FFFFFFFF8000, 0x8000},
-	{0x4010000000002000, 0x4400},
-	{0xDC1FFFFF7FFFFFFB, 0xFC00},
-	{0x4010000000004000, 0x4400},
-	{0xC3FF0000001FFFFF, 0xFC00},
-	{0x4010000000008000, 0x4400},
-	{0xAA5FFDFFFFFFFBFF, 0x8000},
-	{0x4010000000010000, 0x4400},
-	{0xADD27FFFFFFFFFFF, 0x8000},
-	{0x4010000000020000, 0x4400},
-	{0xC0A000000000007F, 0xE800},
-	{0x4010000000040000, 0x4400},
-	{0xC006557B4B69E2D0, 0xC195},
-	{0x4010000000080000, 0x4400},
-	{0x3FCF7FBFFFFFFFFE, 0x33E0},
-	{0x4010000000100000, 0x4400},
-	{0x409840D73DEE3B98, 0x6610},
-	{0x4010000000200000, 0x4400},
-	{0x3FE0000000000040, 0x3800},
-	{0x4010000000400000, 0x4400},
-	{0x43537823757A4056, 0x7C00},
-	{0x4010000000800000, 0x4400},
-	{0x3FD4390B6B748C8B, 0x350E},
-	{0x4010000001000000, 0x4400},
-	{0x4030000008001000, 0x4C00},
-	{0x4010000002000000, 0x4400},
-	{0xC9CFFFFEFDFFFFFE, 0xFC00},
-	{0x4010000004000000, 0x4400},
-	{0xC0800007FFE00000, 0xE000},
-	{0x4010000008000000, 0x4400},
-	{0x3FFFFF00001FFFFE, 0x4000},
-	{0x4010000010000000, 0x4400},
-	{0xC027299D8C803591, 0xC9CA},
-	{0x4010000020000000, 0x4400},
-	{0x400FBFFFFFFFFFFF, 0x43F0},
-	{0x4010000040000000, 0x4400},
-	{0x678F7FFFFFDFFFFE, 0x7C00},
-	{0x4010000080000000, 0x4400},
-	{0xB81B5361B553C0B5, 0x8000},
-	{0x4010000100000000, 0x4400},
-	{0x3FEFFFFFDFFFFFBF, 0x3C00},
-	{0x4010000200000000, 0x4400},
-	{0x40200000003FFFFB, 0x4800},
-	{0x4010000400000000, 0x4400},
-	{0x405000000000003F, 0x5400},
-	{0x4010000800000000, 0x4400},
-	{0x3810007F80000000, 0x0000},
-	{0x4010001000000000, 0x4400},
-	{0x43C0001000000006, 0x7C00},
-	{0x4010002000000000, 0x4400},
-	{0xC3F01FFBFFFFFFFE, 0xFC00},
-	{0x4010004000000000, 0x4400},
-	{0x3E7F3AA52AACA0B2, 0x0002},
-	{0x4010008000000000, 0x4400},
-	{0x80100000000103FE, 0x8000},
-	{0x4010010000000000, 0x4400},
-	{0x3F6853E4B1A59A81, 0x1A15},
-	{0x4010020000000000, 0x4400},
-	{0x41F0040001FFFFFF, 0x7C00},
-	{0x4010040000000000, 0x4401},
-	{0x43EFFFF80001FFFF, 0x7C00},
-	{0x4010080000000000, 0x4402},
-	{0x403FFFDFF7FFFFFF, 0x5000},
-	{0x4010100000000000, 0x4404},
-	{0x3BEFFFFFFFFFFFB6, 0x0000},
-	{0x4010200000000000, 0x4408},
-	{0xC0100000000001DF, 0xC400},
-	{0x4010400000000000, 0x4410},
-	{0xC007AEF2459EAE81, 0xC1EC},
-	{0x4010800000000000, 0x4420},
-	{0x7FDFF7FFFFFFFFEE, 0x7C00},
-	{0x4011000000000000, 0x4440},
-	{0xCE1FF00000800000, 0xFC00},
-	{0x4012000000000000, 0x4480},
-	{0xBFFFFFFFF01FFFFF, 0xC000},
-	{0x4014000000000000, 0x4500},
-	{0xDFCE117D90F47FF6, 0xFC00},
-	{0x4018000000000000, 0x4600},
-	{0x403000000000000E, 0x4C00},
-	{0x401C000000000000, 0x4700},
-	{0xBF7DFFF7FFFFFFFE, 0x9F80},
-	{0x401E000000000000, 0x4780},
-	{0x43F001000001FFFF, 0x7C00},
-	{0x401F000000000000, 0x47C0},
-	{0xC3EE74F5E9B2AD2C, 0xFC00},
-	{0x401F800000000000, 0x47E0},
-	{0x37EFFF7FFFFFFFFC, 0x0000},
-	{0x401FC00000000000, 0x47F0},
-	{0xC0100000007FFFBF, 0xC400},
-	{0x401FE00000000000, 0x47F8},
-	{0x000000000040000F, 0x0000},
-	{0x401FF00000000000, 0x47FC},
-	{0x43C2DD3356B7ED02, 0x7C00},
-	{0x401FF80000000000, 0x47FE},
-	{0xC012166E86EB20D9, 0xC486},
-	{0x401FFC0000000000, 0x47FF},
-	{0x7FD001F800000000, 0x7C00},
-	{0x401FFE0000000000, 0x4800},
-	{0x4800041FFFFFFFFF, 0x7C00},
-	{0x401FFF0000000000, 0x4800},
-	{0x4001D20573A7D542, 0x4075},
-	{0x401FFF8000000000, 0x4800},
-	{0x7FD000400001FFFF, 0x7C00},
-	{0x401FFFC000000000, 0x4800},
-	{0x3FAFFFFDFFDFFFFF, 0x2C00},
-	{0x401FFFE000000000, 0x4800},
-	{0xC04FFF80000001FF, 0xD400},
-	{0x401FFFF000000000, 0x4800},
-	{0xBFE430FA4BF609F2, 0xB90C},
-	{0x401FFFF800000000, 0x4800},
-	{0xC3E000200000FFFF, 0xFC00},
-	{0x401FFFFC00000000, 0x4800},
-	{0xC7E00000200000FF, 0xFC00},
-	{0x401FFFFE00000000, 0x4800},
-	{0xB6FF809A98A04C48, 0x8000},
-	{0x401FFFFF00000000, 0x4800},
-	{0x43C0000201FFFFFF, 0x7C00},
-	{0x401FFFFF80000000, 0x4800},
-	{0x3FB02FFFFFFFFFFF, 0x2C0C},
-	{0x401FFFFFC0000000, 0x4800},
-	{0x40D0000001FF7FFF, 0x7400},
-	{0x401FFFFFE0000000, 0x4800},
-	{0x41FF800000007FFF, 0x7C00},
-	{0x401FFFFFF0000000, 0x4800},
-	{0x3CA001FFFFFFFFFE, 0x0000},
-	{0x401FFFFFF8000000, 0x4800},
-	{0xC0206A5443FE17E7, 0xC81B},
-	{0x401FFFFFFC000000, 0x4800},
-	{0xC0C7B0058930B001, 0xF1EC},
-	{0x401FFFFFFE000000, 0x4800},
-	{0x41EFFFFEFFEFFFFF, 0x7C00},
-	{0x401FFFFFFF000000, 0x4800},
-	{0xB810000000800FFE, 0x8000},
-	{0x401FFFFFFF800000, 0x4800},
-	{0x82FEFFFFFFFFFFF7, 0x8000},
-	{0x401FFFFFFFC00000, 0x4800},
-	{0xBF881FD2E6DB16A7, 0xA208},
-	{0x401FFFFFFFE00000, 0x4800},
-	{0x8026290307F118B3, 0x8000},
-	{0x401FFFFFFFF00000, 0x4800},
-	{0xC1D5EC4C22BD357B, 0xFC00},
-	{0x401FFFFFFFF80000, 0x4800},
-	{0x43E4F9D8F3C87278, 0x7C00},
-	{0x401FFFFFFFFC0000, 0x4800},
-	{0x41E0000040000080, 0x7C00},
-	{0x401FFFFFFFFE0000, 0x4800},
-	{0xC7FDFFFFFFFFFFFE, 0xFC00},
-	{0x401FFFFFFFFF0000, 0x4800},
-	{0xC12C234E176A7D47, 0xFC00},
-	{0x401FFFFFFFFF8000, 0x4800},
-	{0x3810000001FFFFFF, 0x0000},
-	{0x401FFFFFFFFFC000, 0x4800},
-	{0x3F068C98EDA14786, 0x02D2},
-	{0x401FFFFFFFFFE000, 0x4800},
-	{0x3F9FFF0003FFFFFF, 0x2800},
-	{0x401FFFFFFFFFF000, 0x4800},
-	{0x33383F66A5DE21ED, 0x0000},
-	{0x401FFFFFFFFFF800, 0x4800},
-	{0x3FB7FFFFEFFFFFFF, 0x2E00},
-	{0x401FFFFFFFFFFC00, 0x4800},
-	{0x4000000001FFDFFE, 0x4000},
-	{0x401FFFFFFFFFFE00, 0x4800},
-	{0x70D0000004100000, 0x7C00},
-	{0x401FFFFFFFFFFF00, 0x4800},
-	{0x3CE0000000000006, 0x0000},
-	{0x401FFFFFFFFFFF80, 0x4800},
-	{0x86A0000800000100, 0x8000},
-	{0x401FFFFFFFFFFFC0, 0x4800},
-	{0xB81FFFBFFBFFFFFF, 0x8000},
-	{0x401FFFFFFFFFFFE0, 0x4800},
-	{0xC1FFBFFFFFFFFFF6, 0xFC00},
-	{0x401FFFFFFFFFFFF0, 0x4800},
-	{0x43F000010000007F, 0x7C00},
-	{0x401FFFFFFFFFFFF8, 0x4800},
-	{0xA5A75D41255C2A0E, 0x8000},
-	{0x401FFFFFFFFFFFFC, 0x4800},
-	{0x400EFFFFFFFFC000, 0x43C0},
-	{0x401FFFFFFFFFFFFE, 0x4800},
-	{0x4BBFC0000FFFFFFE, 0x7C00},
-	{0x401FFFFFFFFFFFFF, 0x4800},
-	{0x43E0000003FFFF80, 0x7C00},
-	{0x401FFFFFFFFFFFFD, 0x4800},
-	{0xC0000000017FFFFE, 0xC000},
-	{0x401FFFFFFFFFFFFB, 0x4800},
-	{0x7FEFFFFEF7FFFFFF, 0x7C00},
-	{0x401FFFFFFFFFFFF7, 0x4800},
-	{0x47E075D10E96800F, 0x7C00},
-	{0x401FFFFFFFFFFFEF, 0x4800},
-	{0x001FFA497F8E9FF9, 0x0000},
-	{0x401FFFFFFFFFFFDF, 0x4800},
-	{0x30200000000003BF, 0x0000},
-	{0x401FFFFFFFFFFFBF, 0x4800},
-	{0xC02F7DFFFFFFFFFF, 0xCBDF},
-	{0x401FFFFFFFFFFF7F, 0x4800},
-	{0xC1F7DE18F14D481A, 0xFC00},
-	{0x401FFFFFFFFFFEFF, 0x4800},
-	{0x8028001FFFFFFFFF, 0x8000},
-	{0x401FFFFFFFFFFDFF, 0x4800},
-	{0x402FFFFFFFFF8100, 0x4C00},
-	{0x401FFFFFFFFFFBFF, 0x4800},
-	{0x43D00007FFFFFBFF, 0x7C00},
-	{0x401FFFFFFFFFF7FF, 0x4800},
-	{0xBFEFFFFFFBF00000, 0xBC00},
-	{0x401FFFFFFFFFEFFF, 0x4800},
-	{0x37F000001FFFFFC0, 0x0000},
-	{0x401FFFFFFFFFDFFF, 0x4800},
-	{0xC028F649C1BC2F43, 0xCA3E},
-	{0x401FFFFFFFFFBFFF, 0x4800},
-	{0xC7F1EF48476A5369, 0xFC00},
-	{0x401FFFFFFFFF7FFF, 0x4800},
-	{0x13B6B7053EF8173D, 0x0000},
-	{0x401FFFFFFFFEFFFF, 0x4800},
-	{0x802000000007FDFE, 0x8000},
-	{0x401FFFFFFFFDFFFF, 0x4800},
-	{0xC1F037FFFFFFFFFE, 0xFC00},
-	{0x401FFFFFFFFBFFFF, 0x4800},
-	{0xBF9000083FFFFFFF, 0xA400},
-	{0x401FFFFFFFF7FFFF, 0x4800},
-	{0x65B0FFFFFBFFFFFE, 0x7C00},
-	{0x401FFFFFFFEFFFFF, 0x4800},
-	{0x7FFFC000000FFFFF, 0x7FF0},
-	{0x401FFFFFFFDFFFFF, 0x4800},
-	{0x4010000006000000, 0x4400},
-	{0x401FFFFFFFBFFFFF, 0x4800},
-	{0xBFDFFFFDFFFFFFFE, 0xB800},
-	{0x401FFFFFFF7FFFFF, 0x4800},
-	{0x40DFFFFFFFFEFFBF, 0x7800},
-	{0x401FFFFFFEFFFFFF, 0x4800},
-	{0xC1F000000000080E, 0xFC00},
-	{0x401FFFFFFDFFFFFF, 0x4800},
-	{0xC1F0FED6477D5EE9, 0xFC00},
-	{0x401FFFFFFBFFFFFF, 0x4800},
-	{0x43F4FC5334DD59F7, 0x7C00},
-	{0x401FFFFFF7FFFFFF, 0x4800},
-	{0xC03FFFFFF7FFFFBE, 0xD000},
-	{0x401FFFFFEFFFFFFF, 0x4800},
-	{0xC0200020000FFFFF, 0xC800},
-	{0x401FFFFFDFFFFFFF, 0x4800},
-	{0x0F6FFFFFFFFF7FF6, 0x0000},
-	{0x401FFFFFBFFFFFFF, 0x4800},
-	{0x3C7FFFFFFE00003F, 0x0000},
-	{0x401FFFFF7FFFFFFF, 0x4800},
-	{0x4000000005FFFFFF, 0x4000},
-	{0x401FFFFEFFFFFFFF, 0x4800},
-	{0x32803FFFFFFFFFFD, 0x0000},
-	{0x401FFFFDFFFFFFFF, 0x4800},
-	{0x7FD8A55364BB1A49, 0x7C00},
-	{0x401FFFFBFFFFFFFF, 0x4800},
-	{0x3F500004000FFFFF, 0x1400},
-	{0x401FFFF7FFFFFFFF, 0x4800},
-	{0x7FEDFFFFFFFF8000, 0x7C00},
-	{0x401FFFEFFFFFFFFF, 0x4800},
-	{0x3FE0000000001FFE, 0x3800},
-	{0x401FFFDFFFFFFFFF, 0x4800},
-	{0xB3DFFFFFFFFE3FFF, 0x8000},
-	{0x401FFFBFFFFFFFFF, 0x4800},
-	{0x3FFF800000000080, 0x3FE0},
-	{0x401FFF7FFFFFFFFF, 0x4800},
-	{0x3F00003BFFFFFFFE, 0x0200},
-	{0x401FFEFFFFFFFFFF, 0x4800},
-	{0xE0EFE007FFFFFFFE, 0xFC00},
-	{0x401FFDFFFFFFFFFF, 0x47FF},
-	{0x002FFFFC00000008, 0x0000},
-	{0x401FFBFFFFFFFFFF, 0x47FF},
-	{0x3F4818A160CC8E91, 0x1206},
-	{0x401FF7FFFFFFFFFF, 0x47FE},
-	{0x3F9FFFFFFFC40000, 0x2800},
-	{0x401FEFFFFFFFFFFF, 0x47FC},
-	{0xBFBFE00008000000, 0xAFF8},
-	{0x401FDFFFFFFFFFFF, 0x47F8},
-	{0x801C01FFFFFFFFFE, 0x8000},
-	{0x401FBFFFFFFFFFFF, 0x47F0},
-	{0x401F0000000FFFFE, 0x47C0},
-	{0x401F7FFFFFFFFFFF, 0x47E0},
-	{0xBB7000000080007F, 0x8000},
-	{0x401EFFFFFFFFFFFF, 0x47C0},
-	{0xFB700003FFF7FFFF, 0xFC00},
-	{0x401DFFFFFFFFFFFF, 0x4780},
-	{0xB8100003FFFFFFDF, 0x8000},
-	{0x401BFFFFFFFFFFFF, 0x4700},
-	{0x3BAA34DC16B7CF45, 0x0000},
-	{0x4017FFFFFFFFFFFF, 0x4600},
-	{0xB81381713362962B, 0x8000},
-	{0x4013FFFFFFFFFFFF, 0x4500},
-	{0x13555B631F35F49B, 0x0000},
-	{0x4011FFFFFFFFFFFF, 0x4480},
-	{0xC00C0003FFFFFFFF, 0xC300},
-	{0x4010FFFFFFFFFFFF, 0x4440},
-	{0xC1F29F2ED890660C, 0xFC00},
-	{0x40107FFFFFFFFFFF, 0x4420},
-	{0x403FFFF800400000, 0x5000},
-	{0x40103FFFFFFFFFFF, 0x4410},
-	{0x381FF7F000000000, 0x0000},
-	{0x40101FFFFFFFFFFF, 0x4408},
-	{0x62D06D243F0AF79D, 0x7C00},
-	{0x40100FFFFFFFFFFF, 0x4404},
-	{0xC1F000001007FFFF, 0xFC00},
-	{0x401007FFFFFFFFFF, 0x4402},
-	{0x3DDFFE0040000000, 0x0000},
-	{0x401003FFFFFFFFFF, 0x4401},
-	{0x133FFFFFF7FFF000, 0x0000},
-	{0x401001FFFFFFFFFF, 0x4400},
-	{0x05DFFFEFFFF7FFFE, 0x0000},
-	{0x401000FFFFFFFFFF, 0x4400},
-	{0xC5F610AC6F9E20EA, 0xFC00},
-	{0x4010007FFFFFFFFF, 0x4400},
-	{0xFFE20000000007FF, 0xFC00},
-	{0x4010003FFFFFFFFF, 0x4400},
-	{0xC07FFFFFFFFFFFC0, 0xE000},
-	{0x4010001FFFFFFFFF, 0x4400},
-	{0xC01E20C4140C360C, 0xC788},
-	{0x4010000FFFFFFFFF, 0x4400},
-	{0x47E88F9D9B22D58A, 0x7C00},
-	{0x40100007FFFFFFFF, 0x4400},
-	{0xC1CADFA064D9B27E, 0xFC00},
-	{0x40100003FFFFFFFF, 0x4400},
-	{0x7FF58BDB9C6C5B1C, 0x7F62},
-	{0x40100001FFFFFFFF, 0x4400},
-	{0x480804F9C24EFC0A, 0x7C00},
-	{0x40100000FFFFFFFF, 0x4400},
-	{0xBF8FFFFFFFFFFF8E, 0xA400},
-	{0x401000007FFFFFFF, 0x4400},
-	{0xC0066B25165EBD9D, 0xC19B},
-	{0x401000003FFFFFFF, 0x4400},
-	{0xB81FFDFFFFFFFFFB, 0x8000},
-	{0x401000001FFFFFFF, 0x4400},
-	{0x0016FD3BB032FD28, 0x0000},
-	{0x401000000FFFFFFF, 0x4400},
-	{0x800000000080000E, 0x8000},
-	{0x4010000007FFFFFF, 0x4400},
-	{0x3F100000800001FE, 0x0400},
-	{0x4010000003FFFFFF, 0x4400},
-	{0xC0203FFFFEFFFFFF, 0xC810},
-	{0x4010000001FFFFFF, 0x4400},
-	{0x43C000000001FFEE, 0x7C00},
-	{0x4010000000FFFFFF, 0x4400},
-	{0xC0CA1BE1427E503A, 0xF287},
-	{0x40100000007FFFFF, 0x4400},
-	{0xC02DD1D99C7F98AA, 0xCB74},
-	{0x40100000003FFFFF, 0x4400},
-	{0xBCA0000007FFBFFF, 0x8000},
-	{0x40100000001FFFFF, 0x4400},
-	{0xFFD000000000000E, 0xFC00},
-	{0x40100000000FFFFF, 0x4400},
-	{0x1118000000001FFF, 0x0000},
-	{0x401000000007FFFF, 0x4400},
-	{0xB2CFEFFFFFFFFFFE, 0x8000},
-	{0x401000000003FFFF, 0x4400},
-	{0xC029BCE9324D7E68, 0xCA6F},
-	{0x401000000001FFFF, 0x4400},
-	{0x41EFDFFFFFEFFFFF, 0x7C00},
-	{0x401000000000FFFF, 0x4400},
-	{0xBFEFFFFFFF5FFFFF, 0xBC00},
-	{0x4010000000007FFF, 0x4400},
-	{0x3FDFFFFFFFFFFFFE, 0x3800},
-	{0x4010000000003FFF, 0x4400},
-	{0x3FC1D4C0D98C9A40, 0x3075},
-	{0x4010000000001FFF, 0x4400},
-	{0x77A09773B3159336, 0x7C00},
-	{0x4010000000000FFF, 0x4400},
-	{0x41E3FBFFFFFFFFFF, 0x7C00},
-	{0x40100000000007FF, 0x4400},
-	{0x20F0000001FFBFFF, 0x0000},
-	{0x40100000000003FF, 0x4400},
-	{0xC1A0008000000FFE, 0xFC00},
-	{0x40100000000001FF, 0x4400},
-	{0x3BE000000000017E, 0x0000},
-	{0x40100000000000FF, 0x4400},
-	{0x41FFBFFFFFFFFFBE, 0x7C00},
-	{0x401000000000007F, 0x4400},
-	{0x00078C5AD6ADFBDC, 0x0000},
-	{0x401000000000003F, 0x4400},
-	{0xB81001FFFFC00000, 0x8000},
-	{0x401000000000001F, 0x4400},
-	{0xBFBFFFFFFC000200, 0xB000},
-	{0x401000000000000F, 0x4400},
-	{0xC8B0000400FFFFFE, 0xFC00},
-	{0x4010000000000007, 0x4400},
-	{0xBFB4A72453C3761D, 0xAD2A},
-	{0x4010000000000003, 0x4400},
-	{0x47EC4C9BC8060F33, 0x7C00},
-	{0x4020000000000000, 0x4800},
-	{0x401FFFFFFFCFFFFF, 0x4800},
-	{0x4020000000000001, 0x4800},
-	{0x379FFFFFFFFFFE07, 0x0000},
-	{0x4020000000000002, 0x4800},
-	{0xB81822C3620F3006, 0x8000},
-	{0x4020000000000004, 0x4800},
-	{0x7D3EFE0000000000, 0x7C00},
-	{0x4020000000000008, 0x4800},
-	{0xCE207FFFF7FFFFFF, 0xFC00},
-	{0x4020000000000010, 0x4800},
-	{0xC68A130649012715, 0xFC00},
-	{0x4020000000000020, 0x4800},
-	{0xB7FFFFFFE7FFFFFF, 0x8000},
-	{0x4020000000000040, 0x4800},
-	{0xC00FFFEFFFFFFFBF, 0xC400},
-	{0x4020000000000080, 0x4800},
-	{0xB7F0000000003FF0, 0x8000},
-	{0x4020000000000100, 0x4800},
-	{0xFD6A5EED8DF39288, 0xFC00},
-	{0x4020000000000200, 0x4800},
-	{0x41C0000040001000, 0x7C00},
-	{0x4020000000000400, 0x4800},
-	{0x40600000000006FF, 0x5800},
-	{0x4020000000000800, 0x4800},
-	{0xC7F412E03A6CBBCE, 0xFC00},
-	{0x4020000000001000, 0x4800},
-	{0xC1EFFFFC0000003E, 0xFC00},
-	{0x4020000000002000, 0x4800},
-	{0x800043FFFFFFFFFF, 0x8000},
-	{0x4020000000004000, 0x4800},
-	{0xC7EFFBFFEFFFFFFF, 0xFC00},
-	{0x4020000000008000, 0x4800},
-	{0x000EFFFFBFFFFFFE, 0x0000},
-	{0x4020000000010000, 0x4800},
-	{0xC1FFFFFFC03FFFFE, 0xFC00},
-	{0x4020000000020000, 0x4800},
-	{0x7FEFFF8003FFFFFF, 0x7C00},
-	{0x4020000000040000, 0x4800},
-	{0x3FFFFFFFF7C00000, 0x4000},
-	{0x4020000000080000, 0x4800},
-	{0xB810000FFFFFFFFF, 0x8000},
-	{0x4020000000100000, 0x4800},
-	{0x37FA64696895856E, 0x0000},
-	{0x4020000000200000, 0x4800},
-	{0x3CA808E720E65BAB, 0x0000},
-	{0x4020000000400000, 0x4800},
-	{0x567FF80040000000, 0x7C00},
-	{0x4020000000800000, 0x4800},
-	{0x4801F832ADF2F8D2, 0x7C00},
-	{0x4020000001000000, 0x4800},
-	{0xFFE00000000203FF, 0xFC00},
-	{0x4020000002000000, 0x4800},
-	{0x4030200000003FFF, 0x4C08},
-	{0x4020000004000000, 0x4800},
-	{0xC80FFFEFFFFBFFFF, 0xFC00},
-	{0x4020000008000000, 0x4800},
-	{0x3FC0043FFFFFFFFF, 0x3001},
-	{0x4020000010000000, 0x4800},
-	{0x7FFFFFFFFFF7FFFF, 0x7FFF},
-	{0x4020000020000000, 0x4800},
-	{0xC1D00000200003FF, 0xFC00},
-	{0x4020000040000000, 0x4800},
-	{0x4620400000000006, 0x7C00},
-	{0x4020000080000000, 0x4800},
-	{0xC7EF800020000000, 0xFC00},
-	{0x4020000100000000, 0x4800},
-	{0xD70FF7FFFFF7FFFF, 0xFC00},
-	{0x4020000200000000, 0x4800},
-	{0xBFFBFFFFFFFFFEFF, 0xBF00},
-	{0x4020000400000000, 0x4800},
-	{0x3FBFFFFDFFFFFFDF, 0x3000},
-	{0x4020000800000000, 0x4800},
-	{0xBFA12F76243FDBC6, 0xA84C},
-	{0x4020001000000000, 0x4800},
-	{0xC02FFFFFC0000000, 0xCC00},
-	{0x4020002000000000, 0x4800},
-	{0x405351D245CC15CC, 0x54D4},
-	{0x4020004000000000, 0x4800},
-	{0x43400003FFFFF7FE, 0x7C00},
-	{0x4020008000000000, 0x4800},
-	{0x3FB8B527D7992BD7, 0x2E2D},
-	{0x4020010000000000, 0x4800},
-	{0x47E9523233F71FBB, 0x7C00},
-	{0x4020020000000000, 0x4800},
-	{0x3A3020007FFFFFFF, 0x0000},
-	{0x4020040000000000, 0x4801},
-	{0x9ADFFFFFFE000002, 0x8000},
-	{0x4020080000000000, 0x4802},
-	{0xA2B595E44FC859D6, 0x8000},
-	{0x4020100000000000, 0x4804},
-	{0xC080F49B70641BB2, 0xE03D},
-	{0x4020200000000000, 0x4808},
-	{0xC1CB89D8AEB4C15D, 0xFC00},
-	{0x4020400000000000, 0x4810},
-	{0xC5A60762E0C1D396, 0xFC00},
-	{0x4020800000000000, 0x4820},
-	{0x0001FFFDFFFFFFFE, 0x0000},
-	{0x4021000000000000, 0x4840},
-	{0xC3D26300DBE931F9, 0xFC00},
-	{0x4022000000000000, 0x4880},
-	{0xBFAFFBFFFDFFFFFF, 0xABFF},
-	{0x4024000000000000, 0x4900},
-	{0xFFEFFFFFEFFFFFE0, 0xFC00},
-	{0x4028000000000000, 0x4A00},
-	{0xC80FFFDFFBFFFFFF, 0xFC00},
-	{0x402C000000000000, 0x4B00},
-	{0x43E0003DFFFFFFFE, 0x7C00},
-	{0x402E000000000000, 0x4B80},
-	{0xC1F000000001C000, 0xFC00},
-	{0x402F000000000000, 0x4BC0},
-	{0x3FCFFFFF0007FFFF, 0x3400},
-	{0x402F800000000000, 0x4BE0},
-	{0x8448C37C439BF213, 0x8000},
-	{0x402FC00000000000, 0x4BF0},
-	{0x381FEFFFFFDFFFFF, 0x0000},
-	{0x402FE00000000000, 0x4BF8},
-	{0xC3E78AD74CBF7CD3, 0xFC00},
-	{0x402FF00000000000, 0x4BFC},
-	{0x291000001FFFFC00, 0x0000},
-	{0x402FF80000000000, 0x4BFE},
-	{0x400F6FFFFFFFFFFE, 0x43DC},
-	{0x402FFC0000000000, 0x4BFF},
-	{0x47E0000100000007, 0x7C00},
-	{0x402FFE0000000000, 0x4C00},
-	{0x43C7FFFFFFFFFC00, 0x7C00},
-	{0x402FFF0000000000, 0x4C00},
-	{0x455FFFFFFFBFFFFF, 0x7C00},
-	{0x402FFF8000000000, 0x4C00},
-	{0x3FDD273A97C62F9D, 0x374A},
-	{0x402FFFC000000000, 0x4C00},
-	{0xBCBF0CC5EE02B6BA, 0x8000},
-	{0x402FFFE000000000, 0x4C00},
-	{0x41DFF81FFFFFFFFF, 0x7C00},
-	{0x402FFFF000000000, 0x4C00},
-	{0x7FDFFF7FFEFFFFFF, 0x7C00},
-	{0x402FFFF800000000, 0x4C00},
-	{0x3FB14CD75ACE2CC8, 0x2C53},
-	{0x402FFFFC00000000, 0x4C00},
-	{0xC7EFFFFFFFFF7E00, 0xFC00},
-	{0x402FFFFE00000000, 0x4C00},
-	{0x000FFF7FFFFFFFEF, 0x0000},
-	{0x402FFFFF00000000, 0x4C00},
-	{0xFFEE000000000000, 0xFC00},
-	{0x402FFFFF80000000, 0x4C00},
-	{0xFFD4854533448811, 0xFC00},
-	{0x402FFFFFC0000000, 0x4C00},
-	{0x400000000000001B, 0x4000},
-	{0x402FFFFFE0000000, 0x4C00},
-	{0x3EA5DC58A0A008D6, 0x000B},
-	{0x402FFFFFF0000000, 0x4C00},
-	{0x401FFFC000008000, 0x4800},
-	{0x402FFFFFF8000000, 0x4C00},
-	{0x3FC9649081F88D99, 0x3259},
-	{0x402FFFFFFC000000, 0x4C00},
-	{0x3FE00000000FFEFE, 0x3800},
-	{0x402FFFFFFE000000, 0x4C00},
-	{0x4130000000400000, 0x7C00},
-	{0x402FFFFFFF000000, 0x4C00},
-	{0x3EF0000002000FFF, 0x0100},
-	{0x402FFFFFFF800000, 0x4C00},
-	{0xB8B0007FFFFF7FFE, 0x8000},
-	{0x402FFFFFFFC00000, 0x4C00},
-	{0x467FF000007FFFFF, 0x7C00},
-	{0x402FFFFFFFE00000, 0x4C00},
-	{0xBA0EFFFFDFFFFFFF, 0x8000},
-	{0x402FFFFFFFF00000, 0x4C00},
-	{0xBF50037FFFFFFFFF, 0x9401},
-	{0x402FFFFFFFF80000, 0x4C00},
-	{0x3ECCAC21C0E24563, 0x0039},
-	{0x402FFFFFFFFC0000, 0x4C00},
-	{0xFE3FFFDFFFFF7FFF, 0xFC00},
-	{0x402FFFFFFFFE0000, 0x4C00},
-	{0xC000001FFFFFFFFC, 0xC000},
-	{0x402FFFFFFFFF0000, 0x4C00},
-	{0x8017FFFFEFFFFFFE, 0x8000},
-	{0x402FFFFFFFFF8000, 0x4C00},
-	{0xC03FFFFF07FFFFFF, 0xD000},
-	{0x402FFFFFFFFFC000, 0x4C00},
-	{0xC59003FC00000000, 0xFC00},
-	{0x402FFFFFFFFFE000, 0x4C00},
-	{0x400FFFFEDFFFFFFF, 0x4400},
-	{0x402FFFFFFFFFF000, 0x4C00},
-	{0xA1FA7E7BCE894FF2, 0x8000},
-	{0x402FFFFFFFFFF800, 0x4C00},
-	{0xBEC0000003FFBFFF, 0x8020},
-	{0x402FFFFFFFFFFC00, 0x4C00},
-	{0x380FFFFFDFEFFFFF, 0x0000},
-	{0x402FFFFFFFFFFE00, 0x4C00},
-	{0x3FBFFE0002000000, 0x3000},
-	{0x402FFFFFFFFFFF00, 0x4C00},
-	{0x3FF0000000080000, 0x3C00},
-	{0x402FFFFFFFFFFF80, 0x4C00},
-	{0xBFFFEFFFFEFFFFFF, 0xBFFC},
-	{0x402FFFFFFFFFFFC0, 0x4C00},
-	{0x3FDFFFFDFFFFFFBF, 0x3800},
-	{0x402FFFFFFFFFFFE0, 0x4C00},
-	{0xC40FFFFF82000000, 0xFC00},
-	{0x402FFFFFFFFFFFF0, 0x4C00},
-	{0x4007BCEF579DE4C3, 0x41EF},
-	{0x402FFFFFFFFFFFF8, 0x4C00},
-	{0xC1F7DC12466379A6, 0xFC00},
-	{0x402FFFFFFFFFFFFC, 0x4C00},
-	{0x000FFFFFFEF80000, 0x0000},
-	{0x402FFFFFFFFFFFFE, 0x4C00},
-	{0x2B6000001007FFFF, 0x0000},
-	{0x402FFFFFFFFFFFFF, 0x4C00},
-	{0xC03FFFFBFFDFFFFF, 0xD000},
-	{0x402FFFFFFFFFFFFD, 0x4C00},
-	{0x3FB200000007FFFF, 0x2C80},
-	{0x402FFFFFFFFFFFFB, 0x4C00},
-	{0x43EFFFFF800007FF, 0x7C00},
-	{0x402FFFFFFFFFFFF7, 0x4C00},
-	{0xBB10000000040010, 0x8000},
-	{0x402FFFFFFFFFFFEF, 0x4C00},
-	{0x43DFFFFFBFFFF000, 0x7C00},
-	{0x402FFFFFFFFFFFDF, 0x4C00},
-	{0xC0F4000000002000, 0xFC00},
-	{0x402FFFFFFFFFFFBF, 0x4C00},
-	{0xBFBFF803FFFFFFFF, 0xAFFE},
-	{0x402FFFFFFFFFFF7F, 0x4C00},
-	{0x401F5CD5468C6FD2, 0x47D7},
-	{0x402FFFFFFFFFFEFF, 0x4C00},
-	{0x4035E98FD1A188D8, 0x4D7A},
-	{0x402FFFFFFFFFFDFF, 0x4C00},
-	{0x344FFFFFF0002000, 0x0000},
-	{0x402FFFFFFFFFFBFF, 0x4C00},
-	{0xC3D0000003F80000, 0xFC00},
-	{0x402FFFFFFFFFF7FF, 0x4C00},
-	{0xC3DFBFFFFBFFFFFF, 0xFC00},
-	{0x402FFFFFFFFFEFFF, 0x4C00},
-	{0x001FE07FFFFFFFFE, 0x0000},
-	{0x402FFFFFFFFFDFFF, 0x4C00},
-	{0x3FDFFFFFDFFFFFF7, 0x3800},
-	{0x402FFFFFFFFFBFFF, 0x4C00},
-	{0xDD20010040000000, 0xFC00},
-	{0x402FFFFFFFFF7FFF, 0x4C00},
-	{0xCFC07FFFFFFFDFFE, 0xFC00},
-	{0x402FFFFFFFFEFFFF, 0x4C00},
-	{0xBF8FFE00003FFFFF, 0xA400},
-	{0x402FFFFFFFFDFFFF, 0x4C00},
-	{0xC02FFFFF7FFEFFFF, 0xCC00},
-	{0x402FFFFFFFFBFFFF, 0x4C00},
-	{0xC03BFFFFFFFFFFBF, 0xCF00},
-	{0x402FFFFFFFF7FFFF, 0x4C00},
-	{0x3F4007FF7FFFFFFE, 0x1002},
-	{0x402FFFFFFFEFFFFF, 0x4C00},
-	{0xBFBFFFFC0FFFFFFF, 0xB000},
-	{0x402FFFFFFFDFFFFF, 0x4C00},
-	{0x2060000007EFFFFF, 0x0000},
-	{0x402FFFFFFFBFFFFF, 0x4C00},
-	{0x409E910D584AA4AF, 0x67A4},
-	{0x402FFFFFFF7FFFFF, 0x4C00},
-	{0x9A800003FFFEFFFF, 0x8000},
-	{0x402FFFFFFEFFFFFF, 0x4C00},
-	{0x3FCFFFFFFF7FFFF8, 0x3400},
-	{0x402FFFFFFDFFFFFF, 0x4C00},
-	{0xD28D875734EF872A, 0xFC00},
-	{0x402FFFFFFBFFFFFF, 0x4C00},
-	{0x3810FFFFFFFBFFFF, 0x0000},
-	{0x402FFFFFF7FFFFFF, 0x4C00},
-	{0xC02974719BD0802A, 0xCA5D},
-	{0x402FFFFFEFFFFFFF, 0x4C00},
-	{0x4002B37B7B5673AD, 0x40AD},
-	{0x402FFFFFDFFFFFFF, 0x4C00},
-	{0x3F908000003FFFFF, 0x2420},
-	{0x402FFFFFBFFFFFFF, 0x4C00},
-	{0x41B9D1A5524424B9, 0x7C00},
-	{0x402FFFFF7FFFFFFF, 0x4C00},
-	{0x41CFE00400000000, 0x7C00},
-	{0x402FFFFEFFFFFFFF, 0x4C00},
-	{0x402050F1BC683149, 0x4814},
-	{0x402FFFFDFFFFFFFF, 0x4C00},
-	{0x381FFFF8007FFFFF, 0x0000},
-	{0x402FFFFBFFFFFFFF, 0x4C00},
-	{0xBCA7C3801905BFB9, 0x8000},
-	{0x402FFFF7FFFFFFFF, 0x4C00},
-	{0x3FD1003FFFFFFFFE, 0x3440},
-	{0x402FFFEFFFFFFFFF, 0x4C00},
-	{0xBFB0087FFFFFFFFF, 0xAC02},
-	{0x402FFFDFFFFFFFFF, 0x4C00},
-	{0xCE520FFFFFFFFFFF, 0xFC00},
-	{0x402FFFBFFFFFFFFF, 0x4C00},
-	{0xC7E0000000000FF7, 0xFC00},
-	{0x402FFF7FFFFFFFFF, 0x4C00},
-	{0x3F0FFFFFFFFFC7FF, 0x0400},
-	{0x402FFEFFFFFFFFFF, 0x4C00},
-	{0x403000000000023F, 0x4C00},
-	{0x402FFDFFFFFFFFFF, 0x4BFF},
-	{0xBFEFFF0000020000, 0xBC00},
-	{0x402FFBFFFFFFFFFF, 0x4BFF},
-	{0x3FC007FF7FFFFFFF, 0x3002},
-	{0x402FF7FFFFFFFFFF, 0x4BFE},
-	{0x3FD0000000000020, 0x3400},
-	{0x402FEFFFFFFFFFFF, 0x4BFC},
-	{0xBC2000001007FFFF, 0x8000},
-	{0x402FDFFFFFFFFFFF, 0x4BF8},
-	{0xFFF5FEFD404E53BC, 0xFF7F},
-	{0x402FBFFFFFFFFFFF, 0x4BF0},
-	{0x43EFFFFBFDFFFFFF, 0x7C00},
-	{0x402F7FFFFFFFFFFF, 0x4BE0},
-	{0xC01FFBFFFFFFFFEF, 0xC7FF},
-	{0x402EFFFFFFFFFFFF, 0x4BC0},
-	{0x001FFFFFFF7F7FFF, 0x0000},
-	{0x402DFFFFFFFFFFFF, 0x4B80},
-	{0x7FD088470309C28C, 0x7C00},
-	{0x402BFFFFFFFFFFFF, 0x4B00},
-	{0x41FDFFFFFFE00000, 0x7C00},
-	{0x4027FFFFFFFFFFFF, 0x4A00},
-	{0xC06FFFDBFFFFFFFE, 0xDC00},
-	{0x4023FFFFFFFFFFFF, 0x4900},
-	{0xC031000000000FFF, 0xCC40},
-	{0x4021FFFFFFFFFFFF, 0x4880},
-	{0xC3400004003FFFFF, 0xFC00},
-	{0x4020FFFFFFFFFFFF, 0x4840},
-	{0x0000000000040002, 0x0000},
-	{0x40207FFFFFFFFFFF, 0x4820},
-	{0xC34FFE0000000080, 0xFC00},
-	{0x40203FFFFFFFFFFF, 0x4810},
-	{0xC00F0C64B5519455, 0xC3C3},
-	{0x40201FFFFFFFFFFF, 0x4808},
-	{0x3E70005FFFFFFFFF, 0x0001},
-	{0x40200FFFFFFFFFFF, 0x4804},
-	{0x32F0000800000002, 0x0000},
-	{0x402007FFFFFFFFFF, 0x4802},
-	{0xBDBDB5A8C0E9A21E, 0x8000},
-	{0x402003FFFFFFFFFF, 0x4801},
-	{0xC1C0800000000800, 0xFC00},
-	{0x402001FFFFFFFFFF, 0x4800},
-	{0xBFF97B7843C62313, 0xBE5F},
-	{0x402000FFFFFFFFFF, 0x4800},
-	{0xB54C007FFFFFFFFE, 0x8000},
-	{0x4020007FFFFFFFFF, 0x4800},
-	{0x8C33185570751B35, 0x8000},
-	{0x4020003FFFFFFFFF, 0x4800},
-	{0x3E6000C000000000, 0x0001},
-	{0x4020001FFFFFFFFF, 0x4800},
-	{0xC030008000000007, 0xCC00},
-	{0x4020000FFFFFFFFF, 0x4800},
-	{0x4808CCBE9386A85F, 0x7C00},
-	{0x40200007FFFFFFFF, 0x4800},
-	{0x943BB05AF5B96B07, 0x8000},
-	{0x40200003FFFFFFFF, 0x4800},
-	{0x32990E731BA0E139, 0x0000},
-	{0x40200001FFFFFFFF, 0x4800},
-	{0xB0EFC0007FFFFFFF, 0x8000},
-	{0x40200000FFFFFFFF, 0x4800},
-	{0xBFB61B1AC4AC51E2, 0xAD87},
-	{0x402000007FFFFFFF, 0x4800},
-	{0x3720000010000002, 0x0000},
-	{0x402000003FFFFFFF, 0x4800},
-	{0x39BF000010000000, 0x0000},
-	{0x402000001FFFFFFF, 0x4800},
-	{0xC0401FF7FFFFFFFF, 0xD008},
-	{0x402000000FFFFFFF, 0x4800},
-	{0xC1C59A05F6CAC280, 0xFC00},
-	{0x4020000007FFFFFF, 0x4800},
-	{0x88CFFFFFFFF0001E, 0x8000},
-	{0x4020000003FFFFFF, 0x4800},
-	{0x459FFFFFFFC01FFF, 0x7C00},
-	{0x4020000001FFFFFF, 0x4800},
-	{0x43C00001FEFFFFFF, 0x7C00},
-	{0x4020000000FFFFFF, 0x4800},
-	{0x4340FAB2CEF3B477, 0x7C00},
-	{0x40200000007FFFFF, 0x4800},
-	{0x19DFFFF5FFFFFFFF, 0x0000},
-	{0x40200000003FFFFF, 0x4800},
-	{0x3FD8B7B9D3216945, 0x362E},
-	{0x40200000001FFFFF, 0x4800},
-	{0xC3CEFA8AEDCD48BF, 0xFC00},
-	{0x40200000000FFFFF, 0x4800},
-	{0xB3C0000000001FF6, 0x8000},
-	{0x402000000007FFFF, 0x4800},
-	{0x3FFFCEF894CCFB5D, 0x3FF4},
-	{0x402000000003FFFF, 0x4800},
-	{0xFFD1000000000400, 0xFC00},
-	{0x402000000001FFFF, 0x4800},
-	{0xBFB1FFFEFFFFFFFF, 0xAC80},
-	{0x402000000000FFFF, 0x4800},
-	{0xBF20040000000800, 0x8801},
-	{0x4020000000007FFF, 0x4800},
-	{0xC3FFFFFF80000020, 0xFC00},
-	{0x4020000000003FFF, 0x4800},
-	{0x4000000080000800, 0x4000},
-	{0x4020000000001FFF, 0x4800},
-	{0x41F003FFFFFFFFF8, 0x7C00},
-	{0x4020000000000FFF, 0x4800},
-	{0x7FF0000000200000, 0x7E00},
-	{0x40200000000007FF, 0x4800},
-	{0x3D40800000003FFE, 0x0000},
-	{0x40200000000003FF, 0x4800},
-	{0xBFDFFFFFF7FFFBFF, 0xB800},
-	{0x40200000000001FF, 0x4800},
-	{0xC3CFFFFBFEFFFFFF, 0xFC00},
-	{0x40200000000000FF, 0x4800},
-	{0xC25094997A635E87, 0xFC00},
-	{0x402000000000007F, 0x4800},
-	{0x3F80000000000100, 0x2000},
-	{0x402000000000003F, 0x4800},
-	{0x7FF000000001FFE0, 0x7E00},
-	{0x402000000000001F, 0x4800},
-	{0x7FF000000001FDFF, 0x7E00},
-	{0x402000000000000F, 0x4800},
-	{0xC087C7AABFF425BA, 0xE1F2},
-	{0x4020000000000007, 0x4800},
-	{0x401FFFFFFF800800, 0x4800},
-	{0x4020000000000003, 0x4800},
-	{0x3EBFFFFF6FFFFFFF, 0x0020},
-	{0x4030000000000000, 0x4C00},
-	{0xAB9FFF0004000000, 0x8000},
-	{0x4030000000000001, 0x4C00},
-	{0xC3F10000FFFFFFFF, 0xFC00},
-	{0x4030000000000002, 0x4C00},
-	{0xC1DFFEFF00000000, 0xFC00},
-	{0x4030000000000004, 0x4C00},
-	{0x3EDFFFFE00000007, 0x0080},
-	{0x4030000000000008, 0x4C00},
-	{0xBF8FFFFE00080000, 0xA400},
-	{0x4030000000000010, 0x4C00},
-	{0x403FC000FFFFFFFE, 0x4FF0},
-	{0x4030000000000020, 0x4C00},
-	{0xDEA716793D580378, 0xFC00},
-	{0x4030000000000040, 0x4C00},
-	{0x47B4E9758F875F3A, 0x7C00},
-	{0x4030000000000080, 0x4C00},
-	{0xC3FFFFFDFFF7FFFF, 0xFC00},
-	{0x4030000000000100, 0x4C00},
-	{0xBFEFFFFFFF9FFFFE, 0xBC00},
-	{0x4030000000000200, 0x4C00},
-	{0x43496E91A3E36354, 0x7C00},
-	{0x4030000000000400, 0x4C00},
-	{0xFFFFFFF8001FFFFF, 0xFFFF},
-	{0x4030000000000800, 0x4C00},
-	{0x3FD0080001FFFFFF, 0x3402},
-	{0x4030000000001000, 0x4C00},
-	{0x43CFFFFFFFFFFFCF, 0x7C00},
-	{0x4030000000002000, 0x4C00},
-	{0x53C0020000003FFF, 0x7C00},
-	{0x4030000000004000, 0x4C00},
-	{0xC07DE2535300F672, 0xDF79},
-	{0x4030000000008000, 0x4C00},
-	{0x002D731C7CB90C01, 0x0000},
-	{0x4030000000010000, 0x4C00},
-	{0xC16FFFFFFC000000, 0xFC00},
-	{0x4030000000020000, 0x4C00},
-	{0x3FCFFFFF00000003, 0x3400},
-	{0x4030000000040000, 0x4C00},
-	{0xC15FFFEFFFFFFFFD, 0xFC00},
-	{0x4030000000080000, 0x4C00},
-	{0xFFF67C09A21DA926, 0xFF9F},
-	{0x4030000000100000, 0x4C00},
-	{0x0710000020000000, 0x0000},
-	{0x4030000000200000, 0x4C00},
-	{0x3FE3FEFFFFFFFFFF, 0x3900},
-	{0x4030000000400000, 0x4C00},
-	{0xBFD0000002008000, 0xB400},
-	{0x4030000000800000, 0x4C00},
-	{0xBFFFFFEFFFBFFFFE, 0xC000},
-	{0x4030000001000000, 0x4C00},
-	{0xC1D16076C0B00323, 0xFC00},
-	{0x4030000002000000, 0x4C00},
-	{0xC029207ED682DF03, 0xCA48},
-	{0x4030000004000000, 0x4C00},
-	{0x3D9FFFF7DFFFFFFE, 0x0000},
-	{0x4030000008000000, 0x4C00},
-	{0x3CA00000F7FFFFFF, 0x0000},
-	{0x4030000010000000, 0x4C00},
-	{0x7FDFFDFFFFEFFFFF, 0x7C00},
-	{0x4030000020000000, 0x4C00},
-	{0xB22FFFFFFFDFFFF0, 0x8000},
-	{0x4030000040000000, 0x4C00},
-	{0xC1C0004000007FFF, 0xFC00},
-	{0x4030000080000000, 0x4C00},
-	{0xC3C6E0F30C9AAA78, 0xFC00},
-	{0x4030000100000000, 0x4C00},
-	{0x780FFFEFFEFFFFFE, 0x7C00},
-	{0x4030000200000000, 0x4C00},
-	{0x40CFFFFFFE7FFFFE, 0x7400},
-	{0x4030000400000000, 0x4C00},
-	{0xBA2FFF0000000004, 0x8000},
-	{0x4030000800000000, 0x4C00},
-	{0xC1EFFFFFFEFFF7FE, 0xFC00},
-	{0x4030001000000000, 0x4C00},
-	{0xC03FFFDFFFDFFFFF, 0xD000},
-	{0x4030002000000000, 0x4C00},
-	{0x43C010000000000F, 0x7C00},
-	{0x4030004000000000, 0x4C00},
-	{0xC7E0000000000008, 0xFC00},
-	{0x4030008000000000, 0x4C00},
-	{0xC01EFFFDFFFFFFFE, 0xC7C0},
-	{0x4030010000000000, 0x4C00},
-	{0xC08EFFFFFC000000, 0xE3C0},
-	{0x4030020000000000, 0x4C00},
-	{0xFFD6A1E1F7E4FD11, 0xFC00},
-	{0x4030040000000000, 0x4C01},
-	{0xBDFFBFFFFFFFFFBF, 0x8000},
-	{0x4030080000000000, 0x4C02},
-	{0xBFB0000000000002, 0xAC00},
-	{0x4030100000000000, 0x4C04},
-	{0xBFCA7B0C530620DE, 0xB29F},
-	{0x4030200000000000, 0x4C08},
-	{0xC1DF020000000000, 0xFC00},
-	{0x4030400000000000, 0x4C10},
-	{0x43E00000403FFFFF, 0x7C00},
-	{0x4030800000000000, 0x4C20},
-	{0x4010040000000007, 0x4401},
-	{0x4031000000000000, 0x4C40},
-	{0x3FC2F1F7E68718A2, 0x30BC},
-	{0x4032000000000000, 0x4C80},
-	{0xB81FFFF00007FFFF, 0x8000},
-	{0x4034000000000000, 0x4D00},
-	{0xBCAE551BEC2A43F8, 0x8000},
-	{0x4038000000000000, 0x4E00},
-	{0xC3D0000008000006, 0xFC00},
-	{0x403C000000000000, 0x4F00},
-	{0xC1C103B0A189E9A9, 0xFC00},
-	{0x403E000000000000, 0x4F80},
-	{0xC03F39B33A7643C3, 0xCFCE},
-	{0x403F000000000000, 0x4FC0},
-	{0xC8000003FFFFF000, 0xFC00},
-	{0x403F800000000000, 0x4FE0},
-	{0x43D00100000007FE, 0x7C00},
-	{0x403FC00000000000, 0x4FF0},
-	{0x3FC2F0C4D25F82B7, 0x30BC},
-	{0x403FE00000000000, 0x4FF8},
-	{0xB3FE796A4EC55457, 0x8000},
-	{0x403FF00000000000, 0x4FFC},
-	{0x3B3FF000000FFFFF, 0x0000},
-	{0x403FF80000000000, 0x4FFE},
-	{0x3FBBFFFFFFEFFFFF, 0x2F00},
-	{0x403FFC0000000000, 0x4FFF},
-	{0xBECB49380D1966D6, 0x8037},
-	{0x403FFE0000000000, 0x5000},
-	{0x3CAFFF7BFFFFFFFF, 0x0000},
-	{0x403FFF0000000000, 0x5000},
-	{0x3CAFFFFF80001FFE, 0x0000},
-	{0x403FFF8000000000, 0x5000},
-	{0xBF87B22EE684C4FE, 0xA1ED},
-	{0x403FFFC000000000, 0x5000},
-	{0xC23000003FFFFC00, 0xFC00},
-	{0x403FFFE000000000, 0x5000},
-	{0xBFE0007FFFF00000, 0xB800},
-	{0x403FFFF000000000, 0x5000},
-	{0x400EFFDFFFFFFFFF, 0x43C0},
-	{0x403FFFF800000000, 0x5000},
-	{0xBFCF7FFFFFFDFFFF, 0xB3E0},
-	{0x403FFFFC00000000, 0x5000},
-	{0x4340FFFFFDFFFFFF, 0x7C00},
-	{0x403FFFFE00000000, 0x5000},
-	{0x3A92ABF7C9FB3F63, 0x0000},
-	{0x403FFFFF00000000, 0x5000},
-	{0x7FD0004000000010, 0x7C00},
-	{0x403FFFFF80000000, 0x5000},
-	{0x43F0D5466CBD9E7B, 0x7C00},
-	{0x403FFFFFC0000000, 0x5000},
-	{0x7FF0003FFFFFFFE0, 0x7E00},
-	{0x403FFFFFE0000000, 0x5000},
-	{0x380E128B41DFC274, 0x0000},
-	{0x403FFFFFF0000000, 0x5000},
-	{0x4F2000000001FC00, 0x7C00},
-	{0x403FFFFFF8000000, 0x5000},
-	{0xBE58000003FFFFFF, 0x8000},
-	{0x403FFFFFFC000000, 0x5000},
-	{0x3F6FFFFEFFFFFFFE, 0x1C00},
-	{0x403FFFFFFE000000, 0x5000},
-	{0xFFF0000002FFFFFF, 0xFE00},
-	{0x403FFFFFFF000000, 0x5000},
-	{0x3F300000000004FF, 0x0C00},
-	{0x403FFFFFFF800000, 0x5000},
-	{0xB52FFFDFFFFFF7FF, 0x8000},
-	{0x403FFFFFFFC00000, 0x5000},
-	{0x403FFFFFFFFDEFFE, 0x5000},
-	{0x403FFFFFFFE00000, 0x5000},
-	{0xC340FFFFFBFFFFFF, 0xFC00},
-	{0x403FFFFFFFF00000, 0x5000},
-	{0xC1CFFFFFFFFFF810, 0xFC00},
-	{0x403FFFFFFFF80000, 0x5000},
-	{0xC3FFFFFFFFF80000, 0xFC00},
-	{0x403FFFFFFFFC0000, 0x5000},
-	{0xEE38B20518129059, 0xFC00},
-	{0x403FFFFFFFFE0000, 0x5000},
-	{0xC2DDFFFFFFFDFFFF, 0xFC00},
-	{0x403FFFFFFFFF0000, 0x5000},
-	{0xC3C8E1FC91D7BE44, 0xFC00},
-	{0x403FFFFFFFFF8000, 0x5000},
-	{0xBFD00010000FFFFF, 0xB400},
-	{0x403FFFFFFFFFC000, 0x5000},
-	{0xC060800000000002, 0xD820},
-	{0x403FFFFFFFFFE000, 0x5000},
-	{0x2E21FFFFEFFFFFFF, 0x0000},
-	{0x403FFFFFFFFFF000, 0x5000},
-	{0x2AEFFFFFF800000F, 0x0000},
-	{0x403FFFFFFFFFF800, 0x5000},
-	{0x400FFFFFFDFFFFFB, 0x4400},
-	{0x403FFFFFFFFFFC00, 0x5000},
-	{0x418FFFFFFFEFFFE0, 0x7C00},
-	{0x403FFFFFFFFFFE00, 0x5000},
-	{0xC02000010000000F, 0xC800},
-	{0x403FFFFFFFFFFF00, 0x5000},
-	{0x3CEFC00000000080, 0x0000},
-	{0x403FFFFFFFFFFF80, 0x5000},
-	{0xBFCFC00000002000, 0xB3F0},
-	{0x403FFFFFFFFFFFC0, 0x5000},
-	{0xC059D25F092F9BFC, 0xD675},
-	{0x403FFFFFFFFFFFE0, 0x5000},
-	{0xBFF72950BDEDA5D4, 0xBDCA},
-	{0x403FFFFFFFFFFFF0, 0x5000},
-	{0x43FFFFFFFFFC3FFE, 0x7C00},
-	{0x403FFFFFFFFFFFF8, 0x5000},
-	{0xC1ECE59174B54297, 0xFC00},
-	{0x403FFFFFFFFFFFFC, 0x5000},
-	{0x802FDFFFF0000000, 0x8000},
-	{0x403FFFFFFFFFFFFE, 0x5000},
-	{0xFFEFFEFF7FFFFFFE, 0xFC00},
-	{0x403FFFFFFFFFFFFF, 0x5000},
-	{0xC1C5B3D212E98665, 0xFC00},
-	{0x403FFFFFFFFFFFFD, 0x5000},
-	{0xBFEFFFFF80FFFFFF, 0xBC00},
-	{0x403FFFFFFFFFFFFB, 0x5000},
-	{0x403FFFF800000200, 0x5000},
-	{0x403FFFFFFFFFFFF7, 0x5000},
-	{0xBFFE2B15803FA5DD, 0xBF8B},
-	{0x403FFFFFFFFFFFEF, 0x5000},
-	{0xC1DF07FFFFFFFFFF, 0xFC00},
-	{0x403FFFFFFFFFFFDF, 0x5000},
-	{0xBF7278AC76929108, 0x9C9E},
-	{0x403FFFFFFFFFFFBF, 0x5000},
-	{0xB7EFFFFFFFFBFFFA, 0x8000},
-	{0x403FFFFFFFFFFF7F, 0x5000},
-	{0x3FBD899039FBD9C0, 0x2F62},
-	{0x403FFFFFFFFFFEFF, 0x5000},
-	{0x403FFFDFFDFFFFFF, 0x5000},
-	{0x403FFFFFFFFFFDFF, 0x5000},
-	{0x404000000000005F, 0x5000},
-	{0x403FFFFFFFFFFBFF, 0x5000},
-	{0xBFBFFFC001FFFFFF, 0xB000},
-	{0x403FFFFFFFFFF7FF, 0x5000},
-	{0xBFEFFFF7FFFBFFFF, 0xBC00},
-	{0x403FFFFFFFFFEFFF, 0x5000},
-	{0x41DFFDFFFFFFFDFF, 0x7C00},
-	{0x403FFFFFFFFFDFFF, 0x5000},
-	{0xC7E400000000001E, 0xFC00},
-	{0x403FFFFFFFFFBFFF, 0x5000},
-	{0x3E9FC000000007FF, 0x0008},
-	{0x403FFFFFFFFF7FFF, 0x5000},
-	{0xC1F1A7B22EBA7401, 0xFC00},
-	{0x403FFFFFFFFEFFFF, 0x5000},
-	{0xC3FF0E0924A57F06, 0xFC00},
-	{0x403FFFFFFFFDFFFF, 0x5000},
-	{0x3DC0001000FFFFFE, 0x0000},
-	{0x403FFFFFFFFBFFFF, 0x5000},
-	{0xCF9005FFFFFFFFFE, 0xFC00},
-	{0x403FFFFFFFF7FFFF, 0x5000},
-	{0x7FDE000000100000, 0x7C00},
-	{0x403FFFFFFFEFFFFF, 0x5000},
-	{0xBCA00000003FFFF0, 0x8000},
-	{0x403FFFFFFFDFFFFF, 0x5000},
-	{0x400FE00000000080, 0x43F8},
-	{0x403FFFFFFFBFFFFF, 0x5000},
-	{0x3FC0001FFFFFF7FF, 0x3000},
-	{0x403FFFFFFF7FFFFF, 0x5000},
-	{0x41C0531997CF6177, 0x7C00},
-	{0x403FFFFFFEFFFFFF, 0x5000},
-	{0xC3DFFFFF0000003F, 0xFC00},
-	{0x403FFFFFFDFFFFFF, 0x5000},
-	{0x4020000004000001, 0x4800},
-	{0x403FFFFFFBFFFFFF, 0x5000},
-	{0x3CA00003FFFFF7FF, 0x0000},
-	{0x403FFFFFF7FFFFFF, 0x5000},
-	{0x3350001FFFFDFFFF, 0x0000},
-	{0x403FFFFFEFFFFFFF, 0x5000},
-	{0x4047FF8000000000, 0x5200},
-	{0x403FFFFFDFFFFFFF, 0x5000},
-	{0x480DE7D336D6B247, 0x7C00},
-	{0x403FFFFFBFFFFFFF, 0x5000},
-	{0xB8002000000003FF, 0x8000},
-	{0x403FFFFF7FFFFFFF, 0x5000},
-	{0x41300000100007FF, 0x7C00},
-	{0x403FFFFEFFFFFFFF, 0x5000},
-	{0x413007FFFFFFFFEF, 0x7C00},
-	{0x403FFFFDFFFFFFFF, 0x5000},
-	{0x3013F54DB764A03A, 0x0000},
-	{0x403FFFFBFFFFFFFF, 0x5000},
-	{0x407AC00C3B7C123A, 0x5EB0},
-	{0x403FFFF7FFFFFFFF, 0x5000},
-	{0x3FD0000010001000, 0x3400},
-	{0x403FFFEFFFFFFFFF, 0x5000},
-	{0xC1FFFBDFFFFFFFFF, 0xFC00},
-	{0x403FFFDFFFFFFFFF, 0x5000},
-	{0x400000007FFFFFFF, 0x4000},
-	{0x403FFFBFFFFFFFFF, 0x5000},
-	{0xC01451ED1CC5F238, 0xC514},
-	{0x403FFF7FFFFFFFFF, 0x5000},
-	{0x3C7FFFC03FFFFFFE, 0x0000},
-	{0x403FFEFFFFFFFFFF, 0x5000},
-	{0xE6E0043FFFFFFFFF, 0xFC00},
-	{0x403FFDFFFFFFFFFF, 0x4FFF},
-	{0xBFEFFFFFFEFF7FFF, 0xBC00},
-	{0x403FFBFFFFFFFFFF, 0x4FFF},
-	{0xBFC041FFFFFFFFFF, 0xB010},
-	{0x403FF7FFFFFFFFFF, 0x4FFE},
-	{0xBFDFFFFF7DFFFFFE, 0xB800},
-	{0x403FEFFFFFFFFFFF, 0x4FFC},
-	{0x402E00FFFFFFFFFE, 0x4B80},
-	{0x403FDFFFFFFFFFFF, 0x4FF8},
-	{0x41E2EC93AB0E70E5, 0x7C00},
-	{0x403FBFFFFFFFFFFF, 0x4FF0},
-	{0xBE480007FFFFFFFF, 0x8000},
-	{0x403F7FFFFFFFFFFF, 0x4FE0},
-	{0xCC807FFFFFFFFF7E, 0xFC00},
-	{0x403EFFFFFFFFFFFF, 0x4FC0},
-	{0xC0380FB9AE3320DC, 0xCE04},
-	{0x403DFFFFFFFFFFFF, 0x4F80},
-	{0xD2283ABC5248B102, 0xFC00},
-	{0x403BFFFFFFFFFFFF, 0x4F00},
-	{0xC7A0000000EFFFFF, 0xFC00},
-	{0x4037FFFFFFFFFFFF, 0x4E00},
-	{0xBFB0000000041FFF, 0xAC00},
-	{0x4033FFFFFFFFFFFF, 0x4D00},
-	{0xBF1B32F936D792B1, 0x86CD},
-	{0x4031FFFFFFFFFFFF, 0x4C80},
-	{0xC1100000FF7FFFFF, 0xFC00},
-	{0x4030FFFFFFFFFFFF, 0x4C40},
-	{0x400FFFFFFFF7FF00, 0x4400},
-	{0x40307FFFFFFFFFFF, 0x4C20},
-	{0xC370000000000000, 0xFC00},
-	{0x40303FFFFFFFFFFF, 0x4C10},
-	{0xC7EA11D28918F579, 0xFC00},
-	{0x40301FFFFFFFFFFF, 0x4C08},
-	{0x47FFFC0FFFFFFFFF, 0x7C00},
-	{0x40300FFFFFFFFFFF, 0x4C04},
-	{0xBF400010003FFFFF, 0x9000},
-	{0x403007FFFFFFFFFF, 0x4C02},
-	{0x4D2FFFFFFFDFF7FE, 0x7C00},
-	{0x403003FFFFFFFFFF, 0x4C01},
-	{0x41D1FFFFFFFFFE00, 0x7C00},
-	{0x403001FFFFFFFFFF, 0x4C00},
-	{0xB7F22405474099E2, 0x8000},
-	{0x403000FFFFFFFFFF, 0x4C00},
-	{0x3C240910A8D36F19, 0x0000},
-	{0x4030007FFFFFFFFF, 0x4C00},
-	{0x3FF000007FFFFF7F, 0x3C00},
-	{0x4030003FFFFFFFFF, 0x4C00},
-	{0x3602000000007FFF, 0x0000},
-	{0x4030001FFFFFFFFF, 0x4C00},
-	{0xC05000FFFFFFFBFE, 0xD400},
-	{0x4030000FFFFFFFFF, 0x4C00},
-	{0xB7EBFFFFFFFFE000, 0x8000},
-	{0x40300007FFFFFFFF, 0x4C00},
-	{0xC010001FBFFFFFFE, 0xC400},
-	{0x40300003FFFFFFFF, 0x4C00},
-	{0x002FFF7FFFFFFF7E, 0x0000},
-	{0x40300001FFFFFFFF, 0x4C00},
-	{0xBC200000008003FE, 0x8000},
-	{0x40300000FFFFFFFF, 0x4C00},
-	{0xC014000200000000, 0xC500},
-	{0x403000007FFFFFFF, 0x4C00},
-	{0xBFD6B2DEDDA2BCFA, 0xB5AD},
-	{0x403000003FFFFFFF, 0x4C00},
-	{0x40366FF3A91D5E80, 0x4D9C},
-	{0x403000001FFFFFFF, 0x4C00},
-	{0x75E00000004000FF, 0x7C00},
-	{0x403000000FFFFFFF, 0x4C00},
-	{0xC4E0000077FFFFFF, 0xFC00},
-	{0x4030000007FFFFFF, 0x4C00},
-	{0xBEB1EBA5861CC657, 0x8012},
-	{0x4030000003FFFFFF, 0x4C00},
-	{0x4057F783E2BFE110, 0x55FE},
-	{0x4030000001FFFFFF, 0x4C00},
-	{0x4140000001FFBFFE, 0x7C00},
-	{0x4030000000FFFFFF, 0x4C00},
-	{0xBFBEC0D38D5E44F4, 0xAFB0},
-	{0x40300000007FFFFF, 0x4C00},
-	{0x43DFFFFFFF801FFE, 0x7C00},
-	{0x40300000003FFFFF, 0x4C00},
-	{0xC3C64A8EA6C00CE8, 0xFC00},
-	{0x40300000001FFFFF, 0x4C00},
-	{0x7FD0000007FFFFBF, 0x7C00},
-	{0x40300000000FFFFF, 0x4C00},
-	{0x0003A5260CDBC940, 0x0000},
-	{0x403000000007FFFF, 0x4C00},
-	{0x307FFFFFFF7FE000, 0x0000},
-	{0x403000000003FFFF, 0x4C00},
-	{0x4000BF0EC831286D, 0x4030},
-	{0x403000000001FFFF, 0x4C00},
-	{0xBFE14E32D7B056CB, 0xB854},
-	{0x403000000000FFFF, 0x4C00},
-	{0x3F5FFFFFFFC04000, 0x1800},
-	{0x4030000000007FFF, 0x4C00},
-	{0x43DF000FFFFFFFFE, 0x7C00},
-	{0x4030000000003FFF, 0x4C00},
-	{0xBFC853A55A88D937, 0xB215},
-	{0x4030000000001FFF, 0x4C00},
-	{0x47E07FFFFEFFFFFE, 0x7C00},
-	{0x4030000000000FFF, 0x4C00},
-	{0x400FFFEFFFFFE000, 0x4400},
-	{0x40300000000007FF, 0x4C00},
-	{0x4D0000100000003E, 0x7C00},
-	{0x40300000000003FF, 0x4C00},
-	{0x40910618084193FD, 0x6442},
-	{0x40300000000001FF, 0x4C00},
-	{0x001C000000000007, 0x0000},
-	{0x40300000000000FF, 0x4C00},
-	{0xBE3F800020000000, 0x8000},
-	{0x403000000000007F, 0x4C00},
-	{0x3FB633313B4F9A1F, 0x2D8D},
-	{0x403000000000003F, 0x4C00},
-	{0x3FC0000000400003, 0x3000},
-	{0x403000000000001F, 0x4C00},
-	{0x367FFFFFFFF00007, 0x0000},
-	{0x403000000000000F, 0x4C00},
-	{0x40FFFFFFFF7F8000, 0x7C00},
-	{0x4030000000000007, 0x4C00},
-	{0x4410000000000000, 0x7C00},
-	{0x4030000000000003, 0x4C00},
-	{0x894FFFFFDFFFFDFE, 0x8000},
-	{0x41C0000000000000, 0x7C00},
-	{0xBFD0200000003FFF, 0xB408},
-	{0x41C0000000000001, 0x7C00},
-	{0xC01FFFFFE3FFFFFF, 0xC800},
-	{0x41C0000000000002, 0x7C00},
-	{0x4205E744628A79E2, 0x7C00},
-	{0x41C0000000000004, 0x7C00},
-	{0x7FFFF7FFFEFFFFFF, 0x7FFD},
-	{0x41C0000000000008, 0x7C00},
-	{0xC02000008001FFFF, 0xC800},
-	{0x41C0000000000010, 0x7C00},
-	{0xB0A000000003FFF7, 0x8000},
-	{0x41C0000000000020, 0x7C00},
-	{0xC8A0040000000040, 0xFC00},
-	{0x41C0000000000040, 0x7C00},
-	{0x3FB696B49D865E82, 0x2DA6},
-	{0x41C0000000000080, 0x7C00},
-	{0x4900000104000000, 0x7C00},
-	{0x41C0000000000100, 0x7C00},
-	{0x402FFFDFFFDFFFFE, 0x4C00},
-	{0x41C0000000000200, 0x7C00},
-	{0x3FDFFFFFFFFFEEFF, 0x3800},
-	{0x41C0000000000400, 0x7C00},
-	{0x40300FFFFFF7FFFE, 0x4C04},
-	{0x41C0000000000800, 0x7C00},
-	{0xB78FFFEFFFFFFBFE, 0x8000},
-	{0x41C0000000001000, 0x7C00},
-	{0xDA5FFFE007FFFFFF, 0xFC00},
-	{0x41C0000000002000, 0x7C00},
-	{0x3FBFFFDFFFFFFFFE, 0x3000},
-	{0x41C0000000004000, 0x7C00},
-	{0x3FCFFFFFFDFFFFE0, 0x3400},
-	{0x41C0000000008000, 0x7C00},
-	{0xBFAFF0007FFFFFFE, 0xABFC},
-	{0x41C0000000010000, 0x7C00},
-	{0x39E01FFEFFFFFFFE, 0x0000},
-	{0x41C0000000020000, 0x7C00},
-	{0x3D7C00000000001E, 0x0000},
-	{0x41C0000000040000, 0x7C00},
-	{0xC80100000000007F, 0xFC00},
-	{0x41C0000000080000, 0x7C00},
-	{0x4270233C7AB5AEAA, 0x7C00},
-	{0x41C0000000100000, 0x7C00},
-	{0xC02FFFFFFFFEFFEF, 0xCC00},
-	{0x41C0000000200000, 0x7C00},
-	{0x47F000000001FFBE, 0x7C00},
-	{0x41C0000000400000, 0x7C00},
-	{0xC098149BB62B30B7, 0xE605},
-	{0x41C0000000800000, 0x7C00},
-	{0x400E0000FFFFFFFF, 0x4380},
-	{0x41C0000001000000, 0x7C00},
-	{0x4906286301555616, 0x7C00},
-	{0x41C0000002000000, 0x7C00},
-	{0x56BFFFE000080000, 0x7C00},
-	{0x41C0000004000000, 0x7C00},
-	{0xC48080000001FFFE, 0xFC00},
-	{0x41C0000008000000, 0x7C00},
-	{0x3FEF7FFFFFFFF7FE, 0x3BE0},
-	{0x41C0000010000000, 0x7C00},
-	{0xB7ED76947A10AE3C, 0x8000},
-	{0x41C0000020000000, 0x7C00},
-	{0x43E6F1BF8C36D9A0, 0x7C00},
-	{0x41C0000040000000, 0x7C00},
-	{0xBCA00010003FFFFE, 0x8000},
-	{0x41C0000080000000, 0x7C00},
-	{0x41FFFFFFFFFFFFFE, 0x7C00},
-	{0x41C0000100000000, 0x7C00},
-	{0x562B1EDD993CFD1B, 0x7C00},
-	{0x41C0000200000000, 0x7C00},
-	{0xB2EF63DD7AB87E31, 0x8000},
-	{0x41C0000400000000, 0x7C00},
-	{0x41756972728499A8, 0x7C00},
-	{0x41C0000800000000, 0x7C00},
-	{0x43CFFDFFFFFFFF7F, 0x7C00},
-	{0x41C0001000000000, 0x7C00},
-	{0x3FE00000000000F6, 0x3800},
-	{0x41C0002000000000, 0x7C00},
-	{0xCA10800000000003, 0xFC00},
-	{0x41C0004000000000, 0x7C00},
-	{0x3FCFFF0000100000, 0x3400},
-	{0x41C0008000000000, 0x7C00},
-	{0xC1CFFFFFF000007F, 0xFC00},
-	{0x41C0010000000000, 0x7C00},
-	{0x1DB0000000040000, 0x0000},
-	{0x41C0020000000000, 0x7C00},
-	{0xC34BD3DF4075B55A, 0xFC00},
-	{0x41C0040000000000, 0x7C00},
-	{0x3FBFFFFFFFFF8001, 0x3000},
-	{0x41C0080000000000, 0x7C00},
-	{0xC80000000087FFFF, 0xFC00},
-	{0x41C0100000000000, 0x7C00},
-	{0xC7E0000000008FFE, 0xFC00},
-	{0x41C0200000000000, 0x7C00},
-	{0xBFD8D976BBA4A140, 0xB636},
-	{0x41C0400000000000, 0x7C00},
-	{0xC80000000000001C, 0xFC00},
-	{0x41C0800000000000, 0x7C00},
-	{0xC34FFFBFFFFF7FFE, 0xFC00},
-	{0x41C1000000000000, 0x7C00},
-	{0x3FDF7FFFFFFFDFFF, 0x37E0},
-	{0x41C2000000000000, 0x7C00},
-	{0x413FFFFFFFFFBFBE, 0x7C00},
-	{0x41C4000000000000, 0x7C00},
-	{0xBC0DFA5F7AD8B066, 0x8000},
-	{0x41C8000000000000, 0x7C00},
-	{0x405F00000000007F, 0x57C0},
-	{0x41CC000000000000, 0x7C00},
-	{0x400FFFFFFDFFFFF6, 0x4400},
-	{0x41CE000000000000, 0x7C00},
-	{0x7FD00001001FFFFE, 0x7C00},
-	{0x41CF000000000000, 0x7C00},
-	{0xBFA7FFFEFFFFFFFE, 0xAA00},
-	{0x41CF800000000000, 0x7C00},
-	{0xB814000040000000, 0x8000},
-	{0x41CFC00000000000, 0x7C00},
-	{0xC010001FFFBFFFFF, 0xC400},
-	{0x41CFE00000000000, 0x7C00},
-	{0x402FE0000001FFFF, 0x4BF8},
-	{0x41CFF00000000000, 0x7C00},
-	{0x2A8003BFFFFFFFFF, 0x0000},
-	{0x41CFF80000000000, 0x7C00},
-	{0x43FFFF80000FFFFF, 0x7C00},
-	{0x41CFFC0000000000, 0x7C00},
-	{0xC19000001FFC0000, 0xFC00},
-	{0x41CFFE0000000000, 0x7C00},
-	{0x5FF000003FFFFFBE, 0x7C00},
-	{0x41CFFF0000000000, 0x7C00},
-	{0x405FFFF800FFFFFE, 0x5800},
-	{0x41CFFF8000000000, 0x7C00},
-	{0xAADB942036D01FFD, 0x8000},
-	{0x41CFFFC000000000, 0x7C00},
-	{0x47F000003FFFFFFC, 0x7C00},
-	{0x41CFFFE000000000, 0x7C00},
-	{0xC34FFFFBFFFFFFFA, 0xFC00},
-	{0x41CFFFF000000000, 0x7C00},
-	{0x47F00000000017FE, 0x7C00},
-	{0x41CFFFF800000000, 0x7C00},
-	{0xC03FFFFFFEFC0000, 0xD000},
-	{0x41CFFFFC00000000, 0x7C00},
-	{0x480FFFF000000001, 0x7C00},
-	{0x41CFFFFE00000000, 0x7C00},
-	{0xB7FFFFFEFFFFFFDF, 0x8000},
-	{0x41CFFFFF00000000, 0x7C00},
-	{0x47F763DBF97D5DA4, 0x7C00},
-	{0x41CFFFFF80000000, 0x7C00},
-	{0xE1B0C64558F4A5E3, 0xFC00},
-	{0x41CFFFFFC0000000, 0x7C00},
-	{0xBFDFAF44D802C77D, 0xB7EC},
-	{0x41CFFFFFE0000000, 0x7C00},
-	{0x43FFFFFFE0000FFF, 0x7C00},
-	{0x41CFFFFFF0000000, 0x7C00},
-	{0x380DFD6E942C1DDB, 0x0000},
-	{0x41CFFFFFF8000000, 0x7C00},
-	{0xC341001FFFFFFFFF, 0xFC00},
-	{0x41CFFFFFFC000000, 0x7C00},
-	{0xDE6FC5C56C0398FF, 0xFC00},
-	{0x41CFFFFFFE000000, 0x7C00},
-	{0xC80FFFEC00000000, 0xFC00},
-	{0x41CFFFFFFF000000, 0x7C00},
-	{0x4340001000000000, 0x7C00},
-	{0x41CFFFFFFF800000, 0x7C00},
-	{0xC1C000001FFFF7FE, 0xFC00},
-	{0x41CFFFFFFFC00000, 0x7C00},
-	{0xC34002000000000F, 0xFC00},
-	{0x41CFFFFFFFE00000, 0x7C00},
-	{0xBFBD2F378F4B1A91, 0xAF4C},
-	{0x41CFFFFFFFF00000, 0x7C00},
-	{0xDAB7762F05DBD7EF, 0xFC00},
-	{0x41CFFFFFFFF80000, 0x7C00},
-	{0x3F4000007FFF7FFF, 0x1000},
-	{0x41CFFFFFFFFC0000, 0x7C00},
-	{0x43E8B63D5290B4BF, 0x7C00},
-	{0x41CFFFFFFFFE0000, 0x7C00},
-	{0x606EFEFFFFFFFFFF, 0x7C00},
-	{0x41CFFFFFFFFF0000, 0x7C00},
-	{0x423FFFFFFFFE1000, 0x7C00},
-	{0x41CFFFFFFFFF8000, 0x7C00},
-	{0xC020800000040000, 0xC820},
-	{0x41CFFFFFFFFFC000, 0x7C00},
-	{0x40B0FEFFFFFFFFFF, 0x6C40},
-	{0x41CFFFFFFFFFE000, 0x7C00},
-	{0x3FDFFF0000001000, 0x3800},
-	{0x41CFFFFFFFFFF000, 0x7C00},
-	{0x802FFE0800000000, 0x8000},
-	{0x41CFFFFFFFFFF800, 0x7C00},
-	{0xC030100FFFFFFFFF, 0xCC04},
-	{0x41CFFFFFFFFFFC00, 0x7C00},
-	{0x380FFFFFFBFFFF7F, 0x0000},
-	{0x41CFFFFFFFFFFE00, 0x7C00},
-	{0x79100000004003FF, 0x7C00},
-	{0x41CFFFFFFFFFFF00, 0x7C00},
-	{0xC1FFFFFFF0FFFFFE, 0xFC00},
-	{0x41CFFFFFFFFFFF80, 0x7C00},
-	{0xBFCBFFFFFFF00000, 0xB300},
-	{0x41CFFFFFFFFFFFC0, 0x7C00},
-	{0xBE347C0F47F05843, 0x8000},
-	{0x41CFFFFFFFFFFFE0, 0x7C00},
-	{0x801FFC00000001FE, 0x8000},
-	{0x41CFFFFFFFFFFFF0, 0x7C00},
-	{0x404000000000003E, 0x5000},
-	{0x41CFFFFFFFFFFFF8, 0x7C00},
-	{0xBFBFFFFFFFFF801F, 0xB000},
-	{0x41CFFFFFFFFFFFFC, 0x7C00},
-	{0xBFFFFBFFDFFFFFFF, 0xBFFF},
-	{0x41CFFFFFFFFFFFFE, 0x7C00},
-	{0xC01FC00FFFFFFFFF, 0xC7F0},
-	{0x41CFFFFFFFFFFFFF, 0x7C00},
-	{0x43C4229AFB4D351E, 0x7C00},
-	{0x41CFFFFFFFFFFFFD, 0x7C00},
-	{0x41D0080000004000, 0x7C00},
-	{0x41CFFFFFFFFFFFFB, 0x7C00},
-	{0x3FB00002001FFFFF, 0x2C00},
-	{0x41CFFFFFFFFFFFF7, 0x7C00},
-	{0xC03FFFFBEFFFFFFE, 0xD000},
-	{0x41CFFFFFFFFFFFEF, 0x7C00},
-	{0x37EA6EC76655CEEC, 0x0000},
-	{0x41CFFFFFFFFFFFDF, 0x7C00},
-	{0xC029EB58695205AD, 0xCA7B},
-	{0x41CFFFFFFFFFFFBF, 0x7C00},
-	{0xC02F14C8A56D8511, 0xCBC5},
-	{0x41CFFFFFFFFFFF7F, 0x7C00},
-	{0xBCAFFE000000FFFE, 0x8000},
-	{0x41CFFFFFFFFFFEFF, 0x7C00},
-	{0x3FE4D69C42F1B9F0, 0x3936},
-	{0x41CFFFFFFFFFFDFF, 0x7C00},
-	{0x41D00000007FDFFF, 0x7C00},
-	{0x41CFFFFFFFFFFBFF, 0x7C00},
-	{0xC03F7FFFFF7FFFFE, 0xCFE0},
-	{0x41CFFFFFFFFFF7FF, 0x7C00},
-	{0x41B152F9A19244A1, 0x7C00},
-	{0x41CFFFFFFFFFEFFF, 0x7C00},
-	{0x38F3BC05141D45EF, 0x0000},
-	{0x41CFFFFFFFFFDFFF, 0x7C00},
-	{0xC3EBDEC975EDC149, 0xFC00},
-	{0x41CFFFFFFFFFBFFF, 0x7C00},
-	{0x43C000800000000F, 0x7C00},
-	{0x41CFFFFFFFFF7FFF, 0x7C00},
-	{0x43FFFFFFFF87FFFE, 0x7C00},
-	{0x41CFFFFFFFFEFFFF, 0x7C00},
-	{0xBF3FFFFFFFFFFEF0, 0x9000},
-	{0x41CFFFFFFFFDFFFF, 0x7C00},
-	{0xB42FFFFFFBDFFFFE, 0x8000},
-	{0x41CFFFFFFFFBFFFF, 0x7C00},
-	{0xBFE000000000103F, 0xB800},
-	{0x41CFFFFFFFF7FFFF, 0x7C00},
-	{0x47EBFFFFFFBFFFFF, 0x7C00},
-	{0x41CFFFFFFFEFFFFF, 0x7C00},
-	{0x4007FFFFFFFFFF7F, 0x4200},
-	{0x41CFFFFFFFDFFFFF, 0x7C00},
-	{0x110000003FFFFDFF, 0x0000},
-	{0x41CFFFFFFFBFFFFF, 0x7C00},
-	{0xC7FFFFFFFFFF9FFF, 0xFC00},
-	{0x41CFFFFFFF7FFFFF, 0x7C00},
-	{0x4030000007FFFFBF, 0x4C00},
-	{0x41CFFFFFFEFFFFFF, 0x7C00},
-	{0x002CC5F062F3E857, 0x0000},
-	{0x41CFFFFFFDFFFFFF, 0x7C00},
-	{0xB80001FFFFFF7FFF, 0x8000},
-	{0x41CFFFFFFBFFFFFF, 0x7C00},
-	{0x4E6FFFFFFF7FFFDF, 0x7C00},
-	{0x41CFFFFFF7FFFFFF, 0x7C00},
-	{0xB804B24F74CDFC7F, 0x8000},
-	{0x41CFFFFFEFFFFFFF, 0x7C00},
-	{0xBF42D3EAC3144E90, 0x90B5},
-	{0x41CFFFFFDFFFFFFF, 0x7C00},
-	{0x6EFFFF7EFFFFFFFF, 0x7C00},
-	{0x41CFFFFFBFFFFFFF, 0x7C00},
-	{0x381424F62B872B6D, 0x0000},
-	{0x41CFFFFF7FFFFFFF, 0x7C00},
-	{0x7FEE266C2136C6B9, 0x7C00},
-	{0x41CFFFFEFFFFFFFF, 0x7C00},
-	{0xBFBFFFF7FEFFFFFF, 0xB000},
-	{0x41CFFFFDFFFFFFFF, 0x7C00},
-	{0xC034000020000000, 0xCD00},
-	{0x41CFFFFBFFFFFFFF, 0x7C00},
-	{0x402AE28D48EB9B55, 0x4AB9},
-	{0x41CFFFF7FFFFFFFF, 0x7C00},
-	{0xC1CFFFFEBFFFFFFF, 0xFC00},
-	{0x41CFFFEFFFFFFFFF, 0x7C00},
-	{0x309E5EA2FD840103, 0x0000},
-	{0x41CFFFDFFFFFFFFF, 0x7C00},
-	{0x4030000040000100, 0x4C00},
-	{0x41CFFFBFFFFFFFFF, 0x7C00},
-	{0x000FFF7FFF7FFFFE, 0x0000},
-	{0x41CFFF7FFFFFFFFF, 0x7C00},
-	{0xC1C0007FFFFFFFFD, 0xFC00},
-	{0x41CFFEFFFFFFFFFF, 0x7C00},
-	{0xB7F00000001FF7FF, 0x8000},
-	{0x41CFFDFFFFFFFFFF, 0x7C00},
-	{0x400B578D3314543B, 0x42D6},
-	{0x41CFFBFFFFFFFFFF, 0x7C00},
-	{0xC7E040000FFFFFFF, 0xFC00},
-	{0x41CFF7FFFFFFFFFF, 0x7C00},
-	{0x80167624385DB74A, 0x8000},
-	{0x41CFEFFFFFFFFFFF, 0x7C00},
-	{0x404FFF8000FFFFFF, 0x5400},
-	{0x41CFDFFFFFFFFFFF, 0x7C00},
-	{0x37F00000BFFFFFFF, 0x0000},
-	{0x41CFBFFFFFFFFFFF, 0x7C00},
-	{0xC18D944B74D77728, 0xFC00},
-	{0x41CF7FFFFFFFFFFF, 0x7C00},
-	{0xFFF00FF7FFFFFFFE, 0xFE03},
-	{0x41CEFFFFFFFFFFFF, 0x7C00},
-	{0x4340000804000000, 0x7C00},
-	{0x41CDFFFFFFFFFFFF, 0x7C00},
-	{0xC1E7EC024BAD2DB5, 0xFC00},
-	{0x41CBFFFFFFFFFFFF, 0x7C00},
-	{0xC0474B4CEF6133EE, 0xD1D3},
-	{0x41C7FFFFFFFFFFFF, 0x7C00},
-	{0xCC7FFFFFFFFFFCFF, 0xFC00},
-	{0x41C3FFFFFFFFFFFF, 0x7C00},
-	{0xAD50000000008040, 0x8000},
-	{0x41C1FFFFFFFFFFFF, 0x7C00},
-	{0xC03EFFF800000000, 0xCFC0},
-	{0x41C0FFFFFFFFFFFF, 0x7C00},
-	{0x45FFFF7FFFFFFFDE, 0x7C00},
-	{0x41C07FFFFFFFFFFF, 0x7C00},
-	{0xBFBFFFFFFEFFFFFC, 0xB000},
-	{0x41C03FFFFFFFFFFF, 0x7C00},
-	{0x394FFFFFFDFFC000, 0x0000},
-	{0x41C01FFFFFFFFFFF, 0x7C00},
-	{0xC7900004000FFFFF, 0xFC00},
-	{0x41C00FFFFFFFFFFF, 0x7C00},
-	{0x41500000002FFFFF, 0x7C00},
-	{0x41C007FFFFFFFFFF, 0x7C00},
-	{0xC3AF800001FFFFFF, 0xFC00},
-	{0x41C003FFFFFFFFFF, 0x7C00},
-	{0x41ED42112DC8C29E, 0x7C00},
-	{0x41C001FFFFFFFFFF, 0x7C00},
-	{0x41C0000000020003, 0x7C00},
-	{0x41C000FFFFFFFFFF, 0x7C00},
-	{0xC99080000000000F, 0xFC00},
-	{0x41C0007FFFFFFFFF, 0x7C00},
-	{0x8020000041FFFFFF, 0x8000},
-	{0x41C0003FFFFFFFFF, 0x7C00},
-	{0x43D7F80085C476BB, 0x7C00},
-	{0x41C0001FFFFFFFFF, 0x7C00},
-	{0xC1EFFFFC00000001, 0xFC00},
-	{0x41C0000FFFFFFFFF, 0x7C00},
-	{0xFFEFFFFDEFFFFFFF, 0xFC00},
-	{0x41C00007FFFFFFFF, 0x7C00},
-	{0x3FF07FFFFFFFBFFF, 0x3C20},
-	{0x41C00003FFFFFFFF, 0x7C00},
-	{0x4C0FFFDFFFFFFDFF, 0x7C00},
-	{0x41C00001FFFFFFFF, 0x7C00},
-	{0x3FCFFFFFFFFF8010, 0x3400},
-	{0x41C00000FFFFFFFF, 0x7C00},
-	{0x389755C85C83909F, 0x0000},
-	{0x41C000007FFFFFFF, 0x7C00},
-	{0x3FFFF800000007FF, 0x3FFE},
-	{0x41C000003FFFFFFF, 0x7C00},
-	{0xB1AEFFFFFFFFFFDF, 0x8000},
-	{0x41C000001FFFFFFF, 0x7C00},
-	{0xB70000000803FFFF, 0x8000},
-	{0x41C000000FFFFFFF, 0x7C00},
-	{0x38E20E31C0A9BCAF, 0x0000},
-	{0x41C0000007FFFFFF, 0x7C00},
-	{0xBFF000000007FFFB, 0xBC00},
-	{0x41C0000003FFFFFF, 0x7C00},
-	{0x3CA01FFF7FFFFFFE, 0x0000},
-	{0x41C0000001FFFFFF, 0x7C00},
-	{0xC3E3DB829BF51E78, 0xFC00},
-	{0x41C0000000FFFFFF, 0x7C00},
-	{0xC06FFFF000000000, 0xDC00},
-	{0x41C00000007FFFFF, 0x7C00},
-	{0x3F07F36C0264384A, 0x02FE},
-	{0x41C00000003FFFFF, 0x7C00},
-	{0x3FDFFF0000004000, 0x3800},
-	{0x41C00000001FFFFF, 0x7C00},
-	{0xCA6FFFFFFF07FFFF, 0xFC00},
-	{0x41C00000000FFFFF, 0x7C00},
-	{0x402800000007FFFF, 0x4A00},
-	{0x41C000000007FFFF, 0x7C00},
-	{0xFFE1AADE020321E3, 0xFC00},
-	{0x41C000000003FFFF, 0x7C00},
-	{0xC06E7A22AC475E41, 0xDB9F},
-	{0x41C000000001FFFF, 0x7C00},
-	{0xBFC1001FFFFFFFFF, 0xB040},
-	{0x41C000000000FFFF, 0x7C00},
-	{0x6AFFFFFFFFFFDFFE, 0x7C00},
-	{0x41C0000000007FFF, 0x7C00},
-	{0xC020000000013FFF, 0xC800},
-	{0x41C0000000003FFF, 0x7C00},
-	{0xC02FFFDFFEFFFFFF, 0xCC00},
-	{0x41C0000000001FFF, 0x7C00},
-	{0xC2395CC4793FFEFA, 0xFC00},
-	{0x41C0000000000FFF, 0x7C00},
-	{0x3FBFFEFFFFFFFFF7, 0x3000},
-	{0x41C00000000007FF, 0x7C00},
-	{0xC1F0003FFFFFFFFB, 0xFC00},
-	{0x41C00000000003FF, 0x7C00},
-	{0xBFC0001FFFFFFFFB, 0xB000},
-	{0x41C00000000001FF, 0x7C00},
-	{0xBFB0D2C606299064, 0xAC35},
-	{0x41C00000000000FF, 0x7C00},
-	{0x7FE69061CA1A6560, 0x7C00},
-	{0x41C000000000007F, 0x7C00},
-	{0x357000000040003F, 0x0000},
-	{0x41C000000000003F, 0x7C00},
-	{0xC3F0000000087FFE, 0xFC00},
-	{0x41C000000000001F, 0x7C00},
-	{0x401FFFFFFFFFFE03, 0x4800},
-	{0x41C000000000000F, 0x7C00},
-	{0xBF9FFFFFEFFFFFFC, 0xA800},
-	{0x41C0000000000007, 0x7C00},
-	{0x4038E375BD0A299C, 0x4E39},
-	{0x41C0000000000003, 0x7C00},
-	{0x3800A8E8721CA5FB, 0x0000},
-	{0x41D0000000000000, 0x7C00},
-	{0xC030004000003FFF, 0xCC00},
-	{0x41D0000000000001, 0x7C00},
-	{0xB81000003FFFF7FF, 0x8000},
-	{0x41D0000000000002, 0x7C00},
-	{0xC0EFFFC000000007, 0xFC00},
-	{0x41D0000000000004, 0x7C00},
-	{0x4010464ED583A8FF, 0x4412},
-	{0x41D0000000000008, 0x7C00},
-	{0x400FFFFFE00007FF, 0x4400},
-	{0x41D0000000000010, 0x7C00},
-	{0xC1EE4A2A5138C4D2, 0xFC00},
-	{0x41D0000000000020, 0x7C00},
-	{0xC0200001FEFFFFFF, 0xC800},
-	{0x41D0000000000040, 0x7C00},
-	{0xBF000009FFFFFFFE, 0x8200},
-	{0x41D0000000000080, 0x7C00},
-	{0x43CF605CA26BB208, 0x7C00},
-	{0x41D0000000000100, 0x7C00},
-	{0xC00B4EF3AB767FB8, 0xC2D4},
-	{0x41D0000000000200, 0x7C00},
-	{0x475000F800000000, 0x7C00},
-	{0x41D0000000000400, 0x7C00},
-	{0xBE54EFDA559ADE4A, 0x8000},
-	{0x41D0000000000800, 0x7C00},
-	{0x3FFFBDFFFFFFFFFF, 0x3FEF},
-	{0x41D0000000001000, 0x7C00},
-	{0x46FFF80400000000, 0x7C00},
-	{0x41D0000000002000, 0x7C00},
-	{0xBF9FFFFFEFFFFFFF, 0xA800},
-	{0x41D0000000004000, 0x7C00},
-	{0x400FEFFFFFFF7FFE, 0x43FC},
-	{0x41D0000000008000, 0x7C00},
-	{0xC80A9572319AA78E, 0xFC00},
-	{0x41D0000000010000, 0x7C00},
-	{0xBFCFFFC000000800, 0xB400},
-	{0x41D0000000020000, 0x7C00},
-	{0x4018000000007FFF, 0x4600},
-	{0x41D0000000040000, 0x7C00},
-	{0x3FC4FFFFFFFFFFFE, 0x3140},
-	{0x41D0000000080000, 0x7C00},
-	{0xC01FF80000200000, 0xC7FE},
-	{0x41D0000000100000, 0x7C00},
-	{0xC3C99AC611E21E9F, 0xFC00},
-	{0x41D0000000200000, 0x7C00},
-	{0xB58000020000001F, 0x8000},
-	{0x41D0000000400000, 0x7C00},
-	{0xC29040000000FFFE, 0xFC00},
-	{0x41D0000000800000, 0x7C00},
-	{0x43D918D06D9099C6, 0x7C00},
-	{0x41D0000001000000, 0x7C00},
-	{0xC2EFFFFFF7FFFFE0, 0xFC00},
-	{0x41D0000002000000, 0x7C00},
-	{0x47FC0000000007FE, 0x7C00},
-	{0x41D0000004000000, 0x7C00},
-	{0xBB2FFFFFFFFFFEF8, 0x8000},
-	{0x41D0000008000000, 0x7C00},
-	{0x3BF378F8B7C555C2, 0x0000},
-	{0x41D0000010000000, 0x7C00},
-	{0xC3EFF230948E3F5B, 0xFC00},
-	{0x41D0000020000000, 0x7C00},
-	{0xC04B84CA47DB1A1A, 0xD2E1},
-	{0x41D0000040000000, 0x7C00},
-	{0xBCAFC3FFFFFFFFFF, 0x8000},
-	{0x41D0000080000000, 0x7C00},
-	{0x403FFFFFFFFCFFFF, 0x5000},
-	{0x41D0000100000000, 0x7C00},
-	{0x3F98040000000000, 0x2601},
-	{0x41D0000200000000, 0x7C00},
-	{0x3FCB918A3D8B2635, 0x32E4},
-	{0x41D0000400000000, 0x7C00},
-	{0x4FB407FFFFFFFFFF, 0x7C00},
-	{0x41D0000800000000, 0x7C00},
-	{0x9A40000002008000, 0x8000},
-	{0x41D0001000000000, 0x7C00},
-	{0xA13FFFFFCFFFFFFF, 0x8000},
-	{0x41D0002000000000, 0x7C00},
-	{0x413FC00008000000, 0x7C00},
-	{0x41D0004000000000, 0x7C00},
-	{0xC0290E592F14B3A2, 0xCA44},
-	{0x41D0008000000000, 0x7C00},
-	{0x3FEFFFEFFFFFFFBF, 0x3C00},
-	{0x41D0010000000000, 0x7C00},
-	{0xC80F2AEB8364910C, 0xFC00},
-	{0x41D0020000000000, 0x7C00},
-	{0x4281000000001000, 0x7C00},
-	{0x41D0040000000000, 0x7C00},
-	{0x4650000200FFFFFF, 0x7C00},
-	{0x41D0080000000000, 0x7C00},
-	{0x421020003FFFFFFF, 0x7C00},
-	{0x41D0100000000000, 0x7C00},
-	{0x3FA0400000000080, 0x2810},
-	{0x41D0200000000000, 0x7C00},
-	{0x40A0000FFDFFFFFE, 0x6800},
-	{0x41D0400000000000, 0x7C00},
-	{0x43D0000000005000, 0x7C00},
-	{0x41D0800000000000, 0x7C00},
-	{0xC3E1ADA20429E0D1, 0xFC00},
-	{0x41D1000000000000, 0x7C00},
-	{0x80100000000FFFFE, 0x8000},
-	{0x41D2000000000000, 0x7C00},
-	{0xC34FBF7FFFFFFFFF, 0xFC00},
-	{0x41D4000000000000, 0x7C00},
-	{0x37E84C6BB58EA72B, 0x0000},
-	{0x41D8000000000000, 0x7C00},
-	{0xC028C4FA2FD53CE5, 0xCA31},
-	{0x41DC000000000000, 0x7C00},
-	{0x69AE84A76D5D612A, 0x7C00},
-	{0x41DE000000000000, 0x7C00},
-	{0xE6C3E3B592670966, 0xFC00},
-	{0x41DF000000000000, 0x7C00},
-	{0x80100001FFFFF800, 0x8000},
-	{0x41DF800000000000, 0x7C00},
-	{0x3EC00FFFFFFFFDFE, 0x0020},
-	{0x41DFC00000000000, 0x7C00},
-	{0x3CA0002000001FFF, 0x0000},
-	{0x41DFE00000000000, 0x7C00},
-	{0x00107FFFFFEFFFFF, 0x0000},
-	{0x41DFF00000000000, 0x7C00},
-	{0xC400000004200000, 0xFC00},
-	{0x41DFF80000000000, 0x7C00},
-	{0xB4B67C748CDCC12D, 0x8000},
-	{0x41DFFC0000000000, 0x7C00},
-	{0x434E6B395814DCFA, 0x7C00},
-	{0x41DFFE0000000000, 0x7C00},
-	{0x3FB51073C0A2CCBF, 0x2D44},
-	{0x41DFFF0000000000, 0x7C00},
-	{0x40103FFE00000000, 0x4410},
-	{0x41DFFF8000000000, 0x7C00},
-	{0x381FDFFFFFFFDFFE, 0x0000},
-	{0x41DFFFC000000000, 0x7C00},
-	{0xDCAFE0000007FFFE, 0xFC00},
-	{0x41DFFFE000000000, 0x7C00},
-	{0xC02FFFFF80FFFFFF, 0xCC00},
-	{0x41DFFFF000000000, 0x7C00},
-	{0x801CB3F10628E8FC, 0x8000},
-	{0x41DFFFF800000000, 0x7C00},
-	{0xBFC000002FFFFFFF, 0xB000},
-	{0x41DFFFFC00000000, 0x7C00},
-	{0x402FE0000000000F, 0x4BF8},
-	{0x41DFFFFE00000000, 0x7C00},
-	{0xFFDFFFFFC0008000, 0xFC00},
-	{0x41DFFFFF00000000, 0x7C00},
-	{0x0000200000FFFFFF, 0x0000},
-	{0x41DFFFFF80000000, 0x7C00},
-	{0xC1EFFF8000000800, 0xFC00},
-	{0x41DFFFFFC0000000, 0x7C00},
-	{0xC0000000087FFFFF, 0xC000},
-	{0x41DFFFFFE0000000, 0x7C00},
-	{0x3F52F018BD05E95D, 0x14BC},
-	{0x41DFFFFFF0000000, 0x7C00},
-	{0xB80288DF3630AAE5, 0x8000},
-	{0x41DFFFFFF8000000, 0x7C00},
-	{0xEE840FFFFFFFFFFF, 0xFC00},
-	{0x41DFFFFFFC000000, 0x7C00},
-	{0xB7F1000000004000, 0x8000},
-	{0x41DFFFFFFE000000, 0x7C00},
-	{0xC1E0000000000012, 0xFC00},
-	{0x41DFFFFFFF000000, 0x7C00},
-	{0x44F993A53DB505EF, 0x7C00},
-	{0x41DFFFFFFF800000, 0x7C00},
-	{0xFFF0000000000010, 0xFE00},
-	{0x41DFFFFFFFC00000, 0x7C00},
-	{0x40AFFFFFEFFFFFFF, 0x6C00},
-	{0x41DFFFFFFFE00000, 0x7C00},
-	{0xC7F000007FFFFFDE, 0xFC00},
-	{0x41DFFFFFFFF00000, 0x7C00},
-	{0xA5BFE7FFFFFFFFFE, 0x8000},
-	{0x41DFFFFFFFF80000, 0x7C00},
-	{0xBFA0F15F96E41728, 0xA83C},
-	{0x41DFFFFFFFFC0000, 0x7C00},
-	{0x3C10600000000000, 0x0000},
-	{0x41DFFFFFFFFE0000, 0x7C00},
-	{0xEC10000000000F00, 0xFC00},
-	{0x41DFFFFFFFFF0000, 0x7C00},
-	{0xC0677A16CAB6E7A6, 0xD9DF},
-	{0x41DFFFFFFFFF8000, 0x7C00},
-	{0x43C080000003FFFE, 0x7C00},
-	{0x41DFFFFFFFFFC000, 0x7C00},
-	{0x000000000000FDFF, 0x0000},
-	{0x41DFFFFFFFFFE000, 0x7C00},
-	{0xBC444EAA02C7B891, 0x8000},
-	{0x41DFFFFFFFFFF000, 0x7C00},
-	{0x37FF80003FFFFFFF, 0x0000},
-	{0x41DFFFFFFFFFF800, 0x7C00},
-	{0x3FDF00000000FFFF, 0x37C0},
-	{0x41DFFFFFFFFFFC00, 0x7C00},
-	{0x47F000000007FFDF, 0x7C00},
-	{0x41DFFFFFFFFFFE00, 0x7C00},
-	{0xC0000000001FFFFD, 0xC000},
-	{0x41DFFFFFFFFFFF00, 0x7C00},
-	{0xD69FFFFBFFFFFDFF, 0xFC00},
-	{0x41DFFFFFFFFFFF80, 0x7C00},
-	{0x80201FFFEFFFFFFF, 0x8000},
-	{0x41DFFFFFFFFFFFC0, 0x7C00},
-	{0xBFE7EFFFFFFFFFFF, 0xB9FC},
-	{0x41DFFFFFFFFFFFE0, 0x7C00},
-	{0xC1D0400007FFFFFF, 0xFC00},
-	{0x41DFFFFFFFFFFFF0, 0x7C00},
-	{0xC010803FFFFFFFFF, 0xC420},
-	{0x41DFFFFFFFFFFFF8, 0x7C00},
-	{0xFFEA727C3EF25D92, 0xFC00},
-	{0x41DFFFFFFFFFFFFC, 0x7C00},
-	{0xFFD000000003FF7E, 0xFC00},
-	{0x41DFFFFFFFFFFFFE, 0x7C00},
-	{0x43424DF6162673CB, 0x7C00},
-	{0x41DFFFFFFFFFFFFF, 0x7C00},
-	{0x47F7EC7DBC4E178E, 0x7C00},
-	{0x41DFFFFFFFFFFFFD, 0x7C00},
-	{0x2E4A2704B172D1B4, 0x0000},
-	{0x41DFFFFFFFFFFFFB, 0x7C00},
-	{0x56F0007FFFFFFFF7, 0x7C00},
-	{0x41DFFFFFFFFFFFF7, 0x7C00},
-	{0xC02FFFEFBFFFFFFF, 0xCC00},
-	{0x41DFFFFFFFFFFFEF, 0x7C00},
-	{0xDD9000000008001F, 0xFC00},
-	{0x41DFFFFFFFFFFFDF, 0x7C00},
-	{0xBFAF6D336F69B3B6, 0xABDB},
-	{0x41DFFFFFFFFFFFBF, 0x7C00},
-	{0xFFF2000003FFFFFF, 0xFE80},
-	{0x41DFFFFFFFFFFF7F, 0x7C00},
-	{0xC1E020FFFFFFFFFF, 0xFC00},
-	{0x41DFFFFFFFFFFEFF, 0x7C00},
-	{0xC1500000087FFFFE, 0xFC00},
-	{0x41DFFFFFFFFFFDFF, 0x7C00},
-	{0x387FFC003FFFFFFE, 0x0000},
-	{0x41DFFFFFFFFFFBFF, 0x7C00},
-	{0xCA7DA117ED4F500E, 0xFC00},
-	{0x41DFFFFFFFFFF7FF, 0x7C00},
-	{0x80000001F7FFFFFF, 0x8000},
-	{0x41DFFFFFFFFFEFFF, 0x7C00},
-	{0x002FFFFFFFFFF7FB, 0x0000},
-	{0x41DFFFFFFFFFDFFF, 0x7C00},
-	{0x3E6FF77FFFFFFFFE, 0x0001},
-	{0x41DFFFFFFFFFBFFF, 0x7C00},
-	{0x802000000003FEFF, 0x8000},
-	{0x41DFFFFFFFFF7FFF, 0x7C00},
-	{0x405FFFFFDFFFF7FE, 0x5800},
-	{0x41DFFFFFFFFEFFFF, 0x7C00},
-	{0xB7E888C9C705F21E, 0x8000},
-	{0x41DFFFFFFFFDFFFF, 0x7C00},
-	{0xC1CFFFD000000000, 0xFC00},
-	{0x41DFFFFFFFFBFFFF, 0x7C00},
-	{0xBC9BEAD51313D43F, 0x8000},
-	{0x41DFFFFFFFF7FFFF, 0x7C00},
-	{0xB9C201FFFFFFFFFE, 0x8000},
-	{0x41DFFFFFFFEFFFFF, 0x7C00},
-	{0xBFBFFFF1FFFFFFFF, 0xB000},
-	{0x41DFFFFFFFDFFFFF, 0x7C00},
-	{0xC1E4103B7EA76499, 0xFC00},
-	{0x41DFFFFFFFBFFFFF, 0x7C00},
-	{0x40100001FFFF7FFF, 0x4400},
-	{0x41DFFFFFFF7FFFFF, 0x7C00},
-	{0x437FFFFFFFFFFFFF, 0x7C00},
-	{0x41DFFFFFFEFFFFFF, 0x7C00},
-	{0xBFE0C60B16743F09, 0xB832},
-	{0x41DFFFFFFDFFFFFF, 0x7C00},
-	{0x401FFFFFC000007F, 0x4800},
-	{0x41DFFFFFFBFFFFFF, 0x7C00},
-	{0xC01000000007F7FF, 0xC400},
-	{0x41DFFFFFF7FFFFFF, 0x7C00},
-	{0x909FF80003FFFFFE, 0x8000},
-	{0x41DFFFFFEFFFFFFF, 0x7C00},
-	{0x3ED007FFFFFFFFFF, 0x0040},
-	{0x41DFFFFFDFFFFFFF, 0x7C00},
-	{0x3060000000FFFFFA, 0x0000},
-	{0x41DFFFFFBFFFFFFF, 0x7C00},
-	{0xBF3003FFFFFFFEFE, 0x8C01},
-	{0x41DFFFFF7FFFFFFF, 0x7C00},
-	{0x62FC238C6EF72060, 0x7C00},
-	{0x41DFFFFEFFFFFFFF, 0x7C00},
-	{0x3FE264DBB15B9D62, 0x3899},
-	{0x41DFFFFDFFFFFFFF, 0x7C00},
-	{0x43C00003FE000000, 0x7C00},
-	{0x41DFFFFBFFFFFFFF, 0x7C00},
-	{0xBFE10000000FFFFF, 0xB840},
-	{0x41DFFFF7FFFFFFFF, 0x7C00},
-	{0xBFA53A678955E737, 0xA94F},
-	{0x41DFFFEFFFFFFFFF, 0x7C00},
-	{0x47EFFFFF80000000, 0x7C00},
-	{0x41DFFFDFFFFFFFFF, 0x7C00},
-	{0xC57FFE0004000000, 0xFC00},
-	{0x41DFFFBFFFFFFFFF, 0x7C00},
-	{0x802B1193A2353FCB, 0x8000},
-	{0x41DFFF7FFFFFFFFF, 0x7C00},
-	{0xC124E0FA0FB840DE, 0xFC00},
-	{0x41DFFEFFFFFFFFFF, 0x7C00},
-	{0xC7EFFFFFFFFFEFDE, 0xFC00},
-	{0x41DFFDFFFFFFFFFF, 0x7C00},
-	{0x4190000000003BFE, 0x7C00},
-	{0x41DFFBFFFFFFFFFF, 0x7C00},
-	{0xC030000043FFFFFE, 0xCC00},
-	{0x41DFF7FFFFFFFFFF, 0x7C00},
-	{0x4580000000FFFFFE, 0x7C00},
-	{0x41DFEFFFFFFFFFFF, 0x7C00},
-	{0x39400000000FFFFF, 0x0000},
-	{0x41DFDFFFFFFFFFFF, 0x7C00},
-	{0xC01E87329C74F49F, 0xC7A2},
-	{0x41DFBFFFFFFFFFFF, 0x7C00},
-	{0xC0B00FFFFFFFFF7F, 0xEC04},
-	{0x41DF7FFFFFFFFFFF, 0x7C00},
-	{0x41C0001003FFFFFF, 0x7C00},
-	{0x41DEFFFFFFFFFFFF, 0x7C00},
-	{0xC0BFFFC001FFFFFF, 0xF000},
-	{0x41DDFFFFFFFFFFFF, 0x7C00},
-	{0xCB67B1322B940FAF, 0xFC00},
-	{0x41DBFFFFFFFFFFFF, 0x7C00},
-	{0x434000000100000F, 0x7C00},
-	{0x41D7FFFFFFFFFFFF, 0x7C00},
-	{0x403FF8000000007F, 0x4FFE},
-	{0x41D3FFFFFFFFFFFF, 0x7C00},
-	{0xB80E2D9CE496EF32, 0x8000},
-	{0x41D1FFFFFFFFFFFF, 0x7C00},
-	{0x43400000203FFFFE, 0x7C00},
-	{0x41D0FFFFFFFFFFFF, 0x7C00},
-	{0xBCA59A4C491230D6, 0x8000},
-	{0x41D07FFFFFFFFFFF, 0x7C00},
-	{0xC130000FFFFFFFDF, 0xFC00},
-	{0x41D03FFFFFFFFFFF, 0x7C00},
-	{0x3B9000002001FFFF, 0x0000},
-	{0x41D01FFFFFFFFFFF, 0x7C00},
-	{0xB74FFFFBDFFFFFFF, 0x8000},
-	{0x41D00FFFFFFFFFFF, 0x7C00},
-	{0x37FFFFFFFF000FFF, 0x0000},
-	{0x41D007FFFFFFFFFF, 0x7C00},
-	{0xB7F00000000001FE, 0x8000},
-	{0x41D003FFFFFFFFFF, 0x7C00},
-	{0x600000000000008F, 0x7C00},
-	{0x41D001FFFFFFFFFF, 0x7C00},
-	{0xE77FFFFFF7F7FFFF, 0xFC00},
-	{0x41D000FFFFFFFFFF, 0x7C00},
-	{0xB8000000000002FE, 0x8000},
-	{0x41D0007FFFFFFFFF, 0x7C00},
-	{0xBFB02246CF2B3C58, 0xAC09},
-	{0x41D0003FFFFFFFFF, 0x7C00},
-	{0xBFC000FFDFFFFFFF, 0xB000},
-	{0x41D0001FFFFFFFFF, 0x7C00},
-	{0x41F0007FFFBFFFFE, 0x7C00},
-	{0x41D0000FFFFFFFFF, 0x7C00},
-	{0xC340FF8000000000, 0xFC00},
-	{0x41D00007FFFFFFFF, 0x7C00},
-	{0xC3FFFFBFFFFFFFFA, 0xFC00},
-	{0x41D00003FFFFFFFF, 0x7C00},
-	{0xC3F9359C23DB7A34, 0xFC00},
-	{0x41D00001FFFFFFFF, 0x7C00},
-	{0x3FCFFFFEFFDFFFFE, 0x3400},
-	{0x41D00000FFFFFFFF, 0x7C00},
-	{0x0026E7269C087305, 0x0000},
-	{0x41D000007FFFFFFF, 0x7C00},
-	{0xC00FFC03FFFFFFFF, 0xC3FF},
-	{0x41D000003FFFFFFF, 0x7C00},
-	{0x9FE00000001FFBFF, 0x8000},
-	{0x41D000001FFFFFFF, 0x7C00},
-	{0x801FFF7FFFFFFF7F, 0x8000},
-	{0x41D000000FFFFFFF, 0x7C00},
-	{0xB7EFBC0000000000, 0x8000},
-	{0x41D0000007FFFFFF, 0x7C00},
-	{0xCC8FFFFFFFFFEFFE, 0xFC00},
-	{0x41D0000003FFFFFF, 0x7C00},
-	{0x3A487D0BD32D4DB5, 0x0000},
-	{0x41D0000001FFFFFF, 0x7C00},
-	{0x400FBBFFFFFFFFFE, 0x43EF},
-	{0x41D0000000FFFFFF, 0x7C00},
-	{0x4340200000000002, 0x7C00},
-	{0x41D00000007FFFFF, 0x7C00},
-	{0x3F1000000403FFFE, 0x0400},
-	{0x41D00000003FFFFF, 0x7C00},
-	{0x380FFFFF80000FFF, 0x0000},
-	{0x41D00000001FFFFF, 0x7C00},
-	{0x402B7A7D0F4DBEF0, 0x4ADF},
-	{0x41D00000000FFFFF, 0x7C00},
-	{0x3F8A89EB0C93E6E3, 0x22A2},
-	{0x41D000000007FFFF, 0x7C00},
-	{0x55C1FFFC00000000, 0x7C00},
-	{0x41D000000003FFFF, 0x7C00},
-	{0xBFF69B1886C0FB97, 0xBDA7},
-	{0x41D000000001FFFF, 0x7C00},
-	{0x40000000001DFFFF, 0x4000},
-	{0x41D000000000FFFF, 0x7C00},
-	{0xC1F07FFFFFFFFFF0, 0xFC00},
-	{0x41D0000000007FFF, 0x7C00},
-	{0x219800000003FFFF, 0x0000},
-	{0x41D0000000003FFF, 0x7C00},
-	{0x3E8FFC000001FFFF, 0x0004},
-	{0x41D0000000001FFF, 0x7C00},
-	{0xC1FFFFFFFF7EFFFF, 0xFC00},
-	{0x41D0000000000FFF, 0x7C00},
-	{0x381F7FFBFFFFFFFF, 0x0000},
-	{0x41D00000000007FF, 0x7C00},
-	{0x3FA0007FFFFFFBFF, 0x2800},
-	{0x41D00000000003FF, 0x7C00},
-	{0xC1DFFFFFFFFFFF6F, 0xFC00},
-	{0x41D00000000001FF, 0x7C00},
-	{0x3ED003FFFFBFFFFF, 0x0040},
-	{0x41D00000000000FF, 0x7C00},
-	{0xBDA07FFFFFFFFFDF, 0x8000},
-	{0x41D000000000007F, 0x7C00},
-	{0xC02FFFFFFFF7FFFA, 0xCC00},
-	{0x41D000000000003F, 0x7C00},
-	{0xC3CFFFFFFF003FFF, 0xFC00},
-	{0x41D000000000001F, 0x7C00},
-	{0x43C0000400000008, 0x7C00},
-	{0x41D000000000000F, 0x7C00},
-	{0x3FEFFFFFBFFFBFFE, 0x3C00},
-	{0x41D0000000000007, 0x7C00},
-	{0x37E000000000003C, 0x0000},
-	{0x41D0000000000003, 0x7C00},
-	{0x41E6EF801EDD5B9B, 0x7C00},
-	{0x41E0000000000000, 0x7C00},
-	{0x47E53F12A43F7BD2, 0x7C00},
-	{0x41E0000000000001, 0x7C00},
-	{0x43E000007FEFFFFF, 0x7C00},
-	{0x41E0000000000002, 0x7C00},
-	{0x30A06ED3C2175284, 0x0000},
-	{0x41E0000000000004, 0x7C00},
-	{0xD2AFFFFFFFFFFFFE, 0xFC00},
-	{0x41E0000000000008, 0x7C00},
-	{0x381DFFFFFFFC0000, 0x0000},
-	{0x41E0000000000010, 0x7C00},
-	{0x3FDFFFFFFFFFFFF2, 0x3800},
-	{0x41E0000000000020, 0x7C00},
-	{0x38000001FFFFFEFF, 0x0000},
-	{0x41E0000000000040, 0x7C00},
-	{0x380F8C3C849340D4, 0x0000},
-	{0x41E0000000000080, 0x7C00},
-	{0xFFF0889B3D253441, 0xFE22},
-	{0x41E0000000000100, 0x7C00},
-	{0xC1FFF7FFFFE00000, 0xFC00},
-	{0x41E0000000000200, 0x7C00},
-	{0xB52000BFFFFFFFFE, 0x8000},
-	{0x41E0000000000400, 0x7C00},
-	{0xB99C38B93F362449, 0x8000},
-	{0x41E0000000000800, 0x7C00},
-	{0x40A000000000005E, 0x6800},
-	{0x41E0000000001000, 0x7C00},
-	{0x3FF00407FFFFFFFF, 0x3C01},
-	{0x41E0000000002000, 0x7C00},
-	{0xB818E242C8EA5065, 0x8000},
-	{0x41E0000000004000, 0x7C00},
-	{0xBFFFEFDFFFFFFFFF, 0xBFFC},
-	{0x41E0000000008000, 0x7C00},
-	{0x43D1020000000000, 0x7C00},
-	{0x41E0000000010000, 0x7C00},
-	{0x3FFF8000003FFFFF, 0x3FE0},
-	{0x41E0000000020000, 0x7C00},
-	{0xBFE9AA1E80658E3F, 0xBA6B},
-	{0x41E0000000040000, 0x7C00},
-	{0x4138FB83E7482654, 0x7C00},
-	{0x41E0000000080000, 0x7C00},
-	{0xA01FFFFBFFFFFFFE, 0x8000},
-	{0x41E0000000100000, 0x7C00},
-	{0x3BFFFF7FF7FFFFFF, 0x0000},
-	{0x41E0000000200000, 0x7C00},
-	{0xBF30000000002FFE, 0x8C00},
-	{0x41E0000000400000, 0x7C00},
-	{0xC34FFFFF7FFFFFDE, 0xFC00},
-	{0x41E0000000800000, 0x7C00},
-	{0xA170000000001FEE, 0x8000},
-	{0x41E0000001000000, 0x7C00},
-	{0x3FFFE00000008000, 0x3FF8},
-	{0x41E0000002000000, 0x7C00},
-	{0xC18B72FD2103BCAA, 0xFC00},
-	{0x41E0000004000000, 0x7C00},
-	{0xBF8A4C25BD0B7FFE, 0xA293},
-	{0x41E0000008000000, 0x7C00},
-	{0x3F7FA15F431CEDBF, 0x1FE8},
-	{0x41E0000010000000, 0x7C00},
-	{0xBEBFFFFC00FFFFFF, 0x8020},
-	{0x41E0000020000000, 0x7C00},
-	{0x47EFFFFFFEFFFF7F, 0x7C00},
-	{0x41E0000040000000, 0x7C00},
-	{0x9CDFFFF0007FFFFF, 0x8000},
-	{0x41E0000080000000, 0x7C00},
-	{0x8014FFFFFFFFFFFF, 0x8000},
-	{0x41E0000100000000, 0x7C00},
-	{0x43ED6568ECB25BAB, 0x7C00},
-	{0x41E0000200000000, 0x7C00},
-	{0xAFAFFFFFFFFFC004, 0x8000},
-	{0x41E0000400000000, 0x7C00},
-	{0xC7F80001FFFFFFFF, 0xFC00},
-	{0x41E0000800000000, 0x7C00},
-	{0xBFF0000003FDFFFE, 0xBC00},
-	{0x41E0001000000000, 0x7C00},
-	{0xFFD000000000FFF7, 0xFC00},
-	{0x41E0002000000000, 0x7C00},
-	{0x3FADFFFDFFFFFFFF, 0x2B80},
-	{0x41E0004000000000, 0x7C00},
-	{0xB7EFBFFFFFFFFFFC, 0x8000},
-	{0x41E0008000000000, 0x7C00},
-	{0x3E30000200000007, 0x0000},
-	{0x41E0010000000000, 0x7C00},
-	{0x41D4A588BCDCB209, 0x7C00},
-	{0x41E0020000000000, 0x7C00},
-	{0x3FCF222D8F458C71, 0x33C9},
-	{0x41E0040000000000, 0x7C00},
-	{0x3FFADF3F77504534, 0x3EB8},
-	{0x41E0080000000000, 0x7C00},
-	{0xBEDFFFFFFE000002, 0x8080},
-	{0x41E0100000000000, 0x7C00},
-	{0xFFF807FFFFFFFFFF, 0xFE01},
-	{0x41E0200000000000, 0x7C00},
-	{0x43C86077990B13DB, 0x7C00},
-	{0x41E0400000000000, 0x7C00},
-	{0xC7FFFFFFFFFEFFFF, 0xFC00},
-	{0x41E0800000000000, 0x7C00},
-	{0x3E6FFF03FFFFFFFE, 0x0001},
-	{0x41E1000000000000, 0x7C00},
-	{0xBFE200000000003F, 0xB880},
-	{0x41E2000000000000, 0x7C00},
-	{0x3FEFFDFFFFEFFFFF, 0x3BFF},
-	{0x41E4000000000000, 0x7C00},
-	{0x40002FFFFFFFFFFE, 0x400C},
-	{0x41E8000000000000, 0x7C00},
-	{0x802003FFFDFFFFFF, 0x8000},
-	{0x41EC000000000000, 0x7C00},
-	{0xA72000003FFFFBFF, 0x8000},
-	{0x41EE000000000000, 0x7C00},
-	{0xC00FFC0000002000, 0xC3FF},
-	{0x41EF000000000000, 0x7C00},
-	{0x34F0003FFFFFDFFF, 0x0000},
-	{0x41EF800000000000, 0x7C00},
-	{0x47F00000001FFEFF, 0x7C00},
-	{0x41EFC00000000000, 0x7C00},
-	{0x802000200003FFFF, 0x8000},
-	{0x41EFE00000000000, 0x7C00},
-	{0x380073F29DE9C09D, 0x0000},
-	{0x41EFF00000000000, 0x7C00},
-	{0x09BFFFFFFFFF801E, 0x0000},
-	{0x41EFF80000000000, 0x7C00},
-	{0x4114C3CDE7D10137, 0x7C00},
-	{0x41EFFC0000000000, 0x7C00},
-	{0xB81000FFFE000000, 0x8000},
-	{0x41EFFE0000000000, 0x7C00},
-	{0x3A0C083430FB4FFE, 0x0000},
-	{0x41EFFF0000000000, 0x7C00},
-	{0xBFFFFBFFFFFFC000, 0xBFFF},
-	{0x41EFFF8000000000, 0x7C00},
-	{0x3FC003FFFFF00000, 0x3001},
-	{0x41EFFFC000000000, 0x7C00},
-	{0xC000000000000220, 0xC000},
-	{0x41EFFFE000000000, 0x7C00},
-	{0xC14C000000080000, 0xFC00},
-	{0x41EFFFF000000000, 0x7C00},
-	{0xCDB007FFFFFFFF80, 0xFC00},
-	{0x41EFFFF800000000, 0x7C00},
-	{0x8AC0000010000FFF, 0x8000},
-	{0x41EFFFFC00000000, 0x7C00},
-	{0x6CAFFFFFFEFFFFFC, 0x7C00},
-	{0x41EFFFFE00000000, 0x7C00},
-	{0x41C010000000000F, 0x7C00},
-	{0x41EFFFFF00000000, 0x7C00},
-	{0xBF86D31142A77EAD, 0xA1B5},
-	{0x41EFFFFF80000000, 0x7C00},
-	{0xFFEFBFFFFFFFE000, 0xFC00},
-	{0x41EFFFFFC0000000, 0x7C00},
-	{0xB97FFFFBFFFFFFC0, 0x8000},
-	{0x41EFFFFFE0000000, 0x7C00},
-	{0x3FD0100000FFFFFF, 0x3404},
-	{0x41EFFFFFF0000000, 0x7C00},
-	{0x8010000000006000, 0x8000},
-	{0x41EFFFFFF8000000, 0x7C00},
-	{0x41EFFFFEFFFFFFFC, 0x7C00},
-	{0x41EFFFFFFC000000, 0x7C00},
-	{0x3F4002000000FFFF, 0x1001},
-	{0x41EFFFFFFE000000, 0x7C00},
-	{0x43CFFDFFFFBFFFFF, 0x7C00},
-	{0x41EFFFFFFF000000, 0x7C00},
-	{0x0000FFFFFC000000, 0x0000},
-	{0x41EFFFFFFF800000, 0x7C00},
-	{0xC3C69D924137D599, 0xFC00},
-	{0x41EFFFFFFFC00000, 0x7C00},
-	{0x4260000007EFFFFF, 0x7C00},
-	{0x41EFFFFFFFE00000, 0x7C00},
-	{0x4002145AB8D8FE2A, 0x4085},
-	{0x41EFFFFFFFF00000, 0x7C00},
-	{0xB0500207FFFFFFFF, 0x8000},
-	{0x41EFFFFFFFF80000, 0x7C00},
-	{0x44C1000000000000, 0x7C00},
-	{0x41EFFFFFFFFC0000, 0x7C00},
-	{0x40350B3458C6F6BF, 0x4D43},
-	{0x41EFFFFFFFFE0000, 0x7C00},
-	{0x43CFFF5FFFFFFFFF, 0x7C00},
-	{0x41EFFFFFFFFF0000, 0x7C00},
-	{0xBF6FFFFFFFE0001F, 0x9C00},
-	{0x41EFFFFFFFFF8000, 0x7C00},
-	{0x47FF2F9D2281DC05, 0x7C00},
-	{0x41EFFFFFFFFFC000, 0x7C00},
-	{0x381FFFFFFFFFE0FE, 0x0000},
-	{0x41EFFFFFFFFFE000, 0x7C00},
-	{0xB80FFDFFF8000000, 0x8000},
-	{0x41EFFFFFFFFFF000, 0x7C00},
-	{0x87E5BE61B590A696, 0x8000},
-	{0x41EFFFFFFFFFF800, 0x7C00},
-	{0x41DEFFFFFFBFFFFF, 0x7C00},
-	{0x41EFFFFFFFFFFC00, 0x7C00},
-	{0xC00FFFFFC0000FFF, 0xC400},
-	{0x41EFFFFFFFFFFE00, 0x7C00},
-	{0x348FFFFFFFFFF000, 0x0000},
-	{0x41EFFFFFFFFFFF00, 0x7C00},
-	{0x408716C04A5A3871, 0x61C6},
-	{0x41EFFFFFFFFFFF80, 0x7C00},
-	{0x7FD3022D1B4E2B88, 0x7C00},
-	{0x41EFFFFFFFFFFFC0, 0x7C00},
-	{0xBFC0000000000070, 0xB000},
-	{0x41EFFFFFFFFFFFE0, 0x7C00},
-	{0x3AB00077FFFFFFFF, 0x0000},
-	{0x41EFFFFFFFFFFFF0, 0x7C00},
-	{0x402FFFFFFFFFDFFF, 0x4C00},
-	{0x41EFFFFFFFFFFFF8, 0x7C00},
-	{0x43C4CF283EC0BDEC, 0x7C00},
-	{0x41EFFFFFFFFFFFFC, 0x7C00},
-	{0x801FFDFFFFFFFFFE, 0x8000},
-	{0x41EFFFFFFFFFFFFE, 0x7C00},
-	{0xBFEFFEFFFBFFFFFF, 0xBC00},
-	{0x41EFFFFFFFFFFFFF, 0x7C00},
-	{0xBFDC0000000001FF, 0xB700},
-	{0x41EFFFFFFFFFFFFD, 0x7C00},
-	{0xBFCFFFFFDBFFFFFF, 0xB400},
-	{0x41EFFFFFFFFFFFFB, 0x7C00},
-	{0xC1C0007FFFFDFFFF, 0xFC00},
-	{0x41EFFFFFFFFFFFF7, 0x7C00},
-	{0xBCEE2D1FA661AC8A, 0x8000},
-	{0x41EFFFFFFFFFFFEF, 0x7C00},
-	{0xC090803FFFFFFFFF, 0xE420},
-	{0x41EFFFFFFFFFFFDF, 0x7C00},
-	{0x37F0000007FFFFEF, 0x0000},
-	{0x41EFFFFFFFFFFFBF, 0x7C00},
-	{0xBCD5131D3070EED1, 0x8000},
-	{0x41EFFFFFFFFFFF7F, 0x7C00},
-	{0xBCAFFFBFFFFDFFFF, 0x8000},
-	{0x41EFFFFFFFFFFEFF, 0x7C00},
-	{0xC5F0000000000FFE, 0xFC00},
-	{0x41EFFFFFFFFFFDFF, 0x7C00},
-	{0xC7F04000000007FE, 0xFC00},
-	{0x41EFFFFFFFFFFBFF, 0x7C00},
-	{0x393FFFFFFFFBFFFF, 0x0000},
-	{0x41EFFFFFFFFFF7FF, 0x7C00},
-	{0xFFE6694F1471D49C, 0xFC00},
-	{0x41EFFFFFFFFFEFFF, 0x7C00},
-	{0x000EFFFBFFFFFFFF, 0x0000},
-	{0x41EFFFFFFFFFDFFF, 0x7C00},
-	{0xD2AE000000000FFF, 0xFC00},
-	{0x41EFFFFFFFFFBFFF, 0x7C00},
-	{0x4010002007FFFFFF, 0x4400},
-	{0x41EFFFFFFFFF7FFF, 0x7C00},
-	{0x7FDFE01000000000, 0x7C00},
-	{0x41EFFFFFFFFEFFFF, 0x7C00},
-	{0x41F0000002001000, 0x7C00},
-	{0x41EFFFFFFFFDFFFF, 0x7C00},
-	{0x476352B982D16D1E, 0x7C00},
-	{0x41EFFFFFFFFBFFFF, 0x7C00},
-	{0x4B502000000000FE, 0x7C00},
-	{0x41EFFFFFFFF7FFFF, 0x7C00},
-	{0xBFC71248870A636F, 0xB1C5},
-	{0x41EFFFFFFFEFFFFF, 0x7C00},
-	{0xC02FFFFFFFFFFFFD, 0xCC00},
-	{0x41EFFFFFFFDFFFFF, 0x7C00},
-	{0x41CFFFFFFF807FFF, 0x7C00},
-	{0x41EFFFFFFFBFFFFF, 0x7C00},
-	{0x3FBFFFFFEFDFFFFF, 0x3000},
-	{0x41EFFFFFFF7FFFFF, 0x7C00},
-	{0xC3DB46C9F9542387, 0xFC00},
-	{0x41EFFFFFFEFFFFFF, 0x7C00},
-	{0x3FC4262EB7CF1A56, 0x310A},
-	{0x41EFFFFFFDFFFFFF, 0x7C00},
-	{0x42B2000000FFFFFE, 0x7C00},
-	{0x41EFFFFFFBFFFFFF, 0x7C00},
-	{0x67700000080001FF, 0x7C00},
-	{0x41EFFFFFF7FFFFFF, 0x7C00},
-	{0xC4FFFFFFF7FDFFFE, 0xFC00},
-	{0x41EFFFFFEFFFFFFF, 0x7C00},
-	{0x37FFF8000007FFFF, 0x0000},
-	{0x41EFFFFFDFFFFFFF, 0x7C00},
-	{0xBFE0040000000003, 0xB801},
-	{0x41EFFFFFBFFFFFFF, 0x7C00},
-	{0x7FE00008000001FE, 0x7C00},
-	{0x41EFFFFF7FFFFFFF, 0x7C00},
-	{0x3CA0FFFEFFFFFFFF, 0x0000},
-	{0x41EFFFFEFFFFFFFF, 0x7C00},
-	{0xBFD3FFFFFFFBFFFF, 0xB500},
-	{0x41EFFFFDFFFFFFFF, 0x7C00},
-	{0x802000003FFFFFFE, 0x8000},
-	{0x41EFFFFBFFFFFFFF, 0x7C00},
-	{0x3FFFFFFC3FFFFFFF, 0x4000},
-	{0x41EFFFF7FFFFFFFF, 0x7C00},
-	{0xFFF00FFFFFFFFF7E, 0xFE03},
-	{0x41EFFFEFFFFFFFFF, 0x7C00},
-	{0x48000100003FFFFF, 0x7C00},
-	{0x41EFFFDFFFFFFFFF, 0x7C00},
-	{0x434FBFFFFBFFFFFF, 0x7C00},
-	{0x41EFFFBFFFFFFFFF, 0x7C00},
-	{0x381010000000007F, 0x0000},
-	{0x41EFFF7FFFFFFFFF, 0x7C00},
-	{0x418000000001FFFD, 0x7C00},
-	{0x41EFFEFFFFFFFFFF, 0x7C00},
-	{0x3F80807FFFFFFFFE, 0x2020},
-	{0x41EFFDFFFFFFFFFF, 0x7C00},
-	{0x400E3425AD23DAEE, 0x438D},
-	{0x41EFFBFFFFFFFFFF, 0x7C00},
-	{0xB80FFFFFFF8007FF, 0x8000},
-	{0x41EFF7FFFFFFFFFF, 0x7C00},
-	{0x43E000007BFFFFFF, 0x7C00},
-	{0x41EFEFFFFFFFFFFF, 0x7C00},
-	{0xB9C0FFFFFFFFFE00, 0x8000},
-	{0x41EFDFFFFFFFFFFF, 0x7C00},
-	{0xC3D0000000008000, 0xFC00},
-	{0x41EFBFFFFFFFFFFF, 0x7C00},
-	{0x4011000000000000, 0x4440},
-	{0x41EF7FFFFFFFFFFF, 0x7C00},
-	{0x403FFFF0000000FE, 0x5000},
-	{0x41EEFFFFFFFFFFFF, 0x7C00},
-	{0xBFFEBA11B277A1C2, 0xBFAF},
-	{0x41EDFFFFFFFFFFFF, 0x7C00},
-	{0x505FFFFFF7FFC000, 0x7C00},
-	{0x41EBFFFFFFFFFFFF, 0x7C00},
-	{0x3CAFFFFFFF000001, 0x0000},
-	{0x41E7FFFFFFFFFFFF, 0x7C00},
-	{0xFFDFFFFFDFFFFFFD, 0xFC00},
-	{0x41E3FFFFFFFFFFFF, 0x7C00},
-	{0xBFF1000000000400, 0xBC40},
-	{0x41E1FFFFFFFFFFFF, 0x7C00},
-	{0xC0700007FFFFFF80, 0xDC00},
-	{0x41E0FFFFFFFFFFFF, 0x7C00},
-	{0x3870000040004000, 0x0000},
-	{0x41E07FFFFFFFFFFF, 0x7C00},
-	{0x3FC15691A695D6FC, 0x3056},
-	{0x41E03FFFFFFFFFFF, 0x7C00},
-	{0xC01FFFFFFFFFC0FF, 0xC800},
-	{0x41E01FFFFFFFFFFF, 0x7C00},
-	{0x36B7FFFFFFFFDFFF, 0x0000},
-	{0x41E00FFFFFFFFFFF, 0x7C00},
-	{0x403FFFF000000100, 0x5000},
-	{0x41E007FFFFFFFFFF, 0x7C00},
-	{0xC00001FFFDFFFFFF, 0xC000},
-	{0x41E003FFFFFFFFFF, 0x7C00},
-	{0x401E37409AF34BE5, 0x478E},
-	{0x41E001FFFFFFFFFF, 0x7C00},
-	{0x3C1000003EFFFFFF, 0x0000},
-	{0x41E000FFFFFFFFFF, 0x7C00},
-	{0x3FF1FFFFDFFFFFFE, 0x3C80},
-	{0x41E0007FFFFFFFFF, 0x7C00},
-	{0xC30FFFFBFFFFDFFF, 0xFC00},
-	{0x41E0003FFFFFFFFF, 0x7C00},
-	{0x43F03FFFFFFFFFFD, 0x7C00},
-	{0x41E0001FFFFFFFFF, 0x7C00},
-	{0x217000000000403F, 0x0000},
-	{0x41E0000FFFFFFFFF, 0x7C00},
-	{0xC17F000000000020, 0xFC00},
-	{0x41E00007FFFFFFFF, 0x7C00},
-	{0x3FE47ECC06E7B765, 0x3920},
-	{0x41E00003FFFFFFFF, 0x7C00},
-	{0x37F0001000001000, 0x0000},
-	{0x41E00001FFFFFFFF, 0x7C00},
-	{0x48000001FFFFFF7E, 0x7C00},
-	{0x41E00000FFFFFFFF, 0x7C00},
-	{0x0B650C552B765433, 0x0000},
-	{0x41E000007FFFFFFF, 0x7C00},
-	{0xDCA000000803FFFF, 0xFC00},
-	{0x41E000003FFFFFFF, 0x7C00},
-	{0x001FFFFFF7FFFFF6, 0x0000},
-	{0x41E000001FFFFFFF, 0x7C00},
-	{0x0010000000040020, 0x0000},
-	{0x41E000000FFFFFFF, 0x7C00},
-	{0xB7E90EAC12367802, 0x8000},
-	{0x41E0000007FFFFFF, 0x7C00},
-	{0xEC98C208C99A9993, 0xFC00},
-	{0x41E0000003FFFFFF, 0x7C00},
-	{0x052FFBFFFFFFFFFE, 0x0000},
-	{0x41E0000001FFFFFF, 0x7C00},
-	{0x96D0008000000010, 0x8000},
-	{0x41E0000000FFFFFF, 0x7C00},
-	{0xC3C00000001FFFFE, 0xFC00},
-	{0x41E00000007FFFFF, 0x7C00},
-	{0x0E5FFFFE0007FFFF, 0x0000},
-	{0x41E00000003FFFFF, 0x7C00},
-	{0x401511EF5B7F684C, 0x4544},
-	{0x41E00000001FFFFF, 0x7C00},
-	{0xB81FFF00000001FE, 0x8000},
-	{0x41E00000000FFFFF, 0x7C00},
-	{0xBF9000000037FFFF, 0xA400},
-	{0x41E000000007FFFF, 0x7C00},
-	{0x7FF000000003FBFF, 0x7E00},
-	{0x41E000000003FFFF, 0x7C00},
-	{0xFFEFFFFF7FFFFDFF, 0xFC00},
-	{0x41E000000001FFFF, 0x7C00},
-	{0xBCC8D11076503E95, 0x8000},
-	{0x41E000000000FFFF, 0x7C00},
-	{0x3FF0000FFFFFFFF7, 0x3C00},
-	{0x41E0000000007FFF, 0x7C00},
-	{0xC1DFFFFFFFFFF5FF, 0xFC00},
-	{0x41E0000000003FFF, 0x7C00},
-	{0xC069030BF1304C3F, 0xDA41},
-	{0x41E0000000001FFF, 0x7C00},
-	{0x416FFFDFEFFFFFFF, 0x7C00},
-	{0x41E0000000000FFF, 0x7C00},
-	{0xC002163BA9A4420B, 0xC086},
-	{0x41E00000000007FF, 0x7C00},
-	{0x47FFFFFFFFFDE000, 0x7C00},
-	{0x41E00000000003FF, 0x7C00},
-	{0x400FFFFDFFDFFFFF, 0x4400},
-	{0x41E00000000001FF, 0x7C00},
-	{0x37E0000000FEFFFE, 0x0000},
-	{0x41E00000000000FF, 0x7C00},
-	{0x3FC080000FFFFFFF, 0x3020},
-	{0x41E000000000007F, 0x7C00},
-	{0xC080040000000040, 0xE001},
-	{0x41E000000000003F, 0x7C00},
-	{0xC7EFFFFFFFFFF806, 0xFC00},
-	{0x41E000000000001F, 0x7C00},
-	{0x401762D5226C56A0, 0x45D9},
-	{0x41E000000000000F, 0x7C00},
-	{0x4023930525598A37, 0x48E5},
-	{0x41E0000000000007, 0x7C00},
-	{0x23B00000000FFEFF, 0x0000},
-	{0x41E0000000000003, 0x7C00},
-	{0xC040400000080000, 0xD010},
-	{0x41F0000000000000, 0x7C00},
-	{0x4010081FFFFFFFFF, 0x4402},
-	{0x41F0000000000001, 0x7C00},
-	{0x0020000000000017, 0x0000},
-	{0x41F0000000000002, 0x7C00},
-	{0x3FDC000000000010, 0x3700},
-	{0x41F0000000000004, 0x7C00},
-	{0xC0F000000080FFFF, 0xFC00},
-	{0x41F0000000000008, 0x7C00},
-	{0xC001FFFFFFFFFFFC, 0xC080},
-	{0x41F0000000000010, 0x7C00},
-	{0x3FB000200FFFFFFF, 0x2C00},
-	{0x41F0000000000020, 0x7C00},
-	{0xC4E80000000000FF, 0xFC00},
-	{0x41F0000000000040, 0x7C00},
-	{0x3A7F0435C962C5CC, 0x0000},
-	{0x41F0000000000080, 0x7C00},
-	{0x3FF000F7FFFFFFFE, 0x3C00},
-	{0x41F0000000000100, 0x7C00},
-	{0x351FFFFEFFFFFFFF, 0x0000},
-	{0x41F0000000000200, 0x7C00},
-	{0xFADFFFFFFF00FFFF, 0xFC00},
-	{0x41F0000000000400, 0x7C00},
-	{0xFD7FFFFFFF01FFFF, 0xFC00},
-	{0x41F0000000000800, 0x7C00},
-	{0x43F99BC43D5975C9, 0x7C00},
-	{0x41F0000000001000, 0x7C00},
-	{0xB7EFFFFFFFFC01FF, 0x8000},
-	{0x41F0000000002000, 0x7C00},
-	{0x3B5000800000003E, 0x0000},
-	{0x41F0000000004000, 0x7C00},
-	{0xC03FE0FFFFFFFFFE, 0xCFF8},
-	{0x41F0000000008000, 0x7C00},
-	{0xB8138756A4325EA4, 0x8000},
-	{0x41F0000000010000, 0x7C00},
-	{0x3E740000FFFFFFFF, 0x0001},
-	{0x41F0000000020000, 0x7C00},
-	{0x3163FFFFFFFFC000, 0x0000},
-	{0x41F0000000040000, 0x7C00},
-	{0x40BCDDC120EEFB64, 0x6F37},
-	{0x41F0000000080000, 0x7C00},
-	{0x46480000000FFFFF, 0x7C00},
-	{0x41F0000000100000, 0x7C00},
-	{0xBEBFFEFFFFFFFFEF, 0x8020},
-	{0x41F0000000200000, 0x7C00},
-	{0xBF8FDFFFFFFFF000, 0xA3F8},
-	{0x41F0000000400000, 0x7C00},
-	{0x3FE00007FFFFFFDF, 0x3800},
-	{0x41F0000000800000, 0x7C00},
-	{0x539FFF7FEFFFFFFF, 0x7C00},
-	{0x41F0000001000000, 0x7C00},
-	{0x41EFFFFFFFFFFE06, 0x7C00},
-	{0x41F0000002000000, 0x7C00},
-	{0xB7E0000000040000, 0x8000},
-	{0x41F0000004000000, 0x7C00},
-	{0xC01FFFFF000007FF, 0xC800},
-	{0x41F0000008000000, 0x7C00},
-	{0x3FCFFFFFE00000FE, 0x3400},
-	{0x41F0000010000000, 0x7C00},
-	{0xC02C007FFFFFFFFF, 0xCB00},
-	{0x41F0000020000000, 0x7C00},
-	{0x40000000FFFFFFFE, 0x4000},
-	{0x41F0000040000000, 0x7C00},
-	{0xBCA28DFA29844E17, 0x8000},
-	{0x41F0000080000000, 0x7C00},
-	{0x400A49029E84B020, 0x4292},
-	{0x41F0000100000000, 0x7C00},
-	{0x40A0003FFFFFDFFE, 0x6800},
-	{0x41F0000200000000, 0x7C00},
-	{0xC3E0200000010000, 0xFC00},
-	{0x41F0000400000000, 0x7C00},
-	{0xC1D8018E55161DCF, 0xFC00},
-	{0x41F0000800000000, 0x7C00},
-	{0xC01FFFFFEFFFFFF7, 0xC800},
-	{0x41F0001000000000, 0x7C00},
-	{0x7FD000001BFFFFFF, 0x7C00},
-	{0x41F0002000000000, 0x7C00},
-	{0xC00FFFFFFE7FFFFF, 0xC400},
-	{0x41F0004000000000, 0x7C00},
-	{0xC3CFBFFFFFFFFE00, 0xFC00},
-	{0x41F0008000000000, 0x7C00},
-	{0x3FF9AA2E809E454E, 0x3E6B},
-	{0x41F0010000000000, 0x7C00},
-	{0x3E3FFFFFFFFC3FFF, 0x0000},
-	{0x41F0020000000000, 0x7C00},
-	{0x3FE8000800000000, 0x3A00},
-	{0x41F0040000000000, 0x7C00},
-	{0xC0000800001FFFFF, 0xC002},
-	{0x41F0080000000000, 0x7C00},
-	{0x8029425B43F00800, 0x8000},
-	{0x41F0100000000000, 0x7C00},
-	{0xC0201FFFFFFFDFFF, 0xC808},
-	{0x41F0200000000000, 0x7C00},
-	{0xC3C6C634C634077B, 0xFC00},
-	{0x41F0400000000000, 0x7C00},
-	{0x4800000000204000, 0x7C00},
-	{0x41F0800000000000, 0x7C00},
-	{0xC3E657460525E616, 0xFC00},
-	{0x41F1000000000000, 0x7C00},
-	{0xC23C8CDCEEFBE0AF, 0xFC00},
-	{0x41F2000000000000, 0x7C00},
-	{0xBCA00003FFFFFFFA, 0x8000},
-	{0x41F4000000000000, 0x7C00},
-	{0x29FFFFFFF7FFFFF7, 0x0000},
-	{0x41F8000000000000, 0x7C00},
-	{0xBCBFFFFFFFFFFEFD, 0x8000},
-	{0x41FC000000000000, 0x7C00},
-	{0x5CB003FFFFFFFFDF, 0x7C00},
-	{0x41FE000000000000, 0x7C00},
-	{0x3D80000027FFFFFF, 0x0000},
-	{0x41FF000000000000, 0x7C00},
-	{0x7FE80000000001FF, 0x7C00},
-	{0x41FF800000000000, 0x7C00},
-	{0xC1F00000003FFFDF, 0xFC00},
-	{0x41FFC00000000000, 0x7C00},
-	{0x3D3973DDDB43DB0E, 0x0000},
-	{0x41FFE00000000000, 0x7C00},
-	{0xB7EA2476230BC3F4, 0x8000},
-	{0x41FFF00000000000, 0x7C00},
-	{0xBFDDDB5BF648B367, 0xB777},
-	{0x41FFF80000000000, 0x7C00},
-	{0xBA3000000081FFFF, 0x8000},
-	{0x41FFFC0000000000, 0x7C00},
-	{0x380EFFFFFFFFFFBF, 0x0000},
-	{0x41FFFE0000000000, 0x7C00},
-	{0x3FE0000000200FFE, 0x3800},
-	{0x41FFFF0000000000, 0x7C00},
-	{0xBFEFFFFFBFFFF800, 0xBC00},
-	{0x41FFFF8000000000, 0x7C00},
-	{0x41DFB3E0EB0A7097, 0x7C00},
-	{0x41FFFFC000000000, 0x7C00},
-	{0xFFDFFFFFFFFDFF00, 0xFC00},
-	{0x41FFFFE000000000, 0x7C00},
-	{0x3A702E675698EE74, 0x0000},
-	{0x41FFFFF000000000, 0x7C00},
-	{0x402BFFFFFFFFFFFF, 0x4B00},
-	{0x41FFFFF800000000, 0x7C00},
-	{0xB9B001EFFFFFFFFE, 0x8000},
-	{0x41FFFFFC00000000, 0x7C00},
-	{0x380FFC0010000000, 0x0000},
-	{0x41FFFFFE00000000, 0x7C00},
-	{0x800A56E41556587C, 0x8000},
-	{0x41FFFFFF00000000, 0x7C00},
-	{0x801FFFFBFFFFFBFE, 0x8000},
-	{0x41FFFFFF80000000, 0x7C00},
-	{0xC7EFFFFFFC000001, 0xFC00},
-	{0x41FFFFFFC0000000, 0x7C00},
-	{0xC02FFFFFFFFBF7FF, 0xCC00},
-	{0x41FFFFFFE0000000, 0x7C00},
-	{0xBF307F395A1A367D, 0x8C20},
-	{0x41FFFFFFF0000000, 0x7C00},
-	{0x41F00100000000FF, 0x7C00},
-	{0x41FFFFFFF8000000, 0x7C00},
-	{0xC3E00007FFBFFFFF, 0xFC00},
-	{0x41FFFFFFFC000000, 0x7C00},
-	{0x4160FFFFFFFFFE00, 0x7C00},
-	{0x41FFFFFFFE000000, 0x7C00},
-	{0x3801EE777FC827A8, 0x0000},
-	{0x41FFFFFFFF000000, 0x7C00},
-	{0x3FD000004003FFFF, 0x3400},
-	{0x41FFFFFFFF800000, 0x7C00},
-	{0x536FFFFF80007FFF, 0x7C00},
-	{0x41FFFFFFFFC00000, 0x7C00},
-	{0x47EFDFFFFFFFF7FE, 0x7C00},
-	{0x41FFFFFFFFE00000, 0x7C00},
-	{0xB81CA06FFFE3E664, 0x8000},
-	{0x41FFFFFFFFF00000, 0x7C00},
-	{0x3FBFF00000003FFF, 0x2FFC},
-	{0x41FFFFFFFFF80000, 0x7C00},
-	{0xBFDFFFFFFF001FFE, 0xB800},
-	{0x41FFFFFFFFFC0000, 0x7C00},
-	{0x44B77F4538AF13D0, 0x7C00},
-	{0x41FFFFFFFFFE0000, 0x7C00},
-	{0x4D0FFFFFF03FFFFF, 0x7C00},
-	{0x41FFFFFFFFFF0000, 0x7C00},
-	{0x380515343071F8D6, 0x0000},
-	{0x41FFFFFFFFFF8000, 0x7C00},
-	{0xBFC1000000001FFE, 0xB040},
-	{0x41FFFFFFFFFFC000, 0x7C00},
-	{0xC1C407FFFFFFFFFF, 0xFC00},
-	{0x41FFFFFFFFFFE000, 0x7C00},
-	{0x41CCB8A19E471EBB, 0x7C00},
-	{0x41FFFFFFFFFFF000, 0x7C00},
-	{0xB7FFFFFFFFFFFBEE, 0x8000},
-	{0x41FFFFFFFFFFF800, 0x7C00},
-	{0x220FFFFFFFFFBDFF, 0x0000},
-	{0x41FFFFFFFFFFFC00, 0x7C00},
-	{0xB810000F7FFFFFFF, 0x8000},
-	{0x41FFFFFFFFFFFE00, 0x7C00},
-	{0x3F900007FF000000, 0x2400},
-	{0x41FFFFFFFFFFFF00, 0x7C00},
-	{0x47EFEBCB2887DB53, 0x7C00},
-	{0x41FFFFFFFFFFFF80, 0x7C00},
-	{0x6B1FFFFFEFFFFFFD, 0x7C00},
-	{0x41FFFFFFFFFFFFC0, 0x7C00},
-	{0x400FFF000000003F, 0x4400},
-	{0x41FFFFFFFFFFFFE0, 0x7C00},
-	{0x47AFD7FFFFFFFFFF, 0x7C00},
-	{0x41FFFFFFFFFFFFF0, 0x7C00},
-	{0x001AB85973812592, 0x0000},
-	{0x41FFFFFFFFFFFFF8, 0x7C00},
-	{0xC01D8BD60DAB04F3, 0xC763},
-	{0x41FFFFFFFFFFFFFC, 0x7C00},
-	{0x403E7004D3CC8ADD, 0x4F9C},
-	{0x41FFFFFFFFFFFFFE, 0x7C00},
-	{0xB92000207FFFFFFF, 0x8000},
-	{0x41FFFFFFFFFFFFFF, 0x7C00},
-	{0x43C000000FFFFFDE, 0x7C00},
-	{0x41FFFFFFFFFFFFFD, 0x7C00},
-	{0x3FFA9E378E343476, 0x3EA8},
-	{0x41FFFFFFFFFFFFFB, 0x7C00},
-	{0x4024A58E9298B1CC, 0x4929},
-	{0x41FFFFFFFFFFFFF7, 0x7C00},
-	{0x403FFFF00000001E, 0x5000},
-	{0x41FFFFFFFFFFFFEF, 0x7C00},
-	{0xB6400000000081FF, 0x8000},
-	{0x41FFFFFFFFFFFFDF, 0x7C00},
-	{0xBA0F00000001FFFF, 0x8000},
-	{0x41FFFFFFFFFFFFBF, 0x7C00},
-	{0xFFEFFFFFDFFFFEFE, 0xFC00},
-	{0x41FFFFFFFFFFFF7F, 0x7C00},
-	{0x47FE00007FFFFFFF, 0x7C00},
-	{0x41FFFFFFFFFFFEFF, 0x7C00},
-	{0x3F0005FFFFFFFFFE, 0x0201},
-	{0x41FFFFFFFFFFFDFF, 0x7C00},
-	{0x3FEFF001FFFFFFFF, 0x3BFC},
-	{0x41FFFFFFFFFFFBFF, 0x7C00},
-	{0x28200003FFFFFE00, 0x0000},
-	{0x41FFFFFFFFFFF7FF, 0x7C00},
-	{0xC020000003F80000, 0xC800},
-	{0x41FFFFFFFFFFEFFF, 0x7C00},
-	{0x002F7FFFFFFFFE00, 0x0000},
-	{0x41FFFFFFFFFFDFFF, 0x7C00},
-	{0x3F5BB689FCA2BD61, 0x16EE},
-	{0x41FFFFFFFFFFBFFF, 0x7C00},
-	{0x421FFFFF80001FFF, 0x7C00},
-	{0x41FFFFFFFFFF7FFF, 0x7C00},
-	{0xC25FBFFFFE000000, 0xFC00},
-	{0x41FFFFFFFFFEFFFF, 0x7C00},
-	{0x41BFFFFFFBFFFDFF, 0x7C00},
-	{0x41FFFFFFFFFDFFFF, 0x7C00},
-	{0x802FF7FFEFFFFFFF, 0x8000},
-	{0x41FFFFFFFFFBFFFF, 0x7C00},
-	{0x3FC0040000000002, 0x3001},
-	{0x41FFFFFFFFF7FFFF, 0x7C00},
-	{0xBFD37873F4BC37E5, 0xB4DE},
-	{0x41FFFFFFFFEFFFFF, 0x7C00},
-	{0x40193C0BDEF7A4C3, 0x464F},
-	{0x41FFFFFFFFDFFFFF, 0x7C00},
-	{0x3D1FFFEFF7FFFFFE, 0x0000},
-	{0x41FFFFFFFFBFFFFF, 0x7C00},
-	{0x92B40000001FFFFF, 0x8000},
-	{0x41FFFFFFFF7FFFFF, 0x7C00},
-	{0x5BB00FFFFFFDFFFF, 0x7C00},
-	{0x41FFFFFFFEFFFFFF, 0x7C00},
-	{0x434FFFBFFFBFFFFF, 0x7C00},
-	{0x41FFFFFFFDFFFFFF, 0x7C00},
-	{0x0020000010000001, 0x0000},
-	{0x41FFFFFFFBFFFFFF, 0x7C00},
-	{0xC0DFFFF7FFFF8000, 0xF800},
-	{0x41FFFFFFF7FFFFFF, 0x7C00},
-	{0xBFED877D89E0AEB4, 0xBB62},
-	{0x41FFFFFFEFFFFFFF, 0x7C00},
-	{0x47035B8541369C93, 0x7C00},
-	{0x41FFFFFFDFFFFFFF, 0x7C00},
-	{0xC0100000000FFFFD, 0xC400},
-	{0x41FFFFFFBFFFFFFF, 0x7C00},
-	{0xC060000000110000, 0xD800},
-	{0x41FFFFFF7FFFFFFF, 0x7C00},
-	{0xBF9FE00000FFFFFF, 0xA7F8},
-	{0x41FFFFFEFFFFFFFF, 0x7C00},
-	{0xC0200020003FFFFF, 0xC800},
-	{0x41FFFFFDFFFFFFFF, 0x7C00},
-	{0xC5A000000FFEFFFF, 0xFC00},
-	{0x41FFFFFBFFFFFFFF, 0x7C00},
-	{0xB80A9BC89592C536, 0x8000},
-	{0x41FFFFF7FFFFFFFF, 0x7C00},
-	{0x40300006FFFFFFFF, 0x4C00},
-	{0x41FFFFEFFFFFFFFF, 0x7C00},
-	{0x41EFBFF800000000, 0x7C00},
-	{0x41FFFFDFFFFFFFFF, 0x7C00},
-	{0x4EEFFFFFFFFFFFF4, 0x7C00},
-	{0x41FFFFBFFFFFFFFF, 0x7C00},
-	{0x3CAFDEFFFFFFFFFF, 0x0000},
-	{0x41FFFF7FFFFFFFFF, 0x7C00},
-	{0x3FFF7FFFFFFDFFFF, 0x3FE0},
-	{0x41FFFEFFFFFFFFFF, 0x7C00},
-	{0xBFAFFFFFDFBFFFFF, 0xAC00},
-	{0x41FFFDFFFFFFFFFF, 0x7C00},
-	{0x267FFFFF7EFFFFFE, 0x0000},
-	{0x41FFFBFFFFFFFFFF, 0x7C00},
-	{0x47FCBAFF4535B0A5, 0x7C00},
-	{0x41FFF7FFFFFFFFFF, 0x7C00},
-	{0xC3D007FFFFFFFFFB, 0xFC00},
-	{0x41FFEFFFFFFFFFFF, 0x7C00},
-	{0xEBBFFFFFFFFCFFFF, 0xFC00},
-	{0x41FFDFFFFFFFFFFF, 0x7C00},
-	{0xC1D642B8D8481259, 0xFC00},
-	{0x41FFBFFFFFFFFFFF, 0x7C00},
-	{0xBFFFFFFFFFFFD800, 0xC000},
-	{0x41FF7FFFFFFFFFFF, 0x7C00},
-	{0x800FFF8000000000, 0x8000},
-	{0x41FEFFFFFFFFFFFF, 0x7C00},
-	{0xA940000000000FBE, 0x8000},
-	{0x41FDFFFFFFFFFFFF, 0x7C00},
-	{0xAAAFFFFFFFFFF77F, 0x8000},
-	{0x41FBFFFFFFFFFFFF, 0x7C00},
-	{0x3810000000000FFB, 0x0000},
-	{0x41F7FFFFFFFFFFFF, 0x7C00},
-	{0x3FD0000008002000, 0x3400},
-	{0x41F3FFFFFFFFFFFF, 0x7C00},
-	{0xCB8FFFFFFFF80000, 0xFC00},
-	{0x41F1FFFFFFFFFFFF, 0x7C00},
-	{0xBFDFF0000000007F, 0xB7FC},
-	{0x41F0FFFFFFFFFFFF, 0x7C00},
-	{0x64703FFFFFFEFFFF, 0x7C00},
-	{0x41F07FFFFFFFFFFF, 0x7C00},
-	{0x0024E00F323154F4, 0x0000},
-	{0x41F03FFFFFFFFFFF, 0x7C00},
-	{0x3A4FFBFFFFDFFFFE, 0x0000},
-	{0x41F01FFFFFFFFFFF, 0x7C00},
-	{0xBFB000400003FFFF, 0xAC00},
-	{0x41F00FFFFFFFFFFF, 0x7C00},
-	{0xC7F000020001FFFF, 0xFC00},
-	{0x41F007FFFFFFFFFF, 0x7C00},
-	{0xB8F24986E6EB214F, 0x8000},
-	{0x41F003FFFFFFFFFF, 0x7C00},
-	{0x38000000000011FF, 0x0000},
-	{0x41F001FFFFFFFFFF, 0x7C00},
-	{0xC04FF00000000FFF, 0xD3FC},
-	{0x41F000FFFFFFFFFF, 0x7C00},
-	{0xC80400FFFFFFFFFF, 0xFC00},
-	{0x41F0007FFFFFFFFF, 0x7C00},
-	{0x3FEA0337A1A11036, 0x3A81},
-	{0x41F0003FFFFFFFFF, 0x7C00},
-	{0xBC0F7FFFFFFFFFDF, 0x8000},
-	{0x41F0001FFFFFFFFF, 0x7C00},
-	{0xC3DFFF7FFFFFFFBF, 0xFC00},
-	{0x41F0000FFFFFFFFF, 0x7C00},
-	{0x3FB4EF0B3436E824, 0x2D3C},
-	{0x41F00007FFFFFFFF, 0x7C00},
-	{0xC46003FFFFFC0000, 0xFC00},
-	{0x41F00003FFFFFFFF, 0x7C00},
-	{0xAFCFFFFFFFFFF820, 0x8000},
-	{0x41F00001FFFFFFFF, 0x7C00},
-	{0xC3D1DF26455E735D, 0xFC00},
-	{0x41F00000FFFFFFFF, 0x7C00},
-	{0x38110000000000FF, 0x0000},
-	{0x41F000007FFFFFFF, 0x7C00},
-	{0x3E3000000FFFFFF7, 0x0000},
-	{0x41F000003FFFFFFF, 0x7C00},
-	{0xC1EFFFFE0000001E, 0xFC00},
-	{0x41F000001FFFFFFF, 0x7C00},
-	{0x41E0000000001FFF, 0x7C00},
-	{0x41F000000FFFFFFF, 0x7C00},
-	{0xF37000000FFFFFFB, 0xFC00},
-	{0x41F0000007FFFFFF, 0x7C00},
-	{0xC7EFFFF3FFFFFFFF, 0xFC00},
-	{0x41F0000003FFFFFF, 0x7C00},
-	{0xE53FFFFFFF004000, 0xFC00},
-	{0x41F0000001FFFFFF, 0x7C00},
-	{0xC3FFFFE00000001E, 0xFC00},
-	{0x41F0000000FFFFFF, 0x7C00},
-	{0x3EEDFDFFFFFFFFFE, 0x00F0},
-	{0x41F00000007FFFFF, 0x7C00},
-	{0xA45FFFF0000000FE, 0x8000},
-	{0x41F00000003FFFFF, 0x7C00},
-	{0x3FEFFFE000000007, 0x3C00},
-	{0x41F00000001FFFFF, 0x7C00},
-	{0xC80FFFC000001FFE, 0xFC00},
-	{0x41F00000000FFFFF, 0x7C00},
-	{0x3E2000100000007F, 0x0000},
-	{0x41F000000007FFFF, 0x7C00},
-	{0x001DC3167BCD0B73, 0x0000},
-	{0x41F000000003FFFF, 0x7C00},
-	{0x3F0FFFFBFFFE0000, 0x0400},
-	{0x41F000000001FFFF, 0x7C00},
-	{0x3FF003FFFFEFFFFF, 0x3C01},
-	{0x41F000000000FFFF, 0x7C00},
-	{0x7FFC4CC7E942CB99, 0x7F13},
-	{0x41F0000000007FFF, 0x7C00},
-	{0xC0AFFFFFFFEFFFFB, 0xEC00},
-	{0x41F0000000003FFF, 0x7C00},
-	{0x46EF48252953C17F, 0x7C00},
-	{0x41F0000000001FFF, 0x7C00},
-	{0x3FFFFFFFFFFFC1FF, 0x4000},
-	{0x41F0000000000FFF, 0x7C00},
-	{0x41E00000003FEFFF, 0x7C00},
-	{0x41F00000000007FF, 0x7C00},
-	{0xBFE000043FFFFFFE, 0xB800},
-	{0x41F00000000003FF, 0x7C00},
-	{0xBD8FFFFFFFFFFFFF, 0x8000},
-	{0x41F00000000001FF, 0x7C00},
-	{0xC3CFFFFFFFFFC3FE, 0xFC00},
-	{0x41F00000000000FF, 0x7C00},
-	{0xB9D01FFFFFFFFFDE, 0x8000},
-	{0x41F000000000007F, 0x7C00},
-	{0xBF5000000000FFFF, 0x9400},
-	{0x41F000000000003F, 0x7C00},
-	{0xBAFFF7FFFFFFFFFD, 0x8000},
-	{0x41F000000000001F, 0x7C00},
-	{0x3FFFFFEFE0000000, 0x4000},
-	{0x41F000000000000F, 0x7C00},
-	{0xC01F000000003FFF, 0xC7C0},
-	{0x41F0000000000007, 0x7C00},
-	{0xB80FFFDFFFFBFFFF, 0x8000},
-	{0x41F0000000000003, 0x7C00},
-	{0x3E76871432A9628D, 0x0001},
-	{0x4340000000000000, 0x7C00},
-	{0x47F0000000000011, 0x7C00},
-	{0x4340000000000001, 0x7C00},
-	{0xC07FFFFFEFFF8000, 0xE000},
-	{0x4340000000000002, 0x7C00},
-	{0x7FF0007FFFFFFFFD, 0x7E00},
-	{0x4340000000000004, 0x7C00},
-	{0xBFC0000001000004, 0xB000},
-	{0x4340000000000008, 0x7C00},
-	{0xCA7000000001007E, 0xFC00},
-	{0x4340000000000010, 0x7C00},
-	{0xC01EB6BBB59C5C6E, 0xC7AE},
-	{0x4340000000000020, 0x7C00},
-	{0x3FF000000040007F, 0x3C00},
-	{0x4340000000000040, 0x7C00},
-	{0xA3803FFFFDFFFFFE, 0x8000},
-	{0x4340000000000080, 0x7C00},
-	{0x33BFFFFFFFFFC7FF, 0x0000},
-	{0x4340000000000100, 0x7C00},
-	{0xBCFFFFFF800FFFFE, 0x8000},
-	{0x4340000000000200, 0x7C00},
-	{0xC0807FFFFFFFBFFF, 0xE020},
-	{0x4340000000000400, 0x7C00},
-	{0xBFAF84EA108B0D07, 0xABE1},
-	{0x4340000000000800, 0x7C00},
-	{0xC1FFFFFFFFEFFF80, 0xFC00},
-	{0x4340000000001000, 0x7C00},
-	{0xBE58000FFFFFFFFE, 0x8000},
-	{0x4340000000002000, 0x7C00},
-	{0xCBC00000200007FF, 0xFC00},
-	{0x4340000000004000, 0x7C00},
-	{0xC00FFFFFF80001FF, 0xC400},
-	{0x4340000000008000, 0x7C00},
-	{0xBE10804000000000, 0x8000},
-	{0x4340000000010000, 0x7C00},
-	{0x41F36397C108F3C0, 0x7C00},
-	{0x4340000000020000, 0x7C00},
-	{0xC7E4AB9105530C55, 0xFC00},
-	{0x4340000000040000, 0x7C00},
-	{0x43D0000E00000000, 0x7C00},
-	{0x4340000000080000, 0x7C00},
-	{0xC073F7428F7D85E5, 0xDCFE},
-	{0x4340000000100000, 0x7C00},
-	{0x4361C99C561C7159, 0x7C00},
-	{0x4340000000200000, 0x7C00},
-	{0xBEF000020FFFFFFF, 0x8100},
-	{0x4340000000400000, 0x7C00},
-	{0x41EFFFFFFFDFFFF8, 0x7C00},
-	{0x4340000000800000, 0x7C00},
-	{0xBE5FFFFE00400000, 0x8000},
-	{0x4340000001000000, 0x7C00},
-	{0x3FFFFFFFFFFFDFFD, 0x4000},
-	{0x4340000002000000, 0x7C00},
-	{0xC35FFFFFFE000100, 0xFC00},
-	{0x4340000004000000, 0x7C00},
-	{0x403FF7FFEFFFFFFF, 0x4FFE},
-	{0x4340000008000000, 0x7C00},
-	{0xC020000001FFEFFF, 0xC800},
-	{0x4340000010000000, 0x7C00},
-	{0x3FE19841D7B876B8, 0x3866},
-	{0x4340000020000000, 0x7C00},
-	{0x40BFFFF800040000, 0x7000},
-	{0x4340000040000000, 0x7C00},
-	{0x7FDE8380F2E3399E, 0x7C00},
-	{0x4340000080000000, 0x7C00},
-	{0x9960000000FFFFBE, 0x8000},
-	{0x4340000100000000, 0x7C00},
-	{0xC0100000004000FF, 0xC400},
-	{0x4340000200000000, 0x7C00},
-	{0xBFBDFFFFF7FFFFFE, 0xAF80},
-	{0x4340000400000000, 0x7C00},
-	{0x4A4FFFFFFFF9FFFE, 0x7C00},
-	{0x4340000800000000, 0x7C00},
-	{0xBCE0000800000002, 0x8000},
-	{0x4340001000000000, 0x7C00},
-	{0x47F000010000FFFF, 0x7C00},
-	{0x4340002000000000, 0x7C00},
-	{0x3FEFFFFFFE000003, 0x3C00},
-	{0x4340004000000000, 0x7C00},
-	{0x402FFFFFFBFF7FFF, 0x4C00},
-	{0x4340008000000000, 0x7C00},
-	{0x7FEFFF80000003FF, 0x7C00},
-	{0x4340010000000000, 0x7C00},
-	{0xB80004007FFFFFFE, 0x8000},
-	{0x4340020000000000, 0x7C00},
-	{0x43D0000000802000, 0x7C00},
-	{0x4340040000000000, 0x7C00},
-	{0x3F80020000000400, 0x2001},
-	{0x4340080000000000, 0x7C00},
-	{0x3BF01FFFFFF7FFFE, 0x0000},
-	{0x4340100000000000, 0x7C00},
-	{0x3E70000001FFFFEE, 0x0001},
-	{0x4340200000000000, 0x7C00},
-	{0x3FF1F64B080C104C, 0x3C7E},
-	{0x4340400000000000, 0x7C00},
-	{0xC00E000000000100, 0xC380},
-	{0x4340800000000000, 0x7C00},
-	{0x3F8000000800003F, 0x2000},
-	{0x4341000000000000, 0x7C00},
-	{0xA3A000000800001F, 0x8000},
-	{0x4342000000000000, 0x7C00},
-	{0xBCA0000000210000, 0x8000},
-	{0x4344000000000000, 0x7C00},
-	{0xC779E477A866E8B0, 0xFC00},
-	{0x4348000000000000, 0x7C00},
-	{0x37FAD5490C2900A7, 0x0000},
-	{0x434C000000000000, 0x7C00},
-	{0xC0AFFEBFFFFFFFFF, 0xEC00},
-	{0x434E000000000000, 0x7C00},
-	{0x41E217AD45AE275B, 0x7C00},
-	{0x434F000000000000, 0x7C00},
-	{0x3FCFF0000000FFFF, 0x33FC},
-	{0x434F800000000000, 0x7C00},
-	{0xBB6FDFFFFEFFFFFF, 0x8000},
-	{0x434FC00000000000, 0x7C00},
-	{0x11BF9FFFFFFFFFFF, 0x0000},
-	{0x434FE00000000000, 0x7C00},
-	{0xC3CEC13F91B38F27, 0xFC00},
-	{0x434FF00000000000, 0x7C00},
-	{0xC054BF4738F3AE72, 0xD530},
-	{0x434FF80000000000, 0x7C00},
-	{0xFFF816626D4C7AB3, 0xFE05},
-	{0x434FFC0000000000, 0x7C00},
-	{0x3CE00027FFFFFFFE, 0x0000},
-	{0x434FFE0000000000, 0x7C00},
-	{0xB80FFFFC0007FFFF, 0x8000},
-	{0x434FFF0000000000, 0x7C00},
-	{0xC037FFFFFFBFFFFE, 0xCE00},
-	{0x434FFF8000000000, 0x7C00},
-	{0x41DFFF800007FFFE, 0x7C00},
-	{0x434FFFC000000000, 0x7C00},
-	{0x434FFF7FFFFBFFFE, 0x7C00},
-	{0x434FFFE000000000, 0x7C00},
-	{0x3FFFFFFF80000007, 0x4000},
-	{0x434FFFF000000000, 0x7C00},
-	{0xB7F630941E556872, 0x8000},
-	{0x434FFFF800000000, 0x7C00},
-	{0xC80FFFFFFFFFFF86, 0xFC00},
-	{0x434FFFFC00000000, 0x7C00},
-	{0xC800004FFFFFFFFE, 0xFC00},
-	{0x434FFFFE00000000, 0x7C00},
-	{0xCF720A4A222407DB, 0xFC00},
-	{0x434FFFFF00000000, 0x7C00},
-	{0x562FFFFFFBFDFFFE, 0x7C00},
-	{0x434FFFFF80000000, 0x7C00},
-	{0x4010000007FFFBFE, 0x4400},
-	{0x434FFFFFC0000000, 0x7C00},
-	{0xC0DEF5D7901240E9, 0xF7BD},
-	{0x434FFFFFE0000000, 0x7C00},
-	{0x41FFFFFFFE040000, 0x7C00},
-	{0x434FFFFFF0000000, 0x7C00},
-	{0x3A474F9888E58AC9, 0x0000},
-	{0x434FFFFFF8000000, 0x7C00},
-	{0xC032F2F1D6171D38, 0xCCBD},
-	{0x434FFFFFFC000000, 0x7C00},
-	{0x3ECFFFFFBFFBFFFF, 0x0040},
-	{0x434FFFFFFE000000, 0x7C00},
-	{0x83D00000003FF7FF, 0x8000},
-	{0x434FFFFFFF000000, 0x7C00},
-	{0xC02000000FFF7FFF, 0xC800},
-	{0x434FFFFFFF800000, 0x7C00},
-	{0xC00FF00007FFFFFE, 0xC3FC},
-	{0x434FFFFFFFC00000, 0x7C00},
-	{0x40902000003FFFFF, 0x6408},
-	{0x434FFFFFFFE00000, 0x7C00},
-	{0x4340020007FFFFFF, 0x7C00},
-	{0x434FFFFFFFF00000, 0x7C00},
-	{0x47EFFFFFF80FFFFF, 0x7C00},
-	{0x434FFFFFFFF80000, 0x7C00},
-	{0x41F84FD10584F856, 0x7C00},
-	{0x434FFFFFFFFC0000, 0x7C00},
-	{0xC0200000FFFFFFBE, 0xC800},
-	{0x434FFFFFFFFE0000, 0x7C00},
-	{0x40CFFF7FFFFFFFFF, 0x7400},
-	{0x434FFFFFFFFF0000, 0x7C00},
-	{0x339D4CB5E137870D, 0x0000},
-	{0x434FFFFFFFFF8000, 0x7C00},
-	{0x851003FFFFE00000, 0x8000},
-	{0x434FFFFFFFFFC000, 0x7C00},
-	{0xE9CFFFFFFFFDFBFE, 0xFC00},
-	{0x434FFFFFFFFFE000, 0x7C00},
-	{0xFFE000000003FFFE, 0xFC00},
-	{0x434FFFFFFFFFF000, 0x7C00},
-	{0x3EB1000000003FFF, 0x0011},
-	{0x434FFFFFFFFFF800, 0x7C00},
-	{0x37E0000000000082, 0x0000},
-	{0x434FFFFFFFFFFC00, 0x7C00},
-	{0x3FEFFFFFFFFEFFFA, 0x3C00},
-	{0x434FFFFFFFFFFE00, 0x7C00},
-	{0x41FFFFFFFE7FFFFF, 0x7C00},
-	{0x434FFFFFFFFFFF00, 0x7C00},
-	{0x3FD0000000180000, 0x3400},
-	{0x434FFFFFFFFFFF80, 0x7C00},
-	{0x40015E5F073EA094, 0x4058},
-	{0x434FFFFFFFFFFFC0, 0x7C00},
-	{0x480FFFFFFE000FFF, 0x7C00},
-	{0x434FFFFFFFFFFFE0, 0x7C00},
-	{0xC3D0001000200000, 0xFC00},
-	{0x434FFFFFFFFFFFF0, 0x7C00},
-	{0xC0E000020FFFFFFF, 0xF800},
-	{0x434FFFFFFFFFFFF8, 0x7C00},
-	{0xB211F7D00C214AEF, 0x8000},
-	{0x434FFFFFFFFFFFFC, 0x7C00},
-	{0xB52FDFFFFBFFFFFF, 0x8000},
-	{0x434FFFFFFFFFFFFE, 0x7C00},
-	{0x447FFFFFEFFFBFFF, 0x7C00},
-	{0x434FFFFFFFFFFFFF, 0x7C00},
-	{0x3A20021FFFFFFFFF, 0x0000},
-	{0x434FFFFFFFFFFFFD, 0x7C00},
-	{0x43F00000008001FF, 0x7C00},
-	{0x434FFFFFFFFFFFFB, 0x7C00},
-	{0xC1D00000002007FF, 0xFC00},
-	{0x434FFFFFFFFFFFF7, 0x7C00},
-	{0xDFE4A58A649695C6, 0xFC00},
-	{0x434FFFFFFFFFFFEF, 0x7C00},
-	{0xC301000000003FFF, 0xFC00},
-	{0x434FFFFFFFFFFFDF, 0x7C00},
-	{0xFFE000000200001F, 0xFC00},
-	{0x434FFFFFFFFFFFBF, 0x7C00},
-	{0xBFF00000000FFFDE, 0xBC00},
-	{0x434FFFFFFFFFFF7F, 0x7C00},
-	{0x49C6755139928B1C, 0x7C00},
-	{0x434FFFFFFFFFFEFF, 0x7C00},
-	{0xC80FFFFFE0001FFF, 0xFC00},
-	{0x434FFFFFFFFFFDFF, 0x7C00},
-	{0xE3C000000002FFFF, 0xFC00},
-	{0x434FFFFFFFFFFBFF, 0x7C00},
-	{0xC27FE0000000007E, 0xFC00},
-	{0x434FFFFFFFFFF7FF, 0x7C00},
-	{0x480FFF7FFFFFFC00, 0x7C00},
-	{0x434FFFFFFFFFEFFF, 0x7C00},
-	{0x3804623089DE0108, 0x0000},
-	{0x434FFFFFFFFFDFFF, 0x7C00},
-	{0xB040004010000000, 0x8000},
-	{0x434FFFFFFFFFBFFF, 0x7C00},
-	{0x3FB0003FFFFFFC00, 0x2C00},
-	{0x434FFFFFFFFF7FFF, 0x7C00},
-	{0x41CFFFFFF7FFDFFE, 0x7C00},
-	{0x434FFFFFFFFEFFFF, 0x7C00},
-	{0xC3DFFFFFFF01FFFF, 0xFC00},
-	{0x434FFFFFFFFDFFFF, 0x7C00},
-	{0x391400000000FFFF, 0x0000},
-	{0x434FFFFFFFFBFFFF, 0x7C00},
-	{0xFFEFF7FFFDFFFFFE, 0xFC00},
-	{0x434FFFFFFFF7FFFF, 0x7C00},
-	{0xBF0000000000BFFF, 0x8200},
-	{0x434FFFFFFFEFFFFF, 0x7C00},
-	{0xB80000000001001F, 0x8000},
-	{0x434FFFFFFFDFFFFF, 0x7C00},
-	{0x42CFFFFFFFFFFFFF, 0x7C00},
-	{0x434FFFFFFFBFFFFF, 0x7C00},
-	{0xC001BF0D475BB873, 0xC070},
-	{0x434FFFFFFF7FFFFF, 0x7C00},
-	{0x431FFFFFFFFFDFFF, 0x7C00},
-	{0x434FFFFFFEFFFFFF, 0x7C00},
-	{0xC17FFFFFFE800000, 0xFC00},
-	{0x434FFFFFFDFFFFFF, 0x7C00},
-	{0xB7E100000000FFFE, 0x8000},
-	{0x434FFFFFFBFFFFFF, 0x7C00},
-	{0x531F58159DF6A6A7, 0x7C00},
-	{0x434FFFFFF7FFFFFF, 0x7C00},
-	{0xC3EFFFF800000000, 0xFC00},
-	{0x434FFFFFEFFFFFFF, 0x7C00},
-	{0x44F00000040001FF, 0x7C00},
-	{0x434FFFFFDFFFFFFF, 0x7C00},
-	{0xB7F00800000001FF, 0x8000},
-	{0x434FFFFFBFFFFFFF, 0x7C00},
-	{0x3F7FFFFDDFFFFFFF, 0x2000},
-	{0x434FFFFF7FFFFFFF, 0x7C00},
-	{0x3F9FFFFDFFFDFFFF, 0x2800},
-	{0x434FFFFEFFFFFFFF, 0x7C00},
-	{0x434FFFFFFFFF8002, 0x7C00},
-	{0x434FFFFDFFFFFFFF, 0x7C00},
-	{0x40646FD858ABA1C1, 0x591C},
-	{0x434FFFFBFFFFFFFF, 0x7C00},
-	{0x9AC0000040000000, 0x8000},
-	{0x434FFFF7FFFFFFFF, 0x7C00},
-	{0x400FFFFFFFFA0000, 0x4400},
-	{0x434FFFEFFFFFFFFF, 0x7C00},
-	{0x3E9FFFFFFFC07FFE, 0x0008},
-	{0x434FFFDFFFFFFFFF, 0x7C00},
-	{0xC091FFFFFFFFFBFE, 0xE480},
-	{0x434FFFBFFFFFFFFF, 0x7C00},
-	{0xB7F2AED2513C06B8, 0x8000},
-	{0x434FFF7FFFFFFFFF, 0x7C00},
-	{0x43FF44002F27DB87, 0x7C00},
-	{0x434FFEFFFFFFFFFF, 0x7C00},
-	{0x3FCFBDFFFFFFFFFF, 0x33EF},
-	{0x434FFDFFFFFFFFFF, 0x7C00},
-	{0x658752987A2B44BC, 0x7C00},
-	{0x434FFBFFFFFFFFFF, 0x7C00},
-	{0x403001E8F8BA808D, 0x4C00},
-	{0x434FF7FFFFFFFFFF, 0x7C00},
-	{0xC3FBFFFBFFFFFFFE, 0xFC00},
-	{0x434FEFFFFFFFFFFF, 0x7C00},
-	{0xBFD0000007FFFC00, 0xB400},
-	{0x434FDFFFFFFFFFFF, 0x7C00},
-	{0x3CC0EE6844E2F960, 0x0000},
-	{0x434FBFFFFFFFFFFF, 0x7C00},
-	{0xBFC0000000000FC0, 0xB000},
-	{0x434F7FFFFFFFFFFF, 0x7C00},
-	{0x3FCFFFFFF7FFFFFE, 0x3400},
-	{0x434EFFFFFFFFFFFF, 0x7C00},
-	{0x41CFFFFFDFFFFDFF, 0x7C00},
-	{0x434DFFFFFFFFFFFF, 0x7C00},
-	{0x47F400000003FFFE, 0x7C00},
-	{0x434BFFFFFFFFFFFF, 0x7C00},
-	{0x3CA0000400FFFFFE, 0x0000},
-	{0x4347FFFFFFFFFFFF, 0x7C00},
-	{0xAB637FFFFFFFFFFE, 0x8000},
-	{0x4343FFFFFFFFFFFF, 0x7C00},
-	{0x453FFFBEFFFFFFFF, 0x7C00},
-	{0x4341FFFFFFFFFFFF, 0x7C00},
-	{0x9BF0080000007FFF, 0x8000},
-	{0x4340FFFFFFFFFFFF, 0x7C00},
-	{0xBCA858C9C90AC270, 0x8000},
-	{0x43407FFFFFFFFFFF, 0x7C00},
-	{0xC1EC14B77032F6A2, 0xFC00},
-	{0x43403FFFFFFFFFFF, 0x7C00},
-	{0x37F8100000000000, 0x0000},
-	{0x43401FFFFFFFFFFF, 0x7C00},
-	{0xBFCC00000007FFFE, 0xB300},
-	{0x43400FFFFFFFFFFF, 0x7C00},
-	{0xC1F0003FFFFFFFFD, 0xFC00},
-	{0x434007FFFFFFFFFF, 0x7C00},
-	{0x3ECFFFE000000010, 0x0040},
-	{0x434003FFFFFFFFFF, 0x7C00},
-	{0xC78FFFFC20000000, 0xFC00},
-	{0x434001FFFFFFFFFF, 0x7C00},
-	{0x800FFFF7FFFF7FFF, 0x8000},
-	{0x434000FFFFFFFFFF, 0x7C00},
-	{0x3FD01FBFFFFFFFFE, 0x3408},
-	{0x4340007FFFFFFFFF, 0x7C00},
-	{0x3DC43DD6363F832B, 0x0000},
-	{0x4340003FFFFFFFFF, 0x7C00},
-	{0x801FFFFFFFE0007F, 0x8000},
-	{0x4340001FFFFFFFFF, 0x7C00},
-	{0xBE5FFFFFFFFEFE00, 0x8000},
-	{0x4340000FFFFFFFFF, 0x7C00},
-	{0xC1D00000003FFFFD, 0xFC00},
-	{0x43400007FFFFFFFF, 0x7C00},
-	{0xC439C72389928854, 0xFC00},
-	{0x43400003FFFFFFFF, 0x7C00},
-	{0x381007FFFFFFFDFF, 0x0000},
-	{0x43400001FFFFFFFF, 0x7C00},
-	{0x43F395C4912C737E, 0x7C00},
-	{0x43400000FFFFFFFF, 0x7C00},
-	{0x14DFFFE0000007FE, 0x0000},
-	{0x434000007FFFFFFF, 0x7C00},
-	{0x491971E858167A6B, 0x7C00},
-	{0x434000003FFFFFFF, 0x7C00},
-	{0x0A71FFFEFFFFFFFF, 0x0000},
-	{0x434000001FFFFFFF, 0x7C00},
-	{0xFFDFDCA6E4BCE763, 0xFC00},
-	{0x434000000FFFFFFF, 0x7C00},
-	{0x3FD00000FFFFFFFE, 0x3400},
-	{0x4340000007FFFFFF, 0x7C00},
-	{0xB9EFC000000FFFFF, 0x8000},
-	{0x4340000003FFFFFF, 0x7C00},
-	{0x47EFFFFFFFF00000, 0x7C00},
-	{0x4340000001FFFFFF, 0x7C00},
-	{0xC020000002000008, 0xC800},
-	{0x4340000000FFFFFF, 0x7C00},
-	{0xBFEFFFFFFFF00080, 0xBC00},
-	{0x43400000007FFFFF, 0x7C00},
-	{0x4037FFFFFFFFEFFF, 0x4E00},
-	{0x43400000003FFFFF, 0x7C00},
-	{0x0FC003F7FFFFFFFF, 0x0000},
-	{0x43400000001FFFFF, 0x7C00},
-	{0xC80513D9FEFD2020, 0xFC00},
-	{0x43400000000FFFFF, 0x7C00},
-	{0xDD9FFFFFF7FF7FFF, 0xFC00},
-	{0x434000000007FFFF, 0x7C00},
-	{0xBF977610DB4D3444, 0xA5DE},
-	{0x434000000003FFFF, 0x7C00},
-	{0xBFF0000000000FC0, 0xBC00},
-	{0x434000000001FFFF, 0x7C00},
-	{0xC17003FFFFFFFDFF, 0xFC00},
-	{0x434000000000FFFF, 0x7C00},
-	{0x411FFFFBFFFFFF00, 0x7C00},
-	{0x4340000000007FFF, 0x7C00},
-	{0xC1FFFFFFFFE0000E, 0xFC00},
-	{0x4340000000003FFF, 0x7C00},
-	{0x4048AEE9B7E73C7A, 0x522C},
-	{0x4340000000001FFF, 0x7C00},
-	{0x3FBB652749F43D74, 0x2ED9},
-	{0x4340000000000FFF, 0x7C00},
-	{0xBF9FFFFFFF00003F, 0xA800},
-	{0x43400000000007FF, 0x7C00},
-	{0xBFFFFFFE007FFFFE, 0xC000},
-	{0x43400000000003FF, 0x7C00},
-	{0xF5DFC01FFFFFFFFF, 0xFC00},
-	{0x43400000000001FF, 0x7C00},
-	{0xC01FFFFFFFFFC006, 0xC800},
-	{0x43400000000000FF, 0x7C00},
-	{0xC0267F5DF4EE8197, 0xC9A0},
-	{0x434000000000007F, 0x7C00},
-	{0x3FE5E2EC657DF386, 0x3979},
-	{0x434000000000003F, 0x7C00},
-	{0x40100000007FFFEF, 0x4400},
-	{0x434000000000001F, 0x7C00},
-	{0xC0A003FFEFFFFFFF, 0xE801},
-	{0x434000000000000F, 0x7C00},
-	{0xBF9FFFFFBFFFFDFE, 0xA800},
-	{0x4340000000000007, 0x7C00},
-	{0xB25800001FFFFFFF, 0x8000},
-	{0x4340000000000003, 0x7C00},
-	{0x435FFFFDFFFEFFFF, 0x7C00},
-	{0x43C0000000000000, 0x7C00},
-	{0x47ECD03734A640F8, 0x7C00},
-	{0x43C0000000000001, 0x7C00},
-	{0x400FFFFDFFF7FFFF, 0x4400},
-	{0x43C0000000000002, 0x7C00},
-	{0xBFE00000FEFFFFFE, 0xB800},
-	{0x43C0000000000004, 0x7C00},
-	{0xC3C0000000005FFE, 0xFC00},
-	{0x43C0000000000008, 0x7C00},
-	{0x002D537D27B1C127, 0x0000},
-	{0x43C0000000000010, 0x7C00},
-	{0xBFBFE00000FFFFFF, 0xAFF8},
-	{0x43C0000000000020, 0x7C00},
-	{0xC03D81AF925DDA4A, 0xCF60},
-	{0x43C0000000000040, 0x7C00},
-	{0x412FFEFFFF800000, 0x7C00},
-	{0x43C0000000000080, 0x7C00},
-	{0xC7EFFFFFFFFBFBFE, 0xFC00},
-	{0x43C0000000000100, 0x7C00},
-	{0xB80FFFE000000000, 0x8000},
-	{0x43C0000000000200, 0x7C00},
-	{0x4122279499B147A3, 0x7C00},
-	{0x43C0000000000400, 0x7C00},
-	{0xBFEE209FBD26C7F0, 0xBB88},
-	{0x43C0000000000800, 0x7C00},
-	{0x7FE02FFFFFFFFFFE, 0x7C00},
-	{0x43C0000000001000, 0x7C00},
-	{0xC010000000000404, 0xC400},
-	{0x43C0000000002000, 0x7C00},
-	{0xC03FFFFF3FFFFFFF, 0xD000},
-	{0x43C0000000004000, 0x7C00},
-	{0x45B007FFFFF7FFFF, 0x7C00},
-	{0x43C0000000008000, 0x7C00},
-	{0x37EFFFFDFFFFFF00, 0x0000},
-	{0x43C0000000010000, 0x7C00},
-	{0x802FC0001FFFFFFF, 0x8000},
-	{0x43C0000000020000, 0x7C00},
-	{0xBF7000010000FFFF, 0x9C00},
-	{0x43C0000000040000, 0x7C00},
-	{0xC020000FDFFFFFFF, 0xC800},
-	{0x43C0000000080000, 0x7C00},
-	{0xC80FFFA000000000, 0xFC00},
-	{0x43C0000000100000, 0x7C00},
-	{0x3FDC000000000001, 0x3700},
-	{0x43C0000000200000, 0x7C00},
-	{0x37BFFFFE00000800, 0x0000},
-	{0x43C0000000400000, 0x7C00},
-	{0xC01FFFFFF8002000, 0xC800},
-	{0x43C0000000800000, 0x7C00},
-	{0x3EEFFFFFFFFFBFC0, 0x0100},
-	{0x43C0000001000000, 0x7C00},
-	{0xC02B3A552E4DDB8D, 0xCACF},
-	{0x43C0000002000000, 0x7C00},
-	{0xC1FFFFFFFFFFDFDF, 0xFC00},
-	{0x43C0000004000000, 0x7C00},
-	{0x379E000002000000, 0x0000},
-	{0x43C0000008000000, 0x7C00},
-	{0x39C70FA4600A0A15, 0x0000},
-	{0x43C0000010000000, 0x7C00},
-	{0xBFE8D78FE9CF6418, 0xBA36},
-	{0x43C0000020000000, 0x7C00},
-	{0x3FD000000020000F, 0x3400},
-	{0x43C0000040000000, 0x7C00},
-	{0x3FEFFFFFFFEDFFFF, 0x3C00},
-	{0x43C0000080000000, 0x7C00},
-	{0x3804000000200000, 0x0000},
-	{0x43C0000100000000, 0x7C00},
-	{0x4090080003FFFFFE, 0x6402},
-	{0x43C0000200000000, 0x7C00},
-	{0x3CA78D7258DFE79D, 0x0000},
-	{0x43C0000400000000, 0x7C00},
-	{0x47E56D4B9F23419A, 0x7C00},
-	{0x43C0000800000000, 0x7C00},
-	{0x00FFFFFFFFFFFE80, 0x0000},
-	{0x43C0001000000000, 0x7C00},
-	{0xB810732BF7FB7448, 0x8000},
-	{0x43C0002000000000, 0x7C00},
-	{0x800FFFFFFFF78000, 0x8000},
-	{0x43C0004000000000, 0x7C00},
-	{0x41F0F7FFFFFFFFFF, 0x7C00},
-	{0x43C0008000000000, 0x7C00},
-	{0x40661D813FEBAF3B, 0x5987},
-	{0x43C0010000000000, 0x7C00},
-	{0x339FFFFFE00007FF, 0x0000},
-	{0x43C0020000000000, 0x7C00},
-	{0x41EFFFFFFDEFFFFF, 0x7C00},
-	{0x43C0040000000000, 0x7C00},
-	{0x3810002000000080, 0x0000},
-	{0x43C0080000000000, 0x7C00},
-	{0x40BFFFFFE1000000, 0x7000},
-	{0x43C0100000000000, 0x7C00},
-	{0x3FB28F5534D36398, 0x2CA4},
-	{0x43C0200000000000, 0x7C00},
-	{0xBEB6CB165D1CB66E, 0x8017},
-	{0x43C0400000000000, 0x7C00},
-	{0x404FFFFFFFFFDEFF, 0x5400},
-	{0x43C0800000000000, 0x7C00},
-	{0x3C3FFFFFDFFFFFFE, 0x0000},
-	{0x43C1000000000000, 0x7C00},
-	{0x3F60BBF21B7D939F, 0x182F},
-	{0x43C2000000000000, 0x7C00},
-	{0xC1CFFFFFFFFFEFEE, 0xFC00},
-	{0x43C4000000000000, 0x7C00},
-	{0xBF30003FFFFFF800, 0x8C00},
-	{0x43C8000000000000, 0x7C00},
-	{0x380D563B164BB37F, 0x0000},
-	{0x43CC000000000000, 0x7C00},
-	{0xFFEFFFFFFFFFF1FF, 0xFC00},
-	{0x43CE000000000000, 0x7C00},
-	{0x6EB000040000007F, 0x7C00},
-	{0x43CF000000000000, 0x7C00},
-	{0x40200FFFDFFFFFFF, 0x4804},
-	{0x43CF800000000000, 0x7C00},
-	{0xBD6B1A3B935E2FCD, 0x8000},
-	{0x43CFC00000000000, 0x7C00},
-	{0xFFEDF60CA323ABC1, 0xFC00},
-	{0x43CFE00000000000, 0x7C00},
-	{0xBFE00000000003BE, 0xB800},
-	{0x43CFF00000000000, 0x7C00},
-	{0xC03A3E800A55DB9A, 0xCE90},
-	{0x43CFF80000000000, 0x7C00},
-	{0xBFB00080000001FF, 0xAC00},
-	{0x43CFFC0000000000, 0x7C00},
-	{0xC053A857C66F0EEF, 0xD4EA},
-	{0x43CFFE0000000000, 0x7C00},
-	{0x43D0020000FFFFFE, 0x7C00},
-	{0x43CFFF0000000000, 0x7C00},
-	{0xB810000004000020, 0x8000},
-	{0x43CFFF8000000000, 0x7C00},
-	{0xC14E39BFE7C3CDA2, 0xFC00},
-	{0x43CFFFC000000000, 0x7C00},
-	{0x4055A91850CD75E7, 0x556A},
-	{0x43CFFFE000000000, 0x7C00},
-	{0xC0307C0000000000, 0xCC1F},
-	{0x43CFFFF000000000, 0x7C00},
-	{0xBFD0003FFFFFFBFE, 0xB400},
-	{0x43CFFFF800000000, 0x7C00},
-	{0x801C00000FFFFFFF, 0x8000},
-	{0x43CFFFFC00000000, 0x7C00},
-	{0x37EFFFFFFDFDFFFF, 0x0000},
-	{0x43CFFFFE00000000, 0x7C00},
-	{0xBC200000001FFFFF, 0x8000},
-	{0x43CFFFFF00000000, 0x7C00},
-	{0xB7FFFFFFFFFFDFEF, 0x8000},
-	{0x43CFFFFF80000000, 0x7C00},
-	{0xBFB38D7559DB34C5, 0xACE3},
-	{0x43CFFFFFC0000000, 0x7C00},
-	{0x401FFFFFFFFFBDFF, 0x4800},
-	{0x43CFFFFFE0000000, 0x7C00},
-	{0xB772E019ABE869F4, 0x8000},
-	{0x43CFFFFFF0000000, 0x7C00},
-	{0xC01885F8229B80FC, 0xC621},
-	{0x43CFFFFFF8000000, 0x7C00},
-	{0xB90000010001FFFF, 0x8000},
-	{0x43CFFFFFFC000000, 0x7C00},
-	{0x361FFFDFFFFFFFFF, 0x0000},
-	{0x43CFFFFFFE000000, 0x7C00},
-	{0x40107FFFFFFE0000, 0x4420},
-	{0x43CFFFFFFF000000, 0x7C00},
-	{0x0000000000003FFF, 0x0000},
-	{0x43CFFFFFFF800000, 0x7C00},
-	{0x3EEFF80000020000, 0x0100},
-	{0x43CFFFFFFFC00000, 0x7C00},
-	{0x380FDFFFF8000000, 0x0000},
-	{0x43CFFFFFFFE00000, 0x7C00},
-	{0x4345E5FD3D563E9C, 0x7C00},
-	{0x43CFFFFFFFF00000, 0x7C00},
-	{0xC3CFFFFFFFE00004, 0xFC00},
-	{0x43CFFFFFFFF80000, 0x7C00},
-	{0xC3C000010007FFFF, 0xFC00},
-	{0x43CFFFFFFFFC0000, 0x7C00},
-	{0x3F5FFFFFF8FFFFFF, 0x1800},
-	{0x43CFFFFFFFFE0000, 0x7C00},
-	{0xFFFFFFFFFFFF00FE, 0xFFFF},
-	{0x43CFFFFFFFFF0000, 0x7C00},
-	{0xAC1FFF7FFFFFFFFE, 0x8000},
-	{0x43CFFFFFFFFF8000, 0x7C00},
-	{0xC807D10269BB3A85, 0xFC00},
-	{0x43CFFFFFFFFFC000, 0x7C00},
-	{0xB96FF6FFFFFFFFFF, 0x8000},
-	{0x43CFFFFFFFFFE000, 0x7C00},
-	{0xC26FFFEFFBFFFFFF, 0xFC00},
-	{0x43CFFFFFFFFFF000, 0x7C00},
-	{0x4800768773B2BB67, 0x7C00},
-	{0x43CFFFFFFFFFF800, 0x7C00},
-	{0xBF3FDFF7FFFFFFFF, 0x8FF8},
-	{0x43CFFFFFFFFFFC00, 0x7C00},
-	{0x43F937B0F3B286F3, 0x7C00},
-	{0x43CFFFFFFFFFFE00, 0x7C00},
-	{0x3FD400001FFFFFFF, 0x3500},
-	{0x43CFFFFFFFFFFF00, 0x7C00},
-	{0xBFC142C183181196, 0xB051},
-	{0x43CFFFFFFFFFFF80, 0x7C00},
-	{0x344FFEFFFFFFFFF0, 0x0000},
-	{0x43CFFFFFFFFFFFC0, 0x7C00},
-	{0xE9EFFEDFFFFFFFFF, 0xFC00},
-	{0x43CFFFFFFFFFFFE0, 0x7C00},
-	{0x6675CBA187F51F65, 0x7C00},
-	{0x43CFFFFFFFFFFFF0, 0x7C00},
-	{0x3C6A6619DDFDAB8E, 0x0000},
-	{0x43CFFFFFFFFFFFF8, 0x7C00},
-	{0xAAAFFBFFFFFFFBFE, 0x8000},
-	{0x43CFFFFFFFFFFFFC, 0x7C00},
-	{0xBFB000003FFF0000, 0xAC00},
-	{0x43CFFFFFFFFFFFFE, 0x7C00},
-	{0xC040000000020FFF, 0xD000},
-	{0x43CFFFFFFFFFFFFF, 0x7C00},
-	{0x40700000081FFFFF, 0x5C00},
-	{0x43CFFFFFFFFFFFFD, 0x7C00},
-	{0x7FF080000000003F, 0x7E20},
-	{0x43CFFFFFFFFFFFFB, 0x7C00},
-	{0x803BFFFFFFFFFFBF, 0x8000},
-	{0x43CFFFFFFFFFFFF7, 0x7C00},
-	{0xB7FFFEFFFFFFFDFF, 0x8000},
-	{0x43CFFFFFFFFFFFEF, 0x7C00},
-	{0x3FD0000070000000, 0x3400},
-	{0x43CFFFFFFFFFFFDF, 0x7C00},
-	{0x54F0003FFE000000, 0x7C00},
-	{0x43CFFFFFFFFFFFBF, 0x7C00},
-	{0xB01FFFFFF8000010, 0x8000},
-	{0x43CFFFFFFFFFFF7F, 0x7C00},
-	{0x5EB07DFFFFFFFFFF, 0x7C00},
-	{0x43CFFFFFFFFFFEFF, 0x7C00},
-	{0x40100200007FFFFF, 0x4401},
-	{0x43CFFFFFFFFFFDFF, 0x7C00},
-	{0xC02BFFFFFFFFFFEE, 0xCB00},
-	{0x43CFFFFFFFFFFBFF, 0x7C00},
-	{0x47EFFFFFFFFFFFFF, 0x7C00},
-	{0x43CFFFFFFFFFF7FF, 0x7C00},
-	{0x3FF0000000000207, 0x3C00},
-	{0x43CFFFFFFFFFEFFF, 0x7C00},
-	{0xEDC1FFFFFFFDFFFF, 0xFC00},
-	{0x43CFFFFFFFFFDFFF, 0x7C00},
-	{0x002BFFFFFF7FFFFE, 0x0000},
-	{0x43CFFFFFFFFFBFFF, 0x7C00},
-	{0xC3EFFFFFFFFFF8FF, 0xFC00},
-	{0x43CFFFFFFFFF7FFF, 0x7C00},
-	{0xC3E0000002000040, 0xFC00},
-	{0x43CFFFFFFFFEFFFF, 0x7C00},
-	{0xBFB1000000007FFF, 0xAC40},
-	{0x43CFFFFFFFFDFFFF, 0x7C00},
-	{0x47F66EA436AFC707, 0x7C00},
-	{0x43CFFFFFFFFBFFFF, 0x7C00},
-	{0xC800000000007FF8, 0xFC00},
-	{0x43CFFFFFFFF7FFFF, 0x7C00},
-	{0x3FD0000007FFBFFF, 0x3400},
-	{0x43CFFFFFFFEFFFFF, 0x7C00},
-	{0xC0465C81C7A7ED31, 0xD197},
-	{0x43CFFFFFFFDFFFFF, 0x7C00},
-	{0x459DE25755B60276, 0x7C00},
-	{0x43CFFFFFFFBFFFFF, 0x7C00},
-	{0xEDFFFC0000000008, 0xFC00},
-	{0x43CFFFFFFF7FFFFF, 0x7C00},
-	{0x3C6FFFFF8000001F, 0x0000},
-	{0x43CFFFFFFEFFFFFF, 0x7C00},
-	{0x37E242DF518AF6F2, 0x0000},
-	{0x43CFFFFFFDFFFFFF, 0x7C00},
-	{0x802DFFFF7FFFFFFF, 0x8000},
-	{0x43CFFFFFFBFFFFFF, 0x7C00},
-	{0xBE1FF62B5C1FCCA8, 0x8000},
-	{0x43CFFFFFF7FFFFFF, 0x7C00},
-	{0x47EFFFF7FFFFFF00, 0x7C00},
-	{0x43CFFFFFEFFFFFFF, 0x7C00},
-	{0x380FF7FFFFFFEFFF, 0x0000},
-	{0x43CFFFFFDFFFFFFF, 0x7C00},
-	{0x434FFFFFFFFFF7FD, 0x7C00},
-	{0x43CFFFFFBFFFFFFF, 0x7C00},
-	{0xC014000040000000, 0xC500},
-	{0x43CFFFFF7FFFFFFF, 0x7C00},
-	{0xFFF673050997EDC3, 0xFF9C},
-	{0x43CFFFFEFFFFFFFF, 0x7C00},
-	{0x4003547FCD7984CC, 0x40D5},
-	{0x43CFFFFDFFFFFFFF, 0x7C00},
-	{0xC017993AF090FD83, 0xC5E6},
-	{0x43CFFFFBFFFFFFFF, 0x7C00},
-	{0xCCF00000101FFFFF, 0xFC00},
-	{0x43CFFFF7FFFFFFFF, 0x7C00},
-	{0x43D0010040000000, 0x7C00},
-	{0x43CFFFEFFFFFFFFF, 0x7C00},
-	{0xC031CDDEE91DBEDB, 0xCC73},
-	{0x43CFFFDFFFFFFFFF, 0x7C00},
-	{0xBFC00000000FFBFE, 0xB000},
-	{0x43CFFFBFFFFFFFFF, 0x7C00},
-	{0x4000000000001F7F, 0x4000},
-	{0x43CFFF7FFFFFFFFF, 0x7C00},
-	{0x3F7FFFFFF7FFBFFF, 0x2000},
-	{0x43CFFEFFFFFFFFFF, 0x7C00},
-	{0xF70FFFFFDFF7FFFF, 0xFC00},
-	{0x43CFFDFFFFFFFFFF, 0x7C00},
-	{0xC1E0020000080000, 0xFC00},
-	{0x43CFFBFFFFFFFFFF, 0x7C00},
-	{0x434FFFFFFFFFFFFD, 0x7C00},
-	{0x43CFF7FFFFFFFFFF, 0x7C00},
-	{0xC02FFFFFFFFE0FFF, 0xCC00},
-	{0x43CFEFFFFFFFFFFF, 0x7C00},
-	{0xB8161A0939FC3213, 0x8000},
-	{0x43CFDFFFFFFFFFFF, 0x7C00},
-	{0xBFB0000040000007, 0xAC00},
-	{0x43CFBFFFFFFFFFFF, 0x7C00},
-	{0x0BA0000000040FFE, 0x0000},
-	{0x43CF7FFFFFFFFFFF, 0x7C00},
-	{0xBCAFFFFF0000003F, 0x8000},
-	{0x43CEFFFFFFFFFFFF, 0x7C00},
-	{0xBFE00000FFFFFFFF, 0xB800},
-	{0x43CDFFFFFFFFFFFF, 0x7C00},
-	{0x42670FBA59A05390, 0x7C00},
-	{0x43CBFFFFFFFFFFFF, 0x7C00},
-	{0x3360007FFFFC0000, 0x0000},
-	{0x43C7FFFFFFFFFFFF, 0x7C00},
-	{0x40200000001FFC00, 0x4800},
-	{0x43C3FFFFFFFFFFFF, 0x7C00},
-	{0x3FFFFEFFFDFFFFFE, 0x4000},
-	{0x43C1FFFFFFFFFFFF, 0x7C00},
-	{0x7FF03FFEFFFFFFFE, 0x7E0F},
-	{0x43C0FFFFFFFFFFFF, 0x7C00},
-	{0x3CAF40C3D3B09335, 0x0000},
-	{0x43C07FFFFFFFFFFF, 0x7C00},
-	{0xF4A8114F4CBF2126, 0xFC00},
-	{0x43C03FFFFFFFFFFF, 0x7C00},
-	{0xBF9FFFFFFFFA0000, 0xA800},
-	{0x43C01FFFFFFFFFFF, 0x7C00},
-	{0xC0300001FFFFFDFF, 0xCC00},
-	{0x43C00FFFFFFFFFFF, 0x7C00},
-	{0x3FF788C09CCFECE7, 0x3DE2},
-	{0x43C007FFFFFFFFFF, 0x7C00},
-	{0x285FFFFFFFFE007E, 0x0000},
-	{0x43C003FFFFFFFFFF, 0x7C00},
-	{0xAF96DDE05E6EE552, 0x8000},
-	{0x43C001FFFFFFFFFF, 0x7C00},
-	{0xAA4A93DC9E625216, 0x8000},
-	{0x43C000FFFFFFFFFF, 0x7C00},
-	{0xBF5FCD1F4C5D7ECD, 0x97F3},
-	{0x43C0007FFFFFFFFF, 0x7C00},
-	{0xB7EFF000000007FF, 0x8000},
-	{0x43C0003FFFFFFFFF, 0x7C00},
-	{0xC1F53152DA5517F6, 0xFC00},
-	{0x43C0001FFFFFFFFF, 0x7C00},
-	{0x4090004000000001, 0x6400},
-	{0x43C0000FFFFFFFFF, 0x7C00},
-	{0x400DFFFFFDFFFFFF, 0x4380},
-	{0x43C00007FFFFFFFF, 0x7C00},
-	{0xE140FFFFFFEFFFFF, 0xFC00},
-	{0x43C00003FFFFFFFF, 0x7C00},
-	{0xC020000000001FC0, 0xC800},
-	{0x43C00001FFFFFFFF, 0x7C00},
-	{0xBE1FFF77FFFFFFFF, 0x8000},
-	{0x43C00000FFFFFFFF, 0x7C00},
-	{0x0019B5368D8E8FF6, 0x0000},
-	{0x43C000007FFFFFFF, 0x7C00},
-	{0x3CA00000000001FD, 0x0000},
-	{0x43C000003FFFFFFF, 0x7C00},
-	{0xFFFF840000000000, 0xFFE1},
-	{0x43C000001FFFFFFF, 0x7C00},
-	{0x7FD00000800000FF, 0x7C00},
-	{0x43C000000FFFFFFF, 0x7C00},
-	{0xC02FBFFFFFFFFF7F, 0xCBF0},
-	{0x43C0000007FFFFFF, 0x7C00},
-	{0x37E0000007FFFFDF, 0x0000},
-	{0x43C0000003FFFFFF, 0x7C00},
-	{0xC03FFFFF00200000, 0xD000},
-	{0x43C0000001FFFFFF, 0x7C00},
-	{0x404D40D9EDF67238, 0x5350},
-	{0x43C0000000FFFFFF, 0x7C00},
-	{0x802007FFFFFE0000, 0x8000},
-	{0x43C00000007FFFFF, 0x7C00},
-	{0x41B000000000FEFF, 0x7C00},
-	{0x43C00000003FFFFF, 0x7C00},
-	{0xB7F0000000FBFFFF, 0x8000},
-	{0x43C00000001FFFFF, 0x7C00},
-	{0x401F7FFFFFEFFFFF, 0x47E0},
-	{0x43C00000000FFFFF, 0x7C00},
-	{0xC1C000000007BFFE, 0xFC00},
-	{0x43C000000007FFFF, 0x7C00},
-	{0x3DEFFFFF7FFFFFEF, 0x0000},
-	{0x43C000000003FFFF, 0x7C00},
-	{0xC3F10000FFFFFFFF, 0xFC00},
-	{0x43C000000001FFFF, 0x7C00},
-	{0xBFFFFFFFDFFE0000, 0xC000},
-	{0x43C000000000FFFF, 0x7C00},
-	{0xC1F00000001FFBFF, 0xFC00},
-	{0x43C0000000007FFF, 0x7C00},
-	{0xC3F2000007FFFFFF, 0xFC00},
-	{0x43C0000000003FFF, 0x7C00},
-	{0x27AFFFFFFFFE0080, 0x0000},
-	{0x43C0000000001FFF, 0x7C00},
-	{0xC03002000007FFFF, 0xCC01},
-	{0x43C0000000000FFF, 0x7C00},
-	{0x3AB1FFFFFFFFFFFE, 0x0000},
-	{0x43C00000000007FF, 0x7C00},
-	{0x489000007FFFFFDF, 0x7C00},
-	{0x43C00000000003FF, 0x7C00},
-	{0x3FBFFFFFFFFFEFFF, 0x3000},
-	{0x43C00000000001FF, 0x7C00},
-	{0xC17FDFFFFFBFFFFE, 0xFC00},
-	{0x43C00000000000FF, 0x7C00},
-	{0xC3D83B597812298F, 0xFC00},
-	{0x43C000000000007F, 0x7C00},
-	{0xC02FFFFFF7FFEFFE, 0xCC00},
-	{0x43C000000000003F, 0x7C00},
-	{0x9BDFFFDDFFFFFFFF, 0x8000},
-	{0x43C000000000001F, 0x7C00},
-	{0x8C1FE00000FFFFFF, 0x8000},
-	{0x43C000000000000F, 0x7C00},
-	{0x3FEFFFF80FFFFFFF, 0x3C00},
-	{0x43C0000000000007, 0x7C00},
-	{0x4010D5D48459D6ED, 0x4435},
-	{0x43C0000000000003, 0x7C00},
-	{0xFFD00000003F7FFF, 0xFC00},
-	{0x43D0000000000000, 0x7C00},
-	{0x8028687CBB5CC31C, 0x8000},
-	{0x43D0000000000001, 0x7C00},
-	{0x51BFDFF000000000, 0x7C00},
-	{0x43D0000000000002, 0x7C00},
-	{0x3800401FFFFFFFFE, 0x0000},
-	{0x43D0000000000004, 0x7C00},
-	{0x4010FFFFFFFFFFFF, 0x4440},
-	{0x43D0000000000008, 0x7C00},
-	{0x4000000000001FFE, 0x4000},
-	{0x43D0000000000010, 0x7C00},
-	{0x47F00F8000000000, 0x7C00},
-	{0x43D0000000000020, 0x7C00},
-	{0xBBB0001FFFFFDFFF, 0x8000},
-	{0x43D0000000000040, 0x7C00},
-	{0x3FFFFFFFFFFFF1FE, 0x4000},
-	{0x43D0000000000080, 0x7C00},
-	{0xC3A0000FFFFFFFBE, 0xFC00},
-	{0x43D0000000000100, 0x7C00},
-	{0x3E80000001FF7FFE, 0x0002},
-	{0x43D0000000000200, 0x7C00},
-	{0xBFB0000000000880, 0xAC00},
-	{0x43D0000000000400, 0x7C00},
-	{0x4036AF2D3B5480AB, 0x4DAC},
-	{0x43D0000000000800, 0x7C00},
-	{0xFFD0000000000047, 0xFC00},
-	{0x43D0000000001000, 0x7C00},
-	{0xC1EFFFFFFFFFFFF2, 0xFC00},
-	{0x43D0000000002000, 0x7C00},
-	{0xC00126225FECD0FE, 0xC04A},
-	{0x43D0000000004000, 0x7C00},
-	{0x021841BF5551CC10, 0x0000},
-	{0x43D0000000008000, 0x7C00},
-	{0x3E5AD5B29C20BA46, 0x0000},
-	{0x43D0000000010000, 0x7C00},
-	{0xC0507FFFFFEFFFFF, 0xD420},
-	{0x43D0000000020000, 0x7C00},
-	{0x47EDB88F57AEA33B, 0x7C00},
-	{0x43D0000000040000, 0x7C00},
-	{0x3EE0007FE0000000, 0x0080},
-	{0x43D0000000080000, 0x7C00},
-	{0xC3E00010001FFFFF, 0xFC00},
-	{0x43D0000000100000, 0x7C00},
-	{0x41FFFEFFC0000000, 0x7C00},
-	{0x43D0000000200000, 0x7C00},
-	{0x4030200800000000, 0x4C08},
-	{0x43D0000000400000, 0x7C00},
-	{0xBFE00001DFFFFFFF, 0xB800},
-	{0x43D0000000800000, 0x7C00},
-	{0x4030000003FFFFFF, 0x4C00},
-	{0x43D0000001000000, 0x7C00},
-	{0x7D3000001FFBFFFF, 0x7C00},
-	{0x43D0000002000000, 0x7C00},
-	{0xC06F15E8D1B808D9, 0xDBC5},
-	{0x43D0000004000000, 0x7C00},
-	{0x3F80008000000100, 0x2000},
-	{0x43D0000008000000, 0x7C00},
-	{0x3CA0FFFFFFFFFFDF, 0x0000},
-	{0x43D0000010000000, 0x7C00},
-	{0xDDF76F4A1B072CF9, 0xFC00},
-	{0x43D0000020000000, 0x7C00},
-	{0x3F8FFFF800010000, 0x2400},
-	{0x43D0000040000000, 0x7C00},
-	{0xC1E07FFBFFFFFFFF, 0xFC00},
-	{0x43D0000080000000, 0x7C00},
-	{0xFFEA26518B5C44C3, 0xFC00},
-	{0x43D0000100000000, 0x7C00},
-	{0xFFDFFF003FFFFFFE, 0xFC00},
-	{0x43D0000200000000, 0x7C00},
-	{0xBE30000000FFFFDE, 0x8000},
-	{0x43D0000400000000, 0x7C00},
-	{0xBF07A187AB6163D9, 0x82F4},
-	{0x43D0000800000000, 0x7C00},
-	{0x3D4FAF24B08A30C9, 0x0000},
-	{0x43D0001000000000, 0x7C00},
-	{0x434000007FFFF7FF, 0x7C00},
-	{0x43D0002000000000, 0x7C00},
-	{0x7C0007FFF8000000, 0x7C00},
-	{0x43D0004000000000, 0x7C00},
-	{0xBFEACE1013F95318, 0xBAB4},
-	{0x43D0008000000000, 0x7C00},
-	{0x00200001FFFFFEFF, 0x0000},
-	{0x43D0010000000000, 0x7C00},
-	{0x3E40000FFFFFEFFE, 0x0000},
-	{0x43D0020000000000, 0x7C00},
-	{0x055FFFFE00001FFF, 0x0000},
-	{0x43D0040000000000, 0x7C00},
-	{0xFFEFF7FFFEFFFFFF, 0xFC00},
-	{0x43D0080000000000, 0x7C00},
-	{0xC1C7910AB1B13FBB, 0xFC00},
-	{0x43D0100000000000, 0x7C00},
-	{0xBFFFFFFF7FFFFFFF, 0xC000},
-	{0x43D0200000000000, 0x7C00},
-	{0x39FC000100000000, 0x0000},
-	{0x43D0400000000000, 0x7C00},
-	{0x10EFFFFBFDFFFFFF, 0x0000},
-	{0x43D0800000000000, 0x7C00},
-	{0xBFCFFFFE003FFFFF, 0xB400},
-	{0x43D1000000000000, 0x7C00},
-	{0x3FF8000000000000, 0x3E00},
-	{0x43D2000000000000, 0x7C00},
-	{0x3E9FFFFF00400000, 0x0008},
-	{0x43D4000000000000, 0x7C00},
-	{0xBFCFFFFFFFFBBFFE, 0xB400},
-	{0x43D8000000000000, 0x7C00},
-	{0xC7EFFFFFFFDFEFFF, 0xFC00},
-	{0x43DC000000000000, 0x7C00},
-	{0x3FDFFFFFFFFC007E, 0x3800},
-	{0x43DE000000000000, 0x7C00},
-	{0xBCA00000000100FF, 0x8000},
-	{0x43DF000000000000, 0x7C00},
-	{0xC75FEF6DA42DA266, 0xFC00},
-	{0x43DF800000000000, 0x7C00},
-	{0x41DFFFFFFFFFFFBE, 0x7C00},
-	{0x43DFC00000000000, 0x7C00},
-	{0xB65FFEFFFFFFFFFB, 0x8000},
-	{0x43DFE00000000000, 0x7C00},
-	{0xC02FFFFFFFDFFFFC, 0xCC00},
-	{0x43DFF00000000000, 0x7C00},
-	{0xA4F0000000404000, 0x8000},
-	{0x43DFF80000000000, 0x7C00},
-	{0xC07FFFFFFFFFFFFE, 0xE000},
-	{0x43DFFC0000000000, 0x7C00},
-	{0x402FFFFFFBFFFDFF, 0x4C00},
-	{0x43DFFE0000000000, 0x7C00},
-	{0x37FFFFFDFEFFFFFF, 0x0000},
-	{0x43DFFF0000000000, 0x7C00},
-	{0x3E9FFFFC00000002, 0x0008},
-	{0x43DFFF8000000000, 0x7C00},
-	{0xC1C000000000003F, 0xFC00},
-	{0x43DFFFC000000000, 0x7C00},
-	{0xB80FFF7FFFFFF7FE, 0x8000},
-	{0x43DFFFE000000000, 0x7C00},
-	{0xC030B1C91CB63A3A, 0xCC2C},
-	{0x43DFFFF000000000, 0x7C00},
-	{0xC004368EDEDD3835, 0xC10E},
-	{0x43DFFFF800000000, 0x7C00},
-	{0x802F7FFFFFFFFF00, 0x8000},
-	{0x43DFFFFC00000000, 0x7C00},
-	{0x4007C2A52BE3ADA4, 0x41F1},
-	{0x43DFFFFE00000000, 0x7C00},
-	{0x0CCFFE0000001000, 0x0000},
-	{0x43DFFFFF00000000, 0x7C00},
-	{0x4E0FE000007FFFFF, 0x7C00},
-	{0x43DFFFFF80000000, 0x7C00},
-	{0x40307892B6900A5D, 0x4C1E},
-	{0x43DFFFFFC0000000, 0x7C00},
-	{0x47F03FDFFFFFFFFF, 0x7C00},
-	{0x43DFFFFFE0000000, 0x7C00},
-	{0x80013F89EDEF5DFB, 0x8000},
-	{0x43DFFFFFF0000000, 0x7C00},
-	{0x41F88314615F3ECA, 0x7C00},
-	{0x43DFFFFFF8000000, 0x7C00},
-	{0x37F000200007FFFE, 0x0000},
-	{0x43DFFFFFFC000000, 0x7C00},
-	{0x480FFFFBDFFFFFFF, 0x7C00},
-	{0x43DFFFFFFE000000, 0x7C00},
-	{0x3FBFEFEFFFFFFFFE, 0x2FFC},
-	{0x43DFFFFFFF000000, 0x7C00},
-	{0xF1D86BACB6462C72, 0xFC00},
-	{0x43DFFFFFFF800000, 0x7C00},
-	{0x3FFF7FFFFFFFEFFE, 0x3FE0},
-	{0x43DFFFFFFFC00000, 0x7C00},
-	{0x40700DFFFFFFFFFF, 0x5C03},
-	{0x43DFFFFFFFE00000, 0x7C00},
-	{0x43F5EAC790B00C2E, 0x7C00},
-	{0x43DFFFFFFFF00000, 0x7C00},
-	{0xF99000001F7FFFFF, 0xFC00},
-	{0x43DFFFFFFFF80000, 0x7C00},
-	{0x480111D04FE8C8FB, 0x7C00},
-	{0x43DFFFFFFFFC0000, 0x7C00},
-	{0x3F1FF0003FFFFFFF, 0x07FC},
-	{0x43DFFFFFFFFE0000, 0x7C00},
-	{0x3A9FFFFFFC0001FF, 0x0000},
-	{0x43DFFFFFFFFF0000, 0x7C00},
-	{0xAF5000000000201F, 0x8000},
-	{0x43DFFFFFFFFF8000, 0x7C00},
-	{0x3FD0000001E00000, 0x3400},
-	{0x43DFFFFFFFFFC000, 0x7C00},
-	{0x7FDFC000000007FE, 0x7C00},
-	{0x43DFFFFFFFFFE000, 0x7C00},
-	{0x43C000FFFFF00000, 0x7C00},
-	{0x43DFFFFFFFFFF000, 0x7C00},
-	{0x4030000000003FE0, 0x4C00},
-	{0x43DFFFFFFFFFF800, 0x7C00},
-	{0x3FF0007FFFFFFFDF, 0x3C00},
-	{0x43DFFFFFFFFFFC00, 0x7C00},
-	{0x60731ABE6EA40C08, 0x7C00},
-	{0x43DFFFFFFFFFFE00, 0x7C00},
-	{0x5D60040000100000, 0x7C00},
-	{0x43DFFFFFFFFFFF00, 0x7C00},
-	{0x38199CF0DA88BED6, 0x0000},
-	{0x43DFFFFFFFFFFF80, 0x7C00},
-	{0xB7FFFF7FEFFFFFFE, 0x8000},
-	{0x43DFFFFFFFFFFFC0, 0x7C00},
-	{0x3FE9BCC91C045DF8, 0x3A6F},
-	{0x43DFFFFFFFFFFFE0, 0x7C00},
-	{0x3F4FF7FFFFF7FFFF, 0x13FE},
-	{0x43DFFFFFFFFFFFF0, 0x7C00},
-	{0x3BE0000040000002, 0x0000},
-	{0x43DFFFFFFFFFFFF8, 0x7C00},
-	{0xC01FFFFFFFF3FFFF, 0xC800},
-	{0x43DFFFFFFFFFFFFC, 0x7C00},
-	{0x7FD6000000000000, 0x7C00},
-	{0x43DFFFFFFFFFFFFE, 0x7C00},
-	{0x4070100000000200, 0x5C04},
-	{0x43DFFFFFFFFFFFFF, 0x7C00},
-	{0x01200000007FFFFA, 0x0000},
-	{0x43DFFFFFFFFFFFFD, 0x7C00},
-	{0x001FFFFFFFBFFFFA, 0x0000},
-	{0x43DFFFFFFFFFFFFB, 0x7C00},
-	{0xBCB0000000007FFB, 0x8000},
-	{0x43DFFFFFFFFFFFF7, 0x7C00},
-	{0x4540200800000000, 0x7C00},
-	{0x43DFFFFFFFFFFFEF, 0x7C00},
-	{0xFFF22E77C6404320, 0xFE8B},
-	{0x43DFFFFFFFFFFFDF, 0x7C00},
-	{0xA55FFFFF0000000F, 0x8000},
-	{0x43DFFFFFFFFFFFBF, 0x7C00},
-	{0x3FF3DA94A1328F27, 0x3CF7},
-	{0x43DFFFFFFFFFFF7F, 0x7C00},
-	{0x3FF8C2C314649445, 0x3E31},
-	{0x43DFFFFFFFFFFEFF, 0x7C00},
-	{0xC0300FFFFEFFFFFF, 0xCC04},
-	{0x43DFFFFFFFFFFDFF, 0x7C00},
-	{0x3FE000003FF00000, 0x3800},
-	{0x43DFFFFFFFFFFBFF, 0x7C00},
-	{0xBFE0000000047FFE, 0xB800},
-	{0x43DFFFFFFFFFF7FF, 0x7C00},
-	{0xC01FFFFFFFFF7FF7, 0xC800},
-	{0x43DFFFFFFFFFEFFF, 0x7C00},
-	{0xBCAE107699D08E2D, 0x8000},
-	{0x43DFFFFFFFFFDFFF, 0x7C00},
-	{0x41CDB613E63E3D2C, 0x7C00},
-	{0x43DFFFFFFFFFBFFF, 0x7C00},
-	{0x3FC0000042000000, 0x3000},
-	{0x43DFFFFFFFFF7FFF, 0x7C00},
-	{0x43D8958C1D0CB654, 0x7C00},
-	{0x43DFFFFFFFFEFFFF, 0x7C00},
-	{0xBFFFFFF802000000, 0xC000},
-	{0x43DFFFFFFFFDFFFF, 0x7C00},
-	{0x4010000000000000, 0x4400},
-	{0x43DFFFFFFFFBFFFF, 0x7C00},
-	{0x3FC8A832E8D2AA62, 0x322A},
-	{0x43DFFFFFFFF7FFFF, 0x7C00},
-	{0x3CA36C6193800BC3, 0x0000},
-	{0x43DFFFFFFFEFFFFF, 0x7C00},
-	{0xBF4FFFFFFFFF807E, 0x9400},
-	{0x43DFFFFFFFDFFFFF, 0x7C00},
-	{0x3CAFFFFFF801FFFF, 0x0000},
-	{0x43DFFFFFFFBFFFFF, 0x7C00},
-	{0x0020003FFFFC0000, 0x0000},
-	{0x43DFFFFFFF7FFFFF, 0x7C00},
-	{0x38101FFFFFFFFFFC, 0x0000},
-	{0x43DFFFFFFEFFFFFF, 0x7C00},
-	{0xC02E3D0E6EEBFF32, 0xCB8F},
-	{0x43DFFFFFFDFFFFFF, 0x7C00},
-	{0x3FCFFFBFFFFFFFEF, 0x3400},
-	{0x43DFFFFFFBFFFFFF, 0x7C00},
-	{0xC3CFE3FFFFFFFFFF, 0xFC00},
-	{0x43DFFFFFF7FFFFFF, 0x7C00},
-	{0xA0700100000001FE, 0x8000},
-	{0x43DFFFFFEFFFFFFF, 0x7C00},
-	{0x80200000000047FE, 0x8000},
-	{0x43DFFFFFDFFFFFFF, 0x7C00},
-	{0x5CD29D0F101BDAAC, 0x7C00},
-	{0x43DFFFFFBFFFFFFF, 0x7C00},
-	{0xC09403FFFFFFFFFF, 0xE501},
-	{0x43DFFFFF7FFFFFFF, 0x7C00},
-	{0x43FFFFF7FFFFF7FF, 0x7C00},
-	{0x43DFFFFEFFFFFFFF, 0x7C00},
-	{0x40E1B3D7E6C21918, 0x786D},
-	{0x43DFFFFDFFFFFFFF, 0x7C00},
-	{0xC1C1FFDFFFFFFFFF, 0xFC00},
-	{0x43DFFFFBFFFFFFFF, 0x7C00},
-	{0x430FAB199E33DD0C, 0x7C00},
-	{0x43DFFFF7FFFFFFFF, 0x7C00},
-	{0x33E0000003FFFF00, 0x0000},
-	{0x43DFFFEFFFFFFFFF, 0x7C00},
-	{0x37F4957A3DE92A95, 0x0000},
-	{0x43DFFFDFFFFFFFFF, 0x7C00},
-	{0xBFEEFFFFFFFFFDFF, 0xBBC0},
-	{0x43DFFFBFFFFFFFFF, 0x7C00},
-	{0xBC20000FFFFFFFEE, 0x8000},
-	{0x43DFFF7FFFFFFFFF, 0x7C00},
-	{0x0008000007FFFFFF, 0x0000},
-	{0x43DFFEFFFFFFFFFF, 0x7C00},
-	{0x3FE04316957C14BE, 0x3811},
-	{0x43DFFDFFFFFFFFFF, 0x7C00},
-	{0x40063C8D8520ADE8, 0x418F},
-	{0x43DFFBFFFFFFFFFF, 0x7C00},
-	{0xC029510E33616287, 0xCA54},
-	{0x43DFF7FFFFFFFFFF, 0x7C00},
-	{0xBFDB95B4FFC51027, 0xB6E5},
-	{0x43DFEFFFFFFFFFFF, 0x7C00},
-	{0xC010FD63FE74C0A6, 0xC43F},
-	{0x43DFDFFFFFFFFFFF, 0x7C00},
-	{0xFEBA3D867708A173, 0xFC00},
-	{0x43DFBFFFFFFFFFFF, 0x7C00},
-	{0xBE7FEFFFFFFFFFF7, 0x8002},
-	{0x43DF7FFFFFFFFFFF, 0x7C00},
-	{0xC000000080000100, 0xC000},
-	{0x43DEFFFFFFFFFFFF, 0x7C00},
-	{0xC0629907E514BD49, 0xD8A6},
-	{0x43DDFFFFFFFFFFFF, 0x7C00},
-	{0x37E40000000003FF, 0x0000},
-	{0x43DBFFFFFFFFFFFF, 0x7C00},
-	{0x90DFFFFFFFFC000F, 0x8000},
-	{0x43D7FFFFFFFFFFFF, 0x7C00},
-	{0xBFE0FFE000000000, 0xB840},
-	{0x43D3FFFFFFFFFFFF, 0x7C00},
-	{0xBCA12B6FAA1CBE38, 0x8000},
-	{0x43D1FFFFFFFFFFFF, 0x7C00},
-	{0xC560400001000000, 0xFC00},
-	{0x43D0FFFFFFFFFFFF, 0x7C00},
-	{0xC3EB1A3EE1B454AC, 0xFC00},
-	{0x43D07FFFFFFFFFFF, 0x7C00},
-	{0xC0F0004000000006, 0xFC00},
-	{0x43D03FFFFFFFFFFF, 0x7C00},
-	{0x3DC3FFFFFFFF7FFF, 0x0000},
-	{0x43D01FFFFFFFFFFF, 0x7C00},
-	{0x3FDFFFFFFFFF6000, 0x3800},
-	{0x43D00FFFFFFFFFFF, 0x7C00},
-	{0xCEFE007FFFFFFFFE, 0xFC00},
-	{0x43D007FFFFFFFFFF, 0x7C00},
-	{0x3CAD000000000000, 0x0000},
-	{0x43D003FFFFFFFFFF, 0x7C00},
-	{0x47E00010000007FF, 0x7C00},
-	{0x43D001FFFFFFFFFF, 0x7C00},
-	{0x50C0001FFDFFFFFF, 0x7C00},
-	{0x43D000FFFFFFFFFF, 0x7C00},
-	{0x000007DFFFFFFFFF, 0x0000},
-	{0x43D0007FFFFFFFFF, 0x7C00},
-	{0xC1D0000400000FFF, 0xFC00},
-	{0x43D0003FFFFFFFFF, 0x7C00},
-	{0xC0E4CBE47EFBB5E8, 0xF933},
-	{0x43D0001FFFFFFFFF, 0x7C00},
-	{0x41EFFFFFFE01FFFF, 0x7C00},
-	{0x43D0000FFFFFFFFF, 0x7C00},
-	{0x3FBFFFFFFFBF7FFF, 0x3000},
-	{0x43D00007FFFFFFFF, 0x7C00},
-	{0x3FE3FAB558F8CB51, 0x38FF},
-	{0x43D00003FFFFFFFF, 0x7C00},
-	{0x3FF22EC2FBC33D6F, 0x3C8C},
-	{0x43D00001FFFFFFFF, 0x7C00},
-	{0xBFC636E4BF2C89A0, 0xB18E},
-	{0x43D00000FFFFFFFF, 0x7C00},
-	{0x43CE5E988F2B2494, 0x7C00},
-	{0x43D000007FFFFFFF, 0x7C00},
-	{0xB9FFFFFFFFFFF9FE, 0x8000},
-	{0x43D000003FFFFFFF, 0x7C00},
-	{0x4011F1BEF00220A2, 0x447C},
-	{0x43D000001FFFFFFF, 0x7C00},
-	{0x43FEA583E3AF7072, 0x7C00},
-	{0x43D000000FFFFFFF, 0x7C00},
-	{0x3CCE54F2FE5CEE50, 0x0000},
-	{0x43D0000007FFFFFF, 0x7C00},
-	{0x3EBFC000001FFFFF, 0x0020},
-	{0x43D0000003FFFFFF, 0x7C00},
-	{0xC05000000FFFFFFE, 0xD400},
-	{0x43D0000001FFFFFF, 0x7C00},
-	{0xC00FE0003FFFFFFF, 0xC3F8},
-	{0x43D0000000FFFFFF, 0x7C00},
-	{0xC0475C65A2EFB45C, 0xD1D7},
-	{0x43D00000007FFFFF, 0x7C00},
-	{0x3C4B35308D8F9C40, 0x0000},
-	{0x43D00000003FFFFF, 0x7C00},
-	{0xC07FFFFDFFFFFDFF, 0xE000},
-	{0x43D00000001FFFFF, 0x7C00},
-	{0xD9200003FFFBFFFF, 0xFC00},
-	{0x43D00000000FFFFF, 0x7C00},
-	{0xB0AFFFFC00000004, 0x8000},
-	{0x43D000000007FFFF, 0x7C00},
-	{0xC02FFF7EFFFFFFFE, 0xCC00},
-	{0x43D000000003FFFF, 0x7C00},
-	{0x4880001EFFFFFFFE, 0x7C00},
-	{0x43D000000001FFFF, 0x7C00},
-	{0x3FC0000000010FFF, 0x3000},
-	{0x43D000000000FFFF, 0x7C00},
-	{0x2BF2000000007FFF, 0x0000},
-	{0x43D0000000007FFF, 0x7C00},
-	{0x3FEFFDF7FFFFFFFF, 0x3BFF},
-	{0x43D0000000003FFF, 0x7C00},
-	{0xBB34BA0AFB147264, 0x8000},
-	{0x43D0000000001FFF, 0x7C00},
-	{0x7FEFFFFBFFFFFFFF, 0x7C00},
-	{0x43D0000000000FFF, 0x7C00},
-	{0x37EE000000FFFFFF, 0x0000},
-	{0x43D00000000007FF, 0x7C00},
-	{0x3FFFFFFFFFC00080, 0x4000},
-	{0x43D00000000003FF, 0x7C00},
-	{0xD0D04CFE8214CB3F, 0xFC00},
-	{0x43D00000000001FF, 0x7C00},
-	{0xB80AE99058B958E8, 0x8000},
-	{0x43D00000000000FF, 0x7C00},
-	{0xFDBDFFFFFFFFFFFF, 0xFC00},
-	{0x43D000000000007F, 0x7C00},
-	{0x3F5FFF000000001E, 0x1800},
-	{0x43D000000000003F, 0x7C00},
-	{0xBFD000001DFFFFFF, 0xB400},
-	{0x43D000000000001F, 0x7C00},
-	{0x480D82EDDADA3394, 0x7C00},
-	{0x43D000000000000F, 0x7C00},
-	{0xC3CFFFFFFFFFF8FF, 0xFC00},
-	{0x43D0000000000007, 0x7C00},
-	{0xC3A00037FFFFFFFF, 0xFC00},
-	{0x43D0000000000003, 0x7C00},
-	{0x3FFFDFFFFFFFFFEF, 0x3FF8},
-	{0x43E0000000000000, 0x7C00},
-	{0x7FE1BB289B10D2C2, 0x7C00},
-	{0x43E0000000000001, 0x7C00},
-	{0xBCAFFFFFF7FFF7FF, 0x8000},
-	{0x43E0000000000002, 0x7C00},
-	{0x634C1292F37547F4, 0x7C00},
-	{0x43E0000000000004, 0x7C00},
-	{0xBCA2C91EF5D6709B, 0x8000},
-	{0x43E0000000000008, 0x7C00},
-	{0xD1BFFEFFFEFFFFFE, 0xFC00},
-	{0x43E0000000000010, 0x7C00},
-	{0x7FEFFFFFEFFFFFBE, 0x7C00},
-	{0x43E0000000000020, 0x7C00},
-	{0x6624ED5C86B53C65, 0x7C00},
-	{0x43E0000000000040, 0x7C00},
-	{0x403C00000007FFFF, 0x4F00},
-	{0x43E0000000000080, 0x7C00},
-	{0xBFD8BBA5EDC9EAF4, 0xB62F},
-	{0x43E0000000000100, 0x7C00},
-	{0xF9CEFFFFFFDFFFFF, 0xFC00},
-	{0x43E0000000000200, 0x7C00},
-	{0x401FFFFFFFE3FFFF, 0x4800},
-	{0x43E0000000000400, 0x7C00},
-	{0xC1F08CBD6AFD145C, 0xFC00},
-	{0x43E0000000000800, 0x7C00},
-	{0x48903FFFFFFFFEFF, 0x7C00},
-	{0x43E0000000001000, 0x7C00},
-	{0xCE400003FFFF7FFF, 0xFC00},
-	{0x43E0000000002000, 0x7C00},
-	{0x80000007FEFFFFFE, 0x8000},
-	{0x43E0000000004000, 0x7C00},
-	{0xBFE0000007FFFDFE, 0xB800},
-	{0x43E0000000008000, 0x7C00},
-	{0x0010001000001FFF, 0x0000},
-	{0x43E0000000010000, 0x7C00},
-	{0x43E2C87157F70CE6, 0x7C00},
-	{0x43E0000000020000, 0x7C00},
-	{0x3CA000000003FFFE, 0x0000},
-	{0x43E0000000040000, 0x7C00},
-	{0x4010000000002020, 0x4400},
-	{0x43E0000000080000, 0x7C00},
-	{0xC01F27C7E05CDBD4, 0xC7CA},
-	{0x43E0000000100000, 0x7C00},
-	{0xC1F000FDFFFFFFFF, 0xFC00},
-	{0x43E0000000200000, 0x7C00},
-	{0xBCA00008001FFFFF, 0x8000},
-	{0x43E0000000400000, 0x7C00},
-	{0xFFFEBCA41B7130D1, 0xFFAF},
-	{0x43E0000000800000, 0x7C00},
-	{0x7FD00001FE000000, 0x7C00},
-	{0x43E0000001000000, 0x7C00},
-	{0xBF300FFFFFFFFFFD, 0x8C04},
-	{0x43E0000002000000, 0x7C00},
-	{0xBCA008000007FFFF, 0x8000},
-	{0x43E0000004000000, 0x7C00},
-	{0x7FF01FFFFFFFFFF7, 0x7E07},
-	{0x43E0000008000000, 0x7C00},
-	{0x6FAFFFE00000003F, 0x7C00},
-	{0x43E0000010000000, 0x7C00},
-	{0xBC6FFFFFFFFE0FFF, 0x8000},
-	{0x43E0000020000000, 0x7C00},
-	{0xC7E0000007FE0000, 0xFC00},
-	{0x43E0000040000000, 0x7C00},
-	{0x4011FFFFC0000000, 0x4480},
-	{0x43E0000080000000, 0x7C00},
-	{0xBF02EF84BEEA3EAA, 0x825E},
-	{0x43E0000100000000, 0x7C00},
-	{0x000FDFFFFFDFFFFF, 0x0000},
-	{0x43E0000200000000, 0x7C00},
-	{0x4B3FFFFDFFFFFBFF, 0x7C00},
-	{0x43E0000400000000, 0x7C00},
-	{0x3CE236AE3A743B39, 0x0000},
-	{0x43E0000800000000, 0x7C00},
-	{0x43F78C9B9B3AA360, 0x7C00},
-	{0x43E0001000000000, 0x7C00},
-	{0x37E000001007FFFE, 0x0000},
-	{0x43E0002000000000, 0x7C00},
-	{0xB81EFFFFEFFFFFFF, 0x8000},
-	{0x43E0004000000000, 0x7C00},
-	{0x411BFFFFFFFFFBFE, 0x7C00},
-	{0x43E0008000000000, 0x7C00},
-	{0xC1F8B81B1DE0B2E0, 0xFC00},
-	{0x43E0010000000000, 0x7C00},
-	{0xC3F4004000000000, 0xFC00},
-	{0x43E0020000000000, 0x7C00},
-	{0xC3EFFF0000000FFE, 0xFC00},
-	{0x43E0040000000000, 0x7C00},
-	{0xBFDFFFFFFFFFFFFE, 0xB800},
-	{0x43E0080000000000, 0x7C00},
-	{0xC160800000004000, 0xFC00},
-	{0x43E0100000000000, 0x7C00},
-	{0x43DF93F3DA708CCC, 0x7C00},
-	{0x43E0200000000000, 0x7C00},
-	{0x4C1FFFE000040000, 0x7C00},
-	{0x43E0400000000000, 0x7C00},
-	{0xC01FFFFFFFFFF800, 0xC800},
-	{0x43E0800000000000, 0x7C00},
-	{0xC80E08A0B751B6A7, 0xFC00},
-	{0x43E1000000000000, 0x7C00},
-	{0xBFFFFFFFF7FFFDFE, 0xC000},
-	{0x43E2000000000000, 0x7C00},
-	{0xC28FFFBFFFFFFFBE, 0xFC00},
-	{0x43E4000000000000, 0x7C00},
-	{0xC9E91BF88E1F7698, 0xFC00},
-	{0x43E8000000000000, 0x7C00},
-	{0x013F7FFFF7FFFFFE, 0x0000},
-	{0x43EC000000000000, 0x7C00},
-	{0x3FC5FBF4573C9EFE, 0x317F},
-	{0x43EE000000000000, 0x7C00},
-	{0xC1D000020007FFFF, 0xFC00},
-	{0x43EF000000000000, 0x7C00},
-	{0x2917FFFFFFFFFEFF, 0x0000},
-	{0x43EF800000000000, 0x7C00},
-	{0xBFE68FD4451A1588, 0xB9A4},
-	{0x43EFC00000000000, 0x7C00},
-	{0x3FCFFFFFFFFDBFFF, 0x3400},
-	{0x43EFE00000000000, 0x7C00},
-	{0xFFFE3A79A4D1BBEE, 0xFF8E},
-	{0x43EFF00000000000, 0x7C00},
-	{0xC02FFFFBFFFFFF7E, 0xCC00},
-	{0x43EFF80000000000, 0x7C00},
-	{0xC3FDFFFFDFFFFFFF, 0xFC00},
-	{0x43EFFC0000000000, 0x7C00},
-	{0x3FDF4B8A4B32336E, 0x37D3},
-	{0x43EFFE0000000000, 0x7C00},
-	{0x4170000023FFFFFF, 0x7C00},
-	{0x43EFFF0000000000, 0x7C00},
-	{0x37FFFFFFFFFF03FF, 0x0000},
-	{0x43EFFF8000000000, 0x7C00},
-	{0xC380000003FBFFFF, 0xFC00},
-	{0x43EFFFC000000000, 0x7C00},
-	{0x57C00000000005FF, 0x7C00},
-	{0x43EFFFE000000000, 0x7C00},
-	{0x3FFF800000004000, 0x3FE0},
-	{0x43EFFFF000000000, 0x7C00},
-	{0xBE6FC00100000000, 0x8001},
-	{0x43EFFFF800000000, 0x7C00},
-	{0x40A0000020000001, 0x6800},
-	{0x43EFFFFC00000000, 0x7C00},
-	{0xB7F821E83CE25293, 0x8000},
-	{0x43EFFFFE00000000, 0x7C00},
-	{0x40045E187E010695, 0x4118},
-	{0x43EFFFFF00000000, 0x7C00},
-	{0x36228BFBF622869C, 0x0000},
-	{0x43EFFFFF80000000, 0x7C00},
-	{0x3FCFF7FFFE000000, 0x33FE},
-	{0x43EFFFFFC0000000, 0x7C00},
-	{0xF44FFFFBFFFFDFFE, 0xFC00},
-	{0x43EFFFFFE0000000, 0x7C00},
-	{0xB7F0007FFFFFFFDF, 0x8000},
-	{0x43EFFFFFF0000000, 0x7C00},
-	{0x3FF0000007DFFFFE, 0x3C00},
-	{0x43EFFFFFF8000000, 0x7C00},
-	{0xC033E64DF1276454, 0xCCFA},
-	{0x43EFFFFFFC000000, 0x7C00},
-	{0xC00DF7FFFFFFFFFF, 0xC37E},
-	{0x43EFFFFFFE000000, 0x7C00},
-	{0xBE7000003FFFFBFE, 0x8001},
-	{0x43EFFFFFFF000000, 0x7C00},
-	{0xC01E0000003FFFFF, 0xC780},
-	{0x43EFFFFFFF800000, 0x7C00},
-	{0x00179B570DFE1FD4, 0x0000},
-	{0x43EFFFFFFFC00000, 0x7C00},
-	{0x800FFFFFFF7FBFFF, 0x8000},
-	{0x43EFFFFFFFE00000, 0x7C00},
-	{0xC80FFF6FFFFFFFFF, 0xFC00},
-	{0x43EFFFFFFFF00000, 0x7C00},
-	{0xDB10000008800000, 0xFC00},
-	{0x43EFFFFFFFF80000, 0x7C00},
-	{0x001FFFFFBFFFFEFF, 0x0000},
-	{0x43EFFFFFFFFC0000, 0x7C00},
-	{0x800F8FFFFFFFFFFF, 0x8000},
-	{0x43EFFFFFFFFE0000, 0x7C00},
-	{0xC340000001FFFDFE, 0xFC00},
-	{0x43EFFFFFFFFF0000, 0x7C00},
-	{0x4030001000000FFE, 0x4C00},
-	{0x43EFFFFFFFFF8000, 0x7C00},
-	{0x3FBA98B154D3BEAF, 0x2EA6},
-	{0x43EFFFFFFFFFC000, 0x7C00},
-	{0x3FF0000087FFFFFF, 0x3C00},
-	{0x43EFFFFFFFFFE000, 0x7C00},
-	{0x802516901372CB9F, 0x8000},
-	{0x43EFFFFFFFFFF000, 0x7C00},
-	{0x3F2FFFFFFFFFFFFF, 0x0C00},
-	{0x43EFFFFFFFFFF800, 0x7C00},
-	{0xBFE100007FFFFFFF, 0xB840},
-	{0x43EFFFFFFFFFFC00, 0x7C00},
-	{0x41CFFFE0000007FF, 0x7C00},
-	{0x43EFFFFFFFFFFE00, 0x7C00},
-	{0x3FF000200003FFFE, 0x3C00},
-	{0x43EFFFFFFFFFFF00, 0x7C00},
-	{0x3FEFE000000007FF, 0x3BF8},
-	{0x43EFFFFFFFFFFF80, 0x7C00},
-	{0x323BFFFFFFEFFFFF, 0x0000},
-	{0x43EFFFFFFFFFFFC0, 0x7C00},
-	{0xBF600080000003FF, 0x9800},
-	{0x43EFFFFFFFFFFFE0, 0x7C00},
-	{0xB7F00200000000FE, 0x8000},
-	{0x43EFFFFFFFFFFFF0, 0x7C00},
-	{0x25000000803FFFFE, 0x0000},
-	{0x43EFFFFFFFFFFFF8, 0x7C00},
-	{0xBE8FBFFBFFFFFFFE, 0x8004},
-	{0x43EFFFFFFFFFFFFC, 0x7C00},
-	{0xC123AFCFAA18ABDC, 0xFC00},
-	{0x43EFFFFFFFFFFFFE, 0x7C00},
-	{0x381FFFFBC0000000, 0x0000},
-	{0x43EFFFFFFFFFFFFF, 0x7C00},
-	{0x41C000000037FFFE, 0x7C00},
-	{0x43EFFFFFFFFFFFFD, 0x7C00},
-	{0xBF5FFFFF80000007, 0x9800},
-	{0x43EFFFFFFFFFFFFB, 0x7C00},
-	{0xC3DFFFFFFFFFE080, 0xFC00},
-	{0x43EFFFFFFFFFFFF7, 0x7C00},
-	{0x3A1FFFBFFFDFFFFF, 0x0000},
-	{0x43EFFFFFFFFFFFEF, 0x7C00},
-	{0xBFB00000407FFFFF, 0xAC00},
-	{0x43EFFFFFFFFFFFDF, 0x7C00},
-	{0xBFD0000020001FFF, 0xB400},
-	{0x43EFFFFFFFFFFFBF, 0x7C00},
-	{0x402A982D01C42721, 0x4AA6},
-	{0x43EFFFFFFFFFFF7F, 0x7C00},
-	{0x3FC000003FFFFEFE, 0x3000},
-	{0x43EFFFFFFFFFFEFF, 0x7C00},
-	{0xC344315582A21F36, 0xFC00},
-	{0x43EFFFFFFFFFFDFF, 0x7C00},
-	{0x4020007FFFFFF7FF, 0x4800},
-	{0x43EFFFFFFFFFFBFF, 0x7C00},
-	{0xC03EFFFFFFFDFFFF, 0xCFC0},
-	{0x43EFFFFFFFFFF7FF, 0x7C00},
-	{0xC080006FFFFFFFFF, 0xE000},
-	{0x43EFFFFFFFFFEFFF, 0x7C00},
-	{0x3E9010000FFFFFFE, 0x0004},
-	{0x43EFFFFFFFFFDFFF, 0x7C00},
-	{0xD7039DB4E693AAF5, 0xFC00},
-	{0x43EFFFFFFFFFBFFF, 0x7C00},
-	{0xC1DDFFFFFE000000, 0xFC00},
-	{0x43EFFFFFFFFF7FFF, 0x7C00},
-	{0xC0EFA0ED9B7A29D3, 0xFBE8},
-	{0x43EFFFFFFFFEFFFF, 0x7C00},
-	{0xB81FEFFFFFFFFFF7, 0x8000},
-	{0x43EFFFFFFFFDFFFF, 0x7C00},
-	{0xFFFFFEF7FFFFFFFE, 0xFFFF},
-	{0x43EFFFFFFFFBFFFF, 0x7C00},
-	{0x403FFFF800000FFF, 0x5000},
-	{0x43EFFFFFFFF7FFFF, 0x7C00},
-	{0x0000007FFBFFFFFF, 0x0000},
-	{0x43EFFFFFFFEFFFFF, 0x7C00},
-	{0x400FFFFFFFFFFBFB, 0x4400},
-	{0x43EFFFFFFFDFFFFF, 0x7C00},
-	{0x5BD0976186A18A0B, 0x7C00},
-	{0x43EFFFFFFFBFFFFF, 0x7C00},
-	{0xC803FCD13C1DBD41, 0xFC00},
-	{0x43EFFFFFFF7FFFFF, 0x7C00},
-	{0x403FC66CC49FD790, 0x4FF2},
-	{0x43EFFFFFFEFFFFFF, 0x7C00},
-	{0x44AFFFFBFFFFFFFF, 0x7C00},
-	{0x43EFFFFFFDFFFFFF, 0x7C00},
-	{0xB7FEBFFFFFFFFFFF, 0x8000},
-	{0x43EFFFFFFBFFFFFF, 0x7C00},
-	{0x69441B5A6489CDFD, 0x7C00},
-	{0x43EFFFFFF7FFFFFF, 0x7C00},
-	{0xBF007FFFFFFEFFFF, 0x8210},
-	{0x43EFFFFFEFFFFFFF, 0x7C00},
-	{0x96DFFFFFFF7FFFF0, 0x8000},
-	{0x43EFFFFFDFFFFFFF, 0x7C00},
-	{0x4B401FFFFF7FFFFF, 0x7C00},
-	{0x43EFFFFFBFFFFFFF, 0x7C00},
-	{0xBF50000002400000, 0x9400},
-	{0x43EFFFFF7FFFFFFF, 0x7C00},
-	{0x27AFFFFF00000800, 0x0000},
-	{0x43EFFFFEFFFFFFFF, 0x7C00},
-	{0x000003EFFFFFFFFE, 0x0000},
-	{0x43EFFFFDFFFFFFFF, 0x7C00},
-	{0x3BB0002000000100, 0x0000},
-	{0x43EFFFFBFFFFFFFF, 0x7C00},
-	{0xB9C00010000001FF, 0x8000},
-	{0x43EFFFF7FFFFFFFF, 0x7C00},
-	{0xBFE0000002000004, 0xB800},
-	{0x43EFFFEFFFFFFFFF, 0x7C00},
-	{0x8011B1628282BF98, 0x8000},
-	{0x43EFFFDFFFFFFFFF, 0x7C00},
-	{0xC020003FF7FFFFFF, 0xC800},
-	{0x43EFFFBFFFFFFFFF, 0x7C00},
-	{0xBC4FEFFBFFFFFFFE, 0x8000},
-	{0x43EFFF7FFFFFFFFF, 0x7C00},
-	{0x00295759CC808AB3, 0x0000},
-	{0x43EFFEFFFFFFFFFF, 0x7C00},
-	{0x403FFFFFFFFF7FBF, 0x5000},
-	{0x43EFFDFFFFFFFFFF, 0x7C00},
-	{0x37E1C00000000000, 0x0000},
-	{0x43EFFBFFFFFFFFFF, 0x7C00},
-	{0x403FFFFFEFFF8000, 0x5000},
-	{0x43EFF7FFFFFFFFFF, 0x7C00},
-	{0x47EFE000000FFFFF, 0x7C00},
-	{0x43EFEFFFFFFFFFFF, 0x7C00},
-	{0xBF100000000203FF, 0x8400},
-	{0x43EFDFFFFFFFFFFF, 0x7C00},
-	{0xC0E279DB70E4C2D2, 0xF89E},
-	{0x43EFBFFFFFFFFFFF, 0x7C00},
-	{0x930000000021FFFE, 0x8000},
-	{0x43EF7FFFFFFFFFFF, 0x7C00},
-	{0x7C2FE0000000007E, 0x7C00},
-	{0x43EEFFFFFFFFFFFF, 0x7C00},
-	{0x4031FFFFFFFFFFFE, 0x4C80},
-	{0x43EDFFFFFFFFFFFF, 0x7C00},
-	{0xB02FFFFFFFF7FFBE, 0x8000},
-	{0x43EBFFFFFFFFFFFF, 0x7C00},
-	{0xD1830F162102106A, 0xFC00},
-	{0x43E7FFFFFFFFFFFF, 0x7C00},
-	{0xB818000000000040, 0x8000},
-	{0x43E3FFFFFFFFFFFF, 0x7C00},
-	{0x3FD00087FFFFFFFF, 0x3400},
-	{0x43E1FFFFFFFFFFFF, 0x7C00},
-	{0x001ED3BBE341E54A, 0x0000},
-	{0x43E0FFFFFFFFFFFF, 0x7C00},
-	{0xB7EFF80000400000, 0x8000},
-	{0x43E07FFFFFFFFFFF, 0x7C00},
-	{0x381000000000801F, 0x0000},
-	{0x43E03FFFFFFFFFFF, 0x7C00},
-	{0xC7FFFFFFFFEFFF00, 0xFC00},
-	{0x43E01FFFFFFFFFFF, 0x7C00},
-	{0xC1FFFFFFFFFFDFFF, 0xFC00},
-	{0x43E00FFFFFFFFFFF, 0x7C00},
-	{0xB7FFFFFDFFFF0000, 0x8000},
-	{0x43E007FFFFFFFFFF, 0x7C00},
-	{0x7FFA4CC82969DCDB, 0x7E93},
-	{0x43E003FFFFFFFFFF, 0x7C00},
-	{0xC00003FFEFFFFFFF, 0xC001},
-	{0x43E001FFFFFFFFFF, 0x7C00},
-	{0xC34FFFFE0001FFFF, 0xFC00},
-	{0x43E000FFFFFFFFFF, 0x7C00},
-	{0xC1D02000007FFFFF, 0xFC00},
-	{0x43E0007FFFFFFFFF, 0x7C00},
-	{0x3C401FFFFF800000, 0x0000},
-	{0x43E0003FFFFFFFFF, 0x7C00},
-	{0xC2B3AF3438A71281, 0xFC00},
-	{0x43E0001FFFFFFFFF, 0x7C00},
-	{0xC95FFFFF800FFFFF, 0xFC00},
-	{0x43E0000FFFFFFFFF, 0x7C00},
-	{0x3CA3BFDB9FC660C0, 0x0000},
-	{0x43E00007FFFFFFFF, 0x7C00},
-	{0x3FEFFFFFF8000000, 0x3C00},
-	{0x43E00003FFFFFFFF, 0x7C00},
-	{0xBF2007FFFFFC0000, 0x8802},
-	{0x43E00001FFFFFFFF, 0x7C00},
-	{0x001FFFFFFFFFF003, 0x0000},
-	{0x43E00000FFFFFFFF, 0x7C00},
-	{0x4B7F2DFA17DF833E, 0x7C00},
-	{0x43E000007FFFFFFF, 0x7C00},
-	{0xFFD4B0E169F465AE, 0xFC00},
-	{0x43E000003FFFFFFF, 0x7C00},
-	{0xBFE400000007FFFF, 0xB900},
-	{0x43E000001FFFFFFF, 0x7C00},
-	{0x3FA36D2FA27D6BC5, 0x28DB},
-	{0x43E000000FFFFFFF, 0x7C00},
-	{0x41DFFFBFFFFFEFFF, 0x7C00},
-	{0x43E0000007FFFFFF, 0x7C00},
-	{0x3FDE9A88ACCCE467, 0x37A7},
-	{0x43E0000003FFFFFF, 0x7C00},
-	{0xB18FE00000000FFF, 0x8000},
-	{0x43E0000001FFFFFF, 0x7C00},
-	{0xFFD4A58A29062ECA, 0xFC00},
-	{0x43E0000000FFFFFF, 0x7C00},
-	{0x3FCFFFFFFFEFFFFD, 0x3400},
-	{0x43E00000007FFFFF, 0x7C00},
-	{0x2E00000FFFFEFFFE, 0x0000},
-	{0x43E00000003FFFFF, 0x7C00},
-	{0xC3DFFFFFFFFFDFFA, 0xFC00},
-	{0x43E00000001FFFFF, 0x7C00},
-	{0xC34F801000000000, 0xFC00},
-	{0x43E00000000FFFFF, 0x7C00},
-	{0xC01FC0000007FFFE, 0xC7F0},
-	{0x43E000000007FFFF, 0x7C00},
-	{0xD09200FFFFFFFFFF, 0xFC00},
-	{0x43E000000003FFFF, 0x7C00},
-	{0x41D07FFFFFFFFFFF, 0x7C00},
-	{0x43E000000001FFFF, 0x7C00},
-	{0xBFEFC00000400000, 0xBBF0},
-	{0x43E000000000FFFF, 0x7C00},
-	{0xBF00000000000FF6, 0x8200},
-	{0x43E0000000007FFF, 0x7C00},
-	{0xC0EFFFE0000001FE, 0xFC00},
-	{0x43E0000000003FFF, 0x7C00},
-	{0x3271FC0000000000, 0x0000},
-	{0x43E0000000001FFF, 0x7C00},
-	{0xB626982F6A2C8CFD, 0x8000},
-	{0x43E0000000000FFF, 0x7C00},
-	{0x80000000000FFEFF, 0x8000},
-	{0x43E00000000007FF, 0x7C00},
-	{0xC3E549AA94AA2E0C, 0xFC00},
-	{0x43E00000000003FF, 0x7C00},
-	{0x41E00000000FFBFF, 0x7C00},
-	{0x43E00000000001FF, 0x7C00},
-	{0xBFD000F7FFFFFFFF, 0xB400},
-	{0x43E00000000000FF, 0x7C00},
-	{0x5C9FFFE000000002, 0x7C00},
-	{0x43E000000000007F, 0x7C00},
-	{0xE0E07FFEFFFFFFFE, 0xFC00},
-	{0x43E000000000003F, 0x7C00},
-	{0xB2F0096120D34727, 0x8000},
-	{0x43E000000000001F, 0x7C00},
-	{0x283011FFFFFFFFFF, 0x0000},
-	{0x43E000000000000F, 0x7C00},
-	{0xBFFB0471AEAEAF86, 0xBEC1},
-	{0x43E0000000000007, 0x7C00},
-	{0x801FFFFFEFBFFFFE, 0x8000},
-	{0x43E0000000000003, 0x7C00},
-	{0xBFF0003FFFFFFFFE, 0xBC00},
-	{0x43F0000000000000, 0x7C00},
-	{0x37E001FFFF7FFFFF, 0x0000},
-	{0x43F0000000000001, 0x7C00},
-	{0xC040FFFFFFFFFDFF, 0xD040},
-	{0x43F0000000000002, 0x7C00},
-	{0xC015DB89A2D0B53B, 0xC577},
-	{0x43F0000000000004, 0x7C00},
-	{0x59800001FFFFEFFF, 0x7C00},
-	{0x43F0000000000008, 0x7C00},
-	{0x3F4FFF03FFFFFFFF, 0x1400},
-	{0x43F0000000000010, 0x7C00},
-	{0x001FFFFB7FFFFFFE, 0x0000},
-	{0x43F0000000000020, 0x7C00},
-	{0xC055D8DFBE998B72, 0xD576},
-	{0x43F0000000000040, 0x7C00},
-	{0xC0200003FFFFEFFF, 0xC800},
-	{0x43F0000000000080, 0x7C00},
-	{0xC1D0000000020080, 0xFC00},
-	{0x43F0000000000100, 0x7C00},
-	{0xC02FFFFFFFFFFFFC, 0xCC00},
-	{0x43F0000000000200, 0x7C00},
-	{0x0F00000000F00000, 0x0000},
-	{0x43F0000000000400, 0x7C00},
-	{0x3ACB588F87830756, 0x0000},
-	{0x43F0000000000800, 0x7C00},
-	{0xBFDD960F78B8F08C, 0xB766},
-	{0x43F0000000001000, 0x7C00},
-	{0xBF60000001FEFFFF, 0x9800},
-	{0x43F0000000002000, 0x7C00},
-	{0x31662E3B5BA67F36, 0x0000},
-	{0x43F0000000004000, 0x7C00},
-	{0x480FFFFEFFFFFFFD, 0x7C00},
-	{0x43F0000000008000, 0x7C00},
-	{0x3CADA9798E9C4DA1, 0x0000},
-	{0x43F0000000010000, 0x7C00},
-	{0x802FFFFFFFC20000, 0x8000},
-	{0x43F0000000020000, 0x7C00},
-	{0x4F6000000020003F, 0x7C00},
-	{0x43F0000000040000, 0x7C00},
-	{0x434FF8000000003F, 0x7C00},
-	{0x43F0000000080000, 0x7C00},
-	{0xD48F800000400000, 0xFC00},
-	{0x43F0000000100000, 0x7C00},
-	{0x4BF0008000000002, 0x7C00},
-	{0x43F0000000200000, 0x7C00},
-	{0xFFEF7EFFFFFFFFFF, 0xFC00},
-	{0x43F0000000400000, 0x7C00},
-	{0xC1C000000000077E, 0xFC00},
-	{0x43F0000000800000, 0x7C00},
-	{0xFFEFFFFFFFFFEFF8, 0xFC00},
-	{0x43F0000001000000, 0x7C00},
-	{0xC70FFFFFFFFFFF7F, 0xFC00},
-	{0x43F0000002000000, 0x7C00},
-	{0xAEF00000002001FE, 0x8000},
-	{0x43F0000004000000, 0x7C00},
-	{0xBFAFFEC000000000, 0xAC00},
-	{0x43F0000008000000, 0x7C00},
-	{0x409FFBFFFFFFFE00, 0x67FF},
-	{0x43F0000010000000, 0x7C00},
-	{0xC1D001FFFFDFFFFF, 0xFC00},
-	{0x43F0000020000000, 0x7C00},
-	{0x9ABDFFF7FFFFFFFF, 0x8000},
-	{0x43F0000040000000, 0x7C00},
-	{0x41F001FFBFFFFFFE, 0x7C00},
-	{0x43F0000080000000, 0x7C00},
-	{0x53DFFFFFF00000FF, 0x7C00},
-	{0x43F0000100000000, 0x7C00},
-	{0x801FC00100000000, 0x8000},
-	{0x43F0000200000000, 0x7C00},
-	{0xC3EFFE01FFFFFFFF, 0xFC00},
-	{0x43F0000400000000, 0x7C00},
-	{0x41C00FF7FFFFFFFF, 0x7C00},
-	{0x43F0000800000000, 0x7C00},
-	{0x3FF344FC40119787, 0x3CD1},
-	{0x43F0001000000000, 0x7C00},
-	{0x37E1000000000007, 0x0000},
-	{0x43F0002000000000, 0x7C00},
-	{0x47FFB7FFFFFFFFFF, 0x7C00},
-	{0x43F0004000000000, 0x7C00},
-	{0x44B000000080003F, 0x7C00},
-	{0x43F0008000000000, 0x7C00},
-	{0x43C8660F3BAA42C6, 0x7C00},
-	{0x43F0010000000000, 0x7C00},
-	{0xFFE0000001000007, 0xFC00},
-	{0x43F0020000000000, 0x7C00},
-	{0xC57C000000004000, 0xFC00},
-	{0x43F0040000000000, 0x7C00},
-	{0xC80A4F69380ED35F, 0xFC00},
-	{0x43F0080000000000, 0x7C00},
-	{0xC0808000000007FE, 0xE020},
-	{0x43F0100000000000, 0x7C00},
-	{0x70FFFFEFFFFFFFBF, 0x7C00},
-	{0x43F0200000000000, 0x7C00},
-	{0x3B4FBFDFFFFFFFFF, 0x0000},
-	{0x43F0400000000000, 0x7C00},
-	{0x34EFFFE000000040, 0x0000},
-	{0x43F0800000000000, 0x7C00},
-	{0xB7F47449AA370E59, 0x8000},
-	{0x43F1000000000000, 0x7C00},
-	{0xBFDFFFFFF77FFFFF, 0xB800},
-	{0x43F2000000000000, 0x7C00},
-	{0x37FFFF80000000FF, 0x0000},
-	{0x43F4000000000000, 0x7C00},
-	{0xC04FF0000FFFFFFE, 0xD3FC},
-	{0x43F8000000000000, 0x7C00},
-	{0x800FFFFFD8000000, 0x8000},
-	{0x43FC000000000000, 0x7C00},
-	{0xFFF0000000407FFF, 0xFE00},
-	{0x43FE000000000000, 0x7C00},
-	{0xB97000040001FFFF, 0x8000},
-	{0x43FF000000000000, 0x7C00},
-	{0x43D0021FFFFFFFFE, 0x7C00},
-	{0x43FF800000000000, 0x7C00},
-	{0x480FFFFEFFFFFFFE, 0x7C00},
-	{0x43FFC00000000000, 0x7C00},
-	{0xBFD0000088000000, 0xB400},
-	{0x43FFE00000000000, 0x7C00},
-	{0xC0FEFFFBFFFFFFFF, 0xFC00},
-	{0x43FFF00000000000, 0x7C00},
-	{0x1D8EED4183A2EB31, 0x0000},
-	{0x43FFF80000000000, 0x7C00},
-	{0x37E000000000007F, 0x0000},
-	{0x43FFFC0000000000, 0x7C00},
-	{0xB7FE000007FFFFFE, 0x8000},
-	{0x43FFFE0000000000, 0x7C00},
-	{0x8010000000800010, 0x8000},
-	{0x43FFFF0000000000, 0x7C00},
-	{0x404FFFDFFFFF7FFF, 0x5400},
-	{0x43FFFF8000000000, 0x7C00},
-	{0xFFD07FFFFFFFFFEF, 0xFC00},
-	{0x43FFFFC000000000, 0x7C00},
-	{0xB7FFFDFFFF800000, 0x8000},
-	{0x43FFFFE000000000, 0x7C00},
-	{0xB7E00FFFFF000000, 0x8000},
-	{0x43FFFFF000000000, 0x7C00},
-	{0xC340000001FFFFDF, 0xFC00},
-	{0x43FFFFF800000000, 0x7C00},
-	{0x4800040000000FFF, 0x7C00},
-	{0x43FFFFFC00000000, 0x7C00},
-	{0xFFDA1F0E10D1AD15, 0xFC00},
-	{0x43FFFFFE00000000, 0x7C00},
-	{0x3CCE1CE73535AAFF, 0x0000},
-	{0x43FFFFFF00000000, 0x7C00},
-	{0x80220001FFFFFFFF, 0x8000},
-	{0x43FFFFFF80000000, 0x7C00},
-	{0xC01FCFFFFFFFFFFF, 0xC7F4},
-	{0x43FFFFFFC0000000, 0x7C00},
-	{0x47FA383117F87928, 0x7C00},
-	{0x43FFFFFFE0000000, 0x7C00},
-	{0xC03E67F1A735C5BB, 0xCF9A},
-	{0x43FFFFFFF0000000, 0x7C00},
-	{0x43D00000003F7FFF, 0x7C00},
-	{0x43FFFFFFF8000000, 0x7C00},
-	{0xBFF90C445C60CD83, 0xBE43},
-	{0x43FFFFFFFC000000, 0x7C00},
-	{0x3FB03FFFFFFFFF80, 0x2C10},
-	{0x43FFFFFFFE000000, 0x7C00},
-	{0x5DB000007F7FFFFF, 0x7C00},
-	{0x43FFFFFFFF000000, 0x7C00},
-	{0x7FDFFF0000000003, 0x7C00},
-	{0x43FFFFFFFF800000, 0x7C00},
-	{0x434FFFFFE0000400, 0x7C00},
-	{0x43FFFFFFFFC00000, 0x7C00},
-	{0x35AF800000000006, 0x0000},
-	{0x43FFFFFFFFE00000, 0x7C00},
-	{0x47EFFFFFBDFFFFFE, 0x7C00},
-	{0x43FFFFFFFFF00000, 0x7C00},
-	{0x3FCE100000000000, 0x3384},
-	{0x43FFFFFFFFF80000, 0x7C00},
-	{0x3FBFC000000007FF, 0x2FF0},
-	{0x43FFFFFFFFFC0000, 0x7C00},
-	{0xDAEFFFFBFFFFFFDF, 0xFC00},
-	{0x43FFFFFFFFFE0000, 0x7C00},
-	{0x408000017FFFFFFF, 0x6000},
-	{0x43FFFFFFFFFF0000, 0x7C00},
-	{0xC3F07FFFFFFF8000, 0xFC00},
-	{0x43FFFFFFFFFF8000, 0x7C00},
-	{0xA0E5DB1E6A91DA85, 0x8000},
-	{0x43FFFFFFFFFFC000, 0x7C00},
-	{0xBE0573F4B395F1E0, 0x8000},
-	{0x43FFFFFFFFFFE000, 0x7C00},
-	{0x3FFFFF000003FFFE, 0x4000},
-	{0x43FFFFFFFFFFF000, 0x7C00},
-	{0x3FB00000103FFFFE, 0x2C00},
-	{0x43FFFFFFFFFFF800, 0x7C00},
-	{0x4154681562612F35, 0x7C00},
-	{0x43FFFFFFFFFFFC00, 0x7C00},
-	{0x390FFFFFFEEFFFFF, 0x0000},
-	{0x43FFFFFFFFFFFE00, 0x7C00},
-	{0xBFCFFFFFFFFC0001, 0xB400},
-	{0x43FFFFFFFFFFFF00, 0x7C00},
-	{0xE0DFFFFE7FFFFFFE, 0xFC00},
-	{0x43FFFFFFFFFFFF80, 0x7C00},
-	{0xC3DFFFFFFFFC0000, 0xFC00},
-	{0x43FFFFFFFFFFFFC0, 0x7C00},
-	{0x50F7FFFFFF7FFFFF, 0x7C00},
-	{0x43FFFFFFFFFFFFE0, 0x7C00},
-	{0x3DFFFFF800000400, 0x0000},
-	{0x43FFFFFFFFFFFFF0, 0x7C00},
-	{0xBA6FFFF00000007E, 0x8000},
-	{0x43FFFFFFFFFFFFF8, 0x7C00},
-	{0x3FE425355769E25D, 0x3909},
-	{0x43FFFFFFFFFFFFFC, 0x7C00},
-	{0xBFEFFFFE0003FFFE, 0xBC00},
-	{0x43FFFFFFFFFFFFFE, 0x7C00},
-	{0xC1FF800020000000, 0xFC00},
-	{0x43FFFFFFFFFFFFFF, 0x7C00},
-	{0x3FAFFFBFFFFFFFF6, 0x2C00},
-	{0x43FFFFFFFFFFFFFD, 0x7C00},
-	{0x3FE00000000041FE, 0x3800},
-	{0x43FFFFFFFFFFFFFB, 0x7C00},
-	{0x3190008000000002, 0x0000},
-	{0x43FFFFFFFFFFFFF7, 0x7C00},
-	{0xC3400000000003FB, 0xFC00},
-	{0x43FFFFFFFFFFFFEF, 0x7C00},
-	{0x41853A10957AEB7F, 0x7C00},
-	{0x43FFFFFFFFFFFFDF, 0x7C00},
-	{0xC2101FFFC0000000, 0xFC00},
-	{0x43FFFFFFFFFFFFBF, 0x7C00},
-	{0x800FFFFFF7FFFFBF, 0x8000},
-	{0x43FFFFFFFFFFFF7F, 0x7C00},
-	{0xA1C492D8B8A7B2A3, 0x8000},
-	{0x43FFFFFFFFFFFEFF, 0x7C00},
-	{0x0020000077FFFFFF, 0x0000},
-	{0x43FFFFFFFFFFFDFF, 0x7C00},
-	{0x4B3FFFFFC0FFFFFF, 0x7C00},
-	{0x43FFFFFFFFFFFBFF, 0x7C00},
-	{0x43A00000021FFFFF, 0x7C00},
-	{0x43FFFFFFFFFFF7FF, 0x7C00},
-	{0xF9E007FE00000000, 0xFC00},
-	{0x43FFFFFFFFFFEFFF, 0x7C00},
-	{0xC80FFDFFFFFEFFFF, 0xFC00},
-	{0x43FFFFFFFFFFDFFF, 0x7C00},
-	{0x40300020FFFFFFFF, 0x4C00},
-	{0x43FFFFFFFFFFBFFF, 0x7C00},
-	{0xC2B800000001FFFF, 0xFC00},
-	{0x43FFFFFFFFFF7FFF, 0x7C00},
-	{0xC28FF0007FFFFFFF, 0xFC00},
-	{0x43FFFFFFFFFEFFFF, 0x7C00},
-	{0x7FEFFFFFFFFFFDBE, 0x7C00},
-	{0x43FFFFFFFFFDFFFF, 0x7C00},
-	{0xB5EFFFC000FFFFFE, 0x8000},
-	{0x43FFFFFFFFFBFFFF, 0x7C00},
-	{0x47FD957B58845321, 0x7C00},
-	{0x43FFFFFFFFF7FFFF, 0x7C00},
-	{0xBFBDFFFFFFFFF7FF, 0xAF80},
-	{0x43FFFFFFFFEFFFFF, 0x7C00},
-	{0x3E5000000000000A, 0x0000},
-	{0x43FFFFFFFFDFFFFF, 0x7C00},
-	{0x001000001EFFFFFF, 0x0000},
-	{0x43FFFFFFFFBFFFFF, 0x7C00},
-	{0x800FFFFF8000FFFF, 0x8000},
-	{0x43FFFFFFFF7FFFFF, 0x7C00},
-	{0x313000DFFFFFFFFF, 0x0000},
-	{0x43FFFFFFFEFFFFFF, 0x7C00},
-	{0x802FFFFFF800001F, 0x8000},
-	{0x43FFFFFFFDFFFFFF, 0x7C00},
-	{0x3F0FFFFFFE000007, 0x0400},
-	{0x43FFFFFFFBFFFFFF, 0x7C00},
-	{0xDFF0000000001F00, 0xFC00},
-	{0x43FFFFFFF7FFFFFF, 0x7C00},
-	{0x37B74FDF25F9004A, 0x0000},
-	{0x43FFFFFFEFFFFFFF, 0x7C00},
-	{0xC02F8000FFFFFFFF, 0xCBE0},
-	{0x43FFFFFFDFFFFFFF, 0x7C00},
-	{0xBFE0008000000002, 0xB800},
-	{0x43FFFFFFBFFFFFFF, 0x7C00},
-	{0x3F8FFFF0000FFFFE, 0x2400},
-	{0x43FFFFFF7FFFFFFF, 0x7C00},
-	{0xBF77389991D35013, 0x9DCE},
-	{0x43FFFFFEFFFFFFFF, 0x7C00},
-	{0xCF77FFFFFFFFFF7F, 0xFC00},
-	{0x43FFFFFDFFFFFFFF, 0x7C00},
-	{0x4010000000800800, 0x4400},
-	{0x43FFFFFBFFFFFFFF, 0x7C00},
-	{0x381003FFFFFFFF00, 0x0000},
-	{0x43FFFFF7FFFFFFFF, 0x7C00},
-	{0x405001FFFFFFFFE0, 0x5400},
-	{0x43FFFFEFFFFFFFFF, 0x7C00},
-	{0x7A2FFFFFFE800000, 0x7C00},
-	{0x43FFFFDFFFFFFFFF, 0x7C00},
-	{0x401FFBFFFFBFFFFF, 0x47FF},
-	{0x43FFFFBFFFFFFFFF, 0x7C00},
-	{0xA0EFFFFBFFFFFFDF, 0x8000},
-	{0x43FFFF7FFFFFFFFF, 0x7C00},
-	{0x36BBFFFFFFFFFFEF, 0x0000},
-	{0x43FFFEFFFFFFFFFF, 0x7C00},
-	{0xBFF0080000001FFF, 0xBC02},
-	{0x43FFFDFFFFFFFFFF, 0x7C00},
-	{0xDBAFFFFE0003FFFF, 0xFC00},
-	{0x43FFFBFFFFFFFFFF, 0x7C00},
-	{0x400FFFFFFFF7EFFF, 0x4400},
-	{0x43FFF7FFFFFFFFFF, 0x7C00},
-	{0xCF2FFFFFFFFFFFD6, 0xFC00},
-	{0x43FFEFFFFFFFFFFF, 0x7C00},
-	{0x3FFFFC07FFFFFFFF, 0x3FFF},
-	{0x43FFDFFFFFFFFFFF, 0x7C00},
-	{0x3FB0000000000002, 0x2C00},
-	{0x43FFBFFFFFFFFFFF, 0x7C00},
-	{0xC0200FFFFFFFFFDF, 0xC804},
-	{0x43FF7FFFFFFFFFFF, 0x7C00},
-	{0x41EFFFFFFFFFFBFE, 0x7C00},
-	{0x43FEFFFFFFFFFFFF, 0x7C00},
-	{0x62F000007FFFEFFF, 0x7C00},
-	{0x43FDFFFFFFFFFFFF, 0x7C00},
-	{0x3FDFFFFFFFFFDFFF, 0x3800},
-	{0x43FBFFFFFFFFFFFF, 0x7C00},
-	{0x41CFFFF7FFFFFFF6, 0x7C00},
-	{0x43F7FFFFFFFFFFFF, 0x7C00},
-	{0x47E6EB8CA127990A, 0x7C00},
-	{0x43F3FFFFFFFFFFFF, 0x7C00},
-	{0x3800040000000FFF, 0x0000},
-	{0x43F1FFFFFFFFFFFF, 0x7C00},
-	{0xC01FFFFFFEFFFFFB, 0xC800},
-	{0x43F0FFFFFFFFFFFF, 0x7C00},
-	{0x41E07FFFFFFFFFFF, 0x7C00},
-	{0x43F07FFFFFFFFFFF, 0x7C00},
-	{0x3FEFF7FFFFFEFFFF, 0x3BFE},
-	{0x43F03FFFFFFFFFFF, 0x7C00},
-	{0x41DFFFFFFFFFFFFF, 0x7C00},
-	{0x43F01FFFFFFFFFFF, 0x7C00},
-	{0x3F08AF6A5B180FB6, 0x0316},
-	{0x43F00FFFFFFFFFFF, 0x7C00},
-	{0xC1AFFFFE00000001, 0xFC00},
-	{0x43F007FFFFFFFFFF, 0x7C00},
-	{0xBFC769DDA9465824, 0xB1DA},
-	{0x43F003FFFFFFFFFF, 0x7C00},
-	{0x41305FFFFFFFFFFF, 0x7C00},
-	{0x43F001FFFFFFFFFF, 0x7C00},
-	{0xC103AA6952653F90, 0xFC00},
-	{0x43F000FFFFFFFFFF, 0x7C00},
-	{0x4080000020001000, 0x6000},
-	{0x43F0007FFFFFFFFF, 0x7C00},
-	{0x001FFFE00000007F, 0x0000},
-	{0x43F0003FFFFFFFFF, 0x7C00},
-	{0xBFF6E7466D1DB766, 0xBDBA},
-	{0x43F0001FFFFFFFFF, 0x7C00},
-	{0x405FFFFFFDFFFFDF, 0x5800},
-	{0x43F0000FFFFFFFFF, 0x7C00},
-	{0xC00D1439EA5CBB91, 0xC345},
-	{0x43F00007FFFFFFFF, 0x7C00},
-	{0x37FFDFFFBFFFFFFE, 0x0000},
-	{0x43F00003FFFFFFFF, 0x7C00},
-	{0xC3EFBDFFFFFFFFFF, 0xFC00},
-	{0x43F00001FFFFFFFF, 0x7C00},
-	{0x61EDE689C588D150, 0x7C00},
-	{0x43F00000FFFFFFFF, 0x7C00},
-	{0x40700FFFFFFFBFFE, 0x5C04},
-	{0x43F000007FFFFFFF, 0x7C00},
-	{0x42C0000800020000, 0x7C00},
-	{0x43F000003FFFFFFF, 0x7C00},
-	{0xC34FFFF800FFFFFE, 0xFC00},
-	{0x43F000001FFFFFFF, 0x7C00},
-	{0xC7E00FFFFFFFFDFF, 0xFC00},
-	{0x43F000000FFFFFFF, 0x7C00},
-	{0xC0000008000003FF, 0xC000},
-	{0x43F0000007FFFFFF, 0x7C00},
-	{0xBFFFFFFFBFFFFDFF, 0xC000},
-	{0x43F0000003FFFFFF, 0x7C00},
-	{0xC3CFFFFFFFFFF900, 0xFC00},
-	{0x43F0000001FFFFFF, 0x7C00},
-	{0xBFC3E260F625A82A, 0xB0F9},
-	{0x43F0000000FFFFFF, 0x7C00},
-	{0xB800D01AB2BB8E30, 0x8000},
-	{0x43F00000007FFFFF, 0x7C00},
-	{0xC03945B9E77B3CAE, 0xCE51},
-	{0x43F00000003FFFFF, 0x7C00},
-	{0xC7E88C88289E85FB, 0xFC00},
-	{0x43F00000001FFFFF, 0x7C00},
-	{0x4057DDEF90CB3603, 0x55F7},
-	{0x43F00000000FFFFF, 0x7C00},
-	{0x41D00801FFFFFFFE, 0x7C00},
-	{0x43F000000007FFFF, 0x7C00},
-	{0xBE6FFFFFFFFFF040, 0x8001},
-	{0x43F000000003FFFF, 0x7C00},
-	{0xC1001FFFFFFFF7FE, 0xFC00},
-	{0x43F000000001FFFF, 0x7C00},
-	{0xB800000007FFFFEF, 0x8000},
-	{0x43F000000000FFFF, 0x7C00},
-	{0xBF5FFFC7FFFFFFFF, 0x9800},
-	{0x43F0000000007FFF, 0x7C00},
-	{0x3F5620FE966296F8, 0x1588},
-	{0x43F0000000003FFF, 0x7C00},
-	{0x4020000000100006, 0x4800},
-	{0x43F0000000001FFF, 0x7C00},
-	{0x400FFF000000003F, 0x4400},
-	{0x43F0000000000FFF, 0x7C00},
-	{0x42ABEB1F4175F24A, 0x7C00},
-	{0x43F00000000007FF, 0x7C00},
-	{0xC01000017FFFFFFE, 0xC400},
-	{0x43F00000000003FF, 0x7C00},
-	{0x3FDFFFFFFFFE3FFF, 0x3800},
-	{0x43F00000000001FF, 0x7C00},
-	{0x403007FFFFDFFFFF, 0x4C02},
-	{0x43F00000000000FF, 0x7C00},
-	{0x3FF0000000007F00, 0x3C00},
-	{0x43F000000000007F, 0x7C00},
-	{0xAA60001000000004, 0x8000},
-	{0x43F000000000003F, 0x7C00},
-	{0xBFDFF8000000000E, 0xB7FE},
-	{0x43F000000000001F, 0x7C00},
-	{0x384FFFFFDDFFFFFF, 0x0000},
-	{0x43F000000000000F, 0x7C00},
-	{0xC3D7FFFFFF7FFFFF, 0xFC00},
-	{0x43F0000000000007, 0x7C00},
-	{0x0010009FFFFFFFFF, 0x0000},
-	{0x43F0000000000003, 0x7C00},
-	{0x7FD199703368CC21, 0x7C00},
-	{0x47E0000000000000, 0x7C00},
-	{0x3E8FFE00007FFFFF, 0x0004},
-	{0x47E0000000000001, 0x7C00},
-	{0x480000000000037F, 0x7C00},
-	{0x47E0000000000002, 0x7C00},
-	{0xC020000001000006, 0xC800},
-	{0x47E0000000000004, 0x7C00},
-	{0xB810020000000006, 0x8000},
-	{0x47E0000000000008, 0x7C00},
-	{0xBFEFFFFFFFFBFFFE, 0xBC00},
-	{0x47E0000000000010, 0x7C00},
-	{0x3320010000000000, 0x0000},
-	{0x47E0000000000020, 0x7C00},
-	{0xC3EFDFFFFFFFFFC0, 0xFC00},
-	{0x47E0000000000040, 0x7C00},
-	{0x3FEFFFFC00000003, 0x3C00},
-	{0x47E0000000000080, 0x7C00},
-	{0x43400000FFDFFFFF, 0x7C00},
-	{0x47E0000000000100, 0x7C00},
-	{0x173FFFFFFFF7FBFF, 0x0000},
-	{0x47E0000000000200, 0x7C00},
-	{0x0323FFFFFFFFFDFE, 0x0000},
-	{0x47E0000000000400, 0x7C00},
-	{0x4AE3B7D35C74B77B, 0x7C00},
-	{0x47E0000000000800, 0x7C00},
-	{0x3F703FFFFFFFFDFE, 0x1C10},
-	{0x47E0000000001000, 0x7C00},
-	{0x408FFFFFBFF7FFFE, 0x6400},
-	{0x47E0000000002000, 0x7C00},
-	{0x3FD000000000001F, 0x3400},
-	{0x47E0000000004000, 0x7C00},
-	{0x682FFBFFFFEFFFFE, 0x7C00},
-	{0x47E0000000008000, 0x7C00},
-	{0xBFFA5AC8960744C3, 0xBE97},
-	{0x47E0000000010000, 0x7C00},
-	{0xA7C000000000000C, 0x8000},
-	{0x47E0000000020000, 0x7C00},
-	{0x3A00000000A00000, 0x0000},
-	{0x47E0000000040000, 0x7C00},
-	{0xDDFFFFFFFFFFF003, 0xFC00},
-	{0x47E0000000080000, 0x7C00},
-	{0xC1E03FFFFFFFFDFE, 0xFC00},
-	{0x47E0000000100000, 0x7C00},
-	{0x3806A69172CD1EEC, 0x0000},
-	{0x47E0000000200000, 0x7C00},
-	{0x400FC000007FFFFF, 0x43F0},
-	{0x47E0000000400000, 0x7C00},
-	{0x400FFFF7FDFFFFFE, 0x4400},
-	{0x47E0000000800000, 0x7C00},
-	{0x4800040000000080, 0x7C00},
-	{0x47E0000001000000, 0x7C00},
-	{0xBF200000002000FE, 0x8800},
-	{0x47E0000002000000, 0x7C00},
-	{0x000FFFFFFFFFFF82, 0x0000},
-	{0x47E0000004000000, 0x7C00},
-	{0xC3FFFEDFFFFFFFFF, 0xFC00},
-	{0x47E0000008000000, 0x7C00},
-	{0xBFC594A5A7DC6EB3, 0xB165},
-	{0x47E0000010000000, 0x7C00},
-	{0x406E22094896F0BE, 0x5B89},
-	{0x47E0000020000000, 0x7C00},
-	{0xC46E742D8C3881E8, 0xFC00},
-	{0x47E0000040000000, 0x7C00},
-	{0xC1C0008000000100, 0xFC00},
-	{0x47E0000080000000, 0x7C00},
-	{0x001FFFDFFFFFFFDF, 0x0000},
-	{0x47E0000100000000, 0x7C00},
-	{0x41FFFFF6FFFFFFFF, 0x7C00},
-	{0x47E0000200000000, 0x7C00},
-	{0xFFE07FFFFFFFFBFF, 0xFC00},
-	{0x47E0000400000000, 0x7C00},
-	{0xC37FFFFFDFFFFF80, 0xFC00},
-	{0x47E0000800000000, 0x7C00},
-	{0xC1FFFFFFFFFF7FFF, 0xFC00},
-	{0x47E0001000000000, 0x7C00},
-	{0xFFDFFFFFFFFEFFEF, 0xFC00},
-	{0x47E0002000000000, 0x7C00},
-	{0x401001FFFE000000, 0x4400},
-	{0x47E0004000000000, 0x7C00},
-	{0x3E1FFFFC3FFFFFFF, 0x0000},
-	{0x47E0008000000000, 0x7C00},
-	{0xA8E00DDE2C0E71C9, 0x8000},
-	{0x47E0010000000000, 0x7C00},
-	{0x41C0000002000800, 0x7C00},
-	{0x47E0020000000000, 0x7C00},
-	{0xFFFA63291B47DAD6, 0xFE98},
-	{0x47E0040000000000, 0x7C00},
-	{0x8EF07FBFFFFFFFFE, 0x8000},
-	{0x47E0080000000000, 0x7C00},
-	{0xF0AFFFBBFFFFFFFF, 0xFC00},
-	{0x47E0100000000000, 0x7C00},
-	{0xB7ED9A86481B221F, 0x8000},
-	{0x47E0200000000000, 0x7C00},
-	{0x7FD059882092DDE7, 0x7C00},
-	{0x47E0400000000000, 0x7C00},
-	{0xB80000000100003F, 0x8000},
-	{0x47E0800000000000, 0x7C00},
-	{0x3FBDF7FFFFFFFFFF, 0x2F7E},
-	{0x47E1000000000000, 0x7C00},
-	{0x3FDFFFFFFC1FFFFE, 0x3800},
-	{0x47E2000000000000, 0x7C00},
-	{0x3FDFFFFFFFFFFC1E, 0x3800},
-	{0x47E4000000000000, 0x7C00},
-	{0x47E07FFFFFFFEFFE, 0x7C00},
-	{0x47E8000000000000, 0x7C00},
-	{0xF3B1BF0CDA3621DC, 0xFC00},
-	{0x47EC000000000000, 0x7C00},
-	{0x40300FFFFFFFF7FE, 0x4C04},
-	{0x47EE000000000000, 0x7C00},
-	{0x4024B307E18FADB9, 0x492D},
-	{0x47EF000000000000, 0x7C00},
-	{0x3F4FFFF7FFE00000, 0x1400},
-	{0x47EF800000000000, 0x7C00},
-	{0x7FED0E33D4440DF2, 0x7C00},
-	{0x47EFC00000000000, 0x7C00},
-	{0xFFF00001FFFFBFFF, 0xFE00},
-	{0x47EFE00000000000, 0x7C00},
-	{0xBFF63AF2F3DEAEE7, 0xBD8F},
-	{0x47EFF00000000000, 0x7C00},
-	{0xB80FFFF800800000, 0x8000},
-	{0x47EFF80000000000, 0x7C00},
-	{0x3CF0001000000000, 0x0000},
-	{0x47EFFC0000000000, 0x7C00},
-	{0xC3E0000200000020, 0xFC00},
-	{0x47EFFE0000000000, 0x7C00},
-	{0x3DDFFFFFFFFFE01E, 0x0000},
-	{0x47EFFF0000000000, 0x7C00},
-	{0x43E000003FFFFBFF, 0x7C00},
-	{0x47EFFF8000000000, 0x7C00},
-	{0xBFC00403FFFFFFFF, 0xB001},
-	{0x47EFFFC000000000, 0x7C00},
-	{0x43CFFFFFF7FFBFFF, 0x7C00},
-	{0x47EFFFE000000000, 0x7C00},
-	{0xBDC705F36FA21F4B, 0x8000},
-	{0x47EFFFF000000000, 0x7C00},
-	{0xFFEFFFFFFFFFF3FF, 0xFC00},
-	{0x47EFFFF800000000, 0x7C00},
-	{0xC800000020001FFF, 0xFC00},
-	{0x47EFFFFC00000000, 0x7C00},
-	{0x434F00000000000F, 0x7C00},
-	{0x47EFFFFE00000000, 0x7C00},
-	{0x480FEFFFFFFFFF7F, 0x7C00},
-	{0x47EFFFFF00000000, 0x7C00},
-	{0xC77DFFDFFFFFFFFF, 0xFC00},
-	{0x47EFFFFF80000000, 0x7C00},
-	{0x00200100007FFFFF, 0x0000},
-	{0x47EFFFFFC0000000, 0x7C00},
-	{0x4190FEFFFFFFFFFF, 0x7C00},
-	{0x47EFFFFFE0000000, 0x7C00},
-	{0xC00FFFFFFFFC03FE, 0xC400},
-	{0x47EFFFFFF0000000, 0x7C00},
-	{0xD9E1C95C091A12E4, 0xFC00},
-	{0x47EFFFFFF8000000, 0x7C00},
-	{0xC060000001FF7FFF, 0xD800},
-	{0x47EFFFFFFC000000, 0x7C00},
-	{0xC1EF000010000000, 0xFC00},
-	{0x47EFFFFFFE000000, 0x7C00},
-	{0xC1CFFFFFC000FFFF, 0xFC00},
-	{0x47EFFFFFFF000000, 0x7C00},
-	{0x3FF000008000001F, 0x3C00},
-	{0x47EFFFFFFF800000, 0x7C00},
-	{0x000FFFFFFF800010, 0x0000},
-	{0x47EFFFFFFFC00000, 0x7C00},
-	{0xBFE0007FFDFFFFFE, 0xB800},
-	{0x47EFFFFFFFE00000, 0x7C00},
-	{0xBFFFF8003FFFFFFF, 0xBFFE},
-	{0x47EFFFFFFFF00000, 0x7C00},
-	{0xBFCFFFFBFFFFFDFE, 0xB400},
-	{0x47EFFFFFFFF80000, 0x7C00},
-	{0xBCA1FFFFFFFFFFDF, 0x8000},
-	{0x47EFFFFFFFFC0000, 0x7C00},
-	{0x381FFFC0000000FF, 0x0000},
-	{0x47EFFFFFFFFE0000, 0x7C00},
-	{0xBF0FFFFFFFFF77FE, 0x8400},
-	{0x47EFFFFFFFFF0000, 0x7C00},
-	{0x800CCAC8D990FC47, 0x8000},
-	{0x47EFFFFFFFFF8000, 0x7C00},
-	{0x47FE5B075C0561D2, 0x7C00},
-	{0x47EFFFFFFFFFC000, 0x7C00},
-	{0x41D2E918B5CFE4EB, 0x7C00},
-	{0x47EFFFFFFFFFE000, 0x7C00},
-	{0xBF50000000FFFFF0, 0x9400},
-	{0x47EFFFFFFFFFF000, 0x7C00},
-	{0xB840001001FFFFFE, 0x8000},
-	{0x47EFFFFFFFFFF800, 0x7C00},
-	{0x3FD000FFF0000000, 0x3400},
-	{0x47EFFFFFFFFFFC00, 0x7C00},
-	{0xC7EFFFFFEFFFFDFF, 0xFC00},
-	{0x47EFFFFFFFFFFE00, 0x7C00},
-	{0xBD9FFFFFDFEFFFFF, 0x8000},
-	{0x47EFFFFFFFFFFF00, 0x7C00},
-	{0x477BD73AE931066D, 0x7C00},
-	{0x47EFFFFFFFFFFF80, 0x7C00},
-	{0x3FCFFF8000000100, 0x3400},
-	{0x47EFFFFFFFFFFFC0, 0x7C00},
-	{0x401004000000003E, 0x4401},
-	{0x47EFFFFFFFFFFFE0, 0x7C00},
-	{0x43C9D2E862CE494C, 0x7C00},
-	{0x47EFFFFFFFFFFFF0, 0x7C00},
-	{0xC040407FFFFFFFFF, 0xD010},
-	{0x47EFFFFFFFFFFFF8, 0x7C00},
-	{0x3FCFFFFFFFFFF800, 0x3400},
-	{0x47EFFFFFFFFFFFFC, 0x7C00},
-	{0x3F8EA4FFE8CC1C68, 0x23A9},
-	{0x47EFFFFFFFFFFFFE, 0x7C00},
-	{0x3FC783FD7A99268C, 0x31E1},
-	{0x47EFFFFFFFFFFFFF, 0x7C00},
-	{0xF2FC01FFFFFFFFFE, 0xFC00},
-	{0x47EFFFFFFFFFFFFD, 0x7C00},
-	{0xBEA000000BFFFFFE, 0x8008},
-	{0x47EFFFFFFFFFFFFB, 0x7C00},
-	{0x27BFFFC000000007, 0x0000},
-	{0x47EFFFFFFFFFFFF7, 0x7C00},
-	{0x40CFE00000020000, 0x73F8},
-	{0x47EFFFFFFFFFFFEF, 0x7C00},
-	{0xC1CFFFF00003FFFE, 0xFC00},
-	{0x47EFFFFFFFFFFFDF, 0x7C00},
-	{0x37FFF80000000080, 0x0000},
-	{0x47EFFFFFFFFFFFBF, 0x7C00},
-	{0xC5168A17F32DB6FF, 0xFC00},
-	{0x47EFFFFFFFFFFF7F, 0x7C00},
-	{0x4AC0200000000007, 0x7C00},
-	{0x47EFFFFFFFFFFEFF, 0x7C00},
-	{0x3FE00000000002FF, 0x3800},
-	{0x47EFFFFFFFFFFDFF, 0x7C00},
-	{0xC54FFFFFBEFFFFFF, 0xFC00},
-	{0x47EFFFFFFFFFFBFF, 0x7C00},
-	{0xC1F545BEDE121CA1, 0xFC00},
-	{0x47EFFFFFFFFFF7FF, 0x7C00},
-	{0x40100000006FFFFF, 0x4400},
-	{0x47EFFFFFFFFFEFFF, 0x7C00},
-	{0xD88FF8001FFFFFFF, 0xFC00},
-	{0x47EFFFFFFFFFDFFF, 0x7C00},
-	{0xC0329D88CEA146D8, 0xCCA7},
-	{0x47EFFFFFFFFFBFFF, 0x7C00},
-	{0x3F0144F6D411B996, 0x0229},
-	{0x47EFFFFFFFFF7FFF, 0x7C00},
-	{0x304F7BFFFFFFFFFF, 0x0000},
-	{0x47EFFFFFFFFEFFFF, 0x7C00},
-	{0xBFFF5FFFFFFFFFFF, 0xBFD8},
-	{0x47EFFFFFFFFDFFFF, 0x7C00},
-	{0x395FFFFFFF01FFFF, 0x0000},
-	{0x47EFFFFFFFFBFFFF, 0x7C00},
-	{0x404FFFEFEFFFFFFE, 0x5400},
-	{0x47EFFFFFFFF7FFFF, 0x7C00},
-	{0x57464D617021292B, 0x7C00},
-	{0x47EFFFFFFFEFFFFF, 0x7C00},
-	{0xFFD4DB0A17F460AA, 0xFC00},
-	{0x47EFFFFFFFDFFFFF, 0x7C00},
-	{0x801FFFFFFEFF7FFF, 0x8000},
-	{0x47EFFFFFFFBFFFFF, 0x7C00},
-	{0xC7EF834C1C8BF50B, 0xFC00},
-	{0x47EFFFFFFF7FFFFF, 0x7C00},
-	{0x43D6DA97B882EA93, 0x7C00},
-	{0x47EFFFFFFEFFFFFF, 0x7C00},
-	{0xB4BDFFFFFFFFFF7F, 0x8000},
-	{0x47EFFFFFFDFFFFFF, 0x7C00},
-	{0xB81FF0000000000E, 0x8000},
-	{0x47EFFFFFFBFFFFFF, 0x7C00},
-	{0x434FFFFE00004000, 0x7C00},
-	{0x47EFFFFFF7FFFFFF, 0x7C00},
-	{0xC1FFFFFFFFFFFFFF, 0xFC00},
-	{0x47EFFFFFEFFFFFFF, 0x7C00},
-	{0x9E56C89B4E39D7FC, 0x8000},
-	{0x47EFFFFFDFFFFFFF, 0x7C00},
-	{0x3803FFFFFFFFFFC0, 0x0000},
-	{0x47EFFFFFBFFFFFFF, 0x7C00},
-	{0xC19000DFFFFFFFFE, 0xFC00},
-	{0x47EFFFFF7FFFFFFF, 0x7C00},
-	{0xB7E0000800001FFE, 0x8000},
-	{0x47EFFFFEFFFFFFFF, 0x7C00},
-	{0xBB00000000004007, 0x8000},
-	{0x47EFFFFDFFFFFFFF, 0x7C00},
-	{0xFFEFFFFEFFFFDFFF, 0xFC00},
-	{0x47EFFFFBFFFFFFFF, 0x7C00},
-	{0xCC3FFFFFFDFFFFFE, 0xFC00},
-	{0x47EFFFF7FFFFFFFF, 0x7C00},
-	{0x37FE000000800000, 0x0000},
-	{0x47EFFFEFFFFFFFFF, 0x7C00},
-	{0xBEFFFFFFFFFFFFFF, 0x8200},
-	{0x47EFFFDFFFFFFFFF, 0x7C00},
-	{0x7FE07FFFFFBFFFFE, 0x7C00},
-	{0x47EFFFBFFFFFFFFF, 0x7C00},
-	{0xC3DFEFFFE0000000, 0xFC00},
-	{0x47EFFF7FFFFFFFFF, 0x7C00},
-	{0xCC00000000000000, 0xFC00},
-	{0x47EFFEFFFFFFFFFF, 0x7C00},
-	{0xB7F631128B06628E, 0x8000},
-	{0x47EFFDFFFFFFFFFF, 0x7C00},
-	{0xC00FFEFFDFFFFFFF, 0xC400},
-	{0x47EFFBFFFFFFFFFF, 0x7C00},
-	{0x8013D0CFEEE9CBD5, 0x8000},
-	{0x47EFF7FFFFFFFFFF, 0x7C00},
-	{0x3E2FFFFE00002000, 0x0000},
-	{0x47EFEFFFFFFFFFFF, 0x7C00},
-	{0xBFEFFFFFFDFF7FFE, 0xBC00},
-	{0x47EFDFFFFFFFFFFF, 0x7C00},
-	{0x43DFDFFFBFFFFFFE, 0x7C00},
-	{0x47EFBFFFFFFFFFFF, 0x7C00},
-	{0xC1EEC4A135CDEF99, 0xFC00},
-	{0x47EF7FFFFFFFFFFF, 0x7C00},
-	{0x3FF07FF000000000, 0x3C20},
-	{0x47EEFFFFFFFFFFFF, 0x7C00},
-	{0xBFFED188C1594EBF, 0xBFB4},
-	{0x47EDFFFFFFFFFFFF, 0x7C00},
-	{0xC1F0000000100010, 0xFC00},
-	{0x47EBFFFFFFFFFFFF, 0x7C00},
-	{0xCBAFFFFFFFFEFFFB, 0xFC00},
-	{0x47E7FFFFFFFFFFFF, 0x7C00},
-	{0x48001FFFFF7FFFFE, 0x7C00},
-	{0x47E3FFFFFFFFFFFF, 0x7C00},
-	{0xC7FFF7FFFFFFFFDF, 0xFC00},
-	{0x47E1FFFFFFFFFFFF, 0x7C00},
-	{0x43C017FFFFFFFFFF, 0x7C00},
-	{0x47E0FFFFFFFFFFFF, 0x7C00},
-	{0xB81023554FC8CD69, 0x8000},
-	{0x47E07FFFFFFFFFFF, 0x7C00},
-	{0x802FFFFFDFFFFFF0, 0x8000},
-	{0x47E03FFFFFFFFFFF, 0x7C00},
-	{0x3FFFFFF8001FFFFE, 0x4000},
-	{0x47E01FFFFFFFFFFF, 0x7C00},
-	{0x4C3FFFFB00000000, 0x7C00},
-	{0x47E00FFFFFFFFFFF, 0x7C00},
-	{0xC3F0FF8000000000, 0xFC00},
-	{0x47E007FFFFFFFFFF, 0x7C00},
-	{0xBE5000003FFDFFFE, 0x8000},
-	{0x47E003FFFFFFFFFF, 0x7C00},
-	{0xFFDD1CAAF173983D, 0xFC00},
-	{0x47E001FFFFFFFFFF, 0x7C00},
-	{0xC0000000000107FF, 0xC000},
-	{0x47E000FFFFFFFFFF, 0x7C00},
-	{0xB860001FFEFFFFFF, 0x8000},
-	{0x47E0007FFFFFFFFF, 0x7C00},
-	{0x416FFFFFFFFFC003, 0x7C00},
-	{0x47E0003FFFFFFFFF, 0x7C00},
-	{0x47F00001007FFFFF, 0x7C00},
-	{0x47E0001FFFFFFFFF, 0x7C00},
-	{0xF400007FFFBFFFFF, 0xFC00},
-	{0x47E0000FFFFFFFFF, 0x7C00},
-	{0x3FF00020007FFFFF, 0x3C00},
-	{0x47E00007FFFFFFFF, 0x7C00},
-	{0xBFFFF9FFFFFFFFFF, 0xBFFE},
-	{0x47E00003FFFFFFFF, 0x7C00},
-	{0x5980001DFFFFFFFF, 0x7C00},
-	{0x47E00001FFFFFFFF, 0x7C00},
-	{0xBA10003FFFFFFBFE, 0x8000},
-	{0x47E00000FFFFFFFF, 0x7C00},
-	{0xC7FDFFFFFFFFEFFF, 0xFC00},
-	{0x47E000007FFFFFFF, 0x7C00},
-	{0xC0084AF2172CE527, 0xC213},
-	{0x47E000003FFFFFFF, 0x7C00},
-	{0x3D5FFFFFFFFEFFFE, 0x0000},
-	{0x47E000001FFFFFFF, 0x7C00},
-	{0x8020100000000004, 0x8000},
-	{0x47E000000FFFFFFF, 0x7C00},
-	{0xBFFBFFFFBFFFFFFF, 0xBF00},
-	{0x47E0000007FFFFFF, 0x7C00},
-	{0xC5903FFFFFBFFFFE, 0xFC00},
-	{0x47E0000003FFFFFF, 0x7C00},
-	{0x7C5ABCBCC1FFCC3D, 0x7C00},
-	{0x47E0000001FFFFFF, 0x7C00},
-	{0x480BA9DC30631736, 0x7C00},
-	{0x47E0000000FFFFFF, 0x7C00},
-	{0x737FFFFFFFFF7FF6, 0x7C00},
-	{0x47E00000007FFFFF, 0x7C00},
-	{0xBD703FFFFFFFC000, 0x8000},
-	{0x47E00000003FFFFF, 0x7C00},
-	{0x59DFFFFE10000000, 0x7C00},
-	{0x47E00000001FFFFF, 0x7C00},
-	{0xBFEFF800007FFFFF, 0xBBFE},
-	{0x47E00000000FFFFF, 0x7C00},
-	{0xC34976CB116A8993, 0xFC00},
-	{0x47E000000007FFFF, 0x7C00},
-	{0x534FFFFFFFFFE3FF, 0x7C00},
-	{0x47E000000003FFFF, 0x7C00},
-	{0x48001FFFFFFFFFEF, 0x7C00},
-	{0x47E000000001FFFF, 0x7C00},
-	{0xFFF000020FFFFFFF, 0xFE00},
-	{0x47E000000000FFFF, 0x7C00},
-	{0xBFDB4B5DC7F565F9, 0xB6D3},
-	{0x47E0000000007FFF, 0x7C00},
-	{0xD9C8F3F60FDD995D, 0xFC00},
-	{0x47E0000000003FFF, 0x7C00},
-	{0x4020000040004000, 0x4800},
-	{0x47E0000000001FFF, 0x7C00},
-	{0x002F7FF7FFFFFFFE, 0x0000},
-	{0x47E0000000000FFF, 0x7C00},
-	{0x41C4D685A985EBFE, 0x7C00},
-	{0x47E00000000007FF, 0x7C00},
-	{0x406000000008000F, 0x5800},
-	{0x47E00000000003FF, 0x7C00},
-	{0xC1DFE0001FFFFFFE, 0xFC00},
-	{0x47E00000000001FF, 0x7C00},
-	{0xB8DFFFFFFFEFFFEF, 0x8000},
-	{0x47E00000000000FF, 0x7C00},
-	{0xFFD00000001003FF, 0xFC00},
-	{0x47E000000000007F, 0x7C00},
-	{0x3CEFFFFFFBFFFDFF, 0x0000},
-	{0x47E000000000003F, 0x7C00},
-	{0xB80FFFE000200000, 0x8000},
-	{0x47E000000000001F, 0x7C00},
-	{0x43E0010000000FFF, 0x7C00},
-	{0x47E000000000000F, 0x7C00},
-	{0x3F4FFFFFDFFFFFFE, 0x1400},
-	{0x47E0000000000007, 0x7C00},
-	{0x3B1000000000FBFE, 0x0000},
-	{0x47E0000000000003, 0x7C00},
-	{0x3FDFFFFFFE0003FF, 0x3800},
-	{0x47F0000000000000, 0x7C00},
-	{0xB800000000410000, 0x8000},
-	{0x47F0000000000001, 0x7C00},
-	{0xBFF704A83DBE0136, 0xBDC1},
-	{0x47F0000000000002, 0x7C00},
-	{0x0010000000000013, 0x0000},
-	{0x47F0000000000004, 0x7C00},
-	{0xBC000000FFFEFFFF, 0x8000},
-	{0x47F0000000000008, 0x7C00},
-	{0xC01BFFFFFFFFFFFA, 0xC700},
-	{0x47F0000000000010, 0x7C00},
-	{0xB81AEDF3EB96AB9F, 0x8000},
-	{0x47F0000000000020, 0x7C00},
-	{0x41F01FFFFFFFDFFE, 0x7C00},
-	{0x47F0000000000040, 0x7C00},
-	{0xC800007FFFFDFFFF, 0xFC00},
-	{0x47F0000000000080, 0x7C00},
-	{0x3B443C58DF816283, 0x0000},
-	{0x47F0000000000100, 0x7C00},
-	{0x801FFFFFF7FBFFFF, 0x8000},
-	{0x47F0000000000200, 0x7C00},
-	{0xB7E6A5753E234F47, 0x8000},
-	{0x47F0000000000400, 0x7C00},
-	{0x7FE00007FEFFFFFF, 0x7C00},
-	{0x47F0000000000800, 0x7C00},
-	{0xFFFFFFFFFBF7FFFF, 0xFFFF},
-	{0x47F0000000001000, 0x7C00},
-	{0xC0700001FFFFFFFF, 0xDC00},
-	{0x47F0000000002000, 0x7C00},
-	{0xC01000000007DFFE, 0xC400},
-	{0x47F0000000004000, 0x7C00},
-	{0x3FEFFFFFFFFFEFFD, 0x3C00},
-	{0x47F0000000008000, 0x7C00},
-	{0x37F0FFFFFFFFFFFB, 0x0000},
-	{0x47F0000000010000, 0x7C00},
-	{0xC3E0000400000FFF, 0xFC00},
-	{0x47F0000000020000, 0x7C00},
-	{0x41A0000005FFFFFF, 0x7C00},
-	{0x47F0000000040000, 0x7C00},
-	{0xC0100000000FFFEE, 0xC400},
-	{0x47F0000000080000, 0x7C00},
-	{0x43C1FFFDFFFFFFFF, 0x7C00},
-	{0x47F0000000100000, 0x7C00},
-	{0x3CAFFFFFFFFF801E, 0x0000},
-	{0x47F0000000200000, 0x7C00},
-	{0xCA86CA4ECBFC235B, 0xFC00},
-	{0x47F0000000400000, 0x7C00},
-	{0x4E8007FFFDFFFFFF, 0x7C00},
-	{0x47F0000000800000, 0x7C00},
-	{0xB800000FFFFFFFEF, 0x8000},
-	{0x47F0000001000000, 0x7C00},
-	{0x3FE0081FFFFFFFFE, 0x3802},
-	{0x47F0000002000000, 0x7C00},
-	{0x7FFFFFBBFFFFFFFF, 0x7FFF},
-	{0x47F0000004000000, 0x7C00},
-	{0xB7FFFFBFFFFFFF80, 0x8000},
-	{0x47F0000008000000, 0x7C00},
-	{0xBF65A13CBB2CF477, 0x9968},
-	{0x47F0000010000000, 0x7C00},
-	{0x62C821A814612A6F, 0x7C00},
-	{0x47F0000020000000, 0x7C00},
-	{0xC1000007FFFFDFFE, 0xFC00},
-	{0x47F0000040000000, 0x7C00},
-	{0xBFD080000001FFFE, 0xB420},
-	{0x47F0000080000000, 0x7C00},
-	{0x43E0000010007FFE, 0x7C00},
-	{0x47F0000100000000, 0x7C00},
-	{0x3FF0000000000803, 0x3C00},
-	{0x47F0000200000000, 0x7C00},
-	{0x40063C718B0EC42F, 0x418F},
-	{0x47F0000400000000, 0x7C00},
-	{0xBFFFFFFFF0020000, 0xC000},
-	{0x47F0000800000000, 0x7C00},
-	{0x3FC621AC54D73ACE, 0x3188},
-	{0x47F0001000000000, 0x7C00},
-	{0x43C2000000007FFF, 0x7C00},
-	{0x47F0002000000000, 0x7C00},
-	{0x3FF1FFFFFFFFFFF0, 0x3C80},
-	{0x47F0004000000000, 0x7C00},
-	{0xC1C01C4C28F40969, 0xFC00},
-	{0x47F0008000000000, 0x7C00},
-	{0xC1FFFFFFFF7FFF80, 0xFC00},
-	{0x47F0010000000000, 0x7C00},
-	{0xD89FFFFFFFF00FFF, 0xFC00},
-	{0x47F0020000000000, 0x7C00},
-	{0xC3DFFFFFFC1FFFFF, 0xFC00},
-	{0x47F0040000000000, 0x7C00},
-	{0x800FFFDFFFFFFBFF, 0x8000},
-	{0x47F0080000000000, 0x7C00},
-	{0xB4BFFFFE0000003E, 0x8000},
-	{0x47F0100000000000, 0x7C00},
-	{0xBFC0080001000000, 0xB002},
-	{0x47F0200000000000, 0x7C00},
-	{0x380FFFFC1FFFFFFF, 0x0000},
-	{0x47F0400000000000, 0x7C00},
-	{0x2B9FFFFFBFFFFFEE, 0x0000},
-	{0x47F0800000000000, 0x7C00},
-	{0x3FDA5731C30D737D, 0x3696},
-	{0x47F1000000000000, 0x7C00},
-	{0xB27010000007FFFF, 0x8000},
-	{0x47F2000000000000, 0x7C00},
-	{0x8DD0000007FFFDFF, 0x8000},
-	{0x47F4000000000000, 0x7C00},
-	{0xBFD000000007FBFF, 0xB400},
-	{0x47F8000000000000, 0x7C00},
-	{0x3FD000000000FF7F, 0x3400},
-	{0x47FC000000000000, 0x7C00},
-	{0xBF0D97FC330BA474, 0x83B3},
-	{0x47FE000000000000, 0x7C00},
-	{0x41FFFFF7FFF7FFFF, 0x7C00},
-	{0x47FF000000000000, 0x7C00},
-	{0xC03BFFFFFFFFFFFE, 0xCF00},
-	{0x47FF800000000000, 0x7C00},
-	{0xBFAAE08A80A285BA, 0xAAB8},
-	{0x47FFC00000000000, 0x7C00},
-	{0xC3CF2511B65C75FE, 0xFC00},
-	{0x47FFE00000000000, 0x7C00},
-	{0x3880FFFFFFFBFFFF, 0x0000},
-	{0x47FFF00000000000, 0x7C00},
-	{0xBCA0000000FBFFFE, 0x8000},
-	{0x47FFF80000000000, 0x7C00},
-	{0x406067C5BF9A5C63, 0x581A},
-	{0x47FFFC0000000000, 0x7C00},
-	{0x3F80FFFFFFFFFFFD, 0x2040},
-	{0x47FFFE0000000000, 0x7C00},
-	{0xC7E000000007FFFE, 0xFC00},
-	{0x47FFFF0000000000, 0x7C00},
-	{0xAB900007FFFFFF80, 0x8000},
-	{0x47FFFF8000000000, 0x7C00},
-	{0x3FC000401FFFFFFF, 0x3000},
-	{0x47FFFFC000000000, 0x7C00},
-	{0xBED000000000013F, 0x8040},
-	{0x47FFFFE000000000, 0x7C00},
-	{0xC3F000000001007F, 0xFC00},
-	{0x47FFFFF000000000, 0x7C00},
-	{0x480D3B923D3F388A, 0x7C00},
-	{0x47FFFFF800000000, 0x7C00},
-	{0x3FB0001FF7FFFFFF, 0x2C00},
-	{0x47FFFFFC00000000, 0x7C00},
-	{0x4B600000FFFFFFFD, 0x7C00},
-	{0x47FFFFFE00000000, 0x7C00},
-	{0xC12FC00001FFFFFE, 0xFC00},
-	{0x47FFFFFF00000000, 0x7C00},
-	{0x37F000200000FFFF, 0x0000},
-	{0x47FFFFFF80000000, 0x7C00},
-	{0x3EDFFFFFFFFAFFFF, 0x0080},
-	{0x47FFFFFFC0000000, 0x7C00},
-	{0x3F6FFBBFFFFFFFFF, 0x1BFF},
-	{0x47FFFFFFE0000000, 0x7C00},
-	{0xC0E000800000001E, 0xF800},
-	{0x47FFFFFFF0000000, 0x7C00},
-	{0xC09BB9C6326354AB, 0xE6EE},
-	{0x47FFFFFFF8000000, 0x7C00},
-	{0x418FFFFFFFFD7FFF, 0x7C00},
-	{0x47FFFFFFFC000000, 0x7C00},
-	{0x80106DF8CA97ABD0, 0x8000},
-	{0x47FFFFFFFE000000, 0x7C00},
-	{0xBF7E41AFBD1D2DAA, 0x9F90},
-	{0x47FFFFFFFF000000, 0x7C00},
-	{0x88FFFFFFDFFFFFDF, 0x8000},
-	{0x47FFFFFFFF800000, 0x7C00},
-	{0x41CD1EA8673E94D4, 0x7C00},
-	{0x47FFFFFFFFC00000, 0x7C00},
-	{0xBE795082BB18FAF3, 0x8002},
-	{0x47FFFFFFFFE00000, 0x7C00},
-	{0xFFEEFFFFFFFFFFF0, 0xFC00},
-	{0x47FFFFFFFFF00000, 0x7C00},
-	{0xBE20D0465BA17B3F, 0x8000},
-	{0x47FFFFFFFFF80000, 0x7C00},
-	{0xC3E000020000001F, 0xFC00},
-	{0x47FFFFFFFFFC0000, 0x7C00},
-	{0xBE7010007FFFFFFE, 0x8001},
-	{0x47FFFFFFFFFE0000, 0x7C00},
-	{0x002BFFFFFFF7FFFF, 0x0000},
-	{0x47FFFFFFFFFF0000, 0x7C00},
-	{0xC070200000000400, 0xDC08},
-	{0x47FFFFFFFFFF8000, 0x7C00},
-	{0xC0B22CF03B44E3DD, 0xEC8B},
-	{0x47FFFFFFFFFFC000, 0x7C00},
-	{0x417FFF8000010000, 0x7C00},
-	{0x47FFFFFFFFFFE000, 0x7C00},
-	{0x38A001FFFFFFFFFB, 0x0000},
-	{0x47FFFFFFFFFFF000, 0x7C00},
-	{0x43C89C0634083499, 0x7C00},
-	{0x47FFFFFFFFFFF800, 0x7C00},
-	{0xB7FFFFFF80040000, 0x8000},
-	{0x47FFFFFFFFFFFC00, 0x7C00},
-	{0x43C001FFDFFFFFFF, 0x7C00},
-	{0x47FFFFFFFFFFFE00, 0x7C00},
-	{0xBFCFFFE008000000, 0xB400},
-	{0x47FFFFFFFFFFFF00, 0x7C00},
-	{0xC0300000F7FFFFFF, 0xCC00},
-	{0x47FFFFFFFFFFFF80, 0x7C00},
-	{0xBFCBE1EEC3CAD0E7, 0xB2F8},
-	{0x47FFFFFFFFFFFFC0, 0x7C00},
-	{0x4F046D1E52359FFA, 0x7C00},
-	{0x47FFFFFFFFFFFFE0, 0x7C00},
-	{0x43F747B038FD1145, 0x7C00},
-	{0x47FFFFFFFFFFFFF0, 0x7C00},
-	{0x41FFFFFDEFFFFFFF, 0x7C00},
-	{0x47FFFFFFFFFFFFF8, 0x7C00},
-	{0x7FDFDF7FFFFFFFFF, 0x7C00},
-	{0x47FFFFFFFFFFFFFC, 0x7C00},
-	{0xCCCFFFFFFFE00007, 0xFC00},
-	{0x47FFFFFFFFFFFFFE, 0x7C00},
-	{0x41CFFC000001FFFF, 0x7C00},
-	{0x47FFFFFFFFFFFFFF, 0x7C00},
-	{0x47E20000001FFFFF, 0x7C00},
-	{0x47FFFFFFFFFFFFFD, 0x7C00},
-	{0x3FCFFFFFFFFFFE03, 0x3400},
-	{0x47FFFFFFFFFFFFFB, 0x7C00},
-	{0x40E4000000000FFF, 0x7900},
-	{0x47FFFFFFFFFFFFF7, 0x7C00},
-	{0x3800000007FFFFE0, 0x0000},
-	{0x47FFFFFFFFFFFFEF, 0x7C00},
-	{0xC7E0020000003FFF, 0xFC00},
-	{0x47FFFFFFFFFFFFDF, 0x7C00},
-	{0x39F95B7CC64F86EF, 0x0000},
-	{0x47FFFFFFFFFFFFBF, 0x7C00},
-	{0xC06008000000000F, 0xD802},
-	{0x47FFFFFFFFFFFF7F, 0x7C00},
-	{0xC7F973DC4F9A0F7B, 0xFC00},
-	{0x47FFFFFFFFFFFEFF, 0x7C00},
-	{0xC3F9F762089ADFDC, 0xFC00},
-	{0x47FFFFFFFFFFFDFF, 0x7C00},
-	{0xC01FFFFDFFFFF000, 0xC800},
-	{0x47FFFFFFFFFFFBFF, 0x7C00},
-	{0x802D800000000000, 0x8000},
-	{0x47FFFFFFFFFFF7FF, 0x7C00},
-	{0xC01FFFFDFFFFFFEF, 0xC800},
-	{0x47FFFFFFFFFFEFFF, 0x7C00},
-	{0x80100000000000C0, 0x8000},
-	{0x47FFFFFFFFFFDFFF, 0x7C00},
-	{0x40DFFFFFFDFFFFC0, 0x7800},
-	{0x47FFFFFFFFFFBFFF, 0x7C00},
-	{0x6920005FFFFFFFFE, 0x7C00},
-	{0x47FFFFFFFFFF7FFF, 0x7C00},
-	{0x9CDA8C70CA2E8187, 0x8000},
-	{0x47FFFFFFFFFEFFFF, 0x7C00},
-	{0xC041400000000000, 0xD050},
-	{0x47FFFFFFFFFDFFFF, 0x7C00},
-	{0x403000000001F000, 0x4C00},
-	{0x47FFFFFFFFFBFFFF, 0x7C00},
-	{0xD82FFFFF80000004, 0xFC00},
-	{0x47FFFFFFFFF7FFFF, 0x7C00},
-	{0x8E700047FFFFFFFE, 0x8000},
-	{0x47FFFFFFFFEFFFFF, 0x7C00},
-	{0xC151FDFFFFFFFFFF, 0xFC00},
-	{0x47FFFFFFFFDFFFFF, 0x7C00},
-	{0xC03FFFFC07FFFFFE, 0xD000},
-	{0x47FFFFFFFFBFFFFF, 0x7C00},
-	{0xC05D4CCDE6A311A8, 0xD753},
-	{0x47FFFFFFFF7FFFFF, 0x7C00},
-	{0xB80D714F4A78FC7C, 0x8000},
-	{0x47FFFFFFFEFFFFFF, 0x7C00},
-	{0x40207FF800000000, 0x4820},
-	{0x47FFFFFFFDFFFFFF, 0x7C00},
-	{0xB81FFFFFFFFDFF7E, 0x8000},
-	{0x47FFFFFFFBFFFFFF, 0x7C00},
-	{0x90AFFB8000000000, 0x8000},
-	{0x47FFFFFFF7FFFFFF, 0x7C00},
-	{0x37FF000000100000, 0x0000},
-	{0x47FFFFFFEFFFFFFF, 0x7C00},
-	{0xC7FF800000001FFF, 0xFC00},
-	{0x47FFFFFFDFFFFFFF, 0x7C00},
-	{0xC0116FEC722BED8B, 0xC45C},
-	{0x47FFFFFFBFFFFFFF, 0x7C00},
-	{0xBFCFFFFFEEFFFFFF, 0xB400},
-	{0x47FFFFFF7FFFFFFF, 0x7C00},
-	{0x4170800000001FFF, 0x7C00},
-	{0x47FFFFFEFFFFFFFF, 0x7C00},
-	{0xBF620FFFFFFFFFFE, 0x9884},
-	{0x47FFFFFDFFFFFFFF, 0x7C00},
-	{0x3FDCD9794EC0DDEF, 0x3736},
-	{0x47FFFFFBFFFFFFFF, 0x7C00},
-	{0xC7E000FFFFFE0000, 0xFC00},
-	{0x47FFFFF7FFFFFFFF, 0x7C00},
-	{0x800A91BDACDC688E, 0x8000},
-	{0x47FFFFEFFFFFFFFF, 0x7C00},
-	{0x3050000000007FFE, 0x0000},
-	{0x47FFFFDFFFFFFFFF, 0x7C00},
-	{0x40200007FFFFFFFC, 0x4800},
-	{0x47FFFFBFFFFFFFFF, 0x7C00},
-	{0xBFBFFFBFFFFFFFBE, 0xB000},
-	{0x47FFFF7FFFFFFFFF, 0x7C00},
-	{0xC060003FE0000000, 0xD800},
-	{0x47FFFEFFFFFFFFFF, 0x7C00},
-	{0x37FFFFF7FFFFFFEE, 0x0000},
-	{0x47FFFDFFFFFFFFFF, 0x7C00},
-	{0xBFC4002000000000, 0xB100},
-	{0x47FFFBFFFFFFFFFF, 0x7C00},
-	{0xC0EF3305CBDBC945, 0xFBCD},
-	{0x47FFF7FFFFFFFFFF, 0x7C00},
-	{0x3F8C64712CAC130C, 0x2319},
-	{0x47FFEFFFFFFFFFFF, 0x7C00},
-	{0x0DE00FFF7FFFFFFF, 0x0000},
-	{0x47FFDFFFFFFFFFFF, 0x7C00},
-	{0xC1F000BFFFFFFFFF, 0xFC00},
-	{0x47FFBFFFFFFFFFFF, 0x7C00},
-	{0x47F0000000002000, 0x7C00},
-	{0x47FF7FFFFFFFFFFF, 0x7C00},
-	{0x3FCFFFFFF8000FFF, 0x3400},
-	{0x47FEFFFFFFFFFFFF, 0x7C00},
-	{0xC03FFFFFFF004000, 0xD000},
-	{0x47FDFFFFFFFFFFFF, 0x7C00},
-	{0xC3C000007FFFFFDE, 0xFC00},
-	{0x47FBFFFFFFFFFFFF, 0x7C00},
-	{0xC02002000007FFFF, 0xC801},
-	{0x47F7FFFFFFFFFFFF, 0x7C00},
-	{0x40C3693F058C3903, 0x70DA},
-	{0x47F3FFFFFFFFFFFF, 0x7C00},
-	{0x7FFFFFF6FFFFFFFF, 0x7FFF},
-	{0x47F1FFFFFFFFFFFF, 0x7C00},
-	{0xC1DFFE003FFFFFFF, 0xFC00},
-	{0x47F0FFFFFFFFFFFF, 0x7C00},
-	{0x40EFFFE0000FFFFF, 0x7C00},
-	{0x47F07FFFFFFFFFFF, 0x7C00},
-	{0x3F6FFFFFFFFFFF00, 0x1C00},
-	{0x47F03FFFFFFFFFFF, 0x7C00},
-	{0x401FFC0000000000, 0x47FF},
-	{0x47F01FFFFFFFFFFF, 0x7C00},
-	{0xBDDCE5B106FBFFC3, 0x8000},
-	{0x47F00FFFFFFFFFFF, 0x7C00},
-	{0x87C6C97C7FD40E9E, 0x8000},
-	{0x47F007FFFFFFFFFF, 0x7C00},
-	{0x264000000003FFF7, 0x0000},
-	{0x47F003FFFFFFFFFF, 0x7C00},
-	{0x4B7008000000003F, 0x7C00},
-	{0x47F001FFFFFFFFFF, 0x7C00},
-	{0x3C80200002000000, 0x0000},
-	{0x47F000FFFFFFFFFF, 0x7C00},
-	{0x3FDFFE0000000010, 0x3800},
-	{0x47F0007FFFFFFFFF, 0x7C00},
-	{0x40603FBFFFFFFFFF, 0x5810},
-	{0x47F0003FFFFFFFFF, 0x7C00},
-	{0xB800100000000007, 0x8000},
-	{0x47F0001FFFFFFFFF, 0x7C00},
-	{0xB7F0000007FFBFFF, 0x8000},
-	{0x47F0000FFFFFFFFF, 0x7C00},
-	{0xBFF000000004000E, 0xBC00},
-	{0x47F00007FFFFFFFF, 0x7C00},
-	{0xBFD001FFFFFFFFEE, 0xB400},
-	{0x47F00003FFFFFFFF, 0x7C00},
-	{0x3A7D7EFAE711715D, 0x0000},
-	{0x47F00001FFFFFFFF, 0x7C00},
-	{0xC800000000002000, 0xFC00},
-	{0x47F00000FFFFFFFF, 0x7C00},
-	{0x5BEE000000000002, 0x7C00},
-	{0x47F000007FFFFFFF, 0x7C00},
-	{0xC1F001FFFFC00000, 0xFC00},
-	{0x47F000003FFFFFFF, 0x7C00},
-	{0x3FD0000000000027, 0x3400},
-	{0x47F000001FFFFFFF, 0x7C00},
-	{0x47E000001FFFFFBE, 0x7C00},
-	{0x47F000000FFFFFFF, 0x7C00},
-	{0x43C0100000000020, 0x7C00},
-	{0x47F0000007FFFFFF, 0x7C00},
-	{0x802FFFF8FFFFFFFF, 0x8000},
-	{0x47F0000003FFFFFF, 0x7C00},
-	{0x4010000004001FFE, 0x4400},
-	{0x47F0000001FFFFFF, 0x7C00},
-	{0xBF3FFDFFFFF00000, 0x8FFF},
-	{0x47F0000000FFFFFF, 0x7C00},
-	{0x3FB46E0230187623, 0x2D1C},
-	{0x47F00000007FFFFF, 0x7C00},
-	{0x4B160BB3576DDFA9, 0x7C00},
-	{0x47F00000003FFFFF, 0x7C00},
-	{0x37F41FFFFFFFFFFE, 0x0000},
-	{0x47F00000001FFFFF, 0x7C00},
-	{0xC020000FFFFE0000, 0xC800},
-	{0x47F00000000FFFFF, 0x7C00},
-	{0x25AFFFF600000000, 0x0000},
-	{0x47F000000007FFFF, 0x7C00},
-	{0xBF600007FFFFFFBF, 0x9800},
-	{0x47F000000003FFFF, 0x7C00},
-	{0xC34FFFF07FFFFFFE, 0xFC00},
-	{0x47F000000001FFFF, 0x7C00},
-	{0x380936864FCC4E33, 0x0000},
-	{0x47F000000000FFFF, 0x7C00},
-	{0x3FB4400000000000, 0x2D10},
-	{0x47F0000000007FFF, 0x7C00},
-	{0xC17F9FFFFFFFFFFF, 0xFC00},
-	{0x47F0000000003FFF, 0x7C00},
-	{0x41F00002FFFFFFFF, 0x7C00},
-	{0x47F0000000001FFF, 0x7C00},
-	{0x406000000001003F, 0x5800},
-	{0x47F0000000000FFF, 0x7C00},
-	{0x4C10000080000800, 0x7C00},
-	{0x47F00000000007FF, 0x7C00},
-	{0xBE7FFFFFFFFEFFDF, 0x8002},
-	{0x47F00000000003FF, 0x7C00},
-	{0xC34513B9DD5D1C5E, 0xFC00},
-	{0x47F00000000001FF, 0x7C00},
-	{0x3EC000000003FFFD, 0x0020},
-	{0x47F00000000000FF, 0x7C00},
-	{0xC01FFFFFFBFFFFFD, 0xC800},
-	{0x47F000000000007F, 0x7C00},
-	{0x41F00100001FFFFF, 0x7C00},
-	{0x47F000000000003F, 0x7C00},
-	{0x212010000000000F, 0x0000},
-	{0x47F000000000001F, 0x7C00},
-	{0xD38FFF8000100000, 0xFC00},
-	{0x47F000000000000F, 0x7C00},
-	{0x3A801000000FFFFF, 0x0000},
-	{0x47F0000000000007, 0x7C00},
-	{0xC01FFF03FFFFFFFE, 0xC800},
-	{0x47F0000000000003, 0x7C00},
-	{0x403DFFFFFFF7FFFF, 0x4F80},
-	{0x4800000000000000, 0x7C00},
-	{0xC013CD70583A7C25, 0xC4F3},
-	{0x4800000000000001, 0x7C00},
-	{0x7C7FFFFFFC001FFF, 0x7C00},
-	{0x4800000000000002, 0x7C00},
-	{0xAD6000080000007F, 0x8000},
-	{0x4800000000000004, 0x7C00},
-	{0xC3DF80000000FFFF, 0xFC00},
-	{0x4800000000000008, 0x7C00},
-	{0xC00FFFFFB8000000, 0xC400},
-	{0x4800000000000010, 0x7C00},
-	{0x3F91637482359D29, 0x2459},
-	{0x4800000000000020, 0x7C00},
-	{0xC06000000000043F, 0xD800},
-	{0x4800000000000040, 0x7C00},
-	{0xBA0FFFFFFFE001FE, 0x8000},
-	{0x4800000000000080, 0x7C00},
-	{0x47FFEFFF7FFFFFFF, 0x7C00},
-	{0x4800000000000100, 0x7C00},
-	{0x55E9A4367A13ED21, 0x7C00},
-	{0x4800000000000200, 0x7C00},
-	{0x480FFFFFFFF7FFF7, 0x7C00},
-	{0x4800000000000400, 0x7C00},
-	{0xBFE00000047FFFFF, 0xB800},
-	{0x4800000000000800, 0x7C00},
-	{0xB2CFF7FFFFFDFFFE, 0x8000},
-	{0x4800000000001000, 0x7C00},
-	{0x3E4FFF0FFFFFFFFF, 0x0000},
-	{0x4800000000002000, 0x7C00},
-	{0xC1EFFFFE0FFFFFFF, 0xFC00},
-	{0x4800000000004000, 0x7C00},
-	{0x3810000000060000, 0x0000},
-	{0x4800000000008000, 0x7C00},
-	{0xC00FFEFFFFFFFFFF, 0xC400},
-	{0x4800000000010000, 0x7C00},
-	{0x3FF0001FFFFFDFFE, 0x3C00},
-	{0x4800000000020000, 0x7C00},
-	{0x3FFFFFFFFFE7FFFE, 0x4000},
-	{0x4800000000040000, 0x7C00},
-	{0xC000001004000000, 0xC000},
-	{0x4800000000080000, 0x7C00},
-	{0x411000001BFFFFFF, 0x7C00},
-	{0x4800000000100000, 0x7C00},
-	{0xBFD78A03B041D6AB, 0xB5E3},
-	{0x4800000000200000, 0x7C00},
-	{0x3FBFFFFFFDDFFFFF, 0x3000},
-	{0x4800000000400000, 0x7C00},
-	{0xBB5FFFFFFFE003FF, 0x8000},
-	{0x4800000000800000, 0x7C00},
-	{0xC3DDFFFEFFFFFFFF, 0xFC00},
-	{0x4800000001000000, 0x7C00},
-	{0x43CFFFFFFFFFF7FE, 0x7C00},
-	{0x4800000002000000, 0x7C00},
-	{0x411FD8627664AEF2, 0x7C00},
-	{0x4800000004000000, 0x7C00},
-	{0xCEC1C28283C2FFF8, 0xFC00},
-	{0x4800000008000000, 0x7C00},
-	{0x47F00000EFFFFFFE, 0x7C00},
-	{0x4800000010000000, 0x7C00},
-	{0xC049A95C64944C9D, 0xD26A},
-	{0x4800000020000000, 0x7C00},
-	{0x7FF0000000040003, 0x7E00},
-	{0x4800000040000000, 0x7C00},
-	{0xB7FFFDFFFFFFF000, 0x8000},
-	{0x4800000080000000, 0x7C00},
-	{0xC01FFFFFFFFFE01E, 0xC800},
-	{0x4800000100000000, 0x7C00},
-	{0x4AA4000100000000, 0x7C00},
-	{0x4800000200000000, 0x7C00},
-	{0x405000FFFFFDFFFE, 0x5400},
-	{0x4800000400000000, 0x7C00},
-	{0xC03FFDFFFFFFFEFF, 0xCFFF},
-	{0x4800000800000000, 0x7C00},
-	{0xC0300000020001FF, 0xCC00},
-	{0x4800001000000000, 0x7C00},
-	{0xC02FF7FFFFFFFDFF, 0xCBFE},
-	{0x4800002000000000, 0x7C00},
-	{0x7620000000005000, 0x7C00},
-	{0x4800004000000000, 0x7C00},
-	{0x37C00FFFFFFE0000, 0x0000},
-	{0x4800008000000000, 0x7C00},
-	{0x40034C195DF9D587, 0x40D3},
-	{0x4800010000000000, 0x7C00},
-	{0xBF0000040000FFFF, 0x8200},
-	{0x4800020000000000, 0x7C00},
-	{0xBFDF0001FFFFFFFE, 0xB7C0},
-	{0x4800040000000000, 0x7C00},
-	{0x436FFFFFFFBFFFFD, 0x7C00},
-	{0x4800080000000000, 0x7C00},
-	{0x47FFFF8000FFFFFF, 0x7C00},
-	{0x4800100000000000, 0x7C00},
-	{0xC95FFFFFFFFBFEFF, 0xFC00},
-	{0x4800200000000000, 0x7C00},
-	{0xA3907FFFFFFFFFFF, 0x8000},
-	{0x4800400000000000, 0x7C00},
-	{0x3FB5E3C044FBFD06, 0x2D79},
-	{0x4800800000000000, 0x7C00},
-	{0x43EF880000000000, 0x7C00},
-	{0x4801000000000000, 0x7C00},
-	{0x3A2F83FFFFFFFFFF, 0x0000},
-	{0x4802000000000000, 0x7C00},
-	{0xBCA0000000000900, 0x8000},
-	{0x4804000000000000, 0x7C00},
-	{0x381FFFFFFFFEFFFF, 0x0000},
-	{0x4808000000000000, 0x7C00},
-	{0x3970000000080080, 0x0000},
-	{0x480C000000000000, 0x7C00},
-	{0x3F9B334D13B219FF, 0x26CD},
-	{0x480E000000000000, 0x7C00},
-	{0xC3E7FFE000000000, 0xFC00},
-	{0x480F000000000000, 0x7C00},
-	{0x47EFF003FFFFFFFF, 0x7C00},
-	{0x480F800000000000, 0x7C00},
-	{0x428CCF5B6C4C9F4D, 0x7C00},
-	{0x480FC00000000000, 0x7C00},
-	{0x3FD0001FFFFFFC00, 0x3400},
-	{0x480FE00000000000, 0x7C00},
-	{0x43494090FF6B132A, 0x7C00},
-	{0x480FF00000000000, 0x7C00},
-	{0xC006A90B40FDEB7C, 0xC1AA},
-	{0x480FF80000000000, 0x7C00},
-	{0x380FFFFE01FFFFFF, 0x0000},
-	{0x480FFC0000000000, 0x7C00},
-	{0x3F66EDE5A57EAC5A, 0x19BB},
-	{0x480FFE0000000000, 0x7C00},
-	{0x3FFFFFFFF0000001, 0x4000},
-	{0x480FFF0000000000, 0x7C00},
-	{0x7FFE1D3B6317F3F8, 0x7F87},
-	{0x480FFF8000000000, 0x7C00},
-	{0x402C9D10F9790DAF, 0x4B27},
-	{0x480FFFC000000000, 0x7C00},
-	{0xBFEF69DB1D3B0D00, 0xBBDA},
-	{0x480FFFE000000000, 0x7C00},
-	{0xB1C8C2F38B4D9927, 0x8000},
-	{0x480FFFF000000000, 0x7C00},
-	{0xBDDF8000007FFFFF, 0x8000},
-	{0x480FFFF800000000, 0x7C00},
-	{0x3FEF424858494C49, 0x3BD1},
-	{0x480FFFFC00000000, 0x7C00},
-	{0x43DC6009DE48398C, 0x7C00},
-	{0x480FFFFE00000000, 0x7C00},
-	{0x7FE003FFFFFFFFFE, 0x7C00},
-	{0x480FFFFF00000000, 0x7C00},
-	{0xC3DE000080000000, 0xFC00},
-	{0x480FFFFF80000000, 0x7C00},
-	{0xC3F08003FFFFFFFE, 0xFC00},
-	{0x480FFFFFC0000000, 0x7C00},
-	{0x3FE03FFFFFFF7FFE, 0x3810},
-	{0x480FFFFFE0000000, 0x7C00},
-	{0xC7FE156BA5C9BF68, 0xFC00},
-	{0x480FFFFFF0000000, 0x7C00},
-	{0xC7F01D71A7D59205, 0xFC00},
-	{0x480FFFFFF8000000, 0x7C00},
-	{0x3FFFFFFFFFFFFC00, 0x4000},
-	{0x480FFFFFFC000000, 0x7C00},
-	{0xBC27FFFFFFFFFFE0, 0x8000},
-	{0x480FFFFFFE000000, 0x7C00},
-	{0x401FFFFFFFFFF03F, 0x4800},
-	{0x480FFFFFFF000000, 0x7C00},
-	{0x801FFFFFFFFC0400, 0x8000},
-	{0x480FFFFFFF800000, 0x7C00},
-	{0xBFEFFFC000000001, 0xBC00},
-	{0x480FFFFFFFC00000, 0x7C00},
-	{0xC0E00000FFFEFFFE, 0xF800},
-	{0x480FFFFFFFE00000, 0x7C00},
-	{0x3FD30FB2520C945D, 0x34C4},
-	{0x480FFFFFFFF00000, 0x7C00},
-	{0x41DFFFFFDFDFFFFE, 0x7C00},
-	{0x480FFFFFFFF80000, 0x7C00},
-	{0x381000000000401E, 0x0000},
-	{0x480FFFFFFFFC0000, 0x7C00},
-	{0xC1E0000100003FFF, 0xFC00},
-	{0x480FFFFFFFFE0000, 0x7C00},
-	{0x47FE90BC4A2A00C8, 0x7C00},
-	{0x480FFFFFFFFF0000, 0x7C00},
-	{0xB80FFFFFFFFFFFFE, 0x8000},
-	{0x480FFFFFFFFF8000, 0x7C00},
-	{0xC01FFFFE03FFFFFF, 0xC800},
-	{0x480FFFFFFFFFC000, 0x7C00},
-	{0x43E0020000001FFE, 0x7C00},
-	{0x480FFFFFFFFFE000, 0x7C00},
-	{0x3CAFFFEFFFFE0000, 0x0000},
-	{0x480FFFFFFFFFF000, 0x7C00},
-	{0x48000001FFFC0000, 0x7C00},
-	{0x480FFFFFFFFFF800, 0x7C00},
-	{0xC30FFFFFFDFF8000, 0xFC00},
-	{0x480FFFFFFFFFFC00, 0x7C00},
-	{0x801000000007C000, 0x8000},
-	{0x480FFFFFFFFFFE00, 0x7C00},
-	{0x3F50003FFFFFFFBF, 0x1400},
-	{0x480FFFFFFFFFFF00, 0x7C00},
-	{0xC808000000200000, 0xFC00},
-	{0x480FFFFFFFFFFF80, 0x7C00},
-	{0x3BF0000003FFFFBF, 0x0000},
-	{0x480FFFFFFFFFFFC0, 0x7C00},
-	{0x571A0E167C54BB91, 0x7C00},
-	{0x480FFFFFFFFFFFE0, 0x7C00},
-	{0x403FFFFFFE07FFFE, 0x5000},
-	{0x480FFFFFFFFFFFF0, 0x7C00},
-	{0x5FA00000007FFDFF, 0x7C00},
-	{0x480FFFFFFFFFFFF8, 0x7C00},
-	{0x3FEDB5BEEB0BFF8B, 0x3B6D},
-	{0x480FFFFFFFFFFFFC, 0x7C00},
-	{0x43EFFFFFFFFFFF3E, 0x7C00},
-	{0x480FFFFFFFFFFFFE, 0x7C00},
-	{0x47F50647CBF6C04A, 0x7C00},
-	{0x480FFFFFFFFFFFFF, 0x7C00},
-	{0x00007FFFFFFFFFEF, 0x0000},
-	{0x480FFFFFFFFFFFFD, 0x7C00},
-	{0xBFE0000000400040, 0xB800},
-	{0x480FFFFFFFFFFFFB, 0x7C00},
-	{0x48BFFFF7FFFFFFC0, 0x7C00},
-	{0x480FFFFFFFFFFFF7, 0x7C00},
-	{0xC5DFF7FBFFFFFFFF, 0xFC00},
-	{0x480FFFFFFFFFFFEF, 0x7C00},
-	{0x4060001FFFFBFFFF, 0x5800},
-	{0x480FFFFFFFFFFFDF, 0x7C00},
-	{0xAFCFF000000000FF, 0x8000},
-	{0x480FFFFFFFFFFFBF, 0x7C00},
-	{0x3D3FFFFFFD7FFFFF, 0x0000},
-	{0x480FFFFFFFFFFF7F, 0x7C00},
-	{0x402F77FFFFFFFFFF, 0x4BDE},
-	{0x480FFFFFFFFFFEFF, 0x7C00},
-	{0x4F2FFFFFDFFEFFFF, 0x7C00},
-	{0x480FFFFFFFFFFDFF, 0x7C00},
-	{0x43C000002FFFFFFF, 0x7C00},
-	{0x480FFFFFFFFFFBFF, 0x7C00},
-	{0xC05993B868EF8C6F, 0xD665},
-	{0x480FFFFFFFFFF7FF, 0x7C00},
-	{0x5BA10003FFFFFFFF, 0x7C00},
-	{0x480FFFFFFFFFEFFF, 0x7C00},
-	{0xC0300FF800000000, 0xCC04},
-	{0x480FFFFFFFFFDFFF, 0x7C00},
-	{0xA9527FFFFFFFFFFF, 0x8000},
-	{0x480FFFFFFFFFBFFF, 0x7C00},
-	{0xB807FFF800000000, 0x8000},
-	{0x480FFFFFFFFF7FFF, 0x7C00},
-	{0x13900000000001DF, 0x0000},
-	{0x480FFFFFFFFEFFFF, 0x7C00},
-	{0x434FDCD13F6C4CB0, 0x7C00},
-	{0x480FFFFFFFFDFFFF, 0x7C00},
-	{0xB9B04000000001FF, 0x8000},
-	{0x480FFFFFFFFBFFFF, 0x7C00},
-	{0x443FFFFFDFFFEFFF, 0x7C00},
-	{0x480FFFFFFFF7FFFF, 0x7C00},
-	{0xC0000100000007FF, 0xC000},
-	{0x480FFFFFFFEFFFFF, 0x7C00},
-	{0xB815AFB8A5EEB6C6, 0x8000},
-	{0x480FFFFFFFDFFFFF, 0x7C00},
-	{0xA450020FFFFFFFFF, 0x8000},
-	{0x480FFFFFFFBFFFFF, 0x7C00},
-	{0xBFEE3387A35115C1, 0xBB8D},
-	{0x480FFFFFFF7FFFFF, 0x7C00},
-	{0x418000001FBFFFFF, 0x7C00},
-	{0x480FFFFFFEFFFFFF, 0x7C00},
-	{0xBDEFFFFFFFFFFC3E, 0x8000},
-	{0x480FFFFFFDFFFFFF, 0x7C00},
-	{0xB08007FFFFFFDFFE, 0x8000},
-	{0x480FFFFFFBFFFFFF, 0x7C00},
-	{0x46200000000027FF, 0x7C00},
-	{0x480FFFFFF7FFFFFF, 0x7C00},
-	{0x403FC00800000000, 0x4FF0},
-	{0x480FFFFFEFFFFFFF, 0x7C00},
-	{0x2DABF10547F3416E, 0x0000},
-	{0x480FFFFFDFFFFFFF, 0x7C00},
-	{0x6F7AE099246F4C2B, 0x7C00},
-	{0x480FFFFFBFFFFFFF, 0x7C00},
-	{0x40100000007FFFBF, 0x4400},
-	{0x480FFFFF7FFFFFFF, 0x7C00},
-	{0xC390007FFFFDFFFF, 0xFC00},
-	{0x480FFFFEFFFFFFFF, 0x7C00},
-	{0xFFD4000000000000, 0xFC00},
-	{0x480FFFFDFFFFFFFF, 0x7C00},
-	{0x4014713B01090B14, 0x451C},
-	{0x480FFFFBFFFFFFFF, 0x7C00},
-	{0x3FF000DFFFFFFFFF, 0x3C00},
-	{0x480FFFF7FFFFFFFF, 0x7C00},
-	{0x43F00C9D008A9602, 0x7C00},
-	{0x480FFFEFFFFFFFFF, 0x7C00},
-	{0x43D0000000100008, 0x7C00},
-	{0x480FFFDFFFFFFFFF, 0x7C00},
-	{0x401FFFF7FFEFFFFF, 0x4800},
-	{0x480FFFBFFFFFFFFF, 0x7C00},
-	{0xBF910000000003FE, 0xA440},
-	{0x480FFF7FFFFFFFFF, 0x7C00},
-	{0x42EA9D6303741B71, 0x7C00},
-	{0x480FFEFFFFFFFFFF, 0x7C00},
-	{0x4010439AD658875F, 0x4411},
-	{0x480FFDFFFFFFFFFF, 0x7C00},
-	{0x8020000013FFFFFF, 0x8000},
-	{0x480FFBFFFFFFFFFF, 0x7C00},
-	{0x3CAFFFFFFFFC7FFF, 0x0000},
-	{0x480FF7FFFFFFFFFF, 0x7C00},
-	{0x43C921DFB8CD7796, 0x7C00},
-	{0x480FEFFFFFFFFFFF, 0x7C00},
-	{0xC9F80007FFFFFFFE, 0xFC00},
-	{0x480FDFFFFFFFFFFF, 0x7C00},
-	{0xB80007FFFFFFFDFE, 0x8000},
-	{0x480FBFFFFFFFFFFF, 0x7C00},
-	{0xC12FDFFF7FFFFFFE, 0xFC00},
-	{0x480F7FFFFFFFFFFF, 0x7C00},
-	{0xBFDFFFF7FFFEFFFE, 0xB800},
-	{0x480EFFFFFFFFFFFF, 0x7C00},
-	{0x401087FFFFFFFFFE, 0x4422},
-	{0x480DFFFFFFFFFFFF, 0x7C00},
-	{0x3FDFFFFE00000FFF, 0x3800},
-	{0x480BFFFFFFFFFFFF, 0x7C00},
-	{0x356C6F9755EF9261, 0x0000},
-	{0x4807FFFFFFFFFFFF, 0x7C00},
-	{0xBFC04000000003FF, 0xB010},
-	{0x4803FFFFFFFFFFFF, 0x7C00},
-	{0xBF8000000FFF7FFF, 0xA000},
-	{0x4801FFFFFFFFFFFF, 0x7C00},
-	{0x480200000000007E, 0x7C00},
-	{0x4800FFFFFFFFFFFF, 0x7C00},
-	{0x4000200007FFFFFF, 0x4008},
-	{0x48007FFFFFFFFFFF, 0x7C00},
-	{0xBFEFFFFFFFFFFF00, 0xBC00},
-	{0x48003FFFFFFFFFFF, 0x7C00},
-	{0x3FAC5A1EEA7146F1, 0x2B17},
-	{0x48001FFFFFFFFFFF, 0x7C00},
-	{0xC03FFBFFFFFFFDFF, 0xCFFF},
-	{0x48000FFFFFFFFFFF, 0x7C00},
-	{0x00100F7FFFFFFFFF, 0x0000},
-	{0x480007FFFFFFFFFF, 0x7C00},
-	{0xC0AF3FD92E3170D5, 0xEBD0},
-	{0x480003FFFFFFFFFF, 0x7C00},
-	{0xBFB000000003BFFE, 0xAC00},
-	{0x480001FFFFFFFFFF, 0x7C00},
-	{0xC1CFFFFF6FFFFFFF, 0xFC00},
-	{0x480000FFFFFFFFFF, 0x7C00},
-	{0xC07B8C8E19EFA179, 0xDEE3},
-	{0x4800007FFFFFFFFF, 0x7C00},
-	{0x407FFFFE00003FFF, 0x6000},
-	{0x4800003FFFFFFFFF, 0x7C00},
-	{0xC1FBF17A2C378960, 0xFC00},
-	{0x4800001FFFFFFFFF, 0x7C00},
-	{0x43800000003FFFFE, 0x7C00},
-	{0x4800000FFFFFFFFF, 0x7C00},
-	{0xB7F00100000FFFFF, 0x8000},
-	{0x48000007FFFFFFFF, 0x7C00},
-	{0x41F43189C0DE26FE, 0x7C00},
-	{0x48000003FFFFFFFF, 0x7C00},
-	{0x40700203FFFFFFFE, 0x5C01},
-	{0x48000001FFFFFFFF, 0x7C00},
-	{0x47703FFFFFFF7FFF, 0x7C00},
-	{0x48000000FFFFFFFF, 0x7C00},
-	{0xBFBFEFDFFFFFFFFF, 0xAFFC},
-	{0x480000007FFFFFFF, 0x7C00},
-	{0xF0ECB5BD17749B73, 0xFC00},
-	{0x480000003FFFFFFF, 0x7C00},
-	{0x401007FFFFFFFFFD, 0x4402},
-	{0x480000001FFFFFFF, 0x7C00},
-	{0xC02FDBFFFFFFFFFE, 0xCBF7},
-	{0x480000000FFFFFFF, 0x7C00},
-	{0x405FFE00007FFFFF, 0x5800},
-	{0x4800000007FFFFFF, 0x7C00},
-	{0xC1F0040000001FFF, 0xFC00},
-	{0x4800000003FFFFFF, 0x7C00},
-	{0x4029428FF5ECEBB3, 0x4A51},
-	{0x4800000001FFFFFF, 0x7C00},
-	{0xB9EFFFFBF7FFFFFF, 0x8000},
-	{0x4800000000FFFFFF, 0x7C00},
-	{0xC00FFFFFFFFFFFFE, 0xC400},
-	{0x48000000007FFFFF, 0x7C00},
-	{0x3434000000000000, 0x0000},
-	{0x48000000003FFFFF, 0x7C00},
-	{0xCA5FFEFFFFFF8000, 0xFC00},
-	{0x48000000001FFFFF, 0x7C00},
-	{0x402FFFFFFFFFFFFE, 0x4C00},
-	{0x48000000000FFFFF, 0x7C00},
-	{0x41EFFFEFFFFFFFE0, 0x7C00},
-	{0x480000000007FFFF, 0x7C00},
-	{0xC039BE1D508F525E, 0xCE70},
-	{0x480000000003FFFF, 0x7C00},
-	{0x48000000800007FE, 0x7C00},
-	{0x480000000001FFFF, 0x7C00},
-	{0x4030003FFF7FFFFF, 0x4C00},
-	{0x480000000000FFFF, 0x7C00},
-	{0xAB8FFFFFFFFFE007, 0x8000},
-	{0x4800000000007FFF, 0x7C00},
-	{0x4187D007D3F41E2F, 0x7C00},
-	{0x4800000000003FFF, 0x7C00},
-	{0xBFB07F7FFFFFFFFE, 0xAC20},
-	{0x4800000000001FFF, 0x7C00},
-	{0xC1F00010003FFFFE, 0xFC00},
-	{0x4800000000000FFF, 0x7C00},
-	{0xB7F000000200000F, 0x8000},
-	{0x48000000000007FF, 0x7C00},
-	{0xCC6FFFEFFFFC0000, 0xFC00},
-	{0x48000000000003FF, 0x7C00},
-	{0xBF8FFBFFFFFFBFFF, 0xA3FF},
-	{0x48000000000001FF, 0x7C00},
-	{0x3FEFFFFFFFBFFC00, 0x3C00},
-	{0x48000000000000FF, 0x7C00},
-	{0xC01FFE01FFFFFFFF, 0xC800},
-	{0x480000000000007F, 0x7C00},
-	{0xFFD000000007FF80, 0xFC00},
-	{0x480000000000003F, 0x7C00},
-	{0x40800000000807FE, 0x6000},
-	{0x480000000000001F, 0x7C00},
-	{0x7FFFFFFFFFFF5FFE, 0x7FFF},
-	{0x480000000000000F, 0x7C00},
-	{0x3EB20FA985C18561, 0x0012},
-	{0x4800000000000007, 0x7C00},
-	{0x47F00000000403FE, 0x7C00},
-	{0x4800000000000003, 0x7C00},
-	{0x26D72626B91178F6, 0x0000},
-	{0x7FD0000000000000, 0x7C00},
-	{0x41288DDE11662453, 0x7C00},
-	{0x7FD0000000000001, 0x7C00},
-	{0xC05000000007FFFA, 0xD400},
-	{0x7FD0000000000002, 0x7C00},
-	{0xC1DFFFBFDFFFFFFF, 0xFC00},
-	{0x7FD0000000000004, 0x7C00},
-	{0xBE9FFFFFFFF7FEFF, 0x8008},
-	{0x7FD0000000000008, 0x7C00},
-	{0xB71008007FFFFFFF, 0x8000},
-	{0x7FD0000000000010, 0x7C00},
-	{0xC7E0BFFFFFFFFFFF, 0xFC00},
-	{0x7FD0000000000020, 0x7C00},
-	{0x3817D17F7400740C, 0x0000},
-	{0x7FD0000000000040, 0x7C00},
-	{0x43CA578E0E7C15A2, 0x7C00},
-	{0x7FD0000000000080, 0x7C00},
-	{0x40E3FFFFFF7FFFFF, 0x7900},
-	{0x7FD0000000000100, 0x7C00},
-	{0x3FFC0000007FFFFE, 0x3F00},
-	{0x7FD0000000000200, 0x7C00},
-	{0xCF0FC00000020000, 0xFC00},
-	{0x7FD0000000000400, 0x7C00},
-	{0xBFBFFDFFFFFFC000, 0xAFFF},
-	{0x7FD0000000000800, 0x7C00},
-	{0x256FFFEFF7FFFFFF, 0x0000},
-	{0x7FD0000000001000, 0x7C00},
-	{0x2B9000207FFFFFFF, 0x0000},
-	{0x7FD0000000002000, 0x7C00},
-	{0x41EFFFF00003FFFE, 0x7C00},
-	{0x7FD0000000004000, 0x7C00},
-	{0x46877A47B7F05230, 0x7C00},
-	{0x7FD0000000008000, 0x7C00},
-	{0xBFF00000003FFFFE, 0xBC00},
-	{0x7FD0000000010000, 0x7C00},
-	{0xC1F47CE63BB3A4BB, 0xFC00},
-	{0x7FD0000000020000, 0x7C00},
-	{0xBE90000000100040, 0x8004},
-	{0x7FD0000000040000, 0x7C00},
-	{0x383FFFF03FFFFFFE, 0x0000},
-	{0x7FD0000000080000, 0x7C00},
-	{0xC7F63A1B8186594A, 0xFC00},
-	{0x7FD0000000100000, 0x7C00},
-	{0x3D9FFFFFFE0000FE, 0x0000},
-	{0x7FD0000000200000, 0x7C00},
-	{0x3CAFFFFDFFFFFFF6, 0x0000},
-	{0x7FD0000000400000, 0x7C00},
-	{0x3844000000003FFF, 0x0000},
-	{0x7FD0000000800000, 0x7C00},
-	{0x37FFFFFFFBFF8000, 0x0000},
-	{0x7FD0000001000000, 0x7C00},
-	{0xB7EFFFFF80000400, 0x8000},
-	{0x7FD0000002000000, 0x7C00},
-	{0xC1D00000FFFFFFFD, 0xFC00},
-	{0x7FD0000004000000, 0x7C00},
-	{0xBFC01FFFFF800000, 0xB008},
-	{0x7FD0000008000000, 0x7C00},
-	{0x404FFFFFEFF7FFFF, 0x5400},
-	{0x7FD0000010000000, 0x7C00},
-	{0x3F473AF762380248, 0x11CF},
-	{0x7FD0000020000000, 0x7C00},
-	{0xC9E0800000040000, 0xFC00},
-	{0x7FD0000040000000, 0x7C00},
-	{0xE4200001FFFFFF80, 0xFC00},
-	{0x7FD0000080000000, 0x7C00},
-	{0x41F0000000000017, 0x7C00},
-	{0x7FD0000100000000, 0x7C00},
-	{0xC2D1705DE8A36108, 0xFC00},
-	{0x7FD0000200000000, 0x7C00},
-	{0xBFE07FFFFF7FFFFF, 0xB820},
-	{0x7FD0000400000000, 0x7C00},
-	{0xC3D0B3AD0AD1E14E, 0xFC00},
-	{0x7FD0000800000000, 0x7C00},
-	{0x3FD000000FF00000, 0x3400},
-	{0x7FD0001000000000, 0x7C00},
-	{0xFFEFFFC00000FFFE, 0xFC00},
-	{0x7FD0002000000000, 0x7C00},
-	{0xC3E0101FFFFFFFFF, 0xFC00},
-	{0x7FD0004000000000, 0x7C00},
-	{0x3FD000080000FFFF, 0x3400},
-	{0x7FD0008000000000, 0x7C00},
-	{0x37F7BA12C702F3B0, 0x0000},
-	{0x7FD0010000000000, 0x7C00},
-	{0x41E8644DA31EEED8, 0x7C00},
-	{0x7FD0020000000000, 0x7C00},
-	{0xBC5F78135C0E64EB, 0x8000},
-	{0x7FD0040000000000, 0x7C00},
-	{0x3F50000008000000, 0x1400},
-	{0x7FD0080000000000, 0x7C00},
-	{0x38E003FFFDFFFFFF, 0x0000},
-	{0x7FD0100000000000, 0x7C00},
-	{0x41FFFFF7FFFF7FFF, 0x7C00},
-	{0x7FD0200000000000, 0x7C00},
-	{0x403000EFFFFFFFFF, 0x4C00},
-	{0x7FD0400000000000, 0x7C00},
-	{0xC1FFFF7FFFFFFE00, 0xFC00},
-	{0x7FD0800000000000, 0x7C00},
-	{0xBF8F00000FFFFFFF, 0xA3C0},
-	{0x7FD1000000000000, 0x7C00},
-	{0x3A2400000000000F, 0x0000},
-	{0x7FD2000000000000, 0x7C00},
-	{0xC1F00000000FFFEF, 0xFC00},
-	{0x7FD4000000000000, 0x7C00},
-	{0xBFA0020000001FFF, 0xA801},
-	{0x7FD8000000000000, 0x7C00},
-	{0x3E8DFFFFFFFFFDFF, 0x0004},
-	{0x7FDC000000000000, 0x7C00},
-	{0xB8100401FFFFFFFF, 0x8000},
-	{0x7FDE000000000000, 0x7C00},
-	{0xC80FFFFFFFFFFE0E, 0xFC00},
-	{0x7FDF000000000000, 0x7C00},
-	{0x41D0020000000400, 0x7C00},
-	{0x7FDF800000000000, 0x7C00},
-	{0x4000000000000220, 0x4000},
-	{0x7FDFC00000000000, 0x7C00},
-	{0x40E0000008000FFF, 0x7800},
-	{0x7FDFE00000000000, 0x7C00},
-	{0x380FFFFFDF7FFFFE, 0x0000},
-	{0x7FDFF00000000000, 0x7C00},
-	{0x37E0000020000080, 0x0000},
-	{0x7FDFF80000000000, 0x7C00},
-	{0xBFF6335F9E84B667, 0xBD8D},
-	{0x7FDFFC0000000000, 0x7C00},
-	{0x3E8FFFFFFFFFFEFE, 0x0004},
-	{0x7FDFFE0000000000, 0x7C00},
-	{0xD6A28227AED9EB98, 0xFC00},
-	{0x7FDFFF0000000000, 0x7C00},
-	{0x419FFBFFFFF80000, 0x7C00},
-	{0x7FDFFF8000000000, 0x7C00},
-	{0xA380000001000004, 0x8000},
-	{0x7FDFFFC000000000, 0x7C00},
-	{0xC1EA7F034B818960, 0xFC00},
-	{0x7FDFFFE000000000, 0x7C00},
-	{0xBFE000800001FFFF, 0xB800},
-	{0x7FDFFFF000000000, 0x7C00},
-	{0xC53E63FCB1E41D51, 0xFC00},
-	{0x7FDFFFF800000000, 0x7C00},
-	{0x3FFFFFFFFDF80000, 0x4000},
-	{0x7FDFFFFC00000000, 0x7C00},
-	{0xC87FFF80000003FF, 0xFC00},
-	{0x7FDFFFFE00000000, 0x7C00},
-	{0x3FCFFFFC0007FFFF, 0x3400},
-	{0x7FDFFFFF00000000, 0x7C00},
-	{0x43E00020000FFFFF, 0x7C00},
-	{0x7FDFFFFF80000000, 0x7C00},
-	{0xBFB00000003FFFFC, 0xAC00},
-	{0x7FDFFFFFC0000000, 0x7C00},
-	{0xBFF0000010000002, 0xBC00},
-	{0x7FDFFFFFE0000000, 0x7C00},
-	{0xC5777A159EE572E3, 0xFC00},
-	{0x7FDFFFFFF0000000, 0x7C00},
-	{0x707FFFFF7FFF7FFF, 0x7C00},
-	{0x7FDFFFFFF8000000, 0x7C00},
-	{0x3426C6AD56DEA98A, 0x0000},
-	{0x7FDFFFFFFC000000, 0x7C00},
-	{0x3D0001FFFFFFF800, 0x0000},
-	{0x7FDFFFFFFE000000, 0x7C00},
-	{0x480FDFFFFFFFEFFE, 0x7C00},
-	{0x7FDFFFFFFF000000, 0x7C00},
-	{0xC21FFFFE1FFFFFFF, 0xFC00},
-	{0x7FDFFFFFFF800000, 0x7C00},
-	{0x801F00000FFFFFFF, 0x8000},
-	{0x7FDFFFFFFFC00000, 0x7C00},
-	{0x7390DE845914A2BB, 0x7C00},
-	{0x7FDFFFFFFFE00000, 0x7C00},
-	{0x401FFFFBFFFFEFFE, 0x4800},
-	{0x7FDFFFFFFFF00000, 0x7C00},
-	{0xAB900007C0000000, 0x8000},
-	{0x7FDFFFFFFFF80000, 0x7C00},
-	{0xC1B0003FFFFFFFFB, 0xFC00},
-	{0x7FDFFFFFFFFC0000, 0x7C00},
-	{0xC180FFF7FFFFFFFF, 0xFC00},
-	{0x7FDFFFFFFFFE0000, 0x7C00},
-	{0xC3DFFFFEFDFFFFFF, 0xFC00},
-	{0x7FDFFFFFFFFF0000, 0x7C00},
-	{0x2F30000000000000, 0x0000},
-	{0x7FDFFFFFFFFF8000, 0x7C00},
-	{0xBB25FBDA514EB175, 0x8000},
-	{0x7FDFFFFFFFFFC000, 0x7C00},
-	{0xC3DFFFFF7FFFE000, 0xFC00},
-	{0x7FDFFFFFFFFFE000, 0x7C00},
-	{0xC07FFFFFFFFFFFF7, 0xE000},
-	{0x7FDFFFFFFFFFF000, 0x7C00},
-	{0xD000000008400000, 0xFC00},
-	{0x7FDFFFFFFFFFF800, 0x7C00},
-	{0xF88FFFFFFFFFDFFF, 0xFC00},
-	{0x7FDFFFFFFFFFFC00, 0x7C00},
-	{0xBFAFFFFEFFFBFFFF, 0xAC00},
-	{0x7FDFFFFFFFFFFE00, 0x7C00},
-	{0xBF38D69F908EDCD3, 0x8E36},
-	{0x7FDFFFFFFFFFFF00, 0x7C00},
-	{0x5A70000000010007, 0x7C00},
-	{0x7FDFFFFFFFFFFF80, 0x7C00},
-	{0x7FD695A7A55D9674, 0x7C00},
-	{0x7FDFFFFFFFFFFFC0, 0x7C00},
-	{0x43DC961CC4E68273, 0x7C00},
-	{0x7FDFFFFFFFFFFFE0, 0x7C00},
-	{0x402FFFC0007FFFFF, 0x4C00},
-	{0x7FDFFFFFFFFFFFF0, 0x7C00},
-	{0x47F0000000400080, 0x7C00},
-	{0x7FDFFFFFFFFFFFF8, 0x7C00},
-	{0xC3F2000001FFFFFF, 0xFC00},
-	{0x7FDFFFFFFFFFFFFC, 0x7C00},
-	{0x474007FFFFFFDFFF, 0x7C00},
-	{0x7FDFFFFFFFFFFFFE, 0x7C00},
-	{0x3FD0000000200040, 0x3400},
-	{0x7FDFFFFFFFFFFFFF, 0x7C00},
-	{0xC1DFFFFFFC0003FE, 0xFC00},
-	{0x7FDFFFFFFFFFFFFD, 0x7C00},
-	{0x404DAEBC159F5AA9, 0x536C},
-	{0x7FDFFFFFFFFFFFFB, 0x7C00},
-	{0x34272BCF8FB2F35D, 0x0000},
-	{0x7FDFFFFFFFFFFFF7, 0x7C00},
-	{0xB7FC00003FFFFFFF, 0x8000},
-	{0x7FDFFFFFFFFFFFEF, 0x7C00},
-	{0x3FBF00000000003F, 0x2FC0},
-	{0x7FDFFFFFFFFFFFDF, 0x7C00},
-	{0x802FFFFF8FFFFFFF, 0x8000},
-	{0x7FDFFFFFFFFFFFBF, 0x7C00},
-	{0xC1A0000000108000, 0xFC00},
-	{0x7FDFFFFFFFFFFF7F, 0x7C00},
-	{0xC0A00007FFFFE000, 0xE800},
-	{0x7FDFFFFFFFFFFEFF, 0x7C00},
-	{0xBFEFE00000000800, 0xBBF8},
-	{0x7FDFFFFFFFFFFDFF, 0x7C00},
-	{0xC02296980C684A5C, 0xC8A6},
-	{0x7FDFFFFFFFFFFBFF, 0x7C00},
-	{0x3FCFFFFB7FFFFFFE, 0x3400},
-	{0x7FDFFFFFFFFFF7FF, 0x7C00},
-	{0xFFF02000000001FF, 0xFE08},
-	{0x7FDFFFFFFFFFEFFF, 0x7C00},
-	{0x3890010000FFFFFF, 0x0000},
-	{0x7FDFFFFFFFFFDFFF, 0x7C00},
-	{0xC0500000080003FF, 0xD400},
-	{0x7FDFFFFFFFFFBFFF, 0x7C00},
-	{0x7FEFC00000000100, 0x7C00},
-	{0x7FDFFFFFFFFF7FFF, 0x7C00},
-	{0xBFEAB888E67B4FED, 0xBAAE},
-	{0x7FDFFFFFFFFEFFFF, 0x7C00},
-	{0x802FFFFFFEFFBFFE, 0x8000},
-	{0x7FDFFFFFFFFDFFFF, 0x7C00},
-	{0x41C77FFFFFFFFFFF, 0x7C00},
-	{0x7FDFFFFFFFFBFFFF, 0x7C00},
-	{0x7FD1FFFFFFFFFFFE, 0x7C00},
-	{0x7FDFFFFFFFF7FFFF, 0x7C00},
-	{0xBD0C8EDE635AD94F, 0x8000},
-	{0x7FDFFFFFFFEFFFFF, 0x7C00},
-	{0x844FFFFFFFB7FFFE, 0x8000},
-	{0x7FDFFFFFFFDFFFFF, 0x7C00},
-	{0xC1D000000001FDFF, 0xFC00},
-	{0x7FDFFFFFFFBFFFFF, 0x7C00},
-	{0x7B4FFFFFFFF5FFFF, 0x7C00},
-	{0x7FDFFFFFFF7FFFFF, 0x7C00},
-	{0xC34FFEFFFF000000, 0xFC00},
-	{0x7FDFFFFFFEFFFFFF, 0x7C00},
-	{0xBB80FE094B3008A9, 0x8000},
-	{0x7FDFFFFFFDFFFFFF, 0x7C00},
-	{0xC0FFFFFEFFFFFBFE, 0xFC00},
-	{0x7FDFFFFFFBFFFFFF, 0x7C00},
-	{0x418E0000000007FF, 0x7C00},
-	{0x7FDFFFFFF7FFFFFF, 0x7C00},
-	{0xC00000400007FFFF, 0xC000},
-	{0x7FDFFFFFEFFFFFFF, 0x7C00},
-	{0xC787FFFFFFFFFFEF, 0xFC00},
-	{0x7FDFFFFFDFFFFFFF, 0x7C00},
-	{0x402C33F13A520A20, 0x4B0D},
-	{0x7FDFFFFFBFFFFFFF, 0x7C00},
-	{0x37F04000000000FF, 0x0000},
-	{0x7FDFFFFF7FFFFFFF, 0x7C00},
-	{0x43AFFFFFFFFFE3FF, 0x7C00},
-	{0x7FDFFFFEFFFFFFFF, 0x7C00},
-	{0x40F0002FFFFFFFFF, 0x7C00},
-	{0x7FDFFFFDFFFFFFFF, 0x7C00},
-	{0x40B6124004D4CD39, 0x6D85},
-	{0x7FDFFFFBFFFFFFFF, 0x7C00},
-	{0xC1CFFFFFFFFF87FE, 0xFC00},
-	{0x7FDFFFF7FFFFFFFF, 0x7C00},
-	{0xBFD665D81E83E702, 0xB599},
-	{0x7FDFFFEFFFFFFFFF, 0x7C00},
-	{0x4347FFFFFFFFFDFF, 0x7C00},
-	{0x7FDFFFDFFFFFFFFF, 0x7C00},
-	{0x43F52E48130A6817, 0x7C00},
-	{0x7FDFFFBFFFFFFFFF, 0x7C00},
-	{0x00010E340A5105CE, 0x0000},
-	{0x7FDFFF7FFFFFFFFF, 0x7C00},
-	{0xBCDFFFF800000FFF, 0x8000},
-	{0x7FDFFEFFFFFFFFFF, 0x7C00},
-	{0x308625F3B1A93EAC, 0x0000},
-	{0x7FDFFDFFFFFFFFFF, 0x7C00},
-	{0x40056014E99E1E37, 0x4158},
-	{0x7FDFFBFFFFFFFFFF, 0x7C00},
-	{0xBFA29288A18FEECB, 0xA8A5},
-	{0x7FDFF7FFFFFFFFFF, 0x7C00},
-	{0x4020000007FFFFFF, 0x4800},
-	{0x7FDFEFFFFFFFFFFF, 0x7C00},
-	{0x7FF007FFBFFFFFFF, 0x7E01},
-	{0x7FDFDFFFFFFFFFFF, 0x7C00},
-	{0x3FFFFFDFFFFFFFFC, 0x4000},
-	{0x7FDFBFFFFFFFFFFF, 0x7C00},
-	{0xC14D986FC259AC70, 0xFC00},
-	{0x7FDF7FFFFFFFFFFF, 0x7C00},
-	{0xBFBF2A156198FCD0, 0xAFCB},
-	{0x7FDEFFFFFFFFFFFF, 0x7C00},
-	{0x437000FFFBFFFFFF, 0x7C00},
-	{0x7FDDFFFFFFFFFFFF, 0x7C00},
-	{0xBFF5BB7E1205F40D, 0xBD6F},
-	{0x7FDBFFFFFFFFFFFF, 0x7C00},
-	{0xDAAD6A1BBD9D203B, 0xFC00},
-	{0x7FD7FFFFFFFFFFFF, 0x7C00},
-	{0xFFFFFFFEFFFFFFFC, 0xFFFF},
-	{0x7FD3FFFFFFFFFFFF, 0x7C00},
-	{0x2176A19D96D8980C, 0x0000},
-	{0x7FD1FFFFFFFFFFFF, 0x7C00},
-	{0x7FD3ADC350BB4A6B, 0x7C00},
-	{0x7FD0FFFFFFFFFFFF, 0x7C00},
-	{0xC80FFFFFFFFFF07E, 0xFC00},
-	{0x7FD07FFFFFFFFFFF, 0x7C00},
-	{0x43C00200FFFFFFFF, 0x7C00},
-	{0x7FD03FFFFFFFFFFF, 0x7C00},
-	{0x002AA2F9E365236E, 0x0000},
-	{0x7FD01FFFFFFFFFFF, 0x7C00},
-	{0xC460000000000BFF, 0xFC00},
-	{0x7FD00FFFFFFFFFFF, 0x7C00},
-	{0x413AF78723B5664F, 0x7C00},
-	{0x7FD007FFFFFFFFFF, 0x7C00},
-	{0x381FFFFFFFFFFF7B, 0x0000},
-	{0x7FD003FFFFFFFFFF, 0x7C00},
-	{0x47E0083FFFFFFFFE, 0x7C00},
-	{0x7FD001FFFFFFFFFF, 0x7C00},
-	{0xB1ADFFFFFFFFFFDE, 0x8000},
-	{0x7FD000FFFFFFFFFF, 0x7C00},
-	{0xB89000007FFFFDFF, 0x8000},
-	{0x7FD0007FFFFFFFFF, 0x7C00},
-	{0x117FFFF80007FFFF, 0x0000},
-	{0x7FD0003FFFFFFFFF, 0x7C00},
-	{0xB7E000002000003F, 0x8000},
-	{0x7FD0001FFFFFFFFF, 0x7C00},
-	{0x441BFFEFFFFFFFFF, 0x7C00},
-	{0x7FD0000FFFFFFFFF, 0x7C00},
-	{0x37FFFFF7FFFFFE00, 0x0000},
-	{0x7FD00007FFFFFFFF, 0x7C00},
-	{0x47FFFFFFDFFFBFFF, 0x7C00},
-	{0x7FD00003FFFFFFFF, 0x7C00},
-	{0xBA6FFFC000001000, 0x8000},
-	{0x7FD00001FFFFFFFF, 0x7C00},
-	{0x2980100000020000, 0x0000},
-	{0x7FD00000FFFFFFFF, 0x7C00},
-	{0x404FFFFE7FFFFFFF, 0x5400},
-	{0x7FD000007FFFFFFF, 0x7C00},
-	{0x7BABEE5B74BDA664, 0x7C00},
-	{0x7FD000003FFFFFFF, 0x7C00},
-	{0xC1EFC0FFFFFFFFFF, 0xFC00},
-	{0x7FD000001FFFFFFF, 0x7C00},
-	{0x43DFFF7FFC000000, 0x7C00},
-	{0x7FD000000FFFFFFF, 0x7C00},
-	{0xC3FFFFFFFFFFFFE2, 0xFC00},
-	{0x7FD0000007FFFFFF, 0x7C00},
-	{0xF37FFFFFFFEFFBFE, 0xFC00},
-	{0x7FD0000003FFFFFF, 0x7C00},
-	{0x339FFFBFFFFFFFF6, 0x0000},
-	{0x7FD0000001FFFFFF, 0x7C00},
-	{0xC3CFFFFFFBBFFFFF, 0xFC00},
-	{0x7FD0000000FFFFFF, 0x7C00},
-	{0xFFFFFFFFFFBFFFFD, 0xFFFF},
-	{0x7FD00000007FFFFF, 0x7C00},
-	{0xB81AD184C094BE10, 0x8000},
-	{0x7FD00000003FFFFF, 0x7C00},
-	{0x41D2FE259915B9C8, 0x7C00},
-	{0x7FD00000001FFFFF, 0x7C00},
-	{0x9B8C6793BBA2EB1E, 0x8000},
-	{0x7FD00000000FFFFF, 0x7C00},
-	{0x3E9000000000083F, 0x0004},
-	{0x7FD000000007FFFF, 0x7C00},
-	{0xB7EFA0A4BA939275, 0x8000},
-	{0x7FD000000003FFFF, 0x7C00},
-	{0x47F0100400000000, 0x7C00},
-	{0x7FD000000001FFFF, 0x7C00},
-	{0xFFEFFF8000000003, 0xFC00},
-	{0x7FD000000000FFFF, 0x7C00},
-	{0xC34FC000000FFFFE, 0xFC00},
-	{0x7FD0000000007FFF, 0x7C00},
-	{0x408FDFFFFFFF8000, 0x63F8},
-	{0x7FD0000000003FFF, 0x7C00},
-	{0x381000400000007E, 0x0000},
-	{0x7FD0000000001FFF, 0x7C00},
-	{0x3F6FFFFFFFF00007, 0x1C00},
-	{0x7FD0000000000FFF, 0x7C00},
-	{0x43F3FFFFFFFFBFFE, 0x7C00},
-	{0x7FD00000000007FF, 0x7C00},
-	{0x38149AA48642A09D, 0x0000},
-	{0x7FD00000000003FF, 0x7C00},
-	{0x3FB0000800FFFFFE, 0x2C00},
-	{0x7FD00000000001FF, 0x7C00},
-	{0xC010002000000002, 0xC400},
-	{0x7FD00000000000FF, 0x7C00},
-	{0x2B7C0F97A60D46B5, 0x0000},
-	{0x7FD000000000007F, 0x7C00},
-	{0x381FFFFFFFFFC007, 0x0000},
-	{0x7FD000000000003F, 0x7C00},
-	{0xC1EAE26312BF813C, 0xFC00},
-	{0x7FD000000000001F, 0x7C00},
-	{0x3CAFFFFFFBFBFFFE, 0x0000},
-	{0x7FD000000000000F, 0x7C00},
-	{0xC80000FFFFBFFFFF, 0xFC00},
-	{0x7FD0000000000007, 0x7C00},
-	{0x3814000000000200, 0x0000},
-	{0x7FD0000000000003, 0x7C00},
-	{0x3FE00011FFFFFFFF, 0x3800},
-	{0x7FE0000000000000, 0x7C00},
-	{0x801FFFBFDFFFFFFF, 0x8000},
-	{0x7FE0000000000001, 0x7C00},
-	{0xBF6FFFFFFF000040, 0x9C00},
-	{0x7FE0000000000002, 0x7C00},
-	{0xC03FFFFFF8007FFE, 0xD000},
-	{0x7FE0000000000004, 0x7C00},
-	{0x0008716F4EFDE584, 0x0000},
-	{0x7FE0000000000008, 0x7C00},
-	{0xBE106FFFFFFFFFFF, 0x8000},
-	{0x7FE0000000000010, 0x7C00},
-	{0xC7F001FFFFFFFFF0, 0xFC00},
-	{0x7FE0000000000020, 0x7C00},
-	{0x000FFFFDFFFFFE00, 0x0000},
-	{0x7FE0000000000040, 0x7C00},
-	{0xB250000FFEFFFFFF, 0x8000},
-	{0x7FE0000000000080, 0x7C00},
-	{0x42300FFFFFFFF800, 0x7C00},
-	{0x7FE0000000000100, 0x7C00},
-	{0x401000000001F000, 0x4400},
-	{0x7FE0000000000200, 0x7C00},
-	{0xB7E8000000003FFE, 0x8000},
-	{0x7FE0000000000400, 0x7C00},
-	{0x3FC00400001FFFFF, 0x3001},
-	{0x7FE0000000000800, 0x7C00},
-	{0x37FFDFFFFFFFFFFF, 0x0000},
-	{0x7FE0000000001000, 0x7C00},
-	{0x3FF3BFFFFFFFFFFE, 0x3CF0},
-	{0x7FE0000000002000, 0x7C00},
-	{0xC0280007FFFFFFFF, 0xCA00},
-	{0x7FE0000000004000, 0x7C00},
-	{0xC7EB55EB477C9832, 0xFC00},
-	{0x7FE0000000008000, 0x7C00},
-	{0xD71EFFFFFFE00000, 0xFC00},
-	{0x7FE0000000010000, 0x7C00},
-	{0x3FE09254C626A8EC, 0x3825},
-	{0x7FE0000000020000, 0x7C00},
-	{0x45AFFFEFFFFFBFFE, 0x7C00},
-	{0x7FE0000000040000, 0x7C00},
-	{0x41FFF80000003FFE, 0x7C00},
-	{0x7FE0000000080000, 0x7C00},
-	{0xC3800000003FFDFF, 0xFC00},
-	{0x7FE0000000100000, 0x7C00},
-	{0x3FFEFFFFFFBFFFFE, 0x3FC0},
-	{0x7FE0000000200000, 0x7C00},
-	{0x37FFFFFFF9FFFFFF, 0x0000},
-	{0x7FE0000000400000, 0x7C00},
-	{0xC1F0000000000807, 0xFC00},
-	{0x7FE0000000800000, 0x7C00},
-	{0x3FF00803FFFFFFFE, 0x3C02},
-	{0x7FE0000001000000, 0x7C00},
-	{0xBF9000000000003F, 0xA400},
-	{0x7FE0000002000000, 0x7C00},
-	{0x3F01291B2CE6F989, 0x0225},
-	{0x7FE0000004000000, 0x7C00},
-	{0x38004000000000FE, 0x0000},
-	{0x7FE0000008000000, 0x7C00},
-	{0x44584BCB9E18CB83, 0x7C00},
-	{0x7FE0000010000000, 0x7C00},
-	{0x40A3C6C4219BACB5, 0x68F2},
-	{0x7FE0000020000000, 0x7C00},
-	{0x402FFEFFE0000000, 0x4C00},
-	{0x7FE0000040000000, 0x7C00},
-	{0x43FFFFFFDFDFFFFF, 0x7C00},
-	{0x7FE0000080000000, 0x7C00},
-	{0x3FEFFFFF800001FE, 0x3C00},
-	{0x7FE0000100000000, 0x7C00},
-	{0x5420FFFFDFFFFFFF, 0x7C00},
-	{0x7FE0000200000000, 0x7C00},
-	{0x4037CC65758064DF, 0x4DF3},
-	{0x7FE0000400000000, 0x7C00},
-	{0x7FDFFFFFFFFFFEFA, 0x7C00},
-	{0x7FE0000800000000, 0x7C00},
-	{0xC7E6D229D28F78FC, 0xFC00},
-	{0x7FE0001000000000, 0x7C00},
-	{0xC020000020001FFF, 0xC800},
-	{0x7FE0002000000000, 0x7C00},
-	{0x434FFFFFBFFFFBFE, 0x7C00},
-	{0x7FE0004000000000, 0x7C00},
-	{0xBB68E0DF3E8CCA31, 0x8000},
-	{0x7FE0008000000000, 0x7C00},
-	{0xC3EFFFFFF7BFFFFF, 0xFC00},
-	{0x7FE0010000000000, 0x7C00},
-	{0xBFAFFC0000008000, 0xABFF},
-	{0x7FE0020000000000, 0x7C00},
-	{0x41D08BC5DBCA119B, 0x7C00},
-	{0x7FE0040000000000, 0x7C00},
-	{0x44A000000007FFFE, 0x7C00},
-	{0x7FE0080000000000, 0x7C00},
-	{0x3FDFDFFFFFFE0000, 0x37F8},
-	{0x7FE0100000000000, 0x7C00},
-	{0x80259953132B3181, 0x8000},
-	{0x7FE0200000000000, 0x7C00},
-	{0xBFCFFFF020000000, 0xB400},
-	{0x7FE0400000000000, 0x7C00},
-	{0xC05000400001FFFF, 0xD400},
-	{0x7FE0800000000000, 0x7C00},
-	{0xBFF0FFFDFFFFFFFF, 0xBC40},
-	{0x7FE1000000000000, 0x7C00},
-	{0xC710000000003DFF, 0xFC00},
-	{0x7FE2000000000000, 0x7C00},
-	{0xC73002000000007F, 0xFC00},
-	{0x7FE4000000000000, 0x7C00},
-	{0xBFEFFFF07FFFFFFE, 0xBC00},
-	{0x7FE8000000000000, 0x7C00},
-	{0x56D046E96B99EB8B, 0x7C00},
-	{0x7FEC000000000000, 0x7C00},
-	{0x3FBFFDFFFFFDFFFF, 0x2FFF},
-	{0x7FEE000000000000, 0x7C00},
-	{0x4345F3126681A6D3, 0x7C00},
-	{0x7FEF000000000000, 0x7C00},
-	{0xBFC00000000FFFFE, 0xB000},
-	{0x7FEF800000000000, 0x7C00},
-	{0xBC51B5E78940369E, 0x8000},
-	{0x7FEFC00000000000, 0x7C00},
-	{0x3800000000207FFE, 0x0000},
-	{0x7FEFE00000000000, 0x7C00},
-	{0xBCEFFFFC01000000, 0x8000},
-	{0x7FEFF00000000000, 0x7C00},
-	{0xBF86B719D7DB9B16, 0xA1AE},
-	{0x7FEFF80000000000, 0x7C00},
-	{0x3D4FFF8000001FFE, 0x0000},
-	{0x7FEFFC0000000000, 0x7C00},
-	{0xC34008001FFFFFFF, 0xFC00},
-	{0x7FEFFE0000000000, 0x7C00},
-	{0x3FAFFFFFFD7FFFFF, 0x2C00},
-	{0x7FEFFF0000000000, 0x7C00},
-	{0xC09AE1D66A953656, 0xE6B8},
-	{0x7FEFFF8000000000, 0x7C00},
-	{0x47F4000000000007, 0x7C00},
-	{0x7FEFFFC000000000, 0x7C00},
-	{0x3D1FF8000000FFFF, 0x0000},
-	{0x7FEFFFE000000000, 0x7C00},
-	{0x43400003C0000000, 0x7C00},
-	{0x7FEFFFF000000000, 0x7C00},
-	{0xC809F61AD3A262A7, 0xFC00},
-	{0x7FEFFFF800000000, 0x7C00},
-	{0xC5A4AB1BDC6EE58D, 0xFC00},
-	{0x7FEFFFFC00000000, 0x7C00},
-	{0x37F000000040003E, 0x0000},
-	{0x7FEFFFFE00000000, 0x7C00},
-	{0xC1FFFFFBFFFE0000, 0xFC00},
-	{0x7FEFFFFF00000000, 0x7C00},
-	{0xAD4DD31E5972B618, 0x8000},
-	{0x7FEFFFFF80000000, 0x7C00},
-	{0xBFDFFFFFFFFFDFDE, 0xB800},
-	{0x7FEFFFFFC0000000, 0x7C00},
-	{0x3F4FFFFFFFEFBFFF, 0x1400},
-	{0x7FEFFFFFE0000000, 0x7C00},
-	{0x4047FFFFFFFFFF7F, 0x5200},
-	{0x7FEFFFFFF0000000, 0x7C00},
-	{0xCFDFFFFFFFFFFE7F, 0xFC00},
-	{0x7FEFFFFFF8000000, 0x7C00},
-	{0x3A9FFFFFFC00001F, 0x0000},
-	{0x7FEFFFFFFC000000, 0x7C00},
-	{0xC1304FFFFFFFFFFF, 0xFC00},
-	{0x7FEFFFFFFE000000, 0x7C00},
-	{0x7FEFFFFFFFF81000, 0x7C00},
-	{0x7FEFFFFFFF000000, 0x7C00},
-	{0xC1C00000001BFFFE, 0xFC00},
-	{0x7FEFFFFFFF800000, 0x7C00},
-	{0x3F207EFFFFFFFFFE, 0x0820},
-	{0x7FEFFFFFFFC00000, 0x7C00},
-	{0x3FC0007FFFFEFFFF, 0x3000},
-	{0x7FEFFFFFFFE00000, 0x7C00},
-	{0x37750252ECB17B9A, 0x0000},
-	{0x7FEFFFFFFFF00000, 0x7C00},
-	{0xC0F4C67FA1B67081, 0xFC00},
-	{0x7FEFFFFFFFF80000, 0x7C00},
-	{0xB8000200FFFFFFFF, 0x8000},
-	{0x7FEFFFFFFFFC0000, 0x7C00},
-	{0x3FD000000007FFEF, 0x3400},
-	{0x7FEFFFFFFFFE0000, 0x7C00},
-	{0x402FFFF8003FFFFE, 0x4C00},
-	{0x7FEFFFFFFFFF0000, 0x7C00},
-	{0xC11FFC00000007FF, 0xFC00},
-	{0x7FEFFFFFFFFF8000, 0x7C00},
-	{0x402FFFFBFFFFFFF7, 0x4C00},
-	{0x7FEFFFFFFFFFC000, 0x7C00},
-	{0x41D03FFFFFFFFFBE, 0x7C00},
-	{0x7FEFFFFFFFFFE000, 0x7C00},
-	{0x401001000003FFFF, 0x4400},
-	{0x7FEFFFFFFFFFF000, 0x7C00},
-	{0xBFEFFFFFEFFFFFFC, 0xBC00},
-	{0x7FEFFFFFFFFFF800, 0x7C00},
-	{0xB9251C21A5481806, 0x8000},
-	{0x7FEFFFFFFFFFFC00, 0x7C00},
-	{0x4A1FFFFFFFFBFF7F, 0x7C00},
-	{0x7FEFFFFFFFFFFE00, 0x7C00},
-	{0xD8CFF7FFFFFFFEFE, 0xFC00},
-	{0x7FEFFFFFFFFFFF00, 0x7C00},
-	{0x3FEFFFF800000003, 0x3C00},
-	{0x7FEFFFFFFFFFFF80, 0x7C00},
-	{0x3F6FFEFFFFFFFBFF, 0x1C00},
-	{0x7FEFFFFFFFFFFFC0, 0x7C00},
-	{0x7FF008001FFFFFFF, 0x7E02},
-	{0x7FEFFFFFFFFFFFE0, 0x7C00},
-	{0x3F4214A341DD8EC1, 0x1085},
-	{0x7FEFFFFFFFFFFFF0, 0x7C00},
-	{0x41D000000000FFFB, 0x7C00},
-	{0x7FEFFFFFFFFFFFF8, 0x7C00},
-	{0x47EFFC3FFFFFFFFE, 0x7C00},
-	{0x7FEFFFFFFFFFFFFC, 0x7C00},
-	{0xB8003FFFFFFFFBFE, 0x8000},
-	{0x7FEFFFFFFFFFFFFE, 0x7C00},
-	{0x403ACC57BE8EC4AA, 0x4EB3},
-	{0x7FEFFFFFFFFFFFFF, 0x7C00},
-	{0x573BFFEFFFFFFFFE, 0x7C00},
-	{0x7FEFFFFFFFFFFFFD, 0x7C00},
-	{0x43CFF3FFFFFFFFFF, 0x7C00},
-	{0x7FEFFFFFFFFFFFFB, 0x7C00},
-	{0xB80FF003FFFFFFFF, 0x8000},
-	{0x7FEFFFFFFFFFFFF7, 0x7C00},
-	{0xB460000000008200, 0x8000},
-	{0x7FEFFFFFFFFFFFEF, 0x7C00},
-	{0x3B3FF7FFFFF00000, 0x0000},
-	{0x7FEFFFFFFFFFFFDF, 0x7C00},
-	{0xBFC1DFFFFFFFFFFF, 0xB078},
-	{0x7FEFFFFFFFFFFFBF, 0x7C00},
-	{0xC051000080000000, 0xD440},
-	{0x7FEFFFFFFFFFFF7F, 0x7C00},
-	{0x77700001FFFBFFFF, 0x7C00},
-	{0x7FEFFFFFFFFFFEFF, 0x7C00},
-	{0x98280000001FFFFF, 0x8000},
-	{0x7FEFFFFFFFFFFDFF, 0x7C00},
-	{0x41DFFFFEFFFFFFFF, 0x7C00},
-	{0x7FEFFFFFFFFFFBFF, 0x7C00},
-	{0x7FDE020000000000, 0x7C00},
-	{0x7FEFFFFFFFFFF7FF, 0x7C00},
-	{0xC00FFDFFFFFFFFF0, 0xC3FF},
-	{0x7FEFFFFFFFFFEFFF, 0x7C00},
-	{0xB81395A539AF36EC, 0x8000},
-	{0x7FEFFFFFFFFFDFFF, 0x7C00},
-	{0xBEC0000000003F80, 0x8020},
-	{0x7FEFFFFFFFFFBFFF, 0x7C00},
-	{0xFE1FFFFFFFFDFFBE, 0xFC00},
-	{0x7FEFFFFFFFFF7FFF, 0x7C00},
-	{0x4340080000000020, 0x7C00},
-	{0x7FEFFFFFFFFEFFFF, 0x7C00},
-	{0xBFEFFFFFDFFFFFEE, 0xBC00},
-	{0x7FEFFFFFFFFDFFFF, 0x7C00},
-	{0x38004000000FFFFF, 0x0000},
-	{0x7FEFFFFFFFFBFFFF, 0x7C00},
-	{0x41F000080001FFFF, 0x7C00},
-	{0x7FEFFFFFFFF7FFFF, 0x7C00},
-	{0x7FDF7886F522999D, 0x7C00},
-	{0x7FEFFFFFFFEFFFFF, 0x7C00},
-	{0xBF50000000FFFDFF, 0x9400},
-	{0x7FEFFFFFFFDFFFFF, 0x7C00},
-	{0x402E70F4027886E7, 0x4B9C},
-	{0x7FEFFFFFFFBFFFFF, 0x7C00},
-	{0x3CA0000000000F00, 0x0000},
-	{0x7FEFFFFFFF7FFFFF, 0x7C00},
-	{0x43FF800400000000, 0x7C00},
-	{0x7FEFFFFFFEFFFFFF, 0x7C00},
-	{0xC3F000000200FFFF, 0xFC00},
-	{0x7FEFFFFFFDFFFFFF, 0x7C00},
-	{0xB800000000004800, 0x8000},
-	{0x7FEFFFFFFBFFFFFF, 0x7C00},
-	{0x4010010FFFFFFFFE, 0x4400},
-	{0x7FEFFFFFF7FFFFFF, 0x7C00},
-	{0x3F1FEFFFFFFFFFBE, 0x07FC},
-	{0x7FEFFFFFEFFFFFFF, 0x7C00},
-	{0xC1DFFFFFF7FF0000, 0xFC00},
-	{0x7FEFFFFFDFFFFFFF, 0x7C00},
-	{0x3FA00000017FFFFF, 0x2800},
-	{0x7FEFFFFFBFFFFFFF, 0x7C00},
-	{0x800000000000200F, 0x8000},
-	{0x7FEFFFFF7FFFFFFF, 0x7C00},
-	{0xC1FFFFFFFFBFFE00, 0xFC00},
-	{0x7FEFFFFEFFFFFFFF, 0x7C00},
-	{0x43CBC9220F3EB0CF, 0x7C00},
-	{0x7FEFFFFDFFFFFFFF, 0x7C00},
-	{0xC03000000007C000, 0xCC00},
-	{0x7FEFFFFBFFFFFFFF, 0x7C00},
-	{0xC0200FFFFFFFFFF8, 0xC804},
-	{0x7FEFFFF7FFFFFFFF, 0x7C00},
-	{0x801BFFFFFBFFFFFF, 0x8000},
-	{0x7FEFFFEFFFFFFFFF, 0x7C00},
-	{0xC7FFFFFFDFFFFFFF, 0xFC00},
-	{0x7FEFFFDFFFFFFFFF, 0x7C00},
-	{0xC032C762229A7FD6, 0xCCB2},
-	{0x7FEFFFBFFFFFFFFF, 0x7C00},
-	{0x447FFFFFFDFFFFBE, 0x7C00},
-	{0x7FEFFF7FFFFFFFFF, 0x7C00},
-	{0xC0020000007FFFFF, 0xC080},
-	{0x7FEFFEFFFFFFFFFF, 0x7C00},
-	{0x00207FFFFFFFE000, 0x0000},
-	{0x7FEFFDFFFFFFFFFF, 0x7C00},
-	{0x3FD0008003FFFFFF, 0x3400},
-	{0x7FEFFBFFFFFFFFFF, 0x7C00},
-	{0x3FF000000000F7FF, 0x3C00},
-	{0x7FEFF7FFFFFFFFFF, 0x7C00},
-	{0x8020001FFFFFFFF0, 0x8000},
-	{0x7FEFEFFFFFFFFFFF, 0x7C00},
-	{0x47B1FFFFF8000000, 0x7C00},
-	{0x7FEFDFFFFFFFFFFF, 0x7C00},
-	{0x3DB00000200001FE, 0x0000},
-	{0x7FEFBFFFFFFFFFFF, 0x7C00},
-	{0x3F901FFFFFFFFBFF, 0x2408},
-	{0x7FEF7FFFFFFFFFFF, 0x7C00},
-	{0x3FE000007FEFFFFF, 0x3800},
-	{0x7FEEFFFFFFFFFFFF, 0x7C00},
-	{0xBEEFF7FFFFF7FFFE, 0x8100},
-	{0x7FEDFFFFFFFFFFFF, 0x7C00},
-	{0xB0600011FFFFFFFF, 0x8000},
-	{0x7FEBFFFFFFFFFFFF, 0x7C00},
-	{0x7FD6E95819B21024, 0x7C00},
-	{0x7FE7FFFFFFFFFFFF, 0x7C00},
-	{0xC1D0000001FFFF80, 0xFC00},
-	{0x7FE3FFFFFFFFFFFF, 0x7C00},
-	{0x3FB3E7F5E1A8A998, 0x2CFA},
-	{0x7FE1FFFFFFFFFFFF, 0x7C00},
-	{0xFFD010000000003E, 0xFC00},
-	{0x7FE0FFFFFFFFFFFF, 0x7C00},
-	{0xBFF6F3B2478D5E7B, 0xBDBD},
-	{0x7FE07FFFFFFFFFFF, 0x7C00},
-	{0x381E00000003FFFE, 0x0000},
-	{0x7FE03FFFFFFFFFFF, 0x7C00},
-	{0x8020000000006000, 0x8000},
-	{0x7FE01FFFFFFFFFFF, 0x7C00},
-	{0xBFD0000000020400, 0xB400},
-	{0x7FE00FFFFFFFFFFF, 0x7C00},
-	{0x380833F89C593562, 0x0000},
-	{0x7FE007FFFFFFFFFF, 0x7C00},
-	{0x43D95222522BF71B, 0x7C00},
-	{0x7FE003FFFFFFFFFF, 0x7C00},
-	{0x3CAFFFBFFFFFFFC0, 0x0000},
-	{0x7FE001FFFFFFFFFF, 0x7C00},
-	{0xADD497752416C847, 0x8000},
-	{0x7FE000FFFFFFFFFF, 0x7C00},
-	{0x37F11930CF27DE6C, 0x0000},
-	{0x7FE0007FFFFFFFFF, 0x7C00},
-	{0x37FB3D19F7D2CAC2, 0x0000},
-	{0x7FE0003FFFFFFFFF, 0x7C00},
-	{0x3FCFFFFFBFFF7FFE, 0x3400},
-	{0x7FE0001FFFFFFFFF, 0x7C00},
-	{0x264007FFFFFFFFFF, 0x0000},
-	{0x7FE0000FFFFFFFFF, 0x7C00},
-	{0xC1DFFFFFFFFBFFFD, 0xFC00},
-	{0x7FE00007FFFFFFFF, 0x7C00},
-	{0xC7E41050108BB06E, 0xFC00},
-	{0x7FE00003FFFFFFFF, 0x7C00},
-	{0xC34023FFFFFFFFFF, 0xFC00},
-	{0x7FE00001FFFFFFFF, 0x7C00},
-	{0x0019AACF88A51AF4, 0x0000},
-	{0x7FE00000FFFFFFFF, 0x7C00},
-	{0xFFD400000000000F, 0xFC00},
-	{0x7FE000007FFFFFFF, 0x7C00},
-	{0x30A0000800007FFF, 0x0000},
-	{0x7FE000003FFFFFFF, 0x7C00},
-	{0x481FFFFFFEFFFFFB, 0x7C00},
-	{0x7FE000001FFFFFFF, 0x7C00},
-	{0x3DBFFFFFFFFF803F, 0x0000},
-	{0x7FE000000FFFFFFF, 0x7C00},
-	{0xC1C6D70CF8F51815, 0xFC00},
-	{0x7FE0000007FFFFFF, 0x7C00},
-	{0xC038BA627BAF0A91, 0xCE2F},
-	{0x7FE0000003FFFFFF, 0x7C00},
-	{0x4020000003FFFDFF, 0x4800},
-	{0x7FE0000001FFFFFF, 0x7C00},
-	{0x40FDEEA295E2D725, 0x7C00},
-	{0x7FE0000000FFFFFF, 0x7C00},
-	{0x41D00000401FFFFF, 0x7C00},
-	{0x7FE00000007FFFFF, 0x7C00},
-	{0xB0CFFFDFFFFFFDFF, 0x8000},
-	{0x7FE00000003FFFFF, 0x7C00},
-	{0xFFFFFFFFFFBFFFFB, 0xFFFF},
-	{0x7FE00000001FFFFF, 0x7C00},
-	{0xA5AFFFFDFFFBFFFF, 0x8000},
-	{0x7FE00000000FFFFF, 0x7C00},
-	{0xFFE000000000017F, 0xFC00},
-	{0x7FE000000007FFFF, 0x7C00},
-	{0x287FFFFC001FFFFF, 0x0000},
-	{0x7FE000000003FFFF, 0x7C00},
-	{0x42601FFFC0000000, 0x7C00},
-	{0x7FE000000001FFFF, 0x7C00},
-	{0x3A99D31C8D0B5C1B, 0x0000},
-	{0x7FE000000000FFFF, 0x7C00},
-	{0x3FC0000000003F7E, 0x3000},
-	{0x7FE0000000007FFF, 0x7C00},
-	{0x43FFFFFFFFDF7FFE, 0x7C00},
-	{0x7FE0000000003FFF, 0x7C00},
-	{0x0020000400000000, 0x0000},
-	{0x7FE0000000001FFF, 0x7C00},
-	{0xC01FFFFFFC000001, 0xC800},
-	{0x7FE0000000000FFF, 0x7C00},
-	{0x3FCC000007FFFFFE, 0x3300},
-	{0x7FE00000000007FF, 0x7C00},
-	{0xBFE0000000100000, 0xB800},
-	{0x7FE00000000003FF, 0x7C00},
-	{0x41FFBFFFFFFEFFFF, 0x7C00},
-	{0x7FE00000000001FF, 0x7C00},
-	{0x555001FDFFFFFFFF, 0x7C00},
-	{0x7FE00000000000FF, 0x7C00},
-	{0x3FC477797DDC2171, 0x311E},
-	{0x7FE000000000007F, 0x7C00},
-	{0xC800100000000800, 0xFC00},
-	{0x7FE000000000003F, 0x7C00},
-	{0x800000002007FFFF, 0x8000},
-	{0x7FE000000000001F, 0x7C00},
-	{0x41D000047FFFFFFE, 0x7C00},
-	{0x7FE000000000000F, 0x7C00},
-	{0x4A6FBFFFFFFFFF80, 0x7C00},
-	{0x7FE0000000000007, 0x7C00},
-	{0x3F80372B5649BAD2, 0x200E},
-	{0x7FE0000000000003, 0x7C00},
-	{0xBCA02A217B7C3215, 0x8000},
-	{0x7FF0000000000000, 0x7C00},
-	{0x3FE0100010000000, 0x3804},
-	{0x7FF0000000000001, 0x7E00},
-	{0x41FFFFFFEFFFFF7F, 0x7C00},
-	{0x7FF0000000000002, 0x7E00},
-	{0x3B8000010000007E, 0x0000},
-	{0x7FF0000000000004, 0x7E00},
-	{0xC0007FDFFFFFFFFE, 0xC020},
-	{0x7FF0000000000008, 0x7E00},
-	{0x3DAFDFFFFFFFFFFC, 0x0000},
-	{0x7FF0000000000010, 0x7E00},
-	{0xCC6805F311F3A40D, 0xFC00},
-	{0x7FF0000000000020, 0x7E00},
-	{0x2330010000080000, 0x0000},
-	{0x7FF0000000000040, 0x7E00},
-	{0x37E001FFFFFFFE00, 0x0000},
-	{0x7FF0000000000080, 0x7E00},
-	{0x43C00007FBFFFFFF, 0x7C00},
-	{0x7FF0000000000100, 0x7E00},
-	{0xC34FFFFFFFFFE000, 0xFC00},
-	{0x7FF0000000000200, 0x7E00},
-	{0x33AEFFFFFFFFDFFE, 0x0000},
-	{0x7FF0000000000400, 0x7E00},
-	{0x3F100000FFFF7FFF, 0x0400},
-	{0x7FF0000000000800, 0x7E00},
-	{0xC08FFFFFFFFFFFDE, 0xE400},
-	{0x7FF0000000001000, 0x7E00},
-	{0xC0049FCD1B61A433, 0xC128},
-	{0x7FF0000000002000, 0x7E00},
-	{0xBFB003EFFFFFFFFF, 0xAC01},
-	{0x7FF0000000004000, 0x7E00},
-	{0x40F5B729889A330D, 0x7C00},
-	{0x7FF0000000008000, 0x7E00},
-	{0x3FE702B4A288F003, 0x39C1},
-	{0x7FF0000000010000, 0x7E00},
-	{0xB70BFEFFFFFFFFFE, 0x8000},
-	{0x7FF0000000020000, 0x7E00},
-	{0xF4A5F6980F1ADF20, 0xFC00},
-	{0x7FF0000000040000, 0x7E00},
-	{0x3D072EBDA98E1DF1, 0x0000},
-	{0x7FF0000000080000, 0x7E00},
-	{0x3F30001FFFE00000, 0x0C00},
-	{0x7FF0000000100000, 0x7E00},
-	{0x434BD34DCD902326, 0x7C00},
-	{0x7FF0000000200000, 0x7E00},
-	{0x623FFFFC04000000, 0x7C00},
-	{0x7FF0000000400000, 0x7E00},
-	{0x802E38DE620B1633, 0x8000},
-	{0x7FF0000000800000, 0x7E00},
-	{0xE302A9FD695024F6, 0xFC00},
-	{0x7FF0000001000000, 0x7E00},
-	{0x185DFFFFFFFF7FFF, 0x0000},
-	{0x7FF0000002000000, 0x7E00},
-	{0x3FFFB13330EB405E, 0x3FEC},
-	{0x7FF0000004000000, 0x7E00},
-	{0xBD50000000000807, 0x8000},
-	{0x7FF0000008000000, 0x7E00},
-	{0xC010001FFFFFFFEF, 0xC400},
-	{0x7FF0000010000000, 0x7E00},
-	{0xC3D8000000000004, 0xFC00},
-	{0x7FF0000020000000, 0x7E00},
-	{0xBFBFFFFE07FFFFFF, 0xB000},
-	{0x7FF0000040000000, 0x7E00},
-	{0x43FEF39F33DAAF4C, 0x7C00},
-	{0x7FF0000080000000, 0x7E00},
-	{0x529FFF800000003E, 0x7C00},
-	{0x7FF0000100000000, 0x7E00},
-	{0xC3D00000001FFFF7, 0xFC00},
-	{0x7FF0000200000000, 0x7E00},
-	{0xBEAFFFFFFF808000, 0x8010},
-	{0x7FF0000400000000, 0x7E00},
-	{0x3FEFFFFC00080000, 0x3C00},
-	{0x7FF0000800000000, 0x7E00},
-	{0x381FFEFF00000000, 0x0000},
-	{0x7FF0001000000000, 0x7E00},
-	{0x41DFC00000FFFFFF, 0x7C00},
-	{0x7FF0002000000000, 0x7E00},
-	{0x49E0000200007FFE, 0x7C00},
-	{0x7FF0004000000000, 0x7E00},
-	{0xBFE26770748E7C0F, 0xB89A},
-	{0x7FF0008000000000, 0x7E00},
-	{0xBF10400200000000, 0x8410},
-	{0x7FF0010000000000, 0x7E00},
-	{0x466BFFFF00000000, 0x7C00},
-	{0x7FF0020000000000, 0x7E00},
-	{0x7F375EE9C80FC16C, 0x7C00},
-	{0x7FF0040000000000, 0x7E01},
-	{0xC1F0000FFFFFFF7E, 0xFC00},
-	{0x7FF0080000000000, 0x7E02},
-	{0x4330080000200000, 0x7C00},
-	{0x7FF0100000000000, 0x7E04},
-	{0x3F4FFFFBFFFFFEFF, 0x1400},
-	{0x7FF0200000000000, 0x7E08},
-	{0xE4400000000001FA, 0xFC00},
-	{0x7FF0400000000000, 0x7E10},
-	{0xBFFC0000FFFFFFFF, 0xBF00},
-	{0x7FF0800000000000, 0x7E20},
-	{0x400FFFBFFFFFFFC0, 0x4400},
-	{0x7FF1000000000000, 0x7E40},
-	{0xC03FFFFF000001FF, 0xD000},
-	{0x7FF2000000000000, 0x7E80},
-	{0x480000008000FFFF, 0x7C00},
-	{0x7FF4000000000000, 0x7F00},
-	{0xBD0191D5AEFEC37D, 0x8000},
-	{0x7FF8000000000000, 0x7E00},
-	{0x00200008000000FE, 0x0000},
-	{0x7FFC000000000000, 0x7F00},
-	{0xC2BEDA2DF9B5F7FD, 0xFC00},
-	{0x7FFE000000000000, 0x7F80},
-	{0xBFAFFFFFFFFFFFFE, 0xAC00},
-	{0x7FFF000000000000, 0x7FC0},
-	{0x329FFFFFDFFFEFFF, 0x0000},
-	{0x7FFF800000000000, 0x7FE0},
-	{0xC3CFFFFFFFFFFFC0, 0xFC00},
-	{0x7FFFC00000000000, 0x7FF0},
-	{0xBFB0000020003FFE, 0xAC00},
-	{0x7FFFE00000000000, 0x7FF8},
-	{0x3800000100000400, 0x0000},
-	{0x7FFFF00000000000, 0x7FFC},
-	{0xC106167ED1648F9D, 0xFC00},
-	{0x7FFFF80000000000, 0x7FFE},
-	{0xFFFFFF8000000020, 0xFFFF},
-	{0x7FFFFC0000000000, 0x7FFF},
-	{0x43D0000043FFFFFF, 0x7C00},
-	{0x7FFFFE0000000000, 0x7FFF},
-	{0x80200FFFFFFFFFFE, 0x8000},
-	{0x7FFFFF0000000000, 0x7FFF},
-	{0xC3FFFFDBFFFFFFFF, 0xFC00},
-	{0x7FFFFF8000000000, 0x7FFF},
-	{0x400FFFFFFFFFFFFA, 0x4400},
-	{0x7FFFFFC000000000, 0x7FFF},
-	{0x000FFFFFF7FEFFFE, 0x0000},
-	{0x7FFFFFE000000000, 0x7FFF},
-	{0xC010000084000000, 0xC400},
-	{0x7FFFFFF000000000, 0x7FFF},
-	{0x2D2E97A5D060DF07, 0x0000},
-	{0x7FFFFFF800000000, 0x7FFF},
-	{0xFFF00000007FF7FF, 0xFE00},
-	{0x7FFFFFFC00000000, 0x7FFF},
-	{0xC800000001000002, 0xFC00},
-	{0x7FFFFFFE00000000, 0x7FFF},
-	{0x37E07FFFFFFFFDFF, 0x0000},
-	{0x7FFFFFFF00000000, 0x7FFF},
-	{0x3813FFFFFFFF7FFF, 0x0000},
-	{0x7FFFFFFF80000000, 0x7FFF},
-	{0xBF77C501CFF735D1, 0x9DF1},
-	{0x7FFFFFFFC0000000, 0x7FFF},
-	{0x3FCFFFDFFFFFDFFF, 0x3400},
-	{0x7FFFFFFFE0000000, 0x7FFF},
-	{0x3E9E000100000000, 0x0008},
-	{0x7FFFFFFFF0000000, 0x7FFF},
-	{0xC000000003FFFFFC, 0xC000},
-	{0x7FFFFFFFF8000000, 0x7FFF},
-	{0xBFFFFFFFFFF7F7FF, 0xC000},
-	{0x7FFFFFFFFC000000, 0x7FFF},
-	{0xBFE859D1485793A7, 0xBA16},
-	{0x7FFFFFFFFE000000, 0x7FFF},
-	{0x3FFFFFFFFFFFEFF7, 0x4000},
-	{0x7FFFFFFFFF000000, 0x7FFF},
-	{0x3F9FFFEFF7FFFFFF, 0x2800},
-	{0x7FFFFFFFFF800000, 0x7FFF},
-	{0xC48BFFFFFDFFFFFF, 0xFC00},
-	{0x7FFFFFFFFFC00000, 0x7FFF},
-	{0xF27FFFFFFFE02000, 0xFC00},
-	{0x7FFFFFFFFFE00000, 0x7FFF},
-	{0xBD3FFFF7C0000000, 0x8000},
-	{0x7FFFFFFFFFF00000, 0x7FFF},
-	{0x00200000002000FF, 0x0000},
-	{0x7FFFFFFFFFF80000, 0x7FFF},
-	{0x3FFC0404B06F3F66, 0x3F01},
-	{0x7FFFFFFFFFFC0000, 0x7FFF},
-	{0x1E70000000000FBF, 0x0000},
-	{0x7FFFFFFFFFFE0000, 0x7FFF},
-	{0x381FFFFFFF000000, 0x0000},
-	{0x7FFFFFFFFFFF0000, 0x7FFF},
-	{0x4340000000100003, 0x7C00},
-	{0x7FFFFFFFFFFF8000, 0x7FFF},
-	{0x43CB7FFFFFFFFFFE, 0x7C00},
-	{0x7FFFFFFFFFFFC000, 0x7FFF},
-	{0xC010000000180000, 0xC400},
-	{0x7FFFFFFFFFFFE000, 0x7FFF},
-	{0x3FE1F724B4E0D3D3, 0x387E},
-	{0x7FFFFFFFFFFFF000, 0x7FFF},
-	{0x401001FFFFFBFFFF, 0x4400},
-	{0x7FFFFFFFFFFFF800, 0x7FFF},
-	{0xC0100003F7FFFFFF, 0xC400},
-	{0x7FFFFFFFFFFFFC00, 0x7FFF},
-	{0x3FCFFFFFFFFFF7FE, 0x3400},
-	{0x7FFFFFFFFFFFFE00, 0x7FFF},
-	{0xC1F0007FFFFFC000, 0xFC00},
-	{0x7FFFFFFFFFFFFF00, 0x7FFF},
-	{0x3FCDCFF82F5FE3D8, 0x3374},
-	{0x7FFFFFFFFFFFFF80, 0x7FFF},
-	{0x402FFF00FFFFFFFE, 0x4C00},
-	{0x7FFFFFFFFFFFFFC0, 0x7FFF},
-	{0xFFFFFFE0FFFFFFFF, 0xFFFF},
-	{0x7FFFFFFFFFFFFFE0, 0x7FFF},
-	{0xC2F883D59C699AA1, 0xFC00},
-	{0x7FFFFFFFFFFFFFF0, 0x7FFF},
-	{0xBFF32FC59203F630, 0xBCCC},
-	{0x7FFFFFFFFFFFFFF8, 0x7FFF},
-	{0x37F03FFFFFFFE000, 0x0000},
-	{0x7FFFFFFFFFFFFFFC, 0x7FFF},
-	{0x47EFC10000000000, 0x7C00},
-	{0x7FFFFFFFFFFFFFFE, 0x7FFF},
-	{0xBFB0017FFFFFFFFF, 0xAC00},
-	{0x7FFFFFFFFFFFFFFF, 0x7FFF},
-	{0xB910000200000001, 0x8000},
-	{0x7FFFFFFFFFFFFFFD, 0x7FFF},
-	{0x37E0010000000001, 0x0000},
-	{0x7FFFFFFFFFFFFFFB, 0x7FFF},
-	{0xC2300000FFFF0000, 0xFC00},
-	{0x7FFFFFFFFFFFFFF7, 0x7FFF},
-	{0xC09FFFFF77FFFFFF, 0xE800},
-	{0x7FFFFFFFFFFFFFEF, 0x7FFF},
-	{0xC140140000000000, 0xFC00},
-	{0x7FFFFFFFFFFFFFDF, 0x7FFF},
-	{0xBFF0000003FEFFFF, 0xBC00},
-	{0x7FFFFFFFFFFFFFBF, 0x7FFF},
-	{0xC7E7FFFFFFFFFDFE, 0xFC00},
-	{0x7FFFFFFFFFFFFF7F, 0x7FFF},
-	{0xDDF00207FFFFFFFF, 0xFC00},
-	{0x7FFFFFFFFFFFFEFF, 0x7FFF},
-	{0x41FBD02CC956B712, 0x7C00},
-	{0x7FFFFFFFFFFFFDFF, 0x7FFF},
-	{0x3EBFDFF7FFFFFFFE, 0x0020},
-	{0x7FFFFFFFFFFFFBFF, 0x7FFF},
-	{0x800FFFFFFFFF8000, 0x8000},
-	{0x7FFFFFFFFFFFF7FF, 0x7FFF},
-	{0x7FFFFE000000000E, 0x7FFF},
-	{0x7FFFFFFFFFFFEFFF, 0x7FFF},
-	{0x3E4000013FFFFFFF, 0x0000},
-	{0x7FFFFFFFFFFFDFFF, 0x7FFF},
-	{0xC1D4CB9DDC0F1382, 0xFC00},
-	{0x7FFFFFFFFFFFBFFF, 0x7FFF},
-	{0xA0E455A6F86EFE01, 0x8000},
-	{0x7FFFFFFFFFFF7FFF, 0x7FFF},
-	{0xBFB2CD02050E2120, 0xACB3},
-	{0x7FFFFFFFFFFEFFFF, 0x7FFF},
-	{0x3CAFFFFFFFFFFFD0, 0x0000},
-	{0x7FFFFFFFFFFDFFFF, 0x7FFF},
-	{0x40262FF20927FD94, 0x498C},
-	{0x7FFFFFFFFFFBFFFF, 0x7FFF},
-	{0x5AE000000007FFBF, 0x7C00},
-	{0x7FFFFFFFFFF7FFFF, 0x7FFF},
-	{0x37FA2CFE005B883B, 0x0000},
-	{0x7FFFFFFFFFEFFFFF, 0x7FFF},
-	{0xFFEFFFFFFFF81FFE, 0xFC00},
-	{0x7FFFFFFFFFDFFFFF, 0x7FFF},
-	{0x28DFF00001FFFFFF, 0x0000},
-	{0x7FFFFFFFFFBFFFFF, 0x7FFF},
-	{0xBFC07FDFFFFFFFFE, 0xB020},
-	{0x7FFFFFFFFF7FFFFF, 0x7FFF},
-	{0x3CA0000001FFFBFF, 0x0000},
-	{0x7FFFFFFFFEFFFFFF, 0x7FFF},
-	{0x802B184A9C4F40EC, 0x8000},
-	{0x7FFFFFFFFDFFFFFF, 0x7FFF},
-	{0x7FF000000201FFFF, 0x7E00},
-	{0x7FFFFFFFFBFFFFFF, 0x7FFF},
-	{0xC7EFF80000000008, 0xFC00},
-	{0x7FFFFFFFF7FFFFFF, 0x7FFF},
-	{0x000F80000000003F, 0x0000},
-	{0x7FFFFFFFEFFFFFFF, 0x7FFF},
-	{0x978000000080001F, 0x8000},
-	{0x7FFFFFFFDFFFFFFF, 0x7FFF},
-	{0x41C00001F7FFFFFE, 0x7C00},
-	{0x7FFFFFFFBFFFFFFF, 0x7FFF},
-	{0x3B598F3AFB928BFE, 0x0000},
-	{0x7FFFFFFF7FFFFFFF, 0x7FFF},
-	{0x659FFFFEFFFFBFFF, 0x7C00},
-	{0x7FFFFFFEFFFFFFFF, 0x7FFF},
-	{0x40BFFF800001FFFF, 0x7000},
-	{0x7FFFFFFDFFFFFFFF, 0x7FFF},
-	{0xC7E0001FDFFFFFFF, 0xFC00},
-	{0x7FFFFFFBFFFFFFFF, 0x7FFF},
-	{0xC00FFFBFFFDFFFFF, 0xC400},
-	{0x7FFFFFF7FFFFFFFF, 0x7FFF},
-	{0xC1CFFFFFF7FFFFBE, 0xFC00},
-	{0x7FFFFFEFFFFFFFFF, 0x7FFF},
-	{0xC01AEE4FDDB52E51, 0xC6BC},
-	{0x7FFFFFDFFFFFFFFF, 0x7FFF},
-	{0xFFEE83BE509E76BB, 0xFC00},
-	{0x7FFFFFBFFFFFFFFF, 0x7FFF},
-	{0x402FFFFFFFFBFFFC, 0x4C00},
-	{0x7FFFFF7FFFFFFFFF, 0x7FFF},
-	{0x41E0DFA3627B94EE, 0x7C00},
-	{0x7FFFFEFFFFFFFFFF, 0x7FFF},
-	{0xBFBFFFFE0FFFFFFF, 0xB000},
-	{0x7FFFFDFFFFFFFFFF, 0x7FFF},
-	{0x4070003FFFFFFBFF, 0x5C00},
-	{0x7FFFFBFFFFFFFFFF, 0x7FFE},
-	{0x352C000000000100, 0x0000},
-	{0x7FFFF7FFFFFFFFFF, 0x7FFD},
-	{0x41C841491F1F6680, 0x7C00},
-	{0x7FFFEFFFFFFFFFFF, 0x7FFB},
-	{0xBFA0010000000003, 0xA800},
-	{0x7FFFDFFFFFFFFFFF, 0x7FF7},
-	{0xFFDC000000000010, 0xFC00},
-	{0x7FFFBFFFFFFFFFFF, 0x7FEF},
-	{0xC1F00002000FFFFE, 0xFC00},
-	{0x7FFF7FFFFFFFFFFF, 0x7FDF},
-	{0x3FD0000000401000, 0x3400},
-	{0x7FFEFFFFFFFFFFFF, 0x7FBF},
-	{0xC7CFFFFFFFFDDFFF, 0xFC00},
-	{0x7FFDFFFFFFFFFFFF, 0x7F7F},
-	{0x381920F4976CCD66, 0x0000},
-	{0x7FFBFFFFFFFFFFFF, 0x7EFF},
-	{0xC0200003FFFFDFFE, 0xC800},
-	{0x7FF7FFFFFFFFFFFF, 0x7FFF},
-	{0x3C8FDFFFFF000000, 0x0000},
-	{0x7FF3FFFFFFFFFFFF, 0x7EFF},
-	{0x9B6C08A61822C9EE, 0x8000},
-	{0x7FF1FFFFFFFFFFFF, 0x7E7F},
-	{0x43FFFFFF7FFFFFFB, 0x7C00},
-	{0x7FF0FFFFFFFFFFFF, 0x7E3F},
-	{0xB803512C23FAE69B, 0x8000},
-	{0x7FF07FFFFFFFFFFF, 0x7E1F},
-	{0xC3E00001BFFFFFFF, 0xFC00},
-	{0x7FF03FFFFFFFFFFF, 0x7E0F},
-	{0x3FD9180401D002D2, 0x3646},
-	{0x7FF01FFFFFFFFFFF, 0x7E07},
-	{0xA6FBFFFFFFFFF000, 0x8000},
-	{0x7FF00FFFFFFFFFFF, 0x7E03},
-	{0x401ED3F49C3E60D1, 0x47B5},
-	{0x7FF007FFFFFFFFFF, 0x7E01},
-	{0xC8001FE000000000, 0xFC00},
-	{0x7FF003FFFFFFFFFF, 0x7E00},
-	{0xBFC0000000003FF8, 0xB000},
-	{0x7FF001FFFFFFFFFF, 0x7E00},
-	{0x3FDFFFFFFFFFE008, 0x3800},
-	{0x7FF000FFFFFFFFFF, 0x7E00},
-	{0xB8CFFEFBFFFFFFFF, 0x8000},
-	{0x7FF0007FFFFFFFFF, 0x7E00},
-	{0xE6CFFF000000003F, 0xFC00},
-	{0x7FF0003FFFFFFFFF, 0x7E00},
-	{0x7CA0000008000003, 0x7C00},
-	{0x7FF0001FFFFFFFFF, 0x7E00},
-	{0x37FFFF800000000F, 0x0000},
-	{0x7FF0000FFFFFFFFF, 0x7E00},
-	{0x4AC00FFFFFFFFBFE, 0x7C00},
-	{0x7FF00007FFFFFFFF, 0x7E00},
-	{0x47FFFF800000FFFE, 0x7C00},
-	{0x7FF00003FFFFFFFF, 0x7E00},
-	{0x43FFF80000000006, 0x7C00},
-	{0x7FF00001FFFFFFFF, 0x7E00},
-	{0x38500007FFFFEFFF, 0x0000},
-	{0x7FF00000FFFFFFFF, 0x7E00},
-	{0xBFF328D3FAD2C206, 0xBCCA},
-	{0x7FF000007FFFFFFF, 0x7E00},
-	{0xC030000000009FFF, 0xCC00},
-	{0x7FF000003FFFFFFF, 0x7E00},
-	{0xC3C8593A2180B60A, 0xFC00},
-	{0x7FF000001FFFFFFF, 0x7E00},
-	{0x47E7C00000000000, 0x7C00},
-	{0x7FF000000FFFFFFF, 0x7E00},
-	{0x3FEFE00040000000, 0x3BF8},
-	{0x7FF0000007FFFFFF, 0x7E00},
-	{0xBFF76CE0CA64B6C6, 0xBDDB},
-	{0x7FF0000003FFFFFF, 0x7E00},
-	{0x4A499274C7EFF775, 0x7C00},
-	{0x7FF0000001FFFFFF, 0x7E00},
-	{0x4241FFFFFFFDFFFF, 0x7C00},
-	{0x7FF0000000FFFFFF, 0x7E00},
-	{0x43D5493645FE96DA, 0x7C00},
-	{0x7FF00000007FFFFF, 0x7E00},
-	{0x90800000000FFFFF, 0x8000},
-	{0x7FF00000003FFFFF, 0x7E00},
-	{0xB81FFFFFDFFFF7FE, 0x8000},
-	{0x7FF00000001FFFFF, 0x7E00},
-	{0xC0B003E000000000, 0xEC01},
-	{0x7FF00000000FFFFF, 0x7E00},
-	{0xC020003FFFDFFFFE, 0xC800},
-	{0x7FF000000007FFFF, 0x7E00},
-	{0x7FFC8740D036E246, 0x7F21},
-	{0x7FF000000003FFFF, 0x7E00},
-	{0x40F00007FEFFFFFE, 0x7C00},
-	{0x7FF000000001FFFF, 0x7E00},
-	{0xBCE11D6C1DE83E79, 0x8000},
-	{0x7FF000000000FFFF, 0x7E00},
-	{0x41E0210000000000, 0x7C00},
-	{0x7FF0000000007FFF, 0x7E00},
-	{0xFFEFFDFFFFFFFFF7, 0xFC00},
-	{0x7FF0000000003FFF, 0x7E00},
-	{0x43C000000080FFFE, 0x7C00},
-	{0x7FF0000000001FFF, 0x7E00},
-	{0xC3FCBACD9FE596BB, 0xFC00},
-	{0x7FF0000000000FFF, 0x7E00},
-	{0xC01FFFFF003FFFFF, 0xC800},
-	{0x7FF00000000007FF, 0x7E00},
-	{0xC0F000000000203F, 0xFC00},
-	{0x7FF00000000003FF, 0x7E00},
-	{0xA1FF5AF5F632EC15, 0x8000},
-	{0x7FF00000000001FF, 0x7E00},
-	{0x3A4FFFDFFFFFC000, 0x0000},
-	{0x7FF00000000000FF, 0x7E00},
-	{0x4D10001001000000, 0x7C00},
-	{0x7FF000000000007F, 0x7E00},
-	{0x3FE0000004000003, 0x3800},
-	{0x7FF000000000003F, 0x7E00},
-	{0xBE1FEFFFFFDFFFFF, 0x8000},
-	{0x7FF000000000001F, 0x7E00},
-	{0x3FFEEAD883BBFF6B, 0x3FBB},
-	{0x7FF000000000000F, 0x7E00},
-	{0x44E7F8BA157686DE, 0x7C00},
-	{0x7FF0000000000007, 0x7E00},
-	{0xCD800FFFFFFFFFE0, 0xFC00},
-	{0x7FF0000000000003, 0x7E00},
-	{0xB7FE785F42DFD8AD, 0x8000},
-	{0x8000000000000000, 0x8000},
-	{0x3FDF000000000400, 0x37C0},
-	{0x8000000000000001, 0x8000},
-	{0x3FD0000037FFFFFE, 0x3400},
-	{0x8000000000000002, 0x8000},
-	{0x439FFFFFFFE04000, 0x7C00},
-	{0x8000000000000004, 0x8000},
-	{0xC3E0AB17DEDC569B, 0xFC00},
-	{0x8000000000000008, 0x8000},
-	{0x465E3FFFFFFFFFFF, 0x7C00},
-	{0x8000000000000010, 0x8000},
-	{0x43EFFFDFFFFFFFFD, 0x7C00},
-	{0x8000000000000020, 0x8000},
-	{0xB81D37F8321E019C, 0x8000},
-	{0x8000000000000040, 0x8000},
-	{0xC1C007F7FFFFFFFF, 0xFC00},
-	{0x8000000000000080, 0x8000},
-	{0x7FEFFFFFFFFFBFF6, 0x7C00},
-	{0x8000000000000100, 0x8000},
-	{0xBF0EFFFFFFFFFE00, 0x83E0},
-	{0x8000000000000200, 0x8000},
-	{0x47EFFF800000FFFF, 0x7C00},
-	{0x8000000000000400, 0x8000},
-	{0xBF892059779E3464, 0xA248},
-	{0x8000000000000800, 0x8000},
-	{0x43EFE000000003FF, 0x7C00},
-	{0x8000000000001000, 0x8000},
-	{0xA8AFFFC7FFFFFFFF, 0x8000},
-	{0x8000000000002000, 0x8000},
-	{0x4022DF6A6C9E25C6, 0x48B8},
-	{0x8000000000004000, 0x8000},
-	{0xFFE010000000007F, 0xFC00},
-	{0x8000000000008000, 0x8000},
-	{0x40AFFFBFEFFFFFFE, 0x6C00},
-	{0x8000000000010000, 0x8000},
-	{0xBFCFFFFFFFDFFFF0, 0xB400},
-	{0x8000000000020000, 0x8000},
-	{0xC340000000004010, 0xFC00},
-	{0x8000000000040000, 0x8000},
-	{0xC64FF000000001FF, 0xFC00},
-	{0x8000000000080000, 0x8000},
-	{0x7FF3FBFFFFFFFFFF, 0x7EFE},
-	{0x8000000000100000, 0x8000},
-	{0xB430000001001FFF, 0x8000},
-	{0x8000000000200000, 0x8000},
-	{0x3FDA699EE9F60FCB, 0x369A},
-	{0x8000000000400000, 0x8000},
-	{0xC090000000807FFE, 0xE400},
-	{0x8000000000800000, 0x8000},
-	{0x41F000000FFFF7FF, 0x7C00},
-	{0x8000000001000000, 0x8000},
-	{0x3E20000000000201, 0x0000},
-	{0x8000000002000000, 0x8000},
-	{0xBFE03FFFFEFFFFFF, 0xB810},
-	{0x8000000004000000, 0x8000},
-	{0xBD0FFFFFFF00FFFE, 0x8000},
-	{0x8000000008000000, 0x8000},
-	{0x418FFFEFC0000000, 0x7C00},
-	{0x8000000010000000, 0x8000},
-	{0x4000000001FFFF7F, 0x4000},
-	{0x8000000020000000, 0x8000},
-	{0xB80000003FF7FFFF, 0x8000},
-	{0x8000000040000000, 0x8000},
-	{0xC1EFFBFFFFFFFFFB, 0xFC00},
-	{0x8000000080000000, 0x8000},
-	{0x79F0001FFE000000, 0x7C00},
-	{0x8000000100000000, 0x8000},
-	{0xBFB26FEA5A72AE8C, 0xAC9C},
-	{0x8000000200000000, 0x8000},
-	{0x43EFFFFF7FFFFFF7, 0x7C00},
-	{0x8000000400000000, 0x8000},
-	{0xC7EFFDFFFBFFFFFF, 0xFC00},
-	{0x8000000800000000, 0x8000},
-	{0x4120000003FFFFDF, 0x7C00},
-	{0x8000001000000000, 0x8000},
-	{0x37E54EC99E631EE0, 0x0000},
-	{0x8000002000000000, 0x8000},
-	{0xC012B3F39AA59F60, 0xC4AD},
-	{0x8000004000000000, 0x8000},
-	{0x3FC07FFFFFE00000, 0x3020},
-	{0x8000008000000000, 0x8000},
-	{0x480100000003FFFE, 0x7C00},
-	{0x8000010000000000, 0x8000},
-	{0x4C80000000000037, 0x7C00},
-	{0x8000020000000000, 0x8000},
-	{0x8027674177C30A65, 0x8000},
-	{0x8000040000000000, 0x8000},
-	{0xDA470A4D4AAF69B1, 0xFC00},
-	{0x8000080000000000, 0x8000},
-	{0x06C100FFFFFFFFFF, 0x0000},
-	{0x8000100000000000, 0x8000},
-	{0x7FDC0D7915D893AE, 0x7C00},
-	{0x8000200000000000, 0x8000},
-	{0x38D9CB1CA8E05EF3, 0x0000},
-	{0x8000400000000000, 0x8000},
-	{0x3CA0000001FFFFEF, 0x0000},
-	{0x8000800000000000, 0x8000},
-	{0x801D4B53A488EC58, 0x8000},
-	{0x8001000000000000, 0x8000},
-	{0xC28BA0B96EEEFC26, 0xFC00},
-	{0x8002000000000000, 0x8000},
-	{0x399FFF80007FFFFE, 0x0000},
-	{0x8004000000000000, 0x8000},
-	{0xC2013FFFFFFFFFFF, 0xFC00},
-	{0x8008000000000000, 0x8000},
-	{0x3DF0000008010000, 0x0000},
-	{0x800C000000000000, 0x8000},
-	{0xA0B0BCB666A4E6A3, 0x8000},
-	{0x800E000000000000, 0x8000},
-	{0x3FD0000007FFDFFF, 0x3400},
-	{0x800F000000000000, 0x8000},
-	{0xC02ED9813C439E8C, 0xCBB6},
-	{0x800F800000000000, 0x8000},
-	{0x3C40200007FFFFFF, 0x0000},
-	{0x800FC00000000000, 0x8000},
-	{0x388000000DFFFFFF, 0x0000},
-	{0x800FE00000000000, 0x8000},
-	{0x429E0D74E2F4E74C, 0x7C00},
-	{0x800FF00000000000, 0x8000},
-	{0x4C58331BDEE691BB, 0x7C00},
-	{0x800FF80000000000, 0x8000},
-	{0x4247BEBBC6C8A057, 0x7C00},
-	{0x800FFC0000000000, 0x8000},
-	{0xC09FFFFFFFFF0FFF, 0xE800},
-	{0x800FFE0000000000, 0x8000},
-	{0xC03010000007FFFF, 0xCC04},
-	{0x800FFF0000000000, 0x8000},
-	{0xC1DF9C62FC77FB7F, 0xFC00},
-	{0x800FFF8000000000, 0x8000},
-	{0x43C3FFFFFFFFFEFE, 0x7C00},
-	{0x800FFFC000000000, 0x8000},
-	{0x3816E9EA200F496E, 0x0000},
-	{0x800FFFE000000000, 0x8000},
-	{0xC7F7333F47D97EB4, 0xFC00},
-	{0x800FFFF000000000, 0x8000},
-	{0x40FFFFFFFFFFFF9F, 0x7C00},
-	{0x800FFFF800000000, 0x8000},
-	{0x4003EE0F15A47360, 0x40FC},
-	{0x800FFFFC00000000, 0x8000},
-	{0xC040208000000000, 0xD008},
-	{0x800FFFFE00000000, 0x8000},
-	{0xB8EFEFFFFFDFFFFE, 0x8000},
-	{0x800FFFFF00000000, 0x8000},
-	{0xBFC0008000003FFF, 0xB000},
-	{0x800FFFFF80000000, 0x8000},
-	{0xBE3FFFFFFFEF8000, 0x8000},
-	{0x800FFFFFC0000000, 0x8000},
-	{0xB340000002007FFF, 0x8000},
-	{0x800FFFFFE0000000, 0x8000},
-	{0x37F00001FFFFFDFF, 0x0000},
-	{0x800FFFFFF0000000, 0x8000},
-	{0xC3400017FFFFFFFF, 0xFC00},
-	{0x800FFFFFF8000000, 0x8000},
-	{0x40000003FFDFFFFF, 0x4000},
-	{0x800FFFFFFC000000, 0x8000},
-	{0xBF7FFFFFFF0001FF, 0xA000},
-	{0x800FFFFFFE000000, 0x8000},
-	{0xBFF888AFC3C7E72C, 0xBE22},
-	{0x800FFFFFFF000000, 0x8000},
-	{0xC3C800000000FFFF, 0xFC00},
-	{0x800FFFFFFF800000, 0x8000},
-	{0xC3A0000000040010, 0xFC00},
-	{0x800FFFFFFFC00000, 0x8000},
-	{0xC1E000007FFEFFFE, 0xFC00},
-	{0x800FFFFFFFE00000, 0x8000},
-	{0x3FC0207FFFFFFFFF, 0x3008},
-	{0x800FFFFFFFF00000, 0x8000},
-	{0x7FE00000000103FF, 0x7C00},
-	{0x800FFFFFFFF80000, 0x8000},
-	{0x3DBB4171F0CF166B, 0x0000},
-	{0x800FFFFFFFFC0000, 0x8000},
-	{0x227100000FFFFFFF, 0x0000},
-	{0x800FFFFFFFFE0000, 0x8000},
-	{0xC1F1FFFF00000000, 0xFC00},
-	{0x800FFFFFFFFF0000, 0x8000},
-	{0xC3FFFFF7EFFFFFFF, 0xFC00},
-	{0x800FFFFFFFFF8000, 0x8000},
-	{0x402CFFFFFFFFFFFF, 0x4B40},
-	{0x800FFFFFFFFFC000, 0x8000},
-	{0x4020007FFFFFF7FF, 0x4800},
-	{0x800FFFFFFFFFE000, 0x8000},
-	{0x41F0000800001FFF, 0x7C00},
-	{0x800FFFFFFFFFF000, 0x8000},
-	{0xBFC0D65D52D44FCB, 0xB036},
-	{0x800FFFFFFFFFF800, 0x8000},
-	{0xBFC03FFFFFFFF800, 0xB010},
-	{0x800FFFFFFFFFFC00, 0x8000},
-	{0x3FFFFF7FFFFFFFFC, 0x4000},
-	{0x800FFFFFFFFFFE00, 0x8000},
-	{0x3FF00000003FFFFE, 0x3C00},
-	{0x800FFFFFFFFFFF00, 0x8000},
-	{0xC02238D1CE011F1C, 0xC88E},
-	{0x800FFFFFFFFFFF80, 0x8000},
-	{0x404000001FBFFFFE, 0x5000},
-	{0x800FFFFFFFFFFFC0, 0x8000},
-	{0xC80F2AD358E64665, 0xFC00},
-	{0x800FFFFFFFFFFFE0, 0x8000},
-	{0x3CAFFF8000000080, 0x0000},
-	{0x800FFFFFFFFFFFF0, 0x8000},
-	{0xBE0001FFFFFF7FFE, 0x8000},
-	{0x800FFFFFFFFFFFF8, 0x8000},
-	{0x4080000200000001, 0x6000},
-	{0x800FFFFFFFFFFFFC, 0x8000},
-	{0xC06FFFFFFE000200, 0xDC00},
-	{0x800FFFFFFFFFFFFE, 0x8000},
-	{0xC02017FFFFFFFFFF, 0xC806},
-	{0x800FFFFFFFFFFFFF, 0x8000},
-	{0xBFA0000800FFFFFF, 0xA800},
-	{0x800FFFFFFFFFFFFD, 0x8000},
-	{0xC7E000000023FFFF, 0xFC00},
-	{0x800FFFFFFFFFFFFB, 0x8000},
-	{0xA3C00020000007FE, 0x8000},
-	{0x800FFFFFFFFFFFF7, 0x8000},
-	{0xAB5FFF7FFDFFFFFF, 0x8000},
-	{0x800FFFFFFFFFFFEF, 0x8000},
-	{0x3D5A252EFF5AB473, 0x0000},
-	{0x800FFFFFFFFFFFDF, 0x8000},
-	{0x43C04003FFFFFFFF, 0x7C00},
-	{0x800FFFFFFFFFFFBF, 0x8000},
-	{0x47FF800000001000, 0x7C00},
-	{0x800FFFFFFFFFFF7F, 0x8000},
-	{0xC01294A625EC5B61, 0xC4A5},
-	{0x800FFFFFFFFFFEFF, 0x8000},
-	{0xC01AE2C360600154, 0xC6B9},
-	{0x800FFFFFFFFFFDFF, 0x8000},
-	{0xBCAFFFFF80FFFFFF, 0x8000},
-	{0x800FFFFFFFFFFBFF, 0x8000},
-	{0xA3B000000FFFFFF6, 0x8000},
-	{0x800FFFFFFFFFF7FF, 0x8000},
-	{0xFFD10000001FFFFF, 0xFC00},
-	{0x800FFFFFFFFFEFFF, 0x8000},
-	{0x3375CC7255A4EA43, 0x0000},
-	{0x800FFFFFFFFFDFFF, 0x8000},
-	{0x62C008000001FFFE, 0x7C00},
-	{0x800FFFFFFFFFBFFF, 0x8000},
-	{0xC3DC5A26DDF07425, 0xFC00},
-	{0x800FFFFFFFFF7FFF, 0x8000},
-	{0x1CDFFFBFFFFFFFF8, 0x0000},
-	{0x800FFFFFFFFEFFFF, 0x8000},
-	{0xFFFFFDFFFFFFFFF0, 0xFFFF},
-	{0x800FFFFFFFFDFFFF, 0x8000},
-	{0xC7FFFFF7FDFFFFFF, 0xFC00},
-	{0x800FFFFFFFFBFFFF, 0x8000},
-	{0xBFFF800000003FFE, 0xBFE0},
-	{0x800FFFFFFFF7FFFF, 0x8000},
-	{0x407D0E1A6E3146C6, 0x5F44},
-	{0x800FFFFFFFEFFFFF, 0x8000},
-	{0xB7EEA3807704CBC0, 0x8000},
-	{0x800FFFFFFFDFFFFF, 0x8000},
-	{0x39C0000200000002, 0x0000},
-	{0x800FFFFFFFBFFFFF, 0x8000},
-	{0xB7FFBFFFFEFFFFFE, 0x8000},
-	{0x800FFFFFFF7FFFFF, 0x8000},
-	{0x7FEFBF7FFFFFFFFE, 0x7C00},
-	{0x800FFFFFFEFFFFFF, 0x8000},
-	{0xBEA0000010001FFE, 0x8008},
-	{0x800FFFFFFDFFFFFF, 0x8000},
-	{0xBF5FFFFFFFFC0010, 0x9800},
-	{0x800FFFFFFBFFFFFF, 0x8000},
-	{0x3F8E90AFBC5112CB, 0x23A4},
-	{0x800FFFFFF7FFFFFF, 0x8000},
-	{0xBFB00020000001FF, 0xAC00},
-	{0x800FFFFFEFFFFFFF, 0x8000},
-	{0xC02FFFFFFFFDFFFD, 0xCC00},
-	{0x800FFFFFDFFFFFFF, 0x8000},
-	{0x37E002000000000E, 0x0000},
-	{0x800FFFFFBFFFFFFF, 0x8000},
-	{0xC1F1EE83BC234463, 0xFC00},
-	{0x800FFFFF7FFFFFFF, 0x8000},
-	{0xAD8FFFFC007FFFFF, 0x8000},
-	{0x800FFFFEFFFFFFFF, 0x8000},
-	{0x3F00000001001FFE, 0x0200},
-	{0x800FFFFDFFFFFFFF, 0x8000},
-	{0xC1D0003F7FFFFFFF, 0xFC00},
-	{0x800FFFFBFFFFFFFF, 0x8000},
-	{0xC00FFFF800001FFE, 0xC400},
-	{0x800FFFF7FFFFFFFF, 0x8000},
-	{0x43D00001FFFEFFFF, 0x7C00},
-	{0x800FFFEFFFFFFFFF, 0x8000},
-	{0x8000BEFF7780FE41, 0x8000},
-	{0x800FFFDFFFFFFFFF, 0x8000},
-	{0x3816875667D3B336, 0x0000},
-	{0x800FFFBFFFFFFFFF, 0x8000},
-	{0xBF173FFE3E85E0D3, 0x85D0},
-	{0x800FFF7FFFFFFFFF, 0x8000},
-	{0x401FFFFFFFFF7FE0, 0x4800},
-	{0x800FFEFFFFFFFFFF, 0x8000},
-	{0x3CAFFEC000000000, 0x0000},
-	{0x800FFDFFFFFFFFFF, 0x8000},
-	{0x0010000000FDFFFF, 0x0000},
-	{0x800FFBFFFFFFFFFF, 0x8000},
-	{0x4000007FE0000000, 0x4000},
-	{0x800FF7FFFFFFFFFF, 0x8000},
-	{0xBBAB624ABFC603A6, 0x8000},
-	{0x800FEFFFFFFFFFFF, 0x8000},
-	{0xC2D000000000013E, 0xFC00},
-	{0x800FDFFFFFFFFFFF, 0x8000},
-	{0xCEDBFEFFFFFFFFFE, 0xFC00},
-	{0x800FBFFFFFFFFFFF, 0x8000},
-	{0x3DE00000006FFFFE, 0x0000},
-	{0x800F7FFFFFFFFFFF, 0x8000},
-	{0x4491000000010000, 0x7C00},
-	{0x800EFFFFFFFFFFFF, 0x8000},
-	{0x47ECBA9658663C20, 0x7C00},
-	{0x800DFFFFFFFFFFFF, 0x8000},
-	{0xC3CFFFFFFFF3FFFF, 0xFC00},
-	{0x800BFFFFFFFFFFFF, 0x8000},
-	{0xC050000000FFFDFF, 0xD400},
-	{0x8007FFFFFFFFFFFF, 0x8000},
-	{0x001003FBFFFFFFFE, 0x0000},
-	{0x8003FFFFFFFFFFFF, 0x8000},
-	{0xBFF04003FFFFFFFE, 0xBC10},
-	{0x8001FFFFFFFFFFFF, 0x8000},
-	{0xFFF007FFE0000000, 0xFE01},
-	{0x8000FFFFFFFFFFFF, 0x8000},
-	{0xBFEF8D90EC9B16EF, 0xBBE3},
-	{0x80007FFFFFFFFFFF, 0x8000},
-	{0x7FD0000010001FFF, 0x7C00},
-	{0x80003FFFFFFFFFFF, 0x8000},
-	{0xBFD03C0000000000, 0xB40F},
-	{0x80001FFFFFFFFFFF, 0x8000},
-	{0xBFC0FFFFFFF7FFFF, 0xB040},
-	{0x80000FFFFFFFFFFF, 0x8000},
-	{0x7FDFFFFF7FFFFFEE, 0x7C00},
-	{0x800007FFFFFFFFFF, 0x8000},
-	{0x43C8000FFFFFFFFF, 0x7C00},
-	{0x800003FFFFFFFFFF, 0x8000},
-	{0x002008000FFFFFFF, 0x0000},
-	{0x800001FFFFFFFFFF, 0x8000},
-	{0xFFEFFFFFF7FFDFFF, 0xFC00},
-	{0x800000FFFFFFFFFF, 0x8000},
-	{0x4800000004001000, 0x7C00},
-	{0x8000007FFFFFFFFF, 0x8000},
-	{0x43CFDFFFDFFFFFFF, 0x7C00},
-	{0x8000003FFFFFFFFF, 0x8000},
-	{0xBFDFFFFEFFDFFFFF, 0xB800},
-	{0x8000001FFFFFFFFF, 0x8000},
-	{0xC1C513D3FC308F59, 0xFC00},
-	{0x8000000FFFFFFFFF, 0x8000},
-	{0xC03FFFFFFFE0003F, 0xD000},
-	{0x80000007FFFFFFFF, 0x8000},
-	{0x3F57FFFFFFF80000, 0x1600},
-	{0x80000003FFFFFFFF, 0x8000},
-	{0xDB0FBFFFFFFFFFC0, 0xFC00},
-	{0x80000001FFFFFFFF, 0x8000},
-	{0xC0570E6E16A2EFF9, 0xD5C4},
-	{0x80000000FFFFFFFF, 0x8000},
-	{0x3CA77A0C8D67973F, 0x0000},
-	{0x800000007FFFFFFF, 0x8000},
-	{0x43A000FFFFBFFFFF, 0x7C00},
-	{0x800000003FFFFFFF, 0x8000},
-	{0x7FE0000400000002, 0x7C00},
-	{0x800000001FFFFFFF, 0x8000},
-	{0x3CE4A5DB44B12EB9, 0x0000},
-	{0x800000000FFFFFFF, 0x8000},
-	{0xC8080C2C850DC268, 0xFC00},
-	{0x8000000007FFFFFF, 0x8000},
-	{0x401FEFFFFFFFBFFE, 0x47FC},
-	{0x8000000003FFFFFF, 0x8000},
-	{0x3FCA4ACE6B6F07E0, 0x3293},
-	{0x8000000001FFFFFF, 0x8000},
-	{0x2EC0000000001FF6, 0x0000},
-	{0x8000000000FFFFFF, 0x8000},
-	{0xBFEC68A6E5FDAB81, 0xBB1A},
-	{0x80000000007FFFFF, 0x8000},
-	{0x3FD8F721685FE0CA, 0x363E},
-	{0x80000000003FFFFF, 0x8000},
-	{0xC7EFFFFFE0000FFF, 0xFC00},
-	{0x80000000001FFFFF, 0x8000},
-	{0xC01FFFDFFFC00000, 0xC800},
-	{0x80000000000FFFFF, 0x8000},
-	{0x47EDCB41EE8D8B8F, 0x7C00},
-	{0x800000000007FFFF, 0x8000},
-	{0xB81F800000001FFF, 0x8000},
-	{0x800000000003FFFF, 0x8000},
-	{0xB7E800007FFFFFFF, 0x8000},
-	{0x800000000001FFFF, 0x8000},
-	{0xCE6EC7D2D7CF17D5, 0xFC00},
-	{0x800000000000FFFF, 0x8000},
-	{0xC4EE621E966F012B, 0xFC00},
-	{0x8000000000007FFF, 0x8000},
-	{0x3FD00FFFFFFFFFF8, 0x3404},
-	{0x8000000000003FFF, 0x8000},
-	{0xC02000FFFDFFFFFE, 0xC800},
-	{0x8000000000001FFF, 0x8000},
-	{0x330FFFFFF0000000, 0x0000},
-	{0x8000000000000FFF, 0x8000},
-	{0x41C8CD8CB8E83B9F, 0x7C00},
-	{0x80000000000007FF, 0x8000},
-	{0xC094000000000000, 0xE500},
-	{0x80000000000003FF, 0x8000},
-	{0xC1901FFFFFFFFFDF, 0xFC00},
-	{0x80000000000001FF, 0x8000},
-	{0x3CBFEFFFFFFF7FFE, 0x0000},
-	{0x80000000000000FF, 0x8000},
-	{0xC22E58204D9E7219, 0xFC00},
-	{0x800000000000007F, 0x8000},
-	{0x36CFFFFFFBFFFFFF, 0x0000},
-	{0x800000000000003F, 0x8000},
-	{0x201FF9FFFFFFFFFF, 0x0000},
-	{0x800000000000001F, 0x8000},
-	{0xBFEFFFBFFFFC0000, 0xBC00},
-	{0x800000000000000F, 0x8000},
-	{0x4105B3566FA98425, 0x7C00},
-	{0x8000000000000007, 0x8000},
-	{0xC3EFFFFFFFFF01FE, 0xFC00},
-	{0x8000000000000003, 0x8000},
-	{0xB7EF4AF8A8CAB174, 0x8000},
-	{0x8010000000000000, 0x8000},
-	{0xB7EA99247DFC388B, 0x8000},
-	{0x8010000000000001, 0x8000},
-	{0xDF5FFF8040000000, 0xFC00},
-	{0x8010000000000002, 0x8000},
-	{0x47EFFFFFFF3FFFFE, 0x7C00},
-	{0x8010000000000004, 0x8000},
-	{0x8A1FFDFFFFFFFFDE, 0x8000},
-	{0x8010000000000008, 0x8000},
-	{0xDF2480DA8D16A17F, 0xFC00},
-	{0x8010000000000010, 0x8000},
-	{0x41D000000008001F, 0x7C00},
-	{0x8010000000000020, 0x8000},
-	{0x41F0000000FE0000, 0x7C00},
-	{0x8010000000000040, 0x8000},
-	{0xAD8FFFFFFFFFEFFB, 0x8000},
-	{0x8010000000000080, 0x8000},
-	{0xC02F000000000007, 0xCBC0},
-	{0x8010000000000100, 0x8000},
-	{0xCBCFFFFF8001FFFF, 0xFC00},
-	{0x8010000000000200, 0x8000},
-	{0x9C204000FFFFFFFF, 0x8000},
-	{0x8010000000000400, 0x8000},
-	{0xC3CFFFFFFF800020, 0xFC00},
-	{0x8010000000000800, 0x8000},
-	{0xC1603FFFFFFFFFEE, 0xFC00},
-	{0x8010000000001000, 0x8000},
-	{0x3FCFFF000FFFFFFF, 0x3400},
-	{0x8010000000002000, 0x8000},
-	{0x380E2288D735643C, 0x0000},
-	{0x8010000000004000, 0x8000},
-	{0x3FEF9FFFFFFFFFFF, 0x3BE8},
-	{0x8010000000008000, 0x8000},
-	{0xC1C007FFFFFFFEFE, 0xFC00},
-	{0x8010000000010000, 0x8000},
-	{0x4000001FFFFFFFF6, 0x4000},
-	{0x8010000000020000, 0x8000},
-	{0xBFDFFFFFFFF80007, 0xB800},
-	{0x8010000000040000, 0x8000},
-	{0xC0A000001007FFFE, 0xE800},
-	{0x8010000000080000, 0x8000},
-	{0xC1C0400003FFFFFF, 0xFC00},
-	{0x8010000000100000, 0x8000},
-	{0x47D625197F50F53E, 0x7C00},
-	{0x8010000000200000, 0x8000},
-	{0xC020007FFFFFBFFE, 0xC800},
-	{0x8010000000400000, 0x8000},
-	{0xBFA000003FFFFFFE, 0xA800},
-	{0x8010000000800000, 0x8000},
-	{0x403FFFFFF0400000, 0x5000},
-	{0x8010000001000000, 0x8000},
-	{0xBE7000FFFFFFFFF7, 0x8001},
-	{0x8010000002000000, 0x8000},
-	{0x380FFFFFFFDFFF7F, 0x0000},
-	{0x8010000004000000, 0x8000},
-	{0x3020002800000000, 0x0000},
-	{0x8010000008000000, 0x8000},
-	{0xC3C15B4FDA993D36, 0xFC00},
-	{0x8010000010000000, 0x8000},
-	{0x465FFFE000000020, 0x7C00},
-	{0x8010000020000000, 0x8000},
-	{0xBFE8794BEA0DFC41, 0xBA1E},
-	{0x8010000040000000, 0x8000},
-	{0x4063FFFFFFFF7FFF, 0x5900},
-	{0x8010000080000000, 0x8000},
-	{0xC3FFFFFFFFFFFFBB, 0xFC00},
-	{0x8010000100000000, 0x8000},
-	{0x325FFFFFFFFFFD80, 0x0000},
-	{0x8010000200000000, 0x8000},
-	{0x40700000200003FF, 0x5C00},
-	{0x8010000400000000, 0x8000},
-	{0x48502D53AF33FADE, 0x7C00},
-	{0x8010000800000000, 0x8000},
-	{0xC07FFC000000FFFF, 0xDFFF},
-	{0x8010001000000000, 0x8000},
-	{0x401000010000003F, 0x4400},
-	{0x8010002000000000, 0x8000},
-	{0xBFEC000000000400, 0xBB00},
-	{0x8010004000000000, 0x8000},
-	{0x320DFFC8A53501E9, 0x0000},
-	{0x8010008000000000, 0x8000},
-	{0x8022000000000003, 0x8000},
-	{0x8010010000000000, 0x8000},
-	{0xBFF001000003FFFF, 0xBC00},
-	{0x8010020000000000, 0x8000},
-	{0xC3D0000000000810, 0xFC00},
-	{0x8010040000000000, 0x8000},
-	{0x54CFFFFFFFF7FFFE, 0x7C00},
-	{0x8010080000000000, 0x8000},
-	{0xB8000000008007FF, 0x8000},
-	{0x8010100000000000, 0x8000},
-	{0xC01FFFFFFFFC0007, 0xC800},
-	{0x8010200000000000, 0x8000},
-	{0x002D97C311286065, 0x0000},
-	{0x8010400000000000, 0x8000},
-	{0x404000400000000F, 0x5000},
-	{0x8010800000000000, 0x8000},
-	{0x4020000000000037, 0x4800},
-	{0x8011000000000000, 0x8000},
-	{0xBFCFFFFFE0000040, 0xB400},
-	{0x8012000000000000, 0x8000},
-	{0x3FC000007FEFFFFF, 0x3000},
-	{0x8014000000000000, 0x8000},
-	{0xC1C0400000000002, 0xFC00},
-	{0x8018000000000000, 0x8000},
-	{0x802FFFFFCFFFFFFE, 0x8000},
-	{0x801C000000000000, 0x8000},
-	{0xD3FFBFBFFFFFFFFF, 0xFC00},
-	{0x801E000000000000, 0x8000},
-	{0x48001FF7FFFFFFFE, 0x7C00},
-	{0x801F000000000000, 0x8000},
-	{0x8540002002000000, 0x8000},
-	{0x801F800000000000, 0x8000},
-	{0x8000007BFFFFFFFF, 0x8000},
-	{0x801FC00000000000, 0x8000},
-	{0xFFDFFFFFFDFFFFBF, 0xFC00},
-	{0x801FE00000000000, 0x8000},
-	{0xC02FFFFFFDF80000, 0xCC00},
-	{0x801FF00000000000, 0x8000},
-	{0xC04FFFFFE3FFFFFF, 0xD400},
-	{0x801FF80000000000, 0x8000},
-	{0xBFB0000000000FFB, 0xAC00},
-	{0x801FFC0000000000, 0x8000},
-	{0xBFC00000004000FE, 0xB000},
-	{0x801FFE0000000000, 0x8000},
-	{0x405000000003FFF6, 0x5400},
-	{0x801FFF0000000000, 0x8000},
-	{0xBFC7FFFFFFFF7FFE, 0xB200},
-	{0x801FFF8000000000, 0x8000},
-	{0x406FFFFFFF7F7FFE, 0x5C00},
-	{0x801FFFC000000000, 0x8000},
-	{0xFFEFFFFFFFFFFFFF, 0xFC00},
-	{0x801FFFE000000000, 0x8000},
-	{0x3FAFDFEFFFFFFFFF, 0x2BF8},
-	{0x801FFFF000000000, 0x8000},
-	{0xBFDFF00000000000, 0xB7FC},
-	{0x801FFFF800000000, 0x8000},
-	{0x380FB12DBECA1F65, 0x0000},
-	{0x801FFFFC00000000, 0x8000},
-	{0x34B7FFFFFFFDFFFE, 0x0000},
-	{0x801FFFFE00000000, 0x8000},
-	{0x436AFFFFFFFFFFFF, 0x7C00},
-	{0x801FFFFF00000000, 0x8000},
-	{0xC03FEFFFFFFFFF7F, 0xCFFC},
-	{0x801FFFFF80000000, 0x8000},
-	{0x3C81AA9F2A78313F, 0x0000},
-	{0x801FFFFFC0000000, 0x8000},
-	{0x3FC020000007FFFF, 0x3008},
-	{0x801FFFFFE0000000, 0x8000},
-	{0xC3EFFF00000FFFFF, 0xFC00},
-	{0x801FFFFFF0000000, 0x8000},
-	{0xC2D010000000007E, 0xFC00},
-	{0x801FFFFFF8000000, 0x8000},
-	{0x0000001FFFFFFFBE, 0x0000},
-	{0x801FFFFFFC000000, 0x8000},
-	{0x6903FDFFFFFFFFFF, 0x7C00},
-	{0x801FFFFFFE000000, 0x8000},
-	{0xC3800002000007FF, 0xFC00},
-	{0x801FFFFFFF000000, 0x8000},
-	{0x41C107FFFFFFFFFF, 0x7C00},
-	{0x801FFFFFFF800000, 0x8000},
-	{0xC0CCBA6BF165C5C6, 0xF32F},
-	{0x801FFFFFFFC00000, 0x8000},
-	{0x43EFFFF0000007FF, 0x7C00},
-	{0x801FFFFFFFE00000, 0x8000},
-	{0x6DEFFFFE000003FE, 0x7C00},
-	{0x801FFFFFFFF00000, 0x8000},
-	{0x5BB00000200003FF, 0x7C00},
-	{0x801FFFFFFFF80000, 0x8000},
-	{0x80200001FFFFFFF7, 0x8000},
-	{0x801FFFFFFFFC0000, 0x8000},
-	{0x3FD9E8E4AD8F98F2, 0x367A},
-	{0x801FFFFFFFFE0000, 0x8000},
-	{0xC07000000000FF00, 0xDC00},
-	{0x801FFFFFFFFF0000, 0x8000},
-	{0x43FFFFBFFFFFFFDE, 0x7C00},
-	{0x801FFFFFFFFF8000, 0x8000},
-	{0x3FB6AAAE73EDB72A, 0x2DAB},
-	{0x801FFFFFFFFFC000, 0x8000},
-	{0xDD7080007FFFFFFF, 0xFC00},
-	{0x801FFFFFFFFFE000, 0x8000},
-	{0x3CBFFFFFFEFFFBFE, 0x0000},
-	{0x801FFFFFFFFFF000, 0x8000},
-	{0xFFE0FFFFF7FFFFFF, 0xFC00},
-	{0x801FFFFFFFFFF800, 0x8000},
-	{0x3FB0000000000006, 0x2C00},
-	{0x801FFFFFFFFFFC00, 0x8000},
-	{0x244A6DA4CC83781A, 0x0000},
-	{0x801FFFFFFFFFFE00, 0x8000},
-	{0xB800008000000020, 0x8000},
-	{0x801FFFFFFFFFFF00, 0x8000},
-	{0x3D1FBFFFFC000000, 0x0000},
-	{0x801FFFFFFFFFFF80, 0x8000},
-	{0xC3CFBC0000000000, 0xFC00},
-	{0x801FFFFFFFFFFFC0, 0x8000},
-	{0x3FDB3A803B15DA01, 0x36CF},
-	{0x801FFFFFFFFFFFE0, 0x8000},
-	{0x400000000007FFF0, 0x4000},
-	{0x801FFFFFFFFFFFF0, 0x8000},
-	{0x445FFFFFFFFC000F, 0x7C00},
-	{0x801FFFFFFFFFFFF8, 0x8000},
-	{0xC80D3DCC44E8DBB3, 0xFC00},
-	{0x801FFFFFFFFFFFFC, 0x8000},
-	{0x37F7A356A6C237BB, 0x0000},
-	{0x801FFFFFFFFFFFFE, 0x8000},
-	{0x40AF7FFFFBFFFFFE, 0x6BE0},
-	{0x801FFFFFFFFFFFFF, 0x8000},
-	{0xC3E35A050FDC161F, 0xFC00},
-	{0x801FFFFFFFFFFFFD, 0x8000},
-	{0xC061A04E47BDC5F4, 0xD868},
-	{0x801FFFFFFFFFFFFB, 0x8000},
-	{0x4650001FFFF80000, 0x7C00},
-	{0x801FFFFFFFFFFFF7, 0x8000},
-	{0x40F5FEB7404E1166, 0x7C00},
-	{0x801FFFFFFFFFFFEF, 0x8000},
-	{0xAE3FFFBFFF7FFFFE, 0x8000},
-	{0x801FFFFFFFFFFFDF, 0x8000},
-	{0x3FF0001010000000, 0x3C00},
-	{0x801FFFFFFFFFFFBF, 0x8000},
-	{0x145C00000007FFFF, 0x0000},
-	{0x801FFFFFFFFFFF7F, 0x8000},
-	{0x3FFFFFFFFF0000FF, 0x4000},
-	{0x801FFFFFFFFFFEFF, 0x8000},
-	{0x41D0120000000000, 0x7C00},
-	{0x801FFFFFFFFFFDFF, 0x8000},
-	{0xB81000000400007E, 0x8000},
-	{0x801FFFFFFFFFFBFF, 0x8000},
-	{0xC0300000000003DE, 0xCC00},
-	{0x801FFFFFFFFFF7FF, 0x8000},
-	{0x41FBFFFFFFFFDFFE, 0x7C00},
-	{0x801FFFFFFFFFEFFF, 0x8000},
-	{0xC38FC00003FFFFFF, 0xFC00},
-	{0x801FFFFFFFFFDFFF, 0x8000},
-	{0x404B14CB7FEB31FF, 0x52C5},
-	{0x801FFFFFFFFFBFFF, 0x8000},
-	{0x406F705A23F186A8, 0x5BDC},
-	{0x801FFFFFFFFF7FFF, 0x8000},
-	{0xEA910001FFFFFFFF, 0xFC00},
-	{0x801FFFFFFFFEFFFF, 0x8000},
-	{0x42A00000008001FF, 0x7C00},
-	{0x801FFFFFFFFDFFFF, 0x8000},
-	{0x401FC00000000100, 0x47F0},
-	{0x801FFFFFFFFBFFFF, 0x8000},
-	{0x403E00001FFFFFFF, 0x4F80},
-	{0x801FFFFFFFF7FFFF, 0x8000},
-	{0x447483F3315B5ABC, 0x7C00},
-	{0x801FFFFFFFEFFFFF, 0x8000},
-	{0x7FF000000FFE0000, 0x7E00},
-	{0x801FFFFFFFDFFFFF, 0x8000},
-	{0xD17E46D5B76AB712, 0xFC00},
-	{0x801FFFFFFFBFFFFF, 0x8000},
-	{0x8000000FFFEFFFFE, 0x8000},
-	{0x801FFFFFFF7FFFFF, 0x8000},
-	{0xC0EFFFC000000FFF, 0xFC00},
-	{0x801FFFFFFEFFFFFF, 0x8000},
-	{0xA3443E514A2239B5, 0x8000},
-	{0x801FFFFFFDFFFFFF, 0x8000},
-	{0xBF2FFFFFFFE3FFFE, 0x8C00},
-	{0x801FFFFFFBFFFFFF, 0x8000},
-	{0x3D600001FFFFFFFE, 0x0000},
-	{0x801FFFFFF7FFFFFF, 0x8000},
-	{0xBFDFFFFFFFC0000E, 0xB800},
-	{0x801FFFFFEFFFFFFF, 0x8000},
-	{0xBFB0000001FBFFFE, 0xAC00},
-	{0x801FFFFFDFFFFFFF, 0x8000},
-	{0xBFDFFFFF00003FFF, 0xB800},
-	{0x801FFFFFBFFFFFFF, 0x8000},
-	{0xC7E0C91D560AA842, 0xFC00},
-	{0x801FFFFF7FFFFFFF, 0x8000},
-	{0x40CFFFFFFFDFFFFB, 0x7400},
-	{0x801FFFFEFFFFFFFF, 0x8000},
-	{0xC1F0000001008000, 0xFC00},
-	{0x801FFFFDFFFFFFFF, 0x8000},
-	{0xC00000100000003F, 0xC000},
-	{0x801FFFFBFFFFFFFF, 0x8000},
-	{0x40100007FFFFBFFF, 0x4400},
-	{0x801FFFF7FFFFFFFF, 0x8000},
-	{0xE35000000001FFF7, 0xFC00},
-	{0x801FFFEFFFFFFFFF, 0x8000},
-	{0x43E200001FFFFFFF, 0x7C00},
-	{0x801FFFDFFFFFFFFF, 0x8000},
-	{0x00200000FDFFFFFF, 0x0000},
-	{0x801FFFBFFFFFFFFF, 0x8000},
-	{0x41DFFFFFFFBFFFF8, 0x7C00},
-	{0x801FFF7FFFFFFFFF, 0x8000},
-	{0x5D77FFFFFFFFFF7F, 0x7C00},
-	{0x801FFEFFFFFFFFFF, 0x8000},
-	{0x000FFFEFFFFFFFFE, 0x0000},
-	{0x801FFDFFFFFFFFFF, 0x8000},
-	{0x41DFFF0000FFFFFE, 0x7C00},
-	{0x801FFBFFFFFFFFFF, 0x8000},
-	{0x002FFF03FFFFFFFF, 0x0000},
-	{0x801FF7FFFFFFFFFF, 0x8000},
-	{0x800FFFFFFFC07FFF, 0x8000},
-	{0x801FEFFFFFFFFFFF, 0x8000},
-	{0x800FFFFC000000FE, 0x8000},
-	{0x801FDFFFFFFFFFFF, 0x8000},
-	{0x778FFFFFFFFDFFFF, 0x7C00},
-	{0x801FBFFFFFFFFFFF, 0x8000},
-	{0x381FFFF3FFFFFFFF, 0x0000},
-	{0x801F7FFFFFFFFFFF, 0x8000},
-	{0xC08003FFF8000000, 0xE001},
-	{0x801EFFFFFFFFFFFF, 0x8000},
-	{0x40207FFFFFFF7FFF, 0x4820},
-	{0x801DFFFFFFFFFFFF, 0x8000},
-	{0x43D881D35DC9E50C, 0x7C00},
-	{0x801BFFFFFFFFFFFF, 0x8000},
-	{0x8020001FFFFFFF7F, 0x8000},
-	{0x8017FFFFFFFFFFFF, 0x8000},
-	{0x3FDFFC0000000200, 0x37FF},
-	{0x8013FFFFFFFFFFFF, 0x8000},
-	{0x475FFBFFFFFFFFF0, 0x7C00},
-	{0x8011FFFFFFFFFFFF, 0x8000},
-	{0x3FB01ABAE89DC524, 0x2C07},
-	{0x8010FFFFFFFFFFFF, 0x8000},
-	{0xB81C7DAC00497476, 0x8000},
-	{0x80107FFFFFFFFFFF, 0x8000},
-	{0xB81000000000000A, 0x8000},
-	{0x80103FFFFFFFFFFF, 0x8000},
-	{0xD4900FFF00000000, 0xFC00},
-	{0x80101FFFFFFFFFFF, 0x8000},
-	{0xC07000FFFFFFFFBF, 0xDC00},
-	{0x80100FFFFFFFFFFF, 0x8000},
-	{0xC145FFFFFFFFFFFF, 0xFC00},
-	{0x801007FFFFFFFFFF, 0x8000},
-	{0xB7E000043FFFFFFF, 0x8000},
-	{0x801003FFFFFFFFFF, 0x8000},
-	{0x3FDFFFFFFFBEFFFF, 0x3800},
-	{0x801001FFFFFFFFFF, 0x8000},
-	{0x636ED5DC0E0FC882, 0x7C00},
-	{0x801000FFFFFFFFFF, 0x8000},
-	{0x41800000040007FF, 0x7C00},
-	{0x8010007FFFFFFFFF, 0x8000},
-	{0x405FFFFFFFFFFFEF, 0x5800},
-	{0x8010003FFFFFFFFF, 0x8000},
-	{0x4000000FFFFF7FFE, 0x4000},
-	{0x8010001FFFFFFFFF, 0x8000},
-	{0x3FFE07FFFFFFFFFF, 0x3F82},
-	{0x8010000FFFFFFFFF, 0x8000},
-	{0xC3DF7F7FFFFFFFFE, 0xFC00},
-	{0x80100007FFFFFFFF, 0x8000},
-	{0x3CA0003FFFFFEFFE, 0x0000},
-	{0x80100003FFFFFFFF, 0x8000},
-	{0x3DC01FFFFFDFFFFE, 0x0000},
-	{0x80100001FFFFFFFF, 0x8000},
-	{0x801FFFFFFFBFFFFE, 0x8000},
-	{0x80100000FFFFFFFF, 0x8000},
-	{0x5DD8000000000000, 0x7C00},
-	{0x801000007FFFFFFF, 0x8000},
-	{0xC7EFFF7FFF000000, 0xFC00},
-	{0x801000003FFFFFFF, 0x8000},
-	{0xF9CFFFFFFC000000, 0xFC00},
-	{0x801000001FFFFFFF, 0x8000},
-	{0x41E3A3B51963DAFE, 0x7C00},
-	{0x801000000FFFFFFF, 0x8000},
-	{0xC0403E4FE12E0F4E, 0xD010},
-	{0x8010000007FFFFFF, 0x8000},
-	{0x7FDE77A252661E38, 0x7C00},
-	{0x8010000003FFFFFF, 0x8000},
-	{0x47E0008000007FFF, 0x7C00},
-	{0x8010000001FFFFFF, 0x8000},
-	{0x52A02ABD0509CCC1, 0x7C00},
-	{0x8010000000FFFFFF, 0x8000},
-	{0x402FFDEFFFFFFFFE, 0x4BFF},
-	{0x80100000007FFFFF, 0x8000},
-	{0xB7EFFFF7FFFFEFFF, 0x8000},
-	{0x80100000003FFFFF, 0x8000},
-	{0x3FDFFAFFFFFFFFFF, 0x37FF},
-	{0x80100000001FFFFF, 0x8000},
-	{0x3F3FFFFFFDE00000, 0x1000},
-	{0x80100000000FFFFF, 0x8000},
-	{0x719FFF7FFBFFFFFF, 0x7C00},
-	{0x801000000007FFFF, 0x8000},
-	{0xC0400200000007FF, 0xD001},
-	{0x801000000003FFFF, 0x8000},
-	{0xC3C1C71FD1423723, 0xFC00},
-	{0x801000000001FFFF, 0x8000},
-	{0x43EFFFFE0000003F, 0x7C00},
-	{0x801000000000FFFF, 0x8000},
-	{0x3E5C53F421B9FB08, 0x0000},
-	{0x8010000000007FFF, 0x8000},
-	{0xBF32553E524C08F7, 0x8C95},
-	{0x8010000000003FFF, 0x8000},
-	{0x41FFFC000003FFFF, 0x7C00},
-	{0x8010000000001FFF, 0x8000},
-	{0xC300FFFFFFFFFFE0, 0xFC00},
-	{0x8010000000000FFF, 0x8000},
-	{0x37EFFFDFFFFF0000, 0x0000},
-	{0x80100000000007FF, 0x8000},
-	{0xBDE00000FF7FFFFF, 0x8000},
-	{0x80100000000003FF, 0x8000},
-	{0xC1BFFDFFFFFFFDFF, 0xFC00},
-	{0x80100000000001FF, 0x8000},
-	{0xC05F91E724DD8068, 0xD7E4},
-	{0x80100000000000FF, 0x8000},
-	{0x400FEFFFFFFFFF80, 0x43FC},
-	{0x801000000000007F, 0x8000},
-	{0xC07F8000000003FF, 0xDFE0},
-	{0x801000000000003F, 0x8000},
-	{0xBFC000000007FFE0, 0xB000},
-	{0x801000000000001F, 0x8000},
-	{0xC43868F4BD6B53AB, 0xFC00},
-	{0x801000000000000F, 0x8000},
-	{0x3FF0000000000010, 0x3C00},
-	{0x8010000000000007, 0x8000},
-	{0xC7FFFFFFFFFFFDFF, 0xFC00},
-	{0x8010000000000003, 0x8000},
-	{0x403FDFE000000000, 0x4FF8},
-	{0x8020000000000000, 0x8000},
-	{0x7FFFFF0000008000, 0x7FFF},
-	{0x8020000000000001, 0x8000},
-	{0xFFE4F55815025863, 0xFC00},
-	{0x8020000000000002, 0x8000},
-	{0xC030020001FFFFFF, 0xCC01},
-	{0x8020000000000004, 0x8000},
-	{0x3C40020004000000, 0x0000},
-	{0x8020000000000008, 0x8000},
-	{0xC3FFFFFFF9FFFFFF, 0xFC00},
-	{0x8020000000000010, 0x8000},
-	{0xC1C306ADADFD8243, 0xFC00},
-	{0x8020000000000020, 0x8000},
-	{0x40007FFFFFFFFF7F, 0x4020},
-	{0x8020000000000040, 0x8000},
-	{0x400486D446127E6A, 0x4122},
-	{0x8020000000000080, 0x8000},
-	{0xC25476AC2C4A3C45, 0xFC00},
-	{0x8020000000000100, 0x8000},
-	{0x4800FDFFFFFFFFFF, 0x7C00},
-	{0x8020000000000200, 0x8000},
-	{0x00100007FFDFFFFE, 0x0000},
-	{0x8020000000000400, 0x8000},
-	{0xBB6FFFFEFFFFFFFF, 0x8000},
-	{0x8020000000000800, 0x8000},
-	{0x2A428CD233F43E67, 0x0000},
-	{0x8020000000001000, 0x8000},
-	{0x3FA000002000007E, 0x2800},
-	{0x8020000000002000, 0x8000},
-	{0xC7EFFFFF70000000, 0xFC00},
-	{0x8020000000004000, 0x8000},
-	{0x4258E92FDAFF4D75, 0x7C00},
-	{0x8020000000008000, 0x8000},
-	{0xC02FFAFE62193C64, 0xCBFF},
-	{0x8020000000010000, 0x8000},
-	{0x730BFFFFFFFFFC00, 0x7C00},
-	{0x8020000000020000, 0x8000},
-	{0x547AEDEF0E9B20E7, 0x7C00},
-	{0x8020000000040000, 0x8000},
-	{0x40FFFFFFFFFF7FFF, 0x7C00},
-	{0x8020000000080000, 0x8000},
-	{0xBF8BE00000000000, 0xA2F8},
-	{0x8020000000100000, 0x8000},
-	{0x434000003FFFFFFE, 0x7C00},
-	{0x8020000000200000, 0x8000},
-	{0x3F80003FFFFFFFFF, 0x2000},
-	{0x8020000000400000, 0x8000},
-	{0xC030000003FF0000, 0xCC00},
-	{0x8020000000800000, 0x8000},
-	{0xC1CFFFFFF1FFFFFE, 0xFC00},
-	{0x8020000001000000, 0x8000},
-	{0xC7F10000000007FF, 0xFC00},
-	{0x8020000002000000, 0x8000},
-	{0xC0ECF5B9DC24BDEE, 0xFB3D},
-	{0x8020000004000000, 0x8000},
-	{0xC24F800000000004, 0xFC00},
-	{0x8020000008000000, 0x8000},
-	{0xC157FFFFFFFFFE00, 0xFC00},
-	{0x8020000010000000, 0x8000},
-	{0x3FC01FFDFFFFFFFF, 0x3008},
-	{0x8020000020000000, 0x8000},
-	{0x40010000007FFFFE, 0x4040},
-	{0x8020000040000000, 0x8000},
-	{0x39EFBA398C6F4696, 0x0000},
-	{0x8020000080000000, 0x8000},
-	{0x3FE0F00000000000, 0x383C},
-	{0x8020000100000000, 0x8000},
-	{0xB9100001FFFFFE00, 0x8000},
-	{0x8020000200000000, 0x8000},
-	{0xBF90003FEFFFFFFE, 0xA400},
-	{0x8020000400000000, 0x8000},
-	{0xB819D7D3796F7945, 0x8000},
-	{0x8020000800000000, 0x8000},
-	{0xC06552DC35C2B0A4, 0xD955},
-	{0x8020001000000000, 0x8000},
-	{0x424FFEFFFFFFBFFE, 0x7C00},
-	{0x8020002000000000, 0x8000},
-	{0xC00FC0000000007E, 0xC3F0},
-	{0x8020004000000000, 0x8000},
-	{0xC02FFFFFFFFFF81F, 0xCC00},
-	{0x8020008000000000, 0x8000},
-	{0x3E40801FFFFFFFFF, 0x0000},
-	{0x8020010000000000, 0x8000},
-	{0xBBC7FFDFFFFFFFFE, 0x8000},
-	{0x8020020000000000, 0x8000},
-	{0xC7EFFFFFE0003FFF, 0xFC00},
-	{0x8020040000000000, 0x8000},
-	{0x422E708EC3D28A6D, 0x7C00},
-	{0x8020080000000000, 0x8000},
-	{0xC03FFFC00000003E, 0xD000},
-	{0x8020100000000000, 0x8000},
-	{0xBFEFFFFFFFFF8001, 0xBC00},
-	{0x8020200000000000, 0x8000},
-	{0x41D01DFFFFFFFFFF, 0x7C00},
-	{0x8020400000000000, 0x8000},
-	{0x3FC020000000001E, 0x3008},
-	{0x8020800000000000, 0x8000},
-	{0x3FDAEC8C4557590B, 0x36BB},
-	{0x8021000000000000, 0x8000},
-	{0xBFD0000400003FFF, 0xB400},
-	{0x8022000000000000, 0x8000},
-	{0xB7FFFFFFFBFFF7FE, 0x8000},
-	{0x8024000000000000, 0x8000},
-	{0x5D0FFFFF00000007, 0x7C00},
-	{0x8028000000000000, 0x8000},
-	{0x43FFF9FFFFFFFFFF, 0x7C00},
-	{0x802C000000000000, 0x8000},
-	{0xBF60004000007FFF, 0x9800},
-	{0x802E000000000000, 0x8000},
-	{0xD87A7034680F546D, 0xFC00},
-	{0x802F000000000000, 0x8000},
-	{0x47F403FFFFFFFFFE, 0x7C00},
-	{0x802F800000000000, 0x8000},
-	{0x4207C217172C368F, 0x7C00},
-	{0x802FC00000000000, 0x8000},
-	{0xBFE00000000407FF, 0xB800},
-	{0x802FE00000000000, 0x8000},
-	{0x881000000100000E, 0x8000},
-	{0x802FF00000000000, 0x8000},
-	{0xDC7EFFFFFFDFFFFF, 0xFC00},
-	{0x802FF80000000000, 0x8000},
-	{0xDE8007FFFFFBFFFE, 0xFC00},
-	{0x802FFC0000000000, 0x8000},
-	{0x61AE4E47DD8C61A9, 0x7C00},
-	{0x802FFE0000000000, 0x8000},
-	{0x3FC0000083FFFFFE, 0x3000},
-	{0x802FFF0000000000, 0x8000},
-	{0xBD4B29976D8D44EF, 0x8000},
-	{0x802FFF8000000000, 0x8000},
-	{0xB7EFFFFFC0000010, 0x8000},
-	{0x802FFFC000000000, 0x8000},
-	{0xA11FFFFFC0000000, 0x8000},
-	{0x802FFFE000000000, 0x8000},
-	{0x47EFFFFF7FFC0000, 0x7C00},
-	{0x802FFFF000000000, 0x8000},
-	{0xBEAA5847D9EA3AC9, 0x800D},
-	{0x802FFFF800000000, 0x8000},
-	{0xC3C0001DFFFFFFFE, 0xFC00},
-	{0x802FFFFC00000000, 0x8000},
-	{0xC1F15A8CA2149EC8, 0xFC00},
-	{0x802FFFFE00000000, 0x8000},
-	{0x41C0000000007FBF, 0x7C00},
-	{0x802FFFFF00000000, 0x8000},
-	{0x268FFBFFFFFFFBFF, 0x0000},
-	{0x802FFFFF80000000, 0x8000},
-	{0xBCAFFFF00000001F, 0x8000},
-	{0x802FFFFFC0000000, 0x8000},
-	{0x0010FFFEFFFFFFFE, 0x0000},
-	{0x802FFFFFE0000000, 0x8000},
-	{0xBB21001FFFFFFFFF, 0x8000},
-	{0x802FFFFFF0000000, 0x8000},
-	{0xFFE7D4DB2085C936, 0xFC00},
-	{0x802FFFFFF8000000, 0x8000},
-	{0xCBEEFFF800000000, 0xFC00},
-	{0x802FFFFFFC000000, 0x8000},
-	{0x47FFFFFFFFFFFEEE, 0x7C00},
-	{0x802FFFFFFE000000, 0x8000},
-	{0x403010000FFFFFFE, 0x4C04},
-	{0x802FFFFFFF000000, 0x8000},
-	{0xC050000FFFFFFFFB, 0xD400},
-	{0x802FFFFFFF800000, 0x8000},
-	{0xFFD0080400000000, 0xFC00},
-	{0x802FFFFFFFC00000, 0x8000},
-	{0xBA096191ACF64EA3, 0x8000},
-	{0x802FFFFFFFE00000, 0x8000},
-	{0xC01B5D9E9A723E76, 0xC6D7},
-	{0x802FFFFFFFF00000, 0x8000},
-	{0x3FAFFE0000000004, 0x2C00},
-	{0x802FFFFFFFF80000, 0x8000},
-	{0x47E66CFF940EE227, 0x7C00},
-	{0x802FFFFFFFFC0000, 0x8000},
-	{0xB8106FFFFFFFFFFE, 0x8000},
-	{0x802FFFFFFFFE0000, 0x8000},
-	{0x41C00007FFFFFFE0, 0x7C00},
-	{0x802FFFFFFFFF0000, 0x8000},
-	{0xC02000000001FFFE, 0xC800},
-	{0x802FFFFFFFFF8000, 0x8000},
-	{0xC7E2000000010000, 0xFC00},
-	{0x802FFFFFFFFFC000, 0x8000},
-	{0x000FFFFFFFFFFFF4, 0x0000},
-	{0x802FFFFFFFFFE000, 0x8000},
-	{0xBFA712C1855C8BDD, 0xA9C5},
-	{0x802FFFFFFFFFF000, 0x8000},
-	{0xFFDDA0095078D9A4, 0xFC00},
-	{0x802FFFFFFFFFF800, 0x8000},
-	{0x3F75A70F61C4A180, 0x1D6A},
-	{0x802FFFFFFFFFFC00, 0x8000},
-	{0x3F3FFFFFFFC001FE, 0x1000},
-	{0x802FFFFFFFFFFE00, 0x8000},
-	{0xC3DFFFFFF7FFFF7F, 0xFC00},
-	{0x802FFFFFFFFFFF00, 0x8000},
-	{0xC7F1000000000007, 0xFC00},
-	{0x802FFFFFFFFFFF80, 0x8000},
-	{0x800FFFFFFEFFFBFF, 0x8000},
-	{0x802FFFFFFFFFFFC0, 0x8000},
-	{0xC1C0008000007FFF, 0xFC00},
-	{0x802FFFFFFFFFFFE0, 0x8000},
-	{0x43D0000001F00000, 0x7C00},
-	{0x802FFFFFFFFFFFF0, 0x8000},
-	{0xECB6529EBDF9D81F, 0xFC00},
-	{0x802FFFFFFFFFFFF8, 0x8000},
-	{0x40EFFFDC00000000, 0x7C00},
-	{0x802FFFFFFFFFFFFC, 0x8000},
-	{0x3FD000001FFFFDFE, 0x3400},
-	{0x802FFFFFFFFFFFFE, 0x8000},
-	{0xC1CFFFFFFFC0007E, 0xFC00},
-	{0x802FFFFFFFFFFFFF, 0x8000},
-	{0x1794DD35CEF24457, 0x0000},
-	{0x802FFFFFFFFFFFFD, 0x8000},
-	{0xC69FFFFFFFFFFFFF, 0xFC00},
-	{0x802FFFFFFFFFFFFB, 0x8000},
-	{0xC1F00003FFFDFFFF, 0xFC00},
-	{0x802FFFFFFFFFFFF7, 0x8000},
-	{0xBCC007FFFFFFFEFF, 0x8000},
-	{0x802FFFFFFFFFFFEF, 0x8000},
-	{0xB7E001FFFFFFFFFC, 0x8000},
-	{0x802FFFFFFFFFFFDF, 0x8000},
-	{0x381F7BFFFFFFFFFE, 0x0000},
-	{0x802FFFFFFFFFFFBF, 0x8000},
-	{0xB7FFF7FFFBFFFFFE, 0x8000},
-	{0x802FFFFFFFFFFF7F, 0x8000},
-	{0xD07FFFFFFFFFFFDF, 0xFC00},
-	{0x802FFFFFFFFFFEFF, 0x8000},
-	{0xBE7FFDFFFFFFFFFE, 0x8002},
-	{0x802FFFFFFFFFFDFF, 0x8000},
-	{0xBFCECBB677180D60, 0xB3B3},
-	{0x802FFFFFFFFFFBFF, 0x8000},
-	{0xB7E76D07EB40D577, 0x8000},
-	{0x802FFFFFFFFFF7FF, 0x8000},
-	{0x31600000FFFBFFFE, 0x0000},
-	{0x802FFFFFFFFFEFFF, 0x8000},
-	{0xC1F8F3BF010ECE3A, 0xFC00},
-	{0x802FFFFFFFFFDFFF, 0x8000},
-	{0xF23FFFFFFFF7FFFD, 0xFC00},
-	{0x802FFFFFFFFFBFFF, 0x8000},
-	{0xC000000000002040, 0xC000},
-	{0x802FFFFFFFFF7FFF, 0x8000},
-	{0x37E0001FFFBFFFFE, 0x0000},
-	{0x802FFFFFFFFEFFFF, 0x8000},
-	{0x8010007FFFFFFF7E, 0x8000},
-	{0x802FFFFFFFFDFFFF, 0x8000},
-	{0x36F0000000800004, 0x0000},
-	{0x802FFFFFFFFBFFFF, 0x8000},
-	{0x7FDFFFFBFFFE0000, 0x7C00},
-	{0x802FFFFFFFF7FFFF, 0x8000},
-	{0x3F2000200000007F, 0x0800},
-	{0x802FFFFFFFEFFFFF, 0x8000},
-	{0xBFD000004FFFFFFE, 0xB400},
-	{0x802FFFFFFFDFFFFF, 0x8000},
-	{0xC3E0003FFFFFFFBE, 0xFC00},
-	{0x802FFFFFFFBFFFFF, 0x8000},
-	{0xC1CFF80000000100, 0xFC00},
-	{0x802FFFFFFF7FFFFF, 0x8000},
-	{0x41FFFFF800008000, 0x7C00},
-	{0x802FFFFFFEFFFFFF, 0x8000},
-	{0x400FFFFC0000000F, 0x4400},
-	{0x802FFFFFFDFFFFFF, 0x8000},
-	{0x0E5FFFFFFC000002, 0x0000},
-	{0x802FFFFFFBFFFFFF, 0x8000},
-	{0xBFD000000000010E, 0xB400},
-	{0x802FFFFFF7FFFFFF, 0x8000},
-	{0xBEB00000007FF000, 0x8010},
-	{0x802FFFFFEFFFFFFF, 0x8000},
-	{0x25B1FFFFFFFFF800, 0x0000},
-	{0x802FFFFFDFFFFFFF, 0x8000},
-	{0xB7FFFFBFFFFFFFFC, 0x8000},
-	{0x802FFFFFBFFFFFFF, 0x8000},
-	{0x7FD42B4EB3071268, 0x7C00},
-	{0x802FFFFF7FFFFFFF, 0x8000},
-	{0xBFDEFFFFFFFFFFF0, 0xB7C0},
-	{0x802FFFFEFFFFFFFF, 0x8000},
-	{0x7FD01FFFFFBFFFFF, 0x7C00},
-	{0x802FFFFDFFFFFFFF, 0x8000},
-	{0x4032000080000000, 0x4C80},
-	{0x802FFFFBFFFFFFFF, 0x8000},
-	{0x32E400000001FFFE, 0x0000},
-	{0x802FFFF7FFFFFFFF, 0x8000},
-	{0x4B8000200000FFFE, 0x7C00},
-	{0x802FFFEFFFFFFFFF, 0x8000},
-	{0xC3BC956B2A435B9F, 0xFC00},
-	{0x802FFFDFFFFFFFFF, 0x8000},
-	{0x37E00005FFFFFFFF, 0x0000},
-	{0x802FFFBFFFFFFFFF, 0x8000},
-	{0x3FE00000043FFFFF, 0x3800},
-	{0x802FFF7FFFFFFFFF, 0x8000},
-	{0xFFFFFFFFFFFFFE0F, 0xFFFF},
-	{0x802FFEFFFFFFFFFF, 0x8000},
-	{0xC0B004000FFFFFFF, 0xEC01},
-	{0x802FFDFFFFFFFFFF, 0x8000},
-	{0x41D0001FFFFFFFF7, 0x7C00},
-	{0x802FFBFFFFFFFFFF, 0x8000},
-	{0x434FFFFFFFFFF100, 0x7C00},
-	{0x802FF7FFFFFFFFFF, 0x8000},
-	{0xC120000000000017, 0xFC00},
-	{0x802FEFFFFFFFFFFF, 0x8000},
-	{0xBAAFFFFFFFEFFFF8, 0x8000},
-	{0x802FDFFFFFFFFFFF, 0x8000},
-	{0x4011FFFFFFFFFFBF, 0x4480},
-	{0x802FBFFFFFFFFFFF, 0x8000},
-	{0x47E00001FFFFFFFF, 0x7C00},
-	{0x802F7FFFFFFFFFFF, 0x8000},
-	{0x380446E493185FC5, 0x0000},
-	{0x802EFFFFFFFFFFFF, 0x8000},
-	{0xB7EE489420185E5D, 0x8000},
-	{0x802DFFFFFFFFFFFF, 0x8000},
-	{0x3D042EB6C31836A0, 0x0000},
-	{0x802BFFFFFFFFFFFF, 0x8000},
-	{0xC026243148B44991, 0xC989},
-	{0x8027FFFFFFFFFFFF, 0x8000},
-	{0x3F5FFFFFFFFC01FE, 0x1800},
-	{0x8023FFFFFFFFFFFF, 0x8000},
-	{0xC013A756FD904A2A, 0xC4EA},
-	{0x8021FFFFFFFFFFFF, 0x8000},
-	{0x46AFFFDFFFF7FFFE, 0x7C00},
-	{0x8020FFFFFFFFFFFF, 0x8000},
-	{0x3FFFFFFEFFFFFF7F, 0x4000},
-	{0x80207FFFFFFFFFFF, 0x8000},
-	{0xBCA0000000000801, 0x8000},
-	{0x80203FFFFFFFFFFF, 0x8000},
-	{0xBF80000000010007, 0xA000},
-	{0x80201FFFFFFFFFFF, 0x8000},
-	{0x8013FFFFFFFEFFFF, 0x8000},
-	{0x80200FFFFFFFFFFF, 0x8000},
-	{0x3FFBC71C78B801B5, 0x3EF2},
-	{0x802007FFFFFFFFFF, 0x8000},
-	{0x43C0000000001002, 0x7C00},
-	{0x802003FFFFFFFFFF, 0x8000},
-	{0x3810000003FFFFF0, 0x0000},
-	{0x802001FFFFFFFFFF, 0x8000},
-	{0xC03FFFFC40000000, 0xD000},
-	{0x802000FFFFFFFFFF, 0x8000},
-	{0xC1DA423F49DD8103, 0xFC00},
-	{0x8020007FFFFFFFFF, 0x8000},
-	{0x381FFC00007FFFFF, 0x0000},
-	{0x8020003FFFFFFFFF, 0x8000},
-	{0x80044E3B9B4E467B, 0x8000},
-	{0x8020001FFFFFFFFF, 0x8000},
-	{0xC3C0D83AF8778960, 0xFC00},
-	{0x8020000FFFFFFFFF, 0x8000},
-	{0xBFD0007F7FFFFFFF, 0xB400},
-	{0x80200007FFFFFFFF, 0x8000},
-	{0xC1DFFF0003FFFFFE, 0xFC00},
-	{0x80200003FFFFFFFF, 0x8000},
-	{0xC59F7A94AA1C472C, 0xFC00},
-	{0x80200001FFFFFFFF, 0x8000},
-	{0xB5400FFFFDFFFFFE, 0x8000},
-	{0x80200000FFFFFFFF, 0x8000},
-	{0x001F3BD8594328F1, 0x0000},
-	{0x802000007FFFFFFF, 0x8000},
-	{0x41E0000200000020, 0x7C00},
-	{0x802000003FFFFFFF, 0x8000},
-	{0xD6D00000FFFFFFF6, 0xFC00},
-	{0x802000001FFFFFFF, 0x8000},
-	{0xB8D0000005FFFFFF, 0x8000},
-	{0x802000000FFFFFFF, 0x8000},
-	{0xBFD40D92DB0B74C8, 0xB503},
-	{0x8020000007FFFFFF, 0x8000},
-	{0xB7F7FFFFFFFE0000, 0x8000},
-	{0x8020000003FFFFFF, 0x8000},
-	{0xC3FFFFFFC00007FE, 0xFC00},
-	{0x8020000001FFFFFF, 0x8000},
-	{0xC3EFFFFDFFFFBFFF, 0xFC00},
-	{0x8020000000FFFFFF, 0x8000},
-	{0x3F9101FFFFFFFFFF, 0x2440},
-	{0x80200000007FFFFF, 0x8000},
-	{0xBFDFC0001FFFFFFE, 0xB7F0},
-	{0x80200000003FFFFF, 0x8000},
-	{0xDEA003EFFFFFFFFF, 0xFC00},
-	{0x80200000001FFFFF, 0x8000},
-	{0x41EF97C65166B985, 0x7C00},
-	{0x80200000000FFFFF, 0x8000},
-	{0x4340000000047FFE, 0x7C00},
-	{0x802000000007FFFF, 0x8000},
-	{0xBD80000000000FBF, 0x8000},
-	{0x802000000003FFFF, 0x8000},
-	{0x400FFFFFFFAFFFFE, 0x4400},
-	{0x802000000001FFFF, 0x8000},
-	{0x4CF2000001FFFFFE, 0x7C00},
-	{0x802000000000FFFF, 0x8000},
-	{0xC061B6238CFC4352, 0xD86E},
-	{0x8020000000007FFF, 0x8000},
-	{0xB7F0000000060000, 0x8000},
-	{0x8020000000003FFF, 0x8000},
-	{0x43FFFFEFFEFFFFFE, 0x7C00},
-	{0x8020000000001FFF, 0x8000},
-	{0x3FDEFFFFFDFFFFFE, 0x37C0},
-	{0x8020000000000FFF, 0x8000},
-	{0xC011B31682EA62B5, 0xC46D},
-	{0x80200000000007FF, 0x8000},
-	{0xC0FFFFE004000000, 0xFC00},
-	{0x80200000000003FF, 0x8000},
-	{0xC3F000021FFFFFFF, 0xFC00},
-	{0x80200000000001FF, 0x8000},
-	{0xFFEFFFFFFFFFFEFC, 0xFC00},
-	{0x80200000000000FF, 0x8000},
-	{0xC01000000003C000, 0xC400},
-	{0x802000000000007F, 0x8000},
-	{0xBF30010000000000, 0x8C00},
-	{0x802000000000003F, 0x8000},
-	{0x3C0FFFFF8000000F, 0x0000},
-	{0x802000000000001F, 0x8000},
-	{0xBFD0002000FFFFFF, 0xB400},
-	{0x802000000000000F, 0x8000},
-	{0x41E52CCD58DFC654, 0x7C00},
-	{0x8020000000000007, 0x8000},
-	{0xBFFFFFFFFFFC0020, 0xC000},
-	{0x8020000000000003, 0x8000},
-	{0x800561C9BEBCE8D1, 0x8000},
-	{0xB7E0000000000000, 0x8000},
-	{0x4000003FFFFFF7FF, 0x4000},
-	{0xB7E0000000000001, 0x8000},
-	{0x0011FFFFFFFDFFFF, 0x0000},
-	{0xB7E0000000000002, 0x8000},
-	{0xBBA00003FFFFFF80, 0x8000},
-	{0xB7E0000000000004, 0x8000},
-	{0x400C227D3BC17491, 0x4309},
-	{0xB7E0000000000008, 0x8000},
-	{0x43BF7BFFFFFFFFFE, 0x7C00},
-	{0xB7E0000000000010, 0x8000},
-	{0xFFF00000800007FF, 0xFE00},
-	{0xB7E0000000000020, 0x8000},
-	{0xFFFFFFFA00000000, 0xFFFF},
-	{0xB7E0000000000040, 0x8000},
-	{0x47FFFFFFF9FFFFFE, 0x7C00},
-	{0xB7E0000000000080, 0x8000},
-	{0xBFF0000000000010, 0xBC00},
-	{0xB7E0000000000100, 0x8000},
-	{0x3B4FFDFFFFFFFFF0, 0x0000},
-	{0xB7E0000000000200, 0x8000},
-	{0x47F000000001FC00, 0x7C00},
-	{0xB7E0000000000400, 0x8000},
-	{0x401FFFFF0FFFFFFF, 0x4800},
-	{0xB7E0000000000800, 0x8000},
-	{0xBCA1000000020000, 0x8000},
-	{0xB7E0000000001000, 0x8000},
-	{0x41DFFFFFFFFFF900, 0x7C00},
-	{0xB7E0000000002000, 0x8000},
-	{0xC2D6A8B428D41D45, 0xFC00},
-	{0xB7E0000000004000, 0x8000},
-	{0xC0703FFFFFFC0000, 0xDC10},
-	{0xB7E0000000008000, 0x8000},
-	{0xC1DFF8007FFFFFFF, 0xFC00},
-	{0xB7E0000000010000, 0x8000},
-	{0xC1FFFFF8001FFFFF, 0xFC00},
-	{0xB7E0000000020000, 0x8000},
-	{0xC34FFFFEFFEFFFFF, 0xFC00},
-	{0xB7E0000000040000, 0x8000},
-	{0x58E0001000004000, 0x7C00},
-	{0xB7E0000000080000, 0x8000},
-	{0x41E0687E0938F7B9, 0x7C00},
-	{0xB7E0000000100000, 0x8000},
-	{0xAFCBFFFFFBFFFFFF, 0x8000},
-	{0xB7E0000000200000, 0x8000},
-	{0x0000000200000400, 0x0000},
-	{0xB7E0000000400000, 0x8000},
-	{0x4020000000042000, 0x4800},
-	{0xB7E0000000800000, 0x8000},
-	{0x43DFFFC00000007E, 0x7C00},
-	{0xB7E0000001000000, 0x8000},
-	{0xC02F5B067C95E8A8, 0xCBD7},
-	{0xB7E0000002000000, 0x8000},
-	{0xBFE0000200000007, 0xB800},
-	{0xB7E0000004000000, 0x8000},
-	{0xC07FFFFFF5FFFFFF, 0xE000},
-	{0xB7E0000008000000, 0x8000},
-	{0x41C00000000FDFFE, 0x7C00},
-	{0xB7E0000010000000, 0x8000},
-	{0xBFC65C8770DD93F7, 0xB197},
-	{0xB7E0000020000000, 0x8000},
-	{0xC0287DD6B11C36D6, 0xCA1F},
-	{0xB7E0000040000000, 0x8000},
-	{0x41CFFFFFFF7FFFC0, 0x7C00},
-	{0xB7E0000080000000, 0x8000},
-	{0x3FE0000800000080, 0x3800},
-	{0xB7E0000100000000, 0x8000},
-	{0x434F007FFFFFFFFF, 0x7C00},
-	{0xB7E0000200000000, 0x8000},
-	{0xC25FBF0000000000, 0xFC00},
-	{0xB7E0000400000000, 0x8000},
-	{0x549001FFFBFFFFFF, 0x7C00},
-	{0xB7E0000800000000, 0x8000},
-	{0xC004F62DFDB1477B, 0xC13E},
-	{0xB7E0001000000000, 0x8000},
-	{0x2730000002000002, 0x0000},
-	{0xB7E0002000000000, 0x8000},
-	{0xC01F6A0947B244D4, 0xC7DB},
-	{0xB7E0004000000000, 0x8000},
-	{0x7FDFFFFFFF0003FF, 0x7C00},
-	{0xB7E0008000000000, 0x8000},
-	{0xFDAFB0B6F1003974, 0xFC00},
-	{0xB7E0010000000000, 0x8000},
-	{0x40D75D9EF6A16F83, 0x75D7},
-	{0xB7E0020000000000, 0x8000},
-	{0xBFC000040000FFFF, 0xB000},
-	{0xB7E0040000000000, 0x8000},
-	{0xBFDE00001FFFFFFF, 0xB780},
-	{0xB7E0080000000000, 0x8000},
-	{0xBFE000000001003F, 0xB800},
-	{0xB7E0100000000000, 0x8000},
-	{0x2D3C9AF71356938A, 0x0000},
-	{0xB7E0200000000000, 0x8000},
-	{0xB9EDDAD7C0BD1A24, 0x8000},
-	{0xB7E0400000000000, 0x8000},
-	{0xA2201FFBFFFFFFFF, 0x8000},
-	{0xB7E0800000000000, 0x8000},
-	{0x3FE8CB74668F6A80, 0x3A33},
-	{0xB7E1000000000000, 0x8000},
-	{0x38000007FFF7FFFF, 0x0000},
-	{0xB7E2000000000000, 0x8000},
-	{0xC80FFFFDFFFFFFF6, 0xFC00},
-	{0xB7E4000000000000, 0x8000},
-	{0x3F8000000000013F, 0x2000},
-	{0xB7E8000000000000, 0x8000},
-	{0x38840BD1413B8503, 0x0000},
-	{0xB7EC000000000000, 0x8000},
-	{0x3FF6E0113A3DC029, 0x3DB8},
-	{0xB7EE000000000000, 0x8000},
-	{0xC011C232FFF3E8E3, 0xC471},
-	{0xB7EF000000000000, 0x8000},
-	{0xC3CFFFFFFEFFFFF6, 0xFC00},
-	{0xB7EF800000000000, 0x8000},
-	{0xBFB0100010000000, 0xAC04},
-	{0xB7EFC00000000000, 0x8000},
-	{0x47F2DC951DB53AFE, 0x7C00},
-	{0xB7EFE00000000000, 0x8000},
-	{0xA6C00001000003FF, 0x8000},
-	{0xB7EFF00000000000, 0x8000},
-	{0x3E90000003FF0000, 0x0004},
-	{0xB7EFF80000000000, 0x8000},
-	{0x41D261A8DAAD550D, 0x7C00},
-	{0xB7EFFC0000000000, 0x8000},
-	{0xF89FFFFFFF1FFFFF, 0xFC00},
-	{0xB7EFFE0000000000, 0x8000},
-	{0x43EAAE75247C6C67, 0x7C00},
-	{0xB7EFFF0000000000, 0x8000},
-	{0xB82FFF7FDFFFFFFF, 0x8000},
-	{0xB7EFFF8000000000, 0x8000},
-	{0xC01FFFFFFF7FE000, 0xC800},
-	{0xB7EFFFC000000000, 0x8000},
-	{0x3FEFFFFFF87FFFFF, 0x3C00},
-	{0xB7EFFFE000000000, 0x8000},
-	{0x3810003000000000, 0x0000},
-	{0xB7EFFFF000000000, 0x8000},
-	{0x6440000500000000, 0x7C00},
-	{0xB7EFFFF800000000, 0x8000},
-	{0x42FFFFFFFF00FFFF, 0x7C00},
-	{0xB7EFFFFC00000000, 0x8000},
-	{0xC3FB28DB8F058F54, 0xFC00},
-	{0xB7EFFFFE00000000, 0x8000},
-	{0xC07006FFFFFFFFFF, 0xDC02},
-	{0xB7EFFFFF00000000, 0x8000},
-	{0x41E0000001DFFFFF, 0x7C00},
-	{0xB7EFFFFF80000000, 0x8000},
-	{0x7FF000000001F7FF, 0x7E00},
-	{0xB7EFFFFFC0000000, 0x8000},
-	{0xFFEFFF80000000FF, 0xFC00},
-	{0xB7EFFFFFE0000000, 0x8000},
-	{0xC1EFFFF00000001F, 0xFC00},
-	{0xB7EFFFFFF0000000, 0x8000},
-	{0xBFC0001003FFFFFF, 0xB000},
-	{0xB7EFFFFFF8000000, 0x8000},
-	{0xC2C3F98C49E92BB5, 0xFC00},
-	{0xB7EFFFFFFC000000, 0x8000},
-	{0xC7F0000004007FFE, 0xFC00},
-	{0xB7EFFFFFFE000000, 0x8000},
-	{0x39A97525BF74F49D, 0x0000},
-	{0xB7EFFFFFFF000000, 0x8000},
-	{0xBC10000000F00000, 0x8000},
-	{0xB7EFFFFFFF800000, 0x8000},
-	{0x3808D3E488C63B32, 0x0000},
-	{0xB7EFFFFFFFC00000, 0x8000},
-	{0x43590C23C02175BA, 0x7C00},
-	{0xB7EFFFFFFFE00000, 0x8000},
-	{0xB7FFFFFFC0FFFFFE, 0x8000},
-	{0xB7EFFFFFFFF00000, 0x8000},
-	{0xFFECC5ECE81DA3CE, 0xFC00},
-	{0xB7EFFFFFFFF80000, 0x8000},
-	{0x47E400000000007E, 0x7C00},
-	{0xB7EFFFFFFFFC0000, 0x8000},
-	{0xC1EDFFFFFFFFFFF8, 0xFC00},
-	{0xB7EFFFFFFFFE0000, 0x8000},
-	{0x3FB728AAB210EE5B, 0x2DCA},
-	{0xB7EFFFFFFFFF0000, 0x8000},
-	{0x400100000000003F, 0x4040},
-	{0xB7EFFFFFFFFF8000, 0x8000},
-	{0x000FFFFFFFFFFF5F, 0x0000},
-	{0xB7EFFFFFFFFFC000, 0x8000},
-	{0xB7F96942F1A4EE72, 0x8000},
-	{0xB7EFFFFFFFFFE000, 0x8000},
-	{0x33AFA26F781B9EA6, 0x0000},
-	{0xB7EFFFFFFFFFF000, 0x8000},
-	{0x20100080000FFFFF, 0x0000},
-	{0xB7EFFFFFFFFFF800, 0x8000},
-	{0x3CCA55D8A058A0E5, 0x0000},
-	{0xB7EFFFFFFFFFFC00, 0x8000},
-	{0xBFEFFFFFFFE00003, 0xBC00},
-	{0xB7EFFFFFFFFFFE00, 0x8000},
-	{0xC1F007FF7FFFFFFF, 0xFC00},
-	{0xB7EFFFFFFFFFFF00, 0x8000},
-	{0xC01FC0000003FFFF, 0xC7F0},
-	{0xB7EFFFFFFFFFFF80, 0x8000},
-	{0xC0300FFFBFFFFFFE, 0xCC04},
-	{0xB7EFFFFFFFFFFFC0, 0x8000},
-	{0x3F6E0000000000FE, 0x1B80},
-	{0xB7EFFFFFFFFFFFE0, 0x8000},
-	{0x42C7FFFFFFF00000, 0x7C00},
-	{0xB7EFFFFFFFFFFFF0, 0x8000},
-	{0xC025CA3B010DF0B1, 0xC973},
-	{0xB7EFFFFFFFFFFFF8, 0x8000},
-	{0x400FFFFFFE07FFFE, 0x4400},
-	{0xB7EFFFFFFFFFFFFC, 0x8000},
-	{0x43AFFFDFF0000000, 0x7C00},
-	{0xB7EFFFFFFFFFFFFE, 0x8000},
-	{0x401200000000FFFF, 0x4480},
-	{0xB7EFFFFFFFFFFFFF, 0x8000},
-	{0xBFDFFFFEFFFFFF7F, 0xB800},
-	{0xB7EFFFFFFFFFFFFD, 0x8000},
-	{0xBF3F000000000010, 0x8FC0},
-	{0xB7EFFFFFFFFFFFFB, 0x8000},
-	{0xC03000007FFFF800, 0xCC00},
-	{0xB7EFFFFFFFFFFFF7, 0x8000},
-	{0x313C5BAC10F116AD, 0x0000},
-	{0xB7EFFFFFFFFFFFEF, 0x8000},
-	{0x000FFFBFFFEFFFFE, 0x0000},
-	{0xB7EFFFFFFFFFFFDF, 0x8000},
-	{0xC03D63DADD6D6D1F, 0xCF59},
-	{0xB7EFFFFFFFFFFFBF, 0x8000},
-	{0x403897851611F397, 0x4E26},
-	{0xB7EFFFFFFFFFFF7F, 0x8000},
-	{0x41EFFFFFFF7FFF7F, 0x7C00},
-	{0xB7EFFFFFFFFFFEFF, 0x8000},
-	{0x5A2FFFFFFFFFFFFF, 0x7C00},
-	{0xB7EFFFFFFFFFFDFF, 0x8000},
-	{0xC0100020000003FF, 0xC400},
-	{0xB7EFFFFFFFFFFBFF, 0x8000},
-	{0x802000000000047F, 0x8000},
-	{0xB7EFFFFFFFFFF7FF, 0x8000},
-	{0xC03FFFFFFEFBFFFF, 0xD000},
-	{0xB7EFFFFFFFFFEFFF, 0x8000},
-	{0xC008000000800000, 0xC200},
-	{0xB7EFFFFFFFFFDFFF, 0x8000},
-	{0xC01000000FFFFEFF, 0xC400},
-	{0xB7EFFFFFFFFFBFFF, 0x8000},
-	{0x9CE1FFFFFFFFEFFE, 0x8000},
-	{0xB7EFFFFFFFFF7FFF, 0x8000},
-	{0x8017F246AEB69C2F, 0x8000},
-	{0xB7EFFFFFFFFEFFFF, 0x8000},
-	{0x40200000000FFFFD, 0x4800},
-	{0xB7EFFFFFFFFDFFFF, 0x8000},
-	{0x44000001007FFFFE, 0x7C00},
-	{0xB7EFFFFFFFFBFFFF, 0x8000},
-	{0x0C0FFFFFDFDFFFFF, 0x0000},
-	{0xB7EFFFFFFFF7FFFF, 0x8000},
-	{0xB872E52608D35D68, 0x8000},
-	{0xB7EFFFFFFFEFFFFF, 0x8000},
-	{0x3FEFF00000000800, 0x3BFC},
-	{0xB7EFFFFFFFDFFFFF, 0x8000},
-	{0x4022E9BEA641AF30, 0x48BA},
-	{0xB7EFFFFFFFBFFFFF, 0x8000},
-	{0x40296AFEF12DF2B7, 0x4A5B},
-	{0xB7EFFFFFFF7FFFFF, 0x8000},
-	{0xCF455E618BC14CD9, 0xFC00},
-	{0xB7EFFFFFFEFFFFFF, 0x8000},
-	{0x41C42D2974B69C4A, 0x7C00},
-	{0xB7EFFFFFFDFFFFFF, 0x8000},
-	{0xC0F1E6B60842509E, 0xFC00},
-	{0xB7EFFFFFFBFFFFFF, 0x8000},
-	{0x4340000000081FFF, 0x7C00},
-	{0xB7EFFFFFF7FFFFFF, 0x8000},
-	{0xBFF00000003F7FFF, 0xBC00},
-	{0xB7EFFFFFEFFFFFFF, 0x8000},
-	{0xBE2EFFFFFFFFFFEE, 0x8000},
-	{0xB7EFFFFFDFFFFFFF, 0x8000},
-	{0xC66FE000000001FF, 0xFC00},
-	{0xB7EFFFFFBFFFFFFF, 0x8000},
-	{0x402FFFF000007FFF, 0x4C00},
-	{0xB7EFFFFF7FFFFFFF, 0x8000},
-	{0xC09FFFFFFFF9FFFF, 0xE800},
-	{0xB7EFFFFEFFFFFFFF, 0x8000},
-	{0xC2BFFFFFFFDFDFFE, 0xFC00},
-	{0xB7EFFFFDFFFFFFFF, 0x8000},
-	{0x5AA0000040007FFF, 0x7C00},
-	{0xB7EFFFFBFFFFFFFF, 0x8000},
-	{0x00200AD02C977169, 0x0000},
-	{0xB7EFFFF7FFFFFFFF, 0x8000},
-	{0x40F27C497D87593B, 0x7C00},
-	{0xB7EFFFEFFFFFFFFF, 0x8000},
-	{0xC1E4EC9D6AD002B0, 0xFC00},
-	{0xB7EFFFDFFFFFFFFF, 0x8000},
-	{0xA38FFFE01FFFFFFE, 0x8000},
-	{0xB7EFFFBFFFFFFFFF, 0x8000},
-	{0x3FBFFFDFFFFFFFE0, 0x3000},
-	{0xB7EFFF7FFFFFFFFF, 0x8000},
-	{0x3FC0000000000107, 0x3000},
-	{0xB7EFFEFFFFFFFFFF, 0x8000},
-	{0x4018A5263AD9172E, 0x4629},
-	{0xB7EFFDFFFFFFFFFF, 0x8000},
-	{0x43FFF00003FFFFFF, 0x7C00},
-	{0xB7EFFBFFFFFFFFFF, 0x8000},
-	{0x434000000001FFF7, 0x7C00},
-	{0xB7EFF7FFFFFFFFFF, 0x8000},
-	{0xC1F000107FFFFFFE, 0xFC00},
-	{0xB7EFEFFFFFFFFFFF, 0x8000},
-	{0xC1F003EFFFFFFFFF, 0xFC00},
-	{0xB7EFDFFFFFFFFFFF, 0x8000},
-	{0xC0AD18745372527F, 0xEB46},
-	{0xB7EFBFFFFFFFFFFF, 0x8000},
-	{0xBED00FFFFFFFFFFC, 0x8040},
-	{0xB7EF7FFFFFFFFFFF, 0x8000},
-	{0xB81FFFFFFFFFFF9F, 0x8000},
-	{0xB7EEFFFFFFFFFFFF, 0x8000},
-	{0xC1C0000007FFFFDF, 0xFC00},
-	{0xB7EDFFFFFFFFFFFF, 0x8000},
-	{0x403D72A064672BB5, 0x4F5D},
-	{0xB7EBFFFFFFFFFFFF, 0x8000},
-	{0x8B10800000400000, 0x8000},
-	{0xB7E7FFFFFFFFFFFF, 0x8000},
-	{0xC0DFFFFFF3FFFFFF, 0xF800},
-	{0xB7E3FFFFFFFFFFFF, 0x8000},
-	{0xC1600006FFFFFFFE, 0xFC00},
-	{0xB7E1FFFFFFFFFFFF, 0x8000},
-	{0x7FE001007FFFFFFF, 0x7C00},
-	{0xB7E0FFFFFFFFFFFF, 0x8000},
-	{0x41E0000FFF800000, 0x7C00},
-	{0xB7E07FFFFFFFFFFF, 0x8000},
-	{0xC7EFFFE0007FFFFF, 0xFC00},
-	{0xB7E03FFFFFFFFFFF, 0x8000},
-	{0x480FFFFBFEFFFFFF, 0x7C00},
-	{0xB7E01FFFFFFFFFFF, 0x8000},
-	{0x3FB742B7D53222DA, 0x2DD1},
-	{0xB7E00FFFFFFFFFFF, 0x8000},
-	{0xC1F6F45348BEA402, 0xFC00},
-	{0xB7E007FFFFFFFFFF, 0x8000},
-	{0xC07FFFFFFFFFFBEF, 0xE000},
-	{0xB7E003FFFFFFFFFF, 0x8000},
-	{0xBFFFFFFFFFFFE7FE, 0xC000},
-	{0xB7E001FFFFFFFFFF, 0x8000},
-	{0xBFFFC00040000000, 0xBFF0},
-	{0xB7E000FFFFFFFFFF, 0x8000},
-	{0x3F6FFFFFFFC0001F, 0x1C00},
-	{0xB7E0007FFFFFFFFF, 0x8000},
-	{0xB7ED902B469A9D0B, 0x8000},
-	{0xB7E0003FFFFFFFFF, 0x8000},
-	{0x4027C1CC71952CAD, 0x49F0},
-	{0xB7E0001FFFFFFFFF, 0x8000},
-	{0x3810000000000403, 0x0000},
-	{0xB7E0000FFFFFFFFF, 0x8000},
-	{0xC8DFFFFFFFFFFFFF, 0xFC00},
-	{0xB7E00007FFFFFFFF, 0x8000},
-	{0xC98E5471304A1D9D, 0xFC00},
-	{0xB7E00003FFFFFFFF, 0x8000},
-	{0xD253FFFFFFFFBFFF, 0xFC00},
-	{0xB7E00001FFFFFFFF, 0x8000},
-	{0xC03FFFF8000000FF, 0xD000},
-	{0xB7E00000FFFFFFFF, 0x8000},
-	{0x8023D037007FE123, 0x8000},
-	{0xB7E000007FFFFFFF, 0x8000},
-	{0xB81FFFFFFFFC0001, 0x8000},
-	{0xB7E000003FFFFFFF, 0x8000},
-	{0x002F000000003FFF, 0x0000},
-	{0xB7E000001FFFFFFF, 0x8000},
-	{0xC0DFFFFFFE000008, 0xF800},
-	{0xB7E000000FFFFFFF, 0x8000},
-	{0xC02010000FFFFFFF, 0xC804},
-	{0xB7E0000007FFFFFF, 0x8000},
-	{0xB7DFFFFC0003FFFE, 0x8000},
-	{0xB7E0000003FFFFFF, 0x8000},
-	{0xC6A000001FFFEFFF, 0xFC00},
-	{0xB7E0000001FFFFFF, 0x8000},
-	{0x11A06648D4AA6234, 0x0000},
-	{0xB7E0000000FFFFFF, 0x8000},
-	{0x80200000FFFFDFFE, 0x8000},
-	{0xB7E00000007FFFFF, 0x8000},
-	{0x41D00000000407FF, 0x7C00},
-	{0xB7E00000003FFFFF, 0x8000},
-	{0x3FEFF5FFFFFFFFFE, 0x3BFD},
-	{0xB7E00000001FFFFF, 0x8000},
-	{0xC3C3FFFEFFFFFFFF, 0xFC00},
-	{0xB7E00000000FFFFF, 0x8000},
-	{0xBA50000400000004, 0x8000},
-	{0xB7E000000007FFFF, 0x8000},
-	{0xBF0FEFFFFFFFFFDE, 0x83FE},
-	{0xB7E000000003FFFF, 0x8000},
-	{0xBEE4A51A4CA806DB, 0x80A5},
-	{0xB7E000000001FFFF, 0x8000},
-	{0xFFF200003FFFFFFE, 0xFE80},
-	{0xB7E000000000FFFF, 0x8000},
-	{0xC34FFFFC00020000, 0xFC00},
-	{0xB7E0000000007FFF, 0x8000},
-	{0xC3CFFFFFFDFFFEFF, 0xFC00},
-	{0xB7E0000000003FFF, 0x8000},
-	{0xC0500047FFFFFFFF, 0xD400},
-	{0xB7E0000000001FFF, 0x8000},
-	{0xC34020000000003F, 0xFC00},
-	{0xB7E0000000000FFF, 0x8000},
-	{0x41F1FFF7FFFFFFFE, 0x7C00},
-	{0xB7E00000000007FF, 0x8000},
-	{0xBFEFFDFFFFF7FFFF, 0xBBFF},
-	{0xB7E00000000003FF, 0x8000},
-	{0x3FD0000100000000, 0x3400},
-	{0xB7E00000000001FF, 0x8000},
-	{0xC04FFFFFFFFFFFFE, 0xD400},
-	{0xB7E00000000000FF, 0x8000},
-	{0x41F95116D0DB2E77, 0x7C00},
-	{0xB7E000000000007F, 0x8000},
-	{0xC0647FFFFFFFFFFE, 0xD920},
-	{0xB7E000000000003F, 0x8000},
-	{0xBD40000000000401, 0x8000},
-	{0xB7E000000000001F, 0x8000},
-	{0xC1132E14C1F2785B, 0xFC00},
-	{0xB7E000000000000F, 0x8000},
-	{0xB50AA521C8B52042, 0x8000},
-	{0xB7E0000000000007, 0x8000},
-	{0xC05FFFFFFFC00006, 0xD800},
-	{0xB7E0000000000003, 0x8000},
-	{0x47700013FFFFFFFF, 0x7C00},
-	{0xB7F0000000000000, 0x8000},
-	{0x43DFFE0001FFFFFF, 0x7C00},
-	{0xB7F0000000000001, 0x8000},
-	{0xBF60000007FC0000, 0x9800},
-	{0xB7F0000000000002, 0x8000},
-	{0xBBBFFFB7FFFFFFFF, 0x8000},
-	{0xB7F0000000000004, 0x8000},
-	{0x47F0000000000038, 0x7C00},
-	{0xB7F0000000000008, 0x8000},
-	{0x3E6FC00000008000, 0x0001},
-	{0xB7F0000000000010, 0x8000},
-	{0x401FFFFFF8003FFF, 0x4800},
-	{0xB7F0000000000020, 0x8000},
-	{0x7FF80003FFFFFFFE, 0x7E00},
-	{0xB7F0000000000040, 0x8000},
-	{0xAB511688332D1918, 0x8000},
-	{0xB7F0000000000080, 0x8000},
-	{0x403FFFFFFFFDFFF7, 0x5000},
-	{0xB7F0000000000100, 0x8000},
-	{0x4E74A4804DA3412A, 0x7C00},
-	{0xB7F0000000000200, 0x8000},
-	{0x802FFFFF9FFFFFFF, 0x8000},
-	{0xB7F0000000000400, 0x8000},
-	{0xC16FFFFE00000004, 0xFC00},
-	{0xB7F0000000000800, 0x8000},
-	{0x343FF8000000001E, 0x0000},
-	{0xB7F0000000001000, 0x8000},
-	{0x43E000101FFFFFFF, 0x7C00},
-	{0xB7F0000000002000, 0x8000},
-	{0xBD900080000001FF, 0x8000},
-	{0xB7F0000000004000, 0x8000},
-	{0xC50000FFFFFEFFFF, 0xFC00},
-	{0xB7F0000000008000, 0x8000},
-	{0xC3DFFFFEFFFFE000, 0xFC00},
-	{0xB7F0000000010000, 0x8000},
-	{0xC3E00008000FFFFF, 0xFC00},
-	{0xB7F0000000020000, 0x8000},
-	{0xBD8AFD3373CDCD9D, 0x8000},
-	{0xB7F0000000040000, 0x8000},
-	{0xC3CFFE3FFFFFFFFF, 0xFC00},
-	{0xB7F0000000080000, 0x8000},
-	{0x282FFFFFFBFE0000, 0x0000},
-	{0xB7F0000000100000, 0x8000},
-	{0xC3E000003FEFFFFF, 0xFC00},
-	{0xB7F0000000200000, 0x8000},
-	{0xC800000000040001, 0xFC00},
-	{0xB7F0000000400000, 0x8000},
-	{0x3F9FF8000000001F, 0x27FE},
-	{0xB7F0000000800000, 0x8000},
-	{0xB1500003FFFFFFF7, 0x8000},
-	{0xB7F0000001000000, 0x8000},
-	{0x40B000002000FFFF, 0x6C00},
-	{0xB7F0000002000000, 0x8000},
-	{0x41DFFFFFFBDFFFFF, 0x7C00},
-	{0xB7F0000004000000, 0x8000},
-	{0xBFFDDD910DE367D1, 0xBF77},
-	{0xB7F0000008000000, 0x8000},
-	{0x3EB03BFFFFFFFFFF, 0x0010},
-	{0xB7F0000010000000, 0x8000},
-	{0xBFBFFFFC00040000, 0xB000},
-	{0xB7F0000020000000, 0x8000},
-	{0xC1DFFFFFFE0003FE, 0xFC00},
-	{0xB7F0000040000000, 0x8000},
-	{0x3FDFFFFF7FF7FFFE, 0x3800},
-	{0xB7F0000080000000, 0x8000},
-	{0xC1E0000000FFEFFF, 0xFC00},
-	{0xB7F0000100000000, 0x8000},
-	{0xC01FFFF807FFFFFE, 0xC800},
-	{0xB7F0000200000000, 0x8000},
-	{0xB7E0B7C964ED5E90, 0x8000},
-	{0xB7F0000400000000, 0x8000},
-	{0x434412601E1B8E42, 0x7C00},
-	{0xB7F0000800000000, 0x8000},
-	{0xBB5000000003FFDE, 0x8000},
-	{0xB7F0001000000000, 0x8000},
-	{0x405FFDFFFFFDFFFF, 0x57FF},
-	{0xB7F0002000000000, 0x8000},
-	{0xC3FB4C3BEB95818D, 0xFC00},
-	{0xB7F0004000000000, 0x8000},
-	{0x31F6E0C90291C2D0, 0x0000},
-	{0xB7F0008000000000, 0x8000},
-	{0x402000FFFFFBFFFE, 0x4800},
-	{0xB7F0010000000000, 0x8000},
-	{0x3F0FFF80FFFFFFFF, 0x0400},
-	{0xB7F0020000000000, 0x8000},
-	{0x5AD0040000001FFE, 0x7C00},
-	{0xB7F0040000000000, 0x8000},
-	{0x7FDFFFF000004000, 0x7C00},
-	{0xB7F0080000000000, 0x8000},
-	{0xBCDFFFFE00002000, 0x8000},
-	{0xB7F0100000000000, 0x8000},
-	{0xC010003FFFFFFFBE, 0xC400},
-	{0xB7F0200000000000, 0x8000},
-	{0x3FE0000000180000, 0x3800},
-	{0xB7F0400000000000, 0x8000},
-	{0x3B801FFFFFBFFFFF, 0x0000},
-	{0xB7F0800000000000, 0x8000},
-	{0xBE3FFDF7FFFFFFFE, 0x8000},
-	{0xB7F1000000000000, 0x8000},
-	{0x43FFFFFFFFFDFEFF, 0x7C00},
-	{0xB7F2000000000000, 0x8000},
-	{0xFFE00003FFFFFFBF, 0xFC00},
-	{0xB7F4000000000000, 0x8000},
-	{0xBD4FFDFFFFFFFBFE, 0x8000},
-	{0xB7F8000000000000, 0x8000},
-	{0x380000001000000F, 0x0000},
-	{0xB7FC000000000000, 0x8000},
-	{0xC19FFFFF81000000, 0xFC00},
-	{0xB7FE000000000000, 0x8000},
-	{0x43DFFFFFFFBFFFFE, 0x7C00},
-	{0xB7FF000000000000, 0x8000},
-	{0x47EFFF000FFFFFFF, 0x7C00},
-	{0xB7FF800000000000, 0x8000},
-	{0x41FFF947743A16F1, 0x7C00},
-	{0xB7FFC00000000000, 0x8000},
-	{0x4080003C00000000, 0x6000},
-	{0xB7FFE00000000000, 0x8000},
-	{0xC0100000001FFFFB, 0xC400},
-	{0xB7FFF00000000000, 0x8000},
-	{0x8DA39A383A018909, 0x8000},
-	{0xB7FFF80000000000, 0x8000},
-	{0x3CADFFFFFEFFFFFF, 0x0000},
-	{0xB7FFFC0000000000, 0x8000},
-	{0xBFF00001FFFFFFF7, 0xBC00},
-	{0xB7FFFE0000000000, 0x8000},
-	{0xC14801FFFFFFFFFE, 0xFC00},
-	{0xB7FFFF0000000000, 0x8000},
-	{0xB7F1634FA1B3657F, 0x8000},
-	{0xB7FFFF8000000000, 0x8000},
-	{0x405000000020001F, 0x5400},
-	{0xB7FFFFC000000000, 0x8000},
-	{0x400FFFFFFDFFFFF8, 0x4400},
-	{0xB7FFFFE000000000, 0x8000},
-	{0x3FCFFFF7FFFFBFFE, 0x3400},
-	{0xB7FFFFF000000000, 0x8000},
-	{0xC1F07FFFFFFFFFFE, 0xFC00},
-	{0xB7FFFFF800000000, 0x8000},
-	{0x38000000200FFFFE, 0x0000},
-	{0xB7FFFFFC00000000, 0x8000},
-	{0x4006B306163165DD, 0x41AD},
-	{0xB7FFFFFE00000000, 0x8000},
-	{0xBF250115338C9A43, 0x8940},
-	{0xB7FFFFFF00000000, 0x8000},
-	{0xC026000000000000, 0xC980},
-	{0xB7FFFFFF80000000, 0x8000},
-	{0x40428DFBD0453AC8, 0x50A3},
-	{0xB7FFFFFFC0000000, 0x8000},
-	{0xFFF58E0CC2E6063C, 0xFF63},
-	{0xB7FFFFFFE0000000, 0x8000},
-	{0x3EEFFFFFFFE0FFFE, 0x0100},
-	{0xB7FFFFFFF0000000, 0x8000},
-	{0x41F00200007FFFFF, 0x7C00},
-	{0xB7FFFFFFF8000000, 0x8000},
-	{0x47F0E1106CF53AB1, 0x7C00},
-	{0xB7FFFFFFFC000000, 0x8000},
-	{0x3E1000000003FFDF, 0x0000},
-	{0xB7FFFFFFFE000000, 0x8000},
-	{0x3E000000000047FE, 0x0000},
-	{0xB7FFFFFFFF000000, 0x8000},
-	{0x389F826D718E00C3, 0x0000},
-	{0xB7FFFFFFFF800000, 0x8000},
-	{0x41FFFFFFFFBFFFF6, 0x7C00},
-	{0xB7FFFFFFFFC00000, 0x8000},
-	{0xC00CFBB1A4B03065, 0xC33F},
-	{0xB7FFFFFFFFE00000, 0x8000},
-	{0xBFBA9F55C8CD4B2E, 0xAEA8},
-	{0xB7FFFFFFFFF00000, 0x8000},
-	{0x186AC83E29C2FEB9, 0x0000},
-	{0xB7FFFFFFFFF80000, 0x8000},
-	{0xB80FEFBFFFFFFFFF, 0x8000},
-	{0xB7FFFFFFFFFC0000, 0x8000},
-	{0x3FBB1B59199C4489, 0x2EC7},
-	{0xB7FFFFFFFFFE0000, 0x8000},
-	{0xBFC541C4633962A0, 0xB150},
-	{0xB7FFFFFFFFFF0000, 0x8000},
-	{0xC1B51A053E7E78B2, 0xFC00},
-	{0xB7FFFFFFFFFF8000, 0x8000},
-	{0x381FFFC000FFFFFF, 0x0000},
-	{0xB7FFFFFFFFFFC000, 0x8000},
-	{0xC1E00000001FF000, 0xFC00},
-	{0xB7FFFFFFFFFFE000, 0x8000},
-	{0x0020000000000020, 0x0000},
-	{0xB7FFFFFFFFFFF000, 0x8000},
-	{0xC040000810000000, 0xD000},
-	{0xB7FFFFFFFFFFF800, 0x8000},
-	{0x3F4F800000003FFF, 0x13E0},
-	{0xB7FFFFFFFFFFFC00, 0x8000},
-	{0x43EFFF00000007FF, 0x7C00},
-	{0xB7FFFFFFFFFFFE00, 0x8000},
-	{0xB81EFFFFFFFFFF7E, 0x8000},
-	{0xB7FFFFFFFFFFFF00, 0x8000},
-	{0xBFDFFFFFFBFFFFDE, 0xB800},
-	{0xB7FFFFFFFFFFFF80, 0x8000},
-	{0x43E00000BFFFFFFF, 0x7C00},
-	{0xB7FFFFFFFFFFFFC0, 0x8000},
-	{0x865080000000FFFF, 0x8000},
-	{0xB7FFFFFFFFFFFFE0, 0x8000},
-	{0x41CFFFFFFFFFFFFF, 0x7C00},
-	{0xB7FFFFFFFFFFFFF0, 0x8000},
-	{0x41F00003FFFFFFFC, 0x7C00},
-	{0xB7FFFFFFFFFFFFF8, 0x8000},
-	{0x434FFFFBFFBFFFFE, 0x7C00},
-	{0xB7FFFFFFFFFFFFFC, 0x8000},
-	{0x409FC0000001FFFF, 0x67F0},
-	{0xB7FFFFFFFFFFFFFE, 0x8000},
-	{0xBFBFFFFFFF7E0000, 0xB000},
-	{0xB7FFFFFFFFFFFFFF, 0x8000},
-	{0xBFFFFFFFFFFFFBFB, 0xC000},
-	{0xB7FFFFFFFFFFFFFD, 0x8000},
-	{0xBFCFFFFFFFFBFFDF, 0xB400},
-	{0xB7FFFFFFFFFFFFFB, 0x8000},
-	{0x66A87A0D59885B15, 0x7C00},
-	{0xB7FFFFFFFFFFFFF7, 0x8000},
-	{0xD549D6A5D2BB790E, 0xFC00},
-	{0xB7FFFFFFFFFFFFEF, 0x8000},
-	{0x41DFE0000007FFFE, 0x7C00},
-	{0xB7FFFFFFFFFFFFDF, 0x8000},
-	{0xFFDF7FFFFFFF0000, 0xFC00},
-	{0xB7FFFFFFFFFFFFBF, 0x8000},
-	{0x3F50000000000002, 0x1400},
-	{0xB7FFFFFFFFFFFF7F, 0x8000},
-	{0xB48B3F35EAC9B9E3, 0x8000},
-	{0xB7FFFFFFFFFFFEFF, 0x8000},
-	{0x3F9FFFFFFFFFFFF4, 0x2800},
-	{0xB7FFFFFFFFFFFDFF, 0x8000},
-	{0xC1DFFFFFFFC7FFFF, 0xFC00},
-	{0xB7FFFFFFFFFFFBFF, 0x8000},
-	{0x43DFEFFFFFFFBFFF, 0x7C00},
-	{0xB7FFFFFFFFFFF7FF, 0x8000},
-	{0xC59FFF7FFEFFFFFF, 0xFC00},
-	{0xB7FFFFFFFFFFEFFF, 0x8000},
-	{0xCC2007FF00000000, 0xFC00},
-	{0xB7FFFFFFFFFFDFFF, 0x8000},
-	{0x37FCD27A96C54698, 0x0000},
-	{0xB7FFFFFFFFFFBFFF, 0x8000},
-	{0xBFCE000000002000, 0xB380},
-	{0xB7FFFFFFFFFF7FFF, 0x8000},
-	{0x43DFF000000000FF, 0x7C00},
-	{0xB7FFFFFFFFFEFFFF, 0x8000},
-	{0x43E7C55742CC630E, 0x7C00},
-	{0xB7FFFFFFFFFDFFFF, 0x8000},
-	{0xC3A000002001FFFF, 0xFC00},
-	{0xB7FFFFFFFFFBFFFF, 0x8000},
-	{0xC3D07FFFFFFFFF00, 0xFC00},
-	{0xB7FFFFFFFFF7FFFF, 0x8000},
-	{0xC010000012000000, 0xC400},
-	{0xB7FFFFFFFFEFFFFF, 0x8000},
-	{0xC080000000400001, 0xE000},
-	{0xB7FFFFFFFFDFFFFF, 0x8000},
-	{0x001BA1CF41BEA05A, 0x0000},
-	{0xB7FFFFFFFFBFFFFF, 0x8000},
-	{0x3B5FFFFFDFFF7FFF, 0x0000},
-	{0xB7FFFFFFFF7FFFFF, 0x8000},
-	{0xC1D0000000041000, 0xFC00},
-	{0xB7FFFFFFFEFFFFFF, 0x8000},
-	{0x40100000007EFFFE, 0x4400},
-	{0xB7FFFFFFFDFFFFFF, 0x8000},
-	{0xC010000100080000, 0xC400},
-	{0xB7FFFFFFFBFFFFFF, 0x8000},
-	{0xA6B000807FFFFFFF, 0x8000},
-	{0xB7FFFFFFF7FFFFFF, 0x8000},
-	{0x401FF803FFFFFFFF, 0x47FE},
-	{0xB7FFFFFFEFFFFFFF, 0x8000},
-	{0xB7EFFFFFFF700000, 0x8000},
-	{0xB7FFFFFFDFFFFFFF, 0x8000},
-	{0x3FD3FFFFEFFFFFFF, 0x3500},
-	{0xB7FFFFFFBFFFFFFF, 0x8000},
-	{0xBCAFFFFF6FFFFFFF, 0x8000},
-	{0xB7FFFFFF7FFFFFFF, 0x8000},
-	{0xC00001FFBFFFFFFF, 0xC000},
-	{0xB7FFFFFEFFFFFFFF, 0x8000},
-	{0xBC9011FFFFFFFFFF, 0x8000},
-	{0xB7FFFFFDFFFFFFFF, 0x8000},
-	{0xAC541CEB26A9EAC4, 0x8000},
-	{0xB7FFFFFBFFFFFFFF, 0x8000},
-	{0x37F7EE1781ACCFB6, 0x0000},
-	{0xB7FFFFF7FFFFFFFF, 0x8000},
-	{0xCDE000FE00000000, 0xFC00},
-	{0xB7FFFFEFFFFFFFFF, 0x8000},
-	{0x3FBFFFFFFFD7FFFE, 0x3000},
-	{0xB7FFFFDFFFFFFFFF, 0x8000},
-	{0x0900004000001000, 0x0000},
-	{0xB7FFFFBFFFFFFFFF, 0x8000},
-	{0xBFDFFE00000001FF, 0xB800},
-	{0xB7FFFF7FFFFFFFFF, 0x8000},
-	{0x399FFFFFF7FBFFFF, 0x0000},
-	{0xB7FFFEFFFFFFFFFF, 0x8000},
-	{0xBFB805B2A9707EFC, 0xAE01},
-	{0xB7FFFDFFFFFFFFFF, 0x8000},
-	{0x7FFFEFFFFFFFFFF8, 0x7FFB},
-	{0xB7FFFBFFFFFFFFFF, 0x8000},
-	{0x8014A5BC0F8E7C14, 0x8000},
-	{0xB7FFF7FFFFFFFFFF, 0x8000},
-	{0xC00FFFFFFBFFEFFE, 0xC400},
-	{0xB7FFEFFFFFFFFFFF, 0x8000},
-	{0xC7FFFFFFFF3FFFFE, 0xFC00},
-	{0xB7FFDFFFFFFFFFFF, 0x8000},
-	{0x52600007FFFFDFFF, 0x7C00},
-	{0xB7FFBFFFFFFFFFFF, 0x8000},
-	{0x4008D7E296323794, 0x4236},
-	{0xB7FF7FFFFFFFFFFF, 0x8000},
-	{0xB80008003FFFFFFE, 0x8000},
-	{0xB7FEFFFFFFFFFFFF, 0x8000},
-	{0x77E01FFFFFFFFFEF, 0x7C00},
-	{0xB7FDFFFFFFFFFFFF, 0x8000},
-	{0x9A4FE460D2F5AA61, 0x8000},
-	{0xB7FBFFFFFFFFFFFF, 0x8000},
-	{0x43D69F82FD113455, 0x7C00},
-	{0xB7F7FFFFFFFFFFFF, 0x8000},
-	{0xDD6FFFFFFBFFFFDF, 0xFC00},
-	{0xB7F3FFFFFFFFFFFF, 0x8000},
-	{0x319FFFFFE000FFFF, 0x0000},
-	{0xB7F1FFFFFFFFFFFF, 0x8000},
-	{0xC01000FFFFDFFFFF, 0xC400},
-	{0xB7F0FFFFFFFFFFFF, 0x8000},
-	{0x41D00040000001FE, 0x7C00},
-	{0xB7F07FFFFFFFFFFF, 0x8000},
-	{0x029007FF7FFFFFFF, 0x0000},
-	{0xB7F03FFFFFFFFFFF, 0x8000},
-	{0xC349C697324C3C8E, 0xFC00},
-	{0xB7F01FFFFFFFFFFF, 0x8000},
-	{0xBFD01FFFFFFDFFFF, 0xB408},
-	{0xB7F00FFFFFFFFFFF, 0x8000},
-	{0x40280699339A5768, 0x4A02},
-	{0xB7F007FFFFFFFFFF, 0x8000},
-	{0xC060001FBFFFFFFE, 0xD800},
-	{0xB7F003FFFFFFFFFF, 0x8000},
-	{0x001001FFFFDFFFFF, 0x0000},
-	{0xB7F001FFFFFFFFFF, 0x8000},
-	{0xC1F1A5CBFD378C3D, 0xFC00},
-	{0xB7F000FFFFFFFFFF, 0x8000},
-	{0x400FFF7FBFFFFFFF, 0x4400},
-	{0xB7F0007FFFFFFFFF, 0x8000},
-	{0xD859C46E56F08FF3, 0xFC00},
-	{0xB7F0003FFFFFFFFF, 0x8000},
-	{0x480FFFFFFFFF000F, 0x7C00},
-	{0xB7F0001FFFFFFFFF, 0x8000},
-	{0xBF5FFE000000007F, 0x9800},
-	{0xB7F0000FFFFFFFFF, 0x8000},
-	{0x74CFFFFFFFFFFC07, 0x7C00},
-	{0xB7F00007FFFFFFFF, 0x8000},
-	{0x401FFFBBFFFFFFFE, 0x4800},
-	{0xB7F00003FFFFFFFF, 0x8000},
-	{0x330FFC0000001FFF, 0x0000},
-	{0xB7F00001FFFFFFFF, 0x8000},
-	{0xB81FFFFFFFDFFFBF, 0x8000},
-	{0xB7F00000FFFFFFFF, 0x8000},
-	{0xBFCA5233CE28DD1F, 0xB295},
-	{0xB7F000007FFFFFFF, 0x8000},
-	{0x4C2000000001BFFE, 0x7C00},
-	{0xB7F000003FFFFFFF, 0x8000},
-	{0x3E60008000000000, 0x0001},
-	{0xB7F000001FFFFFFF, 0x8000},
-	{0x533FF7F7FFFFFFFF, 0x7C00},
-	{0xB7F000000FFFFFFF, 0x8000},
-	{0xBFEFE0000FFFFFFF, 0xBBF8},
-	{0xB7F0000007FFFFFF, 0x8000},
-	{0x78B01FFF80000000, 0x7C00},
-	{0xB7F0000003FFFFFF, 0x8000},
-	{0x41FFFFFC3FFFFFFF, 0x7C00},
-	{0xB7F0000001FFFFFF, 0x8000},
-	{0x3FEE47EBD14F3721, 0x3B92},
-	{0xB7F0000000FFFFFF, 0x8000},
-	{0xBFDFFFFFDFFFFF7F, 0xB800},
-	{0xB7F00000007FFFFF, 0x8000},
-	{0xBFB8E10347102650, 0xAE38},
-	{0xB7F00000003FFFFF, 0x8000},
-	{0x4041FFFFFFFFFF7F, 0x5080},
-	{0xB7F00000001FFFFF, 0x8000},
-	{0x63F0003FFFFF7FFE, 0x7C00},
-	{0xB7F00000000FFFFF, 0x8000},
-	{0x3FA00000000007BF, 0x2800},
-	{0xB7F000000007FFFF, 0x8000},
-	{0x3B20000008800000, 0x0000},
-	{0xB7F000000003FFFF, 0x8000},
-	{0x47EFFFF000080000, 0x7C00},
-	{0xB7F000000001FFFF, 0x8000},
-	{0x42B0400000003FFF, 0x7C00},
-	{0xB7F000000000FFFF, 0x8000},
-	{0x802FFF0004000000, 0x8000},
-	{0xB7F0000000007FFF, 0x8000},
-	{0x37E7FFFFBFFFFFFF, 0x0000},
-	{0xB7F0000000003FFF, 0x8000},
-	{0x40D0000000000FFF, 0x7400},
-	{0xB7F0000000001FFF, 0x8000},
-	{0xF5D07FFFFFF80000, 0xFC00},
-	{0xB7F0000000000FFF, 0x8000},
-	{0xBF27FFFFFFFFF7FE, 0x8A00},
-	{0xB7F00000000007FF, 0x8000},
-	{0x41D0000101FFFFFE, 0x7C00},
-	{0xB7F00000000003FF, 0x8000},
-	{0x401FFFFE000003FF, 0x4800},
-	{0xB7F00000000001FF, 0x8000},
-	{0x800F51DCE22087F6, 0x8000},
-	{0xB7F00000000000FF, 0x8000},
-	{0xC43FFFFFFC080000, 0xFC00},
-	{0xB7F000000000007F, 0x8000},
-	{0x2B849FDCABA5A638, 0x0000},
-	{0xB7F000000000003F, 0x8000},
-	{0xBC400000003FDFFF, 0x8000},
-	{0xB7F000000000001F, 0x8000},
-	{0x41C07F885F3C599D, 0x7C00},
-	{0xB7F000000000000F, 0x8000},
-	{0x380FFFF800000001, 0x0000},
-	{0xB7F0000000000007, 0x8000},
-	{0x406F3F57FDD6EA91, 0x5BD0},
-	{0xB7F0000000000003, 0x8000},
-	{0xC3FFFFFFC03FFFFF, 0xFC00},
-	{0xB800000000000000, 0x8000},
-	{0xBDBFFFFFFFFFFF7E, 0x8000},
-	{0xB800000000000001, 0x8000},
-	{0x37FF7DFFFFFFFFFF, 0x0000},
-	{0xB800000000000002, 0x8000},
-	{0x3FC7FFFBFFFFFFFF, 0x3200},
-	{0xB800000000000004, 0x8000},
-	{0x42E200000000000E, 0x7C00},
-	{0xB800000000000008, 0x8000},
-	{0x41FB7337FBCA4C46, 0x7C00},
-	{0xB800000000000010, 0x8000},
-	{0x7FDBFFFFFEFFFFFF, 0x7C00},
-	{0xB800000000000020, 0x8000},
-	{0x582FFFFFFFC3FFFF, 0x7C00},
-	{0xB800000000000040, 0x8000},
-	{0x4012761E458F869A, 0x449E},
-	{0xB800000000000080, 0x8000},
-	{0x714EE3FB58EFAAEA, 0x7C00},
-	{0xB800000000000100, 0x8000},
-	{0x1EF07BFFFFFFFFFF, 0x0000},
-	{0xB800000000000200, 0x8000},
-	{0x4FAFFFFFFFE00000, 0x7C00},
-	{0xB800000000000400, 0x8000},
-	{0x403000000007FFE0, 0x4C00},
-	{0xB800000000000800, 0x8000},
-	{0xC060000002003FFF, 0xD800},
-	{0xB800000000001000, 0x8000},
-	{0xC3400000008003FE, 0xFC00},
-	{0xB800000000002000, 0x8000},
-	{0x3FB6B003CBB2872C, 0x2DAC},
-	{0xB800000000004000, 0x8000},
-	{0x3FEFFEFFFEFFFFFF, 0x3C00},
-	{0xB800000000008000, 0x8000},
-	{0xB7E89DB527F68F38, 0x8000},
-	{0xB800000000010000, 0x8000},
-	{0xC1F0010002000000, 0xFC00},
-	{0xB800000000020000, 0x8000},
-	{0xDA61A9EC3ADBB3BD, 0xFC00},
-	{0xB800000000040000, 0x8000},
-	{0x38F0000FFFEFFFFF, 0x0000},
-	{0xB800000000080000, 0x8000},
-	{0xBFC000200000003F, 0xB000},
-	{0xB800000000100000, 0x8000},
-	{0xC00FFFFFFFFFFEF0, 0xC400},
-	{0xB800000000200000, 0x8000},
-	{0xB8110000001FFFFE, 0x8000},
-	{0xB800000000400000, 0x8000},
-	{0xC800000020000FFE, 0xFC00},
-	{0xB800000000800000, 0x8000},
-	{0xBFA10EF0A33EE357, 0xA844},
-	{0xB800000001000000, 0x8000},
-	{0x3FE78BE6E0565362, 0x39E3},
-	{0xB800000002000000, 0x8000},
-	{0x43CCB24F5BD99557, 0x7C00},
-	{0xB800000004000000, 0x8000},
-	{0x237D3C728B25F21E, 0x0000},
-	{0xB800000008000000, 0x8000},
-	{0x41D0FFFFFFFFF7FF, 0x7C00},
-	{0xB800000010000000, 0x8000},
-	{0xC03AF4B6DF684EE0, 0xCEBD},
-	{0xB800000020000000, 0x8000},
-	{0xC3D00000003FFFFF, 0xFC00},
-	{0xB800000040000000, 0x8000},
-	{0x3660AF4966FB08CD, 0x0000},
-	{0xB800000080000000, 0x8000},
-	{0x3FF00020000FFFFF, 0x3C00},
-	{0xB800000100000000, 0x8000},
-	{0x3810000103FFFFFF, 0x0000},
-	{0xB800000200000000, 0x8000},
-	{0x424FFFFFFFFF07FF, 0x7C00},
-	{0xB800000400000000, 0x8000},
-	{0xD65040000FFFFFFF, 0xFC00},
-	{0xB800000800000000, 0x8000},
-	{0xBA200000103FFFFF, 0x8000},
-	{0xB800001000000000, 0x8000},
-	{0xB9F000403FFFFFFE, 0x8000},
-	{0xB800002000000000, 0x8000},
-	{0xC3D5E4CE4974A320, 0xFC00},
-	{0xB800004000000000, 0x8000},
-	{0xC040B22321076AE0, 0xD02D},
-	{0xB800008000000000, 0x8000},
-	{0x3DA1000000003FFF, 0x0000},
-	{0xB800010000000000, 0x8000},
-	{0x2E5003FFFFFFFFEE, 0x0000},
-	{0xB800020000000000, 0x8000},
-	{0xC1E03FFFFFFF0000, 0xFC00},
-	{0xB800040000000000, 0x8000},
-	{0xB910000000000000, 0x8000},
-	{0xB800080000000000, 0x8000},
-	{0x43BFFFFFFFFFC003, 0x7C00},
-	{0xB800100000000000, 0x8000},
-	{0xBFDFFFFFFBFC0000, 0xB800},
-	{0xB800200000000000, 0x8000},
-	{0x43D0180000000000, 0x7C00},
-	{0xB800400000000000, 0x8000},
-	{0x036FE03FFFFFFFFE, 0x0000},
-	{0xB800800000000000, 0x8000},
-	{0x3FBFFFFF7DFFFFFF, 0x3000},
-	{0xB801000000000000, 0x8000},
-	{0x43CA9A4678D87A8D, 0x7C00},
-	{0xB802000000000000, 0x8000},
-	{0x0027D102C7092570, 0x0000},
-	{0xB804000000000000, 0x8000},
-	{0xC3E0000000006000, 0xFC00},
-	{0xB808000000000000, 0x8000},
-	{0xA8500400000FFFFF, 0x8000},
-	{0xB80C000000000000, 0x8000},
-	{0x40700000000401FE, 0x5C00},
-	{0xB80E000000000000, 0x8000},
-	{0x3E3000083FFFFFFF, 0x0000},
-	{0xB80F000000000000, 0x8000},
-	{0xC80FFE001FFFFFFE, 0xFC00},
-	{0xB80F800000000000, 0x8000},
-	{0xB80EFFFFFFFFFC00, 0x8000},
-	{0xB80FC00000000000, 0x8000},
-	{0x3F0D1F8EE27635AD, 0x03A4},
-	{0xB80FE00000000000, 0x8000},
-	{0x407FFFFF80003FFF, 0x6000},
-	{0xB80FF00000000000, 0x8000},
-	{0x406FC3FFFFFFFFFF, 0x5BF1},
-	{0xB80FF80000000000, 0x8000},
-	{0xFFDFFFFF7FFFFFF7, 0xFC00},
-	{0xB80FFC0000000000, 0x8000},
-	{0x3FB0000003FFFBFE, 0x2C00},
-	{0xB80FFE0000000000, 0x8000},
-	{0xC02FFFFFFFDFFFFE, 0xCC00},
-	{0xB80FFF0000000000, 0x8000},
-	{0xBCEFFFFFC1FFFFFF, 0x8000},
-	{0xB80FFF8000000000, 0x8000},
-	{0xC0287FEA669D0BB4, 0xCA20},
-	{0xB80FFFC000000000, 0x8000},
-	{0x41CB788E261B3086, 0x7C00},
-	{0xB80FFFE000000000, 0x8000},
-	{0xC8062AE6613C3197, 0xFC00},
-	{0xB80FFFF000000000, 0x8000},
-	{0xCF9F3683CF7876E3, 0xFC00},
-	{0xB80FFFF800000000, 0x8000},
-	{0x3F283244EA7435CC, 0x0A0D},
-	{0xB80FFFFC00000000, 0x8000},
-	{0xB80FFFFFF003FFFE, 0x8000},
-	{0xB80FFFFE00000000, 0x8000},
-	{0xC03063FEB8485CBB, 0xCC19},
-	{0xB80FFFFF00000000, 0x8000},
-	{0x4B2FFFFFFFFFFFFF, 0x7C00},
-	{0xB80FFFFF80000000, 0x8000},
-	{0xC1CFF7FFFFFFFFF0, 0xFC00},
-	{0xB80FFFFFC0000000, 0x8000},
-	{0xC3CFFFFDFFFFC000, 0xFC00},
-	{0xB80FFFFFE0000000, 0x8000},
-	{0xDE8CDA26A10632BF, 0xFC00},
-	{0xB80FFFFFF0000000, 0x8000},
-	{0xAF1FFFF80000001F, 0x8000},
-	{0xB80FFFFFF8000000, 0x8000},
-	{0xBCA0000000008000, 0x8000},
-	{0xB80FFFFFFC000000, 0x8000},
-	{0x4C177C00819EA4FE, 0x7C00},
-	{0xB80FFFFFFE000000, 0x8000},
-	{0xC03FFFEFFFFFFDFE, 0xD000},
-	{0xB80FFFFFFF000000, 0x8000},
-	{0xC0300000080007FE, 0xCC00},
-	{0xB80FFFFFFF800000, 0x8000},
-	{0x43E2B48DD0A8D944, 0x7C00},
-	{0xB80FFFFFFFC00000, 0x8000},
-	{0x43C00001FFFFFFF8, 0x7C00},
-	{0xB80FFFFFFFE00000, 0x8000},
-	{0x47F000000011FFFE, 0x7C00},
-	{0xB80FFFFFFFF00000, 0x8000},
-	{0x4B3FCFFFFFFFFFFF, 0x7C00},
-	{0xB80FFFFFFFF80000, 0x8000},
-	{0xBFD000200000001F, 0xB400},
-	{0xB80FFFFFFFFC0000, 0x8000},
-	{0x3FFFFFFF00000400, 0x4000},
-	{0xB80FFFFFFFFE0000, 0x8000},
-	{0x43400000000403FF, 0x7C00},
-	{0xB80FFFFFFFFF0000, 0x8000},
-	{0x7B9FFFFFFFFEFDFE, 0x7C00},
-	{0xB80FFFFFFFFF8000, 0x8000},
-	{0x403000000001FFF6, 0x4C00},
-	{0xB80FFFFFFFFFC000, 0x8000},
-	{0x3FDFFFF7FFFFFDFE, 0x3800},
-	{0xB80FFFFFFFFFE000, 0x8000},
-	{0xC3F000003FFF7FFE, 0xFC00},
-	{0xB80FFFFFFFFFF000, 0x8000},
-	{0xC00E0000007FFFFE, 0xC380},
-	{0xB80FFFFFFFFFF800, 0x8000},
-	{0x3FEF000000000006, 0x3BC0},
-	{0xB80FFFFFFFFFFC00, 0x8000},
-	{0x4340000040000FFE, 0x7C00},
-	{0xB80FFFFFFFFFFE00, 0x8000},
-	{0x001000000001FFF7, 0x0000},
-	{0xB80FFFFFFFFFFF00, 0x8000},
-	{0x185FFFFFFFFAFFFF, 0x0000},
-	{0xB80FFFFFFFFFFF80, 0x8000},
-	{0xC0320000003FFFFE, 0xCC80},
-	{0xB80FFFFFFFFFFFC0, 0x8000},
-	{0x3CAFF000007FFFFF, 0x0000},
-	{0xB80FFFFFFFFFFFE0, 0x8000},
-	{0x41C5A570EC19955F, 0x7C00},
-	{0xB80FFFFFFFFFFFF0, 0x8000},
-	{0x2D2000000007FEFF, 0x0000},
-	{0xB80FFFFFFFFFFFF8, 0x8000},
-	{0xBF8FFFFFFFFDE000, 0xA400},
-	{0xB80FFFFFFFFFFFFC, 0x8000},
-	{0x5900001FFFE00000, 0x7C00},
-	{0xB80FFFFFFFFFFFFE, 0x8000},
-	{0x40E00000100003FE, 0x7800},
-	{0xB80FFFFFFFFFFFFF, 0x8000},
-	{0x4940002007FFFFFE, 0x7C00},
-	{0xB80FFFFFFFFFFFFD, 0x8000},
-	{0xD4F56B713C53C0D4, 0xFC00},
-	{0xB80FFFFFFFFFFFFB, 0x8000},
-	{0xC26FE00007FFFFFF, 0xFC00},
-	{0xB80FFFFFFFFFFFF7, 0x8000},
-	{0xC340001FFFDFFFFF, 0xFC00},
-	{0xB80FFFFFFFFFFFEF, 0x8000},
-	{0x179FFFF010000000, 0x0000},
-	{0xB80FFFFFFFFFFFDF, 0x8000},
-	{0x3EA1B283F61FE17C, 0x0009},
-	{0xB80FFFFFFFFFFFBF, 0x8000},
-	{0x3FFFFF0002000000, 0x4000},
-	{0xB80FFFFFFFFFFF7F, 0x8000},
-	{0xBF40002200000000, 0x9000},
-	{0xB80FFFFFFFFFFEFF, 0x8000},
-	{0xC5AFFFFFFFFF8001, 0xFC00},
-	{0xB80FFFFFFFFFFDFF, 0x8000},
-	{0xC03338E36C77E8E8, 0xCCCE},
-	{0xB80FFFFFFFFFFBFF, 0x8000},
-	{0x480FFF007FFFFFFF, 0x7C00},
-	{0xB80FFFFFFFFFF7FF, 0x8000},
-	{0x3FE91375F49A918B, 0x3A45},
-	{0xB80FFFFFFFFFEFFF, 0x8000},
-	{0x47EFFFFF00FFFFFE, 0x7C00},
-	{0xB80FFFFFFFFFDFFF, 0x8000},
-	{0x0020000000200800, 0x0000},
-	{0xB80FFFFFFFFFBFFF, 0x8000},
-	{0xA209A71981E0A2EE, 0x8000},
-	{0xB80FFFFFFFFF7FFF, 0x8000},
-	{0xC3C499EEDF999267, 0xFC00},
-	{0xB80FFFFFFFFEFFFF, 0x8000},
-	{0xFFEFFFF000000080, 0xFC00},
-	{0xB80FFFFFFFFDFFFF, 0x8000},
-	{0x52A00000FFFF7FFF, 0x7C00},
-	{0xB80FFFFFFFFBFFFF, 0x8000},
-	{0x3818C2EABEDB46D5, 0x0000},
-	{0xB80FFFFFFFF7FFFF, 0x8000},
-	{0xC7F001FFFFFFEFFF, 0xFC00},
-	{0xB80FFFFFFFEFFFFF, 0x8000},
-	{0xB7F9BEA687F46A87, 0x8000},
-	{0xB80FFFFFFFDFFFFF, 0x8000},
-	{0x40172710EC4DA53E, 0x45CA},
-	{0xB80FFFFFFFBFFFFF, 0x8000},
-	{0xC9EFFF07FFFFFFFE, 0xFC00},
-	{0xB80FFFFFFF7FFFFF, 0x8000},
-	{0x472467A09600F53F, 0x7C00},
-	{0xB80FFFFFFEFFFFFF, 0x8000},
-	{0xC01FFF800000000F, 0xC800},
-	{0xB80FFFFFFDFFFFFF, 0x8000},
-	{0xBFBF900000000000, 0xAFE4},
-	{0xB80FFFFFFBFFFFFF, 0x8000},
-	{0x43F81D31CCA00CAB, 0x7C00},
-	{0xB80FFFFFF7FFFFFF, 0x8000},
-	{0xC020400000000000, 0xC810},
-	{0xB80FFFFFEFFFFFFF, 0x8000},
-	{0x41CFFBFFFFFF7FFF, 0x7C00},
-	{0xB80FFFFFDFFFFFFF, 0x8000},
-	{0xC03A7CFCA051D879, 0xCE9F},
-	{0xB80FFFFFBFFFFFFF, 0x8000},
-	{0xBFD0002000800000, 0xB400},
-	{0xB80FFFFF7FFFFFFF, 0x8000},
-	{0xC3EFFFE00000001F, 0xFC00},
-	{0xB80FFFFEFFFFFFFF, 0x8000},
-	{0xDA500027FFFFFFFF, 0xFC00},
-	{0xB80FFFFDFFFFFFFF, 0x8000},
-	{0x41F07FFDFFFFFFFF, 0x7C00},
-	{0xB80FFFFBFFFFFFFF, 0x8000},
-	{0xC7E0000000100000, 0xFC00},
-	{0xB80FFFF7FFFFFFFF, 0x8000},
-	{0xFFFFFFF7FFFF7FFE, 0xFFFF},
-	{0xB80FFFEFFFFFFFFF, 0x8000},
-	{0x381FFFFFF0FFFFFE, 0x0000},
-	{0xB80FFFDFFFFFFFFF, 0x8000},
-	{0x421FFFFFFFFFFE00, 0x7C00},
-	{0xB80FFFBFFFFFFFFF, 0x8000},
-	{0xB7EFFFE0000000FE, 0x8000},
-	{0xB80FFF7FFFFFFFFF, 0x8000},
-	{0x8009758748BDC581, 0x8000},
-	{0xB80FFEFFFFFFFFFF, 0x8000},
-	{0xC192C3C2A475E185, 0xFC00},
-	{0xB80FFDFFFFFFFFFF, 0x8000},
-	{0x3FE8000000000FFF, 0x3A00},
-	{0xB80FFBFFFFFFFFFF, 0x8000},
-	{0xB81FFFFFFFFFBF7E, 0x8000},
-	{0xB80FF7FFFFFFFFFF, 0x8000},
-	{0x3F0FFFFFFF7FFFE0, 0x0400},
-	{0xB80FEFFFFFFFFFFF, 0x8000},
-	{0x45FFFFFBFFFFDFFF, 0x7C00},
-	{0xB80FDFFFFFFFFFFF, 0x8000},
-	{0xB7E03FFFFFDFFFFF, 0x8000},
-	{0xB80FBFFFFFFFFFFF, 0x8000},
-	{0xCEEC05D667EEE400, 0xFC00},
-	{0xB80F7FFFFFFFFFFF, 0x8000},
-	{0x41F0000040000007, 0x7C00},
-	{0xB80EFFFFFFFFFFFF, 0x8000},
-	{0xC5098ABFA0939FC2, 0xFC00},
-	{0xB80DFFFFFFFFFFFF, 0x8000},
-	{0x402C0000000007FF, 0x4B00},
-	{0xB80BFFFFFFFFFFFF, 0x8000},
-	{0x7FFFDFFFFFFFFFC0, 0x7FF7},
-	{0xB807FFFFFFFFFFFF, 0x8000},
-	{0x3FCFFFFFFFFFFFFE, 0x3400},
-	{0xB803FFFFFFFFFFFF, 0x8000},
-	{0xBFD13FFFFFFFFFFE, 0xB450},
-	{0xB801FFFFFFFFFFFF, 0x8000},
-	{0xFFEFFFFE0000003F, 0xFC00},
-	{0xB800FFFFFFFFFFFF, 0x8000},
-	{0xC3C000008000FFFF, 0xFC00},
-	{0xB8007FFFFFFFFFFF, 0x8000},
-	{0x3D33F8D2CC1C89F4, 0x0000},
-	{0xB8003FFFFFFFFFFF, 0x8000},
-	{0xFFF00020000003FF, 0xFE00},
-	{0xB8001FFFFFFFFFFF, 0x8000},
-	{0x43D0000000004003, 0x7C00},
-	{0xB8000FFFFFFFFFFF, 0x8000},
-	{0xBBE000004FFFFFFF, 0x8000},
-	{0xB80007FFFFFFFFFF, 0x8000},
-	{0xFFDFFE0000000007, 0xFC00},
-	{0xB80003FFFFFFFFFF, 0x8000},
-	{0xC01FFFFFF8800000, 0xC800},
-	{0xB80001FFFFFFFFFF, 0x8000},
-	{0x8B6FFFE040000000, 0x8000},
-	{0xB80000FFFFFFFFFF, 0x8000},
-	{0xC1DFF8000000000F, 0xFC00},
-	{0xB800007FFFFFFFFF, 0x8000},
-	{0x4A3000000040000F, 0x7C00},
-	{0xB800003FFFFFFFFF, 0x8000},
-	{0xBEC4B2FBDE84CA53, 0x8029},
-	{0xB800001FFFFFFFFF, 0x8000},
-	{0xB51100000001FFFF, 0x8000},
-	{0xB800000FFFFFFFFF, 0x8000},
-	{0x4018000000000020, 0x4600},
-	{0xB8000007FFFFFFFF, 0x8000},
-	{0xFFDFFFFF8000003F, 0xFC00},
-	{0xB8000003FFFFFFFF, 0x8000},
-	{0x8000000010003FFE, 0x8000},
-	{0xB8000001FFFFFFFF, 0x8000},
-	{0xE3BC647187D5BBFB, 0xFC00},
-	{0xB8000000FFFFFFFF, 0x8000},
-	{0x002004000000007F, 0x0000},
-	{0xB80000007FFFFFFF, 0x8000},
-	{0xBD407FFFFFFF0000, 0x8000},
-	{0xB80000003FFFFFFF, 0x8000},
-	{0xB7F4A7B8D51BF2A9, 0x8000},
-	{0xB80000001FFFFFFF, 0x8000},
-	{0xC3DFFFFF00002000, 0xFC00},
-	{0xB80000000FFFFFFF, 0x8000},
-	{0xC077799243021AA7, 0xDDDE},
-	{0xB800000007FFFFFF, 0x8000},
-	{0xC80FFFFFDFFFFBFF, 0xFC00},
-	{0xB800000003FFFFFF, 0x8000},
-	{0xB7E0000020000003, 0x8000},
-	{0xB800000001FFFFFF, 0x8000},
-	{0x3FD8D22564093E2D, 0x3635},
-	{0xB800000000FFFFFF, 0x8000},
-	{0x37FBF66E230BB405, 0x0000},
-	{0xB8000000007FFFFF, 0x8000},
-	{0xC34045E5D4544CA0, 0xFC00},
-	{0xB8000000003FFFFF, 0x8000},
-	{0x46D0000003FFFFDF, 0x7C00},
-	{0xB8000000001FFFFF, 0x8000},
-	{0xC1F0000013FFFFFF, 0xFC00},
-	{0xB8000000000FFFFF, 0x8000},
-	{0xC01FFFFFF7FFBFFF, 0xC800},
-	{0xB80000000007FFFF, 0x8000},
-	{0x524BDE9D24891B52, 0x7C00},
-	{0xB80000000003FFFF, 0x8000},
-	{0xC03FFFFFFE00007E, 0xD000},
-	{0xB80000000001FFFF, 0x8000},
-	{0xFFFFFFFF77FFFFFF, 0xFFFF},
-	{0xB80000000000FFFF, 0x8000},
-	{0x3EEF2B33F7248B82, 0x00F9},
-	{0xB800000000007FFF, 0x8000},
-	{0x4038C72B94AB54EB, 0x4E32},
-	{0xB800000000003FFF, 0x8000},
-	{0x3FF831F76DA33870, 0x3E0C},
-	{0xB800000000001FFF, 0x8000},
-	{0xC3CFFFFFFE003FFF, 0xFC00},
-	{0xB800000000000FFF, 0x8000},
-	{0x41CFFFF800000FFF, 0x7C00},
-	{0xB8000000000007FF, 0x8000},
-	{0xBFC0000020001FFE, 0xB000},
-	{0xB8000000000003FF, 0x8000},
-	{0xC07000000000007F, 0xDC00},
-	{0xB8000000000001FF, 0x8000},
-	{0x41F316FF22F0FBA4, 0x7C00},
-	{0xB8000000000000FF, 0x8000},
-	{0x8FE001FFFFFFFFE0, 0x8000},
-	{0xB80000000000007F, 0x8000},
-	{0x3FA00007FFFFFFFF, 0x2800},
-	{0xB80000000000003F, 0x8000},
-	{0x3FC40000003FFFFE, 0x3100},
-	{0xB80000000000001F, 0x8000},
-	{0x90CB4693077CE7FA, 0x8000},
-	{0xB80000000000000F, 0x8000},
-	{0xC8535C7A5A0C6D59, 0xFC00},
-	{0xB800000000000007, 0x8000},
-	{0x40000007FFFFBFFE, 0x4000},
-	{0xB800000000000003, 0x8000},
-	{0xC1A0000001DFFFFE, 0xFC00},
-	{0xB810000000000000, 0x8000},
-	{0x4D41FFFE00000000, 0x7C00},
-	{0xB810000000000001, 0x8000},
-	{0xC3FFC0003FFFFFFF, 0xFC00},
-	{0xB810000000000002, 0x8000},
-	{0x31AFFFF9FFFFFFFF, 0x0000},
-	{0xB810000000000004, 0x8000},
-	{0xC00FFE0000001FFE, 0xC400},
-	{0xB810000000000008, 0x8000},
-	{0x3FF000000080007F, 0x3C00},
-	{0xB810000000000010, 0x8000},
-	{0x3813B54147F3EB9C, 0x0000},
-	{0xB810000000000020, 0x8000},
-	{0x00200FFFFFFFBFFF, 0x0000},
-	{0xB810000000000040, 0x8000},
-	{0xB4BFFFFFFF7EFFFF, 0x8000},
-	{0xB810000000000080, 0x8000},
-	{0xC3C41502BE6ED81C, 0xFC00},
-	{0xB810000000000100, 0x8000},
-	{0xC7F00020000001FF, 0xFC00},
-	{0xB810000000000200, 0x8000},
-	{0xBE00800003FFFFFF, 0x8000},
-	{0xB810000000000400, 0x8000},
-	{0xB7EE000000000001, 0x8000},
-	{0xB810000000000800, 0x8000},
-	{0xB81FFFFEFFFF7FFF, 0x8000},
-	{0xB810000000001000, 0x8000},
-	{0xC1F00001FFFFFFFF, 0xFC00},
-	{0xB810000000002000, 0x8000},
-	{0x800000100000003E, 0x8000},
-	{0xB810000000004000, 0x8000},
-	{0x403197DC6D913B47, 0x4C66},
-	{0xB810000000008000, 0x8000},
-	{0xC04945757E7C5395, 0xD251},
-	{0xB810000000010000, 0x8000},
-	{0xB7EFF7FFFFFDFFFE, 0x8000},
-	{0xB810000000020000, 0x8000},
-	{0x518BFFFFFF000000, 0x7C00},
-	{0xB810000000040000, 0x8000},
-	{0xC00020000000001E, 0xC008},
-	{0xB810000000080000, 0x8000},
-	{0x3FFFFFFFFFF3FFFF, 0x4000},
-	{0xB810000000100000, 0x8000},
-	{0x3FD200001FFFFFFF, 0x3480},
-	{0xB810000000200000, 0x8000},
-	{0x37EE400000000000, 0x0000},
-	{0xB810000000400000, 0x8000},
-	{0xBEAFFFDDFFFFFFFF, 0x8010},
-	{0xB810000000800000, 0x8000},
-	{0x37EFFFFFFF7FFFFF, 0x0000},
-	{0xB810000001000000, 0x8000},
-	{0xF4F00003FFFFFF7E, 0xFC00},
-	{0xB810000002000000, 0x8000},
-	{0x3D3FFFFFFEFFEFFE, 0x0000},
-	{0xB810000004000000, 0x8000},
-	{0xC32715E416E90334, 0xFC00},
-	{0xB810000008000000, 0x8000},
-	{0x3FC00000000020FE, 0x3000},
-	{0xB810000010000000, 0x8000},
-	{0xC34801FFFFFFFFFF, 0xFC00},
-	{0xB810000020000000, 0x8000},
-	{0xB7FFFFFFFFDFFFEF, 0x8000},
-	{0xB810000040000000, 0x8000},
-	{0xB6EA1FC6995B2C29, 0x8000},
-	{0xB810000080000000, 0x8000},
-	{0x80087A0308E3DA73, 0x8000},
-	{0xB810000100000000, 0x8000},
-	{0xC3A0000002000001, 0xFC00},
-	{0xB810000200000000, 0x8000},
-	{0xBFCA5EC8BBC5A19A, 0xB298},
-	{0xB810000400000000, 0x8000},
-	{0xC35AE3C06C3760BF, 0xFC00},
-	{0xB810000800000000, 0x8000},
-	{0xA9AFC00000FFFFFF, 0x8000},
-	{0xB810001000000000, 0x8000},
-	{0xBF11000000000001, 0x8440},
-	{0xB810002000000000, 0x8000},
-	{0x409FFFFFDFFFFFBF, 0x6800},
-	{0xB810004000000000, 0x8000},
-	{0x9A90100000000800, 0x8000},
-	{0xB810008000000000, 0x8000},
-	{0xC070000000FFFFFC, 0xDC00},
-	{0xB810010000000000, 0x8000},
-	{0xBFC000000FFFF800, 0xB000},
-	{0xB810020000000000, 0x8000},
-	{0x40562A8585BE3CD8, 0x558B},
-	{0xB810040000000000, 0x8000},
-	{0xB11007FFFF7FFFFF, 0x8000},
-	{0xB810080000000000, 0x8000},
-	{0x3CA338F4F9A5BB2B, 0x0000},
-	{0xB810100000000000, 0x8000},
-	{0xC00EFFFFBFFFFFFF, 0xC3C0},
-	{0xB810200000000000, 0x8000},
-	{0x9D80200000000003, 0x8000},
-	{0xB810400000000000, 0x8000},
-	{0xC3FFF40000000000, 0xFC00},
-	{0xB810800000000000, 0x8000},
-	{0x40AFFFFFFF000200, 0x6C00},
-	{0xB811000000000000, 0x8000},
-	{0x47EFC72808E644E2, 0x7C00},
-	{0xB812000000000000, 0x8000},
-	{0xCE71000001000000, 0xFC00},
-	{0xB814000000000000, 0x8000},
-	{0xC03C20A8A4184AA3, 0xCF08},
-	{0xB818000000000000, 0x8000},
-	{0x4800001FFFF7FFFF, 0x7C00},
-	{0xB81C000000000000, 0x8000},
-	{0xB820001002000000, 0x8000},
-	{0xB81E000000000000, 0x8000},
-	{0xC000001FFFFFF7FF, 0xC000},
-	{0xB81F000000000000, 0x8000},
-	{0xB46400000000FFFF, 0x8000},
-	{0xB81F800000000000, 0x8000},
-	{0x3D86A51651AD5F9E, 0x0000},
-	{0xB81FC00000000000, 0x8000},
-	{0xC1C8000001000000, 0xFC00},
-	{0xB81FE00000000000, 0x8000},
-	{0x428FF80007FFFFFF, 0x7C00},
-	{0xB81FF00000000000, 0x8000},
-	{0x3FABFFFFFFF7FFFF, 0x2B00},
-	{0xB81FF80000000000, 0x8000},
-	{0x47F003FFFFFFFFEF, 0x7C00},
-	{0xB81FFC0000000000, 0x8000},
-	{0xA21FF7BFFFFFFFFE, 0x8000},
-	{0xB81FFE0000000000, 0x8000},
-	{0xC05B70077AD1F4A4, 0xD6DC},
-	{0xB81FFF0000000000, 0x8000},
-	{0xC0000040000000FE, 0xC000},
-	{0xB81FFF8000000000, 0x8000},
-	{0x40500000010000FF, 0x5400},
-	{0xB81FFFC000000000, 0x8000},
-	{0xBFEFDFFBFFFFFFFF, 0xBBF8},
-	{0xB81FFFE000000000, 0x8000},
-	{0x3FE000007FFFFFF8, 0x3800},
-	{0xB81FFFF000000000, 0x8000},
-	{0xBFF0280000000000, 0xBC0A},
-	{0xB81FFFF800000000, 0x8000},
-	{0xB10FFFFDFFFDFFFF, 0x8000},
-	{0xB81FFFFC00000000, 0x8000},
-	{0xD7249E68C60E398C, 0xFC00},
-	{0xB81FFFFE00000000, 0x8000},
-	{0x41C0000FFFFFEFFF, 0x7C00},
-	{0xB81FFFFF00000000, 0x8000},
-	{0xC068908FB8E9ABF3, 0xDA24},
-	{0xB81FFFFF80000000, 0x8000},
-	{0xC3FFFF000007FFFE, 0xFC00},
-	{0xB81FFFFFC0000000, 0x8000},
-	{0xBEB4000000020000, 0x8014},
-	{0xB81FFFFFE0000000, 0x8000},
-	{0xC7E003F800000000, 0xFC00},
-	{0xB81FFFFFF0000000, 0x8000},
-	{0xBB62000010000000, 0x8000},
-	{0xB81FFFFFF8000000, 0x8000},
-	{0xC37FFFFFF7FFFFDF, 0xFC00},
-	{0xB81FFFFFFC000000, 0x8000},
-	{0xB7FFFFFFFFEFFFFD, 0x8000},
-	{0xB81FFFFFFE000000, 0x8000},
-	{0x5C3FFFF7FF7FFFFE, 0x7C00},
-	{0xB81FFFFFFF000000, 0x8000},
-	{0xBFF13A289976CAB1, 0xBC4F},
-	{0xB81FFFFFFF800000, 0x8000},
-	{0xB7F0007BFFFFFFFF, 0x8000},
-	{0xB81FFFFFFFC00000, 0x8000},
-	{0x4030003FFFFBFFFF, 0x4C00},
-	{0xB81FFFFFFFE00000, 0x8000},
-	{0xBFE00000000000F7, 0xB800},
-	{0xB81FFFFFFFF00000, 0x8000},
-	{0x3F3FFFFF800000FF, 0x1000},
-	{0xB81FFFFFFFF80000, 0x8000},
-	{0x4010000000000800, 0x4400},
-	{0xB81FFFFFFFFC0000, 0x8000},
-	{0x47E0000040000008, 0x7C00},
-	{0xB81FFFFFFFFE0000, 0x8000},
-	{0xBF907FFFFFFF7FFE, 0xA420},
-	{0xB81FFFFFFFFF0000, 0x8000},
-	{0xC21000FFFFFFFF80, 0xFC00},
-	{0xB81FFFFFFFFF8000, 0x8000},
-	{0x4D8FFFFFFFFBFF00, 0x7C00},
-	{0xB81FFFFFFFFFC000, 0x8000},
-	{0x7FDFFFBFFFFFFDFE, 0x7C00},
-	{0xB81FFFFFFFFFE000, 0x8000},
-	{0x45EFFFEFE0000000, 0x7C00},
-	{0xB81FFFFFFFFFF000, 0x8000},
-	{0xC1E886239E10B478, 0xFC00},
-	{0xB81FFFFFFFFFF800, 0x8000},
-	{0xC7E73BD6855666C5, 0xFC00},
-	{0xB81FFFFFFFFFFC00, 0x8000},
-	{0x3F22000000007FFF, 0x0880},
-	{0xB81FFFFFFFFFFE00, 0x8000},
-	{0x3CD100007FFFFFFF, 0x0000},
-	{0xB81FFFFFFFFFFF00, 0x8000},
-	{0xC3F9B618F7B7C486, 0xFC00},
-	{0xB81FFFFFFFFFFF80, 0x8000},
-	{0x3D9CED2EA8D18451, 0x0000},
-	{0xB81FFFFFFFFFFFC0, 0x8000},
-	{0x3F8C0AD0DB728E4A, 0x2303},
-	{0xB81FFFFFFFFFFFE0, 0x8000},
-	{0x3FCFFFFFFFC3FFFF, 0x3400},
-	{0xB81FFFFFFFFFFFF0, 0x8000},
-	{0x47F00000FFFFF7FE, 0x7C00},
-	{0xB81FFFFFFFFFFFF8, 0x8000},
-	{0x80135B6AC72AB2B3, 0x8000},
-	{0xB81FFFFFFFFFFFFC, 0x8000},
-	{0x4000000FFFFFFFF7, 0x4000},
-	{0xB81FFFFFFFFFFFFE, 0x8000},
-	{0xB917ABEDF4DEB82A, 0x8000},
-	{0xB81FFFFFFFFFFFFF, 0x8000},
-	{0x3E5FF7FBFFFFFFFE, 0x0000},
-	{0xB81FFFFFFFFFFFFD, 0x8000},
-	{0xC804166D2799B322, 0xFC00},
-	{0xB81FFFFFFFFFFFFB, 0x8000},
-	{0xC02000001FFFFEFF, 0xC800},
-	{0xB81FFFFFFFFFFFF7, 0x8000},
-	{0xBF6010D6CB9EB928, 0x9804},
-	{0xB81FFFFFFFFFFFEF, 0x8000},
-	{0xC3C2C2C0E55F4C48, 0xFC00},
-	{0xB81FFFFFFFFFFFDF, 0x8000},
-	{0x438819564D659EB3, 0x7C00},
-	{0xB81FFFFFFFFFFFBF, 0x8000},
-	{0xC1C61F1E027254E2, 0xFC00},
-	{0xB81FFFFFFFFFFF7F, 0x8000},
-	{0x3FC226FF0245BAC0, 0x308A},
-	{0xB81FFFFFFFFFFEFF, 0x8000},
-	{0xC37000000041FFFE, 0xFC00},
-	{0xB81FFFFFFFFFFDFF, 0x8000},
-	{0xBCAEE4F19BFF32E2, 0x8000},
-	{0xB81FFFFFFFFFFBFF, 0x8000},
-	{0xB7F96EE8A7A361E4, 0x8000},
-	{0xB81FFFFFFFFFF7FF, 0x8000},
-	{0x3E74A12E82EA21B5, 0x0001},
-	{0xB81FFFFFFFFFEFFF, 0x8000},
-	{0xFFFFFF7FFFFFFFFC, 0xFFFF},
-	{0xB81FFFFFFFFFDFFF, 0x8000},
-	{0xC0301FFFFFFFFFBE, 0xCC08},
-	{0xB81FFFFFFFFFBFFF, 0x8000},
-	{0x8BFFFFFFFFE00004, 0x8000},
-	{0xB81FFFFFFFFF7FFF, 0x8000},
-	{0xBFA21FFFFFFFFFFF, 0xA888},
-	{0xB81FFFFFFFFEFFFF, 0x8000},
-	{0x3FD0200000000004, 0x3408},
-	{0xB81FFFFFFFFDFFFF, 0x8000},
-	{0x3CAA20655FDA98A3, 0x0000},
-	{0xB81FFFFFFFFBFFFF, 0x8000},
-	{0x6F1FFFDFFFFEFFFF, 0x7C00},
-	{0xB81FFFFFFFF7FFFF, 0x8000},
-	{0x4170000000001FFB, 0x7C00},
-	{0xB81FFFFFFFEFFFFF, 0x8000},
-	{0xC1C00080000FFFFF, 0xFC00},
-	{0xB81FFFFFFFDFFFFF, 0x8000},
-	{0xC02FFF8000002000, 0xCC00},
-	{0xB81FFFFFFFBFFFFF, 0x8000},
-	{0x001FFFF3FFFFFFFF, 0x0000},
-	{0xB81FFFFFFF7FFFFF, 0x8000},
-	{0xC017B28853B8877A, 0xC5ED},
-	{0xB81FFFFFFEFFFFFF, 0x8000},
-	{0xB800A426CAD9018A, 0x8000},
-	{0xB81FFFFFFDFFFFFF, 0x8000},
-	{0x3FBFF3FFFFFFFFFF, 0x2FFD},
-	{0xB81FFFFFFBFFFFFF, 0x8000},
-	{0xB771000003FFFFFF, 0x8000},
-	{0xB81FFFFFF7FFFFFF, 0x8000},
-	{0x8005DAA4F1D01438, 0x8000},
-	{0xB81FFFFFEFFFFFFF, 0x8000},
-	{0xC11000000003FFFA, 0xFC00},
-	{0xB81FFFFFDFFFFFFF, 0x8000},
-	{0x6493FB41E7F2A832, 0x7C00},
-	{0xB81FFFFFBFFFFFFF, 0x8000},
-	{0xC000000101000000, 0xC000},
-	{0xB81FFFFF7FFFFFFF, 0x8000},
-	{0x2C3FFFFFFFFCFFFE, 0x0000},
-	{0xB81FFFFEFFFFFFFF, 0x8000},
-	{0x457BAB0B576E0351, 0x7C00},
-	{0xB81FFFFDFFFFFFFF, 0x8000},
-	{0x522FFFFF1FFFFFFF, 0x7C00},
-	{0xB81FFFFBFFFFFFFF, 0x8000},
-	{0x3FE00080000FFFFE, 0x3800},
-	{0xB81FFFF7FFFFFFFF, 0x8000},
-	{0xC03FFFFFFFFFDFBF, 0xD000},
-	{0xB81FFFEFFFFFFFFF, 0x8000},
-	{0x4C0ED91C86D959C8, 0x7C00},
-	{0xB81FFFDFFFFFFFFF, 0x8000},
-	{0xC02000000080007E, 0xC800},
-	{0xB81FFFBFFFFFFFFF, 0x8000},
-	{0x5D7E2382F52585CA, 0x7C00},
-	{0xB81FFF7FFFFFFFFF, 0x8000},
-	{0xC1ECA5D270AEF7AF, 0xFC00},
-	{0xB81FFEFFFFFFFFFF, 0x8000},
-	{0x52A00020007FFFFF, 0x7C00},
-	{0xB81FFDFFFFFFFFFF, 0x8000},
-	{0xBFC3FFFFFF7FFFFF, 0xB100},
-	{0xB81FFBFFFFFFFFFF, 0x8000},
-	{0x3CAFFFFFBFFDFFFE, 0x0000},
-	{0xB81FF7FFFFFFFFFF, 0x8000},
-	{0x41FFFFFFFFFFFEC0, 0x7C00},
-	{0xB81FEFFFFFFFFFFF, 0x8000},
-	{0xB800000020003FFF, 0x8000},
-	{0xB81FDFFFFFFFFFFF, 0x8000},
-	{0x8014FFFFFFFFFFFF, 0x8000},
-	{0xB81FBFFFFFFFFFFF, 0x8000},
-	{0xB80FFFFFE7FFFFFF, 0x8000},
-	{0xB81F7FFFFFFFFFFF, 0x8000},
-	{0x4020007FFFF7FFFE, 0x4800},
-	{0xB81EFFFFFFFFFFFF, 0x8000},
-	{0xC03DD970B3077CC3, 0xCF76},
-	{0xB81DFFFFFFFFFFFF, 0x8000},
-	{0xC8007FFFFFFDFFFF, 0xFC00},
-	{0xB81BFFFFFFFFFFFF, 0x8000},
-	{0xC3F6078B50845C9F, 0xFC00},
-	{0xB817FFFFFFFFFFFF, 0x8000},
-	{0xC1300000000007FD, 0xFC00},
-	{0xB813FFFFFFFFFFFF, 0x8000},
-	{0x7FF00003FF7FFFFE, 0x7E00},
-	{0xB811FFFFFFFFFFFF, 0x8000},
-	{0xC1EFFFFFEFFFFBFE, 0xFC00},
-	{0xB810FFFFFFFFFFFF, 0x8000},
-	{0x7FEDF2BC2E5FFCA0, 0x7C00},
-	{0xB8107FFFFFFFFFFF, 0x8000},
-	{0x43C0001FFDFFFFFF, 0x7C00},
-	{0xB8103FFFFFFFFFFF, 0x8000},
-	{0xBFF70E2FE85DD9CD, 0xBDC4},
-	{0xB8101FFFFFFFFFFF, 0x8000},
-	{0x002FFFFFE0000001, 0x0000},
-	{0xB8100FFFFFFFFFFF, 0x8000},
-	{0x41FEAD81D1C9D7A8, 0x7C00},
-	{0xB81007FFFFFFFFFF, 0x8000},
-	{0x403FFFFFFFFFFFE6, 0x5000},
-	{0xB81003FFFFFFFFFF, 0x8000},
-	{0xC7FF000000FFFFFF, 0xFC00},
-	{0xB81001FFFFFFFFFF, 0x8000},
-	{0xC3201FFFFFBFFFFE, 0xFC00},
-	{0xB81000FFFFFFFFFF, 0x8000},
-	{0xBFFFFFFFFFFF7FC0, 0xC000},
-	{0xB810007FFFFFFFFF, 0x8000},
-	{0x4E4DFFFFFFFFFFFB, 0x7C00},
-	{0xB810003FFFFFFFFF, 0x8000},
-	{0x40D0000040000003, 0x7400},
-	{0xB810001FFFFFFFFF, 0x8000},
-	{0x3CAFDFFFFFFF7FFE, 0x0000},
-	{0xB810000FFFFFFFFF, 0x8000},
-	{0x3FDFFFFFFFFFDDFF, 0x3800},
-	{0xB8100007FFFFFFFF, 0x8000},
-	{0x4036911C644A76E2, 0x4DA4},
-	{0xB8100003FFFFFFFF, 0x8000},
-	{0xC00FFF4000000000, 0xC400},
-	{0xB8100001FFFFFFFF, 0x8000},
-	{0x400FFFFFFFE00000, 0x4400},
-	{0xB8100000FFFFFFFF, 0x8000},
-	{0xBFB47FFFFFFFFFFF, 0xAD20},
-	{0xB81000007FFFFFFF, 0x8000},
-	{0x402FFFFFFFFFEBFF, 0x4C00},
-	{0xB81000003FFFFFFF, 0x8000},
-	{0xC34000000800FFFF, 0xFC00},
-	{0xB81000001FFFFFFF, 0x8000},
-	{0x4AB00000F0000000, 0x7C00},
-	{0xB81000000FFFFFFF, 0x8000},
-	{0xC03B0D5AEC165F81, 0xCEC3},
-	{0xB810000007FFFFFF, 0x8000},
-	{0x30DFFFFFFFFE0FFF, 0x0000},
-	{0xB810000003FFFFFF, 0x8000},
-	{0xC0500FFFFFFF7FFE, 0xD404},
-	{0xB810000001FFFFFF, 0x8000},
-	{0xC05FFFE03FFFFFFF, 0xD800},
-	{0xB810000000FFFFFF, 0x8000},
-	{0xC2CFE00000000100, 0xFC00},
-	{0xB8100000007FFFFF, 0x8000},
-	{0x4050000004000040, 0x5400},
-	{0xB8100000003FFFFF, 0x8000},
-	{0x802FFFFFFFF7FFF6, 0x8000},
-	{0xB8100000001FFFFF, 0x8000},
-	{0x3C30040800000000, 0x0000},
-	{0xB8100000000FFFFF, 0x8000},
-	{0x002A92BA40309CC3, 0x0000},
-	{0xB81000000007FFFF, 0x8000},
-	{0x800FFEFFFFFFEFFF, 0x8000},
-	{0xB81000000003FFFF, 0x8000},
-	{0xC6A9A8D7B14F3CD3, 0xFC00},
-	{0xB81000000001FFFF, 0x8000},
-	{0xFFD000007FFE0000, 0xFC00},
-	{0xB81000000000FFFF, 0x8000},
-	{0x43FCF333FC2B1940, 0x7C00},
-	{0xB810000000007FFF, 0x8000},
-	{0x3F11FFFF7FFFFFFF, 0x0480},
-	{0xB810000000003FFF, 0x8000},
-	{0x3808C15F70F057FF, 0x0000},
-	{0xB810000000001FFF, 0x8000},
-	{0x4037DBA3411A052E, 0x4DF7},
-	{0xB810000000000FFF, 0x8000},
-	{0x47F24304DC54B7BF, 0x7C00},
-	{0xB8100000000007FF, 0x8000},
-	{0x3FEFFFFF7FFFFF7F, 0x3C00},
-	{0xB8100000000003FF, 0x8000},
-	{0xBFBFFFFFFFFC0001, 0xB000},
-	{0xB8100000000001FF, 0x8000},
-	{0x7FFFDFFFEFFFFFFE, 0x7FF7},
-	{0xB8100000000000FF, 0x8000},
-	{0x437FFFFFFC003FFF, 0x7C00},
-	{0xB81000000000007F, 0x8000},
-	{0x54E3679D10DA8330, 0x7C00},
-	{0xB81000000000003F, 0x8000},
-	{0x8001FDFEE54D62B7, 0x8000},
-	{0xB81000000000001F, 0x8000},
-	{0xC00FFF0000000020, 0xC400},
-	{0xB81000000000000F, 0x8000},
-	{0x40200401FFFFFFFF, 0x4801},
-	{0xB810000000000007, 0x8000},
-	{0x401FFFFFFFFF07FF, 0x4800},
-	{0xB810000000000003, 0x8000},
-	{0xC3FFFE00000000FF, 0xFC00},
-	{0xBCA0000000000000, 0x8000},
-	{0xB59000002000FFFF, 0x8000},
-	{0xBCA0000000000001, 0x8000},
-	{0xD2B07FFFFFFF7FFF, 0xFC00},
-	{0xBCA0000000000002, 0x8000},
-	{0x4DC764A4E9D909D9, 0x7C00},
-	{0xBCA0000000000004, 0x8000},
-	{0x43E0000500000000, 0x7C00},
-	{0xBCA0000000000008, 0x8000},
-	{0x381000007FFFFFDF, 0x0000},
-	{0xBCA0000000000010, 0x8000},
-	{0x41E95932A7138A9E, 0x7C00},
-	{0xBCA0000000000020, 0x8000},
-	{0x80220BA4FE62DA89, 0x8000},
-	{0xBCA0000000000040, 0x8000},
-	{0x40C00040003FFFFF, 0x7000},
-	{0xBCA0000000000080, 0x8000},
-	{0x439FB7FFFFFFFFFF, 0x7C00},
-	{0xBCA0000000000100, 0x8000},
-	{0xC1B03FFFFEFFFFFE, 0xFC00},
-	{0xBCA0000000000200, 0x8000},
-	{0x4110000001000020, 0x7C00},
-	{0xBCA0000000000400, 0x8000},
-	{0xC3D2AE2C9CF636C3, 0xFC00},
-	{0xBCA0000000000800, 0x8000},
-	{0xF28ADC499866D78B, 0xFC00},
-	{0xBCA0000000001000, 0x8000},
-	{0xC0200FFFFFFDFFFF, 0xC804},
-	{0xBCA0000000002000, 0x8000},
-	{0x80176D50662651C6, 0x8000},
-	{0xBCA0000000004000, 0x8000},
-	{0x3FE08000000007FF, 0x3820},
-	{0xBCA0000000008000, 0x8000},
-	{0xBE5FF7FFFFFFDFFF, 0x8000},
-	{0xBCA0000000010000, 0x8000},
-	{0x41DEFFFBFFFFFFFF, 0x7C00},
-	{0xBCA0000000020000, 0x8000},
-	{0xC00FFFFFFF7FFFFE, 0xC400},
-	{0xBCA0000000040000, 0x8000},
-	{0xBFCF01FFFFFFFFFF, 0xB3C0},
-	{0xBCA0000000080000, 0x8000},
-	{0x47CBFFFBFFFFFFFF, 0x7C00},
-	{0xBCA0000000100000, 0x8000},
-	{0x40A0000400001FFF, 0x6800},
-	{0xBCA0000000200000, 0x8000},
-	{0x53700000FFFFFFFB, 0x7C00},
-	{0xBCA0000000400000, 0x8000},
-	{0x7FDDA2C589CE5CA8, 0x7C00},
-	{0xBCA0000000800000, 0x8000},
-	{0xC3EF000000000000, 0xFC00},
-	{0xBCA0000001000000, 0x8000},
-	{0xC8004000000FFFFE, 0xFC00},
-	{0xBCA0000002000000, 0x8000},
-	{0x3EC000001FFFFFE0, 0x0020},
-	{0xBCA0000004000000, 0x8000},
-	{0xC7100000FFF7FFFF, 0xFC00},
-	{0xBCA0000008000000, 0x8000},
-	{0xBCAEF12680C8B329, 0x8000},
-	{0xBCA0000010000000, 0x8000},
-	{0x3EFFFFFFFFFD7FFF, 0x0200},
-	{0xBCA0000020000000, 0x8000},
-	{0x80232E0502A41AAB, 0x8000},
-	{0xBCA0000040000000, 0x8000},
-	{0xD8200000000001FA, 0xFC00},
-	{0xBCA0000080000000, 0x8000},
-	{0xAC0FFFFFFFBFFFFB, 0x8000},
-	{0xBCA0000100000000, 0x8000},
-	{0xC3F9F5EC8994EF31, 0xFC00},
-	{0xBCA0000200000000, 0x8000},
-	{0x3FAFFFF7FFF00000, 0x2C00},
-	{0xBCA0000400000000, 0x8000},
-	{0x7FF0100400000000, 0x7E04},
-	{0xBCA0000800000000, 0x8000},
-	{0xBFCFFFFFE0400000, 0xB400},
-	{0xBCA0001000000000, 0x8000},
-	{0x41D6D66D1C5BC2AE, 0x7C00},
-	{0xBCA0002000000000, 0x8000},
-	{0x43DC000000002000, 0x7C00},
-	{0xBCA0004000000000, 0x8000},
-	{0x43CC543ADEB7A287, 0x7C00},
-	{0xBCA0008000000000, 0x8000},
-	{0xC34F80000001FFFF, 0xFC00},
-	{0xBCA0010000000000, 0x8000},
-	{0x4347F7FFFFFFFFFF, 0x7C00},
-	{0xBCA0020000000000, 0x8000},
-	{0x3F4000001BFFFFFE, 0x1000},
-	{0xBCA0040000000000, 0x8000},
-	{0x4030080000040000, 0x4C02},
-	{0xBCA0080000000000, 0x8000},
-	{0x23D03FFFFFFFF800, 0x0000},
-	{0xBCA0100000000000, 0x8000},
-	{0x6770004200000000, 0x7C00},
-	{0xBCA0200000000000, 0x8000},
-	{0x40307FFFEFFFFFFE, 0x4C20},
-	{0xBCA0400000000000, 0x8000},
-	{0xBFDFFFF3FFFFFFFF, 0xB800},
-	{0xBCA0800000000000, 0x8000},
-	{0x40400007FFC00000, 0x5000},
-	{0xBCA1000000000000, 0x8000},
-	{0x3FBEAFE73F4C7C78, 0x2FAC},
-	{0xBCA2000000000000, 0x8000},
-	{0x43EFFFFFC0001FFE, 0x7C00},
-	{0xBCA4000000000000, 0x8000},
-	{0x801F7FFDFFFFFFFE, 0x8000},
-	{0xBCA8000000000000, 0x8000},
-	{0x401FFEFFFFFFEFFF, 0x4800},
-	{0xBCAC000000000000, 0x8000},
-	{0xBFC000040001FFFF, 0xB000},
-	{0xBCAE000000000000, 0x8000},
-	{0xBF4FFFFF7FFF7FFF, 0x9400},
-	{0xBCAF000000000000, 0x8000},
-	{0x40100000000005FF, 0x4400},
-	{0xBCAF800000000000, 0x8000},
-	{0x4030000200003FFF, 0x4C00},
-	{0xBCAFC00000000000, 0x8000},
-	{0x47F0001FFFE00000, 0x7C00},
-	{0xBCAFE00000000000, 0x8000},
-	{0x49FFFFFF807FFFFE, 0x7C00},
-	{0xBCAFF00000000000, 0x8000},
-	{0x3CA1FFFFFFFFF7FF, 0x0000},
-	{0xBCAFF80000000000, 0x8000},
-	{0x40D0000000FFBFFF, 0x7400},
-	{0xBCAFFC0000000000, 0x8000},
-	{0x420FFFFF000FFFFF, 0x7C00},
-	{0xBCAFFE0000000000, 0x8000},
-	{0xC0249D3FBAD4BD83, 0xC927},
-	{0xBCAFFF0000000000, 0x8000},
-	{0xA80000000100001F, 0x8000},
-	{0xBCAFFF8000000000, 0x8000},
-	{0xC31FFFF7FFFFF800, 0xFC00},
-	{0xBCAFFFC000000000, 0x8000},
-	{0x3FF2F0F0570A6D11, 0x3CBC},
-	{0xBCAFFFE000000000, 0x8000},
-	{0x40307FFFFFFFFF80, 0x4C20},
-	{0xBCAFFFF000000000, 0x8000},
-	{0x417FFFFBFEFFFFFF, 0x7C00},
-	{0xBCAFFFF800000000, 0x8000},
-	{0xC31F000000000006, 0xFC00},
-	{0xBCAFFFFC00000000, 0x8000},
-	{0x2250810000000000, 0x0000},
-	{0xBCAFFFFE00000000, 0x8000},
-	{0xC00FFFFFFFFFF81E, 0xC400},
-	{0xBCAFFFFF00000000, 0x8000},
-	{0x7FF4596FCBC7D4DC, 0x7F16},
-	{0xBCAFFFFF80000000, 0x8000},
-	{0x43AFFFFFFFBDFFFF, 0x7C00},
-	{0xBCAFFFFFC0000000, 0x8000},
-	{0x46509418D51A7EB0, 0x7C00},
-	{0xBCAFFFFFE0000000, 0x8000},
-	{0x41EFFFFFFFFFFD00, 0x7C00},
-	{0xBCAFFFFFF0000000, 0x8000},
-	{0x3F700007FFFFFFFB, 0x1C00},
-	{0xBCAFFFFFF8000000, 0x8000},
-	{0xC3ED0CB35BBBF4AE, 0xFC00},
-	{0xBCAFFFFFFC000000, 0x8000},
-	{0x7020100000000FFE, 0x7C00},
-	{0xBCAFFFFFFE000000, 0x8000},
-	{0x3FF0200000000000, 0x3C08},
-	{0xBCAFFFFFFF000000, 0x8000},
-	{0x43F6B35276BFB6DE, 0x7C00},
-	{0xBCAFFFFFFF800000, 0x8000},
-	{0xC8000001FFFFF000, 0xFC00},
-	{0xBCAFFFFFFFC00000, 0x8000},
-	{0x37F2FFFFFFFFFFFF, 0x0000},
-	{0xBCAFFFFFFFE00000, 0x8000},
-	{0x400F807FFFFFFFFF, 0x43E0},
-	{0xBCAFFFFFFFF00000, 0x8000},
-	{0xC01BE5690534BFA8, 0xC6F9},
-	{0xBCAFFFFFFFF80000, 0x8000},
-	{0xC01FFFFFFFF80800, 0xC800},
-	{0xBCAFFFFFFFFC0000, 0x8000},
-	{0xC03FFFFFFFFDFFEE, 0xD000},
-	{0xBCAFFFFFFFFE0000, 0x8000},
-	{0xC7E0000008001000, 0xFC00},
-	{0xBCAFFFFFFFFF0000, 0x8000},
-	{0x401FFFFFFFEFFFEF, 0x4800},
-	{0xBCAFFFFFFFFF8000, 0x8000},
-	{0xC000003FFFBFFFFF, 0xC000},
-	{0xBCAFFFFFFFFFC000, 0x8000},
-	{0xC020000000007DFF, 0xC800},
-	{0xBCAFFFFFFFFFE000, 0x8000},
-	{0x3F6BFFFEFFFFFFFF, 0x1B00},
-	{0xBCAFFFFFFFFFF000, 0x8000},
-	{0x4070000140000000, 0x5C00},
-	{0xBCAFFFFFFFFFF800, 0x8000},
-	{0xFC0FEFFC00000000, 0xFC00},
-	{0xBCAFFFFFFFFFFC00, 0x8000},
-	{0x405000FFFFFFFFFE, 0x5400},
-	{0xBCAFFFFFFFFFFE00, 0x8000},
-	{0x3FFAFDB44C8A4FB5, 0x3EBF},
-	{0xBCAFFFFFFFFFFF00, 0x8000},
-	{0xB7ED221024FB9F6D, 0x8000},
-	{0xBCAFFFFFFFFFFF80, 0x8000},
-	{0xC34FFFFFFFE01000, 0xFC00},
-	{0xBCAFFFFFFFFFFFC0, 0x8000},
-	{0xB8074AED4B176398, 0x8000},
-	{0xBCAFFFFFFFFFFFE0, 0x8000},
-	{0xC3240576BE676991, 0xFC00},
-	{0xBCAFFFFFFFFFFFF0, 0x8000},
-	{0xC0379E352253701D, 0xCDE8},
-	{0xBCAFFFFFFFFFFFF8, 0x8000},
-	{0xC7E07FFFFFFFFFEE, 0xFC00},
-	{0xBCAFFFFFFFFFFFFC, 0x8000},
-	{0xC0720989B7919E64, 0xDC82},
-	{0xBCAFFFFFFFFFFFFE, 0x8000},
-	{0x396FFFDFFFFFFFFD, 0x0000},
-	{0xBCAFFFFFFFFFFFFF, 0x8000},
-	{0xC04A603D800BE576, 0xD298},
-	{0xBCAFFFFFFFFFFFFD, 0x8000},
-	{0xC024D581DA34B113, 0xC935},
-	{0xBCAFFFFFFFFFFFFB, 0x8000},
-	{0x2B7FFFFFFFFFFF3F, 0x0000},
-	{0xBCAFFFFFFFFFFFF7, 0x8000},
-	{0x3FC13CF870ADA185, 0x304F},
-	{0xBCAFFFFFFFFFFFEF, 0x8000},
-	{0xC30000000000001F, 0xFC00},
-	{0xBCAFFFFFFFFFFFDF, 0x8000},
-	{0x00100000000FFFC0, 0x0000},
-	{0xBCAFFFFFFFFFFFBF, 0x8000},
-	{0x608FFFFFC0000400, 0x7C00},
-	{0xBCAFFFFFFFFFFF7F, 0x8000},
-	{0xB4B9221D2BD7DC2B, 0x8000},
-	{0xBCAFFFFFFFFFFEFF, 0x8000},
-	{0xCBD0011FFFFFFFFF, 0xFC00},
-	{0xBCAFFFFFFFFFFDFF, 0x8000},
-	{0xC02913D7E6D9CA8E, 0xCA45},
-	{0xBCAFFFFFFFFFFBFF, 0x8000},
-	{0x411FFFFFFDFFFFF6, 0x7C00},
-	{0xBCAFFFFFFFFFF7FF, 0x8000},
-	{0xC393D3527CB6576F, 0xFC00},
-	{0xBCAFFFFFFFFFEFFF, 0x8000},
-	{0xC3DBFFFFC0000000, 0xFC00},
-	{0xBCAFFFFFFFFFDFFF, 0x8000},
-	{0xBCA920C8C6FC20A2, 0x8000},
-	{0xBCAFFFFFFFFFBFFF, 0x8000},
-	{0x40D0000000003FFF, 0x7400},
-	{0xBCAFFFFFFFFF7FFF, 0x8000},
-	{0x402CF565EA7631F9, 0x4B3D},
-	{0xBCAFFFFFFFFEFFFF, 0x8000},
-	{0xC34000020FFFFFFF, 0xFC00},
-	{0xBCAFFFFFFFFDFFFF, 0x8000},
-	{0x41EFF80000000002, 0x7C00},
-	{0xBCAFFFFFFFFBFFFF, 0x8000},
-	{0x3FCB62C3C7442A82, 0x32D9},
-	{0xBCAFFFFFFFF7FFFF, 0x8000},
-	{0xC02FFFFF80007FFE, 0xCC00},
-	{0xBCAFFFFFFFEFFFFF, 0x8000},
-	{0xBF0000FFFF7FFFFF, 0x8200},
-	{0xBCAFFFFFFFDFFFFF, 0x8000},
-	{0xBF63FADB1380FE60, 0x98FF},
-	{0xBCAFFFFFFFBFFFFF, 0x8000},
-	{0xC00FFFFFFF020000, 0xC400},
-	{0xBCAFFFFFFF7FFFFF, 0x8000},
-	{0xBFE4FC6805B2CF6F, 0xB93F},
-	{0xBCAFFFFFFEFFFFFF, 0x8000},
-	{0x7FFFFFF80000000E, 0x7FFF},
-	{0xBCAFFFFFFDFFFFFF, 0x8000},
-	{0xC3407FFFFFFFFFFE, 0xFC00},
-	{0xBCAFFFFFFBFFFFFF, 0x8000},
-	{0xC011D964D91C53E6, 0xC476},
-	{0xBCAFFFFFF7FFFFFF, 0x8000},
-	{0x40207FFF00000000, 0x4820},
-	{0xBCAFFFFFEFFFFFFF, 0x8000},
-	{0x3FF07FEFFFFFFFFF, 0x3C20},
-	{0xBCAFFFFFDFFFFFFF, 0x8000},
-	{0xCF200003FFF7FFFF, 0xFC00},
-	{0xBCAFFFFFBFFFFFFF, 0x8000},
-	{0x43400000004003FE, 0x7C00},
-	{0xBCAFFFFF7FFFFFFF, 0x8000},
-	{0x43DFFFDFFFFEFFFF, 0x7C00},
-	{0xBCAFFFFEFFFFFFFF, 0x8000},
-	{0xD31687F7F8BA5157, 0xFC00},
-	{0xBCAFFFFDFFFFFFFF, 0x8000},
-	{0x3F5FFFFC0000003F, 0x1800},
-	{0xBCAFFFFBFFFFFFFF, 0x8000},
-	{0x3FAFF8000FFFFFFF, 0x2BFE},
-	{0xBCAFFFF7FFFFFFFF, 0x8000},
-	{0x324D6A8A16260DE7, 0x0000},
-	{0xBCAFFFEFFFFFFFFF, 0x8000},
-	{0xBFE03C0000000000, 0xB80F},
-	{0xBCAFFFDFFFFFFFFF, 0x8000},
-	{0xC0EEFFFFFFFEFFFE, 0xFBC0},
-	{0xBCAFFFBFFFFFFFFF, 0x8000},
-	{0x77C3962F44AD9235, 0x7C00},
-	{0xBCAFFF7FFFFFFFFF, 0x8000},
-	{0xC0B0007FFF800000, 0xEC00},
-	{0xBCAFFEFFFFFFFFFF, 0x8000},
-	{0x41F0000003FFFEFF, 0x7C00},
-	{0xBCAFFDFFFFFFFFFF, 0x8000},
-	{0x43400400000001FF, 0x7C00},
-	{0xBCAFFBFFFFFFFFFF, 0x8000},
-	{0x5240000000100002, 0x7C00},
-	{0xBCAFF7FFFFFFFFFF, 0x8000},
-	{0xC03000000080003F, 0xCC00},
-	{0xBCAFEFFFFFFFFFFF, 0x8000},
-	{0x3FF000020000003F, 0x3C00},
-	{0xBCAFDFFFFFFFFFFF, 0x8000},
-	{0xB811FFFFFFDFFFFE, 0x8000},
-	{0xBCAFBFFFFFFFFFFF, 0x8000},
-	{0xBF3FFFBDFFFFFFFF, 0x9000},
-	{0xBCAF7FFFFFFFFFFF, 0x8000},
-	{0x8EEE0FFFFFFFFFFE, 0x8000},
-	{0xBCAEFFFFFFFFFFFF, 0x8000},
-	{0x41CFFFFEF8000000, 0x7C00},
-	{0xBCADFFFFFFFFFFFF, 0x8000},
-	{0x9107F7FFFFFFFFFF, 0x8000},
-	{0xBCABFFFFFFFFFFFF, 0x8000},
-	{0xA45EFFFFFFFEFFFF, 0x8000},
-	{0xBCA7FFFFFFFFFFFF, 0x8000},
-	{0xB7FC1058C2620B92, 0x8000},
-	{0xBCA3FFFFFFFFFFFF, 0x8000},
-	{0x37E9E3DA1E50B541, 0x0000},
-	{0xBCA1FFFFFFFFFFFF, 0x8000},
-	{0xC340000003FFFC00, 0xFC00},
-	{0xBCA0FFFFFFFFFFFF, 0x8000},
-	{0x43CFEFFFFF7FFFFF, 0x7C00},
-	{0xBCA07FFFFFFFFFFF, 0x8000},
-	{0x403000000FFFFFFD, 0x4C00},
-	{0xBCA03FFFFFFFFFFF, 0x8000},
-	{0x47FFFFFFFC03FFFF, 0x7C00},
-	{0xBCA01FFFFFFFFFFF, 0x8000},
-	{0x3FE6969F31E5323B, 0x39A6},
-	{0xBCA00FFFFFFFFFFF, 0x8000},
-	{0x47EF000000007FFE, 0x7C00},
-	{0xBCA007FFFFFFFFFF, 0x8000},
-	{0x4180200010000000, 0x7C00},
-	{0xBCA003FFFFFFFFFF, 0x8000},
-	{0xD88FFFFFFF80FFFF, 0xFC00},
-	{0xBCA001FFFFFFFFFF, 0x8000},
-	{0xAFD05AC66EEF7EEF, 0x8000},
-	{0xBCA000FFFFFFFFFF, 0x8000},
-	{0x41FFFFFF9FFFFFFF, 0x7C00},
-	{0xBCA0007FFFFFFFFF, 0x8000},
-	{0x41F00000001001FF, 0x7C00},
-	{0xBCA0003FFFFFFFFF, 0x8000},
-	{0xC03FFFC0000000FF, 0xD000},
-	{0xBCA0001FFFFFFFFF, 0x8000},
-	{0xC800000000077FFF, 0xFC00},
-	{0xBCA0000FFFFFFFFF, 0x8000},
-	{0xBCD00000080000FF, 0x8000},
-	{0xBCA00007FFFFFFFF, 0x8000},
-	{0xC2567706D7E00786, 0xFC00},
-	{0xBCA00003FFFFFFFF, 0x8000},
-	{0xC029616FCF4F1FB6, 0xCA58},
-	{0xBCA00001FFFFFFFF, 0x8000},
-	{0xC40FFF007FFFFFFE, 0xFC00},
-	{0xBCA00000FFFFFFFF, 0x8000},
-	{0xC1EFF7FFFFFFF7FE, 0xFC00},
-	{0xBCA000007FFFFFFF, 0x8000},
-	{0x44EFE0007FFFFFFE, 0x7C00},
-	{0xBCA000003FFFFFFF, 0x8000},
-	{0x802FFFEFFBFFFFFF, 0x8000},
-	{0xBCA000001FFFFFFF, 0x8000},
-	{0xFFD8B838BADC2B08, 0xFC00},
-	{0xBCA000000FFFFFFF, 0x8000},
-	{0xC7FCC1B5EDC3BAB6, 0xFC00},
-	{0xBCA0000007FFFFFF, 0x8000},
-	{0x400FDF7FFFFFFFFE, 0x43F8},
-	{0xBCA0000003FFFFFF, 0x8000},
-	{0xC090000020010000, 0xE400},
-	{0xBCA0000001FFFFFF, 0x8000},
-	{0xBDFFFFFFEFBFFFFF, 0x8000},
-	{0xBCA0000000FFFFFF, 0x8000},
-	{0x4E681C1B9CED8D16, 0x7C00},
-	{0xBCA00000007FFFFF, 0x8000},
-	{0xC02FFFFF7FEFFFFF, 0xCC00},
-	{0xBCA00000003FFFFF, 0x8000},
-	{0xC0E7FDFFFFFFFFFF, 0xF9FF},
-	{0xBCA00000001FFFFF, 0x8000},
-	{0x3DA000000011FFFF, 0x0000},
-	{0xBCA00000000FFFFF, 0x8000},
-	{0xBE50201FFFFFFFFF, 0x8000},
-	{0xBCA000000007FFFF, 0x8000},
-	{0xC2DFFFE0000001FE, 0xFC00},
-	{0xBCA000000003FFFF, 0x8000},
-	{0x0AF0000FEFFFFFFF, 0x0000},
-	{0xBCA000000001FFFF, 0x8000},
-	{0x433FFFFFFFF88000, 0x7C00},
-	{0xBCA000000000FFFF, 0x8000},
-	{0x400FC00003FFFFFE, 0x43F0},
-	{0xBCA0000000007FFF, 0x8000},
-	{0x3F8FFFFFFFBFF000, 0x2400},
-	{0xBCA0000000003FFF, 0x8000},
-	{0x4090000200FFFFFE, 0x6400},
-	{0xBCA0000000001FFF, 0x8000},
-	{0x46528BD76060D28B, 0x7C00},
-	{0xBCA0000000000FFF, 0x8000},
-	{0xC7F000000000FFE0, 0xFC00},
-	{0xBCA00000000007FF, 0x8000},
-	{0xC1D000001FFDFFFF, 0xFC00},
-	{0xBCA00000000003FF, 0x8000},
-	{0xBFE0000003FEFFFE, 0xB800},
-	{0xBCA00000000001FF, 0x8000},
-	{0x55100200000000FF, 0x7C00},
-	{0xBCA00000000000FF, 0x8000},
-	{0xBFB000000001001F, 0xAC00},
-	{0xBCA000000000007F, 0x8000},
-	{0xBFE0000000000DFE, 0xB800},
-	{0xBCA000000000003F, 0x8000},
-	{0xC1CFFC03FFFFFFFF, 0xFC00},
-	{0xBCA000000000001F, 0x8000},
-	{0xC3D20FFFFFFFFFFF, 0xFC00},
-	{0xBCA000000000000F, 0x8000},
-	{0xBB901FFFFFEFFFFF, 0x8000},
-	{0xBCA0000000000007, 0x8000},
-	{0x4EC0000000000207, 0x7C00},
-	{0xBCA0000000000003, 0x8000},
-	{0xBDE0000000000011, 0x8000},
-	{0xBFB0000000000000, 0xAC00},
-	{0xC01FFFFFFBFFFFC0, 0xC800},
-	{0xBFB0000000000001, 0xAC00},
-	{0x39F1385723C0DD85, 0x0000},
-	{0xBFB0000000000002, 0xAC00},
-	{0x41FB861014FDEF18, 0x7C00},
-	{0xBFB0000000000004, 0xAC00},
-	{0x223000200000003F, 0x0000},
-	{0xBFB0000000000008, 0xAC00},
-	{0xC3CFFFFFFBFFFFFE, 0xFC00},
-	{0xBFB0000000000010, 0xAC00},
-	{0x43A010000000003F, 0x7C00},
-	{0xBFB0000000000020, 0xAC00},
-	{0xBE2003FFFFEFFFFF, 0x8000},
-	{0xBFB0000000000040, 0xAC00},
-	{0x436FBFFFFFFFBFFF, 0x7C00},
-	{0xBFB0000000000080, 0xAC00},
-	{0x3E80000000400000, 0x0002},
-	{0xBFB0000000000100, 0xAC00},
-	{0x47FCBB3AF07E0026, 0x7C00},
-	{0xBFB0000000000200, 0xAC00},
-	{0xC1EFFBFFBFFFFFFE, 0xFC00},
-	{0xBFB0000000000400, 0xAC00},
-	{0x80100007FFFFFE00, 0x8000},
-	{0xBFB0000000000800, 0xAC00},
-	{0x43D93C01872DDD76, 0x7C00},
-	{0xBFB0000000001000, 0xAC00},
-	{0x7FD0000FFFFFFFFF, 0x7C00},
-	{0xBFB0000000002000, 0xAC00},
-	{0xBF8FE000FFFFFFFF, 0xA3F8},
-	{0xBFB0000000004000, 0xAC00},
-	{0x3FDFF04000000000, 0x37FC},
-	{0xBFB0000000008000, 0xAC00},
-	{0xC3F0000000FFFFF7, 0xFC00},
-	{0xBFB0000000010000, 0xAC00},
-	{0x43E01BFFFFFFFFFE, 0x7C00},
-	{0xBFB0000000020000, 0xAC00},
-	{0x7FF5D5E2C8247F3D, 0x7F75},
-	{0xBFB0000000040000, 0xAC00},
-	{0xC002C55313242798, 0xC0B1},
-	{0xBFB0000000080000, 0xAC00},
-	{0xB9FFFFFDFFFFFFF8, 0x8000},
-	{0xBFB0000000100000, 0xAC00},
-	{0x3C1BCEE9846B5E78, 0x0000},
-	{0xBFB0000000200000, 0xAC00},
-	{0x3FFFFFFFFFF80003, 0x4000},
-	{0xBFB0000000400000, 0xAC00},
-	{0x47E00000003FFF7E, 0x7C00},
-	{0xBFB0000000800000, 0xAC00},
-	{0xF19DFFC000000000, 0xFC00},
-	{0xBFB0000001000000, 0xAC00},
-	{0x41CB35826C70B487, 0x7C00},
-	{0xBFB0000002000000, 0xAC00},
-	{0x38E3862D39844D1F, 0x0000},
-	{0xBFB0000004000000, 0xAC00},
-	{0x3FAFFFFDFFFFFF7F, 0x2C00},
-	{0xBFB0000008000000, 0xAC00},
-	{0x4070380000000000, 0x5C0E},
-	{0xBFB0000010000000, 0xAC00},
-	{0xC3EBEFFFFFFFFFFE, 0xFC00},
-	{0xBFB0000020000000, 0xAC00},
-	{0x254FFFFFF8000400, 0x0000},
-	{0xBFB0000040000000, 0xAC00},
-	{0x3F8FFFFEFFFFFEFF, 0x2400},
-	{0xBFB0000080000000, 0xAC00},
-	{0x001FFFFFBFFFFFFF, 0x0000},
-	{0xBFB0000100000000, 0xAC00},
-	{0x5C2F7FFFFFFF7FFF, 0x7C00},
-	{0xBFB0000200000000, 0xAC00},
-	{0x380C30B063BD122A, 0x0000},
-	{0xBFB0000400000000, 0xAC00},
-	{0xB7F3B3B2416251FE, 0x8000},
-	{0xBFB0000800000000, 0xAC00},
-	{0x3CA0000000080020, 0x0000},
-	{0xBFB0001000000000, 0xAC00},
-	{0xBFE00000000047FF, 0xB800},
-	{0xBFB0002000000000, 0xAC00},
-	{0x710F07FFFFFFFFFE, 0x7C00},
-	{0xBFB0004000000000, 0xAC00},
-	{0x8020000001FFC000, 0x8000},
-	{0xBFB0008000000000, 0xAC00},
-	{0x3FEB6DCDA3D4BC17, 0x3ADB},
-	{0xBFB0010000000000, 0xAC00},
-	{0xD76FFF0003FFFFFF, 0xFC00},
-	{0xBFB0020000000000, 0xAC00},
-	{0xD132990D623CA0AD, 0xFC00},
-	{0xBFB0040000000000, 0xAC01},
-	{0xC05FFFFFFFFE00FF, 0xD800},
-	{0xBFB0080000000000, 0xAC02},
-	{0xC1F0865131341851, 0xFC00},
-	{0xBFB0100000000000, 0xAC04},
-	{0xBFCFFC0020000000, 0xB3FF},
-	{0xBFB0200000000000, 0xAC08},
-	{0xC1F0000001FFF800, 0xFC00},
-	{0xBFB0400000000000, 0xAC10},
-	{0x9B3FFFDFBFFFFFFE, 0x8000},
-	{0xBFB0800000000000, 0xAC20},
-	{0xC030000040004000, 0xCC00},
-	{0xBFB1000000000000, 0xAC40},
-	{0x47FD9B02C1634EFE, 0x7C00},
-	{0xBFB2000000000000, 0xAC80},
-	{0xBFFFFFFFFE7FFFFF, 0xC000},
-	{0xBFB4000000000000, 0xAD00},
-	{0x401FFFFFFFC00008, 0x4800},
-	{0xBFB8000000000000, 0xAE00},
-	{0xC00FFFC000001FFE, 0xC400},
-	{0xBFBC000000000000, 0xAF00},
-	{0x402000000000002F, 0x4800},
-	{0xBFBE000000000000, 0xAF80},
-	{0xC04C8841D253B470, 0xD322},
-	{0xBFBF000000000000, 0xAFC0},
-	{0x40EFFBFFFFEFFFFF, 0x7BFF},
-	{0xBFBF800000000000, 0xAFE0},
-	{0xB78FFFFFFFFFC003, 0x8000},
-	{0xBFBFC00000000000, 0xAFF0},
-	{0x800189922E883121, 0x8000},
-	{0xBFBFE00000000000, 0xAFF8},
-	{0xC1DD6641118118B2, 0xFC00},
-	{0xBFBFF00000000000, 0xAFFC},
-	{0xBFA010000000007E, 0xA804},
-	{0xBFBFF80000000000, 0xAFFE},
-	{0x43CFFFFFFFF7FFDF, 0x7C00},
-	{0xBFBFFC0000000000, 0xAFFF},
-	{0x3FFFFFFFFFFFFFC8, 0x4000},
-	{0xBFBFFE0000000000, 0xB000},
-	{0x400FFFDFEFFFFFFE, 0x4400},
-	{0xBFBFFF0000000000, 0xB000},
-	{0xBF901FFEFFFFFFFF, 0xA408},
-	{0xBFBFFF8000000000, 0xB000},
-	{0xBCA00000FF7FFFFF, 0x8000},
-	{0xBFBFFFC000000000, 0xB000},
-	{0x47EFFFFFFF800800, 0x7C00},
-	{0xBFBFFFE000000000, 0xB000},
-	{0xC1FFDFFFFEFFFFFF, 0xFC00},
-	{0xBFBFFFF000000000, 0xB000},
-	{0x4020000000003FF8, 0x4800},
-	{0xBFBFFFF800000000, 0xB000},
-	{0x9799AF06B47DF030, 0x8000},
-	{0xBFBFFFFC00000000, 0xB000},
-	{0xA26C7E342BD23F0D, 0x8000},
-	{0xBFBFFFFE00000000, 0xB000},
-	{0x576FFFFFFFFC0000, 0x7C00},
-	{0xBFBFFFFF00000000, 0xB000},
-	{0x3FC0040080000000, 0x3001},
-	{0xBFBFFFFF80000000, 0xB000},
-	{0xC0000000000001FF, 0xC000},
-	{0xBFBFFFFFC0000000, 0xB000},
-	{0x480BFFFEFFFFFFFF, 0x7C00},
-	{0xBFBFFFFFE0000000, 0xB000},
-	{0x37F0040000080000, 0x0000},
-	{0xBFBFFFFFF0000000, 0xB000},
-	{0xBFF000000000002F, 0xBC00},
-	{0xBFBFFFFFF8000000, 0xB000},
-	{0x3FDAB0E0A379F59E, 0x36AC},
-	{0xBFBFFFFFFC000000, 0xB000},
-	{0x3FCFFFFFFF80003F, 0x3400},
-	{0xBFBFFFFFFE000000, 0xB000},
-	{0x802FFFFE00FFFFFF, 0x8000},
-	{0xBFBFFFFFFF000000, 0xB000},
-	{0xC05000000FFBFFFF, 0xD400},
-	{0xBFBFFFFFFF800000, 0xB000},
-	{0x3FD3FFFFFFFFEFFF, 0x3500},
-	{0xBFBFFFFFFFC00000, 0xB000},
-	{0xC1DEFFFFFFBFFFFF, 0xFC00},
-	{0xBFBFFFFFFFE00000, 0xB000},
-	{0xC3E000801FFFFFFF, 0xFC00},
-	{0xBFBFFFFFFFF00000, 0xB000},
-	{0x33CFFFFFFFEFFEFF, 0x0000},
-	{0xBFBFFFFFFFF80000, 0xB000},
-	{0x409FF40000000000, 0x67FD},
-	{0xBFBFFFFFFFFC0000, 0xB000},
-	{0xFFEFFFFF000007FE, 0xFC00},
-	{0xBFBFFFFFFFFE0000, 0xB000},
-	{0xBFBF7FFFFFFFC000, 0xAFE0},
-	{0xBFBFFFFFFFFF0000, 0xB000},
-	{0xC1E6000000000000, 0xFC00},
-	{0xBFBFFFFFFFFF8000, 0xB000},
-	{0xC7E0000007FFFFFC, 0xFC00},
-	{0xBFBFFFFFFFFFC000, 0xB000},
-	{0xBFBFFF000007FFFF, 0xB000},
-	{0xBFBFFFFFFFFFE000, 0xB000},
-	{0x3CA000000200FFFF, 0x0000},
-	{0xBFBFFFFFFFFFF000, 0xB000},
-	{0x40DBE835994A8B67, 0x76FA},
-	{0xBFBFFFFFFFFFF800, 0xB000},
-	{0xBF8DFFFFFFFFFE00, 0xA380},
-	{0xBFBFFFFFFFFFFC00, 0xB000},
-	{0x41FFFEFFFFFFFFFF, 0x7C00},
-	{0xBFBFFFFFFFFFFE00, 0xB000},
-	{0x400D3F6663D0F7CC, 0x4350},
-	{0xBFBFFFFFFFFFFF00, 0xB000},
-	{0x37EFFFFF83FFFFFF, 0x0000},
-	{0xBFBFFFFFFFFFFF80, 0xB000},
-	{0x3FD00001000FFFFF, 0x3400},
-	{0xBFBFFFFFFFFFFFC0, 0xB000},
-	{0xA1E89D5B06B4B527, 0x8000},
-	{0xBFBFFFFFFFFFFFE0, 0xB000},
-	{0x4002003FFFFFFFFF, 0x4080},
-	{0xBFBFFFFFFFFFFFF0, 0xB000},
-	{0x3FD0001F80000000, 0x3400},
-	{0xBFBFFFFFFFFFFFF8, 0xB000},
-	{0x3FCFFFFDFFFBFFFF, 0x3400},
-	{0xBFBFFFFFFFFFFFFC, 0xB000},
-	{0xC8090BEB42485423, 0xFC00},
-	{0xBFBFFFFFFFFFFFFE, 0xB000},
-	{0xB81FF7FFFFFFFFEE, 0x8000},
-	{0xBFBFFFFFFFFFFFFF, 0xB000},
-	{0xC05001FFBFFFFFFE, 0xD400},
-	{0xBFBFFFFFFFFFFFFD, 0xB000},
-	{0xC0300000000FFFF8, 0xCC00},
-	{0xBFBFFFFFFFFFFFFB, 0xB000},
-	{0x3CA00001FFBFFFFF, 0x0000},
-	{0xBFBFFFFFFFFFFFF7, 0xB000},
-	{0xB80FF7FFFFFFFDFF, 0x8000},
-	{0xBFBFFFFFFFFFFFEF, 0xB000},
-	{0xBE900000FFBFFFFF, 0x8004},
-	{0xBFBFFFFFFFFFFFDF, 0xB000},
-	{0xBFD000017FFFFFFF, 0xB400},
-	{0xBFBFFFFFFFFFFFBF, 0xB000},
-	{0xB80FFFFFFFBFF000, 0x8000},
-	{0xBFBFFFFFFFFFFF7F, 0xB000},
-	{0xFFEFFE000001FFFE, 0xFC00},
-	{0xBFBFFFFFFFFFFEFF, 0xB000},
-	{0xBFF80000001FFFFF, 0xBE00},
-	{0xBFBFFFFFFFFFFDFF, 0xB000},
-	{0xBFB0001FFFEFFFFE, 0xAC00},
-	{0xBFBFFFFFFFFFFBFF, 0xB000},
-	{0x3FEFFFFFFF7FFFEF, 0x3C00},
-	{0xBFBFFFFFFFFFF7FF, 0xB000},
-	{0x3F10007000000000, 0x0400},
-	{0xBFBFFFFFFFFFEFFF, 0xB000},
-	{0x80200000000000DF, 0x8000},
-	{0xBFBFFFFFFFFFDFFF, 0xB000},
-	{0x8020000000030000, 0x8000},
-	{0xBFBFFFFFFFFFBFFF, 0xB000},
-	{0xC3C000400FFFFFFE, 0xFC00},
-	{0xBFBFFFFFFFFF7FFF, 0xB000},
-	{0xC0000000001FFFFF, 0xC000},
-	{0xBFBFFFFFFFFEFFFF, 0xB000},
-	{0xD33FC00003FFFFFF, 0xFC00},
-	{0xBFBFFFFFFFFDFFFF, 0xB000},
-	{0xBD911AD70BA2466E, 0x8000},
-	{0xBFBFFFFFFFFBFFFF, 0xB000},
-	{0x6B05C43C2622D7A6, 0x7C00},
-	{0xBFBFFFFFFFF7FFFF, 0xB000},
-	{0xB7EFFFFFFFF83FFF, 0x8000},
-	{0xBFBFFFFFFFEFFFFF, 0xB000},
-	{0x41CFFFFFFFFF7FF6, 0x7C00},
-	{0xBFBFFFFFFFDFFFFF, 0xB000},
-	{0x41D0000000000023, 0x7C00},
-	{0xBFBFFFFFFFBFFFFF, 0xB000},
-	{0x37FFFFFFFFFDDFFE, 0x0000},
-	{0xBFBFFFFFFF7FFFFF, 0xB000},
-	{0xC1C01007FFFFFFFF, 0xFC00},
-	{0xBFBFFFFFFEFFFFFF, 0xB000},
-	{0xC0B0002000002000, 0xEC00},
-	{0xBFBFFFFFFDFFFFFF, 0xB000},
-	{0x41EFFFFFFFFFBFF8, 0x7C00},
-	{0xBFBFFFFFFBFFFFFF, 0xB000},
-	{0x401FFFFBBFFFFFFE, 0x4800},
-	{0xBFBFFFFFF7FFFFFF, 0xB000},
-	{0xBFF580FC0F74BABD, 0xBD60},
-	{0xBFBFFFFFEFFFFFFF, 0xB000},
-	{0x403FFFFFFFFEFFFF, 0x5000},
-	{0xBFBFFFFFDFFFFFFF, 0xB000},
-	{0xFFDE716A20B6D9D4, 0xFC00},
-	{0xBFBFFFFFBFFFFFFF, 0xB000},
-	{0x40304000007FFFFE, 0x4C10},
-	{0xBFBFFFFF7FFFFFFF, 0xB000},
-	{0xC02FE000000000FF, 0xCBF8},
-	{0xBFBFFFFEFFFFFFFF, 0xB000},
-	{0xC1D0007FFFFFFFEE, 0xFC00},
-	{0xBFBFFFFDFFFFFFFF, 0xB000},
-	{0x3FE07FFFFF800000, 0x3820},
-	{0xBFBFFFFBFFFFFFFF, 0xB000},
-	{0xBF0FFFC00001FFFF, 0x8400},
-	{0xBFBFFFF7FFFFFFFF, 0xB000},
-	{0xBFFE000000003FFF, 0xBF80},
-	{0xBFBFFFEFFFFFFFFF, 0xB000},
-	{0x380FFFFFFF001FFF, 0x0000},
-	{0xBFBFFFDFFFFFFFFF, 0xB000},
-	{0xC02FFEFFFF000000, 0xCC00},
-	{0xBFBFFFBFFFFFFFFF, 0xB000},
-	{0x47F0000000008007, 0x7C00},
-	{0xBFBFFF7FFFFFFFFF, 0xB000},
-	{0xBFA1FFFFFF7FFFFE, 0xA880},
-	{0xBFBFFEFFFFFFFFFF, 0xB000},
-	{0x8020000000000000, 0x8000},
-	{0xBFBFFDFFFFFFFFFF, 0xAFFF},
-	{0xC0010000001FFFFF, 0xC040},
-	{0xBFBFFBFFFFFFFFFF, 0xAFFF},
-	{0x8020008001FFFFFE, 0x8000},
-	{0xBFBFF7FFFFFFFFFF, 0xAFFE},
-	{0xB85FFFE001FFFFFF, 0x8000},
-	{0xBFBFEFFFFFFFFFFF, 0xAFFC},
-	{0xB7FFFFFFFFFEFF00, 0x8000},
-	{0xBFBFDFFFFFFFFFFF, 0xAFF8},
-	{0x40107FFFF7FFFFFF, 0x4420},
-	{0xBFBFBFFFFFFFFFFF, 0xAFF0},
-	{0xC0F612F5822A822E, 0xFC00},
-	{0xBFBF7FFFFFFFFFFF, 0xAFE0},
-	{0xBFE3E00000000000, 0xB8F8},
-	{0xBFBEFFFFFFFFFFFF, 0xAFC0},
-	{0xC1C0000000000403, 0xFC00},
-	{0xBFBDFFFFFFFFFFFF, 0xAF80},
-	{0x400BFFDFFFFFFFFF, 0x4300},
-	{0xBFBBFFFFFFFFFFFF, 0xAF00},
-	{0x402FFFFFFFFDDFFF, 0x4C00},
-	{0xBFB7FFFFFFFFFFFF, 0xAE00},
-	{0x403FFFFFFFFC03FE, 0x5000},
-	{0xBFB3FFFFFFFFFFFF, 0xAD00},
-	{0xC34FFFFFFFFFFFDA, 0xFC00},
-	{0xBFB1FFFFFFFFFFFF, 0xAC80},
-	{0x55CFFFFFFFFFFFFE, 0x7C00},
-	{0xBFB0FFFFFFFFFFFF, 0xAC40},
-	{0xBFCFFFFFF7FDFFFE, 0xB400},
-	{0xBFB07FFFFFFFFFFF, 0xAC20},
-	{0x3FFA9104B53FEDFD, 0x3EA4},
-	{0xBFB03FFFFFFFFFFF, 0xAC10},
-	{0x47EFFFFFFBEFFFFE, 0x7C00},
-	{0xBFB01FFFFFFFFFFF, 0xAC08},
-	{0xC3C00000103FFFFE, 0xFC00},
-	{0xBFB00FFFFFFFFFFF, 0xAC04},
-	{0x4080000038000000, 0x6000},
-	{0xBFB007FFFFFFFFFF, 0xAC02},
-	{0x409FFFFFFBFFF7FF, 0x6800},
-	{0xBFB003FFFFFFFFFF, 0xAC01},
-	{0x43E0002000FFFFFF, 0x7C00},
-	{0xBFB001FFFFFFFFFF, 0xAC00},
-	{0x953FEE0000000000, 0x8000},
-	{0xBFB000FFFFFFFFFF, 0xAC00},
-	{0x852FFFFE1FFFFFFE, 0x8000},
-	{0xBFB0007FFFFFFFFF, 0xAC00},
-	{0xBFE00000000FFFFB, 0xB800},
-	{0xBFB0003FFFFFFFFF, 0xAC00},
-	{0x56900000000FFFBE, 0x7C00},
-	{0xBFB0001FFFFFFFFF, 0xAC00},
-	{0x405DBC3B95F0C642, 0x576F},
-	{0xBFB0000FFFFFFFFF, 0xAC00},
-	{0x401FE00000400000, 0x47F8},
-	{0xBFB00007FFFFFFFF, 0xAC00},
-	{0xFFDFFFFBFFFF0000, 0xFC00},
-	{0xBFB00003FFFFFFFF, 0xAC00},
-	{0xB81001000003FFFE, 0x8000},
-	{0xBFB00001FFFFFFFF, 0xAC00},
-	{0xBC9FFFF803FFFFFF, 0x8000},
-	{0xBFB00000FFFFFFFF, 0xAC00},
-	{0xBFC000000000FF80, 0xB000},
-	{0xBFB000007FFFFFFF, 0xAC00},
-	{0x434000000FFFFFE0, 0x7C00},
-	{0xBFB000003FFFFFFF, 0xAC00},
-	{0x407C5ECAAB157906, 0x5F18},
-	{0xBFB000001FFFFFFF, 0xAC00},
-	{0xBF6000FFFFFF7FFE, 0x9800},
-	{0xBFB000000FFFFFFF, 0xAC00},
-	{0x47FFC00007FFFFFE, 0x7C00},
-	{0xBFB0000007FFFFFF, 0xAC00},
-	{0xBFC0003FFC000000, 0xB000},
-	{0xBFB0000003FFFFFF, 0xAC00},
-	{0x381FFFFC00080000, 0x0000},
-	{0xBFB0000001FFFFFF, 0xAC00},
-	{0xBF8FFFFE00000080, 0xA400},
-	{0xBFB0000000FFFFFF, 0xAC00},
-	{0x800FFFFFFFEFBFFF, 0x8000},
-	{0xBFB00000007FFFFF, 0xAC00},
-	{0x4060000010000080, 0x5800},
-	{0xBFB00000003FFFFF, 0xAC00},
-	{0x40BE00000000000F, 0x6F80},
-	{0xBFB00000001FFFFF, 0xAC00},
-	{0x3CA762088100A311, 0x0000},
-	{0xBFB00000000FFFFF, 0xAC00},
-	{0xC01FFFFFFFFFFDF7, 0xC800},
-	{0xBFB000000007FFFF, 0xAC00},
-	{0xC3C8001000000000, 0xFC00},
-	{0xBFB000000003FFFF, 0xAC00},
-	{0x47F03FFFFFF00000, 0x7C00},
-	{0xBFB000000001FFFF, 0xAC00},
-	{0xFFFFFFFFEFFDFFFF, 0xFFFF},
-	{0xBFB000000000FFFF, 0xAC00},
-	{0x3FC32A088BBC644D, 0x30CB},
-	{0xBFB0000000007FFF, 0xAC00},
-	{0x7FE000000100007F, 0x7C00},
-	{0xBFB0000000003FFF, 0xAC00},
-	{0x43E0007FFFFFFBFF, 0x7C00},
-	{0xBFB0000000001FFF, 0xAC00},
-	{0xC253F7FFFFFFFFFE, 0xFC00},
-	{0xBFB0000000000FFF, 0xAC00},
-	{0xBFF3BC3F85A22248, 0xBCEF},
-	{0xBFB00000000007FF, 0xAC00},
-	{0xA6D000FFFF000000, 0x8000},
-	{0xBFB00000000003FF, 0xAC00},
-	{0xC340800000000FFF, 0xFC00},
-	{0xBFB00000000001FF, 0xAC00},
-	{0x3FF8FCE56E974178, 0x3E3F},
-	{0xBFB00000000000FF, 0xAC00},
-	{0x3FFFF7FFFFFFFF7E, 0x3FFE},
-	{0xBFB000000000007F, 0xAC00},
-	{0x417FFFFFFFFF803F, 0x7C00},
-	{0xBFB000000000003F, 0xAC00},
-	{0xCDD3594FA809ABF8, 0xFC00},
-	{0xBFB000000000001F, 0xAC00},
-	{0x480951B3360C37E5, 0x7C00},
-	{0xBFB000000000000F, 0xAC00},
-	{0x41D00000000000FD, 0x7C00},
-	{0xBFB0000000000007, 0xAC00},
-	{0x3FCFFFFFE0020000, 0x3400},
-	{0xBFB0000000000003, 0xAC00},
-	{0x3FD0000008100000, 0x3400},
-	{0xBFC0000000000000, 0xB000},
-	{0x8020E908DC116738, 0x8000},
-	{0xBFC0000000000001, 0xB000},
-	{0x47F000008007FFFE, 0x7C00},
-	{0xBFC0000000000002, 0xB000},
-	{0x402FFFFFFFFE00FF, 0x4C00},
-	{0xBFC0000000000004, 0xB000},
-	{0xB81FFFF800000040, 0x8000},
-	{0xBFC0000000000008, 0xB000},
-	{0x0010000000000000, 0x0000},
-	{0xBFC0000000000010, 0xB000},
-	{0x3E7F7FFFFC000000, 0x0002},
-	{0xBFC0000000000020, 0xB000},
-	{0xC01F800020000000, 0xC7E0},
-	{0xBFC0000000000040, 0xB000},
-	{0x37E75AB2A5B30908, 0x0000},
-	{0xBFC0000000000080, 0xB000},
-	{0x3DECC08581C6B9F9, 0x0000},
-	{0xBFC0000000000100, 0xB000},
-	{0x7FE736B0DF957057, 0x7C00},
-	{0xBFC0000000000200, 0xB000},
-	{0xCBDFFFFFFFFAFFFF, 0xFC00},
-	{0xBFC0000000000400, 0xB000},
-	{0xC0203FFFFFFFFFEE, 0xC810},
-	{0xBFC0000000000800, 0xB000},
-	{0xC34FF5FFFFFFFFFE, 0xFC00},
-	{0xBFC0000000001000, 0xB000},
-	{0x4030000000017FFF, 0x4C00},
-	{0xBFC0000000002000, 0xB000},
-	{0x403F05CBE2739487, 0x4FC1},
-	{0xBFC0000000004000, 0xB000},
-	{0xBA3000000100003E, 0x8000},
-	{0xBFC0000000008000, 0xB000},
-	{0xBC2003FC00000000, 0x8000},
-	{0xBFC0000000010000, 0xB000},
-	{0xBFF0010000000004, 0xBC00},
-	{0xBFC0000000020000, 0xB000},
-	{0x4092447744F490A4, 0x6491},
-	{0xBFC0000000040000, 0xB000},
-	{0xF5031CA9B824ABD7, 0xFC00},
-	{0xBFC0000000080000, 0xB000},
-	{0xC2EE000000000FFF, 0xFC00},
-	{0xBFC0000000100000, 0xB000},
-	{0xC94FFFFFFFE00FFF, 0xFC00},
-	{0xBFC0000000200000, 0xB000},
-	{0x4020000003F7FFFE, 0x4800},
-	{0xBFC0000000400000, 0xB000},
-	{0xC0100002000000FE, 0xC400},
-	{0xBFC0000000800000, 0xB000},
-	{0x5BFBEFFFFFFFFFFF, 0x7C00},
-	{0xBFC0000001000000, 0xB000},
-	{0x407FFE0000003FFF, 0x6000},
-	{0xBFC0000002000000, 0xB000},
-	{0xFFD7EB30DE4B81CF, 0xFC00},
-	{0xBFC0000004000000, 0xB000},
-	{0x402DFFFFFFFBFFFF, 0x4B80},
-	{0xBFC0000008000000, 0xB000},
-	{0x3FE9F74184B4598A, 0x3A7E},
-	{0xBFC0000010000000, 0xB000},
-	{0x3FEFFFFFFFFFBFFC, 0x3C00},
-	{0xBFC0000020000000, 0xB000},
-	{0xC0000400000FFFFE, 0xC001},
-	{0xBFC0000040000000, 0xB000},
-	{0x42B01FFFBFFFFFFE, 0x7C00},
-	{0xBFC0000080000000, 0xB000},
-	{0xFFE000FFFFFFF7FF, 0xFC00},
-	{0xBFC0000100000000, 0xB000},
-	{0x3CA03FFFE0000000, 0x0000},
-	{0xBFC0000200000000, 0xB000},
-	{0xC38E0000000001FF, 0xFC00},
-	{0xBFC0000400000000, 0xB000},
-	{0x3FCFFFE0000007FF, 0x3400},
-	{0xBFC0000800000000, 0xB000},
-	{0x7FD0005FFFFFFFFF, 0x7C00},
-	{0xBFC0001000000000, 0xB000},
-	{0x3FC0000004000004, 0x3000},
-	{0xBFC0002000000000, 0xB000},
-	{0xBCA17C61D4C51300, 0x8000},
-	{0xBFC0004000000000, 0xB000},
-	{0xE6DFC003FFFFFFFF, 0xFC00},
-	{0xBFC0008000000000, 0xB000},
-	{0xB80FFFFFFFDFFFFF, 0x8000},
-	{0xBFC0010000000000, 0xB000},
-	{0x401430B823C62680, 0x450C},
-	{0xBFC0020000000000, 0xB000},
-	{0x471A33BE9DB9F74E, 0x7C00},
-	{0xBFC0040000000000, 0xB001},
-	{0xB99FF7FEFFFFFFFF, 0x8000},
-	{0xBFC0080000000000, 0xB002},
-	{0xC7F0E243E0DCB03C, 0xFC00},
-	{0xBFC0100000000000, 0xB004},
-	{0xB7FFFFFF7FFFFDFE, 0x8000},
-	{0xBFC0200000000000, 0xB008},
-	{0x43CFFFFFFFFFFFF6, 0x7C00},
-	{0xBFC0400000000000, 0xB010},
-	{0xBFBFFF80000001FF, 0xB000},
-	{0xBFC0800000000000, 0xB020},
-	{0xFFEF1F5D54D04FB6, 0xFC00},
-	{0xBFC1000000000000, 0xB040},
-	{0xC3E00000FFE00000, 0xFC00},
-	{0xBFC2000000000000, 0xB080},
-	{0xBFEFFDFFFDFFFFFE, 0xBBFF},
-	{0xBFC4000000000000, 0xB100},
-	{0x38FFFEFFFE000000, 0x0000},
-	{0xBFC8000000000000, 0xB200},
-	{0x39CFFFFFDFFE0000, 0x0000},
-	{0xBFCC000000000000, 0xB300},
-	{0xC04040003FFFFFFF, 0xD010},
-	{0xBFCE000000000000, 0xB380},
-	{0x41F0800007FFFFFE, 0x7C00},
-	{0xBFCF000000000000, 0xB3C0},
-	{0x41D000000001FFF7, 0x7C00},
-	{0xBFCF800000000000, 0xB3E0},
-	{0x3FFFFFFBFEFFFFFE, 0x4000},
-	{0xBFCFC00000000000, 0xB3F0},
-	{0xBFF00FFFFFFFEFFF, 0xBC04},
-	{0xBFCFE00000000000, 0xB3F8},
-	{0x34FDACF15FE35DD9, 0x0000},
-	{0xBFCFF00000000000, 0xB3FC},
-	{0x4017FC16CE05E808, 0x45FF},
-	{0xBFCFF80000000000, 0xB3FE},
-	{0xC3C010000000FFFE, 0xFC00},
-	{0xBFCFFC0000000000, 0xB3FF},
-	{0x41CE003FFFFFFFFE, 0x7C00},
-	{0xBFCFFE0000000000, 0xB400},
-	{0x48046A9F2924744D, 0x7C00},
-	{0xBFCFFF0000000000, 0xB400},
-	{0xC1EFFFFFFF0FFFFF, 0xFC00},
-	{0xBFCFFF8000000000, 0xB400},
-	{0xBDCFF40000000000, 0x8000},
-	{0xBFCFFFC000000000, 0xB400},
-	{0xC1700000FFFFFF80, 0xFC00},
-	{0xBFCFFFE000000000, 0xB400},
-	{0x47F5CEA5DDD877DF, 0x7C00},
-	{0xBFCFFFF000000000, 0xB400},
-	{0x4060000400000010, 0x5800},
-	{0xBFCFFFF800000000, 0xB400},
-	{0xC3CFFFFFFFFFFAFE, 0xFC00},
-	{0xBFCFFFFC00000000, 0xB400},
-	{0xBF8FFC0001FFFFFE, 0xA3FF},
-	{0xBFCFFFFE00000000, 0xB400},
-	{0x3FBFFFFE00FFFFFF, 0x3000},
-	{0xBFCFFFFF00000000, 0xB400},
-	{0x58A2487F868E956D, 0x7C00},
-	{0xBFCFFFFF80000000, 0xB400},
-	{0x3FDFF00002000000, 0x37FC},
-	{0xBFCFFFFFC0000000, 0xB400},
-	{0x383000000801FFFE, 0x0000},
-	{0xBFCFFFFFE0000000, 0xB400},
-	{0x78355C33472D2820, 0x7C00},
-	{0xBFCFFFFFF0000000, 0xB400},
-	{0xA60000000000FDFF, 0x8000},
-	{0xBFCFFFFFF8000000, 0xB400},
-	{0x41C2E39A8C7ABB21, 0x7C00},
-	{0xBFCFFFFFFC000000, 0xB400},
-	{0xC02000001FFFFFFF, 0xC800},
-	{0xBFCFFFFFFE000000, 0xB400},
-	{0x4198000000000010, 0x7C00},
-	{0xBFCFFFFFFF000000, 0xB400},
-	{0x9FF8000000000006, 0x8000},
-	{0xBFCFFFFFFF800000, 0xB400},
-	{0x380D2D44FEE72E47, 0x0000},
-	{0xBFCFFFFFFFC00000, 0xB400},
-	{0xBF6ED2324422B61F, 0x9BB5},
-	{0xBFCFFFFFFFE00000, 0xB400},
-	{0xA834F72DBCD08071, 0x8000},
-	{0xBFCFFFFFFFF00000, 0xB400},
-	{0x871C5A2141C1A5AF, 0x8000},
-	{0xBFCFFFFFFFF80000, 0xB400},
-	{0x800FFBFFFFFDFFFF, 0x8000},
-	{0xBFCFFFFFFFFC0000, 0xB400},
-	{0x3FC98DEB6EC0558A, 0x3263},
-	{0xBFCFFFFFFFFE0000, 0xB400},
-	{0x4F800000801FFFFE, 0x7C00},
-	{0xBFCFFFFFFFFF0000, 0xB400},
-	{0x43DFFFDFFFFFBFFF, 0x7C00},
-	{0xBFCFFFFFFFFF8000, 0xB400},
-	{0x3FD01FFFFFFFFFF7, 0x3408},
-	{0xBFCFFFFFFFFFC000, 0xB400},
-	{0xC1EFFFF7FFF7FFFF, 0xFC00},
-	{0xBFCFFFFFFFFFE000, 0xB400},
-	{0xC1E3FFFFFFFFFFFA, 0xFC00},
-	{0xBFCFFFFFFFFFF000, 0xB400},
-	{0xE20003FFF0000000, 0xFC00},
-	{0xBFCFFFFFFFFFF800, 0xB400},
-	{0xC3F0001000000003, 0xFC00},
-	{0xBFCFFFFFFFFFFC00, 0xB400},
-	{0xB8131F635249006C, 0x8000},
-	{0xBFCFFFFFFFFFFE00, 0xB400},
-	{0x29D9D0138F504FEA, 0x0000},
-	{0xBFCFFFFFFFFFFF00, 0xB400},
-	{0xBF4E000007FFFFFE, 0x9380},
-	{0xBFCFFFFFFFFFFF80, 0xB400},
-	{0xC08003C000000000, 0xE001},
-	{0xBFCFFFFFFFFFFFC0, 0xB400},
-	{0x4010001FFBFFFFFE, 0x4400},
-	{0xBFCFFFFFFFFFFFE0, 0xB400},
-	{0x40DFFDFFFFEFFFFE, 0x77FF},
-	{0xBFCFFFFFFFFFFFF0, 0xB400},
-	{0xFFF55FF879775151, 0xFF57},
-	{0xBFCFFFFFFFFFFFF8, 0xB400},
-	{0x3F10000003F00000, 0x0400},
-	{0xBFCFFFFFFFFFFFFC, 0xB400},
-	{0x3FCF07F07B1F1DFE, 0x33C2},
-	{0xBFCFFFFFFFFFFFFE, 0xB400},
-	{0xC44F800800000000, 0xFC00},
-	{0xBFCFFFFFFFFFFFFF, 0xB400},
-	{0x002FFFC007FFFFFF, 0x0000},
-	{0xBFCFFFFFFFFFFFFD, 0xB400},
-	{0x37F00000000003FA, 0x0000},
-	{0xBFCFFFFFFFFFFFFB, 0xB400},
-	{0xB803EF8DF39C6637, 0x8000},
-	{0xBFCFFFFFFFFFFFF7, 0xB400},
-	{0xC03FCBFD3A544C38, 0xCFF3},
-	{0xBFCFFFFFFFFFFFEF, 0xB400},
-	{0x44D8BEC7B2DAF44C, 0x7C00},
-	{0xBFCFFFFFFFFFFFDF, 0xB400},
-	{0xC259E8726BA4A073, 0xFC00},
-	{0xBFCFFFFFFFFFFFBF, 0xB400},
-	{0xBF8FF00000003FFF, 0xA3FC},
-	{0xBFCFFFFFFFFFFF7F, 0xB400},
-	{0xBFBFFBFFFFBFFFFE, 0xAFFF},
-	{0xBFCFFFFFFFFFFEFF, 0xB400},
-	{0xBF3FA140EB793B79, 0x8FE8},
-	{0xBFCFFFFFFFFFFDFF, 0xB400},
-	{0xC2B8000000000004, 0xFC00},
-	{0xBFCFFFFFFFFFFBFF, 0xB400},
-	{0x372FFFE000000FFF, 0x0000},
-	{0xBFCFFFFFFFFFF7FF, 0xB400},
-	{0x0EAFF7FFFFFFDFFE, 0x0000},
-	{0xBFCFFFFFFFFFEFFF, 0xB400},
-	{0xB31FF800FFFFFFFE, 0x8000},
-	{0xBFCFFFFFFFFFDFFF, 0xB400},
-	{0x3FC061323B5BAE4C, 0x3018},
-	{0xBFCFFFFFFFFFBFFF, 0xB400},
-	{0x3FDCE6582EF6BB84, 0x373A},
-	{0xBFCFFFFFFFFF7FFF, 0xB400},
-	{0xBFDC1B3EC8B5886A, 0xB707},
-	{0xBFCFFFFFFFFEFFFF, 0xB400},
-	{0xB800003FFFBFFFFF, 0x8000},
-	{0xBFCFFFFFFFFDFFFF, 0xB400},
-	{0x30B017FFFFFFFFFE, 0x0000},
-	{0xBFCFFFFFFFFBFFFF, 0xB400},
-	{0xC03E1189123077D0, 0xCF84},
-	{0xBFCFFFFFFFF7FFFF, 0xB400},
-	{0x4000001F7FFFFFFE, 0x4000},
-	{0xBFCFFFFFFFEFFFFF, 0xB400},
-	{0x53557851223F6A23, 0x7C00},
-	{0xBFCFFFFFFFDFFFFF, 0xB400},
-	{0xC3DFF7FF7FFFFFFF, 0xFC00},
-	{0xBFCFFFFFFFBFFFFF, 0xB400},
-	{0x3802B076DDE360D7, 0x0000},
-	{0xBFCFFFFFFF7FFFFF, 0xB400},
-	{0x405000400001FFFF, 0x5400},
-	{0xBFCFFFFFFEFFFFFF, 0xB400},
-	{0xC1A79AED2462AC0B, 0xFC00},
-	{0xBFCFFFFFFDFFFFFF, 0xB400},
-	{0x73F1C618E2E2693F, 0x7C00},
-	{0xBFCFFFFFFBFFFFFF, 0xB400},
-	{0xC1D0020000040000, 0xFC00},
-	{0xBFCFFFFFF7FFFFFF, 0xB400},
-	{0x02E003FFFFFFFEFE, 0x0000},
-	{0xBFCFFFFFEFFFFFFF, 0xB400},
-	{0xBFD2000001FFFFFF, 0xB480},
-	{0xBFCFFFFFDFFFFFFF, 0xB400},
-	{0x4001F7FFFFFFFFFF, 0x407E},
-	{0xBFCFFFFFBFFFFFFF, 0xB400},
-	{0xC04008000000007F, 0xD002},
-	{0xBFCFFFFF7FFFFFFF, 0xB400},
-	{0x801FFFFE0000007F, 0x8000},
-	{0xBFCFFFFEFFFFFFFF, 0xB400},
-	{0x3FFAD3003FCF0377, 0x3EB5},
-	{0xBFCFFFFDFFFFFFFF, 0xB400},
-	{0x400BB0E625DA26F6, 0x42EC},
-	{0xBFCFFFFBFFFFFFFF, 0xB400},
-	{0x41F56340B076C548, 0x7C00},
-	{0xBFCFFFF7FFFFFFFF, 0xB400},
-	{0x480FFFFDFBFFFFFF, 0x7C00},
-	{0xBFCFFFEFFFFFFFFF, 0xB400},
-	{0xC83A77948F0A7065, 0xFC00},
-	{0xBFCFFFDFFFFFFFFF, 0xB400},
-	{0x3FFFE000007FFFFE, 0x3FF8},
-	{0xBFCFFFBFFFFFFFFF, 0xB400},
-	{0xCC732329F3D07CD7, 0xFC00},
-	{0xBFCFFF7FFFFFFFFF, 0xB400},
-	{0x7FF00000001FFFFF, 0x7E00},
-	{0xBFCFFEFFFFFFFFFF, 0xB400},
-	{0x4800FFFFFE000000, 0x7C00},
-	{0xBFCFFDFFFFFFFFFF, 0xB3FF},
-	{0x4D3FF000000FFFFE, 0x7C00},
-	{0xBFCFFBFFFFFFFFFF, 0xB3FF},
-	{0x43EE000000000001, 0x7C00},
-	{0xBFCFF7FFFFFFFFFF, 0xB3FE},
-	{0xC34BFFFFFFFFF7FF, 0xFC00},
-	{0xBFCFEFFFFFFFFFFF, 0xB3FC},
-	{0x419FFFFFF7FFFFF7, 0x7C00},
-	{0xBFCFDFFFFFFFFFFF, 0xB3F8},
-	{0x7F903FFFFFEFFFFF, 0x7C00},
-	{0xBFCFBFFFFFFFFFFF, 0xB3F0},
-	{0x4C4FE00200000000, 0x7C00},
-	{0xBFCF7FFFFFFFFFFF, 0xB3E0},
-	{0x327FBFFFFFDFFFFF, 0x0000},
-	{0xBFCEFFFFFFFFFFFF, 0xB3C0},
-	{0x49D3930B131A37E7, 0x7C00},
-	{0xBFCDFFFFFFFFFFFF, 0xB380},
-	{0xC03FFFFFFFFFFFFF, 0xD000},
-	{0xBFCBFFFFFFFFFFFF, 0xB300},
-	{0xBFE0000047FFFFFF, 0xB800},
-	{0xBFC7FFFFFFFFFFFF, 0xB200},
-	{0x43FFF7FF7FFFFFFF, 0x7C00},
-	{0xBFC3FFFFFFFFFFFF, 0xB100},
-	{0x47F00003FFBFFFFF, 0x7C00},
-	{0xBFC1FFFFFFFFFFFF, 0xB080},
-	{0x3FBF71A0338375ED, 0x2FDC},
-	{0xBFC0FFFFFFFFFFFF, 0xB040},
-	{0x43E0000FFDFFFFFE, 0x7C00},
-	{0xBFC07FFFFFFFFFFF, 0xB020},
-	{0xC7E115488FCC3996, 0xFC00},
-	{0xBFC03FFFFFFFFFFF, 0xB010},
-	{0xC3FFF7FFBFFFFFFF, 0xFC00},
-	{0xBFC01FFFFFFFFFFF, 0xB008},
-	{0xBC00001007FFFFFF, 0x8000},
-	{0xBFC00FFFFFFFFFFF, 0xB004},
-	{0xC01FFFFFFBFFFFDF, 0xC800},
-	{0xBFC007FFFFFFFFFF, 0xB002},
-	{0xAB4FE00000000008, 0x8000},
-	{0xBFC003FFFFFFFFFF, 0xB001},
-	{0xC00FFFFFFFFD0000, 0xC400},
-	{0xBFC001FFFFFFFFFF, 0xB000},
-	{0xCA50001FFDFFFFFE, 0xFC00},
-	{0xBFC000FFFFFFFFFF, 0xB000},
-	{0x5D2001FFFFFFFFEF, 0x7C00},
-	{0xBFC0007FFFFFFFFF, 0xB000},
-	{0xC9137689626E6D51, 0xFC00},
-	{0xBFC0003FFFFFFFFF, 0xB000},
-	{0xC0068F2C7CBA5F78, 0xC1A4},
-	{0xBFC0001FFFFFFFFF, 0xB000},
-	{0x47E3FFFFFFFFDFFF, 0x7C00},
-	{0xBFC0000FFFFFFFFF, 0xB000},
-	{0x87807FFFFFFDFFFE, 0x8000},
-	{0xBFC00007FFFFFFFF, 0xB000},
-	{0xC09FFFFFFEFFFFFA, 0xE800},
-	{0xBFC00003FFFFFFFF, 0xB000},
-	{0x43DF800000007FFF, 0x7C00},
-	{0xBFC00001FFFFFFFF, 0xB000},
-	{0x4011000000800000, 0x4440},
-	{0xBFC00000FFFFFFFF, 0xB000},
-	{0x3FFFF7FFFFFC0000, 0x3FFE},
-	{0xBFC000007FFFFFFF, 0xB000},
-	{0xC34BDB22C3D5DB58, 0xFC00},
-	{0xBFC000003FFFFFFF, 0xB000},
-	{0xBFB00000001FFEFF, 0xAC00},
-	{0xBFC000001FFFFFFF, 0xB000},
-	{0x381000001F7FFFFF, 0x0000},
-	{0xBFC000000FFFFFFF, 0xB000},
-	{0x427D7B55CC15A56D, 0x7C00},
-	{0xBFC0000007FFFFFF, 0xB000},
-	{0x942730384D90D3C3, 0x8000},
-	{0xBFC0000003FFFFFF, 0xB000},
-	{0x802FC007FFFFFFFE, 0x8000},
-	{0xBFC0000001FFFFFF, 0xB000},
-	{0x31DFFFFBFFF7FFFE, 0x0000},
-	{0xBFC0000000FFFFFF, 0xB000},
-	{0xB813FFDFFFFFFFFE, 0x8000},
-	{0xBFC00000007FFFFF, 0xB000},
-	{0x20BFFFFF000FFFFF, 0x0000},
-	{0xBFC00000003FFFFF, 0xB000},
-	{0x4362E61BAC9FE627, 0x7C00},
-	{0xBFC00000001FFFFF, 0xB000},
-	{0x865FDFFFFFFFFFFF, 0x8000},
-	{0xBFC00000000FFFFF, 0xB000},
-	{0xB80FFFFF6FFFFFFF, 0x8000},
-	{0xBFC000000007FFFF, 0xB000},
-	{0x41EF6DE3AE9437FC, 0x7C00},
-	{0xBFC000000003FFFF, 0xB000},
-	{0xC80005FFFFFFFFFE, 0xFC00},
-	{0xBFC000000001FFFF, 0xB000},
-	{0x3ECCCBAA1B76A6BD, 0x003A},
-	{0xBFC000000000FFFF, 0xB000},
-	{0x3E6FFFFFFFFFFF00, 0x0001},
-	{0xBFC0000000007FFF, 0xB000},
-	{0x3C8000000043FFFF, 0x0000},
-	{0xBFC0000000003FFF, 0xB000},
-	{0x3FF2839F576DE65C, 0x3CA1},
-	{0xBFC0000000001FFF, 0xB000},
-	{0x47EFFFFBFFFBFFFE, 0x7C00},
-	{0xBFC0000000000FFF, 0xB000},
-	{0xB7EFFFFFFFFFFBFD, 0x8000},
-	{0xBFC00000000007FF, 0xB000},
-	{0x402E76140CB0D28A, 0x4B9E},
-	{0xBFC00000000003FF, 0xB000},
-	{0xC020000FFFFFF7FF, 0xC800},
-	{0xBFC00000000001FF, 0xB000},
-	{0x3E6FFFFFFFFF7FE0, 0x0001},
-	{0xBFC00000000000FF, 0xB000},
-	{0xBCADFFFFFFF00000, 0x8000},
-	{0xBFC000000000007F, 0xB000},
-	{0xBFAFFFFFF7F7FFFE, 0xAC00},
-	{0xBFC000000000003F, 0xB000},
-	{0xC1D00FFFF0000000, 0xFC00},
-	{0xBFC000000000001F, 0xB000},
-	{0xBFBFFE0000000020, 0xB000},
-	{0xBFC000000000000F, 0xB000},
-	{0xA709799DB4BA840D, 0x8000},
-	{0xBFC0000000000007, 0xB000},
-	{0xB7EFFFFFFFFE8000, 0x8000},
-	{0xBFC0000000000003, 0xB000},
-	{0x8A10000024000000, 0x8000},
-	{0xBFD0000000000000, 0xB400},
-	{0xC000100000020000, 0xC004},
-	{0xBFD0000000000001, 0xB400},
-	{0xC035FFFFFFFFFFFF, 0xCD80},
-	{0xBFD0000000000002, 0xB400},
-	{0x37F0D50AF1847C19, 0x0000},
-	{0xBFD0000000000004, 0xB400},
-	{0x40CB8EE8A418241A, 0x72E4},
-	{0xBFD0000000000008, 0xB400},
-	{0x47F8A8569056D1F1, 0x7C00},
-	{0xBFD0000000000010, 0xB400},
-	{0xBF7000FFFFFFFFEE, 0x9C00},
-	{0xBFD0000000000020, 0xB400},
-	{0xC7F1207E6793CF35, 0xFC00},
-	{0xBFD0000000000040, 0xB400},
-	{0xBFEFF7FFFFF7FFFF, 0xBBFE},
-	{0xBFD0000000000080, 0xB400},
-	{0xB8100004003FFFFF, 0x8000},
-	{0xBFD0000000000100, 0xB400},
-	{0x40000000041FFFFE, 0x4000},
-	{0xBFD0000000000200, 0xB400},
-	{0xC00C322EAA6A195F, 0xC30D},
-	{0xBFD0000000000400, 0xB400},
-	{0x41EFFFFFFFFFEE00, 0x7C00},
-	{0xBFD0000000000800, 0xB400},
-	{0x002000800000001F, 0x0000},
-	{0xBFD0000000001000, 0xB400},
-	{0xBFB00000007FFFFE, 0xAC00},
-	{0xBFD0000000002000, 0xB400},
-	{0x4042E380030883DE, 0x50B9},
-	{0xBFD0000000004000, 0xB400},
-	{0xC1F81E4EE45866C2, 0xFC00},
-	{0xBFD0000000008000, 0xB400},
-	{0xC1F23CFA57A1EAA3, 0xFC00},
-	{0xBFD0000000010000, 0xB400},
-	{0xC1EDD6D1E7AB0388, 0xFC00},
-	{0xBFD0000000020000, 0xB400},
-	{0x43FFFFFFFDFFFDFF, 0x7C00},
-	{0xBFD0000000040000, 0xB400},
-	{0xD9AE4B80764013CB, 0xFC00},
-	{0xBFD0000000080000, 0xB400},
-	{0xBFC00000400FFFFE, 0xB000},
-	{0xBFD0000000100000, 0xB400},
-	{0xC3E00000000801FF, 0xFC00},
-	{0xBFD0000000200000, 0xB400},
-	{0x408A507ACA23A0AF, 0x6294},
-	{0xBFD0000000400000, 0xB400},
-	{0x37EFFFFFE0000002, 0x0000},
-	{0xBFD0000000800000, 0xB400},
-	{0xBFE1A64BBBCA8579, 0xB86A},
-	{0xBFD0000001000000, 0xB400},
-	{0xC27FFFFF40000000, 0xFC00},
-	{0xBFD0000002000000, 0xB400},
-	{0x40100010000001FE, 0x4400},
-	{0xBFD0000004000000, 0xB400},
-	{0xB810000000000202, 0x8000},
-	{0xBFD0000008000000, 0xB400},
-	{0xC216E3E30F6921DA, 0xFC00},
-	{0xBFD0000010000000, 0xB400},
-	{0xC010000000007DFE, 0xC400},
-	{0xBFD0000020000000, 0xB400},
-	{0x38D000001000003F, 0x0000},
-	{0xBFD0000040000000, 0xB400},
-	{0x3CB00003FFEFFFFE, 0x0000},
-	{0xBFD0000080000000, 0xB400},
-	{0x3FC007FFFFFEFFFE, 0x3002},
-	{0xBFD0000100000000, 0xB400},
-	{0xC3E000000000800F, 0xFC00},
-	{0xBFD0000200000000, 0xB400},
-	{0xBE6FFFFFFFFF8020, 0x8001},
-	{0xBFD0000400000000, 0xB400},
-	{0xC1C00000003FFFFB, 0xFC00},
-	{0xBFD0000800000000, 0xB400},
-	{0x43E00000008003FF, 0x7C00},
-	{0xBFD0001000000000, 0xB400},
-	{0x3FFFFFFFFFF77FFF, 0x4000},
-	{0xBFD0002000000000, 0xB400},
-	{0xB80B3E384478A49A, 0x8000},
-	{0xBFD0004000000000, 0xB400},
-	{0xC3C0000801FFFFFF, 0xFC00},
-	{0xBFD0008000000000, 0xB400},
-	{0x3FD08000000003FE, 0x3420},
-	{0xBFD0010000000000, 0xB400},
-	{0xBFB00000FFFFF7FF, 0xAC00},
-	{0xBFD0020000000000, 0xB400},
-	{0xC14EDCE428514A4F, 0xFC00},
-	{0xBFD0040000000000, 0xB401},
-	{0x010EF0C3D11C56F7, 0x0000},
-	{0xBFD0080000000000, 0xB402},
-	{0x41F00000013FFFFE, 0x7C00},
-	{0xBFD0100000000000, 0xB404},
-	{0xC02280EE0484B077, 0xC8A0},
-	{0xBFD0200000000000, 0xB408},
-	{0x7FD0000000000000, 0x7C00},
-	{0xBFD0400000000000, 0xB410},
-	{0xB7FFFFFFDFFFFF7F, 0x8000},
-	{0xBFD0800000000000, 0xB420},
-	{0x3FC000000100001E, 0x3000},
-	{0xBFD1000000000000, 0xB440},
-	{0xC7FFFFBFFFFFFFFD, 0xFC00},
-	{0xBFD2000000000000, 0xB480},
-	{0x5E7E0FFFFFFFFFFE, 0x7C00},
-	{0xBFD4000000000000, 0xB500},
-	{0x43D80000001FFFFF, 0x7C00},
-	{0xBFD8000000000000, 0xB600},
-	{0x403BFFFFFFFFFDFE, 0x4F00},
-	{0xBFDC000000000000, 0xB700},
-	{0xC46FFF8040000000, 0xFC00},
-	{0xBFDE000000000000, 0xB780},
-	{0x40499EF36023A268, 0x5268},
-	{0xBFDF000000000000, 0xB7C0},
-	{0xC1A0020400000000, 0xFC00},
-	{0xBFDF800000000000, 0xB7E0},
-	{0xC34FBFFFFFFFFFF0, 0xFC00},
-	{0xBFDFC00000000000, 0xB7F0},
-	{0x356EF2142FAA2E87, 0x0000},
-	{0xBFDFE00000000000, 0xB7F8},
-	{0xBFDFFFFF0001FFFE, 0xB800},
-	{0xBFDFF00000000000, 0xB7FC},
-	{0x8113FFFFFFFFFFDF, 0x8000},
-	{0xBFDFF80000000000, 0xB7FE},
-	{0x38103FFFFBFFFFFF, 0x0000},
-	{0xBFDFFC0000000000, 0xB7FF},
-	{0x7FD1F7FFFFFFFFFF, 0x7C00},
-	{0xBFDFFE0000000000, 0xB800},
-	{0xBFEFEFFFFFEFFFFE, 0xBBFC},
-	{0xBFDFFF0000000000, 0xB800},
-	{0x40100000003FEFFF, 0x4400},
-	{0xBFDFFF8000000000, 0xB800},
-	{0xFFEDFFFFFFF7FFFF, 0xFC00},
-	{0xBFDFFFC000000000, 0xB800},
-	{0x47F629CCB54C6091, 0x7C00},
-	{0xBFDFFFE000000000, 0xB800},
-	{0xC03FFFFF80000002, 0xD000},
-	{0xBFDFFFF000000000, 0xB800},
-	{0x47E0080000000020, 0x7C00},
-	{0xBFDFFFF800000000, 0xB800},
-	{0xFFF000007FFFFEFF, 0xFE00},
-	{0xBFDFFFFC00000000, 0xB800},
-	{0x802FFF7FFFFFFFFF, 0x8000},
-	{0xBFDFFFFE00000000, 0xB800},
-	{0xBFBFFFFFFFFFC004, 0xB000},
-	{0xBFDFFFFF00000000, 0xB800},
-	{0x41CF800007FFFFFE, 0x7C00},
-	{0xBFDFFFFF80000000, 0xB800},
-	{0x3ECFFFFFFFFFFFFE, 0x0040},
-	{0xBFDFFFFFC0000000, 0xB800},
-	{0xC560080000000400, 0xFC00},
-	{0xBFDFFFFFE0000000, 0xB800},
-	{0xB7FFFFFFBFBFFFFE, 0x8000},
-	{0xBFDFFFFFF0000000, 0xB800},
-	{0x41E000017FFFFFFF, 0x7C00},
-	{0xBFDFFFFFF8000000, 0xB800},
-	{0xB10100000FFFFFFE, 0x8000},
-	{0xBFDFFFFFFC000000, 0xB800},
-	{0x47EE50C81C86E392, 0x7C00},
-	{0xBFDFFFFFFE000000, 0xB800},
-	{0xBFF0480000000000, 0xBC12},
-	{0xBFDFFFFFFF000000, 0xB800},
-	{0xBFCFFFFDFFFFF800, 0xB400},
-	{0xBFDFFFFFFF800000, 0xB800},
-	{0xFFDFFFFFF7FEFFFF, 0xFC00},
-	{0xBFDFFFFFFFC00000, 0xB800},
-	{0xBF30000000028000, 0x8C00},
-	{0xBFDFFFFFFFE00000, 0xB800},
-	{0x3FC79AAE46FDB179, 0x31E7},
-	{0xBFDFFFFFFFF00000, 0xB800},
-	{0x403F80000000FFFF, 0x4FE0},
-	{0xBFDFFFFFFFF80000, 0xB800},
-	{0x001FFFFFFFFFE7FF, 0x0000},
-	{0xBFDFFFFFFFFC0000, 0xB800},
-	{0xC01FFFFBFFFFFFFD, 0xC800},
-	{0xBFDFFFFFFFFE0000, 0xB800},
-	{0xC0AFBFFFFFFFFFEF, 0xEBF0},
-	{0xBFDFFFFFFFFF0000, 0xB800},
-	{0xCE4FFFFFFFEFFFEF, 0xFC00},
-	{0xBFDFFFFFFFFF8000, 0xB800},
-	{0xC34DEE4114592683, 0xFC00},
-	{0xBFDFFFFFFFFFC000, 0xB800},
-	{0x3D4FFFFFFFFEFFBE, 0x0000},
-	{0xBFDFFFFFFFFFE000, 0xB800},
-	{0xC3E00000000023FF, 0xFC00},
-	{0xBFDFFFFFFFFFF000, 0xB800},
-	{0x00110000000007FE, 0x0000},
-	{0xBFDFFFFFFFFFF800, 0xB800},
-	{0xB7E000000107FFFE, 0x8000},
-	{0xBFDFFFFFFFFFFC00, 0xB800},
-	{0x7FDFFFFDFFFFFFFC, 0x7C00},
-	{0xBFDFFFFFFFFFFE00, 0xB800},
-	{0xC17FFF7BFFFFFFFF, 0xFC00},
-	{0xBFDFFFFFFFFFFF00, 0xB800},
-	{0xC28004001FFFFFFE, 0xFC00},
-	{0xBFDFFFFFFFFFFF80, 0xB800},
-	{0xC3D0002000007FFE, 0xFC00},
-	{0xBFDFFFFFFFFFFFC0, 0xB800},
-	{0x403FFFFFEFFFFBFF, 0x5000},
-	{0xBFDFFFFFFFFFFFE0, 0xB800},
-	{0xA7800007FFFFFFF8, 0x8000},
-	{0xBFDFFFFFFFFFFFF0, 0xB800},
-	{0xC03F000000800000, 0xCFC0},
-	{0xBFDFFFFFFFFFFFF8, 0xB800},
-	{0x46D01FFFFFFFFF7F, 0x7C00},
-	{0xBFDFFFFFFFFFFFFC, 0xB800},
-	{0x3FF6A7BA0E1B14FB, 0x3DAA},
-	{0xBFDFFFFFFFFFFFFE, 0xB800},
-	{0x41FFFFFFFFFFFF7C, 0x7C00},
-	{0xBFDFFFFFFFFFFFFF, 0xB800},
-	{0x867FFF803FFFFFFF, 0x8000},
-	{0xBFDFFFFFFFFFFFFD, 0xB800},
-	{0x400FFF7FFFF7FFFF, 0x4400},
-	{0xBFDFFFFFFFFFFFFB, 0xB800},
-	{0xC1DFE0000FFFFFFF, 0xFC00},
-	{0xBFDFFFFFFFFFFFF7, 0xB800},
-	{0x41CFFFFFFFFFFC00, 0x7C00},
-	{0xBFDFFFFFFFFFFFEF, 0xB800},
-	{0xAB300000001DFFFE, 0x8000},
-	{0xBFDFFFFFFFFFFFDF, 0xB800},
-	{0xC0256F28F1E9F6AB, 0xC95C},
-	{0xBFDFFFFFFFFFFFBF, 0xB800},
-	{0xBFEFF00200000000, 0xBBFC},
-	{0xBFDFFFFFFFFFFF7F, 0xB800},
-	{0x5D7FFFFBDFFFFFFF, 0x7C00},
-	{0xBFDFFFFFFFFFFEFF, 0xB800},
-	{0xC015FD12CEFDBECB, 0xC57F},
-	{0xBFDFFFFFFFFFFDFF, 0xB800},
-	{0xC0DC03FFFFFFFFFE, 0xF701},
-	{0xBFDFFFFFFFFFFBFF, 0xB800},
-	{0x37F73EAFE91CFFBD, 0x0000},
-	{0xBFDFFFFFFFFFF7FF, 0xB800},
-	{0xB267E6BC30542BEB, 0x8000},
-	{0xBFDFFFFFFFFFEFFF, 0xB800},
-	{0xBFDFFFFFFFF00006, 0xB800},
-	{0xBFDFFFFFFFFFDFFF, 0xB800},
-	{0x37EFFFFFFFE003FF, 0x0000},
-	{0xBFDFFFFFFFFFBFFF, 0xB800},
-	{0x43588E639A6541F5, 0x7C00},
-	{0xBFDFFFFFFFFF7FFF, 0xB800},
-	{0x380FFFFBFFFFFFF6, 0x0000},
-	{0xBFDFFFFFFFFEFFFF, 0xB800},
-	{0xC1C8246C80DDC98F, 0xFC00},
-	{0xBFDFFFFFFFFDFFFF, 0xB800},
-	{0xBCAFFFFFF800003E, 0x8000},
-	{0xBFDFFFFFFFFBFFFF, 0xB800},
-	{0xBF8DFFFFFFBFFFFF, 0xA380},
-	{0xBFDFFFFFFFF7FFFF, 0xB800},
-	{0xC020040000000040, 0xC801},
-	{0xBFDFFFFFFFEFFFFF, 0xB800},
-	{0x33BFFFF80000003F, 0x0000},
-	{0xBFDFFFFFFFDFFFFF, 0xB800},
-	{0x00200007FDFFFFFF, 0x0000},
-	{0xBFDFFFFFFFBFFFFF, 0xB800},
-	{0x7FFFF7FFFC000000, 0x7FFD},
-	{0xBFDFFFFFFF7FFFFF, 0xB800},
-	{0x000400000000001F, 0x0000},
-	{0xBFDFFFFFFEFFFFFF, 0xB800},
-	{0x43F0000000EFFFFF, 0x7C00},
-	{0xBFDFFFFFFDFFFFFF, 0xB800},
-	{0x47F08C1AFC4D5EE8, 0x7C00},
-	{0xBFDFFFFFFBFFFFFF, 0xB800},
-	{0x40DFFFF800000020, 0x7800},
-	{0xBFDFFFFFF7FFFFFF, 0xB800},
-	{0xC1CFFFFFBFEFFFFE, 0xFC00},
-	{0xBFDFFFFFEFFFFFFF, 0xB800},
-	{0xC3D4828DA0400EBF, 0xFC00},
-	{0xBFDFFFFFDFFFFFFF, 0xB800},
-	{0x3FBB9F6DECF2AF2B, 0x2EE8},
-	{0xBFDFFFFFBFFFFFFF, 0xB800},
-	{0x401CBAFFF11FE5A4, 0x472F},
-	{0xBFDFFFFF7FFFFFFF, 0xB800},
-	{0x13F8000001FFFFFF, 0x0000},
-	{0xBFDFFFFEFFFFFFFF, 0xB800},
-	{0x613FFFFC00000200, 0x7C00},
-	{0xBFDFFFFDFFFFFFFF, 0xB800},
-	{0x3F8275CD7E84BE8F, 0x209D},
-	{0xBFDFFFFBFFFFFFFF, 0xB800},
-	{0x441CFFFFFFFFFFFE, 0x7C00},
-	{0xBFDFFFF7FFFFFFFF, 0xB800},
-	{0xC7EFA6E287F87127, 0xFC00},
-	{0xBFDFFFEFFFFFFFFF, 0xB800},
-	{0x319550B74A43F603, 0x0000},
-	{0xBFDFFFDFFFFFFFFF, 0xB800},
-	{0xBA1000002000FFFE, 0x8000},
-	{0xBFDFFFBFFFFFFFFF, 0xB800},
-	{0xBFF0003FFFFFC000, 0xBC00},
-	{0xBFDFFF7FFFFFFFFF, 0xB800},
-	{0xC3D922262359C180, 0xFC00},
-	{0xBFDFFEFFFFFFFFFF, 0xB800},
-	{0xBCBF7EFFFFFFFFFF, 0x8000},
-	{0xBFDFFDFFFFFFFFFF, 0xB7FF},
-	{0x6D3FFDFFFFE00000, 0x7C00},
-	{0xBFDFFBFFFFFFFFFF, 0xB7FF},
-	{0x37E020000000007F, 0x0000},
-	{0xBFDFF7FFFFFFFFFF, 0xB7FE},
-	{0x79BFFFFFFDFFF7FE, 0x7C00},
-	{0xBFDFEFFFFFFFFFFF, 0xB7FC},
-	{0x401FFFC07FFFFFFE, 0x4800},
-	{0xBFDFDFFFFFFFFFFF, 0xB7F8},
-	{0xBFE010000000003F, 0xB804},
-	{0xBFDFBFFFFFFFFFFF, 0xB7F0},
-	{0xFFFEFFF000000000, 0xFFBF},
-	{0xBFDF7FFFFFFFFFFF, 0xB7E0},
-	{0x45DE88CD99910837, 0x7C00},
-	{0xBFDEFFFFFFFFFFFF, 0xB7C0},
-	{0x401FFFDFFFFFDFFF, 0x4800},
-	{0xBFDDFFFFFFFFFFFF, 0xB780},
-	{0x40308A90D4CF3AD3, 0x4C23},
-	{0xBFDBFFFFFFFFFFFF, 0xB700},
-	{0xBD20800001FFFFFE, 0x8000},
-	{0xBFD7FFFFFFFFFFFF, 0xB600},
-	{0xC7EFFFDFFFFFFBFE, 0xFC00},
-	{0xBFD3FFFFFFFFFFFF, 0xB500},
-	{0x3F800000FFFFFEFE, 0x2000},
-	{0xBFD1FFFFFFFFFFFF, 0xB480},
-	{0xC09C000000000000, 0xE700},
-	{0xBFD0FFFFFFFFFFFF, 0xB440},
-	{0x47F1708515B7A323, 0x7C00},
-	{0xBFD07FFFFFFFFFFF, 0xB420},
-	{0x41D0100FFFFFFFFF, 0x7C00},
-	{0xBFD03FFFFFFFFFFF, 0xB410},
-	{0x3ED40000FFFFFFFE, 0x0050},
-	{0xBFD01FFFFFFFFFFF, 0xB408},
-	{0x3FF0800000001FFE, 0x3C20},
-	{0xBFD00FFFFFFFFFFF, 0xB404},
-	{0xBFE294557EF64F90, 0xB8A5},
-	{0xBFD007FFFFFFFFFF, 0xB402},
-	{0xBFEFFFFFFFFF03FE, 0xBC00},
-	{0xBFD003FFFFFFFFFF, 0xB401},
-	{0xFFF0000000FEFFFF, 0xFE00},
-	{0xBFD001FFFFFFFFFF, 0xB400},
-	{0x4067FFFFFFFFFEFE, 0x5A00},
-	{0xBFD000FFFFFFFFFF, 0xB400},
-	{0x3FF0007FFFFF7FFE, 0x3C00},
-	{0xBFD0007FFFFFFFFF, 0xB400},
-	{0x3FDFFFE00000001F, 0x3800},
-	{0xBFD0003FFFFFFFFF, 0xB400},
-	{0x41CFFEFFFF7FFFFF, 0x7C00},
-	{0xBFD0001FFFFFFFFF, 0xB400},
-	{0x7FFFFFFFFFFFBF7E, 0x7FFF},
-	{0xBFD0000FFFFFFFFF, 0xB400},
-	{0x7A3FFFFFFF7FFF80, 0x7C00},
-	{0xBFD00007FFFFFFFF, 0xB400},
-	{0xBCAFFFF7FFEFFFFE, 0x8000},
-	{0xBFD00003FFFFFFFF, 0xB400},
-	{0x41E7F6A825C9BA2F, 0x7C00},
-	{0xBFD00001FFFFFFFF, 0xB400},
-	{0x0D80007FFFEFFFFF, 0x0000},
-	{0xBFD00000FFFFFFFF, 0xB400},
-	{0x3F9FFFC000000400, 0x2800},
-	{0xBFD000007FFFFFFF, 0xB400},
-	{0x40240007FFFFFFFE, 0x4900},
-	{0xBFD000003FFFFFFF, 0xB400},
-	{0xC1FFFF001FFFFFFF, 0xFC00},
-	{0xBFD000001FFFFFFF, 0xB400},
-	{0x0013FFFFFFFFFFFC, 0x0000},
-	{0xBFD000000FFFFFFF, 0xB400},
-	{0xC1FD0E972C65A21C, 0xFC00},
-	{0xBFD0000007FFFFFF, 0xB400},
-	{0x3DA0000040000008, 0x0000},
-	{0xBFD0000003FFFFFF, 0xB400},
-	{0xC27FFFFFFFFEDFFF, 0xFC00},
-	{0xBFD0000001FFFFFF, 0xB400},
-	{0xC00FFFFFFFEFFFFE, 0xC400},
-	{0xBFD0000000FFFFFF, 0xB400},
-	{0xC0800000007FEFFF, 0xE000},
-	{0xBFD00000007FFFFF, 0xB400},
-	{0x43EFFFE007FFFFFE, 0x7C00},
-	{0xBFD00000003FFFFF, 0xB400},
-	{0xBFDFC00003FFFFFF, 0xB7F0},
-	{0xBFD00000001FFFFF, 0xB400},
-	{0x37EFFFFFF0010000, 0x0000},
-	{0xBFD00000000FFFFF, 0xB400},
-	{0xAD00000020000400, 0x8000},
-	{0xBFD000000007FFFF, 0xB400},
-	{0x3FC00000000FFE00, 0x3000},
-	{0xBFD000000003FFFF, 0xB400},
-	{0xC80FFFE00000001E, 0xFC00},
-	{0xBFD000000001FFFF, 0xB400},
-	{0x41CFFFFFFFFFEFFF, 0x7C00},
-	{0xBFD000000000FFFF, 0xB400},
-	{0x34A01BFFFFFFFFFE, 0x0000},
-	{0xBFD0000000007FFF, 0xB400},
-	{0xFFE22B23EC8ED491, 0xFC00},
-	{0xBFD0000000003FFF, 0xB400},
-	{0xB5000000400007FE, 0x8000},
-	{0xBFD0000000001FFF, 0xB400},
-	{0x3F60800000003FFE, 0x1820},
-	{0xBFD0000000000FFF, 0xB400},
-	{0x43CFFEFFFFEFFFFE, 0x7C00},
-	{0xBFD00000000007FF, 0xB400},
-	{0xBFF52948E6BA99D4, 0xBD4A},
-	{0xBFD00000000003FF, 0xB400},
-	{0x40338806E2A01135, 0x4CE2},
-	{0xBFD00000000001FF, 0xB400},
-	{0xC7E791CD35B705BD, 0xFC00},
-	{0xBFD00000000000FF, 0xB400},
-	{0x6C80021FFFFFFFFF, 0x7C00},
-	{0xBFD000000000007F, 0xB400},
-	{0xBFAFFFFC7FFFFFFF, 0xAC00},
-	{0xBFD000000000003F, 0xB400},
-	{0x402FFFFFFE0007FF, 0x4C00},
-	{0xBFD000000000001F, 0xB400},
-	{0x40A01000000007FF, 0x6804},
-	{0xBFD000000000000F, 0xB400},
-	{0x800FFFFFFFDEFFFE, 0x8000},
-	{0xBFD0000000000007, 0xB400},
-	{0x403A65F3E01E40F2, 0x4E99},
-	{0xBFD0000000000003, 0xB400},
-	{0xC1E0C0A8F327BABD, 0xFC00},
-	{0xBFE0000000000000, 0xB800},
-	{0x451FFFFFFFF8FFFF, 0x7C00},
-	{0xBFE0000000000001, 0xB800},
-	{0xC34000000000FFEF, 0xFC00},
-	{0xBFE0000000000002, 0xB800},
-	{0xC800000400000003, 0xFC00},
-	{0xBFE0000000000004, 0xB800},
-	{0xC7F0000020003FFF, 0xFC00},
-	{0xBFE0000000000008, 0xB800},
-	{0x48000000000BFFFE, 0x7C00},
-	{0xBFE0000000000010, 0xB800},
-	{0x3E1FFFFFFFFFFEFD, 0x0000},
-	{0xBFE0000000000020, 0xB800},
-	{0xC1EFFFC010000000, 0xFC00},
-	{0xBFE0000000000040, 0xB800},
-	{0xBF140000003FFFFF, 0x8500},
-	{0xBFE0000000000080, 0xB800},
-	{0x7FE000040003FFFF, 0x7C00},
-	{0xBFE0000000000100, 0xB800},
-	{0x3CA200000000000F, 0x0000},
-	{0xBFE0000000000200, 0xB800},
-	{0xC0104000FFFFFFFE, 0xC410},
-	{0xBFE0000000000400, 0xB800},
-	{0x08532E7E46D09B48, 0x0000},
-	{0xBFE0000000000800, 0xB800},
-	{0x408EFFFFFFFFFFDE, 0x63C0},
-	{0xBFE0000000001000, 0xB800},
-	{0x403F7DFFFFFFFFFF, 0x4FDF},
-	{0xBFE0000000002000, 0xB800},
-	{0x400FFFFFFFFDBFFE, 0x4400},
-	{0xBFE0000000004000, 0xB800},
-	{0xC087430176ACEF05, 0xE1D1},
-	{0xBFE0000000008000, 0xB800},
-	{0xC3CFFFFFFFFD7FFF, 0xFC00},
-	{0xBFE0000000010000, 0xB800},
-	{0x3FD000000000010E, 0x3400},
-	{0xBFE0000000020000, 0xB800},
-	{0xC0A0020000800000, 0xE801},
-	{0xBFE0000000040000, 0xB800},
-	{0xB7F07FFFFFF00000, 0x8000},
-	{0xBFE0000000080000, 0xB800},
-	{0x3FB0000000000800, 0x2C00},
-	{0xBFE0000000100000, 0xB800},
-	{0xBF6B7376F3EA10AF, 0x9ADD},
-	{0xBFE0000000200000, 0xB800},
-	{0xB81B7F9CE89C0DE4, 0x8000},
-	{0xBFE0000000400000, 0xB800},
-	{0x4033D664D4F7940F, 0x4CF6},
-	{0xBFE0000000800000, 0xB800},
-	{0x3FFFFFFFFFBFDFFE, 0x4000},
-	{0xBFE0000001000000, 0xB800},
-	{0xC1F9827F80B7F3CB, 0xFC00},
-	{0xBFE0000002000000, 0xB800},
-	{0x43DE3B04E66118C8, 0x7C00},
-	{0xBFE0000004000000, 0xB800},
-	{0x3FDFFF0000000FFF, 0x3800},
-	{0xBFE0000008000000, 0xB800},
-	{0xB7E599E2A50CE474, 0x8000},
-	{0xBFE0000010000000, 0xB800},
-	{0x3FCFF83FFFFFFFFF, 0x33FE},
-	{0xBFE0000020000000, 0xB800},
-	{0xC0000000002000FF, 0xC000},
-	{0xBFE0000040000000, 0xB800},
-	{0x8017FFFFFFDFFFFE, 0x8000},
-	{0xBFE0000080000000, 0xB800},
-	{0xC1CFFFFFFFFFFBFF, 0xFC00},
-	{0xBFE0000100000000, 0xB800},
-	{0x3FE00BFFFFFFFFFF, 0x3803},
-	{0xBFE0000200000000, 0xB800},
-	{0xD81FFFBF7FFFFFFE, 0xFC00},
-	{0xBFE0000400000000, 0xB800},
-	{0x3920000002003FFF, 0x0000},
-	{0xBFE0000800000000, 0xB800},
-	{0xB81FFFFF801FFFFE, 0x8000},
-	{0xBFE0001000000000, 0xB800},
-	{0xC01B757365E4E956, 0xC6DD},
-	{0xBFE0002000000000, 0xB800},
-	{0x4E2FC07FFFFFFFFF, 0x7C00},
-	{0xBFE0004000000000, 0xB800},
-	{0xC3F000007E000000, 0xFC00},
-	{0xBFE0008000000000, 0xB800},
-	{0x7FFA636555FF7CB4, 0x7E98},
-	{0xBFE0010000000000, 0xB800},
-	{0x80100000000040FF, 0x8000},
-	{0xBFE0020000000000, 0xB800},
-	{0x400FFFFFFF020000, 0x4400},
-	{0xBFE0040000000000, 0xB801},
-	{0xC12FFDFFFFFFFFFA, 0xFC00},
-	{0xBFE0080000000000, 0xB802},
-	{0xC6E0000001FFFFF8, 0xFC00},
-	{0xBFE0100000000000, 0xB804},
-	{0x3810EA9577BA0F85, 0x0000},
-	{0xBFE0200000000000, 0xB808},
-	{0xC50FFFF7FFFFDFFF, 0xFC00},
-	{0xBFE0400000000000, 0xB810},
-	{0xBDA000007FFFFFFE, 0x8000},
-	{0xBFE0800000000000, 0xB820},
-	{0x1F6F0000000000FE, 0x0000},
-	{0xBFE1000000000000, 0xB840},
-	{0xD6F8C92C48FB47D2, 0xFC00},
-	{0xBFE2000000000000, 0xB880},
-	{0x33FFFFFFDFFFFFBF, 0x0000},
-	{0xBFE4000000000000, 0xB900},
-	{0x4012000001000000, 0x4480},
-	{0xBFE8000000000000, 0xBA00},
-	{0x47E0001000000002, 0x7C00},
-	{0xBFEC000000000000, 0xBB00},
-	{0x3B3100001FFFFFFF, 0x0000},
-	{0xBFEE000000000000, 0xBB80},
-	{0x00282FC79A29B294, 0x0000},
-	{0xBFEF000000000000, 0xBBC0},
-	{0x44A00000000000BF, 0x7C00},
-	{0xBFEF800000000000, 0xBBE0},
-	{0x28B0FFFFFF7FFFFF, 0x0000},
-	{0xBFEFC00000000000, 0xBBF0},
-	{0xFFFFFDFFFFFFFF7E, 0xFFFF},
-	{0xBFEFE00000000000, 0xBBF8},
-	{0x480A816692BB1492, 0x7C00},
-	{0xBFEFF00000000000, 0xBBFC},
-	{0x7359AAFD3EA6B19B, 0x7C00},
-	{0xBFEFF80000000000, 0xBBFE},
-	{0xC0F5B6CD776EACC0, 0xFC00},
-	{0xBFEFFC0000000000, 0xBBFF},
-	{0xBCA93E809DE0269A, 0x8000},
-	{0xBFEFFE0000000000, 0xBC00},
-	{0x400FFF7F7FFFFFFF, 0x4400},
-	{0xBFEFFF0000000000, 0xBC00},
-	{0xB7F0000200800000, 0x8000},
-	{0xBFEFFF8000000000, 0xBC00},
-	{0xBFBFBBFFFFFFFFFE, 0xAFEF},
-	{0xBFEFFFC000000000, 0xBC00},
-	{0x41C3677B131B628A, 0x7C00},
-	{0xBFEFFFE000000000, 0xBC00},
-	{0x43F01FFFFFFFFBFF, 0x7C00},
-	{0xBFEFFFF000000000, 0xBC00},
-	{0xC3E4BE3F210B5788, 0xFC00},
-	{0xBFEFFFF800000000, 0xBC00},
-	{0xBFBFFBFFFDFFFFFF, 0xAFFF},
-	{0xBFEFFFFC00000000, 0xBC00},
-	{0x43FFC00200000000, 0x7C00},
-	{0xBFEFFFFE00000000, 0xBC00},
-	{0x3CAFAE7E8CB1B460, 0x0000},
-	{0xBFEFFFFF00000000, 0xBC00},
-	{0xBFE01FFFFFFFFEFE, 0xB808},
-	{0xBFEFFFFF80000000, 0xBC00},
-	{0x47FB5C2B5224A247, 0x7C00},
-	{0xBFEFFFFFC0000000, 0xBC00},
-	{0xCAFFFFFFFFFFFEFD, 0xFC00},
-	{0xBFEFFFFFE0000000, 0xBC00},
-	{0x37EF7DFFFFFFFFFF, 0x0000},
-	{0xBFEFFFFFF0000000, 0xBC00},
-	{0x41255D9146E83DDF, 0x7C00},
-	{0xBFEFFFFFF8000000, 0xBC00},
-	{0x43D0EFFFFFFFFFFF, 0x7C00},
-	{0xBFEFFFFFFC000000, 0xBC00},
-	{0xA467EEC6D11B1105, 0x8000},
-	{0xBFEFFFFFFE000000, 0xBC00},
-	{0xFCDFFFFFFEFF7FFF, 0xFC00},
-	{0xBFEFFFFFFF000000, 0xBC00},
-	{0x407F7FFFFF000000, 0x5FE0},
-	{0xBFEFFFFFFF800000, 0xBC00},
-	{0x32B007FFFFFFBFFF, 0x0000},
-	{0xBFEFFFFFFFC00000, 0xBC00},
-	{0xFFDFFC000003FFFF, 0xFC00},
-	{0xBFEFFFFFFFE00000, 0xBC00},
-	{0xC7FFFF0000000010, 0xFC00},
-	{0xBFEFFFFFFFF00000, 0xBC00},
-	{0x4021080000000000, 0x4842},
-	{0xBFEFFFFFFFF80000, 0xBC00},
-	{0x3F899EE775E422F2, 0x2268},
-	{0xBFEFFFFFFFFC0000, 0xBC00},
-	{0x3FD070B5A2EBF322, 0x341C},
-	{0xBFEFFFFFFFFE0000, 0xBC00},
-	{0xFFEFFEFFFFFFFF7F, 0xFC00},
-	{0xBFEFFFFFFFFF0000, 0xBC00},
-	{0x7FF00083FFFFFFFE, 0x7E00},
-	{0xBFEFFFFFFFFF8000, 0xBC00},
-	{0x421FFFF900000000, 0x7C00},
-	{0xBFEFFFFFFFFFC000, 0xBC00},
-	{0xB80FFBFFFFFFFDFF, 0x8000},
-	{0xBFEFFFFFFFFFE000, 0xBC00},
-	{0x380E2A6EE4BB51DB, 0x0000},
-	{0xBFEFFFFFFFFFF000, 0xBC00},
-	{0xA3600000000081FF, 0x8000},
-	{0xBFEFFFFFFFFFF800, 0xBC00},
-	{0x8027EFF80978C37B, 0x8000},
-	{0xBFEFFFFFFFFFFC00, 0xBC00},
-	{0xB81EE345AB6754F5, 0x8000},
-	{0xBFEFFFFFFFFFFE00, 0xBC00},
-	{0xBFDFFFF800400000, 0xB800},
-	{0xBFEFFFFFFFFFFF00, 0xBC00},
-	{0x5F1001FFFFFFFF7F, 0x7C00},
-	{0xBFEFFFFFFFFFFF80, 0xBC00},
-	{0xBF1920F14B0BF019, 0x8648},
-	{0xBFEFFFFFFFFFFFC0, 0xBC00},
-	{0xB80FFFFFC0002000, 0x8000},
-	{0xBFEFFFFFFFFFFFE0, 0xBC00},
-	{0x40289F0785ED0F45, 0x4A28},
-	{0xBFEFFFFFFFFFFFF0, 0xBC00},
-	{0xA3CC00000000007F, 0x8000},
-	{0xBFEFFFFFFFFFFFF8, 0xBC00},
-	{0x3DB5140E63767431, 0x0000},
-	{0xBFEFFFFFFFFFFFFC, 0xBC00},
-	{0xC801E4572D52ADED, 0xFC00},
-	{0xBFEFFFFFFFFFFFFE, 0xBC00},
-	{0xC800000000080FFE, 0xFC00},
-	{0xBFEFFFFFFFFFFFFF, 0xBC00},
-	{0x525FFE00000FFFFE, 0x7C00},
-	{0xBFEFFFFFFFFFFFFD, 0xBC00},
-	{0xBCAFFFFFFFFFFFFF, 0x8000},
-	{0xBFEFFFFFFFFFFFFB, 0xBC00},
-	{0xB8194F9E5762811D, 0x8000},
-	{0xBFEFFFFFFFFFFFF7, 0xBC00},
-	{0x3F8FE000003FFFFF, 0x23F8},
-	{0xBFEFFFFFFFFFFFEF, 0xBC00},
-	{0x443FFFFFFFFFFFDE, 0x7C00},
-	{0xBFEFFFFFFFFFFFDF, 0xBC00},
-	{0xBFEFFFFFFFFFDFEF, 0xBC00},
-	{0xBFEFFFFFFFFFFFBF, 0xBC00},
-	{0xC3FFDFFFFFFFEFFF, 0xFC00},
-	{0xBFEFFFFFFFFFFF7F, 0xBC00},
-	{0x413FFFFFE00001FF, 0x7C00},
-	{0xBFEFFFFFFFFFFEFF, 0xBC00},
-	{0xC02B5F253D00BB2C, 0xCAD8},
-	{0xBFEFFFFFFFFFFDFF, 0xBC00},
-	{0xC00000000FFBFFFE, 0xC000},
-	{0xBFEFFFFFFFFFFBFF, 0xBC00},
-	{0xFFF0800000001FFF, 0xFE20},
-	{0xBFEFFFFFFFFFF7FF, 0xBC00},
-	{0xB7E000FFFFFFFFEE, 0x8000},
-	{0xBFEFFFFFFFFFEFFF, 0xBC00},
-	{0xCCA03FFC00000000, 0xFC00},
-	{0xBFEFFFFFFFFFDFFF, 0xBC00},
-	{0xC8001FFFDFFFFFFF, 0xFC00},
-	{0xBFEFFFFFFFFFBFFF, 0xBC00},
-	{0xEEC003FFC0000000, 0xFC00},
-	{0xBFEFFFFFFFFF7FFF, 0xBC00},
-	{0x7FE0000000040010, 0x7C00},
-	{0xBFEFFFFFFFFEFFFF, 0xBC00},
-	{0x002FBFFFFFFFF7FF, 0x0000},
-	{0xBFEFFFFFFFFDFFFF, 0xBC00},
-	{0xC01FFFFFFFFFEF00, 0xC800},
-	{0xBFEFFFFFFFFBFFFF, 0xBC00},
-	{0xC161439C3E1B8AAC, 0xFC00},
-	{0xBFEFFFFFFFF7FFFF, 0xBC00},
-	{0xC1C8000000000100, 0xFC00},
-	{0xBFEFFFFFFFEFFFFF, 0xBC00},
-	{0xBEDFFFDFF8000000, 0x8080},
-	{0xBFEFFFFFFFDFFFFF, 0xBC00},
-	{0xB7FBBB4F286F3F8D, 0x8000},
-	{0xBFEFFFFFFFBFFFFF, 0xBC00},
-	{0x85FF7C0000000000, 0x8000},
-	{0xBFEFFFFFFF7FFFFF, 0xBC00},
-	{0x3C6100000000007F, 0x0000},
-	{0xBFEFFFFFFEFFFFFF, 0xBC00},
-	{0x48D1000000000007, 0x7C00},
-	{0xBFEFFFFFFDFFFFFF, 0xBC00},
-	{0x47F100003FFFFFFF, 0x7C00},
-	{0xBFEFFFFFFBFFFFFF, 0xBC00},
-	{0x403F7FFFFFFEFFFE, 0x4FE0},
-	{0xBFEFFFFFF7FFFFFF, 0xBC00},
-	{0x801FFE000003FFFF, 0x8000},
-	{0xBFEFFFFFEFFFFFFF, 0xBC00},
-	{0xBCB3D3BADD2DED6D, 0x8000},
-	{0xBFEFFFFFDFFFFFFF, 0xBC00},
-	{0x7FDFFFFFFFC000FE, 0x7C00},
-	{0xBFEFFFFFBFFFFFFF, 0xBC00},
-	{0xC47001FFFFFFF000, 0xFC00},
-	{0xBFEFFFFF7FFFFFFF, 0xBC00},
-	{0x3FDFFBFFFFFFFFFB, 0x37FF},
-	{0xBFEFFFFEFFFFFFFF, 0xBC00},
-	{0xBFD1487E46CF981C, 0xB452},
-	{0xBFEFFFFDFFFFFFFF, 0xBC00},
-	{0xBFD992BC2DE539DC, 0xB665},
-	{0xBFEFFFFBFFFFFFFF, 0xBC00},
-	{0x4A9FFFFF80000400, 0x7C00},
-	{0xBFEFFFF7FFFFFFFF, 0xBC00},
-	{0xB9803FFFEFFFFFFF, 0x8000},
-	{0xBFEFFFEFFFFFFFFF, 0xBC00},
-	{0x4020DB43BE6D00D0, 0x4837},
-	{0xBFEFFFDFFFFFFFFF, 0xBC00},
-	{0xBFD0007FFFBFFFFF, 0xB400},
-	{0xBFEFFFBFFFFFFFFF, 0xBC00},
-	{0xD43F7FFFFFDFFFFF, 0xFC00},
-	{0xBFEFFF7FFFFFFFFF, 0xBC00},
-	{0x40305FFFFFFFFFFE, 0x4C18},
-	{0xBFEFFEFFFFFFFFFF, 0xBC00},
-	{0x3FCFFFFFFFFBFFF7, 0x3400},
-	{0xBFEFFDFFFFFFFFFF, 0xBBFF},
-	{0xBFFAE0D657D3C2D8, 0xBEB8},
-	{0xBFEFFBFFFFFFFFFF, 0xBBFF},
-	{0xB2AF5F088F220417, 0x8000},
-	{0xBFEFF7FFFFFFFFFF, 0xBBFE},
-	{0xBFE92B8D205FA6AD, 0xBA4B},
-	{0xBFEFEFFFFFFFFFFF, 0xBBFC},
-	{0x4060000007FFFC00, 0x5800},
-	{0xBFEFDFFFFFFFFFFF, 0xBBF8},
-	{0xC7EFFFFFFFFFFF3F, 0xFC00},
-	{0xBFEFBFFFFFFFFFFF, 0xBBF0},
-	{0x381001FBFFFFFFFF, 0x0000},
-	{0xBFEF7FFFFFFFFFFF, 0xBBE0},
-	{0x05300004001FFFFF, 0x0000},
-	{0xBFEEFFFFFFFFFFFF, 0xBBC0},
-	{0xBFCFFFDFFFF7FFFF, 0xB400},
-	{0xBFEDFFFFFFFFFFFF, 0xBB80},
-	{0x4480003FFFFFFFFF, 0x7C00},
-	{0xBFEBFFFFFFFFFFFF, 0xBB00},
-	{0xBFD0991201C93482, 0xB426},
-	{0xBFE7FFFFFFFFFFFF, 0xBA00},
-	{0xC0DDFFFDFFFFFFFF, 0xF780},
-	{0xBFE3FFFFFFFFFFFF, 0xB900},
-	{0xBFF914CDE2FBEB5E, 0xBE45},
-	{0xBFE1FFFFFFFFFFFF, 0xB880},
-	{0x47F00000000000DF, 0x7C00},
-	{0xBFE0FFFFFFFFFFFF, 0xB840},
-	{0x402FFFFFFFFFFF77, 0x4C00},
-	{0xBFE07FFFFFFFFFFF, 0xB820},
-	{0x603395685085DF16, 0x7C00},
-	{0xBFE03FFFFFFFFFFF, 0xB810},
-	{0xC02F0FEED43940DD, 0xCBC4},
-	{0xBFE01FFFFFFFFFFF, 0xB808},
-	{0xE9BFEFFFFC000000, 0xFC00},
-	{0xBFE00FFFFFFFFFFF, 0xB804},
-	{0xC0755E69821E879E, 0xDD58},
-	{0xBFE007FFFFFFFFFF, 0xB802},
-	{0xC1E00010FFFFFFFF, 0xFC00},
-	{0xBFE003FFFFFFFFFF, 0xB801},
-	{0x3FD00008001FFFFF, 0x3400},
-	{0xBFE001FFFFFFFFFF, 0xB800},
-	{0xBE72400000000000, 0x8001},
-	{0xBFE000FFFFFFFFFF, 0xB800},
-	{0x3FDF001FFFFFFFFF, 0x37C0},
-	{0xBFE0007FFFFFFFFF, 0xB800},
-	{0xB01400000001FFFF, 0x8000},
-	{0xBFE0003FFFFFFFFF, 0xB800},
-	{0x404FC007FFFFFFFE, 0x53F0},
-	{0xBFE0001FFFFFFFFF, 0xB800},
-	{0x8005212832C430F2, 0x8000},
-	{0xBFE0000FFFFFFFFF, 0xB800},
-	{0x4000000000810000, 0x4000},
-	{0xBFE00007FFFFFFFF, 0xB800},
-	{0xBF703FFF80000000, 0x9C10},
-	{0xBFE00003FFFFFFFF, 0xB800},
-	{0xC67FFF800000001E, 0xFC00},
-	{0xBFE00001FFFFFFFF, 0xB800},
-	{0x31E9F26AE61D0EA2, 0x0000},
-	{0xBFE00000FFFFFFFF, 0xB800},
-	{0x3A004000000007FF, 0x0000},
-	{0xBFE000007FFFFFFF, 0xB800},
-	{0x38E000001FFF7FFF, 0x0000},
-	{0xBFE000003FFFFFFF, 0xB800},
-	{0x3FFFFFFFFFFFFFFF, 0x4000},
-	{0xBFE000001FFFFFFF, 0xB800},
-	{0x40280F8DA6395F02, 0x4A04},
-	{0xBFE000000FFFFFFF, 0xB800},
-	{0x407FFF00000007FF, 0x6000},
-	{0xBFE0000007FFFFFF, 0xB800},
-	{0x43E6F76FB397AF67, 0x7C00},
-	{0xBFE0000003FFFFFF, 0xB800},
-	{0x7FD6F9BB1F92642E, 0x7C00},
-	{0xBFE0000001FFFFFF, 0xB800},
-	{0xB7E0100000007FFF, 0x8000},
-	{0xBFE0000000FFFFFF, 0xB800},
-	{0xC7E03FFBFFFFFFFF, 0xFC00},
-	{0xBFE00000007FFFFF, 0xB800},
-	{0xC06000080FFFFFFE, 0xD800},
-	{0xBFE00000003FFFFF, 0xB800},
-	{0xFFFBFFFF00000000, 0xFEFF},
-	{0xBFE00000001FFFFF, 0xB800},
-	{0xBFF000001FFC0000, 0xBC00},
-	{0xBFE00000000FFFFF, 0xB800},
-	{0x429A983EE4986B9B, 0x7C00},
-	{0xBFE000000007FFFF, 0xB800},
-	{0xC01FC0000FFFFFFF, 0xC7F0},
-	{0xBFE000000003FFFF, 0xB800},
-	{0x4020800000003FFE, 0x4820},
-	{0xBFE000000001FFFF, 0xB800},
-	{0x8009502E84F30BFE, 0x8000},
-	{0xBFE000000000FFFF, 0xB800},
-	{0xC256857997DCB852, 0xFC00},
-	{0xBFE0000000007FFF, 0xB800},
-	{0xFFF0001FFFFEFFFF, 0xFE00},
-	{0xBFE0000000003FFF, 0xB800},
-	{0xB4607FFFFFFF7FFE, 0x8000},
-	{0xBFE0000000001FFF, 0xB800},
-	{0xC03003FF7FFFFFFE, 0xCC01},
-	{0xBFE0000000000FFF, 0xB800},
-	{0x4023C1A7199E0237, 0x48F0},
-	{0xBFE00000000007FF, 0xB800},
-	{0xC06FFFFFFFC003FF, 0xDC00},
-	{0xBFE00000000003FF, 0xB800},
-	{0x70F00001FFFFF7FF, 0x7C00},
-	{0xBFE00000000001FF, 0xB800},
-	{0x7FF000007FFFFFF7, 0x7E00},
-	{0xBFE00000000000FF, 0xB800},
-	{0x7FD00FFFFFFFFF7E, 0x7C00},
-	{0xBFE000000000007F, 0xB800},
-	{0xBB20000000090000, 0x8000},
-	{0xBFE000000000003F, 0xB800},
-	{0x43D824844BDF62E1, 0x7C00},
-	{0xBFE000000000001F, 0xB800},
-	{0x912B375BEA021BFC, 0x8000},
-	{0xBFE000000000000F, 0xB800},
-	{0xBCB00000007FFE00, 0x8000},
-	{0xBFE0000000000007, 0xB800},
-	{0x40CFBFFDFFFFFFFE, 0x73F0},
-	{0xBFE0000000000003, 0xB800},
-	{0xFDE17F2D0A4DC4AD, 0xFC00},
-	{0xBFF0000000000000, 0xBC00},
-	{0x432B8E2BDCB6426A, 0x7C00},
-	{0xBFF0000000000001, 0xBC00},
-	{0x802080000007FFFE, 0x8000},
-	{0xBFF0000000000002, 0xBC00},
-	{0x41FFFFFBEFFFFFFF, 0x7C00},
-	{0xBFF0000000000004, 0xBC00},
-	{0xBFCFFFFFFFFBFFBF, 0xB400},
-	{0xBFF0000000000008, 0xBC00},
-	{0xC3CFFFFFFFF7FF80, 0xFC00},
-	{0xBFF0000000000010, 0xBC00},
-	{0x46EFFFFFFFFFBFFB, 0x7C00},
-	{0xBFF0000000000020, 0xBC00},
-	{0xC3DFFFF7FBFFFFFF, 0xFC00},
-	{0xBFF0000000000040, 0xBC00},
-	{0xC3CFB8CE0F35D8CE, 0xFC00},
-	{0xBFF0000000000080, 0xBC00},
-	{0xBA83BFFFFFFFFFFF, 0x8000},
-	{0xBFF0000000000100, 0xBC00},
-	{0xFFE003FFFFFF8000, 0xFC00},
-	{0xBFF0000000000200, 0xBC00},
-	{0xB02FFE003FFFFFFF, 0x8000},
-	{0xBFF0000000000400, 0xBC00},
-	{0x43C6D670E76A2DB5, 0x7C00},
-	{0xBFF0000000000800, 0xBC00},
-	{0x3FC0040002000000, 0x3001},
-	{0xBFF0000000001000, 0xBC00},
-	{0x2E5FFFFFFF00007F, 0x0000},
-	{0xBFF0000000002000, 0xBC00},
-	{0x41CA49803C95611E, 0x7C00},
-	{0xBFF0000000004000, 0xBC00},
-	{0x4377FFFFFFFFFEFE, 0x7C00},
-	{0xBFF0000000008000, 0xBC00},
-	{0x42F0040000001000, 0x7C00},
-	{0xBFF0000000010000, 0xBC00},
-	{0x802000801FFFFFFF, 0x8000},
-	{0xBFF0000000020000, 0xBC00},
-	{0x001FFFFDFFF7FFFF, 0x0000},
-	{0xBFF0000000040000, 0xBC00},
-	{0xC0307FFFFFFFFFFE, 0xCC20},
-	{0xBFF0000000080000, 0xBC00},
-	{0xC03FFFFFFFFF0002, 0xD000},
-	{0xBFF0000000100000, 0xBC00},
-	{0x3C9007FFFEFFFFFE, 0x0000},
-	{0xBFF0000000200000, 0xBC00},
-	{0x40207FFFFFFFFFFF, 0x4820},
-	{0xBFF0000000400000, 0xBC00},
-	{0x41EE95859B3A4D5A, 0x7C00},
-	{0xBFF0000000800000, 0xBC00},
-	{0xFFDFFFC0007FFFFE, 0xFC00},
-	{0xBFF0000001000000, 0xBC00},
-	{0x40300000807FFFFE, 0x4C00},
-	{0xBFF0000002000000, 0xBC00},
-	{0xBFADFFFFEFFFFFFF, 0xAB80},
-	{0xBFF0000004000000, 0xBC00},
-	{0xC030D56AD382F9E6, 0xCC35},
-	{0xBFF0000008000000, 0xBC00},
-	{0xC1F8D9285874C1DC, 0xFC00},
-	{0xBFF0000010000000, 0xBC00},
-	{0x43F0000000FF7FFF, 0x7C00},
-	{0xBFF0000020000000, 0xBC00},
-	{0x43EFFF01FFFFFFFE, 0x7C00},
-	{0xBFF0000040000000, 0xBC00},
-	{0x403FFFDFFBFFFFFF, 0x5000},
-	{0xBFF0000080000000, 0xBC00},
-	{0xC1CC040000000000, 0xFC00},
-	{0xBFF0000100000000, 0xBC00},
-	{0xC1FCDB000B38B25A, 0xFC00},
-	{0xBFF0000200000000, 0xBC00},
-	{0xC0B07FFFFBFFFFFF, 0xEC20},
-	{0xBFF0000400000000, 0xBC00},
-	{0xC01001FFFFFFFFBF, 0xC400},
-	{0xBFF0000800000000, 0xBC00},
-	{0xC3D01FF800000000, 0xFC00},
-	{0xBFF0001000000000, 0xBC00},
-	{0xFFE00FFFFFFFFFFF, 0xFC00},
-	{0xBFF0002000000000, 0xBC00},
-	{0x3800001FFFFE0000, 0x0000},
-	{0xBFF0004000000000, 0xBC00},
-	{0x37E0200000FFFFFF, 0x0000},
-	{0xBFF0008000000000, 0xBC00},
-	{0x47E6744B681A92BB, 0x7C00},
-	{0xBFF0010000000000, 0xBC00},
-	{0xBCAFFFFFFFF7FFEF, 0x8000},
-	{0xBFF0020000000000, 0xBC00},
-	{0x800B12421EA13ED6, 0x8000},
-	{0xBFF0040000000000, 0xBC01},
-	{0xC7EFE3FFFFFFFFFE, 0xFC00},
-	{0xBFF0080000000000, 0xBC02},
-	{0x407FAB08BAA79D9F, 0x5FEB},
-	{0xBFF0100000000000, 0xBC04},
-	{0xB0204000007FFFFF, 0x8000},
-	{0xBFF0200000000000, 0xBC08},
-	{0xC3ADC90E4AB7A7CD, 0xFC00},
-	{0xBFF0400000000000, 0xBC10},
-	{0xC032DF5F5F93CE2D, 0xCCB8},
-	{0xBFF0800000000000, 0xBC20},
-	{0xC0239E40FB523A65, 0xC8E8},
-	{0xBFF1000000000000, 0xBC40},
-	{0x43700003FFFFBFFE, 0x7C00},
-	{0xBFF2000000000000, 0xBC80},
-	{0x000FFFF8001FFFFE, 0x0000},
-	{0xBFF4000000000000, 0xBD00},
-	{0x7FE01FFF7FFFFFFF, 0x7C00},
-	{0xBFF8000000000000, 0xBE00},
-	{0x39C36634D6EB6254, 0x0000},
-	{0xBFFC000000000000, 0xBF00},
-	{0xC807839C0D2441FF, 0xFC00},
-	{0xBFFE000000000000, 0xBF80},
-	{0x3FF7D5E93A3A19F5, 0x3DF5},
-	{0xBFFF000000000000, 0xBFC0},
-	{0xC011FFFFFFFFEFFF, 0xC480},
-	{0xBFFF800000000000, 0xBFE0},
-	{0x0A8FFFFFC0000000, 0x0000},
-	{0xBFFFC00000000000, 0xBFF0},
-	{0xBE1000200000000E, 0x8000},
-	{0xBFFFE00000000000, 0xBFF8},
-	{0x47E07FFFFFFDFFFE, 0x7C00},
-	{0xBFFFF00000000000, 0xBFFC},
-	{0xBFEFFFFFFC0FFFFF, 0xBC00},
-	{0xBFFFF80000000000, 0xBFFE},
-	{0x380FFF7FFFFFFFFA, 0x0000},
-	{0xBFFFFC0000000000, 0xBFFF},
-	{0xBFD0FFFFFF000000, 0xB440},
-	{0xBFFFFE0000000000, 0xC000},
-	{0xCC0DF7EC03E2AFBC, 0xFC00},
-	{0xBFFFFF0000000000, 0xC000},
-	{0x41C0007FFFFFFFFF, 0x7C00},
-	{0xBFFFFF8000000000, 0xC000},
-	{0xC02F80000001FFFF, 0xCBE0},
-	{0xBFFFFFC000000000, 0xC000},
-	{0xFFDFFFBFFFFDFFFF, 0xFC00},
-	{0xBFFFFFE000000000, 0xC000},
-	{0xC09FFFFFF0001FFE, 0xE800},
-	{0xBFFFFFF000000000, 0xC000},
-	{0x37F000000020007E, 0x0000},
-	{0xBFFFFFF800000000, 0xC000},
-	{0xBFF82BC8E792BEEE, 0xBE0B},
-	{0xBFFFFFFC00000000, 0xC000},
-	{0x4ABFFFFFFFFFBEFE, 0x7C00},
-	{0xBFFFFFFE00000000, 0xC000},
-	{0x4E4FFFFFFF7FFEFF, 0x7C00},
-	{0xBFFFFFFF00000000, 0xC000},
-	{0xB7FFFFFFFBFFBFFE, 0x8000},
-	{0xBFFFFFFF80000000, 0xC000},
-	{0xC1CFFFFFFFFFDFBF, 0xFC00},
-	{0xBFFFFFFFC0000000, 0xC000},
-	{0x3CAFFFFF7FFFFFDF, 0x0000},
-	{0xBFFFFFFFE0000000, 0xC000},
-	{0xBE0004000007FFFF, 0x8000},
-	{0xBFFFFFFFF0000000, 0xC000},
-	{0xB7FB6C427DACD8F2, 0x8000},
-	{0xBFFFFFFFF8000000, 0xC000},
-	{0x46D03FFFDFFFFFFF, 0x7C00},
-	{0xBFFFFFFFFC000000, 0xC000},
-	{0x3FCFFFFFFFFFBBFF, 0x3400},
-	{0xBFFFFFFFFE000000, 0xC000},
-	{0x40200003C0000000, 0x4800},
-	{0xBFFFFFFFFF000000, 0xC000},
-	{0xC3DFFDDFFFFFFFFE, 0xFC00},
-	{0xBFFFFFFFFF800000, 0xC000},
-	{0xD2D007FFFFFFFFDF, 0xFC00},
-	{0xBFFFFFFFFFC00000, 0xC000},
-	{0xC7FFF7FFFFFDFFFE, 0xFC00},
-	{0xBFFFFFFFFFE00000, 0xC000},
-	{0x052080000000FFFF, 0x0000},
-	{0xBFFFFFFFFFF00000, 0xC000},
-	{0x0020000200003FFF, 0x0000},
-	{0xBFFFFFFFFFF80000, 0xC000},
-	{0xC1D80003FFFFFFFE, 0xFC00},
-	{0xBFFFFFFFFFFC0000, 0xC000},
-	{0xC00FFFFDFFFC0000, 0xC400},
-	{0xBFFFFFFFFFFE0000, 0xC000},
-	{0xFFF0000020008000, 0xFE00},
-	{0xBFFFFFFFFFFF0000, 0xC000},
-	{0x43EFFFFDFFFBFFFF, 0x7C00},
-	{0xBFFFFFFFFFFF8000, 0xC000},
-	{0x4133BD1B9013CED7, 0x7C00},
-	{0xBFFFFFFFFFFFC000, 0xC000},
-	{0x3D4FFFFFE0000020, 0x0000},
-	{0xBFFFFFFFFFFFE000, 0xC000},
-	{0xBFF1000200000000, 0xBC40},
-	{0xBFFFFFFFFFFFF000, 0xC000},
-	{0x3FEFFFFFFFFEFFFF, 0x3C00},
-	{0xBFFFFFFFFFFFF800, 0xC000},
-	{0x000000FFFFFFFFEF, 0x0000},
-	{0xBFFFFFFFFFFFFC00, 0xC000},
-	{0x088FFFC7FFFFFFFF, 0x0000},
-	{0xBFFFFFFFFFFFFE00, 0xC000},
-	{0x117066D01AFE3124, 0x0000},
-	{0xBFFFFFFFFFFFFF00, 0xC000},
-	{0xBFC9A5500A147C99, 0xB269},
-	{0xBFFFFFFFFFFFFF80, 0xC000},
-	{0xAD3000000FFFFEFF, 0x8000},
-	{0xBFFFFFFFFFFFFFC0, 0xC000},
-	{0xB7EE1FFFFFFFFFFF, 0x8000},
-	{0xBFFFFFFFFFFFFFE0, 0xC000},
-	{0xB7FADC8829032FAB, 0x8000},
-	{0xBFFFFFFFFFFFFFF0, 0xC000},
-	{0xBAB376B84847FCCE, 0x8000},
-	{0xBFFFFFFFFFFFFFF8, 0xC000},
-	{0x37FFFF7FFC000000, 0x0000},
-	{0xBFFFFFFFFFFFFFFC, 0xC000},
-	{0x41EFFFFFFFFFBF80, 0x7C00},
-	{0xBFFFFFFFFFFFFFFE, 0xC000},
-	{0x3FE000001FFFEFFF, 0x3800},
-	{0xBFFFFFFFFFFFFFFF, 0xC000},
-	{0x7FD00000007FFF00, 0x7C00},
-	{0xBFFFFFFFFFFFFFFD, 0xC000},
-	{0x45E0000000021FFE, 0x7C00},
-	{0xBFFFFFFFFFFFFFFB, 0xC000},
-	{0x7FFFFFFFFEFBFFFE, 0x7FFF},
-	{0xBFFFFFFFFFFFFFF7, 0xC000},
-	{0x4000000000800001, 0x4000},
-	{0xBFFFFFFFFFFFFFEF, 0xC000},
-	{0x7FE98B245DB99699, 0x7C00},
-	{0xBFFFFFFFFFFFFFDF, 0xC000},
-	{0xDD3000007FFFFFE0, 0xFC00},
-	{0xBFFFFFFFFFFFFFBF, 0xC000},
-	{0xBFE0004200000000, 0xB800},
-	{0xBFFFFFFFFFFFFF7F, 0xC000},
-	{0xC1E000040000001E, 0xFC00},
-	{0xBFFFFFFFFFFFFEFF, 0xC000},
-	{0xC1DFFFF7FFFFBFFF, 0xFC00},
-	{0xBFFFFFFFFFFFFDFF, 0xC000},
-	{0x47FD0AB05ADE7574, 0x7C00},
-	{0xBFFFFFFFFFFFFBFF, 0xC000},
-	{0x37FFFFFF7FFFFFBE, 0x0000},
-	{0xBFFFFFFFFFFFF7FF, 0xC000},
-	{0xEFAFFFFDFFFFFEFE, 0xFC00},
-	{0xBFFFFFFFFFFFEFFF, 0xC000},
-	{0xBFC2E3BE3CADFD4B, 0xB0B9},
-	{0xBFFFFFFFFFFFDFFF, 0xC000},
-	{0x403FFFFFFFFFFFFF, 0x5000},
-	{0xBFFFFFFFFFFFBFFF, 0xC000},
-	{0x37F00000FFFFFF7F, 0x0000},
-	{0xBFFFFFFFFFFF7FFF, 0xC000},
-	{0xEF73E63392856A25, 0xFC00},
-	{0xBFFFFFFFFFFEFFFF, 0xC000},
-	{0xBF6FFFFFDEFFFFFF, 0x9C00},
-	{0xBFFFFFFFFFFDFFFF, 0xC000},
-	{0xBF9FC0001FFFFFFF, 0xA7F0},
-	{0xBFFFFFFFFFFBFFFF, 0xC000},
-	{0x2FEFFF7FFFFFFFFB, 0x0000},
-	{0xBFFFFFFFFFF7FFFF, 0xC000},
-	{0x41F3FFFFFFFFFFDF, 0x7C00},
-	{0xBFFFFFFFFFEFFFFF, 0xC000},
-	{0x434FFFFFFFFFE7FF, 0x7C00},
-	{0xBFFFFFFFFFDFFFFF, 0xC000},
-	{0x407B4C85215A9D6F, 0x5ED3},
-	{0xBFFFFFFFFFBFFFFF, 0xC000},
-	{0x3FFFFFFF6FFFFFFF, 0x4000},
-	{0xBFFFFFFFFF7FFFFF, 0xC000},
-	{0x3FEFFEFFFFFFFFBF, 0x3C00},
-	{0xBFFFFFFFFEFFFFFF, 0xC000},
-	{0xC1DFFFFFFFFFFFC2, 0xFC00},
-	{0xBFFFFFFFFDFFFFFF, 0xC000},
-	{0x8010000000001FDF, 0x8000},
-	{0xBFFFFFFFFBFFFFFF, 0xC000},
-	{0xC7E2C0266E07EEBE, 0xFC00},
-	{0xBFFFFFFFF7FFFFFF, 0xC000},
-	{0x401FFDFFFFFFE000, 0x47FF},
-	{0xBFFFFFFFEFFFFFFF, 0xC000},
-	{0x3FDFFFFFFC004000, 0x3800},
-	{0xBFFFFFFFDFFFFFFF, 0xC000},
-	{0xBCA678E0724B7558, 0x8000},
-	{0xBFFFFFFFBFFFFFFF, 0xC000},
-	{0xC2A000000003FFDE, 0xFC00},
-	{0xBFFFFFFF7FFFFFFF, 0xC000},
-	{0xBF9FD7FFFFFFFFFE, 0xA7F6},
-	{0xBFFFFFFEFFFFFFFF, 0xC000},
-	{0x401FF003FFFFFFFF, 0x47FC},
-	{0xBFFFFFFDFFFFFFFF, 0xC000},
-	{0x4030000000800008, 0x4C00},
-	{0xBFFFFFFBFFFFFFFF, 0xC000},
-	{0x68A94A91FF7F466D, 0x7C00},
-	{0xBFFFFFF7FFFFFFFF, 0xC000},
-	{0xC1FD4CCFA0432DEC, 0xFC00},
-	{0xBFFFFFEFFFFFFFFF, 0xC000},
-	{0x3FFFFFFFFFFFFC0E, 0x4000},
-	{0xBFFFFFDFFFFFFFFF, 0xC000},
-	{0xC1F107FFFFFFFFFF, 0xFC00},
-	{0xBFFFFFBFFFFFFFFF, 0xC000},
-	{0xBFEFFFFE3FFFFFFF, 0xBC00},
-	{0xBFFFFF7FFFFFFFFF, 0xC000},
-	{0xBF6FFFFFFE000100, 0x9C00},
-	{0xBFFFFEFFFFFFFFFF, 0xC000},
-	{0x41E0000004000010, 0x7C00},
-	{0xBFFFFDFFFFFFFFFF, 0xBFFF},
-	{0xC01000000000FDFE, 0xC400},
-	{0xBFFFFBFFFFFFFFFF, 0xBFFF},
-	{0x14DFFFFEFFFFFFBE, 0x0000},
-	{0xBFFFF7FFFFFFFFFF, 0xBFFE},
-	{0x41C3FFFFFFFFFFF7, 0x7C00},
-	{0xBFFFEFFFFFFFFFFF, 0xBFFC},
-	{0xC02671C40FE79687, 0xC99C},
-	{0xBFFFDFFFFFFFFFFF, 0xBFF8},
-	{0xC5AE379FB3091B21, 0xFC00},
-	{0xBFFFBFFFFFFFFFFF, 0xBFF0},
-	{0xB9F0000FFFFDFFFF, 0x8000},
-	{0xBFFF7FFFFFFFFFFF, 0xBFE0},
-	{0xC0D800AD18E9DAD7, 0xF600},
-	{0xBFFEFFFFFFFFFFFF, 0xBFC0},
-	{0xBFDFFFFFFFF82000, 0xB800},
-	{0xBFFDFFFFFFFFFFFF, 0xBF80},
-	{0xBCAFFFFF7FFFEFFF, 0x8000},
-	{0xBFFBFFFFFFFFFFFF, 0xBF00},
-	{0xC07003FFFFFFFF00, 0xDC01},
-	{0xBFF7FFFFFFFFFFFF, 0xBE00},
-	{0x34FFFF8000001FFE, 0x0000},
-	{0xBFF3FFFFFFFFFFFF, 0xBD00},
-	{0x7FD0C0B9E67B3FBB, 0x7C00},
-	{0xBFF1FFFFFFFFFFFF, 0xBC80},
-	{0xBE90007FFBFFFFFE, 0x8004},
-	{0xBFF0FFFFFFFFFFFF, 0xBC40},
-	{0x43EFFF7FFFFFFBFF, 0x7C00},
-	{0xBFF07FFFFFFFFFFF, 0xBC20},
-	{0x400FFFFBFFFFFFFD, 0x4400},
-	{0xBFF03FFFFFFFFFFF, 0xBC10},
-	{0x8009B3A9D5169C39, 0x8000},
-	{0xBFF01FFFFFFFFFFF, 0xBC08},
-	{0xC711000000007FFF, 0xFC00},
-	{0xBFF00FFFFFFFFFFF, 0xBC04},
-	{0x002FFFFFFFFC0FFF, 0x0000},
-	{0xBFF007FFFFFFFFFF, 0xBC02},
-	{0xBCAFFC000001FFFE, 0x8000},
-	{0xBFF003FFFFFFFFFF, 0xBC01},
-	{0xA9A1FFFFFFFFFFF7, 0x8000},
-	{0xBFF001FFFFFFFFFF, 0xBC00},
-	{0xC80000000000077E, 0xFC00},
-	{0xBFF000FFFFFFFFFF, 0xBC00},
-	{0x40025202DA794CF3, 0x4095},
-	{0xBFF0007FFFFFFFFF, 0xBC00},
-	{0x3D5021FFFFFFFFFF, 0x0000},
-	{0xBFF0003FFFFFFFFF, 0xBC00},
-	{0xBD4FFFFF01FFFFFF, 0x8000},
-	{0xBFF0001FFFFFFFFF, 0xBC00},
-	{0xD94F80003FFFFFFF, 0xFC00},
-	{0xBFF0000FFFFFFFFF, 0xBC00},
-	{0x43FFFFFFFFC0003F, 0x7C00},
-	{0xBFF00007FFFFFFFF, 0xBC00},
-	{0xDA63FFFFFFFFFFFE, 0xFC00},
-	{0xBFF00003FFFFFFFF, 0xBC00},
-	{0x002DFFFFC0000000, 0x0000},
-	{0xBFF00001FFFFFFFF, 0xBC00},
-	{0xFFD2B1AB1B38EE34, 0xFC00},
-	{0xBFF00000FFFFFFFF, 0xBC00},
-	{0x3FE1FFFFBFFFFFFF, 0x3880},
-	{0xBFF000007FFFFFFF, 0xBC00},
-	{0x380007FFFFBFFFFF, 0x0000},
-	{0xBFF000003FFFFFFF, 0xBC00},
-	{0xC3CE17E89F84A955, 0xFC00},
-	{0xBFF000001FFFFFFF, 0xBC00},
-	{0xC3D733DE0ED58BBD, 0xFC00},
-	{0xBFF000000FFFFFFF, 0xBC00},
-	{0x42D0010000000400, 0x7C00},
-	{0xBFF0000007FFFFFF, 0xBC00},
-	{0x41FCEBE236F8260F, 0x7C00},
-	{0xBFF0000003FFFFFF, 0xBC00},
-	{0x3A4F2E05A110FCAE, 0x0000},
-	{0xBFF0000001FFFFFF, 0xBC00},
-	{0xBFF03FFFFFFFFFFC, 0xBC10},
-	{0xBFF0000000FFFFFF, 0xBC00},
-	{0xC000000000080007, 0xC000},
-	{0xBFF00000007FFFFF, 0xBC00},
-	{0xC01001FFFFBFFFFF, 0xC400},
-	{0xBFF00000003FFFFF, 0xBC00},
-	{0xD133A7F49F18DA25, 0xFC00},
-	{0xBFF00000001FFFFF, 0xBC00},
-	{0x434000000001FFEE, 0x7C00},
-	{0xBFF00000000FFFFF, 0xBC00},
-	{0x47FFFFFFF1FFFFFE, 0x7C00},
-	{0xBFF000000007FFFF, 0xBC00},
-	{0xBFFFFFF7FFBFFFFE, 0xC000},
-	{0xBFF000000003FFFF, 0xBC00},
-	{0x42D2426A07194BF7, 0x7C00},
-	{0xBFF000000001FFFF, 0xBC00},
-	{0xBFC0800000000002, 0xB020},
-	{0xBFF000000000FFFF, 0xBC00},
-	{0xC008D408A16DC6D0, 0xC235},
-	{0xBFF0000000007FFF, 0xBC00},
-	{0xC3F2276B2ABE601A, 0xFC00},
-	{0xBFF0000000003FFF, 0xBC00},
-	{0x37F3FFFC00000000, 0x0000},
-	{0xBFF0000000001FFF, 0xBC00},
-	{0x41C0000000000100, 0x7C00},
-	{0xBFF0000000000FFF, 0xBC00},
-	{0xEADFFBFFFFFFFFFF, 0xFC00},
-	{0xBFF00000000007FF, 0xBC00},
-	{0x40500000000803FE, 0x5400},
-	{0xBFF00000000003FF, 0xBC00},
-	{0xC1F1F1EFD897C419, 0xFC00},
-	{0xBFF00000000001FF, 0xBC00},
-	{0x37E010000000FFFF, 0x0000},
-	{0xBFF00000000000FF, 0xBC00},
-	{0xB7E000403FFFFFFF, 0x8000},
-	{0xBFF000000000007F, 0xBC00},
-	{0x43EE050F7EEBF026, 0x7C00},
-	{0xBFF000000000003F, 0xBC00},
-	{0xFFE0000002FFFFFF, 0xFC00},
-	{0xBFF000000000001F, 0xBC00},
-	{0x9FDB23ECCD402452, 0x8000},
-	{0xBFF000000000000F, 0xBC00},
-	{0xBFCF7FFFFDFFFFFE, 0xB3E0},
-	{0xBFF0000000000007, 0xBC00},
-	{0x802100000000007F, 0x8000},
-	{0xBFF0000000000003, 0xBC00},
-	{0x234A0472D2965B52, 0x0000},
-	{0xC000000000000000, 0xC000},
-	{0x82BFFFEFFFEFFFFF, 0x8000},
-	{0xC000000000000001, 0xC000},
-	{0xB61E0000000001FF, 0x8000},
-	{0xC000000000000002, 0xC000},
-	{0x40509CD96E881B50, 0x5427},
-	{0xC000000000000004, 0xC000},
-	{0xC0DFFFFFC00007FE, 0xF800},
-	{0xC000000000000008, 0xC000},
-	{0xC3DFFF80000007FF, 0xFC00},
-	{0xC000000000000010, 0xC000},
-	{0x47E0007DFFFFFFFE, 0x7C00},
-	{0xC000000000000020, 0xC000},
-	{0xC21F3FFFFFFFFFFF, 0xFC00},
-	{0xC000000000000040, 0xC000},
-	{0xBFED7802D66C837E, 0xBB5E},
-	{0xC000000000000080, 0xC000},
-	{0xE9F331C0AF8A179A, 0xFC00},
-	{0xC000000000000100, 0xC000},
-	{0x434FFFF040000000, 0x7C00},
-	{0xC000000000000200, 0xC000},
-	{0x3B3FFFFFFDFFFFFE, 0x0000},
-	{0xC000000000000400, 0xC000},
-	{0xBBE129A2CFDB3FB3, 0x8000},
-	{0xC000000000000800, 0xC000},
-	{0x97700008000001FE, 0x8000},
-	{0xC000000000001000, 0xC000},
-	{0xBFD50B64CCAF5FB6, 0xB543},
-	{0xC000000000002000, 0xC000},
-	{0xBA9FFFFFFE0001FF, 0x8000},
-	{0xC000000000004000, 0xC000},
-	{0x800EFBFFFFFFFFFF, 0x8000},
-	{0xC000000000008000, 0xC000},
-	{0x3FEFFFFFFFFFFEFB, 0x3C00},
-	{0xC000000000010000, 0xC000},
-	{0x3FEFFFFFBFFFFEFE, 0x3C00},
-	{0xC000000000020000, 0xC000},
-	{0xB7D00FFF7FFFFFFF, 0x8000},
-	{0xC000000000040000, 0xC000},
-	{0xC060010000007FFF, 0xD800},
-	{0xC000000000080000, 0xC000},
-	{0x802FFFFBBFFFFFFF, 0x8000},
-	{0xC000000000100000, 0xC000},
-	{0xC43FFFFFFFF80800, 0xFC00},
-	{0xC000000000200000, 0xC000},
-	{0x3FE1000007FFFFFE, 0x3840},
-	{0xC000000000400000, 0xC000},
-	{0x4641FFFFFFFDFFFF, 0x7C00},
-	{0xC000000000800000, 0xC000},
-	{0xD1FA9193DC6DD0AF, 0xFC00},
-	{0xC000000001000000, 0xC000},
-	{0xB53DF6F781FAB992, 0x8000},
-	{0xC000000002000000, 0xC000},
-	{0xC03FFCFA9AE8DA6E, 0xCFFF},
-	{0xC000000004000000, 0xC000},
-	{0xBCAFFFFFFFFF1FFE, 0x8000},
-	{0xC000000008000000, 0xC000},
-	{0xC0700800000007FE, 0xDC02},
-	{0xC000000010000000, 0xC000},
-	{0x47F000003FFFFF80, 0x7C00},
-	{0xC000000020000000, 0xC000},
-	{0xFFD0000002000004, 0xFC00},
-	{0xC000000040000000, 0xC000},
-	{0xC250007FFFFBFFFE, 0xFC00},
-	{0xC000000080000000, 0xC000},
-	{0x7FF000000000040F, 0x7E00},
-	{0xC000000100000000, 0xC000},
-	{0x3F30000200000FFF, 0x0C00},
-	{0xC000000200000000, 0xC000},
-	{0xC1CA4672DEE57F4C, 0xFC00},
-	{0xC000000400000000, 0xC000},
-	{0x51200000207FFFFF, 0x7C00},
-	{0xC000000800000000, 0xC000},
-	{0x43CF3FFFFFFFFFFE, 0x7C00},
-	{0xC000001000000000, 0xC000},
-	{0x5A14409A6C4213DB, 0x7C00},
-	{0xC000002000000000, 0xC000},
-	{0x407AD6D1AE685B45, 0x5EB6},
-	{0xC000004000000000, 0xC000},
-	{0x3FE2A176D18F7D22, 0x38A8},
-	{0xC000008000000000, 0xC000},
-	{0x402AC22C948D84B5, 0x4AB1},
-	{0xC000010000000000, 0xC000},
-	{0x3FFFFFFE00000200, 0x4000},
-	{0xC000020000000000, 0xC000},
-	{0x334FFFFFFFF7F7FF, 0x0000},
-	{0xC000040000000000, 0xC001},
-	{0x3CFF000000003FFF, 0x0000},
-	{0xC000080000000000, 0xC002},
-	{0xFFDFFFFFFFFFFF07, 0xFC00},
-	{0xC000100000000000, 0xC004},
-	{0xB80FFFFFFC7FFFFE, 0x8000},
-	{0xC000200000000000, 0xC008},
-	{0xBFC45263312365FB, 0xB115},
-	{0xC000400000000000, 0xC010},
-	{0xC13010000000001F, 0xFC00},
-	{0xC000800000000000, 0xC020},
-	{0xC1C76EB284E60E03, 0xFC00},
-	{0xC001000000000000, 0xC040},
-	{0xC03EC103DA211796, 0xCFB0},
-	{0xC002000000000000, 0xC080},
-	{0x40AFFFDFFFEFFFFF, 0x6C00},
-	{0xC004000000000000, 0xC100},
-	{0xCCE7E1FF5ECBA765, 0xFC00},
-	{0xC008000000000000, 0xC200},
-	{0x3FB66BC0AEAEB232, 0x2D9B},
-	{0xC00C000000000000, 0xC300},
-	{0x80200000000000FE, 0x8000},
-	{0xC00E000000000000, 0xC380},
-	{0x39100000003FEFFF, 0x0000},
-	{0xC00F000000000000, 0xC3C0},
-	{0xFFD003FFFFFDFFFF, 0xFC00},
-	{0xC00F800000000000, 0xC3E0},
-	{0xBCA56CC1170E92A1, 0x8000},
-	{0xC00FC00000000000, 0xC3F0},
-	{0xBFD020000000000F, 0xB408},
-	{0xC00FE00000000000, 0xC3F8},
-	{0xBD0BFFA7589DB125, 0x8000},
-	{0xC00FF00000000000, 0xC3FC},
-	{0x3FEF0FFFFFFFFFFF, 0x3BC4},
-	{0xC00FF80000000000, 0xC3FE},
-	{0x000FFFFFFFFE000E, 0x0000},
-	{0xC00FFC0000000000, 0xC3FF},
-	{0xC7FFFFF3FFFFFFFF, 0xFC00},
-	{0xC00FFE0000000000, 0xC400},
-	{0x43C0020000080000, 0x7C00},
-	{0xC00FFF0000000000, 0xC400},
-	{0x37EF002000000000, 0x0000},
-	{0xC00FFF8000000000, 0xC400},
-	{0x47FC07FFFFFFFFFF, 0x7C00},
-	{0xC00FFFC000000000, 0xC400},
-	{0xBD80007FFEFFFFFE, 0x8000},
-	{0xC00FFFE000000000, 0xC400},
-	{0xBFB000400001FFFF, 0xAC00},
-	{0xC00FFFF000000000, 0xC400},
-	{0xBFCFFFE000007FFF, 0xB400},
-	{0xC00FFFF800000000, 0xC400},
-	{0xBFB8BDE2D6C8C5F4, 0xAE2F},
-	{0xC00FFFFC00000000, 0xC400},
-	{0x3F1FEFFFFFFBFFFF, 0x07FC},
-	{0xC00FFFFE00000000, 0xC400},
-	{0x40000004FFFFFFFF, 0x4000},
-	{0xC00FFFFF00000000, 0xC400},
-	{0x45495170322BF693, 0x7C00},
-	{0xC00FFFFF80000000, 0xC400},
-	{0x3F800003FFFFFFF7, 0x2000},
-	{0xC00FFFFFC0000000, 0xC400},
-	{0x4032A8ABBEC64752, 0x4CAA},
-	{0xC00FFFFFE0000000, 0xC400},
-	{0xC030FFFFFFFDFFFF, 0xCC40},
-	{0xC00FFFFFF0000000, 0xC400},
-	{0x40C2007FFFFFFFFF, 0x7080},
-	{0xC00FFFFFF8000000, 0xC400},
-	{0x3FFC26D51CA73D04, 0x3F0A},
-	{0xC00FFFFFFC000000, 0xC400},
-	{0x3FF003FFFF800000, 0x3C01},
-	{0xC00FFFFFFE000000, 0xC400},
-	{0xC02FFFE000000003, 0xCC00},
-	{0xC00FFFFFFF000000, 0xC400},
-	{0x7FD0000003FEFFFF, 0x7C00},
-	{0xC00FFFFFFF800000, 0xC400},
-	{0xB80FFFE000000006, 0x8000},
-	{0xC00FFFFFFFC00000, 0xC400},
-	{0xBDE0100000080000, 0x8000},
-	{0xC00FFFFFFFE00000, 0xC400},
-	{0x3E7FFFFFFFF9FFFE, 0x0002},
-	{0xC00FFFFFFFF00000, 0xC400},
-	{0xB7E3FFFFFFFFF7FF, 0x8000},
-	{0xC00FFFFFFFF80000, 0xC400},
-	{0xFE900000000403FF, 0xFC00},
-	{0xC00FFFFFFFFC0000, 0xC400},
-	{0x41DFFBBFFFFFFFFF, 0x7C00},
-	{0xC00FFFFFFFFE0000, 0xC400},
-	{0xC03FFEFFFFFFFFDF, 0xD000},
-	{0xC00FFFFFFFFF0000, 0xC400},
-	{0x40E7CED2D2CFA2A9, 0x79F4},
-	{0xC00FFFFFFFFF8000, 0xC400},
-	{0xD234CC08FDCBA0EC, 0xFC00},
-	{0xC00FFFFFFFFFC000, 0xC400},
-	{0xC1EF76707B57C33A, 0xFC00},
-	{0xC00FFFFFFFFFE000, 0xC400},
-	{0x47F0000000400001, 0x7C00},
-	{0xC00FFFFFFFFFF000, 0xC400},
-	{0x3CCD88A638FED502, 0x0000},
-	{0xC00FFFFFFFFFF800, 0xC400},
-	{0x43C1DA9FF7C68E43, 0x7C00},
-	{0xC00FFFFFFFFFFC00, 0xC400},
-	{0xBFF000800000FFFE, 0xBC00},
-	{0xC00FFFFFFFFFFE00, 0xC400},
-	{0xC06FFFFFC000007F, 0xDC00},
-	{0xC00FFFFFFFFFFF00, 0xC400},
-	{0xC7EFFFFFBFFDFFFE, 0xFC00},
-	{0xC00FFFFFFFFFFF80, 0xC400},
-	{0xC020008400000000, 0xC800},
-	{0xC00FFFFFFFFFFFC0, 0xC400},
-	{0xBF4FFFFF0001FFFF, 0x9400},
-	{0xC00FFFFFFFFFFFE0, 0xC400},
-	{0x47E02F241CF07CCB, 0x7C00},
-	{0xC00FFFFFFFFFFFF0, 0xC400},
-	{0x434FFFFFFFEEFFFF, 0x7C00},
-	{0xC00FFFFFFFFFFFF8, 0xC400},
-	{0xE830000000000046, 0xFC00},
-	{0xC00FFFFFFFFFFFFC, 0xC400},
-	{0xC1C915E8AE93FDAD, 0xFC00},
-	{0xC00FFFFFFFFFFFFE, 0xC400},
-	{0xC37DEFFFFFFFFFFF, 0xFC00},
-	{0xC00FFFFFFFFFFFFF, 0xC400},
-	{0xBFCFF0000007FFFE, 0xB3FC},
-	{0xC00FFFFFFFFFFFFD, 0xC400},
-	{0xFFE000007FDFFFFE, 0xFC00},
-	{0xC00FFFFFFFFFFFFB, 0xC400},
-	{0xB540080000000001, 0x8000},
-	{0xC00FFFFFFFFFFFF7, 0xC400},
-	{0x73001FFFFFF00000, 0x7C00},
-	{0xC00FFFFFFFFFFFEF, 0xC400},
-	{0xBCA0800000000001, 0x8000},
-	{0xC00FFFFFFFFFFFDF, 0xC400},
-	{0xB7EFDFFFFFFFFBFE, 0x8000},
-	{0xC00FFFFFFFFFFFBF, 0xC400},
-	{0x43EE13708C5DF82D, 0x7C00},
-	{0xC00FFFFFFFFFFF7F, 0xC400},
-	{0xC02000000001F7FE, 0xC800},
-	{0xC00FFFFFFFFFFEFF, 0xC400},
-	{0xC1BFFEFFFFFFDFFE, 0xFC00},
-	{0xC00FFFFFFFFFFDFF, 0xC400},
-	{0xBDCF7FEFFFFFFFFF, 0x8000},
-	{0xC00FFFFFFFFFFBFF, 0xC400},
-	{0xC0CFFFEFFFFFFDFE, 0xF400},
-	{0xC00FFFFFFFFFF7FF, 0xC400},
-	{0xC011FE0000000000, 0xC480},
-	{0xC00FFFFFFFFFEFFF, 0xC400},
-	{0x16FF7FFC00000000, 0x0000},
-	{0xC00FFFFFFFFFDFFF, 0xC400},
-	{0x480FFFFFFFFFFFED, 0x7C00},
-	{0xC00FFFFFFFFFBFFF, 0xC400},
-	{0xC3CFF01FFFFFFFFF, 0xFC00},
-	{0xC00FFFFFFFFF7FFF, 0xC400},
-	{0xBFEC4084C8F1D2EA, 0xBB10},
-	{0xC00FFFFFFFFEFFFF, 0xC400},
-	{0xC020200100000000, 0xC808},
-	{0xC00FFFFFFFFDFFFF, 0xC400},
-	{0x00201000000FFFFF, 0x0000},
-	{0xC00FFFFFFFFBFFFF, 0xC400},
-	{0x47F10000001FFFFF, 0x7C00},
-	{0xC00FFFFFFFF7FFFF, 0xC400},
-	{0xBFFFFFFFFFF87FFE, 0xC000},
-	{0xC00FFFFFFFEFFFFF, 0xC400},
-	{0xBFDFFFFC00000010, 0xB800},
-	{0xC00FFFFFFFDFFFFF, 0xC400},
-	{0xC650000017FFFFFF, 0xFC00},
-	{0xC00FFFFFFFBFFFFF, 0xC400},
-	{0xC000000003FFFFFE, 0xC000},
-	{0xC00FFFFFFF7FFFFF, 0xC400},
-	{0xC3E0000000005FFF, 0xFC00},
-	{0xC00FFFFFFEFFFFFF, 0xC400},
-	{0xA2BFFFFFBFDFFFFF, 0x8000},
-	{0xC00FFFFFFDFFFFFF, 0xC400},
-	{0xC1293E8EDFF38709, 0xFC00},
-	{0xC00FFFFFFBFFFFFF, 0xC400},
-	{0xFFFFFFFBFFDFFFFE, 0xFFFF},
-	{0xC00FFFFFF7FFFFFF, 0xC400},
-	{0x002FFFFBFFFFFFFC, 0x0000},
-	{0xC00FFFFFEFFFFFFF, 0xC400},
-	{0xBFD844B1FEC003AF, 0xB611},
-	{0xC00FFFFFDFFFFFFF, 0xC400},
-	{0x408FFFE0007FFFFF, 0x6400},
-	{0xC00FFFFFBFFFFFFF, 0xC400},
-	{0xC3F6AB0DBB981697, 0xFC00},
-	{0xC00FFFFF7FFFFFFF, 0xC400},
-	{0xC1FFFFFE02000000, 0xFC00},
-	{0xC00FFFFEFFFFFFFF, 0xC400},
-	{0x40E77E03DE34E858, 0x79E0},
-	{0xC00FFFFDFFFFFFFF, 0xC400},
-	{0xBFB000000800001F, 0xAC00},
-	{0xC00FFFFBFFFFFFFF, 0xC400},
-	{0x000FFFFFFFFFE100, 0x0000},
-	{0xC00FFFF7FFFFFFFF, 0xC400},
-	{0xBA68D6AA61D90263, 0x8000},
-	{0xC00FFFEFFFFFFFFF, 0xC400},
-	{0x4D51EE47C1678499, 0x7C00},
-	{0xC00FFFDFFFFFFFFF, 0xC400},
-	{0x3D4C0000007FFFFE, 0x0000},
-	{0xC00FFFBFFFFFFFFF, 0xC400},
-	{0x3A6D90281BEAEE81, 0x0000},
-	{0xC00FFF7FFFFFFFFF, 0xC400},
-	{0x3B9000077FFFFFFE, 0x0000},
-	{0xC00FFEFFFFFFFFFF, 0xC400},
-	{0x3D1000201FFFFFFE, 0x0000},
-	{0xC00FFDFFFFFFFFFF, 0xC3FF},
-	{0x5D9BFFFFFDFFFFFF, 0x7C00},
-	{0xC00FFBFFFFFFFFFF, 0xC3FF},
-	{0x4C10007DFFFFFFFF, 0x7C00},
-	{0xC00FF7FFFFFFFFFF, 0xC3FE},
-	{0x3E6FEFFFFFFFF7FF, 0x0001},
-	{0xC00FEFFFFFFFFFFF, 0xC3FC},
-	{0xC23007FFFFFEFFFF, 0xFC00},
-	{0xC00FDFFFFFFFFFFF, 0xC3F8},
-	{0xB811BA24035F53AB, 0x8000},
-	{0xC00FBFFFFFFFFFFF, 0xC3F0},
-	{0x418BFEFFFFFFFFFE, 0x7C00},
-	{0xC00F7FFFFFFFFFFF, 0xC3E0},
-	{0xC1E8E8D46BEAB275, 0xFC00},
-	{0xC00EFFFFFFFFFFFF, 0xC3C0},
-	{0xA88000008001FFFF, 0x8000},
-	{0xC00DFFFFFFFFFFFF, 0xC380},
-	{0x7FEFFFFBFFFFBFFF, 0x7C00},
-	{0xC00BFFFFFFFFFFFF, 0xC300},
-	{0xBFD0000102000000, 0xB400},
-	{0xC007FFFFFFFFFFFF, 0xC200},
-	{0x3FEFFFFE3FFFFFFE, 0x3C00},
-	{0xC003FFFFFFFFFFFF, 0xC100},
-	{0x434000000000001A, 0x7C00},
-	{0xC001FFFFFFFFFFFF, 0xC080},
-	{0xBFB0000100000080, 0xAC00},
-	{0xC000FFFFFFFFFFFF, 0xC040},
-	{0x41C000001BFFFFFF, 0x7C00},
-	{0xC0007FFFFFFFFFFF, 0xC020},
-	{0xC00FFFFFFFFFFF7F, 0xC400},
-	{0xC0003FFFFFFFFFFF, 0xC010},
-	{0xB74FFFFFFFFFF3FE, 0x8000},
-	{0xC0001FFFFFFFFFFF, 0xC008},
-	{0x00202F63F122C5A2, 0x0000},
-	{0xC0000FFFFFFFFFFF, 0xC004},
-	{0x41D00000003FFFFB, 0x7C00},
-	{0xC00007FFFFFFFFFF, 0xC002},
-	{0x94CFF7FFFFFEFFFF, 0x8000},
-	{0xC00003FFFFFFFFFF, 0xC001},
-	{0x40DFFFFF6FFFFFFF, 0x7800},
-	{0xC00001FFFFFFFFFF, 0xC000},
-	{0x480000000000003A, 0x7C00},
-	{0xC00000FFFFFFFFFF, 0xC000},
-	{0xC7FFFEFFFFFFBFFF, 0xFC00},
-	{0xC000007FFFFFFFFF, 0xC000},
-	{0x47EFFFE00000007F, 0x7C00},
-	{0xC000003FFFFFFFFF, 0xC000},
-	{0x2740100000200000, 0x0000},
-	{0xC000001FFFFFFFFF, 0xC000},
-	{0xC02FFFFFFE00007F, 0xCC00},
-	{0xC000000FFFFFFFFF, 0xC000},
-	{0xB9E080000000001F, 0x8000},
-	{0xC0000007FFFFFFFF, 0xC000},
-	{0xC3CDC00000000000, 0xFC00},
-	{0xC0000003FFFFFFFF, 0xC000},
-	{0x41FFFFFBFFFDFFFE, 0x7C00},
-	{0xC0000001FFFFFFFF, 0xC000},
-	{0xCD300020001FFFFF, 0xFC00},
-	{0xC0000000FFFFFFFF, 0xC000},
-	{0x400FFFFFFC000002, 0x4400},
-	{0xC00000007FFFFFFF, 0xC000},
-	{0xB81FF7FFFFFFFFFB, 0x8000},
-	{0xC00000003FFFFFFF, 0xC000},
-	{0xA8E000001FFFFFFA, 0x8000},
-	{0xC00000001FFFFFFF, 0xC000},
-	{0x40C000000000000F, 0x7000},
-	{0xC00000000FFFFFFF, 0xC000},
-	{0x412FFFFFFF7FFF00, 0x7C00},
-	{0xC000000007FFFFFF, 0xC000},
-	{0x43D3E1D7C2066606, 0x7C00},
-	{0xC000000003FFFFFF, 0xC000},
-	{0x3C80000110000000, 0x0000},
-	{0xC000000001FFFFFF, 0xC000},
-	{0xFFDFFFE07FFFFFFE, 0xFC00},
-	{0xC000000000FFFFFF, 0xC000},
-	{0xBEF010000000007F, 0x8101},
-	{0xC0000000007FFFFF, 0xC000},
-	{0x41CFFFFFFFFFFFE2, 0x7C00},
-	{0xC0000000003FFFFF, 0xC000},
-	{0xC1E74C265B11985C, 0xFC00},
-	{0xC0000000001FFFFF, 0xC000},
-	{0x3FFFFF0000007FFF, 0x4000},
-	{0xC0000000000FFFFF, 0xC000},
-	{0xB7A2045608287F6C, 0x8000},
-	{0xC00000000007FFFF, 0xC000},
-	{0x3F7FFFFE001FFFFE, 0x2000},
-	{0xC00000000003FFFF, 0xC000},
-	{0x80000200000000FF, 0x8000},
-	{0xC00000000001FFFF, 0xC000},
-	{0x40000200000000FE, 0x4001},
-	{0xC00000000000FFFF, 0xC000},
-	{0xC603FFFF7FFFFFFE, 0xFC00},
-	{0xC000000000007FFF, 0xC000},
-	{0x37E21A4601A55D9A, 0x0000},
-	{0xC000000000003FFF, 0xC000},
-	{0xBFDFFFFFFFFC003E, 0xB800},
-	{0xC000000000001FFF, 0xC000},
-	{0x43CFF80000001FFF, 0x7C00},
-	{0xC000000000000FFF, 0xC000},
-	{0xB81F800007FFFFFF, 0x8000},
-	{0xC0000000000007FF, 0xC000},
-	{0x3DE001FF80000000, 0x0000},
-	{0xC0000000000003FF, 0xC000},
-	{0xB7F001FFFFFFFFF7, 0x8000},
-	{0xC0000000000001FF, 0xC000},
-	{0x37F9AF82E79FA619, 0x0000},
-	{0xC0000000000000FF, 0xC000},
-	{0xBFEFFFFFF3FFFFFF, 0xBC00},
-	{0xC00000000000007F, 0xC000},
-	{0xB8000000100007FF, 0x8000},
-	{0xC00000000000003F, 0xC000},
-	{0xDB08BAE49164370C, 0xFC00},
-	{0xC00000000000001F, 0xC000},
-	{0xC3ED72D6CC2ED6BA, 0xFC00},
-	{0xC00000000000000F, 0xC000},
-	{0xBEFFFFFFFFFFFC00, 0x8200},
-	{0xC000000000000007, 0xC000},
-	{0x3D800000FFFFEFFF, 0x0000},
-	{0xC000000000000003, 0xC000},
-	{0x43E3007BF016278E, 0x7C00},
-	{0xC010000000000000, 0xC400},
-	{0xC0352383BC575C0F, 0xCD49},
-	{0xC010000000000001, 0xC400},
-	{0xC1DB110251400702, 0xFC00},
-	{0xC010000000000002, 0xC400},
-	{0x43EFFFF800800000, 0x7C00},
-	{0xC010000000000004, 0xC400},
-	{0x41EFFFFFFBFFFFFD, 0x7C00},
-	{0xC010000000000008, 0xC400},
-	{0xBFEFFFFFFFFEFFFF, 0xBC00},
-	{0xC010000000000010, 0xC400},
-	{0xFFEC000000000002, 0xFC00},
-	{0xC010000000000020, 0xC400},
-	{0x4800000007DFFFFF, 0x7C00},
-	{0xC010000000000040, 0xC400},
-	{0xB80FFF8000007FFF, 0x8000},
-	{0xC010000000000080, 0xC400},
-	{0x41F34A21EC3C399A, 0x7C00},
-	{0xC010000000000100, 0xC400},
-	{0x37FFFFFF000007FE, 0x0000},
-	{0xC010000000000200, 0xC400},
-	{0xC3D0007FFFFFFFFF, 0xFC00},
-	{0xC010000000000400, 0xC400},
-	{0xF0B0807FFFFFFFFF, 0xFC00},
-	{0xC010000000000800, 0xC400},
-	{0x002D32D5D98D89FA, 0x0000},
-	{0xC010000000001000, 0xC400},
-	{0x3FD000000800FFFF, 0x3400},
-	{0xC010000000002000, 0xC400},
-	{0x47FFFF5FFFFFFFFE, 0x7C00},
-	{0xC010000000004000, 0xC400},
-	{0x3FCEC3552FC21FDE, 0x33B1},
-	{0xC010000000008000, 0xC400},
-	{0x401FE7FFFFFFFFFE, 0x47FA},
-	{0xC010000000010000, 0xC400},
-	{0x41D0000000200000, 0x7C00},
-	{0xC010000000020000, 0xC400},
-	{0xBFB00000000002FF, 0xAC00},
-	{0xC010000000040000, 0xC400},
-	{0x3530010008000000, 0x0000},
-	{0xC010000000080000, 0xC400},
-	{0x381FC00000007FFE, 0x0000},
-	{0xC010000000100000, 0xC400},
-	{0xEE2FFFFFFFFDFFDF, 0xFC00},
-	{0xC010000000200000, 0xC400},
-	{0xBCA007FFFFFFFFF7, 0x8000},
-	{0xC010000000400000, 0xC400},
-	{0xBF6565484180F375, 0x9959},
-	{0xC010000000800000, 0xC400},
-	{0x3FE884991BE937AE, 0x3A21},
-	{0xC010000001000000, 0xC400},
-	{0x3FDFFFFFFF007FFF, 0x3800},
-	{0xC010000002000000, 0xC400},
-	{0x3FCFFFFFFC002000, 0x3400},
-	{0xC010000004000000, 0xC400},
-	{0x475800003FFFFFFF, 0x7C00},
-	{0xC010000008000000, 0xC400},
-	{0xC06B2FF7BFECE517, 0xDACC},
-	{0xC010000010000000, 0xC400},
-	{0x3F00103FFFFFFFFE, 0x0202},
-	{0xC010000020000000, 0xC400},
-	{0xA6296B42A815DC6F, 0x8000},
-	{0xC010000040000000, 0xC400},
-	{0xC01FFFFFFDFFFE00, 0xC800},
-	{0xC010000080000000, 0xC400},
-	{0x3C643B6E2240C430, 0x0000},
-	{0xC010000100000000, 0xC400},
-	{0x380FFFFFF801FFFF, 0x0000},
-	{0xC010000200000000, 0xC400},
-	{0xC80800000000FFFE, 0xFC00},
-	{0xC010000400000000, 0xC400},
-	{0xBB9F80007FFFFFFF, 0x8000},
-	{0xC010000800000000, 0xC400},
-	{0x41ECEFDBEBF5BA09, 0x7C00},
-	{0xC010001000000000, 0xC400},
-	{0xBFB00000000FFFF7, 0xAC00},
-	{0xC010002000000000, 0xC400},
-	{0xC3C0000400000003, 0xFC00},
-	{0xC010004000000000, 0xC400},
-	{0xB81BF7FFFFFFFFFF, 0x8000},
-	{0xC010008000000000, 0xC400},
-	{0xBCA00040FFFFFFFF, 0x8000},
-	{0xC010010000000000, 0xC400},
-	{0x380B5C85816F8957, 0x0000},
-	{0xC010020000000000, 0xC400},
-	{0xBFF00000401FFFFF, 0xBC00},
-	{0xC010040000000000, 0xC401},
-	{0x381FFFFFFFFFFF82, 0x0000},
-	{0xC010080000000000, 0xC402},
-	{0x41F8000000020000, 0x7C00},
-	{0xC010100000000000, 0xC404},
-	{0xBCC0000004FFFFFE, 0x8000},
-	{0xC010200000000000, 0xC408},
-	{0x36414597ED8FB218, 0x0000},
-	{0xC010400000000000, 0xC410},
-	{0xC3DFFFFFFFFFFC07, 0xFC00},
-	{0xC010800000000000, 0xC420},
-	{0xC02FFFF7FFFFFFDF, 0xCC00},
-	{0xC011000000000000, 0xC440},
-	{0xC7FFFFFBFFFFFBFF, 0xFC00},
-	{0xC012000000000000, 0xC480},
-	{0xB9D7E72668871404, 0x8000},
-	{0xC014000000000000, 0xC500},
-	{0x3FD85B7592714B47, 0x3617},
-	{0xC018000000000000, 0xC600},
-	{0xFB97FFFFFBFFFFFF, 0xFC00},
-	{0xC01C000000000000, 0xC700},
-	{0x43F5CA6484E23E7C, 0x7C00},
-	{0xC01E000000000000, 0xC780},
-	{0xC00000000017FFFF, 0xC000},
-	{0xC01F000000000000, 0xC7C0},
-	{0xBF8000000000083F, 0xA000},
-	{0xC01F800000000000, 0xC7E0},
-	{0xD90F387296BE900C, 0xFC00},
-	{0xC01FC00000000000, 0xC7F0},
-	{0xB8CFFC0000003FFF, 0x8000},
-	{0xC01FE00000000000, 0xC7F8},
-	{0x3FDA76A66627155C, 0x369E},
-	{0xC01FF00000000000, 0xC7FC},
-	{0x3FB0000100000FFF, 0x2C00},
-	{0xC01FF80000000000, 0xC7FE},
-	{0xBFEFFFFFFFF7FEFF, 0xBC00},
-	{0xC01FFC0000000000, 0xC7FF},
-	{0x3B13800000000000, 0x0000},
-	{0xC01FFE0000000000, 0xC800},
-	{0xBF73FFFF80000000, 0x9D00},
-	{0xC01FFF0000000000, 0xC800},
-	{0xC390000100000002, 0xFC00},
-	{0xC01FFF8000000000, 0xC800},
-	{0x47FCE722C4887F7A, 0x7C00},
-	{0xC01FFFC000000000, 0xC800},
-	{0x3EFFC00000000FFE, 0x01FC},
-	{0xC01FFFE000000000, 0xC800},
-	{0x40C6470D50B10D1B, 0x7192},
-	{0xC01FFFF000000000, 0xC800},
-	{0x2A80000000000804, 0x0000},
-	{0xC01FFFF800000000, 0xC800},
-	{0xC03FFFFFBFFFFFEF, 0xD000},
-	{0xC01FFFFC00000000, 0xC800},
-	{0x904FFFFFFC03FFFF, 0x8000},
-	{0xC01FFFFE00000000, 0xC800},
-	{0xAC8FFFFDFFFFFFFD, 0x8000},
-	{0xC01FFFFF00000000, 0xC800},
-	{0xBE60000FFFFFFFEF, 0x8001},
-	{0xC01FFFFF80000000, 0xC800},
-	{0xA4BFF0001FFFFFFF, 0x8000},
-	{0xC01FFFFFC0000000, 0xC800},
-	{0x40290C6201162D9A, 0x4A43},
-	{0xC01FFFFFE0000000, 0xC800},
-	{0x8200000000000013, 0x8000},
-	{0xC01FFFFFF0000000, 0xC800},
-	{0x21A4CA4B810B1014, 0x0000},
-	{0xC01FFFFFF8000000, 0xC800},
-	{0xC7E007FFFFFFFFFE, 0xFC00},
-	{0xC01FFFFFFC000000, 0xC800},
-	{0x4520FFFFFFFFFFFB, 0x7C00},
-	{0xC01FFFFFFE000000, 0xC800},
-	{0x3ED0FFFFFFFFFFFE, 0x0044},
-	{0xC01FFFFFFF000000, 0xC800},
-	{0x41C0FFFFFFFDFFFF, 0x7C00},
-	{0xC01FFFFFFF800000, 0xC800},
-	{0xB810000001FFFFFC, 0x8000},
-	{0xC01FFFFFFFC00000, 0xC800},
-	{0xBFD3C830987D6E00, 0xB4F2},
-	{0xC01FFFFFFFE00000, 0xC800},
-	{0x8C6FFFFEFFFFFBFF, 0x8000},
-	{0xC01FFFFFFFF00000, 0xC800},
-	{0x3F9FFFFFFFFE000E, 0x2800},
-	{0xC01FFFFFFFF80000, 0xC800},
-	{0xC0AFFFFFFFFFFEEE, 0xEC00},
-	{0xC01FFFFFFFFC0000, 0xC800},
-	{0x3FD000080000FFFF, 0x3400},
-	{0xC01FFFFFFFFE0000, 0xC800},
-	{0xC1EC000FFFFFFFFF, 0xFC00},
-	{0xC01FFFFFFFFF0000, 0xC800},
-	{0x802091CE65143DB0, 0x8000},
-	{0xC01FFFFFFFFF8000, 0xC800},
-	{0xBC9FCD49CE3CB565, 0x8000},
-	{0xC01FFFFFFFFFC000, 0xC800},
-	{0xFFEFFFFF000FFFFF, 0xFC00},
-	{0xC01FFFFFFFFFE000, 0xC800},
-	{0x40CFFFBFFFEFFFFE, 0x7400},
-	{0xC01FFFFFFFFFF000, 0xC800},
-	{0xC09825346F91E4BE, 0xE609},
-	{0xC01FFFFFFFFFF800, 0xC800},
-	{0xC7FDFFFEFFFFFFFF, 0xFC00},
-	{0xC01FFFFFFFFFFC00, 0xC800},
-	{0x5560004003FFFFFE, 0x7C00},
-	{0xC01FFFFFFFFFFE00, 0xC800},
-	{0x3FC00000002FFFFF, 0x3000},
-	{0xC01FFFFFFFFFFF00, 0xC800},
-	{0xBFCFFF00007FFFFE, 0xB400},
-	{0xC01FFFFFFFFFFF80, 0xC800},
-	{0xBABA286E282F10B8, 0x8000},
-	{0xC01FFFFFFFFFFFC0, 0xC800},
-	{0x37F00003BFFFFFFE, 0x0000},
-	{0xC01FFFFFFFFFFFE0, 0xC800},
-	{0xB7EFFFFF7FFFFFEF, 0x8000},
-	{0xC01FFFFFFFFFFFF0, 0xC800},
-	{0xB7EFFFFFFBFFFFBF, 0x8000},
-	{0xC01FFFFFFFFFFFF8, 0xC800},
-	{0x7FEFFFC000000006, 0x7C00},
-	{0xC01FFFFFFFFFFFFC, 0xC800},
-	{0x3FAFFFFBFFFF7FFF, 0x2C00},
-	{0xC01FFFFFFFFFFFFE, 0xC800},
-	{0xC036EA6F421734CE, 0xCDBB},
-	{0xC01FFFFFFFFFFFFF, 0xC800},
-	{0x43E77FFFFFFFFFFF, 0x7C00},
-	{0xC01FFFFFFFFFFFFD, 0xC800},
-	{0x231FFFFFFFEE0000, 0x0000},
-	{0xC01FFFFFFFFFFFFB, 0xC800},
-	{0xCD30100000000001, 0xFC00},
-	{0xC01FFFFFFFFFFFF7, 0xC800},
-	{0xC80909F9215DA39C, 0xFC00},
-	{0xC01FFFFFFFFFFFEF, 0xC800},
-	{0x7FDFFFFFBFFFFEFE, 0x7C00},
-	{0xC01FFFFFFFFFFFDF, 0xC800},
-	{0xB7FFFFFFF80000FF, 0x8000},
-	{0xC01FFFFFFFFFFFBF, 0xC800},
-	{0x4040000010000040, 0x5000},
-	{0xC01FFFFFFFFFFF7F, 0xC800},
-	{0x7FFEF732925BFC0E, 0x7FBD},
-	{0xC01FFFFFFFFFFEFF, 0xC800},
-	{0xC00FFDFFFFFFF000, 0xC3FF},
-	{0xC01FFFFFFFFFFDFF, 0xC800},
-	{0x40040001FFFFFFFF, 0x4100},
-	{0xC01FFFFFFFFFFBFF, 0xC800},
-	{0xC3E0000010080000, 0xFC00},
-	{0xC01FFFFFFFFFF7FF, 0xC800},
-	{0x41EF255F808589A5, 0x7C00},
-	{0xC01FFFFFFFFFEFFF, 0xC800},
-	{0x3A80000008000000, 0x0000},
-	{0xC01FFFFFFFFFDFFF, 0xC800},
-	{0xF04FFFFFC0002000, 0xFC00},
-	{0xC01FFFFFFFFFBFFF, 0xC800},
-	{0x4236FFE3E09DB745, 0x7C00},
-	{0xC01FFFFFFFFF7FFF, 0xC800},
-	{0xC032C5B3F44D1CBC, 0xCCB1},
-	{0xC01FFFFFFFFEFFFF, 0xC800},
-	{0x031B0892DED84F45, 0x0000},
-	{0xC01FFFFFFFFDFFFF, 0xC800},
-	{0x3FF0000017FFFFFE, 0x3C00},
-	{0xC01FFFFFFFFBFFFF, 0xC800},
-	{0xC1BFFFFF00010000, 0xFC00},
-	{0xC01FFFFFFFF7FFFF, 0xC800},
-	{0x479FFFFFFFFFFFFD, 0x7C00},
-	{0xC01FFFFFFFEFFFFF, 0xC800},
-	{0xC04FDFFFDFFFFFFE, 0xD3F8},
-	{0xC01FFFFFFFDFFFFF, 0xC800},
-	{0x380FFFFFFFFFC0FF, 0x0000},
-	{0xC01FFFFFFFBFFFFF, 0xC800},
-	{0xC80FFFFF0007FFFF, 0xFC00},
-	{0xC01FFFFFFF7FFFFF, 0xC800},
-	{0xFFD2FE206787E54A, 0xFC00},
-	{0xC01FFFFFFEFFFFFF, 0xC800},
-	{0x3803620026362418, 0x0000},
-	{0xC01FFFFFFDFFFFFF, 0xC800},
-	{0x7FDFFFFFE007FFFF, 0x7C00},
-	{0xC01FFFFFFBFFFFFF, 0xC800},
-	{0xD9800001FF800000, 0xFC00},
-	{0xC01FFFFFF7FFFFFF, 0xC800},
-	{0x84BFFFC00000007F, 0x8000},
-	{0xC01FFFFFEFFFFFFF, 0xC800},
-	{0xBFF1400000000000, 0xBC50},
-	{0xC01FFFFFDFFFFFFF, 0xC800},
-	{0x3CA07A7C04753F19, 0x0000},
-	{0xC01FFFFFBFFFFFFF, 0xC800},
-	{0x3FA000002007FFFF, 0x2800},
-	{0xC01FFFFF7FFFFFFF, 0xC800},
-	{0x40100000007FFFFE, 0x4400},
-	{0xC01FFFFEFFFFFFFF, 0xC800},
-	{0x002000FFFFBFFFFF, 0x0000},
-	{0xC01FFFFDFFFFFFFF, 0xC800},
-	{0x402FFF7FFFFFFF7F, 0x4C00},
-	{0xC01FFFFBFFFFFFFF, 0xC800},
-	{0x43EFFFFFF000000F, 0x7C00},
-	{0xC01FFFF7FFFFFFFF, 0xC800},
-	{0xC1F05B974CBF9843, 0xFC00},
-	{0xC01FFFEFFFFFFFFF, 0xC800},
-	{0x8EA6EFD0BFF526FF, 0x8000},
-	{0xC01FFFDFFFFFFFFF, 0xC800},
-	{0xC70001FFFFFFFFF7, 0xFC00},
-	{0xC01FFFBFFFFFFFFF, 0xC800},
-	{0xC7E0007FFDFFFFFE, 0xFC00},
-	{0xC01FFF7FFFFFFFFF, 0xC800},
-	{0x002FFFFFFFFFFAFF, 0x0000},
-	{0xC01FFEFFFFFFFFFF, 0xC800},
-	{0x40C000000000807F, 0x7000},
-	{0xC01FFDFFFFFFFFFF, 0xC7FF},
-	{0x68902000003FFFFF, 0x7C00},
-	{0xC01FFBFFFFFFFFFF, 0xC7FF},
-	{0xC3D8D4C9603BC151, 0xFC00},
-	{0xC01FF7FFFFFFFFFF, 0xC7FE},
-	{0x400FFFFFFEDFFFFF, 0x4400},
-	{0xC01FEFFFFFFFFFFF, 0xC7FC},
-	{0xC1DFFFFFF7DFFFFF, 0xFC00},
-	{0xC01FDFFFFFFFFFFF, 0xC7F8},
-	{0xC7E000000040003F, 0xFC00},
-	{0xC01FBFFFFFFFFFFF, 0xC7F0},
-	{0x400FFFFFFF001FFE, 0x4400},
-	{0xC01F7FFFFFFFFFFF, 0xC7E0},
-	{0xC3D63F7C94DC5E1E, 0xFC00},
-	{0xC01EFFFFFFFFFFFF, 0xC7C0},
-	{0x380003FFFFFFFDFE, 0x0000},
-	{0xC01DFFFFFFFFFFFF, 0xC780},
-	{0x3FBFFFFFFFFDFFFB, 0x3000},
-	{0xC01BFFFFFFFFFFFF, 0xC700},
-	{0xFFEFFFF800007FFF, 0xFC00},
-	{0xC017FFFFFFFFFFFF, 0xC600},
-	{0xC80FFFFFFFFFDFEE, 0xFC00},
-	{0xC013FFFFFFFFFFFF, 0xC500},
-	{0xC3E0007FFFFFFFFA, 0xFC00},
-	{0xC011FFFFFFFFFFFF, 0xC480},
-	{0xB8009F5B8F1A3968, 0x8000},
-	{0xC010FFFFFFFFFFFF, 0xC440},
-	{0x0B473F67B794786D, 0x0000},
-	{0xC0107FFFFFFFFFFF, 0xC420},
-	{0xC03AC23B154AA8CE, 0xCEB1},
-	{0xC0103FFFFFFFFFFF, 0xC410},
-	{0xC7CFFFFFF0001FFF, 0xFC00},
-	{0xC0101FFFFFFFFFFF, 0xC408},
-	{0x41D00002000003FF, 0x7C00},
-	{0xC0100FFFFFFFFFFF, 0xC404},
-	{0x41EBFFFFFFFDFFFF, 0x7C00},
-	{0xC01007FFFFFFFFFF, 0xC402},
-	{0x5A10000001001FFF, 0x7C00},
-	{0xC01003FFFFFFFFFF, 0xC401},
-	{0xC3D7F48928A0399D, 0xFC00},
-	{0xC01001FFFFFFFFFF, 0xC400},
-	{0x3FD99C1642EE6E76, 0x3667},
-	{0xC01000FFFFFFFFFF, 0xC400},
-	{0xC0501FFFFFFFF800, 0xD408},
-	{0xC010007FFFFFFFFF, 0xC400},
-	{0x7FE0040007FFFFFF, 0x7C00},
-	{0xC010003FFFFFFFFF, 0xC400},
-	{0x434FFFFFFFEFFDFE, 0x7C00},
-	{0xC010001FFFFFFFFF, 0xC400},
-	{0xB84A1A99743E0BFE, 0x8000},
-	{0xC010000FFFFFFFFF, 0xC400},
-	{0xBFE0000000001BFF, 0xB800},
-	{0xC0100007FFFFFFFF, 0xC400},
-	{0xC34000000EFFFFFF, 0xFC00},
-	{0xC0100003FFFFFFFF, 0xC400},
-	{0xC011FFFFFFFFFFE0, 0xC480},
-	{0xC0100001FFFFFFFF, 0xC400},
-	{0xC7FFF00003FFFFFF, 0xFC00},
-	{0xC0100000FFFFFFFF, 0xC400},
-	{0x7BCE8622B11B115E, 0x7C00},
-	{0xC01000007FFFFFFF, 0xC400},
-	{0xC3EC00000000001E, 0xFC00},
-	{0xC01000003FFFFFFF, 0xC400},
-	{0xC80FFFFFFFF03FFE, 0xFC00},
-	{0xC01000001FFFFFFF, 0xC400},
-	{0x47F52DED313452E9, 0x7C00},
-	{0xC01000000FFFFFFF, 0xC400},
-	{0x43F6CC9B331910D5, 0x7C00},
-	{0xC010000007FFFFFF, 0xC400},
-	{0x3FBDE42CB1A951B3, 0x2F79},
-	{0xC010000003FFFFFF, 0xC400},
-	{0xBCAFFFFFEFFFFFFF, 0x8000},
-	{0xC010000001FFFFFF, 0xC400},
-	{0x4010000080007FFF, 0x4400},
-	{0xC010000000FFFFFF, 0xC400},
-	{0x3F8FFFFFFDFFF7FF, 0x2400},
-	{0xC0100000007FFFFF, 0xC400},
-	{0xD6E080000000000F, 0xFC00},
-	{0xC0100000003FFFFF, 0xC400},
-	{0xC4FA41E92D8B697F, 0xFC00},
-	{0xC0100000001FFFFF, 0xC400},
-	{0x47E0000000801FFF, 0x7C00},
-	{0xC0100000000FFFFF, 0xC400},
-	{0xBFFFFF7FFFFFDFFE, 0xC000},
-	{0xC01000000007FFFF, 0xC400},
-	{0xC3CE8B64E59DAAE8, 0xFC00},
-	{0xC01000000003FFFF, 0xC400},
-	{0x3FE7CC6ECAF6F28D, 0x39F3},
-	{0xC01000000001FFFF, 0xC400},
-	{0x3F1FFFF8000001FE, 0x0800},
-	{0xC01000000000FFFF, 0xC400},
-	{0x3F500000021FFFFE, 0x1400},
-	{0xC010000000007FFF, 0xC400},
-	{0x248FFFFFFFEFF7FF, 0x0000},
-	{0xC010000000003FFF, 0xC400},
-	{0x4019026B3A3AF331, 0x4641},
-	{0xC010000000001FFF, 0xC400},
-	{0xB7E0000FFFFEFFFF, 0x8000},
-	{0xC010000000000FFF, 0xC400},
-	{0xC3CE063ED577CF85, 0xFC00},
-	{0xC0100000000007FF, 0xC400},
-	{0x401FFFFFFFDFFFE0, 0x4800},
-	{0xC0100000000003FF, 0xC400},
-	{0xBEC451F84C36C3DC, 0x8029},
-	{0xC0100000000001FF, 0xC400},
-	{0xBFC7C00000000000, 0xB1F0},
-	{0xC0100000000000FF, 0xC400},
-	{0xBEAFFFFFFFFFFFBD, 0x8010},
-	{0xC01000000000007F, 0xC400},
-	{0xC02010000001FFFF, 0xC804},
-	{0xC01000000000003F, 0xC400},
-	{0x3802A38247C0CAE9, 0x0000},
-	{0xC01000000000001F, 0xC400},
-	{0x489250FFFCD99B90, 0x7C00},
-	{0xC01000000000000F, 0xC400},
-	{0x37FAFD4001E540E8, 0x0000},
-	{0xC010000000000007, 0xC400},
-	{0x36400FFFFFFFFF7E, 0x0000},
-	{0xC010000000000003, 0xC400},
-	{0x3FD0008000000FFF, 0x3400},
-	{0xC020000000000000, 0xC800},
-	{0xC1D7FFFEFFFFFFFF, 0xFC00},
-	{0xC020000000000001, 0xC800},
-	{0x3ABFFFFFFFFC0010, 0x0000},
-	{0xC020000000000002, 0xC800},
-	{0x104000010000FFFF, 0x0000},
-	{0xC020000000000004, 0xC800},
-	{0xC0D00000000FDFFF, 0xF400},
-	{0xC020000000000008, 0xC800},
-	{0x43EFFFFFFFF60000, 0x7C00},
-	{0xC020000000000010, 0xC800},
-	{0x40000001C0000000, 0x4000},
-	{0xC020000000000020, 0xC800},
-	{0xC04FFFFFFFFFFE07, 0xD400},
-	{0xC020000000000040, 0xC800},
-	{0x41E98D2493299C45, 0x7C00},
-	{0xC020000000000080, 0xC800},
-	{0xDA99D6923DE843CD, 0xFC00},
-	{0xC020000000000100, 0xC800},
-	{0x4020000007FFFFFB, 0x4800},
-	{0xC020000000000200, 0xC800},
-	{0xC1FFFFEFFFFFFFF7, 0xFC00},
-	{0xC020000000000400, 0xC800},
-	{0x3FC0000080020000, 0x3000},
-	{0xC020000000000800, 0xC800},
-	{0x40022F77A22AE87D, 0x408C},
-	{0xC020000000001000, 0xC800},
-	{0x3FE00FFFFFFF0000, 0x3804},
-	{0xC020000000002000, 0xC800},
-	{0xBF6007FFFFFC0000, 0x9802},
-	{0xC020000000004000, 0xC800},
-	{0xBFBFFFFFFFFFFFA0, 0xB000},
-	{0xC020000000008000, 0xC800},
-	{0x402FFFFFFFFFFF3F, 0x4C00},
-	{0xC020000000010000, 0xC800},
-	{0x43400001FFFFFFFA, 0x7C00},
-	{0xC020000000020000, 0xC800},
-	{0xB81FFFFFFFFFFCFE, 0x8000},
-	{0xC020000000040000, 0xC800},
-	{0x43E00000000FEFFF, 0x7C00},
-	{0xC020000000080000, 0xC800},
-	{0x3F6F3FFFFFFFFFFE, 0x1BD0},
-	{0xC020000000100000, 0xC800},
-	{0x3FC0000800100000, 0x3000},
-	{0xC020000000200000, 0xC800},
-	{0xD8FDFFE000000000, 0xFC00},
-	{0xC020000000400000, 0xC800},
-	{0xC1EFFFFFFFFF0010, 0xFC00},
-	{0xC020000000800000, 0xC800},
-	{0xC0200000000021FE, 0xC800},
-	{0xC020000001000000, 0xC800},
-	{0xB15001FFFFFFFFF0, 0x8000},
-	{0xC020000002000000, 0xC800},
-	{0x3FC0000080000007, 0x3000},
-	{0xC020000004000000, 0xC800},
-	{0x41E07FFFFFFEFFFE, 0x7C00},
-	{0xC020000008000000, 0xC800},
-	{0x43CFF8000003FFFF, 0x7C00},
-	{0xC020000010000000, 0xC800},
-	{0x7FD00003FFFFEFFE, 0x7C00},
-	{0xC020000020000000, 0xC800},
-	{0xC0000000000FFFFB, 0xC000},
-	{0xC020000040000000, 0xC800},
-	{0x29EFFFFFDFFFFFFE, 0x0000},
-	{0xC020000080000000, 0xC800},
-	{0x617C000000000080, 0x7C00},
-	{0xC020000100000000, 0xC800},
-	{0xC805FFFFFFFFFFFE, 0xFC00},
-	{0xC020000200000000, 0xC800},
-	{0xC7F0000000000000, 0xFC00},
-	{0xC020000400000000, 0xC800},
-	{0xC7200000007FF800, 0xFC00},
-	{0xC020000800000000, 0xC800},
-	{0xC07FFFF000000800, 0xE000},
-	{0xC020001000000000, 0xC800},
-	{0x3FFF7FFF00000000, 0x3FE0},
-	{0xC020002000000000, 0xC800},
-	{0xC1E0000000BFFFFF, 0xFC00},
-	{0xC020004000000000, 0xC800},
-	{0xC1C8E56548017105, 0xFC00},
-	{0xC020008000000000, 0xC800},
-	{0x3FFFFFFFFFF5FFFF, 0x4000},
-	{0xC020010000000000, 0xC800},
-	{0xBF3790366FA44790, 0x8DE4},
-	{0xC020020000000000, 0xC800},
-	{0xC02FE4F86865EA3D, 0xCBF9},
-	{0xC020040000000000, 0xC801},
-	{0x8620000FFFDFFFFE, 0x8000},
-	{0xC020080000000000, 0xC802},
-	{0xC950000005000000, 0xFC00},
-	{0xC020100000000000, 0xC804},
-	{0x41D0101000000000, 0x7C00},
-	{0xC020200000000000, 0xC808},
-	{0xBFBFFFC000003FFE, 0xB000},
-	{0xC020400000000000, 0xC810},
-	{0xC0244E72A9379833, 0xC914},
-	{0xC020800000000000, 0xC820},
-	{0xBE70000001100000, 0x8001},
-	{0xC021000000000000, 0xC840},
-	{0x47F7FB7820C4A5A5, 0x7C00},
-	{0xC022000000000000, 0xC880},
-	{0xCE49864EE4C62640, 0xFC00},
-	{0xC024000000000000, 0xC900},
-	{0xC3E0305CF10D30CD, 0xFC00},
-	{0xC028000000000000, 0xCA00},
-	{0xB7FFFEFFFFFFFFFD, 0x8000},
-	{0xC02C000000000000, 0xCB00},
-	{0xFFE200000007FFFE, 0xFC00},
-	{0xC02E000000000000, 0xCB80},
-	{0x7FEC00000007FFFE, 0x7C00},
-	{0xC02F000000000000, 0xCBC0},
-	{0x405A76EC76A866D8, 0x569E},
-	{0xC02F800000000000, 0xCBE0},
-	{0xC02FFFFE07FFFFFF, 0xCC00},
-	{0xC02FC00000000000, 0xCBF0},
-	{0x434FF7FFFFFFFFF0, 0x7C00},
-	{0xC02FE00000000000, 0xCBF8},
-	{0x3FEFFFFFFFF7FE00, 0x3C00},
-	{0xC02FF00000000000, 0xCBFC},
-	{0x43FFFFFFFDFFEFFF, 0x7C00},
-	{0xC02FF80000000000, 0xCBFE},
-	{0x4006A2E0DF98153A, 0x41A9},
-	{0xC02FFC0000000000, 0xCBFF},
-	{0xC03FFF7FFFFFFFFE, 0xD000},
-	{0xC02FFE0000000000, 0xCC00},
-	{0x173FBFFFDFFFFFFF, 0x0000},
-	{0xC02FFF0000000000, 0xCC00},
-	{0x3810000000800007, 0x0000},
-	{0xC02FFF8000000000, 0xCC00},
-	{0x82CFFFF80007FFFF, 0x8000},
-	{0xC02FFFC000000000, 0xCC00},
-	{0x37F0FFFFFEFFFFFF, 0x0000},
-	{0xC02FFFE000000000, 0xCC00},
-	{0xC1D0020000004000, 0xFC00},
-	{0xC02FFFF000000000, 0xCC00},
-	{0xC0FFFFFEFFFFFFBF, 0xFC00},
-	{0xC02FFFF800000000, 0xCC00},
-	{0xA9AFE007FFFFFFFE, 0x8000},
-	{0xC02FFFFC00000000, 0xCC00},
-	{0x3FBFFFFFFFFFF7C0, 0x3000},
-	{0xC02FFFFE00000000, 0xCC00},
-	{0xFFD219F849126511, 0xFC00},
-	{0xC02FFFFF00000000, 0xCC00},
-	{0x40142AD89088EC19, 0x450B},
-	{0xC02FFFFF80000000, 0xCC00},
-	{0xC80A3BB2F0A033BC, 0xFC00},
-	{0xC02FFFFFC0000000, 0xCC00},
-	{0xFFEB7752F39D1079, 0xFC00},
-	{0xC02FFFFFE0000000, 0xCC00},
-	{0xC3F0994DC21C0305, 0xFC00},
-	{0xC02FFFFFF0000000, 0xCC00},
-	{0xC7E2460E01276B28, 0xFC00},
-	{0xC02FFFFFF8000000, 0xCC00},
-	{0x43EFFDF7FFFFFFFE, 0x7C00},
-	{0xC02FFFFFFC000000, 0xCC00},
-	{0x41D01000000000FF, 0x7C00},
-	{0xC02FFFFFFE000000, 0xCC00},
-	{0xC0F003584FF4F3AB, 0xFC00},
-	{0xC02FFFFFFF000000, 0xCC00},
-	{0x3FD0DB36D439A0D6, 0x3437},
-	{0xC02FFFFFFF800000, 0xCC00},
-	{0xB7EFC00001FFFFFE, 0x8000},
-	{0xC02FFFFFFFC00000, 0xCC00},
-	{0xC0B696003E5633BE, 0xEDA6},
-	{0xC02FFFFFFFE00000, 0xCC00},
-	{0x7FF0F8C1B099BD0A, 0x7E3E},
-	{0xC02FFFFFFFF00000, 0xCC00},
-	{0x400FFBFFFFFFEFFE, 0x43FF},
-	{0xC02FFFFFFFF80000, 0xCC00},
-	{0x3FCFFBFFFFF7FFFF, 0x33FF},
-	{0xC02FFFFFFFFC0000, 0xCC00},
-	{0x43C7FFFFFFFFF7FF, 0x7C00},
-	{0xC02FFFFFFFFE0000, 0xCC00},
-	{0x4F1D1FB4E886409E, 0x7C00},
-	{0xC02FFFFFFFFF0000, 0xCC00},
-	{0x801FFFFEFFFFFBFF, 0x8000},
-	{0xC02FFFFFFFFF8000, 0xCC00},
-	{0xBC6706A0502FB50A, 0x8000},
-	{0xC02FFFFFFFFFC000, 0xCC00},
-	{0xBFA00000001FFF7E, 0xA800},
-	{0xC02FFFFFFFFFE000, 0xCC00},
-	{0x4E600011FFFFFFFF, 0x7C00},
-	{0xC02FFFFFFFFFF000, 0xCC00},
-	{0xC0F00000000807FE, 0xFC00},
-	{0xC02FFFFFFFFFF800, 0xCC00},
-	{0x30E00EFFFFFFFFFE, 0x0000},
-	{0xC02FFFFFFFFFFC00, 0xCC00},
-	{0xC01FFFFF7BFFFFFE, 0xC800},
-	{0xC02FFFFFFFFFFE00, 0xCC00},
-	{0xA5003FFFFFFFFFFF, 0x8000},
-	{0xC02FFFFFFFFFFF00, 0xCC00},
-	{0xB7FFFFFFDFFFFFFE, 0x8000},
-	{0xC02FFFFFFFFFFF80, 0xCC00},
-	{0x3F4C07FFFFFFFFFE, 0x1302},
-	{0xC02FFFFFFFFFFFC0, 0xCC00},
-	{0xC7F0000000000407, 0xFC00},
-	{0xC02FFFFFFFFFFFE0, 0xCC00},
-	{0x3FCFDFFFFBFFFFFF, 0x33F8},
-	{0xC02FFFFFFFFFFFF0, 0xCC00},
-	{0xA86FFFFFFFFFFFB0, 0x8000},
-	{0xC02FFFFFFFFFFFF8, 0xCC00},
-	{0x3817FFFFEFFFFFFE, 0x0000},
-	{0xC02FFFFFFFFFFFFC, 0xCC00},
-	{0xC6BFC000000007FE, 0xFC00},
-	{0xC02FFFFFFFFFFFFE, 0xCC00},
-	{0x47FFFFFDFFFFF7FE, 0x7C00},
-	{0xC02FFFFFFFFFFFFF, 0xCC00},
-	{0xAA2FFFFFFFFF5FFF, 0x8000},
-	{0xC02FFFFFFFFFFFFD, 0xCC00},
-	{0xC3E98981A2CF7CDA, 0xFC00},
-	{0xC02FFFFFFFFFFFFB, 0xCC00},
-	{0x47EF26A466E1A8E1, 0x7C00},
-	{0xC02FFFFFFFFFFFF7, 0xCC00},
-	{0x5B9020000000000F, 0x7C00},
-	{0xC02FFFFFFFFFFFEF, 0xCC00},
-	{0x8BFFFFFF800000FE, 0x8000},
-	{0xC02FFFFFFFFFFFDF, 0xCC00},
-	{0xBFCEB776C58E818A, 0xB3AE},
-	{0xC02FFFFFFFFFFFBF, 0xCC00},
-	{0x3FDF80000FFFFFFF, 0x37E0},
-	{0xC02FFFFFFFFFFF7F, 0xCC00},
-	{0xC0C8000000001FFF, 0xF200},
-	{0xC02FFFFFFFFFFEFF, 0xCC00},
-	{0xD3CF3D65AFD459CE, 0xFC00},
-	{0xC02FFFFFFFFFFDFF, 0xCC00},
-	{0x3CAFFFFFFE0FFFFE, 0x0000},
-	{0xC02FFFFFFFFFFBFF, 0xCC00},
-	{0x47EFFFFFFFE00003, 0x7C00},
-	{0xC02FFFFFFFFFF7FF, 0xCC00},
-	{0x40FAACC72AFCB442, 0x7C00},
-	{0xC02FFFFFFFFFEFFF, 0xCC00},
-	{0x40001FFFC0000000, 0x4008},
-	{0xC02FFFFFFFFFDFFF, 0xCC00},
-	{0x3EE047FFFFFFFFFE, 0x0082},
-	{0xC02FFFFFFFFFBFFF, 0xCC00},
-	{0xBFFFFFBFFFFFFFFE, 0xC000},
-	{0xC02FFFFFFFFF7FFF, 0xCC00},
-	{0xCDAFFFFFFFEFFFFC, 0xFC00},
-	{0xC02FFFFFFFFEFFFF, 0xCC00},
-	{0x401FFFF80001FFFE, 0x4800},
-	{0xC02FFFFFFFFDFFFF, 0xCC00},
-	{0x3FE1BD8100101511, 0x386F},
-	{0xC02FFFFFFFFBFFFF, 0xCC00},
-	{0x44D003F800000000, 0x7C00},
-	{0xC02FFFFFFFF7FFFF, 0xCC00},
-	{0x001001FFFF000000, 0x0000},
-	{0xC02FFFFFFFEFFFFF, 0xCC00},
-	{0xC168FF45260FA9BC, 0xFC00},
-	{0xC02FFFFFFFDFFFFF, 0xCC00},
-	{0xFFD00007FFFFFBFF, 0xFC00},
-	{0xC02FFFFFFFBFFFFF, 0xCC00},
-	{0x41F000000010000E, 0x7C00},
-	{0xC02FFFFFFF7FFFFF, 0xCC00},
-	{0x43F3FFFFFFFFFDFE, 0x7C00},
-	{0xC02FFFFFFEFFFFFF, 0xCC00},
-	{0xD3DFFFE000004000, 0xFC00},
-	{0xC02FFFFFFDFFFFFF, 0xCC00},
-	{0xBA0E95E9448FCEB0, 0x8000},
-	{0xC02FFFFFFBFFFFFF, 0xCC00},
-	{0x3FB0007FBFFFFFFF, 0x2C00},
-	{0xC02FFFFFF7FFFFFF, 0xCC00},
-	{0xFFD0008000000000, 0xFC00},
-	{0xC02FFFFFEFFFFFFF, 0xCC00},
-	{0x43EFFFFFF800001F, 0x7C00},
-	{0xC02FFFFFDFFFFFFF, 0xCC00},
-	{0x3FFFFFFFFF001FFE, 0x4000},
-	{0xC02FFFFFBFFFFFFF, 0xCC00},
-	{0xBFEFFFF000007FFF, 0xBC00},
-	{0xC02FFFFF7FFFFFFF, 0xCC00},
-	{0x4020000000000020, 0x4800},
-	{0xC02FFFFEFFFFFFFF, 0xCC00},
-	{0xB76000000010000E, 0x8000},
-	{0xC02FFFFDFFFFFFFF, 0xCC00},
-	{0x3420000080000800, 0x0000},
-	{0xC02FFFFBFFFFFFFF, 0xCC00},
-	{0x41C0000000200002, 0x7C00},
-	{0xC02FFFF7FFFFFFFF, 0xCC00},
-	{0x3FDFFFFC00003FFF, 0x3800},
-	{0xC02FFFEFFFFFFFFF, 0xCC00},
-	{0x4000000103FFFFFF, 0x4000},
-	{0xC02FFFDFFFFFFFFF, 0xCC00},
-	{0xA5BFFF00003FFFFE, 0x8000},
-	{0xC02FFFBFFFFFFFFF, 0xCC00},
-	{0x43CFFFE200000000, 0x7C00},
-	{0xC02FFF7FFFFFFFFF, 0xCC00},
-	{0x9E4020000000003F, 0x8000},
-	{0xC02FFEFFFFFFFFFF, 0xCC00},
-	{0x8000000FFF7FFFFF, 0x8000},
-	{0xC02FFDFFFFFFFFFF, 0xCBFF},
-	{0xC1D28AA2D10E9CAD, 0xFC00},
-	{0xC02FFBFFFFFFFFFF, 0xCBFF},
-	{0x7FDFFFFFC1FFFFFF, 0x7C00},
-	{0xC02FF7FFFFFFFFFF, 0xCBFE},
-	{0x415FFFFF7FFFFFBF, 0x7C00},
-	{0xC02FEFFFFFFFFFFF, 0xCBFC},
-	{0xBC19A4E58F607493, 0x8000},
-	{0xC02FDFFFFFFFFFFF, 0xCBF8},
-	{0xB81968BBADFE6654, 0x8000},
-	{0xC02FBFFFFFFFFFFF, 0xCBF0},
-	{0xC80F800002000000, 0xFC00},
-	{0xC02F7FFFFFFFFFFF, 0xCBE0},
-	{0x4037FFFF00000000, 0x4E00},
-	{0xC02EFFFFFFFFFFFF, 0xCBC0},
-	{0xBCA0000000008100, 0x8000},
-	{0xC02DFFFFFFFFFFFF, 0xCB80},
-	{0xC23000000010FFFF, 0xFC00},
-	{0xC02BFFFFFFFFFFFF, 0xCB00},
-	{0x4CDFFF7BFFFFFFFF, 0x7C00},
-	{0xC027FFFFFFFFFFFF, 0xCA00},
-	{0xBFC0000000000024, 0xB000},
-	{0xC023FFFFFFFFFFFF, 0xC900},
-	{0xBCAD696FC8033F57, 0x8000},
-	{0xC021FFFFFFFFFFFF, 0xC880},
-	{0xD6AFFFFDF7FFFFFF, 0xFC00},
-	{0xC020FFFFFFFFFFFF, 0xC840},
-	{0xBFF0B0C6C86816AA, 0xBC2C},
-	{0xC0207FFFFFFFFFFF, 0xC820},
-	{0xC0DFFFFFFDFFFFBE, 0xF800},
-	{0xC0203FFFFFFFFFFF, 0xC810},
-	{0x47FFFFE000000100, 0x7C00},
-	{0xC0201FFFFFFFFFFF, 0xC808},
-	{0xC7300000001FFFBF, 0xFC00},
-	{0xC0200FFFFFFFFFFF, 0xC804},
-	{0x01348D6A5F1E21EC, 0x0000},
-	{0xC02007FFFFFFFFFF, 0xC802},
-	{0xC01FFFF7EFFFFFFE, 0xC800},
-	{0xC02003FFFFFFFFFF, 0xC801},
-	{0x801FFFFFFFF00000, 0x8000},
-	{0xC02001FFFFFFFFFF, 0xC800},
-	{0xBD8F800000FFFFFE, 0x8000},
-	{0xC02000FFFFFFFFFF, 0xC800},
-	{0x43F7E8791BD1E08D, 0x7C00},
-	{0xC020007FFFFFFFFF, 0xC800},
-	{0xC1CD9F2E6AD17C59, 0xFC00},
-	{0xC020003FFFFFFFFF, 0xC800},
-	{0xC36FFFFFFFEFFFEF, 0xFC00},
-	{0xC020001FFFFFFFFF, 0xC800},
-	{0x440FFFFFFFF9FFFF, 0x7C00},
-	{0xC020000FFFFFFFFF, 0xC800},
-	{0xBFF002000000003E, 0xBC01},
-	{0xC0200007FFFFFFFF, 0xC800},
-	{0xB8E01FFBFFFFFFFE, 0x8000},
-	{0xC0200003FFFFFFFF, 0xC800},
-	{0x3FBFFFFFFB7FFFFF, 0x3000},
-	{0xC0200001FFFFFFFF, 0xC800},
-	{0xBCAFFFFFF80007FF, 0x8000},
-	{0xC0200000FFFFFFFF, 0xC800},
-	{0x401FFFFFFFB7FFFF, 0x4800},
-	{0xC02000007FFFFFFF, 0xC800},
-	{0xEA1FFFF7FFFFF7FF, 0xFC00},
-	{0xC02000003FFFFFFF, 0xC800},
-	{0x43F800FFFFFFFFFF, 0x7C00},
-	{0xC02000001FFFFFFF, 0xC800},
-	{0xC03100000000FFFF, 0xCC40},
-	{0xC02000000FFFFFFF, 0xC800},
-	{0x3A9FFEFFFFF00000, 0x0000},
-	{0xC020000007FFFFFF, 0xC800},
-	{0x3E3FFFFFFFFF0007, 0x0000},
-	{0xC020000003FFFFFF, 0xC800},
-	{0xC09FF8000003FFFF, 0xE7FE},
-	{0xC020000001FFFFFF, 0xC800},
-	{0xBFF00000000FFFFC, 0xBC00},
-	{0xC020000000FFFFFF, 0xC800},
-	{0xC0101003FFFFFFFE, 0xC404},
-	{0xC0200000007FFFFF, 0xC800},
-	{0x47FEFFFFFFFE0000, 0x7C00},
-	{0xC0200000003FFFFF, 0xC800},
-	{0x3ED000FFFFE00000, 0x0040},
-	{0xC0200000001FFFFF, 0xC800},
-	{0xFFF4A4A36D1CAC6D, 0xFF29},
-	{0xC0200000000FFFFF, 0xC800},
-	{0x43EFFFF7FFFEFFFF, 0x7C00},
-	{0xC02000000007FFFF, 0xC800},
-	{0x7FEFDFFFFFFFFC00, 0x7C00},
-	{0xC02000000003FFFF, 0xC800},
-	{0x400DFFFFFFFFFFC0, 0x4380},
-	{0xC02000000001FFFF, 0xC800},
-	{0xC80066178EEC7AE4, 0xFC00},
-	{0xC02000000000FFFF, 0xC800},
-	{0x37E000200001FFFF, 0x0000},
-	{0xC020000000007FFF, 0xC800},
-	{0x43FFF7FFFF7FFFFF, 0x7C00},
-	{0xC020000000003FFF, 0xC800},
-	{0xC7F74CF821C5FD7A, 0xFC00},
-	{0xC020000000001FFF, 0xC800},
-	{0x43400000007DFFFF, 0x7C00},
-	{0xC020000000000FFF, 0xC800},
-	{0xBFC7FF8000000000, 0xB200},
-	{0xC0200000000007FF, 0xC800},
-	{0x7FD000000400001F, 0x7C00},
-	{0xC0200000000003FF, 0xC800},
-	{0xFFF0000000FEFFFF, 0xFE00},
-	{0xC0200000000001FF, 0xC800},
-	{0xC0F000003FFFC000, 0xFC00},
-	{0xC0200000000000FF, 0xC800},
-	{0x3FD000FFFFDFFFFF, 0x3400},
-	{0xC02000000000007F, 0xC800},
-	{0xB81FFFFFFFFFDFFC, 0x8000},
-	{0xC02000000000003F, 0xC800},
-	{0x3FCFFFFFFF000080, 0x3400},
-	{0xC02000000000001F, 0xC800},
-	{0xC7FFFFF7FFF00000, 0xFC00},
-	{0xC02000000000000F, 0xC800},
-	{0xB7F1FFFDFFFFFFFF, 0x8000},
-	{0xC020000000000007, 0xC800},
-	{0xB5DFFFFF80000000, 0x8000},
-	{0xC020000000000003, 0xC800},
-	{0x41EEFD002D9E3BB8, 0x7C00},
-	{0xC030000000000000, 0xCC00},
-	{0xC014986A785D77D1, 0xC526},
-	{0xC030000000000001, 0xCC00},
-	{0x3810000080040000, 0x0000},
-	{0xC030000000000002, 0xCC00},
-	{0x801FFE0000000000, 0x8000},
-	{0xC030000000000004, 0xCC00},
-	{0x3FE000100001FFFF, 0x3800},
-	{0xC030000000000008, 0xCC00},
-	{0xCB3066C95EB7C21D, 0xFC00},
-	{0xC030000000000010, 0xCC00},
-	{0x3FCFFFBFFFFFFFF7, 0x3400},
-	{0xC030000000000020, 0xCC00},
-	{0x3CA0000000103FFF, 0x0000},
-	{0xC030000000000040, 0xCC00},
-	{0xC3A0003FFFFDFFFF, 0xFC00},
-	{0xC030000000000080, 0xCC00},
-	{0xC1F0000080000001, 0xFC00},
-	{0xC030000000000100, 0xCC00},
-	{0x3FEFFFFC0000007F, 0x3C00},
-	{0xC030000000000200, 0xCC00},
-	{0xBF4FF7FFF0000000, 0x93FE},
-	{0xC030000000000400, 0xCC00},
-	{0x381E8F96D50C9DC0, 0x0000},
-	{0xC030000000000800, 0xCC00},
-	{0xC1FEF6F24C756255, 0xFC00},
-	{0xC030000000001000, 0xCC00},
-	{0xB80FFFFF800FFFFE, 0x8000},
-	{0xC030000000002000, 0xCC00},
-	{0xC00CD3EFFD12FAC2, 0xC335},
-	{0xC030000000004000, 0xCC00},
-	{0x3F3FFFFFFFEFC000, 0x1000},
-	{0xC030000000008000, 0xCC00},
-	{0x21CDEFFFFFFFFFFF, 0x0000},
-	{0xC030000000010000, 0xCC00},
-	{0x7FD284BB8AEA5A24, 0x7C00},
-	{0xC030000000020000, 0xCC00},
-	{0x6680000017FFFFFF, 0x7C00},
-	{0xC030000000040000, 0xCC00},
-	{0x4020000000018000, 0x4800},
-	{0xC030000000080000, 0xCC00},
-	{0x3F251B997770BFCC, 0x0947},
-	{0xC030000000100000, 0xCC00},
-	{0x801FFC0008000000, 0x8000},
-	{0xC030000000200000, 0xCC00},
-	{0x45F00002001FFFFF, 0x7C00},
-	{0xC030000000400000, 0xCC00},
-	{0x001000000003FBFF, 0x0000},
-	{0xC030000000800000, 0xCC00},
-	{0xC3FDE6824714CFA8, 0xFC00},
-	{0xC030000001000000, 0xCC00},
-	{0x002FFE0000001FFE, 0x0000},
-	{0xC030000002000000, 0xCC00},
-	{0x3F5FFFFFE003FFFF, 0x1800},
-	{0xC030000004000000, 0xCC00},
-	{0x409BCA952665330F, 0x66F3},
-	{0xC030000008000000, 0xCC00},
-	{0xFFF00000000000A0, 0xFE00},
-	{0xC030000010000000, 0xCC00},
-	{0xBFB000000000FFF7, 0xAC00},
-	{0xC030000020000000, 0xCC00},
-	{0xBFCFFFFC00000FFE, 0xB400},
-	{0xC030000040000000, 0xCC00},
-	{0x4800000040080000, 0x7C00},
-	{0xC030000080000000, 0xCC00},
-	{0x480800000000000F, 0x7C00},
-	{0xC030000100000000, 0xCC00},
-	{0x802FFFFFC0007FFE, 0x8000},
-	{0xC030000200000000, 0xCC00},
-	{0x434FFFFFC0000001, 0x7C00},
-	{0xC030000400000000, 0xCC00},
-	{0xB813F7D212DB5D8E, 0x8000},
-	{0xC030000800000000, 0xCC00},
-	{0x3FE0000000000000, 0x3800},
-	{0xC030001000000000, 0xCC00},
-	{0x414FFFFFFFC03FFF, 0x7C00},
-	{0xC030002000000000, 0xCC00},
-	{0x802000000FFEFFFF, 0x8000},
-	{0xC030004000000000, 0xCC00},
-	{0xC1F1A70B472EC7B7, 0xFC00},
-	{0xC030008000000000, 0xCC00},
-	{0x400000000000200F, 0x4000},
-	{0xC030010000000000, 0xCC00},
-	{0xFFEFFFFE003FFFFF, 0xFC00},
-	{0xC030020000000000, 0xCC00},
-	{0x41EFFFFFFFE000FF, 0x7C00},
-	{0xC030040000000000, 0xCC01},
-	{0x41DFFFEFFFDFFFFF, 0x7C00},
-	{0xC030080000000000, 0xCC02},
-	{0xC000000004000020, 0xC000},
-	{0xC030100000000000, 0xCC04},
-	{0xDF1000077FFFFFFE, 0xFC00},
-	{0xC030200000000000, 0xCC08},
-	{0x3819AB5035510A08, 0x0000},
-	{0xC030400000000000, 0xCC10},
-	{0x43DFFFFFFFEFFFEF, 0x7C00},
-	{0xC030800000000000, 0xCC20},
-	{0xBD7660D811855574, 0x8000},
-	{0xC031000000000000, 0xCC40},
-	{0xC05284FA8BA9AE45, 0xD4A1},
-	{0xC032000000000000, 0xCC80},
-	{0x47CE1D3D52E0CC67, 0x7C00},
-	{0xC034000000000000, 0xCD00},
-	{0x7FDFFFFFFF9FFFFF, 0x7C00},
-	{0xC038000000000000, 0xCE00},
-	{0x40074CD3D625B04D, 0x41D3},
-	{0xC03C000000000000, 0xCF00},
-	{0x403FFFFFF8000040, 0x5000},
-	{0xC03E000000000000, 0xCF80},
-	{0x434FFFC7FFFFFFFF, 0x7C00},
-	{0xC03F000000000000, 0xCFC0},
-	{0xBF800080003FFFFE, 0xA000},
-	{0xC03F800000000000, 0xCFE0},
-	{0xC0100000000007DF, 0xC400},
-	{0xC03FC00000000000, 0xCFF0},
-	{0xA5194374973E2530, 0x8000},
-	{0xC03FE00000000000, 0xCFF8},
-	{0x800F7FFFFF7FFFFE, 0x8000},
-	{0xC03FF00000000000, 0xCFFC},
-	{0x47F5EC6043EA294C, 0x7C00},
-	{0xC03FF80000000000, 0xCFFE},
-	{0xB80020000007FFFE, 0x8000},
-	{0xC03FFC0000000000, 0xCFFF},
-	{0x43DF8000003FFFFF, 0x7C00},
-	{0xC03FFE0000000000, 0xD000},
-	{0x513FFDFFFFFFFFFA, 0x7C00},
-	{0xC03FFF0000000000, 0xD000},
-	{0xC140000000080002, 0xFC00},
-	{0xC03FFF8000000000, 0xD000},
-	{0x403400FFFFFFFFFE, 0x4D00},
-	{0xC03FFFC000000000, 0xD000},
-	{0x426C4BF0F0BCC8C8, 0x7C00},
-	{0xC03FFFE000000000, 0xD000},
-	{0xBFD0007BFFFFFFFF, 0xB400},
-	{0xC03FFFF000000000, 0xD000},
-	{0xC15FFFFF00000004, 0xFC00},
-	{0xC03FFFF800000000, 0xD000},
-	{0x380010000000007F, 0x0000},
-	{0xC03FFFFC00000000, 0xD000},
-	{0xC03FFFFFFFFFFFFF, 0xD000},
-	{0xC03FFFFE00000000, 0xD000},
-	{0x400FFFC01FFFFFFF, 0x4400},
-	{0xC03FFFFF00000000, 0xD000},
-	{0xC03FFFFFFFFFE800, 0xD000},
-	{0xC03FFFFF80000000, 0xD000},
-	{0xC0200017FFFFFFFE, 0xC800},
-	{0xC03FFFFFC0000000, 0xD000},
-	{0x4340807FFFFFFFFF, 0x7C00},
-	{0xC03FFFFFE0000000, 0xD000},
-	{0x402B6C8E6D631208, 0x4ADB},
-	{0xC03FFFFFF0000000, 0xD000},
-	{0xC1E3ACE1D279323E, 0xFC00},
-	{0xC03FFFFFF8000000, 0xD000},
-	{0x282FFFFFFFF7FF7F, 0x0000},
-	{0xC03FFFFFFC000000, 0xD000},
-	{0x3FDFFFFFFEFDFFFF, 0x3800},
-	{0xC03FFFFFFE000000, 0xD000},
-	{0x3FAF9FFFFFFFFFFF, 0x2BE8},
-	{0xC03FFFFFFF000000, 0xD000},
-	{0x3FD001000000007E, 0x3400},
-	{0xC03FFFFFFF800000, 0xD000},
-	{0x43F7FFFFFDFFFFFE, 0x7C00},
-	{0xC03FFFFFFFC00000, 0xD000},
-	{0x3FC03F7FFFFFFFFF, 0x3010},
-	{0xC03FFFFFFFE00000, 0xD000},
-	{0x4465E9D6D263B16C, 0x7C00},
-	{0xC03FFFFFFFF00000, 0xD000},
-	{0x40100000000800FF, 0x4400},
-	{0xC03FFFFFFFF80000, 0xD000},
-	{0xFFE00040000FFFFF, 0xFC00},
-	{0xC03FFFFFFFFC0000, 0xD000},
-	{0x7FEFFFF7BFFFFFFF, 0x7C00},
-	{0xC03FFFFFFFFE0000, 0xD000},
-	{0x403DE94E216ABE3B, 0x4F7A},
-	{0xC03FFFFFFFFF0000, 0xD000},
-	{0xC000001FFFFFF800, 0xC000},
-	{0xC03FFFFFFFFF8000, 0xD000},
-	{0x000C001FFFFFFFFF, 0x0000},
-	{0xC03FFFFFFFFFC000, 0xD000},
-	{0x002CA116F913AD51, 0x0000},
-	{0xC03FFFFFFFFFE000, 0xD000},
-	{0x41E00000000101FF, 0x7C00},
-	{0xC03FFFFFFFFFF000, 0xD000},
-	{0xB340800000007FFE, 0x8000},
-	{0xC03FFFFFFFFFF800, 0xD000},
-	{0xC12FFFFFDFFFFFBF, 0xFC00},
-	{0xC03FFFFFFFFFFC00, 0xD000},
-	{0xC7FFF00001000000, 0xFC00},
-	{0xC03FFFFFFFFFFE00, 0xD000},
-	{0x000FFF3FFFFFFFFF, 0x0000},
-	{0xC03FFFFFFFFFFF00, 0xD000},
-	{0xC0D6DDC04F24A9BE, 0xF5B7},
-	{0xC03FFFFFFFFFFF80, 0xD000},
-	{0x47EBFFFFFFFFFEFF, 0x7C00},
-	{0xC03FFFFFFFFFFFC0, 0xD000},
-	{0xFFEFFFFE00000FFF, 0xFC00},
-	{0xC03FFFFFFFFFFFE0, 0xD000},
-	{0x41D0008010000000, 0x7C00},
-	{0xC03FFFFFFFFFFFF0, 0xD000},
-	{0x3FBFAFD19167E96F, 0x2FEC},
-	{0xC03FFFFFFFFFFFF8, 0xD000},
-	{0x47EFFFFFFDBFFFFF, 0x7C00},
-	{0xC03FFFFFFFFFFFFC, 0xD000},
-	{0x41D0200000001FFF, 0x7C00},
-	{0xC03FFFFFFFFFFFFE, 0xD000},
-	{0xBFCAA9A5CEB0E4E6, 0xB2AA},
-	{0xC03FFFFFFFFFFFFF, 0xD000},
-	{0xBFC0003FFFFFFBFF, 0xB000},
-	{0xC03FFFFFFFFFFFFD, 0xD000},
-	{0xAEBF040000000000, 0x8000},
-	{0xC03FFFFFFFFFFFFB, 0xD000},
-	{0x40F0000000000008, 0x7C00},
-	{0xC03FFFFFFFFFFFF7, 0xD000},
-	{0x001FFFFC00000800, 0x0000},
-	{0xC03FFFFFFFFFFFEF, 0xD000},
-	{0x40174FA867E4AAB0, 0x45D4},
-	{0xC03FFFFFFFFFFFDF, 0xD000},
-	{0xBE2FF7FBFFFFFFFF, 0x8000},
-	{0xC03FFFFFFFFFFFBF, 0xD000},
-	{0x41FFF80003FFFFFF, 0x7C00},
-	{0xC03FFFFFFFFFFF7F, 0xD000},
-	{0x54703FFFFFFFDFFE, 0x7C00},
-	{0xC03FFFFFFFFFFEFF, 0xD000},
-	{0x380FFF7FFFFFFFFF, 0x0000},
-	{0xC03FFFFFFFFFFDFF, 0xD000},
-	{0xC00FFFFFE00000FE, 0xC400},
-	{0xC03FFFFFFFFFFBFF, 0xD000},
-	{0x37FFFFFFC000003F, 0x0000},
-	{0xC03FFFFFFFFFF7FF, 0xD000},
-	{0xBFD8D502FF875BFF, 0xB635},
-	{0xC03FFFFFFFFFEFFF, 0xD000},
-	{0xC3FFFFFFFFE007FE, 0xFC00},
-	{0xC03FFFFFFFFFDFFF, 0xD000},
-	{0xC80FFFFFFFFFFF20, 0xFC00},
-	{0xC03FFFFFFFFFBFFF, 0xD000},
-	{0x380FFFFFFE000040, 0x0000},
-	{0xC03FFFFFFFFF7FFF, 0xD000},
-	{0xC7F0008000000FFF, 0xFC00},
-	{0xC03FFFFFFFFEFFFF, 0xD000},
-	{0xB5D00000000000BE, 0x8000},
-	{0xC03FFFFFFFFDFFFF, 0xD000},
-	{0x3FC000FFFFFFFFDF, 0x3000},
-	{0xC03FFFFFFFFBFFFF, 0xD000},
-	{0xBFB0373C2D156E40, 0xAC0E},
-	{0xC03FFFFFFFF7FFFF, 0xD000},
-	{0xBDE3FFFFFFFFFFDE, 0x8000},
-	{0xC03FFFFFFFEFFFFF, 0xD000},
-	{0xC1F0800000000000, 0xFC00},
-	{0xC03FFFFFFFDFFFFF, 0xD000},
-	{0xB7FFFFF7FFDFFFFF, 0x8000},
-	{0xC03FFFFFFFBFFFFF, 0xD000},
-	{0x38F0000000047FFF, 0x0000},
-	{0xC03FFFFFFF7FFFFF, 0xD000},
-	{0xC010000000047FFF, 0xC400},
-	{0xC03FFFFFFEFFFFFF, 0xD000},
-	{0xC3AEFFFFFEFFFFFF, 0xFC00},
-	{0xC03FFFFFFDFFFFFF, 0xD000},
-	{0xC3CB4164E7C82E31, 0xFC00},
-	{0xC03FFFFFFBFFFFFF, 0xD000},
-	{0xBB9499B6F38D1C79, 0x8000},
-	{0xC03FFFFFF7FFFFFF, 0xD000},
-	{0xAA2000000200003E, 0x8000},
-	{0xC03FFFFFEFFFFFFF, 0xD000},
-	{0x3FD000FFFEFFFFFF, 0x3400},
-	{0xC03FFFFFDFFFFFFF, 0xD000},
-	{0x405F9B027A324C49, 0x57E7},
-	{0xC03FFFFFBFFFFFFF, 0xD000},
-	{0xBF4FFFFFFBFFFFF8, 0x9400},
-	{0xC03FFFFF7FFFFFFF, 0xD000},
-	{0xA220200000000001, 0x8000},
-	{0xC03FFFFEFFFFFFFF, 0xD000},
-	{0x232FFFFFFFFFFBFF, 0x0000},
-	{0xC03FFFFDFFFFFFFF, 0xD000},
-	{0x41CFFF800001FFFE, 0x7C00},
-	{0xC03FFFFBFFFFFFFF, 0xD000},
-	{0xBFEFFFFFFFFFFF7E, 0xBC00},
-	{0xC03FFFF7FFFFFFFF, 0xD000},
-	{0x000FFF7FFFFFFFDE, 0x0000},
-	{0xC03FFFEFFFFFFFFF, 0xD000},
-	{0xBFCFFFFFE0000003, 0xB400},
-	{0xC03FFFDFFFFFFFFF, 0xD000},
-	{0xD45FE00000004000, 0xFC00},
-	{0xC03FFFBFFFFFFFFF, 0xD000},
-	{0xC3EFC00000004000, 0xFC00},
-	{0xC03FFF7FFFFFFFFF, 0xD000},
-	{0x41DFFFBFFFFFFFFF, 0x7C00},
-	{0xC03FFEFFFFFFFFFF, 0xD000},
-	{0xC6A03FFDFFFFFFFF, 0xFC00},
-	{0xC03FFDFFFFFFFFFF, 0xCFFF},
-	{0x41D000009FFFFFFF, 0x7C00},
-	{0xC03FFBFFFFFFFFFF, 0xCFFF},
-	{0x0021447786A8A8D7, 0x0000},
-	{0xC03FF7FFFFFFFFFF, 0xCFFE},
-	{0xC3D7FFFFFFFFEFFE, 0xFC00},
-	{0xC03FEFFFFFFFFFFF, 0xCFFC},
-	{0xC03FFFF80FFFFFFE, 0xD000},
-	{0xC03FDFFFFFFFFFFF, 0xCFF8},
-	{0xBFCFFFFFFFBFFFF0, 0xB400},
-	{0xC03FBFFFFFFFFFFF, 0xCFF0},
-	{0xC3EFFFFFFFFCFFFF, 0xFC00},
-	{0xC03F7FFFFFFFFFFF, 0xCFE0},
-	{0x3FB4E8B6A3F3BA4A, 0x2D3A},
-	{0xC03EFFFFFFFFFFFF, 0xCFC0},
-	{0x00268FB820C36B05, 0x0000},
-	{0xC03DFFFFFFFFFFFF, 0xCF80},
-	{0x3CAED03257BA08BC, 0x0000},
-	{0xC03BFFFFFFFFFFFF, 0xCF00},
-	{0x7FD0000007FFF800, 0x7C00},
-	{0xC037FFFFFFFFFFFF, 0xCE00},
-	{0x41EFF80000FFFFFE, 0x7C00},
-	{0xC033FFFFFFFFFFFF, 0xCD00},
-	{0xB8700000FFFFFFFF, 0x8000},
-	{0xC031FFFFFFFFFFFF, 0xCC80},
-	{0x3E96E4CCEF4B4F58, 0x0006},
-	{0xC030FFFFFFFFFFFF, 0xCC40},
-	{0x3FF03FFFFFFDFFFE, 0x3C10},
-	{0xC0307FFFFFFFFFFF, 0xCC20},
-	{0xC011FFFFFFDFFFFF, 0xC480},
-	{0xC0303FFFFFFFFFFF, 0xCC10},
-	{0x47F0800000800000, 0x7C00},
-	{0xC0301FFFFFFFFFFF, 0xCC08},
-	{0x41E00002FFFFFFFF, 0x7C00},
-	{0xC0300FFFFFFFFFFF, 0xCC04},
-	{0xC0AFFFFFFFFE0000, 0xEC00},
-	{0xC03007FFFFFFFFFF, 0xCC02},
-	{0xBFC0000000008FFF, 0xB000},
-	{0xC03003FFFFFFFFFF, 0xCC01},
-	{0xC03438C0AB4AFC29, 0xCD0E},
-	{0xC03001FFFFFFFFFF, 0xCC00},
-	{0x41E00080FFFFFFFF, 0x7C00},
-	{0xC03000FFFFFFFFFF, 0xCC00},
-	{0x000FFFFFFFFFEDFF, 0x0000},
-	{0xC030007FFFFFFFFF, 0xCC00},
-	{0xBFEFFFFFFFFFDF00, 0xBC00},
-	{0xC030003FFFFFFFFF, 0xCC00},
-	{0x43FE000000000FFF, 0x7C00},
-	{0xC030001FFFFFFFFF, 0xCC00},
-	{0xC01000000FFEFFFE, 0xC400},
-	{0xC030000FFFFFFFFF, 0xCC00},
-	{0xC7E0000002000010, 0xFC00},
-	{0xC0300007FFFFFFFF, 0xCC00},
-	{0x4014E2B38147DD8A, 0x4539},
-	{0xC0300003FFFFFFFF, 0xCC00},
-	{0xC06FBFFFF0000000, 0xDBF0},
-	{0xC0300001FFFFFFFF, 0xCC00},
-	{0x3F3FFFFEFFFFFFFF, 0x1000},
-	{0xC0300000FFFFFFFF, 0xCC00},
-	{0xBF9000000001FE00, 0xA400},
-	{0xC03000007FFFFFFF, 0xCC00},
-	{0x41F0000200000007, 0x7C00},
-	{0xC03000003FFFFFFF, 0xCC00},
-	{0xCFEDFEFFFFFFFFFF, 0xFC00},
-	{0xC03000001FFFFFFF, 0xCC00},
-	{0xF090000020000008, 0xFC00},
-	{0xC03000000FFFFFFF, 0xCC00},
-	{0x800F7FFFFFFFFF80, 0x8000},
-	{0xC030000007FFFFFF, 0xCC00},
-	{0x28E0000000000300, 0x0000},
-	{0xC030000003FFFFFF, 0xCC00},
-	{0x31CABA1EABDBF503, 0x0000},
-	{0xC030000001FFFFFF, 0xCC00},
-	{0xBFDE008000000000, 0xB780},
-	{0xC030000000FFFFFF, 0xCC00},
-	{0xC02FFFFFFBFFDFFF, 0xCC00},
-	{0xC0300000007FFFFF, 0xCC00},
-	{0x8B3F7FFFFDFFFFFF, 0x8000},
-	{0xC0300000003FFFFF, 0xCC00},
-	{0xC0703FFFC0000000, 0xDC10},
-	{0xC0300000001FFFFF, 0xCC00},
-	{0x4030000000800800, 0x4C00},
-	{0xC0300000000FFFFF, 0xCC00},
-	{0xBFB00007FFFFFFDF, 0xAC00},
-	{0xC03000000007FFFF, 0xCC00},
-	{0x37FFFFFFEFFDFFFF, 0x0000},
-	{0xC03000000003FFFF, 0xCC00},
-	{0x381000000200FFFE, 0x0000},
-	{0xC03000000001FFFF, 0xCC00},
-	{0x40FFFFFFFFF7FEFE, 0x7C00},
-	{0xC03000000000FFFF, 0xCC00},
-	{0x3F30043FFFFFFFFF, 0x0C01},
-	{0xC030000000007FFF, 0xCC00},
-	{0xC01FFFFFE0FFFFFF, 0xC800},
-	{0xC030000000003FFF, 0xCC00},
-	{0x43CFFFFFBFFFFFFC, 0x7C00},
-	{0xC030000000001FFF, 0xCC00},
-	{0x405100000000FFFF, 0x5440},
-	{0xC030000000000FFF, 0xCC00},
-	{0xC0F122CC07BF3687, 0xFC00},
-	{0xC0300000000007FF, 0xCC00},
-	{0xBFDFFFE800000000, 0xB800},
-	{0xC0300000000003FF, 0xCC00},
-	{0x3FEFFFFCFFFFFFFF, 0x3C00},
-	{0xC0300000000001FF, 0xCC00},
-	{0x800FFEFFFFFFFFBF, 0x8000},
-	{0xC0300000000000FF, 0xCC00},
-	{0x40ADFFFFFC000000, 0x6B80},
-	{0xC03000000000007F, 0xCC00},
-	{0x434000000003F7FF, 0x7C00},
-	{0xC03000000000003F, 0xCC00},
-	{0x3FD0400003FFFFFF, 0x3410},
-	{0xC03000000000001F, 0xCC00},
-	{0x37F5F097DD33088A, 0x0000},
-	{0xC03000000000000F, 0xCC00},
-	{0xC38856647388F919, 0xFC00},
-	{0xC030000000000007, 0xCC00},
-	{0x3FDF00000000003E, 0x37C0},
-	{0xC030000000000003, 0xCC00},
-	{0x557BFFFFFFFDFFFF, 0x7C00},
-	{0xC1C0000000000000, 0xFC00},
-	{0x43B146B6CEB550B0, 0x7C00},
-	{0xC1C0000000000001, 0xFC00},
-	{0x401FFFFFFFFFE00E, 0x4800},
-	{0xC1C0000000000002, 0xFC00},
-	{0xC1F00000FFFF7FFF, 0xFC00},
-	{0xC1C0000000000004, 0xFC00},
-	{0x396F80000000000E, 0x0000},
-	{0xC1C0000000000008, 0xFC00},
-	{0xC8F1BA58B3EA40A9, 0xFC00},
-	{0xC1C0000000000010, 0xFC00},
-	{0x3FAFFBFFFFFEFFFF, 0x2BFF},
-	{0xC1C0000000000020, 0xFC00},
-	{0x2EA0000000001007, 0x0000},
-	{0xC1C0000000000040, 0xFC00},
-	{0x42100000200000FE, 0x7C00},
-	{0xC1C0000000000080, 0xFC00},
-	{0xC7F17FFFFFFFFFFE, 0xFC00},
-	{0xC1C0000000000100, 0xFC00},
-	{0x846FFFFFFFFC0007, 0x8000},
-	{0xC1C0000000000200, 0xFC00},
-	{0xC30A84A28CABDD0A, 0xFC00},
-	{0xC1C0000000000400, 0xFC00},
-	{0xC02FFFFFFFFFC3FF, 0xCC00},
-	{0xC1C0000000000800, 0xFC00},
-	{0xC7EFFFFFFFEFFEFE, 0xFC00},
-	{0xC1C0000000001000, 0xFC00},
-	{0x3CAFFFFFFFEBFFFF, 0x0000},
-	{0xC1C0000000002000, 0xFC00},
-	{0xBFB0000FEFFFFFFF, 0xAC00},
-	{0xC1C0000000004000, 0xFC00},
-	{0x3FDFFFFFFFFFFFFE, 0x3800},
-	{0xC1C0000000008000, 0xFC00},
-	{0x434100001FFFFFFF, 0x7C00},
-	{0xC1C0000000010000, 0xFC00},
-	{0xC7F0006FFFFFFFFE, 0xFC00},
-	{0xC1C0000000020000, 0xFC00},
-	{0x40F85565BC570866, 0x7C00},
-	{0xC1C0000000040000, 0xFC00},
-	{0x3FE618C73BCA0C2C, 0x3986},
-	{0xC1C0000000080000, 0xFC00},
-	{0xBF6D246F0FC321FB, 0x9B49},
-	{0xC1C0000000100000, 0xFC00},
-	{0xC0CFFE0001000000, 0xF400},
-	{0xC1C0000000200000, 0xFC00},
-	{0x3FFBAB306BDE767A, 0x3EEB},
-	{0xC1C0000000400000, 0xFC00},
-	{0xC34E7F8B51EAC40B, 0xFC00},
-	{0xC1C0000000800000, 0xFC00},
-	{0x3FD000000200007F, 0x3400},
-	{0xC1C0000001000000, 0xFC00},
-	{0xBFCFFF7FFFFFFEFF, 0xB400},
-	{0xC1C0000002000000, 0xFC00},
-	{0xBBD0010100000000, 0x8000},
-	{0xC1C0000004000000, 0xFC00},
-	{0xCFE6393E61DC06B9, 0xFC00},
-	{0xC1C0000008000000, 0xFC00},
-	{0xC657FFFFFFFFFEFF, 0xFC00},
-	{0xC1C0000010000000, 0xFC00},
-	{0xC000003FDFFFFFFF, 0xC000},
-	{0xC1C0000020000000, 0xFC00},
-	{0x41A829D33F351305, 0x7C00},
-	{0xC1C0000040000000, 0xFC00},
-	{0xB2701000000001FE, 0x8000},
-	{0xC1C0000080000000, 0xFC00},
-	{0x38D4000000000080, 0x0000},
-	{0xC1C0000100000000, 0xFC00},
-	{0x3C7C001FFFFFFFFE, 0x0000},
-	{0xC1C0000200000000, 0xFC00},
-	{0xB80806481934C1F2, 0x8000},
-	{0xC1C0000400000000, 0xFC00},
-	{0x3E60000807FFFFFF, 0x0001},
-	{0xC1C0000800000000, 0xFC00},
-	{0xB89FFFFBFDFFFFFF, 0x8000},
-	{0xC1C0001000000000, 0xFC00},
-	{0x316000007FFDFFFE, 0x0000},
-	{0xC1C0002000000000, 0xFC00},
-	{0x40C00401FFFFFFFF, 0x7001},
-	{0xC1C0004000000000, 0xFC00},
-	{0x41CC09B074DC7214, 0x7C00},
-	{0xC1C0008000000000, 0xFC00},
-	{0x3FE8B474F0B2E4CB, 0x3A2D},
-	{0xC1C0010000000000, 0xFC00},
-	{0xC1BC000000000FFE, 0xFC00},
-	{0xC1C0020000000000, 0xFC00},
-	{0xC1FB7FE1719CDA1C, 0xFC00},
-	{0xC1C0040000000000, 0xFC00},
-	{0x381000000040FFFF, 0x0000},
-	{0xC1C0080000000000, 0xFC00},
-	{0x43FDFFFFFFFFFEFF, 0x7C00},
-	{0xC1C0100000000000, 0xFC00},
-	{0xBFC008000003FFFF, 0xB002},
-	{0xC1C0200000000000, 0xFC00},
-	{0xC3C0000001100000, 0xFC00},
-	{0xC1C0400000000000, 0xFC00},
-	{0x8010000104000000, 0x8000},
-	{0xC1C0800000000000, 0xFC00},
-	{0x3C3FFFDFFFFFFFFD, 0x0000},
-	{0xC1C1000000000000, 0xFC00},
-	{0xB8600001FFFFFEFF, 0x8000},
-	{0xC1C2000000000000, 0xFC00},
-	{0xA8BFFC000001FFFF, 0x8000},
-	{0xC1C4000000000000, 0xFC00},
-	{0x3FD75880A24A9F84, 0x35D6},
-	{0xC1C8000000000000, 0xFC00},
-	{0x401FF7FFF7FFFFFE, 0x47FE},
-	{0xC1CC000000000000, 0xFC00},
-	{0x3FD36948585A6608, 0x34DA},
-	{0xC1CE000000000000, 0xFC00},
-	{0x37FD09191ADBA594, 0x0000},
-	{0xC1CF000000000000, 0xFC00},
-	{0x4019A9256959CCF7, 0x466A},
-	{0xC1CF800000000000, 0xFC00},
-	{0x41EFFFFFFFFF07FE, 0x7C00},
-	{0xC1CFC00000000000, 0xFC00},
-	{0xBFCD7B6A49E6F050, 0xB35F},
-	{0xC1CFE00000000000, 0xFC00},
-	{0xC1C010000001FFFF, 0xFC00},
-	{0xC1CFF00000000000, 0xFC00},
-	{0x37F000400000007F, 0x0000},
-	{0xC1CFF80000000000, 0xFC00},
-	{0x002EADEDB5AC91CA, 0x0000},
-	{0xC1CFFC0000000000, 0xFC00},
-	{0x518CD5D92C39AF87, 0x7C00},
-	{0xC1CFFE0000000000, 0xFC00},
-	{0x3FB966A9CCB61965, 0x2E5A},
-	{0xC1CFFF0000000000, 0xFC00},
-	{0xC1DD72E1A064F9C8, 0xFC00},
-	{0xC1CFFF8000000000, 0xFC00},
-	{0xBCA0000020000004, 0x8000},
-	{0xC1CFFFC000000000, 0xFC00},
-	{0xC1EFFFFFFFFFE0FF, 0xFC00},
-	{0xC1CFFFE000000000, 0xFC00},
-	{0x41DFFFFF000001FE, 0x7C00},
-	{0xC1CFFFF000000000, 0xFC00},
-	{0x40600800000007FF, 0x5802},
-	{0xC1CFFFF800000000, 0xFC00},
-	{0xC3E0000000000000, 0xFC00},
-	{0xC1CFFFFC00000000, 0xFC00},
-	{0x401FFF8007FFFFFE, 0x4800},
-	{0xC1CFFFFE00000000, 0xFC00},
-	{0xBC500200000000FF, 0x8000},
-	{0xC1CFFFFF00000000, 0xFC00},
-	{0xC1C0000000000000, 0xFC00},
-	{0xC1CFFFFF80000000, 0xFC00},
-	{0xC1A0000200003FFE, 0xFC00},
-	{0xC1CFFFFFC0000000, 0xFC00},
-	{0xC00FF800000003FF, 0xC3FE},
-	{0xC1CFFFFFE0000000, 0xFC00},
-	{0x335FFBFFFBFFFFFE, 0x0000},
-	{0xC1CFFFFFF0000000, 0xFC00},
-	{0x43F0FF7FFFFFFFFE, 0x7C00},
-	{0xC1CFFFFFF8000000, 0xFC00},
-	{0x3FBFFFFFDFFFFFF8, 0x3000},
-	{0xC1CFFFFFFC000000, 0xFC00},
-	{0x800FFBDFFFFFFFFF, 0x8000},
-	{0xC1CFFFFFFE000000, 0xFC00},
-	{0x4D00000000FFFFF8, 0x7C00},
-	{0xC1CFFFFFFF000000, 0xFC00},
-	{0xB0F0800000007FFE, 0x8000},
-	{0xC1CFFFFFFF800000, 0xFC00},
-	{0x1ADB6AEA6D7859C3, 0x0000},
-	{0xC1CFFFFFFFC00000, 0xFC00},
-	{0xC08FFFFFFFDFFF7F, 0xE400},
-	{0xC1CFFFFFFFE00000, 0xFC00},
-	{0x3810000004002000, 0x0000},
-	{0xC1CFFFFFFFF00000, 0xFC00},
-	{0x400FEFFFFFFFFFFC, 0x43FC},
-	{0xC1CFFFFFFFF80000, 0xFC00},
-	{0xC09C9B63818525B1, 0xE727},
-	{0xC1CFFFFFFFFC0000, 0xFC00},
-	{0x43EFFC0020000000, 0x7C00},
-	{0xC1CFFFFFFFFE0000, 0xFC00},
-	{0xC03000003FFFFFFE, 0xCC00},
-	{0xC1CFFFFFFFFF0000, 0xFC00},
-	{0xB7FD8FAB6A64E59B, 0x8000},
-	{0xC1CFFFFFFFFF8000, 0xFC00},
-	{0x40112B2AF3AB07E1, 0x444B},
-	{0xC1CFFFFFFFFFC000, 0xFC00},
-	{0x47F1FFFFF7FFFFFF, 0x7C00},
-	{0xC1CFFFFFFFFFE000, 0xFC00},
-	{0x3FBFFFFE000001FF, 0x3000},
-	{0xC1CFFFFFFFFFF000, 0xFC00},
-	{0xC3EFFFF800100000, 0xFC00},
-	{0xC1CFFFFFFFFFF800, 0xFC00},
-	{0x3FCFFFFFFF9FFFFF, 0x3400},
-	{0xC1CFFFFFFFFFFC00, 0xFC00},
-	{0x6B09EACDA5894527, 0x7C00},
-	{0xC1CFFFFFFFFFFE00, 0xFC00},
-	{0xC1D3FFFFFFFFFDFE, 0xFC00},
-	{0xC1CFFFFFFFFFFF00, 0xFC00},
-	{0x4060FFFFDFFFFFFF, 0x5840},
-	{0xC1CFFFFFFFFFFF80, 0xFC00},
-	{0x402FFFC0000001FE, 0x4C00},
-	{0xC1CFFFFFFFFFFFC0, 0xFC00},
-	{0x362FFFE00007FFFE, 0x0000},
-	{0xC1CFFFFFFFFFFFE0, 0xFC00},
-	{0xC00DD8C18C74FC1A, 0xC376},
-	{0xC1CFFFFFFFFFFFF0, 0xFC00},
-	{0x43F0000020003FFF, 0x7C00},
-	{0xC1CFFFFFFFFFFFF8, 0xFC00},
-	{0x47E6A64C743DD34A, 0x7C00},
-	{0xC1CFFFFFFFFFFFFC, 0xFC00},
-	{0xC00FFF8000200000, 0xC400},
-	{0xC1CFFFFFFFFFFFFE, 0xFC00},
-	{0x43A00000000007FF, 0x7C00},
-	{0xC1CFFFFFFFFFFFFF, 0xFC00},
-	{0x296000000000FFFE, 0x0000},
-	{0xC1CFFFFFFFFFFFFD, 0xFC00},
-	{0x3810001FFFFFF000, 0x0000},
-	{0xC1CFFFFFFFFFFFFB, 0xFC00},
-	{0x3FB0000000001FF6, 0x2C00},
-	{0xC1CFFFFFFFFFFFF7, 0xFC00},
-	{0xC7EF0CAE51CAE2DE, 0xFC00},
-	{0xC1CFFFFFFFFFFFEF, 0xFC00},
-	{0x7FF1FF0D495D3C0E, 0x7E7F},
-	{0xC1CFFFFFFFFFFFDF, 0xFC00},
-	{0xBFD000000FEFFFFF, 0xB400},
-	{0xC1CFFFFFFFFFFFBF, 0xFC00},
-	{0x47F3B7366BA09746, 0x7C00},
-	{0xC1CFFFFFFFFFFF7F, 0xFC00},
-	{0x999FBFFFFFFFBFFF, 0x8000},
-	{0xC1CFFFFFFFFFFEFF, 0xFC00},
-	{0x475FFFFFFF9FFFFF, 0x7C00},
-	{0xC1CFFFFFFFFFFDFF, 0xFC00},
-	{0xC14AE779F24D8A57, 0xFC00},
-	{0xC1CFFFFFFFFFFBFF, 0xFC00},
-	{0xC1E0100000000002, 0xFC00},
-	{0xC1CFFFFFFFFFF7FF, 0xFC00},
-	{0xC3E0000000807FFF, 0xFC00},
-	{0xC1CFFFFFFFFFEFFF, 0xFC00},
-	{0xC3D8B559DCBBB665, 0xFC00},
-	{0xC1CFFFFFFFFFDFFF, 0xFC00},
-	{0x3E904B13AC6F8EF0, 0x0004},
-	{0xC1CFFFFFFFFFBFFF, 0xFC00},
-	{0xC1D007FFFFBFFFFF, 0xFC00},
-	{0xC1CFFFFFFFFF7FFF, 0xFC00},
-	{0xC7F007FFFFFFE000, 0xFC00},
-	{0xC1CFFFFFFFFEFFFF, 0xFC00},
-	{0x40000000002FFFFF, 0x4000},
-	{0xC1CFFFFFFFFDFFFF, 0xFC00},
-	{0x3C97FFFFFFBFFFFF, 0x0000},
-	{0xC1CFFFFFFFFBFFFF, 0xFC00},
-	{0x9C32059F4B5A9BFE, 0x8000},
-	{0xC1CFFFFFFFF7FFFF, 0xFC00},
-	{0xC1E000000003F800, 0xFC00},
-	{0xC1CFFFFFFFEFFFFF, 0xFC00},
-	{0x8000003FFFFFBFFF, 0x8000},
-	{0xC1CFFFFFFFDFFFFF, 0xFC00},
-	{0x5EDF7FFFFFFFE000, 0x7C00},
-	{0xC1CFFFFFFFBFFFFF, 0xFC00},
-	{0x480FFFDFFFFFFFFA, 0x7C00},
-	{0xC1CFFFFFFF7FFFFF, 0xFC00},
-	{0x3E0FFFFFFBFFFFF8, 0x0000},
-	{0xC1CFFFFFFEFFFFFF, 0xFC00},
-	{0x3E90800000FFFFFF, 0x0004},
-	{0xC1CFFFFFFDFFFFFF, 0xFC00},
-	{0xBF7010003FFFFFFF, 0x9C04},
-	{0xC1CFFFFFFBFFFFFF, 0xFC00},
-	{0xC00FFFFFFDFDFFFF, 0xC400},
-	{0xC1CFFFFFF7FFFFFF, 0xFC00},
-	{0x192038670BAE80DE, 0x0000},
-	{0xC1CFFFFFEFFFFFFF, 0xFC00},
-	{0xC3F7F7FFFFFFFFFF, 0xFC00},
-	{0xC1CFFFFFDFFFFFFF, 0xFC00},
-	{0x7FF3FFFFF0000000, 0x7EFF},
-	{0xC1CFFFFFBFFFFFFF, 0xFC00},
-	{0x4024AE9691B55A68, 0x492C},
-	{0xC1CFFFFF7FFFFFFF, 0xFC00},
-	{0xC00002003FFFFFFF, 0xC001},
-	{0xC1CFFFFEFFFFFFFF, 0xFC00},
-	{0xBFBFFFFBFFFFFFFF, 0xB000},
-	{0xC1CFFFFDFFFFFFFF, 0xFC00},
-	{0x3CAA5CE614E5F8ED, 0x0000},
-	{0xC1CFFFFBFFFFFFFF, 0xFC00},
-	{0x401FFE07FFFFFFFF, 0x4800},
-	{0xC1CFFFF7FFFFFFFF, 0xFC00},
-	{0x4008206E2A550C28, 0x4208},
-	{0xC1CFFFEFFFFFFFFF, 0xFC00},
-	{0xBC500FFFFFFFFBFF, 0x8000},
-	{0xC1CFFFDFFFFFFFFF, 0xFC00},
-	{0xBFDBFFFFFFFE0000, 0xB700},
-	{0xC1CFFFBFFFFFFFFF, 0xFC00},
-	{0x3D9FFFFFF0200000, 0x0000},
-	{0xC1CFFF7FFFFFFFFF, 0xFC00},
-	{0x3EA00000000023FF, 0x0008},
-	{0xC1CFFEFFFFFFFFFF, 0xFC00},
-	{0x466000800000FFFE, 0x7C00},
-	{0xC1CFFDFFFFFFFFFF, 0xFC00},
-	{0xC00FFFFFFFFFFFEC, 0xC400},
-	{0xC1CFFBFFFFFFFFFF, 0xFC00},
-	{0x47FF003FFFFFFFFF, 0x7C00},
-	{0xC1CFF7FFFFFFFFFF, 0xFC00},
-	{0x47F416F7F4299BDE, 0x7C00},
-	{0xC1CFEFFFFFFFFFFF, 0xFC00},
-	{0xC03F800000000FFF, 0xCFE0},
-	{0xC1CFDFFFFFFFFFFF, 0xFC00},
-	{0xC1FFFFFFBDFFFFFF, 0xFC00},
-	{0xC1CFBFFFFFFFFFFF, 0xFC00},
-	{0xC1FDFFFFFFFFFBFF, 0xFC00},
-	{0xC1CF7FFFFFFFFFFF, 0xFC00},
-	{0x4167AF5A0D706A71, 0x7C00},
-	{0xC1CEFFFFFFFFFFFF, 0xFC00},
-	{0x3F2FFFBFFFFFFFFD, 0x0C00},
-	{0xC1CDFFFFFFFFFFFF, 0xFC00},
-	{0xB010004007FFFFFF, 0x8000},
-	{0xC1CBFFFFFFFFFFFF, 0xFC00},
-	{0x401BFFFF7FFFFFFE, 0x4700},
-	{0xC1C7FFFFFFFFFFFF, 0xFC00},
-	{0x41C0040000000002, 0x7C00},
-	{0xC1C3FFFFFFFFFFFF, 0xFC00},
-	{0xC3E0003FFFDFFFFF, 0xFC00},
-	{0xC1C1FFFFFFFFFFFF, 0xFC00},
-	{0x3F70000000100000, 0x1C00},
-	{0xC1C0FFFFFFFFFFFF, 0xFC00},
-	{0x6B9FFFFFFF000000, 0x7C00},
-	{0xC1C07FFFFFFFFFFF, 0xFC00},
-	{0xC1FF000000000100, 0xFC00},
-	{0xC1C03FFFFFFFFFFF, 0xFC00},
-	{0x43D9BA2947AC4100, 0x7C00},
-	{0xC1C01FFFFFFFFFFF, 0xFC00},
-	{0x43F51AC663660571, 0x7C00},
-	{0xC1C00FFFFFFFFFFF, 0xFC00},
-	{0xC0000000040000FF, 0xC000},
-	{0xC1C007FFFFFFFFFF, 0xFC00},
-	{0xBFDFFFFFFFFFBF7F, 0xB800},
-	{0xC1C003FFFFFFFFFF, 0xFC00},
-	{0x43CF1D9503C0ABAE, 0x7C00},
-	{0xC1C001FFFFFFFFFF, 0xFC00},
-	{0xB81FFFFFFFDFBFFF, 0x8000},
-	{0xC1C000FFFFFFFFFF, 0xFC00},
-	{0xB7E4B5B35A85CC67, 0x8000},
-	{0xC1C0007FFFFFFFFF, 0xFC00},
-	{0x4340000000002002, 0x7C00},
-	{0xC1C0003FFFFFFFFF, 0xFC00},
-	{0xC030020007FFFFFF, 0xCC01},
-	{0xC1C0001FFFFFFFFF, 0xFC00},
-	{0xC0821482D8F033CA, 0xE085},
-	{0xC1C0000FFFFFFFFF, 0xFC00},
-	{0xD99FF0000000001F, 0xFC00},
-	{0xC1C00007FFFFFFFF, 0xFC00},
-	{0x7FD0000002001FFE, 0x7C00},
-	{0xC1C00003FFFFFFFF, 0xFC00},
-	{0xBA5FE0000000003E, 0x8000},
-	{0xC1C00001FFFFFFFF, 0xFC00},
-	{0xC10FFFFFFFFFFFF2, 0xFC00},
-	{0xC1C00000FFFFFFFF, 0xFC00},
-	{0x7FDC0000000001FF, 0x7C00},
-	{0xC1C000007FFFFFFF, 0xFC00},
-	{0xC03BC61CF960462F, 0xCEF2},
-	{0xC1C000003FFFFFFF, 0xFC00},
-	{0x43D07FFFFFFFFDFF, 0x7C00},
-	{0xC1C000001FFFFFFF, 0xFC00},
-	{0xC710040007FFFFFF, 0xFC00},
-	{0xC1C000000FFFFFFF, 0xFC00},
-	{0x7FD100000003FFFE, 0x7C00},
-	{0xC1C0000007FFFFFF, 0xFC00},
-	{0xF08FDFFFFFFFFFFD, 0xFC00},
-	{0xC1C0000003FFFFFF, 0xFC00},
-	{0xC7E4370518464F2B, 0xFC00},
-	{0xC1C0000001FFFFFF, 0xFC00},
-	{0xB7F41996DFE5B9E7, 0x8000},
-	{0xC1C0000000FFFFFF, 0xFC00},
-	{0xB7EFBFFFFFFDFFFF, 0x8000},
-	{0xC1C00000007FFFFF, 0xFC00},
-	{0xBFFFFFFFFF007FFF, 0xC000},
-	{0xC1C00000003FFFFF, 0xFC00},
-	{0x3CAFFFFFFF7FFFBF, 0x0000},
-	{0xC1C00000001FFFFF, 0xFC00},
-	{0x3FCFFFFFE0010000, 0x3400},
-	{0xC1C00000000FFFFF, 0xFC00},
-	{0x7FFF592380644577, 0x7FD6},
-	{0xC1C000000007FFFF, 0xFC00},
-	{0xBC9D008D51FDAB54, 0x8000},
-	{0xC1C000000003FFFF, 0xFC00},
-	{0x4B071AD10C3A092C, 0x7C00},
-	{0xC1C000000001FFFF, 0xFC00},
-	{0x800FFFFFFFFFFFFF, 0x8000},
-	{0xC1C000000000FFFF, 0xFC00},
-	{0xA31FFFFFFFFFE07F, 0x8000},
-	{0xC1C0000000007FFF, 0xFC00},
-	{0x41F0000FFFEFFFFF, 0x7C00},
-	{0xC1C0000000003FFF, 0xFC00},
-	{0xBFBFFFF000200000, 0xB000},
-	{0xC1C0000000001FFF, 0xFC00},
-	{0x55AFFFBFDFFFFFFF, 0x7C00},
-	{0xC1C0000000000FFF, 0xFC00},
-	{0x801FFFFFEFBFFFFF, 0x8000},
-	{0xC1C00000000007FF, 0xFC00},
-	{0xBFBEFFFFFFFC0000, 0xAFC0},
-	{0xC1C00000000003FF, 0xFC00},
-	{0x90800047FFFFFFFE, 0x8000},
-	{0xC1C00000000001FF, 0xFC00},
-	{0xC01FFDFFFFFFFBFF, 0xC7FF},
-	{0xC1C00000000000FF, 0xFC00},
-	{0x41F742D1DA7613F6, 0x7C00},
-	{0xC1C000000000007F, 0xFC00},
-	{0x3F8FFFFFBFFEFFFF, 0x2400},
-	{0xC1C000000000003F, 0xFC00},
-	{0xC92FFFFFF80003FF, 0xFC00},
-	{0xC1C000000000001F, 0xFC00},
-	{0xBCF7169E08D2C242, 0x8000},
-	{0xC1C000000000000F, 0xFC00},
-	{0x47EFFFFFFFF7FFEF, 0x7C00},
-	{0xC1C0000000000007, 0xFC00},
-	{0xBFC000000000100F, 0xB000},
-	{0xC1C0000000000003, 0xFC00},
-	{0x3FC027FFFFFFFFFE, 0x300A},
-	{0xC1D0000000000000, 0xFC00},
-	{0xBFA07FFFFFFFF7FE, 0xA820},
-	{0xC1D0000000000001, 0xFC00},
-	{0x41D1000000000000, 0x7C00},
-	{0xC1D0000000000002, 0xFC00},
-	{0x41EFFFFFFFFFF880, 0x7C00},
-	{0xC1D0000000000004, 0xFC00},
-	{0x3048AFCD6508014A, 0x0000},
-	{0xC1D0000000000008, 0xFC00},
-	{0x3F700FFFFFFFFF7F, 0x1C04},
-	{0xC1D0000000000010, 0xFC00},
-	{0x7FDFEFFFFFFFFFFB, 0x7C00},
-	{0xC1D0000000000020, 0xFC00},
-	{0xBFC563221E48C38E, 0xB159},
-	{0xC1D0000000000040, 0xFC00},
-	{0x3CAB89C795097C65, 0x0000},
-	{0xC1D0000000000080, 0xFC00},
-	{0xB7E4000000FFFFFE, 0x8000},
-	{0xC1D0000000000100, 0xFC00},
-	{0xC0200007FFFFFFFF, 0xC800},
-	{0xC1D0000000000200, 0xFC00},
-	{0x406C237977543189, 0x5B09},
-	{0xC1D0000000000400, 0xFC00},
-	{0x3CAFFFFFFFFFFF01, 0x0000},
-	{0xC1D0000000000800, 0xFC00},
-	{0x400FFFFFFFFFFF86, 0x4400},
-	{0xC1D0000000001000, 0xFC00},
-	{0x410A0F49FB49A852, 0x7C00},
-	{0xC1D0000000002000, 0xFC00},
-	{0xBE1FFFFFFFFFFFFF, 0x8000},
-	{0xC1D0000000004000, 0xFC00},
-	{0x80208000000001FF, 0x8000},
-	{0xC1D0000000008000, 0xFC00},
-	{0x43C31BB635660939, 0x7C00},
-	{0xC1D0000000010000, 0xFC00},
-	{0xB7E00000000000EF, 0x8000},
-	{0xC1D0000000020000, 0xFC00},
-	{0x3FBFFFFBFFFFFFFE, 0x3000},
-	{0xC1D0000000040000, 0xFC00},
-	{0x3E6000800000003E, 0x0001},
-	{0xC1D0000000080000, 0xFC00},
-	{0xC7E00FFFFFFFFFFE, 0xFC00},
-	{0xC1D0000000100000, 0xFC00},
-	{0xC030000FFFFFFBFF, 0xCC00},
-	{0xC1D0000000200000, 0xFC00},
-	{0x402FFFFF0001FFFF, 0x4C00},
-	{0xC1D0000000400000, 0xFC00},
-	{0x41E0000000030000, 0x7C00},
-	{0xC1D0000000800000, 0xFC00},
-	{0x43F000000FFFFFEE, 0x7C00},
-	{0xC1D0000001000000, 0xFC00},
-	{0xB27F8BF1FC7A329C, 0x8000},
-	{0xC1D0000002000000, 0xFC00},
-	{0xAAD7150A868D1BFE, 0x8000},
-	{0xC1D0000004000000, 0xFC00},
-	{0x3FDFFFFFFBFFFFEF, 0x3800},
-	{0xC1D0000008000000, 0xFC00},
-	{0x3FFC3FFFFFFFFFFF, 0x3F10},
-	{0xC1D0000010000000, 0xFC00},
-	{0x41C0FFFFFFFFFFFE, 0x7C00},
-	{0xC1D0000020000000, 0xFC00},
-	{0x3FCFFBFFFFFFFFFB, 0x33FF},
-	{0xC1D0000040000000, 0xFC00},
-	{0x3530040000007FFE, 0x0000},
-	{0xC1D0000080000000, 0xFC00},
-	{0x96E000000000FFFF, 0x8000},
-	{0xC1D0000100000000, 0xFC00},
-	{0xC000000000000038, 0xC000},
-	{0xC1D0000200000000, 0xFC00},
-	{0xC0800000100FFFFF, 0xE000},
-	{0xC1D0000400000000, 0xFC00},
-	{0xBFE0000FDFFFFFFE, 0xB800},
-	{0xC1D0000800000000, 0xFC00},
-	{0x40392F426257CB51, 0x4E4C},
-	{0xC1D0001000000000, 0xFC00},
-	{0x380BFFFFFF000000, 0x0000},
-	{0xC1D0002000000000, 0xFC00},
-	{0xC1FE6BBADB8CCF25, 0xFC00},
-	{0xC1D0004000000000, 0xFC00},
-	{0xCABFFFFFFDC00000, 0xFC00},
-	{0xC1D0008000000000, 0xFC00},
-	{0xBFB00000000077FF, 0xAC00},
-	{0xC1D0010000000000, 0xFC00},
-	{0x3E495224EFA7D5C3, 0x0000},
-	{0xC1D0020000000000, 0xFC00},
-	{0xC1C000000200003F, 0xFC00},
-	{0xC1D0040000000000, 0xFC00},
-	{0x3AE000000801FFFF, 0x0000},
-	{0xC1D0080000000000, 0xFC00},
-	{0x47F000000007FFFC, 0x7C00},
-	{0xC1D0100000000000, 0xFC00},
-	{0x3BA5095716762CD9, 0x0000},
-	{0xC1D0200000000000, 0xFC00},
-	{0xC022000FFFFFFFFF, 0xC880},
-	{0xC1D0400000000000, 0xFC00},
-	{0x000D3249BB317908, 0x0000},
-	{0xC1D0800000000000, 0xFC00},
-	{0xC03F8FFFFFFFFFFF, 0xCFE4},
-	{0xC1D1000000000000, 0xFC00},
-	{0xC380001FBFFFFFFF, 0xFC00},
-	{0xC1D2000000000000, 0xFC00},
-	{0xFFEBFFFFBFFFFFFE, 0xFC00},
-	{0xC1D4000000000000, 0xFC00},
-	{0xC1DFF7FFFFFBFFFF, 0xFC00},
-	{0xC1D8000000000000, 0xFC00},
-	{0x7FDBCA856F5A7B5B, 0x7C00},
-	{0xC1DC000000000000, 0xFC00},
-	{0x406C0003FFFFFFFE, 0x5B00},
-	{0xC1DE000000000000, 0xFC00},
-	{0x385FDF7FFFFFFFFF, 0x0000},
-	{0xC1DF000000000000, 0xFC00},
-	{0xB80001000001FFFF, 0x8000},
-	{0xC1DF800000000000, 0xFC00},
-	{0xBFC8000000000FFF, 0xB200},
-	{0xC1DFC00000000000, 0xFC00},
-	{0x43EFBFFFF0000000, 0x7C00},
-	{0xC1DFE00000000000, 0xFC00},
-	{0x3F8FFFFFF8200000, 0x2400},
-	{0xC1DFF00000000000, 0xFC00},
-	{0xBFF001FFFFC00000, 0xBC00},
-	{0xC1DFF80000000000, 0xFC00},
-	{0xB7FFFFE020000000, 0x8000},
-	{0xC1DFFC0000000000, 0xFC00},
-	{0xBC00FD9307186F09, 0x8000},
-	{0xC1DFFE0000000000, 0xFC00},
-	{0xBFB3FFC000000000, 0xAD00},
-	{0xC1DFFF0000000000, 0xFC00},
-	{0x62C8A7E66CE603A6, 0x7C00},
-	{0xC1DFFF8000000000, 0xFC00},
-	{0x3800800003FFFFFF, 0x0000},
-	{0xC1DFFFC000000000, 0xFC00},
-	{0xD0C000000FFFFFEF, 0xFC00},
-	{0xC1DFFFE000000000, 0xFC00},
-	{0x3FF270540EB09A7B, 0x3C9C},
-	{0xC1DFFFF000000000, 0xFC00},
-	{0x3E27FFFFFFFFFFFF, 0x0000},
-	{0xC1DFFFF800000000, 0xFC00},
-	{0x46CFF0000000007F, 0x7C00},
-	{0xC1DFFFFC00000000, 0xFC00},
-	{0xC34FEFFFFFFFFDFE, 0xFC00},
-	{0xC1DFFFFE00000000, 0xFC00},
-	{0xC3F1E460C394F7DC, 0xFC00},
-	{0xC1DFFFFF00000000, 0xFC00},
-	{0x434FFFFFFBFFC000, 0x7C00},
-	{0xC1DFFFFF80000000, 0xFC00},
-	{0xBFE029D0178B90BC, 0xB80A},
-	{0xC1DFFFFFC0000000, 0xFC00},
-	{0xB5A0040000800000, 0x8000},
-	{0xC1DFFFFFE0000000, 0xFC00},
-	{0xBEFFDFFFFFFFFFF6, 0x81FE},
-	{0xC1DFFFFFF0000000, 0xFC00},
-	{0x413002000000FFFF, 0x7C00},
-	{0xC1DFFFFFF8000000, 0xFC00},
-	{0x3FC0FFFFFFFFFFEF, 0x3040},
-	{0xC1DFFFFFFC000000, 0xFC00},
-	{0x7FE000FFFFF7FFFF, 0x7C00},
-	{0xC1DFFFFFFE000000, 0xFC00},
-	{0x4A04C48D41580F9C, 0x7C00},
-	{0xC1DFFFFFFF000000, 0xFC00},
-	{0xC1F0000000002FFF, 0xFC00},
-	{0xC1DFFFFFFF800000, 0xFC00},
-	{0xC030000800040000, 0xCC00},
-	{0xC1DFFFFFFFC00000, 0xFC00},
-	{0xBFCF818DAB2314A8, 0xB3E0},
-	{0xC1DFFFFFFFE00000, 0xFC00},
-	{0xC3C903C78CF5E921, 0xFC00},
-	{0xC1DFFFFFFFF00000, 0xFC00},
-	{0xC08CCCAD6F08CCB9, 0xE333},
-	{0xC1DFFFFFFFF80000, 0xFC00},
-	{0x3F9E000007FFFFFF, 0x2780},
-	{0xC1DFFFFFFFFC0000, 0xFC00},
-	{0x434FFFFFFFEFF7FF, 0x7C00},
-	{0xC1DFFFFFFFFE0000, 0xFC00},
-	{0xC3D7968553025471, 0xFC00},
-	{0xC1DFFFFFFFFF0000, 0xFC00},
-	{0xC3E0000000041000, 0xFC00},
-	{0xC1DFFFFFFFFF8000, 0xFC00},
-	{0x8CD000FFFF7FFFFE, 0x8000},
-	{0xC1DFFFFFFFFFC000, 0xFC00},
-	{0x47EDA9BF49685EF0, 0x7C00},
-	{0xC1DFFFFFFFFFE000, 0xFC00},
-	{0x802FFFFFFFFFFFE2, 0x8000},
-	{0xC1DFFFFFFFFFF000, 0xFC00},
-	{0xCA505B4E5AF3FE0D, 0xFC00},
-	{0xC1DFFFFFFFFFF800, 0xFC00},
-	{0x3E70000000001DFF, 0x0001},
-	{0xC1DFFFFFFFFFFC00, 0xFC00},
-	{0x43FFE000FFFFFFFF, 0x7C00},
-	{0xC1DFFFFFFFFFFE00, 0xFC00},
-	{0x41EFFFFE3FFFFFFF, 0x7C00},
-	{0xC1DFFFFFFFFFFF00, 0xFC00},
-	{0x800FFFFFFFFF7FFB, 0x8000},
-	{0xC1DFFFFFFFFFFF80, 0xFC00},
-	{0x418011318E036A26, 0x7C00},
-	{0xC1DFFFFFFFFFFFC0, 0xFC00},
-	{0x8013B30C6BBC5536, 0x8000},
-	{0xC1DFFFFFFFFFFFE0, 0xFC00},
-	{0x473429703740F120, 0x7C00},
-	{0xC1DFFFFFFFFFFFF0, 0xFC00},
-	{0x3FFFAA833D83FA6F, 0x3FEB},
-	{0xC1DFFFFFFFFFFFF8, 0xFC00},
-	{0xBD7040000007FFFE, 0x8000},
-	{0xC1DFFFFFFFFFFFFC, 0xFC00},
-	{0x3CA0004000000007, 0x0000},
-	{0xC1DFFFFFFFFFFFFE, 0xFC00},
-	{0x42C02000000001FE, 0x7C00},
-	{0xC1DFFFFFFFFFFFFF, 0xFC00},
-	{0x401F793F0D3EA843, 0x47DE},
-	{0xC1DFFFFFFFFFFFFD, 0xFC00},
-	{0xBFC00800000001FF, 0xB002},
-	{0xC1DFFFFFFFFFFFFB, 0xFC00},
-	{0xBBBF8000007FFFFF, 0x8000},
-	{0xC1DFFFFFFFFFFFF7, 0xFC00},
-	{0x0415F6319A01A77B, 0x0000},
-	{0xC1DFFFFFFFFFFFEF, 0xFC00},
-	{0x464F400000000000, 0x7C00},
-	{0xC1DFFFFFFFFFFFDF, 0xFC00},
-	{0x0B6FFF7FFFFF8000, 0x0000},
-	{0xC1DFFFFFFFFFFFBF, 0xFC00},
-	{0x800001FDFFFFFFFF, 0x8000},
-	{0xC1DFFFFFFFFFFF7F, 0xFC00},
-	{0x308FFFFFEFFFFF00, 0x0000},
-	{0xC1DFFFFFFFFFFEFF, 0xFC00},
-	{0xB81FFFFFFFC10000, 0x8000},
-	{0xC1DFFFFFFFFFFDFF, 0xFC00},
-	{0xC3F11F84A1105553, 0xFC00},
-	{0xC1DFFFFFFFFFFBFF, 0xFC00},
-	{0x3FD666245A248993, 0x359A},
-	{0xC1DFFFFFFFFFF7FF, 0xFC00},
-	{0x3FEFF7FFFFFF7FFF, 0x3BFE},
-	{0xC1DFFFFFFFFFEFFF, 0xFC00},
-	{0xB05FFFFE1FFFFFFE, 0x8000},
-	{0xC1DFFFFFFFFFDFFF, 0xFC00},
-	{0xC3C49782B0577B3A, 0xFC00},
-	{0xC1DFFFFFFFFFBFFF, 0xFC00},
-	{0xB9300003FFFFFEFE, 0x8000},
-	{0xC1DFFFFFFFFF7FFF, 0xFC00},
-	{0x3FEFFFFBFFFFFFFD, 0x3C00},
-	{0xC1DFFFFFFFFEFFFF, 0xFC00},
-	{0x3E563C21C97D77BF, 0x0000},
-	{0xC1DFFFFFFFFDFFFF, 0xFC00},
-	{0xC01000000007FFEF, 0xC400},
-	{0xC1DFFFFFFFFBFFFF, 0xFC00},
-	{0xC0DFFFFFFFFFFFF9, 0xF800},
-	{0xC1DFFFFFFFF7FFFF, 0xFC00},
-	{0xBE4FFFBF7FFFFFFF, 0x8000},
-	{0xC1DFFFFFFFEFFFFF, 0xFC00},
-	{0xC0B0000003FFFFFF, 0xEC00},
-	{0xC1DFFFFFFFDFFFFF, 0xFC00},
-	{0x3EE0000800000000, 0x0080},
-	{0xC1DFFFFFFFBFFFFF, 0xFC00},
-	{0x3F700000040007FF, 0x1C00},
-	{0xC1DFFFFFFF7FFFFF, 0xFC00},
-	{0xC1109F1574512B39, 0xFC00},
-	{0xC1DFFFFFFEFFFFFF, 0xFC00},
-	{0xBFFFF80000000000, 0xBFFE},
-	{0xC1DFFFFFFDFFFFFF, 0xFC00},
-	{0xBFEFFFDEFFFFFFFF, 0xBC00},
-	{0xC1DFFFFFFBFFFFFF, 0xFC00},
-	{0x0AAF801FFFFFFFFF, 0x0000},
-	{0xC1DFFFFFF7FFFFFF, 0xFC00},
-	{0x4D570BBCC519E1D2, 0x7C00},
-	{0xC1DFFFFFEFFFFFFF, 0xFC00},
-	{0x002FFFFFFFF7FF80, 0x0000},
-	{0xC1DFFFFFDFFFFFFF, 0xFC00},
-	{0xB7EFFFFFFFFDFFFE, 0x8000},
-	{0xC1DFFFFFBFFFFFFF, 0xFC00},
-	{0xBFDCB58DAC72C872, 0xB72D},
-	{0xC1DFFFFF7FFFFFFF, 0xFC00},
-	{0x564FFFEFEFFFFFFF, 0x7C00},
-	{0xC1DFFFFEFFFFFFFF, 0xFC00},
-	{0xB81FFFFFFFFDF7FE, 0x8000},
-	{0xC1DFFFFDFFFFFFFF, 0xFC00},
-	{0xBFCFFDFFFFFFFDFE, 0xB3FF},
-	{0xC1DFFFFBFFFFFFFF, 0xFC00},
-	{0x41F6C0AFFF9D250D, 0x7C00},
-	{0xC1DFFFF7FFFFFFFF, 0xFC00},
-	{0x3FBC000000000002, 0x2F00},
-	{0xC1DFFFEFFFFFFFFF, 0xFC00},
-	{0x43C0000102000000, 0x7C00},
-	{0xC1DFFFDFFFFFFFFF, 0xFC00},
-	{0x41D00400001FFFFE, 0x7C00},
-	{0xC1DFFFBFFFFFFFFF, 0xFC00},
-	{0x7FDFFFFFFFFBBFFE, 0x7C00},
-	{0xC1DFFF7FFFFFFFFF, 0xFC00},
-	{0xC765EBDF76E44B35, 0xFC00},
-	{0xC1DFFEFFFFFFFFFF, 0xFC00},
-	{0xBCAFFFFFFDFFFFBF, 0x8000},
-	{0xC1DFFDFFFFFFFFFF, 0xFC00},
-	{0xC7E4C55213E68FAD, 0xFC00},
-	{0xC1DFFBFFFFFFFFFF, 0xFC00},
-	{0x000000001FBFFFFE, 0x0000},
-	{0xC1DFF7FFFFFFFFFF, 0xFC00},
-	{0x802EFF0000000000, 0x8000},
-	{0xC1DFEFFFFFFFFFFF, 0xFC00},
-	{0xC02C000000000010, 0xCB00},
-	{0xC1DFDFFFFFFFFFFF, 0xFC00},
-	{0xC009A3F3BE4889AA, 0xC269},
-	{0xC1DFBFFFFFFFFFFF, 0xFC00},
-	{0xBFE000000007FFFF, 0xB800},
-	{0xC1DF7FFFFFFFFFFF, 0xFC00},
-	{0x90200000000000FD, 0x8000},
-	{0xC1DEFFFFFFFFFFFF, 0xFC00},
-	{0xC7FEB926E080E225, 0xFC00},
-	{0xC1DDFFFFFFFFFFFF, 0xFC00},
-	{0xBFFDBFFFFFFFFFFF, 0xBF70},
-	{0xC1DBFFFFFFFFFFFF, 0xFC00},
-	{0xBFE0FFFFBFFFFFFF, 0xB840},
-	{0xC1D7FFFFFFFFFFFF, 0xFC00},
-	{0x3FE24ACE2AFA99A1, 0x3893},
-	{0xC1D3FFFFFFFFFFFF, 0xFC00},
-	{0x3FD00001FFFFFFBE, 0x3400},
-	{0xC1D1FFFFFFFFFFFF, 0xFC00},
-	{0xFFFFFFFFF7FFFFF8, 0xFFFF},
-	{0xC1D0FFFFFFFFFFFF, 0xFC00},
-	{0x42EFFD0000000000, 0x7C00},
-	{0xC1D07FFFFFFFFFFF, 0xFC00},
-	{0xBCAFFC0000000000, 0x8000},
-	{0xC1D03FFFFFFFFFFF, 0xFC00},
-	{0xC068F82DCBA10B0D, 0xDA3E},
-	{0xC1D01FFFFFFFFFFF, 0xFC00},
-	{0xB661842678F5FD2C, 0x8000},
-	{0xC1D00FFFFFFFFFFF, 0xFC00},
-	{0xC06FDFFF7FFFFFFF, 0xDBF8},
-	{0xC1D007FFFFFFFFFF, 0xFC00},
-	{0x0C9BAEC4CDC50501, 0x0000},
-	{0xC1D003FFFFFFFFFF, 0xFC00},
-	{0xBA7F1FFB0B68DA80, 0x8000},
-	{0xC1D001FFFFFFFFFF, 0xFC00},
-	{0x4C11AE72EDB1694A, 0x7C00},
-	{0xC1D000FFFFFFFFFF, 0xFC00},
-	{0xBCFFBFC000000000, 0x8000},
-	{0xC1D0007FFFFFFFFF, 0xFC00},
-	{0x41301FFFFFFFFBFE, 0x7C00},
-	{0xC1D0003FFFFFFFFF, 0xFC00},
-	{0x47EA9C3F5EB4E272, 0x7C00},
-	{0xC1D0001FFFFFFFFF, 0xFC00},
-	{0xC7E0401000000000, 0xFC00},
-	{0xC1D0000FFFFFFFFF, 0xFC00},
-	{0xBCA000FFFFFFEFFF, 0x8000},
-	{0xC1D00007FFFFFFFF, 0xFC00},
-	{0xC01FFFFFF9FFFFFE, 0xC800},
-	{0xC1D00003FFFFFFFF, 0xFC00},
-	{0xBFDFFFFFFFFEFFFB, 0xB800},
-	{0xC1D00001FFFFFFFF, 0xFC00},
-	{0x3E7000000800000E, 0x0001},
-	{0xC1D00000FFFFFFFF, 0xFC00},
-	{0xBC400000007FBFFE, 0x8000},
-	{0xC1D000007FFFFFFF, 0xFC00},
-	{0xBCA000000001003E, 0x8000},
-	{0xC1D000003FFFFFFF, 0xFC00},
-	{0x3A300FFEFFFFFFFF, 0x0000},
-	{0xC1D000001FFFFFFF, 0xFC00},
-	{0xBFCA121671BC857F, 0xB285},
-	{0xC1D000000FFFFFFF, 0xFC00},
-	{0xC00FE00000000000, 0xC3F8},
-	{0xC1D0000007FFFFFF, 0xFC00},
-	{0xC000000000007FF0, 0xC000},
-	{0xC1D0000003FFFFFF, 0xFC00},
-	{0xF410000000200000, 0xFC00},
-	{0xC1D0000001FFFFFF, 0xFC00},
-	{0xC03BFFFFFFFFFFFF, 0xCF00},
-	{0xC1D0000000FFFFFF, 0xFC00},
-	{0x40001F8000000000, 0x4008},
-	{0xC1D00000007FFFFF, 0xFC00},
-	{0x4C40000003FFFEFE, 0x7C00},
-	{0xC1D00000003FFFFF, 0xFC00},
-	{0x4011FFFFFFFBFFFE, 0x4480},
-	{0xC1D00000001FFFFF, 0xFC00},
-	{0xB81FFFDFFFEFFFFF, 0x8000},
-	{0xC1D00000000FFFFF, 0xFC00},
-	{0xB450000400000010, 0x8000},
-	{0xC1D000000007FFFF, 0xFC00},
-	{0xC01FFFFFFF010000, 0xC800},
-	{0xC1D000000003FFFF, 0xFC00},
-	{0xBFD000000002003E, 0xB400},
-	{0xC1D000000001FFFF, 0xFC00},
-	{0x3D66ECB645B22C20, 0x0000},
-	{0xC1D000000000FFFF, 0xFC00},
-	{0x38C000000004000F, 0x0000},
-	{0xC1D0000000007FFF, 0xFC00},
-	{0xC090021FFFFFFFFF, 0xE401},
-	{0xC1D0000000003FFF, 0xFC00},
-	{0x4025C49F9E5AF7AC, 0x4971},
-	{0xC1D0000000001FFF, 0xFC00},
-	{0x3FD00010000003FE, 0x3400},
-	{0xC1D0000000000FFF, 0xFC00},
-	{0xBF00100000000100, 0x8202},
-	{0xC1D00000000007FF, 0xFC00},
-	{0x401FDFF7FFFFFFFE, 0x47F8},
-	{0xC1D00000000003FF, 0xFC00},
-	{0xC3C000FFFFFFF7FE, 0xFC00},
-	{0xC1D00000000001FF, 0xFC00},
-	{0xBFB0000801FFFFFF, 0xAC00},
-	{0xC1D00000000000FF, 0xFC00},
-	{0xBF300020001FFFFE, 0x8C00},
-	{0xC1D000000000007F, 0xFC00},
-	{0x4800000000000000, 0x7C00},
-	{0xC1D000000000003F, 0xFC00},
-	{0xBCAFFFFFFE7FFFFF, 0x8000},
-	{0xC1D000000000001F, 0xFC00},
-	{0x3FB00FFFFFFFFFEF, 0x2C04},
-	{0xC1D000000000000F, 0xFC00},
-	{0xC3E4B56C214E2FF9, 0xFC00},
-	{0xC1D0000000000007, 0xFC00},
-	{0x3CA60D38F2F15488, 0x0000},
-	{0xC1D0000000000003, 0xFC00},
-	{0x3FDFFFFFFFFFFFEF, 0x3800},
-	{0xC1E0000000000000, 0xFC00},
-	{0xC02CBB2C06647E6A, 0xCB2F},
-	{0xC1E0000000000001, 0xFC00},
-	{0xC34FFBFFFFFFF7FE, 0xFC00},
-	{0xC1E0000000000002, 0xFC00},
-	{0x380FF00000000000, 0x0000},
-	{0xC1E0000000000004, 0xFC00},
-	{0x000D21C0281EEE6C, 0x0000},
-	{0xC1E0000000000008, 0xFC00},
-	{0x3A9010171E9E2ED7, 0x0000},
-	{0xC1E0000000000010, 0xFC00},
-	{0x41D7BC90D53B56AD, 0x7C00},
-	{0xC1E0000000000020, 0xFC00},
-	{0x40AFEFFFFFE00000, 0x6BFC},
-	{0xC1E0000000000040, 0xFC00},
-	{0xBFFFBFFFFFFFFFEE, 0xBFF0},
-	{0xC1E0000000000080, 0xFC00},
-	{0xBF70000000018000, 0x9C00},
-	{0xC1E0000000000100, 0xFC00},
-	{0x41C91450477925D9, 0x7C00},
-	{0xC1E0000000000200, 0xFC00},
-	{0xBFB657D8D5798BB2, 0xAD96},
-	{0xC1E0000000000400, 0xFC00},
-	{0x3E6FFFFFFFFC0000, 0x0001},
-	{0xC1E0000000000800, 0xFC00},
-	{0xC0B2E9B82FD98083, 0xECBA},
-	{0xC1E0000000001000, 0xFC00},
-	{0xC03FFFFFF000000F, 0xD000},
-	{0xC1E0000000002000, 0xFC00},
-	{0xFFD00000001EFFFF, 0xFC00},
-	{0xC1E0000000004000, 0xFC00},
-	{0x406CC41D0F9A588D, 0x5B31},
-	{0xC1E0000000008000, 0xFC00},
-	{0xB800000200007FFF, 0x8000},
-	{0xC1E0000000010000, 0xFC00},
-	{0x37FDFFFFEFFFFFFF, 0x0000},
-	{0xC1E0000000020000, 0xFC00},
-	{0xB7FFFFFFFFFFFF40, 0x8000},
-	{0xC1E0000000040000, 0xFC00},
-	{0x37FE000000000007, 0x0000},
-	{0xC1E0000000080000, 0xFC00},
-	{0xC340000080000400, 0xFC00},
-	{0xC1E0000000100000, 0xFC00},
-	{0xC383FFFBFFFFFFFF, 0xFC00},
-	{0xC1E0000000200000, 0xFC00},
-	{0x3CACD4E0734943FA, 0x0000},
-	{0xC1E0000000400000, 0xFC00},
-	{0xBFD000000001FFEE, 0xB400},
-	{0xC1E0000000800000, 0xFC00},
-	{0x3FE8E8E2004B455A, 0x3A3A},
-	{0xC1E0000001000000, 0xFC00},
-	{0xBF6FFFFFEFFFEFFE, 0x9C00},
-	{0xC1E0000002000000, 0xFC00},
-	{0xC1940B41AF02FEDC, 0xFC00},
-	{0xC1E0000004000000, 0xFC00},
-	{0x0010000000002001, 0x0000},
-	{0xC1E0000008000000, 0xFC00},
-	{0x419FFFFFFFFFDFFF, 0x7C00},
-	{0xC1E0000010000000, 0xFC00},
-	{0x4000003FE0000000, 0x4000},
-	{0xC1E0000020000000, 0xFC00},
-	{0xC020400007FFFFFE, 0xC810},
-	{0xC1E0000040000000, 0xFC00},
-	{0x3E7FFFE1FFFFFFFE, 0x0002},
-	{0xC1E0000080000000, 0xFC00},
-	{0x480000FFFFFFFBFE, 0x7C00},
-	{0xC1E0000100000000, 0xFC00},
-	{0x40BFFFF00000003F, 0x7000},
-	{0xC1E0000200000000, 0xFC00},
-	{0xC00FFFFFFBFFFFDE, 0xC400},
-	{0xC1E0000400000000, 0xFC00},
-	{0x29503BFC6DDC44CF, 0x0000},
-	{0xC1E0000800000000, 0xFC00},
-	{0xBFF000000FBFFFFE, 0xBC00},
-	{0xC1E0001000000000, 0xFC00},
-	{0xC00ED0A898E8113A, 0xC3B4},
-	{0xC1E0002000000000, 0xFC00},
-	{0xC1F0FFEFFFFFFFFF, 0xFC00},
-	{0xC1E0004000000000, 0xFC00},
-	{0x1DFBFFFFFFFF8000, 0x0000},
-	{0xC1E0008000000000, 0xFC00},
-	{0xC8007FFFFFFEFFFE, 0xFC00},
-	{0xC1E0010000000000, 0xFC00},
-	{0x3CA829B6D3CF969C, 0x0000},
-	{0xC1E0020000000000, 0xFC00},
-	{0x73F0010000003FFF, 0x7C00},
-	{0xC1E0040000000000, 0xFC00},
-	{0x40FFFFFFBFFEFFFE, 0x7C00},
-	{0xC1E0080000000000, 0xFC00},
-	{0x601000000FFFFF7F, 0x7C00},
-	{0xC1E0100000000000, 0xFC00},
-	{0x3818F0C8C1413C3E, 0x0000},
-	{0xC1E0200000000000, 0xFC00},
-	{0xBFC000004FFFFFFE, 0xB000},
-	{0xC1E0400000000000, 0xFC00},
-	{0x3FE00001FFFFFFBE, 0x3800},
-	{0xC1E0800000000000, 0xFC00},
-	{0x3FE608C3EBD45C06, 0x3982},
-	{0xC1E1000000000000, 0xFC00},
-	{0x400C0C2586E37139, 0x4303},
-	{0xC1E2000000000000, 0xFC00},
-	{0xC3C705445E04423A, 0xFC00},
-	{0xC1E4000000000000, 0xFC00},
-	{0xC0D0B6FB8EF3189E, 0xF42E},
-	{0xC1E8000000000000, 0xFC00},
-	{0xC15FFFFFFFFC000E, 0xFC00},
-	{0xC1EC000000000000, 0xFC00},
-	{0xBF2B9AF644FA4D08, 0x8AE7},
-	{0xC1EE000000000000, 0xFC00},
-	{0x434C16359FF60D2F, 0x7C00},
-	{0xC1EF000000000000, 0xFC00},
-	{0xBFE0000003FE0000, 0xB800},
-	{0xC1EF800000000000, 0xFC00},
-	{0x3EDFF7FFFFFFFFFD, 0x0080},
-	{0xC1EFC00000000000, 0xFC00},
-	{0x3C7FC6FE88C948B0, 0x0000},
-	{0xC1EFE00000000000, 0xFC00},
-	{0xC80EF6F52DD7529B, 0xFC00},
-	{0xC1EFF00000000000, 0xFC00},
-	{0x388008003FFFFFFF, 0x0000},
-	{0xC1EFF80000000000, 0xFC00},
-	{0x3FC00080000FFFFE, 0x3000},
-	{0xC1EFFC0000000000, 0xFC00},
-	{0x0010000000401FFF, 0x0000},
-	{0xC1EFFE0000000000, 0xFC00},
-	{0xC3FFFFEFEFFFFFFE, 0xFC00},
-	{0xC1EFFF0000000000, 0xFC00},
-	{0x3CFFFFFFDFFDFFFF, 0x0000},
-	{0xC1EFFF8000000000, 0xFC00},
-	{0xB803F33490BBD1CD, 0x8000},
-	{0xC1EFFFC000000000, 0xFC00},
-	{0x25607FFFFFFFFDFF, 0x0000},
-	{0xC1EFFFE000000000, 0xFC00},
-	{0x401FF0000000003F, 0x47FC},
-	{0xC1EFFFF000000000, 0xFC00},
-	{0xBFEFFFFE0007FFFF, 0xBC00},
-	{0xC1EFFFF800000000, 0xFC00},
-	{0xC28002003FFFFFFF, 0xFC00},
-	{0xC1EFFFFC00000000, 0xFC00},
-	{0xC7EFFFEFFFFFFF80, 0xFC00},
-	{0xC1EFFFFE00000000, 0xFC00},
-	{0x4AFFFFFEEFFFFFFF, 0x7C00},
-	{0xC1EFFFFF00000000, 0xFC00},
-	{0x47F3FFFFFFFFFFEF, 0x7C00},
-	{0xC1EFFFFF80000000, 0xFC00},
-	{0x40500000007DFFFE, 0x5400},
-	{0xC1EFFFFFC0000000, 0xFC00},
-	{0xC2BFF7FFFFFF0000, 0xFC00},
-	{0xC1EFFFFFE0000000, 0xFC00},
-	{0x3FE401FFFFFFFFFF, 0x3900},
-	{0xC1EFFFFFF0000000, 0xFC00},
-	{0x34DFFFFF5FFFFFFE, 0x0000},
-	{0xC1EFFFFFF8000000, 0xFC00},
-	{0xDC057E4C279DEF7F, 0xFC00},
-	{0xC1EFFFFFFC000000, 0xFC00},
-	{0xC1CFEDFFFFFFFFFF, 0xFC00},
-	{0xC1EFFFFFFE000000, 0xFC00},
-	{0x4027FFFFFFFF0000, 0x4A00},
-	{0xC1EFFFFFFF000000, 0xFC00},
-	{0x4095276D410F8B28, 0x654A},
-	{0xC1EFFFFFFF800000, 0xFC00},
-	{0xBFF4000003FFFFFF, 0xBD00},
-	{0xC1EFFFFFFFC00000, 0xFC00},
-	{0xC0DFFFFFF8003FFE, 0xF800},
-	{0xC1EFFFFFFFE00000, 0xFC00},
-	{0x7FE0007FFFDFFFFF, 0x7C00},
-	{0xC1EFFFFFFFF00000, 0xFC00},
-	{0x3980002003FFFFFE, 0x0000},
-	{0xC1EFFFFFFFF80000, 0xFC00},
-	{0x402C0000000007FF, 0x4B00},
-	{0xC1EFFFFFFFFC0000, 0xFC00},
-	{0xBED00007FFFFFDFE, 0x8040},
-	{0xC1EFFFFFFFFE0000, 0xFC00},
-	{0x3E81107F4FC362ED, 0x0002},
-	{0xC1EFFFFFFFFF0000, 0xFC00},
-	{0xC0000800003FFFFF, 0xC002},
-	{0xC1EFFFFFFFFF8000, 0xFC00},
-	{0xBFB0000900000000, 0xAC00},
-	{0xC1EFFFFFFFFFC000, 0xFC00},
-	{0x4346BE28EC0833EB, 0x7C00},
-	{0xC1EFFFFFFFFFE000, 0xFC00},
-	{0x40333E390D375373, 0x4CD0},
-	{0xC1EFFFFFFFFFF000, 0xFC00},
-	{0xB7EB95A164C19138, 0x8000},
-	{0xC1EFFFFFFFFFF800, 0xFC00},
-	{0xAEA4F9070D5EDEF3, 0x8000},
-	{0xC1EFFFFFFFFFFC00, 0xFC00},
-	{0xB3107FBFFFFFFFFF, 0x8000},
-	{0xC1EFFFFFFFFFFE00, 0xFC00},
-	{0x3FEE7F8D7450EAED, 0x3BA0},
-	{0xC1EFFFFFFFFFFF00, 0xFC00},
-	{0xBCAFFF9FFFFFFFFF, 0x8000},
-	{0xC1EFFFFFFFFFFF80, 0xFC00},
-	{0xFFEFFFF9FFFFFFFF, 0xFC00},
-	{0xC1EFFFFFFFFFFFC0, 0xFC00},
-	{0x3FFE0007FFFFFFFF, 0x3F80},
-	{0xC1EFFFFFFFFFFFE0, 0xFC00},
-	{0x41F0000001FF0000, 0x7C00},
-	{0xC1EFFFFFFFFFFFF0, 0xFC00},
-	{0x4010003FEFFFFFFE, 0x4400},
-	{0xC1EFFFFFFFFFFFF8, 0xFC00},
-	{0x3800200080000000, 0x0000},
-	{0xC1EFFFFFFFFFFFFC, 0xFC00},
-	{0x802CC303D6399965, 0x8000},
-	{0xC1EFFFFFFFFFFFFE, 0xFC00},
-	{0xC340001F7FFFFFFF, 0xFC00},
-	{0xC1EFFFFFFFFFFFFF, 0xFC00},
-	{0xBFEFFFC0000FFFFF, 0xBC00},
-	{0xC1EFFFFFFFFFFFFD, 0xFC00},
-	{0xC0319836E5CDC02E, 0xCC66},
-	{0xC1EFFFFFFFFFFFFB, 0xFC00},
-	{0xB81FFFFFFBFFC000, 0x8000},
-	{0xC1EFFFFFFFFFFFF7, 0xFC00},
-	{0x7FE9CAC8D5104789, 0x7C00},
-	{0xC1EFFFFFFFFFFFEF, 0xFC00},
-	{0x445FFFFF78000000, 0x7C00},
-	{0xC1EFFFFFFFFFFFDF, 0xFC00},
-	{0xBE31FFFFFFFFFFFA, 0x8000},
-	{0xC1EFFFFFFFFFFFBF, 0xFC00},
-	{0x43740CC3A0D4FCD8, 0x7C00},
-	{0xC1EFFFFFFFFFFF7F, 0xFC00},
-	{0x5BD000000040000F, 0x7C00},
-	{0xC1EFFFFFFFFFFEFF, 0xFC00},
-	{0xBFDFDFFFFFFFFFFB, 0xB7F8},
-	{0xC1EFFFFFFFFFFDFF, 0xFC00},
-	{0xAA500000000001DF, 0x8000},
-	{0xC1EFFFFFFFFFFBFF, 0xFC00},
-	{0x163FFFF7FFFFFFBF, 0x0000},
-	{0xC1EFFFFFFFFFF7FF, 0xFC00},
-	{0xBFA0080020000000, 0xA802},
-	{0xC1EFFFFFFFFFEFFF, 0xFC00},
-	{0xC010400000000400, 0xC410},
-	{0xC1EFFFFFFFFFDFFF, 0xFC00},
-	{0x3FDFFFFFFFFFFFFF, 0x3800},
-	{0xC1EFFFFFFFFFBFFF, 0xFC00},
-	{0x37EFFF87FFFFFFFE, 0x0000},
-	{0xC1EFFFFFFFFF7FFF, 0xFC00},
-	{0x3F8FFFFFFFFFFAFF, 0x2400},
-	{0xC1EFFFFFFFFEFFFF, 0xFC00},
-	{0x37FFFFFFFFFEE000, 0x0000},
-	{0xC1EFFFFFFFFDFFFF, 0xFC00},
-	{0x00100001FEFFFFFF, 0x0000},
-	{0xC1EFFFFFFFFBFFFF, 0xFC00},
-	{0x800000000000207E, 0x8000},
-	{0xC1EFFFFFFFF7FFFF, 0xFC00},
-	{0x3F800C0000000000, 0x2003},
-	{0xC1EFFFFFFFEFFFFF, 0xFC00},
-	{0xBFCFFDFFFFEFFFFE, 0xB3FF},
-	{0xC1EFFFFFFFDFFFFF, 0xFC00},
-	{0x406F000002000000, 0x5BC0},
-	{0xC1EFFFFFFFBFFFFF, 0xFC00},
-	{0xC1D000000000000B, 0xFC00},
-	{0xC1EFFFFFFF7FFFFF, 0xFC00},
-	{0xC26FFFFFFFBFFFF7, 0xFC00},
-	{0xC1EFFFFFFEFFFFFF, 0xFC00},
-	{0xBE0800000000003F, 0x8000},
-	{0xC1EFFFFFFDFFFFFF, 0xFC00},
-	{0x40000000100003FF, 0x4000},
-	{0xC1EFFFFFFBFFFFFF, 0xFC00},
-	{0x3FAFEFFFFFFFDFFE, 0x2BFC},
-	{0xC1EFFFFFF7FFFFFF, 0xFC00},
-	{0xC04994E1C606226F, 0xD265},
-	{0xC1EFFFFFEFFFFFFF, 0xFC00},
-	{0xB7F0FFFFFFFFE000, 0x8000},
-	{0xC1EFFFFFDFFFFFFF, 0xFC00},
-	{0xC1ECDFC9B4713C1F, 0xFC00},
-	{0xC1EFFFFFBFFFFFFF, 0xFC00},
-	{0xC3DF5BE7FE24DECE, 0xFC00},
-	{0xC1EFFFFF7FFFFFFF, 0xFC00},
-	{0xA1E80007FFFFFFFF, 0x8000},
-	{0xC1EFFFFEFFFFFFFF, 0xFC00},
-	{0xBCA3308D17ABD124, 0x8000},
-	{0xC1EFFFFDFFFFFFFF, 0xFC00},
-	{0xC00FFFFFC0007FFF, 0xC400},
-	{0xC1EFFFFBFFFFFFFF, 0xFC00},
-	{0xC038000020000000, 0xCE00},
-	{0xC1EFFFF7FFFFFFFF, 0xFC00},
-	{0xC09FB00000000000, 0xE7EC},
-	{0xC1EFFFEFFFFFFFFF, 0xFC00},
-	{0xF620000400000003, 0xFC00},
-	{0xC1EFFFDFFFFFFFFF, 0xFC00},
-	{0x43FFFFFF7FFFFFF8, 0x7C00},
-	{0xC1EFFFBFFFFFFFFF, 0xFC00},
-	{0xC1C0002080000000, 0xFC00},
-	{0xC1EFFF7FFFFFFFFF, 0xFC00},
-	{0xC1EFFF7FFFDFFFFE, 0xFC00},
-	{0xC1EFFEFFFFFFFFFF, 0xFC00},
-	{0x7AFFFFFFFFFF3FFF, 0x7C00},
-	{0xC1EFFDFFFFFFFFFF, 0xFC00},
-	{0xB7FFFFFFFE001FFE, 0x8000},
-	{0xC1EFFBFFFFFFFFFF, 0xFC00},
-	{0x41F0000000000803, 0x7C00},
-	{0xC1EFF7FFFFFFFFFF, 0xFC00},
-	{0xBD6FFE000007FFFF, 0x8000},
-	{0xC1EFEFFFFFFFFFFF, 0xFC00},
-	{0xC1A003FF7FFFFFFF, 0xFC00},
-	{0xC1EFDFFFFFFFFFFF, 0xFC00},
-	{0xC7FC1DB00B5E5D87, 0xFC00},
-	{0xC1EFBFFFFFFFFFFF, 0xFC00},
-	{0xC34BCCAB6C99A128, 0xFC00},
-	{0xC1EF7FFFFFFFFFFF, 0xFC00},
-	{0x47E00100007FFFFE, 0x7C00},
-	{0xC1EEFFFFFFFFFFFF, 0xFC00},
-	{0xC37003FFFFFF0000, 0xFC00},
-	{0xC1EDFFFFFFFFFFFF, 0xFC00},
-	{0x41A003FFFFEFFFFF, 0x7C00},
-	{0xC1EBFFFFFFFFFFFF, 0xFC00},
-	{0xBDDD5CE608E4CEE2, 0x8000},
-	{0xC1E7FFFFFFFFFFFF, 0xFC00},
-	{0xC029C22BB837CFFD, 0xCA71},
-	{0xC1E3FFFFFFFFFFFF, 0xFC00},
-	{0xC8946459D455C37B, 0xFC00},
-	{0xC1E1FFFFFFFFFFFF, 0xFC00},
-	{0x38D0004000000100, 0x0000},
-	{0xC1E0FFFFFFFFFFFF, 0xFC00},
-	{0x380FFFFFCFFFFFFE, 0x0000},
-	{0xC1E07FFFFFFFFFFF, 0xFC00},
-	{0x7FD000FFFFFDFFFF, 0x7C00},
-	{0xC1E03FFFFFFFFFFF, 0xFC00},
-	{0x3C334F56C617891F, 0x0000},
-	{0xC1E01FFFFFFFFFFF, 0xFC00},
-	{0x42F000FFFDFFFFFE, 0x7C00},
-	{0xC1E00FFFFFFFFFFF, 0xFC00},
-	{0x001FFFEFFFFFFFE0, 0x0000},
-	{0xC1E007FFFFFFFFFF, 0xFC00},
-	{0xC02814C613C82C49, 0xCA05},
-	{0xC1E003FFFFFFFFFF, 0xFC00},
-	{0x404FEFFFFFF7FFFE, 0x53FC},
-	{0xC1E001FFFFFFFFFF, 0xFC00},
-	{0xBB9FFFFDFFFFFFF6, 0x8000},
-	{0xC1E000FFFFFFFFFF, 0xFC00},
-	{0x43D609A00B1AED44, 0x7C00},
-	{0xC1E0007FFFFFFFFF, 0xFC00},
-	{0x3F9F3E90DD2F9241, 0x27D0},
-	{0xC1E0003FFFFFFFFF, 0xFC00},
-	{0x32C0000FFFF7FFFF, 0x0000},
-	{0xC1E0001FFFFFFFFF, 0xFC00},
-	{0xC1F0000000000002, 0xFC00},
-	{0xC1E0000FFFFFFFFF, 0xFC00},
-	{0x4000020000000000, 0x4000},
-	{0xC1E00007FFFFFFFF, 0xFC00},
-	{0xC3F1EE4EEBB6CB21, 0xFC00},
-	{0xC1E00003FFFFFFFF, 0xFC00},
-	{0x32BFFFDFFFFDFFFF, 0x0000},
-	{0xC1E00001FFFFFFFF, 0xFC00},
-	{0x7FD00003FF7FFFFE, 0x7C00},
-	{0xC1E00000FFFFFFFF, 0xFC00},
-	{0x380FFFFF7FFC0000, 0x0000},
-	{0xC1E000007FFFFFFF, 0xFC00},
-	{0x40FE0869FED66EB1, 0x7C00},
-	{0xC1E000003FFFFFFF, 0xFC00},
-	{0xC40FFFFFFFEFFFFF, 0xFC00},
-	{0xC1E000001FFFFFFF, 0xFC00},
-	{0x4050000010000004, 0x5400},
-	{0xC1E000000FFFFFFF, 0xFC00},
-	{0x3E8FFFFFFBBFFFFF, 0x0004},
-	{0xC1E0000007FFFFFF, 0xFC00},
-	{0x3E3FFFFFFFC00010, 0x0000},
-	{0xC1E0000003FFFFFF, 0xFC00},
-	{0x43EFFFFA00000000, 0x7C00},
-	{0xC1E0000001FFFFFF, 0xFC00},
-	{0x47E79B1D9F24A7F5, 0x7C00},
-	{0xC1E0000000FFFFFF, 0xFC00},
-	{0xC390000003FFEFFE, 0xFC00},
-	{0xC1E00000007FFFFF, 0xFC00},
-	{0x2080000000208000, 0x0000},
-	{0xC1E00000003FFFFF, 0xFC00},
-	{0x3F1FF00000040000, 0x07FC},
-	{0xC1E00000001FFFFF, 0xFC00},
-	{0xE07AC9523360DC69, 0xFC00},
-	{0xC1E00000000FFFFF, 0xFC00},
-	{0x40303B508C9C9978, 0x4C0F},
-	{0xC1E000000007FFFF, 0xFC00},
-	{0xBFD0003FFFFFFFE0, 0xB400},
-	{0xC1E000000003FFFF, 0xFC00},
-	{0x400FFFF7F7FFFFFF, 0x4400},
-	{0xC1E000000001FFFF, 0xFC00},
-	{0xBF00000200000040, 0x8200},
-	{0xC1E000000000FFFF, 0xFC00},
-	{0x3FD000001FFDFFFE, 0x3400},
-	{0xC1E0000000007FFF, 0xFC00},
-	{0x802FFDFFFFFFFFFF, 0x8000},
-	{0xC1E0000000003FFF, 0xFC00},
-	{0x3F9FFFFF81FFFFFF, 0x2800},
-	{0xC1E0000000001FFF, 0xFC00},
-	{0x41FB1527ADB94722, 0x7C00},
-	{0xC1E0000000000FFF, 0xFC00},
-	{0x4020000003FFFFFA, 0x4800},
-	{0xC1E00000000007FF, 0xFC00},
-	{0x40300000000007BE, 0x4C00},
-	{0xC1E00000000003FF, 0xFC00},
-	{0x405F0FFFFFFFFFFE, 0x57C4},
-	{0xC1E00000000001FF, 0xFC00},
-	{0x43420000FFFFFFFF, 0x7C00},
-	{0xC1E00000000000FF, 0xFC00},
-	{0xBFA3E071190DE1D0, 0xA8F8},
-	{0xC1E000000000007F, 0xFC00},
-	{0xFFDFFFEFFFFFFBFF, 0xFC00},
-	{0xC1E000000000003F, 0xFC00},
-	{0xC1EFFEFFFFFE0000, 0xFC00},
-	{0xC1E000000000001F, 0xFC00},
-	{0x380000FFFFFE0000, 0x0000},
-	{0xC1E000000000000F, 0xFC00},
-	{0x43FFFFFFFFC03FFF, 0x7C00},
-	{0xC1E0000000000007, 0xFC00},
-	{0xC11FFFFF80040000, 0xFC00},
-	{0xC1E0000000000003, 0xFC00},
-	{0xBCF49DBF3A32BE8D, 0x8000},
-	{0xC1F0000000000000, 0xFC00},
-	{0xBF43070A79F0D237, 0x90C2},
-	{0xC1F0000000000001, 0xFC00},
-	{0x4020000000003FC0, 0x4800},
-	{0xC1F0000000000002, 0xFC00},
-	{0x3EEFFFFFFF7BFFFE, 0x0100},
-	{0xC1F0000000000004, 0xFC00},
-	{0xBFBFFFDFFFFF8000, 0xB000},
-	{0xC1F0000000000008, 0xFC00},
-	{0xBFF0003FFFEFFFFE, 0xBC00},
-	{0xC1F0000000000010, 0xFC00},
-	{0x800005FFFFFFFFFE, 0x8000},
-	{0xC1F0000000000020, 0xFC00},
-	{0xBFC08FFFFFFFFFFF, 0xB024},
-	{0xC1F0000000000040, 0xFC00},
-	{0xC67FFFFFFFFC000F, 0xFC00},
-	{0xC1F0000000000080, 0xFC00},
-	{0x4020030903541BD5, 0x4801},
-	{0xC1F0000000000100, 0xFC00},
-	{0x3EFFFFFFF3FFFFFF, 0x0200},
-	{0xC1F0000000000200, 0xFC00},
-	{0x41EFFF80000003FF, 0x7C00},
-	{0xC1F0000000000400, 0xFC00},
-	{0x3FE0000000003FFE, 0x3800},
-	{0xC1F0000000000800, 0xFC00},
-	{0xBFB000800FFFFFFF, 0xAC00},
-	{0xC1F0000000001000, 0xFC00},
-	{0x311D4297A1BECB9B, 0x0000},
-	{0xC1F0000000002000, 0xFC00},
-	{0x477F05C0D73CF6F5, 0x7C00},
-	{0xC1F0000000004000, 0xFC00},
-	{0x3FF10003FFFFFFFE, 0x3C40},
-	{0xC1F0000000008000, 0xFC00},
-	{0x47FFDF7FFFFFFFFF, 0x7C00},
-	{0xC1F0000000010000, 0xFC00},
-	{0x7FDF1FFFFFFFFFFE, 0x7C00},
-	{0xC1F0000000020000, 0xFC00},
-	{0x27B487D3F2B54922, 0x0000},
-	{0xC1F0000000040000, 0xFC00},
-	{0x0010000010000040, 0x0000},
-	{0xC1F0000000080000, 0xFC00},
-	{0xB7F0000000FF7FFE, 0x8000},
-	{0xC1F0000000100000, 0xFC00},
-	{0x7FD0001FFFFFF7FE, 0x7C00},
-	{0xC1F0000000200000, 0xFC00},
-	{0xFB8000003FFFFF7F, 0xFC00},
-	{0xC1F0000000400000, 0xFC00},
-	{0x3109372533E461B5, 0x0000},
-	{0xC1F0000000800000, 0xFC00},
-	{0x41F7FFFFFFFC0000, 0x7C00},
-	{0xC1F0000001000000, 0xFC00},
-	{0xBFBAA19A877E1A5B, 0xAEA8},
-	{0xC1F0000002000000, 0xFC00},
-	{0x47E4CEF76673AD42, 0x7C00},
-	{0xC1F0000004000000, 0xFC00},
-	{0x47EFFFC000000010, 0x7C00},
-	{0xC1F0000008000000, 0xFC00},
-	{0x430FF000000007FE, 0x7C00},
-	{0xC1F0000010000000, 0xFC00},
-	{0xC0AFFFFDFFEFFFFF, 0xEC00},
-	{0xC1F0000020000000, 0xFC00},
-	{0xBFBFFFF7FFFFFFFB, 0xB000},
-	{0xC1F0000040000000, 0xFC00},
-	{0xC1F07FFFFFFFFFFF, 0xFC00},
-	{0xC1F0000080000000, 0xFC00},
-	{0xBCFFFDFFFFFDFFFF, 0x8000},
-	{0xC1F0000100000000, 0xFC00},
-	{0xBFFFF000FFFFFFFF, 0xBFFC},
-	{0xC1F0000200000000, 0xFC00},
-	{0xC0200000800007FF, 0xC800},
-	{0xC1F0000400000000, 0xFC00},
-	{0x41DFFFFFFFFFFE3F, 0x7C00},
-	{0xC1F0000800000000, 0xFC00},
-	{0x43FB19F22561EBB0, 0x7C00},
-	{0xC1F0001000000000, 0xFC00},
-	{0x3FDFFFF7FFFFBFFF, 0x3800},
-	{0xC1F0002000000000, 0xFC00},
-	{0x0024D6C646B17FFF, 0x0000},
-	{0xC1F0004000000000, 0xFC00},
-	{0x3DCFFFBFBFFFFFFF, 0x0000},
-	{0xC1F0008000000000, 0xFC00},
-	{0x3E30000000005FFF, 0x0000},
-	{0xC1F0010000000000, 0xFC00},
-	{0x41F00000001FFFFE, 0x7C00},
-	{0xC1F0020000000000, 0xFC00},
-	{0x4754EDB6EDF1002F, 0x7C00},
-	{0xC1F0040000000000, 0xFC00},
-	{0xBFFFE20000000000, 0xBFF8},
-	{0xC1F0080000000000, 0xFC00},
-	{0xC7D08000FFFFFFFE, 0xFC00},
-	{0xC1F0100000000000, 0xFC00},
-	{0xC7EE0000003FFFFE, 0xFC00},
-	{0xC1F0200000000000, 0xFC00},
-	{0x3FD0080000003FFE, 0x3402},
-	{0xC1F0400000000000, 0xFC00},
-	{0xC31FFFBFFFFFFEFF, 0xFC00},
-	{0xC1F0800000000000, 0xFC00},
-	{0xCB70FFFFFBFFFFFF, 0xFC00},
-	{0xC1F1000000000000, 0xFC00},
-	{0xB7E000000007BFFF, 0x8000},
-	{0xC1F2000000000000, 0xFC00},
-	{0xC29C3FFFFFFFFFFF, 0xFC00},
-	{0xC1F4000000000000, 0xFC00},
-	{0x3FB00000003FF000, 0x2C00},
-	{0xC1F8000000000000, 0xFC00},
-	{0x40E000000007DFFF, 0x7800},
-	{0xC1FC000000000000, 0xFC00},
-	{0xBF8000000000080E, 0xA000},
-	{0xC1FE000000000000, 0xFC00},
-	{0xC13000FFFFFFDFFF, 0xFC00},
-	{0xC1FF000000000000, 0xFC00},
-	{0xC0383CEF59E019A0, 0xCE0F},
-	{0xC1FF800000000000, 0xFC00},
-	{0xBCAFFFBFFF7FFFFF, 0x8000},
-	{0xC1FFC00000000000, 0xFC00},
-	{0x37E55F98ECDBF027, 0x0000},
-	{0xC1FFE00000000000, 0xFC00},
-	{0x47F1000000000002, 0x7C00},
-	{0xC1FFF00000000000, 0xFC00},
-	{0x3FB0000000FFFFEF, 0x2C00},
-	{0xC1FFF80000000000, 0xFC00},
-	{0xBF20003FFFFFFFFF, 0x8800},
-	{0xC1FFFC0000000000, 0xFC00},
-	{0x381FFE0000000FFF, 0x0000},
-	{0xC1FFFE0000000000, 0xFC00},
-	{0x64BFFFFFE000000F, 0x7C00},
-	{0xC1FFFF0000000000, 0xFC00},
-	{0xBC2FF7FFFFFFFF80, 0x8000},
-	{0xC1FFFF8000000000, 0xFC00},
-	{0x41CFDFFFFFFFFFFB, 0x7C00},
-	{0xC1FFFFC000000000, 0xFC00},
-	{0x801FFFBFFFFFFF00, 0x8000},
-	{0xC1FFFFE000000000, 0xFC00},
-	{0xAC0FBFFFFFFE0000, 0x8000},
-	{0xC1FFFFF000000000, 0xFC00},
-	{0x5E3FF8000000003F, 0x7C00},
-	{0xC1FFFFF800000000, 0xFC00},
-	{0xB80FFFFFFFDFFF00, 0x8000},
-	{0xC1FFFFFC00000000, 0xFC00},
-	{0xB96FFFFFFFFFFFFF, 0x8000},
-	{0xC1FFFFFE00000000, 0xFC00},
-	{0xBCA584CDB8F34CBC, 0x8000},
-	{0xC1FFFFFF00000000, 0xFC00},
-	{0x412991C256A632D6, 0x7C00},
-	{0xC1FFFFFF80000000, 0xFC00},
-	{0x4308071687DDC63E, 0x7C00},
-	{0xC1FFFFFFC0000000, 0xFC00},
-	{0xC7EFFFFFF000007F, 0xFC00},
-	{0xC1FFFFFFE0000000, 0xFC00},
-	{0xC00FC00000000010, 0xC3F0},
-	{0xC1FFFFFFF0000000, 0xFC00},
-	{0xBFBFEFEFFFFFFFFF, 0xAFFC},
-	{0xC1FFFFFFF8000000, 0xFC00},
-	{0xBCAB57BDE638F236, 0x8000},
-	{0xC1FFFFFFFC000000, 0xFC00},
-	{0xC00B92F350CD18F9, 0xC2E5},
-	{0xC1FFFFFFFE000000, 0xFC00},
-	{0x3EFAA88558B57A4D, 0x01AB},
-	{0xC1FFFFFFFF000000, 0xFC00},
-	{0xC34D90D16C9AFBB3, 0xFC00},
-	{0xC1FFFFFFFF800000, 0xFC00},
-	{0xC3D01FFFEFFFFFFF, 0xFC00},
-	{0xC1FFFFFFFFC00000, 0xFC00},
-	{0xFFEF83F4C5BD4854, 0xFC00},
-	{0xC1FFFFFFFFE00000, 0xFC00},
-	{0x42B0000000007BFF, 0x7C00},
-	{0xC1FFFFFFFFF00000, 0xFC00},
-	{0xC3CF800007FFFFFF, 0xFC00},
-	{0xC1FFFFFFFFF80000, 0xFC00},
-	{0x3EDECA8A9B67ACDC, 0x007B},
-	{0xC1FFFFFFFFFC0000, 0xFC00},
-	{0xC8000000002001FF, 0xFC00},
-	{0xC1FFFFFFFFFE0000, 0xFC00},
-	{0xC7F9D35C0CEE07F0, 0xFC00},
-	{0xC1FFFFFFFFFF0000, 0xFC00},
-	{0x43FDAA32B99AC489, 0x7C00},
-	{0xC1FFFFFFFFFF8000, 0xFC00},
-	{0x303FFDFFFFFFF000, 0x0000},
-	{0xC1FFFFFFFFFFC000, 0xFC00},
-	{0xBFEF000000000003, 0xBBC0},
-	{0xC1FFFFFFFFFFE000, 0xFC00},
-	{0xBCE200003FFFFFFE, 0x8000},
-	{0xC1FFFFFFFFFFF000, 0xFC00},
-	{0x3FEFFFFFFFFFFAFE, 0x3C00},
-	{0xC1FFFFFFFFFFF800, 0xFC00},
-	{0x43DFFFFFFFBF8000, 0x7C00},
-	{0xC1FFFFFFFFFFFC00, 0xFC00},
-	{0x3D7C38F7F6B6DC77, 0x0000},
-	{0xC1FFFFFFFFFFFE00, 0xFC00},
-	{0xFFDFFFFFFFFFFBF8, 0xFC00},
-	{0xC1FFFFFFFFFFFF00, 0xFC00},
-	{0x3FFFFFFC00001FFE, 0x4000},
-	{0xC1FFFFFFFFFFFF80, 0xFC00},
-	{0x47FFFFFFFFDFBFFF, 0x7C00},
-	{0xC1FFFFFFFFFFFFC0, 0xFC00},
-	{0x4340000003BFFFFF, 0x7C00},
-	{0xC1FFFFFFFFFFFFE0, 0xFC00},
-	{0x56B7FFFFFFFFFFBF, 0x7C00},
-	{0xC1FFFFFFFFFFFFF0, 0xFC00},
-	{0x7FEFF80008000000, 0x7C00},
-	{0xC1FFFFFFFFFFFFF8, 0xFC00},
-	{0xB81FFFFFFFF800FE, 0x8000},
-	{0xC1FFFFFFFFFFFFFC, 0xFC00},
-	{0x374FEFFFFFFBFFFF, 0x0000},
-	{0xC1FFFFFFFFFFFFFE, 0xFC00},
-	{0x707EFFBFFFFFFFFE, 0x7C00},
-	{0xC1FFFFFFFFFFFFFF, 0xFC00},
-	{0x43EBFFFFDFFFFFFE, 0x7C00},
-	{0xC1FFFFFFFFFFFFFD, 0xFC00},
-	{0x4343E18C6A19745F, 0x7C00},
-	{0xC1FFFFFFFFFFFFFB, 0xFC00},
-	{0x0000D534DAEBD4C7, 0x0000},
-	{0xC1FFFFFFFFFFFFF7, 0xFC00},
-	{0x37E0FFFF00000000, 0x0000},
-	{0xC1FFFFFFFFFFFFEF, 0xFC00},
-	{0xAE70000FFFFFFFFE, 0x8000},
-	{0xC1FFFFFFFFFFFFDF, 0xFC00},
-	{0x41E3FFFFEFFFFFFE, 0x7C00},
-	{0xC1FFFFFFFFFFFFBF, 0xFC00},
-	{0xBFE391C753573F49, 0xB8E4},
-	{0xC1FFFFFFFFFFFF7F, 0xFC00},
-	{0x37B000000001FF00, 0x0000},
-	{0xC1FFFFFFFFFFFEFF, 0xFC00},
-	{0xC3FFFDFDFFFFFFFF, 0xFC00},
-	{0xC1FFFFFFFFFFFDFF, 0xFC00},
-	{0xC3C376A979AAF649, 0xFC00},
-	{0xC1FFFFFFFFFFFBFF, 0xFC00},
-	{0xC1CE00001FFFFFFF, 0xFC00},
-	{0xC1FFFFFFFFFFF7FF, 0xFC00},
-	{0xBFFFFFFFFFE00003, 0xC000},
-	{0xC1FFFFFFFFFFEFFF, 0xFC00},
-	{0xC010080003FFFFFF, 0xC402},
-	{0xC1FFFFFFFFFFDFFF, 0xFC00},
-	{0x8010DB8343BD5E6A, 0x8000},
-	{0xC1FFFFFFFFFFBFFF, 0xFC00},
-	{0xC6DFFFFFC000FFFF, 0xFC00},
-	{0xC1FFFFFFFFFF7FFF, 0xFC00},
-	{0x621FFFFEFFFFDFFF, 0x7C00},
-	{0xC1FFFFFFFFFEFFFF, 0xFC00},
-	{0x79D2816A9A373414, 0x7C00},
-	{0xC1FFFFFFFFFDFFFF, 0xFC00},
-	{0xC80207FFFFFFFFFE, 0xFC00},
-	{0xC1FFFFFFFFFBFFFF, 0xFC00},
-	{0x3FF000000011FFFF, 0x3C00},
-	{0xC1FFFFFFFFF7FFFF, 0xFC00},
-	{0x00000FFFF8000000, 0x0000},
-	{0xC1FFFFFFFFEFFFFF, 0xFC00},
-	{0xC00FFFFFC000FFFF, 0xC400},
-	{0xC1FFFFFFFFDFFFFF, 0xFC00},
-	{0xDC0007FFFFFF7FFF, 0xFC00},
-	{0xC1FFFFFFFFBFFFFF, 0xFC00},
-	{0x402A8E38620DC850, 0x4AA4},
-	{0xC1FFFFFFFF7FFFFF, 0xFC00},
-	{0x40E9C20DAD0ED3C3, 0x7A71},
-	{0xC1FFFFFFFEFFFFFF, 0xFC00},
-	{0x7FEFFFDFDFFFFFFE, 0x7C00},
-	{0xC1FFFFFFFDFFFFFF, 0xFC00},
-	{0x7FD11657DC25BCF3, 0x7C00},
-	{0xC1FFFFFFFBFFFFFF, 0xFC00},
-	{0x3E61FEFFFFFFFFFF, 0x0001},
-	{0xC1FFFFFFF7FFFFFF, 0xFC00},
-	{0x391FFFFFFFFFFFFE, 0x0000},
-	{0xC1FFFFFFEFFFFFFF, 0xFC00},
-	{0x43C003FFFFFFFF7F, 0x7C00},
-	{0xC1FFFFFFDFFFFFFF, 0xFC00},
-	{0xC021FFFE00000000, 0xC880},
-	{0xC1FFFFFFBFFFFFFF, 0xFC00},
-	{0xC7EFFFFFFBF80000, 0xFC00},
-	{0xC1FFFFFF7FFFFFFF, 0xFC00},
-	{0x880FFFFF0000FFFF, 0x8000},
-	{0xC1FFFFFEFFFFFFFF, 0xFC00},
-	{0xBF115F18D1B346AA, 0x8458},
-	{0xC1FFFFFDFFFFFFFF, 0xFC00},
-	{0x41D003FFDFFFFFFE, 0x7C00},
-	{0xC1FFFFFBFFFFFFFF, 0xFC00},
-	{0x001FFFFFFDFFFFDF, 0x0000},
-	{0xC1FFFFF7FFFFFFFF, 0xFC00},
-	{0x43D00000000000FE, 0x7C00},
-	{0xC1FFFFEFFFFFFFFF, 0xFC00},
-	{0x43400000010003FF, 0x7C00},
-	{0xC1FFFFDFFFFFFFFF, 0xFC00},
-	{0xC3EFFFBFFEFFFFFE, 0xFC00},
-	{0xC1FFFFBFFFFFFFFF, 0xFC00},
-	{0xB7FFFFFFFFC0FFFF, 0x8000},
-	{0xC1FFFF7FFFFFFFFF, 0xFC00},
-	{0xC02211A9B2CD41A2, 0xC884},
-	{0xC1FFFEFFFFFFFFFF, 0xFC00},
-	{0x31EFF000001FFFFF, 0x0000},
-	{0xC1FFFDFFFFFFFFFF, 0xFC00},
-	{0xC009D2DED6487124, 0xC275},
-	{0xC1FFFBFFFFFFFFFF, 0xFC00},
-	{0x41D11D6ED6C85607, 0x7C00},
-	{0xC1FFF7FFFFFFFFFF, 0xFC00},
-	{0x8020000500000000, 0x8000},
-	{0xC1FFEFFFFFFFFFFF, 0xFC00},
-	{0x27DF00000000003F, 0x0000},
-	{0xC1FFDFFFFFFFFFFF, 0xFC00},
-	{0x42B556658397BF93, 0x7C00},
-	{0xC1FFBFFFFFFFFFFF, 0xFC00},
-	{0x3800000600000000, 0x0000},
-	{0xC1FF7FFFFFFFFFFF, 0xFC00},
-	{0x403000DFFFFFFFFF, 0x4C00},
-	{0xC1FEFFFFFFFFFFFF, 0xFC00},
-	{0x3FCFFFFFFF00007E, 0x3400},
-	{0xC1FDFFFFFFFFFFFF, 0xFC00},
-	{0x3FCF03FFFFFFFFFF, 0x33C1},
-	{0xC1FBFFFFFFFFFFFF, 0xFC00},
-	{0x3FB6161CF520B1FE, 0x2D86},
-	{0xC1F7FFFFFFFFFFFF, 0xFC00},
-	{0xBFEDFFFFFFFFFFFF, 0xBB80},
-	{0xC1F3FFFFFFFFFFFF, 0xFC00},
-	{0x4A71916E66AF7FAC, 0x7C00},
-	{0xC1F1FFFFFFFFFFFF, 0xFC00},
-	{0x41780619AC070B0D, 0x7C00},
-	{0xC1F0FFFFFFFFFFFF, 0xFC00},
-	{0x1080020000003FFE, 0x0000},
-	{0xC1F07FFFFFFFFFFF, 0xFC00},
-	{0xC3D298D7D73242A6, 0xFC00},
-	{0xC1F03FFFFFFFFFFF, 0xFC00},
-	{0x4800E4C3487EE79F, 0x7C00},
-	{0xC1F01FFFFFFFFFFF, 0xFC00},
-	{0x3F75908F8902619E, 0x1D64},
-	{0xC1F00FFFFFFFFFFF, 0xFC00},
-	{0x47400000000807FE, 0x7C00},
-	{0xC1F007FFFFFFFFFF, 0xFC00},
-	{0x3FCFFFFFFFC00008, 0x3400},
-	{0xC1F003FFFFFFFFFF, 0xFC00},
-	{0xDEF103FFFFFFFFFF, 0xFC00},
-	{0xC1F001FFFFFFFFFF, 0xFC00},
-	{0x8010000F00000000, 0x8000},
-	{0xC1F000FFFFFFFFFF, 0xFC00},
-	{0xBFC0000002000007, 0xB000},
-	{0xC1F0007FFFFFFFFF, 0xFC00},
-	{0x40B000000001FFE0, 0x6C00},
-	{0xC1F0003FFFFFFFFF, 0xFC00},
-	{0xFFF4B43DABBA6387, 0xFF2D},
-	{0xC1F0001FFFFFFFFF, 0xFC00},
-	{0x381003FFFE000000, 0x0000},
-	{0xC1F0000FFFFFFFFF, 0xFC00},
-	{0xBFA806B37AA383D7, 0xAA02},
-	{0xC1F00007FFFFFFFF, 0xFC00},
-	{0x47E0000000000FFE, 0x7C00},
-	{0xC1F00003FFFFFFFF, 0xFC00},
-	{0x37C000000FFFFEFF, 0x0000},
-	{0xC1F00001FFFFFFFF, 0xFC00},
-	{0x3FB7FFEFFFFFFFFE, 0x2E00},
-	{0xC1F00000FFFFFFFF, 0xFC00},
-	{0xC80010000FFFFFFF, 0xFC00},
-	{0xC1F000007FFFFFFF, 0xFC00},
-	{0xE45FF00000000000, 0xFC00},
-	{0xC1F000003FFFFFFF, 0xFC00},
-	{0x43407FFFFFFFBFFF, 0x7C00},
-	{0xC1F000001FFFFFFF, 0xFC00},
-	{0x3FA00000000041FF, 0x2800},
-	{0xC1F000000FFFFFFF, 0xFC00},
-	{0xC0D010000000007F, 0xF404},
-	{0xC1F0000007FFFFFF, 0xFC00},
-	{0xC3E0000000040004, 0xFC00},
-	{0xC1F0000003FFFFFF, 0xFC00},
-	{0xC7F0003FEFFFFFFF, 0xFC00},
-	{0xC1F0000001FFFFFF, 0xFC00},
-	{0xC2CFFDFFFF7FFFFE, 0xFC00},
-	{0xC1F0000000FFFFFF, 0xFC00},
-	{0xC8037FFFFFFFFFFF, 0xFC00},
-	{0xC1F00000007FFFFF, 0xFC00},
-	{0xC030000002000002, 0xCC00},
-	{0xC1F00000003FFFFF, 0xFC00},
-	{0xBFE03FFFFFFFFEFF, 0xB810},
-	{0xC1F00000001FFFFF, 0xFC00},
-	{0xC809F30E5DF99E3B, 0xFC00},
-	{0xC1F00000000FFFFF, 0xFC00},
-	{0x801FFFFFFFFFFFDA, 0x8000},
-	{0xC1F000000007FFFF, 0xFC00},
-	{0x3DEE28329650F691, 0x0000},
-	{0xC1F000000003FFFF, 0xFC00},
-	{0xC1CFFFFBDFFFFFFF, 0xFC00},
-	{0xC1F000000001FFFF, 0xFC00},
-	{0xC50FFFF803FFFFFF, 0xFC00},
-	{0xC1F000000000FFFF, 0xFC00},
-	{0xC060000000001E00, 0xD800},
-	{0xC1F0000000007FFF, 0xFC00},
-	{0x3FF05D6D8960BEB0, 0x3C17},
-	{0xC1F0000000003FFF, 0xFC00},
-	{0xFFD0000000000FFF, 0xFC00},
-	{0xC1F0000000001FFF, 0xFC00},
-	{0x41C0000FFEFFFFFF, 0x7C00},
-	{0xC1F0000000000FFF, 0xFC00},
-	{0xBFC4937BD3988461, 0xB125},
-	{0xC1F00000000007FF, 0xFC00},
-	{0x3FF59208831C4A7F, 0x3D65},
-	{0xC1F00000000003FF, 0xFC00},
-	{0x434FFFFFFFFFFFF3, 0x7C00},
-	{0xC1F00000000001FF, 0xFC00},
-	{0xA3BF00FFFFFFFFFE, 0x8000},
-	{0xC1F00000000000FF, 0xFC00},
-	{0x46BFFFFFFFFFDFFD, 0x7C00},
-	{0xC1F000000000007F, 0xFC00},
-	{0x2691E56BE6B6957C, 0x0000},
-	{0xC1F000000000003F, 0xFC00},
-	{0xC010000000801FFF, 0xC400},
-	{0xC1F000000000001F, 0xFC00},
-	{0xC02F00000000003F, 0xCBC0},
-	{0xC1F000000000000F, 0xFC00},
-	{0x3FD0003FFFFDFFFF, 0x3400},
-	{0xC1F0000000000007, 0xFC00},
-	{0xC3C000001FFFEFFF, 0xFC00},
-	{0xC1F0000000000003, 0xFC00},
-	{0xC7F00000003FFFFD, 0xFC00},
-	{0xC340000000000000, 0xFC00},
-	{0xC3FFFFFFFFFFFF70, 0xFC00},
-	{0xC340000000000001, 0xFC00},
-	{0x434FFFFF7FFF7FFF, 0x7C00},
-	{0xC340000000000002, 0xFC00},
-	{0xFFE9D41833A98C2A, 0xFC00},
-	{0xC340000000000004, 0xFC00},
-	{0x4800185B5C862133, 0x7C00},
-	{0xC340000000000008, 0xFC00},
-	{0x41EFFFFFF0200000, 0x7C00},
-	{0xC340000000000010, 0xFC00},
-	{0xC0057D23402AE3E1, 0xC15F},
-	{0xC340000000000020, 0xFC00},
-	{0xBFEFFFFFF7FFF7FF, 0xBC00},
-	{0xC340000000000040, 0xFC00},
-	{0xBFC000FFFFFFFFBF, 0xB000},
-	{0xC340000000000080, 0xFC00},
-	{0x6A9FFC01FFFFFFFE, 0x7C00},
-	{0xC340000000000100, 0xFC00},
-	{0x3F5FF1FFFFFFFFFF, 0x17FC},
-	{0xC340000000000200, 0xFC00},
-	{0x0004F1A3E3D82D4D, 0x0000},
-	{0xC340000000000400, 0xFC00},
-	{0x599FFFE020000000, 0x7C00},
-	{0xC340000000000800, 0xFC00},
-	{0xFFE000040000FFFF, 0xFC00},
-	{0xC340000000001000, 0xFC00},
-	{0xB7EFFFFFFFFFC1FF, 0x8000},
-	{0xC340000000002000, 0xFC00},
-	{0xA1CFFFCFFFFFFFFE, 0x8000},
-	{0xC340000000004000, 0xFC00},
-	{0xBF8FFBFFFFFFDFFF, 0xA3FF},
-	{0xC340000000008000, 0xFC00},
-	{0xC3EFFFFBFFF80000, 0xFC00},
-	{0xC340000000010000, 0xFC00},
-	{0xBFC000000040003F, 0xB000},
-	{0xC340000000020000, 0xFC00},
-	{0xC059070492E1686C, 0xD642},
-	{0xC340000000040000, 0xFC00},
-	{0x3DA3FFFFFFFFFFEF, 0x0000},
-	{0xC340000000080000, 0xFC00},
-	{0x43C0100000000006, 0x7C00},
-	{0xC340000000100000, 0xFC00},
-	{0xB7FD1BAE8F32DD8A, 0x8000},
-	{0xC340000000200000, 0xFC00},
-	{0xBB000000010003FE, 0x8000},
-	{0xC340000000400000, 0xFC00},
-	{0xC0600001FE000000, 0xD800},
-	{0xC340000000800000, 0xFC00},
-	{0x43CAAD68A19533DD, 0x7C00},
-	{0xC340000001000000, 0xFC00},
-	{0x00F4000000000800, 0x0000},
-	{0xC340000002000000, 0xFC00},
-	{0x37EFF8000FFFFFFF, 0x0000},
-	{0xC340000004000000, 0xFC00},
-	{0x4B0AE0277987B33B, 0x7C00},
-	{0xC340000008000000, 0xFC00},
-	{0x7FEFFFFFBFFFC000, 0x7C00},
-	{0xC340000010000000, 0xFC00},
-	{0xFFD020FFFFFFFFFE, 0xFC00},
-	{0xC340000020000000, 0xFC00},
-	{0xC07FFFFFFFFC000F, 0xE000},
-	{0xC340000040000000, 0xFC00},
-	{0x403F800000001000, 0x4FE0},
-	{0xC340000080000000, 0xFC00},
-	{0x3FDFF8007FFFFFFE, 0x37FE},
-	{0xC340000100000000, 0xFC00},
-	{0xC3400000407FFFFE, 0xFC00},
-	{0xC340000200000000, 0xFC00},
-	{0x3FF0000003FFFFFB, 0x3C00},
-	{0xC340000400000000, 0xFC00},
-	{0xBFDFFFFFFFFBFFBF, 0xB800},
-	{0xC340000800000000, 0xFC00},
-	{0x47F13043ADE81361, 0x7C00},
-	{0xC340001000000000, 0xFC00},
-	{0xC7EFF04000000000, 0xFC00},
-	{0xC340002000000000, 0xFC00},
-	{0x402FFFFFFFFFFDF7, 0x4C00},
-	{0xC340004000000000, 0xFC00},
-	{0x34B000007FFFFFFC, 0x0000},
-	{0xC340008000000000, 0xFC00},
-	{0x7FD293D145FC8C8C, 0x7C00},
-	{0xC340010000000000, 0xFC00},
-	{0x40100000100FFFFF, 0x4400},
-	{0xC340020000000000, 0xFC00},
-	{0x3FAFFFFFDFFFFFF8, 0x2C00},
-	{0xC340040000000000, 0xFC00},
-	{0x39A000000000403E, 0x0000},
-	{0xC340080000000000, 0xFC00},
-	{0x3F500000001FFFC0, 0x1400},
-	{0xC340100000000000, 0xFC00},
-	{0x41D03DFFFFFFFFFE, 0x7C00},
-	{0xC340200000000000, 0xFC00},
-	{0xB9FFFFFFFF7FFEFE, 0x8000},
-	{0xC340400000000000, 0xFC00},
-	{0x4100010040000000, 0x7C00},
-	{0xC340800000000000, 0xFC00},
-	{0x4010000000000406, 0x4400},
-	{0xC341000000000000, 0xFC00},
-	{0x419107FFFFFFFFFF, 0x7C00},
-	{0xC342000000000000, 0xFC00},
-	{0xB37FFFFFFFDDFFFE, 0x8000},
-	{0xC344000000000000, 0xFC00},
-	{0xB979BE9E8082FFC8, 0x8000},
-	{0xC348000000000000, 0xFC00},
-	{0xBFFAB0252990FDD6, 0xBEAC},
-	{0xC34C000000000000, 0xFC00},
-	{0xBD2FC00000000100, 0x8000},
-	{0xC34E000000000000, 0xFC00},
-	{0xBFEFFEFFFFFFDFFF, 0xBC00},
-	{0xC34F000000000000, 0xFC00},
-	{0xC3F000000000001E, 0xFC00},
-	{0xC34F800000000000, 0xFC00},
-	{0xB7F9980A49E56A9E, 0x8000},
-	{0xC34FC00000000000, 0xFC00},
-	{0x3F7515DBF3611899, 0x1D45},
-	{0xC34FE00000000000, 0xFC00},
-	{0xB7F3C0C4CA28E530, 0x8000},
-	{0xC34FF00000000000, 0xFC00},
-	{0xF31800000000001F, 0xFC00},
-	{0xC34FF80000000000, 0xFC00},
-	{0x3810000200000040, 0x0000},
-	{0xC34FFC0000000000, 0xFC00},
-	{0xBFF1FFFFFFFF7FFE, 0xBC80},
-	{0xC34FFE0000000000, 0xFC00},
-	{0xC04FFFFFFFFFE400, 0xD400},
-	{0xC34FFF0000000000, 0xFC00},
-	{0x43CFFFFFFF7FFDFF, 0x7C00},
-	{0xC34FFF8000000000, 0xFC00},
-	{0x41EFFFFBFDFFFFFF, 0x7C00},
-	{0xC34FFFC000000000, 0xFC00},
-	{0x3F8FEFFFFF7FFFFE, 0x23FC},
-	{0xC34FFFE000000000, 0xFC00},
-	{0x400FFFFFF8002000, 0x4400},
-	{0xC34FFFF000000000, 0xFC00},
-	{0xC3DFE00000008000, 0xFC00},
-	{0xC34FFFF800000000, 0xFC00},
-	{0x3DD7FFFFFFEFFFFF, 0x0000},
-	{0xC34FFFFC00000000, 0xFC00},
-	{0x3FD4471F63B4BF95, 0x3512},
-	{0xC34FFFFE00000000, 0xFC00},
-	{0x7FF0000000000FDE, 0x7E00},
-	{0xC34FFFFF00000000, 0xFC00},
-	{0xB28FFFF800007FFF, 0x8000},
-	{0xC34FFFFF80000000, 0xFC00},
-	{0x67940000007FFFFF, 0x7C00},
-	{0xC34FFFFFC0000000, 0xFC00},
-	{0xB808291C9AFD4EAD, 0x8000},
-	{0xC34FFFFFE0000000, 0xFC00},
-	{0x41AFFFFFFFFFFFFF, 0x7C00},
-	{0xC34FFFFFF0000000, 0xFC00},
-	{0xE050001E00000000, 0xFC00},
-	{0xC34FFFFFF8000000, 0xFC00},
-	{0xBFC00000004001FF, 0xB000},
-	{0xC34FFFFFFC000000, 0xFC00},
-	{0xC3F000000000000D, 0xFC00},
-	{0xC34FFFFFFE000000, 0xFC00},
-	{0x3FD00000081FFFFF, 0x3400},
-	{0xC34FFFFFFF000000, 0xFC00},
-	{0xBFE8431A2CE4D0B7, 0xBA11},
-	{0xC34FFFFFFF800000, 0xFC00},
-	{0xC02000000000001D, 0xC800},
-	{0xC34FFFFFFFC00000, 0xFC00},
-	{0x47FFF80000000001, 0x7C00},
-	{0xC34FFFFFFFE00000, 0xFC00},
-	{0xC01FFFFFFFFFDFFB, 0xC800},
-	{0xC34FFFFFFFF00000, 0xFC00},
-	{0xC80FFBFFDFFFFFFE, 0xFC00},
-	{0xC34FFFFFFFF80000, 0xFC00},
-	{0x3F700000000200FF, 0x1C00},
-	{0xC34FFFFFFFFC0000, 0xFC00},
-	{0x8020FFFFF7FFFFFF, 0x8000},
-	{0xC34FFFFFFFFE0000, 0xFC00},
-	{0x47F627C2B6931E83, 0x7C00},
-	{0xC34FFFFFFFFF0000, 0xFC00},
-	{0x400FFFC0000FFFFF, 0x4400},
-	{0xC34FFFFFFFFF8000, 0xFC00},
-	{0xFFE0010000000FFF, 0xFC00},
-	{0xC34FFFFFFFFFC000, 0xFC00},
-	{0x3ED95A4D95B6A336, 0x0065},
-	{0xC34FFFFFFFFFE000, 0xFC00},
-	{0xBFF1007FFFFFFFFE, 0xBC40},
-	{0xC34FFFFFFFFFF000, 0xFC00},
-	{0x419C15298BC4F4F9, 0x7C00},
-	{0xC34FFFFFFFFFF800, 0xFC00},
-	{0xA5DF7FFFFFFFFC00, 0x8000},
-	{0xC34FFFFFFFFFFC00, 0xFC00},
-	{0xC30892419BFFD00E, 0xFC00},
-	{0xC34FFFFFFFFFFE00, 0xFC00},
-	{0x3B30001000000FFF, 0x0000},
-	{0xC34FFFFFFFFFFF00, 0xFC00},
-	{0xB81000001FFFFFF7, 0x8000},
-	{0xC34FFFFFFFFFFF80, 0xFC00},
-	{0xB80FFEFFFFFFEFFF, 0x8000},
-	{0xC34FFFFFFFFFFFC0, 0xFC00},
-	{0xB810900000000000, 0x8000},
-	{0xC34FFFFFFFFFFFE0, 0xFC00},
-	{0xE4C0001EFFFFFFFE, 0xFC00},
-	{0xC34FFFFFFFFFFFF0, 0xFC00},
-	{0x3BBFFFFFFFF1FFFF, 0x0000},
-	{0xC34FFFFFFFFFFFF8, 0xFC00},
-	{0x3FC0020000FFFFFF, 0x3001},
-	{0xC34FFFFFFFFFFFFC, 0xFC00},
-	{0xC2FFFFFFFDFFF7FF, 0xFC00},
-	{0xC34FFFFFFFFFFFFE, 0xFC00},
-	{0xB800000020400000, 0x8000},
-	{0xC34FFFFFFFFFFFFF, 0xFC00},
-	{0xD80FDFFFFFFFFFF8, 0xFC00},
-	{0xC34FFFFFFFFFFFFD, 0xFC00},
-	{0x403FFFFFFFFBFF7F, 0x5000},
-	{0xC34FFFFFFFFFFFFB, 0xFC00},
-	{0x41E00FFFFFFFFFFB, 0x7C00},
-	{0xC34FFFFFFFFFFFF7, 0xFC00},
-	{0xC01FFFFFFFE01FFF, 0xC800},
-	{0xC34FFFFFFFFFFFEF, 0xFC00},
-	{0x3E9FFFFFFFFE0007, 0x0008},
-	{0xC34FFFFFFFFFFFDF, 0xFC00},
-	{0x44B0C65A12AFCD33, 0x7C00},
-	{0xC34FFFFFFFFFFFBF, 0xFC00},
-	{0xCB20000001FFFFFE, 0xFC00},
-	{0xC34FFFFFFFFFFF7F, 0xFC00},
-	{0x1F9FFC0000200000, 0x0000},
-	{0xC34FFFFFFFFFFEFF, 0xFC00},
-	{0x40200807FFFFFFFE, 0x4802},
-	{0xC34FFFFFFFFFFDFF, 0xFC00},
-	{0x3F5FFE0003FFFFFE, 0x1800},
-	{0xC34FFFFFFFFFFBFF, 0xFC00},
-	{0x41E75DF6CAC5A00F, 0x7C00},
-	{0xC34FFFFFFFFFF7FF, 0xFC00},
-	{0x41FFFFFFC0100000, 0x7C00},
-	{0xC34FFFFFFFFFEFFF, 0xFC00},
-	{0xC0325F2E1846FD48, 0xCC98},
-	{0xC34FFFFFFFFFDFFF, 0xFC00},
-	{0x37E10E5747073642, 0x0000},
-	{0xC34FFFFFFFFFBFFF, 0xFC00},
-	{0x3FFFFFFFFFFFFFDC, 0x4000},
-	{0xC34FFFFFFFFF7FFF, 0xFC00},
-	{0xA640FFFFFFFFFBFF, 0x8000},
-	{0xC34FFFFFFFFEFFFF, 0xFC00},
-	{0x3800001FFFFBFFFE, 0x0000},
-	{0xC34FFFFFFFFDFFFF, 0xFC00},
-	{0x558000000000000A, 0x7C00},
-	{0xC34FFFFFFFFBFFFF, 0xFC00},
-	{0xBC1000000000200F, 0x8000},
-	{0xC34FFFFFFFF7FFFF, 0xFC00},
-	{0x43D8DDA07A0F4DC9, 0x7C00},
-	{0xC34FFFFFFFEFFFFF, 0xFC00},
-	{0xBFF001000000001E, 0xBC00},
-	{0xC34FFFFFFFDFFFFF, 0xFC00},
-	{0x434F800000000040, 0x7C00},
-	{0xC34FFFFFFFBFFFFF, 0xFC00},
-	{0x381FFFE000000100, 0x0000},
-	{0xC34FFFFFFF7FFFFF, 0xFC00},
-	{0x38F00001FFFBFFFE, 0x0000},
-	{0xC34FFFFFFEFFFFFF, 0xFC00},
-	{0x37EFFEFFFFFBFFFF, 0x0000},
-	{0xC34FFFFFFDFFFFFF, 0xFC00},
-	{0xB80AF21DC2C19A9D, 0x8000},
-	{0xC34FFFFFFBFFFFFF, 0xFC00},
-	{0x43EFFDBFFFFFFFFE, 0x7C00},
-	{0xC34FFFFFF7FFFFFF, 0xFC00},
-	{0xC1C007FFFEFFFFFF, 0xFC00},
-	{0xC34FFFFFEFFFFFFF, 0xFC00},
-	{0x3FC083EC8BD66DB2, 0x3021},
-	{0xC34FFFFFDFFFFFFF, 0xFC00},
-	{0x3FF00000001FFFFF, 0x3C00},
-	{0xC34FFFFFBFFFFFFF, 0xFC00},
-	{0x996411E8FB847D23, 0x8000},
-	{0xC34FFFFF7FFFFFFF, 0xFC00},
-	{0xFFF400000000001F, 0xFF00},
-	{0xC34FFFFEFFFFFFFF, 0xFC00},
-	{0xBFEDFFFFEFFFFFFF, 0xBB80},
-	{0xC34FFFFDFFFFFFFF, 0xFC00},
-	{0x402FFFFBFFBFFFFE, 0x4C00},
-	{0xC34FFFFBFFFFFFFF, 0xFC00},
-	{0x002FD2FCF06E088C, 0x0000},
-	{0xC34FFFF7FFFFFFFF, 0xFC00},
-	{0xAD2FE0003FFFFFFF, 0x8000},
-	{0xC34FFFEFFFFFFFFF, 0xFC00},
-	{0xC030100003FFFFFF, 0xCC04},
-	{0xC34FFFDFFFFFFFFF, 0xFC00},
-	{0x403FFFFFBFFFF7FF, 0x5000},
-	{0xC34FFFBFFFFFFFFF, 0xFC00},
-	{0xBFF1A3F834F15D9D, 0xBC69},
-	{0xC34FFF7FFFFFFFFF, 0xFC00},
-	{0x0020003EFFFFFFFE, 0x0000},
-	{0xC34FFEFFFFFFFFFF, 0xFC00},
-	{0xC7E0006FFFFFFFFE, 0xFC00},
-	{0xC34FFDFFFFFFFFFF, 0xFC00},
-	{0x4024F0657FFDB0A6, 0x493C},
-	{0xC34FFBFFFFFFFFFF, 0xFC00},
-	{0x4800007FFEFFFFFE, 0x7C00},
-	{0xC34FF7FFFFFFFFFF, 0xFC00},
-	{0x1580003FFFFF7FFF, 0x0000},
-	{0xC34FEFFFFFFFFFFF, 0xFC00},
-	{0xC7E03BFFFFFFFFFF, 0xFC00},
-	{0xC34FDFFFFFFFFFFF, 0xFC00},
-	{0xC02FC000000FFFFF, 0xCBF0},
-	{0xC34FBFFFFFFFFFFF, 0xFC00},
-	{0x7FFFFF77FFFFFFFE, 0x7FFF},
-	{0xC34F7FFFFFFFFFFF, 0xFC00},
-	{0xBE1FF7FFFFFFBFFF, 0x8000},
-	{0xC34EFFFFFFFFFFFF, 0xFC00},
-	{0xB81EE593D338D567, 0x8000},
-	{0xC34DFFFFFFFFFFFF, 0xFC00},
-	{0xBDAFFFFFFFFFFFAF, 0x8000},
-	{0xC34BFFFFFFFFFFFF, 0xFC00},
-	{0xC8E000003FFFFDFF, 0xFC00},
-	{0xC347FFFFFFFFFFFF, 0xFC00},
-	{0x3C7FFFFFFFFDFF7F, 0x0000},
-	{0xC343FFFFFFFFFFFF, 0xFC00},
-	{0xC2E0000013FFFFFF, 0xFC00},
-	{0xC341FFFFFFFFFFFF, 0xFC00},
-	{0x46AEFFFFEFFFFFFE, 0x7C00},
-	{0xC340FFFFFFFFFFFF, 0xFC00},
-	{0x40CFFFFEFFFFFFFC, 0x7400},
-	{0xC3407FFFFFFFFFFF, 0xFC00},
-	{0x3FB9FFFFFFFFFFFF, 0x2E80},
-	{0xC3403FFFFFFFFFFF, 0xFC00},
-	{0xB80000800000FFFF, 0x8000},
-	{0xC3401FFFFFFFFFFF, 0xFC00},
-	{0x640FFFFFFFFFEFFF, 0x7C00},
-	{0xC3400FFFFFFFFFFF, 0xFC00},
-	{0xC1CFFFFFF0000FFE, 0xFC00},
-	{0xC34007FFFFFFFFFF, 0xFC00},
-	{0xC1D0000000000006, 0xFC00},
-	{0xC34003FFFFFFFFFF, 0xFC00},
-	{0x3FC0010000000040, 0x3000},
-	{0xC34001FFFFFFFFFF, 0xFC00},
-	{0xC34FFFFFFFFFE000, 0xFC00},
-	{0xC34000FFFFFFFFFF, 0xFC00},
-	{0xC00008001FFFFFFF, 0xC002},
-	{0xC340007FFFFFFFFF, 0xFC00},
-	{0xBF4FF7FFF7FFFFFE, 0x93FE},
-	{0xC340003FFFFFFFFF, 0xFC00},
-	{0x3FB01000001FFFFE, 0x2C04},
-	{0xC340001FFFFFFFFF, 0xFC00},
-	{0xC3F007FFFFFFC000, 0xFC00},
-	{0xC340000FFFFFFFFF, 0xFC00},
-	{0xC1D0000000FF0000, 0xFC00},
-	{0xC3400007FFFFFFFF, 0xFC00},
-	{0xC120008000000800, 0xFC00},
-	{0xC3400003FFFFFFFF, 0xFC00},
-	{0x7FDFFC0001FFFFFF, 0x7C00},
-	{0xC3400001FFFFFFFF, 0xFC00},
-	{0x43E4D58FBD2FBE6A, 0x7C00},
-	{0xC3400000FFFFFFFF, 0xFC00},
-	{0x3FE0000FFF800000, 0x3800},
-	{0xC34000007FFFFFFF, 0xFC00},
-	{0x4080000000000100, 0x6000},
-	{0xC34000003FFFFFFF, 0xFC00},
-	{0xC0CFFBFFFFFFBFFF, 0xF3FF},
-	{0xC34000001FFFFFFF, 0xFC00},
-	{0x4D900000040FFFFF, 0x7C00},
-	{0xC34000000FFFFFFF, 0xFC00},
-	{0x462FFFC0000FFFFF, 0x7C00},
-	{0xC340000007FFFFFF, 0xFC00},
-	{0xC7FBFFFFFFFFFFFC, 0xFC00},
-	{0xC340000003FFFFFF, 0xFC00},
-	{0x00207FF800000000, 0x0000},
-	{0xC340000001FFFFFF, 0xFC00},
-	{0x0E100800007FFFFF, 0x0000},
-	{0xC340000000FFFFFF, 0xFC00},
-	{0xC1D00000FFFFDFFF, 0xFC00},
-	{0xC3400000007FFFFF, 0xFC00},
-	{0x4F30000000000202, 0x7C00},
-	{0xC3400000003FFFFF, 0xFC00},
-	{0xC5CFFFDFFBFFFFFF, 0xFC00},
-	{0xC3400000001FFFFF, 0xFC00},
-	{0xC80FFFFFC00003FF, 0xFC00},
-	{0xC3400000000FFFFF, 0xFC00},
-	{0xA4DFFFFFFF8007FF, 0x8000},
-	{0xC34000000007FFFF, 0xFC00},
-	{0x002001FFFFFDFFFE, 0x0000},
-	{0xC34000000003FFFF, 0xFC00},
-	{0x376949635FB95C1B, 0x0000},
-	{0xC34000000001FFFF, 0xFC00},
-	{0x3FD0FFFFFFFDFFFF, 0x3440},
-	{0xC34000000000FFFF, 0xFC00},
-	{0x8020007FBFFFFFFE, 0x8000},
-	{0xC340000000007FFF, 0xFC00},
-	{0xC1E0107FFFFFFFFF, 0xFC00},
-	{0xC340000000003FFF, 0xFC00},
-	{0xBFD15A180FB355F7, 0xB457},
-	{0xC340000000001FFF, 0xFC00},
-	{0xC5002007FFFFFFFE, 0xFC00},
-	{0xC340000000000FFF, 0xFC00},
-	{0x9FA40000000003FF, 0x8000},
-	{0xC3400000000007FF, 0xFC00},
-	{0x434FFFFFFFFFFE7E, 0x7C00},
-	{0xC3400000000003FF, 0xFC00},
-	{0x4380010000000001, 0x7C00},
-	{0xC3400000000001FF, 0xFC00},
-	{0xC1E00004000003FE, 0xFC00},
-	{0xC3400000000000FF, 0xFC00},
-	{0xBFEFFEFFFFFFFFFC, 0xBC00},
-	{0xC34000000000007F, 0xFC00},
-	{0xAA9FFFFFFFFFF7EF, 0x8000},
-	{0xC34000000000003F, 0xFC00},
-	{0x430FFFFF000007FE, 0x7C00},
-	{0xC34000000000001F, 0xFC00},
-	{0xBFD00000000407FE, 0xB400},
-	{0xC34000000000000F, 0xFC00},
-	{0x00007F7FFFFFFFFE, 0x0000},
-	{0xC340000000000007, 0xFC00},
-	{0x380F7FFFFF7FFFFF, 0x0000},
-	{0xC340000000000003, 0xFC00},
-	{0x3FCFF00000000200, 0x33FC},
-	{0xC3C0000000000000, 0xFC00},
-	{0xC032625C81E7A43D, 0xCC99},
-	{0xC3C0000000000001, 0xFC00},
-	{0x64DFFFFFFFFC0100, 0x7C00},
-	{0xC3C0000000000002, 0xFC00},
-	{0xC6CFFFEFFFFFFBFE, 0xFC00},
-	{0xC3C0000000000004, 0xFC00},
-	{0xFFEDFFFFFFFFFFFD, 0xFC00},
-	{0xC3C0000000000008, 0xFC00},
-	{0xC3F90930351368CA, 0xFC00},
-	{0xC3C0000000000010, 0xFC00},
-	{0x000FFFFFFFFBFFBF, 0x0000},
-	{0xC3C0000000000020, 0xFC00},
-	{0x00107FFFFFFFFF00, 0x0000},
-	{0xC3C0000000000040, 0xFC00},
-	{0x40FFFFF800000FFF, 0x7C00},
-	{0xC3C0000000000080, 0xFC00},
-	{0x3CAFFFFFFF8000FF, 0x0000},
-	{0xC3C0000000000100, 0xFC00},
-	{0x37FFFFFFFFFFB7FE, 0x0000},
-	{0xC3C0000000000200, 0xFC00},
-	{0x3FBC001000000000, 0x2F00},
-	{0xC3C0000000000400, 0xFC00},
-	{0x41DF7FFFFFFFFBFF, 0x7C00},
-	{0xC3C0000000000800, 0xFC00},
-	{0x41C0010000004000, 0x7C00},
-	{0xC3C0000000001000, 0xFC00},
-	{0x3FE0000004000008, 0x3800},
-	{0xC3C0000000002000, 0xFC00},
-	{0x41E1800000000000, 0x7C00},
-	{0xC3C0000000004000, 0xFC00},
-	{0x407FF04000000000, 0x5FFC},
-	{0xC3C0000000008000, 0xFC00},
-	{0x3F0FFFFFF80007FF, 0x0400},
-	{0xC3C0000000010000, 0xFC00},
-	{0xBCA0000000020400, 0x8000},
-	{0xC3C0000000020000, 0xFC00},
-	{0xBCAE00000001FFFE, 0x8000},
-	{0xC3C0000000040000, 0xFC00},
-	{0x80100000001FFFFE, 0x8000},
-	{0xC3C0000000080000, 0xFC00},
-	{0xC3D0017FFFFFFFFF, 0xFC00},
-	{0xC3C0000000100000, 0xFC00},
-	{0x2FB0000000200020, 0x0000},
-	{0xC3C0000000200000, 0xFC00},
-	{0x403000001FFFFFFB, 0x4C00},
-	{0xC3C0000000400000, 0xFC00},
-	{0xB81E0000003FFFFE, 0x8000},
-	{0xC3C0000000800000, 0xFC00},
-	{0x37EDDFFFFFFFFFFE, 0x0000},
-	{0xC3C0000001000000, 0xFC00},
-	{0x7FFFFFFE0000007F, 0x7FFF},
-	{0xC3C0000002000000, 0xFC00},
-	{0x3FF896A15EBFF506, 0x3E26},
-	{0xC3C0000004000000, 0xFC00},
-	{0x43CFEFFFFFFFFFFE, 0x7C00},
-	{0xC3C0000008000000, 0xFC00},
-	{0x3E8807FFFFFFFFFE, 0x0003},
-	{0xC3C0000010000000, 0xFC00},
-	{0xB81EFFF7FFFFFFFE, 0x8000},
-	{0xC3C0000020000000, 0xFC00},
-	{0xBF983B2BD04E03BA, 0xA60F},
-	{0xC3C0000040000000, 0xFC00},
-	{0x41EFFFFFFFFFE001, 0x7C00},
-	{0xC3C0000080000000, 0xFC00},
-	{0x37E6EE20751A0C2A, 0x0000},
-	{0xC3C0000100000000, 0xFC00},
-	{0x99F0001FFFF00000, 0x8000},
-	{0xC3C0000200000000, 0xFC00},
-	{0x40200FFFFFFFFDFF, 0x4804},
-	{0xC3C0000400000000, 0xFC00},
-	{0x7FE37905487D1FF1, 0x7C00},
-	{0xC3C0000800000000, 0xFC00},
-	{0x3FEFF80000800000, 0x3BFE},
-	{0xC3C0001000000000, 0xFC00},
-	{0x42D00000010000FF, 0x7C00},
-	{0xC3C0002000000000, 0xFC00},
-	{0xC01000000081FFFE, 0xC400},
-	{0xC3C0004000000000, 0xFC00},
-	{0xBED01185D745A6EE, 0x8040},
-	{0xC3C0008000000000, 0xFC00},
-	{0x3FDFFFFFFFF8003F, 0x3800},
-	{0xC3C0010000000000, 0xFC00},
-	{0xBF3FFFFFE0000FFF, 0x9000},
-	{0xC3C0020000000000, 0xFC00},
-	{0xB493F7FFFFFFFFFE, 0x8000},
-	{0xC3C0040000000000, 0xFC00},
-	{0xBC6FFFFFFFBFEFFE, 0x8000},
-	{0xC3C0080000000000, 0xFC00},
-	{0x47F0000000080000, 0x7C00},
-	{0xC3C0100000000000, 0xFC00},
-	{0x00100007FFF7FFFF, 0x0000},
-	{0xC3C0200000000000, 0xFC00},
-	{0x3F60000008000000, 0x1800},
-	{0xC3C0400000000000, 0xFC00},
-	{0xBFBFFFFE00008000, 0xB000},
-	{0xC3C0800000000000, 0xFC00},
-	{0xC3C0400000020000, 0xFC00},
-	{0xC3C1000000000000, 0xFC00},
-	{0xC3F0000000FFFFFE, 0xFC00},
-	{0xC3C2000000000000, 0xFC00},
-	{0x412DBCED8A50EAB5, 0x7C00},
-	{0xC3C4000000000000, 0xFC00},
-	{0xFFF945ED483B155B, 0xFE51},
-	{0xC3C8000000000000, 0xFC00},
-	{0xC000000000020007, 0xC000},
-	{0xC3CC000000000000, 0xFC00},
-	{0x402E00000000003F, 0x4B80},
-	{0xC3CE000000000000, 0xFC00},
-	{0x381FFF7FFEFFFFFE, 0x0000},
-	{0xC3CF000000000000, 0xFC00},
-	{0x3FD007FFFFFFBFFE, 0x3402},
-	{0xC3CF800000000000, 0xFC00},
-	{0x40046F692F3A00DC, 0x411C},
-	{0xC3CFC00000000000, 0xFC00},
-	{0xC5AD3C3500D77064, 0xFC00},
-	{0xC3CFE00000000000, 0xFC00},
-	{0x7123FFFFFFFFFFFF, 0x7C00},
-	{0xC3CFF00000000000, 0xFC00},
-	{0xBFC7FFFFFFFFFFFB, 0xB200},
-	{0xC3CFF80000000000, 0xFC00},
-	{0x3CA0000000000806, 0x0000},
-	{0xC3CFFC0000000000, 0xFC00},
-	{0x00104000003FFFFF, 0x0000},
-	{0xC3CFFE0000000000, 0xFC00},
-	{0x37E925F773BED05E, 0x0000},
-	{0xC3CFFF0000000000, 0xFC00},
-	{0x3FB0001FFFFFFFF0, 0x2C00},
-	{0xC3CFFF8000000000, 0xFC00},
-	{0xBF1FFFF9FFFFFFFF, 0x8800},
-	{0xC3CFFFC000000000, 0xFC00},
-	{0x5A40FE8675DBB743, 0x7C00},
-	{0xC3CFFFE000000000, 0xFC00},
-	{0x4F2000000007FFDF, 0x7C00},
-	{0xC3CFFFF000000000, 0xFC00},
-	{0x39C45BFB0C46D469, 0x0000},
-	{0xC3CFFFF800000000, 0xFC00},
-	{0x03105658EA65F9FC, 0x0000},
-	{0xC3CFFFFC00000000, 0xFC00},
-	{0xBFE0020000000010, 0xB801},
-	{0xC3CFFFFE00000000, 0xFC00},
-	{0x384FFFFFFFDFEFFE, 0x0000},
-	{0xC3CFFFFF00000000, 0xFC00},
-	{0xC0300000000007FD, 0xCC00},
-	{0xC3CFFFFF80000000, 0xFC00},
-	{0x9F691908CEAA3D14, 0x8000},
-	{0xC3CFFFFFC0000000, 0xFC00},
-	{0x3FB0001FDFFFFFFF, 0x2C00},
-	{0xC3CFFFFFE0000000, 0xFC00},
-	{0xBFFFFFF81FFFFFFE, 0xC000},
-	{0xC3CFFFFFF0000000, 0xFC00},
-	{0xC02FFFFFFFFFFF84, 0xCC00},
-	{0xC3CFFFFFF8000000, 0xFC00},
-	{0xB80007FFFFBFFFFE, 0x8000},
-	{0xC3CFFFFFFC000000, 0xFC00},
-	{0xBFB08190E0B1D738, 0xAC20},
-	{0xC3CFFFFFFE000000, 0xFC00},
-	{0xB71003FFFE000000, 0x8000},
-	{0xC3CFFFFFFF000000, 0xFC00},
-	{0xFFF002003FFFFFFE, 0xFE00},
-	{0xC3CFFFFFFF800000, 0xFC00},
-	{0x002C25F9F84A691E, 0x0000},
-	{0xC3CFFFFFFFC00000, 0xFC00},
-	{0xC0D0000041000000, 0xF400},
-	{0xC3CFFFFFFFE00000, 0xFC00},
-	{0xBFA0001000200000, 0xA800},
-	{0xC3CFFFFFFFF00000, 0xFC00},
-	{0xC1FFFFFFFFF9FFFF, 0xFC00},
-	{0xC3CFFFFFFFF80000, 0xFC00},
-	{0xC5D003FFFFC00000, 0xFC00},
-	{0xC3CFFFFFFFFC0000, 0xFC00},
-	{0x42CE5DA14221CA50, 0x7C00},
-	{0xC3CFFFFFFFFE0000, 0xFC00},
-	{0xC0000F97B1895D83, 0xC004},
-	{0xC3CFFFFFFFFF0000, 0xFC00},
-	{0x402FFFF5FFFFFFFF, 0x4C00},
-	{0xC3CFFFFFFFFF8000, 0xFC00},
-	{0x47F344C8C36BFC3B, 0x7C00},
-	{0xC3CFFFFFFFFFC000, 0xFC00},
-	{0x47EFFFFBF7FFFFFF, 0x7C00},
-	{0xC3CFFFFFFFFFE000, 0xFC00},
-	{0xBADFFFFFFFFF9000, 0x8000},
-	{0xC3CFFFFFFFFFF000, 0xFC00},
-	{0x3FFFFFFFFFFFF400, 0x4000},
-	{0xC3CFFFFFFFFFF800, 0xFC00},
-	{0xBF2000007FFFF7FE, 0x8800},
-	{0xC3CFFFFFFFFFFC00, 0xFC00},
-	{0x2129AB6DCAD39D08, 0x0000},
-	{0xC3CFFFFFFFFFFE00, 0xFC00},
-	{0x402000020003FFFF, 0x4800},
-	{0xC3CFFFFFFFFFFF00, 0xFC00},
-	{0x8A2FFFDFFBFFFFFF, 0x8000},
-	{0xC3CFFFFFFFFFFF80, 0xFC00},
-	{0x40FFFFFFFFC00003, 0x7C00},
-	{0xC3CFFFFFFFFFFFC0, 0xFC00},
-	{0x4100003F7FFFFFFF, 0x7C00},
-	{0xC3CFFFFFFFFFFFE0, 0xFC00},
-	{0x3FEFFFFFFFC000FE, 0x3C00},
-	{0xC3CFFFFFFFFFFFF0, 0xFC00},
-	{0x43E9BA061069DADA, 0x7C00},
-	{0xC3CFFFFFFFFFFFF8, 0xFC00},
-	{0x0E1FFBFFFFFFFEFE, 0x0000},
-	{0xC3CFFFFFFFFFFFFC, 0xFC00},
-	{0x3FD00FB0FD4CF6C2, 0x3404},
-	{0xC3CFFFFFFFFFFFFE, 0xFC00},
-	{0xC02FFFFFFFF01FFE, 0xCC00},
-	{0xC3CFFFFFFFFFFFFF, 0xFC00},
-	{0x002FFFFDFFBFFFFF, 0x0000},
-	{0xC3CFFFFFFFFFFFFD, 0xFC00},
-	{0xC04000000000041F, 0xD000},
-	{0xC3CFFFFFFFFFFFFB, 0xFC00},
-	{0x38000000007C0000, 0x0000},
-	{0xC3CFFFFFFFFFFFF7, 0xFC00},
-	{0xC1EEFFFFF0000000, 0xFC00},
-	{0xC3CFFFFFFFFFFFEF, 0xFC00},
-	{0xB810000DFFFFFFFF, 0x8000},
-	{0xC3CFFFFFFFFFFFDF, 0xFC00},
-	{0x8B0FCF4CDB9F2179, 0x8000},
-	{0xC3CFFFFFFFFFFFBF, 0xFC00},
-	{0xC0AFFFFEFFFE0000, 0xEC00},
-	{0xC3CFFFFFFFFFFF7F, 0xFC00},
-	{0x41DE00001FFFFFFF, 0x7C00},
-	{0xC3CFFFFFFFFFFEFF, 0xFC00},
-	{0xBF6FFFFFFFFFFFFE, 0x9C00},
-	{0xC3CFFFFFFFFFFDFF, 0xFC00},
-	{0x7FFFC00007FFFFFF, 0x7FF0},
-	{0xC3CFFFFFFFFFFBFF, 0xFC00},
-	{0x4F5001FFFDFFFFFE, 0x7C00},
-	{0xC3CFFFFFFFFFF7FF, 0xFC00},
-	{0x7DB57D4B4BDFFA27, 0x7C00},
-	{0xC3CFFFFFFFFFEFFF, 0xFC00},
-	{0xB7E2B6A08EE31898, 0x8000},
-	{0xC3CFFFFFFFFFDFFF, 0xFC00},
-	{0x3FFFFF7FFFE00000, 0x4000},
-	{0xC3CFFFFFFFFFBFFF, 0xFC00},
-	{0x414FFFFE00000FFF, 0x7C00},
-	{0xC3CFFFFFFFFF7FFF, 0xFC00},
-	{0xB7FFFFE000080000, 0x8000},
-	{0xC3CFFFFFFFFEFFFF, 0xFC00},
-	{0x37F00000FFFDFFFF, 0x0000},
-	{0xC3CFFFFFFFFDFFFF, 0xFC00},
-	{0xBDC0000000100001, 0x8000},
-	{0xC3CFFFFFFFFBFFFF, 0xFC00},
-	{0xC13FFFFFDFFFE000, 0xFC00},
-	{0xC3CFFFFFFFF7FFFF, 0xFC00},
-	{0xBF2FFFBFFFFFFFEF, 0x8C00},
-	{0xC3CFFFFFFFEFFFFF, 0xFC00},
-	{0xB7E0001FFFFEFFFF, 0x8000},
-	{0xC3CFFFFFFFDFFFFF, 0xFC00},
-	{0xB7EFFDFFFFFBFFFE, 0x8000},
-	{0xC3CFFFFFFFBFFFFF, 0xFC00},
-	{0x8007488A0BEFE5B3, 0x8000},
-	{0xC3CFFFFFFF7FFFFF, 0xFC00},
-	{0x7FE0082000000000, 0x7C00},
-	{0xC3CFFFFFFEFFFFFF, 0xFC00},
-	{0x395CE2BFCB055217, 0x0000},
-	{0xC3CFFFFFFDFFFFFF, 0xFC00},
-	{0xC0BFFFFFFFFFFFFF, 0xF000},
-	{0xC3CFFFFFFBFFFFFF, 0xFC00},
-	{0xC7EFBFFFFFFFFFEF, 0xFC00},
-	{0xC3CFFFFFF7FFFFFF, 0xFC00},
-	{0xC789BC42903804BF, 0xFC00},
-	{0xC3CFFFFFEFFFFFFF, 0xFC00},
-	{0x3FBFC07FFFFFFFFF, 0x2FF0},
-	{0xC3CFFFFFDFFFFFFF, 0xFC00},
-	{0xBC9CF48BB12ACF03, 0x8000},
-	{0xC3CFFFFFBFFFFFFF, 0xFC00},
-	{0x460FFFFFFFFDC000, 0x7C00},
-	{0xC3CFFFFF7FFFFFFF, 0xFC00},
-	{0x46EFFFFFFFFFFFFE, 0x7C00},
-	{0xC3CFFFFEFFFFFFFF, 0xFC00},
-	{0x402000000003FFE0, 0x4800},
-	{0xC3CFFFFDFFFFFFFF, 0xFC00},
-	{0x438000001FFFFE00, 0x7C00},
-	{0xC3CFFFFBFFFFFFFF, 0xFC00},
-	{0xC3E000001FFFFFF8, 0xFC00},
-	{0xC3CFFFF7FFFFFFFF, 0xFC00},
-	{0xC1D00000003FFBFE, 0xFC00},
-	{0xC3CFFFEFFFFFFFFF, 0xFC00},
-	{0x0020000001FFDFFF, 0x0000},
-	{0xC3CFFFDFFFFFFFFF, 0xFC00},
-	{0xB7FFFFFDFFBFFFFE, 0x8000},
-	{0xC3CFFFBFFFFFFFFF, 0xFC00},
-	{0x3FBFFF7FFFFFFFFD, 0x3000},
-	{0xC3CFFF7FFFFFFFFF, 0xFC00},
-	{0xC34400000000001E, 0xFC00},
-	{0xC3CFFEFFFFFFFFFF, 0xFC00},
-	{0x43FFBFFFFFDFFFFF, 0x7C00},
-	{0xC3CFFDFFFFFFFFFF, 0xFC00},
-	{0xFFD66C637EDE31B4, 0xFC00},
-	{0xC3CFFBFFFFFFFFFF, 0xFC00},
-	{0x401BF7FFFFFFFFFF, 0x46FE},
-	{0xC3CFF7FFFFFFFFFF, 0xFC00},
-	{0x400FFE01FFFFFFFF, 0x4400},
-	{0xC3CFEFFFFFFFFFFF, 0xFC00},
-	{0xAF7FFFFFFFFF7FE0, 0x8000},
-	{0xC3CFDFFFFFFFFFFF, 0xFC00},
-	{0x8029768EF52FFFD7, 0x8000},
-	{0xC3CFBFFFFFFFFFFF, 0xFC00},
-	{0xB800000000017FFF, 0x8000},
-	{0xC3CF7FFFFFFFFFFF, 0xFC00},
-	{0x801FFFFFFF9FFFFF, 0x8000},
-	{0xC3CEFFFFFFFFFFFF, 0xFC00},
-	{0xC0EFFFFFFFFF800E, 0xFC00},
-	{0xC3CDFFFFFFFFFFFF, 0xFC00},
-	{0x43D56EF00BD98691, 0x7C00},
-	{0xC3CBFFFFFFFFFFFF, 0xFC00},
-	{0xBFBF000008000000, 0xAFC0},
-	{0xC3C7FFFFFFFFFFFF, 0xFC00},
-	{0x43C0007FFFFFFFFA, 0x7C00},
-	{0xC3C3FFFFFFFFFFFF, 0xFC00},
-	{0xC05FFFFFFE0003FF, 0xD800},
-	{0xC3C1FFFFFFFFFFFF, 0xFC00},
-	{0x7FD5B7789FA775DE, 0x7C00},
-	{0xC3C0FFFFFFFFFFFF, 0xFC00},
-	{0xC1DFEFFFFFBFFFFF, 0xFC00},
-	{0xC3C07FFFFFFFFFFF, 0xFC00},
-	{0x303FFFFFE00FFFFE, 0x0000},
-	{0xC3C03FFFFFFFFFFF, 0xFC00},
-	{0x3F00000000001EFE, 0x0200},
-	{0xC3C01FFFFFFFFFFF, 0xFC00},
-	{0x404FFFC003FFFFFF, 0x5400},
-	{0xC3C00FFFFFFFFFFF, 0xFC00},
-	{0x381FFFFFBFFFFF80, 0x0000},
-	{0xC3C007FFFFFFFFFF, 0xFC00},
-	{0xD7A00EFFFFFFFFFF, 0xFC00},
-	{0xC3C003FFFFFFFFFF, 0xFC00},
-	{0x7FE0FFFFFFFFFBFE, 0x7C00},
-	{0xC3C001FFFFFFFFFF, 0xFC00},
-	{0x3E900000FFFEFFFF, 0x0004},
-	{0xC3C000FFFFFFFFFF, 0xFC00},
-	{0xC623EF1B8E4BF4AD, 0xFC00},
-	{0xC3C0007FFFFFFFFF, 0xFC00},
-	{0x57DF7FFFFF7FFFFF, 0x7C00},
-	{0xC3C0003FFFFFFFFF, 0xFC00},
-	{0xC0003FFFEFFFFFFF, 0xC010},
-	{0xC3C0001FFFFFFFFF, 0xFC00},
-	{0x3CA0114D324E0DDB, 0x0000},
-	{0xC3C0000FFFFFFFFF, 0xFC00},
-	{0xCC1D42424A793321, 0xFC00},
-	{0xC3C00007FFFFFFFF, 0xFC00},
-	{0xC04FFFFFFFFFFF10, 0xD400},
-	{0xC3C00003FFFFFFFF, 0xFC00},
-	{0xC210000007FFEFFF, 0xFC00},
-	{0xC3C00001FFFFFFFF, 0xFC00},
-	{0x39DFC01FFFFFFFFF, 0x0000},
-	{0xC3C00000FFFFFFFF, 0xFC00},
-	{0xBFF4008000000000, 0xBD00},
-	{0xC3C000007FFFFFFF, 0xFC00},
-	{0x3DCFFFFFFFC01000, 0x0000},
-	{0xC3C000003FFFFFFF, 0xFC00},
-	{0xBFFBE00000000000, 0xBEF8},
-	{0xC3C000001FFFFFFF, 0xFC00},
-	{0xB7FFFFFFFFFF03FF, 0x8000},
-	{0xC3C000000FFFFFFF, 0xFC00},
-	{0xC04FFFFC00080000, 0xD400},
-	{0xC3C0000007FFFFFF, 0xFC00},
-	{0x4007FFFFFFFFFFBF, 0x4200},
-	{0xC3C0000003FFFFFF, 0xFC00},
-	{0xBFD000000000FFFD, 0xB400},
-	{0xC3C0000001FFFFFF, 0xFC00},
-	{0x7FFDFFFFFFEFFFFE, 0x7F7F},
-	{0xC3C0000000FFFFFF, 0xFC00},
-	{0x381FF7FFFFEFFFFE, 0x0000},
-	{0xC3C00000007FFFFF, 0xFC00},
-	{0xC2E00000001FFFFF, 0xFC00},
-	{0xC3C00000003FFFFF, 0xFC00},
-	{0x47E0000004000000, 0x7C00},
-	{0xC3C00000001FFFFF, 0xFC00},
-	{0x400FFFFFFFE0007F, 0x4400},
-	{0xC3C00000000FFFFF, 0xFC00},
-	{0x4B3FFFFBFFEFFFFF, 0x7C00},
-	{0xC3C000000007FFFF, 0xFC00},
-	{0x3800410000000000, 0x0000},
-	{0xC3C000000003FFFF, 0xFC00},
-	{0x43F878129585B971, 0x7C00},
-	{0xC3C000000001FFFF, 0xFC00},
-	{0xCE8FFFFFFFF7FFDE, 0xFC00},
-	{0xC3C000000000FFFF, 0xFC00},
-	{0x434203FFFFFFFFFE, 0x7C00},
-	{0xC3C0000000007FFF, 0xFC00},
-	{0x00000400000007FF, 0x0000},
-	{0xC3C0000000003FFF, 0xFC00},
-	{0xBB1004000001FFFE, 0x8000},
-	{0xC3C0000000001FFF, 0xFC00},
-	{0x4040000000000800, 0x5000},
-	{0xC3C0000000000FFF, 0xFC00},
-	{0x43400FFFBFFFFFFE, 0x7C00},
-	{0xC3C00000000007FF, 0xFC00},
-	{0x000FFFFFBFDFFFFF, 0x0000},
-	{0xC3C00000000003FF, 0xFC00},
-	{0xBFC2651C5381FB39, 0xB099},
-	{0xC3C00000000001FF, 0xFC00},
-	{0xB7A0000000000801, 0x8000},
-	{0xC3C00000000000FF, 0xFC00},
-	{0x4D306AF8750B6F4C, 0x7C00},
-	{0xC3C000000000007F, 0xFC00},
-	{0xC3EFFFFF7BFFFFFF, 0xFC00},
-	{0xC3C000000000003F, 0xFC00},
-	{0x801FFFFE80000000, 0x8000},
-	{0xC3C000000000001F, 0xFC00},
-	{0xC0247DE5BB2C57D8, 0xC91F},
-	{0xC3C000000000000F, 0xFC00},
-	{0x48000000003FFBFE, 0x7C00},
-	{0xC3C0000000000007, 0xFC00},
-	{0x381000000107FFFF, 0x0000},
-	{0xC3C0000000000003, 0xFC00},
-	{0xBCAFFFFFFFC1FFFF, 0x8000},
-	{0xC3D0000000000000, 0xFC00},
-	{0x2DA0800007FFFFFF, 0x0000},
-	{0xC3D0000000000001, 0xFC00},
-	{0x43DFFFFFFFFFFFAE, 0x7C00},
-	{0xC3D0000000000002, 0xFC00},
-	{0xC3C2B236095088DE, 0xFC00},
-	{0xC3D0000000000004, 0xFC00},
-	{0x3FB9BB14738A7593, 0x2E6F},
-	{0xC3D0000000000008, 0xFC00},
-	{0x3E262B8620039AA8, 0x0000},
-	{0xC3D0000000000010, 0xFC00},
-	{0x4D6FFFC000040000, 0x7C00},
-	{0xC3D0000000000020, 0xFC00},
-	{0xC34BA4678DDFF65D, 0xFC00},
-	{0xC3D0000000000040, 0xFC00},
-	{0xC070A84D58060F62, 0xDC2A},
-	{0xC3D0000000000080, 0xFC00},
-	{0xD10FFF80000007FE, 0xFC00},
-	{0xC3D0000000000100, 0xFC00},
-	{0x379FFFFFFFFFFDF8, 0x0000},
-	{0xC3D0000000000200, 0xFC00},
-	{0xBF6AF9FE69A9D5D0, 0x9ABE},
-	{0xC3D0000000000400, 0xFC00},
-	{0x001FFFFFBFFF7FFE, 0x0000},
-	{0xC3D0000000000800, 0xFC00},
-	{0xC02E0000000000FF, 0xCB80},
-	{0xC3D0000000001000, 0xFC00},
-	{0xC96FF7FFFFFFFFEE, 0xFC00},
-	{0xC3D0000000002000, 0xFC00},
-	{0x3E3FC00007FFFFFF, 0x0000},
-	{0xC3D0000000004000, 0xFC00},
-	{0x3E7FEFFFFFF7FFFF, 0x0002},
-	{0xC3D0000000008000, 0xFC00},
-	{0x801BC4748968541F, 0x8000},
-	{0xC3D0000000010000, 0xFC00},
-	{0x3F999F6FFC94CA55, 0x2668},
-	{0xC3D0000000020000, 0xFC00},
-	{0x4CEFFFFFFFFFE006, 0x7C00},
-	{0xC3D0000000040000, 0xFC00},
-	{0xC0308000000FFFFF, 0xCC20},
-	{0xC3D0000000080000, 0xFC00},
-	{0xC0200000FFFFFF7E, 0xC800},
-	{0xC3D0000000100000, 0xFC00},
-	{0xC8000FFFEFFFFFFF, 0xFC00},
-	{0xC3D0000000200000, 0xFC00},
-	{0xBE2FFF0FFFFFFFFF, 0x8000},
-	{0xC3D0000000400000, 0xFC00},
-	{0xC7E0000FFFFFFF7F, 0xFC00},
-	{0xC3D0000000800000, 0xFC00},
-	{0x3F62E05C5ED8F0E4, 0x18B8},
-	{0xC3D0000001000000, 0xFC00},
-	{0xB7E0000000203FFF, 0x8000},
-	{0xC3D0000002000000, 0xFC00},
-	{0x3CA7C4DD7FA93994, 0x0000},
-	{0xC3D0000004000000, 0xFC00},
-	{0xBFE3A7F7965E7D42, 0xB8EA},
-	{0xC3D0000008000000, 0xFC00},
-	{0xBB60000001080000, 0x8000},
-	{0xC3D0000010000000, 0xFC00},
-	{0x3FC0000000080010, 0x3000},
-	{0xC3D0000020000000, 0xFC00},
-	{0x2F8FFEF7FFFFFFFE, 0x0000},
-	{0xC3D0000040000000, 0xFC00},
-	{0xBFF1FFFFFF7FFFFF, 0xBC80},
-	{0xC3D0000080000000, 0xFC00},
-	{0x381FFFFDFFFFFBFF, 0x0000},
-	{0xC3D0000100000000, 0xFC00},
-	{0x480E1A56004111E1, 0x7C00},
-	{0xC3D0000200000000, 0xFC00},
-	{0xDBB000000000003E, 0xFC00},
-	{0xC3D0000400000000, 0xFC00},
-	{0x32474F5480EABB6A, 0x0000},
-	{0xC3D0000800000000, 0xFC00},
-	{0x740FFFF000000001, 0x7C00},
-	{0xC3D0001000000000, 0xFC00},
-	{0xC1DFFF87FFFFFFFF, 0xFC00},
-	{0xC3D0002000000000, 0xFC00},
-	{0xA3CFFEFFFFFF7FFE, 0x8000},
-	{0xC3D0004000000000, 0xFC00},
-	{0x3D2000000000BFFE, 0x0000},
-	{0xC3D0008000000000, 0xFC00},
-	{0xC0C00001FEFFFFFF, 0xF000},
-	{0xC3D0010000000000, 0xFC00},
-	{0x7FF5691456E2F3A8, 0x7F5A},
-	{0xC3D0020000000000, 0xFC00},
-	{0xBFAC3F75BB6208FB, 0xAB10},
-	{0xC3D0040000000000, 0xFC00},
-	{0xC3DFBFFFFFFFFFFD, 0xFC00},
-	{0xC3D0080000000000, 0xFC00},
-	{0xBFFFFFFF7FC00000, 0xC000},
-	{0xC3D0100000000000, 0xFC00},
-	{0x46F000000040001F, 0x7C00},
-	{0xC3D0200000000000, 0xFC00},
-	{0xC3FF547D43018880, 0xFC00},
-	{0xC3D0400000000000, 0xFC00},
-	{0x000080000FFFFFFE, 0x0000},
-	{0xC3D0800000000000, 0xFC00},
-	{0x3FB0000002000001, 0x2C00},
-	{0xC3D1000000000000, 0xFC00},
-	{0x3F003FFFFFFFF000, 0x0208},
-	{0xC3D2000000000000, 0xFC00},
-	{0x0C71FF7FFFFFFFFF, 0x0000},
-	{0xC3D4000000000000, 0xFC00},
-	{0x41C00040FFFFFFFE, 0x7C00},
-	{0xC3D8000000000000, 0xFC00},
-	{0x3FF89D1AABF04EF2, 0x3E27},
-	{0xC3DC000000000000, 0xFC00},
-	{0xBFCFF7FFDFFFFFFE, 0xB3FE},
-	{0xC3DE000000000000, 0xFC00},
-	{0xC3CFFFFFFFFFAFFF, 0xFC00},
-	{0xC3DF000000000000, 0xFC00},
-	{0x3FB0200000000008, 0x2C08},
-	{0xC3DF800000000000, 0xFC00},
-	{0xFFE00FFFFFF7FFFF, 0xFC00},
-	{0xC3DFC00000000000, 0xFC00},
-	{0xBFFFFFFFFFFC03FE, 0xC000},
-	{0xC3DFE00000000000, 0xFC00},
-	{0xB0707FFFFFFFFFFB, 0x8000},
-	{0xC3DFF00000000000, 0xFC00},
-	{0x3F20007FFFFFDFFE, 0x0800},
-	{0xC3DFF80000000000, 0xFC00},
-	{0x807FFFFFE00000FF, 0x8000},
-	{0xC3DFFC0000000000, 0xFC00},
-	{0x38100010007FFFFE, 0x0000},
-	{0xC3DFFE0000000000, 0xFC00},
-	{0x0000000004020000, 0x0000},
-	{0xC3DFFF0000000000, 0xFC00},
-	{0x41D346ABCA56756F, 0x7C00},
-	{0xC3DFFF8000000000, 0xFC00},
-	{0xDAD000000000FBFE, 0xFC00},
-	{0xC3DFFFC000000000, 0xFC00},
-	{0x3F80040200000000, 0x2001},
-	{0xC3DFFFE000000000, 0xFC00},
-	{0x12BFF000000001FE, 0x0000},
-	{0xC3DFFFF000000000, 0xFC00},
-	{0x43E008000001FFFF, 0x7C00},
-	{0xC3DFFFF800000000, 0xFC00},
-	{0x43D100000003FFFF, 0x7C00},
-	{0xC3DFFFFC00000000, 0xFC00},
-	{0x3CEEF3F7EB7D173C, 0x0000},
-	{0xC3DFFFFE00000000, 0xFC00},
-	{0xC02FFFFFFFFFFFFF, 0xCC00},
-	{0xC3DFFFFF00000000, 0xFC00},
-	{0x381FFFE000000000, 0x0000},
-	{0xC3DFFFFF80000000, 0xFC00},
-	{0xF5F000000007BFFE, 0xFC00},
-	{0xC3DFFFFFC0000000, 0xFC00},
-	{0x2E87DC71D546AC56, 0x0000},
-	{0xC3DFFFFFE0000000, 0xFC00},
-	{0x2214002000000000, 0x0000},
-	{0xC3DFFFFFF0000000, 0xFC00},
-	{0x41D3E420D9199998, 0x7C00},
-	{0xC3DFFFFFF8000000, 0xFC00},
-	{0x40300005FFFFFFFF, 0x4C00},
-	{0xC3DFFFFFFC000000, 0xFC00},
-	{0xC17FC00080000000, 0xFC00},
-	{0xC3DFFFFFFE000000, 0xFC00},
-	{0x3D70000000004003, 0x0000},
-	{0xC3DFFFFFFF000000, 0xFC00},
-	{0xC060000000001002, 0xD800},
-	{0xC3DFFFFFFF800000, 0xFC00},
-	{0xBED004000000003F, 0x8040},
-	{0xC3DFFFFFFFC00000, 0xFC00},
-	{0x43E07FFF7FFFFFFE, 0x7C00},
-	{0xC3DFFFFFFFE00000, 0xFC00},
-	{0xD2F5949605B93B1A, 0xFC00},
-	{0xC3DFFFFFFFF00000, 0xFC00},
-	{0x3EF4BF1F55EEFEF4, 0x014C},
-	{0xC3DFFFFFFFF80000, 0xFC00},
-	{0xB82FFBBFFFFFFFFF, 0x8000},
-	{0xC3DFFFFFFFFC0000, 0xFC00},
-	{0xC0300000003FDFFE, 0xCC00},
-	{0xC3DFFFFFFFFE0000, 0xFC00},
-	{0xCFF00011FFFFFFFE, 0xFC00},
-	{0xC3DFFFFFFFFF0000, 0xFC00},
-	{0xC3E000000007FEFE, 0xFC00},
-	{0xC3DFFFFFFFFF8000, 0xFC00},
-	{0xC000000002100000, 0xC000},
-	{0xC3DFFFFFFFFFC000, 0xFC00},
-	{0x380FFFFFFFFF00FF, 0x0000},
-	{0xC3DFFFFFFFFFE000, 0xFC00},
-	{0x5A15EDC409E269D5, 0x7C00},
-	{0xC3DFFFFFFFFFF000, 0xFC00},
-	{0xBFC3185C8700B8C1, 0xB0C6},
-	{0xC3DFFFFFFFFFF800, 0xFC00},
-	{0xBCA000000007FFE0, 0x8000},
-	{0xC3DFFFFFFFFFFC00, 0xFC00},
-	{0x47EC9FDCD73A7859, 0x7C00},
-	{0xC3DFFFFFFFFFFE00, 0xFC00},
-	{0xC3C020FFFFFFFFFF, 0xFC00},
-	{0xC3DFFFFFFFFFFF00, 0xFC00},
-	{0xBFF101FFFFFFFFFE, 0xBC40},
-	{0xC3DFFFFFFFFFFF80, 0xFC00},
-	{0x54F00FFFFFFF7FFF, 0x7C00},
-	{0xC3DFFFFFFFFFFFC0, 0xFC00},
-	{0x334FFFFFFFCFFFFF, 0x0000},
-	{0xC3DFFFFFFFFFFFE0, 0xFC00},
-	{0x40E128C635F4BEA8, 0x784A},
-	{0xC3DFFFFFFFFFFFF0, 0xFC00},
-	{0x3FD000007FFFF7FF, 0x3400},
-	{0xC3DFFFFFFFFFFFF8, 0xFC00},
-	{0xC01E2F284A09BB08, 0xC78C},
-	{0xC3DFFFFFFFFFFFFC, 0xFC00},
-	{0xFEBFFFFFFFFF8006, 0xFC00},
-	{0xC3DFFFFFFFFFFFFE, 0xFC00},
-	{0x3FE0000000000200, 0x3800},
-	{0xC3DFFFFFFFFFFFFF, 0xFC00},
-	{0x4DF000200000007F, 0x7C00},
-	{0xC3DFFFFFFFFFFFFD, 0xFC00},
-	{0x48000001BFFFFFFF, 0x7C00},
-	{0xC3DFFFFFFFFFFFFB, 0xFC00},
-	{0x7FF0001DFFFFFFFF, 0x7E00},
-	{0xC3DFFFFFFFFFFFF7, 0xFC00},
-	{0x0257FFFFFFFFFDFF, 0x0000},
-	{0xC3DFFFFFFFFFFFEF, 0xFC00},
-	{0x4802003FFFFFFFFF, 0x7C00},
-	{0xC3DFFFFFFFFFFFDF, 0xFC00},
-	{0x405CC7315144AAF4, 0x5732},
-	{0xC3DFFFFFFFFFFFBF, 0xFC00},
-	{0xC1EFFFFDFFFFFFEF, 0xFC00},
-	{0xC3DFFFFFFFFFFF7F, 0xFC00},
-	{0x0015743A450BA39C, 0x0000},
-	{0xC3DFFFFFFFFFFEFF, 0xFC00},
-	{0xC1DFFFFFBFBFFFFE, 0xFC00},
-	{0xC3DFFFFFFFFFFDFF, 0xFC00},
-	{0xBFBFFFFBDFFFFFFE, 0xB000},
-	{0xC3DFFFFFFFFFFBFF, 0xFC00},
-	{0x42DC0000FFFFFFFF, 0x7C00},
-	{0xC3DFFFFFFFFFF7FF, 0xFC00},
-	{0xB81000000000017E, 0x8000},
-	{0xC3DFFFFFFFFFEFFF, 0xFC00},
-	{0xBF20E9AC110C56E1, 0x883A},
-	{0xC3DFFFFFFFFFDFFF, 0xFC00},
-	{0x40100003FFFFFFFD, 0x4400},
-	{0xC3DFFFFFFFFFBFFF, 0xFC00},
-	{0xC02000000001FF80, 0xC800},
-	{0xC3DFFFFFFFFF7FFF, 0xFC00},
-	{0x79F7C6094B3295D6, 0x7C00},
-	{0xC3DFFFFFFFFEFFFF, 0xFC00},
-	{0x2C50000000000FFD, 0x0000},
-	{0xC3DFFFFFFFFDFFFF, 0xFC00},
-	{0x3FE89286A87B9F76, 0x3A25},
-	{0xC3DFFFFFFFFBFFFF, 0xFC00},
-	{0x402DFFFFFFFFFFEE, 0x4B80},
-	{0xC3DFFFFFFFF7FFFF, 0xFC00},
-	{0xC03313EBF2C7E9C1, 0xCCC5},
-	{0xC3DFFFFFFFEFFFFF, 0xFC00},
-	{0x8B0FE00000000400, 0x8000},
-	{0xC3DFFFFFFFDFFFFF, 0xFC00},
-	{0xC7F6CE8AB0533228, 0xFC00},
-	{0xC3DFFFFFFFBFFFFF, 0xFC00},
-	{0x41E438B2178F5F90, 0x7C00},
-	{0xC3DFFFFFFF7FFFFF, 0xFC00},
-	{0xC3C4FDD7EFDF5284, 0xFC00},
-	{0xC3DFFFFFFEFFFFFF, 0xFC00},
-	{0x3FCFFFDFFFFFFFF7, 0x3400},
-	{0xC3DFFFFFFDFFFFFF, 0xFC00},
-	{0xC3D2000000000003, 0xFC00},
-	{0xC3DFFFFFFBFFFFFF, 0xFC00},
-	{0x4C70001000000040, 0x7C00},
-	{0xC3DFFFFFF7FFFFFF, 0xFC00},
-	{0xBCC1A1CFC167DA1D, 0x8000},
-	{0xC3DFFFFFEFFFFFFF, 0xFC00},
-	{0xC15000000100007E, 0xFC00},
-	{0xC3DFFFFFDFFFFFFF, 0xFC00},
-	{0xC1CFFFFFFF0001FF, 0xFC00},
-	{0xC3DFFFFFBFFFFFFF, 0xFC00},
-	{0xCD7FDBFFFFFFFFFE, 0xFC00},
-	{0xC3DFFFFF7FFFFFFF, 0xFC00},
-	{0x3E1C8F100038BF5F, 0x0000},
-	{0xC3DFFFFEFFFFFFFF, 0xFC00},
-	{0xCDB0003FFFFFDFFF, 0xFC00},
-	{0xC3DFFFFDFFFFFFFF, 0xFC00},
-	{0x171FFFFDFFFFFE00, 0x0000},
-	{0xC3DFFFFBFFFFFFFF, 0xFC00},
-	{0xCD700000040000FE, 0xFC00},
-	{0xC3DFFFF7FFFFFFFF, 0xFC00},
-	{0xFFF0400000000002, 0xFE10},
-	{0xC3DFFFEFFFFFFFFF, 0xFC00},
-	{0xBC7001FFFFFFFF00, 0x8000},
-	{0xC3DFFFDFFFFFFFFF, 0xFC00},
-	{0x41FE0003FFFFFFFF, 0x7C00},
-	{0xC3DFFFBFFFFFFFFF, 0xFC00},
-	{0xC2FFFFFFFF7FDFFF, 0xFC00},
-	{0xC3DFFF7FFFFFFFFF, 0xFC00},
-	{0xC25F77D79394D2CB, 0xFC00},
-	{0xC3DFFEFFFFFFFFFF, 0xFC00},
-	{0xBF4803FFFFFFFFFE, 0x9201},
-	{0xC3DFFDFFFFFFFFFF, 0xFC00},
-	{0x3FB53285F0F1AAE7, 0x2D4D},
-	{0xC3DFFBFFFFFFFFFF, 0xFC00},
-	{0x3EAEDD90AE92C9BC, 0x000F},
-	{0xC3DFF7FFFFFFFFFF, 0xFC00},
-	{0xC17FFFF000007FFF, 0xFC00},
-	{0xC3DFEFFFFFFFFFFF, 0xFC00},
-	{0x49F000FFFFFFFFFD, 0x7C00},
-	{0xC3DFDFFFFFFFFFFF, 0xFC00},
-	{0x3FC1C1B2D60960C9, 0x3070},
-	{0xC3DFBFFFFFFFFFFF, 0xFC00},
-	{0xC3FFFFE1FFFFFFFF, 0xFC00},
-	{0xC3DF7FFFFFFFFFFF, 0xFC00},
-	{0x3FE1CA1E3EC9BD1D, 0x3873},
-	{0xC3DEFFFFFFFFFFFF, 0xFC00},
-	{0x3FAAD026900BCAA4, 0x2AB4},
-	{0xC3DDFFFFFFFFFFFF, 0xFC00},
-	{0x401FFFFFFDFFBFFF, 0x4800},
-	{0xC3DBFFFFFFFFFFFF, 0xFC00},
-	{0x3FDFFFFEFFE00000, 0x3800},
-	{0xC3D7FFFFFFFFFFFF, 0xFC00},
-	{0xB7E00000000005FF, 0x8000},
-	{0xC3D3FFFFFFFFFFFF, 0xFC00},
-	{0xC02000003FFFEFFF, 0xC800},
-	{0xC3D1FFFFFFFFFFFF, 0xFC00},
-	{0x7FFC000000003FFF, 0x7F00},
-	{0xC3D0FFFFFFFFFFFF, 0xFC00},
-	{0x3FD04001FFFFFFFF, 0x3410},
-	{0xC3D07FFFFFFFFFFF, 0xFC00},
-	{0xB800003FFFE00000, 0x8000},
-	{0xC3D03FFFFFFFFFFF, 0xFC00},
-	{0x43A00000002001FF, 0x7C00},
-	{0xC3D01FFFFFFFFFFF, 0xFC00},
-	{0x41F097FD5BAE7CA9, 0x7C00},
-	{0xC3D00FFFFFFFFFFF, 0xFC00},
-	{0x3FEF800000000100, 0x3BE0},
-	{0xC3D007FFFFFFFFFF, 0xFC00},
-	{0xC0E003FFFFEFFFFE, 0xF801},
-	{0xC3D003FFFFFFFFFF, 0xFC00},
-	{0x39C00007FEFFFFFE, 0x0000},
-	{0xC3D001FFFFFFFFFF, 0xFC00},
-	{0xBC03D874A0AE8440, 0x8000},
-	{0xC3D000FFFFFFFFFF, 0xFC00},
-	{0x403FFE7FFFFFFFFF, 0x5000},
-	{0xC3D0007FFFFFFFFF, 0xFC00},
-	{0xBFD0000000040020, 0xB400},
-	{0xC3D0003FFFFFFFFF, 0xFC00},
-	{0x43F0000010000000, 0x7C00},
-	{0xC3D0001FFFFFFFFF, 0xFC00},
-	{0xFCF436DDA389FC4C, 0xFC00},
-	{0xC3D0000FFFFFFFFF, 0xFC00},
-	{0xBFF000003FFEFFFF, 0xBC00},
-	{0xC3D00007FFFFFFFF, 0xFC00},
-	{0x9CAFF800007FFFFE, 0x8000},
-	{0xC3D00003FFFFFFFF, 0xFC00},
-	{0xEA8FFFFFE0000010, 0xFC00},
-	{0xC3D00001FFFFFFFF, 0xFC00},
-	{0xC02D1F6655376225, 0xCB48},
-	{0xC3D00000FFFFFFFF, 0xFC00},
-	{0xFFD00004FFFFFFFE, 0xFC00},
-	{0xC3D000007FFFFFFF, 0xFC00},
-	{0x169B624742B4D463, 0x0000},
-	{0xC3D000003FFFFFFF, 0xFC00},
-	{0x41DA9B0143585131, 0x7C00},
-	{0xC3D000001FFFFFFF, 0xFC00},
-	{0xC00FFFFFF5FFFFFF, 0xC400},
-	{0xC3D000000FFFFFFF, 0xFC00},
-	{0x3FB00020000007FE, 0x2C00},
-	{0xC3D0000007FFFFFF, 0xFC00},
-	{0x4E07E0BA4AC15169, 0x7C00},
-	{0xC3D0000003FFFFFF, 0xFC00},
-	{0x4DE00000027FFFFE, 0x7C00},
-	{0xC3D0000001FFFFFF, 0xFC00},
-	{0x72EFFD37DC89C1ED, 0x7C00},
-	{0xC3D0000000FFFFFF, 0xFC00},
-	{0xBB8000003FFFFFFF, 0x8000},
-	{0xC3D00000007FFFFF, 0xFC00},
-	{0xDDE8000000000008, 0xFC00},
-	{0xC3D00000003FFFFF, 0xFC00},
-	{0xBFAFC08000000000, 0xABF0},
-	{0xC3D00000001FFFFF, 0xFC00},
-	{0x4360800000000040, 0x7C00},
-	{0xC3D00000000FFFFF, 0xFC00},
-	{0xBE9FFFFFFFFFFFFE, 0x8008},
-	{0xC3D000000007FFFF, 0xFC00},
-	{0x4019C5B84001206E, 0x4671},
-	{0xC3D000000003FFFF, 0xFC00},
-	{0x7FDFFFFEFFFFFFF7, 0x7C00},
-	{0xC3D000000001FFFF, 0xFC00},
-	{0x82BFFFF000001FFE, 0x8000},
-	{0xC3D000000000FFFF, 0xFC00},
-	{0x43E00000007FEFFE, 0x7C00},
-	{0xC3D0000000007FFF, 0xFC00},
-	{0x43DFFFFFFBFFFFFC, 0x7C00},
-	{0xC3D0000000003FFF, 0xFC00},
-	{0x3FDFFFFFFBFFFFF7, 0x3800},
-	{0xC3D0000000001FFF, 0xFC00},
-	{0xBFD6F156B9CE3A96, 0xB5BC},
-	{0xC3D0000000000FFF, 0xFC00},
-	{0xC04F7FFFBFFFFFFF, 0xD3E0},
-	{0xC3D00000000007FF, 0xFC00},
-	{0x4000001FFEFFFFFF, 0x4000},
-	{0xC3D00000000003FF, 0xFC00},
-	{0xBFEB6E411051D768, 0xBADC},
-	{0xC3D00000000001FF, 0xFC00},
-	{0xC720000007FDFFFE, 0xFC00},
-	{0xC3D00000000000FF, 0xFC00},
-	{0x3FD0000000000803, 0x3400},
-	{0xC3D000000000007F, 0xFC00},
-	{0xBF9C9A4156D8D102, 0xA727},
-	{0xC3D000000000003F, 0xFC00},
-	{0xFFE0000000024000, 0xFC00},
-	{0xC3D000000000001F, 0xFC00},
-	{0x41C1DAD6CCEE4DDC, 0x7C00},
-	{0xC3D000000000000F, 0xFC00},
-	{0xC03FFFF801FFFFFF, 0xD000},
-	{0xC3D0000000000007, 0xFC00},
-	{0x41E000001F800000, 0x7C00},
-	{0xC3D0000000000003, 0xFC00},
-	{0x4FC0FFFFFFFFFE00, 0x7C00},
-	{0xC3E0000000000000, 0xFC00},
-	{0x400FFFFFFFFFE00F, 0x4400},
-	{0xC3E0000000000001, 0xFC00},
-	{0x4A9FFFF87FFFFFFF, 0x7C00},
-	{0xC3E0000000000002, 0xFC00},
-	{0x3CA000000001EFFF, 0x0000},
-	{0xC3E0000000000004, 0xFC00},
-	{0xC22E84097FF6882F, 0xFC00},
-	{0xC3E0000000000008, 0xFC00},
-	{0x431FFF0020000000, 0x7C00},
-	{0xC3E0000000000010, 0xFC00},
-	{0x41E3FFFEFFFFFFFE, 0x7C00},
-	{0xC3E0000000000020, 0xFC00},
-	{0xDA10000820000000, 0xFC00},
-	{0xC3E0000000000040, 0xFC00},
-	{0xBCA01FDFFFFFFFFF, 0x8000},
-	{0xC3E0000000000080, 0xFC00},
-	{0xFFEFFFFFEBFFFFFF, 0xFC00},
-	{0xC3E0000000000100, 0xFC00},
-	{0xC076806A02904498, 0xDDA0},
-	{0xC3E0000000000200, 0xFC00},
-	{0xBFFFFFFFFFFFFFDB, 0xC000},
-	{0xC3E0000000000400, 0xFC00},
-	{0xBFE59F3D31471644, 0xB968},
-	{0xC3E0000000000800, 0xFC00},
-	{0x0DAFFFFEFFFFBFFE, 0x0000},
-	{0xC3E0000000001000, 0xFC00},
-	{0x403FFFFFFF07FFFF, 0x5000},
-	{0xC3E0000000002000, 0xFC00},
-	{0xD51007FFFFFFBFFF, 0xFC00},
-	{0xC3E0000000004000, 0xFC00},
-	{0x7D5000007FBFFFFF, 0x7C00},
-	{0xC3E0000000008000, 0xFC00},
-	{0xBFECB2F7F961045F, 0xBB2D},
-	{0xC3E0000000010000, 0xFC00},
-	{0x3FFFFFFFFF3FFFFF, 0x4000},
-	{0xC3E0000000020000, 0xFC00},
-	{0xC03007FFBFFFFFFE, 0xCC02},
-	{0xC3E0000000040000, 0xFC00},
-	{0x401FFFFF80003FFE, 0x4800},
-	{0xC3E0000000080000, 0xFC00},
-	{0xC19FFFFFEFFFFC00, 0xFC00},
-	{0xC3E0000000100000, 0xFC00},
-	{0x4C8FC00200000000, 0x7C00},
-	{0xC3E0000000200000, 0xFC00},
-	{0x7FE00FFFFFFFFFFB, 0x7C00},
-	{0xC3E0000000400000, 0xFC00},
-	{0x3FE1FFFFFBFFFFFF, 0x3880},
-	{0xC3E0000000800000, 0xFC00},
-	{0x43CE506063F9E2FB, 0x7C00},
-	{0xC3E0000001000000, 0xFC00},
-	{0xC020000017FFFFFF, 0xC800},
-	{0xC3E0000002000000, 0xFC00},
-	{0x3F633589E80B4DDA, 0x18CD},
-	{0xC3E0000004000000, 0xFC00},
-	{0x3FC1000003FFFFFF, 0x3040},
-	{0xC3E0000008000000, 0xFC00},
-	{0xC1F0000000010FFF, 0xFC00},
-	{0xC3E0000010000000, 0xFC00},
-	{0x41DFFFFF80000FFE, 0x7C00},
-	{0xC3E0000020000000, 0xFC00},
-	{0x400CD48E7CA3D0B7, 0x4335},
-	{0xC3E0000040000000, 0xFC00},
-	{0x4B00000FFFFFF800, 0x7C00},
-	{0xC3E0000080000000, 0xFC00},
-	{0x38155AB2F1A48E15, 0x0000},
-	{0xC3E0000100000000, 0xFC00},
-	{0xC7FFFFFFC0800000, 0xFC00},
-	{0xC3E0000200000000, 0xFC00},
-	{0x3FA0000FFFFFF000, 0x2800},
-	{0xC3E0000400000000, 0xFC00},
-	{0x41ED3498575E1412, 0x7C00},
-	{0xC3E0000800000000, 0xFC00},
-	{0x66B00000000083FF, 0x7C00},
-	{0xC3E0001000000000, 0xFC00},
-	{0x8028F533E6B80BB0, 0x8000},
-	{0xC3E0002000000000, 0xFC00},
-	{0x3FE36E1649CC84CC, 0x38DC},
-	{0xC3E0004000000000, 0xFC00},
-	{0x3E5FFFFFFFFBFFFF, 0x0000},
-	{0xC3E0008000000000, 0xFC00},
-	{0x41DE000003FFFFFF, 0x7C00},
-	{0xC3E0010000000000, 0xFC00},
-	{0x76534636C3BEB918, 0x7C00},
-	{0xC3E0020000000000, 0xFC00},
-	{0x8D3FBFFFFFFFFBFE, 0x8000},
-	{0xC3E0040000000000, 0xFC00},
-	{0xC3DFFFFF00000006, 0xFC00},
-	{0xC3E0080000000000, 0xFC00},
-	{0x7FDE0000000000FF, 0x7C00},
-	{0xC3E0100000000000, 0xFC00},
-	{0x41DD7CF0B7C13492, 0x7C00},
-	{0xC3E0200000000000, 0xFC00},
-	{0x381000000003FFFE, 0x0000},
-	{0xC3E0400000000000, 0xFC00},
-	{0x3E3189D2547DB5B2, 0x0000},
-	{0xC3E0800000000000, 0xFC00},
-	{0xBFB0020000000004, 0xAC01},
-	{0xC3E1000000000000, 0xFC00},
-	{0x80003FFFFFFFF800, 0x8000},
-	{0xC3E2000000000000, 0xFC00},
-	{0xC00E6379D64AC689, 0xC399},
-	{0xC3E4000000000000, 0xFC00},
-	{0xBFB0200000000FFF, 0xAC08},
-	{0xC3E8000000000000, 0xFC00},
-	{0xE38000000000000F, 0xFC00},
-	{0xC3EC000000000000, 0xFC00},
-	{0xC3C0020000007FFF, 0xFC00},
-	{0xC3EE000000000000, 0xFC00},
-	{0xC0EFFFDF80000000, 0xFC00},
-	{0xC3EF000000000000, 0xFC00},
-	{0x5AAFE00000000007, 0x7C00},
-	{0xC3EF800000000000, 0xFC00},
-	{0x418FFFFE04000000, 0x7C00},
-	{0xC3EFC00000000000, 0xFC00},
-	{0x43FFFFE0003FFFFE, 0x7C00},
-	{0xC3EFE00000000000, 0xFC00},
-	{0xB9DFFFDFFFFFFF00, 0x8000},
-	{0xC3EFF00000000000, 0xFC00},
-	{0x3FD0000001FFFFFD, 0x3400},
-	{0xC3EFF80000000000, 0xFC00},
-	{0x56B7483F655A8A7C, 0x7C00},
-	{0xC3EFFC0000000000, 0xFC00},
-	{0x8010000000000003, 0x8000},
-	{0xC3EFFE0000000000, 0xFC00},
-	{0x48600000000000FE, 0x7C00},
-	{0xC3EFFF0000000000, 0xFC00},
-	{0xC3D00FFFBFFFFFFF, 0xFC00},
-	{0xC3EFFF8000000000, 0xFC00},
-	{0x3FDDDFFFFFFFFFFF, 0x3778},
-	{0xC3EFFFC000000000, 0xFC00},
-	{0xC2C07EFFFFFFFFFF, 0xFC00},
-	{0xC3EFFFE000000000, 0xFC00},
-	{0xB80FFFFFFFFDFDFE, 0x8000},
-	{0xC3EFFFF000000000, 0xFC00},
-	{0x4010400000FFFFFF, 0x4410},
-	{0xC3EFFFF800000000, 0xFC00},
-	{0x43FFFF0400000000, 0x7C00},
-	{0xC3EFFFFC00000000, 0xFC00},
-	{0x29F000FFFFEFFFFF, 0x0000},
-	{0xC3EFFFFE00000000, 0xFC00},
-	{0xBFC01FFFFDFFFFFF, 0xB008},
-	{0xC3EFFFFF00000000, 0xFC00},
-	{0xC7E50B07EC4149DC, 0xFC00},
-	{0xC3EFFFFF80000000, 0xFC00},
-	{0x3C89FCD92C50596C, 0x0000},
-	{0xC3EFFFFFC0000000, 0xFC00},
-	{0xC010048000000000, 0xC401},
-	{0xC3EFFFFFE0000000, 0xFC00},
-	{0x41F2065DE3E52F2F, 0x7C00},
-	{0xC3EFFFFFF0000000, 0xFC00},
-	{0x40B0000003FFFFFF, 0x6C00},
-	{0xC3EFFFFFF8000000, 0xFC00},
-	{0x41D248C6C4C9E9FC, 0x7C00},
-	{0xC3EFFFFFFC000000, 0xFC00},
-	{0xDB300003FEFFFFFE, 0xFC00},
-	{0xC3EFFFFFFE000000, 0xFC00},
-	{0xC3C00000107FFFFE, 0xFC00},
-	{0xC3EFFFFFFF000000, 0xFC00},
-	{0xC3B8000000000003, 0xFC00},
-	{0xC3EFFFFFFF800000, 0xFC00},
-	{0xC1C000000400001F, 0xFC00},
-	{0xC3EFFFFFFFC00000, 0xFC00},
-	{0xC3EF7C76B5F78D53, 0xFC00},
-	{0xC3EFFFFFFFE00000, 0xFC00},
-	{0x411001FFFFF00000, 0x7C00},
-	{0xC3EFFFFFFFF00000, 0xFC00},
-	{0x0027FFFFFFFFFF7E, 0x0000},
-	{0xC3EFFFFFFFF80000, 0xFC00},
-	{0xBFA00000013FFFFF, 0xA800},
-	{0xC3EFFFFFFFFC0000, 0xFC00},
-	{0xC1C0000801FFFFFF, 0xFC00},
-	{0xC3EFFFFFFFFE0000, 0xFC00},
-	{0xFFEFFFFFFF8FFFFF, 0xFC00},
-	{0xC3EFFFFFFFFF0000, 0xFC00},
-	{0x3CEFFFFFFFFFC100, 0x0000},
-	{0xC3EFFFFFFFFF8000, 0xFC00},
-	{0x400E3CBFA22599F9, 0x438F},
-	{0xC3EFFFFFFFFFC000, 0xFC00},
-	{0xBFFFFE0000000000, 0xC000},
-	{0xC3EFFFFFFFFFE000, 0xFC00},
-	{0xBFBD05600902F776, 0xAF41},
-	{0xC3EFFFFFFFFFF000, 0xFC00},
-	{0x441000010000000F, 0x7C00},
-	{0xC3EFFFFFFFFFF800, 0xFC00},
-	{0x7C503FFFFF7FFFFF, 0x7C00},
-	{0xC3EFFFFFFFFFFC00, 0xFC00},
-	{0x34AE69352D35F289, 0x0000},
-	{0xC3EFFFFFFFFFFE00, 0xFC00},
-	{0xFFDC0FFFFFFFFFFF, 0xFC00},
-	{0xC3EFFFFFFFFFFF00, 0xFC00},
-	{0x3FDFFFF003FFFFFE, 0x3800},
-	{0xC3EFFFFFFFFFFF80, 0xFC00},
-	{0xC3D8F74362D27A1E, 0xFC00},
-	{0xC3EFFFFFFFFFFFC0, 0xFC00},
-	{0x3FFFFF01FFFFFFFE, 0x4000},
-	{0xC3EFFFFFFFFFFFE0, 0xFC00},
-	{0xC03FFBFFFDFFFFFF, 0xCFFF},
-	{0xC3EFFFFFFFFFFFF0, 0xFC00},
-	{0x47F13B0AC51AE528, 0x7C00},
-	{0xC3EFFFFFFFFFFFF8, 0xFC00},
-	{0x40EFFF0FFFFFFFFE, 0x7C00},
-	{0xC3EFFFFFFFFFFFFC, 0xFC00},
-	{0x3F5FFFFC10000000, 0x1800},
-	{0xC3EFFFFFFFFFFFFE, 0xFC00},
-	{0x4004C20339DDD6F7, 0x4131},
-	{0xC3EFFFFFFFFFFFFF, 0xFC00},
-	{0xC0154FC49A6A7287, 0xC554},
-	{0xC3EFFFFFFFFFFFFD, 0xFC00},
-	{0x3CA00001FFFBFFFE, 0x0000},
-	{0xC3EFFFFFFFFFFFFB, 0xFC00},
-	{0x39EFC00000020000, 0x0000},
-	{0xC3EFFFFFFFFFFFF7, 0xFC00},
-	{0xC00F80000000000F, 0xC3E0},
-	{0xC3EFFFFFFFFFFFEF, 0xFC00},
-	{0x3FFF80FFFFFFFFFF, 0x3FE0},
-	{0xC3EFFFFFFFFFFFDF, 0xFC00},
-	{0xBFD27D6B1D34DCC5, 0xB49F},
-	{0xC3EFFFFFFFFFFFBF, 0xFC00},
-	{0xB4E53087CC3B42A1, 0x8000},
-	{0xC3EFFFFFFFFFFF7F, 0xFC00},
-	{0xC1DFFFFFFFF807FF, 0xFC00},
-	{0xC3EFFFFFFFFFFEFF, 0xFC00},
-	{0xBFCFFFFE0003FFFF, 0xB400},
-	{0xC3EFFFFFFFFFFDFF, 0xFC00},
-	{0xC7EFFFFF801FFFFE, 0xFC00},
-	{0xC3EFFFFFFFFFFBFF, 0xFC00},
-	{0xC09FFFFFFDEFFFFE, 0xE800},
-	{0xC3EFFFFFFFFFF7FF, 0xFC00},
-	{0x401040000001FFFE, 0x4410},
-	{0xC3EFFFFFFFFFEFFF, 0xFC00},
-	{0x43707903C127B6F6, 0x7C00},
-	{0xC3EFFFFFFFFFDFFF, 0xFC00},
-	{0x38B00FFFFFFFFFFF, 0x0000},
-	{0xC3EFFFFFFFFFBFFF, 0xFC00},
-	{0x312F860D399D9B04, 0x0000},
-	{0xC3EFFFFFFFFF7FFF, 0xFC00},
-	{0x380007FFFFFFFF7F, 0x0000},
-	{0xC3EFFFFFFFFEFFFF, 0xFC00},
-	{0x401000FDFFFFFFFE, 0x4400},
-	{0xC3EFFFFFFFFDFFFF, 0xFC00},
-	{0xBD4FF80000001FFE, 0x8000},
-	{0xC3EFFFFFFFFBFFFF, 0xFC00},
-	{0xC490000007FFFFC0, 0xFC00},
-	{0xC3EFFFFFFFF7FFFF, 0xFC00},
-	{0xC0100007FFFFFFBE, 0xC400},
-	{0xC3EFFFFFFFEFFFFF, 0xFC00},
-	{0xBFAFF10000000000, 0xABFC},
-	{0xC3EFFFFFFFDFFFFF, 0xFC00},
-	{0x47EDFFFFFFFBFFFE, 0x7C00},
-	{0xC3EFFFFFFFBFFFFF, 0xFC00},
-	{0x4260050000000000, 0x7C00},
-	{0xC3EFFFFFFF7FFFFF, 0xFC00},
-	{0x3FD0200000000003, 0x3408},
-	{0xC3EFFFFFFEFFFFFF, 0xFC00},
-	{0xFFFC4CF2D29682DB, 0xFF13},
-	{0xC3EFFFFFFDFFFFFF, 0xFC00},
-	{0x42E0000107FFFFFF, 0x7C00},
-	{0xC3EFFFFFFBFFFFFF, 0xFC00},
-	{0xC038BA0F644CE379, 0xCE2F},
-	{0xC3EFFFFFF7FFFFFF, 0xFC00},
-	{0xC1FDFFFFFFFFFFFE, 0xFC00},
-	{0xC3EFFFFFEFFFFFFF, 0xFC00},
-	{0xBFFFFF668490C314, 0xC000},
-	{0xC3EFFFFFDFFFFFFF, 0xFC00},
-	{0xC0000003FFFFFF80, 0xC000},
-	{0xC3EFFFFFBFFFFFFF, 0xFC00},
-	{0xBC4F80000000003F, 0x8000},
-	{0xC3EFFFFF7FFFFFFF, 0xFC00},
-	{0x400000FFFFDFFFFF, 0x4000},
-	{0xC3EFFFFEFFFFFFFF, 0xFC00},
-	{0x41F0000001FFFC00, 0x7C00},
-	{0xC3EFFFFDFFFFFFFF, 0xFC00},
-	{0xC00FFFC0001FFFFF, 0xC400},
-	{0xC3EFFFFBFFFFFFFF, 0xFC00},
-	{0x37E519D0CA3D7BC9, 0x0000},
-	{0xC3EFFFF7FFFFFFFF, 0xFC00},
-	{0x3B5FFFFBFFFDFFFF, 0x0000},
-	{0xC3EFFFEFFFFFFFFF, 0xFC00},
-	{0x3FF0000800000FFF, 0x3C00},
-	{0xC3EFFFDFFFFFFFFF, 0xFC00},
-	{0xC0DFFFFB7FFFFFFF, 0xF800},
-	{0xC3EFFFBFFFFFFFFF, 0xFC00},
-	{0xBFC0000000000000, 0xB000},
-	{0xC3EFFF7FFFFFFFFF, 0xFC00},
-	{0x40307FFFFFFEFFFF, 0x4C20},
-	{0xC3EFFEFFFFFFFFFF, 0xFC00},
-	{0xC3DFFFFFF8003FFF, 0xFC00},
-	{0xC3EFFDFFFFFFFFFF, 0xFC00},
-	{0x3800000000403FFF, 0x0000},
-	{0xC3EFFBFFFFFFFFFF, 0xFC00},
-	{0x3FDFFFC01FFFFFFF, 0x3800},
-	{0xC3EFF7FFFFFFFFFF, 0xFC00},
-	{0x000000000010000F, 0x0000},
-	{0xC3EFEFFFFFFFFFFF, 0xFC00},
-	{0xBF883E95F0E4E693, 0xA210},
-	{0xC3EFDFFFFFFFFFFF, 0xFC00},
-	{0x404FFFFFFFFF80FF, 0x5400},
-	{0xC3EFBFFFFFFFFFFF, 0xFC00},
-	{0xB7FFEFFFFFFFFF7F, 0x8000},
-	{0xC3EF7FFFFFFFFFFF, 0xFC00},
-	{0x3F8F800001FFFFFF, 0x23E0},
-	{0xC3EEFFFFFFFFFFFF, 0xFC00},
-	{0x40AFFBFF7FFFFFFE, 0x6BFF},
-	{0xC3EDFFFFFFFFFFFF, 0xFC00},
-	{0x3F79074A21DB04F7, 0x1E42},
-	{0xC3EBFFFFFFFFFFFF, 0xFC00},
-	{0xBFD81D6D3396D21B, 0xB607},
-	{0xC3E7FFFFFFFFFFFF, 0xFC00},
-	{0xC00FFFFF7DFFFFFE, 0xC400},
-	{0xC3E3FFFFFFFFFFFF, 0xFC00},
-	{0xBB3F0003FFFFFFFF, 0x8000},
-	{0xC3E1FFFFFFFFFFFF, 0xFC00},
-	{0x40C3FFFFFFFFFF7F, 0x7100},
-	{0xC3E0FFFFFFFFFFFF, 0xFC00},
-	{0x3F7001000000FFFF, 0x1C00},
-	{0xC3E07FFFFFFFFFFF, 0xFC00},
-	{0xBAB000007FFBFFFF, 0x8000},
-	{0xC3E03FFFFFFFFFFF, 0xFC00},
-	{0x4800000040007FFF, 0x7C00},
-	{0xC3E01FFFFFFFFFFF, 0xFC00},
-	{0x401FFFDFBFFFFFFE, 0x4800},
-	{0xC3E00FFFFFFFFFFF, 0xFC00},
-	{0xC1EFFFFFFB7FFFFF, 0xFC00},
-	{0xC3E007FFFFFFFFFF, 0xFC00},
-	{0x3FDEA062B29B7640, 0x37A8},
-	{0xC3E003FFFFFFFFFF, 0xFC00},
-	{0xBE7801B65E65F5D0, 0x8002},
-	{0xC3E001FFFFFFFFFF, 0xFC00},
-	{0x7FD967600F8CB2FE, 0x7C00},
-	{0xC3E000FFFFFFFFFF, 0xFC00},
-	{0x41DD2F24152241BC, 0x7C00},
-	{0xC3E0007FFFFFFFFF, 0xFC00},
-	{0xC1C30F3381A70205, 0xFC00},
-	{0xC3E0003FFFFFFFFF, 0xFC00},
-	{0x22201FBFFFFFFFFF, 0x0000},
-	{0xC3E0001FFFFFFFFF, 0xFC00},
-	{0x000422C9D95EF20C, 0x0000},
-	{0xC3E0000FFFFFFFFF, 0xFC00},
-	{0xC8080000000007FE, 0xFC00},
-	{0xC3E00007FFFFFFFF, 0xFC00},
-	{0x3E2FFFFFFFFF8002, 0x0000},
-	{0xC3E00003FFFFFFFF, 0xFC00},
-	{0xC3CFEFFFFFFFFF80, 0xFC00},
-	{0xC3E00001FFFFFFFF, 0xFC00},
-	{0xB253A45585FC70C5, 0x8000},
-	{0xC3E00000FFFFFFFF, 0xFC00},
-	{0x403F000200000000, 0x4FC0},
-	{0xC3E000007FFFFFFF, 0xFC00},
-	{0x4010000000000000, 0x4400},
-	{0xC3E000003FFFFFFF, 0xFC00},
-	{0xC7F1F57D40562156, 0xFC00},
-	{0xC3E000001FFFFFFF, 0xFC00},
-	{0xC341FFEFFFFFFFFF, 0xFC00},
-	{0xC3E000000FFFFFFF, 0xFC00},
-	{0xBFCC264FBB919C4A, 0xB30A},
-	{0xC3E0000007FFFFFF, 0xFC00},
-	{0x3FC0004000FFFFFF, 0x3000},
-	{0xC3E0000003FFFFFF, 0xFC00},
-	{0xBFC000800001FFFF, 0xB000},
-	{0xC3E0000001FFFFFF, 0xFC00},
-	{0x7FE0FFFF80000000, 0x7C00},
-	{0xC3E0000000FFFFFF, 0xFC00},
-	{0xC13FFFFFFFFBF000, 0xFC00},
-	{0xC3E00000007FFFFF, 0xFC00},
-	{0x403635269C4916E7, 0x4D8D},
-	{0xC3E00000003FFFFF, 0xFC00},
-	{0xC1C0020000000400, 0xFC00},
-	{0xC3E00000001FFFFF, 0xFC00},
-	{0x40300200000000FE, 0x4C01},
-	{0xC3E00000000FFFFF, 0xFC00},
-	{0xC05FEFFFFFFFFFFD, 0xD7FC},
-	{0xC3E000000007FFFF, 0xFC00},
-	{0x402001FFFFFF7FFE, 0x4800},
-	{0xC3E000000003FFFF, 0xFC00},
-	{0xC1D0000400003FFF, 0xFC00},
-	{0xC3E000000001FFFF, 0xFC00},
-	{0x41000000000002FF, 0x7C00},
-	{0xC3E000000000FFFF, 0xFC00},
-	{0x3CA73543D2F5B9F6, 0x0000},
-	{0xC3E0000000007FFF, 0xFC00},
-	{0x47F00001003FFFFE, 0x7C00},
-	{0xC3E0000000003FFF, 0xFC00},
-	{0x389B7BF7D6D04305, 0x0000},
-	{0xC3E0000000001FFF, 0xFC00},
-	{0xC03ABAB3F05C9AC9, 0xCEAF},
-	{0xC3E0000000000FFF, 0xFC00},
-	{0x001000003FFFFFFD, 0x0000},
-	{0xC3E00000000007FF, 0xFC00},
-	{0x33D00080001FFFFF, 0x0000},
-	{0xC3E00000000003FF, 0xFC00},
-	{0x3FF0000000010001, 0x3C00},
-	{0xC3E00000000001FF, 0xFC00},
-	{0x20EFFFFEFFFFBFFF, 0x0000},
-	{0xC3E00000000000FF, 0xFC00},
-	{0xC1D0000000400007, 0xFC00},
-	{0xC3E000000000007F, 0xFC00},
-	{0xC1CAD525F3C1A2B0, 0xFC00},
-	{0xC3E000000000003F, 0xFC00},
-	{0x3FC00000FFFF7FFF, 0x3000},
-	{0xC3E000000000001F, 0xFC00},
-	{0x8010004000000FFE, 0x8000},
-	{0xC3E000000000000F, 0xFC00},
-	{0x43CB1F74EAF954FE, 0x7C00},
-	{0xC3E0000000000007, 0xFC00},
-	{0x41DA5390B09E10D4, 0x7C00},
-	{0xC3E0000000000003, 0xFC00},
-	{0x3130000000000000, 0x0000},
-	{0xC3F0000000000000, 0xFC00},
-	{0xC34CDD0178993E1A, 0xFC00},
-	{0xC3F0000000000001, 0xFC00},
-	{0xE0E0000007F80000, 0xFC00},
-	{0xC3F0000000000002, 0xFC00},
-	{0x001100003FFFFFFF, 0x0000},
-	{0xC3F0000000000004, 0xFC00},
-	{0x4808000000100000, 0x7C00},
-	{0xC3F0000000000008, 0xFC00},
-	{0x381000001001FFFE, 0x0000},
-	{0xC3F0000000000010, 0xFC00},
-	{0xBFC00000000040FE, 0xB000},
-	{0xC3F0000000000020, 0xFC00},
-	{0xC0000007FFFFFDFE, 0xC000},
-	{0xC3F0000000000040, 0xFC00},
-	{0xC0506D1917F9868B, 0xD41B},
-	{0xC3F0000000000080, 0xFC00},
-	{0x35D040000FFFFFFE, 0x0000},
-	{0xC3F0000000000100, 0xFC00},
-	{0x3FBFFFFFFBFFFFF0, 0x3000},
-	{0xC3F0000000000200, 0xFC00},
-	{0xC57F000200000000, 0xFC00},
-	{0xC3F0000000000400, 0xFC00},
-	{0xBF8001000000007F, 0xA000},
-	{0xC3F0000000000800, 0xFC00},
-	{0x4009B1DD17FF427A, 0x426C},
-	{0xC3F0000000001000, 0xFC00},
-	{0x400FFFFFFFDFDFFE, 0x4400},
-	{0xC3F0000000002000, 0xFC00},
-	{0x3FD8C807F58054CD, 0x3632},
-	{0xC3F0000000004000, 0xFC00},
-	{0x7BFFFFFBFFFEFFFF, 0x7C00},
-	{0xC3F0000000008000, 0xFC00},
-	{0xB81F800020000000, 0x8000},
-	{0xC3F0000000010000, 0xFC00},
-	{0xC00D77988643BCA0, 0xC35E},
-	{0xC3F0000000020000, 0xFC00},
-	{0xCFDC00007FFFFFFF, 0xFC00},
-	{0xC3F0000000040000, 0xFC00},
-	{0x3FAFFFF800000800, 0x2C00},
-	{0xC3F0000000080000, 0xFC00},
-	{0xC030001FFFFFFFEF, 0xCC00},
-	{0xC3F0000000100000, 0xFC00},
-	{0xA2CFC7392721AEB2, 0x8000},
-	{0xC3F0000000200000, 0xFC00},
-	{0xFFDFFFFDFFFC0000, 0xFC00},
-	{0xC3F0000000400000, 0xFC00},
-	{0xFFEB84AAEC48ED09, 0xFC00},
-	{0xC3F0000000800000, 0xFC00},
-	{0x35D0001FFFFFFF7E, 0x0000},
-	{0xC3F0000001000000, 0xFC00},
-	{0xB850003FFBFFFFFF, 0x8000},
-	{0xC3F0000002000000, 0xFC00},
-	{0x421FFFFFFFFFFF87, 0x7C00},
-	{0xC3F0000004000000, 0xFC00},
-	{0x3F8003FFFFFFFFFF, 0x2001},
-	{0xC3F0000008000000, 0xFC00},
-	{0x47FE0000000001FF, 0x7C00},
-	{0xC3F0000010000000, 0xFC00},
-	{0xBFCC0000000001FE, 0xB300},
-	{0xC3F0000020000000, 0xFC00},
-	{0x43DFFFFFFFFFFF7E, 0x7C00},
-	{0xC3F0000040000000, 0xFC00},
-	{0xC7E00100001FFFFF, 0xFC00},
-	{0xC3F0000080000000, 0xFC00},
-	{0xBC043FFFFFFFFFFE, 0x8000},
-	{0xC3F0000100000000, 0xFC00},
-	{0x4030040003FFFFFE, 0x4C01},
-	{0xC3F0000200000000, 0xFC00},
-	{0xFFDCA0ED80CD5624, 0xFC00},
-	{0xC3F0000400000000, 0xFC00},
-	{0xBD881120AF3F095B, 0x8000},
-	{0xC3F0000800000000, 0xFC00},
-	{0xBF8FFFFFFF000007, 0xA400},
-	{0xC3F0001000000000, 0xFC00},
-	{0xC040000020001000, 0xD000},
-	{0xC3F0002000000000, 0xFC00},
-	{0x800FFFFFFFFFF006, 0x8000},
-	{0xC3F0004000000000, 0xFC00},
-	{0x3FFFC00000000FFF, 0x3FF0},
-	{0xC3F0008000000000, 0xFC00},
-	{0xC04BFFFF7FFFFFFE, 0xD300},
-	{0xC3F0010000000000, 0xFC00},
-	{0x3F8FFFFFFBFFFFDF, 0x2400},
-	{0xC3F0020000000000, 0xFC00},
-	{0x47FFFFFFFFFFDFDF, 0x7C00},
-	{0xC3F0040000000000, 0xFC00},
-	{0xBFBFFF0400000000, 0xB000},
-	{0xC3F0080000000000, 0xFC00},
-	{0x400FFC0000FFFFFF, 0x43FF},
-	{0xC3F0100000000000, 0xFC00},
-	{0x3F9F800000FFFFFF, 0x27E0},
-	{0xC3F0200000000000, 0xFC00},
-	{0xC03000DFFFFFFFFF, 0xCC00},
-	{0xC3F0400000000000, 0xFC00},
-	{0xC1E87D5B158A7E5F, 0xFC00},
-	{0xC3F0800000000000, 0xFC00},
-	{0xB9CFFFF800007FFF, 0x8000},
-	{0xC3F1000000000000, 0xFC00},
-	{0xB7E3DBC5AB01CAC6, 0x8000},
-	{0xC3F2000000000000, 0xFC00},
-	{0xC760000001007FFE, 0xFC00},
-	{0xC3F4000000000000, 0xFC00},
-	{0x411FFFFFFE3FFFFE, 0x7C00},
-	{0xC3F8000000000000, 0xFC00},
-	{0x001000800000FFFE, 0x0000},
-	{0xC3FC000000000000, 0xFC00},
-	{0xBD2FFFFFFFFF0001, 0x8000},
-	{0xC3FE000000000000, 0xFC00},
-	{0x3DE0000088000000, 0x0000},
-	{0xC3FF000000000000, 0xFC00},
-	{0x43CFFFFFFFFE01FE, 0x7C00},
-	{0xC3FF800000000000, 0xFC00},
-	{0xB7E0000003FFFFF6, 0x8000},
-	{0xC3FFC00000000000, 0xFC00},
-	{0xC02FFFE0000003FE, 0xCC00},
-	{0xC3FFE00000000000, 0xFC00},
-	{0xB66FFFFFEFFF7FFF, 0x8000},
-	{0xC3FFF00000000000, 0xFC00},
-	{0x40D007FFF7FFFFFF, 0x7402},
-	{0xC3FFF80000000000, 0xFC00},
-	{0x402FB858CFC3D265, 0x4BEE},
-	{0xC3FFFC0000000000, 0xFC00},
-	{0xDDDFD1486B686DD9, 0xFC00},
-	{0xC3FFFE0000000000, 0xFC00},
-	{0xBFCFFFFFFFFFFE1F, 0xB400},
-	{0xC3FFFF0000000000, 0xFC00},
-	{0xBE318ED1A19C270B, 0x8000},
-	{0xC3FFFF8000000000, 0xFC00},
-	{0xBFDEBBEAACD410C3, 0xB7AF},
-	{0xC3FFFFC000000000, 0xFC00},
-	{0x7FEFEFFEFFFFFFFE, 0x7C00},
-	{0xC3FFFFE000000000, 0xFC00},
-	{0x41DFFFFFFFFFFFC0, 0x7C00},
-	{0xC3FFFFF000000000, 0xFC00},
-	{0xC3FFF0000001FFFF, 0xFC00},
-	{0xC3FFFFF800000000, 0xFC00},
-	{0xC03C552C30A7341A, 0xCF15},
-	{0xC3FFFFFC00000000, 0xFC00},
-	{0x7FD0000010FFFFFF, 0x7C00},
-	{0xC3FFFFFE00000000, 0xFC00},
-	{0x2960000008000004, 0x0000},
-	{0xC3FFFFFF00000000, 0xFC00},
-	{0xBF8FFFFFFFFFFC00, 0xA400},
-	{0xC3FFFFFF80000000, 0xFC00},
-	{0x97D0001000000007, 0x8000},
-	{0xC3FFFFFFC0000000, 0xFC00},
-	{0xC1CFFFFFFFF9FFFF, 0xFC00},
-	{0xC3FFFFFFE0000000, 0xFC00},
-	{0x41E8B2FDE6D2CA0D, 0x7C00},
-	{0xC3FFFFFFF0000000, 0xFC00},
-	{0x6D6017FFFFFFFFFF, 0x7C00},
-	{0xC3FFFFFFF8000000, 0xFC00},
-	{0x001FFFFFFD000000, 0x0000},
-	{0xC3FFFFFFFC000000, 0xFC00},
-	{0xC1CFFFFFF800FFFE, 0xFC00},
-	{0xC3FFFFFFFE000000, 0xFC00},
-	{0x7FDFFE01FFFFFFFF, 0x7C00},
-	{0xC3FFFFFFFF000000, 0xFC00},
-	{0x6C8606E0670927F6, 0x7C00},
-	{0xC3FFFFFFFF800000, 0xFC00},
-	{0x41C5B2C9165836FE, 0x7C00},
-	{0xC3FFFFFFFFC00000, 0xFC00},
-	{0x41D001FC00000000, 0x7C00},
-	{0xC3FFFFFFFFE00000, 0xFC00},
-	{0x2DFFFDFDFFFFFFFF, 0x0000},
-	{0xC3FFFFFFFFF00000, 0xFC00},
-	{0xC01972398F95970F, 0xC65D},
-	{0xC3FFFFFFFFF80000, 0xFC00},
-	{0xC01A749AFD187B21, 0xC69D},
-	{0xC3FFFFFFFFFC0000, 0xFC00},
-	{0x41F281ED48F52E4D, 0x7C00},
-	{0xC3FFFFFFFFFE0000, 0xFC00},
-	{0x37FFE00000001FFF, 0x0000},
-	{0xC3FFFFFFFFFF0000, 0xFC00},
-	{0xC0F13B61C9FF586B, 0xFC00},
-	{0xC3FFFFFFFFFF8000, 0xFC00},
-	{0xC3D0800004000000, 0xFC00},
-	{0xC3FFFFFFFFFFC000, 0xFC00},
-	{0x85A000000001FFFB, 0x8000},
-	{0xC3FFFFFFFFFFE000, 0xFC00},
-	{0x4060000040000040, 0x5800},
-	{0xC3FFFFFFFFFFF000, 0xFC00},
-	{0x41E00000017FFFFF, 0x7C00},
-	{0xC3FFFFFFFFFFF800, 0xFC00},
-	{0x47D00000000003FF, 0x7C00},
-	{0xC3FFFFFFFFFFFC00, 0xFC00},
-	{0xC1D00000FFFFFFFE, 0xFC00},
-	{0xC3FFFFFFFFFFFE00, 0xFC00},
-	{0x4D571722D09C6477, 0x7C00},
-	{0xC3FFFFFFFFFFFF00, 0xFC00},
-	{0x8B5FFFFFFFFFF806, 0x8000},
-	{0xC3FFFFFFFFFFFF80, 0xFC00},
-	{0x0750000420000000, 0x0000},
-	{0xC3FFFFFFFFFFFFC0, 0xFC00},
-	{0xBFE000000000FBFF, 0xB800},
-	{0xC3FFFFFFFFFFFFE0, 0xFC00},
-	{0x3ED5920A1B6BF9B0, 0x0056},
-	{0xC3FFFFFFFFFFFFF0, 0xFC00},
-	{0x39000001DFFFFFFF, 0x0000},
-	{0xC3FFFFFFFFFFFFF8, 0xFC00},
-	{0xBFA000000001FF00, 0xA800},
-	{0xC3FFFFFFFFFFFFFC, 0xFC00},
-	{0x43454FFBA3AEFE2C, 0x7C00},
-	{0xC3FFFFFFFFFFFFFE, 0xFC00},
-	{0x3FDCA79DB9D7E3F6, 0x372A},
-	{0xC3FFFFFFFFFFFFFF, 0xFC00},
-	{0xBFFFFFFFF7FFFFDF, 0xC000},
-	{0xC3FFFFFFFFFFFFFD, 0xFC00},
-	{0xBF90000000082000, 0xA400},
-	{0xC3FFFFFFFFFFFFFB, 0xFC00},
-	{0xBFFFFEFFFFFFFFFC, 0xC000},
-	{0xC3FFFFFFFFFFFFF7, 0xFC00},
-	{0xB7E00000000FFF7F, 0x8000},
-	{0xC3FFFFFFFFFFFFEF, 0xFC00},
-	{0xC01DB51F57EE8E37, 0xC76D},
-	{0xC3FFFFFFFFFFFFDF, 0xFC00},
-	{0xA0101FFFFE000000, 0x8000},
-	{0xC3FFFFFFFFFFFFBF, 0xFC00},
-	{0xC01E000000003FFF, 0xC780},
-	{0xC3FFFFFFFFFFFF7F, 0xFC00},
-	{0x480FF0000007FFFF, 0x7C00},
-	{0xC3FFFFFFFFFFFEFF, 0xFC00},
-	{0x5FBFFFFFE0000000, 0x7C00},
-	{0xC3FFFFFFFFFFFDFF, 0xFC00},
-	{0xC00E065EA00C8BD8, 0xC382},
-	{0xC3FFFFFFFFFFFBFF, 0xFC00},
-	{0x46DFFFFFFDFFFFDE, 0x7C00},
-	{0xC3FFFFFFFFFFF7FF, 0xFC00},
-	{0xC0B1000000000000, 0xEC40},
-	{0xC3FFFFFFFFFFEFFF, 0xFC00},
-	{0x0C9362B712569ACC, 0x0000},
-	{0xC3FFFFFFFFFFDFFF, 0xFC00},
-	{0xC1F000200003FFFF, 0xFC00},
-	{0xC3FFFFFFFFFFBFFF, 0xFC00},
-	{0x403C000000001000, 0x4F00},
-	{0xC3FFFFFFFFFF7FFF, 0xFC00},
-	{0xC09B2AC0A7A459B5, 0xE6CB},
-	{0xC3FFFFFFFFFEFFFF, 0xFC00},
-	{0x7FF8BABD6FA1CF4F, 0x7E2E},
-	{0xC3FFFFFFFFFDFFFF, 0xFC00},
-	{0x7FD003FFFFFFFFFF, 0x7C00},
-	{0xC3FFFFFFFFFBFFFF, 0xFC00},
-	{0xC960201FFFFFFFFF, 0xFC00},
-	{0xC3FFFFFFFFF7FFFF, 0xFC00},
-	{0xBFE058A9411E403C, 0xB816},
-	{0xC3FFFFFFFFEFFFFF, 0xFC00},
-	{0x41DEFFFFFFFF7FFF, 0x7C00},
-	{0xC3FFFFFFFFDFFFFF, 0xFC00},
-	{0x43FCBF8BA3E48776, 0x7C00},
-	{0xC3FFFFFFFFBFFFFF, 0xFC00},
-	{0xBFBFFEFDFFFFFFFE, 0xB000},
-	{0xC3FFFFFFFF7FFFFF, 0xFC00},
-	{0xB80EFFFFFFFFFBFF, 0x8000},
-	{0xC3FFFFFFFEFFFFFF, 0xFC00},
-	{0x7FDFDFF7FFFFFFFF, 0x7C00},
-	{0xC3FFFFFFFDFFFFFF, 0xFC00},
-	{0x41FE4ED77A5BC76B, 0x7C00},
-	{0xC3FFFFFFFBFFFFFF, 0xFC00},
-	{0xB810100000000020, 0x8000},
-	{0xC3FFFFFFF7FFFFFF, 0xFC00},
-	{0x4108000008000000, 0x7C00},
-	{0xC3FFFFFFEFFFFFFF, 0xFC00},
-	{0x23C84301AF70B624, 0x0000},
-	{0xC3FFFFFFDFFFFFFF, 0xFC00},
-	{0x38001FDFFFFFFFFF, 0x0000},
-	{0xC3FFFFFFBFFFFFFF, 0xFC00},
-	{0x43F00000003FFFFE, 0x7C00},
-	{0xC3FFFFFF7FFFFFFF, 0xFC00},
-	{0x2CD7FFFFFF800000, 0x0000},
-	{0xC3FFFFFEFFFFFFFF, 0xFC00},
-	{0xBFCFBFFFFFFFF000, 0xB3F0},
-	{0xC3FFFFFDFFFFFFFF, 0xFC00},
-	{0x43D000010000FFFF, 0x7C00},
-	{0xC3FFFFFBFFFFFFFF, 0xFC00},
-	{0x40ED38743CCFAEC3, 0x7B4E},
-	{0xC3FFFFF7FFFFFFFF, 0xFC00},
-	{0xD4A1AE9B3C4A922F, 0xFC00},
-	{0xC3FFFFEFFFFFFFFF, 0xFC00},
-	{0x40CFDFFFFFFFFFBF, 0x73F8},
-	{0xC3FFFFDFFFFFFFFF, 0xFC00},
-	{0xFFEF73BD4D913BA4, 0xFC00},
-	{0xC3FFFFBFFFFFFFFF, 0xFC00},
-	{0x3E00800000003FFE, 0x0000},
-	{0xC3FFFF7FFFFFFFFF, 0xFC00},
-	{0xB39D33BFD6E5C7A6, 0x8000},
-	{0xC3FFFEFFFFFFFFFF, 0xFC00},
-	{0xC4B0000000060000, 0xFC00},
-	{0xC3FFFDFFFFFFFFFF, 0xFC00},
-	{0x3F1915F0316E79C8, 0x0645},
-	{0xC3FFFBFFFFFFFFFF, 0xFC00},
-	{0x403FFFFE01000000, 0x5000},
-	{0xC3FFF7FFFFFFFFFF, 0xFC00},
-	{0xC16F800FFFFFFFFE, 0xFC00},
-	{0xC3FFEFFFFFFFFFFF, 0xFC00},
-	{0xBF1D7D7BACE3A236, 0x875F},
-	{0xC3FFDFFFFFFFFFFF, 0xFC00},
-	{0xBDAFFDFFFFFFE000, 0x8000},
-	{0xC3FFBFFFFFFFFFFF, 0xFC00},
-	{0xB7F200547694CA5F, 0x8000},
-	{0xC3FF7FFFFFFFFFFF, 0xFC00},
-	{0xC3CFFFFFFFC0001F, 0xFC00},
-	{0xC3FEFFFFFFFFFFFF, 0xFC00},
-	{0x3FF0800000000040, 0x3C20},
-	{0xC3FDFFFFFFFFFFFF, 0xFC00},
-	{0xBF5FDFFFFFFFFEFF, 0x97F8},
-	{0xC3FBFFFFFFFFFFFF, 0xFC00},
-	{0x406AD42903FA8ACE, 0x5AB5},
-	{0xC3F7FFFFFFFFFFFF, 0xFC00},
-	{0x3FF001000003FFFF, 0x3C00},
-	{0xC3F3FFFFFFFFFFFF, 0xFC00},
-	{0x4017FB7B55C6C951, 0x45FF},
-	{0xC3F1FFFFFFFFFFFF, 0xFC00},
-	{0x0026BABDBB3E83CA, 0x0000},
-	{0xC3F0FFFFFFFFFFFF, 0xFC00},
-	{0xBD50200000000007, 0x8000},
-	{0xC3F07FFFFFFFFFFF, 0xFC00},
-	{0xC030FFD096DE0482, 0xCC40},
-	{0xC3F03FFFFFFFFFFF, 0xFC00},
-	{0x38101FFFFBFFFFFE, 0x0000},
-	{0xC3F01FFFFFFFFFFF, 0xFC00},
-	{0x41ED82C56CCEA9FC, 0x7C00},
-	{0xC3F00FFFFFFFFFFF, 0xFC00},
-	{0x801FDFFE00000000, 0x8000},
-	{0xC3F007FFFFFFFFFF, 0xFC00},
-	{0xC3F7FFFE00000000, 0xFC00},
-	{0xC3F003FFFFFFFFFF, 0xFC00},
-	{0xBFD007FFFDFFFFFE, 0xB402},
-	{0xC3F001FFFFFFFFFF, 0xFC00},
-	{0xFFF40000000003FE, 0xFF00},
-	{0xC3F000FFFFFFFFFF, 0xFC00},
-	{0x7FF0000000040008, 0x7E00},
-	{0xC3F0007FFFFFFFFF, 0xFC00},
-	{0xC00B53F3E0A5C617, 0xC2D5},
-	{0xC3F0003FFFFFFFFF, 0xFC00},
-	{0x43E000000FFFFF00, 0x7C00},
-	{0xC3F0001FFFFFFFFF, 0xFC00},
-	{0xC3CFA1AFEBD1CD19, 0xFC00},
-	{0xC3F0000FFFFFFFFF, 0xFC00},
-	{0x3FFEFFFDFFFFFFFF, 0x3FC0},
-	{0xC3F00007FFFFFFFF, 0xFC00},
-	{0xC801000000800000, 0xFC00},
-	{0xC3F00003FFFFFFFF, 0xFC00},
-	{0x45B203FFFFFFFFFF, 0x7C00},
-	{0xC3F00001FFFFFFFF, 0xFC00},
-	{0x3CA0040000000006, 0x0000},
-	{0xC3F00000FFFFFFFF, 0xFC00},
-	{0xB81BFFFFFFFFDFFF, 0x8000},
-	{0xC3F000007FFFFFFF, 0xFC00},
-	{0xBFB0001FFFDFFFFE, 0xAC00},
-	{0xC3F000003FFFFFFF, 0xFC00},
-	{0xBFAFFF00000007FE, 0xAC00},
-	{0xC3F000001FFFFFFF, 0xFC00},
-	{0x3FC0800003FFFFFF, 0x3020},
-	{0xC3F000000FFFFFFF, 0xFC00},
-	{0x403FFFFFFFF00020, 0x5000},
-	{0xC3F0000007FFFFFF, 0xFC00},
-	{0x3F8FFFF7FFFEFFFF, 0x2400},
-	{0xC3F0000003FFFFFF, 0xFC00},
-	{0x001F7FFFFFFFE000, 0x0000},
-	{0xC3F0000001FFFFFF, 0xFC00},
-	{0xC030000000043FFF, 0xCC00},
-	{0xC3F0000000FFFFFF, 0xFC00},
-	{0x149FFFEFFFFFFFF7, 0x0000},
-	{0xC3F00000007FFFFF, 0xFC00},
-	{0x47EBDFFFFFFFFFFF, 0x7C00},
-	{0xC3F00000003FFFFF, 0xFC00},
-	{0xC800000040000200, 0xFC00},
-	{0xC3F00000001FFFFF, 0xFC00},
-	{0xC01C97635128CB66, 0xC726},
-	{0xC3F00000000FFFFF, 0xFC00},
-	{0xC69FFFFF003FFFFF, 0xFC00},
-	{0xC3F000000007FFFF, 0xFC00},
-	{0xFFD4000000007FFE, 0xFC00},
-	{0xC3F000000003FFFF, 0xFC00},
-	{0x3CF54944D756A4D8, 0x0000},
-	{0xC3F000000001FFFF, 0xFC00},
-	{0x3FC0000002000007, 0x3000},
-	{0xC3F000000000FFFF, 0xFC00},
-	{0xBFC0000004000FFE, 0xB000},
-	{0xC3F0000000007FFF, 0xFC00},
-	{0xB5702000000000FF, 0x8000},
-	{0xC3F0000000003FFF, 0xFC00},
-	{0xBE4FFFFFFFFC0000, 0x8000},
-	{0xC3F0000000001FFF, 0xFC00},
-	{0xFFDFFFFFEFFBFFFE, 0xFC00},
-	{0xC3F0000000000FFF, 0xFC00},
-	{0x40719BCB11B3D331, 0x5C67},
-	{0xC3F00000000007FF, 0xFC00},
-	{0x41CFFFFFF1FFFFFF, 0x7C00},
-	{0xC3F00000000003FF, 0xFC00},
-	{0x38094B75BBDD7C0F, 0x0000},
-	{0xC3F00000000001FF, 0xFC00},
-	{0xBFCFFFFFFBFFFEFE, 0xB400},
-	{0xC3F00000000000FF, 0xFC00},
-	{0x43EFE3FC976054F6, 0x7C00},
-	{0xC3F000000000007F, 0xFC00},
-	{0x8C600001EFFFFFFE, 0x8000},
-	{0xC3F000000000003F, 0xFC00},
-	{0x401FEFFFFFFFFFF8, 0x47FC},
-	{0xC3F000000000001F, 0xFC00},
-	{0x4010FBFFFFFFFFFF, 0x443F},
-	{0xC3F000000000000F, 0xFC00},
-	{0x00000001DFFFFFFE, 0x0000},
-	{0xC3F0000000000007, 0xFC00},
-	{0x41C9BA0242070256, 0x7C00},
-	{0xC3F0000000000003, 0xFC00},
-	{0x41574A1CDA5022C6, 0x7C00},
-	{0xC7E0000000000000, 0xFC00},
-	{0xBFD0000400000000, 0xB400},
-	{0xC7E0000000000001, 0xFC00},
-	{0xBA3000FFFFFFF7FF, 0x8000},
-	{0xC7E0000000000002, 0xFC00},
-	{0x890FFC0000000080, 0x8000},
-	{0xC7E0000000000004, 0xFC00},
-	{0xFFF889C3CADBF0EA, 0xFE22},
-	{0xC7E0000000000008, 0xFC00},
-	{0xC06FFEFFFFFFFFBF, 0xDC00},
-	{0xC7E0000000000010, 0xFC00},
-	{0x43CFFFFFDFFFFFEF, 0x7C00},
-	{0xC7E0000000000020, 0xFC00},
-	{0x3FBEA6D103E97ED5, 0x2FAA},
-	{0xC7E0000000000040, 0xFC00},
-	{0x3DED78BE5CBD643E, 0x0000},
-	{0xC7E0000000000080, 0xFC00},
-	{0xBFC0004002000000, 0xB000},
-	{0xC7E0000000000100, 0xFC00},
-	{0x40D034B12AC34D8A, 0x740D},
-	{0xC7E0000000000200, 0xFC00},
-	{0x3FC000FFFFFFFFFF, 0x3000},
-	{0xC7E0000000000400, 0xFC00},
-	{0x47FC7363E67C24F4, 0x7C00},
-	{0xC7E0000000000800, 0xFC00},
-	{0xC1AFF08000000000, 0xFC00},
-	{0xC7E0000000001000, 0xFC00},
-	{0x3D5858493E9899E3, 0x0000},
-	{0xC7E0000000002000, 0xFC00},
-	{0x41EBAE1B401AB3A6, 0x7C00},
-	{0xC7E0000000004000, 0xFC00},
-	{0x406FFFFF0FFFFFFF, 0x5C00},
-	{0xC7E0000000008000, 0xFC00},
-	{0xC00000000100001F, 0xC000},
-	{0xC7E0000000010000, 0xFC00},
-	{0xC0215A92840DA64F, 0xC857},
-	{0xC7E0000000020000, 0xFC00},
-	{0x400FF0007FFFFFFE, 0x43FC},
-	{0xC7E0000000040000, 0xFC00},
-	{0xC561FFFFFFC00000, 0xFC00},
-	{0xC7E0000000080000, 0xFC00},
-	{0xBC70000100000001, 0x8000},
-	{0xC7E0000000100000, 0xFC00},
-	{0x7FE9000000000000, 0x7C00},
-	{0xC7E0000000200000, 0xFC00},
-	{0x37EFFFFF800000FE, 0x0000},
-	{0xC7E0000000400000, 0xFC00},
-	{0x3CEFC00020000000, 0x0000},
-	{0xC7E0000000800000, 0xFC00},
-	{0x3FC2000FFFFFFFFF, 0x3080},
-	{0xC7E0000001000000, 0xFC00},
-	{0x38100000000007BF, 0x0000},
-	{0xC7E0000002000000, 0xFC00},
-	{0xB7F8080000000000, 0x8000},
-	{0xC7E0000004000000, 0xFC00},
-	{0xC3DE00000000001F, 0xFC00},
-	{0xC7E0000008000000, 0xFC00},
-	{0x4029A8B3335EC176, 0x4A6A},
-	{0xC7E0000010000000, 0xFC00},
-	{0x4000FFFFEFFFFFFE, 0x4040},
-	{0xC7E0000020000000, 0xFC00},
-	{0xC028000000000004, 0xCA00},
-	{0xC7E0000040000000, 0xFC00},
-	{0x31A43FEBD1A39B81, 0x0000},
-	{0xC7E0000080000000, 0xFC00},
-	{0x3FBFFFFFFFBFC000, 0x3000},
-	{0xC7E0000100000000, 0xFC00},
-	{0xBFEFFFFFFFFFFDFF, 0xBC00},
-	{0xC7E0000200000000, 0xFC00},
-	{0x41C000080000007F, 0x7C00},
-	{0xC7E0000400000000, 0xFC00},
-	{0x7FF0000000400100, 0x7E00},
-	{0xC7E0000800000000, 0xFC00},
-	{0xBF000000010000FF, 0x8200},
-	{0xC7E0001000000000, 0xFC00},
-	{0x52E021FFFFFFFFFF, 0x7C00},
-	{0xC7E0002000000000, 0xFC00},
-	{0xC3F3FFFFF8000000, 0xFC00},
-	{0xC7E0004000000000, 0xFC00},
-	{0x37FFFFFFBFFFEFFF, 0x0000},
-	{0xC7E0008000000000, 0xFC00},
-	{0x4471B3D6AD97DD7C, 0x7C00},
-	{0xC7E0010000000000, 0xFC00},
-	{0x6AA00000000803FF, 0x7C00},
-	{0xC7E0020000000000, 0xFC00},
-	{0xC087FFFFFFFFFFFD, 0xE200},
-	{0xC7E0040000000000, 0xFC00},
-	{0x3880080000000010, 0x0000},
-	{0xC7E0080000000000, 0xFC00},
-	{0x00100003F8000000, 0x0000},
-	{0xC7E0100000000000, 0xFC00},
-	{0xBFFFFFFFFFDFFFC0, 0xC000},
-	{0xC7E0200000000000, 0xFC00},
-	{0xC9FFFBFFFFFFFFFC, 0xFC00},
-	{0xC7E0400000000000, 0xFC00},
-	{0x401BCC2382CFD313, 0x46F3},
-	{0xC7E0800000000000, 0xFC00},
-	{0xAC6EE441EC6976DD, 0x8000},
-	{0xC7E1000000000000, 0xFC00},
-	{0xB920007FFFFFFBFF, 0x8000},
-	{0xC7E2000000000000, 0xFC00},
-	{0x409FFFFFFFFFFBFA, 0x6800},
-	{0xC7E4000000000000, 0xFC00},
-	{0x47EE39381AFF5D49, 0x7C00},
-	{0xC7E8000000000000, 0xFC00},
-	{0x41DA310C6CD5F3EE, 0x7C00},
-	{0xC7EC000000000000, 0xFC00},
-	{0xFFFC00000000003F, 0xFF00},
-	{0xC7EE000000000000, 0xFC00},
-	{0xF56749E3BD81553C, 0xFC00},
-	{0xC7EF000000000000, 0xFC00},
-	{0x41C1007FFFFFFFFF, 0x7C00},
-	{0xC7EF800000000000, 0xFC00},
-	{0x47A20007FFFFFFFF, 0x7C00},
-	{0xC7EFC00000000000, 0xFC00},
-	{0x4035EE2AE8BF749A, 0x4D7C},
-	{0xC7EFE00000000000, 0xFC00},
-	{0xC7FD79DA98B18B2C, 0xFC00},
-	{0xC7EFF00000000000, 0xFC00},
-	{0xBA0FFFFFFFC07FFF, 0x8000},
-	{0xC7EFF80000000000, 0xFC00},
-	{0xA55FFFFFFFFFF7DE, 0x8000},
-	{0xC7EFFC0000000000, 0xFC00},
-	{0xA7FFFFFFFFEDFFFE, 0x8000},
-	{0xC7EFFE0000000000, 0xFC00},
-	{0xC1E0000040000003, 0xFC00},
-	{0xC7EFFF0000000000, 0xFC00},
-	{0x3FE0080400000000, 0x3802},
-	{0xC7EFFF8000000000, 0xFC00},
-	{0x2CD0000FFFFFFFBE, 0x0000},
-	{0xC7EFFFC000000000, 0xFC00},
-	{0x40343FFFFFFFFFFE, 0x4D10},
-	{0xC7EFFFE000000000, 0xFC00},
-	{0x43C007FFEFFFFFFF, 0x7C00},
-	{0xC7EFFFF000000000, 0xFC00},
-	{0xC7FFFF00001FFFFE, 0xFC00},
-	{0xC7EFFFF800000000, 0xFC00},
-	{0x42CC23F429C7BCCE, 0x7C00},
-	{0xC7EFFFFC00000000, 0xFC00},
-	{0xB81FFBFFFFFF7FFE, 0x8000},
-	{0xC7EFFFFE00000000, 0xFC00},
-	{0xC7E0000040003FFF, 0xFC00},
-	{0xC7EFFFFF00000000, 0xFC00},
-	{0x000003F000000000, 0x0000},
-	{0xC7EFFFFF80000000, 0xFC00},
-	{0xD20F000000000200, 0xFC00},
-	{0xC7EFFFFFC0000000, 0xFC00},
-	{0xB3D0020000FFFFFF, 0x8000},
-	{0xC7EFFFFFE0000000, 0xFC00},
-	{0x801007FFFBFFFFFE, 0x8000},
-	{0xC7EFFFFFF0000000, 0xFC00},
-	{0xFFD005FFFFFFFFFE, 0xFC00},
-	{0xC7EFFFFFF8000000, 0xFC00},
-	{0x400000007FFE0000, 0x4000},
-	{0xC7EFFFFFFC000000, 0xFC00},
-	{0x9CFF7FFFEFFFFFFE, 0x8000},
-	{0xC7EFFFFFFE000000, 0xFC00},
-	{0x7FE00040000FFFFE, 0x7C00},
-	{0xC7EFFFFFFF000000, 0xFC00},
-	{0x434400003FFFFFFE, 0x7C00},
-	{0xC7EFFFFFFF800000, 0xFC00},
-	{0xC10FFFFC3FFFFFFF, 0xFC00},
-	{0xC7EFFFFFFFC00000, 0xFC00},
-	{0x405FFFFFFFFF83FF, 0x5800},
-	{0xC7EFFFFFFFE00000, 0xFC00},
-	{0xC000040000001FFF, 0xC001},
-	{0xC7EFFFFFFFF00000, 0xFC00},
-	{0xBC5FFFFFFFFFFFF7, 0x8000},
-	{0xC7EFFFFFFFF80000, 0xFC00},
-	{0xC34FFFFFBFFFFFFE, 0xFC00},
-	{0xC7EFFFFFFFFC0000, 0xFC00},
-	{0x3B3C0000003FFFFE, 0x0000},
-	{0xC7EFFFFFFFFE0000, 0xFC00},
-	{0x43E0000007FFFFEF, 0x7C00},
-	{0xC7EFFFFFFFFF0000, 0xFC00},
-	{0x47FFF0003FFFFFFE, 0x7C00},
-	{0xC7EFFFFFFFFF8000, 0xFC00},
-	{0x400FBFC000000000, 0x43F0},
-	{0xC7EFFFFFFFFFC000, 0xFC00},
-	{0xBE10007FFFFF8000, 0x8000},
-	{0xC7EFFFFFFFFFE000, 0xFC00},
-	{0x4344B4F7A7BE88EA, 0x7C00},
-	{0xC7EFFFFFFFFFF000, 0xFC00},
-	{0x000E8B46A0BACF5B, 0x0000},
-	{0xC7EFFFFFFFFFF800, 0xFC00},
-	{0xC7E0083FFFFFFFFF, 0xFC00},
-	{0xC7EFFFFFFFFFFC00, 0xFC00},
-	{0xBBB000000000C000, 0x8000},
-	{0xC7EFFFFFFFFFFE00, 0xFC00},
-	{0x418FC00000000FFF, 0x7C00},
-	{0xC7EFFFFFFFFFFF00, 0xFC00},
-	{0xC1DFFF8000002000, 0xFC00},
-	{0xC7EFFFFFFFFFFF80, 0xFC00},
-	{0xC06FFFFFFFFFF01F, 0xDC00},
-	{0xC7EFFFFFFFFFFFC0, 0xFC00},
-	{0x47E3FFFFFFFFBFFF, 0x7C00},
-	{0xC7EFFFFFFFFFFFE0, 0xFC00},
-	{0xC1500000001FFFFD, 0xFC00},
-	{0xC7EFFFFFFFFFFFF0, 0xFC00},
-	{0xC0BFFFFC0FFFFFFF, 0xF000},
-	{0xC7EFFFFFFFFFFFF8, 0xFC00},
-	{0x41FFE00000000200, 0x7C00},
-	{0xC7EFFFFFFFFFFFFC, 0xFC00},
-	{0xC06E0660EEE53670, 0xDB82},
-	{0xC7EFFFFFFFFFFFFE, 0xFC00},
-	{0xC14C9E4F5BE371D7, 0xFC00},
-	{0xC7EFFFFFFFFFFFFF, 0xFC00},
-	{0xFFFFFFDFE0000000, 0xFFFF},
-	{0xC7EFFFFFFFFFFFFD, 0xFC00},
-	{0xFFF00000021FFFFF, 0xFE00},
-	{0xC7EFFFFFFFFFFFFB, 0xFC00},
-	{0x800FFFFFBFFBFFFE, 0x8000},
-	{0xC7EFFFFFFFFFFFF7, 0xFC00},
-	{0xC1EF222BD13B5BF3, 0xFC00},
-	{0xC7EFFFFFFFFFFFEF, 0xFC00},
-	{0x80000000000001FE, 0x8000},
-	{0xC7EFFFFFFFFFFFDF, 0xFC00},
-	{0x4047FFFFFFFFFFFA, 0x5200},
-	{0xC7EFFFFFFFFFFFBF, 0xFC00},
-	{0x3FC00000FFC00000, 0x3000},
-	{0xC7EFFFFFFFFFFF7F, 0xFC00},
-	{0x801BFFF7FFFFFFFF, 0x8000},
-	{0xC7EFFFFFFFFFFEFF, 0xFC00},
-	{0x3390FFFFFFFFC000, 0x0000},
-	{0xC7EFFFFFFFFFFDFF, 0xFC00},
-	{0xC80FFFFFEFFFFEFF, 0xFC00},
-	{0xC7EFFFFFFFFFFBFF, 0xFC00},
-	{0x888C00000007FFFF, 0x8000},
-	{0xC7EFFFFFFFFFF7FF, 0xFC00},
-	{0xBE8E00000003FFFF, 0x8004},
-	{0xC7EFFFFFFFFFEFFF, 0xFC00},
-	{0x43E845B8D8D78DC7, 0x7C00},
-	{0xC7EFFFFFFFFFDFFF, 0xFC00},
-	{0xC1EFFFFFFFDFFFFF, 0xFC00},
-	{0xC7EFFFFFFFFFBFFF, 0xFC00},
-	{0x43411BB1C77E4AD0, 0x7C00},
-	{0xC7EFFFFFFFFF7FFF, 0xFC00},
-	{0xC060000000001001, 0xD800},
-	{0xC7EFFFFFFFFEFFFF, 0xFC00},
-	{0x3A90000000000022, 0x0000},
-	{0xC7EFFFFFFFFDFFFF, 0xFC00},
-	{0x3FF000000010003F, 0x3C00},
-	{0xC7EFFFFFFFFBFFFF, 0xFC00},
-	{0x3F8FFFFFFFFFFE7F, 0x2400},
-	{0xC7EFFFFFFFF7FFFF, 0xFC00},
-	{0xCBE1AD991CE5D8D8, 0xFC00},
-	{0xC7EFFFFFFFEFFFFF, 0xFC00},
-	{0x43FFFFFFFFFFF840, 0x7C00},
-	{0xC7EFFFFFFFDFFFFF, 0xFC00},
-	{0xB81E0B0ACA9B6E9E, 0x8000},
-	{0xC7EFFFFFFFBFFFFF, 0xFC00},
-	{0x43F54D50E5984FB2, 0x7C00},
-	{0xC7EFFFFFFF7FFFFF, 0xFC00},
-	{0xC34000013FFFFFFF, 0xFC00},
-	{0xC7EFFFFFFEFFFFFF, 0xFC00},
-	{0x403010000007FFFE, 0x4C04},
-	{0xC7EFFFFFFDFFFFFF, 0xFC00},
-	{0x42655C50D304A227, 0x7C00},
-	{0xC7EFFFFFFBFFFFFF, 0xFC00},
-	{0xDB5007EFFFFFFFFF, 0xFC00},
-	{0xC7EFFFFFF7FFFFFF, 0xFC00},
-	{0xCDE000000000BFFF, 0xFC00},
-	{0xC7EFFFFFEFFFFFFF, 0xFC00},
-	{0x481FFFFBFFFFFFFE, 0x7C00},
-	{0xC7EFFFFFDFFFFFFF, 0xFC00},
-	{0x3FB52B4EAD46BF6E, 0x2D4B},
-	{0xC7EFFFFFBFFFFFFF, 0xFC00},
-	{0x3F863696B23D5720, 0x218E},
-	{0xC7EFFFFF7FFFFFFF, 0xFC00},
-	{0xBFB00000000002FF, 0xAC00},
-	{0xC7EFFFFEFFFFFFFF, 0xFC00},
-	{0xBFC4BD139368D191, 0xB12F},
-	{0xC7EFFFFDFFFFFFFF, 0xFC00},
-	{0xC1C000000009FFFF, 0xFC00},
-	{0xC7EFFFFBFFFFFFFF, 0xFC00},
-	{0x3FB000FFFFFFFBFE, 0x2C00},
-	{0xC7EFFFF7FFFFFFFF, 0xFC00},
-	{0xFFE809BE35AAF34F, 0xFC00},
-	{0xC7EFFFEFFFFFFFFF, 0xFC00},
-	{0x3F6A87BF380D1DC0, 0x1AA2},
-	{0xC7EFFFDFFFFFFFFF, 0xFC00},
-	{0x800FFFFFFFDFFFFC, 0x8000},
-	{0xC7EFFFBFFFFFFFFF, 0xFC00},
-	{0x41F0500000000000, 0x7C00},
-	{0xC7EFFF7FFFFFFFFF, 0xFC00},
-	{0xB5A0000FFFFFFFBE, 0x8000},
-	{0xC7EFFEFFFFFFFFFF, 0xFC00},
-	{0xDB7FFE000000003E, 0xFC00},
-	{0xC7EFFDFFFFFFFFFF, 0xFC00},
-	{0xC8293E0AE88E80DF, 0xFC00},
-	{0xC7EFFBFFFFFFFFFF, 0xFC00},
-	{0x37FDFFFFFFF80000, 0x0000},
-	{0xC7EFF7FFFFFFFFFF, 0xFC00},
-	{0x37F00003FFFFF7FF, 0x0000},
-	{0xC7EFEFFFFFFFFFFF, 0xFC00},
-	{0x47E3927F6B7F6E6B, 0x7C00},
-	{0xC7EFDFFFFFFFFFFF, 0xFC00},
-	{0x3FE0000180000000, 0x3800},
-	{0xC7EFBFFFFFFFFFFF, 0xFC00},
-	{0x402FFFFF00002000, 0x4C00},
-	{0xC7EF7FFFFFFFFFFF, 0xFC00},
-	{0xC030201FFFFFFFFF, 0xCC08},
-	{0xC7EEFFFFFFFFFFFF, 0xFC00},
-	{0x226FFFFFE01FFFFE, 0x0000},
-	{0xC7EDFFFFFFFFFFFF, 0xFC00},
-	{0x404FFF7E00000000, 0x5400},
-	{0xC7EBFFFFFFFFFFFF, 0xFC00},
-	{0x3F5FFFF7FFFF7FFE, 0x1800},
-	{0xC7E7FFFFFFFFFFFF, 0xFC00},
-	{0x41EFFFFFFBFFFFFC, 0x7C00},
-	{0xC7E3FFFFFFFFFFFF, 0xFC00},
-	{0xC0AFFDFFFFFFFFF8, 0xEBFF},
-	{0xC7E1FFFFFFFFFFFF, 0xFC00},
-	{0x403000000000807F, 0x4C00},
-	{0xC7E0FFFFFFFFFFFF, 0xFC00},
-	{0xC04072CDC07413FB, 0xD01D},
-	{0xC7E07FFFFFFFFFFF, 0xFC00},
-	{0xC03FFBFFDFFFFFFE, 0xCFFF},
-	{0xC7E03FFFFFFFFFFF, 0xFC00},
-	{0xC076FBE90A5ED757, 0xDDBF},
-	{0xC7E01FFFFFFFFFFF, 0xFC00},
-	{0x3FF0510DD2301194, 0x3C14},
-	{0xC7E00FFFFFFFFFFF, 0xFC00},
-	{0x8AA0000000203FFF, 0x8000},
-	{0xC7E007FFFFFFFFFF, 0xFC00},
-	{0x41F0534FCAD92F7E, 0x7C00},
-	{0xC7E003FFFFFFFFFF, 0xFC00},
-	{0xC000000000000403, 0xC000},
-	{0xC7E001FFFFFFFFFF, 0xFC00},
-	{0x400FFFFFFFFFFDDF, 0x4400},
-	{0xC7E000FFFFFFFFFF, 0xFC00},
-	{0x7FF000010000FFFF, 0x7E00},
-	{0xC7E0007FFFFFFFFF, 0xFC00},
-	{0xC3C9FB21D3F2C68B, 0xFC00},
-	{0xC7E0003FFFFFFFFF, 0xFC00},
-	{0xABD912AC6B870A22, 0x8000},
-	{0xC7E0001FFFFFFFFF, 0xFC00},
-	{0x381001FFFFFE0000, 0x0000},
-	{0xC7E0000FFFFFFFFF, 0xFC00},
-	{0xC0E001FFFFFFFFFF, 0xF800},
-	{0xC7E00007FFFFFFFF, 0xFC00},
-	{0x226000FFFFFEFFFF, 0x0000},
-	{0xC7E00003FFFFFFFF, 0xFC00},
-	{0x0026FD09ACF51B62, 0x0000},
-	{0xC7E00001FFFFFFFF, 0xFC00},
-	{0x3FE0000000008FFF, 0x3800},
-	{0xC7E00000FFFFFFFF, 0xFC00},
-	{0xBFEFFFFFFFFFFBBE, 0xBC00},
-	{0xC7E000007FFFFFFF, 0xFC00},
-	{0x41F0C00000000000, 0x7C00},
-	{0xC7E000003FFFFFFF, 0xFC00},
-	{0x43EFFFFFFFFFCFFF, 0x7C00},
-	{0xC7E000001FFFFFFF, 0xFC00},
-	{0xC3F5139D35866E08, 0xFC00},
-	{0xC7E000000FFFFFFF, 0xFC00},
-	{0x01AFFFFFFFEF7FFE, 0x0000},
-	{0xC7E0000007FFFFFF, 0xFC00},
-	{0x43CFFFFFFFEFFFEF, 0x7C00},
-	{0xC7E0000003FFFFFF, 0xFC00},
-	{0x4098C910B24720A2, 0x6632},
-	{0xC7E0000001FFFFFF, 0xFC00},
-	{0xBFF0010000003FFF, 0xBC00},
-	{0xC7E0000000FFFFFF, 0xFC00},
-	{0x0024CF33EFB1F57A, 0x0000},
-	{0xC7E00000007FFFFF, 0xFC00},
-	{0x47E7FFF595C9DB88, 0x7C00},
-	{0xC7E00000003FFFFF, 0xFC00},
-	{0x403800000000001F, 0x4E00},
-	{0xC7E00000001FFFFF, 0xFC00},
-	{0xC0FDAEE967BD3112, 0xFC00},
-	{0xC7E00000000FFFFF, 0xFC00},
-	{0xA58FFFFFFFFBFEFE, 0x8000},
-	{0xC7E000000007FFFF, 0xFC00},
-	{0x3FCBAC504AECD51A, 0x32EB},
-	{0xC7E000000003FFFF, 0xFC00},
-	{0xFFFFFFC0000001FF, 0xFFFF},
-	{0xC7E000000001FFFF, 0xFC00},
-	{0x4030000000040FFF, 0x4C00},
-	{0xC7E000000000FFFF, 0xFC00},
-	{0xACE1000000FFFFFE, 0x8000},
-	{0xC7E0000000007FFF, 0xFC00},
-	{0x3CEFDFFFFFFFFDFF, 0x0000},
-	{0xC7E0000000003FFF, 0xFC00},
-	{0x43407DFFFFFFFFFF, 0x7C00},
-	{0xC7E0000000001FFF, 0xFC00},
-	{0x357FFFF800001000, 0x0000},
-	{0xC7E0000000000FFF, 0xFC00},
-	{0xC3F0017FFFFFFFFF, 0xFC00},
-	{0xC7E00000000007FF, 0xFC00},
-	{0x4090FFFFDFFFFFFF, 0x6440},
-	{0xC7E00000000003FF, 0xFC00},
-	{0x323FE00000000001, 0x0000},
-	{0xC7E00000000001FF, 0xFC00},
-	{0xFFEFFFFFFFFFFBF8, 0xFC00},
-	{0xC7E00000000000FF, 0xFC00},
-	{0x0020100000000001, 0x0000},
-	{0xC7E000000000007F, 0xFC00},
-	{0xC7FFFFFFFFFC0010, 0xFC00},
-	{0xC7E000000000003F, 0xFC00},
-	{0x3FCF580DC11216D4, 0x33D6},
-	{0xC7E000000000001F, 0xFC00},
-	{0x0003ACDD146CB65C, 0x0000},
-	{0xC7E000000000000F, 0xFC00},
-	{0x00110CD0FD04ED1F, 0x0000},
-	{0xC7E0000000000007, 0xFC00},
-	{0xBCA0000000001002, 0x8000},
-	{0xC7E0000000000003, 0xFC00},
-	{0xC03FFFFC04000000, 0xD000},
-	{0xC7F0000000000000, 0xFC00},
-	{0xBF33FFFFFFFFFFBE, 0x8D00},
-	{0xC7F0000000000001, 0xFC00},
-	{0x3FBF71F9AC6B2842, 0x2FDC},
-	{0xC7F0000000000002, 0xFC00},
-	{0x3FC63282122B9438, 0x318D},
-	{0xC7F0000000000004, 0xFC00},
-	{0xC00FC0000000003E, 0xC3F0},
-	{0xC7F0000000000008, 0xFC00},
-	{0x485FBFDFFFFFFFFF, 0x7C00},
-	{0xC7F0000000000010, 0xFC00},
-	{0xB7F2000003FFFFFF, 0x8000},
-	{0xC7F0000000000020, 0xFC00},
-	{0xC02FFFFFFF800007, 0xCC00},
-	{0xC7F0000000000040, 0xFC00},
-	{0xC6A68D91D1D2A497, 0xFC00},
-	{0xC7F0000000000080, 0xFC00},
-	{0xB7FFC00001FFFFFE, 0x8000},
-	{0xC7F0000000000100, 0xFC00},
-	{0x3DBC000800000000, 0x0000},
-	{0xC7F0000000000200, 0xFC00},
-	{0x3F60FFFFFFFFFF7F, 0x1840},
-	{0xC7F0000000000400, 0xFC00},
-	{0x32A1710AD4A261AF, 0x0000},
-	{0xC7F0000000000800, 0xFC00},
-	{0x3FC4000000000FFE, 0x3100},
-	{0xC7F0000000001000, 0xFC00},
-	{0x3FD3FBFFFFFFFFFF, 0x34FF},
-	{0xC7F0000000002000, 0xFC00},
-	{0x4DDFFFFFFFEFFBFF, 0x7C00},
-	{0xC7F0000000004000, 0xFC00},
-	{0x39A00008000000FF, 0x0000},
-	{0xC7F0000000008000, 0xFC00},
-	{0x3FEFF01000000000, 0x3BFC},
-	{0xC7F0000000010000, 0xFC00},
-	{0x406FFFFFFE0001FF, 0x5C00},
-	{0xC7F0000000020000, 0xFC00},
-	{0x3FF0000000100800, 0x3C00},
-	{0xC7F0000000040000, 0xFC00},
-	{0x42000000001FFFEF, 0x7C00},
-	{0xC7F0000000080000, 0xFC00},
-	{0x28CFF7FFFF7FFFFF, 0x0000},
-	{0xC7F0000000100000, 0xFC00},
-	{0xC80FFFFFEF7FFFFE, 0xFC00},
-	{0xC7F0000000200000, 0xFC00},
-	{0xBC1FFFFFFFFFFDFB, 0x8000},
-	{0xC7F0000000400000, 0xFC00},
-	{0x400E000000000007, 0x4380},
-	{0xC7F0000000800000, 0xFC00},
-	{0xC7E000080003FFFF, 0xFC00},
-	{0xC7F0000001000000, 0xFC00},
-	{0x433F0000000FFFFF, 0x7C00},
-	{0xC7F0000002000000, 0xFC00},
-	{0x623481B6C9A88551, 0x7C00},
-	{0xC7F0000004000000, 0xFC00},
-	{0x400FFFC000040000, 0x4400},
-	{0xC7F0000008000000, 0xFC00},
-	{0xC014509BF9DD59C0, 0xC514},
-	{0xC7F0000010000000, 0xFC00},
-	{0xC1EFFFFFBFBFFFFF, 0xFC00},
-	{0xC7F0000020000000, 0xFC00},
-	{0xBDB7F394BF7006F8, 0x8000},
-	{0xC7F0000040000000, 0xFC00},
-	{0xB7F007FFDFFFFFFF, 0x8000},
-	{0xC7F0000080000000, 0xFC00},
-	{0x3FEFBFFFFFFFFFF6, 0x3BF0},
-	{0xC7F0000100000000, 0xFC00},
-	{0x3E000007FFFFFFFC, 0x0000},
-	{0xC7F0000200000000, 0xFC00},
-	{0x41E004000FFFFFFF, 0x7C00},
-	{0xC7F0000400000000, 0xFC00},
-	{0x3FE2AC8FAF4177DF, 0x38AB},
-	{0xC7F0000800000000, 0xFC00},
-	{0x41EFAEE91EBD26D0, 0x7C00},
-	{0xC7F0001000000000, 0xFC00},
-	{0x434F600000000000, 0x7C00},
-	{0xC7F0002000000000, 0xFC00},
-	{0x400AB73EF248D82E, 0x42AE},
-	{0xC7F0004000000000, 0xFC00},
-	{0x87800020007FFFFE, 0x8000},
-	{0xC7F0008000000000, 0xFC00},
-	{0x458FF7F7FFFFFFFE, 0x7C00},
-	{0xC7F0010000000000, 0xFC00},
-	{0xBCA00001FFFFF800, 0x8000},
-	{0xC7F0020000000000, 0xFC00},
-	{0xAA1FFFFFF8200000, 0x8000},
-	{0xC7F0040000000000, 0xFC00},
-	{0x4C168F02796A7B38, 0x7C00},
-	{0xC7F0080000000000, 0xFC00},
-	{0x0010007FFFFFF800, 0x0000},
-	{0xC7F0100000000000, 0xFC00},
-	{0x65001000003FFFFF, 0x7C00},
-	{0xC7F0200000000000, 0xFC00},
-	{0xB81458813D033291, 0x8000},
-	{0xC7F0400000000000, 0xFC00},
-	{0x3FBCAA5B5FBE8517, 0x2F2B},
-	{0xC7F0800000000000, 0xFC00},
-	{0xF64FFFFFFFBFFFC0, 0xFC00},
-	{0xC7F1000000000000, 0xFC00},
-	{0x400A7E72539D4163, 0x42A0},
-	{0xC7F2000000000000, 0xFC00},
-	{0xFFD37D791A95F0F4, 0xFC00},
-	{0xC7F4000000000000, 0xFC00},
-	{0x7FD00800007FFFFF, 0x7C00},
-	{0xC7F8000000000000, 0xFC00},
-	{0x3F80000020000003, 0x2000},
-	{0xC7FC000000000000, 0xFC00},
-	{0x3F8FFFFBFFFFFEFF, 0x2400},
-	{0xC7FE000000000000, 0xFC00},
-	{0x41EFFFFFEFFFF7FF, 0x7C00},
-	{0xC7FF000000000000, 0xFC00},
-	{0x002FFFFFFEFFC000, 0x0000},
-	{0xC7FF800000000000, 0xFC00},
-	{0x41E0000000001FDE, 0x7C00},
-	{0xC7FFC00000000000, 0xFC00},
-	{0xB80FFFFFF0000006, 0x8000},
-	{0xC7FFE00000000000, 0xFC00},
-	{0xC1E3FFFFFBFFFFFE, 0xFC00},
-	{0xC7FFF00000000000, 0xFC00},
-	{0x867DFFFFFFFFFF7F, 0x8000},
-	{0xC7FFF80000000000, 0xFC00},
-	{0xB810000008000080, 0x8000},
-	{0xC7FFFC0000000000, 0xFC00},
-	{0x4330080FFFFFFFFF, 0x7C00},
-	{0xC7FFFE0000000000, 0xFC00},
-	{0xC7E1FFFFFFFFFEFF, 0xFC00},
-	{0xC7FFFF0000000000, 0xFC00},
-	{0xB80E3EFA0C9B786C, 0x8000},
-	{0xC7FFFF8000000000, 0xFC00},
-	{0xC02C1072B638C2D0, 0xCB04},
-	{0xC7FFFFC000000000, 0xFC00},
-	{0x802FFFFFFFFFE3FE, 0x8000},
-	{0xC7FFFFE000000000, 0xFC00},
-	{0xC07FFFFFEFFFFF7F, 0xE000},
-	{0xC7FFFFF000000000, 0xFC00},
-	{0x43F72EF451A8E9CA, 0x7C00},
-	{0xC7FFFFF800000000, 0xFC00},
-	{0x3D89FC7FA123CFAB, 0x0000},
-	{0xC7FFFFFC00000000, 0xFC00},
-	{0x3FBF000200000000, 0x2FC0},
-	{0xC7FFFFFE00000000, 0xFC00},
-	{0x21403FBFFFFFFFFE, 0x0000},
-	{0xC7FFFFFF00000000, 0xFC00},
-	{0x0DAFFFFFFC0FFFFE, 0x0000},
-	{0xC7FFFFFF80000000, 0xFC00},
-	{0x3FB0020000000000, 0x2C00},
-	{0xC7FFFFFFC0000000, 0xFC00},
-	{0x4020010000000004, 0x4800},
-	{0xC7FFFFFFE0000000, 0xFC00},
-	{0x41DF000000080000, 0x7C00},
-	{0xC7FFFFFFF0000000, 0xFC00},
-	{0x000AC96F16DEDB8C, 0x0000},
-	{0xC7FFFFFFF8000000, 0xFC00},
-	{0x801F7259AE59EB95, 0x8000},
-	{0xC7FFFFFFFC000000, 0xFC00},
-	{0xC137710AA9BEA8D5, 0xFC00},
-	{0xC7FFFFFFFE000000, 0xFC00},
-	{0x4480000002000FFF, 0x7C00},
-	{0xC7FFFFFFFF000000, 0xFC00},
-	{0xC02000000007E000, 0xC800},
-	{0xC7FFFFFFFF800000, 0xFC00},
-	{0xFFF0000005000000, 0xFE00},
-	{0xC7FFFFFFFFC00000, 0xFC00},
-	{0xC3F4000000000800, 0xFC00},
-	{0xC7FFFFFFFFE00000, 0xFC00},
-	{0x000000000000FEFF, 0x0000},
-	{0xC7FFFFFFFFF00000, 0xFC00},
-	{0x40200003FFDFFFFF, 0x4800},
-	{0xC7FFFFFFFFF80000, 0xFC00},
-	{0x4027FFFFDFFFFFFF, 0x4A00},
-	{0xC7FFFFFFFFFC0000, 0xFC00},
-	{0xC7EF3C905ABFB40B, 0xFC00},
-	{0xC7FFFFFFFFFE0000, 0xFC00},
-	{0xC1D2000000000200, 0xFC00},
-	{0xC7FFFFFFFFFF0000, 0xFC00},
-	{0x380000000FFFFFFF, 0x0000},
-	{0xC7FFFFFFFFFF8000, 0xFC00},
-	{0xBFF85012A714FFA9, 0xBE14},
-	{0xC7FFFFFFFFFFC000, 0xFC00},
-	{0x4E3618D52B6416F8, 0x7C00},
-	{0xC7FFFFFFFFFFE000, 0xFC00},
-	{0xC34FFFFFFFFFFFFE, 0xFC00},
-	{0xC7FFFFFFFFFFF000, 0xFC00},
-	{0x37E0000083FFFFFF, 0x0000},
-	{0xC7FFFFFFFFFFF800, 0xFC00},
-	{0x3FEFFFFF7FFFFFFB, 0x3C00},
-	{0xC7FFFFFFFFFFFC00, 0xFC00},
-	{0x802FFFE001FFFFFE, 0x8000},
-	{0xC7FFFFFFFFFFFE00, 0xFC00},
-	{0xB7F0000001080000, 0x8000},
-	{0xC7FFFFFFFFFFFF00, 0xFC00},
-	{0xBAD0000000010000, 0x8000},
-	{0xC7FFFFFFFFFFFF80, 0xFC00},
-	{0xA05FFFFFF3FFFFFE, 0x8000},
-	{0xC7FFFFFFFFFFFFC0, 0xFC00},
-	{0x3FFFDFFFFFFFFC00, 0x3FF8},
-	{0xC7FFFFFFFFFFFFE0, 0xFC00},
-	{0xC1C2BDD14399F5C2, 0xFC00},
-	{0xC7FFFFFFFFFFFFF0, 0xFC00},
-	{0xB749EA36B96AAC17, 0x8000},
-	{0xC7FFFFFFFFFFFFF8, 0xFC00},
-	{0xC72FF7FFBFFFFFFF, 0xFC00},
-	{0xC7FFFFFFFFFFFFFC, 0xFC00},
-	{0xA341FFFFFFFFEFFF, 0x8000},
-	{0xC7FFFFFFFFFFFFFE, 0xFC00},
-	{0x3E4FF10000000000, 0x0000},
-	{0xC7FFFFFFFFFFFFFF, 0xFC00},
-	{0xB63FB7FFFFFFFFFF, 0x8000},
-	{0xC7FFFFFFFFFFFFFD, 0xFC00},
-	{0xCB7FFFFDFFFFFDFE, 0xFC00},
-	{0xC7FFFFFFFFFFFFFB, 0xFC00},
-	{0xC3EFFFFFE0000000, 0xFC00},
-	{0xC7FFFFFFFFFFFFF7, 0xFC00},
-	{0x4023FB3928A71FF4, 0x48FF},
-	{0xC7FFFFFFFFFFFFEF, 0xFC00},
-	{0xBFCFFDFFFFFFFE00, 0xB3FF},
-	{0xC7FFFFFFFFFFFFDF, 0xFC00},
-	{0x430000000000E000, 0x7C00},
-	{0xC7FFFFFFFFFFFFBF, 0xFC00},
-	{0x48B3FFFBFFFFFFFE, 0x7C00},
-	{0xC7FFFFFFFFFFFF7F, 0xFC00},
-	{0x43D0000200000006, 0x7C00},
-	{0xC7FFFFFFFFFFFEFF, 0xFC00},
-	{0x3FDFFFF000000003, 0x3800},
-	{0xC7FFFFFFFFFFFDFF, 0xFC00},
-	{0x41DFFFE07FFFFFFE, 0x7C00},
-	{0xC7FFFFFFFFFFFBFF, 0xFC00},
-	{0xFFF99050542FBA57, 0xFE64},
-	{0xC7FFFFFFFFFFF7FF, 0xFC00},
-	{0x005FE00000003FFF, 0x0000},
-	{0xC7FFFFFFFFFFEFFF, 0xFC00},
-	{0x37F00001FFFFFFF6, 0x0000},
-	{0xC7FFFFFFFFFFDFFF, 0xFC00},
-	{0x40749103144F65A4, 0x5D24},
-	{0xC7FFFFFFFFFFBFFF, 0xFC00},
-	{0xC002FC1655D99710, 0xC0BF},
-	{0xC7FFFFFFFFFF7FFF, 0xFC00},
-	{0x43C10F8B58853855, 0x7C00},
-	{0xC7FFFFFFFFFEFFFF, 0xFC00},
-	{0x3F2FFFC007FFFFFF, 0x0C00},
-	{0xC7FFFFFFFFFDFFFF, 0xFC00},
-	{0x403FFEFFFFFF8000, 0x5000},
-	{0xC7FFFFFFFFFBFFFF, 0xFC00},
-	{0xBFB0004000000000, 0xAC00},
-	{0xC7FFFFFFFFF7FFFF, 0xFC00},
-	{0x4029B19AF2428C6B, 0x4A6C},
-	{0xC7FFFFFFFFEFFFFF, 0xFC00},
-	{0xB7E0FFFFFFFFFFBE, 0x8000},
-	{0xC7FFFFFFFFDFFFFF, 0xFC00},
-	{0xC4ADFFFFFFF80000, 0xFC00},
-	{0xC7FFFFFFFFBFFFFF, 0xFC00},
-	{0x4030CC5C1D2E8782, 0x4C33},
-	{0xC7FFFFFFFF7FFFFF, 0xFC00},
-	{0x37F0C943199FE6FC, 0x0000},
-	{0xC7FFFFFFFEFFFFFF, 0xFC00},
-	{0x8012417CB54D3F2A, 0x8000},
-	{0xC7FFFFFFFDFFFFFF, 0xFC00},
-	{0xC1E474574F552E61, 0xFC00},
-	{0xC7FFFFFFFBFFFFFF, 0xFC00},
-	{0x002003FFFFFFFFDF, 0x0000},
-	{0xC7FFFFFFF7FFFFFF, 0xFC00},
-	{0x4010004000000FFF, 0x4400},
-	{0xC7FFFFFFEFFFFFFF, 0xFC00},
-	{0xFFFFFFFDF7FFFFFF, 0xFFFF},
-	{0xC7FFFFFFDFFFFFFF, 0xFC00},
-	{0xC3E582E1494E1B46, 0xFC00},
-	{0xC7FFFFFFBFFFFFFF, 0xFC00},
-	{0x41C00010003FFFFF, 0x7C00},
-	{0xC7FFFFFF7FFFFFFF, 0xFC00},
-	{0x7E7DE012C73E2281, 0x7C00},
-	{0xC7FFFFFEFFFFFFFF, 0xFC00},
-	{0xC250003FFFDFFFFF, 0xFC00},
-	{0xC7FFFFFDFFFFFFFF, 0xFC00},
-	{0xBFEFC0000007FFFF, 0xBBF0},
-	{0xC7FFFFFBFFFFFFFF, 0xFC00},
-	{0xB69FFFFFEFFFFFFE, 0x8000},
-	{0xC7FFFFF7FFFFFFFF, 0xFC00},
-	{0x41D0000400003FFE, 0x7C00},
-	{0xC7FFFFEFFFFFFFFF, 0xFC00},
-	{0xC14C568804E9DFFA, 0xFC00},
-	{0xC7FFFFDFFFFFFFFF, 0xFC00},
-	{0xC0AB8CD5CE25529F, 0xEAE3},
-	{0xC7FFFFBFFFFFFFFF, 0xFC00},
-	{0xBCA407FFFFFFFFFF, 0x8000},
-	{0xC7FFFF7FFFFFFFFF, 0xFC00},
-	{0x219FFFE00000000E, 0x0000},
-	{0xC7FFFEFFFFFFFFFF, 0xFC00},
-	{0xC7FFF007FFFFFFFE, 0xFC00},
-	{0xC7FFFDFFFFFFFFFF, 0xFC00},
-	{0xBCA0F2606E55852D, 0x8000},
-	{0xC7FFFBFFFFFFFFFF, 0xFC00},
-	{0xBFD3FEFFFFFFFFFF, 0xB500},
-	{0xC7FFF7FFFFFFFFFF, 0xFC00},
-	{0x480100000FFFFFFE, 0x7C00},
-	{0xC7FFEFFFFFFFFFFF, 0xFC00},
-	{0xBF8ACDB83F639112, 0xA2B3},
-	{0xC7FFDFFFFFFFFFFF, 0xFC00},
-	{0xBEFFFFFFFFFEFFBE, 0x8200},
-	{0xC7FFBFFFFFFFFFFF, 0xFC00},
-	{0x40102B2041E9C5F7, 0x440B},
-	{0xC7FF7FFFFFFFFFFF, 0xFC00},
-	{0xCC8FFFFFFFDFFFFE, 0xFC00},
-	{0xC7FEFFFFFFFFFFFF, 0xFC00},
-	{0xB81B9008650FF80D, 0x8000},
-	{0xC7FDFFFFFFFFFFFF, 0xFC00},
-	{0x403FFFFFFFE00FFF, 0x5000},
-	{0xC7FBFFFFFFFFFFFF, 0xFC00},
-	{0xC004BC880F7C6BE6, 0xC12F},
-	{0xC7F7FFFFFFFFFFFF, 0xFC00},
-	{0x27D0400003FFFFFF, 0x0000},
-	{0xC7F3FFFFFFFFFFFF, 0xFC00},
-	{0xC0F00000000017FF, 0xFC00},
-	{0xC7F1FFFFFFFFFFFF, 0xFC00},
-	{0xD93013E276048AF0, 0xFC00},
-	{0xC7F0FFFFFFFFFFFF, 0xFC00},
-	{0xC7EF801AB96A284C, 0xFC00},
-	{0xC7F07FFFFFFFFFFF, 0xFC00},
-	{0xBFE0000000100080, 0xB800},
-	{0xC7F03FFFFFFFFFFF, 0xFC00},
-	{0x41C0100000001000, 0x7C00},
-	{0xC7F01FFFFFFFFFFF, 0xFC00},
-	{0x002FEFFFEFFFFFFE, 0x0000},
-	{0xC7F00FFFFFFFFFFF, 0xFC00},
-	{0x43FFC00001000000, 0x7C00},
-	{0xC7F007FFFFFFFFFF, 0xFC00},
-	{0xC1D000000002007F, 0xFC00},
-	{0xC7F003FFFFFFFFFF, 0xFC00},
-	{0x41EFFFFFFFFFFFCF, 0x7C00},
-	{0xC7F001FFFFFFFFFF, 0xFC00},
-	{0x1C6000000000807F, 0x0000},
-	{0xC7F000FFFFFFFFFF, 0xFC00},
-	{0x3E9003FFFFF7FFFE, 0x0004},
-	{0xC7F0007FFFFFFFFF, 0xFC00},
-	{0x3CAFF0000000007F, 0x0000},
-	{0xC7F0003FFFFFFFFF, 0xFC00},
-	{0x4051000001FFFFFF, 0x5440},
-	{0xC7F0001FFFFFFFFF, 0xFC00},
-	{0xBE04E5DBF6045C48, 0x8000},
-	{0xC7F0000FFFFFFFFF, 0xFC00},
-	{0xC1CFDFFFEFFFFFFE, 0xFC00},
-	{0xC7F00007FFFFFFFF, 0xFC00},
-	{0x400FEFFFFFFF7FFE, 0x43FC},
-	{0xC7F00003FFFFFFFF, 0xFC00},
-	{0x4BBFFF8000000000, 0x7C00},
-	{0xC7F00001FFFFFFFF, 0xFC00},
-	{0xCA7FFFFFFFFF0007, 0xFC00},
-	{0xC7F00000FFFFFFFF, 0xFC00},
-	{0xC3D156962A11C817, 0xFC00},
-	{0xC7F000007FFFFFFF, 0xFC00},
-	{0x7FE020000000001E, 0x7C00},
-	{0xC7F000003FFFFFFF, 0xFC00},
-	{0xC1E33B06B2D25B92, 0xFC00},
-	{0xC7F000001FFFFFFF, 0xFC00},
-	{0xC096080F4C6ECD83, 0xE582},
-	{0xC7F000000FFFFFFF, 0xFC00},
-	{0x4340000002200000, 0x7C00},
-	{0xC7F0000007FFFFFF, 0xFC00},
-	{0xC3400000000FF7FF, 0xFC00},
-	{0xC7F0000003FFFFFF, 0xFC00},
-	{0xBCAFFFFFFF7FFFF0, 0x8000},
-	{0xC7F0000001FFFFFF, 0xFC00},
-	{0x43C000001000003E, 0x7C00},
-	{0xC7F0000000FFFFFF, 0xFC00},
-	{0x476FFFFFE00007FF, 0x7C00},
-	{0xC7F00000007FFFFF, 0xFC00},
-	{0x3FC7E1DD2FCB8E93, 0x31F8},
-	{0xC7F00000003FFFFF, 0xFC00},
-	{0xE3D02000FFFFFFFF, 0xFC00},
-	{0xC7F00000001FFFFF, 0xFC00},
-	{0xBCA1FFFFFFFFFDFF, 0x8000},
-	{0xC7F00000000FFFFF, 0xFC00},
-	{0x4030000408000000, 0x4C00},
-	{0xC7F000000007FFFF, 0xFC00},
-	{0x2F300000000001EF, 0x0000},
-	{0xC7F000000003FFFF, 0xFC00},
-	{0x57FFFFFFFFFFFFEC, 0x7C00},
-	{0xC7F000000001FFFF, 0xFC00},
-	{0xFFE000FFFFFFFFFF, 0xFC00},
-	{0xC7F000000000FFFF, 0xFC00},
-	{0x41BFFFBFF7FFFFFE, 0x7C00},
-	{0xC7F0000000007FFF, 0xFC00},
-	{0x407459DF67FF0776, 0x5D16},
-	{0xC7F0000000003FFF, 0xFC00},
-	{0xBE3FFBFFFFFFFFFD, 0x8000},
-	{0xC7F0000000001FFF, 0xFC00},
-	{0x3E800000001FEFFF, 0x0002},
-	{0xC7F0000000000FFF, 0xFC00},
-	{0x3FC0001F7FFFFFFF, 0x3000},
-	{0xC7F00000000007FF, 0xFC00},
-	{0x4030008007FFFFFF, 0x4C00},
-	{0xC7F00000000003FF, 0xFC00},
-	{0x9E20007FDFFFFFFE, 0x8000},
-	{0xC7F00000000001FF, 0xFC00},
-	{0x401FFFFF000000FF, 0x4800},
-	{0xC7F00000000000FF, 0xFC00},
-	{0xC0E000803FFFFFFF, 0xF800},
-	{0xC7F000000000007F, 0xFC00},
-	{0xC7E1FFFFE0000000, 0xFC00},
-	{0xC7F000000000003F, 0xFC00},
-	{0x7FFDB05C38DDDB7A, 0x7F6C},
-	{0xC7F000000000001F, 0xFC00},
-	{0xC3EFF83FFFFFFFFF, 0xFC00},
-	{0xC7F000000000000F, 0xFC00},
-	{0x7FDFFFFFFDFFFFEE, 0x7C00},
-	{0xC7F0000000000007, 0xFC00},
-	{0x47F0080001FFFFFE, 0x7C00},
-	{0xC7F0000000000003, 0xFC00},
-	{0xC3EFFFFFFEFC0000, 0xFC00},
-	{0xC800000000000000, 0xFC00},
-	{0x47EFFFEFFEFFFFFE, 0x7C00},
-	{0xC800000000000001, 0xFC00},
-	{0xCA7F800000003FFE, 0xFC00},
-	{0xC800000000000002, 0xFC00},
-	{0x87CE0000001FFFFE, 0x8000},
-	{0xC800000000000004, 0xFC00},
-	{0x7FDFEFFFFFFFFFF7, 0x7C00},
-	{0xC800000000000008, 0xFC00},
-	{0x002609A9109FC2C0, 0x0000},
-	{0xC800000000000010, 0xFC00},
-	{0x48004000000000FF, 0x7C00},
-	{0xC800000000000020, 0xFC00},
-	{0x3CAFFF01FFFFFFFE, 0x0000},
-	{0xC800000000000040, 0xFC00},
-	{0xC03046DBE4D98BCB, 0xCC12},
-	{0xC800000000000080, 0xFC00},
-	{0x3CA307CA929F5D84, 0x0000},
-	{0xC800000000000100, 0xFC00},
-	{0x53600040FFFFFFFE, 0x7C00},
-	{0xC800000000000200, 0xFC00},
-	{0x40203FFFFF7FFFFE, 0x4810},
-	{0xC800000000000400, 0xFC00},
-	{0x37E001000007FFFE, 0x0000},
-	{0xC800000000000800, 0xFC00},
-	{0x402C3FFFFFFFFFFE, 0x4B10},
-	{0xC800000000001000, 0xFC00},
-	{0x3FEFFFFF8000000E, 0x3C00},
-	{0xC800000000002000, 0xFC00},
-	{0x480FFFBFFF7FFFFF, 0x7C00},
-	{0xC800000000004000, 0xFC00},
-	{0x4000000000FFFFFF, 0x4000},
-	{0xC800000000008000, 0xFC00},
-	{0xBFFA40520D6FC6C9, 0xBE90},
-	{0xC800000000010000, 0xFC00},
-	{0xC1CCF60DD4FF788B, 0xFC00},
-	{0xC800000000020000, 0xFC00},
-	{0xD3F000080000007F, 0xFC00},
-	{0xC800000000040000, 0xFC00},
-	{0x802990B98D73CE5F, 0x8000},
-	{0xC800000000080000, 0xFC00},
-	{0x3FCFFDFFFFFFFEFF, 0x33FF},
-	{0xC800000000100000, 0xFC00},
-	{0xB38FFE7FFFFFFFFE, 0x8000},
-	{0xC800000000200000, 0xFC00},
-	{0x4010000004002000, 0x4400},
-	{0xC800000000400000, 0xFC00},
-	{0x40A0000FFFFFEFFE, 0x6800},
-	{0xC800000000800000, 0xFC00},
-	{0xDA100000200007FF, 0xFC00},
-	{0xC800000001000000, 0xFC00},
-	{0xD8E8800000000000, 0xFC00},
-	{0xC800000002000000, 0xFC00},
-	{0x3FDFFDFFFFFBFFFF, 0x37FF},
-	{0xC800000004000000, 0xFC00},
-	{0xC1EFFFFFFFEFFFFC, 0xFC00},
-	{0xC800000008000000, 0xFC00},
-	{0x405FFFFFFFFF0FFE, 0x5800},
-	{0xC800000010000000, 0xFC00},
-	{0x43C003FFFFFFFF7F, 0x7C00},
-	{0xC800000020000000, 0xFC00},
-	{0xC7FFBD7DB8F4DD9B, 0xFC00},
-	{0xC800000040000000, 0xFC00},
-	{0x1A882CFE289E7B1A, 0x0000},
-	{0xC800000080000000, 0xFC00},
-	{0x401FFDFFFDFFFFFF, 0x47FF},
-	{0xC800000100000000, 0xFC00},
-	{0xC0016D4700B5F4DE, 0xC05B},
-	{0xC800000200000000, 0xFC00},
-	{0x92E969BB7F8A7613, 0x8000},
-	{0xC800000400000000, 0xFC00},
-	{0xC34AAFD7B99B498E, 0xFC00},
-	{0xC800000800000000, 0xFC00},
-	{0xC7E000000000013E, 0xFC00},
-	{0xC800001000000000, 0xFC00},
-	{0xB81FFFFFFFFFF03F, 0x8000},
-	{0xC800002000000000, 0xFC00},
-	{0x40376C2225241A59, 0x4DDB},
-	{0xC800004000000000, 0xFC00},
-	{0x401F08DC6821DB3E, 0x47C2},
-	{0xC800008000000000, 0xFC00},
-	{0xC800007FFFF00000, 0xFC00},
-	{0xC800010000000000, 0xFC00},
-	{0xBFCFFFFFFFEFFFF7, 0xB400},
-	{0xC800020000000000, 0xFC00},
-	{0x3FFFFF000001FFFF, 0x4000},
-	{0xC800040000000000, 0xFC00},
-	{0x95E9DAD2B896B2E8, 0x8000},
-	{0xC800080000000000, 0xFC00},
-	{0x4A7EFFFFFFFDFFFE, 0x7C00},
-	{0xC800100000000000, 0xFC00},
-	{0x800FFFFBFFDFFFFE, 0x8000},
-	{0xC800200000000000, 0xFC00},
-	{0xBF0C7FE6E2BB188E, 0x8390},
-	{0xC800400000000000, 0xFC00},
-	{0x401FFFFFFFB7FFFF, 0x4800},
-	{0xC800800000000000, 0xFC00},
-	{0xC3DFFFF0000000FF, 0xFC00},
-	{0xC801000000000000, 0xFC00},
-	{0xBC1000FFFFFFFBFF, 0x8000},
-	{0xC802000000000000, 0xFC00},
-	{0xC20DC8A46B2DACDD, 0xFC00},
-	{0xC804000000000000, 0xFC00},
-	{0x7FDD67EE4E8FC67F, 0x7C00},
-	{0xC808000000000000, 0xFC00},
-	{0xC314453E8FE8F346, 0xFC00},
-	{0xC80C000000000000, 0xFC00},
-	{0x41FFFFDFFFFFF7FF, 0x7C00},
-	{0xC80E000000000000, 0xFC00},
-	{0x4E50001FFFFEFFFE, 0x7C00},
-	{0xC80F000000000000, 0xFC00},
-	{0xC02FFF80000003FF, 0xCC00},
-	{0xC80F800000000000, 0xFC00},
-	{0x4010000000020400, 0x4400},
-	{0xC80FC00000000000, 0xFC00},
-	{0xC07FFFFFC0001000, 0xE000},
-	{0xC80FE00000000000, 0xFC00},
-	{0x3FF3D2BD18373669, 0x3CF5},
-	{0xC80FF00000000000, 0xFC00},
-	{0x3D0FFF8000003FFF, 0x0000},
-	{0xC80FF80000000000, 0xFC00},
-	{0x480FFFE001FFFFFF, 0x7C00},
-	{0xC80FFC0000000000, 0xFC00},
-	{0xC3FFFFF7F7FFFFFE, 0xFC00},
-	{0xC80FFE0000000000, 0xFC00},
-	{0x3421B4DB21B04D58, 0x0000},
-	{0xC80FFF0000000000, 0xFC00},
-	{0x40003FFFFFFFFC00, 0x4010},
-	{0xC80FFF8000000000, 0xFC00},
-	{0x0F3FFFFF7FFFFFFB, 0x0000},
-	{0xC80FFFC000000000, 0xFC00},
-	{0xBFDFFFFC08000000, 0xB800},
-	{0xC80FFFE000000000, 0xFC00},
-	{0xC1DC841A40FF94FB, 0xFC00},
-	{0xC80FFFF000000000, 0xFC00},
-	{0xC6BE000FFFFFFFFF, 0xFC00},
-	{0xC80FFFF800000000, 0xFC00},
-	{0xBEEC5D6E37937E68, 0x80E3},
-	{0xC80FFFFC00000000, 0xFC00},
-	{0xFFF000000FFBFFFE, 0xFE00},
-	{0xC80FFFFE00000000, 0xFC00},
-	{0x41DFF00003FFFFFF, 0x7C00},
-	{0xC80FFFFF00000000, 0xFC00},
-	{0xBFC020003FFFFFFF, 0xB008},
-	{0xC80FFFFF80000000, 0xFC00},
-	{0x41C21DFEEA0A4935, 0x7C00},
-	{0xC80FFFFFC0000000, 0xFC00},
-	{0xC80BFFFFFFFF0000, 0xFC00},
-	{0xC80FFFFFE0000000, 0xFC00},
-	{0x6D506B1F5781CDBE, 0x7C00},
-	{0xC80FFFFFF0000000, 0xFC00},
-	{0x381FFFFFFFBFFFE0, 0x0000},
-	{0xC80FFFFFF8000000, 0xFC00},
-	{0xC0EFFFFFFFFDFDFE, 0xFC00},
-	{0xC80FFFFFFC000000, 0xFC00},
-	{0xC1FC00001FFFFFFF, 0xFC00},
-	{0xC80FFFFFFE000000, 0xFC00},
-	{0x47F0080000000800, 0x7C00},
-	{0xC80FFFFFFF000000, 0xFC00},
-	{0xC20FFFFFFFFEFFFC, 0xFC00},
-	{0xC80FFFFFFF800000, 0xFC00},
-	{0x9FB0000000BFFFFF, 0x8000},
-	{0xC80FFFFFFFC00000, 0xFC00},
-	{0xBFFFFFFEFFFFFFFF, 0xC000},
-	{0xC80FFFFFFFE00000, 0xFC00},
-	{0x4000000004007FFE, 0x4000},
-	{0xC80FFFFFFFF00000, 0xFC00},
-	{0xBFFFFFFFFFFF7F00, 0xC000},
-	{0xC80FFFFFFFF80000, 0xFC00},
-	{0x41F199418A71FD37, 0x7C00},
-	{0xC80FFFFFFFFC0000, 0xFC00},
-	{0x37FEFFFFFFFFFDFF, 0x0000},
-	{0xC80FFFFFFFFE0000, 0xFC00},
-	{0xBFFF03FFFFFFFFFF, 0xBFC1},
-	{0xC80FFFFFFFFF0000, 0xFC00},
-	{0x40500800000000FF, 0x5402},
-	{0xC80FFFFFFFFF8000, 0xFC00},
-	{0xB8100004000000FF, 0x8000},
-	{0xC80FFFFFFFFFC000, 0xFC00},
-	{0x400FFFFFFFFF0FFF, 0x4400},
-	{0xC80FFFFFFFFFE000, 0xFC00},
-	{0x4000000000100007, 0x4000},
-	{0xC80FFFFFFFFFF000, 0xFC00},
-	{0x4027BBC6EE3C2A32, 0x49EF},
-	{0xC80FFFFFFFFFF800, 0xFC00},
-	{0xC342100000000000, 0xFC00},
-	{0xC80FFFFFFFFFFC00, 0xFC00},
-	{0xC3C0000007FFFFFF, 0xFC00},
-	{0xC80FFFFFFFFFFE00, 0xFC00},
-	{0x40AFFFF7FFFFFFFB, 0x6C00},
-	{0xC80FFFFFFFFFFF00, 0xFC00},
-	{0xE66F80000000003F, 0xFC00},
-	{0xC80FFFFFFFFFFF80, 0xFC00},
-	{0x37E000007FFFFFC0, 0x0000},
-	{0xC80FFFFFFFFFFFC0, 0xFC00},
-	{0x43FFFFFF7FFFDFFF, 0x7C00},
-	{0xC80FFFFFFFFFFFE0, 0xFC00},
-	{0x37E3711588193323, 0x0000},
-	{0xC80FFFFFFFFFFFF0, 0xFC00},
-	{0x7FEFFFFFF8000003, 0x7C00},
-	{0xC80FFFFFFFFFFFF8, 0xFC00},
-	{0xC3BF80000000FFFE, 0xFC00},
-	{0xC80FFFFFFFFFFFFC, 0xFC00},
-	{0xC1EFFFFFFC03FFFE, 0xFC00},
-	{0xC80FFFFFFFFFFFFE, 0xFC00},
-	{0x8CB0000003FFFFE0, 0x8000},
-	{0xC80FFFFFFFFFFFFF, 0xFC00},
-	{0xC01FFFFFFFFE0FFE, 0xC800},
-	{0xC80FFFFFFFFFFFFD, 0xFC00},
-	{0xBFC03FFFFFFFFFF7, 0xB010},
-	{0xC80FFFFFFFFFFFFB, 0xFC00},
-	{0x43C166E502655883, 0x7C00},
-	{0xC80FFFFFFFFFFFF7, 0xFC00},
-	{0x3FBFFFFC00FFFFFE, 0x3000},
-	{0xC80FFFFFFFFFFFEF, 0xFC00},
-	{0xBFBFFEFE00000000, 0xB000},
-	{0xC80FFFFFFFFFFFDF, 0xFC00},
-	{0x401FFFFFFFDF7FFE, 0x4800},
-	{0xC80FFFFFFFFFFFBF, 0xFC00},
-	{0xC0900041FFFFFFFE, 0xE400},
-	{0xC80FFFFFFFFFFF7F, 0xFC00},
-	{0x7FD1E348EB57F30B, 0x7C00},
-	{0xC80FFFFFFFFFFEFF, 0xFC00},
-	{0x41C111EBD5DBE4BB, 0x7C00},
-	{0xC80FFFFFFFFFFDFF, 0xFC00},
-	{0x40F007FFFFF7FFFF, 0x7C00},
-	{0xC80FFFFFFFFFFBFF, 0xFC00},
-	{0x434FFFFFFFFE001F, 0x7C00},
-	{0xC80FFFFFFFFFF7FF, 0xFC00},
-	{0x4720000003FFFFFC, 0x7C00},
-	{0xC80FFFFFFFFFEFFF, 0xFC00},
-	{0x41CFFFF80000007F, 0x7C00},
-	{0xC80FFFFFFFFFDFFF, 0xFC00},
-	{0xC3F7FFFFF7FFFFFF, 0xFC00},
-	{0xC80FFFFFFFFFBFFF, 0xFC00},
-	{0xC34FE003FFFFFFFF, 0xFC00},
-	{0xC80FFFFFFFFF7FFF, 0xFC00},
-	{0xC34000000000047F, 0xFC00},
-	{0xC80FFFFFFFFEFFFF, 0xFC00},
-	{0xBF90080000800000, 0xA402},
-	{0xC80FFFFFFFFDFFFF, 0xFC00},
-	{0xC1D8F4256AF6845D, 0xFC00},
-	{0xC80FFFFFFFFBFFFF, 0xFC00},
-	{0x3FE0000000F7FFFE, 0x3800},
-	{0xC80FFFFFFFF7FFFF, 0xFC00},
-	{0x3E1000001007FFFF, 0x0000},
-	{0xC80FFFFFFFEFFFFF, 0xFC00},
-	{0xC1D00000003BFFFE, 0xFC00},
-	{0xC80FFFFFFFDFFFFF, 0xFC00},
-	{0xBCA0040000200000, 0x8000},
-	{0xC80FFFFFFFBFFFFF, 0xFC00},
-	{0xC1FFFFF000080000, 0xFC00},
-	{0xC80FFFFFFF7FFFFF, 0xFC00},
-	{0x41D0000001FFFDFE, 0x7C00},
-	{0xC80FFFFFFEFFFFFF, 0xFC00},
-	{0x47FFF00000000010, 0x7C00},
-	{0xC80FFFFFFDFFFFFF, 0xFC00},
-	{0x009FFE0000000001, 0x0000},
-	{0xC80FFFFFFBFFFFFF, 0xFC00},
-	{0x30A0000FFFFF7FFF, 0x0000},
-	{0xC80FFFFFF7FFFFFF, 0xFC00},
-	{0x480F8FD2C7D9DC19, 0x7C00},
-	{0xC80FFFFFEFFFFFFF, 0xFC00},
-	{0x1A70001000000003, 0x0000},
-	{0xC80FFFFFDFFFFFFF, 0xFC00},
-	{0xC3EF4F8C8CA5D199, 0xFC00},
-	{0xC80FFFFFBFFFFFFF, 0xFC00},
-	{0x3FEA9525ED1E8F82, 0x3AA5},
-	{0xC80FFFFF7FFFFFFF, 0xFC00},
-	{0x2230000000000800, 0x0000},
-	{0xC80FFFFEFFFFFFFF, 0xFC00},
-	{0x3F5DFFE000000000, 0x1780},
-	{0xC80FFFFDFFFFFFFF, 0xFC00},
-	{0x8020010000002000, 0x8000},
-	{0xC80FFFFBFFFFFFFF, 0xFC00},
-	{0xBFCFE7FFFFFFFFFF, 0xB3FA},
-	{0xC80FFFF7FFFFFFFF, 0xFC00},
-	{0xC02000000001FFFF, 0xC800},
-	{0xC80FFFEFFFFFFFFF, 0xFC00},
-	{0x41EBD541B8E71BDE, 0x7C00},
-	{0xC80FFFDFFFFFFFFF, 0xFC00},
-	{0xBF1FFFBFFFFFFFF7, 0x8800},
-	{0xC80FFFBFFFFFFFFF, 0xFC00},
-	{0x4000000040800000, 0x4000},
-	{0xC80FFF7FFFFFFFFF, 0xFC00},
-	{0x400FFFC000004000, 0x4400},
-	{0xC80FFEFFFFFFFFFF, 0xFC00},
-	{0xC030080000000006, 0xCC02},
-	{0xC80FFDFFFFFFFFFF, 0xFC00},
-	{0xA08000000000040E, 0x8000},
-	{0xC80FFBFFFFFFFFFF, 0xFC00},
-	{0x37E6933889EB05AC, 0x0000},
-	{0xC80FF7FFFFFFFFFF, 0xFC00},
-	{0xC34000000000007F, 0xFC00},
-	{0xC80FEFFFFFFFFFFF, 0xFC00},
-	{0xC52FFFFFFDFEFFFF, 0xFC00},
-	{0xC80FDFFFFFFFFFFF, 0xFC00},
-	{0xC3A000007FFFE000, 0xFC00},
-	{0xC80FBFFFFFFFFFFF, 0xFC00},
-	{0x47F8040000000000, 0x7C00},
-	{0xC80F7FFFFFFFFFFF, 0xFC00},
-	{0xE8FDEFFFFFFFFFFE, 0xFC00},
-	{0xC80EFFFFFFFFFFFF, 0xFC00},
-	{0xB8105D43BD5388BD, 0x8000},
-	{0xC80DFFFFFFFFFFFF, 0xFC00},
-	{0x801FE00000004000, 0x8000},
-	{0xC80BFFFFFFFFFFFF, 0xFC00},
-	{0xC02FFFFFFFF1FFFE, 0xCC00},
-	{0xC807FFFFFFFFFFFF, 0xFC00},
-	{0xC44FFFFC0000003E, 0xFC00},
-	{0xC803FFFFFFFFFFFF, 0xFC00},
-	{0xC01F000FFFFFFFFF, 0xC7C0},
-	{0xC801FFFFFFFFFFFF, 0xFC00},
-	{0x4760000000040007, 0x7C00},
-	{0xC800FFFFFFFFFFFF, 0xFC00},
-	{0x415FFFEDFFFFFFFF, 0x7C00},
-	{0xC8007FFFFFFFFFFF, 0xFC00},
-	{0xBCAFFDFE00000000, 0x8000},
-	{0xC8003FFFFFFFFFFF, 0xFC00},
-	{0xC0CE37CECD02794D, 0xF38E},
-	{0xC8001FFFFFFFFFFF, 0xFC00},
-	{0x7FEE000000010000, 0x7C00},
-	{0xC8000FFFFFFFFFFF, 0xFC00},
-	{0xBFCFFFFFC007FFFF, 0xB400},
-	{0xC80007FFFFFFFFFF, 0xFC00},
-	{0x4AE000001000FFFF, 0x7C00},
-	{0xC80003FFFFFFFFFF, 0xFC00},
-	{0x425FFFFFF800001F, 0x7C00},
-	{0xC80001FFFFFFFFFF, 0xFC00},
-	{0x4EC0001FFBFFFFFF, 0x7C00},
-	{0xC80000FFFFFFFFFF, 0xFC00},
-	{0xBFE0000004003FFF, 0xB800},
-	{0xC800007FFFFFFFFF, 0xFC00},
-	{0x3FEFFF7FFFFFFEFE, 0x3C00},
-	{0xC800003FFFFFFFFF, 0xFC00},
-	{0x4020000000080003, 0x4800},
-	{0xC800001FFFFFFFFF, 0xFC00},
-	{0x38175F14B8F1FF42, 0x0000},
-	{0xC800000FFFFFFFFF, 0xFC00},
-	{0x3FF0040000001000, 0x3C01},
-	{0xC8000007FFFFFFFF, 0xFC00},
-	{0xFFFC461DFE9DDDA4, 0xFF11},
-	{0xC8000003FFFFFFFF, 0xFC00},
-	{0x3810000003FEFFFF, 0x0000},
-	{0xC8000001FFFFFFFF, 0xFC00},
-	{0xBFDFFBFFFFFFFBFE, 0xB7FF},
-	{0xC8000000FFFFFFFF, 0xFC00},
-	{0x407FFFC000000020, 0x6000},
-	{0xC80000007FFFFFFF, 0xFC00},
-	{0x47FFFFFFFFFFFDFE, 0x7C00},
-	{0xC80000003FFFFFFF, 0xFC00},
-	{0xC01EE2757BF951D0, 0xC7B9},
-	{0xC80000001FFFFFFF, 0xFC00},
-	{0xB8100000007FF7FF, 0x8000},
-	{0xC80000000FFFFFFF, 0xFC00},
-	{0x945FB72F6E1062CE, 0x8000},
-	{0xC800000007FFFFFF, 0xFC00},
-	{0x281FFFFFFFFF7EFE, 0x0000},
-	{0xC800000003FFFFFF, 0xFC00},
-	{0xF5B0003FFFFFFFFC, 0xFC00},
-	{0xC800000001FFFFFF, 0xFC00},
-	{0x401FC0000000FFFF, 0x47F0},
-	{0xC800000000FFFFFF, 0xFC00},
-	{0x37E0000006FFFFFF, 0x0000},
-	{0xC8000000007FFFFF, 0xFC00},
-	{0xBFFA7CE207F833F7, 0xBE9F},
-	{0xC8000000003FFFFF, 0xFC00},
-	{0xBFF7E3C3D4252A2D, 0xBDF9},
-	{0xC8000000001FFFFF, 0xFC00},
-	{0xBFEFFFFFFFFAFFFF, 0xBC00},
-	{0xC8000000000FFFFF, 0xFC00},
-	{0xCE000000000200FE, 0xFC00},
-	{0xC80000000007FFFF, 0xFC00},
-	{0xC0C7DE34330B33D3, 0xF1F8},
-	{0xC80000000003FFFF, 0xFC00},
-	{0xC0E0000000006FFF, 0xF800},
-	{0xC80000000001FFFF, 0xFC00},
-	{0xC0169FFFE060D1A9, 0xC5A8},
-	{0xC80000000000FFFF, 0xFC00},
-	{0xD6B7FBFFFFFFFFFF, 0xFC00},
-	{0xC800000000007FFF, 0xFC00},
-	{0xC7A00EFFFFFFFFFF, 0xFC00},
-	{0xC800000000003FFF, 0xFC00},
-	{0xB7F268D8B1059103, 0x8000},
-	{0xC800000000001FFF, 0xFC00},
-	{0x381FFFDFFFFFFF7E, 0x0000},
-	{0xC800000000000FFF, 0xFC00},
-	{0x380FFFFFFFFE3FFF, 0x0000},
-	{0xC8000000000007FF, 0xFC00},
-	{0x3E91717A76633483, 0x0004},
-	{0xC8000000000003FF, 0xFC00},
-	{0x3E4FFFFF07FFFFFF, 0x0000},
-	{0xC8000000000001FF, 0xFC00},
-	{0x402FFFFFE03FFFFF, 0x4C00},
-	{0xC8000000000000FF, 0xFC00},
-	{0x7FD200000003FFFE, 0x7C00},
-	{0xC80000000000007F, 0xFC00},
-	{0x409FFDFFFFFEFFFE, 0x67FF},
-	{0xC80000000000003F, 0xFC00},
-	{0x2E9FFFFFFFFFFFFF, 0x0000},
-	{0xC80000000000001F, 0xFC00},
-	{0x3F9FFFFFF007FFFF, 0x2800},
-	{0xC80000000000000F, 0xFC00},
-	{0x32D000007FBFFFFF, 0x0000},
-	{0xC800000000000007, 0xFC00},
-	{0xC3D0BAEF46ABD1A6, 0xFC00},
-	{0xC800000000000003, 0xFC00},
-	{0xB7FFFFFFEFFFF000, 0x8000},
-	{0xFFD0000000000000, 0xFC00},
-	{0x4019FC19EC75877C, 0x467F},
-	{0xFFD0000000000001, 0xFC00},
-	{0x3FBFFFFFFC000FFF, 0x3000},
-	{0xFFD0000000000002, 0xFC00},
-	{0x80100020003FFFFE, 0x8000},
-	{0xFFD0000000000004, 0xFC00},
-	{0x0010000000000000, 0x0000},
-	{0xFFD0000000000008, 0xFC00},
-	{0xC1D000007FEFFFFF, 0xFC00},
-	{0xFFD0000000000010, 0xFC00},
-	{0x43408000000007FE, 0x7C00},
-	{0xFFD0000000000020, 0xFC00},
-	{0x3FF69EA5438A5BFF, 0x3DA8},
-	{0xFFD0000000000040, 0xFC00},
-	{0x41F03FFFFFFFFEFF, 0x7C00},
-	{0xFFD0000000000080, 0xFC00},
-	{0xBCA37382544B0C6B, 0x8000},
-	{0xFFD0000000000100, 0xFC00},
-	{0x38F0000804000000, 0x0000},
-	{0xFFD0000000000200, 0xFC00},
-	{0xB807997EA3590EBF, 0x8000},
-	{0xFFD0000000000400, 0xFC00},
-	{0x7FE0B2894B030DE6, 0x7C00},
-	{0xFFD0000000000800, 0xFC00},
-	{0xC34FFFFFFFFFFFBF, 0xFC00},
-	{0xFFD0000000001000, 0xFC00},
-	{0x5CB007FFFFDFFFFF, 0x7C00},
-	{0xFFD0000000002000, 0xFC00},
-	{0x80115BB6A2EF4E82, 0x8000},
-	{0xFFD0000000004000, 0xFC00},
-	{0x7FEFFFFFFFFC003E, 0x7C00},
-	{0xFFD0000000008000, 0xFC00},
-	{0x2E740DEB28B3073A, 0x0000},
-	{0xFFD0000000010000, 0xFC00},
-	{0xC1A000003EFFFFFE, 0xFC00},
-	{0xFFD0000000020000, 0xFC00},
-	{0xBFD400FFFFFFFFFF, 0xB500},
-	{0xFFD0000000040000, 0xFC00},
-	{0xC0CA6182C8A0833F, 0xF298},
-	{0xFFD0000000080000, 0xFC00},
-	{0xAA4FF77FFFFFFFFF, 0x8000},
-	{0xFFD0000000100000, 0xFC00},
-	{0xCF88F98E0D5480AE, 0xFC00},
-	{0xFFD0000000200000, 0xFC00},
-	{0x45FFFEFFFFFFFF00, 0x7C00},
-	{0xFFD0000000400000, 0xFC00},
-	{0x001FFFFFFFFEFEFF, 0x0000},
-	{0xFFD0000000800000, 0xFC00},
-	{0xFFF87816A6E51643, 0xFE1E},
-	{0xFFD0000001000000, 0xFC00},
-	{0x403F4C5317C8748A, 0x4FD3},
-	{0xFFD0000002000000, 0xFC00},
-	{0xC0700007FEFFFFFF, 0xDC00},
-	{0xFFD0000004000000, 0xFC00},
-	{0xB7AFFFC007FFFFFF, 0x8000},
-	{0xFFD0000008000000, 0xFC00},
-	{0x403E80D497C03A23, 0x4FA0},
-	{0xFFD0000010000000, 0xFC00},
-	{0xC00004000000FFFF, 0xC001},
-	{0xFFD0000020000000, 0xFC00},
-	{0x480010007FFFFFFF, 0x7C00},
-	{0xFFD0000040000000, 0xFC00},
-	{0x405FFFFFF000001F, 0x5800},
-	{0xFFD0000080000000, 0xFC00},
-	{0xFFFFFEFE00000000, 0xFFFF},
-	{0xFFD0000100000000, 0xFC00},
-	{0xCE40203FFFFFFFFE, 0xFC00},
-	{0xFFD0000200000000, 0xFC00},
-	{0xCAB514CEADC37B2B, 0xFC00},
-	{0xFFD0000400000000, 0xFC00},
-	{0x41F199361C165026, 0x7C00},
-	{0xFFD0000800000000, 0xFC00},
-	{0x3BDFFFFF0001FFFF, 0x0000},
-	{0xFFD0001000000000, 0xFC00},
-	{0x99C000000000200E, 0x8000},
-	{0xFFD0002000000000, 0xFC00},
-	{0x3FC7FE0000000000, 0x3200},
-	{0xFFD0004000000000, 0xFC00},
-	{0xC020000000400200, 0xC800},
-	{0xFFD0008000000000, 0xFC00},
-	{0x32E6A200CC4FB0C1, 0x0000},
-	{0xFFD0010000000000, 0xFC00},
-	{0x93BFEFFFFFFBFFFE, 0x8000},
-	{0xFFD0020000000000, 0xFC00},
-	{0xA13DFFFFFFFFFFF8, 0x8000},
-	{0xFFD0040000000000, 0xFC00},
-	{0xBB4DCFA9A6107992, 0x8000},
-	{0xFFD0080000000000, 0xFC00},
-	{0xB070003FFFFFF7FE, 0x8000},
-	{0xFFD0100000000000, 0xFC00},
-	{0x3ED000FFFFFFE000, 0x0040},
-	{0xFFD0200000000000, 0xFC00},
-	{0xC0CB601E91F32231, 0xF2D8},
-	{0xFFD0400000000000, 0xFC00},
-	{0x7FE667CDD21FCEAE, 0x7C00},
-	{0xFFD0800000000000, 0xFC00},
-	{0x7FF00FFFFFFFFEFF, 0x7E03},
-	{0xFFD1000000000000, 0xFC00},
-	{0xC7E0A4D242ACB1A5, 0xFC00},
-	{0xFFD2000000000000, 0xFC00},
-	{0xD7EC269C7D106799, 0xFC00},
-	{0xFFD4000000000000, 0xFC00},
-	{0x499BDB2D9877DD92, 0x7C00},
-	{0xFFD8000000000000, 0xFC00},
-	{0x381FFF8001FFFFFE, 0x0000},
-	{0xFFDC000000000000, 0xFC00},
-	{0x43CFFFFFEFFFFFFF, 0x7C00},
-	{0xFFDE000000000000, 0xFC00},
-	{0x07FFFBFE00000000, 0x0000},
-	{0xFFDF000000000000, 0xFC00},
-	{0xBFC017FFFFFFFFFF, 0xB006},
-	{0xFFDF800000000000, 0xFC00},
-	{0x36E000000403FFFF, 0x0000},
-	{0xFFDFC00000000000, 0xFC00},
-	{0xB6E00BFFFFFFFFFE, 0x8000},
-	{0xFFDFE00000000000, 0xFC00},
-	{0x41CE000000008000, 0x7C00},
-	{0xFFDFF00000000000, 0xFC00},
-	{0xC37000000002000F, 0xFC00},
-	{0xFFDFF80000000000, 0xFC00},
-	{0xBFEF7FFFBFFFFFFF, 0xBBE0},
-	{0xFFDFFC0000000000, 0xFC00},
-	{0x39500000000043FF, 0x0000},
-	{0xFFDFFE0000000000, 0xFC00},
-	{0x480014575CEC2D68, 0x7C00},
-	{0xFFDFFF0000000000, 0xFC00},
-	{0xC0F03FFFFFFFFFF8, 0xFC00},
-	{0xFFDFFF8000000000, 0xFC00},
-	{0xBFC000400FFFFFFE, 0xB000},
-	{0xFFDFFFC000000000, 0xFC00},
-	{0x80241BA6CB595A6D, 0x8000},
-	{0xFFDFFFE000000000, 0xFC00},
-	{0x3FDFFFFFFEFFFFBF, 0x3800},
-	{0xFFDFFFF000000000, 0xFC00},
-	{0xC1E4000FFFFFFFFF, 0xFC00},
-	{0xFFDFFFF800000000, 0xFC00},
-	{0x3FB000000000001D, 0x2C00},
-	{0xFFDFFFFC00000000, 0xFC00},
-	{0xBFC17FFFFFFFFFFE, 0xB060},
-	{0xFFDFFFFE00000000, 0xFC00},
-	{0xBFE0004000000400, 0xB800},
-	{0xFFDFFFFF00000000, 0xFC00},
-	{0xC3DE000000000008, 0xFC00},
-	{0xFFDFFFFF80000000, 0xFC00},
-	{0x3677ABA8CA645139, 0x0000},
-	{0xFFDFFFFFC0000000, 0xFC00},
-	{0x3FE02000000003FF, 0x3808},
-	{0xFFDFFFFFE0000000, 0xFC00},
-	{0xBF8FFFFFFDFFFEFF, 0xA400},
-	{0xFFDFFFFFF0000000, 0xFC00},
-	{0x20CFFFFFBFC00000, 0x0000},
-	{0xFFDFFFFFF8000000, 0xFC00},
-	{0xC0A06964D3DA8425, 0xE81A},
-	{0xFFDFFFFFFC000000, 0xFC00},
-	{0xBFF000040000FFFE, 0xBC00},
-	{0xFFDFFFFFFE000000, 0xFC00},
-	{0x5080100000000002, 0x7C00},
-	{0xFFDFFFFFFF000000, 0xFC00},
-	{0x41DDB117AAE92549, 0x7C00},
-	{0xFFDFFFFFFF800000, 0xFC00},
-	{0xC7FD060DB00E2A3C, 0xFC00},
-	{0xFFDFFFFFFFC00000, 0xFC00},
-	{0xC07C001FFFFFFFFF, 0xDF00},
-	{0xFFDFFFFFFFE00000, 0xFC00},
-	{0x40DFFFFFFFFFF03F, 0x7800},
-	{0xFFDFFFFFFFF00000, 0xFC00},
-	{0x3CAF6378464F88CB, 0x0000},
-	{0xFFDFFFFFFFF80000, 0xFC00},
-	{0xB8088ADCF304DBA9, 0x8000},
-	{0xFFDFFFFFFFFC0000, 0xFC00},
-	{0xC04007BFFFFFFFFE, 0xD002},
-	{0xFFDFFFFFFFFE0000, 0xFC00},
-	{0xC5B080007FFFFFFE, 0xFC00},
-	{0xFFDFFFFFFFFF0000, 0xFC00},
-	{0xBFD007FF00000000, 0xB402},
-	{0xFFDFFFFFFFFF8000, 0xFC00},
-	{0xC3C1E864E1ADE750, 0xFC00},
-	{0xFFDFFFFFFFFFC000, 0xFC00},
-	{0xC3F000000400001F, 0xFC00},
-	{0xFFDFFFFFFFFFE000, 0xFC00},
-	{0x3FD0000000480000, 0x3400},
-	{0xFFDFFFFFFFFFF000, 0xFC00},
-	{0x3FD0004000000200, 0x3400},
-	{0xFFDFFFFFFFFFF800, 0xFC00},
-	{0x402080001FFFFFFE, 0x4820},
-	{0xFFDFFFFFFFFFFC00, 0xFC00},
-	{0xC8C0020000000010, 0xFC00},
-	{0xFFDFFFFFFFFFFE00, 0xFC00},
-	{0x407004000001FFFF, 0x5C01},
-	{0xFFDFFFFFFFFFFF00, 0xFC00},
-	{0x41F3C3CABF050125, 0x7C00},
-	{0xFFDFFFFFFFFFFF80, 0xFC00},
-	{0xB7EFFFE0FFFFFFFF, 0x8000},
-	{0xFFDFFFFFFFFFFFC0, 0xFC00},
-	{0xA3CF7FFFFBFFFFFF, 0x8000},
-	{0xFFDFFFFFFFFFFFE0, 0xFC00},
-	{0x342100000FFFFFFF, 0x0000},
-	{0xFFDFFFFFFFFFFFF0, 0xFC00},
-	{0x4620220000000000, 0x7C00},
-	{0xFFDFFFFFFFFFFFF8, 0xFC00},
-	{0xAA702FFFFFFFFFFF, 0x8000},
-	{0xFFDFFFFFFFFFFFFC, 0xFC00},
-	{0xBF70003FFC000000, 0x9C00},
-	{0xFFDFFFFFFFFFFFFE, 0xFC00},
-	{0xC433C4A4E4AD79D6, 0xFC00},
-	{0xFFDFFFFFFFFFFFFF, 0xFC00},
-	{0x41D000004000007E, 0x7C00},
-	{0xFFDFFFFFFFFFFFFD, 0xFC00},
-	{0xC0A0000000020040, 0xE800},
-	{0xFFDFFFFFFFFFFFFB, 0xFC00},
-	{0x400FFFFBF7FFFFFF, 0x4400},
-	{0xFFDFFFFFFFFFFFF7, 0xFC00},
-	{0x8008C396364CCE63, 0x8000},
-	{0xFFDFFFFFFFFFFFEF, 0xFC00},
-	{0xC3CF7FFFFF000000, 0xFC00},
-	{0xFFDFFFFFFFFFFFDF, 0xFC00},
-	{0x40DFFFFC000003FF, 0x7800},
-	{0xFFDFFFFFFFFFFFBF, 0xFC00},
-	{0x82AFFF4000000000, 0x8000},
-	{0xFFDFFFFFFFFFFF7F, 0xFC00},
-	{0xC01A2B0B4726A7D0, 0xC68B},
-	{0xFFDFFFFFFFFFFEFF, 0xFC00},
-	{0x403007FFFBFFFFFF, 0x4C02},
-	{0xFFDFFFFFFFFFFDFF, 0xFC00},
-	{0x55CAEAD3443BE09A, 0x7C00},
-	{0xFFDFFFFFFFFFFBFF, 0xFC00},
-	{0x4025E36990D4AEF5, 0x4979},
-	{0xFFDFFFFFFFFFF7FF, 0xFC00},
-	{0xB86000401FFFFFFF, 0x8000},
-	{0xFFDFFFFFFFFFEFFF, 0xFC00},
-	{0x47F00000001FFFDF, 0x7C00},
-	{0xFFDFFFFFFFFFDFFF, 0xFC00},
-	{0x8D60000000007FFC, 0x8000},
-	{0xFFDFFFFFFFFFBFFF, 0xFC00},
-	{0x40BFF7FFFFFFFFDE, 0x6FFE},
-	{0xFFDFFFFFFFFF7FFF, 0xFC00},
-	{0x7FD020000FFFFFFF, 0x7C00},
-	{0xFFDFFFFFFFFEFFFF, 0xFC00},
-	{0x3E08FC19269C159E, 0x0000},
-	{0xFFDFFFFFFFFDFFFF, 0xFC00},
-	{0x7FF001000000007E, 0x7E00},
-	{0xFFDFFFFFFFFBFFFF, 0xFC00},
-	{0x43DBBC91E9B0D7BA, 0x7C00},
-	{0xFFDFFFFFFFF7FFFF, 0xFC00},
-	{0x3FFE0000003FFFFF, 0x3F80},
-	{0xFFDFFFFFFFEFFFFF, 0xFC00},
-	{0xC1421206D114AA36, 0xFC00},
-	{0xFFDFFFFFFFDFFFFF, 0xFC00},
-	{0x37EFDFFFFFFFFEFE, 0x0000},
-	{0xFFDFFFFFFFBFFFFF, 0xFC00},
-	{0xB7F00007FFFFF7FE, 0x8000},
-	{0xFFDFFFFFFF7FFFFF, 0xFC00},
-	{0x80100004000007FE, 0x8000},
-	{0xFFDFFFFFFEFFFFFF, 0xFC00},
-	{0x32E000000000101F, 0x0000},
-	{0xFFDFFFFFFDFFFFFF, 0xFC00},
-	{0xBFEEFFFFFFFFFFFF, 0xBBC0},
-	{0xFFDFFFFFFBFFFFFF, 0xFC00},
-	{0xBFF1001000000000, 0xBC40},
-	{0xFFDFFFFFF7FFFFFF, 0xFC00},
-	{0xC014573D1047FF3A, 0xC516},
-	{0xFFDFFFFFEFFFFFFF, 0xFC00},
-	{0x001000000004003F, 0x0000},
-	{0xFFDFFFFFDFFFFFFF, 0xFC00},
-	{0xC3F0000000009FFE, 0xFC00},
-	{0xFFDFFFFFBFFFFFFF, 0xFC00},
-	{0xAE6FFE0007FFFFFE, 0x8000},
-	{0xFFDFFFFF7FFFFFFF, 0xFC00},
-	{0x3E86996B9CDBD82D, 0x0003},
-	{0xFFDFFFFEFFFFFFFF, 0xFC00},
-	{0xE5A000007FFFFFF7, 0xFC00},
-	{0xFFDFFFFDFFFFFFFF, 0xFC00},
-	{0x0000000000100006, 0x0000},
-	{0xFFDFFFFBFFFFFFFF, 0xFC00},
-	{0xB86DF5B645D16043, 0x8000},
-	{0xFFDFFFF7FFFFFFFF, 0xFC00},
-	{0xBA0FFC00000003FE, 0x8000},
-	{0xFFDFFFEFFFFFFFFF, 0xFC00},
-	{0xBFDF00000000000F, 0xB7C0},
-	{0xFFDFFFDFFFFFFFFF, 0xFC00},
-	{0xC1C80000007FFFFF, 0xFC00},
-	{0xFFDFFFBFFFFFFFFF, 0xFC00},
-	{0x4027ED753F4FDD10, 0x49FB},
-	{0xFFDFFF7FFFFFFFFF, 0xFC00},
-	{0x469FFFFF7F000000, 0x7C00},
-	{0xFFDFFEFFFFFFFFFF, 0xFC00},
-	{0xC1C00000041FFFFF, 0xFC00},
-	{0xFFDFFDFFFFFFFFFF, 0xFC00},
-	{0x47600000000047FE, 0x7C00},
-	{0xFFDFFBFFFFFFFFFF, 0xFC00},
-	{0xB81000000002000F, 0x8000},
-	{0xFFDFF7FFFFFFFFFF, 0xFC00},
-	{0x43E0000100000FFE, 0x7C00},
-	{0xFFDFEFFFFFFFFFFF, 0xFC00},
-	{0x419FFFEFFFBFFFFE, 0x7C00},
-	{0xFFDFDFFFFFFFFFFF, 0xFC00},
-	{0x32A0003FFFFFFFFB, 0x0000},
-	{0xFFDFBFFFFFFFFFFF, 0xFC00},
-	{0xD3500000100001FE, 0xFC00},
-	{0xFFDF7FFFFFFFFFFF, 0xFC00},
-	{0x41EF000000001000, 0x7C00},
-	{0xFFDEFFFFFFFFFFFF, 0xFC00},
-	{0x4010400400000000, 0x4410},
-	{0xFFDDFFFFFFFFFFFF, 0xFC00},
-	{0xBE7FFFFDFFDFFFFE, 0x8002},
-	{0xFFDBFFFFFFFFFFFF, 0xFC00},
-	{0xC03FFFF1FFFFFFFF, 0xD000},
-	{0xFFD7FFFFFFFFFFFF, 0xFC00},
-	{0x30EFFFFBFFFFFF7F, 0x0000},
-	{0xFFD3FFFFFFFFFFFF, 0xFC00},
-	{0x43CFFFFFFF020000, 0x7C00},
-	{0xFFD1FFFFFFFFFFFF, 0xFC00},
-	{0xFFF000000107FFFF, 0xFE00},
-	{0xFFD0FFFFFFFFFFFF, 0xFC00},
-	{0xC0500000001FFFF7, 0xD400},
-	{0xFFD07FFFFFFFFFFF, 0xFC00},
-	{0x3800007FFFFFFC00, 0x0000},
-	{0xFFD03FFFFFFFFFFF, 0xFC00},
-	{0x43DFFFFFFF8001FF, 0x7C00},
-	{0xFFD01FFFFFFFFFFF, 0xFC00},
-	{0xB58BBFFFFFFFFFFE, 0x8000},
-	{0xFFD00FFFFFFFFFFF, 0xFC00},
-	{0x000001FFFEFFFFFF, 0x0000},
-	{0xFFD007FFFFFFFFFF, 0xFC00},
-	{0xBEEFFC000000001E, 0x8100},
-	{0xFFD003FFFFFFFFFF, 0xFC00},
-	{0x3E5000000020000F, 0x0000},
-	{0xFFD001FFFFFFFFFF, 0xFC00},
-	{0x3FBFFFF7FFFFF800, 0x3000},
-	{0xFFD000FFFFFFFFFF, 0xFC00},
-	{0x3F5515C91262AFF1, 0x1545},
-	{0xFFD0007FFFFFFFFF, 0xFC00},
-	{0x400FDFFFFDFFFFFF, 0x43F8},
-	{0xFFD0003FFFFFFFFF, 0xFC00},
-	{0x7FE2000000000004, 0x7C00},
-	{0xFFD0001FFFFFFFFF, 0xFC00},
-	{0xFFE00200003FFFFF, 0xFC00},
-	{0xFFD0000FFFFFFFFF, 0xFC00},
-	{0x3FF8877229EAF2B0, 0x3E22},
-	{0xFFD00007FFFFFFFF, 0xFC00},
-	{0x3FFFFFFFFFFF7FDF, 0x4000},
-	{0xFFD00003FFFFFFFF, 0xFC00},
-	{0x41C7F1684BD062CB, 0x7C00},
-	{0xFFD00001FFFFFFFF, 0xFC00},
-	{0xC017FDFFFFFFFFFF, 0xC5FF},
-	{0xFFD00000FFFFFFFF, 0xFC00},
-	{0xBFBFFFFFFFDFFEFF, 0xB000},
-	{0xFFD000007FFFFFFF, 0xFC00},
-	{0x86FEC522403241B7, 0x8000},
-	{0xFFD000003FFFFFFF, 0xFC00},
-	{0xEF1FFFE000000FFF, 0xFC00},
-	{0xFFD000001FFFFFFF, 0xFC00},
-	{0x802FFFFFF0100000, 0x8000},
-	{0xFFD000000FFFFFFF, 0xFC00},
-	{0xC0700FF7FFFFFFFF, 0xDC04},
-	{0xFFD0000007FFFFFF, 0xFC00},
-	{0xBE2FFFDFFFFFFFFB, 0x8000},
-	{0xFFD0000003FFFFFF, 0xFC00},
-	{0xBFEFBFFFFFFFFFDF, 0xBBF0},
-	{0xFFD0000001FFFFFF, 0xFC00},
-	{0xBFC24EDEF3DCA982, 0xB094},
-	{0xFFD0000000FFFFFF, 0xFC00},
-	{0xBFD7B4610B43E984, 0xB5ED},
-	{0xFFD00000007FFFFF, 0xFC00},
-	{0x3FBF80000007FFFF, 0x2FE0},
-	{0xFFD00000003FFFFF, 0xFC00},
-	{0x47ECF55D3DA10073, 0x7C00},
-	{0xFFD00000001FFFFF, 0xFC00},
-	{0xACBFE00FFFFFFFFF, 0x8000},
-	{0xFFD00000000FFFFF, 0xFC00},
-	{0x40000000008001FF, 0x4000},
-	{0xFFD000000007FFFF, 0xFC00},
-	{0x409B7A768A72AA69, 0x66DF},
-	{0xFFD000000003FFFF, 0xFC00},
-	{0x41C003FFFFFFFFFC, 0x7C00},
-	{0xFFD000000001FFFF, 0xFC00},
-	{0x3F6FFFFFFF80FFFF, 0x1C00},
-	{0xFFD000000000FFFF, 0xFC00},
-	{0x95DEFEFFFFFFFFFF, 0x8000},
-	{0xFFD0000000007FFF, 0xFC00},
-	{0x401C5ECC7ECC187F, 0x4718},
-	{0xFFD0000000003FFF, 0xFC00},
-	{0xC01000000008FFFF, 0xC400},
-	{0xFFD0000000001FFF, 0xFC00},
-	{0xBCAFFFFFC00FFFFF, 0x8000},
-	{0xFFD0000000000FFF, 0xFC00},
-	{0xC1DFFF0000007FFF, 0xFC00},
-	{0xFFD00000000007FF, 0xFC00},
-	{0x434EDE84B885CF5A, 0x7C00},
-	{0xFFD00000000003FF, 0xFC00},
-	{0x3F1E003FFFFFFFFE, 0x0780},
-	{0xFFD00000000001FF, 0xFC00},
-	{0xDF2FFFFFFFFBC000, 0xFC00},
-	{0xFFD00000000000FF, 0xFC00},
-	{0xC1D000000DFFFFFF, 0xFC00},
-	{0xFFD000000000007F, 0xFC00},
-	{0xFFF5E9E9DCFDDF70, 0xFF7A},
-	{0xFFD000000000003F, 0xFC00},
-	{0xC74FFFFF7FFBFFFE, 0xFC00},
-	{0xFFD000000000001F, 0xFC00},
-	{0xBFEAC958C267BE01, 0xBAB2},
-	{0xFFD000000000000F, 0xFC00},
-	{0x43800000081FFFFE, 0x7C00},
-	{0xFFD0000000000007, 0xFC00},
-	{0xC06001FFBFFFFFFF, 0xD800},
-	{0xFFD0000000000003, 0xFC00},
-	{0x8001565D4F3B430B, 0x8000},
-	{0xFFE0000000000000, 0xFC00},
-	{0xBE000000009FFFFF, 0x8000},
-	{0xFFE0000000000001, 0xFC00},
-	{0xD77FBFFFFFFDFFFE, 0xFC00},
-	{0xFFE0000000000002, 0xFC00},
-	{0x3F00003FFFFFFF7E, 0x0200},
-	{0xFFE0000000000004, 0xFC00},
-	{0xBFF0000006000000, 0xBC00},
-	{0xFFE0000000000008, 0xFC00},
-	{0x43C0000000400000, 0x7C00},
-	{0xFFE0000000000010, 0xFC00},
-	{0x3F40000000007F7F, 0x1000},
-	{0xFFE0000000000020, 0xFC00},
-	{0xC3CF8000FFFFFFFF, 0xFC00},
-	{0xFFE0000000000040, 0xFC00},
-	{0x3FFFE00000003FFF, 0x3FF8},
-	{0xFFE0000000000080, 0xFC00},
-	{0x2C10001FF8000000, 0x0000},
-	{0xFFE0000000000100, 0xFC00},
-	{0x3F7FFFFFFFBFFFFF, 0x2000},
-	{0xFFE0000000000200, 0xFC00},
-	{0xFFDFFFFFFFF88000, 0xFC00},
-	{0xFFE0000000000400, 0xFC00},
-	{0x4050003FFFC00000, 0x5400},
-	{0xFFE0000000000800, 0xFC00},
-	{0xC3DFF8000000003F, 0xFC00},
-	{0xFFE0000000001000, 0xFC00},
-	{0x3EA38FA8AEB1D571, 0x000A},
-	{0xFFE0000000002000, 0xFC00},
-	{0x4096A8C72B77C493, 0x65AA},
-	{0xFFE0000000004000, 0xFC00},
-	{0x400FFFFFDFFFFFFA, 0x4400},
-	{0xFFE0000000008000, 0xFC00},
-	{0xC07000000FFFFFFF, 0xDC00},
-	{0xFFE0000000010000, 0xFC00},
-	{0xC1C7166FF14C917A, 0xFC00},
-	{0xFFE0000000020000, 0xFC00},
-	{0xC4A000000000017E, 0xFC00},
-	{0xFFE0000000040000, 0xFC00},
-	{0xD93FFFFFFEFFF800, 0xFC00},
-	{0xFFE0000000080000, 0xFC00},
-	{0xC34FFFFFFFFEDFFF, 0xFC00},
-	{0xFFE0000000100000, 0xFC00},
-	{0x401FDFFFC0000000, 0x47F8},
-	{0xFFE0000000200000, 0xFC00},
-	{0x411FFC01FFFFFFFF, 0x7C00},
-	{0xFFE0000000400000, 0xFC00},
-	{0xC7F0000000300000, 0xFC00},
-	{0xFFE0000000800000, 0xFC00},
-	{0xC3CEB58A20BF93EA, 0xFC00},
-	{0xFFE0000001000000, 0xFC00},
-	{0x3EAFFDFFFFFFFFFA, 0x0010},
-	{0xFFE0000002000000, 0xFC00},
-	{0xC7E003FFFFFFFFFC, 0xFC00},
-	{0xFFE0000004000000, 0xFC00},
-	{0x41FDF3576DCB8291, 0x7C00},
-	{0xFFE0000008000000, 0xFC00},
-	{0x48CFFFFFFFFFFDEE, 0x7C00},
-	{0xFFE0000010000000, 0xFC00},
-	{0x315FFFFFFF00000F, 0x0000},
-	{0xFFE0000020000000, 0xFC00},
-	{0x7FE00003FF7FFFFE, 0x7C00},
-	{0xFFE0000040000000, 0xFC00},
-	{0xBFDFFF0000000001, 0xB800},
-	{0xFFE0000080000000, 0xFC00},
-	{0x434FC00000003FFE, 0x7C00},
-	{0xFFE0000100000000, 0xFC00},
-	{0x800FFFFFFFFFE0FF, 0x8000},
-	{0xFFE0000200000000, 0xFC00},
-	{0x802BFFFFFFFFFFEF, 0x8000},
-	{0xFFE0000400000000, 0xFC00},
-	{0x381FFFFFFEEFFFFE, 0x0000},
-	{0xFFE0000800000000, 0xFC00},
-	{0xBFE2000000001FFE, 0xB880},
-	{0xFFE0001000000000, 0xFC00},
-	{0xBD558ED176289B4D, 0x8000},
-	{0xFFE0002000000000, 0xFC00},
-	{0x3E70000000100002, 0x0001},
-	{0xFFE0004000000000, 0xFC00},
-	{0xC0C0004000020000, 0xF000},
-	{0xFFE0008000000000, 0xFC00},
-	{0xC1CF862F8A3C0C51, 0xFC00},
-	{0xFFE0010000000000, 0xFC00},
-	{0xC02F7FFBFFFFFFFE, 0xCBE0},
-	{0xFFE0020000000000, 0xFC00},
-	{0xF89000000800007F, 0xFC00},
-	{0xFFE0040000000000, 0xFC00},
-	{0xB80FFFFFFFFFFF01, 0x8000},
-	{0xFFE0080000000000, 0xFC00},
-	{0x40200002001FFFFF, 0x4800},
-	{0xFFE0100000000000, 0xFC00},
-	{0x3803FFFFFFFFFFFD, 0x0000},
-	{0xFFE0200000000000, 0xFC00},
-	{0x3AD0080000000010, 0x0000},
-	{0xFFE0400000000000, 0xFC00},
-	{0xC3FFDFFFFFFFFFF7, 0xFC00},
-	{0xFFE0800000000000, 0xFC00},
-	{0xBFE0000000040100, 0xB800},
-	{0xFFE1000000000000, 0xFC00},
-	{0xC7E000FFFFFFFFFC, 0xFC00},
-	{0xFFE2000000000000, 0xFC00},
-	{0x3FF00200FFFFFFFF, 0x3C01},
-	{0xFFE4000000000000, 0xFC00},
-	{0x41FEFFFFF8000000, 0x7C00},
-	{0xFFE8000000000000, 0xFC00},
-	{0x7DAF0000001FFFFF, 0x7C00},
-	{0xFFEC000000000000, 0xFC00},
-	{0xA4E0800000000001, 0x8000},
-	{0xFFEE000000000000, 0xFC00},
-	{0x7FDFFFFFFFF801FF, 0x7C00},
-	{0xFFEF000000000000, 0xFC00},
-	{0x3F6FFFFFFFEC0000, 0x1C00},
-	{0xFFEF800000000000, 0xFC00},
-	{0xB8001FFFFFFFFFFE, 0x8000},
-	{0xFFEFC00000000000, 0xFC00},
-	{0x3FE0000880000000, 0x3800},
-	{0xFFEFE00000000000, 0xFC00},
-	{0x0010000006FFFFFE, 0x0000},
-	{0xFFEFF00000000000, 0xFC00},
-	{0xBFED8573AF44E1A4, 0xBB61},
-	{0xFFEFF80000000000, 0xFC00},
-	{0x402000FFFFFFF7FE, 0x4800},
-	{0xFFEFFC0000000000, 0xFC00},
-	{0xBF7F6DBD784D205B, 0x9FDB},
-	{0xFFEFFE0000000000, 0xFC00},
-	{0xC34F9FFFFFFFFFFF, 0xFC00},
-	{0xFFEFFF0000000000, 0xFC00},
-	{0xC01FF80000400000, 0xC7FE},
-	{0xFFEFFF8000000000, 0xFC00},
-	{0xBEA0002FFFFFFFFF, 0x8008},
-	{0xFFEFFFC000000000, 0xFC00},
-	{0x43EFFFC000000000, 0x7C00},
-	{0xFFEFFFE000000000, 0xFC00},
-	{0xBF667E693DC6F757, 0x99A0},
-	{0xFFEFFFF000000000, 0xFC00},
-	{0x3FEB9E230ED53FAE, 0x3AE8},
-	{0xFFEFFFF800000000, 0xFC00},
-	{0x7FE992ADAA5A1187, 0x7C00},
-	{0xFFEFFFFC00000000, 0xFC00},
-	{0x43EFFDFF80000000, 0x7C00},
-	{0xFFEFFFFE00000000, 0xFC00},
-	{0x38BFFFFFFE0000FF, 0x0000},
-	{0xFFEFFFFF00000000, 0xFC00},
-	{0xBCAFFE0000000080, 0x8000},
-	{0xFFEFFFFF80000000, 0xFC00},
-	{0xC3F00000007FFFEF, 0xFC00},
-	{0xFFEFFFFFC0000000, 0xFC00},
-	{0x42765A6D1A63827E, 0x7C00},
-	{0xFFEFFFFFE0000000, 0xFC00},
-	{0xC3EFFF800000003E, 0xFC00},
-	{0xFFEFFFFFF0000000, 0xFC00},
-	{0xC75FFFFFFF800008, 0xFC00},
-	{0xFFEFFFFFF8000000, 0xFC00},
-	{0x37F25B7A47E7D6CD, 0x0000},
-	{0xFFEFFFFFFC000000, 0xFC00},
-	{0x37F000000000011E, 0x0000},
-	{0xFFEFFFFFFE000000, 0xFC00},
-	{0x94BFFF800000007E, 0x8000},
-	{0xFFEFFFFFFF000000, 0xFC00},
-	{0x4800D39A92851774, 0x7C00},
-	{0xFFEFFFFFFF800000, 0xFC00},
-	{0x41DFFFFFFFFFFFFD, 0x7C00},
-	{0xFFEFFFFFFFC00000, 0xFC00},
-	{0xD25FFFFFFF001FFF, 0xFC00},
-	{0xFFEFFFFFFFE00000, 0xFC00},
-	{0x8005972AB36757FE, 0x8000},
-	{0xFFEFFFFFFFF00000, 0xFC00},
-	{0x436005FFFFFFFFFE, 0x7C00},
-	{0xFFEFFFFFFFF80000, 0xFC00},
-	{0x3FCFFFFFFFFFF7FA, 0x3400},
-	{0xFFEFFFFFFFFC0000, 0xFC00},
-	{0xC08007FFFFFBFFFE, 0xE002},
-	{0xFFEFFFFFFFFE0000, 0xFC00},
-	{0xB8C0008000100000, 0x8000},
-	{0xFFEFFFFFFFFF0000, 0xFC00},
-	{0x43400000207FFFFF, 0x7C00},
-	{0xFFEFFFFFFFFF8000, 0xFC00},
-	{0xC800207FFFFFFFFE, 0xFC00},
-	{0xFFEFFFFFFFFFC000, 0xFC00},
-	{0x415FFFFFF0001000, 0x7C00},
-	{0xFFEFFFFFFFFFE000, 0xFC00},
-	{0x4190020000100000, 0x7C00},
-	{0xFFEFFFFFFFFFF000, 0xFC00},
-	{0x000FFF001FFFFFFF, 0x0000},
-	{0xFFEFFFFFFFFFF800, 0xFC00},
-	{0xC00FFC0000003FFE, 0xC3FF},
-	{0xFFEFFFFFFFFFFC00, 0xFC00},
-	{0x40200FFF80000000, 0x4804},
-	{0xFFEFFFFFFFFFFE00, 0xFC00},
-	{0x43EFFF7FFFFDFFFE, 0x7C00},
-	{0xFFEFFFFFFFFFFF00, 0xFC00},
-	{0xC191D9C67C680693, 0xFC00},
-	{0xFFEFFFFFFFFFFF80, 0xFC00},
-	{0x380FFBFFFFFFFFFF, 0x0000},
-	{0xFFEFFFFFFFFFFFC0, 0xFC00},
-	{0x3EF86F8A7315635F, 0x0187},
-	{0xFFEFFFFFFFFFFFE0, 0xFC00},
-	{0xC805E7D64A2936B3, 0xFC00},
-	{0xFFEFFFFFFFFFFFF0, 0xFC00},
-	{0x41C55A982D0BC9B1, 0x7C00},
-	{0xFFEFFFFFFFFFFFF8, 0xFC00},
-	{0x7FE791DA74E6DB7F, 0x7C00},
-	{0xFFEFFFFFFFFFFFFC, 0xFC00},
-	{0x325FFFFC000000FE, 0x0000},
-	{0xFFEFFFFFFFFFFFFE, 0xFC00},
-	{0x7FDFFFFE00007FFF, 0x7C00},
-	{0xFFEFFFFFFFFFFFFF, 0xFC00},
-	{0x43EFFFFFFC0003FE, 0x7C00},
-	{0xFFEFFFFFFFFFFFFD, 0xFC00},
-	{0x3F4BF83C9434D4D3, 0x12FE},
-	{0xFFEFFFFFFFFFFFFB, 0xFC00},
-	{0x47EFFFFFFD000000, 0x7C00},
-	{0xFFEFFFFFFFFFFFF7, 0xFC00},
-	{0x40000004007FFFFF, 0x4000},
-	{0xFFEFFFFFFFFFFFEF, 0xFC00},
-	{0xB81FFFFDFFFDFFFF, 0x8000},
-	{0xFFEFFFFFFFFFFFDF, 0xFC00},
-	{0xC7F03FFFBFFFFFFF, 0xFC00},
-	{0xFFEFFFFFFFFFFFBF, 0xFC00},
-	{0x41F2000001FFFFFF, 0x7C00},
-	{0xFFEFFFFFFFFFFF7F, 0xFC00},
-	{0xC1C000001FF7FFFE, 0xFC00},
-	{0xFFEFFFFFFFFFFEFF, 0xFC00},
-	{0xC3DEFFFFFF7FFFFF, 0xFC00},
-	{0xFFEFFFFFFFFFFDFF, 0xFC00},
-	{0xBFFFFFEFFFFFFEFE, 0xC000},
-	{0xFFEFFFFFFFFFFBFF, 0xFC00},
-	{0xBFCFF0007FFFFFFF, 0xB3FC},
-	{0xFFEFFFFFFFFFF7FF, 0xFC00},
-	{0xC1AFFFFFFFFFFFFE, 0xFC00},
-	{0xFFEFFFFFFFFFEFFF, 0xFC00},
-	{0xD14FFFFFFF800FFE, 0xFC00},
-	{0xFFEFFFFFFFFFDFFF, 0xFC00},
-	{0xFFFFFF7FFF7FFFFE, 0xFFFF},
-	{0xFFEFFFFFFFFFBFFF, 0xFC00},
-	{0x3FED7693FF07B1B8, 0x3B5E},
-	{0xFFEFFFFFFFFF7FFF, 0xFC00},
-	{0xC7F000FFFF000000, 0xFC00},
-	{0xFFEFFFFFFFFEFFFF, 0xFC00},
-	{0x41DFFFE01FFFFFFF, 0x7C00},
-	{0xFFEFFFFFFFFDFFFF, 0xFC00},
-	{0x7FE000080000001F, 0x7C00},
-	{0xFFEFFFFFFFFBFFFF, 0xFC00},
-	{0xB7FD5586F1894A48, 0x8000},
-	{0xFFEFFFFFFFF7FFFF, 0xFC00},
-	{0x4320010000000008, 0x7C00},
-	{0xFFEFFFFFFFEFFFFF, 0xFC00},
-	{0xFFEFFFFBBFFFFFFF, 0xFC00},
-	{0xFFEFFFFFFFDFFFFF, 0xFC00},
-	{0xC0BFFFFFFFFFEFFF, 0xF000},
-	{0xFFEFFFFFFFBFFFFF, 0xFC00},
-	{0x3FB00000107FFFFE, 0x2C00},
-	{0xFFEFFFFFFF7FFFFF, 0xFC00},
-	{0x41C003FDFFFFFFFF, 0x7C00},
-	{0xFFEFFFFFFEFFFFFF, 0xFC00},
-	{0x4035A709719FC6F1, 0x4D6A},
-	{0xFFEFFFFFFDFFFFFF, 0xFC00},
-	{0x3FBFFFFFC8000000, 0x3000},
-	{0xFFEFFFFFFBFFFFFF, 0xFC00},
-	{0xA43FFDFFFFBFFFFF, 0x8000},
-	{0xFFEFFFFFF7FFFFFF, 0xFC00},
-	{0x41DFBFFDFFFFFFFF, 0x7C00},
-	{0xFFEFFFFFEFFFFFFF, 0xFC00},
-	{0xB7EFFFFFFDFFFF80, 0x8000},
-	{0xFFEFFFFFDFFFFFFF, 0xFC00},
-	{0xB7E400000000007F, 0x8000},
-	{0xFFEFFFFFBFFFFFFF, 0xFC00},
-	{0x4030000007FFF000, 0x4C00},
-	{0xFFEFFFFF7FFFFFFF, 0xFC00},
-	{0x22E10003FFFFFFFF, 0x0000},
-	{0xFFEFFFFEFFFFFFFF, 0xFC00},
-	{0xC030000007C00000, 0xCC00},
-	{0xFFEFFFFDFFFFFFFF, 0xFC00},
-	{0x801AAD1AA1277598, 0x8000},
-	{0xFFEFFFFBFFFFFFFF, 0xFC00},
-	{0x040DC8B8BEDE9A45, 0x0000},
-	{0xFFEFFFF7FFFFFFFF, 0xFC00},
-	{0xBEC0000000002FFF, 0x8020},
-	{0xFFEFFFEFFFFFFFFF, 0xFC00},
-	{0x47EFFE000001FFFF, 0x7C00},
-	{0xFFEFFFDFFFFFFFFF, 0xFC00},
-	{0xC7E000008000001F, 0xFC00},
-	{0xFFEFFFBFFFFFFFFF, 0xFC00},
-	{0xBFAFFFFEFFFBFFFF, 0xAC00},
-	{0xFFEFFF7FFFFFFFFF, 0xFC00},
-	{0x3F6FFFFFF803FFFE, 0x1C00},
-	{0xFFEFFEFFFFFFFFFF, 0xFC00},
-	{0x4340000000000011, 0x7C00},
-	{0xFFEFFDFFFFFFFFFF, 0xFC00},
-	{0x41F6A44C680DBD24, 0x7C00},
-	{0xFFEFFBFFFFFFFFFF, 0xFC00},
-	{0xC01FFFDFFFFFFFFF, 0xC800},
-	{0xFFEFF7FFFFFFFFFF, 0xFC00},
-	{0xBDE1AEAD4BD0BDE2, 0x8000},
-	{0xFFEFEFFFFFFFFFFF, 0xFC00},
-	{0xB810000400000006, 0x8000},
-	{0xFFEFDFFFFFFFFFFF, 0xFC00},
-	{0x001007FFFFFFFFF7, 0x0000},
-	{0xFFEFBFFFFFFFFFFF, 0xFC00},
-	{0x002000FFFFFFFFBF, 0x0000},
-	{0xFFEF7FFFFFFFFFFF, 0xFC00},
-	{0xBF08434125F7030A, 0x8308},
-	{0xFFEEFFFFFFFFFFFF, 0xFC00},
-	{0xBFEFFFBFFFFFF7FF, 0xBC00},
-	{0xFFEDFFFFFFFFFFFF, 0xFC00},
-	{0x7FD00000000000F6, 0x7C00},
-	{0xFFEBFFFFFFFFFFFF, 0xFC00},
-	{0x43E4EB880D8E4121, 0x7C00},
-	{0xFFE7FFFFFFFFFFFF, 0xFC00},
-	{0xC0327A27FE00872B, 0xCC9F},
-	{0xFFE3FFFFFFFFFFFF, 0xFC00},
-	{0xB810000003EFFFFE, 0x8000},
-	{0xFFE1FFFFFFFFFFFF, 0xFC00},
-	{0xA99FFFDFFEFFFFFF, 0x8000},
-	{0xFFE0FFFFFFFFFFFF, 0xFC00},
-	{0xC0200800000007FF, 0xC802},
-	{0xFFE07FFFFFFFFFFF, 0xFC00},
-	{0xC1F00000007FFFF8, 0xFC00},
-	{0xFFE03FFFFFFFFFFF, 0xFC00},
-	{0x4019B3A83C0B20D1, 0x466D},
-	{0xFFE01FFFFFFFFFFF, 0xFC00},
-	{0x37F400003FFFFFFE, 0x0000},
-	{0xFFE00FFFFFFFFFFF, 0xFC00},
-	{0x40C0000000017FFF, 0x7000},
-	{0xFFE007FFFFFFFFFF, 0xFC00},
-	{0x7FD00000FFFFFFFD, 0x7C00},
-	{0xFFE003FFFFFFFFFF, 0xFC00},
-	{0x3FC0200000000008, 0x3008},
-	{0xFFE001FFFFFFFFFF, 0xFC00},
-	{0x47FFA00000000000, 0x7C00},
-	{0xFFE000FFFFFFFFFF, 0xFC00},
-	{0xC801C368D7AB61C7, 0xFC00},
-	{0xFFE0007FFFFFFFFF, 0xFC00},
-	{0x000FFFFFFFFF7EFE, 0x0000},
-	{0xFFE0003FFFFFFFFF, 0xFC00},
-	{0xC80000007FF7FFFF, 0xFC00},
-	{0xFFE0001FFFFFFFFF, 0xFC00},
-	{0xC030007FFFFFFFFF, 0xCC00},
-	{0xFFE0000FFFFFFFFF, 0xFC00},
-	{0xC011D368768FA386, 0xC475},
-	{0xFFE00007FFFFFFFF, 0xFC00},
-	{0x3F9C20086A3CF5F0, 0x2708},
-	{0xFFE00003FFFFFFFF, 0xFC00},
-	{0xC0EFFFFFEFFFFFDF, 0xFC00},
-	{0xFFE00001FFFFFFFF, 0xFC00},
-	{0x3D5DC9D125568CD1, 0x0000},
-	{0xFFE00000FFFFFFFF, 0xFC00},
-	{0x3FEFFFFFFFBFFEFF, 0x3C00},
-	{0xFFE000007FFFFFFF, 0xFC00},
-	{0x47EFFFFEEFFFFFFE, 0x7C00},
-	{0xFFE000003FFFFFFF, 0xFC00},
-	{0x3FD0401FFFFFFFFF, 0x3410},
-	{0xFFE000001FFFFFFF, 0xFC00},
-	{0x401E3A00682B0F97, 0x478F},
-	{0xFFE000000FFFFFFF, 0xFC00},
-	{0x43D34C85E84C448E, 0x7C00},
-	{0xFFE0000007FFFFFF, 0xFC00},
-	{0x32500100FFFFFFFF, 0x0000},
-	{0xFFE0000003FFFFFF, 0xFC00},
-	{0xC02BFCD8FE0F82B1, 0xCAFF},
-	{0xFFE0000001FFFFFF, 0xFC00},
-	{0xB81FFFFFFFF04000, 0x8000},
-	{0xFFE0000000FFFFFF, 0xFC00},
-	{0x34FFFFDFFFBFFFFF, 0x0000},
-	{0xFFE00000007FFFFF, 0xFC00},
-	{0x8000020000000100, 0x8000},
-	{0xFFE00000003FFFFF, 0xFC00},
-	{0x41CFFFFFC0000200, 0x7C00},
-	{0xFFE00000001FFFFF, 0xFC00},
-	{0x400EFFC000000000, 0x43C0},
-	{0xFFE00000000FFFFF, 0xFC00},
-	{0xBF0FFC0000800000, 0x8400},
-	{0xFFE000000007FFFF, 0xFC00},
-	{0x3FF0023FFFFFFFFE, 0x3C01},
-	{0xFFE000000003FFFF, 0xFC00},
-	{0x37EFFFFFEFDFFFFE, 0x0000},
-	{0xFFE000000001FFFF, 0xFC00},
-	{0x480E95CC7574EDE7, 0x7C00},
-	{0xFFE000000000FFFF, 0xFC00},
-	{0xF450000000000110, 0xFC00},
-	{0xFFE0000000007FFF, 0xFC00},
-	{0xB7EFFFFBFFEFFFFE, 0x8000},
-	{0xFFE0000000003FFF, 0xFC00},
-	{0x37E281EBC41A0BE0, 0x0000},
-	{0xFFE0000000001FFF, 0xFC00},
-	{0x4D5F8000000007FF, 0x7C00},
-	{0xFFE0000000000FFF, 0xFC00},
-	{0x37FF616362FCA140, 0x0000},
-	{0xFFE00000000007FF, 0xFC00},
-	{0x404BD63594390A39, 0x52F6},
-	{0xFFE00000000003FF, 0xFC00},
-	{0x46C0000000001F80, 0x7C00},
-	{0xFFE00000000001FF, 0xFC00},
-	{0x40648A84C96EA4C2, 0x5923},
-	{0xFFE00000000000FF, 0xFC00},
-	{0x3F80000003FFFFFA, 0x2000},
-	{0xFFE000000000007F, 0xFC00},
-	{0x41FFCC4421538F4B, 0x7C00},
-	{0xFFE000000000003F, 0xFC00},
-	{0x42B0000000003FFD, 0x7C00},
-	{0xFFE000000000001F, 0xFC00},
-	{0xBF8FFFFBFFFFBFFF, 0xA400},
-	{0xFFE000000000000F, 0xFC00},
-	{0x43DEDA24BBCF7F71, 0x7C00},
-	{0xFFE0000000000007, 0xFC00},
-	{0x43F0003FFFFE0000, 0x7C00},
-	{0xFFE0000000000003, 0xFC00},
-	{0x41D42D90556C9DE6, 0x7C00},
-	{0xFFF0000000000000, 0xFC00},
-	{0x3B2003FFF7FFFFFF, 0x0000},
-	{0xFFF0000000000001, 0xFE00},
-	{0x1B60002001000000, 0x0000},
-	{0xFFF0000000000002, 0xFE00},
-	{0xC01FFFFFFFF01000, 0xC800},
-	{0xFFF0000000000004, 0xFE00},
-	{0x43C0200000000003, 0x7C00},
-	{0xFFF0000000000008, 0xFE00},
-	{0x4599BFAE09C8D930, 0x7C00},
-	{0xFFF0000000000010, 0xFE00},
-	{0xC3FEFFFFFFFFF000, 0xFC00},
-	{0xFFF0000000000020, 0xFE00},
-	{0xC1B33680E3217F8C, 0xFC00},
-	{0xFFF0000000000040, 0xFE00},
-	{0xB7F01FFFFFF00000, 0x8000},
-	{0xFFF0000000000080, 0xFE00},
-	{0x7FDFA00000000000, 0x7C00},
-	{0xFFF0000000000100, 0xFE00},
-	{0xBF4FFFE007FFFFFF, 0x9400},
-	{0xFFF0000000000200, 0xFE00},
-	{0x88FF7FFFFFFFBFFF, 0x8000},
-	{0xFFF0000000000400, 0xFE00},
-	{0xC00616E1C36C612B, 0xC186},
-	{0xFFF0000000000800, 0xFE00},
-	{0x2DF0002000000010, 0x0000},
-	{0xFFF0000000001000, 0xFE00},
-	{0x43EEFFFFFFFC0000, 0x7C00},
-	{0xFFF0000000002000, 0xFE00},
-	{0x7FFB5CEACDAE9A1C, 0x7ED7},
-	{0xFFF0000000004000, 0xFE00},
-	{0x41CFFFFFFFFEFFBF, 0x7C00},
-	{0xFFF0000000008000, 0xFE00},
-	{0x47FFFFFFC0000007, 0x7C00},
-	{0xFFF0000000010000, 0xFE00},
-	{0xBF5A410111380B14, 0x9690},
-	{0xFFF0000000020000, 0xFE00},
-	{0x40E0001FFFFFC000, 0x7800},
-	{0xFFF0000000040000, 0xFE00},
-	{0x400000043FFFFFFE, 0x4000},
-	{0xFFF0000000080000, 0xFE00},
-	{0x41F00040003FFFFE, 0x7C00},
-	{0xFFF0000000100000, 0xFE00},
-	{0xB4F47BF9D820542B, 0x8000},
-	{0xFFF0000000200000, 0xFE00},
-	{0x2E5FFFE0007FFFFF, 0x0000},
-	{0xFFF0000000400000, 0xFE00},
-	{0x8010001000000FFE, 0x8000},
-	{0xFFF0000000800000, 0xFE00},
-	{0x41C00087FFFFFFFF, 0x7C00},
-	{0xFFF0000001000000, 0xFE00},
-	{0xC020200000000000, 0xC808},
-	{0xFFF0000002000000, 0xFE00},
-	{0x404FFFFFFFFFC3FE, 0x5400},
-	{0xFFF0000004000000, 0xFE00},
-	{0x37EFFFFFDFFFBFFF, 0x0000},
-	{0xFFF0000008000000, 0xFE00},
-	{0xB818EB1FECEDAC3F, 0x8000},
-	{0xFFF0000010000000, 0xFE00},
-	{0xC0020F285BFE3E1F, 0xC084},
-	{0xFFF0000020000000, 0xFE00},
-	{0x002E000000000200, 0x0000},
-	{0xFFF0000040000000, 0xFE00},
-	{0xC3400003FFFFFFFF, 0xFC00},
-	{0xFFF0000080000000, 0xFE00},
-	{0xC1DFFFFE04000000, 0xFC00},
-	{0xFFF0000100000000, 0xFE00},
-	{0x3FE000007FFFEFFF, 0x3800},
-	{0xFFF0000200000000, 0xFE00},
-	{0xC20C0AE0A47B3AF7, 0xFC00},
-	{0xFFF0000400000000, 0xFE00},
-	{0xDC75CD392AEE7F4D, 0xFC00},
-	{0xFFF0000800000000, 0xFE00},
-	{0xBA90003FFEFFFFFF, 0x8000},
-	{0xFFF0001000000000, 0xFE00},
-	{0x43EBE0D93A02B65A, 0x7C00},
-	{0xFFF0002000000000, 0xFE00},
-	{0x400FFFFFFFF800FE, 0x4400},
-	{0xFFF0004000000000, 0xFE00},
-	{0xFFE030C69203427C, 0xFC00},
-	{0xFFF0008000000000, 0xFE00},
-	{0x3FA07FFFF8000000, 0x2820},
-	{0xFFF0010000000000, 0xFE00},
-	{0x3F229C3B7AFC2C09, 0x08A7},
-	{0xFFF0020000000000, 0xFE00},
-	{0x37E508F464C17C1E, 0x0000},
-	{0xFFF0040000000000, 0xFE01},
-	{0xBCA01FFFFFFF7FFF, 0x8000},
-	{0xFFF0080000000000, 0xFE02},
-	{0xC340000007FFFFDF, 0xFC00},
-	{0xFFF0100000000000, 0xFE04},
-	{0xC7E002000007FFFF, 0xFC00},
-	{0xFFF0200000000000, 0xFE08},
-	{0xB7FDFFF7FFFFFFFF, 0x8000},
-	{0xFFF0400000000000, 0xFE10},
-	{0xC1F0100000000000, 0xFC00},
-	{0xFFF0800000000000, 0xFE20},
-	{0xC80FFDFFFF7FFFFF, 0xFC00},
-	{0xFFF1000000000000, 0xFE40},
-	{0x326FFFFF5FFFFFFF, 0x0000},
-	{0xFFF2000000000000, 0xFE80},
-	{0xBE2F3FFFFFFFFFFF, 0x8000},
-	{0xFFF4000000000000, 0xFF00},
-	{0xC1CE15874463ACED, 0xFC00},
-	{0xFFF8000000000000, 0xFE00},
-	{0x3CAFE1FE20CC6CAB, 0x0000},
-	{0xFFFC000000000000, 0xFF00},
-	{0x4340000001FFFFFE, 0x7C00},
-	{0xFFFE000000000000, 0xFF80},
-	{0xBFDFFFFFFEFFFFFC, 0xB800},
-	{0xFFFF000000000000, 0xFFC0},
-	{0x47F0010000000001, 0x7C00},
-	{0xFFFF800000000000, 0xFFE0},
-	{0xBFEC202F426DCC5D, 0xBB08},
-	{0xFFFFC00000000000, 0xFFF0},
-	{0x8003FFFF00000000, 0x8000},
-	{0xFFFFE00000000000, 0xFFF8},
-	{0xC3DFFFFFF0008000, 0xFC00},
-	{0xFFFFF00000000000, 0xFFFC},
-	{0x402EFFFFFFEFFFFF, 0x4BC0},
-	{0xFFFFF80000000000, 0xFFFE},
-	{0xC91FFFF007FFFFFE, 0xFC00},
-	{0xFFFFFC0000000000, 0xFFFF},
-	{0xBC9FC0000000001F, 0x8000},
-	{0xFFFFFE0000000000, 0xFFFF},
-	{0xC1D0005FFFFFFFFF, 0xFC00},
-	{0xFFFFFF0000000000, 0xFFFF},
-	{0xC0300008007FFFFF, 0xCC00},
-	{0xFFFFFF8000000000, 0xFFFF},
-	{0xC01000000FFFFFFD, 0xC400},
-	{0xFFFFFFC000000000, 0xFFFF},
-	{0x3E800002FFFFFFFE, 0x0002},
-	{0xFFFFFFE000000000, 0xFFFF},
-	{0xC3E00000007FFFFE, 0xFC00},
-	{0xFFFFFFF000000000, 0xFFFF},
-	{0x3FD935E40595B6B4, 0x364D},
-	{0xFFFFFFF800000000, 0xFFFF},
-	{0x3FB18D8C25A96CF4, 0x2C63},
-	{0xFFFFFFFC00000000, 0xFFFF},
-	{0xB8CFFFFC00001FFF, 0x8000},
-	{0xFFFFFFFE00000000, 0xFFFF},
-	{0x40900000020000FF, 0x6400},
-	{0xFFFFFFFF00000000, 0xFFFF},
-	{0x7FE004FFFFFFFFFF, 0x7C00},
-	{0xFFFFFFFF80000000, 0xFFFF},
-	{0x40700000010FFFFF, 0x5C00},
-	{0xFFFFFFFFC0000000, 0xFFFF},
-	{0x4F55982FE3BAAA00, 0x7C00},
-	{0xFFFFFFFFE0000000, 0xFFFF},
-	{0xB1A00000FFFFEFFE, 0x8000},
-	{0xFFFFFFFFF0000000, 0xFFFF},
-	{0x7FF5BE069151661A, 0x7F6F},
-	{0xFFFFFFFFF8000000, 0xFFFF},
-	{0xC01FFFFFEFFFFF7E, 0xC800},
-	{0xFFFFFFFFFC000000, 0xFFFF},
-	{0x3FFFFFBFEFFFFFFF, 0x4000},
-	{0xFFFFFFFFFE000000, 0xFFFF},
-	{0x4010000600000000, 0x4400},
-	{0xFFFFFFFFFF000000, 0xFFFF},
-	{0x4090000002FFFFFE, 0x6400},
-	{0xFFFFFFFFFF800000, 0xFFFF},
-	{0x4DE01000001FFFFF, 0x7C00},
-	{0xFFFFFFFFFFC00000, 0xFFFF},
-	{0x39EFFFFFFFFFF600, 0x0000},
-	{0xFFFFFFFFFFE00000, 0xFFFF},
-	{0xC7894B0D7D87F6A4, 0xFC00},
-	{0xFFFFFFFFFFF00000, 0xFFFF},
-	{0xC340FFFFFFEFFFFF, 0xFC00},
-	{0xFFFFFFFFFFF80000, 0xFFFF},
-	{0xBFD00200000000FF, 0xB401},
-	{0xFFFFFFFFFFFC0000, 0xFFFF},
-	{0xC0FE0000FFFFFFFF, 0xFC00},
-	{0xFFFFFFFFFFFE0000, 0xFFFF},
-	{0x3F7938836B8B82EA, 0x1E4E},
-	{0xFFFFFFFFFFFF0000, 0xFFFF},
-	{0x48000000000FFE00, 0x7C00},
-	{0xFFFFFFFFFFFF8000, 0xFFFF},
-	{0x3FDFFF7DFFFFFFFF, 0x3800},
-	{0xFFFFFFFFFFFFC000, 0xFFFF},
-	{0x43EFFFFFE00FFFFF, 0x7C00},
-	{0xFFFFFFFFFFFFE000, 0xFFFF},
-	{0xBDEFDFFFFFFFFFBE, 0x8000},
-	{0xFFFFFFFFFFFFF000, 0xFFFF},
-	{0xC117FD5B2A3AF2C9, 0xFC00},
-	{0xFFFFFFFFFFFFF800, 0xFFFF},
-	{0x00200000003FFFFC, 0x0000},
-	{0xFFFFFFFFFFFFFC00, 0xFFFF},
-	{0x400F7C0000000000, 0x43DF},
-	{0xFFFFFFFFFFFFFE00, 0xFFFF},
-	{0x3983FFFFFFFFFFE0, 0x0000},
-	{0xFFFFFFFFFFFFFF00, 0xFFFF},
-	{0x3FB0000BFFFFFFFF, 0x2C00},
-	{0xFFFFFFFFFFFFFF80, 0xFFFF},
-	{0x40042E18EFE7D6A0, 0x410C},
-	{0xFFFFFFFFFFFFFFC0, 0xFFFF},
-	{0x47EFFBFFFFFFFFFB, 0x7C00},
-	{0xFFFFFFFFFFFFFFE0, 0xFFFF},
-	{0xC39F0001FFFFFFFF, 0xFC00},
-	{0xFFFFFFFFFFFFFFF0, 0xFFFF},
-	{0xC1D0000410000000, 0xFC00},
-	{0xFFFFFFFFFFFFFFF8, 0xFFFF},
-	{0xAB8FFFFFFFDFFEFF, 0x8000},
-	{0xFFFFFFFFFFFFFFFC, 0xFFFF},
-	{0x40100FFFFFFFFFFB, 0x4404},
-	{0xFFFFFFFFFFFFFFFE, 0xFFFF},
-	{0x476FFFDFFFFFFFFC, 0x7C00},
-	{0xFFFFFFFFFFFFFFFF, 0xFFFF},
-	{0xC0363E96C3CAD357, 0xCD90},
-	{0xFFFFFFFFFFFFFFFD, 0xFFFF},
-	{0xC3EF84AEC0C0022B, 0xFC00},
-	{0xFFFFFFFFFFFFFFFB, 0xFFFF},
-	{0x47F000003FFFFFF8, 0x7C00},
-	{0xFFFFFFFFFFFFFFF7, 0xFFFF},
-	{0xC3C836EFE1D6ACED, 0xFC00},
-	{0xFFFFFFFFFFFFFFEF, 0xFFFF},
-	{0xC3F0000000001000, 0xFC00},
-	{0xFFFFFFFFFFFFFFDF, 0xFFFF},
-	{0x46E623DFAF47E91E, 0x7C00},
-	{0xFFFFFFFFFFFFFFBF, 0xFFFF},
-	{0x434FFBFFFFFFEFFF, 0x7C00},
-	{0xFFFFFFFFFFFFFF7F, 0xFFFF},
-	{0xC00211D5C238D07A, 0xC084},
-	{0xFFFFFFFFFFFFFEFF, 0xFFFF},
-	{0x42DFFDFFFFDFFFFF, 0x7C00},
-	{0xFFFFFFFFFFFFFDFF, 0xFFFF},
-	{0xB80F840000000000, 0x8000},
-	{0xFFFFFFFFFFFFFBFF, 0xFFFF},
-	{0x37F0000010000FFF, 0x0000},
-	{0xFFFFFFFFFFFFF7FF, 0xFFFF},
-	{0xB27BFF7FFFFFFFFE, 0x8000},
-	{0xFFFFFFFFFFFFEFFF, 0xFFFF},
-	{0xC1C000003BFFFFFF, 0xFC00},
-	{0xFFFFFFFFFFFFDFFF, 0xFFFF},
-	{0x7FF00083FFFFFFFF, 0x7E00},
-	{0xFFFFFFFFFFFFBFFF, 0xFFFF},
-	{0xC0AF800000000400, 0xEBE0},
-	{0xFFFFFFFFFFFF7FFF, 0xFFFF},
-	{0xB802000000000008, 0x8000},
-	{0xFFFFFFFFFFFEFFFF, 0xFFFF},
-	{0x40E00000001FFFF6, 0x7800},
-	{0xFFFFFFFFFFFDFFFF, 0xFFFF},
-	{0x41FEFFF7FFFFFFFE, 0x7C00},
-	{0xFFFFFFFFFFFBFFFF, 0xFFFF},
-	{0xC7FDED9F6AF22C51, 0xFC00},
-	{0xFFFFFFFFFFF7FFFF, 0xFFFF},
-	{0xC9754E02E1B187F5, 0xFC00},
-	{0xFFFFFFFFFFEFFFFF, 0xFFFF},
-	{0x002FFFFFF7FFFFFE, 0x0000},
-	{0xFFFFFFFFFFDFFFFF, 0xFFFF},
-	{0xC3F7CF5746CF6345, 0xFC00},
-	{0xFFFFFFFFFFBFFFFF, 0xFFFF},
-	{0x3E7FFFFFFC0001FF, 0x0002},
-	{0xFFFFFFFFFF7FFFFF, 0xFFFF},
-	{0x3D2A94835E1FA17A, 0x0000},
-	{0xFFFFFFFFFEFFFFFF, 0xFFFF},
-	{0xFFEFFFFFFFE00002, 0xFC00},
-	{0xFFFFFFFFFDFFFFFF, 0xFFFF},
-	{0x43400200000FFFFF, 0x7C00},
-	{0xFFFFFFFFFBFFFFFF, 0xFFFF},
-	{0x3E7FFFFFFFFFF400, 0x0002},
-	{0xFFFFFFFFF7FFFFFF, 0xFFFF},
-	{0xC710001FFFFFFFDE, 0xFC00},
-	{0xFFFFFFFFEFFFFFFF, 0xFFFF},
-	{0xC0201000007FFFFF, 0xC804},
-	{0xFFFFFFFFDFFFFFFF, 0xFFFF},
-	{0xB80000001000FFFF, 0x8000},
-	{0xFFFFFFFFBFFFFFFF, 0xFFFF},
-	{0xFFFFC00001FFFFFE, 0xFFF0},
-	{0xFFFFFFFF7FFFFFFF, 0xFFFF},
-	{0x3FB10EA4228BB07F, 0x2C44},
-	{0xFFFFFFFEFFFFFFFF, 0xFFFF},
-	{0x404B6677C5F87CD8, 0x52DA},
-	{0xFFFFFFFDFFFFFFFF, 0xFFFF},
-	{0xBC6FFF801FFFFFFF, 0x8000},
-	{0xFFFFFFFBFFFFFFFF, 0xFFFF},
-	{0x43CDFFFFFFFFFBFF, 0x7C00},
-	{0xFFFFFFF7FFFFFFFF, 0xFFFF},
-	{0xC5B05CB221971A0D, 0xFC00},
-	{0xFFFFFFEFFFFFFFFF, 0xFFFF},
-	{0x41D00000023FFFFF, 0x7C00},
-	{0xFFFFFFDFFFFFFFFF, 0xFFFF},
-	{0x402000000100FFFF, 0x4800},
-	{0xFFFFFFBFFFFFFFFF, 0xFFFF},
-	{0x7FD0000000002003, 0x7C00},
-	{0xFFFFFF7FFFFFFFFF, 0xFFFF},
-	{0x624FFFFFFFFFFC0F, 0x7C00},
-	{0xFFFFFEFFFFFFFFFF, 0xFFFF},
-	{0x002CC2277D3E415B, 0x0000},
-	{0xFFFFFDFFFFFFFFFF, 0xFFFF},
-	{0x3F7F7FFFFFFBFFFF, 0x1FE0},
-	{0xFFFFFBFFFFFFFFFF, 0xFFFE},
-	{0xC1F800000003FFFF, 0xFC00},
-	{0xFFFFF7FFFFFFFFFF, 0xFFFD},
-	{0xBFDDD74177A4F5E0, 0xB776},
-	{0xFFFFEFFFFFFFFFFF, 0xFFFB},
-	{0xC052000000000007, 0xD480},
-	{0xFFFFDFFFFFFFFFFF, 0xFFF7},
-	{0x407E0003FFFFFFFF, 0x5F80},
-	{0xFFFFBFFFFFFFFFFF, 0xFFEF},
-	{0xBFC1D37FC68F257E, 0xB075},
-	{0xFFFF7FFFFFFFFFFF, 0xFFDF},
-	{0x583FFC003FFFFFFF, 0x7C00},
-	{0xFFFEFFFFFFFFFFFF, 0xFFBF},
-	{0x40000200001FFFFF, 0x4001},
-	{0xFFFDFFFFFFFFFFFF, 0xFF7F},
-	{0x3E5FFFFFFFC00007, 0x0000},
-	{0xFFFBFFFFFFFFFFFF, 0xFEFF},
-	{0x41EFBFFFFFFFFFF7, 0x7C00},
-	{0xFFF7FFFFFFFFFFFF, 0xFFFF},
-	{0xC3F08001FFFFFFFF, 0xFC00},
-	{0xFFF3FFFFFFFFFFFF, 0xFEFF},
-	{0x43DFFFFFFFDFFFDE, 0x7C00},
-	{0xFFF1FFFFFFFFFFFF, 0xFE7F},
-	{0x80271CDAE419C044, 0x8000},
-	{0xFFF0FFFFFFFFFFFF, 0xFE3F},
-	{0x3CAFF7FFFFFFFFF8, 0x0000},
-	{0xFFF07FFFFFFFFFFF, 0xFE1F},
-	{0xB89001FFFFFFFFFD, 0x8000},
-	{0xFFF03FFFFFFFFFFF, 0xFE0F},
-	{0x7080000010001FFE, 0x7C00},
-	{0xFFF01FFFFFFFFFFF, 0xFE07},
-	{0xC31FFFFFFFFFF807, 0xFC00},
-	{0xFFF00FFFFFFFFFFF, 0xFE03},
-	{0x425FDFFFFFFFFFEF, 0x7C00},
-	{0xFFF007FFFFFFFFFF, 0xFE01},
-	{0xC1C16D2AB222C805, 0xFC00},
-	{0xFFF003FFFFFFFFFF, 0xFE00},
-	{0x381FFFFFFFDF7FFF, 0x0000},
-	{0xFFF001FFFFFFFFFF, 0xFE00},
-	{0x92801FFFBFFFFFFF, 0x8000},
-	{0xFFF000FFFFFFFFFF, 0xFE00},
-	{0x3FFFFFE000FFFFFF, 0x4000},
-	{0xFFF0007FFFFFFFFF, 0xFE00},
-	{0x3FF6385D44B1DD8F, 0x3D8E},
-	{0xFFF0003FFFFFFFFF, 0xFE00},
-	{0x47F000000000005F, 0x7C00},
-	{0xFFF0001FFFFFFFFF, 0xFE00},
-	{0xBFEFF80020000000, 0xBBFE},
-	{0xFFF0000FFFFFFFFF, 0xFE00},
-	{0x47EFFFFFDF7FFFFE, 0x7C00},
-	{0xFFF00007FFFFFFFF, 0xFE00},
-	{0xD6D0CF4F0E0603CA, 0xFC00},
-	{0xFFF00003FFFFFFFF, 0xFE00},
-	{0x406FFFFFFFFFFFFF, 0x5C00},
-	{0xFFF00001FFFFFFFF, 0xFE00},
-	{0x41F00000000003FF, 0x7C00},
-	{0xFFF00000FFFFFFFF, 0xFE00},
-	{0x3F91000000FFFFFF, 0x2440},
-	{0xFFF000007FFFFFFF, 0xFE00},
-	{0x43F8880081892690, 0x7C00},
-	{0xFFF000003FFFFFFF, 0xFE00},
-	{0x41D020001FFFFFFF, 0x7C00},
-	{0xFFF000001FFFFFFF, 0xFE00},
-	{0xC0DFC0000000007E, 0xF7F0},
-	{0xFFF000000FFFFFFF, 0xFE00},
-	{0x41C200000000003F, 0x7C00},
-	{0xFFF0000007FFFFFF, 0xFE00},
-	{0xBE6FBE886AEC6360, 0x8001},
-	{0xFFF0000003FFFFFF, 0xFE00},
-	{0x43F03FFFFFFC0000, 0x7C00},
-	{0xFFF0000001FFFFFF, 0xFE00},
-	{0x4BAC0A01FDC11113, 0x7C00},
-	{0xFFF0000000FFFFFF, 0xFE00},
-	{0x5A5FFFFFFF6FFFFF, 0x7C00},
-	{0xFFF00000007FFFFF, 0xFE00},
-	{0x41DFFBFFEFFFFFFE, 0x7C00},
-	{0xFFF00000003FFFFF, 0xFE00},
-	{0x41D6861F76E7F907, 0x7C00},
-	{0xFFF00000001FFFFF, 0xFE00},
-	{0xBF10040FFFFFFFFF, 0x8401},
-	{0xFFF00000000FFFFF, 0xFE00},
-	{0x3FE000001001FFFF, 0x3800},
-	{0xFFF000000007FFFF, 0xFE00},
-	{0xC7FFFFE0007FFFFF, 0xFC00},
-	{0xFFF000000003FFFF, 0xFE00},
-	{0xBFAFFFFFFE000000, 0xAC00},
-	{0xFFF000000001FFFF, 0xFE00},
-	{0xBFF3674728398AEA, 0xBCDA},
-	{0xFFF000000000FFFF, 0xFE00},
-	{0xC0BFE01000000000, 0xEFF8},
-	{0xFFF0000000007FFF, 0xFE00},
-	{0xA8B07EFFFFFFFFFF, 0x8000},
-	{0xFFF0000000003FFF, 0xFE00},
-	{0xC3C00000000005FE, 0xFC00},
-	{0xFFF0000000001FFF, 0xFE00},
-	{0x4010FFFFFFFFFEFE, 0x4440},
-	{0xFFF0000000000FFF, 0xFE00},
-	{0xC6AFEFF000000000, 0xFC00},
-	{0xFFF00000000007FF, 0xFE00},
-	{0x4BEFBF9FCA3B5CD0, 0x7C00},
-	{0xFFF00000000003FF, 0xFE00},
-	{0xBFE0000FFFFFBFFF, 0xB800},
-	{0xFFF00000000001FF, 0xFE00},
-	{0x47ED96B17B14C6CE, 0x7C00},
-	{0xFFF00000000000FF, 0xFE00},
-	{0xFFFFFFFFFF7FBFFE, 0xFFFF},
-	{0xFFF000000000007F, 0xFE00},
-	{0x449000000000FFE0, 0x7C00},
-	{0xFFF000000000003F, 0xFE00},
-	{0x403FFFFFFC01FFFF, 0x5000},
-	{0xFFF000000000001F, 0xFE00},
-	{0x4012000000010000, 0x4480},
-	{0xFFF000000000000F, 0xFE00},
-	{0x40032532160CC4D7, 0x40C9},
-	{0xFFF0000000000007, 0xFE00},
-	{0x37FF65A2E35C2E99, 0x0000},
-	{0xFFF0000000000003, 0xFE00},
-}
@@ -0,0 +1,72 @@
+package float16
+
+import "strconv"
+
+// Flags is a set of IEEE 754 exception flags.
+// The bit layout is the same as the flags column of Berkeley TestFloat.
+type Flags uint8
+
+// These constants define the exception flags.
+const (
+	Inexact   Flags = 1 << iota // the rounded result differs from the exact result
+	Underflow                   // the result is tiny and inexact
+	Overflow                    // the rounded result is too large to be finite
+	DivByZero                   // an exact infinite result is produced from finite operands
+	Invalid                     // the operation has no usefully definable result
+)
+
+var flagNames = [...]string{
+	"Inexact",
+	"Underflow",
+	"Overflow",
+	"DivByZero",
+	"Invalid",
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	var buf []byte
+	for i, name := range flagNames {
+		if f&(1<<i) == 0 {
+			continue
+		}
+		if len(buf) > 0 {
+			buf = append(buf, '|')
+		}
+		buf = append(buf, name...)
+		f &^= 1 << i
+	}
+	if f != 0 {
+		if len(buf) > 0 {
+			buf = append(buf, '|')
+		}
+		buf = append(buf, "0x"...)
+		buf = append(buf, nibble('x', uint16(f)>>4), nibble('x', uint16(f)))
+	}
+	return string(buf)
+}
+
+// Tininess determines when underflow is detected.
+type Tininess byte
+
+// These constants define the methods of detecting tininess.
+const (
+	// AfterRounding detects tininess after rounding,
+	// as if the exponent range were unbounded.
+	AfterRounding Tininess = iota
+
+	// BeforeRounding detects tininess before rounding.
+	BeforeRounding
+)
+
+func (t Tininess) String() string {
+	switch t {
+	case AfterRounding:
+		return "AfterRounding"
+	case BeforeRounding:
+		return "BeforeRounding"
+	}
+	return "Tininess(" + strconv.Itoa(int(t)) + ")"
+}
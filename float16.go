@@ -5,16 +5,22 @@ import (
 	"math/bits"
 )
 
+// The parameters of [FormatFloat16]; the other constants of Float16 are derived from them.
 const (
-	uvnan      = 0x7e00     // "not-a-number"
-	uvinf      = 0x7c00     // infinity
-	uvneginf   = 0xfc00     // negative infinity
-	uvone      = 0x3c00     // one
-	mask16     = 0x1f       // mask for exponent
-	shift16    = 16 - 5 - 1 // shift for exponent
-	bias16     = 15         // bias for exponent
-	signMask16 = 1 << 15    // mask for sign bit
+	expBits16  = 5
+	fracBits16 = 10
+)
+
+const (
+	mask16     = 1<<expBits16 - 1              // mask for exponent
+	shift16    = fracBits16                    // shift for exponent
+	bias16     = 1<<(expBits16-1) - 1          // bias for exponent
+	signMask16 = 1 << (expBits16 + fracBits16) // mask for sign bit
 	fracMask16 = 1<<shift16 - 1
+	uvinf      = mask16 << shift16      // infinity
+	uvneginf   = signMask16 | uvinf     // negative infinity
+	uvnan      = uvinf | 1<<(shift16-1) // "not-a-number"
+	uvone      = bias16 << shift16      // one
 )
 
 const (
@@ -33,6 +39,11 @@ const (
 	fracMask64 = 1<<shift64 - 1
 )
 
+var (
+	float32info = floatInfo{shift32, 8, -bias32}
+	float64info = floatInfo{shift64, 11, -bias64}
+)
+
 // Float16 represents a 16-bit floating point number.
 type Float16 uint16
 
@@ -66,6 +77,7 @@ func (f Float16) Bits() uint16 {
 
 // FromFloat32 returns the floating point number corresponding
 // to the IEEE 754 binary representation of f.
+// A NaN is converted to a quiet NaN with the same sign and the high-order bits of the payload.
 func FromFloat32(f float32) Float16 {
 	b := math.Float32bits(f)
 	sign := uint16((b & signMask32) >> (32 - 16))
@@ -77,7 +89,7 @@ func FromFloat32(f float32) Float16 {
 			// infinity or negative infinity
 			return Float16(sign | (mask16 << shift16))
 		} else {
-			// NaN
+			// NaN; keep the sign and the payload, and make it quiet.
 			return Float16(sign | uvnan | uint16(frac>>(shift32-shift16)&fracMask16))
 		}
 	}
@@ -110,6 +122,7 @@ func FromFloat32(f float32) Float16 {
 
 // FromFloat64 returns the floating point number corresponding
 // to the IEEE 754 binary representation of f.
+// A NaN is converted to a quiet NaN with the same sign and the high-order bits of the payload.
 func FromFloat64(f float64) Float16 {
 	b := math.Float64bits(f)
 	sign := uint16((b & signMask64) >> (64 - 16))
@@ -121,8 +134,8 @@ func FromFloat64(f float64) Float16 {
 			// infinity or negative infinity
 			return Float16(sign | (mask16 << shift16))
 		} else {
-			// NaN
-			return Float16(uvnan)
+			// NaN; keep the sign and the payload, and make it quiet.
+			return Float16(sign | uvnan | uint16(frac>>(shift64-shift16)&fracMask16))
 		}
 	}
 
@@ -153,6 +166,7 @@ func FromFloat64(f float64) Float16 {
 }
 
 // Float32 returns the float32 representation of f.
+// A NaN is converted to a quiet NaN with the same sign and payload.
 func (f Float16) Float32() float32 {
 	sign := uint32(f&signMask16) << (32 - 16)
 	exp := uint32(f>>shift16) & mask16
@@ -170,6 +184,10 @@ func (f Float16) Float32() float32 {
 	} else if exp == mask16 {
 		// infinity or NaN
 		exp = mask32
+		if frac != 0 {
+			// keep the payload, and make it quiet.
+			frac |= quietBit16
+		}
 	} else {
 		// normal number
 		exp += bias32 - bias16
@@ -178,6 +196,7 @@ func (f Float16) Float32() float32 {
 }
 
 // Float64 returns the float64 representation of f.
+// A NaN is converted to a quiet NaN with the same sign and payload.
 func (f Float16) Float64() float64 {
 	sign := uint64(f&signMask16) << (64 - 16)
 	exp := uint64(f>>shift16) & mask16
@@ -196,7 +215,8 @@ func (f Float16) Float64() float64 {
 		// infinity or NaN
 		exp = mask64
 		if frac != 0 {
-			frac = 1 << (shift64 - 1)
+			// keep the payload, and make it quiet.
+			frac = (frac | quietBit16) << (shift64 - shift16)
 		}
 	} else {
 		// normal number
@@ -233,3 +253,63 @@ func (f Float16) split() (sign uint16, exp int32, frac uint16) {
 	}
 	return
 }
+
+// narrow converts the finite non-negative number with the bit representation b
+// in the format src to the narrower format dst, rounding to nearest even.
+// The exponent range of dst is assumed to be unbounded above,
+// so the caller must check the result for overflow.
+func narrow(b uint64, src, dst *floatInfo) uint64 {
+	exp := int(b>>src.mantbits) & (1<<src.expbits - 1)
+	frac := b & (1<<src.mantbits - 1)
+	if exp == 0 {
+		// subnormal number
+		exp = 1
+	} else {
+		// normal number
+		frac |= 1 << src.mantbits
+	}
+	exp += src.bias
+
+	if exp <= dst.bias {
+		// handle subnormal number
+		roundBit := dst.bias + 1 - exp + int(src.mantbits-dst.mantbits)
+		if roundBit >= 64 {
+			return 0
+		}
+		halfMinusULP := uint64(1)<<(roundBit-1) - 1
+		frac += halfMinusULP + ((frac >> roundBit) & 1)
+		return frac >> roundBit
+	}
+
+	// handle normal number
+
+	// round to nearest even
+	shift := src.mantbits - dst.mantbits
+	halfMinusULP := uint64(1)<<(shift-1) - 1
+	frac += halfMinusULP + ((frac >> shift) & 1)
+
+	// frac contains the implicit bit, so adding it carries into the exponent field.
+	return uint64(exp-dst.bias-1)<<dst.mantbits + frac>>shift
+}
+
+// widen converts the finite non-negative number with the bit representation b
+// in the format src to the wider format dst.
+// The conversion is exact.
+func widen(b uint64, src, dst *floatInfo) uint64 {
+	exp := int(b>>src.mantbits) & (1<<src.expbits - 1)
+	frac := b & (1<<src.mantbits - 1)
+	if exp == 0 {
+		// subnormal number
+		shift := (src.bias - int(src.mantbits)) - (dst.bias - int(dst.mantbits))
+		l := bits.Len64(frac)
+		if l == 0 || l+shift <= int(dst.mantbits)+1 {
+			// frac << shift is the value in the unit of the smallest subnormal number of dst,
+			// and it is also the bit representation.
+			return frac << shift
+		}
+		frac = (frac << (int(src.mantbits) - l + 1)) & (1<<src.mantbits - 1)
+		exp = l - int(src.mantbits)
+	}
+	exp += src.bias - dst.bias
+	return uint64(exp)<<dst.mantbits | frac<<(dst.mantbits-src.mantbits)
+}
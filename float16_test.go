@@ -85,15 +85,10 @@ func TestFromFloat32(t *testing.T) {
 	}
 }
 
-//go:generate sh -c "perl scripts/f32_to_f16.pl | gofmt > f32_to_f16_test.go"
 func TestFromFloat32_TestFloat(t *testing.T) {
-	for _, tt := range f32ToF16 {
-		f32 := math.Float32frombits(tt.f32)
-		got := FromFloat32(f32)
-		if got.Bits() != tt.f16 {
-			t.Errorf("%08x: expected %04x, got %04x", tt.f32, tt.f16, got.Bits())
-		}
-	}
+	testFloat(t, "f32_to_f16", func(x []uint64) uint64 {
+		return uint64(FromFloat32(math.Float32frombits(uint32(x[0]))))
+	})
 }
 
 func TestFromFloat32_All(t *testing.T) {
@@ -156,19 +151,10 @@ func TestFromFloat64(t *testing.T) {
 	}
 }
 
-//go:generate sh -c "perl scripts/f64_to_f16.pl | gofmt > f64_to_f16_test.go"
 func TestFromFloat64_TestFloat(t *testing.T) {
-	for _, tt := range f64ToF16 {
-		f64 := math.Float64frombits(tt.f64)
-		got := FromFloat64(f64)
-		want := FromBits(tt.f16)
-		if got.IsNaN() && want.IsNaN() {
-			continue
-		}
-		if got != want {
-			t.Errorf("%016x: expected %04x, got %04x", tt.f64, tt.f16, got.Bits())
-		}
-	}
+	testFloat(t, "f64_to_f16", func(x []uint64) uint64 {
+		return uint64(FromFloat64(math.Float64frombits(x[0])))
+	})
 }
 
 func TestFromFloat64_All(t *testing.T) {
@@ -0,0 +1,282 @@
+package float16
+
+import (
+	"math"
+)
+
+const (
+	uvnanE4M3   = 0x7f   // "not-a-number"
+	uvmaxE4M3   = 0x7e   // the largest finite value, 448
+	signE4M3    = 1 << 7 // mask for sign bit
+	uvnanE5M2   = 0x7e   // "not-a-number"
+	uvinfE5M2   = 0x7c   // infinity
+	uvnegE5M2   = 0xfc   // negative infinity
+	uvmaxE5M2   = 0x7b   // the largest finite value, 57344
+	signE5M2    = 1 << 7 // mask for sign bit
+	fracE5M2    = 1<<2 - 1
+	expMaskE5M2 = 0x1f << 2
+)
+
+// e4m3info describes E4M3.
+// E4M3 uses the largest exponent for finite numbers,
+// so e4m3info has an extra exponent bit to round the numbers beyond the largest exponent.
+// The sign bit is also moved to bit 8, so the bit representations must be converted.
+var e4m3info = floatInfo{3, 5, -7}
+
+var e5m2info = floatInfo{2, 5, -15}
+
+// E4M3 represents an 8-bit floating point number in the OCP FP8 E4M3 format.
+// It has 4 exponent bits and 3 fraction bits.
+// E4M3 has no infinities, and S.1111.111 is NaN, so the largest finite value is 448.
+type E4M3 uint8
+
+// E5M2 represents an 8-bit floating point number in the OCP FP8 E5M2 format.
+// It has 5 exponent bits and 2 fraction bits, and follows the IEEE 754 conventions
+// for infinities and NaNs. The largest finite value is 57344.
+type E5M2 uint8
+
+// E4M3FromBits returns the floating point number corresponding
+// the binary representation b.
+func E4M3FromBits(b uint8) E4M3 {
+	return E4M3(b)
+}
+
+// Bits returns the binary representation of x.
+func (x E4M3) Bits() uint8 {
+	return uint8(x)
+}
+
+// E4M3NaN returns a “not-a-number” value.
+func E4M3NaN() E4M3 {
+	return E4M3(uvnanE4M3)
+}
+
+// IsNaN reports whether x is a “not-a-number” value.
+func (x E4M3) IsNaN() bool {
+	return x&^signE4M3 == uvnanE4M3
+}
+
+// E4M3FromFloat16 returns the E4M3 nearest to f, rounding ties to even.
+// If saturate is true, the values that are too large to be represented,
+// including infinities, become the largest finite value with the same sign.
+// Otherwise they become NaN.
+func E4M3FromFloat16(f Float16, saturate bool) E4M3 {
+	return e4m3FromBits(uint64(f), &float16info, saturate)
+}
+
+// E4M3FromFloat32 returns the E4M3 nearest to f, rounding ties to even.
+// saturate is handled as in [E4M3FromFloat16].
+func E4M3FromFloat32(f float32, saturate bool) E4M3 {
+	return e4m3FromBits(uint64(math.Float32bits(f)), &float32info, saturate)
+}
+
+// E4M3FromFloat64 returns the E4M3 nearest to f, rounding ties to even.
+// saturate is handled as in [E4M3FromFloat16].
+func E4M3FromFloat64(f float64, saturate bool) E4M3 {
+	return e4m3FromBits(math.Float64bits(f), &float64info, saturate)
+}
+
+// e4m3FromBits converts the number with the bit representation b in the format src to E4M3.
+func e4m3FromBits(b uint64, src *floatInfo, saturate bool) E4M3 {
+	signMask := uint64(1) << (src.expbits + src.mantbits)
+	inf := uint64(1<<src.expbits-1) << src.mantbits
+	var sign E4M3
+	if b&signMask != 0 {
+		sign = signE4M3
+		b &^= signMask
+	}
+
+	if b > inf {
+		// NaN
+		return sign | uvnanE4M3
+	}
+	if b < inf {
+		if r := narrow(b, src, &e4m3info); r <= uvmaxE4M3 {
+			return sign | E4M3(r)
+		}
+	}
+
+	// overflow
+	if saturate {
+		return sign | uvmaxE4M3
+	}
+	return sign | uvnanE4M3
+}
+
+// Float16 returns the Float16 representation of x.
+// The conversion is exact.
+func (x E4M3) Float16() Float16 {
+	sign := Float16(x&signE4M3) << 8
+	if x.IsNaN() {
+		return sign | uvnan
+	}
+	return sign | Float16(widen(uint64(x&^signE4M3), &e4m3info, &float16info))
+}
+
+// Float32 returns the float32 representation of x.
+// The conversion is exact.
+func (x E4M3) Float32() float32 {
+	return x.Float16().Float32()
+}
+
+// Float64 returns the float64 representation of x.
+// The conversion is exact.
+func (x E4M3) Float64() float64 {
+	return x.Float16().Float64()
+}
+
+// ParseE4M3 converts the string s to an E4M3, rounding to nearest even.
+// It accepts the same syntax as [Parse].
+// If s is syntactically well-formed but is out of the range of E4M3,
+// including infinities, ParseE4M3 returns NaN and err.Err = ErrRange.
+// A non-zero value that underflows to zero returns ±0 and err.Err = ErrRange.
+func ParseE4M3(s string) (E4M3, error) {
+	b, err := FormatE4M3.parse(s, "float16.ParseE4M3")
+	return E4M3(b), err
+}
+
+func (x E4M3) String() string {
+	return x.Text('g', -1)
+}
+
+// Text converts x to a string, in the same way as [strconv.FormatFloat].
+func (x E4M3) Text(fmt byte, prec int) string {
+	return string(x.Append(make([]byte, 0, 8), fmt, prec))
+}
+
+// Append appends the string form of x, as generated by x.Text, to buf
+// and returns the extended buffer.
+func (x E4M3) Append(buf []byte, fmt byte, prec int) []byte {
+	return FormatE4M3.Append(buf, uint16(x), fmt, prec)
+}
+
+// E5M2FromBits returns the floating point number corresponding
+// the binary representation b.
+func E5M2FromBits(b uint8) E5M2 {
+	return E5M2(b)
+}
+
+// Bits returns the binary representation of x.
+func (x E5M2) Bits() uint8 {
+	return uint8(x)
+}
+
+// E5M2Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func E5M2Inf(sign int) E5M2 {
+	if sign >= 0 {
+		return E5M2(uvinfE5M2)
+	}
+	return E5M2(uvnegE5M2)
+}
+
+// IsInf reports whether x is an infinity, according to sign.
+// If sign > 0, IsInf reports whether x is positive infinity.
+// If sign < 0, IsInf reports whether x is negative infinity.
+// If sign == 0, IsInf reports whether x is either infinity.
+func (x E5M2) IsInf(sign int) bool {
+	return sign >= 0 && x == uvinfE5M2 || sign <= 0 && x == uvnegE5M2
+}
+
+// E5M2NaN returns a “not-a-number” value.
+func E5M2NaN() E5M2 {
+	return E5M2(uvnanE5M2)
+}
+
+// IsNaN reports whether x is a “not-a-number” value.
+func (x E5M2) IsNaN() bool {
+	return x&expMaskE5M2 == expMaskE5M2 && x&fracE5M2 != 0
+}
+
+// E5M2FromFloat16 returns the E5M2 nearest to f, rounding ties to even.
+// If saturate is true, the values that are too large to be represented,
+// including infinities, become the largest finite value with the same sign.
+// Otherwise they become infinities.
+func E5M2FromFloat16(f Float16, saturate bool) E5M2 {
+	return e5m2FromBits(uint64(f), &float16info, saturate)
+}
+
+// E5M2FromFloat32 returns the E5M2 nearest to f, rounding ties to even.
+// saturate is handled as in [E5M2FromFloat16].
+func E5M2FromFloat32(f float32, saturate bool) E5M2 {
+	return e5m2FromBits(uint64(math.Float32bits(f)), &float32info, saturate)
+}
+
+// E5M2FromFloat64 returns the E5M2 nearest to f, rounding ties to even.
+// saturate is handled as in [E5M2FromFloat16].
+func E5M2FromFloat64(f float64, saturate bool) E5M2 {
+	return e5m2FromBits(math.Float64bits(f), &float64info, saturate)
+}
+
+// e5m2FromBits converts the number with the bit representation b in the format src to E5M2.
+func e5m2FromBits(b uint64, src *floatInfo, saturate bool) E5M2 {
+	signMask := uint64(1) << (src.expbits + src.mantbits)
+	inf := uint64(1<<src.expbits-1) << src.mantbits
+	var sign E5M2
+	if b&signMask != 0 {
+		sign = signE5M2
+		b &^= signMask
+	}
+
+	if b > inf {
+		// NaN
+		return sign | uvnanE5M2
+	}
+	if b < inf {
+		if r := narrow(b, src, &e5m2info); r <= uvmaxE5M2 {
+			return sign | E5M2(r)
+		}
+	}
+
+	// overflow
+	if saturate {
+		return sign | uvmaxE5M2
+	}
+	return sign | uvinfE5M2
+}
+
+// Float16 returns the Float16 representation of x.
+// The conversion is exact.
+func (x E5M2) Float16() Float16 {
+	sign := Float16(x&signE5M2) << 8
+	switch {
+	case x.IsNaN():
+		return sign | uvnan
+	case x.IsInf(0):
+		return sign | uvinf
+	}
+	return sign | Float16(widen(uint64(x&^signE5M2), &e5m2info, &float16info))
+}
+
+// Float32 returns the float32 representation of x.
+// The conversion is exact.
+func (x E5M2) Float32() float32 {
+	return x.Float16().Float32()
+}
+
+// Float64 returns the float64 representation of x.
+// The conversion is exact.
+func (x E5M2) Float64() float64 {
+	return x.Float16().Float64()
+}
+
+// ParseE5M2 converts the string s to an E5M2, rounding to nearest even.
+// It accepts the same syntax as [Parse].
+func ParseE5M2(s string) (E5M2, error) {
+	b, err := FormatE5M2.parse(s, "float16.ParseE5M2")
+	return E5M2(b), err
+}
+
+func (x E5M2) String() string {
+	return x.Text('g', -1)
+}
+
+// Text converts x to a string, in the same way as [strconv.FormatFloat].
+func (x E5M2) Text(fmt byte, prec int) string {
+	return string(x.Append(make([]byte, 0, 8), fmt, prec))
+}
+
+// Append appends the string form of x, as generated by x.Text, to buf
+// and returns the extended buffer.
+func (x E5M2) Append(buf []byte, fmt byte, prec int) []byte {
+	return genericFtoa(buf, uint64(x), fmt, prec, &e5m2info)
+}
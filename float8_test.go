@@ -0,0 +1,414 @@
+package float16
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+)
+
+// float8Values returns the magnitudes of the codes of an 8-bit format.
+// The codes beyond the largest finite value hold the values
+// as if the exponent range were unbounded.
+func float8Values(mantbits, bias int) []float64 {
+	values := make([]float64, 0x81)
+	for code := range values {
+		exp := code >> mantbits
+		mant := code & (1<<mantbits - 1)
+		if exp == 0 {
+			values[code] = math.Ldexp(float64(mant), 1-bias-mantbits)
+		} else {
+			values[code] = math.Ldexp(float64(mant|1<<mantbits), exp-bias-mantbits)
+		}
+	}
+	return values
+}
+
+var (
+	e4m3Values = float8Values(3, 7)
+	e5m2Values = float8Values(2, 15)
+)
+
+// roundFloat8 is a reference implementation of the conversions to 8-bit formats.
+// It returns the code nearest to the magnitude of f, rounding ties to even codes.
+func roundFloat8(f float64, values []float64) (code int) {
+	f = math.Abs(f)
+	if f >= values[len(values)-1] {
+		return len(values) - 1
+	}
+	for values[code+1] <= f {
+		code++
+	}
+	mid := (values[code] + values[code+1]) / 2
+	if f > mid || f == mid && code%2 == 1 {
+		code++
+	}
+	return code
+}
+
+func refE4M3(f float64, saturate bool) E4M3 {
+	var sign E4M3
+	if math.Signbit(f) {
+		sign = 0x80
+	}
+	if math.IsNaN(f) {
+		return sign | 0x7f
+	}
+	if code := roundFloat8(f, e4m3Values); code <= 0x7e {
+		return sign | E4M3(code)
+	}
+	if saturate {
+		return sign | 0x7e
+	}
+	return sign | 0x7f
+}
+
+func refE5M2(f float64, saturate bool) E5M2 {
+	var sign E5M2
+	if math.Signbit(f) {
+		sign = 0x80
+	}
+	if math.IsNaN(f) {
+		return sign | 0x7e
+	}
+	if code := roundFloat8(f, e5m2Values); code <= 0x7b {
+		return sign | E5M2(code)
+	}
+	if saturate {
+		return sign | 0x7b
+	}
+	return sign | 0x7c
+}
+
+func TestE4M3(t *testing.T) {
+	tests := []struct {
+		x    E4M3
+		want float64
+	}{
+		{0x00, 0},
+		{0x80, negZero},
+		{0x01, 0x1p-9},   // smallest positive subnormal number
+		{0x07, 0x1.cp-7}, // largest positive subnormal number
+		{0x08, 0x1p-6},   // smallest positive normal number
+		{0x38, 1},
+		{0x39, 1.125},
+		{0x7e, 448}, // largest normal number
+		{0xfe, -448},
+		{0x7f, math.NaN()},
+		{0xff, math.NaN()},
+	}
+	for _, tt := range tests {
+		got := tt.x.Float64()
+		if math.Float64bits(got) != math.Float64bits(tt.want) && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+			t.Errorf("%02x: expected %v, got %v", tt.x, tt.want, got)
+		}
+	}
+
+	for b := 0; b < 1<<8; b++ {
+		x := E4M3FromBits(uint8(b))
+		if x.IsNaN() != (b&0x7f == 0x7f) {
+			t.Errorf("%02x: unexpected IsNaN", b)
+		}
+		if x.IsNaN() {
+			continue
+		}
+		if got := E4M3FromFloat16(x.Float16(), false); got != x {
+			t.Errorf("%02x: expected %02x, got %02x", b, x, got)
+		}
+		if got := float64(x.Float32()); got != x.Float64() {
+			t.Errorf("%02x: expected %v, got %v", b, x.Float64(), got)
+		}
+	}
+}
+
+func TestE4M3FromFloat16_All(t *testing.T) {
+	for b := 0; b < 1<<16; b++ {
+		f := Float16(b)
+		for _, saturate := range []bool{false, true} {
+			want := refE4M3(f.Float64(), saturate)
+			if got := E4M3FromFloat16(f, saturate); got != want {
+				t.Errorf("%04x, %t: expected %02x, got %02x", b, saturate, want, got)
+			}
+		}
+	}
+}
+
+func TestE4M3FromFloat32(t *testing.T) {
+	tests := []struct {
+		f        float32
+		saturate bool
+		want     E4M3
+	}{
+		{1, false, 0x38},
+		{0x1.1p0, false, 0x38}, // rounds to nearest even
+		{0x1.3p0, false, 0x3a},
+		{0x1p-10, false, 0x00},
+		{math.Nextafter32(0x1p-10, 1), false, 0x01},
+		{464, false, 0x7e},
+		{math.Nextafter32(464, 1000), false, 0x7f},
+		{math.Nextafter32(464, 1000), true, 0x7e},
+		{-1000, false, 0xff},
+		{-1000, true, 0xfe},
+		{float32(math.Inf(1)), false, 0x7f},
+		{float32(math.Inf(-1)), true, 0xfe},
+		{float32(math.NaN()), true, 0x7f},
+	}
+	for _, tt := range tests {
+		if got := E4M3FromFloat32(tt.f, tt.saturate); got != tt.want {
+			t.Errorf("%x, %t: expected %02x, got %02x", tt.f, tt.saturate, tt.want, got)
+		}
+		if got := E4M3FromFloat64(float64(tt.f), tt.saturate); got != tt.want {
+			t.Errorf("%x, %t: expected %02x, got %02x", tt.f, tt.saturate, tt.want, got)
+		}
+	}
+
+	r := newXorshift64()
+	for i := 0; i < 1<<16; i++ {
+		f := randomFloat8Input(r)
+		if got, want := E4M3FromFloat64(f, false), refE4M3(f, false); got != want {
+			t.Errorf("%x: expected %02x, got %02x", f, want, got)
+		}
+		f32 := float32(f)
+		if got, want := E4M3FromFloat32(f32, true), refE4M3(float64(f32), true); got != want {
+			t.Errorf("%x: expected %02x, got %02x", f32, want, got)
+		}
+	}
+}
+
+// randomFloat8Input returns a random float64 around the range of 8-bit formats.
+func randomFloat8Input(r *xorshift64) float64 {
+	u := r.Uint64()
+	exp := int(u%48) - 24
+	f := math.Ldexp(1+float64(u>>11)/(1<<53), exp)
+	if u&(1<<6) != 0 {
+		// the midpoints are interesting for rounding.
+		f = math.Ldexp(math.Round(math.Ldexp(f, 5)), -5)
+	}
+	if u&(1<<7) != 0 {
+		f = -f
+	}
+	return f
+}
+
+func TestParseE4M3(t *testing.T) {
+	tests := []struct {
+		s    string
+		want E4M3
+		err  error
+	}{
+		{"1", 0x38, nil},
+		{"-0", 0x80, nil},
+		{"448", 0x7e, nil},
+		{"464", 0x7e, nil},
+		{"0x1.cp8", 0x7e, nil},
+		{"0.001953125", 0x01, nil},
+		{"nan", 0x7f, nil},
+		{"465", 0x7f, strconv.ErrRange},
+		{"-1e10", 0xff, strconv.ErrRange},
+		{"inf", 0x7f, strconv.ErrRange},
+		{"0x1p9", 0x7f, strconv.ErrRange},
+		{"1e", 0x00, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		got, err := ParseE4M3(tt.s)
+		if got != tt.want {
+			t.Errorf("%q: expected %02x, got %02x", tt.s, tt.want, got)
+		}
+		if tt.err == nil {
+			if err != nil {
+				t.Errorf("%q: unexpected error: %v", tt.s, err)
+			}
+			continue
+		}
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) || numErr.Func != "float16.ParseE4M3" || numErr.Err != tt.err {
+			t.Errorf("%q: unexpected error: %v", tt.s, err)
+		}
+	}
+}
+
+func TestE5M2(t *testing.T) {
+	tests := []struct {
+		x    E5M2
+		want float64
+	}{
+		{0x00, 0},
+		{0x80, negZero},
+		{0x01, 0x1p-16},   // smallest positive subnormal number
+		{0x03, 0x1.8p-15}, // largest positive subnormal number
+		{0x04, 0x1p-14},   // smallest positive normal number
+		{0x3c, 1},
+		{0x3d, 1.25},
+		{0x7b, 57344}, // largest normal number
+		{0x7c, math.Inf(1)},
+		{0xfc, math.Inf(-1)},
+		{0x7d, math.NaN()},
+		{0x7e, math.NaN()},
+		{0xff, math.NaN()},
+	}
+	for _, tt := range tests {
+		got := tt.x.Float64()
+		if math.Float64bits(got) != math.Float64bits(tt.want) && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+			t.Errorf("%02x: expected %v, got %v", tt.x, tt.want, got)
+		}
+	}
+
+	for b := 0; b < 1<<8; b++ {
+		x := E5M2FromBits(uint8(b))
+		if x.IsNaN() != (b&0x7f > 0x7c) {
+			t.Errorf("%02x: unexpected IsNaN", b)
+		}
+		if x.IsNaN() {
+			continue
+		}
+		if got := E5M2FromFloat16(x.Float16(), false); got != x {
+			t.Errorf("%02x: expected %02x, got %02x", b, x, got)
+		}
+		if got := float64(x.Float32()); got != x.Float64() {
+			t.Errorf("%02x: expected %v, got %v", b, x.Float64(), got)
+		}
+	}
+}
+
+func TestE5M2FromFloat16_All(t *testing.T) {
+	for b := 0; b < 1<<16; b++ {
+		f := Float16(b)
+		for _, saturate := range []bool{false, true} {
+			want := refE5M2(f.Float64(), saturate)
+			if got := E5M2FromFloat16(f, saturate); got != want {
+				t.Errorf("%04x, %t: expected %02x, got %02x", b, saturate, want, got)
+			}
+		}
+	}
+}
+
+func TestE5M2FromFloat32(t *testing.T) {
+	tests := []struct {
+		f        float32
+		saturate bool
+		want     E5M2
+	}{
+		{1, false, 0x3c},
+		{1.125, false, 0x3c}, // rounds to nearest even
+		{1.375, false, 0x3e},
+		{0x1p-17, false, 0x00},
+		{math.Nextafter32(0x1p-17, 1), false, 0x01},
+		{61440, false, 0x7c},
+		{math.Nextafter32(61440, 0), false, 0x7b},
+		{61440, true, 0x7b},
+		{float32(math.Inf(-1)), false, 0xfc},
+		{float32(math.Inf(-1)), true, 0xfb},
+		{float32(math.NaN()), false, 0x7e},
+	}
+	for _, tt := range tests {
+		if got := E5M2FromFloat32(tt.f, tt.saturate); got != tt.want {
+			t.Errorf("%x, %t: expected %02x, got %02x", tt.f, tt.saturate, tt.want, got)
+		}
+		if got := E5M2FromFloat64(float64(tt.f), tt.saturate); got != tt.want {
+			t.Errorf("%x, %t: expected %02x, got %02x", tt.f, tt.saturate, tt.want, got)
+		}
+	}
+
+	r := newXorshift64()
+	for i := 0; i < 1<<16; i++ {
+		f := randomFloat8Input(r)
+		if got, want := E5M2FromFloat64(f, false), refE5M2(f, false); got != want {
+			t.Errorf("%x: expected %02x, got %02x", f, want, got)
+		}
+		f32 := float32(f)
+		if got, want := E5M2FromFloat32(f32, true), refE5M2(float64(f32), true); got != want {
+			t.Errorf("%x: expected %02x, got %02x", f32, want, got)
+		}
+	}
+}
+
+func TestParseE5M2(t *testing.T) {
+	tests := []struct {
+		s    string
+		want E5M2
+		err  error
+	}{
+		{"1", 0x3c, nil},
+		{"-0", 0x80, nil},
+		{"57344", 0x7b, nil},
+		{"61439", 0x7b, nil},
+		{"0x1p-16", 0x01, nil},
+		{"-inf", 0xfc, nil},
+		{"nan", 0x7e, nil},
+		{"61440", 0x7c, strconv.ErrRange},
+		{"-1e10", 0xfc, strconv.ErrRange},
+		{"1_", 0x00, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		got, err := ParseE5M2(tt.s)
+		if got != tt.want {
+			t.Errorf("%q: expected %02x, got %02x", tt.s, tt.want, got)
+		}
+		if tt.err == nil {
+			if err != nil {
+				t.Errorf("%q: unexpected error: %v", tt.s, err)
+			}
+			continue
+		}
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) || numErr.Func != "float16.ParseE5M2" || numErr.Err != tt.err {
+			t.Errorf("%q: unexpected error: %v", tt.s, err)
+		}
+	}
+}
+
+func TestFloat8_Text_RoundTrip(t *testing.T) {
+	for b := 0; b < 1<<8; b++ {
+		x := E4M3FromBits(uint8(b))
+		y := E5M2FromBits(uint8(b))
+		for _, fmt := range []byte{'e', 'f', 'g', 'x'} {
+			if !x.IsNaN() {
+				s := x.Text(fmt, -1)
+				if got, err := ParseE4M3(s); err != nil || got != x {
+					t.Errorf("%02x: %q round trips to %02x, %v", b, s, got, err)
+				}
+			}
+			if !y.IsNaN() {
+				s := y.Text(fmt, -1)
+				if got, err := ParseE5M2(s); err != nil || got != y {
+					t.Errorf("%02x: %q round trips to %02x, %v", b, s, got, err)
+				}
+			}
+		}
+		for _, fmt := range []byte{'e', 'f', 'g'} {
+			for _, prec := range []int{0, 2, 5} {
+				if got, want := x.Text(fmt, prec), strconv.FormatFloat(x.Float64(), fmt, prec, 64); got != want {
+					t.Errorf("%02x.Text(%q, %d): expected %q, got %q", b, fmt, prec, want, got)
+				}
+				if got, want := y.Text(fmt, prec), strconv.FormatFloat(y.Float64(), fmt, prec, 64); got != want {
+					t.Errorf("%02x.Text(%q, %d): expected %q, got %q", b, fmt, prec, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestFloat8_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      any
+		want   string
+	}{
+		{"%v", E4M3(0x38), "1"},
+		{"%v", E4M3(0xfe), "-450"},
+		{"%+.2f", E4M3(0x01), "+0.00"},
+		{"%x", E4M3(0x01), "0x1p-09"},
+		{"%v", E4M3(0x7f), "NaN"},
+		{"%v", E5M2(0x7b), "60000"},
+		{"%v", E5M2(0x7c), "+Inf"},
+		{"%b", E5M2(0x3c), "4p-2"},
+		{"%v", E5M2(0x7e), "NaN"},
+	}
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("%q: expected %q, got %q", tt.format, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+// Package floats provides the functions that operate on slices of [float16.Float16].
+//
+// The elementwise operations give the bit-identical results to the corresponding
+// methods of Float16. The reductions, such as [Sum] and [Dot], accumulate in a wider
+// format and convert the result to Float16 at the end.
+// The sums of up to 2^13 Float16 values are exact in float64, so [Sum] of such slices is correctly rounded.
+// The other reductions round the partial sums, so their results may differ from the correctly rounded ones.
+package floats
+
+import (
+	"strconv"
+
+	"github.com/shogo82148/float16"
+)
+
+// Accumulator selects the format that the reductions accumulate in.
+type Accumulator uint8
+
+// These constants define supported accumulators.
+const (
+	// Float64 accumulates in float64.
+	// The sums of up to 2^13 Float16 values and the products of two Float16 values are exact in float64,
+	// but the sums of the products may be rounded.
+	Float64 Accumulator = iota
+
+	// Float32 accumulates in float32.
+	// The products of Float16 values are exact in float32, but the sums may be rounded.
+	Float32
+
+	// Compensated accumulates in float64 with Neumaier's compensated summation,
+	// which keeps the rounding errors of the sums.
+	// It is slower than Float64, but the result is as accurate as if the sums were computed
+	// in twice the precision of float64, regardless of the length.
+	Compensated
+)
+
+func (acc Accumulator) String() string {
+	switch acc {
+	case Float64:
+		return "Float64"
+	case Float32:
+		return "Float32"
+	case Compensated:
+		return "Compensated"
+	}
+	return "Accumulator(" + strconv.Itoa(int(acc)) + ")"
+}
+
+func checkLen(n int, s []float16.Float16) {
+	if len(s) != n {
+		panic("floats: slice lengths do not match")
+	}
+}
+
+// Add sets dst[i] = x[i] + y[i] for all i.
+// dst may overlap x or y exactly. It panics if the lengths of the slices don't match.
+func Add(dst, x, y []float16.Float16) {
+	checkLen(len(dst), x)
+	checkLen(len(dst), y)
+	for i := range dst {
+		dst[i] = x[i].Add(y[i])
+	}
+}
+
+// Sub sets dst[i] = x[i] - y[i] for all i.
+// dst may overlap x or y exactly. It panics if the lengths of the slices don't match.
+func Sub(dst, x, y []float16.Float16) {
+	checkLen(len(dst), x)
+	checkLen(len(dst), y)
+	for i := range dst {
+		dst[i] = x[i].Sub(y[i])
+	}
+}
+
+// Mul sets dst[i] = x[i] × y[i] for all i.
+// dst may overlap x or y exactly. It panics if the lengths of the slices don't match.
+func Mul(dst, x, y []float16.Float16) {
+	checkLen(len(dst), x)
+	checkLen(len(dst), y)
+	for i := range dst {
+		dst[i] = x[i].Mul(y[i])
+	}
+}
+
+// Div sets dst[i] = x[i] / y[i] for all i, in the same way as [float16.Float16.Quo].
+// dst may overlap x or y exactly. It panics if the lengths of the slices don't match.
+func Div(dst, x, y []float16.Float16) {
+	checkLen(len(dst), x)
+	checkLen(len(dst), y)
+	for i := range dst {
+		dst[i] = x[i].Quo(y[i])
+	}
+}
+
+// FMA sets dst[i] = x[i] × y[i] + z[i] for all i, computed with only one rounding.
+// dst may overlap x, y or z exactly. It panics if the lengths of the slices don't match.
+func FMA(dst, x, y, z []float16.Float16) {
+	checkLen(len(dst), x)
+	checkLen(len(dst), y)
+	checkLen(len(dst), z)
+	for i := range dst {
+		dst[i] = float16.FMA(x[i], y[i], z[i])
+	}
+}
+
+// Scale sets dst[i] = a × x[i] for all i.
+// dst may overlap x exactly. It panics if the lengths of the slices don't match.
+func Scale(dst []float16.Float16, a float16.Float16, x []float16.Float16) {
+	checkLen(len(dst), x)
+	for i := range dst {
+		dst[i] = a.Mul(x[i])
+	}
+}
+
+// Axpy sets y[i] = a × x[i] + y[i] for all i, computed with only one rounding for each element.
+// It panics if the lengths of the slices don't match.
+func Axpy(a float16.Float16, x, y []float16.Float16) {
+	checkLen(len(y), x)
+	for i := range y {
+		y[i] = float16.FMA(a, x[i], y[i])
+	}
+}
+
+// Sum returns the sum of the elements of x, accumulating in float64.
+// If x has at most 2^13 elements, the sum is exact before the conversion to Float16,
+// so the result is correctly rounded.
+// The sum of the empty slice is +0.
+func Sum(x []float16.Float16) float16.Float16 {
+	return SumWith(x, Float64)
+}
+
+// SumWith returns the sum of the elements of x, accumulating in the format acc.
+// The sum of the empty slice is +0.
+func SumWith(x []float16.Float16, acc Accumulator) float16.Float16 {
+	switch acc {
+	case Float32:
+		var sum float32
+		for _, v := range x {
+			sum += v.Float32()
+		}
+		return float16.FromFloat32(sum)
+	case Compensated:
+		var s neumaier
+		for _, v := range x {
+			s.add(v.Float64())
+		}
+		return float16.FromFloat64(s.sum())
+	}
+	var sum float64
+	for _, v := range x {
+		sum += v.Float64()
+	}
+	return float16.FromFloat64(sum)
+}
+
+// Dot returns the dot product of x and y, accumulating in float64.
+// The products are exact, but their sum is rounded in float64 before the conversion to Float16,
+// so the result is not always correctly rounded.
+// It panics if the lengths of the slices don't match.
+func Dot(x, y []float16.Float16) float16.Float16 {
+	return DotWith(x, y, Float64)
+}
+
+// DotWith returns the dot product of x and y, accumulating in the format acc.
+// It panics if the lengths of the slices don't match.
+func DotWith(x, y []float16.Float16, acc Accumulator) float16.Float16 {
+	checkLen(len(x), y)
+	switch acc {
+	case Float32:
+		var sum float32
+		for i := range x {
+			sum += x[i].Float32() * y[i].Float32()
+		}
+		return float16.FromFloat32(sum)
+	case Compensated:
+		var s neumaier
+		for i := range x {
+			s.add(x[i].Float64() * y[i].Float64())
+		}
+		return float16.FromFloat64(s.sum())
+	}
+	var sum float64
+	for i := range x {
+		sum += x[i].Float64() * y[i].Float64()
+	}
+	return float16.FromFloat64(sum)
+}
+
+// neumaier is an accumulator of Neumaier's compensated summation.
+type neumaier struct {
+	s, c float64
+}
+
+func (n *neumaier) add(v float64) {
+	t := n.s + v
+	if abs(n.s) >= abs(v) {
+		n.c += (n.s - t) + v
+	} else {
+		n.c += (v - t) + n.s
+	}
+	n.s = t
+}
+
+func (n *neumaier) sum() float64 {
+	if n.c == 0 || n.s-n.s != 0 {
+		// the sum is infinity or NaN, or no errors have been compensated.
+		return n.s
+	}
+	return n.s + n.c
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Max returns the maximum element of x, in the same way as [float16.Float16.Maximum].
+// If x contains NaN, the result is NaN.
+// It panics if x is empty.
+func Max(x []float16.Float16) float16.Float16 {
+	return x[ArgMax(x)]
+}
+
+// Min returns the minimum element of x, in the same way as [float16.Float16.Minimum].
+// If x contains NaN, the result is NaN.
+// It panics if x is empty.
+func Min(x []float16.Float16) float16.Float16 {
+	return x[ArgMin(x)]
+}
+
+// ArgMax returns the index of the maximum element of x.
+// If there are multiple maximum elements, the first one is returned.
+// If x contains NaN, ArgMax returns the index of the first NaN.
+// -0 is considered to be less than +0.
+// It panics if x is empty.
+func ArgMax(x []float16.Float16) int {
+	if len(x) == 0 {
+		panic("floats: zero length slice")
+	}
+	idx := 0
+	for i, v := range x {
+		if v.IsNaN() {
+			return i
+		}
+		if v != x[idx] && v.Maximum(x[idx]) == v {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// ArgMin returns the index of the minimum element of x.
+// If there are multiple minimum elements, the first one is returned.
+// If x contains NaN, ArgMin returns the index of the first NaN.
+// -0 is considered to be less than +0.
+// It panics if x is empty.
+func ArgMin(x []float16.Float16) int {
+	if len(x) == 0 {
+		panic("floats: zero length slice")
+	}
+	idx := 0
+	for i, v := range x {
+		if v.IsNaN() {
+			return i
+		}
+		if v != x[idx] && v.Minimum(x[idx]) == v {
+			idx = i
+		}
+	}
+	return idx
+}
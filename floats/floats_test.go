@@ -0,0 +1,299 @@
+package floats
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/shogo82148/float16"
+)
+
+type Float16 = float16.Float16
+
+// randSlice returns a slice of random Float16 values, including NaNs and infinities.
+func randSlice(r *rand.Rand, n int) []Float16 {
+	s := make([]Float16, n)
+	for i := range s {
+		s[i] = float16.FromBits(uint16(r.Uint32()))
+	}
+	return s
+}
+
+// finiteSlice returns a slice of random finite Float16 values whose magnitudes are less than max.
+func finiteSlice(r *rand.Rand, n int, max float64) []Float16 {
+	s := make([]Float16, n)
+	for i := range s {
+		s[i] = float16.FromFloat64((r.Float64()*2 - 1) * max)
+	}
+	return s
+}
+
+func TestElementwise(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const n = 1 << 16
+	x, y, z := randSlice(r, n), randSlice(r, n), randSlice(r, n)
+
+	tests := []struct {
+		name   string
+		f      func(dst, x, y []Float16)
+		scalar func(a, b Float16) Float16
+	}{
+		{"Add", Add, Float16.Add},
+		{"Sub", Sub, Float16.Sub},
+		{"Mul", Mul, Float16.Mul},
+		{"Div", Div, Float16.Quo},
+		{"Scale", func(dst, x, y []Float16) {
+			for i := range dst {
+				Scale(dst[i:i+1], x[i], y[i:i+1])
+			}
+		}, Float16.Mul},
+	}
+	for _, tt := range tests {
+		dst := make([]Float16, n)
+		tt.f(dst, x, y)
+		for i := range dst {
+			if want := tt.scalar(x[i], y[i]); dst[i] != want {
+				t.Errorf("%s(%04x, %04x): expected %04x, got %04x", tt.name, x[i], y[i], want, dst[i])
+			}
+		}
+
+		// dst may be the same as x.
+		dst = append([]Float16(nil), x...)
+		tt.f(dst, dst, y)
+		for i := range dst {
+			if want := tt.scalar(x[i], y[i]); dst[i] != want {
+				t.Errorf("%s(%04x, %04x) in place: expected %04x, got %04x", tt.name, x[i], y[i], want, dst[i])
+			}
+		}
+	}
+
+	dst := make([]Float16, n)
+	FMA(dst, x, y, z)
+	for i := range dst {
+		if want := float16.FMA(x[i], y[i], z[i]); dst[i] != want {
+			t.Errorf("FMA(%04x, %04x, %04x): expected %04x, got %04x", x[i], y[i], z[i], want, dst[i])
+		}
+	}
+
+	a := float16.FromFloat64(-1.5)
+	dst = append([]Float16(nil), y...)
+	Axpy(a, x, dst)
+	for i := range dst {
+		if want := float16.FMA(a, x[i], y[i]); dst[i] != want {
+			t.Errorf("Axpy(%04x, %04x, %04x): expected %04x, got %04x", a, x[i], y[i], want, dst[i])
+		}
+	}
+}
+
+func TestLengthMismatch(t *testing.T) {
+	a, b := make([]Float16, 3), make([]Float16, 4)
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"Add", func() { Add(a, a, b) }},
+		{"Sub", func() { Sub(b, a, a) }},
+		{"Mul", func() { Mul(a, b, a) }},
+		{"Div", func() { Div(a, a, b) }},
+		{"FMA", func() { FMA(a, a, a, b) }},
+		{"Scale", func() { Scale(a, 1, b) }},
+		{"Axpy", func() { Axpy(1, a, b) }},
+		{"Dot", func() { Dot(a, b) }},
+		{"Max", func() { Max(nil) }},
+		{"ArgMin", func() { ArgMin(nil) }},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", tt.name)
+				}
+			}()
+			tt.f()
+		}()
+	}
+}
+
+// exactSum returns the correctly rounded sum of x.
+func exactSum(x []Float16) Float16 {
+	sum := new(big.Float).SetPrec(1000)
+	for _, v := range x {
+		sum.Add(sum, v.BigFloat())
+	}
+	f, _ := float16.FromBigFloat(sum)
+	return f
+}
+
+// exactDot returns the correctly rounded dot product of x and y.
+func exactDot(x, y []Float16) Float16 {
+	sum := new(big.Float).SetPrec(1000)
+	for i := range x {
+		p := new(big.Float).SetPrec(1000).Mul(x[i].BigFloat(), y[i].BigFloat())
+		sum.Add(sum, p)
+	}
+	f, _ := float16.FromBigFloat(sum)
+	return f
+}
+
+// withinULP reports whether got is want or one of its neighbors.
+func withinULP(got, want Float16) bool {
+	return got == want || got == want.Nextafter(float16.Inf(1)) || got == want.Nextafter(float16.Inf(-1))
+}
+
+func TestSum(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		x := finiteSlice(r, r.Intn(1000), 100)
+		want := exactSum(x)
+		for _, acc := range []Accumulator{Float64, Compensated} {
+			if got := SumWith(x, acc); got != want {
+				t.Errorf("%v: expected %v, got %v", acc, want, got)
+			}
+		}
+		if got := Sum(x); got != want {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+
+	// the rounding errors of float64 are compensated.
+	huge := float16.FromFloat64(0x1p15)
+	tiny := float16.FromBits(0x0001) // 2^-24
+	x := []Float16{huge, tiny, float16.FromFloat64(-0x1p15), tiny}
+	if got, want := SumWith(x, Compensated), float16.FromFloat64(0x1p-23); got != want {
+		t.Errorf("Compensated: expected %v, got %v", want, got)
+	}
+
+	// float32 loses the first tiny value.
+	if got, want := SumWith(x, Float32), tiny; got != want {
+		t.Errorf("Float32: expected %v, got %v", want, got)
+	}
+
+	tests := []struct {
+		x    []Float16
+		want Float16
+	}{
+		{nil, 0},
+		{[]Float16{float16.FromFloat64(65504), float16.FromFloat64(65504)}, float16.Inf(1)},
+		{[]Float16{float16.Inf(1), float16.Inf(-1)}, float16.NaN()},
+		{[]Float16{float16.FromFloat64(1), float16.NaN()}, float16.NaN()},
+	}
+	for _, tt := range tests {
+		for _, acc := range []Accumulator{Float64, Float32, Compensated} {
+			got := SumWith(tt.x, acc)
+			if got != tt.want && !(got.IsNaN() && tt.want.IsNaN()) {
+				t.Errorf("%v, %v: expected %v, got %v", tt.x, acc, tt.want, got)
+			}
+		}
+	}
+}
+
+func TestDot(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		n := r.Intn(1000)
+		x, y := finiteSlice(r, n, 10), finiteSlice(r, n, 10)
+		want := exactDot(x, y)
+		if got := DotWith(x, y, Compensated); got != want {
+			t.Errorf("Compensated: expected %v, got %v", want, got)
+		}
+
+		// Float64 rounds the partial sums, so the result may be off by 1 ulp.
+		if got := DotWith(x, y, Float64); !withinULP(got, want) {
+			t.Errorf("Float64: expected %v within 1 ulp, got %v", want, got)
+		}
+		if got := Dot(x, y); !withinULP(got, want) {
+			t.Errorf("expected %v within 1 ulp, got %v", want, got)
+		}
+	}
+
+	// the products of small integers and their partial sums are exact in float64,
+	// so Float64 gives the correctly rounded result.
+	for i := 0; i < 1000; i++ {
+		n := r.Intn(1000)
+		x, y := make([]Float16, n), make([]Float16, n)
+		for j := range x {
+			x[j] = float16.FromInt64(int64(r.Intn(129) - 64))
+			y[j] = float16.FromInt64(int64(r.Intn(129) - 64))
+		}
+		want := exactDot(x, y)
+		if got := DotWith(x, y, Float64); got != want {
+			t.Errorf("Float64: expected %v, got %v", want, got)
+		}
+	}
+
+	// the products are exact in float32, and they are not rounded to Float16.
+	x := []Float16{float16.FromFloat64(1.001), float16.FromFloat64(1.001)}
+	if got, want := DotWith(x, x, Float32), exactDot(x, x); got != want {
+		t.Errorf("Float32: expected %v, got %v", want, got)
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	one := float16.FromFloat64(1)
+	negZero := float16.FromBits(0x8000)
+	tests := []struct {
+		x              []Float16
+		argMax, argMin int
+	}{
+		{[]Float16{one}, 0, 0},
+		{[]Float16{one, float16.FromFloat64(-1), float16.FromFloat64(2), float16.FromFloat64(-1)}, 2, 1},
+		{[]Float16{0, negZero}, 0, 1},
+		{[]Float16{negZero, 0}, 1, 0},
+		{[]Float16{float16.Inf(-1), float16.Inf(1)}, 1, 0},
+		{[]Float16{one, float16.NaN(), float16.Inf(1), float16.NaN()}, 1, 1},
+	}
+	for _, tt := range tests {
+		if got := ArgMax(tt.x); got != tt.argMax {
+			t.Errorf("ArgMax(%v): expected %d, got %d", tt.x, tt.argMax, got)
+		}
+		if got := ArgMin(tt.x); got != tt.argMin {
+			t.Errorf("ArgMin(%v): expected %d, got %d", tt.x, tt.argMin, got)
+		}
+	}
+
+	// Max and Min agree with the reduction by Maximum and Minimum.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		x := randSlice(r, r.Intn(100)+1)
+		wantMax, wantMin := x[0], x[0]
+		for _, v := range x[1:] {
+			wantMax = wantMax.Maximum(v)
+			wantMin = wantMin.Minimum(v)
+		}
+		if got := Max(x); got != wantMax && !(got.IsNaN() && wantMax.IsNaN()) {
+			t.Errorf("Max(%v): expected %v, got %v", x, wantMax, got)
+		}
+		if got := Min(x); got != wantMin && !(got.IsNaN() && wantMin.IsNaN()) {
+			t.Errorf("Min(%v): expected %v, got %v", x, wantMin, got)
+		}
+	}
+}
+
+func TestAccumulator_String(t *testing.T) {
+	tests := []struct {
+		acc  Accumulator
+		want string
+	}{
+		{Float64, "Float64"},
+		{Float32, "Float32"},
+		{Compensated, "Compensated"},
+		{Accumulator(42), "Accumulator(42)"},
+	}
+	for _, tt := range tests {
+		if got := tt.acc.String(); got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func BenchmarkDot(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x, y := finiteSlice(r, 4096, 10), finiteSlice(r, 4096, 10)
+	for _, acc := range []Accumulator{Float64, Float32, Compensated} {
+		b.Run(acc.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				DotWith(x, y, acc)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package float16
+
+// Floor returns the greatest integer value less than or equal to x.
+//
+// Special cases are:
+//
+//	Floor(±0) = ±0
+//	Floor(±Inf) = ±Inf
+//	Floor(NaN) = NaN
+func (x Float16) Floor() Float16 {
+	if x&signMask16 != 0 {
+		return signMask16 | (x &^ signMask16).ceilAbs()
+	}
+	return x.Trunc()
+}
+
+// Ceil returns the least integer value greater than or equal to x.
+//
+// Special cases are:
+//
+//	Ceil(±0) = ±0
+//	Ceil(±Inf) = ±Inf
+//	Ceil(NaN) = NaN
+func (x Float16) Ceil() Float16 {
+	if x&signMask16 != 0 {
+		return x.Trunc()
+	}
+	return x.ceilAbs()
+}
+
+// ceilAbs returns the least integer value greater than or equal to x.
+// x must be non-negative.
+func (x Float16) ceilAbs() Float16 {
+	e := int((x>>shift16)&mask16) - bias16
+	switch {
+	case x == 0:
+		return x
+	case e < 0:
+		// 0 < x < 1
+		return uvone
+	case e < shift16:
+		if mask := Float16(fracMask16 >> e); x&mask != 0 {
+			// the carry may increase the exponent, and it is also valid.
+			x = (x | mask) + 1
+		}
+	}
+	return x
+}
+
+// Trunc returns the integer value of x.
+//
+// Special cases are:
+//
+//	Trunc(±0) = ±0
+//	Trunc(±Inf) = ±Inf
+//	Trunc(NaN) = NaN
+func (x Float16) Trunc() Float16 {
+	e := int((x>>shift16)&mask16) - bias16
+	switch {
+	case e < 0:
+		// |x| < 1
+		return x & signMask16
+	case e < shift16:
+		return x &^ Float16(fracMask16>>e)
+	}
+	return x
+}
+
+// Round returns the nearest integer, rounding half away from zero.
+//
+// Special cases are:
+//
+//	Round(±0) = ±0
+//	Round(±Inf) = ±Inf
+//	Round(NaN) = NaN
+func (x Float16) Round() Float16 {
+	e := int((x>>shift16)&mask16) - bias16
+	switch {
+	case e < -1:
+		// |x| < 0.5
+		return x & signMask16
+	case e == -1:
+		// 0.5 <= |x| < 1
+		return x&signMask16 | uvone
+	case e < shift16:
+		const half = 1 << (shift16 - 1)
+		x += half >> e
+		x &^= Float16(fracMask16 >> e)
+	}
+	return x
+}
+
+// RoundToEven returns the nearest integer, rounding ties to even.
+//
+// Special cases are:
+//
+//	RoundToEven(±0) = ±0
+//	RoundToEven(±Inf) = ±Inf
+//	RoundToEven(NaN) = NaN
+func (x Float16) RoundToEven() Float16 {
+	e := int((x>>shift16)&mask16) - bias16
+	switch {
+	case e < -1 || e == -1 && x&fracMask16 == 0:
+		// |x| <= 0.5
+		return x & signMask16
+	case e == -1:
+		// 0.5 < |x| < 1
+		return x&signMask16 | uvone
+	case e < shift16:
+		const halfMinusULP = (1 << (shift16 - 1)) - 1
+		x += (halfMinusULP + (x>>(shift16-e))&1) >> e
+		x &^= Float16(fracMask16 >> e)
+	}
+	return x
+}
+
+// Modf returns integer and fractional floating-point numbers
+// that sum to f. Both values have the same sign as f.
+//
+// Special cases are:
+//
+//	Modf(±Inf) = ±Inf, NaN
+//	Modf(NaN) = NaN, NaN
+func (f Float16) Modf() (int Float16, frac Float16) {
+	if f.IsInf(0) {
+		return f, uvnan
+	}
+	int = f.Trunc()
+	if f.IsNaN() {
+		return int, f
+	}
+	// the fractional part is exact, so the subtraction does the job.
+	frac = f.Sub(int)&^signMask16 | f&signMask16
+	return
+}
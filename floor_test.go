@@ -0,0 +1,55 @@
+package float16
+
+import (
+	"math"
+	"testing"
+)
+
+// testUnaryExact checks that f returns the same result as ref for all Float16 values.
+// ref must return results that are exactly representable in Float16.
+func testUnaryExact(t *testing.T, name string, f func(x Float16) Float16, ref func(x float64) float64) {
+	t.Helper()
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		got := f(x)
+		want := FromFloat64(ref(x.Float64()))
+		if got != want && !(got.IsNaN() && want.IsNaN()) {
+			t.Errorf("%s(%v (%04x)): expected %v (%04x), got %v (%04x)", name, x, uint16(x), want, uint16(want), got, uint16(got))
+		}
+	}
+}
+
+func TestFloor(t *testing.T) {
+	testUnaryExact(t, "Floor", Float16.Floor, math.Floor)
+}
+
+func TestCeil(t *testing.T) {
+	testUnaryExact(t, "Ceil", Float16.Ceil, math.Ceil)
+}
+
+func TestTrunc(t *testing.T) {
+	testUnaryExact(t, "Trunc", Float16.Trunc, math.Trunc)
+}
+
+func TestRound(t *testing.T) {
+	testUnaryExact(t, "Round", Float16.Round, math.Round)
+}
+
+func TestRoundToEven(t *testing.T) {
+	testUnaryExact(t, "RoundToEven", Float16.RoundToEven, math.RoundToEven)
+}
+
+func TestModf(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		gotInt, gotFrac := x.Modf()
+		i, f := math.Modf(x.Float64())
+		wantInt, wantFrac := FromFloat64(i), FromFloat64(f)
+		if gotInt != wantInt && !(gotInt.IsNaN() && wantInt.IsNaN()) {
+			t.Errorf("Modf(%v (%04x)): expected int %v (%04x), got %v (%04x)", x, uint16(x), wantInt, uint16(wantInt), gotInt, uint16(gotInt))
+		}
+		if gotFrac != wantFrac && !(gotFrac.IsNaN() && wantFrac.IsNaN()) {
+			t.Errorf("Modf(%v (%04x)): expected frac %v (%04x), got %v (%04x)", x, uint16(x), wantFrac, uint16(wantFrac), gotFrac, uint16(gotFrac))
+		}
+	}
+}
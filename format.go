@@ -3,82 +3,256 @@ package float16
 import "fmt"
 
 var _ fmt.Formatter = Float16(0)
+var _ fmt.Formatter = BFloat16(0)
+var _ fmt.Formatter = E4M3(0)
+var _ fmt.Formatter = E5M2(0)
 
 // Format implements [fmt.Formatter].
+// It formats x in the same way as the fmt package formats float32,
+// except that the shortest representation is the one of Float16.
 func (x Float16) Format(s fmt.State, verb rune) {
-	if x.IsNaN() {
-		s.Write([]byte("NaN"))
-		return
+	format(s, verb, x.formatNumber())
+}
+
+func (x Float16) formatNumber() number {
+	return number{
+		typ:       "float16.Float16",
+		nan:       x.IsNaN(),
+		inf:       x.IsInf(0),
+		neg:       x&signMask16 != 0,
+		appendAbs: (x &^ signMask16).Append,
 	}
+}
 
-	var prefix []byte
-	var data []byte
+// Format implements [fmt.Formatter].
+func (x BFloat16) Format(s fmt.State, verb rune) {
+	format(s, verb, number{
+		typ:       "float16.BFloat16",
+		nan:       x.IsNaN(),
+		inf:       x.IsInf(0),
+		neg:       x&signMaskBF16 != 0,
+		appendAbs: (x &^ signMaskBF16).Append,
+	})
+}
 
-	// sign
-	if x&signMask16 != 0 {
-		prefix = append(prefix, '-')
-		x &^= signMask16
-	} else {
-		if s.Flag('+') {
-			prefix = append(prefix, '+')
-		} else if s.Flag(' ') {
-			prefix = append(prefix, ' ')
-		}
-	}
+// Format implements [fmt.Formatter].
+func (x E4M3) Format(s fmt.State, verb rune) {
+	format(s, verb, number{
+		typ:       "float16.E4M3",
+		nan:       x.IsNaN(),
+		neg:       x&signE4M3 != 0,
+		appendAbs: (x &^ signE4M3).Append,
+	})
+}
+
+// Format implements [fmt.Formatter].
+func (x E5M2) Format(s fmt.State, verb rune) {
+	format(s, verb, number{
+		typ:       "float16.E5M2",
+		nan:       x.IsNaN(),
+		inf:       x.IsInf(0),
+		neg:       x&signE5M2 != 0,
+		appendAbs: (x &^ signE5M2).Append,
+	})
+}
+
+// number is a floating-point number to be formatted by [fmt.Formatter].
+type number struct {
+	// typ is the name of the type, which is reported for bad verbs.
+	typ string
+
+	nan, inf bool
 
+	// neg reports whether the number is negative.
+	neg bool
+
+	// appendAbs appends the absolute value of the number in the same way as Float16.Append.
+	appendAbs func(buf []byte, fmt byte, prec int) []byte
+}
+
+// format formats a floating-point number for [fmt.Formatter].
+func format(s fmt.State, verb rune, x number) {
+	// %+v and %#v are the syntax of struct fields and Go values,
+	// and they don't change how numbers are formatted.
+	plus := s.Flag('+') && verb != 'v'
+	sharp := s.Flag('#') && verb != 'v'
+	x.format(s, verb, plus, sharp)
+}
+
+// format formats x in the same way as (*fmt.pp).fmtFloat.
+func (x number) format(s fmt.State, verb rune, plus, sharp bool) {
+	prec, ok := s.Precision()
+	if !ok {
+		prec = -1
+	}
 	switch verb {
-	case 'b':
-		data = x.appendBin(data)
-	case 'f':
-		if prec, ok := s.Precision(); ok {
-			data = x.Append(data, byte(verb), prec)
-		} else {
-			data = x.Append(data, byte(verb), -1)
+	case 'v':
+		verb = 'g'
+	case 'b', 'g', 'G', 'x', 'X':
+	case 'f', 'e', 'E', 'F':
+		if !ok {
+			prec = 6
+		}
+		if verb == 'F' {
+			verb = 'f'
 		}
-	case 'e', 'E':
-		if prec, ok := s.Precision(); ok {
-			data = x.Append(data, byte(verb), prec)
-		} else {
-			data = x.Append(data, byte(verb), -1)
+	default:
+		// bad verb, e.g. %!s(float16.Float16=1)
+		s.Write([]byte("%!" + string(verb) + "(" + x.typ + "="))
+		x.format(s, 'v', plus, sharp)
+		s.Write([]byte{')'})
+		return
+	}
+
+	// Format number, reserving space for leading + sign if needed.
+	var buf [32]byte
+	num := buf[:1]
+	num[0] = '+'
+	if x.neg && !x.nan {
+		num[0] = '-'
+	}
+	switch {
+	case x.nan:
+		num = append(num, "NaN"...)
+	case x.inf:
+		num = append(num, "Inf"...)
+	default:
+		num = x.appendAbs(num, byte(verb), prec)
+	}
+
+	// The space flag means to add a leading space instead of a "+" sign
+	// unless the sign is explicitly asked for by the plus flag.
+	if s.Flag(' ') && num[0] == '+' && !plus {
+		num[0] = ' '
+	}
+
+	// Special handling for infinities and NaN,
+	// which don't look like a number so shouldn't be padded with zeros.
+	if x.nan || x.inf {
+		// Remove sign before NaN if not asked for.
+		if x.nan && !s.Flag(' ') && !plus {
+			num = num[1:]
 		}
-	case 'g', 'G':
-		if prec, ok := s.Precision(); ok {
-			data = x.Append(data, byte(verb), prec)
-		} else {
-			data = x.Append(data, byte(verb), -1)
+		pad(s, num, false)
+		return
+	}
+
+	// The sharp flag forces printing a decimal point for non-binary formats
+	// and retains trailing zeros, which we may need to restore.
+	if sharp && verb != 'b' {
+		num = append(num[:1], sharpen(num[1:], verb, prec)...)
+	}
+
+	zero := s.Flag('0') && !s.Flag('-')
+	w, ok := s.Width()
+
+	// We want a sign if asked for and if the sign is not positive.
+	if plus || num[0] != '+' {
+		// If we're zero padding to the left we want the sign before the leading zeros.
+		// Achieve this by writing the sign out and then padding the unsigned number.
+		if zero && ok && w > len(num) {
+			s.Write(num[:1])
+			writePadding(s, w-len(num), true)
+			s.Write(num[1:])
+			return
 		}
-	case 'x', 'X':
-		if prec, ok := s.Precision(); ok {
-			data = x.Append(data, byte(verb), prec)
-		} else {
-			data = x.Append(data, byte(verb), -1)
+		pad(s, num, zero)
+		return
+	}
+
+	// No sign to show and the number is positive; just print the unsigned number.
+	pad(s, num[1:], zero)
+}
+
+// pad writes b to s with the padding specified by the width and the minus flag.
+func pad(s fmt.State, b []byte, zero bool) {
+	w, ok := s.Width()
+	if !ok || w <= len(b) {
+		s.Write(b)
+		return
+	}
+	if s.Flag('-') {
+		// right padding
+		s.Write(b)
+		writePadding(s, w-len(b), false)
+	} else {
+		// left padding
+		writePadding(s, w-len(b), zero)
+		s.Write(b)
+	}
+}
+
+// writePadding writes n bytes of padding to s.
+func writePadding(s fmt.State, n int, zero bool) {
+	padByte := byte(' ')
+	if zero {
+		padByte = '0'
+	}
+	var buf [32]byte
+	for i := range buf {
+		buf[i] = padByte
+	}
+	for n > 0 {
+		m := min(n, len(buf))
+		s.Write(buf[:m])
+		n -= m
+	}
+}
+
+// sharpen applies the '#' flag to the formatted absolute value num in the same way as the fmt package.
+// It forces a decimal point, and retains the trailing zeros of %g.
+func sharpen(num []byte, verb rune, prec int) []byte {
+	digits := 0
+	switch verb {
+	case 'g', 'G', 'x':
+		digits = prec
+		// If no precision is set explicitly use a precision of 6.
+		if digits == -1 {
+			digits = 6
 		}
-	case 'v':
-		data = x.Append(data, 'g', -1)
 	}
 
-	if w, ok := s.Width(); ok {
-		var buf [1]byte
-		if s.Flag('-') {
-			s.Write(prefix)
-			s.Write(data)
-			buf[0] = ' '
-			for i := len(data); i < w; i++ {
-				s.Write(buf[:1])
+	// Buffer pre-allocated with enough room for
+	// exponent notations of the form "e+123" or "p-1023".
+	var tailBuf [6]byte
+	tail := tailBuf[:0]
+
+	hasDecimalPoint := false
+	sawNonzeroDigit := false
+	for i := 0; i < len(num); i++ {
+		switch num[i] {
+		case '.':
+			hasDecimalPoint = true
+		case 'p', 'P':
+			tail = append(tail, num[i:]...)
+			num = num[:i]
+		case 'e', 'E':
+			if verb != 'x' && verb != 'X' {
+				tail = append(tail, num[i:]...)
+				num = num[:i]
+				break
 			}
-		} else {
-			buf[0] = ' '
-			for i := len(data); i < w; i++ {
-				s.Write(buf[:1])
+			fallthrough
+		default:
+			if num[i] != '0' {
+				sawNonzeroDigit = true
+			}
+			// Count significant digits after the first non-zero digit.
+			if sawNonzeroDigit {
+				digits--
 			}
-			s.Write(prefix)
-			s.Write(data)
 		}
-		return
 	}
-
-	if len(prefix) > 0 {
-		s.Write(prefix)
+	if !hasDecimalPoint {
+		// Leading digit 0 should contribute once to digits.
+		if len(num) == 1 && num[0] == '0' {
+			digits--
+		}
+		num = append(num, '.')
+	}
+	for digits > 0 {
+		num = append(num, '0')
+		digits--
 	}
-	s.Write(data)
+	return append(num, tail...)
 }
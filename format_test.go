@@ -2,6 +2,8 @@ package float16
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -15,15 +17,19 @@ func TestFormat(t *testing.T) {
 		{"%b", FromFloat64(0), "0p-24"},
 
 		// verb "%f"
-		{"%f", FromFloat64(0.5), "0.5"},
-		{"%f", FromFloat64(-0.5), "-0.5"},
-		{"%+f", FromFloat64(0.5), "+0.5"},
-		{"%+f", FromFloat64(-0.5), "-0.5"},
-		{"% f", FromFloat64(0.5), " 0.5"},
-		{"% f", FromFloat64(-0.5), "-0.5"},
-		{"%8f", FromFloat64(0.5), "     0.5"},
-		{"%-8f", FromFloat64(0.5), "0.5     "},
+		{"%f", FromFloat64(0.5), "0.500000"},
+		{"%f", FromFloat64(-0.5), "-0.500000"},
+		{"%+f", FromFloat64(0.5), "+0.500000"},
+		{"%+f", FromFloat64(-0.5), "-0.500000"},
+		{"% f", FromFloat64(0.5), " 0.500000"},
+		{"% f", FromFloat64(-0.5), "-0.500000"},
+		{"%10f", FromFloat64(0.5), "  0.500000"},
+		{"%-10f", FromFloat64(0.5), "0.500000  "},
 		{"%.2f", FromFloat64(0.5), "0.50"},
+		{"%08.2f", FromFloat64(-0.5), "-0000.50"},
+		{"% 08.2f", FromFloat64(100), " 0100.00"},
+		{"%-08.2f|", FromFloat64(0.5), "0.50    |"},
+		{"%F", FromFloat64(0.5), "0.500000"},
 
 		// verb "%e"
 		{"%.6e", FromFloat64(0.5), "5.000000e-01"},
@@ -31,21 +37,44 @@ func TestFormat(t *testing.T) {
 		// verb "%g"
 		{"%g", FromFloat64(0.5), "0.5"},
 		{"%.1g", FromFloat64(0.25), "0.2"},
+		{"%#g", FromFloat64(0.5), "0.500000"},
+		{"%#.3g", FromFloat64(100), "100."},
+		{"%#.4g", FromFloat64(0x1p-24), "5.960e-08"},
+		{"%#.0e", FromFloat64(0.5), "5.e-01"},
+		{"%#.0f", FromFloat64(-0.5), "-0."},
 
 		// verb "%x"
 		{"%x", FromFloat64(0.5), "0x1p-01"},
-		{"%#x", FromFloat64(0.5), "0x1p-01"},
+		{"%#x", FromFloat64(0.5), "0x1.0000p-01"},
 		{"%.1x", FromFloat64(0.5), "0x1.0p-01"},
 
 		// verb "%X"
 		{"%X", FromFloat64(0.5), "0X1P-01"},
-		{"%#X", FromFloat64(0.5), "0X1P-01"},
+		{"%#X", FromFloat64(0.5), "0X1.P-01"},
 		{"%.1X", FromFloat64(0.5), "0X1.0P-01"},
 
 		// verb "%v"
 		{"%v", FromFloat64(0.5), "0.5"},
 		{"%v", uvnan, "NaN"},
 		{"%v", uvnan | signMask16, "NaN"},
+		{"%+v", FromFloat64(0.5), "0.5"},
+		{"%08v", FromFloat64(-0.5), "-00000.5"},
+		{"%.2v", FromFloat64(0.125), "0.12"},
+
+		// NaN and infinities
+		{"%+8f", uvnan, "    +NaN"},
+		{"% f", uvnan, " NaN"},
+		{"%08f", uvnan, "     NaN"},
+		{"%-6v|", uvnan, "NaN   |"},
+		{"%v", uvinf, "+Inf"},
+		{"%v", uvneginf, "-Inf"},
+		{"%08f", uvneginf, "    -Inf"},
+		{"%+e", uvinf, "+Inf"},
+
+		// bad verbs
+		{"%s", FromFloat64(0.5), "%!s(float16.Float16=0.5)"},
+		{"%q", uvneginf, "%!q(float16.Float16=-Inf)"},
+		{"%+d", FromFloat64(100), "%!d(float16.Float16=+100)"},
 	}
 
 	for _, tt := range tests {
@@ -55,3 +84,74 @@ func TestFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestFormat_Sharp(t *testing.T) {
+	formats := []string{
+		"%#.0g", "%#.1g", "%#.3g", "%#.5g", "%#.10G",
+		"%#.0e", "%#.3E",
+		"%#.0f", "%#.2f",
+		"%#x", "%#.0x", "%#.2x", "%#X", "%#.1X",
+	}
+	for i := 0; i < 0x10000; i++ {
+		x := FromBits(uint16(i))
+		if x.IsNaN() || x.IsInf(0) {
+			continue
+		}
+		for _, format := range formats {
+			got := fmt.Sprintf(format, x)
+			want := fmt.Sprintf(format, x.Float32())
+			if got != want {
+				t.Errorf("%s, %04x: expected %s, got %s", format, i, want, got)
+			}
+		}
+	}
+}
+
+func TestFormat_Float32(t *testing.T) {
+	// all combinations of the flags, widths, precisions and verbs.
+	var formats []string
+	for flags := 0; flags < 1<<5; flags++ {
+		var f strings.Builder
+		for i, c := range "+- #0" {
+			if flags&(1<<i) != 0 {
+				f.WriteRune(c)
+			}
+		}
+		for _, width := range []string{"", "1", "8", "14"} {
+			for _, prec := range []string{"", ".0", ".3", ".8"} {
+				for _, verb := range "veEfFgGxXsqd" {
+					formats = append(formats, "%"+f.String()+width+prec+string(verb))
+				}
+			}
+		}
+	}
+
+	step := 97
+	if testing.Short() {
+		step = 997
+	}
+	values := []Float16{0, signMask16, uvone, uvmax, 1, uvinf, uvneginf, uvnan, uvnan | signMask16}
+	for i := 0; i < 0x10000; i += step {
+		values = append(values, Float16(i))
+	}
+
+	for _, x := range values {
+		f := x.Float32()
+
+		// the shortest representations of Float16 are not always the ones of float32.
+		shortest := x.Text('g', -1) == strconv.FormatFloat(float64(f), 'g', -1, 32)
+
+		for _, format := range formats {
+			verb := format[len(format)-1]
+			if !shortest && !strings.Contains(format, ".") && strings.IndexByte("vgGsqd", verb) >= 0 {
+				continue
+			}
+			got := fmt.Sprintf(format, x)
+			want := fmt.Sprintf(format, f)
+			want = strings.Replace(want, "(float32=", "(float16.Float16=", 1)
+			if got != want {
+				t.Errorf("%q, %04x: expected %q, got %q", format, uint16(x), want, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package float16
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Frexp breaks f into a normalized fraction
+// and an integral power of two.
+// It returns frac and exp satisfying f == frac × 2**exp,
+// with the absolute value of frac in the interval [½, 1).
+//
+// Special cases are:
+//
+//	Frexp(±0) = ±0, 0
+//	Frexp(±Inf) = ±Inf, 0
+//	Frexp(NaN) = NaN, 0
+func (f Float16) Frexp() (frac Float16, exp int) {
+	if f&^signMask16 == 0 || f.IsInf(0) || f.IsNaN() {
+		return f, 0
+	}
+	sign, e, fr := f.split()
+	frac = Float16(sign) | (bias16-1)<<shift16 | Float16(fr&fracMask16)
+	return frac, int(e) + 1
+}
+
+// Ldexp is the inverse of Frexp.
+// It returns frac × 2**exp, rounding to nearest even.
+//
+// Special cases are:
+//
+//	Ldexp(±0, exp) = ±0
+//	Ldexp(±Inf, exp) = ±Inf
+//	Ldexp(NaN, exp) = NaN
+func (frac Float16) Ldexp(exp int) Float16 {
+	if frac&^signMask16 == 0 || frac.IsInf(0) || frac.IsNaN() {
+		return frac
+	}
+
+	// the exponents out of this range overflow or underflow anyway,
+	// and clamping them avoids the overflow of int.
+	const limit = 2 * (bias16 + shift16 + 1)
+	exp = max(-limit, min(limit, exp))
+
+	sign, e, fr := frac.split()
+	var c Context
+	return c.round(sign, int(e)+exp-shift16, uint64(fr), false)
+}
+
+// Ilogb returns the binary exponent of x as an integer.
+//
+// Special cases are:
+//
+//	Ilogb(±Inf) = MaxInt32
+//	Ilogb(0) = MinInt32
+//	Ilogb(NaN) = MaxInt32
+func (x Float16) Ilogb() int {
+	switch {
+	case x&^signMask16 == 0:
+		return math.MinInt32
+	case x.IsNaN() || x.IsInf(0):
+		return math.MaxInt32
+	}
+	_, exp, _ := x.split()
+	return int(exp)
+}
+
+// Logb returns the binary exponent of x.
+//
+// Special cases are:
+//
+//	Logb(±Inf) = +Inf
+//	Logb(0) = -Inf
+//	Logb(NaN) = NaN
+func (x Float16) Logb() Float16 {
+	switch {
+	case x&^signMask16 == 0:
+		return uvneginf
+	case x.IsInf(0):
+		return uvinf
+	case x.IsNaN():
+		return x
+	}
+
+	_, exp, _ := x.split()
+	if exp == 0 {
+		return 0
+	}
+
+	// convert exp to Float16.
+	// it is in [-24, 15], so the conversion is exact.
+	var sign Float16
+	if exp < 0 {
+		sign = signMask16
+		exp = -exp
+	}
+	l := bits.Len32(uint32(exp))
+	frac := Float16(exp<<(shift16-l+1)) & fracMask16
+	return sign | Float16(l-1+bias16)<<shift16 | frac
+}
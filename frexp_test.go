@@ -0,0 +1,60 @@
+package float16
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFrexp(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		gotFrac, gotExp := x.Frexp()
+		f, e := math.Frexp(x.Float64())
+		wantFrac := FromFloat64(f)
+		if (gotFrac != wantFrac && !(gotFrac.IsNaN() && wantFrac.IsNaN())) || gotExp != e {
+			t.Errorf("Frexp(%v (%04x)): expected %v, %d, got %v, %d", x, uint16(x), wantFrac, e, gotFrac, gotExp)
+		}
+	}
+}
+
+func TestLdexp(t *testing.T) {
+	exps := []int{math.MinInt32, -1000, 1000, math.MaxInt32}
+	for e := -45; e <= 45; e++ {
+		exps = append(exps, e)
+	}
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		for _, e := range exps {
+			got := x.Ldexp(e)
+
+			// math.Ldexp is exact in the range of Float16, and FromFloat64 rounds it once.
+			// It overflows the exponent on 32-bit platforms if |e| is close to MaxInt32,
+			// so clamp e to a range that gives the same results.
+			want := FromFloat64(math.Ldexp(x.Float64(), max(-1000, min(e, 1000))))
+			if got != want && !(got.IsNaN() && want.IsNaN()) {
+				t.Errorf("Ldexp(%v (%04x), %d): expected %v (%04x), got %v (%04x)", x, uint16(x), e, want, uint16(want), got, uint16(got))
+			}
+		}
+	}
+
+	// math.Ldexp overflows int in these cases.
+	if got := FromFloat64(1).Ldexp(math.MaxInt); got != uvinf {
+		t.Errorf("Ldexp(1, MaxInt): expected +Inf, got %v", got)
+	}
+	if got := FromFloat64(-1).Ldexp(math.MinInt); got != signMask16 {
+		t.Errorf("Ldexp(-1, MinInt): expected -0, got %v", got)
+	}
+}
+
+func TestIlogb(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		if got, want := x.Ilogb(), math.Ilogb(x.Float64()); got != want {
+			t.Errorf("Ilogb(%v (%04x)): expected %d, got %d", x, uint16(x), want, got)
+		}
+	}
+}
+
+func TestLogb(t *testing.T) {
+	testUnaryExact(t, "Logb", Float16.Logb, math.Logb)
+}
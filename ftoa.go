@@ -4,7 +4,6 @@ package float16
 
 import (
 	"math/bits"
-	"strconv"
 
 	"github.com/shogo82148/int128"
 )
@@ -17,6 +16,21 @@ func (x Float16) Text(fmt byte, prec int) string {
 	return string(x.Append(make([]byte, 0, 8), fmt, prec))
 }
 
+// FormatFloat converts the floating-point number x to a string,
+// according to the format fmt and precision prec.
+// The formats and precisions are the same as [strconv.FormatFloat],
+// except that 'b' formats the 11-bit significand of x,
+// and the precision -1 uses the smallest number of digits necessary to represent x uniquely as a Float16.
+func FormatFloat(x Float16, fmt byte, prec int) string {
+	return x.Text(fmt, prec)
+}
+
+// AppendFloat appends the string form of the floating-point number x,
+// as generated by [FormatFloat], to dst and returns the extended buffer.
+func AppendFloat(dst []byte, x Float16, fmt byte, prec int) []byte {
+	return x.Append(dst, fmt, prec)
+}
+
 func (x Float16) Append(buf []byte, fmt byte, prec int) []byte {
 	switch {
 	case x.IsNaN():
@@ -38,8 +52,7 @@ func (x Float16) Append(buf []byte, fmt byte, prec int) []byte {
 		return x.appendSci(buf, fmt, prec)
 	case 'g', 'G':
 		if prec >= 0 {
-			// In this case, bitSize is ignored anyway so it's ok to pass.
-			return strconv.AppendFloat(buf, x.Float64(), fmt, prec, 64)
+			return x.appendG(buf, fmt, prec)
 		}
 
 		if x&signMask16 != 0 {
@@ -55,7 +68,8 @@ func (x Float16) Append(buf []byte, fmt byte, prec int) []byte {
 		return x.appendDec(buf, fmt, prec)
 	}
 
-	return x.appendDec(buf, fmt, prec)
+	// unknown format
+	return append(buf, '%', fmt)
 }
 
 func (x Float16) appendBin(buf []byte) []byte {
@@ -110,6 +124,7 @@ func (x Float16) appendDec(buf []byte, fmt byte, prec int) []byte {
 	// sign
 	if x&signMask16 != 0 {
 		buf = append(buf, '-')
+		x &^= signMask16
 	}
 
 	if prec >= 0 {
@@ -268,91 +283,9 @@ func (x Float16) appendSci(buf []byte, fmt byte, prec int) []byte {
 	}
 
 	if prec >= 0 {
-		const five24 = 59604644775390625 // = 5^24
-
-		var dec24 int128.Uint128
-		fix := x.fix24()
-		dec24.H, dec24.L = bits.Mul64(uint64(fix), five24)
-
-		if fix == 0 {
-			buf = append(buf, '0')
-			if prec > 0 {
-				buf = append(buf, '.')
-				for i := 0; i < prec; i++ {
-					buf = append(buf, '0')
-				}
-			}
-			buf = append(buf, fmt, '+', '0', '0')
-			return buf
-		}
-
-		// find the first non-zero digit
-		tmp := dec24
-		var n int
-		for ; tmp.H != 0 || tmp.L != 0; n++ {
-			tmp = tmp.Div(ten)
-		}
-		n--
-
-		// round to nearest even
-		if prec < n {
-			m := int128.Uint128{L: 1}
-			for i := 0; i < n-prec; i++ {
-				m = m.Mul(ten)
-			}
-			m2 := m.Rsh(1)
-			div, mod := dec24.DivMod(m)
-			dec24 = dec24.Sub(mod)
-			if mod.Cmp(m2) > 0 {
-				// round up
-				dec24 = dec24.Add(m)
-			} else if mod.Cmp(m2) == 0 {
-				// round to even
-				if div.L&1 != 0 {
-					dec24 = dec24.Add(m)
-				}
-			}
-		}
-
-		// convert to decimal
-		var data [30]byte
-		for i := 0; i < 30; i++ {
-			var mod int128.Uint128
-			dec24, mod = dec24.DivMod(ten)
-			data[i] = byte(mod.L)
-		}
-
-		// find the first non-zero digit
-		i := len(data) - 1
-		for ; i >= 0; i-- {
-			if data[i] != 0 {
-				break
-			}
-		}
-		buf = append(buf, data[i]+'0')
-		i--
-
-		if prec != 0 {
-			buf = append(buf, '.')
-			var j int
-			for ; i >= 0 && j < prec; i, j = i-1, j+1 {
-				buf = append(buf, data[i]+'0')
-			}
-			for ; j < prec; j++ {
-				buf = append(buf, '0')
-			}
-		}
-
-		buf = append(buf, fmt)
-		n -= 24
-		if n >= 0 {
-			buf = append(buf, '+')
-		} else {
-			buf = append(buf, '-')
-			n = -n
-		}
-		buf = append(buf, byte((n/10)%10)+'0', byte(n%10)+'0')
-		return buf
+		var d [32]byte
+		digits, dp := x.roundDigits(&d, prec+1)
+		return fmtEFG(buf, false, digits, dp, prec, fmt, false)
 	}
 
 	// find the intermediate value between two adjacent floating-point numbers.
@@ -433,6 +366,76 @@ func (x Float16) appendSci(buf []byte, fmt byte, prec int) []byte {
 	return buf
 }
 
+// appendG formats x in the 'g' or 'G' format with the precision prec >= 0.
+// It follows the rules of strconv.AppendFloat:
+// %e is used if the exponent is less than -4 or greater than or equal to the precision,
+// and the trailing zeros are removed.
+func (x Float16) appendG(buf []byte, fmt byte, prec int) []byte {
+	if prec == 0 {
+		prec = 1
+	}
+	var d [32]byte
+	digits, dp := (x &^ signMask16).roundDigits(&d, prec)
+	return fmtEFG(buf, x&signMask16 != 0, digits, dp, prec, fmt, false)
+}
+
+// roundDigits rounds x, which must not be negative, to nd > 0 significant decimal digits,
+// rounding ties to even.
+// It returns the digits in d without the trailing zeros, and the position of the decimal point dp,
+// i.e. x is rounded to 0.digits * 10^dp.
+// If x is zero, digits is empty.
+func (x Float16) roundDigits(d *[32]byte, nd int) (digits []byte, dp int) {
+	const five24 = 59604644775390625 // = 5^24
+	ten := int128.Uint128{L: 10}
+
+	// dec24 is x * 10^24, which is an integer.
+	var dec24 int128.Uint128
+	fix := x.fix24()
+	if fix == 0 {
+		return d[:0], 0
+	}
+	dec24.H, dec24.L = bits.Mul64(uint64(fix), five24)
+
+	// the number of the digits of dec24
+	n := 0
+	for tmp := dec24; tmp.H != 0 || tmp.L != 0; n++ {
+		tmp = tmp.Div(ten)
+	}
+
+	// round to nearest even
+	if nd < n {
+		dec24 = roundUint128(dec24, n-nd).Div(pow10Uint128(n - nd))
+		if dec24.Cmp(pow10Uint128(nd)) >= 0 {
+			// rounding up carries into a new digit, e.g. 9.99 to 10.0.
+			dec24 = dec24.Div(ten)
+			n++
+		}
+	} else {
+		nd = n
+	}
+
+	// convert to decimal
+	for i := nd - 1; i >= 0; i-- {
+		var mod int128.Uint128
+		dec24, mod = dec24.DivMod(ten)
+		d[i] = byte(mod.L) + '0'
+	}
+	for nd > 0 && d[nd-1] == '0' {
+		nd--
+	}
+	return d[:nd], n - 24
+}
+
+// pow10Uint128 returns 10^n.
+func pow10Uint128(n int) int128.Uint128 {
+	ten := int128.Uint128{L: 10}
+	y := int128.Uint128{L: 1}
+	for i := 0; i < n; i++ {
+		y = y.Mul(ten)
+	}
+	return y
+}
+
 func roundUint128(x int128.Uint128, n int) int128.Uint128 {
 	ten := int128.Uint128{L: 10}
 	y := int128.Uint128{L: 1}
@@ -462,6 +465,11 @@ func roundUint128(x int128.Uint128, n int) int128.Uint128 {
 }
 
 func (x Float16) appendHex(buf []byte, fmt byte, prec int) []byte {
+	if prec < 0 {
+		// any negative precision means the shortest representation, as strconv does.
+		prec = -1
+	}
+
 	// normalize x
 	sign, exp, frac := x.split()
 	if sign != 0 {
@@ -535,9 +543,6 @@ func (x Float16) appendHex(buf []byte, fmt byte, prec int) []byte {
 		buf = append(buf, nibble(fmt, frac>>2))
 
 	default:
-		if prec < 0 {
-			panic("invalid precision")
-		}
 		buf = append(buf, '1', '.')
 		buf = append(buf, nibble(fmt, frac>>6))
 		buf = append(buf, nibble(fmt, frac>>2))
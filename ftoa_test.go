@@ -1,6 +1,9 @@
 package float16
 
 import (
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -389,3 +392,169 @@ func TestText_RoundTrip(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatFloat_Strconv(t *testing.T) {
+	precs := []int{0, 1, 2, 3, 4, 5, 6, 8, 10, 16, 24, 30}
+	step := 1
+	if testing.Short() {
+		step = 7
+	}
+	for i := 0; i < 0x10000; i += step {
+		x := FromBits(uint16(i))
+		f := float64(x.Float32())
+		for _, fmt := range []byte{'e', 'E', 'f', 'g', 'G', 'x', 'X'} {
+			for _, prec := range precs {
+				got := FormatFloat(x, fmt, prec)
+				want := strconv.FormatFloat(f, fmt, prec, 32)
+				if got != want {
+					t.Errorf("FormatFloat(%04x, %c, %d): expected %s, got %s", i, fmt, prec, want, got)
+				}
+			}
+		}
+
+		// every Float16 has the same shortest hexadecimal representation as float32.
+		for _, fmt := range []byte{'x', 'X'} {
+			got := FormatFloat(x, fmt, -1)
+			want := strconv.FormatFloat(f, fmt, -1, 32)
+			if got != want {
+				t.Errorf("FormatFloat(%04x, %c, -1): expected %s, got %s", i, fmt, want, got)
+			}
+		}
+	}
+}
+
+func TestFormatFloat_Shortest(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := FromBits(uint16(i))
+		for _, fmt := range []byte{'e', 'E', 'f', 'g', 'G'} {
+			got := FormatFloat(x, fmt, -1)
+
+			// the shortest digits of Float16 are not always the ones of float32,
+			// but they have the same format with the same number of digits.
+			y, err := Parse(got)
+			if err != nil {
+				t.Errorf("FormatFloat(%04x, %c, -1) = %s: %v", i, fmt, got, err)
+				continue
+			}
+			if y != x && !(x.IsNaN() && y.IsNaN()) {
+				t.Errorf("FormatFloat(%04x, %c, -1) = %s: parsed as %04x", i, fmt, got, y)
+			}
+			f, err := strconv.ParseFloat(got, 64)
+			if err != nil {
+				t.Errorf("FormatFloat(%04x, %c, -1) = %s: %v", i, fmt, got, err)
+				continue
+			}
+			if want := strconv.FormatFloat(f, fmt, -1, 64); got != want && !x.IsNaN() {
+				t.Errorf("FormatFloat(%04x, %c, -1): expected %s, got %s", i, fmt, want, got)
+			}
+		}
+	}
+}
+
+func TestFormatFloat_Invalid(t *testing.T) {
+	for _, fmt := range []byte{'a', 'F', 'v', 0} {
+		got := FormatFloat(FromFloat64(1.5), fmt, -1)
+		want := strconv.FormatFloat(1.5, fmt, -1, 32)
+		if got != want {
+			t.Errorf("%q: expected %q, got %q", fmt, want, got)
+		}
+	}
+}
+
+func TestAppendFloat(t *testing.T) {
+	buf := []byte("x = ")
+	buf = AppendFloat(buf, FromFloat64(-1.5), 'e', 3)
+	if got, want := string(buf), "x = -1.500e+00"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestText_G(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := FromBits(uint16(i))
+		f := float64(x.Float32())
+		for _, fmt := range []byte{'g', 'G'} {
+			for prec := 0; prec <= 30; prec++ {
+				got := x.Text(fmt, prec)
+				want := strconv.FormatFloat(f, fmt, prec, 32)
+				if got != want {
+					t.Errorf("Text(%04x, %c, %d): expected %s, got %s", i, fmt, prec, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestAppend_Allocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	x := FromFloat64(-0.1)
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, fmt := range []byte{'e', 'f', 'g', 'x'} {
+			for _, prec := range []int{-1, 0, 3, 10} {
+				buf = x.Append(buf[:0], fmt, prec)
+			}
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocation, got %v", allocs)
+	}
+}
+
+// FuzzAppend checks that Parse(Text(x, fmt, -1)) == x for the shortest decimal formats,
+// that the other formats agree with strconv.FormatFloat of float32,
+// and that Append, AppendFloat and Text agree with each other.
+func FuzzAppend(f *testing.F) {
+	f.Add(uint16(0x3c00), byte('g'), -1, "")
+	f.Add(uint16(0x0001), byte('e'), 30, "x = ")
+	f.Add(uint16(0x7bff), byte('f'), 0, "")
+	f.Add(uint16(0xfc00), byte('x'), 3, "")
+	f.Add(uint16(0x7e00), byte('G'), -1, "")
+
+	f.Fuzz(func(t *testing.T, bits uint16, fmt byte, prec int, prefix string) {
+		if prec > 1000 {
+			// limit the size of the output.
+			return
+		}
+		x := FromBits(bits)
+		s := x.Text(fmt, prec)
+		if got := string(x.Append([]byte(prefix), fmt, prec)); got != prefix+s {
+			t.Errorf("Append(%q, %04x, %c, %d): expected %q, got %q", prefix, bits, fmt, prec, prefix+s, got)
+		}
+		if got := string(AppendFloat([]byte(prefix), x, fmt, prec)); got != prefix+s {
+			t.Errorf("AppendFloat(%q, %04x, %c, %d): expected %q, got %q", prefix, bits, fmt, prec, prefix+s, got)
+		}
+		if got := FormatFloat(x, fmt, prec); got != s {
+			t.Errorf("FormatFloat(%04x, %c, %d): expected %q, got %q", bits, fmt, prec, s, got)
+		}
+
+		switch fmt {
+		case 'e', 'E', 'f', 'g', 'G':
+			if prec < 0 {
+				// the shortest decimal digits of Float16 are not always the ones of float32.
+				y, err := Parse(s)
+				if err != nil {
+					t.Fatalf("Text(%04x, %c, %d) = %q: %v", bits, fmt, prec, s, err)
+				}
+				if y != x && !(x.IsNaN() && y.IsNaN()) {
+					t.Errorf("Text(%04x, %c, %d) = %q: parsed as %04x", bits, fmt, prec, s, y)
+				}
+				return
+			}
+		case 'b':
+			// the mantissa and the exponent are the ones of Float16, not of float32.
+			if !x.IsNaN() && !x.IsInf(0) {
+				mant, exp, ok := strings.Cut(s, "p")
+				m, err1 := strconv.ParseInt(mant, 10, 64)
+				e, err2 := strconv.Atoi(exp)
+				if !ok || err1 != nil || err2 != nil || math.Ldexp(float64(m), e) != x.Float64() {
+					t.Errorf("Text(%04x, %c, %d): unexpected %q", bits, fmt, prec, s)
+				}
+				return
+			}
+		}
+		want := strconv.FormatFloat(float64(x.Float32()), fmt, prec, 32)
+		if s != want {
+			t.Errorf("Text(%04x, %c, %d): expected %q, got %q", bits, fmt, prec, want, s)
+		}
+	})
+}
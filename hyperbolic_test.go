@@ -0,0 +1,109 @@
+package float16
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSinh(t *testing.T) {
+	testSpecialCases(t, Float16.Sinh, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), math.Inf(-1)},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Sinh, func(x *big.Float) *big.Float {
+		e := bigExp(x)
+		return newBigFloat().SetMantExp(bigSub(e, bigQuo(bigInt(1), e)), -1)
+	})
+}
+
+func TestCosh(t *testing.T) {
+	testSpecialCases(t, Float16.Cosh, []specialCase{
+		{0, 1},
+		{negZero, 1},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), math.Inf(1)},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Cosh, func(x *big.Float) *big.Float {
+		e := bigExp(x)
+		return newBigFloat().SetMantExp(bigAdd(e, bigQuo(bigInt(1), e)), -1)
+	})
+}
+
+func TestTanh(t *testing.T) {
+	testSpecialCases(t, Float16.Tanh, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), 1},
+		{math.Inf(-1), -1},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Tanh, func(x *big.Float) *big.Float {
+		// tanh(x) = (e**2x - 1) / (e**2x + 1)
+		e := bigExp(newBigFloat().SetMantExp(x, 1))
+		return bigQuo(bigSub(e, bigInt(1)), bigAdd(e, bigInt(1)))
+	})
+}
+
+func TestAsinh(t *testing.T) {
+	testSpecialCases(t, Float16.Asinh, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), math.Inf(-1)},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Asinh, func(x *big.Float) *big.Float {
+		// asinh(x) = sign(x) log(|x| + sqrt(x**2 + 1))
+		ax := newBigFloat().Abs(x)
+		r := bigLog(bigAdd(ax, bigSqrt(bigAdd(bigMul(x, x), bigInt(1)))))
+		if x.Signbit() {
+			r.Neg(r)
+		}
+		return r
+	})
+}
+
+func TestAcosh(t *testing.T) {
+	testSpecialCases(t, Float16.Acosh, []specialCase{
+		{1, 0},
+		{math.Inf(1), math.Inf(1)},
+		{0.5, math.NaN()},
+		{math.Inf(-1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Acosh, func(x *big.Float) *big.Float {
+		if x.Cmp(bigInt(1)) < 0 {
+			return nil
+		}
+		// acosh(x) = log(x + sqrt(x**2 - 1))
+		return bigLog(bigAdd(x, bigSqrt(bigSub(bigMul(x, x), bigInt(1)))))
+	})
+}
+
+func TestAtanh(t *testing.T) {
+	testSpecialCases(t, Float16.Atanh, []specialCase{
+		{1, math.Inf(1)},
+		{0, 0},
+		{negZero, negZero},
+		{-1, math.Inf(-1)},
+		{2, math.NaN()},
+		{-2, math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Atanh, func(x *big.Float) *big.Float {
+		switch newBigFloat().Abs(x).Cmp(bigInt(1)) {
+		case 1:
+			return nil
+		case 0:
+			return newBigFloat().SetInf(x.Signbit())
+		}
+		// atanh(x) = log((1 + x) / (1 - x)) / 2
+		r := bigLog(bigQuo(bigAdd(bigInt(1), x), bigSub(bigInt(1), x)))
+		return r.SetMantExp(r, -1)
+	})
+}
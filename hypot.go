@@ -0,0 +1,32 @@
+package float16
+
+import "math"
+
+// Hypot returns Sqrt(p*p + q*q), taking care to avoid
+// unnecessary overflow and underflow.
+// The result is correctly rounded.
+//
+// Special cases are:
+//
+//	Hypot(±Inf, q) = +Inf
+//	Hypot(p, ±Inf) = +Inf
+//	Hypot(NaN, q) = NaN
+//	Hypot(p, NaN) = NaN
+func (p Float16) Hypot(q Float16) Float16 {
+	// special cases
+	switch {
+	case p.IsInf(0) || q.IsInf(0):
+		return uvinf
+	case p.IsNaN() || q.IsNaN():
+		return uvnan
+	}
+
+	// The squares of Float16 have at most 22 bits and they never overflow in float64.
+	// If the exponents of them differ by at most 31, the sum is exact.
+	// Otherwise, the smaller one is too small to affect the rounding.
+	// In both cases, rounding the square root twice is safe
+	// because float64 has more than twice as many bits as Float16.
+	fp := p.Float64()
+	fq := q.Float64()
+	return FromFloat64(math.Sqrt(fp*fp + fq*fq))
+}
@@ -0,0 +1,49 @@
+package float16
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHypot(t *testing.T) {
+	inf := math.Inf(1)
+	nan := math.NaN()
+	tests := []struct {
+		p, q, want float64
+	}{
+		{inf, 1, inf},
+		{-inf, nan, inf},
+		{1, inf, inf},
+		{nan, -inf, inf},
+		{nan, 1, nan},
+		{1, nan, nan},
+		{3, 4, 5},
+		{-3, 4, 5},
+		{negZero, 0, 0},
+		{65504, 65504, inf},
+		{0x1p-24, 0x1p-24, 0x1p-24},
+	}
+	for _, tt := range tests {
+		got := FromFloat64(tt.p).Hypot(FromFloat64(tt.q))
+		want := FromFloat64(tt.want)
+		if got != want && !(got.IsNaN() && want.IsNaN()) {
+			t.Errorf("Hypot(%v, %v): expected %v, got %v", tt.p, tt.q, want, got)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		p := Float16(r.Intn(0x7c00)) | Float16(r.Intn(2))<<15
+		q := Float16(r.Intn(0x7c00)) | Float16(r.Intn(2))<<15
+		if i%2 == 0 {
+			// make the exponents close.
+			q = p&0xfc00 | Float16(r.Intn(0x400))
+		}
+		bp, bq := p.big(ToNearestEven), q.big(ToNearestEven)
+		want := roundBig(bigSqrt(bigAdd(bigMul(bp, bp), bigMul(bq, bq))), ToNearestEven)
+		if got := p.Hypot(q); got != want {
+			t.Errorf("Hypot(%v, %v): expected %v, got %v", p, q, want, got)
+		}
+	}
+}
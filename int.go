@@ -0,0 +1,330 @@
+package float16
+
+import "math"
+
+// FromInt64 returns the Float16 nearest to i, rounding ties to even.
+// The values out of the range of Float16 are converted to infinities.
+func FromInt64(i int64) Float16 {
+	var c Context
+	return c.FromInt64(i)
+}
+
+// FromInt32 returns the Float16 nearest to i, rounding ties to even.
+// The values out of the range of Float16 are converted to infinities.
+func FromInt32(i int32) Float16 {
+	return FromInt64(int64(i))
+}
+
+// FromInt returns the Float16 nearest to i, rounding ties to even.
+// The values out of the range of Float16 are converted to infinities.
+func FromInt(i int) Float16 {
+	return FromInt64(int64(i))
+}
+
+// FromUint64 returns the Float16 nearest to u, rounding ties to even.
+// The values out of the range of Float16 are converted to infinity.
+func FromUint64(u uint64) Float16 {
+	var c Context
+	return c.FromUint64(u)
+}
+
+// FromUint32 returns the Float16 nearest to u, rounding ties to even.
+// The values out of the range of Float16 are converted to infinity.
+func FromUint32(u uint32) Float16 {
+	return FromUint64(uint64(u))
+}
+
+// FromUint returns the Float16 nearest to u, rounding ties to even.
+// The values out of the range of Float16 are converted to infinity.
+func FromUint(u uint) Float16 {
+	return FromUint64(uint64(u))
+}
+
+// FromInt64 returns the Float16 nearest to i in the direction of c.Mode.
+func (c *Context) FromInt64(i int64) Float16 {
+	if i < 0 {
+		// uint64(-i) is also correct for math.MinInt64.
+		return c.round(signMask16, 0, uint64(-i), false)
+	}
+	return c.round(0, 0, uint64(i), false)
+}
+
+// FromUint64 returns the Float16 nearest to u in the direction of c.Mode.
+func (c *Context) FromUint64(u uint64) Float16 {
+	return c.round(0, 0, u, false)
+}
+
+// Int64 returns the result of converting x to int64, rounding in the direction of c.Mode.
+// If x is NaN or the rounded value is out of the range of int64,
+// Int64 raises Invalid and returns the nearest value in the range, or math.MaxInt64 for NaN.
+// Otherwise, it raises Inexact if the rounding changes the value.
+func (c *Context) Int64(x Float16) int64 {
+	return c.toInt(x, math.MinInt64, math.MaxInt64)
+}
+
+// Int32 is like [Context.Int64], but it converts x to int32.
+func (c *Context) Int32(x Float16) int32 {
+	return int32(c.toInt(x, math.MinInt32, math.MaxInt32))
+}
+
+// Int16 is like [Context.Int64], but it converts x to int16.
+func (c *Context) Int16(x Float16) int16 {
+	return int16(c.toInt(x, math.MinInt16, math.MaxInt16))
+}
+
+// Int8 is like [Context.Int64], but it converts x to int8.
+func (c *Context) Int8(x Float16) int8 {
+	return int8(c.toInt(x, math.MinInt8, math.MaxInt8))
+}
+
+// Int is like [Context.Int64], but it converts x to int.
+func (c *Context) Int(x Float16) int {
+	return int(c.toInt(x, math.MinInt, math.MaxInt))
+}
+
+// Uint64 returns the result of converting x to uint64, rounding in the direction of c.Mode.
+// If x is NaN or the rounded value is out of the range of uint64,
+// Uint64 raises Invalid and returns the nearest value in the range, or math.MaxUint64 for NaN.
+// Otherwise, it raises Inexact if the rounding changes the value.
+func (c *Context) Uint64(x Float16) uint64 {
+	return c.toUint(x, math.MaxUint64)
+}
+
+// Uint32 is like [Context.Uint64], but it converts x to uint32.
+func (c *Context) Uint32(x Float16) uint32 {
+	return uint32(c.toUint(x, math.MaxUint32))
+}
+
+// Uint16 is like [Context.Uint64], but it converts x to uint16.
+func (c *Context) Uint16(x Float16) uint16 {
+	return uint16(c.toUint(x, math.MaxUint16))
+}
+
+// Uint8 is like [Context.Uint64], but it converts x to uint8.
+func (c *Context) Uint8(x Float16) uint8 {
+	return uint8(c.toUint(x, math.MaxUint8))
+}
+
+// Uint is like [Context.Uint64], but it converts x to uint.
+func (c *Context) Uint(x Float16) uint {
+	return uint(c.toUint(x, math.MaxUint))
+}
+
+// toInt converts x to an integer in [lo, hi], rounding in the direction of c.Mode.
+// NaN is converted to hi, as SoftFloat does.
+func (c *Context) toInt(x Float16, lo, hi int64) int64 {
+	switch {
+	case x.IsNaN(), x.IsInf(1):
+		c.Flags |= Invalid
+		return hi
+	case x.IsInf(-1):
+		c.Flags |= Invalid
+		return lo
+	}
+
+	// Inexact must not be raised with Invalid.
+	d := Context{Mode: c.Mode}
+	neg, abs := d.roundInt(x)
+	if neg {
+		// uint64(-lo) is also correct for math.MinInt64.
+		if abs > uint64(-lo) {
+			c.Flags |= Invalid
+			return lo
+		}
+		c.Flags |= d.Flags
+		return -int64(abs)
+	}
+	if abs > uint64(hi) {
+		c.Flags |= Invalid
+		return hi
+	}
+	c.Flags |= d.Flags
+	return int64(abs)
+}
+
+// toUint converts x to an integer in [0, hi], rounding in the direction of c.Mode.
+// NaN is converted to hi, as SoftFloat does.
+func (c *Context) toUint(x Float16, hi uint64) uint64 {
+	switch {
+	case x.IsNaN(), x.IsInf(1):
+		c.Flags |= Invalid
+		return hi
+	case x.IsInf(-1):
+		c.Flags |= Invalid
+		return 0
+	}
+
+	// Inexact must not be raised with Invalid.
+	d := Context{Mode: c.Mode}
+	neg, abs := d.roundInt(x)
+	if neg && abs != 0 {
+		c.Flags |= Invalid
+		return 0
+	}
+	if abs > hi {
+		c.Flags |= Invalid
+		return hi
+	}
+	c.Flags |= d.Flags
+	return abs
+}
+
+// roundInt rounds the finite number x to an integer in the direction of c.Mode,
+// and returns its sign and magnitude.
+func (c *Context) roundInt(x Float16) (neg bool, abs uint64) {
+	neg = x&signMask16 != 0
+	if x&^signMask16 == 0 {
+		return neg, 0
+	}
+
+	// x = frac × 2^(exp-shift16)
+	_, exp, frac := x.split()
+	q, guard, rest := truncate(uint64(frac), false, shift16-int(exp))
+	if guard || rest {
+		c.Flags |= Inexact
+	}
+	if c.Mode.roundUp(neg, q, guard, rest) {
+		q++
+	}
+	return neg, q
+}
+
+// Int64 returns the result of converting x to int64, truncating toward zero,
+// and reports whether the result is exactly x.
+// If x is not an integer or is out of the range of int64,
+// Int64 returns the same value as [Float16.SaturatingInt64] and false.
+func (x Float16) Int64() (int64, bool) {
+	c := Context{Mode: ToZero}
+	i := c.Int64(x)
+	return i, c.Flags == 0
+}
+
+// Int32 is like [Float16.Int64], but it converts x to int32.
+func (x Float16) Int32() (int32, bool) {
+	c := Context{Mode: ToZero}
+	i := c.Int32(x)
+	return i, c.Flags == 0
+}
+
+// Int16 is like [Float16.Int64], but it converts x to int16.
+func (x Float16) Int16() (int16, bool) {
+	c := Context{Mode: ToZero}
+	i := c.Int16(x)
+	return i, c.Flags == 0
+}
+
+// Int8 is like [Float16.Int64], but it converts x to int8.
+func (x Float16) Int8() (int8, bool) {
+	c := Context{Mode: ToZero}
+	i := c.Int8(x)
+	return i, c.Flags == 0
+}
+
+// Int is like [Float16.Int64], but it converts x to int.
+func (x Float16) Int() (int, bool) {
+	c := Context{Mode: ToZero}
+	i := c.Int(x)
+	return i, c.Flags == 0
+}
+
+// Uint64 returns the result of converting x to uint64, truncating toward zero,
+// and reports whether the result is exactly x.
+// If x is not an integer or is out of the range of uint64,
+// Uint64 returns the same value as [Float16.SaturatingUint64] and false.
+func (x Float16) Uint64() (uint64, bool) {
+	c := Context{Mode: ToZero}
+	u := c.Uint64(x)
+	return u, c.Flags == 0
+}
+
+// Uint32 is like [Float16.Uint64], but it converts x to uint32.
+func (x Float16) Uint32() (uint32, bool) {
+	c := Context{Mode: ToZero}
+	u := c.Uint32(x)
+	return u, c.Flags == 0
+}
+
+// Uint16 is like [Float16.Uint64], but it converts x to uint16.
+func (x Float16) Uint16() (uint16, bool) {
+	c := Context{Mode: ToZero}
+	u := c.Uint16(x)
+	return u, c.Flags == 0
+}
+
+// Uint8 is like [Float16.Uint64], but it converts x to uint8.
+func (x Float16) Uint8() (uint8, bool) {
+	c := Context{Mode: ToZero}
+	u := c.Uint8(x)
+	return u, c.Flags == 0
+}
+
+// Uint is like [Float16.Uint64], but it converts x to uint.
+func (x Float16) Uint() (uint, bool) {
+	c := Context{Mode: ToZero}
+	u := c.Uint(x)
+	return u, c.Flags == 0
+}
+
+// SaturatingInt64 returns the result of converting x to int64, truncating toward zero.
+// The values out of the range of int64, including infinities, are clamped to the range,
+// and NaN is converted to math.MaxInt64.
+func (x Float16) SaturatingInt64() int64 {
+	c := Context{Mode: ToZero}
+	return c.Int64(x)
+}
+
+// SaturatingInt32 is like [Float16.SaturatingInt64], but it converts x to int32.
+func (x Float16) SaturatingInt32() int32 {
+	c := Context{Mode: ToZero}
+	return c.Int32(x)
+}
+
+// SaturatingInt16 is like [Float16.SaturatingInt64], but it converts x to int16.
+func (x Float16) SaturatingInt16() int16 {
+	c := Context{Mode: ToZero}
+	return c.Int16(x)
+}
+
+// SaturatingInt8 is like [Float16.SaturatingInt64], but it converts x to int8.
+func (x Float16) SaturatingInt8() int8 {
+	c := Context{Mode: ToZero}
+	return c.Int8(x)
+}
+
+// SaturatingInt is like [Float16.SaturatingInt64], but it converts x to int.
+func (x Float16) SaturatingInt() int {
+	c := Context{Mode: ToZero}
+	return c.Int(x)
+}
+
+// SaturatingUint64 returns the result of converting x to uint64, truncating toward zero.
+// The values out of the range of uint64, including infinities and negative numbers, are clamped to the range,
+// and NaN is converted to math.MaxUint64.
+func (x Float16) SaturatingUint64() uint64 {
+	c := Context{Mode: ToZero}
+	return c.Uint64(x)
+}
+
+// SaturatingUint32 is like [Float16.SaturatingUint64], but it converts x to uint32.
+func (x Float16) SaturatingUint32() uint32 {
+	c := Context{Mode: ToZero}
+	return c.Uint32(x)
+}
+
+// SaturatingUint16 is like [Float16.SaturatingUint64], but it converts x to uint16.
+func (x Float16) SaturatingUint16() uint16 {
+	c := Context{Mode: ToZero}
+	return c.Uint16(x)
+}
+
+// SaturatingUint8 is like [Float16.SaturatingUint64], but it converts x to uint8.
+func (x Float16) SaturatingUint8() uint8 {
+	c := Context{Mode: ToZero}
+	return c.Uint8(x)
+}
+
+// SaturatingUint is like [Float16.SaturatingUint64], but it converts x to uint.
+func (x Float16) SaturatingUint() uint {
+	c := Context{Mode: ToZero}
+	return c.Uint(x)
+}
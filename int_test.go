@@ -0,0 +1,191 @@
+package float16
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFromInt(t *testing.T) {
+	// the conversions of the integers to float64 are exact,
+	// so FromFloat64 gives the correctly rounded results.
+	for i := int64(-70000); i <= 70000; i++ {
+		want := FromFloat64(float64(i))
+		if got := FromInt64(i); got != want {
+			t.Errorf("FromInt64(%d): expected %04x, got %04x", i, want, got)
+		}
+		if got := FromInt32(int32(i)); got != want {
+			t.Errorf("FromInt32(%d): expected %04x, got %04x", i, want, got)
+		}
+		if got := FromInt(int(i)); got != want {
+			t.Errorf("FromInt(%d): expected %04x, got %04x", i, want, got)
+		}
+		if i < 0 {
+			continue
+		}
+		if got := FromUint64(uint64(i)); got != want {
+			t.Errorf("FromUint64(%d): expected %04x, got %04x", i, want, got)
+		}
+		if got := FromUint32(uint32(i)); got != want {
+			t.Errorf("FromUint32(%d): expected %04x, got %04x", i, want, got)
+		}
+		if got := FromUint(uint(i)); got != want {
+			t.Errorf("FromUint(%d): expected %04x, got %04x", i, want, got)
+		}
+	}
+
+	tests := []struct {
+		got, want Float16
+	}{
+		{FromInt64(math.MaxInt64), uvinf},
+		{FromInt64(math.MinInt64), uvneginf},
+		{FromUint64(math.MaxUint64), uvinf},
+		{FromInt32(math.MinInt32), uvneginf},
+		{FromUint32(math.MaxUint32), uvinf},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("expected %04x, got %04x", tt.want, tt.got)
+		}
+	}
+}
+
+// refInt converts x to an integer in [lo, hi] by float64 arithmetic, truncating toward zero.
+// NaN is converted to hi.
+func refInt(x Float16, lo, hi float64) (float64, bool) {
+	f := x.Float64()
+	if math.IsNaN(f) {
+		return hi, false
+	}
+	i := math.Trunc(f)
+	if i < lo {
+		return lo, false
+	}
+	if i > hi {
+		return hi, false
+	}
+	return i, i == f
+}
+
+func TestInt(t *testing.T) {
+	type conv func(x Float16) (float64, bool)
+	tests := []struct {
+		name   string
+		lo, hi float64
+		exact  conv
+		sat    func(x Float16) float64
+	}{
+		{
+			"Int64", math.MinInt64, math.MaxInt64,
+			func(x Float16) (float64, bool) { i, ok := x.Int64(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingInt64()) },
+		},
+		{
+			"Int32", math.MinInt32, math.MaxInt32,
+			func(x Float16) (float64, bool) { i, ok := x.Int32(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingInt32()) },
+		},
+		{
+			"Int16", math.MinInt16, math.MaxInt16,
+			func(x Float16) (float64, bool) { i, ok := x.Int16(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingInt16()) },
+		},
+		{
+			"Int8", math.MinInt8, math.MaxInt8,
+			func(x Float16) (float64, bool) { i, ok := x.Int8(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingInt8()) },
+		},
+		{
+			"Int", math.MinInt, math.MaxInt,
+			func(x Float16) (float64, bool) { i, ok := x.Int(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingInt()) },
+		},
+		{
+			"Uint64", 0, math.MaxUint64,
+			func(x Float16) (float64, bool) { i, ok := x.Uint64(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingUint64()) },
+		},
+		{
+			"Uint32", 0, math.MaxUint32,
+			func(x Float16) (float64, bool) { i, ok := x.Uint32(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingUint32()) },
+		},
+		{
+			"Uint16", 0, math.MaxUint16,
+			func(x Float16) (float64, bool) { i, ok := x.Uint16(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingUint16()) },
+		},
+		{
+			"Uint8", 0, math.MaxUint8,
+			func(x Float16) (float64, bool) { i, ok := x.Uint8(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingUint8()) },
+		},
+		{
+			"Uint", 0, math.MaxUint,
+			func(x Float16) (float64, bool) { i, ok := x.Uint(); return float64(i), ok },
+			func(x Float16) float64 { return float64(x.SaturatingUint()) },
+		},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 0x10000; i++ {
+			x := Float16(i)
+			want, wantOK := refInt(x, tt.lo, tt.hi)
+			got, ok := tt.exact(x)
+			if got != want || ok != wantOK {
+				t.Errorf("%s(%04x): expected %v, %t, got %v, %t", tt.name, i, want, wantOK, got, ok)
+			}
+			if got := tt.sat(x); got != want {
+				t.Errorf("Saturating%s(%04x): expected %v, got %v", tt.name, i, want, got)
+			}
+		}
+	}
+}
+
+func TestContext_Int16(t *testing.T) {
+	round := map[RoundingMode]func(float64) float64{
+		ToNearestEven: math.RoundToEven,
+		ToNearestAway: math.Round,
+		ToZero:        math.Trunc,
+		ToNegativeInf: math.Floor,
+		ToPositiveInf: math.Ceil,
+	}
+	for mode, f := range round {
+		for i := 0; i < 0x10000; i++ {
+			x := Float16(i)
+			if x.IsNaN() {
+				continue
+			}
+			want := f(x.Float64())
+			var wantFlags Flags
+			if want != x.Float64() {
+				wantFlags = Inexact
+			}
+			if want < math.MinInt16 {
+				want, wantFlags = math.MinInt16, Invalid
+			}
+			if want > math.MaxInt16 {
+				want, wantFlags = math.MaxInt16, Invalid
+			}
+
+			c := Context{Mode: mode}
+			got := c.Int16(x)
+			if float64(got) != want || c.Flags != wantFlags {
+				t.Errorf("%v, %04x: expected %v (%v), got %d (%v)", mode, i, want, wantFlags, got, c.Flags)
+			}
+		}
+	}
+}
+
+func TestContext_Int_NaN(t *testing.T) {
+	for _, x := range []Float16{uvnan, uvnan | signMask16, 0x7c01} {
+		var c Context
+		if got := c.Int64(x); got != math.MaxInt64 {
+			t.Errorf("%04x: expected %d, got %d", x, int64(math.MaxInt64), got)
+		}
+		if got := c.Uint8(x); got != math.MaxUint8 {
+			t.Errorf("%04x: expected %d, got %d", x, math.MaxUint8, got)
+		}
+		if c.Flags != Invalid {
+			t.Errorf("%04x: expected %v, got %v", x, Invalid, c.Flags)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package testfloat
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Case is a test case.
+type Case struct {
+	// Line is the line number in the file, starting at 1.
+	Line int
+
+	// Operands are the bit patterns of the operands.
+	Operands []uint64
+
+	// Result is the bit pattern of the expected result.
+	// For comparisons, it is 1 for true and 0 for false.
+	Result uint64
+
+	// Flags is the expected exception flags.
+	// The bits are inexact (1), underflow (2), overflow (4), infinite (8) and invalid (16).
+	Flags uint8
+}
+
+// Reader reads the test cases from the output of testfloat_gen.
+// It reads level 1 and level 2 files in the same way,
+// and it doesn't hold the whole file in memory.
+type Reader struct {
+	s   *bufio.Scanner
+	c   Case
+	err error
+}
+
+// NewReader returns a new Reader that reads the test cases with n operands from r.
+func NewReader(r io.Reader, n int) *Reader {
+	return &Reader{
+		s: bufio.NewScanner(r),
+		c: Case{Operands: make([]uint64, n)},
+	}
+}
+
+// Next reads the next test case, which is available through the Case method.
+// It returns false when it reaches the end of the input or an error.
+func (r *Reader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	for r.s.Scan() {
+		r.c.Line++
+		line := strings.TrimSpace(r.s.Text())
+		if line == "" {
+			continue
+		}
+		if err := r.parse(line); err != nil {
+			r.err = err
+			return false
+		}
+		return true
+	}
+	r.err = r.s.Err()
+	return false
+}
+
+func (r *Reader) parse(line string) error {
+	fields := strings.Fields(line)
+	n := len(r.c.Operands)
+	if len(fields) != n+2 {
+		return fmt.Errorf("testfloat: line %d: expected %d fields, got %d", r.c.Line, n+2, len(fields))
+	}
+	for i, field := range fields[:n+1] {
+		v, err := strconv.ParseUint(field, 16, 64)
+		if err != nil {
+			return fmt.Errorf("testfloat: line %d: invalid value %q", r.c.Line, field)
+		}
+		if i < n {
+			r.c.Operands[i] = v
+		} else {
+			r.c.Result = v
+		}
+	}
+	flags, err := strconv.ParseUint(fields[n+1], 16, 8)
+	if err != nil {
+		return fmt.Errorf("testfloat: line %d: invalid flags %q", r.c.Line, fields[n+1])
+	}
+	r.c.Flags = uint8(flags)
+	return nil
+}
+
+// Case returns the test case read by the last call of Next.
+// The returned value is overwritten by the next call of Next.
+func (r *Reader) Case() *Case {
+	return &r.c
+}
+
+// Err returns the first error encountered by the Reader.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Open opens the file f and returns a Reader of the test cases, and a Closer to close the file.
+// If the file is compressed by gzip, it is decompressed on the fly.
+func (f File) Open() (*Reader, io.Closer, error) {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !f.Gzip {
+		return NewReader(file, f.Function.Operands), file, nil
+	}
+	z, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	return NewReader(z, f.Function.Operands), file, nil
+}
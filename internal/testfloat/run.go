@@ -0,0 +1,110 @@
+package testfloat
+
+import (
+	"strings"
+	"testing"
+)
+
+// Op is an operation under test.
+// It returns the bit pattern of the result and the exception flags raised.
+// For comparisons, the result is 1 for true and 0 for false.
+// The slice of the operands must not be retained.
+type Op func(operands []uint64) (result uint64, flags uint8)
+
+// Runner runs operations against test vectors.
+type Runner struct {
+	// IgnoreFlags makes the runner ignore the exception flags,
+	// for operations that don't report them.
+	IgnoreFlags bool
+
+	// CheckNaNs makes the runner require NaN results to have the same bit patterns as the expected ones.
+	// Otherwise any NaN is accepted, as testfloat does by default.
+	CheckNaNs bool
+
+	// MaxErrors is the number of errors reported before the runner gives up.
+	// If it is zero, the runner gives up after 20 errors, as testfloat does by default.
+	// If it is negative, the runner reports all errors.
+	MaxErrors int
+}
+
+// Run runs op against the test vectors in the file f,
+// with the zero Runner.
+func Run(t testing.TB, f File, op Op) {
+	t.Helper()
+	new(Runner).Run(t, f, op)
+}
+
+// Run runs op against the test vectors in the file f.
+// Errors are reported to t.
+func (r *Runner) Run(t testing.TB, f File, op Op) {
+	t.Helper()
+
+	rd, c, err := f.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	maxErrors := r.MaxErrors
+	if maxErrors == 0 {
+		maxErrors = 20
+	}
+
+	fn := f.Function
+	errors := 0
+	for rd.Next() {
+		tc := rd.Case()
+		got, flags := op(tc.Operands)
+		if r.IgnoreFlags {
+			flags = tc.Flags
+		}
+		if r.equal(fn.Result, got, tc.Result) && flags == tc.Flags {
+			continue
+		}
+
+		var buf strings.Builder
+		buf.WriteString(fn.Name)
+		buf.WriteByte('(')
+		for i, v := range tc.Operands {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(hex(fn.Operand, v))
+		}
+		buf.WriteByte(')')
+		if r.IgnoreFlags {
+			t.Errorf("line %d: %s: expected %s, got %s",
+				tc.Line, buf.String(), hex(fn.Result, tc.Result), hex(fn.Result, got))
+		} else {
+			t.Errorf("line %d: %s: expected %s %02X, got %s %02X",
+				tc.Line, buf.String(), hex(fn.Result, tc.Result), tc.Flags, hex(fn.Result, got), flags)
+		}
+
+		errors++
+		if maxErrors > 0 && errors >= maxErrors {
+			t.Fatalf("%s: too many errors", f.Path)
+		}
+	}
+	if err := rd.Err(); err != nil {
+		t.Fatalf("%s: %v", f.Path, err)
+	}
+}
+
+func (r *Runner) equal(typ Type, got, want uint64) bool {
+	if got == want {
+		return true
+	}
+	return !r.CheckNaNs && typ.IsNaN(got) && typ.IsNaN(want)
+}
+
+// hex formats v in the same way as testfloat_gen.
+func hex(typ Type, v uint64) string {
+	const digits = "0123456789ABCDEF"
+	n := typ.digits()
+	buf := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		buf[i] = digits[v&0xf]
+		v >>= 4
+	}
+	return string(buf)
+}
@@ -0,0 +1,306 @@
+// Package testfloat reads the test vectors generated by testfloat_gen of Berkeley TestFloat,
+// and runs operations against them.
+//
+// A file of test vectors has one test case per line,
+// which has the operands, the expected result and the expected exception flags in hexadecimal:
+//
+//	E803 BD07 E804 01
+//
+// The file doesn't record the options of testfloat_gen,
+// so they are encoded in the file name:
+//
+//	<function>[_r<mode>][_tininess<before|after>][_exact|_notexact][_level<n>].txt[.gz]
+//
+// For example, the vectors generated by
+//
+//	$ testfloat_gen -level 2 -rminMag f16_mul | gzip > f16_mul_rminMag_level2.txt.gz
+//
+// are tested with the rounding mode "minMag".
+// See http://www.jhauser.us/arithmetic/TestFloat.html for testfloat_gen.
+package testfloat
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Type is a type of operands and results of TestFloat.
+type Type int
+
+// These constants define the types.
+const (
+	Bool Type = iota
+	F16
+	BF16
+	F32
+	F64
+	I32
+	UI32
+	I64
+	UI64
+)
+
+var typeNames = [...]string{
+	Bool: "bool",
+	F16:  "f16",
+	BF16: "bf16",
+	F32:  "f32",
+	F64:  "f64",
+	I32:  "i32",
+	UI32: "ui32",
+	I64:  "i64",
+	UI64: "ui64",
+}
+
+func (t Type) String() string {
+	if t >= 0 && int(t) < len(typeNames) {
+		return typeNames[t]
+	}
+	return "Type(" + strconv.Itoa(int(t)) + ")"
+}
+
+func parseType(s string) (Type, bool) {
+	for t, name := range typeNames {
+		if name == s && Type(t) != Bool {
+			return Type(t), true
+		}
+	}
+	return 0, false
+}
+
+// digits returns the number of hexadecimal digits of t.
+func (t Type) digits() int {
+	switch t {
+	case F16, BF16:
+		return 4
+	case F32, I32, UI32:
+		return 8
+	case F64, I64, UI64:
+		return 16
+	}
+	return 1
+}
+
+// IsNaN reports whether bits is a NaN of the type t.
+func (t Type) IsNaN(bits uint64) bool {
+	var exp, frac uint64
+	switch t {
+	case F16:
+		exp, frac = 0x7c00, 0x03ff
+	case BF16:
+		exp, frac = 0x7f80, 0x007f
+	case F32:
+		exp, frac = 0x7f80_0000, 0x007f_ffff
+	case F64:
+		exp, frac = 0x7ff0_0000_0000_0000, 0x000f_ffff_ffff_ffff
+	default:
+		return false
+	}
+	return bits&exp == exp && bits&frac != 0
+}
+
+// Function is a function of TestFloat, such as "f16_add" or "f32_to_f16".
+type Function struct {
+	// Name is the name of the function given to testfloat_gen.
+	Name string
+
+	// Operands is the number of the operands.
+	Operands int
+
+	// Operand is the type of the operands.
+	Operand Type
+
+	// Result is the type of the result.
+	Result Type
+}
+
+// ParseFunction parses the name of a function of TestFloat.
+func ParseFunction(name string) (Function, error) {
+	typ, op, ok := strings.Cut(name, "_")
+	if !ok {
+		return Function{}, fmt.Errorf("testfloat: unknown function %q", name)
+	}
+	t, ok := parseType(typ)
+	if !ok {
+		return Function{}, fmt.Errorf("testfloat: unknown type in %q", name)
+	}
+
+	fn := Function{Name: name, Operand: t, Result: t}
+	switch op {
+	case "roundToInt", "sqrt":
+		fn.Operands = 1
+	case "add", "sub", "mul", "div", "rem":
+		fn.Operands = 2
+	case "mulAdd":
+		fn.Operands = 3
+	case "eq", "le", "lt", "eq_signaling", "le_quiet", "lt_quiet":
+		fn.Operands = 2
+		fn.Result = Bool
+	default:
+		typ, ok := strings.CutPrefix(op, "to_")
+		if !ok {
+			return Function{}, fmt.Errorf("testfloat: unknown function %q", name)
+		}
+		r, ok := parseType(typ)
+		if !ok {
+			return Function{}, fmt.Errorf("testfloat: unknown type in %q", name)
+		}
+		fn.Operands = 1
+		fn.Result = r
+	}
+	return fn, nil
+}
+
+// RoundingMode is a rounding mode of TestFloat.
+type RoundingMode int
+
+// These constants define the rounding modes,
+// which are given to testfloat_gen by the options -rnear_even, -rnear_maxMag and so on.
+const (
+	NearEven   RoundingMode = iota // round to nearest, ties to even
+	NearMaxMag                     // round to nearest, ties away from zero
+	MinMag                         // round toward zero
+	Min                            // round toward negative infinity
+	Max                            // round toward positive infinity
+	Odd                            // round to odd
+)
+
+var modeNames = [...]string{
+	NearEven:   "near_even",
+	NearMaxMag: "near_maxMag",
+	MinMag:     "minMag",
+	Min:        "min",
+	Max:        "max",
+	Odd:        "odd",
+}
+
+func (m RoundingMode) String() string {
+	if m >= 0 && int(m) < len(modeNames) {
+		return modeNames[m]
+	}
+	return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// ParseRoundingMode parses the rounding mode in the form of the option of testfloat_gen,
+// such as "-rminMag", or without the prefix, such as "minMag".
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	name := strings.TrimPrefix(s, "-r")
+	for m, n := range modeNames {
+		if n == name {
+			return RoundingMode(m), nil
+		}
+	}
+	return 0, fmt.Errorf("testfloat: unknown rounding mode %q", s)
+}
+
+// Tininess determines when underflow is detected.
+type Tininess int
+
+// These constants define the methods of detecting tininess,
+// which are given to testfloat_gen by the options -tininessafter and -tininessbefore.
+const (
+	AfterRounding Tininess = iota
+	BeforeRounding
+)
+
+func (t Tininess) String() string {
+	switch t {
+	case AfterRounding:
+		return "after"
+	case BeforeRounding:
+		return "before"
+	}
+	return "Tininess(" + strconv.Itoa(int(t)) + ")"
+}
+
+// File is a file of test vectors.
+type File struct {
+	// Path is the path to the file.
+	Path string
+
+	// Function is the function tested.
+	Function Function
+
+	// Mode is the rounding mode. The default is NearEven.
+	Mode RoundingMode
+
+	// Tininess is the method of detecting tininess. The default is AfterRounding.
+	Tininess Tininess
+
+	// Exact reports whether the conversions to integers raise the inexact exception,
+	// which is given to testfloat_gen by the option -exact. The default is false.
+	Exact bool
+
+	// Level is the level of the test vectors. The default is 1.
+	Level int
+
+	// Gzip reports whether the file is compressed by gzip.
+	Gzip bool
+}
+
+// ParseFile parses the name of the file of test vectors.
+// See the package documentation for the naming rule.
+func ParseFile(path string) (File, error) {
+	f := File{Path: path, Level: 1}
+	name := filepath.Base(path)
+	if s, ok := strings.CutSuffix(name, ".gz"); ok {
+		name = s
+		f.Gzip = true
+	}
+	name, ok := strings.CutSuffix(name, ".txt")
+	if !ok {
+		return File{}, fmt.Errorf("testfloat: %s: not a text file", path)
+	}
+
+	// the options follow the function name in any order.
+	for {
+		if s, ok := cutOption(name, "tininessafter"); ok {
+			name, f.Tininess = s, AfterRounding
+			continue
+		}
+		if s, ok := cutOption(name, "tininessbefore"); ok {
+			name, f.Tininess = s, BeforeRounding
+			continue
+		}
+		if s, ok := cutOption(name, "exact"); ok {
+			name, f.Exact = s, true
+			continue
+		}
+		if s, ok := cutOption(name, "notexact"); ok {
+			name, f.Exact = s, false
+			continue
+		}
+		if s, ok := cutOption(name, "level1"); ok {
+			name, f.Level = s, 1
+			continue
+		}
+		if s, ok := cutOption(name, "level2"); ok {
+			name, f.Level = s, 2
+			continue
+		}
+		found := false
+		for m, mode := range modeNames {
+			if s, ok := cutOption(name, "r"+mode); ok {
+				name, f.Mode = s, RoundingMode(m)
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+	}
+
+	fn, err := ParseFunction(name)
+	if err != nil {
+		return File{}, fmt.Errorf("%w in %s", err, path)
+	}
+	f.Function = fn
+	return f, nil
+}
+
+func cutOption(name, opt string) (string, bool) {
+	return strings.CutSuffix(name, "_"+opt)
+}
@@ -0,0 +1,271 @@
+package testfloat
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseFunction(t *testing.T) {
+	tests := []struct {
+		name string
+		want Function
+	}{
+		{"f16_add", Function{"f16_add", 2, F16, F16}},
+		{"f32_sqrt", Function{"f32_sqrt", 1, F32, F32}},
+		{"f16_mulAdd", Function{"f16_mulAdd", 3, F16, F16}},
+		{"f64_roundToInt", Function{"f64_roundToInt", 1, F64, F64}},
+		{"f16_le_quiet", Function{"f16_le_quiet", 2, F16, Bool}},
+		{"f32_to_f16", Function{"f32_to_f16", 1, F32, F16}},
+		{"f16_to_ui64", Function{"f16_to_ui64", 1, F16, UI64}},
+		{"i32_to_f16", Function{"i32_to_f16", 1, I32, F16}},
+		{"bf16_mulAdd", Function{"bf16_mulAdd", 3, BF16, BF16}},
+		{"f64_to_bf16", Function{"f64_to_bf16", 1, F64, BF16}},
+	}
+	for _, tt := range tests {
+		got, err := ParseFunction(tt.name)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	for _, name := range []string{"", "f16", "f16_foo", "f8_add", "bool_add", "f16_to_bool", "f16_to_f8"} {
+		if _, err := ParseFunction(name); err == nil {
+			t.Errorf("%q: expected error", name)
+		}
+	}
+}
+
+func TestType_IsNaN(t *testing.T) {
+	tests := []struct {
+		typ  Type
+		bits uint64
+		want bool
+	}{
+		{F16, 0x7c00, false},
+		{F16, 0x7e00, true},
+		{F16, 0xfc01, true},
+		{BF16, 0x7f80, false},
+		{BF16, 0x7fc0, true},
+		{BF16, 0xff81, true},
+		{BF16, 0x7e00, false},
+		{F32, 0x7fc0_0000, true},
+		{F64, 0x7ff0_0000_0000_0000, false},
+		{I32, 0xffff_ffff, false},
+	}
+	for _, tt := range tests {
+		if got := tt.typ.IsNaN(tt.bits); got != tt.want {
+			t.Errorf("%s %X: expected %t, got %t", tt.typ, tt.bits, tt.want, got)
+		}
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	tests := []struct {
+		path     string
+		function string
+		mode     RoundingMode
+		tininess Tininess
+		exact    bool
+		level    int
+		gzip     bool
+	}{
+		{"f16_add.txt", "f16_add", NearEven, AfterRounding, false, 1, false},
+		{"testdata/f16_add.txt.gz", "f16_add", NearEven, AfterRounding, false, 1, true},
+		{"f16_mul_rminMag_level2.txt.gz", "f16_mul", MinMag, AfterRounding, false, 2, true},
+		{"f16_mul_level2_rminMag.txt.gz", "f16_mul", MinMag, AfterRounding, false, 2, true},
+		{"f16_mul_rnear_even.txt", "f16_mul", NearEven, AfterRounding, false, 1, false},
+		{"f16_mul_rnear_maxMag.txt", "f16_mul", NearMaxMag, AfterRounding, false, 1, false},
+		{"f16_mul_rmin_tininessbefore.txt", "f16_mul", Min, BeforeRounding, false, 1, false},
+		{"f16_mul_rmax.txt", "f16_mul", Max, AfterRounding, false, 1, false},
+		{"f16_mul_rodd.txt", "f16_mul", Odd, AfterRounding, false, 1, false},
+		{"f16_lt_quiet_rmax.txt", "f16_lt_quiet", Max, AfterRounding, false, 1, false},
+		{"f64_to_f16_tininessafter.txt", "f64_to_f16", NearEven, AfterRounding, false, 1, false},
+		{"f16_to_i32_rmin_exact.txt.gz", "f16_to_i32", Min, AfterRounding, true, 1, true},
+		{"f16_to_ui64_exact_level2.txt", "f16_to_ui64", NearEven, AfterRounding, true, 2, false},
+		{"f16_to_i64_notexact.txt", "f16_to_i64", NearEven, AfterRounding, false, 1, false},
+	}
+	for _, tt := range tests {
+		f, err := ParseFile(tt.path)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.path, err)
+			continue
+		}
+		if f.Path != tt.path || f.Function.Name != tt.function || f.Mode != tt.mode ||
+			f.Tininess != tt.tininess || f.Exact != tt.exact || f.Level != tt.level || f.Gzip != tt.gzip {
+			t.Errorf("%s: unexpected result: %+v", tt.path, f)
+		}
+	}
+
+	for _, path := range []string{"f16_add", "f16_add.json", "f16_add_rfoo.txt", "f16_add_level3.txt", "README.txt"} {
+		if _, err := ParseFile(path); err == nil {
+			t.Errorf("%q: expected error", path)
+		}
+	}
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	for _, mode := range []RoundingMode{NearEven, NearMaxMag, MinMag, Min, Max, Odd} {
+		for _, s := range []string{mode.String(), "-r" + mode.String()} {
+			got, err := ParseRoundingMode(s)
+			if err != nil || got != mode {
+				t.Errorf("%q: expected %v, got %v (%v)", s, mode, got, err)
+			}
+		}
+	}
+	if _, err := ParseRoundingMode("-rfoo"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestReader(t *testing.T) {
+	const input = "E803 BD07 E804 01\n\n0000 BBFF BBFF 00\r\nC006 AC0F C026 01\n"
+	r := NewReader(strings.NewReader(input), 2)
+	want := []Case{
+		{1, []uint64{0xe803, 0xbd07}, 0xe804, 0x01},
+		{3, []uint64{0x0000, 0xbbff}, 0xbbff, 0x00},
+		{4, []uint64{0xc006, 0xac0f}, 0xc026, 0x01},
+	}
+	var got []Case
+	for r.Next() {
+		c := *r.Case()
+		c.Operands = append([]uint64(nil), c.Operands...)
+		got = append(got, c)
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestReader_Error(t *testing.T) {
+	tests := []string{
+		"E803 BD07 E804\n",
+		"E803 BD07 E804 01 00\n",
+		"E803 XD07 E804 01\n",
+		"E803 BD07 E804 100\n",
+	}
+	for _, input := range tests {
+		r := NewReader(strings.NewReader("0000 0000 0000 00\n"+input), 2)
+		if !r.Next() {
+			t.Errorf("%q: unexpected error: %v", input, r.Err())
+			continue
+		}
+		if r.Next() {
+			t.Errorf("%q: expected error", input)
+			continue
+		}
+		if err := r.Err(); err == nil || !strings.Contains(err.Error(), "line 2") {
+			t.Errorf("%q: unexpected error: %v", input, err)
+		}
+	}
+}
+
+// recorder records the errors reported by Runner.
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+	r.fatal = true
+	panic(r)
+}
+
+func (r *recorder) run(f func()) {
+	defer func() {
+		if v := recover(); v != nil && v != r {
+			panic(v)
+		}
+	}()
+	f()
+}
+
+func writeFile(t *testing.T, name, content string) File {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if strings.HasSuffix(name, ".gz") {
+		z := gzip.NewWriter(file)
+		if _, err := z.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		if err := z.Close(); err != nil {
+			t.Fatal(err)
+		}
+	} else if _, err := file.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := ParseFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// add adds two non-negative Float16 numbers in the same exponent,
+// which is enough for the tests.
+func add(x []uint64) (uint64, uint8) {
+	return x[0] + x[1]&0x3ff, 0
+}
+
+func TestRun(t *testing.T) {
+	const input = "3C00 0001 3C01 00\n3C00 0002 3C02 00\n7E00 0000 7E00 00\n"
+	for _, name := range []string{"f16_add.txt", "f16_add.txt.gz"} {
+		f := writeFile(t, name, input)
+		Run(t, f, add)
+	}
+}
+
+func TestRun_Error(t *testing.T) {
+	const input = "3C00 0001 3C01 00\n3C00 0002 3C03 00\n3C00 0003 3C03 01\n7E00 0000 7E01 00\n"
+	f := writeFile(t, "f16_add.txt", input)
+
+	rec := &recorder{TB: t}
+	rec.run(func() { Run(rec, f, add) })
+	want := []string{
+		"line 2: f16_add(3C00, 0002): expected 3C03 00, got 3C02 00",
+		"line 3: f16_add(3C00, 0003): expected 3C03 01, got 3C03 00",
+	}
+	if rec.fatal || fmt.Sprint(rec.errors) != fmt.Sprint(want) {
+		t.Errorf("expected %q, got %q", want, rec.errors)
+	}
+
+	// NaNs are compared bit by bit, and the flags are ignored.
+	rec = &recorder{TB: t}
+	rec.run(func() { (&Runner{IgnoreFlags: true, CheckNaNs: true}).Run(rec, f, add) })
+	want = []string{
+		"line 2: f16_add(3C00, 0002): expected 3C03, got 3C02",
+		"line 4: f16_add(7E00, 0000): expected 7E01, got 7E00",
+	}
+	if rec.fatal || fmt.Sprint(rec.errors) != fmt.Sprint(want) {
+		t.Errorf("expected %q, got %q", want, rec.errors)
+	}
+
+	// the runner gives up after MaxErrors errors.
+	rec = &recorder{TB: t}
+	rec.run(func() { (&Runner{MaxErrors: 1}).Run(rec, f, add) })
+	if !rec.fatal || len(rec.errors) != 2 {
+		t.Errorf("expected fatal error, got %q", rec.errors)
+	}
+}
@@ -0,0 +1,43 @@
+package float16
+
+import "math"
+
+// Log returns the natural logarithm of x.
+//
+// Special cases are:
+//
+//	Log(+Inf) = +Inf
+//	Log(0) = -Inf
+//	Log(x < 0) = NaN
+//	Log(NaN) = NaN
+func (x Float16) Log() Float16 {
+	return elementary(math.Log, x)
+}
+
+// Log2 returns the binary logarithm of x.
+//
+// Special cases are the same as Log.
+func (x Float16) Log2() Float16 {
+	return elementary(math.Log2, x)
+}
+
+// Log10 returns the decimal logarithm of x.
+//
+// Special cases are the same as Log.
+func (x Float16) Log10() Float16 {
+	return elementary(math.Log10, x)
+}
+
+// Log1p returns the natural logarithm of 1 plus its argument x.
+// It is more accurate than x.Add(1).Log() when x is near zero.
+//
+// Special cases are:
+//
+//	Log1p(+Inf) = +Inf
+//	Log1p(±0) = ±0
+//	Log1p(-1) = -Inf
+//	Log1p(x < -1) = NaN
+//	Log1p(NaN) = NaN
+func (x Float16) Log1p() Float16 {
+	return elementary(math.Log1p, x)
+}
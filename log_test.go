@@ -0,0 +1,83 @@
+package float16
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestLog(t *testing.T) {
+	testSpecialCases(t, Float16.Log, []specialCase{
+		{1, 0},
+		{math.Inf(1), math.Inf(1)},
+		{0, math.Inf(-1)},
+		{negZero, math.Inf(-1)},
+		{-1, math.NaN()},
+		{math.Inf(-1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Log, func(x *big.Float) *big.Float {
+		if x.Sign() < 0 {
+			return nil
+		}
+		return bigLog(x)
+	})
+}
+
+func TestLog2(t *testing.T) {
+	testSpecialCases(t, Float16.Log2, []specialCase{
+		{1, 0},
+		{1024, 10},
+		{0x1p-24, -24},
+		{math.Inf(1), math.Inf(1)},
+		{0, math.Inf(-1)},
+		{-1, math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Log2, func(x *big.Float) *big.Float {
+		if x.Sign() < 0 {
+			return nil
+		}
+		return bigQuo(bigLog(x), bigLn2)
+	})
+}
+
+func TestLog10(t *testing.T) {
+	testSpecialCases(t, Float16.Log10, []specialCase{
+		{1, 0},
+		{10, 1},
+		{10000, 4},
+		{math.Inf(1), math.Inf(1)},
+		{0, math.Inf(-1)},
+		{-1, math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Log10, func(x *big.Float) *big.Float {
+		if x.Sign() < 0 {
+			return nil
+		}
+		return bigQuo(bigLog(x), bigLn10)
+	})
+}
+
+func TestLog1p(t *testing.T) {
+	testSpecialCases(t, Float16.Log1p, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{-1, math.Inf(-1)},
+		{-2, math.NaN()},
+		{math.Inf(1), math.Inf(1)},
+		{math.Inf(-1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Log1p, func(x *big.Float) *big.Float {
+		y := bigAdd(x, bigInt(1))
+		switch y.Sign() {
+		case -1:
+			return nil
+		case 0:
+			return new(big.Float).SetInf(true)
+		}
+		return bigLog(y)
+	})
+}
@@ -10,7 +10,7 @@ func (a Float16) Mul(b Float16) Float16 {
 	if a.IsNaN() || b.IsNaN() {
 		// anything * NaN = NaN
 		// NaN * anything = NaN
-		return uvnan
+		return propagateNaN(a, b)
 	}
 
 	signA := a & signMask16
@@ -43,29 +43,10 @@ func (a Float16) Mul(b Float16) Float16 {
 
 	sign := signA ^ signB
 
-	var fracA uint32
-	if expA == -bias16 {
-		// a is subnormal
-		fracA = uint32(a & fracMask16)
-		l := bits.Len32(fracA)
-		fracA <<= shift16 - l + 1
-		expA = -(bias16 + shift16) + l
-	} else {
-		// a is normal
-		fracA = uint32(a&fracMask16) | (1 << shift16)
-	}
-
-	var fracB uint32
-	if expB == -bias16 {
-		// b is subnormal
-		fracB = uint32(b & fracMask16)
-		l := bits.Len32(fracB)
-		fracB <<= shift16 - l + 1
-		expB = -(bias16 + shift16) + l
-	} else {
-		// b is normal
-		fracB = uint32(b&fracMask16) | (1 << shift16)
-	}
+	_, eA, fA := a.split()
+	expA, fracA := int(eA), uint32(fA)
+	_, eB, fB := b.split()
+	expB, fracB := int(eB), uint32(fB)
 
 	exp := expA + expB
 	frac := fracA * fracB
@@ -101,7 +82,7 @@ func (a Float16) Quo(b Float16) Float16 {
 	if a.IsNaN() || b.IsNaN() {
 		// anything / NaN = NaN
 		// NaN / anything = NaN
-		return uvnan
+		return propagateNaN(a, b)
 	}
 
 	signA := a & signMask16
@@ -141,33 +122,15 @@ func (a Float16) Quo(b Float16) Float16 {
 		return sign
 	}
 
-	var fracA uint32
-	if expA == -bias16 {
-		// a is subnormal
-		fracA = uint32(a & fracMask16)
-		l := bits.Len32(fracA)
-		fracA <<= shift16 - l + 1
-		expA = -(bias16 + shift16) + l
-	} else {
-		// a is normal
-		fracA = uint32(a&fracMask16) | (1 << shift16)
-	}
+	_, eA, fA := a.split()
+	expA, fracA := int(eA), uint32(fA)
 	if fracA == 0 {
 		// a is zero
 		return sign
 	}
 
-	var fracB uint32
-	if expB == -bias16 {
-		// b is subnormal
-		fracB = uint32(b & fracMask16)
-		l := bits.Len32(fracB)
-		fracB <<= shift16 - l + 1
-		expB = -(bias16 + shift16) + l
-	} else {
-		// b is normal
-		fracB = uint32(b&fracMask16) | (1 << shift16)
-	}
+	_, eB, fB := b.split()
+	expB, fracB := int(eB), uint32(fB)
 
 	exp := expA - expB + bias16
 	if fracA < fracB {
@@ -209,7 +172,7 @@ func (a Float16) Add(b Float16) Float16 {
 	if a.IsNaN() || b.IsNaN() {
 		// anything + NaN = NaN
 		// NaN + anything = NaN
-		return uvnan
+		return propagateNaN(a, b)
 	}
 	if a^signMask16 == 0 { // a is ±0
 		return b
@@ -234,6 +197,10 @@ func (a Float16) Add(b Float16) Float16 {
 
 // Sub returns the IEEE 754 binary64 difference of a and b.
 func (a Float16) Sub(b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		// keep the sign of NaN
+		return propagateNaN(a, b)
+	}
 	return a.Add(b ^ signMask16)
 }
 
@@ -330,6 +297,11 @@ func (a Float16) comparable() int16 {
 	return i + int16(a>>15) // normalize -0 to 0
 }
 
+// signedComparable is the same as comparable, but -0 is less than +0.
+func (a Float16) signedComparable() int16 {
+	return int16(a) ^ ((int16(a) >> 15) & 0x7fff)
+}
+
 // Eq returns a == b.
 // NaNs are not equal to anything, including NaN.
 func (a Float16) Eq(b Float16) bool {
@@ -396,11 +368,270 @@ func (a Float16) Ge(b Float16) bool {
 	return b.Le(a)
 }
 
+// Remainder returns the IEEE 754 floating-point remainder of a/b.
+// The result is a - n*b, where n is the integer nearest to a/b, rounding ties to even.
+// The result is always exact.
+//
+// Special cases are:
+//
+//	Remainder(±Inf, b) = NaN
+//	Remainder(NaN, b) = NaN
+//	Remainder(a, 0) = NaN
+//	Remainder(a, ±Inf) = a
+//	Remainder(a, NaN) = NaN
+func (a Float16) Remainder(b Float16) Float16 {
+	if r, ok := a.remSpecial(b); ok {
+		return r
+	}
+
+	x, y := a.fixed(), b.fixed()
+	q, r := x/y, x%y
+	sign := uint16(a & signMask16)
+	if 2*r > y || 2*r == y && q&1 != 0 {
+		// |a| - (q+1)|b| is nearer to zero, and its sign is opposite to a.
+		r = y - r
+		sign ^= signMask16
+	}
+	if r == 0 {
+		// the zero has the same sign as a.
+		return a & signMask16
+	}
+	var c Context
+	return c.round(sign, -bias16-shift16+1, r, false)
+}
+
+// Mod returns the floating-point remainder of a/b, as C's fmod does.
+// The magnitude of the result is less than b and its sign agrees with that of a.
+// The result is always exact.
+//
+// Special cases are:
+//
+//	Mod(±Inf, b) = NaN
+//	Mod(NaN, b) = NaN
+//	Mod(a, 0) = NaN
+//	Mod(a, ±Inf) = a
+//	Mod(a, NaN) = NaN
+func (a Float16) Mod(b Float16) Float16 {
+	if r, ok := a.remSpecial(b); ok {
+		return r
+	}
+
+	r := a.fixed() % b.fixed()
+	if r == 0 {
+		return a & signMask16
+	}
+	var c Context
+	return c.round(uint16(a&signMask16), -bias16-shift16+1, r, false)
+}
+
+// remSpecial handles the special cases of Remainder and Mod.
+func (a Float16) remSpecial(b Float16) (Float16, bool) {
+	switch {
+	case a.IsNaN() || b.IsNaN():
+		return propagateNaN(a, b), true
+	case a.IsInf(0) || b&^signMask16 == 0:
+		return uvnan, true
+	case b.IsInf(0):
+		return a, true
+	}
+	return 0, false
+}
+
+// fixed returns the absolute value of the finite number a
+// in the unit of the smallest subnormal number.
+// The result is less than 2^40.
+func (a Float16) fixed() uint64 {
+	exp := int((a >> shift16) & mask16)
+	frac := uint64(a & fracMask16)
+	if exp == 0 {
+		// subnormal number
+		return frac
+	}
+	// normal number
+	return (frac | 1<<shift16) << (exp - 1)
+}
+
+// Dim returns the maximum of a-b or 0.
+//
+// Special cases are:
+//
+//	Dim(+Inf, +Inf) = NaN
+//	Dim(-Inf, -Inf) = NaN
+//	Dim(a, NaN) = Dim(NaN, b) = NaN
+func (a Float16) Dim(b Float16) Float16 {
+	v := a.Sub(b)
+	if v.Le(0) {
+		return 0
+	}
+	return v
+}
+
+// Maximum returns the larger of a or b,
+// as the maximum operation of IEEE 754-2019 defines.
+// -0 is considered less than +0.
+//
+// Special cases are:
+//
+//	Maximum(a, NaN) = Maximum(NaN, b) = NaN
+//	Maximum(+0, ±0) = Maximum(±0, +0) = +0
+//	Maximum(-0, -0) = -0
+func (a Float16) Maximum(b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN(a, b)
+	}
+	return a.max(b)
+}
+
+// Minimum returns the smaller of a or b,
+// as the minimum operation of IEEE 754-2019 defines.
+// -0 is considered less than +0.
+//
+// Special cases are:
+//
+//	Minimum(a, NaN) = Minimum(NaN, b) = NaN
+//	Minimum(-0, ±0) = Minimum(±0, -0) = -0
+//	Minimum(+0, +0) = +0
+func (a Float16) Minimum(b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN(a, b)
+	}
+	return a.min(b)
+}
+
+// MaximumNumber returns the larger of a or b,
+// as the maximumNumber operation of IEEE 754-2019 defines.
+// It is the same as Maximum, except that NaNs are treated as missing data.
+//
+// Special cases are:
+//
+//	MaximumNumber(a, NaN) = MaximumNumber(NaN, a) = a
+//	MaximumNumber(NaN, NaN) = NaN
+func (a Float16) MaximumNumber(b Float16) Float16 {
+	if r, ok := a.number(b); ok {
+		return r
+	}
+	return a.max(b)
+}
+
+// MinimumNumber returns the smaller of a or b,
+// as the minimumNumber operation of IEEE 754-2019 defines.
+// It is the same as Minimum, except that NaNs are treated as missing data.
+//
+// Special cases are:
+//
+//	MinimumNumber(a, NaN) = MinimumNumber(NaN, a) = a
+//	MinimumNumber(NaN, NaN) = NaN
+func (a Float16) MinimumNumber(b Float16) Float16 {
+	if r, ok := a.number(b); ok {
+		return r
+	}
+	return a.min(b)
+}
+
+// MaximumMagnitude returns the one of a or b with the larger magnitude,
+// as the maximumMagnitude operation of IEEE 754-2019 defines.
+// If the magnitudes are equal, it returns Maximum(a, b).
+// If a or b is NaN, it returns NaN.
+func (a Float16) MaximumMagnitude(b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN(a, b)
+	}
+	return a.maxMag(b)
+}
+
+// MinimumMagnitude returns the one of a or b with the smaller magnitude,
+// as the minimumMagnitude operation of IEEE 754-2019 defines.
+// If the magnitudes are equal, it returns Minimum(a, b).
+// If a or b is NaN, it returns NaN.
+func (a Float16) MinimumMagnitude(b Float16) Float16 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN(a, b)
+	}
+	return a.minMag(b)
+}
+
+// MaximumMagnitudeNumber returns the one of a or b with the larger magnitude,
+// as the maximumMagnitudeNumber operation of IEEE 754-2019 defines.
+// It is the same as MaximumMagnitude, except that NaNs are treated as missing data.
+func (a Float16) MaximumMagnitudeNumber(b Float16) Float16 {
+	if r, ok := a.number(b); ok {
+		return r
+	}
+	return a.maxMag(b)
+}
+
+// MinimumMagnitudeNumber returns the one of a or b with the smaller magnitude,
+// as the minimumMagnitudeNumber operation of IEEE 754-2019 defines.
+// It is the same as MinimumMagnitude, except that NaNs are treated as missing data.
+func (a Float16) MinimumMagnitudeNumber(b Float16) Float16 {
+	if r, ok := a.number(b); ok {
+		return r
+	}
+	return a.minMag(b)
+}
+
+// number handles NaNs for the *Number operations.
+// If a or b is NaN, it returns the other one, or NaN if both are NaN.
+func (a Float16) number(b Float16) (Float16, bool) {
+	switch {
+	case a.IsNaN() && b.IsNaN():
+		return propagateNaN(a, b), true
+	case a.IsNaN():
+		return b, true
+	case b.IsNaN():
+		return a, true
+	}
+	return 0, false
+}
+
+// max returns the larger of a and b, which are not NaN.
+// -0 is considered less than +0.
+func (a Float16) max(b Float16) Float16 {
+	if a.signedComparable() > b.signedComparable() {
+		return a
+	}
+	return b
+}
+
+// min returns the smaller of a and b, which are not NaN.
+// -0 is considered less than +0.
+func (a Float16) min(b Float16) Float16 {
+	if a.signedComparable() < b.signedComparable() {
+		return a
+	}
+	return b
+}
+
+// maxMag returns the one of a and b with the larger magnitude, which are not NaN.
+func (a Float16) maxMag(b Float16) Float16 {
+	switch absA, absB := a&^signMask16, b&^signMask16; {
+	case absA > absB:
+		return a
+	case absA < absB:
+		return b
+	}
+	return a.max(b)
+}
+
+// minMag returns the one of a and b with the smaller magnitude, which are not NaN.
+func (a Float16) minMag(b Float16) Float16 {
+	switch absA, absB := a&^signMask16, b&^signMask16; {
+	case absA < absB:
+		return a
+	case absA > absB:
+		return b
+	}
+	return a.min(b)
+}
+
 // FMA returns x * y + z, computed with only one rounding.
 // (That is, FMA returns the fused multiply-add of x, y, and z.)
 func FMA(x, y, z Float16) Float16 {
-	if x.IsNaN() || y.IsNaN() || z.IsNaN() {
-		return uvnan
+	if x.IsNaN() || y.IsNaN() {
+		return propagateNaN(x, y)
+	}
+	if z.IsNaN() {
+		return z.quiet()
 	}
 
 	fx := x.Float64()
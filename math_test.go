@@ -1,16 +1,11 @@
 package float16
 
 import (
-	"bufio"
 	"cmp"
-	"compress/gzip"
 	"errors"
 	"math"
 	"math/big"
-	"os"
 	"runtime"
-	"strconv"
-	"strings"
 	"sync"
 	"testing"
 	"testing/quick"
@@ -240,17 +235,10 @@ func TestMul(t *testing.T) {
 	}
 }
 
-//go:generate sh -c "perl scripts/f16_mul.pl | gofmt > f16_mul_test.go"
-
 func TestMul_TestFloat(t *testing.T) {
-	for _, tt := range f16Mul {
-		fa := tt.a
-		fb := tt.b
-		got := fa.Mul(fb)
-		if got.Compare(tt.want) != 0 {
-			t.Errorf("%x * %x: expected %x, got %x", tt.a, tt.b, tt.want, got)
-		}
-	}
+	testFloat(t, "f16_mul", func(x []uint64) uint64 {
+		return uint64(Float16(x[0]).Mul(Float16(x[1])))
+	})
 }
 
 func BenchmarkMul(b *testing.B) {
@@ -261,6 +249,14 @@ func BenchmarkMul(b *testing.B) {
 	}
 }
 
+func BenchmarkQuo(b *testing.B) {
+	x := newXorshift32()
+	for i := 0; i < b.N; i++ {
+		fa, fb := x.Float16Pair()
+		runtime.KeepAlive(fa.Quo(fb))
+	}
+}
+
 func BenchmarkMul2(b *testing.B) {
 	x := newXorshift32()
 	for i := 0; i < b.N; i++ {
@@ -360,16 +356,10 @@ func TestQuo(t *testing.T) {
 	}
 }
 
-//go:generate sh -c "perl scripts/f16_div.pl | gofmt > f16_div_test.go"
 func TestQuo_TestFloat(t *testing.T) {
-	for _, tt := range f16Div {
-		fa := tt.a
-		fb := tt.b
-		got := fa.Quo(fb)
-		if got.Compare(tt.want) != 0 {
-			t.Errorf("%x / %x: expected %x, got %x", tt.a, tt.b, tt.want, got)
-		}
-	}
+	testFloat(t, "f16_div", func(x []uint64) uint64 {
+		return uint64(Float16(x[0]).Quo(Float16(x[1])))
+	})
 }
 
 func TestQuo_All(t *testing.T) {
@@ -468,16 +458,10 @@ func TestAdd(t *testing.T) {
 	}
 }
 
-//go:generate sh -c "perl scripts/f16_add.pl | gofmt > f16_add_test.go"
 func TestAdd_TestFloat(t *testing.T) {
-	for _, tt := range f16Add {
-		fa := tt.a
-		fb := tt.b
-		got := fa.Add(fb)
-		if got.Compare(tt.want) != 0 {
-			t.Errorf("%x + %x: expected %x, got %x", tt.a, tt.b, tt.want, got)
-		}
-	}
+	testFloat(t, "f16_add", func(x []uint64) uint64 {
+		return uint64(Float16(x[0]).Add(Float16(x[1])))
+	})
 }
 
 func TestAdd_All(t *testing.T) {
@@ -702,41 +686,201 @@ func TestEq(t *testing.T) {
 	}
 }
 
-//go:generate sh -c "perl scripts/f16_eq.pl | gofmt > f16_eq_test.go"
-
 func TestEq_TestFloat(t *testing.T) {
-	for _, tt := range f16Eq {
-		fa := tt.a
-		fb := tt.b
-		got := fa.Eq(fb)
-		if got != tt.want {
-			t.Errorf("%x == %x: expected %t, got %t", tt.a, tt.b, tt.want, got)
+	testFloat(t, "f16_eq", func(x []uint64) uint64 {
+		return testFloatBool(Float16(x[0]).Eq(Float16(x[1])))
+	})
+}
+
+func TestLt_TestFloat(t *testing.T) {
+	testFloat(t, "f16_lt", func(x []uint64) uint64 {
+		return testFloatBool(Float16(x[0]).Lt(Float16(x[1])))
+	})
+}
+
+func TestLe_TestFloat(t *testing.T) {
+	testFloat(t, "f16_le", func(x []uint64) uint64 {
+		return testFloatBool(Float16(x[0]).Le(Float16(x[1])))
+	})
+}
+
+func TestRemainder_TestFloat(t *testing.T) {
+	testFloat(t, "f16_rem", func(x []uint64) uint64 {
+		return uint64(Float16(x[0]).Remainder(Float16(x[1])))
+	})
+}
+
+// binaryAll returns the function for checkEqual that applies op to Float16 values.
+// NaNs are canonicalized.
+func binaryAll(op func(a, b Float16) Float16) func(a, b uint16) uint16 {
+	return func(a, b uint16) uint16 {
+		c := op(Float16(a), Float16(b))
+		if c.IsNaN() {
+			return uvnan
 		}
+		return c.Bits()
 	}
 }
 
-//go:generate sh -c "perl scripts/f16_lt.pl | gofmt > f16_lt_test.go"
+// binaryAll64 returns the function for checkEqual that applies op to float64 values.
+// op must return the results that are exactly representable in Float16.
+func binaryAll64(op func(a, b float64) float64) func(a, b uint16) uint16 {
+	return func(a, b uint16) uint16 {
+		c := op(Float16(a).Float64(), Float16(b).Float64())
+		if math.IsNaN(c) {
+			return uvnan
+		}
+		return FromFloat64(c).Bits()
+	}
+}
 
-func TestLt_TestFloat(t *testing.T) {
-	for _, tt := range f16Lt {
-		fa := tt.a
-		fb := tt.b
-		got := fa.Lt(fb)
-		if got != tt.want {
-			t.Errorf("%x < %x: expected %t, got %t", tt.a, tt.b, tt.want, got)
+func TestRemainder_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.Remainder), binaryAll64(math.Remainder), "remainder")
+}
+
+func TestMod_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.Mod), binaryAll64(math.Mod), "mod")
+}
+
+func TestDim_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.Dim), binaryAll64(math.Dim), "dim")
+}
+
+// The references of the minimum and maximum operations of IEEE 754-2019.
+
+func refMaximum(a, b float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b):
+		return math.NaN()
+	case a > b:
+		return a
+	case a < b:
+		return b
+	case a == 0 && !math.Signbit(a):
+		return a
+	}
+	return b
+}
+
+func refMinimum(a, b float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b):
+		return math.NaN()
+	case a < b:
+		return a
+	case a > b:
+		return b
+	case a == 0 && math.Signbit(a):
+		return a
+	}
+	return b
+}
+
+// refNumber makes op treat NaNs as missing data.
+func refNumber(op func(a, b float64) float64) func(a, b float64) float64 {
+	return func(a, b float64) float64 {
+		switch {
+		case math.IsNaN(a):
+			return b
+		case math.IsNaN(b):
+			return a
 		}
+		return op(a, b)
 	}
 }
 
-//go:generate sh -c "perl scripts/f16_le.pl | gofmt > f16_le_test.go"
+// refMagnitude makes op compare the magnitudes first.
+func refMagnitude(op func(a, b float64) float64, larger bool) func(a, b float64) float64 {
+	return func(a, b float64) float64 {
+		switch {
+		case math.IsNaN(a) || math.IsNaN(b):
+			return math.NaN()
+		case math.Abs(a) > math.Abs(b):
+			if larger {
+				return a
+			}
+			return b
+		case math.Abs(a) < math.Abs(b):
+			if larger {
+				return b
+			}
+			return a
+		}
+		return op(a, b)
+	}
+}
 
-func TestLe_TestFloat(t *testing.T) {
-	for _, tt := range f16Le {
-		fa := tt.a
-		fb := tt.b
-		got := fa.Le(fb)
+func TestMaximum_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.Maximum), binaryAll64(refMaximum), "maximum")
+}
+
+func TestMinimum_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.Minimum), binaryAll64(refMinimum), "minimum")
+}
+
+func TestMaximumNumber_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.MaximumNumber), binaryAll64(refNumber(refMaximum)), "maximumNumber")
+}
+
+func TestMinimumNumber_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.MinimumNumber), binaryAll64(refNumber(refMinimum)), "minimumNumber")
+}
+
+func TestMaximumMagnitude_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.MaximumMagnitude), binaryAll64(refMagnitude(refMaximum, true)), "maximumMagnitude")
+}
+
+func TestMinimumMagnitude_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.MinimumMagnitude), binaryAll64(refMagnitude(refMinimum, false)), "minimumMagnitude")
+}
+
+func TestMaximumMagnitudeNumber_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.MaximumMagnitudeNumber), binaryAll64(refNumber(refMagnitude(refMaximum, true))), "maximumMagnitudeNumber")
+}
+
+func TestMinimumMagnitudeNumber_All(t *testing.T) {
+	checkEqual(t, binaryAll(Float16.MinimumMagnitudeNumber), binaryAll64(refNumber(refMagnitude(refMinimum, false))), "minimumMagnitudeNumber")
+}
+
+func TestMinMax_Special(t *testing.T) {
+	nan := NaN()
+	pz, nz := Float16(0), Float16(signMask16)
+	one, negOne := Float16(uvone), FromFloat64(-1)
+	tests := []struct {
+		name string
+		op   func(a, b Float16) Float16
+		a, b Float16
+		want Float16
+	}{
+		{"Maximum", Float16.Maximum, pz, nz, pz},
+		{"Maximum", Float16.Maximum, nz, pz, pz},
+		{"Maximum", Float16.Maximum, nz, nz, nz},
+		{"Maximum", Float16.Maximum, one, nan, nan},
+		{"Minimum", Float16.Minimum, pz, nz, nz},
+		{"Minimum", Float16.Minimum, nz, pz, nz},
+		{"Minimum", Float16.Minimum, nan, one, nan},
+		{"MaximumNumber", Float16.MaximumNumber, one, nan, one},
+		{"MaximumNumber", Float16.MaximumNumber, nan, nz, nz},
+		{"MaximumNumber", Float16.MaximumNumber, nan, nan, nan},
+		{"MinimumNumber", Float16.MinimumNumber, nan, one, one},
+		{"MinimumNumber", Float16.MinimumNumber, pz, nz, nz},
+		{"MaximumMagnitude", Float16.MaximumMagnitude, negOne, one, one},
+		{"MaximumMagnitude", Float16.MaximumMagnitude, FromFloat64(-2), one, FromFloat64(-2)},
+		{"MinimumMagnitude", Float16.MinimumMagnitude, negOne, one, negOne},
+		{"MinimumMagnitude", Float16.MinimumMagnitude, FromFloat64(-2), one, one},
+		{"MinimumMagnitudeNumber", Float16.MinimumMagnitudeNumber, nan, negOne, negOne},
+		{"MaximumMagnitudeNumber", Float16.MaximumMagnitudeNumber, FromFloat64(-2), nan, FromFloat64(-2)},
+	}
+	for _, tt := range tests {
+		got := tt.op(tt.a, tt.b)
+		if tt.want.IsNaN() {
+			if !got.IsNaN() {
+				t.Errorf("%s(%v, %v): expected NaN, got %v", tt.name, tt.a, tt.b, got)
+			}
+			continue
+		}
 		if got != tt.want {
-			t.Errorf("%x <= %x: expected %t, got %t", tt.a, tt.b, tt.want, got)
+			t.Errorf("%s(%v, %v): expected %v (%04x), got %v (%04x)", tt.name, tt.a, tt.b, tt.want, uint16(tt.want), got, uint16(got))
 		}
 	}
 }
@@ -766,52 +910,9 @@ func BenchmarkLe(b *testing.B) {
 }
 
 func TestFMA_TestFloat(t *testing.T) {
-	f, err := os.Open("testdata/f16_mulAdd.txt.gz")
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer f.Close()
-
-	r, err := gzip.NewReader(f)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer r.Close()
-
-	s := bufio.NewScanner(r)
-	for s.Scan() {
-		line := s.Text()
-		line = strings.TrimSpace(line)
-		seg := strings.Split(line, " ")
-		if len(seg) < 4 {
-			t.Fatal("invalid test data")
-		}
-		x, err := strconv.ParseUint(seg[0], 16, 16)
-		if err != nil {
-			t.Fatal(err)
-		}
-		y, err := strconv.ParseUint(seg[1], 16, 16)
-		if err != nil {
-			t.Fatal(err)
-		}
-		z, err := strconv.ParseUint(seg[2], 16, 16)
-		if err != nil {
-			t.Fatal(err)
-		}
-		a, err := strconv.ParseUint(seg[3], 16, 16)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		fx := Float16(x)
-		fy := Float16(y)
-		fz := Float16(z)
-		fa := Float16(a)
-		got := FMA(fx, fy, fz)
-		if got.Compare(fa) != 0 {
-			t.Errorf("%x * %x + %x: expected %x, got %x", x, y, z, fa, got)
-		}
-	}
+	testFloat(t, "f16_mulAdd", func(x []uint64) uint64 {
+		return uint64(FMA(Float16(x[0]), Float16(x[1]), Float16(x[2])))
+	})
 }
 
 func BenchmarkFMA(b *testing.B) {
@@ -0,0 +1,600 @@
+package float16
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// NaNEncoding describes how a [Format] encodes NaNs.
+type NaNEncoding uint8
+
+// These constants define supported NaN encodings.
+const (
+	// NaNIEEE reserves the largest exponent as IEEE 754 does.
+	// The codes with a non-zero fraction are NaNs,
+	// and the code with a zero fraction is the infinity if HasInf is true, NaN otherwise.
+	NaNIEEE NaNEncoding = iota
+
+	// NaNAllOnes encodes NaN only as the code with all exponent and fraction bits set,
+	// such as OCP FP8 E4M3. The other codes of the largest exponent are finite.
+	NaNAllOnes
+
+	// NaNNone means that the format has no NaN, such as OCP FP6 and FP4.
+	// All codes are finite.
+	NaNNone
+)
+
+// Format describes a binary floating-point format of at most 16 bits:
+// a sign bit, ExpBits exponent bits and FracBits fraction bits.
+// The exponent bias is 2^(ExpBits-1)-1, and the zero exponent encodes zeros and subnormal numbers.
+//
+// The values of a format are represented by their bit patterns in uint16.
+// The methods of Format round to nearest even.
+// The results that are too large to be represented become infinities if the format has them,
+// otherwise NaN if the format has it, otherwise the largest finite values.
+// The invalid operations, such as 0/0, result in NaN, or +0 if the format has no NaN.
+//
+// ExpBits must be in the range [1, 10], so that every value is exactly representable in float64.
+// FracBits must be positive, and HasInf requires NaNIEEE.
+// The methods panic if the format is invalid.
+//
+// [FormatFloat16] is the format of [Float16], and both round through the same code,
+// except that Add, Sub, Mul and Quo of Float16 have their own faster round-to-nearest-even code.
+type Format struct {
+	ExpBits     int
+	FracBits    int
+	HasInf      bool
+	NaNEncoding NaNEncoding
+}
+
+// The formats that are commonly used.
+var (
+	FormatFloat16  = Format{ExpBits: expBits16, FracBits: fracBits16, HasInf: true, NaNEncoding: NaNIEEE} // IEEE 754 binary16
+	FormatBFloat16 = Format{ExpBits: 8, FracBits: 7, HasInf: true, NaNEncoding: NaNIEEE}                  // bfloat16
+	FormatE5M2     = Format{ExpBits: 5, FracBits: 2, HasInf: true, NaNEncoding: NaNIEEE}                  // OCP FP8 E5M2
+	FormatE4M3     = Format{ExpBits: 4, FracBits: 3, HasInf: false, NaNEncoding: NaNAllOnes}              // OCP FP8 E4M3
+	FormatE3M2     = Format{ExpBits: 3, FracBits: 2, HasInf: false, NaNEncoding: NaNNone}                 // OCP FP6 E3M2
+	FormatE2M3     = Format{ExpBits: 2, FracBits: 3, HasInf: false, NaNEncoding: NaNNone}                 // OCP FP6 E2M3
+	FormatE2M1     = Format{ExpBits: 2, FracBits: 1, HasInf: false, NaNEncoding: NaNNone}                 // OCP FP4 E2M1
+)
+
+// check panics if f is invalid.
+func (f Format) check() {
+	if f.ExpBits < 1 || f.ExpBits > 10 || f.FracBits < 1 || 1+f.ExpBits+f.FracBits > 16 {
+		panic("float16: invalid Format size")
+	}
+	if f.NaNEncoding > NaNNone || f.HasInf && f.NaNEncoding != NaNIEEE {
+		panic("float16: invalid Format encoding")
+	}
+}
+
+// Bits returns the number of bits of f.
+func (f Format) Bits() int {
+	return 1 + f.ExpBits + f.FracBits
+}
+
+// info returns the floatInfo of f.
+// If f uses the largest exponent for finite numbers, the floatInfo has an extra exponent bit
+// to round the numbers beyond the largest exponent, and the sign bit is moved accordingly.
+func (f Format) info() floatInfo {
+	f.check()
+	flt := floatInfo{uint(f.FracBits), uint(f.ExpBits), 1 - 1<<(f.ExpBits-1)}
+	if f.NaNEncoding != NaNIEEE {
+		flt.expbits++
+	}
+	return flt
+}
+
+// signMask returns the mask for the sign bit.
+func (f Format) signMask() uint16 {
+	return 1 << (f.ExpBits + f.FracBits)
+}
+
+// maxFinite returns the bit pattern of the largest finite value.
+func (f Format) maxFinite() uint16 {
+	switch f.NaNEncoding {
+	case NaNIEEE:
+		return (1<<f.ExpBits-1)<<f.FracBits - 1
+	case NaNAllOnes:
+		return f.signMask() - 2
+	}
+	return f.signMask() - 1
+}
+
+// nan returns the NaN, or +0 if f has no NaN.
+func (f Format) nan() uint16 {
+	switch f.NaNEncoding {
+	case NaNIEEE:
+		return (1<<f.ExpBits-1)<<f.FracBits | 1<<(f.FracBits-1)
+	case NaNAllOnes:
+		return f.signMask() - 1
+	}
+	return 0
+}
+
+// overflow returns the magnitude of the results that are too large to be represented.
+func (f Format) overflow() uint16 {
+	switch {
+	case f.HasInf:
+		return f.maxFinite() + 1
+	case f.NaNEncoding != NaNNone:
+		return f.nan()
+	}
+	return f.maxFinite()
+}
+
+// NaN returns the NaN of f.
+// It panics if f has no NaN.
+func (f Format) NaN() uint16 {
+	f.check()
+	if f.NaNEncoding == NaNNone {
+		panic("float16: Format has no NaN")
+	}
+	return f.nan()
+}
+
+// Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+// It panics if f has no infinities.
+func (f Format) Inf(sign int) uint16 {
+	f.check()
+	if !f.HasInf {
+		panic("float16: Format has no infinities")
+	}
+	b := f.maxFinite() + 1
+	if sign < 0 {
+		b |= f.signMask()
+	}
+	return b
+}
+
+// IsNaN reports whether b is a NaN.
+func (f Format) IsNaN(b uint16) bool {
+	b &= f.signMask() - 1
+	switch f.NaNEncoding {
+	case NaNIEEE:
+		if f.HasInf {
+			return b > f.maxFinite()+1
+		}
+		return b > f.maxFinite()
+	case NaNAllOnes:
+		return b == f.signMask()-1
+	}
+	return false
+}
+
+// IsInf reports whether b is an infinity, according to sign.
+// If sign > 0, IsInf reports whether b is positive infinity.
+// If sign < 0, IsInf reports whether b is negative infinity.
+// If sign == 0, IsInf reports whether b is either infinity.
+func (f Format) IsInf(b uint16, sign int) bool {
+	if !f.HasInf || b&^f.signMask() != f.maxFinite()+1 {
+		return false
+	}
+	neg := b&f.signMask() != 0
+	return sign >= 0 && !neg || sign <= 0 && neg
+}
+
+// isZero reports whether b is ±0.
+func (f Format) isZero(b uint16) bool {
+	return b&^f.signMask() == 0
+}
+
+// decode returns the finite value of b as (-1)^neg × mant × 2^exp.
+func (f Format) decode(b uint16) (neg bool, exp int, mant uint64) {
+	neg = b&f.signMask() != 0
+	e := int(b>>f.FracBits) & (1<<f.ExpBits - 1)
+	mant = uint64(b) & (1<<f.FracBits - 1)
+	if e == 0 {
+		// subnormal number
+		e = 1
+	} else {
+		// normal number
+		mant |= 1 << f.FracBits
+	}
+	exp = e - (1<<(f.ExpBits-1) - 1) - f.FracBits
+	return
+}
+
+// round rounds (-1)^neg × mant × 2^exp to f.
+// sticky reports whether non-zero bits below mant have been discarded.
+func (f Format) round(neg bool, exp int, mant uint64, sticky bool) uint16 {
+	var c Context
+	flt := f.info()
+	return f.fromMagnitude(neg, c.roundBits(&flt, false, exp, mant, sticky))
+}
+
+// fromMagnitude returns the value with the sign neg and the magnitude b,
+// which is rounded as if the exponent range were unbounded above.
+func (f Format) fromMagnitude(neg bool, b uint64) uint16 {
+	var sign uint16
+	if neg {
+		sign = f.signMask()
+	}
+	if b > uint64(f.maxFinite()) {
+		return sign | f.overflow()
+	}
+	return sign | uint16(b)
+}
+
+// FromFloat64 returns the value of f nearest to x.
+func (f Format) FromFloat64(x float64) uint16 {
+	flt := f.info()
+	b := math.Float64bits(x)
+	neg := b&signMask64 != 0
+	b &^= signMask64
+	switch {
+	case math.IsNaN(x):
+		return f.nan()
+	case math.IsInf(x, 0):
+		return f.fromMagnitude(neg, math.MaxUint64)
+	}
+	return f.fromMagnitude(neg, narrow(b, &float64info, &flt))
+}
+
+// FromFloat32 returns the value of f nearest to x.
+func (f Format) FromFloat32(x float32) uint16 {
+	// float64 represents x exactly.
+	return f.FromFloat64(float64(x))
+}
+
+// Float64 returns the float64 representation of b.
+// The conversion is exact.
+func (f Format) Float64(b uint16) float64 {
+	flt := f.info()
+	switch {
+	case f.IsNaN(b):
+		return math.NaN()
+	case f.IsInf(b, 1):
+		return math.Inf(1)
+	case f.IsInf(b, -1):
+		return math.Inf(-1)
+	}
+	x := math.Float64frombits(widen(uint64(b&^f.signMask()), &flt, &float64info))
+	if b&f.signMask() != 0 {
+		x = -x
+	}
+	return x
+}
+
+// Float32 returns the float32 nearest to b.
+func (f Format) Float32(b uint16) float32 {
+	return float32(f.Float64(b))
+}
+
+// Add returns the sum of a and b.
+func (f Format) Add(a, b uint16) uint16 {
+	f.check()
+	if f.IsNaN(a) || f.IsNaN(b) {
+		return f.nan()
+	}
+	if f.IsInf(a, 0) {
+		if f.IsInf(b, 0) && a != b {
+			// ±inf + ∓inf = NaN
+			return f.nan()
+		}
+		return a
+	}
+	if f.IsInf(b, 0) {
+		return b
+	}
+	if f.isZero(a) {
+		if f.isZero(b) {
+			// the sum of zeros is -0 only if both are -0.
+			return a & b
+		}
+		return b
+	}
+	if f.isZero(b) {
+		return a
+	}
+
+	negA, expA, mantA := f.decode(a)
+	negB, expB, mantB := f.decode(b)
+	neg, exp, mant := addMant(negA, expA, mantA, negB, expB, mantB, f.FracBits+1)
+	if mant == 0 {
+		return 0
+	}
+	return f.round(neg, exp, mant, false)
+}
+
+// Sub returns the difference of a and b.
+func (f Format) Sub(a, b uint16) uint16 {
+	if f.IsNaN(b) {
+		return f.nan()
+	}
+	return f.Add(a, b^f.signMask())
+}
+
+// Mul returns the product of a and b.
+func (f Format) Mul(a, b uint16) uint16 {
+	f.check()
+	if f.IsNaN(a) || f.IsNaN(b) {
+		return f.nan()
+	}
+
+	neg := (a^b)&f.signMask() != 0
+	if f.IsInf(a, 0) || f.IsInf(b, 0) {
+		if f.isZero(a) || f.isZero(b) {
+			// ±inf * ±0 = NaN
+			return f.nan()
+		}
+		return f.fromMagnitude(neg, math.MaxUint64)
+	}
+	if f.isZero(a) || f.isZero(b) {
+		return f.fromMagnitude(neg, 0)
+	}
+
+	_, expA, mantA := f.decode(a)
+	_, expB, mantB := f.decode(b)
+	return f.round(neg, expA+expB, mantA*mantB, false)
+}
+
+// Quo returns the quotient of a and b.
+func (f Format) Quo(a, b uint16) uint16 {
+	f.check()
+	if f.IsNaN(a) || f.IsNaN(b) {
+		return f.nan()
+	}
+
+	neg := (a^b)&f.signMask() != 0
+	switch {
+	case f.isZero(b):
+		if f.isZero(a) {
+			// ±0 / ±0 = NaN
+			return f.nan()
+		}
+		return f.fromMagnitude(neg, math.MaxUint64)
+	case f.IsInf(a, 0):
+		if f.IsInf(b, 0) {
+			// ±inf / ±inf = NaN
+			return f.nan()
+		}
+		return f.fromMagnitude(neg, math.MaxUint64)
+	case f.IsInf(b, 0) || f.isZero(a):
+		return f.fromMagnitude(neg, 0)
+	}
+
+	// 40 extra bits are enough for the rounding bit and the guard bit.
+	_, expA, mantA := f.decode(a)
+	_, expB, mantB := f.decode(b)
+	n := mantA << 40
+	q, r := n/mantB, n%mantB
+	return f.round(neg, expA-expB-40, q, r != 0)
+}
+
+// Sqrt returns the square root of b.
+func (f Format) Sqrt(b uint16) uint16 {
+	f.check()
+	switch {
+	case f.IsNaN(b):
+		return f.nan()
+	case f.isZero(b) || f.IsInf(b, 1):
+		return b
+	case b&f.signMask() != 0:
+		return f.nan()
+	}
+
+	_, exp, mant := f.decode(b)
+
+	// make mant as large as possible for sqrt64, and the exponent even.
+	shift := 52 - bits.Len64(mant)
+	if (exp-shift)%2 != 0 {
+		shift--
+	}
+	mant <<= shift
+	exp -= shift
+	s := sqrt64(mant)
+	return f.round(false, exp/2, s, s*s != mant)
+}
+
+// FMA returns a * b + c, computed with only one rounding.
+func (f Format) FMA(a, b, c uint16) uint16 {
+	f.check()
+	if f.IsNaN(a) || f.IsNaN(b) || f.IsNaN(c) {
+		return f.nan()
+	}
+
+	neg := (a^b)&f.signMask() != 0
+	zeroAB := f.isZero(a) || f.isZero(b)
+	if f.IsInf(a, 0) || f.IsInf(b, 0) {
+		if zeroAB {
+			// ±inf * ±0 = NaN
+			return f.nan()
+		}
+		if f.IsInf(c, 0) && (c&f.signMask() != 0) != neg {
+			// ±inf - ±inf = NaN
+			return f.nan()
+		}
+		return f.fromMagnitude(neg, math.MaxUint64)
+	}
+	if f.IsInf(c, 0) {
+		return c
+	}
+	if zeroAB {
+		if !f.isZero(c) {
+			return c
+		}
+		// the sum of zeros is -0 only if both are -0.
+		return f.fromMagnitude(neg, 0) & c
+	}
+
+	_, expA, mantA := f.decode(a)
+	_, expB, mantB := f.decode(b)
+	if f.isZero(c) {
+		return f.round(neg, expA+expB, mantA*mantB, false)
+	}
+	negC, expC, mantC := f.decode(c)
+	neg, exp, mant := addMant(neg, expA+expB, mantA*mantB, negC, expC, mantC, f.FracBits+1)
+	if mant == 0 {
+		return 0
+	}
+	return f.round(neg, exp, mant, false)
+}
+
+// addMant returns (-1)^negX × mantX × 2^expX + (-1)^negY × mantY × 2^expY.
+// The result is exact enough to be rounded to prec bits;
+// the smaller operand may be replaced by a tiny value that rounds in the same way.
+// mantX and mantY must be less than 2^31, and prec must be less than 16.
+func addMant(negX bool, expX int, mantX uint64, negY bool, expY int, mantY uint64, prec int) (neg bool, exp int, mant uint64) {
+	// the exponents just above the leading bits.
+	topX := expX + bits.Len64(mantX)
+	topY := expY + bits.Len64(mantY)
+	if topX < topY {
+		negX, expX, mantX, negY, expY, mantY = negY, expY, mantY, negX, expX, mantX
+		topX, topY = topY, topX
+	}
+
+	// The result has its leading bit at topX-2 or above, so its guard bit is at topX-prec-2 or above.
+	// If y is below both of the guard bit and the least significant bit of x,
+	// it only affects the sticky bit.
+	if limit := min(expX, topX-prec-3); topY < limit {
+		expY, mantY = limit-1, 1
+	}
+
+	// align the operands.
+	if expX > expY {
+		mantX <<= expX - expY
+		exp = expY
+	} else {
+		mantY <<= expY - expX
+		exp = expX
+	}
+
+	switch {
+	case negX == negY:
+		return negX, exp, mantX + mantY
+	case mantX >= mantY:
+		return negX, exp, mantX - mantY
+	default:
+		return negY, exp, mantY - mantX
+	}
+}
+
+// Compare compares a and b and returns:
+//
+//	-1 if a <  b
+//	 0 if a == b (incl. -0 == 0, -Inf == -Inf, and +Inf == +Inf)
+//	+1 if a >  b
+//
+// a NaN is considered less than any non-NaN, and two NaNs are equal.
+func (f Format) Compare(a, b uint16) int {
+	aNaN := f.IsNaN(a)
+	bNaN := f.IsNaN(b)
+	if aNaN && bNaN {
+		return 0
+	}
+	if aNaN {
+		return -1
+	}
+	if bNaN {
+		return 1
+	}
+
+	ia := f.comparable(a)
+	ib := f.comparable(b)
+	if ia < ib {
+		return -1
+	}
+	if ia > ib {
+		return 1
+	}
+	return 0
+}
+
+// comparable converts b to a comparable form.
+func (f Format) comparable(b uint16) int32 {
+	i := int32(b &^ f.signMask())
+	if b&f.signMask() != 0 {
+		return -i
+	}
+	return i
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+func (f Format) Eq(a, b uint16) bool {
+	if f.IsNaN(a) || f.IsNaN(b) {
+		return false
+	}
+	return f.comparable(a) == f.comparable(b)
+}
+
+// Lt returns a < b.
+// It returns false if a or b is NaN.
+func (f Format) Lt(a, b uint16) bool {
+	if f.IsNaN(a) || f.IsNaN(b) {
+		return false
+	}
+	return f.comparable(a) < f.comparable(b)
+}
+
+// Le returns a <= b.
+// It returns false if a or b is NaN.
+func (f Format) Le(a, b uint16) bool {
+	if f.IsNaN(a) || f.IsNaN(b) {
+		return false
+	}
+	return f.comparable(a) <= f.comparable(b)
+}
+
+// Parse converts the string s to the nearest value of f.
+// It accepts the same syntax as [Parse].
+// If s is syntactically well-formed but is out of the range of f,
+// Parse returns the value for overflows described in [Format] and err.Err = ErrRange.
+// A non-zero value that underflows to zero returns ±0 and err.Err = ErrRange.
+// If f has no NaN, "NaN" is a syntax error.
+func (f Format) Parse(s string) (uint16, error) {
+	return f.parse(s, "float16.Format.Parse")
+}
+
+// parse is the same as Parse, but fn is the name of the function reported in errors.
+func (f Format) parse(s string, fn string) (uint16, error) {
+	var c Context
+	flt := f.info()
+	b, err := parseBits(s, &flt, fn, &c)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return 0, err
+	}
+
+	signMask := uint64(1) << (flt.expbits + flt.mantbits)
+	inf := uint64(1<<flt.expbits-1) << flt.mantbits
+	neg := b&signMask != 0
+	b &^= signMask
+	switch {
+	case b > inf:
+		// NaN
+		if f.NaNEncoding == NaNNone {
+			return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+		}
+		return f.nan(), nil
+	case b == inf && f.HasInf:
+		// the infinity, or an overflow that parseBits has already reported.
+		return f.fromMagnitude(neg, b), err
+	case b > uint64(f.maxFinite()):
+		return f.fromMagnitude(neg, b), &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrRange}
+	}
+	// a finite value, or an underflow that parseBits has already reported.
+	return f.fromMagnitude(neg, b), err
+}
+
+// Text converts b to a string, in the same way as [strconv.FormatFloat].
+func (f Format) Text(b uint16, fmt byte, prec int) string {
+	return string(f.Append(make([]byte, 0, 8), b, fmt, prec))
+}
+
+// Append appends the string form of b, as generated by f.Text, to buf
+// and returns the extended buffer.
+func (f Format) Append(buf []byte, b uint16, fmt byte, prec int) []byte {
+	flt := f.info()
+	if f.IsNaN(b) {
+		return append(buf, "NaN"...)
+	}
+
+	// move the sign bit for flt.
+	x := uint64(b &^ f.signMask())
+	if b&f.signMask() != 0 {
+		x |= 1 << (flt.expbits + flt.mantbits)
+	}
+	return genericFtoa(buf, x, fmt, prec, &flt)
+}
@@ -0,0 +1,428 @@
+package float16
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// smallFormats are the formats that are small enough to be tested exhaustively.
+var smallFormats = []Format{
+	FormatE2M1,
+	FormatE2M3,
+	FormatE3M2,
+	FormatE4M3,
+	FormatE5M2,
+	{ExpBits: 1, FracBits: 2, HasInf: false, NaNEncoding: NaNNone},
+	{ExpBits: 3, FracBits: 1, HasInf: false, NaNEncoding: NaNIEEE},
+	{ExpBits: 3, FracBits: 3, HasInf: true, NaNEncoding: NaNIEEE},
+	{ExpBits: 2, FracBits: 2, HasInf: false, NaNEncoding: NaNAllOnes},
+}
+
+// formatCodes returns all the codes of f.
+func formatCodes(f Format) []uint16 {
+	codes := make([]uint16, 1<<f.Bits())
+	for i := range codes {
+		codes[i] = uint16(i)
+	}
+	return codes
+}
+
+// sameFormat reports whether a and b are the same, treating all NaNs as equal.
+func sameFormat(f Format, a, b uint16) bool {
+	return a == b || f.IsNaN(a) && f.IsNaN(b)
+}
+
+// formatValues returns the magnitudes of the codes from zero to the largest finite value of f,
+// and the value of the next code as if the exponent range were unbounded.
+// It doesn't depend on the implementation of Format except maxFinite.
+func formatValues(f Format) []*big.Float {
+	bias := 1<<(f.ExpBits-1) - 1
+	values := make([]*big.Float, int(f.maxFinite())+2)
+	for code := range values {
+		exp := code >> f.FracBits
+		mant := int64(code & (1<<f.FracBits - 1))
+		if exp == 0 {
+			exp = 1
+		} else {
+			mant |= 1 << f.FracBits
+		}
+		values[code] = new(big.Float).SetMantExp(new(big.Float).SetInt64(mant), exp-bias-f.FracBits)
+	}
+	return values
+}
+
+// refRound is a reference implementation of rounding.
+// It returns the code of f nearest to x, rounding ties to even codes.
+func refRound(f Format, values []*big.Float, x *big.Float) uint16 {
+	var sign uint16
+	if x.Signbit() {
+		sign = f.signMask()
+	}
+	ax := new(big.Float).Abs(x)
+	code := 0
+	if ax.Cmp(values[len(values)-1]) >= 0 {
+		code = len(values) - 1
+	} else {
+		for values[code+1].Cmp(ax) <= 0 {
+			code++
+		}
+		mid := new(big.Float).SetPrec(256).Add(values[code], values[code+1])
+		mid.Quo(mid, big.NewFloat(2))
+		if c := ax.Cmp(mid); c > 0 || c == 0 && code%2 == 1 {
+			code++
+		}
+	}
+
+	if code < len(values)-1 {
+		return sign | uint16(code)
+	}
+
+	// overflow
+	switch {
+	case f.HasInf:
+		return sign | (f.maxFinite() + 1)
+	case f.NaNEncoding != NaNNone:
+		return f.nan()
+	}
+	return sign | f.maxFinite()
+}
+
+func TestFormat_Float64(t *testing.T) {
+	for _, f := range smallFormats {
+		values := formatValues(f)
+		for _, b := range formatCodes(f) {
+			got := f.Float64(b)
+			switch {
+			case f.IsNaN(b):
+				if !math.IsNaN(got) {
+					t.Errorf("%v: %02x: expected NaN, got %v", f, b, got)
+				}
+				continue
+			case f.IsInf(b, 0):
+				if !math.IsInf(got, 0) || math.Signbit(got) != (b&f.signMask() != 0) {
+					t.Errorf("%v: %02x: expected infinity, got %v", f, b, got)
+				}
+				continue
+			}
+			want, _ := values[b&^f.signMask()].Float64()
+			if b&f.signMask() != 0 {
+				want = -want
+			}
+			if math.Float64bits(got) != math.Float64bits(want) {
+				t.Errorf("%v: %02x: expected %v, got %v", f, b, want, got)
+			}
+		}
+	}
+}
+
+func TestFormat_FromFloat64(t *testing.T) {
+	for _, f := range smallFormats {
+		values := formatValues(f)
+		inputs := []float64{0, math.SmallestNonzeroFloat64, math.MaxFloat64, 1e300, 1e-300}
+		for _, v := range values {
+			x, _ := v.Float64()
+			inputs = append(inputs, x, math.Nextafter(x, 0), math.Nextafter(x, math.Inf(1)))
+		}
+		for i := 0; i+1 < len(values); i++ {
+			// the midpoints
+			x, _ := values[i].Float64()
+			y, _ := values[i+1].Float64()
+			mid := (x + y) / 2
+			inputs = append(inputs, mid, math.Nextafter(mid, 0), math.Nextafter(mid, math.Inf(1)))
+		}
+
+		for _, x := range inputs {
+			for _, x := range []float64{x, -x} {
+				want := refRound(f, values, big.NewFloat(x))
+				if got := f.FromFloat64(x); !sameFormat(f, got, want) {
+					t.Errorf("%v: %v: expected %02x, got %02x", f, x, want, got)
+				}
+			}
+		}
+
+		// the special values
+		if got := f.FromFloat64(math.NaN()); !f.IsNaN(got) && !(f.NaNEncoding == NaNNone && got == 0) {
+			t.Errorf("%v: NaN: got %02x", f, got)
+		}
+		for _, sign := range []int{1, -1} {
+			want := refRound(f, values, new(big.Float).SetInf(sign < 0))
+			if got := f.FromFloat64(math.Inf(sign)); !sameFormat(f, got, want) {
+				t.Errorf("%v: %v: expected %02x, got %02x", f, math.Inf(sign), want, got)
+			}
+		}
+	}
+}
+
+// The arithmetic on float64 rounds correctly to 53 bits,
+// and it is enough for rounding to the small formats once again.
+// So the float64 operations followed by FromFloat64 are references of the operations of the small formats.
+
+func TestFormat_Arithmetic(t *testing.T) {
+	for _, f := range smallFormats {
+		codes := formatCodes(f)
+		for _, a := range codes {
+			fa := f.Float64(a)
+			for _, b := range codes {
+				fb := f.Float64(b)
+				if got, want := f.Add(a, b), f.FromFloat64(fa+fb); !sameFormat(f, got, want) {
+					t.Errorf("%v: %02x + %02x: expected %02x, got %02x", f, a, b, want, got)
+				}
+				if got, want := f.Sub(a, b), f.FromFloat64(fa-fb); !sameFormat(f, got, want) {
+					t.Errorf("%v: %02x - %02x: expected %02x, got %02x", f, a, b, want, got)
+				}
+				if got, want := f.Mul(a, b), f.FromFloat64(fa*fb); !sameFormat(f, got, want) {
+					t.Errorf("%v: %02x * %02x: expected %02x, got %02x", f, a, b, want, got)
+				}
+				if got, want := f.Quo(a, b), f.FromFloat64(fa/fb); !sameFormat(f, got, want) {
+					t.Errorf("%v: %02x / %02x: expected %02x, got %02x", f, a, b, want, got)
+				}
+			}
+			if got, want := f.Sqrt(a), f.FromFloat64(math.Sqrt(fa)); !sameFormat(f, got, want) {
+				t.Errorf("%v: sqrt(%02x): expected %02x, got %02x", f, a, want, got)
+			}
+		}
+	}
+}
+
+func TestFormat_FMA(t *testing.T) {
+	for _, f := range smallFormats {
+		if f.Bits() > 6 && testing.Short() {
+			continue
+		}
+		values := formatValues(f)
+		codes := formatCodes(f)
+		for _, a := range codes {
+			fa := f.Float64(a)
+			for _, b := range codes {
+				fb := f.Float64(b)
+				for _, c := range codes {
+					fc := f.Float64(c)
+					got := f.FMA(a, b, c)
+
+					var want uint16
+					r := math.FMA(fa, fb, fc)
+					if math.IsNaN(r) || math.IsInf(r, 0) || r == 0 {
+						// math.FMA handles the special values.
+						want = f.FromFloat64(r)
+					} else {
+						// avoid rounding twice.
+						x := new(big.Float).SetPrec(1024).SetFloat64(fa)
+						x.Mul(x, big.NewFloat(fb))
+						x.Add(x, big.NewFloat(fc))
+						want = refRound(f, values, x)
+					}
+					if !sameFormat(f, got, want) {
+						t.Errorf("%v: fma(%02x, %02x, %02x): expected %02x, got %02x", f, a, b, c, want, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestFormat_Compare(t *testing.T) {
+	for _, f := range smallFormats {
+		codes := formatCodes(f)
+		for _, a := range codes {
+			fa := f.Float64(a)
+			for _, b := range codes {
+				fb := f.Float64(b)
+				if got, want := f.Eq(a, b), fa == fb; got != want {
+					t.Errorf("%v: %02x == %02x: expected %t, got %t", f, a, b, want, got)
+				}
+				if got, want := f.Lt(a, b), fa < fb; got != want {
+					t.Errorf("%v: %02x < %02x: expected %t, got %t", f, a, b, want, got)
+				}
+				if got, want := f.Le(a, b), fa <= fb; got != want {
+					t.Errorf("%v: %02x <= %02x: expected %t, got %t", f, a, b, want, got)
+				}
+				want := 0
+				switch {
+				case math.IsNaN(fa) && math.IsNaN(fb):
+				case math.IsNaN(fa) || fa < fb:
+					want = -1
+				case math.IsNaN(fb) || fa > fb:
+					want = 1
+				}
+				if got := f.Compare(a, b); got != want {
+					t.Errorf("%v: compare(%02x, %02x): expected %d, got %d", f, a, b, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestFormat_Float16(t *testing.T) {
+	f := FormatFloat16
+	if f.info() != float16info || f.NaN() != uvnan || f.Inf(1) != uvinf || f.Inf(-1) != uvneginf {
+		t.Errorf("FormatFloat16 doesn't agree with the constants of Float16")
+	}
+	for i := 0; i < 1<<16; i++ {
+		b := uint16(i)
+		x := Float16(b)
+		if got, want := f.Float64(b), x.Float64(); math.Float64bits(got) != math.Float64bits(want) && !(math.IsNaN(got) && math.IsNaN(want)) {
+			t.Errorf("%04x: expected %v, got %v", b, want, got)
+		}
+		if got, want := f.Sqrt(b), uint16(x.Sqrt()); !sameFormat(f, got, want) {
+			t.Errorf("sqrt(%04x): expected %04x, got %04x", b, want, got)
+		}
+		if got, want := f.Text(b, 'e', 5), x.Text('e', 5); got != want {
+			t.Errorf("%04x: expected %q, got %q", b, want, got)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		a := uint16(r.Uint32())
+		b := uint16(r.Uint32())
+		c := uint16(r.Uint32())
+		x, y, z := Float16(a), Float16(b), Float16(c)
+		if got, want := f.Add(a, b), uint16(x.Add(y)); !sameFormat(f, got, want) {
+			t.Errorf("%04x + %04x: expected %04x, got %04x", a, b, want, got)
+		}
+		if got, want := f.Mul(a, b), uint16(x.Mul(y)); !sameFormat(f, got, want) {
+			t.Errorf("%04x * %04x: expected %04x, got %04x", a, b, want, got)
+		}
+		if got, want := f.Quo(a, b), uint16(x.Quo(y)); !sameFormat(f, got, want) {
+			t.Errorf("%04x / %04x: expected %04x, got %04x", a, b, want, got)
+		}
+		if got, want := f.FMA(a, b, c), uint16(FMA(x, y, z)); !sameFormat(f, got, want) {
+			t.Errorf("fma(%04x, %04x, %04x): expected %04x, got %04x", a, b, c, want, got)
+		}
+		if got, want := f.Compare(a, b), x.Compare(y); got != want {
+			t.Errorf("compare(%04x, %04x): expected %d, got %d", a, b, want, got)
+		}
+
+		v := math.Float64frombits(r.Uint64() >> r.Intn(64))
+		if got, want := f.FromFloat64(v), uint16(FromFloat64(v)); !sameFormat(f, got, want) {
+			t.Errorf("%v: expected %04x, got %04x", v, want, got)
+		}
+	}
+}
+
+func TestFormat_BFloat16(t *testing.T) {
+	f := FormatBFloat16
+	testFloat(t, "bf16_add", func(x []uint64) uint64 { return uint64(f.Add(uint16(x[0]), uint16(x[1]))) })
+	testFloat(t, "bf16_sub", func(x []uint64) uint64 { return uint64(f.Sub(uint16(x[0]), uint16(x[1]))) })
+	testFloat(t, "bf16_mul", func(x []uint64) uint64 { return uint64(f.Mul(uint16(x[0]), uint16(x[1]))) })
+	testFloat(t, "bf16_div", func(x []uint64) uint64 { return uint64(f.Quo(uint16(x[0]), uint16(x[1]))) })
+	testFloat(t, "bf16_sqrt", func(x []uint64) uint64 { return uint64(f.Sqrt(uint16(x[0]))) })
+	testFloat(t, "bf16_mulAdd", func(x []uint64) uint64 {
+		return uint64(f.FMA(uint16(x[0]), uint16(x[1]), uint16(x[2])))
+	})
+	testFloat(t, "f64_to_bf16", func(x []uint64) uint64 { return uint64(f.FromFloat64(math.Float64frombits(x[0]))) })
+	for i := 0; i < 1<<16; i++ {
+		b := uint16(i)
+		if got, want := f.Text(b, 'g', -1), BFloat16(b).String(); got != want {
+			t.Errorf("%04x: expected %q, got %q", b, want, got)
+		}
+	}
+}
+
+func TestFormat_Float8(t *testing.T) {
+	for i := 0; i < 1<<8; i++ {
+		b := uint16(i)
+		if got, want := FormatE4M3.Float64(b), E4M3(b).Float64(); math.Float64bits(got) != math.Float64bits(want) && !(math.IsNaN(got) && math.IsNaN(want)) {
+			t.Errorf("E4M3 %02x: expected %v, got %v", b, want, got)
+		}
+		if got, want := FormatE5M2.Float64(b), E5M2(b).Float64(); math.Float64bits(got) != math.Float64bits(want) && !(math.IsNaN(got) && math.IsNaN(want)) {
+			t.Errorf("E5M2 %02x: expected %v, got %v", b, want, got)
+		}
+	}
+	for i := 0; i < 1<<16; i++ {
+		x := Float16(i).Float64()
+		if got, want := FormatE4M3.FromFloat64(x), uint16(E4M3FromFloat64(x, false)); !sameFormat(FormatE4M3, got, want) {
+			t.Errorf("E4M3 %v: expected %02x, got %02x", x, want, got)
+		}
+		if got, want := FormatE5M2.FromFloat64(x), uint16(E5M2FromFloat64(x, false)); !sameFormat(FormatE5M2, got, want) {
+			t.Errorf("E5M2 %v: expected %02x, got %02x", x, want, got)
+		}
+	}
+}
+
+func TestFormat_Text_RoundTrip(t *testing.T) {
+	for _, f := range smallFormats {
+		for _, b := range formatCodes(f) {
+			for _, fmt := range []byte{'e', 'f', 'g', 'x'} {
+				s := f.Text(b, fmt, -1)
+				got, err := f.Parse(s)
+				if err != nil {
+					t.Errorf("%v: %02x: %q: %v", f, b, s, err)
+					continue
+				}
+				if !sameFormat(f, got, b) {
+					t.Errorf("%v: %02x: %q: got %02x", f, b, s, got)
+				}
+			}
+		}
+	}
+}
+
+func TestFormat_Parse(t *testing.T) {
+	tests := []struct {
+		f    Format
+		s    string
+		want uint16
+		err  error
+	}{
+		{FormatE2M1, "6", 0x07, nil},
+		{FormatE2M1, "-0.25", 0x08, strconv.ErrRange}, // underflows to -0
+		{FormatE2M1, "0.26", 0x01, nil},
+		{FormatE2M1, "5", 0x06, nil},
+		{FormatE2M1, "6.9", 0x07, nil},
+		{FormatE2M1, "7", 0x07, strconv.ErrRange},
+		{FormatE2M1, "-1e9", 0x0f, strconv.ErrRange},
+		{FormatE2M1, "inf", 0x07, strconv.ErrRange},
+		{FormatE2M1, "NaN", 0, strconv.ErrSyntax},
+		{FormatE2M3, "7.5", 0x1f, nil},
+		{FormatE2M3, "0.125", 0x01, nil},
+		{FormatE3M2, "28", 0x1f, nil},
+		{FormatE3M2, "0.0625", 0x01, nil},
+		{FormatE3M2, "32", 0x1f, strconv.ErrRange},
+		{FormatE4M3, "464", 0x7e, nil},
+		{FormatE4M3, "465", 0x7f, strconv.ErrRange},
+		{FormatE4M3, "nan", 0x7f, nil},
+		{FormatE5M2, "-inf", 0xfc, nil},
+		{FormatE5M2, "1e6", 0x7c, strconv.ErrRange},
+		{FormatBFloat16, "1", 0x3f80, nil},
+		{FormatFloat16, "65520", 0x7c00, strconv.ErrRange},
+		{FormatFloat16, "1x", 0, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		got, err := tt.f.Parse(tt.s)
+		if !sameFormat(tt.f, got, tt.want) {
+			t.Errorf("%v: %q: expected %04x, got %04x", tt.f, tt.s, tt.want, got)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%v: %q: expected error %v, got %v", tt.f, tt.s, tt.err, err)
+		}
+		if err != nil && err.(*strconv.NumError).Func != "float16.Format.Parse" {
+			t.Errorf("%v: %q: unexpected error %v", tt.f, tt.s, err)
+		}
+	}
+}
+
+func TestFormat_Invalid(t *testing.T) {
+	tests := []Format{
+		{ExpBits: 0, FracBits: 3},
+		{ExpBits: 11, FracBits: 3},
+		{ExpBits: 4, FracBits: 0},
+		{ExpBits: 8, FracBits: 8},
+		{ExpBits: 4, FracBits: 3, HasInf: true, NaNEncoding: NaNAllOnes},
+		{ExpBits: 4, FracBits: 3, NaNEncoding: 3},
+	}
+	for _, f := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v: expected panic", f)
+				}
+			}()
+			f.Add(0, 0)
+		}()
+	}
+}
@@ -0,0 +1,53 @@
+package float16
+
+// quietBit16 is the most significant bit of the fraction,
+// which distinguishes quiet NaNs from signaling NaNs.
+const quietBit16 = 1 << (shift16 - 1)
+
+// payloadMask16 is the mask for the payload of NaNs.
+const payloadMask16 = quietBit16 - 1
+
+// NaNWithPayload returns a NaN whose payload is the low 9 bits of payload.
+// If signaling is true, it returns a signaling NaN, otherwise a quiet NaN.
+// It panics if signaling is true and the payload is zero,
+// because the bit pattern is an infinity.
+func NaNWithPayload(payload uint16, signaling bool) Float16 {
+	payload &= payloadMask16
+	if signaling {
+		if payload == 0 {
+			panic("float16: signaling NaN with zero payload")
+		}
+		return Float16(uvinf | payload)
+	}
+	return Float16(uvnan | payload)
+}
+
+// IsSignalingNaN reports whether f is a signaling NaN.
+func (f Float16) IsSignalingNaN() bool {
+	return f.IsNaN() && f&quietBit16 == 0
+}
+
+// Payload returns the payload of the NaN f,
+// which is the fraction without the bit distinguishing quiet NaNs from signaling NaNs.
+// If f is not a NaN, it returns 0.
+func (f Float16) Payload() uint16 {
+	if !f.IsNaN() {
+		return 0
+	}
+	return uint16(f & payloadMask16)
+}
+
+// quiet returns the quiet NaN with the same sign and payload as the NaN f.
+func (f Float16) quiet() Float16 {
+	return f | quietBit16
+}
+
+// propagateNaN returns the result of an operation whose operands include NaN.
+// As IEEE 754 recommends, the result is the first NaN operand, quieted,
+// so its sign and payload are preserved.
+func propagateNaN(a, b Float16) Float16 {
+	if a.IsNaN() {
+		return a.quiet()
+	}
+	return b.quiet()
+}
@@ -0,0 +1,150 @@
+package float16
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNaNWithPayload(t *testing.T) {
+	for payload := uint16(0); payload <= payloadMask16; payload++ {
+		q := NaNWithPayload(payload, false)
+		if !q.IsNaN() || q.IsSignalingNaN() || q.Payload() != payload || q.Signbit() {
+			t.Errorf("%03x: unexpected quiet NaN %04x", payload, uint16(q))
+		}
+		if payload == 0 {
+			continue
+		}
+		s := NaNWithPayload(payload, true)
+		if !s.IsNaN() || !s.IsSignalingNaN() || s.Payload() != payload || s.Signbit() {
+			t.Errorf("%03x: unexpected signaling NaN %04x", payload, uint16(s))
+		}
+		if s.quiet() != q {
+			t.Errorf("%03x: expected %04x, got %04x", payload, uint16(q), uint16(s.quiet()))
+		}
+	}
+
+	if got := NaNWithPayload(0xffff, false); got != 0x7fff {
+		t.Errorf("expected 7fff, got %04x", uint16(got))
+	}
+	if NaN().Payload() != 0 || NaN().IsSignalingNaN() {
+		t.Errorf("unexpected NaN()")
+	}
+	if Inf(1).Payload() != 0 || Inf(1).IsSignalingNaN() || FromFloat64(1).Payload() != 0 {
+		t.Errorf("unexpected payload of numbers")
+	}
+}
+
+func TestNaNWithPayload_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	NaNWithPayload(0x200, true)
+}
+
+func TestNaN_Conversion(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		if !x.IsNaN() {
+			continue
+		}
+		q := x.quiet()
+
+		f32 := x.Float32()
+		want32 := uint32(x&signMask16)<<16 | mask32<<shift32 | uint32(q&fracMask16)<<(shift32-shift16)
+		if got := math.Float32bits(f32); got != want32 {
+			t.Errorf("%04x: Float32: expected %08x, got %08x", i, want32, got)
+		}
+		if got := FromFloat32(f32); got != q {
+			t.Errorf("%04x: FromFloat32: expected %04x, got %04x", i, uint16(q), uint16(got))
+		}
+
+		f64 := x.Float64()
+		want64 := uint64(x&signMask16)<<48 | mask64<<shift64 | uint64(q&fracMask16)<<(shift64-shift16)
+		if got := math.Float64bits(f64); got != want64 {
+			t.Errorf("%04x: Float64: expected %016x, got %016x", i, want64, got)
+		}
+		if got := FromFloat64(f64); got != q {
+			t.Errorf("%04x: FromFloat64: expected %04x, got %04x", i, uint16(q), uint16(got))
+		}
+	}
+
+	// the low-order bits of the payload are dropped.
+	f := math.Float64frombits(0xfff4_0000_0000_0001) // signaling NaN
+	if got := FromFloat64(f); got != 0xff00 {
+		t.Errorf("expected ff00, got %04x", uint16(got))
+	}
+	var c Context
+	if got := c.FromFloat64(f); got != 0xff00 || c.Flags != Invalid {
+		t.Errorf("expected ff00 (Invalid), got %04x (%v)", uint16(got), c.Flags)
+	}
+}
+
+func TestNaN_Propagation(t *testing.T) {
+	nans := []Float16{
+		NaNWithPayload(0x123, false),
+		NaNWithPayload(0x045, true),
+		NaNWithPayload(0x1ff, true) | signMask16,
+		NaN() | signMask16,
+	}
+	one := FromFloat64(1)
+	binary := map[string]func(a, b Float16) Float16{
+		"Add":              Float16.Add,
+		"Sub":              Float16.Sub,
+		"Mul":              Float16.Mul,
+		"Quo":              Float16.Quo,
+		"Remainder":        Float16.Remainder,
+		"Mod":              Float16.Mod,
+		"Maximum":          Float16.Maximum,
+		"Minimum":          Float16.Minimum,
+		"MaximumMagnitude": Float16.MaximumMagnitude,
+		"MinimumMagnitude": Float16.MinimumMagnitude,
+		"FMA(a, 1, b)":     func(a, b Float16) Float16 { return FMA(a, one, b) },
+		"FMA(1, a, b)":     func(a, b Float16) Float16 { return FMA(one, a, b) },
+		"Context.Add":      func(a, b Float16) Float16 { return new(Context).Add(a, b) },
+		"Context.Sub":      func(a, b Float16) Float16 { return new(Context).Sub(a, b) },
+		"Context.Mul":      func(a, b Float16) Float16 { return new(Context).Mul(a, b) },
+		"Context.Quo":      func(a, b Float16) Float16 { return new(Context).Quo(a, b) },
+		"Context.FMA":      func(a, b Float16) Float16 { return new(Context).FMA(a, one, b) },
+	}
+	for name, op := range binary {
+		for _, a := range nans {
+			// the NaN operand is propagated.
+			if got, want := op(a, one), a.quiet(); got != want {
+				t.Errorf("%s(%04x, 1): expected %04x, got %04x", name, uint16(a), uint16(want), uint16(got))
+			}
+			if got, want := op(one, a), a.quiet(); got != want {
+				t.Errorf("%s(1, %04x): expected %04x, got %04x", name, uint16(a), uint16(want), uint16(got))
+			}
+
+			// the first one is propagated if both are NaN.
+			for _, b := range nans {
+				if got, want := op(a, b), a.quiet(); got != want {
+					t.Errorf("%s(%04x, %04x): expected %04x, got %04x", name, uint16(a), uint16(b), uint16(want), uint16(got))
+				}
+			}
+		}
+	}
+
+	for _, a := range nans {
+		if got, want := a.Sqrt(), a.quiet(); got != want {
+			t.Errorf("Sqrt(%04x): expected %04x, got %04x", uint16(a), uint16(want), uint16(got))
+		}
+		var c Context
+		if got, want := c.Sqrt(a), a.quiet(); got != want {
+			t.Errorf("Context.Sqrt(%04x): expected %04x, got %04x", uint16(a), uint16(want), uint16(got))
+		}
+		if (c.Flags == Invalid) != a.IsSignalingNaN() {
+			t.Errorf("Context.Sqrt(%04x): unexpected flags %v", uint16(a), c.Flags)
+		}
+	}
+
+	// invalid operations return the default NaN.
+	if got := Inf(1).Sub(Inf(1)); got != uvnan {
+		t.Errorf("Inf - Inf: expected %04x, got %04x", uvnan, uint16(got))
+	}
+	if got := FromFloat64(-1).Sqrt(); got != uvnan {
+		t.Errorf("Sqrt(-1): expected %04x, got %04x", uvnan, uint16(got))
+	}
+}
@@ -0,0 +1,24 @@
+package float16
+
+// Nextafter returns the next representable Float16 value after x towards y.
+//
+// Special cases are:
+//
+//	Nextafter(x, x)   = x
+//	Nextafter(NaN, y) = NaN
+//	Nextafter(x, NaN) = NaN
+func (x Float16) Nextafter(y Float16) Float16 {
+	switch {
+	case x.IsNaN() || y.IsNaN():
+		return propagateNaN(x, y)
+	case x.Eq(y):
+		return x
+	case x&^signMask16 == 0:
+		// the smallest subnormal number with the sign of y
+		return y&signMask16 | 1
+	case y.Gt(x) == (x&signMask16 == 0):
+		return x + 1
+	default:
+		return x - 1
+	}
+}
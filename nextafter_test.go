@@ -0,0 +1,75 @@
+package float16
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestNextafter(t *testing.T) {
+	// all non-NaN Float16 values in ascending order, as the reference.
+	var values []float64
+	for i := 0; i < 0x10000; i++ {
+		if x := Float16(i); !x.IsNaN() && x != signMask16 {
+			values = append(values, x.Float64())
+		}
+	}
+	sort.Float64s(values)
+	ref := func(x, y float64) float64 {
+		if math.IsNaN(x) || math.IsNaN(y) {
+			return math.NaN()
+		}
+		if x == y {
+			return x
+		}
+		i := sort.SearchFloat64s(values, x)
+		var r float64
+		if x < y {
+			r = values[i+1]
+		} else {
+			r = values[i-1]
+		}
+		if r == 0 {
+			// the zero has the same sign as x, as math.Nextafter does.
+			r = math.Copysign(0, x)
+		}
+		return r
+	}
+
+	ys := []Float16{0x0000, 0x8000, 0x3c00, 0xbc00, 0x0001, 0x8001, uvinf, uvneginf, uvnan}
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		for _, y := range append(ys, x, x+1, x-1) {
+			got := x.Nextafter(y)
+			fx, fy := x.Float64(), y.Float64()
+			want := FromFloat64(ref(fx, fy))
+			if fx == 0 && fy != 0 && !y.IsNaN() {
+				want = FromFloat64(math.Copysign(0x1p-24, fy))
+			}
+			if got != want && !(got.IsNaN() && want.IsNaN()) {
+				t.Errorf("Nextafter(%04x, %04x): expected %04x, got %04x", uint16(x), uint16(y), uint16(want), uint16(got))
+			}
+		}
+	}
+}
+
+func TestNextafter_NaN(t *testing.T) {
+	qnan := NaNWithPayload(0x123, false) | signMask16
+	snan := NaNWithPayload(0x045, true)
+	tests := []struct {
+		x, y Float16
+		want Float16
+	}{
+		{qnan, uvone, qnan},
+		{uvone, qnan, qnan},
+		{snan, uvone, snan.quiet()},
+		{uvone, snan, snan.quiet()},
+		{qnan, snan, qnan},
+		{snan, qnan, snan.quiet()},
+	}
+	for _, tt := range tests {
+		if got := tt.x.Nextafter(tt.y); got != tt.want {
+			t.Errorf("Nextafter(%04x, %04x): expected %04x, got %04x", uint16(tt.x), uint16(tt.y), uint16(tt.want), uint16(got))
+		}
+	}
+}
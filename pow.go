@@ -0,0 +1,106 @@
+package float16
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Pow returns x**y, the base-x exponential of y.
+// The result is correctly rounded if x**y is exactly representable in float64,
+// and the error is less than 1 ulp otherwise.
+//
+// Special cases are (in order):
+//
+//	Pow(x, ±0) = 1 for any x
+//	Pow(1, y) = 1 for any y
+//	Pow(x, 1) = x for any x
+//	Pow(NaN, y) = NaN
+//	Pow(x, NaN) = NaN
+//	Pow(±0, y) = ±Inf for y an odd integer < 0
+//	Pow(±0, -Inf) = +Inf
+//	Pow(±0, +Inf) = +0
+//	Pow(±0, y) = +Inf for finite y < 0 and not an odd integer
+//	Pow(±0, y) = ±0 for y an odd integer > 0
+//	Pow(±0, y) = +0 for finite y > 0 and not an odd integer
+//	Pow(-1, ±Inf) = 1
+//	Pow(x, +Inf) = +Inf for |x| > 1
+//	Pow(x, -Inf) = +0 for |x| > 1
+//	Pow(x, +Inf) = +0 for |x| < 1
+//	Pow(x, -Inf) = +Inf for |x| < 1
+//	Pow(+Inf, y) = +Inf for y > 0
+//	Pow(+Inf, y) = +0 for y < 0
+//	Pow(-Inf, y) = Pow(-0, -y)
+//	Pow(x, y) = NaN for finite x < 0 and finite non-integer y
+func (x Float16) Pow(y Float16) Float16 {
+	fx := x.Float64()
+	fy := y.Float64()
+	if r, ok := powExact(fx, fy); ok {
+		return FromFloat64(r)
+	}
+	return FromFloat64(math.Pow(fx, fy))
+}
+
+// powExact returns x**y if x > 0, y is not an integer, and x**y is exactly representable in float64.
+// math.Pow computes such x**y with math.Exp and math.Log,
+// so the result may not be exact even if it is a midpoint of Float16.
+// The cases of integer y are handled by math.Pow exactly.
+func powExact(x, y float64) (float64, bool) {
+	if !(x > 0) || math.IsInf(x, 0) || math.IsInf(y, 0) || math.IsNaN(y) || y == math.Trunc(y) {
+		return 0, false
+	}
+
+	// x = a × 2**e, where a is odd.
+	a, e := oddMant(x)
+
+	// y = p / 2**k, where p is odd and k > 0.
+	p, k := oddMant(y)
+	k = -k
+
+	// x**y = a**(p / 2**k) × 2**(e × p / 2**k)
+	// It is rational only if a is a perfect (2**k)-th power
+	// and e × p is a multiple of 2**k.
+	exp := int64(e) * p
+	if exp%(1<<k) != 0 {
+		return 0, false
+	}
+	exp >>= k
+	for i := 0; i < k && a != 1; i++ {
+		s := sqrt64(uint64(a))
+		if s*s != uint64(a) {
+			return 0, false
+		}
+		a = int64(s)
+	}
+	if a == 1 {
+		return math.Ldexp(1, int(exp)), true
+	}
+	if p < 0 {
+		// 1 / a**|p| is not a binary fraction.
+		return 0, false
+	}
+
+	// compute c = a**p, if it fits in float64 exactly.
+	c := uint64(1)
+	for i := int64(0); i < p; i++ {
+		hi, lo := bits.Mul64(c, uint64(a))
+		if hi != 0 || lo >= 1<<53 {
+			return 0, false
+		}
+		c = lo
+	}
+	return math.Ldexp(float64(c), int(exp)), true
+}
+
+// oddMant returns m and e such that f = m × 2**e and m is odd.
+// f must be finite and non-zero.
+func oddMant(f float64) (m int64, e int) {
+	b := math.Float64bits(f)
+	mant := b&(1<<shift64-1) | 1<<shift64
+	e = int(b>>shift64&(1<<11-1)) - bias64 - shift64
+	tz := bits.TrailingZeros64(mant)
+	m = int64(mant >> tz)
+	if b&signMask64 != 0 {
+		m = -m
+	}
+	return m, e + tz
+}
@@ -0,0 +1,132 @@
+package float16
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestPow(t *testing.T) {
+	inf := math.Inf(1)
+	nan := math.NaN()
+	tests := []struct {
+		x, y, want float64
+	}{
+		// special cases
+		{nan, 0, 1},
+		{nan, negZero, 1},
+		{1, nan, 1},
+		{1, inf, 1},
+		{nan, 1, nan},
+		{-2, 1, -2},
+		{nan, 2, nan},
+		{2, nan, nan},
+		{0, -3, inf},
+		{negZero, -3, -inf},
+		{negZero, -inf, inf},
+		{negZero, inf, 0},
+		{negZero, -2, inf},
+		{negZero, -0.5, inf},
+		{negZero, 3, negZero},
+		{0, 3, 0},
+		{negZero, 2, 0},
+		{negZero, 0.5, 0},
+		{-1, inf, 1},
+		{-1, -inf, 1},
+		{2, inf, inf},
+		{2, -inf, 0},
+		{0.5, inf, 0},
+		{0.5, -inf, inf},
+		{inf, 0.5, inf},
+		{inf, -0.5, 0},
+		{-inf, 3, -inf},
+		{-inf, -3, negZero},
+		{-2, 0.5, nan},
+
+		// exact results
+		{2, 10, 1024},
+		{-3, 3, -27},
+		{2, -24, 0x1p-24},
+		{2, -25, 0},
+		{2, 16, inf},
+		{9, 1.5, 27},
+		{225, 1.5, 3376},      // 3375 is a midpoint
+		{0.25, 12.5, 0},       // 0x1p-25 is a midpoint
+		{0.25, 11.5, 0x1p-23}, // 0x1p-23 is exact
+		{16, 0.25, 2},
+		{16, -0.75, 0.125},
+		{56.25, 1.5, 422}, // 421.875 is a midpoint, and math.Pow returns a smaller value
+		{676, 1.5, 17568}, // 17576 is a midpoint, and math.Pow returns a larger value
+	}
+	for _, tt := range tests {
+		got := FromFloat64(tt.x).Pow(FromFloat64(tt.y))
+		want := FromFloat64(tt.want)
+		if got != want && !(got.IsNaN() && want.IsNaN()) {
+			t.Errorf("Pow(%v, %v): expected %v, got %v", tt.x, tt.y, want, got)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		x := Float16(r.Intn(0x7c00)) | Float16(r.Intn(2))<<15
+		var y Float16
+		switch i % 3 {
+		case 0:
+			// small integer powers are exact
+			y = FromFloat64(float64(r.Intn(33) - 16))
+		case 1:
+			// make x**y near one
+			y = Float16(r.Intn(0x7c00)) | Float16(r.Intn(2))<<15
+			x = 0x3c00 + Float16(r.Intn(64)) - 32
+		default:
+			y = Float16(r.Intn(0x7c00)) | Float16(r.Intn(2))<<15
+		}
+		if x&^signMask16 == 0 || y&^signMask16 == 0 {
+			continue
+		}
+		got := x.Pow(y)
+
+		fy := y.Float64()
+		if x&signMask16 != 0 && fy != math.Trunc(fy) {
+			if !got.IsNaN() {
+				t.Errorf("Pow(%v, %v): expected NaN, got %v", x, y, got)
+			}
+			continue
+		}
+
+		want := bigPow(x.big(ToNearestEven), fy)
+		if i%3 == 0 {
+			// the result must be correctly rounded.
+			if w := roundBig(want, ToNearestEven); got != w {
+				t.Errorf("Pow(%v, %v): expected %v, got %v", x, y, w, got)
+			}
+			continue
+		}
+		if !withinULP(got, want) {
+			t.Errorf("Pow(%v, %v): expected %v, got %v", x, y, want, got)
+		}
+	}
+}
+
+// bigPow returns x**y.
+func bigPow(x *big.Float, y float64) *big.Float {
+	if y == math.Trunc(y) && math.Abs(y) <= 16 {
+		// compute exactly.
+		r := new(big.Float).SetPrec(1024).SetInt64(1)
+		for i := 0; i < int(math.Abs(y)); i++ {
+			r.Mul(r, x)
+		}
+		if y < 0 {
+			r.Quo(bigInt(1), r)
+		}
+		return r
+	}
+
+	ax := newBigFloat().Abs(x)
+	r := bigExp(bigMul(newBigFloat().SetFloat64(y), bigLog(ax)))
+	if x.Signbit() && math.Mod(y, 2) != 0 {
+		r.Neg(r)
+	}
+	return r
+}
@@ -0,0 +1,185 @@
+package float16
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+// RoundingMode determines how a value is rounded to the nearest Float16.
+type RoundingMode byte
+
+// These constants define supported rounding modes.
+const (
+	ToNearestEven RoundingMode = iota // == IEEE 754-2008 roundTiesToEven
+	ToNearestAway                     // == IEEE 754-2008 roundTiesToAway
+	ToZero                            // == IEEE 754-2008 roundTowardZero
+	ToNegativeInf                     // == IEEE 754-2008 roundTowardNegative
+	ToPositiveInf                     // == IEEE 754-2008 roundTowardPositive
+)
+
+func (mode RoundingMode) String() string {
+	switch mode {
+	case ToNearestEven:
+		return "ToNearestEven"
+	case ToNearestAway:
+		return "ToNearestAway"
+	case ToZero:
+		return "ToZero"
+	case ToNegativeInf:
+		return "ToNegativeInf"
+	case ToPositiveInf:
+		return "ToPositiveInf"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(mode)) + ")"
+}
+
+const uvmax = uvinf - 1 // the largest finite value
+
+// floatInfo describes a binary floating-point format.
+// A finite value is (-1)^sign × 1.frac × 2^(exp+bias) if exp is non-zero,
+// and (-1)^sign × 0.frac × 2^(1+bias) otherwise.
+type floatInfo struct {
+	mantbits uint // the number of the fraction bits
+	expbits  uint // the number of the exponent bits
+	bias     int
+}
+
+var float16info = FormatFloat16.info()
+
+// round rounds (-1)^sign × mant × 2^exp to a Float16 in the direction of c.Mode,
+// and raises the exception flags.
+// sticky reports whether non-zero bits below mant have been discarded,
+// that is, the exact magnitude is slightly larger than mant × 2^exp.
+func (c *Context) round(sign uint16, exp int, mant uint64, sticky bool) Float16 {
+	return Float16(c.roundBits(&float16info, sign != 0, exp, mant, sticky))
+}
+
+// roundBits is the same as round, but it rounds to the format described by flt,
+// and returns the bit representation of the result.
+func (c *Context) roundBits(flt *floatInfo, neg bool, exp int, mant uint64, sticky bool) uint64 {
+	var sign uint64
+	if neg {
+		sign = 1 << (flt.expbits + flt.mantbits)
+	}
+	mantbits := int(flt.mantbits)
+	minexp := 1 + flt.bias // the exponent of the smallest normal number
+
+	if mant == 0 {
+		if !sticky {
+			return sign
+		}
+		// the magnitude is non-zero, but less than half of the smallest subnormal.
+		mant, exp, sticky = 1, minexp-mantbits-2, false
+	}
+
+	// the exponent of the leading bit.
+	e := exp + bits.Len64(mant) - 1
+	tiny := e < minexp
+
+	// the exponent of the least significant bit of the result.
+	lsb := e - mantbits
+	if lsb < minexp-mantbits {
+		// the result is subnormal
+		lsb = minexp - mantbits
+	}
+
+	q, guard, rest := truncate(mant, sticky, lsb-exp)
+	inexact := guard || rest
+	if inexact {
+		if tiny && c.Tininess == AfterRounding && e == minexp-1 {
+			// check whether the result would be the smallest normal number
+			// if the exponent range were unbounded.
+			q, guard, rest := truncate(mant, sticky, e-mantbits-exp)
+			if q == 1<<(mantbits+1)-1 && c.Mode.roundUp(neg, q, guard, rest) {
+				tiny = false
+			}
+		}
+		c.Flags |= Inexact
+		if tiny {
+			c.Flags |= Underflow
+		}
+	}
+
+	if c.Mode.roundUp(neg, q, guard, rest) {
+		q++
+		if q == 1<<(mantbits+1) {
+			q >>= 1
+			lsb++
+		}
+	}
+
+	// the biased exponent of the result, if it is normal.
+	biased := lsb + mantbits - flt.bias
+	if biased >= 1<<flt.expbits-1 {
+		return c.overflowBits(flt, neg)
+	}
+
+	// q contains the implicit bit, so adding it carries into the exponent field.
+	return sign | (uint64(biased-1)<<flt.mantbits + q)
+}
+
+// truncate discards the lowest n bits of mant.
+// guard is the most significant discarded bit, and rest reports
+// whether any of the other discarded bits, or sticky, is non-zero.
+func truncate(mant uint64, sticky bool, n int) (q uint64, guard, rest bool) {
+	switch {
+	case n <= 0:
+		q = mant << -n
+		rest = sticky
+	case n <= 64:
+		q = mant >> n
+		r := mant & (1<<n - 1)
+		guard = r>>(n-1) != 0
+		rest = r&(1<<(n-1)-1) != 0 || sticky
+	default:
+		rest = true
+	}
+	return
+}
+
+// roundUp reports whether the truncated magnitude q should be incremented.
+func (mode RoundingMode) roundUp(neg bool, q uint64, guard, rest bool) bool {
+	switch mode {
+	case ToNearestEven:
+		return guard && (rest || q&1 != 0)
+	case ToNearestAway:
+		return guard
+	case ToNegativeInf:
+		return (guard || rest) && neg
+	case ToPositiveInf:
+		return (guard || rest) && !neg
+	}
+	return false
+}
+
+// overflow returns the result of rounding a value
+// that is too large to be represented in Float16, and raises the exception flags.
+func (c *Context) overflow(sign uint16) Float16 {
+	return Float16(c.overflowBits(&float16info, sign != 0))
+}
+
+// overflowBits is the same as overflow, but it returns the bit representation
+// in the format described by flt.
+func (c *Context) overflowBits(flt *floatInfo, neg bool) uint64 {
+	var sign uint64
+	if neg {
+		sign = 1 << (flt.expbits + flt.mantbits)
+	}
+	inf := uint64(1<<flt.expbits-1) << flt.mantbits
+	c.Flags |= Overflow | Inexact
+	switch mode := c.Mode; {
+	case mode == ToZero,
+		mode == ToNegativeInf && !neg,
+		mode == ToPositiveInf && neg:
+		return sign | (inf - 1)
+	}
+	return sign | inf
+}
+
+// zero returns the sign of an exact zero sum of operands with opposite signs.
+func (mode RoundingMode) zero() Float16 {
+	if mode == ToNegativeInf {
+		return signMask16
+	}
+	return 0
+}
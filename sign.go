@@ -0,0 +1,22 @@
+package float16
+
+// Abs returns the absolute value of x.
+//
+// Special cases are:
+//
+//	Abs(±Inf) = +Inf
+//	Abs(NaN) = NaN
+func (x Float16) Abs() Float16 {
+	return x &^ signMask16
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (x Float16) Signbit() bool {
+	return x&signMask16 != 0
+}
+
+// Copysign returns a value with the magnitude of f
+// and the sign of sign.
+func (f Float16) Copysign(sign Float16) Float16 {
+	return f&^signMask16 | sign&signMask16
+}
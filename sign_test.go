@@ -0,0 +1,37 @@
+package float16
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAbs(t *testing.T) {
+	testUnaryExact(t, "Abs", Float16.Abs, math.Abs)
+}
+
+func TestSignbit(t *testing.T) {
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		if got, want := x.Signbit(), math.Signbit(x.Float64()); got != want {
+			t.Errorf("Signbit(%v (%04x)): expected %t, got %t", x, uint16(x), want, got)
+		}
+	}
+}
+
+func TestCopysign(t *testing.T) {
+	signs := []Float16{0x0000, 0x8000, 0x3c00, 0xbc00, uvinf, uvneginf, uvnan, uvnan | signMask16}
+	for i := 0; i < 0x10000; i++ {
+		x := Float16(i)
+		for _, sign := range signs {
+			got := x.Copysign(sign)
+			want := FromFloat64(math.Copysign(x.Float64(), sign.Float64()))
+			if x.IsNaN() {
+				// FromFloat64 quiets signaling NaNs, but Copysign keeps them as they are.
+				want = x&^signMask16 | sign&signMask16
+			}
+			if got != want {
+				t.Errorf("Copysign(%04x, %04x): expected %04x, got %04x", uint16(x), uint16(sign), uint16(want), uint16(got))
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package float16
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	_ sql.Scanner   = (*Float16)(nil)
+	_ driver.Valuer = Float16(0)
+	_ sql.Scanner   = (*NullFloat16)(nil)
+	_ driver.Valuer = NullFloat16{}
+)
+
+// Scan implements [sql.Scanner].
+// It accepts float64, float32, int64, []byte and string values.
+// The numbers are rounded to nearest even, and the strings are parsed by [Parse].
+// If the value is out of the range of Float16, i.e. it overflows,
+// or it is non-zero but underflows to zero, Scan returns an error
+// that wraps [strconv.ErrRange], and x is not modified.
+// The numbers and the strings are treated in the same way.
+func (x *Float16) Scan(src any) error {
+	var c Context
+	var f Float16
+	var num string
+	switch src := src.(type) {
+	case float64:
+		f = c.FromFloat64(src)
+		num = strconv.FormatFloat(src, 'g', -1, 64)
+	case float32:
+		f = c.FromFloat32(src)
+		num = strconv.FormatFloat(float64(src), 'g', -1, 32)
+	case int64:
+		f = c.FromInt64(src)
+		num = strconv.FormatInt(src, 10)
+	case []byte:
+		return x.scanString(string(src))
+	case string:
+		return x.scanString(src)
+	case nil:
+		return errors.New("float16: converting NULL to Float16 is unsupported")
+	default:
+		return fmt.Errorf("float16: unsupported Scan, storing driver.Value type %T into type Float16", src)
+	}
+	// the same conditions as Parse: an overflow, or a non-zero value that underflows to zero.
+	if c.Flags&Overflow != 0 || c.Flags&Underflow != 0 && f&^signMask16 == 0 {
+		return &strconv.NumError{Func: "float16.Scan", Num: num, Err: strconv.ErrRange}
+	}
+	*x = f
+	return nil
+}
+
+func (x *Float16) scanString(s string) error {
+	f, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*x = f
+	return nil
+}
+
+// Value implements [driver.Valuer].
+// It returns x as a float64, which represents x exactly.
+func (x Float16) Value() (driver.Value, error) {
+	return x.Float64(), nil
+}
+
+// NullFloat16 represents a Float16 that may be null.
+// NullFloat16 implements the [sql.Scanner] interface so
+// it can be used as a scan destination, similar to [sql.NullFloat64].
+type NullFloat16 struct {
+	Float16 Float16
+	Valid   bool // Valid is true if Float16 is not NULL
+}
+
+// Scan implements [sql.Scanner].
+func (n *NullFloat16) Scan(src any) error {
+	if src == nil {
+		n.Float16, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Float16.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements [driver.Valuer].
+func (n NullFloat16) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Float16.Value()
+}
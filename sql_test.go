@@ -0,0 +1,111 @@
+package float16
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		src  any
+		want Float16
+	}{
+		{float64(1.5), FromFloat64(1.5)},
+		{float64(0.1), FromFloat64(0.1)},
+		{math.Inf(-1), uvneginf},
+		{float64(65519), uvmax},
+		{float32(0.1), FromFloat32(0.1)},
+		{int64(-2049), FromFloat64(-2048)},
+		{[]byte("0.1"), FromFloat64(0.1)},
+		{"-0", signMask16},
+		{"+Inf", uvinf},
+
+		// the smallest values that don't underflow to zero
+		{float64(0x1.000001p-25), 0x0001},
+		{float32(-0x1.000002p-25), 0x8001},
+		{"0x1.000001p-25", 0x0001},
+	}
+	for _, tt := range tests {
+		var got Float16
+		if err := got.Scan(tt.src); err != nil {
+			t.Errorf("%v: %v", tt.src, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%v: expected %04x, got %04x", tt.src, tt.want, got)
+		}
+	}
+
+	var got Float16
+	if err := got.Scan(math.NaN()); err != nil || !got.IsNaN() {
+		t.Errorf("NaN: expected NaN, got %v, %v", got, err)
+	}
+}
+
+func TestScan_Error(t *testing.T) {
+	ranges := []any{
+		float64(65520), float64(-1e10), float32(1e6), int64(math.MaxInt64), "1e5", []byte("-65520"),
+
+		// non-zero values that underflow to zero
+		float64(1e-10), float64(-0x1p-26), float64(0x1p-25), float32(1e-10), float32(-0x1p-25), "1e-10", []byte("-0x1p-26"),
+	}
+	for _, src := range ranges {
+		x := Float16(uvone)
+		err := x.Scan(src)
+		if !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("%v: expected ErrRange, got %v", src, err)
+		}
+		if x != uvone {
+			t.Errorf("%v: the value is modified: %v", src, x)
+		}
+	}
+
+	for _, src := range []any{nil, "foo", true, int32(1)} {
+		x := Float16(uvone)
+		if err := x.Scan(src); err == nil {
+			t.Errorf("%v: expected error, got nil", src)
+		}
+		if x != uvone {
+			t.Errorf("%v: the value is modified: %v", src, x)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	v, err := FromFloat64(0.1).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, ok := v.(float64); !ok || f != FromFloat64(0.1).Float64() {
+		t.Errorf("expected %v, got %#v", FromFloat64(0.1).Float64(), v)
+	}
+}
+
+func TestNullFloat16(t *testing.T) {
+	var n NullFloat16
+	if err := n.Scan(float64(1.5)); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Float16 != FromFloat64(1.5) {
+		t.Errorf("expected valid 1.5, got %v", n)
+	}
+	if v, err := n.Value(); err != nil || v != 1.5 {
+		t.Errorf("expected 1.5, got %v, %v", v, err)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid || n.Float16 != 0 {
+		t.Errorf("expected null, got %v", n)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("expected nil, got %v, %v", v, err)
+	}
+
+	if err := n.Scan("1e10"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("expected ErrRange, got %v", err)
+	}
+}
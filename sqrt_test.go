@@ -41,18 +41,8 @@ func BenchmarkSqrt(b *testing.B) {
 	}
 }
 
-//go:generate sh -c "perl scripts/f16_sqrt.pl | gofmt > f16_sqrt_test.go"
-
 func TestSqrt_TestFloat(t *testing.T) {
-	for _, tt := range f16Sqrt {
-		x := FromBits(tt.x)
-		got := x.Sqrt()
-		want := FromBits(tt.y)
-		if got.IsNaN() && want.IsNaN() {
-			continue
-		}
-		if got != want {
-			t.Errorf("expected %04x, got %04x", got.Bits(), want.Bits())
-		}
-	}
+	testFloat(t, "f16_sqrt", func(x []uint64) uint64 {
+		return uint64(Float16(x[0]).Sqrt())
+	})
 }
@@ -0,0 +1,111 @@
+package float16
+
+import (
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/shogo82148/float16/internal/testfloat"
+)
+
+// testFloatOps are the operations tested against the test vectors of Berkeley TestFloat in testdata.
+// To test another operation, add it here and put the output of testfloat_gen into testdata, e.g.:
+//
+//	$ testfloat_gen -level 1 -rminMag f16_mul | gzip > testdata/f16_mul_rminMag.txt.gz
+//
+// See the package internal/testfloat for the naming rule of the files.
+var testFloatOps = map[string]func(c *Context, x []uint64) uint64{
+	"f16_add":    func(c *Context, x []uint64) uint64 { return uint64(c.Add(Float16(x[0]), Float16(x[1]))) },
+	"f16_sub":    func(c *Context, x []uint64) uint64 { return uint64(c.Sub(Float16(x[0]), Float16(x[1]))) },
+	"f16_mul":    func(c *Context, x []uint64) uint64 { return uint64(c.Mul(Float16(x[0]), Float16(x[1]))) },
+	"f16_div":    func(c *Context, x []uint64) uint64 { return uint64(c.Quo(Float16(x[0]), Float16(x[1]))) },
+	"f16_sqrt":   func(c *Context, x []uint64) uint64 { return uint64(c.Sqrt(Float16(x[0]))) },
+	"f16_mulAdd": func(c *Context, x []uint64) uint64 { return uint64(c.FMA(Float16(x[0]), Float16(x[1]), Float16(x[2]))) },
+	"f16_eq":     func(c *Context, x []uint64) uint64 { return testFloatBool(c.Eq(Float16(x[0]), Float16(x[1]))) },
+	"f16_lt":     func(c *Context, x []uint64) uint64 { return testFloatBool(c.Lt(Float16(x[0]), Float16(x[1]))) },
+	"f16_le":     func(c *Context, x []uint64) uint64 { return testFloatBool(c.Le(Float16(x[0]), Float16(x[1]))) },
+	"f32_to_f16": func(c *Context, x []uint64) uint64 { return uint64(c.FromFloat32(math.Float32frombits(uint32(x[0])))) },
+	"f64_to_f16": func(c *Context, x []uint64) uint64 { return uint64(c.FromFloat64(math.Float64frombits(x[0]))) },
+	"i32_to_f16": func(c *Context, x []uint64) uint64 { return uint64(c.FromInt64(int64(int32(x[0])))) },
+	"i64_to_f16": func(c *Context, x []uint64) uint64 { return uint64(c.FromInt64(int64(x[0]))) },
+	"ui32_to_f16": func(c *Context, x []uint64) uint64 {
+		return uint64(c.FromUint64(uint64(uint32(x[0]))))
+	},
+	"ui64_to_f16": func(c *Context, x []uint64) uint64 { return uint64(c.FromUint64(x[0])) },
+	"f16_to_i32":  func(c *Context, x []uint64) uint64 { return uint64(uint32(c.Int32(Float16(x[0])))) },
+	"f16_to_ui32": func(c *Context, x []uint64) uint64 { return uint64(c.Uint32(Float16(x[0]))) },
+	"f16_to_i64":  func(c *Context, x []uint64) uint64 { return uint64(c.Int64(Float16(x[0]))) },
+	"f16_to_ui64": func(c *Context, x []uint64) uint64 { return c.Uint64(Float16(x[0])) },
+}
+
+var testFloatModes = map[testfloat.RoundingMode]RoundingMode{
+	testfloat.NearEven:   ToNearestEven,
+	testfloat.NearMaxMag: ToNearestAway,
+	testfloat.MinMag:     ToZero,
+	testfloat.Min:        ToNegativeInf,
+	testfloat.Max:        ToPositiveInf,
+}
+
+func testFloatBool(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestContext_TestFloat(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.txt*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range files {
+		f, err := testfloat.ParseFile(name)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			op, ok := testFloatOps[f.Function.Name]
+			if !ok {
+				t.Skipf("%s is not supported", f.Function.Name)
+			}
+			mode, ok := testFloatModes[f.Mode]
+			if !ok {
+				t.Skipf("rounding mode %s is not supported", f.Mode)
+			}
+			tininess := AfterRounding
+			if f.Tininess == testfloat.BeforeRounding {
+				tininess = BeforeRounding
+			}
+			if f.Function.Operand == testfloat.F16 && f.Function.Result != testfloat.F16 &&
+				f.Function.Result != testfloat.Bool && !f.Exact {
+				t.Skip("the conversions to integers always raise inexact, as testfloat_gen -exact")
+			}
+			if f.Level > 1 && testing.Short() {
+				t.Skip("skipping level 2 test vectors in short mode")
+			}
+
+			testfloat.Run(t, f, func(x []uint64) (uint64, uint8) {
+				c := Context{Mode: mode, Tininess: tininess}
+				got := op(&c, x)
+				return got, uint8(c.Flags)
+			})
+		})
+	}
+}
+
+// testFloat runs op against the test vectors testdata/<function>.txt.gz,
+// which are generated with the default options of testfloat_gen,
+// i.e. rounding to nearest even.
+// The exception flags are not checked.
+func testFloat(t *testing.T, function string, op func(x []uint64) uint64) {
+	t.Helper()
+	f, err := testfloat.ParseFile(filepath.Join("testdata", function+".txt.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &testfloat.Runner{IgnoreFlags: true}
+	r.Run(t, f, func(x []uint64) (uint64, uint8) {
+		return op(x), 0
+	})
+}
@@ -0,0 +1,183 @@
+package float16
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestSin(t *testing.T) {
+	testSpecialCases(t, Float16.Sin, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), math.NaN()},
+		{math.Inf(-1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Sin, func(x *big.Float) *big.Float {
+		sin, _ := bigSinCos(x)
+		return sin
+	})
+}
+
+func TestCos(t *testing.T) {
+	testSpecialCases(t, Float16.Cos, []specialCase{
+		{0, 1},
+		{negZero, 1},
+		{math.Inf(1), math.NaN()},
+		{math.Inf(-1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Cos, func(x *big.Float) *big.Float {
+		_, cos := bigSinCos(x)
+		return cos
+	})
+}
+
+func TestTan(t *testing.T) {
+	testSpecialCases(t, Float16.Tan, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), math.NaN()},
+		{math.Inf(-1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Tan, func(x *big.Float) *big.Float {
+		sin, cos := bigSinCos(x)
+		return bigQuo(sin, cos)
+	})
+}
+
+func TestAsin(t *testing.T) {
+	testSpecialCases(t, Float16.Asin, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{1, math.Pi / 2},
+		{2, math.NaN()},
+		{math.Inf(-1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Asin, bigAsin)
+}
+
+func TestAcos(t *testing.T) {
+	testSpecialCases(t, Float16.Acos, []specialCase{
+		{1, 0},
+		{-1, math.Pi},
+		{0, math.Pi / 2},
+		{2, math.NaN()},
+		{math.Inf(1), math.NaN()},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Acos, func(x *big.Float) *big.Float {
+		asin := bigAsin(x)
+		if asin == nil {
+			return nil
+		}
+		return bigSub(newBigFloat().SetMantExp(bigPi, -1), asin)
+	})
+}
+
+func bigAsin(x *big.Float) *big.Float {
+	switch c := newBigFloat().Abs(x).Cmp(bigInt(1)); {
+	case c > 0:
+		return nil
+	case c == 0:
+		r := newBigFloat().SetMantExp(bigPi, -1)
+		if x.Signbit() {
+			r.Neg(r)
+		}
+		return r
+	}
+	// asin(x) = atan(x / sqrt(1 - x**2))
+	return bigAtan(bigQuo(x, bigSqrt(bigSub(bigInt(1), bigMul(x, x)))))
+}
+
+func TestAtan(t *testing.T) {
+	testSpecialCases(t, Float16.Atan, []specialCase{
+		{0, 0},
+		{negZero, negZero},
+		{math.Inf(1), math.Pi / 2},
+		{math.Inf(-1), -math.Pi / 2},
+		{math.NaN(), math.NaN()},
+	})
+	testExhaustive(t, Float16.Atan, bigAtan)
+}
+
+func TestAtan2(t *testing.T) {
+	inf := math.Inf(1)
+	nan := math.NaN()
+	tests := []struct {
+		y, x, want float64
+	}{
+		{1, nan, nan},
+		{nan, 1, nan},
+		{0, 1, 0},
+		{0, 0, 0},
+		{negZero, 1, negZero},
+		{negZero, 0, negZero},
+		{0, -1, math.Pi},
+		{0, negZero, math.Pi},
+		{negZero, -1, -math.Pi},
+		{negZero, negZero, -math.Pi},
+		{1, 0, math.Pi / 2},
+		{-1, 0, -math.Pi / 2},
+		{inf, inf, math.Pi / 4},
+		{-inf, inf, -math.Pi / 4},
+		{inf, -inf, 3 * math.Pi / 4},
+		{-inf, -inf, -3 * math.Pi / 4},
+		{1, inf, 0},
+		{1, -inf, math.Pi},
+		{-1, -inf, -math.Pi},
+		{inf, 1, math.Pi / 2},
+		{-inf, 1, -math.Pi / 2},
+	}
+	for _, tt := range tests {
+		got := FromFloat64(tt.y).Atan2(FromFloat64(tt.x))
+		want := FromFloat64(tt.want)
+		if got != want && !(got.IsNaN() && want.IsNaN()) {
+			t.Errorf("Atan2(%v, %v): expected %v, got %v", tt.y, tt.x, want, got)
+		}
+	}
+
+	// the error must be less than 1 ulp.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		y := Float16(r.Intn(0x7c00)) | Float16(r.Intn(2))<<15
+		x := Float16(r.Intn(0x7c00)) | Float16(r.Intn(2))<<15
+		if x&^signMask16 == 0 || y&^signMask16 == 0 {
+			continue
+		}
+		// atan2(y, x) = atan(y / x) ± π if x < 0
+		want := bigAtan(bigQuo(y.big(ToNearestEven), x.big(ToNearestEven)))
+		if x&signMask16 != 0 {
+			if y&signMask16 != 0 {
+				want.Sub(want, bigPi)
+			} else {
+				want.Add(want, bigPi)
+			}
+		}
+		got := y.Atan2(x)
+		if !withinULP(got, want) {
+			t.Errorf("Atan2(%v, %v): expected %v, got %v", y, x, want, got)
+		}
+	}
+}
+
+// withinULP reports whether got is one of the Float16 values adjacent to want.
+func withinULP(got Float16, want *big.Float) bool {
+	w := roundBig(want, ToNearestEven)
+	if got == w {
+		return true
+	}
+	// the Float16 values are monotonic in the comparable form.
+	d := int(got.comparable()) - int(w.comparable())
+	if d != 1 && d != -1 {
+		return false
+	}
+	// want must be between got and w.
+	c1 := got.big(ToNearestEven).Cmp(want)
+	c2 := w.big(ToNearestEven).Cmp(want)
+	return c1 != c2
+}